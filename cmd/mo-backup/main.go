@@ -0,0 +1,169 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// mo-backup lists and restores the backups taken by the BACKUP statement.
+//
+//	mo-backup list -backup <path>
+//	mo-backup restore -backup <path> -target <shared fs dir> [-ts <physical-logical>]
+//	        [-wal <backup root> -shared <shared fs dir>] [-account <id>] [-work <dir>]
+//
+// A backup is restored into a fresh cluster as it was at its timestamp. With -wal,
+// the cluster is restored to -ts after a backup by replaying the WAL archived by
+// the DN with archive-wal. With -account, only the account is restored, from the
+// backups of the cluster or of the account. The restore of an account into an
+// existing cluster is not supported.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/backup"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage:\n")
+	fmt.Fprintf(os.Stderr, "  mo-backup list -backup <path>\n")
+	fmt.Fprintf(os.Stderr, "  mo-backup restore -backup <path> -target <shared fs dir> [-ts <physical-logical>]\n")
+	fmt.Fprintf(os.Stderr, "          [-wal <backup root> -shared <shared fs dir>] [-account <id>] [-work <dir>]\n")
+	fmt.Fprintf(os.Stderr, "restore builds a fresh cluster from the backup at -ts, which must be listed by mo-backup list.\n")
+	fmt.Fprintf(os.Stderr, "with -wal, the cluster is restored to any -ts after a backup by replaying the archived WAL.\n")
+	fmt.Fprintf(os.Stderr, "with -account, only the account is restored. restoring into an existing cluster is not supported.\n")
+	os.Exit(2)
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+	var err error
+	switch os.Args[1] {
+	case "list":
+		err = list(os.Args[2:])
+	case "restore":
+		err = restore(os.Args[2:])
+	default:
+		usage()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mo-backup error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func list(args []string) error {
+	var path string
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	flags.StringVar(&path, "backup", "", "the path of the backups, must be specified")
+	_ = flags.Parse(args)
+	if path == "" {
+		usage()
+	}
+
+	ctx := context.Background()
+	fs, err := backup.OpenPath(nil, path)
+	if err != nil {
+		return err
+	}
+	metas, err := backup.ListMetas(ctx, fs)
+	if err != nil {
+		return err
+	}
+	for _, meta := range metas {
+		fmt.Fprintf(os.Stdout, "%s\t%s\taccount=%d\tdatabase=%d\t%d files\n",
+			meta.TS.DebugString(), meta.Scope.Kind,
+			meta.Scope.AccountID, meta.Scope.DatabaseID, len(meta.Files))
+	}
+	return nil
+}
+
+func restore(args []string) error {
+	var path, target, ts, archive, shared, work string
+	var account int64
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	flags.StringVar(&path, "backup", "", "the path of the backups, must be specified")
+	flags.StringVar(&target, "target", "", "the data dir of the shared file service of a fresh cluster, must be specified")
+	flags.StringVar(&ts, "ts", "", "the timestamp to restore to, default the latest backup")
+	flags.StringVar(&archive, "wal", "", "the backup root of the DN, where the WAL is archived, to restore to a timestamp after a backup")
+	flags.StringVar(&shared, "shared", "", "the data dir of the shared file service of the cluster, where the objects flushed after the backup are")
+	flags.Int64Var(&account, "account", -1, "the id of the account to restore alone")
+	flags.StringVar(&work, "work", "", "the empty local dir where the WAL is replayed, default a temp dir")
+	_ = flags.Parse(args)
+	if path == "" || target == "" {
+		usage()
+	}
+
+	var restoreTS timestamp.Timestamp
+	var err error
+	if ts != "" {
+		if restoreTS, err = timestamp.ParseTimestamp(ts); err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	src, err := backup.OpenPath(nil, path)
+	if err != nil {
+		return err
+	}
+	dst, err := backup.OpenTarget(target)
+	if err != nil {
+		return err
+	}
+	if archive == "" && account < 0 {
+		meta, err := backup.Restore(ctx, src, dst, restoreTS)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "restored the %s backup at %s, %d files\n",
+			meta.Scope.Kind, meta.TS.DebugString(), len(meta.Files))
+		return nil
+	}
+
+	scope := backup.Scope{Kind: backup.ScopeCluster}
+	if account >= 0 {
+		scope = backup.Scope{Kind: backup.ScopeAccount, AccountID: uint32(account)}
+	}
+	var replay backup.ReplaySource
+	if archive != "" {
+		if shared == "" {
+			usage()
+		}
+		if replay.Archive, err = backup.OpenPath(nil, archive); err != nil {
+			return err
+		}
+		if replay.Shared, err = backup.OpenTarget(shared); err != nil {
+			return err
+		}
+	}
+	if work == "" {
+		if work, err = os.MkdirTemp("", "mo-backup"); err != nil {
+			return err
+		}
+		defer os.RemoveAll(work)
+	}
+	meta, err := backup.RestoreToTS(ctx, src, replay, dst, work, restoreTS, scope)
+	if err != nil {
+		return err
+	}
+	if ts == "" {
+		ts = "the latest backup"
+	}
+	fmt.Fprintf(os.Stdout, "restored the %s to %s, %d files\n",
+		scope.Kind, ts, len(meta.Files))
+	return nil
+}
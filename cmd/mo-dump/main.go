@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/hex"
 	"flag"
 	"fmt"
 	_ "github.com/go-sql-driver/mysql"
@@ -370,10 +371,13 @@ func convertValue(v any, typ string) string {
 			return retStr
 		}
 		return "'" + retStr + "'" // NaN, +Inf, -Inf, maybe no hacking need in the future
-	case "int", "tinyint", "smallint", "bigint", "unsigned bigint", "unsigned int", "unsigned tinyint", "unsigned smallint", "double", "bool", "boolean", "":
+	case "int", "tinyint", "smallint", "bigint", "unsigned bigint", "unsigned int", "unsigned tinyint", "unsigned smallint", "double", "bool", "boolean", "year", "":
 		// why empty string in column type?
 		// see https://github.com/matrixorigin/matrixone/issues/8050#issuecomment-1431251524
 		return string(ret)
+	case "bit":
+		// the value of bit is the big endian bytes, dump it as the hex literal
+		return "0x" + hex.EncodeToString(ret)
 	default:
 		return "'" + strings.Replace(string(ret), "'", "\\'", -1) + "'"
 	}
@@ -386,10 +390,13 @@ func convertValue2(v any, typ string) (sql.RawBytes, string) {
 	}
 	typ = strings.ToLower(typ)
 	switch typ {
-	case "int", "tinyint", "smallint", "bigint", "unsigned bigint", "unsigned int", "unsigned tinyint", "unsigned smallint", "double", "bool", "boolean", "", "float":
+	case "int", "tinyint", "smallint", "bigint", "unsigned bigint", "unsigned int", "unsigned tinyint", "unsigned smallint", "double", "bool", "boolean", "year", "", "float":
 		// why empty string in column type?
 		// see https://github.com/matrixorigin/matrixone/issues/8050#issuecomment-1431251524
 		return ret, defaultFmt
+	case "bit":
+		// like mysqldump, the bytes of bit are written as they are
+		return ret, defaultFmt
 	case "json":
 		return ret, jsonFmt
 	default:
@@ -47,12 +47,18 @@ func TestConvertValue(t *testing.T) {
 		{"2021-01-01", "date"},
 		{"2021-01-01 00:00:00", "datetime"},
 		{"2021-01-01 00:00:00", "timestamp"},
+		{"2023", "year"},
+		{"\x01\x02", "bit"},
+		{"a", "enum"},
+		{"a,b", "set"},
 	}
 	for _, v := range kase {
 		s := convertValue(makeValue(v.val), v.typ)
 		switch v.typ {
-		case "int", "tinyint", "smallint", "bigint", "unsigned bigint", "unsigned int", "unsigned tinyint", "unsigned smallint", "float", "double":
+		case "int", "tinyint", "smallint", "bigint", "unsigned bigint", "unsigned int", "unsigned tinyint", "unsigned smallint", "float", "double", "year":
 			require.Equal(t, v.val, s)
+		case "bit":
+			require.Equal(t, "0x0102", s)
 		default:
 
 			require.Equal(t, fmt.Sprintf("'%v'", v.val), s)
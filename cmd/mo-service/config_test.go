@@ -17,6 +17,7 @@ package main
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/dnservice"
@@ -73,6 +74,45 @@ func TestParseDNConfig(t *testing.T) {
 	assert.Equal(t, 2, len(cfg.getDNServiceConfig().HAKeeper.ClientConfig.ServiceAddresses))
 }
 
+func TestParseTLSConfig(t *testing.T) {
+	data := `
+	service-type = "CN"
+
+	[hakeeper-client]
+	service-addresses = ["1"]
+
+	[hakeeper-client.tls]
+	enable = true
+	ca-file = "ca.pem"
+	cert-file = "client.pem"
+	key-file = "client-key.pem"
+
+	[cn.rpc.tls]
+	enable = true
+	ca-file = "ca.pem"
+	cert-file = "cn.pem"
+	key-file = "cn-key.pem"
+	reload-interval = "10s"
+
+	[dn.rpc.tls]
+	enable = true
+	cert-file = "dn.pem"
+
+	[logservice.rpc.tls]
+	enable = true
+	server-name = "log"
+	`
+	cfg := &Config{}
+	assert.NoError(t, parseFromString(data, cfg))
+	assert.True(t, cfg.getProxyConfig().HAKeeper.ClientConfig.TLS.Enable)
+	assert.Equal(t, "client.pem", cfg.getDNServiceConfig().HAKeeper.ClientConfig.TLS.CertFile)
+	assert.Equal(t, "ca.pem", cfg.getLogServiceConfig().HAKeeperClientConfig.TLS.CAFile)
+	assert.Equal(t, "cn.pem", cfg.getCNServiceConfig().RPC.TLS.CertFile)
+	assert.Equal(t, time.Second*10, cfg.getCNServiceConfig().RPC.TLS.ReloadInterval.Duration)
+	assert.Equal(t, "dn.pem", cfg.DN.RPC.TLS.CertFile)
+	assert.Equal(t, "log", cfg.LogService.RPC.TLS.ServerName)
+}
+
 func TestFileServiceFactory(t *testing.T) {
 	c := &Config{}
 	c.FileServices = append(c.FileServices, fileservice.Config{
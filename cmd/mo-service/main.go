@@ -344,6 +344,12 @@ func initTraceMetric(ctx context.Context, st metadata.ServiceType, cfg *Config,
 		_ = table.SetPathBuilder(ctx, SV.PathBuilder)
 	}
 	if !SV.DisableTrace {
+		var auditWriterFactory table.WriterFactory
+		if SV.AuditLogSink == "file" {
+			if auditWriterFactory, err = export.GetAuditFileWriterFactory(SV.AuditLogDir); err != nil {
+				return err
+			}
+		}
 		initWG.Add(1)
 		collector := export.NewMOCollector(ctx, export.WithOBCollectorConfig(&SV.OBCollectorConfig))
 		stopper.RunNamedTask("trace", func(ctx context.Context) {
@@ -352,6 +358,7 @@ func initTraceMetric(ctx context.Context, st metadata.ServiceType, cfg *Config,
 				motrace.WithNode(UUID, nodeRole),
 				motrace.WithBatchProcessor(collector),
 				motrace.WithFSWriterFactory(writerFactory),
+				motrace.WithAuditWriterFactory(auditWriterFactory),
 				motrace.WithSQLExecutor(nil),
 			); err != nil {
 				panic(err)
@@ -0,0 +1,467 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package backup takes the backups of a cluster, an account or a database by the
+// BACKUP statement, and restores them by mo-backup.
+//
+// A backup is a global checkpoint with the objects it refers to, so it is restored
+// as it was at its timestamp into the shared storage of a fresh cluster. With
+// archive-wal of the DN, the WAL is archived under the backup root, and a cluster
+// or an account is restored to a timestamp after a backup by replaying the WAL
+// archived after it, see RestoreToTS. The restore of an account into an existing
+// cluster is not supported, since the checkpoint replaces the whole catalog of the
+// cluster. An account is restored into a fresh cluster with only the account,
+// whose data can then be copied.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
+)
+
+const (
+	// MetaDir is the dir of the backup metadata files, a metadata file is written
+	// after all the files of the backup are copied, so a backup is complete only
+	// if its metadata file exists.
+	MetaDir = "backup/"
+
+	metaVersion = 1
+
+	defaultFlushTimeout = 5 * time.Minute
+)
+
+// ScopeKind is what a backup contains.
+type ScopeKind string
+
+const (
+	ScopeCluster  ScopeKind = "cluster"
+	ScopeAccount  ScopeKind = "account"
+	ScopeDatabase ScopeKind = "database"
+)
+
+// Scope is what a backup contains.
+type Scope struct {
+	Kind       ScopeKind `json:"kind"`
+	AccountID  uint32    `json:"account_id,omitempty"`
+	DatabaseID uint64    `json:"database_id,omitempty"`
+}
+
+// acceptDB returns whether the database is in the backup. The system database
+// mo_catalog is in all the backups of an account, its tables are filtered by the
+// account.
+func (s Scope) acceptDB(entry *catalog.DBEntry) bool {
+	switch s.Kind {
+	case ScopeAccount:
+		return entry.GetTenantID() == s.AccountID || entry.IsSystemDB()
+	case ScopeDatabase:
+		return entry.GetID() == s.DatabaseID
+	}
+	return true
+}
+
+// acceptTable returns whether the table is in the backup. The backup of an account
+// also has the system tables of the sys account, so the account in the restored
+// cluster can be logged in, their rows of the other accounts are deleted by
+// deltaFn.
+func (s Scope) acceptTable(entry *catalog.TableEntry) bool {
+	switch s.Kind {
+	case ScopeAccount:
+		tenantID := entry.GetLastestSchema().AcInfo.TenantID
+		return tenantID == s.AccountID ||
+			(tenantID == 0 && entry.GetDB().IsSystemDB())
+	case ScopeDatabase:
+		return entry.GetDB().GetID() == s.DatabaseID
+	}
+	return true
+}
+
+// deltaFn returns the deltaFn of checkpoint.BackupCheckpoint for the scope. In the
+// backup of an account, the rows of the sys tables of mo_catalog only belonging
+// to the other accounts, by the column account_id, are deleted at ts by the
+// deletes written into dst. The rows of the sys account are kept for the login
+// of the restored cluster.
+func (s Scope) deltaFn(
+	ctx context.Context,
+	dst fileservice.FileService,
+	ts types.TS,
+) func(*catalog.BlockEntry, objectio.Location, objectio.Location) (objectio.Location, error) {
+	if s.Kind != ScopeAccount {
+		return nil
+	}
+	return func(entry *catalog.BlockEntry, metaLoc, deltaLoc objectio.Location) (objectio.Location, error) {
+		table := entry.GetSegment().GetTable()
+		if !table.GetDB().IsSystemDB() || table.GetLastestSchema().AcInfo.TenantID != 0 || metaLoc.IsEmpty() {
+			return deltaLoc, nil
+		}
+		schema := entry.GetSchema()
+		idx := schema.GetColIdx(pkgcatalog.SystemDBAttr_AccID)
+		if idx < 0 {
+			return deltaLoc, nil
+		}
+		return s.deleteOtherAccounts(ctx, dst, ts, entry, schema.ColDefs[idx], metaLoc, deltaLoc)
+	}
+}
+
+// deleteOtherAccounts writes the deletes of the block, with the rows of the other
+// accounts deleted, into dst and returns their location.
+func (s Scope) deleteOtherAccounts(
+	ctx context.Context,
+	dst fileservice.FileService,
+	ts types.TS,
+	entry *catalog.BlockEntry,
+	def *catalog.ColDef,
+	metaLoc, deltaLoc objectio.Location,
+) (objectio.Location, error) {
+	fs := entry.GetBlockData().GetFs()
+	vec, err := tables.LoadPersistedColumnData(fs, entry.AsCommonID(), def, metaLoc)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Close()
+
+	// the deletes of the block are kept
+	deletes := containers.NewBatch()
+	defer deletes.Close()
+	deletes.AddVector(catalog.PhyAddrColumnName, containers.MakeVector(types.T_Rowid.ToType()))
+	deletes.AddVector(catalog.AttrCommitTs, containers.MakeVector(types.T_TS.ToType()))
+	deletes.AddVector(catalog.AttrAborted, containers.MakeVector(types.T_bool.ToType()))
+	deleted := make(map[types.Rowid]struct{})
+	if !deltaLoc.IsEmpty() {
+		bat, err := tables.LoadPersistedDeletes(fs, deltaLoc)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < bat.Length(); i++ {
+			rowid, aborted := bat.Vecs[0].Get(i).(types.Rowid), bat.Vecs[2].Get(i).(bool)
+			deletes.Vecs[0].Append(rowid, false)
+			deletes.Vecs[1].Append(bat.Vecs[1].Get(i), false)
+			deletes.Vecs[2].Append(aborted, false)
+			if !aborted {
+				deleted[rowid] = struct{}{}
+			}
+		}
+		bat.Close()
+	}
+
+	rows := 0
+	for i := 0; i < vec.Length(); i++ {
+		if vec.IsNull(i) {
+			continue
+		}
+		if accountID := toAccountID(vec.Get(i)); accountID == 0 || accountID == s.AccountID {
+			continue
+		}
+		rowid := objectio.NewRowid(&entry.ID, uint32(i))
+		if _, ok := deleted[rowid]; ok {
+			continue
+		}
+		deletes.Vecs[0].Append(rowid, false)
+		deletes.Vecs[1].Append(ts, false)
+		deletes.Vecs[2].Append(false, false)
+		rows++
+	}
+	if rows == 0 {
+		return deltaLoc, nil
+	}
+
+	name := objectio.BuildObjectName(objectio.NewSegmentid(), 0)
+	writer, err := blockio.NewBlockWriterNew(dst, name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = writer.WriteBatchWithOutIndex(containers.ToCNBatch(deletes)); err != nil {
+		return nil, err
+	}
+	blocks, _, err := writer.Sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return blockio.EncodeLocation(name, blocks[0].GetExtent(), uint32(deletes.Length()), blocks[0].GetID()), nil
+}
+
+func toAccountID(v any) uint32 {
+	switch id := v.(type) {
+	case int32:
+		return uint32(id)
+	case uint32:
+		return id
+	case int64:
+		return uint32(id)
+	case uint64:
+		return uint32(id)
+	}
+	return 0
+}
+
+// Meta is the metadata of a backup.
+type Meta struct {
+	Version int                 `json:"version"`
+	TS      timestamp.Timestamp `json:"ts"`
+	Scope   Scope               `json:"scope"`
+	// Files are the files of the backup, the checkpoint metadata file is the last.
+	Files []string `json:"files"`
+}
+
+func metaName(ts types.TS) string {
+	return MetaDir + ts.ToString()
+}
+
+// Backup copies a snapshot of the scope into dst. All the data committed so far
+// is flushed first, then a global checkpoint of the scope is collected and written
+// into dst, with the objects it refers to copied from the storage of TAE. The
+// objects already in dst are skipped, so the backups to the same dst share them.
+func Backup(
+	ctx context.Context,
+	tae *db.DB,
+	dst fileservice.FileService,
+	scope Scope,
+	flushTimeout time.Duration) (*Meta, error) {
+	ts, err := flushForBackup(ctx, tae, flushTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	files, objects, err := checkpoint.BackupCheckpoint(
+		ctx, dst, tae.Catalog, ts, scope.acceptDB, scope.acceptTable, scope.deltaFn(ctx, dst, ts))
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range objects {
+		if _, err = dst.StatFile(ctx, name); err == nil {
+			continue
+		} else if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return nil, err
+		}
+		if err = copyFile(ctx, tae.Fs.Service, dst, name); err != nil {
+			return nil, err
+		}
+	}
+
+	meta := &Meta{
+		Version: metaVersion,
+		TS:      ts.ToTimestamp(),
+		Scope:   scope,
+		Files:   append(objects, files...),
+	}
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	if err = dst.Write(ctx, fileservice.IOVector{
+		FilePath: metaName(ts),
+		Entries: []fileservice.IOEntry{
+			{
+				Size: int64(len(data)),
+				Data: data,
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+	logutil.Infof("backup %s at %s, %d files", scope.Kind, ts.ToString(), len(meta.Files))
+	return meta, nil
+}
+
+// flushForBackup flushes the data committed so far and returns the end of the
+// checkpoint of the backup. The data only in memory is not in the backup, so
+// the checkpoint ends at a flush ts, before which all the changes are flushed.
+// The locations of the flush are committed after it, so the ts is the end of a
+// flush which has no change committed during it, the flush is repeated until
+// then.
+func flushForBackup(ctx context.Context, tae *db.DB, flushTimeout time.Duration) (types.TS, error) {
+	if flushTimeout == 0 {
+		flushTimeout = defaultFlushTimeout
+	}
+	deadline := time.Now().Add(flushTimeout)
+	ts := tae.TxnMgr.Now()
+	for {
+		if err := tae.BGCheckpointRunner.ForceFlush(ts, ctx, flushTimeout); err != nil {
+			return types.TS{}, err
+		}
+		end := tae.TxnMgr.Now()
+		if tae.BGCheckpointRunner.IsAllChangesFlushed(ts, end, false) {
+			return end, nil
+		}
+		if time.Now().After(deadline) {
+			return types.TS{}, moerr.NewInternalError(ctx, "backup: the changes committed during the flush are not flushed in %s", flushTimeout)
+		}
+		ts = end
+	}
+}
+
+// ListMetas returns the metadata of the complete backups in fs, ordered by the
+// backup timestamp.
+func ListMetas(ctx context.Context, fs fileservice.FileService) ([]*Meta, error) {
+	entries, err := fs.List(ctx, MetaDir)
+	if err != nil {
+		return nil, err
+	}
+	metas := make([]*Meta, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir {
+			continue
+		}
+		vec := &fileservice.IOVector{
+			FilePath: MetaDir + entry.Name,
+			Entries: []fileservice.IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		}
+		if err = fs.Read(ctx, vec); err != nil {
+			return nil, err
+		}
+		meta := new(Meta)
+		if err = json.Unmarshal(vec.Entries[0].Data, meta); err != nil {
+			return nil, err
+		}
+		if meta.Version != metaVersion {
+			return nil, moerr.NewNotSupported(ctx, "backup version %d", meta.Version)
+		}
+		metas = append(metas, meta)
+	}
+	sort.Slice(metas, func(i, j int) bool {
+		return metas[i].TS.Less(metas[j].TS)
+	})
+	return metas, nil
+}
+
+// Restore copies the backup in src at ts into dst, the shared storage of a fresh
+// cluster, which then starts from the checkpoint of the backup. An empty ts
+// restores the latest backup. The restore to a timestamp between the backups is
+// done by RestoreToTS.
+func Restore(
+	ctx context.Context,
+	src, dst fileservice.FileService,
+	ts timestamp.Timestamp) (*Meta, error) {
+	metas, err := ListMetas(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+	var meta *Meta
+	for _, m := range metas {
+		if ts.IsEmpty() || ts.Equal(m.TS) {
+			meta = m
+		}
+	}
+	if meta == nil {
+		if ts.IsEmpty() {
+			return nil, moerr.NewInvalidInput(ctx, "no backup found")
+		}
+		return nil, moerr.NewInvalidInput(ctx, "no backup at %s, restore to it by replaying the archived WAL", ts.DebugString())
+	}
+
+	if err = checkTarget(ctx, dst); err != nil {
+		return nil, err
+	}
+	for _, name := range meta.Files {
+		if err = copyFile(ctx, src, dst, name); err != nil {
+			return nil, err
+		}
+	}
+	logutil.Infof("restore %s backup at %s, %d files",
+		meta.Scope.Kind, meta.TS.DebugString(), len(meta.Files))
+	return meta, nil
+}
+
+// OpenPath returns the file service of the dir of a backup, which is either a
+// local dir or a path with the arguments of the service, like LOAD DATA takes.
+func OpenPath(fs fileservice.FileService, dir string) (fileservice.FileService, error) {
+	etlFS, readPath, err := fileservice.GetForETL(fs, strings.TrimSuffix(dir, "/")+"/")
+	if err != nil {
+		return nil, err
+	}
+	if readPath = strings.Trim(readPath, "/"); readPath == "" {
+		return etlFS, nil
+	}
+	return fileservice.SubPath(etlFS, readPath), nil
+}
+
+// OpenUnderRoot returns the file service of the dir under root, the backup root of
+// the DN. The dir is a relative path which does not leave root, so the backups are
+// only written under root.
+func OpenUnderRoot(fs fileservice.FileService, root, dir string) (fileservice.FileService, error) {
+	if root == "" {
+		return nil, moerr.NewNotSupportedNoCtx("backup without the backup root of the DN")
+	}
+	clean := path.Clean(dir)
+	if dir == "" || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") ||
+		strings.Contains(dir, fileservice.ServiceNameSeparator) {
+		return nil, moerr.NewInvalidInputNoCtx("backup path '%s', which must be a relative path under the backup root", dir)
+	}
+	return OpenPath(fs, strings.TrimSuffix(root, "/")+"/"+clean)
+}
+
+// OpenTarget returns the shared file service of the cluster to restore into. A
+// local dir is opened as the DISK file service of TAE, other paths like OpenPath.
+func OpenTarget(dir string) (fileservice.FileService, error) {
+	fsPath, err := fileservice.ParsePath(dir)
+	if err != nil {
+		return nil, err
+	}
+	if fsPath.Service != "" {
+		return OpenPath(nil, dir)
+	}
+	return fileservice.NewFileService(fileservice.Config{
+		Name:    defines.SharedFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}, nil)
+}
+
+func copyFile(ctx context.Context, src, dst fileservice.FileService, name string) error {
+	var reader io.ReadCloser
+	if err := src.Read(ctx, &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Size:              -1,
+				ReadCloserForRead: &reader,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	defer reader.Close()
+	return dst.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Size:           -1,
+				ReaderForWrite: reader,
+			},
+		},
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/require"
+)
+
+func openDB(t *testing.T, dir string) *db.DB {
+	tae, err := db.Open(dir, config.WithLongScanAndCKPOpts(nil))
+	require.NoError(t, err)
+	return tae
+}
+
+func createAndAppend(t *testing.T, tae *db.DB, tenantID uint32, name string, rows int) {
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.Name = name
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockBatch(schema, rows)
+	defer bat.Close()
+
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	txn.BindAccessInfo(tenantID, 0, 0)
+	database, err := txn.CreateDatabase(name, "", "")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(bat))
+	require.NoError(t, txn.Commit())
+}
+
+// createSysTable creates a sys table in mo_catalog with the rows of the accounts.
+func createSysTable(t *testing.T, tae *db.DB, name string, accountIDs []uint32) {
+	schema := catalog.NewEmptySchema(name)
+	require.NoError(t, schema.AppendPKCol("id", types.T_int64.ToType(), 0))
+	require.NoError(t, schema.AppendCol(pkgcatalog.SystemDBAttr_AccID, types.T_uint32.ToType()))
+	require.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 2
+	schema.SegmentMaxBlocks = 2
+	bat := catalog.MockBatch(schema, len(accountIDs))
+	defer bat.Close()
+	for i, id := range accountIDs {
+		bat.Vecs[1].Update(i, id, false)
+	}
+
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.GetDatabase(pkgcatalog.MO_CATALOG)
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(bat))
+	require.NoError(t, txn.Commit())
+}
+
+// countRows returns the rows of the table, or -1 if the database is not found.
+func countRows(t *testing.T, tae *db.DB, tenantID uint32, name string) int {
+	return countTableRows(t, tae, tenantID, name, name)
+}
+
+func countTableRows(t *testing.T, tae *db.DB, tenantID uint32, dbName, name string) int {
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	txn.BindAccessInfo(tenantID, 0, 0)
+	defer func() {
+		require.NoError(t, txn.Commit())
+	}()
+	database, err := txn.GetDatabase(dbName)
+	if err != nil {
+		return -1
+	}
+	rel, err := database.GetRelationByName(name)
+	require.NoError(t, err)
+	rows := 0
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		view, err := it.GetBlock().GetColumnDataById(0)
+		require.NoError(t, err)
+		view.ApplyDeletes()
+		rows += view.Length()
+		view.Close()
+		it.Next()
+	}
+	return rows
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	blockio.Start()
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	tae := openDB(t, filepath.Join(dir, "src"))
+	createAndAppend(t, tae, 1, "db1", 25)
+	createAndAppend(t, tae, 2, "db2", 15)
+	createSysTable(t, tae, "mo_accounts", []uint32{0, 1, 2, 1, 2, 2})
+
+	bk, err := OpenUnderRoot(nil, dir, "backup")
+	require.NoError(t, err)
+	meta1, err := Backup(ctx, tae, bk, Scope{Kind: ScopeAccount, AccountID: 1}, time.Minute)
+	require.NoError(t, err)
+	meta2, err := Backup(ctx, tae, bk, Scope{Kind: ScopeCluster}, time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, tae.Close())
+
+	metas, err := ListMetas(ctx, bk)
+	require.NoError(t, err)
+	require.Equal(t, []*Meta{meta1, meta2}, metas)
+
+	// the backup of the account only restores the account
+	target := filepath.Join(dir, "dst1")
+	fs, err := OpenTarget(filepath.Join(target, "data"))
+	require.NoError(t, err)
+	restored, err := Restore(ctx, bk, fs, meta1.TS)
+	require.NoError(t, err)
+	require.Equal(t, meta1, restored)
+	tae = openDB(t, target)
+	require.Equal(t, 25, countRows(t, tae, 1, "db1"))
+	require.Equal(t, -1, countRows(t, tae, 2, "db2"))
+	// the rows of the other accounts are not in the sys tables
+	require.Equal(t, 3, countTableRows(t, tae, 0, pkgcatalog.MO_CATALOG, "mo_accounts"))
+	require.NoError(t, tae.Close())
+
+	// the latest backup is restored without a timestamp
+	target = filepath.Join(dir, "dst2")
+	fs = objectio.TmpNewFileservice(filepath.Join(target, "data"))
+	restored, err = Restore(ctx, bk, fs, timestamp.Timestamp{})
+	require.NoError(t, err)
+	require.Equal(t, meta2, restored)
+	tae = openDB(t, target)
+	require.Equal(t, 25, countRows(t, tae, 1, "db1"))
+	require.Equal(t, 15, countRows(t, tae, 2, "db2"))
+	require.Equal(t, 6, countTableRows(t, tae, 0, pkgcatalog.MO_CATALOG, "mo_accounts"))
+	require.NoError(t, tae.Close())
+
+	// no restore into an existing cluster
+	_, err = Restore(ctx, bk, fs, timestamp.Timestamp{})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+	// the restore to a timestamp between the backups replays the WAL
+	_, err = Restore(ctx, bk, objectio.TmpNewFileservice(filepath.Join(dir, "dst3", "data")),
+		meta2.TS.Next())
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
+
+func TestRestoreToTS(t *testing.T) {
+	blockio.Start()
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	root, err := OpenPath(nil, filepath.Join(dir, "root"))
+	require.NoError(t, err)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	opts.ArchiveFs = root
+	tae, err := db.Open(filepath.Join(dir, "src"), opts)
+	require.NoError(t, err)
+	createAndAppend(t, tae, 1, "db1", 25)
+	bk, err := OpenUnderRoot(nil, filepath.Join(dir, "root"), "backup")
+	require.NoError(t, err)
+	meta, err := Backup(ctx, tae, bk, Scope{Kind: ScopeCluster}, time.Minute)
+	require.NoError(t, err)
+
+	createAndAppend(t, tae, 2, "db2", 15)
+	// the objects flushed after the backup are read from the shared storage
+	_, err = flushForBackup(ctx, tae, time.Minute)
+	require.NoError(t, err)
+	ts1 := tae.TxnMgr.Now()
+	createAndAppend(t, tae, 1, "db3", 5)
+	ts2 := tae.TxnMgr.Now()
+	require.NoError(t, tae.Close())
+
+	replay := ReplaySource{
+		Archive: root,
+		Shared:  objectio.TmpNewFileservice(filepath.Join(dir, "src", "data")),
+	}
+	restore := func(name string, ts types.TS, scope Scope) *db.DB {
+		target := filepath.Join(dir, name)
+		fs := objectio.TmpNewFileservice(filepath.Join(target, "data"))
+		_, err := RestoreToTS(ctx, bk, replay, fs, filepath.Join(dir, name+"-work"), ts.ToTimestamp(), scope)
+		require.NoError(t, err)
+		return openDB(t, target)
+	}
+
+	// the cluster at ts1
+	tae = restore("dst1", ts1, Scope{Kind: ScopeCluster})
+	require.Equal(t, 25, countRows(t, tae, 1, "db1"))
+	require.Equal(t, 15, countRows(t, tae, 2, "db2"))
+	require.Equal(t, -1, countRows(t, tae, 1, "db3"))
+	require.NoError(t, tae.Close())
+
+	// the account 1 at ts2 from the backup of the cluster
+	tae = restore("dst2", ts2, Scope{Kind: ScopeAccount, AccountID: 1})
+	require.Equal(t, 25, countRows(t, tae, 1, "db1"))
+	require.Equal(t, -1, countRows(t, tae, 2, "db2"))
+	require.Equal(t, 5, countRows(t, tae, 1, "db3"))
+	require.NoError(t, tae.Close())
+
+	// no backup before the timestamp
+	_, err = RestoreToTS(ctx, bk, replay, objectio.TmpNewFileservice(filepath.Join(dir, "dst3", "data")),
+		filepath.Join(dir, "dst3-work"), timestamp.Timestamp{PhysicalTime: 1}, Scope{Kind: ScopeCluster})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+	// no archived WAL to replay after the backup
+	_, err = RestoreToTS(ctx, bk, ReplaySource{}, objectio.TmpNewFileservice(filepath.Join(dir, "dst4", "data")),
+		filepath.Join(dir, "dst4-work"), meta.TS.Next(), Scope{Kind: ScopeCluster})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
+
+func TestOpenUnderRoot(t *testing.T) {
+	dir := t.TempDir()
+	for _, path := range []string{"backup", "daily/1", "./a/../b"} {
+		_, err := OpenUnderRoot(nil, dir, path)
+		require.NoError(t, err, path)
+	}
+	for _, path := range []string{"", "/tmp/backup", "../backup", "a/../../backup",
+		"s3,endpoint,region,bucket,key,secret,prefix:backup"} {
+		_, err := OpenUnderRoot(nil, dir, path)
+		require.Error(t, err, path)
+	}
+	_, err := OpenUnderRoot(nil, "", "backup")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+}
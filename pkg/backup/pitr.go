@@ -0,0 +1,240 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+const (
+	workDataDir     = "data"
+	workSnapshotDir = "snapshot"
+)
+
+// ReplaySource is where RestoreToTS reads the changes committed after a backup.
+type ReplaySource struct {
+	// Archive is the backup root of the DN, where the WAL is archived with
+	// archive-wal, see wal.ArchivedDriver.
+	Archive fileservice.FileService
+	// Shared is the shared file service of the cluster, where the objects flushed
+	// after the backup are read.
+	Shared fileservice.FileService
+}
+
+// contains returns whether the backup of s has all the data of the scope o.
+func (s Scope) contains(o Scope) bool {
+	return s.Kind == ScopeCluster || s == o
+}
+
+// overlayFS reads the files not in the work dir of the restore from the shared
+// file service of the cluster. The files are only written into the work dir.
+type overlayFS struct {
+	fileservice.FileService
+	lower fileservice.FileService
+}
+
+func (fs overlayFS) Read(ctx context.Context, vector *fileservice.IOVector) error {
+	err := fs.FileService.Read(ctx, vector)
+	if fs.lower != nil && moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return fs.lower.Read(ctx, vector)
+	}
+	return err
+}
+
+func (fs overlayFS) StatFile(ctx context.Context, filePath string) (*fileservice.DirEntry, error) {
+	entry, err := fs.FileService.StatFile(ctx, filePath)
+	if fs.lower != nil && moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return fs.lower.StatFile(ctx, filePath)
+	}
+	return entry, err
+}
+
+// RestoreToTS restores the scope as it was at ts into dst, the shared storage of a
+// fresh cluster. An empty ts is the latest backup containing the scope, so an
+// account can be restored alone from a backup of the cluster.
+//
+// It starts from the latest backup in src at or before ts which contains the
+// scope. If ts is after the backup, the records of the WAL archived in
+// replay.Archive after the backup up to ts are replayed on it, in a TAE opened
+// under workDir, an empty local dir. Then a backup of the scope is taken from the
+// TAE and restored into dst.
+func RestoreToTS(
+	ctx context.Context,
+	src fileservice.FileService,
+	replay ReplaySource,
+	dst fileservice.FileService,
+	workDir string,
+	ts timestamp.Timestamp,
+	scope Scope) (*Meta, error) {
+	metas, err := ListMetas(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+	var base *Meta
+	for _, m := range metas {
+		if m.Scope.contains(scope) && (ts.IsEmpty() || !ts.Less(m.TS)) {
+			base = m
+		}
+	}
+	if base == nil {
+		return nil, moerr.NewInvalidInput(ctx, "no backup of the %s at or before %s", scope.Kind, ts.DebugString())
+	}
+	if ts.IsEmpty() {
+		ts = base.TS
+	}
+	if err = checkTarget(ctx, dst); err != nil {
+		return nil, err
+	}
+
+	// the work dir is a TAE started from the backup
+	if err = os.MkdirAll(workDir, 0755); err != nil {
+		return nil, err
+	}
+	work, err := OpenTarget(filepath.Join(workDir, workDataDir))
+	if err != nil {
+		return nil, err
+	}
+	if err = checkTarget(ctx, work); err != nil {
+		return nil, err
+	}
+	for _, name := range base.Files {
+		if err = copyFile(ctx, src, work, name); err != nil {
+			return nil, err
+		}
+	}
+	if base.TS.Less(ts) {
+		if replay.Archive == nil || replay.Shared == nil {
+			return nil, moerr.NewInvalidInput(ctx, "restore to %s after the backup at %s without the archived WAL and the shared storage of the cluster",
+				ts.DebugString(), base.TS.DebugString())
+		}
+		if err = prepareWAL(ctx, replay.Archive, workDir,
+			types.TimestampToTS(base.TS), types.TimestampToTS(ts)); err != nil {
+			return nil, err
+		}
+	}
+	tae, err := db.Open(workDir, &options.Options{
+		Fs:        overlayFS{FileService: work, lower: replay.Shared},
+		LogStoreT: options.LogstoreBatchStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := OpenTarget(filepath.Join(workDir, workSnapshotDir))
+	if err != nil {
+		_ = tae.Close()
+		return nil, err
+	}
+	meta, err := Backup(ctx, tae, snapshot, scope, 0)
+	if closeErr := tae.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if meta, err = Restore(ctx, snapshot, dst, meta.TS); err != nil {
+		return nil, err
+	}
+	logutil.Infof("restore %s to %s from the backup at %s",
+		scope.Kind, ts.DebugString(), base.TS.DebugString())
+	return meta, nil
+}
+
+// prepareWAL writes the archived records committed in (from, to] into the WAL of
+// the TAE under dir, in the order they were archived, so they are replayed by the
+// open of the TAE after the checkpoint of the backup at from.
+func prepareWAL(ctx context.Context, archive fileservice.FileService, dir string, from, to types.TS) error {
+	sessions, err := wal.ListArchiveSessions(ctx, archive)
+	if err != nil {
+		return err
+	}
+	// the records after the backup are all archived only if a session started before it
+	if len(sessions) == 0 || sessions[0].Start.Greater(from) {
+		return moerr.NewInvalidInput(ctx, "the WAL after the backup at %s is not archived", from.ToString())
+	}
+
+	driver := wal.NewDriverWithBatchStore(dir, db.WALDir, nil)
+	defer driver.Close()
+	replayed := make(map[string]struct{})
+	for _, session := range sessions {
+		if err = wal.ReadArchiveSession(ctx, archive, session, func(payload []byte) error {
+			cmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(payload))
+			if err != nil {
+				return err
+			}
+			txnCmd := cmd.(*txnbase.TxnCmd)
+			id, prepareTS, is2PC := txnCmd.ID, txnCmd.PrepareTS, txnCmd.Is2PC()
+			txnCmd.Close()
+			if prepareTS.LessEq(from) || prepareTS.Greater(to) {
+				return nil
+			}
+			// a record is archived again by the replay of a restart
+			if _, ok := replayed[id]; ok {
+				return nil
+			}
+			if is2PC {
+				return moerr.NewNotSupported(ctx, "replay the archived WAL of the 2PC txn %s", id)
+			}
+			replayed[id] = struct{}{}
+
+			e := entry.GetBase()
+			defer e.Free()
+			e.SetType(txnimpl.ETTxnRecord)
+			if err = e.SetPayload(payload); err != nil {
+				return err
+			}
+			e.SetInfo(&entry.Info{
+				Group: wal.GroupPrepare,
+				TxnId: id,
+			})
+			if _, err = driver.AppendEntry(wal.GroupPrepare, e); err != nil {
+				return err
+			}
+			return e.WaitDone()
+		}); err != nil {
+			return err
+		}
+	}
+	logutil.Infof("replay %d archived txns in (%s, %s]", len(replayed), from.ToString(), to.ToString())
+	return nil
+}
+
+// checkTarget returns an error if the target of the restore is not fresh.
+func checkTarget(ctx context.Context, fs fileservice.FileService) error {
+	entries, err := fs.List(ctx, checkpoint.CheckpointDir)
+	if err != nil {
+		return err
+	}
+	if len(entries) > 0 {
+		return moerr.NewNotSupported(ctx, "restore into an existing cluster, the restore target already has checkpoints")
+	}
+	return nil
+}
@@ -124,6 +124,12 @@ func ParseEntryList(es []*api.Entry) (any, []*api.Entry, error) {
 				Key:   SystemRelAttr_CreateSQL,
 				Value: cmds[i].CreateSql,
 			})
+			if len(cmds[i].Compression) > 0 {
+				pro.Properties = append(pro.Properties, engine.Property{
+					Key:   SystemRelAttr_Compression,
+					Value: cmds[i].Compression,
+				})
+			}
 			cmds[i].Defs = append(cmds[i].Defs, pro)
 			if err = fillCreateTable(&idx, &cmds[i], es); err != nil {
 				return nil, nil, err
@@ -190,6 +196,7 @@ func genCreateTables(rows [][]any) []CreateTable {
 		cmds[i].Viewdef = string(row[MO_TABLES_VIEWDEF_IDX].([]byte))
 		cmds[i].Constraint = row[MO_TABLES_CONSTRAINT_IDX].([]byte)
 		cmds[i].RelKind = string(row[MO_TABLES_RELKIND_IDX].([]byte))
+		cmds[i].Compression = string(row[MO_TABLES_COMPRESSION_IDX].([]byte))
 	}
 	return cmds
 }
@@ -279,6 +286,7 @@ func genTableDefs(row []any) (engine.TableDef, error) {
 	attr.AutoIncrement = row[MO_COLUMNS_ATT_IS_AUTO_INCREMENT_IDX].(int8) == 1
 	attr.Primary = string(row[MO_COLUMNS_ATT_CONSTRAINT_TYPE_IDX].([]byte)) == "p"
 	attr.ClusterBy = row[MO_COLUMNS_ATT_IS_CLUSTERBY].(int8) == 1
+	attr.EnumValues = string(row[MO_COLUMNS_ATT_ENUM_IDX].([]byte))
 	return &engine.AttributeDef{Attr: attr}, nil
 }
 
@@ -300,7 +308,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_int16:
+		case types.T_int16, types.T_year:
 			col := vector.MustFixedCol[int16](vec)
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
@@ -320,7 +328,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			col := vector.MustFixedCol[uint16](vec)
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
@@ -330,7 +338,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			col := vector.MustFixedCol[uint64](vec)
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
@@ -395,7 +403,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_json, types.T_text:
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_json, types.T_text, types.T_vecf32, types.T_vecf64:
 			col := vector.MustBytesCol(vec)
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
@@ -38,7 +38,14 @@ const (
 	IndexTablePrimaryColName = "__mo_index_pri_col"
 	ExternalFilePath         = "__mo_filepath"
 	IndexTableNamePrefix     = "__mo_index_unique__"
-	AutoIncrTableName        = "%!%mo_increment_columns"
+	// IvfflatIndexTableNamePrefix is the prefix of the ivfflat index table, which
+	// keeps the centroids of the lists and the primary keys in each list.
+	IvfflatIndexTableNamePrefix = "__mo_index_ivfflat_"
+	// IndexTableCentroidColName is the centroid of the list in the ivfflat index table
+	IndexTableCentroidColName = "__mo_index_centroid_col"
+	// IndexAlgoIvfflat is the algorithm name of the ivfflat index
+	IndexAlgoIvfflat  = "ivfflat"
+	AutoIncrTableName = "%!%mo_increment_columns"
 )
 
 var AutoIncrColumnNames = []string{Row_ID, "name", "offset", "step"}
@@ -48,7 +55,7 @@ func ContainExternalHidenCol(col string) bool {
 }
 
 func IsHiddenTable(name string) bool {
-	if strings.HasPrefix(name, IndexTableNamePrefix) {
+	if strings.HasPrefix(name, IndexTableNamePrefix) || strings.HasPrefix(name, IvfflatIndexTableNamePrefix) {
 		return true
 	}
 	return strings.EqualFold(name, AutoIncrTableName)
@@ -67,6 +74,13 @@ const (
 const (
 	// Non-hard-coded data dictionary table
 	MO_INDEXES = "mo_indexes"
+
+	// the column privileges and the row level security policies
+	MO_COLUMN_PRIVS = "mo_column_privs"
+	MO_POLICIES     = "mo_policies"
+
+	// the column statistics of ANALYZE TABLE
+	MO_COLUMN_STATS = "mo_column_stats"
 )
 
 const (
@@ -105,6 +119,8 @@ const (
 	SystemRelAttr_ViewDef     = "viewdef"
 	SystemRelAttr_Constraint  = "constraint"
 	SystemRelAttr_Version     = "rel_version"
+	// the compression option of the table, see compress.ParseCompression
+	SystemRelAttr_Compression = "rel_compression"
 
 	// 'mo_columns' table
 	SystemColAttr_UniqName        = "att_uniq_name"
@@ -129,6 +145,8 @@ const (
 	SystemColAttr_HasUpdate       = "attr_has_update"
 	SystemColAttr_Update          = "attr_update"
 	SystemColAttr_IsClusterBy     = "attr_is_clusterby"
+	// the members of ENUM and SET, see types.FormatEnumValues
+	SystemColAttr_EnumValues = "attr_enum"
 
 	BlockMeta_ID              = "block_id"
 	BlockMeta_Delete_ID       = "block_delete_id"
@@ -211,6 +229,7 @@ const (
 	MO_TABLES_VIEWDEF_IDX        = 14
 	MO_TABLES_CONSTRAINT_IDX     = 15
 	MO_TABLES_VERSION_IDX        = 16
+	MO_TABLES_COMPRESSION_IDX    = 17
 
 	MO_COLUMNS_ATT_UNIQ_NAME_IDX         = 0
 	MO_COLUMNS_ACCOUNT_ID_IDX            = 1
@@ -234,6 +253,7 @@ const (
 	MO_COLUMNS_ATT_HAS_UPDATE_IDX        = 19
 	MO_COLUMNS_ATT_UPDATE_IDX            = 20
 	MO_COLUMNS_ATT_IS_CLUSTERBY          = 21
+	MO_COLUMNS_ATT_ENUM_IDX              = 22
 
 	BLOCKMETA_ID_IDX         = 0
 	BLOCKMETA_ENTRYSTATE_IDX = 1
@@ -304,6 +324,7 @@ type CreateTable struct {
 	RelKind      string
 	Viewdef      string
 	Constraint   []byte
+	Compression  string
 	Defs         []engine.TableDef
 }
 
@@ -354,6 +375,7 @@ var (
 		SystemRelAttr_ViewDef,
 		SystemRelAttr_Constraint,
 		SystemRelAttr_Version,
+		SystemRelAttr_Compression,
 	}
 	MoColumnsSchema = []string{
 		SystemColAttr_UniqName,
@@ -378,6 +400,7 @@ var (
 		SystemColAttr_HasUpdate,
 		SystemColAttr_Update,
 		SystemColAttr_IsClusterBy,
+		SystemColAttr_EnumValues,
 	}
 	MoTableMetaSchema = []string{
 		BlockMeta_ID,
@@ -417,30 +440,32 @@ var (
 		types.New(types.T_blob, 0, 0),       // viewdef
 		types.New(types.T_varchar, 5000, 0), // constraint
 		types.New(types.T_uint32, 0, 0),     // schema_version
+		types.New(types.T_varchar, 5000, 0), // rel_compression
 	}
 	MoColumnsTypes = []types.Type{
-		types.New(types.T_varchar, 256, 0),  // att_uniq_name
-		types.New(types.T_uint32, 0, 0),     // account_id
-		types.New(types.T_uint64, 0, 0),     // att_database_id
-		types.New(types.T_varchar, 256, 0),  // att_database
-		types.New(types.T_uint64, 0, 0),     // att_relname_id
-		types.New(types.T_varchar, 256, 0),  // att_relname
-		types.New(types.T_varchar, 256, 0),  // attname
-		types.New(types.T_varchar, 256, 0),  // atttyp
-		types.New(types.T_int32, 0, 0),      // attnum
-		types.New(types.T_int32, 0, 0),      // att_length
-		types.New(types.T_int8, 0, 0),       // attnotnull
-		types.New(types.T_int8, 0, 0),       // atthasdef
-		types.New(types.T_varchar, 2048, 0), // att_default
-		types.New(types.T_int8, 0, 0),       // attisdropped
-		types.New(types.T_char, 1, 0),       // att_constraint_type
-		types.New(types.T_int8, 0, 0),       // att_is_unsigned
-		types.New(types.T_int8, 0, 0),       // att_is_auto_increment
-		types.New(types.T_varchar, 2048, 0), // att_comment
-		types.New(types.T_int8, 0, 0),       // att_is_hidden
-		types.New(types.T_int8, 0, 0),       // att_has_update
-		types.New(types.T_varchar, 2048, 0), // att_update
-		types.New(types.T_int8, 0, 0),       // att_is_clusterby
+		types.New(types.T_varchar, 256, 0),                 // att_uniq_name
+		types.New(types.T_uint32, 0, 0),                    // account_id
+		types.New(types.T_uint64, 0, 0),                    // att_database_id
+		types.New(types.T_varchar, 256, 0),                 // att_database
+		types.New(types.T_uint64, 0, 0),                    // att_relname_id
+		types.New(types.T_varchar, 256, 0),                 // att_relname
+		types.New(types.T_varchar, 256, 0),                 // attname
+		types.New(types.T_varchar, 256, 0),                 // atttyp
+		types.New(types.T_int32, 0, 0),                     // attnum
+		types.New(types.T_int32, 0, 0),                     // att_length
+		types.New(types.T_int8, 0, 0),                      // attnotnull
+		types.New(types.T_int8, 0, 0),                      // atthasdef
+		types.New(types.T_varchar, 2048, 0),                // att_default
+		types.New(types.T_int8, 0, 0),                      // attisdropped
+		types.New(types.T_char, 1, 0),                      // att_constraint_type
+		types.New(types.T_int8, 0, 0),                      // att_is_unsigned
+		types.New(types.T_int8, 0, 0),                      // att_is_auto_increment
+		types.New(types.T_varchar, 2048, 0),                // att_comment
+		types.New(types.T_int8, 0, 0),                      // att_is_hidden
+		types.New(types.T_int8, 0, 0),                      // att_has_update
+		types.New(types.T_varchar, 2048, 0),                // att_update
+		types.New(types.T_int8, 0, 0),                      // att_is_clusterby
+		types.New(types.T_varchar, types.MaxVarcharLen, 0), // attr_enum
 	}
 	MoTableMetaTypes = []types.Type{
 		types.New(types.T_Blockid, 0, 0),                   // block_id
@@ -0,0 +1,219 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	// CheckpointTable is the table in mo_catalog of the checkpoints of the cdc
+	// tasks.
+	CheckpointTable = "mo_cdc_checkpoint"
+)
+
+var (
+	sqlCreateCheckpointTable = fmt.Sprintf(`create table if not exists %s.%s(
+		task_name varchar(256) primary key,
+		checkpoint_ts varchar(64) not null,
+		runner varchar(64) not null,
+		lease_expire bigint not null,
+		update_time timestamp
+	)`, catalog.MO_CATALOG, CheckpointTable)
+	sqlSelectCheckpoint = `select checkpoint_ts from %s.%s where task_name = '%s'`
+	sqlSaveCheckpoint   = `update %s.%s set checkpoint_ts = '%s', update_time = utc_timestamp()
+		where task_name = '%s' and runner = '%s'`
+	sqlSelectLease = `select runner, lease_expire from %s.%s where task_name = '%s'`
+	sqlInsertLease = `insert into %s.%s values ('%s', '', '%s', %d, utc_timestamp())`
+	sqlUpdateLease = `update %s.%s set runner = '%s', lease_expire = %d
+		where task_name = '%s' and runner = '%s' and lease_expire = %d`
+
+	sqlSelectTable = `select reldatabase_id, rel_id from %s.mo_tables
+		where account_id = %d and reldatabase = '%s' and relname = '%s'`
+	sqlSelectColumns = `select attname, att_constraint_type, att_is_hidden from %s.mo_columns
+		where att_relname_id = %d and attname != '%s' order by attnum`
+)
+
+// InitSchema creates the checkpoint table of the cdc tasks.
+func InitSchema(ctx context.Context, ieFactory func() ie.InternalExecutor) error {
+	exec := ieFactory()
+	return exec.Exec(ctx, sqlCreateCheckpointTable, ie.NewOptsBuilder().Internal(true).Finish())
+}
+
+// escape escapes a string to be put between the single quotes of the sqls.
+func escape(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	return strings.ReplaceAll(s, "'", "\\'")
+}
+
+type checkpointStore struct {
+	ieFactory func() ie.InternalExecutor
+	runner    string
+}
+
+// NewCheckpointStore returns the store of the checkpoints in mo_catalog. The
+// checkpoint of a task is only saved by the runner holding its lease.
+func NewCheckpointStore(ieFactory func() ie.InternalExecutor, runner string) CheckpointStore {
+	return &checkpointStore{ieFactory: ieFactory, runner: runner}
+}
+
+// NewLease returns the leases of the tasks in mo_catalog, kept with the checkpoints.
+func NewLease(ieFactory func() ie.InternalExecutor, runner string) Lease {
+	return &checkpointStore{ieFactory: ieFactory, runner: runner}
+}
+
+func (s *checkpointStore) Load(ctx context.Context, task string) (timestamp.Timestamp, error) {
+	result := s.ieFactory().Query(ctx,
+		fmt.Sprintf(sqlSelectCheckpoint, catalog.MO_CATALOG, CheckpointTable, escape(task)),
+		ie.NewOptsBuilder().Internal(true).Finish())
+	if err := result.Error(); err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	if result.RowCount() == 0 {
+		return timestamp.Timestamp{}, nil
+	}
+	value, err := result.StringValueByName(ctx, 0, "checkpoint_ts")
+	if err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	if value == "" {
+		return timestamp.Timestamp{}, nil
+	}
+	return timestamp.ParseTimestamp(value)
+}
+
+func (s *checkpointStore) Save(ctx context.Context, task string, ts timestamp.Timestamp) error {
+	return s.ieFactory().Exec(ctx,
+		fmt.Sprintf(sqlSaveCheckpoint, catalog.MO_CATALOG, CheckpointTable,
+			ts.DebugString(), escape(task), escape(s.runner)),
+		ie.NewOptsBuilder().Internal(true).Finish())
+}
+
+// Acquire implements Lease. The lease is taken over by a compare and swap of the
+// runner and the expire time, so only one of the runners racing for it wins.
+func (s *checkpointStore) Acquire(ctx context.Context, task string, ttl time.Duration) (bool, error) {
+	exec := s.ieFactory()
+	opts := ie.NewOptsBuilder().Internal(true).Finish()
+	runner, expire, found, err := s.getLease(ctx, exec, task)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	newExpire := now.Add(ttl).UnixMilli()
+	if !found {
+		return false, exec.Exec(ctx,
+			fmt.Sprintf(sqlInsertLease, catalog.MO_CATALOG, CheckpointTable,
+				escape(task), escape(s.runner), newExpire), opts)
+	}
+	if runner != s.runner && expire >= now.UnixMilli() {
+		return false, nil
+	}
+	if err = exec.Exec(ctx,
+		fmt.Sprintf(sqlUpdateLease, catalog.MO_CATALOG, CheckpointTable,
+			escape(s.runner), newExpire, escape(task), escape(runner), expire), opts); err != nil {
+		return false, err
+	}
+	runner, expire, _, err = s.getLease(ctx, exec, task)
+	if err != nil {
+		return false, err
+	}
+	return runner == s.runner && expire == newExpire, nil
+}
+
+func (s *checkpointStore) getLease(
+	ctx context.Context,
+	exec ie.InternalExecutor,
+	task string) (runner string, expire int64, found bool, err error) {
+	result := exec.Query(ctx,
+		fmt.Sprintf(sqlSelectLease, catalog.MO_CATALOG, CheckpointTable, escape(task)),
+		ie.NewOptsBuilder().Internal(true).Finish())
+	if err = result.Error(); err != nil || result.RowCount() == 0 {
+		return
+	}
+	if runner, err = result.StringValueByName(ctx, 0, "runner"); err != nil {
+		return
+	}
+	value, err := result.StringValueByName(ctx, 0, "lease_expire")
+	if err != nil {
+		return
+	}
+	expire, err = strconv.ParseInt(value, 10, 64)
+	return runner, expire, err == nil, err
+}
+
+// ResolveTable returns the table of the account by the catalog.
+func ResolveTable(
+	ctx context.Context,
+	ieFactory func() ie.InternalExecutor,
+	accountID uint32,
+	name string) (*Table, error) {
+	db, tbl, err := splitTableName(name)
+	if err != nil {
+		return nil, err
+	}
+	exec := ieFactory()
+	opts := ie.NewOptsBuilder().Internal(true).Finish()
+	result := exec.Query(ctx,
+		fmt.Sprintf(sqlSelectTable, catalog.MO_CATALOG, accountID, escape(db), escape(tbl)), opts)
+	if err = result.Error(); err != nil {
+		return nil, err
+	}
+	if result.RowCount() == 0 {
+		return nil, moerr.NewNoSuchTable(ctx, db, tbl)
+	}
+	var ids [2]uint64
+	for i, col := range []string{catalog.SystemRelAttr_DBID, catalog.SystemRelAttr_ID} {
+		value, err := result.StringValueByName(ctx, 0, col)
+		if err != nil {
+			return nil, err
+		}
+		if ids[i], err = strconv.ParseUint(value, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	result = exec.Query(ctx,
+		fmt.Sprintf(sqlSelectColumns, catalog.MO_CATALOG, ids[1], catalog.Row_ID), opts)
+	if err = result.Error(); err != nil {
+		return nil, err
+	}
+	attrs := make([]Attr, result.RowCount())
+	for i := range attrs {
+		row := uint64(i)
+		if attrs[i].Name, err = result.StringValueByName(ctx, row, catalog.SystemColAttr_Name); err != nil {
+			return nil, err
+		}
+		constraint, err := result.StringValueByName(ctx, row, catalog.SystemColAttr_ConstraintType)
+		if err != nil {
+			return nil, err
+		}
+		attrs[i].Primary = constraint == catalog.SystemColPKConstraint
+		hidden, err := result.StringValueByName(ctx, row, catalog.SystemColAttr_IsHidden)
+		if err != nil {
+			return nil, err
+		}
+		attrs[i].Hidden = hidden != "0"
+	}
+	return NewTable(accountID, ids[0], ids[1], db, tbl, attrs), nil
+}
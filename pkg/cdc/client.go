@@ -0,0 +1,70 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail/service"
+)
+
+type logtailSubscriber struct {
+	*service.LogtailClient
+	client morpc.RPCClient
+}
+
+// NewLogtailSubscriber returns a subscriber of the logtail service of the dn
+// at addr, each task has its own stream.
+func NewLogtailSubscriber(addr string) (Subscriber, error) {
+	logger := logutil.GetGlobalLogger().Named("cdc-log-tail-client")
+	codec := morpc.NewMessageCodec(func() morpc.Message {
+		return &service.LogtailResponseSegment{}
+	})
+	factory := morpc.NewGoettyBasedBackendFactory(codec,
+		morpc.WithBackendGoettyOptions(
+			goetty.WithSessionRWBUfferSize(1<<20, 1<<20),
+		),
+		morpc.WithBackendLogger(logger),
+	)
+	c, err := morpc.NewClient(factory,
+		morpc.WithClientMaxBackendPerHost(1),
+		morpc.WithClientTag("cdc-log-tail-client"),
+		morpc.WithClientLogger(logger),
+	)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.NewStream(addr, true)
+	if err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	lc, err := service.NewLogtailClient(stream)
+	if err != nil {
+		_ = stream.Close()
+		_ = c.Close()
+		return nil, err
+	}
+	return &logtailSubscriber{LogtailClient: lc, client: c}, nil
+}
+
+func (s *logtailSubscriber) Close() error {
+	err := s.LogtailClient.Close()
+	if e := s.client.Close(); err == nil {
+		err = e
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// RunWithLease runs fn if the lease of the task is acquired, and renews the lease
+// every ttl/3 while fn runs. The ctx of fn is canceled once the lease is lost, so
+// a task runs in one cn at a time. It returns false if the lease is held by
+// another runner.
+func RunWithLease(
+	ctx context.Context,
+	lease Lease,
+	task string,
+	ttl time.Duration,
+	fn func(ctx context.Context) error) (bool, error) {
+	ok, err := lease.Acquire(ctx, task, ttl)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				ok, err := lease.Acquire(ctx, task, ttl)
+				if err != nil || !ok {
+					logutil.Errorf("cdc task %s lost the lease: %v", task, err)
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return true, fn(ctx)
+}
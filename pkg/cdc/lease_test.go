@@ -0,0 +1,99 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testLease grants the lease of a task to the first runner, until it is released.
+type testLease struct {
+	sync.Mutex
+	holders map[string]string
+	runner  string
+}
+
+func (l *testLease) Acquire(_ context.Context, task string, _ time.Duration) (bool, error) {
+	l.Lock()
+	defer l.Unlock()
+	holder, ok := l.holders[task]
+	if !ok {
+		l.holders[task] = l.runner
+		return true, nil
+	}
+	return holder == l.runner, nil
+}
+
+func (l *testLease) release(task string) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.holders, task)
+}
+
+func TestRunWithLease(t *testing.T) {
+	ctx := context.Background()
+	holders := make(map[string]string)
+	lease1 := &testLease{holders: holders, runner: "cn1"}
+	lease2 := &testLease{holders: holders, runner: "cn2"}
+
+	started := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ran, err := RunWithLease(ctx, lease1, "task", 30*time.Millisecond,
+			func(ctx context.Context) error {
+				close(started)
+				<-ctx.Done()
+				return ctx.Err()
+			})
+		require.True(t, ran)
+		require.Error(t, err)
+	}()
+	<-started
+
+	// the task is not run by the other runner
+	ran, err := RunWithLease(ctx, lease2, "task", 30*time.Millisecond,
+		func(ctx context.Context) error {
+			t.Fatal("the task runs twice")
+			return nil
+		})
+	require.False(t, ran)
+	require.NoError(t, err)
+
+	// the task stops once the lease is lost
+	lease1.Lock()
+	holders["task"] = "cn2"
+	lease1.Unlock()
+	<-done
+
+	lease2.release("task")
+	ran, err = RunWithLease(ctx, lease2, "task", time.Minute,
+		func(ctx context.Context) error {
+			return nil
+		})
+	require.True(t, ran)
+	require.NoError(t, err)
+}
+
+func TestEscape(t *testing.T) {
+	require.Equal(t, "db", escape("db"))
+	require.Equal(t, `a\'b`, escape(`a'b`))
+	require.Equal(t, `a\\\'b`, escape(`a\'b`))
+}
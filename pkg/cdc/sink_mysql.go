@@ -0,0 +1,206 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+type mysqlSink struct {
+	db *sql.DB
+}
+
+// NewMySQLSink returns a sink applying the transactions to the tables of the
+// same names in a MySQL compatible database. The changes of a table with a
+// primary key are applied by the primary key, so applying a transaction again
+// is idempotent. The changes of a table without one are applied by all the
+// columns.
+func NewMySQLSink(user, password, addr string) (Sink, error) {
+	cfg := mysql.NewConfig()
+	cfg.User = user
+	cfg.Passwd = password
+	cfg.Net = "tcp"
+	cfg.Addr = addr
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, err
+	}
+	return &mysqlSink{db: db}, nil
+}
+
+func (s *mysqlSink) Send(ctx context.Context, txn *Txn) (err error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, c := range txn.Changes {
+		for _, stmt := range changeStatements(c) {
+			if _, err = tx.ExecContext(ctx, stmt.sql, stmt.args...); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+// Flush does nothing, the transactions are committed by Send.
+func (s *mysqlSink) Flush(_ context.Context) error {
+	return nil
+}
+
+func (s *mysqlSink) Close() error {
+	return s.db.Close()
+}
+
+type statement struct {
+	sql  string
+	args []any
+}
+
+// changeStatements returns the statements applying a change.
+func changeStatements(c Change) []statement {
+	t := c.Table
+	if c.Type == ChangeTruncate {
+		var b strings.Builder
+		b.WriteString("delete from ")
+		writeTableName(&b, t)
+		return []statement{{sql: b.String()}}
+	}
+	if len(t.PrimaryKeys) > 0 {
+		switch c.Type {
+		case ChangeInsert, ChangeUpdate:
+			stmts := make([]statement, 0, 2)
+			if c.Type == ChangeUpdate && !samePrimaryKey(t, c.Before, c.After) {
+				stmts = append(stmts, deleteByPrimaryKey(t, c.Before))
+			}
+			return append(stmts, insertStatement(t, "replace", c.After))
+		case ChangeDelete:
+			return []statement{deleteByPrimaryKey(t, c.Before)}
+		}
+		return nil
+	}
+	switch c.Type {
+	case ChangeInsert:
+		return []statement{insertStatement(t, "insert", c.After)}
+	case ChangeUpdate:
+		return []statement{deleteByRow(t, c.Before), insertStatement(t, "insert", c.After)}
+	case ChangeDelete:
+		return []statement{deleteByRow(t, c.Before)}
+	}
+	return nil
+}
+
+func insertStatement(t *Table, verb string, row Row) statement {
+	var b strings.Builder
+	b.WriteString(verb)
+	b.WriteString(" into ")
+	writeTableName(&b, t)
+	b.WriteString(" (")
+	for i, col := range t.Columns {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeIdent(&b, col)
+	}
+	b.WriteString(") values (")
+	for i := range t.Columns {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteByte('?')
+	}
+	b.WriteByte(')')
+	return statement{sql: b.String(), args: append([]any(nil), row...)}
+}
+
+func deleteByPrimaryKey(t *Table, row Row) statement {
+	var b strings.Builder
+	b.WriteString("delete from ")
+	writeTableName(&b, t)
+	b.WriteString(" where ")
+	args := make([]any, 0, len(t.PrimaryKeys))
+	n := 0
+	for i, col := range t.Columns {
+		if !t.isPrimaryKey(col) {
+			continue
+		}
+		if n > 0 {
+			b.WriteString(" and ")
+		}
+		writeIdent(&b, col)
+		b.WriteString(" = ?")
+		args = append(args, row[i])
+		n++
+	}
+	return statement{sql: b.String(), args: args}
+}
+
+// deleteByRow deletes one of the rows equal to row, for the tables without a
+// primary key.
+func deleteByRow(t *Table, row Row) statement {
+	var b strings.Builder
+	b.WriteString("delete from ")
+	writeTableName(&b, t)
+	b.WriteString(" where ")
+	for i, col := range t.Columns {
+		if i > 0 {
+			b.WriteString(" and ")
+		}
+		writeIdent(&b, col)
+		b.WriteString(" <=> ?")
+	}
+	b.WriteString(" limit 1")
+	return statement{sql: b.String(), args: append([]any(nil), row...)}
+}
+
+func samePrimaryKey(t *Table, before, after Row) bool {
+	for i, col := range t.Columns {
+		if t.isPrimaryKey(col) && !reflect.DeepEqual(before[i], after[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Table) isPrimaryKey(col string) bool {
+	for _, pk := range t.PrimaryKeys {
+		if pk == col {
+			return true
+		}
+	}
+	return false
+}
+
+func writeTableName(b *strings.Builder, t *Table) {
+	writeIdent(b, t.Database)
+	b.WriteByte('.')
+	writeIdent(b, t.Name)
+}
+
+func writeIdent(b *strings.Builder, name string) {
+	b.WriteByte('`')
+	b.WriteString(strings.ReplaceAll(name, "`", "``"))
+	b.WriteByte('`')
+}
@@ -0,0 +1,565 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+)
+
+var metaTableMatchRegexp = regexp.MustCompile(`\_\d+\_meta`)
+
+func isMetaTable(name string) bool {
+	return metaTableMatchRegexp.MatchString(name)
+}
+
+// change is a row change with its commit timestamp.
+type change struct {
+	ts     types.TS
+	typ    ChangeType
+	pk     string
+	before Row
+	after  Row
+}
+
+// cachedRow is a row appended in memory.
+type cachedRow struct {
+	row Row
+	pk  string
+}
+
+// tableState decodes the logtail of a table into row changes. The logtail only
+// has the rowids of the deleted rows, so the state keeps the rows appended in
+// memory, and reads the rows of the flushed blocks from their objects.
+type tableState struct {
+	table *Table
+	fs    fileservice.FileService
+
+	// rows are the rows appended in memory, until their block is flushed.
+	rows map[types.Rowid]cachedRow
+	// blocks are the locations of the flushed blocks.
+	blocks map[types.Blockid]objectio.Location
+	// loaded are the blocks read while consuming a logtail.
+	loaded map[types.Blockid]*batch.Batch
+}
+
+func newTableState(table *Table, fs fileservice.FileService) *tableState {
+	return &tableState{
+		table:  table,
+		fs:     fs,
+		rows:   make(map[types.Rowid]cachedRow),
+		blocks: make(map[types.Blockid]objectio.Location),
+	}
+}
+
+type logtailEntries struct {
+	metaIns, metaDel []*batch.Batch
+	rowIns, rowDel   []*batch.Batch
+}
+
+func splitEntries(entries []*api.Entry) (ret logtailEntries, err error) {
+	for _, entry := range entries {
+		bat, err := batch.ProtoBatchToBatch(entry.Bat)
+		if err != nil {
+			return ret, err
+		}
+		meta := isMetaTable(entry.TableName)
+		switch {
+		case meta && entry.EntryType == api.Entry_Insert:
+			ret.metaIns = append(ret.metaIns, bat)
+		case meta:
+			ret.metaDel = append(ret.metaDel, bat)
+		case entry.EntryType == api.Entry_Insert:
+			ret.rowIns = append(ret.rowIns, bat)
+		default:
+			ret.rowDel = append(ret.rowDel, bat)
+		}
+	}
+	return
+}
+
+// consume returns the changes of the incremental logtail of a transaction.
+// Besides the rows changed in memory, the blocks written by the CN, like the
+// ones of LOAD DATA, only show up as new non-appendable blocks, whose rows are
+// read from the objects. The new blocks of a merge are not changes, a merge
+// deletes the blocks it merges in the same transaction.
+func (s *tableState) consume(ctx context.Context, entries []*api.Entry) ([]change, error) {
+	defer s.resetLoaded()
+	es, err := splitEntries(entries)
+	if err != nil {
+		return nil, err
+	}
+	merging := len(es.metaDel) > 0
+
+	var changes []change
+	for _, bat := range es.metaIns {
+		createTS := vector.MustFixedCol[types.TS](bat.Vecs[1])
+		for i, blockID := range vector.MustFixedCol[types.Blockid](bat.Vecs[2]) {
+			_, known := s.blocks[blockID]
+			appendable, location := s.addBlock(bat, i)
+			if known || appendable || merging || location.IsEmpty() {
+				continue
+			}
+			if changes, err = s.readInserts(ctx, changes, blockID, location, createTS[i], types.TS{}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, bat := range es.metaDel {
+		s.dropBlocks(bat)
+	}
+	if changes, err = s.consumeRows(ctx, changes, es, types.TS{}, true); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// bootstrap consumes the logtail of the subscription at ts, which is the checkpoint
+// of the table plus the changes in memory. It returns the changes after start,
+// none if start is empty, which is the case of a new task. The changes flushed
+// after start are read from the objects, but the merged ones can't be, as the
+// merge loses the commit timestamps of the rows. So if the table has been merged
+// after start, the table is re-synced by the snapshot of its rows at ts instead.
+func (s *tableState) bootstrap(
+	ctx context.Context,
+	entries []*api.Entry,
+	start, ts types.TS) ([]change, error) {
+	defer s.resetLoaded()
+	es, err := splitEntries(entries)
+	if err != nil {
+		return nil, err
+	}
+	resume := !start.IsEmpty()
+	resync := false
+	if resume {
+		for _, bat := range es.metaDel {
+			for _, mergeTS := range vector.MustFixedCol[types.TS](bat.Vecs[1]) {
+				if mergeTS.Greater(start) {
+					resync = true
+				}
+			}
+		}
+	}
+	if resync {
+		if ts.IsEmpty() {
+			return nil, moerr.NewInternalError(ctx,
+				"cdc: table %s has been merged after the checkpoint %s, but the subscription has no timestamp to re-sync it", s.table, start.ToString())
+		}
+		logutil.Warnf("cdc: table %s has been merged after the checkpoint %s, re-sync it by the snapshot at %s",
+			s.table, start.ToString(), ts.ToString())
+		resume = false
+	}
+
+	var changes []change
+	deltas := make(map[types.Blockid]objectio.Location)
+	read := make(map[types.Blockid]struct{})
+	// the blocks and all their deletes of the snapshot
+	var appendables map[types.Blockid]bool
+	var allDeltas map[types.Blockid][]objectio.Location
+	if resync {
+		appendables = make(map[types.Blockid]bool)
+		allDeltas = make(map[types.Blockid][]objectio.Location)
+	}
+	for _, bat := range es.metaIns {
+		createTS := vector.MustFixedCol[types.TS](bat.Vecs[1])
+		deltaLoc := vector.MustBytesCol(bat.Vecs[6])
+		commitTS := vector.MustFixedCol[types.TS](bat.Vecs[7])
+		for i, blockID := range vector.MustFixedCol[types.Blockid](bat.Vecs[2]) {
+			appendable, location := s.addBlock(bat, i)
+			if resync && !location.IsEmpty() {
+				appendables[blockID] = appendable
+				if loc := objectio.Location(deltaLoc[i]); !loc.IsEmpty() {
+					allDeltas[blockID] = append(allDeltas[blockID], append(objectio.Location(nil), loc...))
+				}
+			}
+			if !resume || location.IsEmpty() {
+				continue
+			}
+			if loc := objectio.Location(deltaLoc[i]); !loc.IsEmpty() && commitTS[i].Greater(start) {
+				deltas[blockID] = loc
+			}
+			if _, ok := read[blockID]; ok {
+				continue
+			}
+			if appendable && commitTS[i].Greater(start) {
+				// flushed after start
+				read[blockID] = struct{}{}
+				if changes, err = s.readInserts(ctx, changes, blockID, location, types.TS{}, start); err != nil {
+					return nil, err
+				}
+			} else if !appendable && createTS[i].Greater(start) {
+				read[blockID] = struct{}{}
+				if changes, err = s.readInserts(ctx, changes, blockID, location, createTS[i], types.TS{}); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	for _, bat := range es.metaDel {
+		s.dropBlocks(bat)
+	}
+	for blockID, loc := range deltas {
+		if changes, err = s.readDeletes(ctx, changes, blockID, loc, start); err != nil {
+			return nil, err
+		}
+	}
+	if changes, err = s.consumeRows(ctx, changes, es, start, resume); err != nil {
+		return nil, err
+	}
+	if resync {
+		return s.snapshot(ctx, es, appendables, allDeltas, ts)
+	}
+	return changes, nil
+}
+
+// snapshot returns the changes re-syncing the table to its rows at ts, which are
+// a truncate followed by the inserts of all the rows. The rows are the ones of
+// the flushed blocks without their deletes, plus the ones in memory.
+func (s *tableState) snapshot(
+	ctx context.Context,
+	es logtailEntries,
+	appendables map[types.Blockid]bool,
+	deltas map[types.Blockid][]objectio.Location,
+	ts types.TS) ([]change, error) {
+	deleted := make(map[types.Rowid]struct{})
+	for _, bat := range es.rowDel {
+		for _, rowID := range vector.MustFixedCol[types.Rowid](bat.Vecs[0]) {
+			deleted[rowID] = struct{}{}
+		}
+	}
+	changes := []change{{ts: ts, typ: ChangeTruncate}}
+
+	blockIDs := make([]types.Blockid, 0, len(s.blocks))
+	for blockID := range s.blocks {
+		blockIDs = append(blockIDs, blockID)
+	}
+	sort.Slice(blockIDs, func(i, j int) bool {
+		return blockIDs[i].Compare(blockIDs[j]) < 0
+	})
+	for _, blockID := range blockIDs {
+		for _, loc := range deltas[blockID] {
+			if err := s.readDeletedRows(ctx, deleted, loc); err != nil {
+				return nil, err
+			}
+		}
+		bat, commits, err := s.readBlock(ctx, s.blocks[blockID], appendables[blockID])
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < bat.Vecs[0].Length(); i++ {
+			if _, ok := deleted[objectio.NewRowid(&blockID, uint32(i))]; ok {
+				continue
+			}
+			// aborted
+			if commits != nil && commits[i].IsEmpty() {
+				continue
+			}
+			row, pk := s.table.row(bat.Vecs, i)
+			changes = append(changes, change{ts: ts, typ: ChangeInsert, pk: pk, after: row})
+		}
+	}
+
+	rowIDs := make([]types.Rowid, 0, len(s.rows))
+	for rowID := range s.rows {
+		if _, ok := s.blocks[rowID.GetBlockid()]; !ok {
+			rowIDs = append(rowIDs, rowID)
+		}
+	}
+	sort.Slice(rowIDs, func(i, j int) bool {
+		return rowIDs[i].Less(rowIDs[j])
+	})
+	for _, rowID := range rowIDs {
+		cached := s.rows[rowID]
+		changes = append(changes, change{ts: ts, typ: ChangeInsert, pk: cached.pk, after: cached.row})
+	}
+	return changes, nil
+}
+
+// readDeletedRows adds the rows deleted by the persisted deletes to deleted.
+func (s *tableState) readDeletedRows(
+	ctx context.Context,
+	deleted map[types.Rowid]struct{},
+	location objectio.Location) error {
+	reader, err := blockio.NewObjectReader(s.fs, location)
+	if err != nil {
+		return err
+	}
+	bat, err := reader.LoadColumns(ctx, []uint16{0, 2}, location.ID(), nil)
+	if err != nil {
+		return err
+	}
+	aborted := vector.MustFixedCol[bool](bat.Vecs[1])
+	for i, rowID := range vector.MustFixedCol[types.Rowid](bat.Vecs[0]) {
+		if !aborted[i] {
+			deleted[rowID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// consumeRows consumes the rows changed in memory, the changes after start are
+// returned if emit. The inserts are consumed first, as the logtail of the
+// subscription has the deletes of the rows it inserts.
+func (s *tableState) consumeRows(
+	ctx context.Context,
+	changes []change,
+	es logtailEntries,
+	start types.TS,
+	emit bool) ([]change, error) {
+	for _, bat := range es.rowIns {
+		if err := s.table.checkAttrs(ctx, bat); err != nil {
+			return nil, err
+		}
+		rowIDs := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+		commitTS := vector.MustFixedCol[types.TS](bat.Vecs[1])
+		for i, rowID := range rowIDs {
+			row, pk := s.table.row(bat.Vecs[2:], i)
+			s.rows[rowID] = cachedRow{row: row, pk: pk}
+			if !emit || commitTS[i].LessEq(start) {
+				continue
+			}
+			changes = append(changes, change{
+				ts:    commitTS[i],
+				typ:   ChangeInsert,
+				pk:    pk,
+				after: row,
+			})
+		}
+	}
+	for _, bat := range es.rowDel {
+		rowIDs := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+		commitTS := vector.MustFixedCol[types.TS](bat.Vecs[1])
+		for i, rowID := range rowIDs {
+			if !emit || commitTS[i].LessEq(start) {
+				delete(s.rows, rowID)
+				continue
+			}
+			row, pk, err := s.getRow(ctx, rowID)
+			if err != nil {
+				return nil, err
+			}
+			delete(s.rows, rowID)
+			changes = append(changes, change{
+				ts:     commitTS[i],
+				typ:    ChangeDelete,
+				pk:     pk,
+				before: row,
+			})
+		}
+	}
+	return changes, nil
+}
+
+// addBlock records the location of the i-th block of the meta insert batch. The
+// rows of a flushed appendable block are dropped from memory.
+func (s *tableState) addBlock(bat *batch.Batch, i int) (appendable bool, location objectio.Location) {
+	blockID := vector.GetFixedAt[types.Blockid](bat.Vecs[2], i)
+	appendable = vector.GetFixedAt[bool](bat.Vecs[3], i)
+	location = objectio.Location(bat.Vecs[5].GetBytesAt(i))
+	if location.IsEmpty() {
+		return
+	}
+	location = append(objectio.Location(nil), location...)
+	s.blocks[blockID] = location
+	if appendable {
+		s.dropRows(blockID)
+	}
+	return
+}
+
+func (s *tableState) dropBlocks(bat *batch.Batch) {
+	for _, rowID := range vector.MustFixedCol[types.Rowid](bat.Vecs[0]) {
+		blockID := rowID.GetBlockid()
+		delete(s.blocks, blockID)
+		s.dropRows(blockID)
+	}
+}
+
+func (s *tableState) dropRows(blockID types.Blockid) {
+	for rowID := range s.rows {
+		if rowID.GetBlockid() == blockID {
+			delete(s.rows, rowID)
+		}
+	}
+}
+
+// getRow returns the row in memory, or reads it from its block.
+func (s *tableState) getRow(ctx context.Context, rowID types.Rowid) (Row, string, error) {
+	if cached, ok := s.rows[rowID]; ok {
+		return cached.row, cached.pk, nil
+	}
+	blockID, offset := rowID.Decode()
+	bat, err := s.loadBlock(ctx, blockID)
+	if err != nil {
+		return nil, "", err
+	}
+	if bat == nil || int(offset) >= bat.Vecs[0].Length() {
+		return nil, "", moerr.NewInternalError(ctx,
+			"cdc: the deleted row %s of table %s is not found", rowID.String(), s.table)
+	}
+	row, pk := s.table.row(bat.Vecs, int(offset))
+	return row, pk, nil
+}
+
+func (s *tableState) loadBlock(ctx context.Context, blockID types.Blockid) (*batch.Batch, error) {
+	if bat, ok := s.loaded[blockID]; ok {
+		return bat, nil
+	}
+	location, ok := s.blocks[blockID]
+	if !ok || s.fs == nil {
+		return nil, nil
+	}
+	bat, _, err := s.readBlock(ctx, location, false)
+	if err != nil {
+		return nil, err
+	}
+	if s.loaded == nil {
+		s.loaded = make(map[types.Blockid]*batch.Batch)
+	}
+	s.loaded[blockID] = bat
+	return bat, nil
+}
+
+func (s *tableState) resetLoaded() {
+	s.loaded = nil
+}
+
+// readBlock reads the columns of the block, and the commit timestamps of the
+// rows if withCommits, the rows aborted have empty timestamps.
+func (s *tableState) readBlock(
+	ctx context.Context,
+	location objectio.Location,
+	withCommits bool) (*batch.Batch, []types.TS, error) {
+	if s.fs == nil {
+		return nil, nil, moerr.NewInternalError(ctx, "cdc: no file service to read the blocks of table %s", s.table)
+	}
+	reader, err := blockio.NewObjectReader(s.fs, location)
+	if err != nil {
+		return nil, nil, err
+	}
+	idxes := make([]uint16, len(s.table.attrs))
+	for i := range idxes {
+		idxes[i] = uint16(i)
+	}
+	if withCommits {
+		// the commit ts and aborted columns are the last of an appendable block
+		meta, err := reader.LoadObjectMeta(ctx, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		count := meta.GetBlockMeta(uint32(location.ID())).GetColumnCount()
+		idxes = append(idxes, count-2, count-1)
+	}
+	bat, err := reader.LoadColumns(ctx, idxes, location.ID(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !withCommits {
+		return bat, nil, nil
+	}
+	n := len(bat.Vecs)
+	commits := vector.MustFixedCol[types.TS](bat.Vecs[n-2])
+	aborted := vector.MustFixedCol[bool](bat.Vecs[n-1])
+	ret := make([]types.TS, len(commits))
+	for i := range commits {
+		if !aborted[i] {
+			ret[i] = commits[i]
+		}
+	}
+	bat.Vecs = bat.Vecs[:n-2]
+	return bat, ret, nil
+}
+
+// readInserts returns the rows of a block as inserts. The rows are committed at
+// ts, or at their own commit timestamps after start if ts is empty.
+func (s *tableState) readInserts(
+	ctx context.Context,
+	changes []change,
+	blockID types.Blockid,
+	location objectio.Location,
+	ts, start types.TS) ([]change, error) {
+	bat, commits, err := s.readBlock(ctx, location, ts.IsEmpty())
+	if err != nil {
+		return nil, err
+	}
+	if s.loaded == nil {
+		s.loaded = make(map[types.Blockid]*batch.Batch)
+	}
+	s.loaded[blockID] = bat
+	for i := 0; i < bat.Vecs[0].Length(); i++ {
+		commitTS := ts
+		if commitTS.IsEmpty() {
+			if commitTS = commits[i]; commitTS.IsEmpty() || commitTS.LessEq(start) {
+				continue
+			}
+		}
+		row, pk := s.table.row(bat.Vecs, i)
+		changes = append(changes, change{
+			ts:    commitTS,
+			typ:   ChangeInsert,
+			pk:    pk,
+			after: row,
+		})
+	}
+	return changes, nil
+}
+
+// readDeletes returns the deletes after start of the persisted deletes of a
+// block.
+func (s *tableState) readDeletes(
+	ctx context.Context,
+	changes []change,
+	blockID types.Blockid,
+	location objectio.Location,
+	start types.TS) ([]change, error) {
+	reader, err := blockio.NewObjectReader(s.fs, location)
+	if err != nil {
+		return nil, err
+	}
+	bat, err := reader.LoadColumns(ctx, []uint16{0, 1, 2}, location.ID(), nil)
+	if err != nil {
+		return nil, err
+	}
+	rowIDs := vector.MustFixedCol[types.Rowid](bat.Vecs[0])
+	commitTS := vector.MustFixedCol[types.TS](bat.Vecs[1])
+	aborted := vector.MustFixedCol[bool](bat.Vecs[2])
+	for i, rowID := range rowIDs {
+		if aborted[i] || commitTS[i].LessEq(start) || rowID.GetBlockid() != blockID {
+			continue
+		}
+		row, pk, err := s.getRow(ctx, rowID)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change{
+			ts:     commitTS[i],
+			typ:    ChangeDelete,
+			pk:     pk,
+			before: row,
+		})
+	}
+	return changes, nil
+}
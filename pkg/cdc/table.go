@@ -0,0 +1,203 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+)
+
+// Attr is a column of a table as TAE stores it.
+type Attr struct {
+	Name    string
+	Hidden  bool
+	Primary bool
+}
+
+// Table is a table captured by a task.
+type Table struct {
+	AccountID  uint32
+	DatabaseID uint64
+	TableID    uint64
+	Database   string
+	Name       string
+	// Columns are the visible columns of the table.
+	Columns []string
+	// PrimaryKeys are the visible primary key columns, it is empty if the table
+	// has no primary key or a composite one, which TAE keeps in a hidden column.
+	PrimaryKeys []string
+
+	// attrs are the columns stored by TAE, in the order of the logtail batches
+	// and the columns of the objects.
+	attrs []string
+	// colIdx is the index in attrs of each visible column.
+	colIdx []int
+	// pkIdx is the index in attrs of the primary key, -1 if there is none.
+	pkIdx int
+}
+
+// NewTable returns a table with the columns stored by TAE in order, without
+// the rowid column.
+func NewTable(
+	accountID uint32,
+	databaseID, tableID uint64,
+	database, name string,
+	attrs []Attr) *Table {
+	t := &Table{
+		AccountID:  accountID,
+		DatabaseID: databaseID,
+		TableID:    tableID,
+		Database:   database,
+		Name:       name,
+		pkIdx:      -1,
+	}
+	for i, attr := range attrs {
+		t.attrs = append(t.attrs, attr.Name)
+		if attr.Primary {
+			t.pkIdx = i
+		}
+		if attr.Hidden {
+			continue
+		}
+		t.Columns = append(t.Columns, attr.Name)
+		t.colIdx = append(t.colIdx, i)
+		if attr.Primary {
+			t.PrimaryKeys = append(t.PrimaryKeys, attr.Name)
+		}
+	}
+	return t
+}
+
+// String returns db.table.
+func (t *Table) String() string {
+	return t.Database + "." + t.Name
+}
+
+func (t *Table) apiTableID() api.TableID {
+	return api.TableID{
+		DbId: t.DatabaseID,
+		TbId: t.TableID,
+	}
+}
+
+// checkAttrs checks the data columns of a logtail batch, which start after the
+// rowid and commit ts columns.
+func (t *Table) checkAttrs(ctx context.Context, bat *batch.Batch) error {
+	if len(bat.Vecs) != len(t.attrs)+2 {
+		return moerr.NewInternalError(ctx, "cdc: the columns of table %s changed", t)
+	}
+	if len(bat.Attrs) == len(bat.Vecs) {
+		for i, attr := range t.attrs {
+			if !strings.EqualFold(bat.Attrs[i+2], attr) {
+				return moerr.NewInternalError(ctx, "cdc: the columns of table %s changed", t)
+			}
+		}
+	}
+	return nil
+}
+
+// row returns the visible values and the primary key of the i-th row of vecs,
+// which are the vectors of attrs.
+func (t *Table) row(vecs []*vector.Vector, i int) (Row, string) {
+	row := make(Row, len(t.colIdx))
+	for j, idx := range t.colIdx {
+		row[j] = getValue(vecs[idx], i)
+	}
+	pk := ""
+	if t.pkIdx >= 0 {
+		pk = fmt.Sprint(getValue(vecs[t.pkIdx], i))
+	}
+	return row, pk
+}
+
+// getValue returns the value of the i-th row of the vector in a type the sinks
+// can encode, the types without a native counterpart are formatted as string.
+func getValue(vec *vector.Vector, i int) any {
+	if vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+		return nil
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		return vector.GetFixedAt[bool](vec, i)
+	case types.T_int8:
+		return vector.GetFixedAt[int8](vec, i)
+	case types.T_int16, types.T_year:
+		return vector.GetFixedAt[int16](vec, i)
+	case types.T_int32:
+		return vector.GetFixedAt[int32](vec, i)
+	case types.T_int64:
+		return vector.GetFixedAt[int64](vec, i)
+	case types.T_uint8:
+		return vector.GetFixedAt[uint8](vec, i)
+	case types.T_uint16, types.T_enum:
+		return vector.GetFixedAt[uint16](vec, i)
+	case types.T_uint32:
+		return vector.GetFixedAt[uint32](vec, i)
+	case types.T_uint64, types.T_set, types.T_bit:
+		return vector.GetFixedAt[uint64](vec, i)
+	case types.T_float32:
+		return vector.GetFixedAt[float32](vec, i)
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, i)
+	case types.T_char, types.T_varchar, types.T_text:
+		return string(vec.GetBytesAt(i))
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return append([]byte(nil), vec.GetBytesAt(i)...)
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(i)).String()
+	case types.T_vecf32:
+		return types.VectorToString(types.BytesToVector[float32](vec.GetBytesAt(i)))
+	case types.T_vecf64:
+		return types.VectorToString(types.BytesToVector[float64](vec.GetBytesAt(i)))
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, i).String()
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, i).String2(typ.Scale)
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, i).String2(typ.Scale)
+	case types.T_timestamp:
+		// in UTC, as stored
+		return vector.GetFixedAt[types.Timestamp](vec, i).String2(time.UTC, typ.Scale)
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, i).Format(typ.Scale)
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, i).Format(typ.Scale)
+	case types.T_uuid:
+		return vector.GetFixedAt[types.Uuid](vec, i).ToString()
+	case types.T_TS:
+		ts := vector.GetFixedAt[types.TS](vec, i)
+		return ts.ToString()
+	case types.T_Rowid:
+		rowid := vector.GetFixedAt[types.Rowid](vec, i)
+		return rowid.String()
+	case types.T_Blockid:
+		blockid := vector.GetFixedAt[types.Blockid](vec, i)
+		return blockid.String()
+	}
+	// the other types are not the types of columns
+	if typ.IsVarlen() {
+		return string(vec.GetBytesAt(i))
+	}
+	return nil
+}
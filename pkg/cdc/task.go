@@ -0,0 +1,316 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	taelogtail "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail/service"
+)
+
+// pendingTxn is the changes of a transaction not sent yet, by table.
+type pendingTxn struct {
+	tables  []*Table
+	changes map[*Table][]change
+}
+
+// Task subscribes the logtail of its tables, turns it into the transactions
+// of row changes and sends them to its sink in the order of commit timestamps.
+//
+// Each update of the logtail stream is the logtail of a transaction, with a
+// timestamp all the tables subscribed have reached. After all the tables are
+// subscribed, the transactions until the timestamp of an update are sent, and
+// the timestamp becomes the checkpoint of the task after the sink is flushed.
+// A restarted task resumes from its checkpoint, so a transaction is sent at
+// least once. A table merged after the checkpoint can't be resumed, it is
+// re-synced by a truncate and the inserts of its rows at the subscription.
+type Task struct {
+	name               string
+	tables             map[uint64]*tableState
+	fs                 fileservice.FileService
+	sub                Subscriber
+	sink               Sink
+	store              CheckpointStore
+	checkpointInterval time.Duration
+
+	// start is the checkpoint the task starts from.
+	start      types.TS
+	subscribed map[uint64]struct{}
+	pending    map[types.TS]*pendingTxn
+	// sent is the timestamp until which the transactions were sent.
+	sent types.TS
+	// saved is the checkpoint saved.
+	saved     types.TS
+	savedTime time.Time
+}
+
+// NewTask returns a task capturing the tables. fs is the shared file service
+// to read the flushed blocks from.
+func NewTask(
+	name string,
+	tables []*Table,
+	fs fileservice.FileService,
+	sub Subscriber,
+	sink Sink,
+	store CheckpointStore,
+	checkpointInterval time.Duration) *Task {
+	t := &Task{
+		name:               name,
+		tables:             make(map[uint64]*tableState, len(tables)),
+		fs:                 fs,
+		sub:                sub,
+		sink:               sink,
+		store:              store,
+		checkpointInterval: checkpointInterval,
+		subscribed:         make(map[uint64]struct{}, len(tables)),
+		pending:            make(map[types.TS]*pendingTxn),
+	}
+	for _, table := range tables {
+		t.tables[table.TableID] = newTableState(table, fs)
+	}
+	return t
+}
+
+// Run runs the task until ctx is done or an error occurs. The subscriber and
+// the sink are closed when it returns.
+func (t *Task) Run(ctx context.Context) error {
+	defer func() {
+		_ = t.sink.Close()
+	}()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		// unblock Receive
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+		_ = t.sub.Close()
+	}()
+
+	ts, err := t.store.Load(ctx, t.name)
+	if err != nil {
+		return err
+	}
+	t.start = types.TimestampToTS(ts)
+	t.sent, t.saved = t.start, t.start
+	t.savedTime = time.Now()
+	logutil.Infof("cdc task %s starts from %s", t.name, t.start.ToString())
+
+	for _, s := range t.tables {
+		if err = t.sub.Subscribe(ctx, s.table.apiTableID()); err != nil {
+			return err
+		}
+	}
+	for {
+		resp, err := t.sub.Receive()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if err = t.handle(ctx, resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (t *Task) handle(ctx context.Context, resp *service.LogtailResponse) error {
+	if e := resp.GetError(); e != nil {
+		return moerr.NewInternalError(ctx, "cdc task %s: logtail error of table %d: %s",
+			t.name, e.GetTable().GetTbId(), e.Status.GetMessage())
+	}
+	if sub := resp.GetSubscribeResponse(); sub != nil {
+		return t.handleSubscribe(ctx, &sub.Logtail)
+	}
+	if update := resp.GetUpdateResponse(); update != nil {
+		return t.handleUpdate(ctx, update)
+	}
+	return nil
+}
+
+func (t *Task) handleSubscribe(ctx context.Context, tail *logtail.TableLogtail) error {
+	s, ok := t.tables[tail.GetTable().GetTbId()]
+	if !ok {
+		return nil
+	}
+	var entries []*api.Entry
+	if tail.CkpLocation != "" {
+		if t.fs == nil {
+			return moerr.NewInternalError(ctx,
+				"cdc: no file service to read the checkpoint of table %s", s.table)
+		}
+		var err error
+		if entries, err = taelogtail.LoadCheckpointEntries(
+			ctx, tail.CkpLocation,
+			s.table.TableID, s.table.Name,
+			s.table.DatabaseID, s.table.Database, t.fs); err != nil {
+			return err
+		}
+	}
+	for i := range tail.Commands {
+		entries = append(entries, &tail.Commands[i])
+	}
+	var ts types.TS
+	if tail.Ts != nil {
+		ts = types.TimestampToTS(*tail.Ts)
+	}
+	changes, err := s.bootstrap(ctx, entries, t.start, ts)
+	if err != nil {
+		return err
+	}
+	t.addChanges(s.table, changes)
+	t.subscribed[s.table.TableID] = struct{}{}
+	logutil.Infof("cdc task %s subscribed table %s", t.name, s.table)
+	return nil
+}
+
+func (t *Task) handleUpdate(ctx context.Context, update *logtail.UpdateResponse) error {
+	for i := range update.LogtailList {
+		tail := &update.LogtailList[i]
+		s, ok := t.tables[tail.GetTable().GetTbId()]
+		if !ok {
+			continue
+		}
+		entries := make([]*api.Entry, len(tail.Commands))
+		for j := range tail.Commands {
+			entries[j] = &tail.Commands[j]
+		}
+		changes, err := s.consume(ctx, entries)
+		if err != nil {
+			return err
+		}
+		t.addChanges(s.table, changes)
+	}
+	if len(t.subscribed) < len(t.tables) || update.To == nil {
+		return nil
+	}
+	if err := t.send(ctx, types.TimestampToTS(*update.To)); err != nil {
+		return err
+	}
+	return t.checkpoint(ctx)
+}
+
+func (t *Task) addChanges(table *Table, changes []change) {
+	for _, c := range changes {
+		if c.ts.LessEq(t.sent) {
+			continue
+		}
+		txn, ok := t.pending[c.ts]
+		if !ok {
+			txn = &pendingTxn{changes: make(map[*Table][]change)}
+			t.pending[c.ts] = txn
+		}
+		if _, ok = txn.changes[table]; !ok {
+			txn.tables = append(txn.tables, table)
+		}
+		txn.changes[table] = append(txn.changes[table], c)
+	}
+}
+
+// send sends the pending transactions until to.
+func (t *Task) send(ctx context.Context, to types.TS) error {
+	if to.LessEq(t.sent) {
+		return nil
+	}
+	var tss []types.TS
+	for ts := range t.pending {
+		if ts.LessEq(to) {
+			tss = append(tss, ts)
+		}
+	}
+	sort.Slice(tss, func(i, j int) bool {
+		return tss[i].Less(tss[j])
+	})
+	for _, ts := range tss {
+		pending := t.pending[ts]
+		txn := &Txn{CommitTS: ts.ToTimestamp()}
+		for _, table := range pending.tables {
+			txn.Changes = mergeChanges(txn.Changes, table, pending.changes[table])
+		}
+		if len(txn.Changes) > 0 {
+			if err := t.sink.Send(ctx, txn); err != nil {
+				return err
+			}
+		}
+		delete(t.pending, ts)
+	}
+	t.sent = to
+	return nil
+}
+
+// checkpoint flushes the sink and saves the checkpoint every checkpoint
+// interval.
+func (t *Task) checkpoint(ctx context.Context) error {
+	if t.sent.LessEq(t.saved) || time.Since(t.savedTime) < t.checkpointInterval {
+		return nil
+	}
+	if err := t.sink.Flush(ctx); err != nil {
+		return err
+	}
+	if err := t.store.Save(ctx, t.name, t.sent.ToTimestamp()); err != nil {
+		return err
+	}
+	t.saved = t.sent
+	t.savedTime = time.Now()
+	return nil
+}
+
+// mergeChanges appends the changes of a table in a transaction to ret, the
+// truncate and the deletes first. A delete and an insert of the same primary key
+// are merged into an update.
+func mergeChanges(ret []Change, table *Table, changes []change) []Change {
+	for _, c := range changes {
+		if c.typ == ChangeTruncate {
+			ret = append(ret, Change{Table: table, Type: ChangeTruncate})
+		}
+	}
+	inserts := make(map[string]int)
+	for i, c := range changes {
+		if c.typ == ChangeInsert && c.pk != "" {
+			inserts[c.pk] = i
+		}
+	}
+	merged := make(map[int]struct{})
+	for _, c := range changes {
+		if c.typ != ChangeDelete {
+			continue
+		}
+		if i, ok := inserts[c.pk]; ok && c.pk != "" {
+			delete(inserts, c.pk)
+			merged[i] = struct{}{}
+			ret = append(ret, Change{Table: table, Type: ChangeUpdate, Before: c.before, After: changes[i].after})
+			continue
+		}
+		ret = append(ret, Change{Table: table, Type: ChangeDelete, Before: c.before})
+	}
+	for i, c := range changes {
+		if _, ok := merged[i]; ok || c.typ != ChangeInsert {
+			continue
+		}
+		ret = append(ret, Change{Table: table, Type: ChangeInsert, After: c.after})
+	}
+	return ret
+}
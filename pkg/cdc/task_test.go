@@ -0,0 +1,330 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail/service"
+	"github.com/stretchr/testify/require"
+)
+
+type testSubscriber struct {
+	subscribed []api.TableID
+	c          chan *service.LogtailResponse
+}
+
+func newTestSubscriber(resps ...*service.LogtailResponse) *testSubscriber {
+	s := &testSubscriber{c: make(chan *service.LogtailResponse, len(resps))}
+	for _, resp := range resps {
+		s.c <- resp
+	}
+	close(s.c)
+	return s
+}
+
+func (s *testSubscriber) Subscribe(_ context.Context, table api.TableID) error {
+	s.subscribed = append(s.subscribed, table)
+	return nil
+}
+
+func (s *testSubscriber) Receive() (*service.LogtailResponse, error) {
+	resp, ok := <-s.c
+	if !ok {
+		return nil, moerr.NewStreamClosedNoCtx()
+	}
+	return resp, nil
+}
+
+func (s *testSubscriber) Close() error {
+	return nil
+}
+
+type testSink struct {
+	txns    []*Txn
+	flushed int
+	closed  bool
+}
+
+func (s *testSink) Send(_ context.Context, txn *Txn) error {
+	s.txns = append(s.txns, txn)
+	return nil
+}
+
+func (s *testSink) Flush(_ context.Context) error {
+	s.flushed = len(s.txns)
+	return nil
+}
+
+func (s *testSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+type testStore map[string]timestamp.Timestamp
+
+func (s testStore) Load(_ context.Context, task string) (timestamp.Timestamp, error) {
+	return s[task], nil
+}
+
+func (s testStore) Save(_ context.Context, task string, ts timestamp.Timestamp) error {
+	s[task] = ts
+	return nil
+}
+
+func newTestTable() *Table {
+	return NewTable(0, 1, 2, "db", "t", []Attr{
+		{Name: "a", Primary: true},
+		{Name: "b"},
+	})
+}
+
+type testRow struct {
+	id uint32
+	ts int64
+	a  int64
+	b  string
+}
+
+func testRowid(id uint32) types.Rowid {
+	return types.BuildTestRowid(1, int64(id))
+}
+
+func testInsertEntry(t *testing.T, table *Table, rows ...testRow) api.Entry {
+	mp := mpool.MustNewZero()
+	bat := batch.New(true, []string{"rowid", "ts", "a", "b"})
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	bat.Vecs[2] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[3] = vector.NewVec(types.T_varchar.ToType())
+	for _, row := range rows {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], testRowid(row.id), false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.BuildTS(row.ts, 0), false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[2], row.a, false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[3], []byte(row.b), false, mp))
+	}
+	pb, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	return api.Entry{
+		EntryType:    api.Entry_Insert,
+		TableId:      table.TableID,
+		DatabaseId:   table.DatabaseID,
+		TableName:    table.Name,
+		DatabaseName: table.Database,
+		Bat:          pb,
+	}
+}
+
+func testDeleteEntry(t *testing.T, table *Table, rows ...testRow) api.Entry {
+	mp := mpool.MustNewZero()
+	bat := batch.New(true, []string{"rowid", "ts"})
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	for _, row := range rows {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], testRowid(row.id), false, mp))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.BuildTS(row.ts, 0), false, mp))
+	}
+	pb, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	return api.Entry{
+		EntryType:    api.Entry_Delete,
+		TableId:      table.TableID,
+		DatabaseId:   table.DatabaseID,
+		TableName:    table.Name,
+		DatabaseName: table.Database,
+		Bat:          pb,
+	}
+}
+
+func testSubscribeResponse(table *Table, entries ...api.Entry) *service.LogtailResponse {
+	id := table.apiTableID()
+	resp := &service.LogtailResponse{}
+	resp.Response = &logtail.LogtailResponse_SubscribeResponse{
+		SubscribeResponse: &logtail.SubscribeResponse{
+			Logtail: logtail.TableLogtail{Table: &id, Commands: entries},
+		},
+	}
+	return resp
+}
+
+func testUpdateResponse(to int64, table *Table, entries ...api.Entry) *service.LogtailResponse {
+	id := table.apiTableID()
+	ts := types.BuildTS(to, 0).ToTimestamp()
+	update := &logtail.UpdateResponse{To: &ts}
+	if len(entries) > 0 {
+		update.LogtailList = []logtail.TableLogtail{{Table: &id, Commands: entries}}
+	}
+	resp := &service.LogtailResponse{}
+	resp.Response = &logtail.LogtailResponse_UpdateResponse{UpdateResponse: update}
+	return resp
+}
+
+func TestTask(t *testing.T) {
+	table := newTestTable()
+	r1 := testRow{id: 1, ts: 1, a: 1, b: "x"}
+	r2 := testRow{id: 2, ts: 2, a: 2, b: "y"}
+	r3 := testRow{id: 3, ts: 3, a: 1, b: "z"}
+	r4 := testRow{id: 4, ts: 4, a: 4, b: "w"}
+	sub := newTestSubscriber(
+		testSubscribeResponse(table, testInsertEntry(t, table, r1)),
+		testUpdateResponse(2, table, testInsertEntry(t, table, r2)),
+		// update r1 to r3
+		testUpdateResponse(3, table,
+			testDeleteEntry(t, table, testRow{id: 1, ts: 3}),
+			testInsertEntry(t, table, r3)),
+		// a heartbeat
+		testUpdateResponse(4, table),
+		testUpdateResponse(5, table,
+			testDeleteEntry(t, table, testRow{id: 2, ts: 5}),
+			testInsertEntry(t, table, testRow{id: 4, ts: 5, a: r4.a, b: r4.b})),
+	)
+	sink := &testSink{}
+	store := testStore{}
+	task := NewTask("task", []*Table{table}, nil, sub, sink, store, 0)
+	err := task.Run(context.Background())
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrStreamClosed))
+	require.Equal(t, []api.TableID{table.apiTableID()}, sub.subscribed)
+	require.True(t, sink.closed)
+
+	require.Equal(t, 3, len(sink.txns))
+	require.Equal(t, types.BuildTS(2, 0).ToTimestamp(), sink.txns[0].CommitTS)
+	require.Equal(t, []Change{
+		{Table: table, Type: ChangeInsert, After: Row{int64(2), "y"}},
+	}, sink.txns[0].Changes)
+	require.Equal(t, types.BuildTS(3, 0).ToTimestamp(), sink.txns[1].CommitTS)
+	require.Equal(t, []Change{
+		{Table: table, Type: ChangeUpdate, Before: Row{int64(1), "x"}, After: Row{int64(1), "z"}},
+	}, sink.txns[1].Changes)
+	require.Equal(t, types.BuildTS(5, 0).ToTimestamp(), sink.txns[2].CommitTS)
+	require.Equal(t, []Change{
+		{Table: table, Type: ChangeDelete, Before: Row{int64(2), "y"}},
+		{Table: table, Type: ChangeInsert, After: Row{int64(4), "w"}},
+	}, sink.txns[2].Changes)
+
+	require.Equal(t, 3, sink.flushed)
+	require.Equal(t, types.BuildTS(5, 0).ToTimestamp(), store["task"])
+}
+
+func TestTaskResume(t *testing.T) {
+	table := newTestTable()
+	// the logtail in memory of the subscription
+	sub := newTestSubscriber(
+		testSubscribeResponse(table,
+			testInsertEntry(t, table,
+				testRow{id: 1, ts: 1, a: 1, b: "x"},
+				testRow{id: 2, ts: 2, a: 2, b: "y"},
+				testRow{id: 3, ts: 3, a: 3, b: "z"}),
+			testDeleteEntry(t, table,
+				testRow{id: 1, ts: 2},
+				testRow{id: 2, ts: 3})),
+		testUpdateResponse(4, table),
+	)
+	sink := &testSink{}
+	store := testStore{"task": types.BuildTS(2, 0).ToTimestamp()}
+	task := NewTask("task", []*Table{table}, nil, sub, sink, store, 0)
+	err := task.Run(context.Background())
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrStreamClosed))
+
+	// only the transaction after the checkpoint is sent
+	require.Equal(t, 1, len(sink.txns))
+	require.Equal(t, types.BuildTS(3, 0).ToTimestamp(), sink.txns[0].CommitTS)
+	require.Equal(t, []Change{
+		{Table: table, Type: ChangeDelete, Before: Row{int64(2), "y"}},
+		{Table: table, Type: ChangeInsert, After: Row{int64(3), "z"}},
+	}, sink.txns[0].Changes)
+	require.Equal(t, types.BuildTS(4, 0).ToTimestamp(), store["task"])
+}
+
+func TestTaskResync(t *testing.T) {
+	table := newTestTable()
+	// the blocks of the rows before the checkpoint were merged after it
+	mp := mpool.MustNewZero()
+	bat := batch.New(true, []string{"rowid", "ts"})
+	bat.Vecs[0] = vector.NewVec(types.T_Rowid.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], types.BuildTestRowid(9, 0), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.BuildTS(3, 0), false, mp))
+	pb, err := batch.BatchToProtoBatch(bat)
+	require.NoError(t, err)
+	merge := api.Entry{
+		EntryType:    api.Entry_Delete,
+		TableId:      table.TableID,
+		DatabaseId:   table.DatabaseID,
+		TableName:    "_2_meta",
+		DatabaseName: table.Database,
+		Bat:          pb,
+	}
+	resp := testSubscribeResponse(table,
+		merge,
+		testInsertEntry(t, table,
+			testRow{id: 1, ts: 3, a: 1, b: "x"},
+			testRow{id: 2, ts: 4, a: 2, b: "y"}),
+		testDeleteEntry(t, table, testRow{id: 1, ts: 4}))
+	subTS := types.BuildTS(4, 0).ToTimestamp()
+	resp.GetSubscribeResponse().Logtail.Ts = &subTS
+	sub := newTestSubscriber(resp, testUpdateResponse(5, table))
+	sink := &testSink{}
+	store := testStore{"task": types.BuildTS(2, 0).ToTimestamp()}
+	task := NewTask("task", []*Table{table}, nil, sub, sink, store, 0)
+	err = task.Run(context.Background())
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrStreamClosed))
+
+	// the table is re-synced at the subscription and the checkpoint advances
+	require.Equal(t, 1, len(sink.txns))
+	require.Equal(t, subTS, sink.txns[0].CommitTS)
+	require.Equal(t, []Change{
+		{Table: table, Type: ChangeTruncate},
+		{Table: table, Type: ChangeInsert, After: Row{int64(2), "y"}},
+	}, sink.txns[0].Changes)
+	require.Equal(t, types.BuildTS(5, 0).ToTimestamp(), store["task"])
+}
+
+func TestTaskCheckpointInterval(t *testing.T) {
+	table := newTestTable()
+	sub := newTestSubscriber(
+		testSubscribeResponse(table),
+		testUpdateResponse(2, table, testInsertEntry(t, table, testRow{id: 1, ts: 2, a: 1, b: "x"})),
+	)
+	sink := &testSink{}
+	store := testStore{}
+	task := NewTask("task", []*Table{table}, nil, sub, sink, store, time.Hour)
+	_ = task.Run(context.Background())
+	require.Equal(t, 1, len(sink.txns))
+	require.Equal(t, 0, sink.flushed)
+	_, ok := store["task"]
+	require.False(t, ok)
+}
+
+func TestMergeChangesWithoutPrimaryKey(t *testing.T) {
+	table := NewTable(0, 1, 2, "db", "t", []Attr{{Name: "a"}, {Name: "b"}})
+	changes := mergeChanges(nil, table, []change{
+		{typ: ChangeInsert, after: Row{int64(1), "y"}},
+		{typ: ChangeDelete, before: Row{int64(1), "x"}},
+	})
+	require.Equal(t, []Change{
+		{Table: table, Type: ChangeDelete, Before: Row{int64(1), "x"}},
+		{Table: table, Type: ChangeInsert, After: Row{int64(1), "y"}},
+	}, changes)
+}
@@ -122,12 +122,20 @@ func NewCNClient(
 
 	var err error
 	cfg.Fill()
+	var tlsOpts []morpc.BackendOption
+	if cfg.RPC.TLS.Enable {
+		tc, err := cfg.RPC.TLS.NewClientTLSConfig()
+		if err != nil {
+			return err
+		}
+		tlsOpts = append(tlsOpts, morpc.WithBackendTLS(tc))
+	}
 	client = &CNClient{config: cfg, localServiceAddress: localServiceAddress}
 	client.requestPool = &sync.Pool{New: func() any { return &pipeline.Message{} }}
 
 	codec := morpc.NewMessageCodec(client.acquireMessage,
 		morpc.WithCodecMaxBodySize(int(cfg.RPC.MaxMessageSize)))
-	factory := morpc.NewGoettyBasedBackendFactory(codec,
+	backendOpts := []morpc.BackendOption{
 		morpc.WithBackendGoettyOptions(
 			goetty.WithSessionRWBUfferSize(cfg.ReadBufferSize, cfg.WriteBufferSize),
 			goetty.WithSessionReleaseMsgFunc(func(v any) {
@@ -139,7 +147,9 @@ func NewCNClient(
 		),
 		morpc.WithBackendConnectTimeout(cfg.TimeOutForEachConnect),
 		morpc.WithBackendLogger(logger),
-	)
+	}
+	backendOpts = append(backendOpts, tlsOpts...)
+	factory := morpc.NewGoettyBasedBackendFactory(codec, backendOpts...)
 
 	client.client, err = morpc.NewClient(factory,
 		morpc.WithClientMaxBackendPerHost(cfg.MaxSenderNumber),
@@ -78,7 +78,8 @@ func (s *service) initDistributedTAE(
 		logutil.Info("cn turn push model on.")
 		err = cnEngine.InitLogTailPushModel(
 			ctx,
-			s.timestampWaiter)
+			s.timestampWaiter,
+			s.cfg.RPC.TLS)
 		if err != nil {
 			return err
 		}
@@ -112,9 +112,7 @@ func NewService(
 		return nil, err
 	}
 
-	server, err := morpc.NewRPCServer("cn-server", cfg.ListenAddress,
-		morpc.NewMessageCodec(srv.acquireMessage,
-			morpc.WithCodecMaxBodySize(int(cfg.RPC.MaxMessageSize))),
+	serverOpts := []morpc.ServerOption{
 		morpc.WithServerLogger(srv.logger),
 		morpc.WithServerGoettyOptions(
 			goetty.WithSessionRWBUfferSize(cfg.ReadBufferSize, cfg.WriteBufferSize),
@@ -125,7 +123,19 @@ func NewService(
 				}
 			}),
 		),
-		morpc.WithServerDisableAutoCancelContext())
+		morpc.WithServerDisableAutoCancelContext(),
+	}
+	if cfg.RPC.TLS.Enable {
+		tc, err := cfg.RPC.TLS.NewServerTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts, morpc.WithServerTLS(tc))
+	}
+	server, err := morpc.NewRPCServer("cn-server", cfg.ListenAddress,
+		morpc.NewMessageCodec(srv.acquireMessage,
+			morpc.WithCodecMaxBodySize(int(cfg.RPC.MaxMessageSize))),
+		serverOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +178,9 @@ func (s *service) Start() error {
 	if err := s.startCNStoreHeartbeat(); err != nil {
 		return err
 	}
+	if err := s.startHistoryRetentionRefresher(); err != nil {
+		return err
+	}
 	return s.server.Start()
 }
 
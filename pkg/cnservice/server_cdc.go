@@ -0,0 +1,124 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"go.uber.org/zap"
+)
+
+const (
+	cdcRetryInterval = time.Second * 10
+	cdcLeaseTTL      = time.Second * 30
+)
+
+// startCDC starts the cdc tasks of the cn after the system init completed. All
+// the cns having a task in their configs race for its lease, and only the cn
+// holding the lease runs it.
+func (s *service) startCDC(ctx context.Context) {
+	if len(s.cfg.CDC.Tasks) == 0 || ctx.Err() != nil {
+		return
+	}
+	ieFactory := func() ie.InternalExecutor {
+		return frontend.NewInternalExecutor(s.pu, s.aicm)
+	}
+	if err := cdc.InitSchema(ctx, ieFactory); err != nil {
+		s.logger.Error("init cdc schema failed", zap.Error(err))
+		return
+	}
+	fs, err := fileservice.Get[fileservice.FileService](s.fileService, defines.SharedFileServiceName)
+	if err != nil {
+		s.logger.Error("get file service for cdc failed", zap.Error(err))
+		return
+	}
+	store := cdc.NewCheckpointStore(ieFactory, s.cfg.UUID)
+	lease := cdc.NewLease(ieFactory, s.cfg.UUID)
+	for _, cfg := range s.cfg.CDC.Tasks {
+		cfg := cfg
+		if err := s.stopper.RunTask(func(ctx context.Context) {
+			for {
+				ran, err := cdc.RunWithLease(ctx, lease, cfg.Name, cdcLeaseTTL,
+					func(ctx context.Context) error {
+						return s.runCDCTask(ctx, cfg, ieFactory, fs, store)
+					})
+				if ctx.Err() != nil {
+					return
+				}
+				if ran || err != nil {
+					s.logger.Error("cdc task failed, retry later",
+						zap.String("task", cfg.Name),
+						zap.Error(err))
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(cdcRetryInterval):
+				}
+			}
+		}); err != nil {
+			s.logger.Error("start cdc task failed",
+				zap.String("task", cfg.Name),
+				zap.Error(err))
+		}
+	}
+}
+
+func (s *service) runCDCTask(
+	ctx context.Context,
+	cfg cdc.TaskConfig,
+	ieFactory func() ie.InternalExecutor,
+	fs fileservice.FileService,
+	store cdc.CheckpointStore) error {
+	tables := make([]*cdc.Table, 0, len(cfg.Tables))
+	for _, name := range cfg.Tables {
+		table, err := cdc.ResolveTable(ctx, ieFactory, cfg.AccountID, name)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, table)
+	}
+
+	var addr string
+	clusterservice.GetMOCluster().GetDNService(clusterservice.NewSelector(),
+		func(d metadata.DNService) bool {
+			addr = d.LogTailServiceAddress
+			return false
+		})
+	if addr == "" {
+		return moerr.NewInternalError(ctx, "no logtail service for cdc")
+	}
+
+	sink, err := cdc.NewSink(ctx, cfg.Sink)
+	if err != nil {
+		return err
+	}
+	sub, err := cdc.NewLogtailSubscriber(addr)
+	if err != nil {
+		_ = sink.Close()
+		return err
+	}
+	return cdc.NewTask(cfg.Name, tables, fs, sub, sink, store,
+		s.cfg.CDC.CheckpointInterval.Duration).Run(ctx)
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/fagongzi/util/protoc"
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/ctl"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"go.uber.org/zap"
+)
+
+const (
+	historyRetentionRefreshInterval = time.Second * 10
+)
+
+// startHistoryRetentionRefresher keeps the history retention of the DNs in the
+// runtime, the snapshots of AS OF TIMESTAMP and SET TRANSACTION SNAPSHOT older
+// than it are rejected, since their data may have been reclaimed by GC.
+func (s *service) startHistoryRetentionRefresher() error {
+	return s.stopper.RunNamedTask("cnservice-history-retention", func(ctx context.Context) {
+		ticker := time.NewTicker(historyRetentionRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.refreshHistoryRetention(ctx); err != nil && ctx.Err() == nil {
+				s.logger.Error("failed to refresh the history retention", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// refreshHistoryRetention gets the history retention of all the DNs by the debug
+// requests, the shortest one is kept.
+func (s *service) refreshHistoryRetention(ctx context.Context) error {
+	var requests []txn.TxnRequest
+	clusterservice.GetMOCluster().GetDNService(clusterservice.NewSelector(),
+		func(store metadata.DNService) bool {
+			for _, shard := range store.Shards {
+				req := txn.NewTxnRequest(&txn.CNOpRequest{
+					OpCode: uint32(ctl.CmdMethod_HistoryRetention),
+					Target: metadata.DNShard{
+						DNShardRecord: metadata.DNShardRecord{
+							ShardID: shard.ShardID,
+						},
+						ReplicaID: shard.ReplicaID,
+						Address:   store.TxnServiceAddress,
+					},
+				})
+				req.Method = txn.TxnMethod_DEBUG
+				requests = append(requests, req)
+			}
+			return true
+		})
+	if len(requests) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, historyRetentionRefreshInterval)
+	defer cancel()
+	txnClient, err := s.getTxnClient()
+	if err != nil {
+		return err
+	}
+	txnOp, err := txnClient.New(ctx, timestamp.Timestamp{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = txnOp.Rollback(ctx)
+	}()
+	op, ok := txnOp.(client.DebugableTxnOperator)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "debug function not supported")
+	}
+	result, err := op.Debug(ctx, requests)
+	if err != nil {
+		return err
+	}
+	defer result.Release()
+
+	retention := time.Duration(-1)
+	for _, resp := range result.Responses {
+		if resp.TxnError != nil {
+			return resp.TxnError.UnwrapError()
+		}
+		r := ctl.DNStringResponse{}
+		protoc.MustUnmarshal(&r, resp.CNOpResponse.Payload)
+		d, err := time.ParseDuration(r.ReturnStr)
+		if err != nil {
+			return err
+		}
+		if retention < 0 || d < retention {
+			retention = d
+		}
+	}
+	if retention >= 0 {
+		runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.HistoryRetention, retention)
+	}
+	return nil
+}
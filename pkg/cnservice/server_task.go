@@ -73,7 +73,18 @@ func (s *service) initTaskServiceHolder() {
 			})
 	}
 
-	if err := s.stopper.RunTask(s.waitSystemInitCompleted); err != nil {
+	if err := s.stopper.RunTask(func(ctx context.Context) {
+		s.waitSystemInitCompleted(ctx)
+		s.upgradeMoCatalog(ctx)
+		s.startCDC(ctx)
+		if err := s.stopper.RunTask(func(ctx context.Context) {
+			frontend.RunAuditConfigLoader(ctx, s.pu, s.aicm)
+		}); err != nil {
+			s.logger.Error("start the audit config loader failed", zap.Error(err))
+		}
+		// the quotas of the accounts count the connections and the queries on all the cns
+		frontend.RunAccountUsageReporter(ctx, s.pu, s.aicm, s.cfg.UUID)
+	}); err != nil {
 		panic(err)
 	}
 }
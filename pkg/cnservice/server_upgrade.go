@@ -0,0 +1,45 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"go.uber.org/zap"
+)
+
+const (
+	upgradeRetryInterval = time.Second * 5
+)
+
+// upgradeMoCatalog creates the tables of mo_catalog missing in the accounts created by the
+// older versions after the system init completed. All the cns do it when they start, the
+// conflicts between them are retried.
+func (s *service) upgradeMoCatalog(ctx context.Context) {
+	for {
+		err := frontend.UpgradeMoCatalog(ctx, s.pu, s.aicm)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		s.logger.Error("upgrade mo_catalog failed, retry later", zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(upgradeRetryInterval):
+		}
+	}
+}
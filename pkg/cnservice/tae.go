@@ -58,6 +58,7 @@ func initTAE(
 				DNReplicaID:      pu.SV.DNReplicaID,
 				ServiceAddresses: cfg.HAKeeper.ClientConfig.ServiceAddresses,
 				MaxMessageSize:   int(cfg.RPC.MaxMessageSize),
+				TLS:              cfg.HAKeeper.ClientConfig.TLS,
 			})
 			cancel()
 			return lc, err
@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/cdc"
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
@@ -184,6 +185,10 @@ type Config struct {
 
 	// Ctl ctl service config. CtlService is used to handle ctl request. See mo_ctl for detail.
 	Ctl ctlservice.Config `toml:"ctl"`
+
+	// CDC is the config of the cdc tasks run by the cn, which send the row
+	// changes of tables to the sinks.
+	CDC cdc.Config `toml:"cdc"`
 }
 
 func (c *Config) Validate() error {
@@ -257,6 +262,9 @@ func (c *Config) Validate() error {
 		return moerr.NewBadDBNoCtx("not support txn mode: " + c.Txn.Mode)
 	}
 	c.Ctl.Adjust(foundMachineHost, defaultCtlListenAddress)
+	if err := c.CDC.Validate(); err != nil {
+		return err
+	}
 	c.LockService.ServiceID = c.UUID
 	c.LockService.Validate()
 	return nil
@@ -78,6 +78,20 @@ const (
 	ErrWrongValueCountOnRow uint16 = 20308
 	ErrBadFieldError        uint16 = 20309
 	ErrWrongDatetimeSpec    uint16 = 20310
+	ErrCTEMaxRecursionDepth uint16 = 20311
+	ErrKeyDoesNotExist      uint16 = 20312
+
+	ErrGeneratedColumnFunctionNotAllowed  uint16 = 20313
+	ErrNonDefaultValueForGeneratedColumn  uint16 = 20314
+	ErrUnsupportedActionOnGeneratedColumn uint16 = 20315
+	ErrGeneratedColumnNonPrior            uint16 = 20316
+	ErrGeneratedColumnRefAutoInc          uint16 = 20317
+
+	ErrCheckConstraintViolated                    uint16 = 20318
+	ErrCheckConstraintFunctionNotAllowed          uint16 = 20319
+	ErrColumnCheckConstraintReferencesOtherColumn uint16 = 20320
+	ErrCheckConstraintRefersAutoIncrementColumn   uint16 = 20321
+	ErrCheckConstraintDupName                     uint16 = 20322
 
 	// Group 4: unexpected state and io errors
 	ErrInvalidState                 uint16 = 20400
@@ -126,6 +140,9 @@ const (
 	ErrNoConfig                     uint16 = 20443
 	ErrNoSuchSequence               uint16 = 20444
 	ErrProcedureAlreadyExists       uint16 = 20445
+	ErrTooManyConnections           uint16 = 20446
+	ErrQuotaExceeded                uint16 = 20447
+	ErrColumnAccessDenied           uint16 = 20448
 
 	// Group 5: rpc timeout
 	// ErrRPCTimeout rpc timeout
@@ -236,6 +253,20 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrWrongValueCountOnRow: {ER_WRONG_VALUE_COUNT_ON_ROW, []string{MySQLDefaultSqlState}, "Column count doesn't match value count at row %d"},
 	ErrBadFieldError:        {ER_BAD_FIELD_ERROR, []string{MySQLDefaultSqlState}, "Unknown column '%s' in '%s'"},
 	ErrWrongDatetimeSpec:    {ER_WRONG_DATETIME_SPEC, []string{MySQLDefaultSqlState}, "wrong date/time format specifier: %s"},
+	ErrCTEMaxRecursionDepth: {ER_CTE_MAX_RECURSION_DEPTH, []string{MySQLDefaultSqlState}, "Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value."},
+	ErrKeyDoesNotExist:      {ER_KEY_DOES_NOT_EXITS, []string{MySQLDefaultSqlState}, "Key '%s' doesn't exist in table '%s'"},
+
+	ErrGeneratedColumnFunctionNotAllowed:  {ER_GENERATED_COLUMN_FUNCTION_IS_NOT_ALLOWED, []string{MySQLDefaultSqlState}, "Expression of generated column '%s' contains a disallowed function."},
+	ErrNonDefaultValueForGeneratedColumn:  {ER_NON_DEFAULT_VALUE_FOR_GENERATED_COLUMN, []string{MySQLDefaultSqlState}, "The value specified for generated column '%s' in table '%s' is not allowed."},
+	ErrUnsupportedActionOnGeneratedColumn: {ER_UNSUPPORTED_ACTION_ON_GENERATED_COLUMN, []string{MySQLDefaultSqlState}, "'%s' is not supported for generated columns."},
+	ErrGeneratedColumnNonPrior:            {ER_GENERATED_COLUMN_NON_PRIOR, []string{MySQLDefaultSqlState}, "Generated column can refer only to generated columns defined prior to it."},
+	ErrGeneratedColumnRefAutoInc:          {ER_GENERATED_COLUMN_REF_AUTO_INC, []string{MySQLDefaultSqlState}, "Generated column '%s' cannot refer to auto-increment column."},
+
+	ErrCheckConstraintViolated:                    {ER_CHECK_CONSTRAINT_VIOLATED, []string{MySQLDefaultSqlState}, "Check constraint '%s' is violated."},
+	ErrCheckConstraintFunctionNotAllowed:          {ER_CHECK_CONSTRAINT_FUNCTION_IS_NOT_ALLOWED, []string{MySQLDefaultSqlState}, "An expression of a check constraint '%s' contains disallowed function."},
+	ErrColumnCheckConstraintReferencesOtherColumn: {ER_COLUMN_CHECK_CONSTRAINT_REFERENCES_OTHER_COLUMN, []string{MySQLDefaultSqlState}, "Column check constraint '%s' references other column."},
+	ErrCheckConstraintRefersAutoIncrementColumn:   {ER_CHECK_CONSTRAINT_REFERS_AUTO_INCREMENT_COLUMN, []string{MySQLDefaultSqlState}, "Check constraint '%s' cannot refer to an auto-increment column."},
+	ErrCheckConstraintDupName:                     {ER_CHECK_CONSTRAINT_DUP_NAME, []string{MySQLDefaultSqlState}, "Duplicate check constraint name '%s'."},
 
 	// Group 4: unexpected state or file io error
 	ErrInvalidState:                 {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "invalid state %s"},
@@ -263,6 +294,9 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrTableAlreadyExists:           {ER_TABLE_EXISTS_ERROR, []string{MySQLDefaultSqlState}, "table %s already exists"},
 	ErrFunctionAlreadyExists:        {ER_UDF_ALREADY_EXISTS, []string{MySQLDefaultSqlState}, "function %s already exists"},
 	ErrProcedureAlreadyExists:       {ER_UDF_ALREADY_EXISTS, []string{MySQLDefaultSqlState}, "procedure %s already exists"},
+	ErrTooManyConnections:           {ER_TOO_MANY_USER_CONNECTIONS, []string{"42000"}, "account %s already has more than 'max_connections' (%d) active connections"},
+	ErrQuotaExceeded:                {ER_USER_LIMIT_REACHED, []string{"42000"}, "account %s has exceeded the '%s' quota (limit: %d)"},
+	ErrColumnAccessDenied:           {ER_COLUMNACCESS_DENIED_ERROR, []string{"42000"}, "%s command denied for column '%s' in table '%s'"},
 	ErrDropNonExistsFunction:        {ER_CANT_FIND_UDF, []string{MySQLDefaultSqlState}, "function %s doesn't exist"},
 	ErrNoService:                    {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "service %s not found"},
 	ErrDupServiceName:               {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "duplicate service name %s"},
@@ -703,6 +737,18 @@ func NewNoSuchSequence(ctx context.Context, db, tbl string) *Error {
 	return newError(ctx, ErrNoSuchSequence, db, tbl)
 }
 
+func NewTooManyConnections(ctx context.Context, account string, limit int64) *Error {
+	return newError(ctx, ErrTooManyConnections, account, limit)
+}
+
+func NewQuotaExceeded(ctx context.Context, account, quota string, limit int64) *Error {
+	return newError(ctx, ErrQuotaExceeded, account, quota, limit)
+}
+
+func NewColumnAccessDenied(ctx context.Context, command, column, table string) *Error {
+	return newError(ctx, ErrColumnAccessDenied, command, column, table)
+}
+
 func NewBadView(ctx context.Context, db, v string) *Error {
 	return newError(ctx, ErrBadView, db, v)
 }
@@ -899,6 +945,54 @@ func NewWrongDatetimeSpec(ctx context.Context, val string) *Error {
 	return newError(ctx, ErrWrongDatetimeSpec, val)
 }
 
+func NewCTEMaxRecursionDepth(ctx context.Context, iterations int64) *Error {
+	return newError(ctx, ErrCTEMaxRecursionDepth, iterations)
+}
+
+func NewKeyDoesNotExist(ctx context.Context, key, table string) *Error {
+	return newError(ctx, ErrKeyDoesNotExist, key, table)
+}
+
+func NewGeneratedColumnFunctionNotAllowed(ctx context.Context, col string) *Error {
+	return newError(ctx, ErrGeneratedColumnFunctionNotAllowed, col)
+}
+
+func NewNonDefaultValueForGeneratedColumn(ctx context.Context, col, table string) *Error {
+	return newError(ctx, ErrNonDefaultValueForGeneratedColumn, col, table)
+}
+
+func NewUnsupportedActionOnGeneratedColumn(ctx context.Context, action string) *Error {
+	return newError(ctx, ErrUnsupportedActionOnGeneratedColumn, action)
+}
+
+func NewGeneratedColumnNonPrior(ctx context.Context) *Error {
+	return newError(ctx, ErrGeneratedColumnNonPrior)
+}
+
+func NewGeneratedColumnRefAutoInc(ctx context.Context, col string) *Error {
+	return newError(ctx, ErrGeneratedColumnRefAutoInc, col)
+}
+
+func NewCheckConstraintViolated(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCheckConstraintViolated, name)
+}
+
+func NewCheckConstraintFunctionNotAllowed(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCheckConstraintFunctionNotAllowed, name)
+}
+
+func NewColumnCheckConstraintReferencesOtherColumn(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrColumnCheckConstraintReferencesOtherColumn, name)
+}
+
+func NewCheckConstraintRefersAutoIncrementColumn(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCheckConstraintRefersAutoIncrementColumn, name)
+}
+
+func NewCheckConstraintDupName(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCheckConstraintDupName, name)
+}
+
 func NewRoleGrantedToSelf(ctx context.Context, from, to string) *Error {
 	return newError(ctx, ErrRoleGrantedToSelf, from, to)
 }
@@ -300,6 +300,14 @@ func NewProcedureAlreadyExistsNoCtx(f string) *Error {
 	return newError(Context(), ErrProcedureAlreadyExists, f)
 }
 
+func NewQuotaExceededNoCtx(account, quota string, limit int64) *Error {
+	return newError(Context(), ErrQuotaExceeded, account, quota, limit)
+}
+
+func NewColumnAccessDeniedNoCtx(command, column, table string) *Error {
+	return newError(Context(), ErrColumnAccessDenied, command, column, table)
+}
+
 func NewTxnNeedRetryNoCtx() *Error {
 	return newError(Context(), ErrTxnNeedRetry)
 }
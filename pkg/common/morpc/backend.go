@@ -16,6 +16,7 @@ package morpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"runtime"
 	"sync"
@@ -100,6 +101,13 @@ func WithBackendStreamBufferSize(value int) BackendOption {
 	}
 }
 
+// WithBackendTLS enable TLS with the tls config. See TLSConfig.NewClientTLSConfig.
+func WithBackendTLS(tlsConfig *tls.Config) BackendOption {
+	return func(rb *remoteBackend) {
+		rb.options.tlsConfig = tlsConfig
+	}
+}
+
 // WithBackendGoettyOptions set goetty connection options. e.g. set read/write buffer
 // size, adjust net.Conn attribute etc.
 func WithBackendGoettyOptions(options ...goetty.Option) BackendOption {
@@ -126,6 +134,7 @@ type remoteBackend struct {
 	options struct {
 		hasPayloadResponse bool
 		goettyOptions      []goetty.Option
+		tlsConfig          *tls.Config
 		connectTimeout     time.Duration
 		bufferSize         int
 		busySize           int
@@ -246,6 +255,10 @@ func (rb *remoteBackend) adjust() {
 	rb.options.goettyOptions = append(rb.options.goettyOptions,
 		goetty.WithSessionCodec(rb.codec),
 		goetty.WithSessionLogger(rb.logger))
+	if rb.options.tlsConfig != nil {
+		rb.options.goettyOptions = append(rb.options.goettyOptions,
+			goetty.WithSessionTLS(rb.options.tlsConfig))
+	}
 }
 
 func (rb *remoteBackend) Send(ctx context.Context, request Message) (*Future, error) {
@@ -57,6 +57,8 @@ type Config struct {
 	PayloadCopyBufferSize toml.ByteSize `toml:"payload-copy-buffer-size"`
 	// EnableCompress enable compress message
 	EnableCompress bool `toml:"enable-compress"`
+	// TLS mutual TLS config
+	TLS TLSConfig `toml:"tls"`
 
 	// BackendOptions extra backend options
 	BackendOptions []BackendOption `toml:"-"`
@@ -89,6 +91,7 @@ func (c *Config) Adjust() {
 	if c.PayloadCopyBufferSize == 0 {
 		c.PayloadCopyBufferSize = toml.ByteSize(defaultPayloadCopyBufferSize)
 	}
+	c.TLS.Adjust()
 }
 
 // NewClient create client from config
@@ -110,10 +113,19 @@ func (c Config) NewClient(
 		codecOpts = append(codecOpts, WithCodecEnableCompress(mp))
 	}
 
+	backendOpts := c.getBackendOptions(logger.Named(tag))
+	if c.TLS.Enable {
+		tlsConfig, err := c.TLS.NewClientTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		backendOpts = append(backendOpts, WithBackendTLS(tlsConfig))
+	}
+
 	codec := NewMessageCodec(
 		responseFactory,
 		codecOpts...)
-	bf := NewGoettyBasedBackendFactory(codec, c.getBackendOptions(logger.Named(tag))...)
+	bf := NewGoettyBasedBackendFactory(codec, backendOpts...)
 	return NewClient(bf, c.getClientOptions(tag, logger.Named(tag))...)
 }
 
@@ -138,6 +150,13 @@ func (c Config) NewServer(
 		}
 		codecOpts = append(codecOpts, WithCodecEnableCompress(mp))
 	}
+	if c.TLS.Enable {
+		tlsConfig, err := c.TLS.NewServerTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithServerTLS(tlsConfig))
+	}
 	opts = append(opts,
 		WithServerLogger(logger.Named(tag)),
 		WithServerGoettyOptions(goetty.WithSessionReleaseMsgFunc(func(v interface{}) {
@@ -16,6 +16,7 @@ package morpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"sync"
 	"time"
@@ -78,6 +79,13 @@ func WithServerDisableAutoCancelContext() ServerOption {
 	}
 }
 
+// WithServerTLS enable TLS with the tls config. See TLSConfig.NewServerTLSConfig.
+func WithServerTLS(tlsConfig *tls.Config) ServerOption {
+	return func(s *server) {
+		s.options.tlsConfig = tlsConfig
+	}
+}
+
 type server struct {
 	name        string
 	address     string
@@ -93,6 +101,7 @@ type server struct {
 		batchSendSize            int
 		filter                   func(Message) bool
 		disableAutoCancelContext bool
+		tlsConfig                *tls.Config
 	}
 	pool struct {
 		futures *sync.Pool
@@ -119,11 +128,17 @@ func NewRPCServer(name, address string, codec Codec, options ...ServerOption) (R
 		goetty.WithSessionCodec(codec),
 		goetty.WithSessionLogger(s.logger))
 
+	appOptions := []goetty.AppOption{
+		goetty.WithAppLogger(s.logger),
+		goetty.WithAppSessionOptions(s.options.goettyOptions...),
+	}
+	if s.options.tlsConfig != nil {
+		appOptions = append(appOptions, goetty.WithAppTLS(s.options.tlsConfig))
+	}
 	app, err := goetty.NewApplication(
 		s.address,
 		s.onMessage,
-		goetty.WithAppLogger(s.logger),
-		goetty.WithAppSessionOptions(s.options.goettyOptions...),
+		appOptions...,
 	)
 	if err != nil {
 		s.logger.Error("create rpc server failed",
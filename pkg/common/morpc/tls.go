@@ -0,0 +1,220 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package morpc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"go.uber.org/zap"
+)
+
+var (
+	defaultTLSReloadInterval = time.Minute
+)
+
+// TLSConfig mutual TLS config. Both the client and the server present the
+// certificate in CertFile, and verify the certificate of the peer with the
+// CA in CAFile. So the certificate should be valid for both the server auth
+// and the client auth.
+type TLSConfig struct {
+	// Enable enable mutual TLS. Default is false.
+	Enable bool `toml:"enable"`
+	// CAFile the CA certificates to verify the certificate of the peer
+	CAFile string `toml:"ca-file"`
+	// CertFile the certificate presented to the peer
+	CertFile string `toml:"cert-file"`
+	// KeyFile the private key of the certificate
+	KeyFile string `toml:"key-file"`
+	// ServerName the name to verify the certificate of the server. Default is
+	// the host of the address to connect.
+	ServerName string `toml:"server-name"`
+	// ReloadInterval the interval to check the changes of the files. The new
+	// connections use the new files after they are changed. Default is 1 min.
+	ReloadInterval toml.Duration `toml:"reload-interval"`
+}
+
+// Adjust adjust config, fill default value
+func (c *TLSConfig) Adjust() {
+	if c.ReloadInterval.Duration == 0 {
+		c.ReloadInterval.Duration = defaultTLSReloadInterval
+	}
+}
+
+// Validate validate config
+func (c TLSConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if c.CAFile == "" || c.CertFile == "" || c.KeyFile == "" {
+		return moerr.NewBadConfigNoCtx("ca-file, cert-file and key-file are required to enable TLS")
+	}
+	return nil
+}
+
+// NewServerTLSConfig returns the tls config of the server. The server requires and
+// verifies the certificate of the client.
+func (c TLSConfig) NewServerTLSConfig() (*tls.Config, error) {
+	r, err := newCertReloader(c)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.get()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				ClientCAs:    pool,
+			}, nil
+		},
+	}, nil
+}
+
+// NewClientTLSConfig returns the tls config of the client. The client presents its
+// certificate and verifies the certificate of the server.
+func (c TLSConfig) NewClientTLSConfig() (*tls.Config, error) {
+	r, err := newCertReloader(c)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+		// The CA can be reloaded, but RootCAs can not be changed after the config
+		// is used. So the certificate of the server is verified in VerifyConnection.
+		InsecureSkipVerify: true,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.get()
+			return cert, nil
+		},
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			_, pool := r.get()
+			return verifyServerCertificate(cs, pool)
+		},
+	}, nil
+}
+
+func verifyServerCertificate(cs tls.ConnectionState, pool *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return moerr.NewInternalErrorNoCtx("no certificate of the server")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         pool,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// certReloader loads the certificate and the CA, and reloads them if the files are changed.
+type certReloader struct {
+	cfg TLSConfig
+
+	mu struct {
+		sync.Mutex
+		lastCheck time.Time
+		modTimes  []time.Time
+		cert      *tls.Certificate
+		pool      *x509.CertPool
+	}
+}
+
+func newCertReloader(cfg TLSConfig) (*certReloader, error) {
+	cfg.Adjust()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	r := &certReloader{cfg: cfg}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) get() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.mu.lastCheck) >= r.cfg.ReloadInterval.Duration && r.changed() {
+		if err := r.load(); err != nil {
+			getLogger().Error("failed to reload the TLS files, use the old ones",
+				zap.String("cert-file", r.cfg.CertFile),
+				zap.Error(err))
+		} else {
+			getLogger().Info("TLS files reloaded",
+				zap.String("cert-file", r.cfg.CertFile))
+		}
+	}
+	return r.mu.cert, r.mu.pool
+}
+
+func (r *certReloader) files() []string {
+	return []string{r.cfg.CAFile, r.cfg.CertFile, r.cfg.KeyFile}
+}
+
+func (r *certReloader) changed() bool {
+	r.mu.lastCheck = time.Now()
+	for i, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return false
+		}
+		if !info.ModTime().Equal(r.mu.modTimes[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *certReloader) load() error {
+	var modTimes []time.Time
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(r.cfg.CAFile)
+	if err != nil {
+		return err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return moerr.NewBadConfigNoCtx("no CA certificate in %s", r.cfg.CAFile)
+	}
+
+	r.mu.lastCheck = time.Now()
+	r.mu.modTimes = modTimes
+	r.mu.cert = &cert
+	r.mu.pool = pool
+	return nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package morpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	file string
+}
+
+func newTestCA(t *testing.T, dir, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	file := filepath.Join(dir, name+".pem")
+	writePEM(t, file, "CERTIFICATE", der)
+	return &testCA{cert: cert, key: key, file: file}
+}
+
+// issue issues the certificate of the service for both the server auth and the client auth
+func (ca *testCA) issue(t *testing.T, dir, name string, serial int64) TLSConfig {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	cfg := TLSConfig{
+		Enable:     true,
+		CAFile:     ca.file,
+		CertFile:   filepath.Join(dir, name+"-cert.pem"),
+		KeyFile:    filepath.Join(dir, name+"-key.pem"),
+		ServerName: "localhost",
+	}
+	writePEM(t, cfg.CertFile, "CERTIFICATE", der)
+	writePEM(t, cfg.KeyFile, "EC PRIVATE KEY", keyDer)
+	return cfg
+}
+
+func writePEM(t *testing.T, file, typ string, der []byte) {
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600))
+}
+
+func testTLSRPC(t *testing.T, serverTLS, clientTLS TLSConfig, testFunc func(RPCClient)) {
+	require.NoError(t, os.RemoveAll(testUnixFile))
+	logger := logutil.GetPanicLoggerWithLevel(zap.InfoLevel)
+
+	cfg := Config{TLS: serverTLS}
+	cfg.Adjust()
+	s, err := cfg.NewServer("test", testAddr, logger,
+		func() Message { return &testMessage{} }, func(Message) {})
+	require.NoError(t, err)
+	s.RegisterRequestHandler(func(ctx context.Context, request Message, _ uint64, cs ClientSession) error {
+		return cs.Write(ctx, request)
+	})
+	require.NoError(t, s.Start())
+	defer func() {
+		assert.NoError(t, s.Close())
+	}()
+
+	cfg = Config{TLS: clientTLS}
+	cfg.Adjust()
+	c, err := cfg.NewClient("test", logger, func() Message { return &testMessage{} })
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, c.Close())
+	}()
+	testFunc(c)
+}
+
+func TestRPCWithTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	serverTLS := ca.issue(t, dir, "server", 2)
+	clientTLS := ca.issue(t, dir, "client", 3)
+
+	testTLSRPC(t, serverTLS, clientTLS, func(c RPCClient) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		req := newTestMessage(1)
+		f, err := c.Send(ctx, testAddr, req)
+		require.NoError(t, err)
+		defer f.Close()
+		resp, err := f.Get()
+		require.NoError(t, err)
+		assert.Equal(t, req, resp)
+	})
+}
+
+func TestRPCWithTLSRejectUntrustedCertificate(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	other := newTestCA(t, dir, "other")
+	serverTLS := ca.issue(t, dir, "server", 2)
+	// the client trusts the server, but its certificate is issued by the other CA
+	clientTLS := other.issue(t, dir, "client", 3)
+	clientTLS.CAFile = ca.file
+
+	testTLSRPC(t, serverTLS, clientTLS, func(c RPCClient) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		f, err := c.Send(ctx, testAddr, newTestMessage(1))
+		if err == nil {
+			defer f.Close()
+			_, err = f.Get()
+		}
+		assert.Error(t, err)
+	})
+}
+
+func TestTLSConfigValidate(t *testing.T) {
+	assert.NoError(t, TLSConfig{}.Validate())
+	assert.Error(t, TLSConfig{Enable: true, CertFile: "cert", KeyFile: "key"}.Validate())
+
+	c := TLSConfig{}
+	c.Adjust()
+	assert.Equal(t, defaultTLSReloadInterval, c.ReloadInterval.Duration)
+
+	_, err := TLSConfig{Enable: true, CAFile: "ca", CertFile: "cert", KeyFile: "key"}.NewServerTLSConfig()
+	assert.Error(t, err)
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "ca")
+	cfg := ca.issue(t, dir, "server", 2)
+	cfg.ReloadInterval = toml.Duration{Duration: time.Nanosecond}
+
+	r, err := newCertReloader(cfg)
+	require.NoError(t, err)
+	cert, _ := r.get()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), leaf.SerialNumber.Int64())
+
+	// rotate the certificate
+	ca.issue(t, dir, "server", 4)
+	modTime := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(cfg.CertFile, modTime, modTime))
+	cert, _ = r.get()
+	leaf, err = x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), leaf.SerialNumber.Int64())
+
+	// the broken files are ignored
+	require.NoError(t, os.WriteFile(cfg.KeyFile, []byte("broken"), 0600))
+	cert2, _ := r.get()
+	assert.Equal(t, cert, cert2)
+}
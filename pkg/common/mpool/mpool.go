@@ -311,6 +311,7 @@ type MPool struct {
 	noLock  bool
 	pools   [NumFixedPool]fixedPool
 	details *mpoolDetails
+	budget  atomic.Pointer[mpoolBudget]
 
 	// To remove: this thing is highly unlikely to be of any good use.
 	sels *sync.Pool
@@ -343,6 +344,35 @@ func (mp *MPool) Stats() *MPoolStats {
 	return &mp.stats
 }
 
+// mpoolBudget limits the bytes in use of the pool below the cap, the
+// allocation beyond the limit fails with the error of exceeded.
+type mpoolBudget struct {
+	limit    int64
+	exceeded func() error
+}
+
+// SetBudget limits the pool to allocate at most sz bytes more than the
+// bytes in use now, e.g. the memory quota of a query. The allocation beyond
+// the budget fails with the error returned by exceeded. sz 0 removes the
+// budget.
+func (mp *MPool) SetBudget(sz int64, exceeded func() error) {
+	if sz <= 0 {
+		mp.budget.Store(nil)
+		return
+	}
+	mp.budget.Store(&mpoolBudget{
+		limit:    mp.CurrNB() + sz,
+		exceeded: exceeded,
+	})
+}
+
+func (mp *MPool) checkBudget(curr int64) error {
+	if b := mp.budget.Load(); b != nil && curr > b.limit {
+		return b.exceeded()
+	}
+	return nil
+}
+
 func (mp *MPool) Cap() int64 {
 	if mp.cap == 0 {
 		return PB
@@ -517,6 +547,11 @@ func (mp *MPool) Alloc(sz int) ([]byte, error) {
 		mp.stats.RecordFree(mp.tag, int64(sz))
 		return nil, moerr.NewInternalErrorNoCtx("mpool out of space, alloc %d bytes, cap %d", sz, mp.cap)
 	}
+	if err := mp.checkBudget(mycurr); err != nil {
+		mp.stats.RecordFree(mp.tag, int64(sz))
+		globalStats.RecordFree("global", int64(sz))
+		return nil, err
+	}
 
 	if mp.details != nil {
 		mp.details.recordAlloc(int64(sz))
@@ -689,6 +724,11 @@ func (mp *MPool) Increase(nb int64) error {
 		mp.stats.RecordFree(mp.tag, nb)
 		return moerr.NewInternalErrorNoCtx("mpool out of space, alloc %d bytes, cap %d", nb, mp.cap)
 	}
+	if err := mp.checkBudget(mycurr); err != nil {
+		mp.stats.RecordFree(mp.tag, nb)
+		globalStats.RecordFree("global", nb)
+		return err
+	}
 	return nil
 }
 
@@ -18,6 +18,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/stretchr/testify/require"
 )
 
@@ -114,3 +115,28 @@ func TestMP(t *testing.T) {
 	wg.Wait()
 
 }
+
+func TestMPoolBudget(t *testing.T) {
+	m, err := NewMPool("test-mpool-budget", 0, NoFixed)
+	require.NoError(t, err)
+
+	a, err := m.Alloc(100)
+	require.NoError(t, err)
+	defer m.Free(a)
+
+	m.SetBudget(1000, func() error {
+		return moerr.NewQuotaExceededNoCtx("acc", "query_memory", 1000)
+	})
+	b, err := m.Alloc(1000)
+	require.NoError(t, err)
+	_, err = m.Alloc(1)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQuotaExceeded))
+	require.True(t, moerr.IsMoErrCode(m.Increase(1), moerr.ErrQuotaExceeded))
+	require.Equal(t, int64(1100), m.CurrNB())
+	m.Free(b)
+
+	m.SetBudget(0, nil)
+	b, err = m.Alloc(2000)
+	require.NoError(t, err)
+	m.Free(b)
+}
@@ -34,6 +34,9 @@ const (
 	TxnMode = "txn-mode"
 	// TxnIsolation runtime default txn isolation
 	TxnIsolation = "txn-isolation"
+	// HistoryRetention how long the DN keeps the history data for the reads at past
+	// timestamps, a time.Duration refreshed by the CN
+	HistoryRetention = "history-retention"
 )
 
 // Runtime contains the runtime environment for a MO service. Each CN/DN/LOG service
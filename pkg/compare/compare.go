@@ -35,7 +35,7 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 			return newCompare(genericDescCompare[int8], genericCopy[int8], nullsLast)
 		}
 		return newCompare(genericAscCompare[int8], genericCopy[int8], nullsLast)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		if desc {
 			return newCompare(genericDescCompare[int16], genericCopy[int16], nullsLast)
 		}
@@ -55,7 +55,7 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 			return newCompare(genericDescCompare[uint8], genericCopy[uint8], nullsLast)
 		}
 		return newCompare(genericAscCompare[uint8], genericCopy[uint8], nullsLast)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if desc {
 			return newCompare(genericDescCompare[uint16], genericCopy[uint16], nullsLast)
 		}
@@ -65,7 +65,7 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 			return newCompare(genericDescCompare[uint32], genericCopy[uint32], nullsLast)
 		}
 		return newCompare(genericAscCompare[uint32], genericCopy[uint32], nullsLast)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if desc {
 			return newCompare(genericDescCompare[uint64], genericCopy[uint64], nullsLast)
 		}
@@ -131,7 +131,7 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 		}
 		return newCompare(uuidAscCompare, uuidCopy, nullsLast)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_text, types.T_vecf32, types.T_vecf64:
 		return &strCompare{
 			desc:        desc,
 			nullsLast:   nullsLast,
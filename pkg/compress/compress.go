@@ -15,15 +15,74 @@
 package compress
 
 import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/pierrec/lz4/v4"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+var (
+	// the encoders and the decoder are safe for concurrent use by EncodeAll and DecodeAll
+	zstdEncoders sync.Map // level -> *zstd.Encoder
+	// the decoder starts its goroutines when created, so it is created at the first use
+	// instead of the package initialization.
+	zstdDecoder struct {
+		once sync.Once
+		dec  *zstd.Decoder
+		err  error
+	}
+)
+
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoder.once.Do(func() {
+		zstdDecoder.dec, zstdDecoder.err = zstd.NewReader(nil)
+	})
+	return zstdDecoder.dec, zstdDecoder.err
+}
+
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	if v, ok := zstdEncoders.Load(level); ok {
+		return v.(*zstd.Encoder), nil
+	}
+	enc, err := zstd.NewWriter(nil,
+		zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	v, _ := zstdEncoders.LoadOrStore(level, enc)
+	return v.(*zstd.Encoder), nil
+}
+
+// CompressBlockBound returns the size of dst needed to compress the src of size n.
+func CompressBlockBound(n int, typ int) int {
+	switch typ {
+	case Snappy:
+		return snappy.MaxEncodedLen(n)
+	case Zstd:
+		// the worst case of zstd is the raw blocks plus the frame and block headers
+		return n + n>>7 + 64
+	}
+	return lz4.CompressBlockBound(n)
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressWithLevel(src, dst, typ, DefaultZstdLevel)
+}
+
+// CompressWithLevel is the same as Compress, the level is only used by zstd.
+func CompressWithLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,8 +90,16 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		enc, err := getZstdEncoder(level)
+		if err != nil {
+			return nil, err
+		}
+		return enc.EncodeAll(src, dst[:0]), nil
+	case Snappy:
+		return snappy.Encode(dst, src), nil
 	}
-	return nil, nil
+	return nil, moerr.NewNotSupportedNoCtx("compress with the algorithm %d", typ)
 }
 
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
@@ -43,6 +110,43 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		dec, err := getZstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		return dec.DecodeAll(src, dst[:0])
+	case Snappy:
+		return snappy.Decode(dst, src)
+	}
+	return nil, moerr.NewNotSupportedNoCtx("decompress with the algorithm %d", typ)
+}
+
+// ParseCompression parses the compression option of the table, which is the name of the
+// algorithm optionally followed by the level of zstd, e.g. "zstd:9". The empty option is lz4.
+func ParseCompression(option string) (T, int, error) {
+	option = strings.ToLower(strings.TrimSpace(option))
+	if option == "" {
+		return Lz4, 0, nil
+	}
+	name, levelStr, hasLevel := strings.Cut(option, ":")
+	typ, ok := Algorithms[name]
+	if !ok {
+		return None, 0, moerr.NewInvalidInputNoCtx("unsupported compression '%s'", option)
+	}
+	if typ != Zstd {
+		if hasLevel {
+			return None, 0, moerr.NewInvalidInputNoCtx("the compression '%s' has no level", name)
+		}
+		return T(typ), 0, nil
+	}
+	if !hasLevel {
+		return Zstd, DefaultZstdLevel, nil
+	}
+	level, err := strconv.Atoi(levelStr)
+	if err != nil || level < MinZstdLevel || level > MaxZstdLevel {
+		return None, 0, moerr.NewInvalidInputNoCtx("the level of zstd should be between %d and %d, but got '%s'",
+			MinZstdLevel, MaxZstdLevel, levelStr)
 	}
-	return nil, nil
+	return Zstd, level, nil
 }
@@ -19,9 +19,11 @@ import (
 	"log"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +43,62 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestCompressAlgorithms(t *testing.T) {
+	raw := make([]byte, 0, 64<<10)
+	for i := 0; len(raw) < cap(raw); i++ {
+		raw = append(raw, []byte(fmt.Sprintf("row-%d,", i%1000))...)
+	}
+	for _, typ := range []int{Lz4, Zstd, Snappy} {
+		buf := make([]byte, CompressBlockBound(len(raw), typ))
+		compressed, err := Compress(raw, buf, typ)
+		require.NoError(t, err)
+		require.Less(t, len(compressed), len(raw), T(typ).String())
+		data, err := Decompress(compressed, make([]byte, len(raw)), typ)
+		require.NoError(t, err)
+		require.Equal(t, raw, data, T(typ).String())
+	}
+
+	fast, err := CompressWithLevel(raw, nil, Zstd, MinZstdLevel)
+	require.NoError(t, err)
+	best, err := CompressWithLevel(raw, nil, Zstd, MaxZstdLevel)
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(best), len(fast))
+	data, err := Decompress(best, nil, Zstd)
+	require.NoError(t, err)
+	require.Equal(t, raw, data)
+
+	// an unknown algorithm is an error instead of the empty data
+	for _, typ := range []int{None, Snappy + 1} {
+		_, err = Compress(raw, nil, typ)
+		require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported), T(typ).String())
+		_, err = Decompress(raw, nil, typ)
+		require.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported), T(typ).String())
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	cases := []struct {
+		option string
+		typ    T
+		level  int
+	}{
+		{"", Lz4, 0},
+		{"none", None, 0},
+		{"LZ4", Lz4, 0},
+		{"snappy", Snappy, 0},
+		{"zstd", Zstd, DefaultZstdLevel},
+		{"zstd:9", Zstd, 9},
+	}
+	for _, c := range cases {
+		typ, level, err := ParseCompression(c.option)
+		require.NoError(t, err, c.option)
+		require.Equal(t, c.typ, typ, c.option)
+		require.Equal(t, c.level, level, c.option)
+	}
+
+	for _, option := range []string{"zlib", "lz4:1", "zstd:0", "zstd:23", "zstd:x"} {
+		_, _, err := ParseCompression(option)
+		require.Error(t, err, option)
+	}
+}
@@ -19,6 +19,15 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
+	Snappy
+)
+
+const (
+	// DefaultZstdLevel is the level of zstd used if no level is specified.
+	DefaultZstdLevel = 3
+	MinZstdLevel     = 1
+	MaxZstdLevel     = 22
 )
 
 type T uint8
@@ -29,6 +38,10 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
+	case Snappy:
+		return "SNAPPY"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
@@ -51,6 +51,12 @@ var (
 	//port defines which port the mo-server listens on and clients connect to
 	defaultPort = 6001
 
+	// defaultPgAuthMethod is the password authentication of the postgresql wire protocol
+	defaultPgAuthMethod = "scram-sha-256"
+
+	// defaultAuthenticationPlugin hashes the passwords of the new users
+	defaultAuthenticationPlugin = "mysql_native_password"
+
 	//listening ip
 	defaultHost = "0.0.0.0"
 
@@ -142,6 +148,12 @@ var (
 	// defaultMergedExtension default: tae. Support val in [csv, tae]
 	defaultMergedExtension = "tae"
 
+	// defaultAuditLogSink default: table. Support val in [table, file]
+	defaultAuditLogSink = "table"
+
+	// defaultAuditLogDir default: ./store/audit
+	defaultAuditLogDir = "./store/audit"
+
 	// defaultOBShowStatsInterval default: 1min
 	defaultOBShowStatsInterval = time.Minute
 
@@ -181,6 +193,23 @@ type FrontendParameters struct {
 	// UnixSocketAddress listening unix domain socket
 	UnixSocketAddress string `toml:"unix-socket"`
 
+	//pgPort defines which port the postgresql wire protocol listens on. default is 0, disabled
+	PgPort int64 `toml:"pgPort"`
+
+	//pgAuthMethod is the password authentication of the postgresql wire protocol.
+	//scram-sha-256, md5 or password. default is scram-sha-256. the cleartext password
+	//is only accepted over TLS
+	PgAuthMethod string `toml:"pgAuthMethod"`
+
+	//defaultAuthenticationPlugin is the authentication plugin for the new passwords.
+	//caching_sha2_password or mysql_native_password. default is mysql_native_password
+	DefaultAuthenticationPlugin string `toml:"defaultAuthenticationPlugin"`
+
+	//rsaPrivateKeyPath is the PEM file of the RSA private key, which encrypts the password
+	//of caching_sha2_password and sha256_password without TLS. It is required if the proxy is
+	//enabled, the servers behind the proxy share the key. default is empty, a key is generated at startup
+	RsaPrivateKeyPath string `toml:"rsaPrivateKeyPath"`
+
 	//guest mmu limitation. default: 1 << 40 = 1099511627776
 	GuestMmuLimitation int64 `toml:"guestMmuLimitation"`
 
@@ -202,6 +231,9 @@ type FrontendParameters struct {
 	//process.Limitation.PartitionRows. default: 10 << 32 = 42949672960
 	ProcessLimitationPartitionRows int64 `toml:"processLimitationPartitionRows"`
 
+	//process.Limitation.SpillSize. default: 0, never spill
+	ProcessLimitationSpillSize int64 `toml:"processLimitationSpillSize"`
+
 	//the root directory of the storage and matrixcube's data. The actual dir is cubeDirPrefix + nodeID
 	StorePath string `toml:"storePath"`
 
@@ -330,6 +362,14 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.UnixSocketAddress = defaultUnixAddr
 	}
 
+	if fp.PgAuthMethod == "" {
+		fp.PgAuthMethod = defaultPgAuthMethod
+	}
+
+	if fp.DefaultAuthenticationPlugin == "" {
+		fp.DefaultAuthenticationPlugin = defaultAuthenticationPlugin
+	}
+
 	if fp.GuestMmuLimitation == 0 {
 		fp.GuestMmuLimitation = int64(toml.ByteSize(defaultGuestMmuLimitation))
 	}
@@ -553,6 +593,13 @@ type ObservabilityParameters struct {
 	// MergedExtension default: tae. Support val in [csv, tae]
 	MergedExtension string `toml:"mergedExtension"`
 
+	// AuditLogSink default: table. Support val in [table, file].
+	// With table, the audit log is written into system.system_audit. With file, into the csv files in AuditLogDir.
+	AuditLogSink string `toml:"auditLogSink"`
+
+	// AuditLogDir default: ./store/audit. The local dir of the audit log files.
+	AuditLogDir string `toml:"auditLogDir"`
+
 	OBCollectorConfig
 }
 
@@ -608,6 +655,14 @@ func (op *ObservabilityParameters) SetDefaultValues(version string) {
 	if op.MergedExtension == "" {
 		op.MergedExtension = defaultMergedExtension
 	}
+
+	if op.AuditLogSink == "" {
+		op.AuditLogSink = defaultAuditLogSink
+	}
+
+	if op.AuditLogDir == "" {
+		op.AuditLogDir = defaultAuditLogDir
+	}
 }
 
 type OBCollectorConfig struct {
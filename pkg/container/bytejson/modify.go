@@ -0,0 +1,486 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// ModifyType is how JSON_SET, JSON_INSERT and JSON_REPLACE change a document.
+type ModifyType byte
+
+const (
+	// ModifySet replaces the existing values and adds the missing ones.
+	ModifySet ModifyType = iota + 1
+	// ModifyInsert only adds the missing values.
+	ModifyInsert
+	// ModifyReplace only replaces the existing values.
+	ModifyReplace
+)
+
+// CreateArray returns a json array of the elements.
+func CreateArray(elems []ByteJson) ByteJson {
+	return *mergeToArray(elems)
+}
+
+// CreateObject returns a json object of the keys and the values, the last
+// value of a duplicate key wins like MySQL.
+func CreateObject(keys []string, vals []ByteJson) (ByteJson, error) {
+	obj := make(map[string]interface{}, len(keys))
+	for i, key := range keys {
+		obj[key] = vals[i]
+	}
+	return buildObject(obj)
+}
+
+// ConcatArrays returns a json array of the elements of the arrays, the values
+// which are not arrays are taken as elements.
+func ConcatArrays(arrs []ByteJson) ByteJson {
+	elems := make([]ByteJson, 0, len(arrs))
+	for _, arr := range arrs {
+		if arr.Type == TpCodeArray {
+			elems = append(elems, arr.arrayElems()...)
+		} else {
+			elems = append(elems, arr)
+		}
+	}
+	return CreateArray(elems)
+}
+
+// ConcatObjects returns a json object of the members of the objects, the member
+// of the later object wins if the key is duplicate.
+func ConcatObjects(objs []ByteJson) (ByteJson, error) {
+	obj := make(map[string]interface{})
+	for _, o := range objs {
+		if o.Type != TpCodeObject {
+			return ByteJson{}, moerr.NewInvalidInputNoCtx("json value is not an object")
+		}
+		for i, cnt := 0, o.GetElemCnt(); i < cnt; i++ {
+			obj[string(o.getObjectKey(i))] = o.getObjectVal(i)
+		}
+	}
+	return buildObject(obj)
+}
+
+func buildObject(obj map[string]interface{}) (ByteJson, error) {
+	buf, err := addObject(make([]byte, 0, 64), obj)
+	if err != nil {
+		return ByteJson{}, err
+	}
+	return ByteJson{Type: TpCodeObject, Data: buf}, nil
+}
+
+// objectEntries returns the members of the object by their keys.
+func (bj ByteJson) objectEntries() map[string]interface{} {
+	cnt := bj.GetElemCnt()
+	obj := make(map[string]interface{}, cnt+1)
+	for i := 0; i < cnt; i++ {
+		obj[string(bj.getObjectKey(i))] = bj.getObjectVal(i)
+	}
+	return obj
+}
+
+func (bj ByteJson) arrayElems() []ByteJson {
+	cnt := bj.GetElemCnt()
+	elems := make([]ByteJson, cnt, cnt+1)
+	for i := 0; i < cnt; i++ {
+		elems[i] = bj.getArrayElem(i)
+	}
+	return elems
+}
+
+func (bj ByteJson) objectKeyIndex(key []byte) (int, bool) {
+	cnt := bj.GetElemCnt()
+	idx := sort.Search(cnt, func(i int) bool {
+		return bytes.Compare(bj.getObjectKey(i), key) >= 0
+	})
+	return idx, idx < cnt && bytes.Equal(bj.getObjectKey(idx), key)
+}
+
+// checkModifyPath checks that the path refers to a single value.
+func checkModifyPath(path *Path) error {
+	if path.flag != 0 {
+		return moerr.NewInvalidInputNoCtx("in this situation, path expressions may not contain the * and ** tokens")
+	}
+	for _, sub := range path.paths {
+		if sub.tp == subPathRange {
+			return moerr.NewInvalidInputNoCtx("in this situation, path expressions may not contain the range token")
+		}
+	}
+	return nil
+}
+
+// Lookup returns the value at the path, which must not contain wildcards, and
+// false if there is no such value.
+func (bj ByteJson) Lookup(path *Path) (ByteJson, bool, error) {
+	if err := checkModifyPath(path); err != nil {
+		return ByteJson{}, false, err
+	}
+	for p := *path; !p.empty(); {
+		sub, next := p.step()
+		switch sub.tp {
+		case subPathKey:
+			if bj.Type != TpCodeObject {
+				return ByteJson{}, false, nil
+			}
+			idx, ok := bj.objectKeyIndex(string2Slice(sub.key))
+			if !ok {
+				return ByteJson{}, false, nil
+			}
+			bj = bj.getObjectVal(idx)
+		case subPathIdx:
+			cnt := 1
+			if bj.Type == TpCodeArray {
+				cnt = bj.GetElemCnt()
+			}
+			idx, _, _ := sub.idx.genIndex(cnt)
+			if idx < 0 || idx >= cnt {
+				return ByteJson{}, false, nil
+			}
+			// a value which is not an array is treated as an array of itself
+			if bj.Type == TpCodeArray {
+				bj = bj.getArrayElem(idx)
+			}
+		}
+		p = next
+	}
+	return bj, true, nil
+}
+
+// Modify returns the document with the values at the paths changed to vals in
+// the way of tp, the paths are applied in order like MySQL.
+func (bj ByteJson) Modify(paths []*Path, vals []ByteJson, tp ModifyType) (ByteJson, error) {
+	var err error
+	for i, path := range paths {
+		if err = checkModifyPath(path); err != nil {
+			return ByteJson{}, err
+		}
+		if bj, err = bj.modify(path, vals[i], tp); err != nil {
+			return ByteJson{}, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) modify(path *Path, val ByteJson, tp ModifyType) (ByteJson, error) {
+	if path.empty() {
+		if tp == ModifyInsert {
+			return bj, nil
+		}
+		return val, nil
+	}
+
+	sub, next := path.step()
+	switch sub.tp {
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return bj, nil
+		}
+		idx, ok := bj.objectKeyIndex(string2Slice(sub.key))
+		if ok {
+			child, err := bj.getObjectVal(idx).modify(&next, val, tp)
+			if err != nil {
+				return ByteJson{}, err
+			}
+			obj := bj.objectEntries()
+			obj[sub.key] = child
+			return buildObject(obj)
+		}
+		if !next.empty() || tp == ModifyReplace {
+			return bj, nil
+		}
+		obj := bj.objectEntries()
+		obj[sub.key] = val
+		return buildObject(obj)
+
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			// a value which is not an array is treated as an array of itself
+			idx, _, _ := sub.idx.genIndex(1)
+			if idx == 0 {
+				return bj.modify(&next, val, tp)
+			}
+			if idx < 0 || !next.empty() || tp == ModifyReplace {
+				return bj, nil
+			}
+			return CreateArray([]ByteJson{bj, val}), nil
+		}
+		cnt := bj.GetElemCnt()
+		idx, _, _ := sub.idx.genIndex(cnt)
+		if idx < 0 {
+			return bj, nil
+		}
+		if idx < cnt {
+			child, err := bj.getArrayElem(idx).modify(&next, val, tp)
+			if err != nil {
+				return ByteJson{}, err
+			}
+			elems := bj.arrayElems()
+			elems[idx] = child
+			return CreateArray(elems), nil
+		}
+		if !next.empty() || tp == ModifyReplace {
+			return bj, nil
+		}
+		return CreateArray(append(bj.arrayElems(), val)), nil
+	}
+	return bj, nil
+}
+
+// Remove returns the document with the values at the paths removed, the paths
+// are applied in order like MySQL.
+func (bj ByteJson) Remove(paths []*Path) (ByteJson, error) {
+	var err error
+	for _, path := range paths {
+		if err = checkModifyPath(path); err != nil {
+			return ByteJson{}, err
+		}
+		if path.empty() {
+			return ByteJson{}, moerr.NewInvalidInputNoCtx("the path expression '$' is not allowed in this context")
+		}
+		if bj, err = bj.remove(path); err != nil {
+			return ByteJson{}, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) remove(path *Path) (ByteJson, error) {
+	sub, next := path.step()
+	switch sub.tp {
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return bj, nil
+		}
+		idx, ok := bj.objectKeyIndex(string2Slice(sub.key))
+		if !ok {
+			return bj, nil
+		}
+		obj := bj.objectEntries()
+		if next.empty() {
+			delete(obj, sub.key)
+			return buildObject(obj)
+		}
+		child, err := bj.getObjectVal(idx).remove(&next)
+		if err != nil {
+			return ByteJson{}, err
+		}
+		obj[sub.key] = child
+		return buildObject(obj)
+
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			return bj, nil
+		}
+		cnt := bj.GetElemCnt()
+		idx, _, _ := sub.idx.genIndex(cnt)
+		if idx < 0 || idx >= cnt {
+			return bj, nil
+		}
+		elems := bj.arrayElems()
+		if next.empty() {
+			return CreateArray(append(elems[:idx], elems[idx+1:]...)), nil
+		}
+		child, err := elems[idx].remove(&next)
+		if err != nil {
+			return ByteJson{}, err
+		}
+		elems[idx] = child
+		return CreateArray(elems), nil
+	}
+	return bj, nil
+}
+
+// MergePatch merges the documents in order as RFC 7396 like JSON_MERGE_PATCH.
+func MergePatch(docs []ByteJson) (ByteJson, error) {
+	var err error
+	target := docs[0]
+	for _, patch := range docs[1:] {
+		if target, err = mergePatch(target, patch); err != nil {
+			return ByteJson{}, err
+		}
+	}
+	return target, nil
+}
+
+func mergePatch(target, patch ByteJson) (ByteJson, error) {
+	if patch.Type != TpCodeObject {
+		return patch, nil
+	}
+	var obj map[string]interface{}
+	if target.Type == TpCodeObject {
+		obj = target.objectEntries()
+	} else {
+		obj = make(map[string]interface{}, patch.GetElemCnt())
+	}
+	for i := 0; i < patch.GetElemCnt(); i++ {
+		key := string(patch.getObjectKey(i))
+		val := patch.getObjectVal(i)
+		if val.IsNull() {
+			delete(obj, key)
+			continue
+		}
+		old, ok := obj[key].(ByteJson)
+		if !ok {
+			old = Null
+		}
+		merged, err := mergePatch(old, val)
+		if err != nil {
+			return ByteJson{}, err
+		}
+		obj[key] = merged
+	}
+	return buildObject(obj)
+}
+
+// Contains returns true if the candidate is contained in the document like
+// JSON_CONTAINS: a scalar contains an equal scalar, an object contains an
+// object whose members are all contained in its members of the same keys, and
+// an array contains a value contained in one of its elements, or an array
+// whose elements are all contained in it.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		for i := 0; i < candidate.GetElemCnt(); i++ {
+			idx, ok := bj.objectKeyIndex(candidate.getObjectKey(i))
+			if !ok || !bj.getObjectVal(idx).Contains(candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			for i := 0; i < candidate.GetElemCnt(); i++ {
+				if !bj.Contains(candidate.getArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i := 0; i < bj.GetElemCnt(); i++ {
+			if bj.getArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return scalarEqual(bj, candidate)
+}
+
+func scalarEqual(a, b ByteJson) bool {
+	switch a.Type {
+	case TpCodeInt64, TpCodeUint64, TpCodeFloat64:
+		switch b.Type {
+		case TpCodeInt64, TpCodeUint64, TpCodeFloat64:
+			return compareNumber(a, b) == 0
+		}
+		return false
+	case TpCodeString:
+		return b.Type == TpCodeString && bytes.Equal(a.GetString(), b.GetString())
+	case TpCodeLiteral:
+		return b.Type == TpCodeLiteral && a.Data[0] == b.Data[0]
+	}
+	return false
+}
+
+func compareNumber(a, b ByteJson) int {
+	if a.Type == b.Type {
+		switch a.Type {
+		case TpCodeInt64:
+			return compare(a.GetInt64(), b.GetInt64())
+		case TpCodeUint64:
+			return compare(a.GetUint64(), b.GetUint64())
+		}
+	}
+	if a.Type == TpCodeInt64 && b.Type == TpCodeUint64 {
+		if a.GetInt64() < 0 {
+			return -1
+		}
+		return compare(uint64(a.GetInt64()), b.GetUint64())
+	}
+	if a.Type == TpCodeUint64 && b.Type == TpCodeInt64 {
+		return -compareNumber(b, a)
+	}
+	return compare(a.numberAsFloat64(), b.numberAsFloat64())
+}
+
+func (bj ByteJson) numberAsFloat64() float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	}
+	return bj.GetFloat64()
+}
+
+func compare[T int64 | uint64 | float64](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// Keys returns the keys of the object as a json array.
+func (bj ByteJson) Keys() ByteJson {
+	cnt := bj.GetElemCnt()
+	keys := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = ByteJson{Type: TpCodeString, Data: addString(nil, string(bj.getObjectKey(i)))}
+	}
+	return CreateArray(keys)
+}
+
+// Length returns the number of the elements of an array or the members of an
+// object, or 1 for a scalar like JSON_LENGTH.
+func (bj ByteJson) Length() int64 {
+	if bj.Type == TpCodeArray || bj.Type == TpCodeObject {
+		return int64(bj.GetElemCnt())
+	}
+	return 1
+}
+
+// TypeName returns the name of the type of the value like JSON_TYPE.
+func (bj ByteJson) TypeName() string {
+	switch bj.Type {
+	case TpCodeObject:
+		return "OBJECT"
+	case TpCodeArray:
+		return "ARRAY"
+	case TpCodeInt64:
+		return "INTEGER"
+	case TpCodeUint64:
+		return "UNSIGNED INTEGER"
+	case TpCodeFloat64:
+		return "DOUBLE"
+	case TpCodeString:
+		return "STRING"
+	case TpCodeLiteral:
+		if bj.Data[0] == LiteralNull {
+			return "NULL"
+		}
+		return "BOOLEAN"
+	}
+	return "UNKNOWN"
+}
@@ -0,0 +1,226 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, s string) ByteJson {
+	bj, err := ParseFromString(s)
+	require.NoError(t, err)
+	return bj
+}
+
+func mustParsePaths(t *testing.T, strs ...string) []*Path {
+	paths := make([]*Path, len(strs))
+	for i, s := range strs {
+		p, err := ParseJsonPath(s)
+		require.NoError(t, err)
+		paths[i] = &p
+	}
+	return paths
+}
+
+func TestModify(t *testing.T) {
+	doc := `{"a": 1, "b": [2, 3, {"c": 4}]}`
+	kases := []struct {
+		tp   ModifyType
+		path string
+		val  string
+		want string
+	}{
+		{ModifySet, "$.a", "10", `{"a": 10, "b": [2, 3, {"c": 4}]}`},
+		{ModifySet, "$.d", `"x"`, `{"a": 1, "b": [2, 3, {"c": 4}], "d": "x"}`},
+		{ModifySet, "$.b[2].c", "null", `{"a": 1, "b": [2, 3, {"c": null}]}`},
+		{ModifySet, "$.b[5]", "true", `{"a": 1, "b": [2, 3, {"c": 4}, true]}`},
+		{ModifySet, "$.b[last]", "5", `{"a": 1, "b": [2, 3, 5]}`},
+		{ModifySet, "$.a[1]", "2", `{"a": [1, 2], "b": [2, 3, {"c": 4}]}`},
+		{ModifySet, "$.x.y", "2", doc},
+		{ModifySet, "$", "[]", `[]`},
+		{ModifyInsert, "$.a", "10", doc},
+		{ModifyInsert, "$.d", "[1]", `{"a": 1, "b": [2, 3, {"c": 4}], "d": [1]}`},
+		{ModifyInsert, "$.b[0]", "10", doc},
+		{ModifyInsert, "$.b[3]", "10", `{"a": 1, "b": [2, 3, {"c": 4}, 10]}`},
+		{ModifyReplace, "$.a", "1.5", `{"a": 1.5, "b": [2, 3, {"c": 4}]}`},
+		{ModifyReplace, "$.d", "10", doc},
+		{ModifyReplace, "$.b[3]", "10", doc},
+		{ModifyReplace, "$.a[0]", `{"e": 1}`, `{"a": {"e": 1}, "b": [2, 3, {"c": 4}]}`},
+	}
+	for _, kase := range kases {
+		out, err := mustParse(t, doc).Modify(mustParsePaths(t, kase.path), []ByteJson{mustParse(t, kase.val)}, kase.tp)
+		require.NoError(t, err)
+		require.Equal(t, mustParse(t, kase.want).String(), out.String(), "path: %s", kase.path)
+	}
+
+	// the paths are applied in order
+	out, err := mustParse(t, `[1]`).Modify(mustParsePaths(t, "$[1]", "$[2]"),
+		[]ByteJson{mustParse(t, "2"), mustParse(t, "3")}, ModifyInsert)
+	require.NoError(t, err)
+	require.Equal(t, "[1, 2, 3]", out.String())
+
+	_, err = mustParse(t, doc).Modify(mustParsePaths(t, "$.*"), []ByteJson{Null}, ModifySet)
+	require.Error(t, err)
+	_, err = mustParse(t, doc).Modify(mustParsePaths(t, "$**.c"), []ByteJson{Null}, ModifySet)
+	require.Error(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	doc := `{"a": 1, "b": [2, 3, {"c": 4}]}`
+	kases := []struct {
+		paths []string
+		want  string
+	}{
+		{[]string{"$.a"}, `{"b": [2, 3, {"c": 4}]}`},
+		{[]string{"$.b[0]"}, `{"a": 1, "b": [3, {"c": 4}]}`},
+		{[]string{"$.b[0]", "$.b[0]"}, `{"a": 1, "b": [{"c": 4}]}`},
+		{[]string{"$.b[last].c"}, `{"a": 1, "b": [2, 3, {}]}`},
+		{[]string{"$.x", "$.b[10]", "$.a.b"}, doc},
+	}
+	for _, kase := range kases {
+		out, err := mustParse(t, doc).Remove(mustParsePaths(t, kase.paths...))
+		require.NoError(t, err)
+		require.Equal(t, mustParse(t, kase.want).String(), out.String(), "paths: %v", kase.paths)
+	}
+
+	_, err := mustParse(t, doc).Remove(mustParsePaths(t, "$"))
+	require.Error(t, err)
+	_, err = mustParse(t, doc).Remove(mustParsePaths(t, "$.b[*]"))
+	require.Error(t, err)
+}
+
+func TestCreate(t *testing.T) {
+	arr := CreateArray([]ByteJson{mustParse(t, "1"), Null, mustParse(t, `"a"`), mustParse(t, `{"b": [true]}`)})
+	require.Equal(t, `[1, null, "a", {"b": [true]}]`, arr.String())
+	require.Equal(t, "[]", CreateArray(nil).String())
+
+	obj, err := CreateObject([]string{"b", "a", "b"}, []ByteJson{mustParse(t, "1"), arr, mustParse(t, "2.5")})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": [1, null, "a", {"b": [true]}], "b": 2.5}`, obj.String())
+	// the result is the same as the parsed text
+	require.Equal(t, mustParse(t, obj.String()), obj)
+
+	arr = ConcatArrays([]ByteJson{mustParse(t, "[1, 2]"), mustParse(t, "[]"), mustParse(t, `[{"a": 3}]`), mustParse(t, "4")})
+	require.Equal(t, `[1, 2, {"a": 3}, 4]`, arr.String())
+	obj, err = ConcatObjects([]ByteJson{mustParse(t, `{"a": 1, "b": 2}`), mustParse(t, `{"a": [3]}`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": [3], "b": 2}`, obj.String())
+	_, err = ConcatObjects([]ByteJson{mustParse(t, "[1]")})
+	require.Error(t, err)
+
+	var bj ByteJson
+	require.NoError(t, bj.UnmarshalObject(1.5))
+	require.Equal(t, TpCodeFloat64, bj.Type)
+	require.Equal(t, "1.5", bj.String())
+}
+
+func TestLookup(t *testing.T) {
+	doc := mustParse(t, `{"a": null, "b": [2, {"c": 4}], "d": 5}`)
+	kases := []struct {
+		path string
+		want string
+	}{
+		{"$.a", "null"},
+		{"$.b[1].c", "4"},
+		{"$.b[last]", `{"c": 4}`},
+		{"$.d[0]", "5"},
+		{"$.x", ""},
+		{"$.b[2]", ""},
+		{"$.d[1]", ""},
+	}
+	for _, kase := range kases {
+		out, ok, err := doc.Lookup(mustParsePaths(t, kase.path)[0])
+		require.NoError(t, err)
+		require.Equal(t, kase.want != "", ok, "path: %s", kase.path)
+		if ok {
+			require.Equal(t, kase.want, out.String())
+		}
+	}
+	_, _, err := doc.Lookup(mustParsePaths(t, "$.*")[0])
+	require.Error(t, err)
+}
+
+func TestMergePatch(t *testing.T) {
+	kases := []struct {
+		docs []string
+		want string
+	}{
+		{[]string{`{"a": 1, "b": 2}`, `{"a": 3, "c": 4}`}, `{"a": 3, "b": 2, "c": 4}`},
+		{[]string{`{"a": 1, "b": 2}`, `{"a": null}`}, `{"b": 2}`},
+		{[]string{`{"a": {"x": 1}}`, `{"a": {"y": 2, "z": null}}`}, `{"a": {"x": 1, "y": 2}}`},
+		{[]string{`[1, 2]`, `{"a": 1}`}, `{"a": 1}`},
+		{[]string{`{"a": 1}`, `[1, 2]`}, `[1, 2]`},
+		{[]string{`{"a": 1}`, `{"b": 2}`, `{"a": null}`}, `{"b": 2}`},
+	}
+	for _, kase := range kases {
+		docs := make([]ByteJson, len(kase.docs))
+		for i, doc := range kase.docs {
+			docs[i] = mustParse(t, doc)
+		}
+		out, err := MergePatch(docs)
+		require.NoError(t, err)
+		require.Equal(t, mustParse(t, kase.want).String(), out.String())
+	}
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		want      bool
+	}{
+		{`1`, `1`, true},
+		{`1`, `1.0`, true},
+		{`1`, `"1"`, false},
+		{`"a"`, `"a"`, true},
+		{`[1, 2, [3]]`, `1`, true},
+		{`[1, 2, [3]]`, `3`, true},
+		{`[1, 2, [3]]`, `[2, 1]`, true},
+		{`[1, 2, [3]]`, `[1, 4]`, false},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"b": {"c": 2}}`, true},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"a": 1, "d": 2}`, false},
+		{`{"a": 1}`, `1`, false},
+		{`[{"a": 1, "b": 2}]`, `{"a": 1}`, true},
+		{`[true, null]`, `null`, true},
+	}
+	for _, kase := range kases {
+		require.Equal(t, kase.want, mustParse(t, kase.target).Contains(mustParse(t, kase.candidate)),
+			"target: %s, candidate: %s", kase.target, kase.candidate)
+	}
+}
+
+func TestInfo(t *testing.T) {
+	obj := mustParse(t, `{"b": 1, "a": [1, 2, 3]}`)
+	require.Equal(t, `["a", "b"]`, obj.Keys().String())
+	require.Equal(t, int64(2), obj.Length())
+	require.Equal(t, int64(0), mustParse(t, `[]`).Length())
+	require.Equal(t, int64(1), mustParse(t, `"abc"`).Length())
+
+	kases := map[string]string{
+		`{}`:                   "OBJECT",
+		`[]`:                   "ARRAY",
+		`-1`:                   "INTEGER",
+		`18446744073709551615`: "UNSIGNED INTEGER",
+		`1.5`:                  "DOUBLE",
+		`"a"`:                  "STRING",
+		`true`:                 "BOOLEAN",
+		`null`:                 "NULL",
+	}
+	for doc, want := range kases {
+		require.Equal(t, want, mustParse(t, doc).TypeName())
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// MaxBitLen is the max width of BIT
+const MaxBitLen = 64
+
+// CheckBit checks the value fits in BIT(width)
+func CheckBit(v uint64, width int32) error {
+	if width > 0 && width < MaxBitLen && v>>width != 0 {
+		return moerr.NewOutOfRangeNoCtx("bit", "value %d for bit(%d)", v, width)
+	}
+	return nil
+}
+
+// BitFromBytes converts the binary string to BIT(width), the bytes are big endian.
+func BitFromBytes(b []byte, width int32) (uint64, error) {
+	if len(b) > 8 {
+		for _, c := range b[:len(b)-8] {
+			if c != 0 {
+				return 0, moerr.NewOutOfRangeNoCtx("bit", "value 0x%x for bit(%d)", b, width)
+			}
+		}
+		b = b[len(b)-8:]
+	}
+	var buf [8]byte
+	copy(buf[8-len(b):], b)
+	v := binary.BigEndian.Uint64(buf[:])
+	return v, CheckBit(v, width)
+}
+
+// BitToBytes returns the big endian bytes of BIT(width) like MySQL, the
+// length is (width+7)/8.
+func BitToBytes(v uint64, width int32) []byte {
+	if width <= 0 || width > MaxBitLen {
+		width = MaxBitLen
+	}
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	return buf[8-(width+7)/8:]
+}
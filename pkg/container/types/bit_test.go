@@ -0,0 +1,41 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBit(t *testing.T) {
+	require.NoError(t, CheckBit(255, 8))
+	require.Error(t, CheckBit(256, 8))
+	require.NoError(t, CheckBit(1<<63, MaxBitLen))
+
+	require.Equal(t, []byte{0x01, 0x02}, BitToBytes(0x0102, 10))
+	require.Len(t, BitToBytes(1, 0), 8)
+
+	v, err := BitFromBytes([]byte{0x01, 0x02}, 16)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x0102), v)
+	v, err = BitFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0x01}, 8)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+	_, err = BitFromBytes([]byte{0x01, 0x02}, 8)
+	require.Error(t, err)
+	_, err = BitFromBytes([]byte{1, 0, 0, 0, 0, 0, 0, 0, 0}, MaxBitLen)
+	require.Error(t, err)
+}
@@ -307,7 +307,7 @@ func DecodeValue(val []byte, t T) any {
 		return DecodeFixed[bool](val)
 	case T_int8:
 		return DecodeFixed[int8](val)
-	case T_int16:
+	case T_int16, T_year:
 		return DecodeFixed[int16](val)
 	case T_int32:
 		return DecodeFixed[int32](val)
@@ -315,11 +315,11 @@ func DecodeValue(val []byte, t T) any {
 		return DecodeFixed[int64](val)
 	case T_uint8:
 		return DecodeFixed[uint8](val)
-	case T_uint16:
+	case T_uint16, T_enum:
 		return DecodeFixed[uint16](val)
 	case T_uint32:
 		return DecodeFixed[uint32](val)
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return DecodeFixed[uint64](val)
 	case T_float32:
 		return DecodeFixed[float32](val)
@@ -343,7 +343,7 @@ func DecodeValue(val []byte, t T) any {
 		return DecodeFixed[TS](val)
 	case T_Rowid:
 		return DecodeFixed[Rowid](val)
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_vecf32, T_vecf64:
 		return val
 	default:
 		panic(fmt.Sprintf("unsupported type %v", t))
@@ -356,7 +356,7 @@ func EncodeValue(val any, t T) []byte {
 		return EncodeFixed(val.(bool))
 	case T_int8:
 		return EncodeFixed(val.(int8))
-	case T_int16:
+	case T_int16, T_year:
 		return EncodeFixed(val.(int16))
 	case T_int32:
 		return EncodeFixed(val.(int32))
@@ -364,11 +364,11 @@ func EncodeValue(val any, t T) []byte {
 		return EncodeFixed(val.(int64))
 	case T_uint8:
 		return EncodeFixed(val.(uint8))
-	case T_uint16:
+	case T_uint16, T_enum:
 		return EncodeFixed(val.(uint16))
 	case T_uint32:
 		return EncodeFixed(val.(uint32))
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return EncodeFixed(val.(uint64))
 	case T_float32:
 		return EncodeFixed(val.(float32))
@@ -392,7 +392,7 @@ func EncodeValue(val any, t T) []byte {
 		return EncodeFixed(val.(TS))
 	case T_Rowid:
 		return EncodeFixed(val.(Rowid))
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_vecf32, T_vecf64:
 		return val.([]byte)
 	default:
 		panic(fmt.Sprintf("unsupported type %v", t))
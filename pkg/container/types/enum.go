@@ -0,0 +1,164 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// MaxEnumLen is the max number of the members of ENUM
+	MaxEnumLen = 65535
+	// MaxSetLen is the max number of the members of SET
+	MaxSetLen = 64
+)
+
+/*
+The members of ENUM and SET are not kept in Type, they are kept with the
+column (plan.Type.Enumvalues and mo_columns.attr_enum) in the form of the
+SQL literals, e.g. 'a','b''c'. So the SQL of the column type is
+ENUM(<members>) or SET(<members>).
+*/
+
+// FormatEnumValues formats the members of ENUM or SET
+func FormatEnumValues(members []string) string {
+	var b strings.Builder
+	for i, m := range members {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteByte('\'')
+		b.WriteString(strings.ReplaceAll(m, "'", "''"))
+		b.WriteByte('\'')
+	}
+	return b.String()
+}
+
+// ParseEnumValues parses the members formatted by FormatEnumValues
+func ParseEnumValues(s string) []string {
+	var members []string
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case !quoted && c == '\'':
+			quoted = true
+			b.Reset()
+		case quoted && c == '\'':
+			if i+1 < len(s) && s[i+1] == '\'' {
+				b.WriteByte('\'')
+				i++
+				continue
+			}
+			quoted = false
+			members = append(members, b.String())
+		case quoted:
+			b.WriteByte(c)
+		}
+	}
+	return members
+}
+
+// findEnumMember returns the 1-based index of the member, or 0
+func findEnumMember(members []string, s string) int {
+	s = strings.TrimRight(s, " ")
+	for i, m := range members {
+		if strings.EqualFold(m, s) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// ParseEnum returns the index of the member s. Like MySQL, the number that is
+// not a member is taken as the index.
+func ParseEnum(members []string, s string) (uint16, error) {
+	if idx := findEnumMember(members, s); idx > 0 {
+		return uint16(idx), nil
+	}
+	if n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64); err == nil {
+		return ParseEnumIndex(members, n)
+	}
+	return 0, moerr.NewDataTruncatedNoCtx("enum", "'%s' is not a member of enum(%s)", s, FormatEnumValues(members))
+}
+
+// ParseEnumIndex checks the index of the member
+func ParseEnumIndex(members []string, n uint64) (uint16, error) {
+	if n > uint64(len(members)) {
+		return 0, moerr.NewDataTruncatedNoCtx("enum", "%d is not a member index of enum(%s)", n, FormatEnumValues(members))
+	}
+	return uint16(n), nil
+}
+
+// EnumString returns the member of the index, the index 0 is the empty string.
+func EnumString(members []string, idx uint16) (string, error) {
+	if idx == 0 {
+		return "", nil
+	}
+	if int(idx) > len(members) {
+		return "", moerr.NewDataTruncatedNoCtx("enum", "%d is not a member index of enum(%s)", idx, FormatEnumValues(members))
+	}
+	return members[idx-1], nil
+}
+
+// ParseSet returns the bitmap of the comma separated members in s. Like MySQL,
+// the number that is not a member is taken as the bitmap.
+func ParseSet(members []string, s string) (uint64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	var v uint64
+	for _, m := range strings.Split(s, ",") {
+		idx := findEnumMember(members, m)
+		if idx == 0 {
+			if n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64); err == nil {
+				return ParseSetBitmap(members, n)
+			}
+			return 0, moerr.NewDataTruncatedNoCtx("set", "'%s' is not a member of set(%s)", m, FormatEnumValues(members))
+		}
+		v |= 1 << (idx - 1)
+	}
+	return v, nil
+}
+
+// ParseSetBitmap checks the bitmap of the members
+func ParseSetBitmap(members []string, n uint64) (uint64, error) {
+	if len(members) < MaxSetLen && n >= 1<<len(members) {
+		return 0, moerr.NewDataTruncatedNoCtx("set", "%d is not a member bitmap of set(%s)", n, FormatEnumValues(members))
+	}
+	return n, nil
+}
+
+// SetString returns the comma separated members of the bitmap in the order of
+// the definition.
+func SetString(members []string, v uint64) string {
+	var b strings.Builder
+	first := true
+	for i, m := range members {
+		if v&(1<<i) == 0 {
+			continue
+		}
+		if !first {
+			b.WriteByte(',')
+		}
+		first = false
+		b.WriteString(m)
+	}
+	return b.String()
+}
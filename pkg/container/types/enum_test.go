@@ -0,0 +1,85 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnumValues(t *testing.T) {
+	members := []string{"a", "b'c", "", "d,e"}
+	s := FormatEnumValues(members)
+	require.Equal(t, "'a','b''c','','d,e'", s)
+	require.Equal(t, members, ParseEnumValues(s))
+}
+
+func TestParseEnum(t *testing.T) {
+	members := []string{"small", "medium", "large"}
+	cases := []struct {
+		s      string
+		expect uint16
+		err    bool
+	}{
+		{"small", 1, false},
+		{"LARGE", 3, false},
+		{"medium  ", 2, false},
+		{"2", 2, false},
+		{"0", 0, false},
+		{"4", 0, true},
+		{"huge", 0, true},
+	}
+	for _, c := range cases {
+		idx, err := ParseEnum(members, c.s)
+		if c.err {
+			require.Error(t, err, c.s)
+			continue
+		}
+		require.NoError(t, err, c.s)
+		require.Equal(t, c.expect, idx, c.s)
+	}
+
+	s, err := EnumString(members, 2)
+	require.NoError(t, err)
+	require.Equal(t, "medium", s)
+	s, err = EnumString(members, 0)
+	require.NoError(t, err)
+	require.Equal(t, "", s)
+	_, err = EnumString(members, 4)
+	require.Error(t, err)
+}
+
+func TestParseSet(t *testing.T) {
+	members := []string{"a", "b", "c"}
+	v, err := ParseSet(members, "c,a")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), v)
+	require.Equal(t, "a,c", SetString(members, v))
+
+	v, err = ParseSet(members, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), v)
+	require.Equal(t, "", SetString(members, v))
+
+	v, err = ParseSet(members, "7")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), v)
+
+	_, err = ParseSet(members, "a,d")
+	require.Error(t, err)
+	_, err = ParseSetBitmap(members, 8)
+	require.Error(t, err)
+}
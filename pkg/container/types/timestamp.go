@@ -84,6 +84,10 @@ func (ts Timestamp) Unix() int64 {
 	return (int64(ts) - unixEpochMicroSecs) / microSecsPerSec
 }
 
+func (ts Timestamp) UnixNano() int64 {
+	return (int64(ts) - unixEpochMicroSecs) * nanoSecsPerMicroSec
+}
+
 func (ts Timestamp) UnixToFloat() float64 {
 	return float64(int64(ts)-unixEpochMicroSecs) / microSecsPerSec
 }
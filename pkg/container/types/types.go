@@ -30,6 +30,8 @@ const (
 
 	// bool family
 	T_bool T = 10
+	// T_bit is BIT(M), the bit-field value is kept in an uint64
+	T_bit T = 11
 
 	// numeric/integer family
 	T_int8    T = 20
@@ -60,6 +62,8 @@ const (
 	T_datetime  T = 52
 	T_timestamp T = 53
 	T_interval  T = 54
+	// T_year is YEAR, 0 or 1901 to 2155 kept in an int16
+	T_year T = 55
 
 	// string family
 	T_char      T = 60
@@ -68,11 +72,21 @@ const (
 	T_uuid      T = 63
 	T_binary    T = 64
 	T_varbinary T = 65
+	// T_enum is ENUM, the 1-based index of the member is kept in an uint16,
+	// 0 is the index of the empty string error value.
+	T_enum T = 66
+	// T_set is SET, the bitmap of the members is kept in an uint64.
+	T_set T = 67
 
 	// blobs
 	T_blob T = 70
 	T_text T = 71
 
+	// vectors, VECF32(n) and VECF64(n) are the embeddings of n dimensions,
+	// the little endian floats are kept in the varlena.
+	T_vecf32 T = 80
+	T_vecf64 T = 81
+
 	// Transaction TS
 	T_TS      T = 100
 	T_Rowid   T = 101
@@ -180,6 +194,7 @@ type Number interface {
 
 var Types map[string]T = map[string]T{
 	"bool": T_bool,
+	"bit":  T_bit,
 
 	"tinyint":  T_int8,
 	"smallint": T_int16,
@@ -205,6 +220,7 @@ var Types map[string]T = map[string]T{
 	"time":      T_time,
 	"timestamp": T_timestamp,
 	"interval":  T_interval,
+	"year":      T_year,
 
 	"char":    T_char,
 	"varchar": T_varchar,
@@ -216,6 +232,11 @@ var Types map[string]T = map[string]T{
 	"text": T_text,
 	"blob": T_blob,
 	"uuid": T_uuid,
+	"enum": T_enum,
+	"set":  T_set,
+
+	"vecf32": T_vecf32,
+	"vecf64": T_vecf64,
 
 	"transaction timestamp": T_TS,
 	"rowid":                 T_Rowid,
@@ -234,7 +255,7 @@ func New(oid T, width, scale int32) Type {
 
 func CharsetType(oid T) uint8 {
 	switch oid {
-	case T_blob, T_varbinary, T_binary:
+	case T_blob, T_varbinary, T_binary, T_vecf32, T_vecf64:
 		// binary charset
 		return 1
 	default:
@@ -321,6 +342,12 @@ func (t Type) DescString() string {
 		return fmt.Sprintf("DECIMAL(%d,%d)", t.Width, t.Scale)
 	case T_decimal128:
 		return fmt.Sprintf("DECIAML(%d,%d)", t.Width, t.Scale)
+	case T_bit:
+		return fmt.Sprintf("BIT(%d)", t.Width)
+	case T_vecf32:
+		return fmt.Sprintf("VECF32(%d)", t.Width)
+	case T_vecf64:
+		return fmt.Sprintf("VECF64(%d)", t.Width)
 	}
 	return t.Oid.String()
 }
@@ -344,7 +371,7 @@ func (t T) ToType() Type {
 		typ.Size = 1
 	case T_int8:
 		typ.Size = 1
-	case T_int16:
+	case T_int16, T_year:
 		typ.Size = 2
 	case T_int32, T_date:
 		typ.Size = 4
@@ -352,12 +379,15 @@ func (t T) ToType() Type {
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_set:
 		typ.Size = 8
+	case T_bit:
+		typ.Size = 8
+		typ.Width = MaxBitLen
 	case T_float32:
 		typ.Size = 4
 	case T_float64:
@@ -379,7 +409,8 @@ func (t T) ToType() Type {
 		typ.Size = RowidSize
 	case T_Blockid:
 		typ.Size = BlockidSize
-	case T_json, T_blob, T_text:
+	case T_json, T_blob, T_text, T_vecf32, T_vecf64:
+		// the width 0 of the vectors means any dimension
 		typ.Size = VarlenaSize
 	case T_char:
 		typ.Size = VarlenaSize
@@ -468,6 +499,18 @@ func (t T) String() string {
 		return "BLOCKID"
 	case T_interval:
 		return "INTERVAL"
+	case T_bit:
+		return "BIT"
+	case T_year:
+		return "YEAR"
+	case T_enum:
+		return "ENUM"
+	case T_set:
+		return "SET"
+	case T_vecf32:
+		return "VECF32"
+	case T_vecf64:
+		return "VECF64"
 	}
 	return fmt.Sprintf("unexpected type: %d", t)
 }
@@ -535,6 +578,18 @@ func (t T) OidString() string {
 		return "T_Blockid"
 	case T_interval:
 		return "T_interval"
+	case T_bit:
+		return "T_bit"
+	case T_year:
+		return "T_year"
+	case T_enum:
+		return "T_enum"
+	case T_set:
+		return "T_set"
+	case T_vecf32:
+		return "T_vecf32"
+	case T_vecf64:
+		return "T_vecf64"
 	}
 	return "unknown_type"
 }
@@ -546,7 +601,7 @@ func (t T) TypeLen() int {
 		return 0
 	case T_int8, T_bool:
 		return 1
-	case T_int16:
+	case T_int16, T_year:
 		return 2
 	case T_int32, T_date:
 		return 4
@@ -554,17 +609,17 @@ func (t T) TypeLen() int {
 		return 8
 	case T_uint8:
 		return 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return 8
 	case T_float32:
 		return 4
 	case T_float64:
 		return 8
-	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_vecf32, T_vecf64:
 		return VarlenaSize
 	case T_decimal64:
 		return 8
@@ -593,11 +648,11 @@ func (t T) FixedLength() int {
 		return 0
 	case T_int8, T_uint8, T_bool:
 		return 1
-	case T_int16, T_uint16:
+	case T_int16, T_uint16, T_year, T_enum:
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_time, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_datetime, T_time, T_float64, T_timestamp, T_set, T_bit:
 		return 8
 	case T_decimal64:
 		return 8
@@ -613,7 +668,7 @@ func (t T) FixedLength() int {
 		return RowidSize
 	case T_Blockid:
 		return BlockidSize
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_vecf32, T_vecf64:
 		return -24
 	}
 	panic(moerr.NewInternalErrorNoCtx(fmt.Sprintf("unknown type %d", t)))
@@ -0,0 +1,97 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// MaxVectorDimension is the max dimension of VECF32 and VECF64
+const MaxVectorDimension = 65535
+
+// RealNumbers are the element types of the vectors
+type RealNumbers interface {
+	float32 | float64
+}
+
+// BytesToVector returns the elements of the vector kept in the varlena, the
+// bytes are not copied.
+func BytesToVector[T RealNumbers](b []byte) []T {
+	return DecodeSlice[T](b)
+}
+
+// VectorToBytes returns the bytes of the vector kept in the varlena
+func VectorToBytes[T RealNumbers](v []T) []byte {
+	return EncodeSlice(v)
+}
+
+// CheckVectorDimension checks the vector has the dimension of VECF32(dim) or
+// VECF64(dim), the dimension 0 means any.
+func CheckVectorDimension(n int, dim int32) error {
+	if dim > 0 && n != int(dim) {
+		return moerr.NewInvalidInputNoCtx("expected vector dimension %d, but got %d", dim, n)
+	}
+	return nil
+}
+
+// ParseVector parses the text of the vector, e.g. [1, 2.5, -3]
+func ParseVector[T RealNumbers](s string, dim int32) ([]T, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return nil, moerr.NewInvalidInputNoCtx("malformed vector '%s'", s)
+	}
+	s = strings.TrimSpace(s[1 : len(s)-1])
+	var v []T
+	if s != "" {
+		elems := strings.Split(s, ",")
+		v = make([]T, len(elems))
+		bitSize := 64
+		if _, ok := any(v).([]float32); ok {
+			bitSize = 32
+		}
+		for i, e := range elems {
+			f, err := strconv.ParseFloat(strings.TrimSpace(e), bitSize)
+			if err != nil {
+				return nil, moerr.NewInvalidInputNoCtx("malformed vector element '%s'", e)
+			}
+			v[i] = T(f)
+		}
+	}
+	if len(v) == 0 || len(v) > MaxVectorDimension {
+		return nil, moerr.NewInvalidInputNoCtx("invalid vector dimension %d", len(v))
+	}
+	return v, CheckVectorDimension(len(v), dim)
+}
+
+// VectorToString returns the text of the vector
+func VectorToString[T RealNumbers](v []T) string {
+	bitSize := 64
+	if _, ok := any(v).([]float32); ok {
+		bitSize = 32
+	}
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'g', -1, bitSize))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
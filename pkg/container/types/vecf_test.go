@@ -0,0 +1,61 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVector(t *testing.T) {
+	cases := []struct {
+		s      string
+		dim    int32
+		expect []float32
+		err    bool
+	}{
+		{"[1, 2.5, -3]", 3, []float32{1, 2.5, -3}, false},
+		{" [1,2] ", 0, []float32{1, 2}, false},
+		{"[1, 2]", 3, nil, true},
+		{"[]", 0, nil, true},
+		{"1, 2", 0, nil, true},
+		{"[1, a]", 0, nil, true},
+	}
+	for _, c := range cases {
+		v, err := ParseVector[float32](c.s, c.dim)
+		if c.err {
+			require.Error(t, err, c.s)
+			continue
+		}
+		require.NoError(t, err, c.s)
+		require.Equal(t, c.expect, v, c.s)
+	}
+}
+
+func TestVectorToString(t *testing.T) {
+	require.Equal(t, "[1, 2.5, -3]", VectorToString([]float32{1, 2.5, -3}))
+	require.Equal(t, "[0.1, 1e+21]", VectorToString([]float64{0.1, 1e21}))
+
+	v, err := ParseVector[float64](VectorToString([]float64{0.1, 1e21}), 2)
+	require.NoError(t, err)
+	require.Equal(t, []float64{0.1, 1e21}, v)
+}
+
+func TestVectorBytes(t *testing.T) {
+	v := []float64{1, -2, 3.5}
+	require.Equal(t, v, BytesToVector[float64](VectorToBytes(v)))
+	require.Equal(t, 24, len(VectorToBytes(v)))
+}
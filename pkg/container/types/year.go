@@ -0,0 +1,66 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	MinYear = 1901
+	MaxYear = 2155
+)
+
+// YearFromInt converts the number to YEAR like MySQL, 1 to 69 are 2001 to 2069,
+// 70 to 99 are 1970 to 1999, and 0 is 0000.
+func YearFromInt(n int64) (int16, error) {
+	switch {
+	case n == 0:
+		return 0, nil
+	case n >= 1 && n <= 69:
+		return int16(n + 2000), nil
+	case n >= 70 && n <= 99:
+		return int16(n + 1900), nil
+	case n >= MinYear && n <= MaxYear:
+		return int16(n), nil
+	}
+	return 0, moerr.NewOutOfRangeNoCtx("year", "value %d", n)
+}
+
+// ParseYear converts the string to YEAR like MySQL. Unlike the number, the
+// one or two digit strings '0' to '69' are 2000 to 2069.
+func ParseYear(s string) (int16, error) {
+	s = strings.TrimSpace(s)
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, moerr.NewInvalidArgNoCtx("parse year", s)
+	}
+	if len(s) <= 2 && n < 70 {
+		return int16(n + 2000), nil
+	}
+	if len(s) == 4 && n == 0 {
+		return 0, nil
+	}
+	return YearFromInt(n)
+}
+
+// YearString formats YEAR with 4 digits
+func YearString(y int16) string {
+	return fmt.Sprintf("%04d", y)
+}
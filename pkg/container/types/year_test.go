@@ -0,0 +1,49 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestYear(t *testing.T) {
+	y, err := YearFromInt(69)
+	require.NoError(t, err)
+	require.Equal(t, int16(2069), y)
+	y, err = YearFromInt(70)
+	require.NoError(t, err)
+	require.Equal(t, int16(1970), y)
+	y, err = YearFromInt(0)
+	require.NoError(t, err)
+	require.Equal(t, "0000", YearString(y))
+	_, err = YearFromInt(1900)
+	require.Error(t, err)
+	_, err = YearFromInt(2156)
+	require.Error(t, err)
+
+	y, err = ParseYear("0")
+	require.NoError(t, err)
+	require.Equal(t, int16(2000), y)
+	y, err = ParseYear("0000")
+	require.NoError(t, err)
+	require.Equal(t, int16(0), y)
+	y, err = ParseYear(" 2023 ")
+	require.NoError(t, err)
+	require.Equal(t, "2023", YearString(y))
+	_, err = ParseYear("abc")
+	require.Error(t, err)
+}
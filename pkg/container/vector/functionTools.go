@@ -317,7 +317,7 @@ func NewFunctionResultWrapper(typ types.Type, mp *mpool.MPool, isConst bool, len
 	}
 
 	switch typ.Oid {
-	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
+	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_vecf32, types.T_vecf64:
 		// IF STRING type.
 		return newResultFunc[types.Varlena](v, mp)
 	case types.T_json:
@@ -333,7 +333,7 @@ func NewFunctionResultWrapper(typ types.Type, mp *mpool.MPool, isConst bool, len
 		return newResultFunc[bool](v, mp)
 	case types.T_int8:
 		return newResultFunc[int8](v, mp)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return newResultFunc[int16](v, mp)
 	case types.T_int32:
 		return newResultFunc[int32](v, mp)
@@ -341,11 +341,11 @@ func NewFunctionResultWrapper(typ types.Type, mp *mpool.MPool, isConst bool, len
 		return newResultFunc[int64](v, mp)
 	case types.T_uint8:
 		return newResultFunc[uint8](v, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return newResultFunc[uint16](v, mp)
 	case types.T_uint32:
 		return newResultFunc[uint32](v, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return newResultFunc[uint64](v, mp)
 	case types.T_float32:
 		return newResultFunc[float32](v, mp)
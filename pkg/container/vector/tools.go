@@ -171,7 +171,7 @@ func (v *Vector) setupColFromData() {
 			v.col = DecodeFixedCol[bool](v)
 		case types.T_int8:
 			v.col = DecodeFixedCol[int8](v)
-		case types.T_int16:
+		case types.T_int16, types.T_year:
 			v.col = DecodeFixedCol[int16](v)
 		case types.T_int32:
 			v.col = DecodeFixedCol[int32](v)
@@ -179,11 +179,11 @@ func (v *Vector) setupColFromData() {
 			v.col = DecodeFixedCol[int64](v)
 		case types.T_uint8:
 			v.col = DecodeFixedCol[uint8](v)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			v.col = DecodeFixedCol[uint16](v)
 		case types.T_uint32:
 			v.col = DecodeFixedCol[uint32](v)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			v.col = DecodeFixedCol[uint64](v)
 		case types.T_float32:
 			v.col = DecodeFixedCol[float32](v)
@@ -277,7 +277,7 @@ func (v *Vector) CompareAndCheckIntersect(vec *Vector) (bool, error) {
 	switch v.typ.Oid {
 	case types.T_int8:
 		return checkNumberIntersect[int8](v, vec)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return checkNumberIntersect[int16](v, vec)
 	case types.T_int32:
 		return checkNumberIntersect[int32](v, vec)
@@ -285,11 +285,11 @@ func (v *Vector) CompareAndCheckIntersect(vec *Vector) (bool, error) {
 		return checkNumberIntersect[int64](v, vec)
 	case types.T_uint8:
 		return checkNumberIntersect[uint8](v, vec)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return checkNumberIntersect[uint16](v, vec)
 	case types.T_uint32:
 		return checkNumberIntersect[uint32](v, vec)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return checkNumberIntersect[uint64](v, vec)
 	case types.T_float32:
 		return checkNumberIntersect[float32](v, vec)
@@ -321,7 +321,7 @@ func (v *Vector) CompareAndCheckIntersect(vec *Vector) (bool, error) {
 		}, func(t1, t2 types.Uuid) bool {
 			return t1.Le(t2)
 		})
-	case types.T_varchar, types.T_binary, types.T_varbinary, types.T_char, types.T_text:
+	case types.T_varchar, types.T_binary, types.T_varbinary, types.T_char, types.T_text, types.T_vecf32, types.T_vecf64:
 		return checkStrIntersect(v, vec, func(t1, t2 string) bool {
 			return strings.Compare(t1, t2) >= 0
 		}, func(t1, t2 string) bool {
@@ -400,7 +400,7 @@ func (v *Vector) CompareAndCheckAnyResultIsTrue(ctx context.Context, vec *Vector
 	switch v.typ.Oid {
 	case types.T_int8:
 		return compareNumber[int8](ctx, v, vec, funName)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return compareNumber[int16](ctx, v, vec, funName)
 	case types.T_int32:
 		return compareNumber[int32](ctx, v, vec, funName)
@@ -408,11 +408,11 @@ func (v *Vector) CompareAndCheckAnyResultIsTrue(ctx context.Context, vec *Vector
 		return compareNumber[int64](ctx, v, vec, funName)
 	case types.T_uint8:
 		return compareNumber[uint8](ctx, v, vec, funName)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return compareNumber[uint16](ctx, v, vec, funName)
 	case types.T_uint32:
 		return compareNumber[uint32](ctx, v, vec, funName)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return compareNumber[uint64](ctx, v, vec, funName)
 	case types.T_float32:
 		return compareNumber[float32](ctx, v, vec, funName)
@@ -466,7 +466,7 @@ func (v *Vector) ToConst(row, length int, mp *mpool.MPool) *Vector {
 		return NewConstFixed(v.typ, v.col.([]bool)[row], length, mp)
 	case types.T_int8:
 		return NewConstFixed(v.typ, v.col.([]int8)[row], length, mp)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return NewConstFixed(v.typ, v.col.([]int16)[row], length, mp)
 	case types.T_int32:
 		return NewConstFixed(v.typ, v.col.([]int32)[row], length, mp)
@@ -474,11 +474,11 @@ func (v *Vector) ToConst(row, length int, mp *mpool.MPool) *Vector {
 		return NewConstFixed(v.typ, v.col.([]int64)[row], length, mp)
 	case types.T_uint8:
 		return NewConstFixed(v.typ, v.col.([]uint8)[row], length, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return NewConstFixed(v.typ, v.col.([]uint16)[row], length, mp)
 	case types.T_uint32:
 		return NewConstFixed(v.typ, v.col.([]uint32)[row], length, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return NewConstFixed(v.typ, v.col.([]uint64)[row], length, mp)
 	case types.T_float32:
 		return NewConstFixed(v.typ, v.col.([]float32)[row], length, mp)
@@ -504,7 +504,7 @@ func (v *Vector) ToConst(row, length int, mp *mpool.MPool) *Vector {
 		return NewConstFixed(v.typ, v.col.([]types.Rowid)[row], length, mp)
 	case types.T_Blockid:
 		return NewConstFixed(v.typ, v.col.([]types.Blockid)[row], length, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		return NewConstBytes(v.typ, v.GetBytesAt(row), length, mp)
 	}
 	return nil
@@ -572,7 +572,7 @@ func (v *Vector) Shrink(sels []int64, negate bool) {
 		shrinkFixed[bool](v, sels, negate)
 	case types.T_int8:
 		shrinkFixed[int8](v, sels, negate)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		shrinkFixed[int16](v, sels, negate)
 	case types.T_int32:
 		shrinkFixed[int32](v, sels, negate)
@@ -580,17 +580,17 @@ func (v *Vector) Shrink(sels []int64, negate bool) {
 		shrinkFixed[int64](v, sels, negate)
 	case types.T_uint8:
 		shrinkFixed[uint8](v, sels, negate)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		shrinkFixed[uint16](v, sels, negate)
 	case types.T_uint32:
 		shrinkFixed[uint32](v, sels, negate)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		shrinkFixed[uint64](v, sels, negate)
 	case types.T_float32:
 		shrinkFixed[float32](v, sels, negate)
 	case types.T_float64:
 		shrinkFixed[float64](v, sels, negate)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		// XXX shrink varlena, but did not shrink area.  For our vector, this
 		// may well be the right thing.  If want to shrink area as well, we
 		// have to copy each varlena value and swizzle pointer.
@@ -631,7 +631,7 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) error {
 		shuffleFixed[bool](v, sels, mp)
 	case types.T_int8:
 		shuffleFixed[int8](v, sels, mp)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		shuffleFixed[int16](v, sels, mp)
 	case types.T_int32:
 		shuffleFixed[int32](v, sels, mp)
@@ -639,17 +639,17 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) error {
 		shuffleFixed[int64](v, sels, mp)
 	case types.T_uint8:
 		shuffleFixed[uint8](v, sels, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		shuffleFixed[uint16](v, sels, mp)
 	case types.T_uint32:
 		shuffleFixed[uint32](v, sels, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		shuffleFixed[uint64](v, sels, mp)
 	case types.T_float32:
 		shuffleFixed[float32](v, sels, mp)
 	case types.T_float64:
 		shuffleFixed[float64](v, sels, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		shuffleFixed[types.Varlena](v, sels, mp)
 	case types.T_date:
 		shuffleFixed[types.Date](v, sels, mp)
@@ -793,7 +793,7 @@ func GetUnionFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) error
 			v.length += w.length
 			return nil
 		}
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				for i := 0; i < w.length; i++ {
@@ -929,7 +929,7 @@ func GetUnionFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) error
 			v.length += w.length
 			return nil
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				for i := 0; i < w.length; i++ {
@@ -997,7 +997,7 @@ func GetUnionFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) error
 			v.length += w.length
 			return nil
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				for i := 0; i < w.length; i++ {
@@ -1406,7 +1406,7 @@ func GetUnionFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) error
 			return nil
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				for i := 0; i < w.length; i++ {
@@ -1521,7 +1521,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			}
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
 		}
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, int16(0), true, mp)
@@ -1565,7 +1565,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			}
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, uint16(0), true, mp)
@@ -1587,7 +1587,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			}
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, uint64(0), true, mp)
@@ -1720,7 +1720,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			return appendOneFixed(v, ws[sel], nulls.Contains(w.nsp, uint64(sel)), mp)
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, types.Varlena{}, true, mp)
@@ -2071,7 +2071,7 @@ func (v *Vector) String() string {
 		return vecToString[bool](v)
 	case types.T_int8:
 		return vecToString[int8](v)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return vecToString[int16](v)
 	case types.T_int32:
 		return vecToString[int32](v)
@@ -2079,11 +2079,11 @@ func (v *Vector) String() string {
 		return vecToString[int64](v)
 	case types.T_uint8:
 		return vecToString[uint8](v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return vecToString[uint16](v)
 	case types.T_uint32:
 		return vecToString[uint32](v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return vecToString[uint64](v)
 	case types.T_float32:
 		return vecToString[float32](v)
@@ -2109,7 +2109,7 @@ func (v *Vector) String() string {
 		return vecToString[types.Rowid](v)
 	case types.T_Blockid:
 		return vecToString[types.Blockid](v)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		col := MustStrCol(v)
 		if len(col) == 1 {
 			if nulls.Contains(v.nsp, 0) {
@@ -2179,7 +2179,7 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 		return appendOneFixed(vec, val.(bool), false, mp)
 	case types.T_int8:
 		return appendOneFixed(vec, val.(int8), false, mp)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return appendOneFixed(vec, val.(int16), false, mp)
 	case types.T_int32:
 		return appendOneFixed(vec, val.(int32), false, mp)
@@ -2187,11 +2187,11 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 		return appendOneFixed(vec, val.(int64), false, mp)
 	case types.T_uint8:
 		return appendOneFixed(vec, val.(uint8), false, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return appendOneFixed(vec, val.(uint16), false, mp)
 	case types.T_uint32:
 		return appendOneFixed(vec, val.(uint32), false, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return appendOneFixed(vec, val.(uint64), false, mp)
 	case types.T_float32:
 		return appendOneFixed(vec, val.(float32), false, mp)
@@ -2217,7 +2217,7 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 		return appendOneFixed(vec, val.(types.Rowid), false, mp)
 	case types.T_Blockid:
 		return appendOneFixed(vec, val.(types.Blockid), false, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		return appendOneBytes(vec, val.([]byte), false, mp)
 	}
 	return nil
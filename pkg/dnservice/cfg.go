@@ -58,6 +58,7 @@ var (
 	defaultMaxLogtailFetchFailure     = 5
 
 	storageDir     = "storage"
+	backupDir      = "backup"
 	defaultDataDir = "./mo-data"
 )
 
@@ -102,6 +103,23 @@ type Config struct {
 		GlobalMinCount      int64         `toml:"global-min-count"`
 	}
 
+	GC struct {
+		// HistoryRetention is how long the history data is kept from GC for the reads at
+		// past timestamps by AS OF TIMESTAMP or SET TRANSACTION SNAPSHOT. The CNs reject
+		// the snapshots older than it.
+		HistoryRetention toml.Duration `toml:"history-retention"`
+	}
+
+	Backup struct {
+		// Root is the dir the backups of BACKUP are written under, the path of BACKUP
+		// is relative to it. A local dir or a path with the arguments of the service,
+		// like LOAD DATA takes. Default is the backup dir under DataDir.
+		Root string `toml:"root"`
+		// ArchiveWAL archives the WAL under Root, so mo-backup can restore the cluster to
+		// a timestamp after a backup by replaying it.
+		ArchiveWAL bool `toml:"archive-wal"`
+	}
+
 	LogtailServer struct {
 		ListenAddress              string        `toml:"listen-address"`
 		ServiceAddress             string        `toml:"service-address"`
@@ -152,6 +170,9 @@ func (c *Config) Validate() error {
 		c.DataDir = defaultDataDir
 	}
 	c.Txn.Storage.dataDir = filepath.Join(c.DataDir, storageDir)
+	if c.Backup.Root == "" {
+		c.Backup.Root = filepath.Join(c.DataDir, backupDir)
+	}
 	if c.ListenAddress == "" {
 		c.ListenAddress = defaultListenAddress
 	}
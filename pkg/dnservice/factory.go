@@ -102,6 +102,7 @@ func (s *store) newLogServiceClient(shard metadata.DNShard) (logservice.Client,
 		DNReplicaID:      shard.ReplicaID,
 		ServiceAddresses: s.cfg.HAKeeper.ClientConfig.ServiceAddresses,
 		MaxMessageSize:   int(s.cfg.RPC.MaxMessageSize),
+		TLS:              s.cfg.HAKeeper.ClientConfig.TLS,
 	})
 }
 
@@ -134,6 +135,13 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		IncrementalInterval: s.cfg.Ckp.IncrementalInterval.Duration,
 		GlobalMinCount:      s.cfg.Ckp.GlobalMinCount,
 	}
+	gcCfg := &options.GCCfg{
+		HistoryRetention: s.cfg.GC.HistoryRetention.Duration,
+	}
+	backupCfg := &options.BackupCfg{
+		Root:       s.cfg.Backup.Root,
+		ArchiveWAL: s.cfg.Backup.ArchiveWAL,
+	}
 	logtailServerAddr := s.cfg.LogtailServer.ListenAddress
 	logtailServerCfg := &options.LogtailServerCfg{
 		RpcMaxMessageSize:        int64(s.cfg.LogtailServer.RpcMaxMessageSize),
@@ -142,6 +150,7 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		LogtailCollectInterval:   s.cfg.LogtailServer.LogtailCollectInterval.Duration,
 		ResponseSendTimeout:      s.cfg.LogtailServer.LogtailResponseSendTimeout.Duration,
 		MaxLogtailFetchFailure:   s.cfg.LogtailServer.MaxLogtailFetchFailure,
+		RpcTLS:                   s.cfg.RPC.TLS,
 	}
 
 	// use s3 as main fs
@@ -157,6 +166,8 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		fs,
 		s.rt,
 		ckpcfg,
+		gcCfg,
+		backupCfg,
 		logtailServerAddr,
 		logtailServerCfg,
 		options.LogstoreType(s.cfg.Txn.Storage.LogBackend))
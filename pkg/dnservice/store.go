@@ -348,7 +348,8 @@ func (s *store) initTxnServer() error {
 		s.cfg.ListenAddress,
 		s.rt,
 		rpc.WithServerMaxMessageSize(int(s.cfg.RPC.MaxMessageSize)),
-		rpc.WithServerEnableCompress(s.cfg.RPC.EnableCompress))
+		rpc.WithServerEnableCompress(s.cfg.RPC.EnableCompress),
+		rpc.WithServerTLS(s.cfg.RPC.TLS))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,563 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	deleteColumnStatsFormat = `delete from mo_catalog.mo_column_stats where table_id = %d and column_name in (%s);`
+	insertColumnStatsFormat = `insert into mo_catalog.mo_column_stats(table_id, column_name, row_count, null_frac, ndv, histogram, analyzed_time) values (%d, '%s', %v, %v, %v, '%s', now());`
+	getColumnStatsFormat    = `select column_name, row_count, null_frac, ndv, histogram from mo_catalog.mo_column_stats where table_id = %d;`
+	getAnalyzedTablesSql    = `select distinct table_id from mo_catalog.mo_column_stats;`
+
+	// the histogram is built by the rows sampled at random, the limit only guards the memory
+	getHistogramSampleFormat = "select cast(%s as double) from %s where %s is not null and rand() < %v limit %d;"
+
+	// the column statistics persisted by ANALYZE TABLE may be updated by the other cns,
+	// so they are loaded again after this interval
+	columnStatsReloadInterval = time.Minute
+	columnStatsLoadTimeout    = time.Minute
+
+	// the statistics of a table not planned in the timeout are evicted, and the least
+	// recently planned ones are evicted when the cache holds too many tables
+	columnStatsIdleTimeout = 10 * time.Minute
+	columnStatsCacheSize   = 10000
+)
+
+var (
+	analyzeTableOutputColumns = [4]Column{
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Table",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Op",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Msg_type",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Msg_text",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+	}
+)
+
+// doAnalyzeTable collects the row count, null fraction, HyperLogLog ndv and the equi-depth
+// histogram of the columns, and persists them in mo_catalog.mo_column_stats. All the work
+// is done by the sql in the background, so it scales with the table like any other query.
+func doAnalyzeTable(ctx context.Context, ses *Session, stmt *tree.AnalyzeStmt) error {
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		dbName = ses.GetDatabaseName()
+	}
+	if dbName == "" {
+		return moerr.NewNoDB(ctx)
+	}
+	tblName := string(stmt.Table.ObjectName)
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tblName)
+	if tableDef == nil {
+		return moerr.NewNoSuchTable(ctx, dbName, tblName)
+	}
+	cols, err := getAnalyzeColumns(ctx, tableDef, stmt.Cols)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	columnStats, err := collectColumnStats(ctx, bh, quoteIdentifier(dbName)+"."+quoteIdentifier(tblName), cols)
+	if err != nil {
+		return err
+	}
+	if err = saveColumnStats(ctx, bh, tableDef.TblId, cols, columnStats); err != nil {
+		return err
+	}
+	getColumnStatsCache(ses.GetParameterUnit(), ses.GetAutoIncrCacheManager()).update(
+		getAccountId(ctx), tableDef.TblId, columnStats)
+
+	rs := &MysqlResultSet{}
+	for _, col := range analyzeTableOutputColumns {
+		rs.AddColumn(col)
+	}
+	rs.AddRow([]interface{}{dbName + "." + tblName, "analyze", "status", "OK"})
+	ses.SetMysqlResultSet(rs)
+	return nil
+}
+
+// getAnalyzeColumns returns the named columns, or all the visible columns if none is named.
+func getAnalyzeColumns(ctx context.Context, tableDef *plan.TableDef, names tree.IdentifierList) ([]*plan.ColDef, error) {
+	var cols []*plan.ColDef
+	if len(names) == 0 {
+		for _, col := range tableDef.Cols {
+			if !col.Hidden && col.Name != catalog.Row_ID {
+				cols = append(cols, col)
+			}
+		}
+		return cols, nil
+	}
+
+	for _, name := range names {
+		var found *plan.ColDef
+		for _, col := range tableDef.Cols {
+			if strings.EqualFold(col.Name, string(name)) {
+				found = col
+				break
+			}
+		}
+		if found == nil {
+			return nil, moerr.NewBadFieldError(ctx, string(name), tableDef.Name)
+		}
+		cols = append(cols, found)
+	}
+	return cols, nil
+}
+
+func collectColumnStats(ctx context.Context, bh BackgroundExec, table string, cols []*plan.ColDef) (map[string]*plan2.ColumnStats, error) {
+	// approx_count_distinct is computed by HyperLogLog
+	var sb strings.Builder
+	sb.WriteString("select count(*)")
+	for _, col := range cols {
+		name := quoteIdentifier(col.Name)
+		fmt.Fprintf(&sb, ", count(%s), approx_count_distinct(%s)", name, name)
+	}
+	fmt.Fprintf(&sb, " from %s;", table)
+
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, sb.String()); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewInternalError(ctx, "failed to analyze table %s", table)
+	}
+
+	rowCount, err := erArray[0].GetFloat64(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	columnStats := make(map[string]*plan2.ColumnStats, len(cols))
+	for i, col := range cols {
+		nonNullCnt, err := erArray[0].GetFloat64(ctx, 0, uint64(2*i+1))
+		if err != nil {
+			return nil, err
+		}
+		ndv, err := erArray[0].GetFloat64(ctx, 0, uint64(2*i+2))
+		if err != nil {
+			return nil, err
+		}
+		cs := &plan2.ColumnStats{
+			RowCount: rowCount,
+			Ndv:      ndv,
+		}
+		if rowCount > 0 {
+			cs.NullFrac = (rowCount - nonNullCnt) / rowCount
+		}
+		if nonNullCnt > 0 && plan2.CanBuildHistogram(types.T(col.Typ.Id)) {
+			if cs.Histogram, err = collectHistogram(ctx, bh, table, col.Name, nonNullCnt, ndv); err != nil {
+				return nil, err
+			}
+		}
+		columnStats[col.Name] = cs
+	}
+	return columnStats, nil
+}
+
+func collectHistogram(ctx context.Context, bh BackgroundExec, table, colName string, nonNullCnt, ndv float64) (*plan2.Histogram, error) {
+	name := quoteIdentifier(colName)
+	ratio := math.Min(plan2.HistogramSampleRows/nonNullCnt, 1)
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getHistogramSampleFormat, name, table, name, ratio, 2*plan2.HistogramSampleRows)); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []float64
+	if execResultArrayHasData(erArray) {
+		values = make([]float64, erArray[0].GetRowCount())
+		for i := range values {
+			if values[i], err = erArray[0].GetFloat64(ctx, uint64(i), 0); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return plan2.BuildHistogram(values, nonNullCnt, ndv), nil
+}
+
+// saveColumnStats replaces the statistics of the columns in one transaction.
+func saveColumnStats(ctx context.Context, bh BackgroundExec, tableID uint64, cols []*plan.ColDef, columnStats map[string]*plan2.ColumnStats) error {
+	var err error
+	var data []byte
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = "'" + escapeString(col.Name) + "'"
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, fmt.Sprintf(deleteColumnStatsFormat, tableID, strings.Join(names, ", ")))
+	if err != nil {
+		goto handleFailed
+	}
+	for _, col := range cols {
+		cs := columnStats[col.Name]
+		data = nil
+		if cs.Histogram != nil {
+			data, err = json.Marshal(cs.Histogram)
+			if err != nil {
+				goto handleFailed
+			}
+		}
+		err = bh.Exec(ctx, fmt.Sprintf(insertColumnStatsFormat, tableID, escapeString(col.Name), cs.RowCount, cs.NullFrac, cs.Ndv, escapeString(string(data))))
+		if err != nil {
+			goto handleFailed
+		}
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return nil
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// loadColumnStats reads the statistics persisted by ANALYZE TABLE.
+func loadColumnStats(ctx context.Context, exec ie.InternalExecutor, tableID uint64) (map[string]*plan2.ColumnStats, error) {
+	result := exec.Query(ctx, fmt.Sprintf(getColumnStatsFormat, tableID), ie.NewOptsBuilder().Internal(true).Finish())
+	if err := result.Error(); err != nil {
+		return nil, err
+	}
+
+	columnStats := make(map[string]*plan2.ColumnStats)
+	for i := uint64(0); i < result.RowCount(); i++ {
+		name, err := result.StringValueByName(ctx, i, "column_name")
+		if err != nil {
+			return nil, err
+		}
+		cs := &plan2.ColumnStats{}
+		if cs.RowCount, err = result.Float64ValueByName(ctx, i, "row_count"); err != nil {
+			return nil, err
+		}
+		if cs.NullFrac, err = result.Float64ValueByName(ctx, i, "null_frac"); err != nil {
+			return nil, err
+		}
+		if cs.Ndv, err = result.Float64ValueByName(ctx, i, "ndv"); err != nil {
+			return nil, err
+		}
+		data, err := result.StringValueByName(ctx, i, "histogram")
+		if err != nil {
+			return nil, err
+		}
+		if data != "" {
+			cs.Histogram = &plan2.Histogram{}
+			if err = json.Unmarshal([]byte(data), cs.Histogram); err != nil {
+				return nil, err
+			}
+		}
+		columnStats[name] = cs
+	}
+	return columnStats, nil
+}
+
+// loadAnalyzedTables reads the ids of the tables analyzed by ANALYZE TABLE.
+func loadAnalyzedTables(ctx context.Context, exec ie.InternalExecutor) (map[uint64]bool, error) {
+	result := exec.Query(ctx, getAnalyzedTablesSql, ie.NewOptsBuilder().Internal(true).Finish())
+	if err := result.Error(); err != nil {
+		return nil, err
+	}
+	tables := make(map[uint64]bool, result.RowCount())
+	for i := uint64(0); i < result.RowCount(); i++ {
+		value, err := result.StringValueByName(ctx, i, "table_id")
+		if err != nil {
+			return nil, err
+		}
+		tableID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		tables[tableID] = true
+	}
+	return tables, nil
+}
+
+var (
+	gColumnStatsCache     *columnStatsCache
+	gColumnStatsCacheOnce sync.Once
+)
+
+// getColumnStatsCache returns the cache of the column statistics shared by all the sessions.
+func getColumnStatsCache(pu *config.ParameterUnit, aicm *defines.AutoIncrCacheManager) *columnStatsCache {
+	gColumnStatsCacheOnce.Do(func() {
+		accountCtx := func(ctx context.Context, accountID uint32) context.Context {
+			ctx = context.WithValue(ctx, defines.TenantIDKey{}, accountID)
+			ctx = context.WithValue(ctx, defines.UserIDKey{}, uint32(rootID))
+			if accountID == sysAccountID {
+				return context.WithValue(ctx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+			}
+			return context.WithValue(ctx, defines.RoleIDKey{}, uint32(accountAdminRoleID))
+		}
+		gColumnStatsCache = newColumnStatsCache(
+			func(ctx context.Context, accountID uint32, tableID uint64) (map[string]*plan2.ColumnStats, error) {
+				return loadColumnStats(accountCtx(ctx, accountID), NewInternalExecutor(pu, aicm), tableID)
+			},
+			func(ctx context.Context, accountID uint32) (map[uint64]bool, error) {
+				return loadAnalyzedTables(accountCtx(ctx, accountID), NewInternalExecutor(pu, aicm))
+			})
+	})
+	return gColumnStatsCache
+}
+
+type columnStatsKey struct {
+	accountID uint32
+	tableID   uint64
+}
+
+type columnStatsEntry struct {
+	columnStats map[string]*plan2.ColumnStats
+	loadTime    time.Time
+	accessTime  time.Time
+	loading     bool
+}
+
+// analyzedTables are the ids of the analyzed tables of an account.
+type analyzedTables struct {
+	tables   map[uint64]bool
+	loadTime time.Time
+	loading  bool
+}
+
+// columnStatsCache caches the column statistics of the analyzed tables for all the sessions
+// of the cn. The statistics are loaded in the background, so the planning never waits for
+// them, the queries planned before they are loaded just estimate by the zonemaps.
+type columnStatsCache struct {
+	sync.Mutex
+	load         func(ctx context.Context, accountID uint32, tableID uint64) (map[string]*plan2.ColumnStats, error)
+	loadAnalyzed func(ctx context.Context, accountID uint32) (map[uint64]bool, error)
+	accounts     map[uint32]*analyzedTables
+	tables       map[columnStatsKey]*columnStatsEntry
+}
+
+func newColumnStatsCache(
+	load func(ctx context.Context, accountID uint32, tableID uint64) (map[string]*plan2.ColumnStats, error),
+	loadAnalyzed func(ctx context.Context, accountID uint32) (map[uint64]bool, error)) *columnStatsCache {
+	return &columnStatsCache{
+		load:         load,
+		loadAnalyzed: loadAnalyzed,
+		accounts:     make(map[uint32]*analyzedTables),
+		tables:       make(map[columnStatsKey]*columnStatsEntry),
+	}
+}
+
+// get returns the cached statistics of the table, and loads them in the background if they
+// were never loaded or loaded too long ago. Only the analyzed tables are cached.
+func (c *columnStatsCache) get(accountID uint32, tableID uint64) map[string]*plan2.ColumnStats {
+	key := columnStatsKey{accountID: accountID, tableID: tableID}
+	now := time.Now()
+	c.Lock()
+	defer c.Unlock()
+	account, ok := c.accounts[accountID]
+	if !ok {
+		account = &analyzedTables{}
+		c.accounts[accountID] = account
+	}
+	if !account.loading && now.Sub(account.loadTime) > columnStatsReloadInterval {
+		account.loading = true
+		go c.reloadAnalyzed(accountID)
+	}
+	if !account.tables[tableID] {
+		return nil
+	}
+
+	entry, ok := c.tables[key]
+	if !ok {
+		c.evict(now)
+		entry = &columnStatsEntry{}
+		c.tables[key] = entry
+	}
+	entry.accessTime = now
+	if !entry.loading && now.Sub(entry.loadTime) > columnStatsReloadInterval {
+		entry.loading = true
+		go c.reload(key)
+	}
+	return entry.columnStats
+}
+
+// evict removes the statistics not planned in columnStatsIdleTimeout, and the least recently
+// planned ones until there is room for a new table.
+func (c *columnStatsCache) evict(now time.Time) {
+	for key, entry := range c.tables {
+		if !entry.loading && now.Sub(entry.accessTime) > columnStatsIdleTimeout {
+			delete(c.tables, key)
+		}
+	}
+	for len(c.tables) >= columnStatsCacheSize {
+		var oldest columnStatsKey
+		var oldestTime time.Time
+		for key, entry := range c.tables {
+			if oldestTime.IsZero() || entry.accessTime.Before(oldestTime) {
+				oldest, oldestTime = key, entry.accessTime
+			}
+		}
+		delete(c.tables, oldest)
+	}
+}
+
+func (c *columnStatsCache) reload(key columnStatsKey) {
+	ctx, cancel := context.WithTimeout(context.Background(), columnStatsLoadTimeout)
+	defer cancel()
+	columnStats, err := c.load(ctx, key.accountID, key.tableID)
+
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.tables[key]
+	if !ok {
+		// evicted or removed
+		return
+	}
+	entry.loading = false
+	// a failure is not retried until the next reload, the estimation falls back to the zonemaps
+	entry.loadTime = time.Now()
+	if err != nil {
+		logutil.Warnf("load column stats of table %d failed, %v", key.tableID, err)
+		return
+	}
+	entry.columnStats = columnStats
+}
+
+func (c *columnStatsCache) reloadAnalyzed(accountID uint32) {
+	ctx, cancel := context.WithTimeout(context.Background(), columnStatsLoadTimeout)
+	defer cancel()
+	tables, err := c.loadAnalyzed(ctx, accountID)
+
+	c.Lock()
+	defer c.Unlock()
+	account, ok := c.accounts[accountID]
+	if !ok {
+		return
+	}
+	account.loading = false
+	account.loadTime = time.Now()
+	if err != nil {
+		logutil.Warnf("load the analyzed tables of account %d failed, %v", accountID, err)
+		return
+	}
+	// the tables analyzed on the cn while loading
+	for tableID := range account.tables {
+		if _, ok := c.tables[columnStatsKey{accountID: accountID, tableID: tableID}]; ok {
+			tables[tableID] = true
+		}
+	}
+	account.tables = tables
+}
+
+// update replaces the statistics of the analyzed columns of the table. The cache is
+// copied on write, the maps returned by get are never changed.
+func (c *columnStatsCache) update(accountID uint32, tableID uint64, columnStats map[string]*plan2.ColumnStats) {
+	key := columnStatsKey{accountID: accountID, tableID: tableID}
+	now := time.Now()
+	c.Lock()
+	defer c.Unlock()
+	account, ok := c.accounts[accountID]
+	if !ok {
+		account = &analyzedTables{}
+		c.accounts[accountID] = account
+	}
+	if account.tables == nil {
+		account.tables = make(map[uint64]bool)
+	}
+	account.tables[tableID] = true
+
+	entry, ok := c.tables[key]
+	if !ok {
+		c.evict(now)
+		entry = &columnStatsEntry{}
+		c.tables[key] = entry
+	}
+	entry.accessTime = now
+	merged := make(map[string]*plan2.ColumnStats, len(entry.columnStats)+len(columnStats))
+	for name, cs := range entry.columnStats {
+		merged[name] = cs
+	}
+	for name, cs := range columnStats {
+		merged[name] = cs
+	}
+	entry.columnStats = merged
+}
+
+// remove drops the cached statistics of the account, they are loaded again.
+func (c *columnStatsCache) remove(accountID uint32) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.accounts, accountID)
+	for key := range c.tables {
+		if key.accountID == accountID {
+			delete(c.tables, key)
+		}
+	}
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func escapeString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	return strings.ReplaceAll(s, "'", "\\'")
+}
@@ -0,0 +1,225 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/stretchr/testify/require"
+)
+
+func newMrsForAnalyze(names []string, rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range names {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func TestGetAnalyzeColumns(t *testing.T) {
+	ctx := context.Background()
+	tableDef := &plan.TableDef{
+		Name: "t",
+		Cols: []*plan.ColDef{
+			{Name: "a"},
+			{Name: "b"},
+			{Name: "__mo_cpkey_col", Hidden: true},
+		},
+	}
+
+	cols, err := getAnalyzeColumns(ctx, tableDef, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*plan.ColDef{tableDef.Cols[0], tableDef.Cols[1]}, cols)
+
+	cols, err = getAnalyzeColumns(ctx, tableDef, tree.IdentifierList{"B"})
+	require.NoError(t, err)
+	require.Equal(t, []*plan.ColDef{tableDef.Cols[1]}, cols)
+
+	_, err = getAnalyzeColumns(ctx, tableDef, tree.IdentifierList{"c"})
+	require.Error(t, err)
+}
+
+func TestCollectColumnStats(t *testing.T) {
+	ctx := context.Background()
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar)}},
+	}
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bh.sql2result["select count(*), count(`a`), approx_count_distinct(`a`), count(`b`), approx_count_distinct(`b`) from `db`.`t`;"] =
+		newMrsForAnalyze([]string{"c0", "c1", "c2", "c3", "c4"}, [][]interface{}{{int64(100), int64(80), uint64(4), int64(100), uint64(50)}})
+	samples := make([][]interface{}, 0, 80)
+	for i := 0; i < 60; i++ {
+		samples = append(samples, []interface{}{float64(1)})
+	}
+	for i := 0; i < 20; i++ {
+		samples = append(samples, []interface{}{float64(2 + i%3*4)})
+	}
+	bh.sql2result[fmt.Sprintf(getHistogramSampleFormat, "`a`", "`db`.`t`", "`a`", float64(1), 2*plan2.HistogramSampleRows)] =
+		newMrsForAnalyze([]string{"c0"}, samples)
+
+	columnStats, err := collectColumnStats(ctx, bh, "`db`.`t`", cols)
+	require.NoError(t, err)
+	require.Equal(t, &plan2.ColumnStats{
+		RowCount: 100,
+		NullFrac: 0.2,
+		Ndv:      4,
+		Histogram: &plan2.Histogram{
+			Buckets: []plan2.HistogramBucket{
+				{Lower: 1, Upper: 1, Count: 60, Ndv: 1},
+				{Lower: 2, Upper: 2, Count: 7, Ndv: 1},
+				{Lower: 6, Upper: 6, Count: 7, Ndv: 1},
+				{Lower: 10, Upper: 10, Count: 6, Ndv: 1},
+			},
+		},
+	}, columnStats["a"])
+	require.Equal(t, &plan2.ColumnStats{RowCount: 100, Ndv: 50}, columnStats["b"])
+}
+
+func TestLoadColumnStats(t *testing.T) {
+	ctx := context.Background()
+	exec := newInternalExecutorForTest()
+	exec.sql2result[fmt.Sprintf(getColumnStatsFormat, 1)] = newMrsForAnalyze(
+		[]string{"column_name", "row_count", "null_frac", "ndv", "histogram"},
+		[][]interface{}{
+			{"a", float64(100), float64(0.2), float64(4), `{"buckets":[{"lower":1,"upper":1,"count":60,"ndv":1}]}`},
+			{"b", float64(100), float64(0), float64(50), ""},
+		})
+
+	columnStats, err := loadColumnStats(ctx, exec, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(columnStats))
+	require.Equal(t, []plan2.HistogramBucket{{Lower: 1, Upper: 1, Count: 60, Ndv: 1}}, columnStats["a"].Histogram.Buckets)
+	require.Nil(t, columnStats["b"].Histogram)
+	require.Equal(t, float64(50), columnStats["b"].Ndv)
+}
+
+func TestColumnStatsCache(t *testing.T) {
+	loaded := make(chan struct{}, 1)
+	analyzedLoaded := make(chan struct{}, 1)
+	cache := newColumnStatsCache(
+		func(ctx context.Context, accountID uint32, tableID uint64) (map[string]*plan2.ColumnStats, error) {
+			defer func() { loaded <- struct{}{} }()
+			return map[string]*plan2.ColumnStats{"a": {Ndv: float64(accountID)}}, nil
+		},
+		func(ctx context.Context, accountID uint32) (map[uint64]bool, error) {
+			defer func() { analyzedLoaded <- struct{}{} }()
+			return map[uint64]bool{10: true}, nil
+		})
+
+	// the analyzed tables and then the statistics are loaded in the background
+	require.Nil(t, cache.get(1, 10))
+	<-analyzedLoaded
+	require.Eventually(t, func() bool {
+		return cache.get(1, 10)["a"] != nil
+	}, time.Second*5, time.Millisecond*10)
+	<-loaded
+	require.Equal(t, float64(1), cache.get(1, 10)["a"].Ndv)
+
+	// not loaded again until the reload interval
+	require.Equal(t, 0, len(loaded))
+	require.Equal(t, 0, len(analyzedLoaded))
+
+	// the table not analyzed is not cached
+	require.Nil(t, cache.get(1, 11))
+	require.Equal(t, 1, len(cache.tables))
+
+	// the analyzed columns are replaced, the others are kept
+	before := cache.get(1, 10)
+	cache.update(1, 10, map[string]*plan2.ColumnStats{"b": {Ndv: 5}})
+	after := cache.get(1, 10)
+	require.Equal(t, float64(1), after["a"].Ndv)
+	require.Equal(t, float64(5), after["b"].Ndv)
+	require.Equal(t, 1, len(before))
+
+	// the table analyzed on the cn is cached at once
+	cache.update(1, 11, map[string]*plan2.ColumnStats{"c": {Ndv: 7}})
+	require.Equal(t, float64(7), cache.get(1, 11)["c"].Ndv)
+
+	// the statistics of the account are dropped
+	cache.remove(1)
+	require.Equal(t, 0, len(cache.tables))
+	require.Nil(t, cache.accounts[1])
+}
+
+func TestColumnStatsCacheEvict(t *testing.T) {
+	cache := newColumnStatsCache(nil, nil)
+	now := time.Now()
+	cache.tables[columnStatsKey{accountID: 1, tableID: 1}] = &columnStatsEntry{accessTime: now.Add(-columnStatsIdleTimeout - time.Second)}
+	cache.tables[columnStatsKey{accountID: 1, tableID: 2}] = &columnStatsEntry{accessTime: now}
+	cache.evict(now)
+	require.Equal(t, 1, len(cache.tables))
+	require.NotNil(t, cache.tables[columnStatsKey{accountID: 1, tableID: 2}])
+
+	for i := 0; i < columnStatsCacheSize; i++ {
+		cache.tables[columnStatsKey{accountID: 2, tableID: uint64(i)}] = &columnStatsEntry{accessTime: now.Add(time.Duration(i+1) * time.Millisecond)}
+	}
+	cache.evict(now)
+	require.Equal(t, columnStatsCacheSize-1, len(cache.tables))
+	// the least recently planned one is evicted
+	require.Nil(t, cache.tables[columnStatsKey{accountID: 1, tableID: 2}])
+}
+
+func TestLoadAnalyzedTables(t *testing.T) {
+	ctx := context.Background()
+	exec := newInternalExecutorForTest()
+	exec.sql2result[getAnalyzedTablesSql] = newMrsForAnalyze(
+		[]string{"table_id"}, [][]interface{}{{uint64(3)}, {uint64(5)}})
+
+	tables, err := loadAnalyzedTables(ctx, exec)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]bool{3: true, 5: true}, tables)
+}
+
+type internalExecutorForTest struct {
+	sql2result map[string]*MysqlResultSet
+	executed   []string
+}
+
+func newInternalExecutorForTest() *internalExecutorForTest {
+	return &internalExecutorForTest{sql2result: make(map[string]*MysqlResultSet)}
+}
+
+func (exec *internalExecutorForTest) Exec(ctx context.Context, sql string, opts ie.SessionOverrideOptions) error {
+	exec.executed = append(exec.executed, sql)
+	return nil
+}
+
+func (exec *internalExecutorForTest) Query(ctx context.Context, sql string, opts ie.SessionOverrideOptions) ie.InternalExecResult {
+	mrs, ok := exec.sql2result[sql]
+	if !ok {
+		mrs = &MysqlResultSet{}
+	}
+	return &internalExecResult{resultSet: mrs}
+}
+
+func (exec *internalExecutorForTest) ApplySessionOverride(opts ie.SessionOverrideOptions) {}
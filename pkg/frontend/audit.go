@@ -0,0 +1,396 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+)
+
+/*
+The audit log records the logins and the statements of the users. The frontend
+calls the audit plugins at two points:
+
+ 1. connection: the client is authenticated or rejected in the handshake, see
+    RoutineManager.Handler.
+ 2. statement: the statement is finished, see logStatementStatus.
+
+The events are filtered by the rules in the global variables, only the moadmin
+of the sys account can set them. They are saved in the table
+mo_catalog.mo_audit_config of the sys account, and every cn loads them from the
+table in auditConfigLoadInterval, so they are the same in the whole cluster:
+
+	audit_log            enables the audit log.
+	audit_log_events     the comma separated classes of the events, in
+	                     [connect, ddl, dcl, dml, dql, tcl, other, all].
+	audit_log_accounts   the comma separated accounts, empty for all.
+	audit_log_users      the comma separated users, empty for all.
+	audit_log_statement  records the text of the statement besides the digest.
+
+The default plugin writes the events through the batch pipeline of the trace
+into the table system.system_audit, or the local csv files if the auditLogSink
+of the observability is file. It fails with the trace disabled.
+*/
+
+const (
+	auditLogVar          = "audit_log"
+	auditLogEventsVar    = "audit_log_events"
+	auditLogAccountsVar  = "audit_log_accounts"
+	auditLogUsersVar     = "audit_log_users"
+	auditLogStatementVar = "audit_log_statement"
+
+	auditClassConnect = "connect"
+	auditClassAll     = "all"
+
+	// every cn loads the variables of the audit log in the interval
+	auditConfigLoadInterval = 5 * time.Second
+)
+
+const (
+	deleteAuditVariableFormat = `delete from mo_catalog.mo_audit_config where variable_name = '%s';`
+	insertAuditVariableFormat = `insert into mo_catalog.mo_audit_config(variable_name, variable_value, modified_time) values ('%s', '%s', now());`
+	getAuditVariablesSql      = `select variable_name, variable_value from mo_catalog.mo_audit_config;`
+)
+
+var auditClasses = map[string]bool{
+	auditClassConnect:                  true,
+	strings.ToLower(tree.QueryTypeDDL): true,
+	strings.ToLower(tree.QueryTypeDCL): true,
+	strings.ToLower(tree.QueryTypeDML): true,
+	strings.ToLower(tree.QueryTypeDQL): true,
+	strings.ToLower(tree.QueryTypeTCL): true,
+	strings.ToLower(tree.QueryTypeOth): true,
+	auditClassAll:                      true,
+}
+
+// AuditPlugin receives the events of the audit log.
+type AuditPlugin interface {
+	// Audit is called with the event matched by the filter rules.
+	Audit(ctx context.Context, record *motrace.AuditRecord) error
+}
+
+// AuditPluginFunc adapts the function to AuditPlugin.
+type AuditPluginFunc func(ctx context.Context, record *motrace.AuditRecord) error
+
+func (f AuditPluginFunc) Audit(ctx context.Context, record *motrace.AuditRecord) error {
+	return f(ctx, record)
+}
+
+var auditPlugins = struct {
+	sync.RWMutex
+	plugins []AuditPlugin
+}{
+	plugins: []AuditPlugin{AuditPluginFunc(motrace.ReportAudit)},
+}
+
+// RegisterAuditPlugin adds the plugin that receives the events of the audit log.
+func RegisterAuditPlugin(p AuditPlugin) {
+	auditPlugins.Lock()
+	defer auditPlugins.Unlock()
+	auditPlugins.plugins = append(auditPlugins.plugins, p)
+}
+
+func getAuditPlugins() []AuditPlugin {
+	auditPlugins.RLock()
+	defer auditPlugins.RUnlock()
+	return auditPlugins.plugins
+}
+
+// auditFilter is the filter rules of the audit log
+type auditFilter struct {
+	classes map[string]bool
+	// nil for all
+	accounts map[string]bool
+	// nil for all
+	users     map[string]bool
+	statement bool
+}
+
+// parseAuditList splits the comma separated list, it returns nil for the empty list.
+func parseAuditList(v interface{}) map[string]bool {
+	s, _ := v.(string)
+	var list map[string]bool
+	for _, item := range strings.Split(s, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" {
+			continue
+		}
+		if list == nil {
+			list = make(map[string]bool)
+		}
+		list[item] = true
+	}
+	return list
+}
+
+// getAuditFilter returns the filter rules, or nil if the audit log is disabled.
+func getAuditFilter(gsv *GlobalSystemVariables) *auditFilter {
+	svbt := SystemVariableBoolType{}
+	if _, val, ok := gsv.GetGlobalSysVar(auditLogVar); !ok || !svbt.IsTrue(val) {
+		return nil
+	}
+	_, events, _ := gsv.GetGlobalSysVar(auditLogEventsVar)
+	_, accounts, _ := gsv.GetGlobalSysVar(auditLogAccountsVar)
+	_, users, _ := gsv.GetGlobalSysVar(auditLogUsersVar)
+	_, statement, _ := gsv.GetGlobalSysVar(auditLogStatementVar)
+	return &auditFilter{
+		classes:   parseAuditList(events),
+		accounts:  parseAuditList(accounts),
+		users:     parseAuditList(users),
+		statement: svbt.IsTrue(statement),
+	}
+}
+
+func (f *auditFilter) match(class, account, user string) bool {
+	if !f.classes[auditClassAll] && !f.classes[strings.ToLower(class)] {
+		return false
+	}
+	if f.accounts != nil && !f.accounts[strings.ToLower(account)] {
+		return false
+	}
+	if f.users != nil && !f.users[strings.ToLower(user)] {
+		return false
+	}
+	return true
+}
+
+// checkAuditVariable checks the value of the global variable of the audit log.
+func checkAuditVariable(ctx context.Context, ses *Session, name string, value interface{}) error {
+	name = strings.ToLower(name)
+	if !isAuditVariable(name) {
+		return nil
+	}
+	tenant := ses.GetTenantInfo()
+	if tenant == nil || !tenant.IsMoAdminRole() {
+		return moerr.NewInternalError(ctx, "only the moadmin of the sys account can set the variable %s", name)
+	}
+	if name == auditLogEventsVar {
+		for class := range parseAuditList(value) {
+			if !auditClasses[class] {
+				return moerr.NewInvalidArg(ctx, "audit log event", class)
+			}
+		}
+	}
+	return nil
+}
+
+// isAuditVariable returns whether the variable is of the audit log.
+func isAuditVariable(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), auditLogVar)
+}
+
+// auditVariableString returns the value of the global variable saved in mo_audit_config.
+func auditVariableString(gsv *GlobalSystemVariables, name string) string {
+	def, val, _ := gsv.GetGlobalSysVar(name)
+	if svbt, ok := def.GetType().(SystemVariableBoolType); ok {
+		if svbt.IsTrue(val) {
+			return "on"
+		}
+		return "off"
+	}
+	return fmt.Sprintf("%v", val)
+}
+
+// saveAuditVariable saves the global variable of the audit log set in the session,
+// the other cns load it from mo_audit_config.
+func saveAuditVariable(ctx context.Context, ses *Session, name string) error {
+	var err error
+	name = strings.ToLower(name)
+	value := escapeString(auditVariableString(ses.GetGlobalSysVars(), name))
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, fmt.Sprintf(deleteAuditVariableFormat, name))
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, fmt.Sprintf(insertAuditVariableFormat, name, value))
+	if err != nil {
+		goto handleFailed
+	}
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	return err
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// loadAuditVariables sets the global variables of the audit log saved in mo_audit_config.
+func loadAuditVariables(ctx context.Context, exec ie.InternalExecutor, gsv *GlobalSystemVariables) error {
+	result := exec.Query(ctx, getAuditVariablesSql, ie.NewOptsBuilder().Internal(true).Finish())
+	if err := result.Error(); err != nil {
+		return err
+	}
+	for i := uint64(0); i < result.RowCount(); i++ {
+		name, err := result.StringValueByName(ctx, i, "variable_name")
+		if err != nil {
+			return err
+		}
+		value, err := result.StringValueByName(ctx, i, "variable_value")
+		if err != nil {
+			return err
+		}
+		if !isAuditVariable(name) {
+			continue
+		}
+		if err = gsv.SetGlobalSysVar(ctx, name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunAuditConfigLoader loads the variables of the audit log set on any cn every
+// auditConfigLoadInterval until ctx is done.
+func RunAuditConfigLoader(ctx context.Context, pu *config.ParameterUnit, aicm *defines.AutoIncrCacheManager) {
+	exec := NewInternalExecutor(pu, aicm)
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	sysCtx = context.WithValue(sysCtx, defines.UserIDKey{}, uint32(rootID))
+	sysCtx = context.WithValue(sysCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+	ticker := time.NewTicker(auditConfigLoadInterval)
+	defer ticker.Stop()
+	for {
+		if err := loadAuditVariables(sysCtx, exec, GSysVariables); err != nil {
+			logutil.Warnf("load the variables of the audit log failed. error:%v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// clientIP returns the ip of the address like ip:port
+func clientIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// statementDigest returns the sha256 of the statement with the literals replaced by '?'.
+func statementDigest(stmt tree.Statement) string {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithHiddenValue())
+	stmt.Format(fmtCtx)
+	sum := sha256.Sum256([]byte(fmtCtx.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+func newAuditRecord(ses *Session, event string, tenant *TenantInfo) *motrace.AuditRecord {
+	r := &motrace.AuditRecord{
+		Timestamp: time.Now(),
+		Event:     event,
+		Account:   tenant.GetTenant(),
+		User:      tenant.GetUser(),
+		Role:      tenant.GetDefaultRole(),
+		Database:  ses.GetDatabaseName(),
+	}
+	copy(r.SessionID[:], ses.GetUUID())
+	if ses.protocol != nil {
+		r.ConnectionID = ses.protocol.ConnectionID()
+		r.Host = clientIP(ses.protocol.Peer())
+	}
+	return r
+}
+
+func reportAudit(ctx context.Context, ses *Session, r *motrace.AuditRecord) {
+	for _, p := range getAuditPlugins() {
+		if err := p.Audit(ctx, r); err != nil {
+			logErrorf(ses.GetDebugString(), "audit. error:%v", err)
+		}
+	}
+}
+
+// auditConnect records the login of the user, err is the failure of the authentication.
+func auditConnect(ctx context.Context, ses *Session, userInput string, err error) {
+	if ses == nil {
+		return
+	}
+	filter := getAuditFilter(GSysVariables)
+	if filter == nil {
+		return
+	}
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		var err2 error
+		if tenant, err2 = GetTenantInfo(ctx, userInput); err2 != nil {
+			tenant = &TenantInfo{User: userInput}
+		}
+	}
+	if !filter.match(auditClassConnect, tenant.GetTenant(), tenant.GetUser()) {
+		return
+	}
+	r := newAuditRecord(ses, motrace.AuditEventConnect, tenant)
+	r.Error = err
+	reportAudit(ctx, ses, r)
+}
+
+// auditStatement records the finished statement of the user.
+func auditStatement(ctx context.Context, ses *Session, stmt tree.Statement, err error) {
+	if ses == nil || stmt == nil || ses.IsBackgroundSession() || ses.GetIsInternal() {
+		return
+	}
+	filter := getAuditFilter(GSysVariables)
+	if filter == nil {
+		return
+	}
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return
+	}
+	stmtType := getStatementType(stmt)
+	if !filter.match(stmtType.GetQueryType(), tenant.GetTenant(), tenant.GetUser()) {
+		return
+	}
+	r := newAuditRecord(ses, motrace.AuditEventStatement, tenant)
+	r.QueryType = stmtType.GetQueryType()
+	r.StatementType = stmtType.GetStatementType()
+	r.Digest = statementDigest(stmt)
+	if filter.statement {
+		// the passwords are hidden in the formatted statement
+		r.Statement = tree.String(stmt, dialect.MYSQL)
+	}
+	r.Error = err
+	reportAudit(ctx, ses, r)
+}
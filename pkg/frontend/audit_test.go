@@ -0,0 +1,204 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func setAuditVariables(t *testing.T, values map[string]interface{}) {
+	ctx := context.TODO()
+	for name, value := range values {
+		_, old, _ := GSysVariables.GetGlobalSysVar(name)
+		require.NoError(t, GSysVariables.SetGlobalSysVar(ctx, name, value))
+		name := name
+		t.Cleanup(func() {
+			_ = GSysVariables.SetGlobalSysVar(ctx, name, old)
+		})
+	}
+}
+
+func captureAudit(t *testing.T) *[]*motrace.AuditRecord {
+	var records []*motrace.AuditRecord
+	auditPlugins.Lock()
+	old := auditPlugins.plugins
+	auditPlugins.plugins = nil
+	auditPlugins.Unlock()
+	t.Cleanup(func() {
+		auditPlugins.Lock()
+		auditPlugins.plugins = old
+		auditPlugins.Unlock()
+	})
+	RegisterAuditPlugin(AuditPluginFunc(func(ctx context.Context, r *motrace.AuditRecord) error {
+		records = append(records, r)
+		return nil
+	}))
+	return &records
+}
+
+func Test_auditFilter(t *testing.T) {
+	require.Nil(t, getAuditFilter(GSysVariables))
+
+	setAuditVariables(t, map[string]interface{}{
+		auditLogVar:         int8(1),
+		auditLogEventsVar:   "Connect, DDL",
+		auditLogAccountsVar: "acc1,sys",
+	})
+	filter := getAuditFilter(GSysVariables)
+	require.NotNil(t, filter)
+	require.True(t, filter.statement)
+	require.Nil(t, filter.users)
+	require.True(t, filter.match("connect", "sys", "root"))
+	require.True(t, filter.match("DDL", "acc1", "u1"))
+	require.False(t, filter.match("DML", "acc1", "u1"))
+	require.False(t, filter.match("DDL", "acc2", "u1"))
+
+	setAuditVariables(t, map[string]interface{}{
+		auditLogEventsVar: "all",
+		auditLogUsersVar:  "u1",
+	})
+	filter = getAuditFilter(GSysVariables)
+	require.True(t, filter.match("DML", "acc1", "u1"))
+	require.False(t, filter.match("DML", "acc1", "u2"))
+}
+
+func Test_checkAuditVariable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+	ctx := ses.GetRequestContext()
+	require.NoError(t, checkAuditVariable(ctx, ses, "autocommit", int8(1)))
+	require.NoError(t, checkAuditVariable(ctx, ses, auditLogEventsVar, "ddl,dml"))
+	err := checkAuditVariable(ctx, ses, auditLogEventsVar, "ddl,select")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidArg))
+
+	ses.SetTenantInfo(&TenantInfo{Tenant: "acc1", User: "u1", DefaultRole: accountAdminRoleName})
+	require.Error(t, checkAuditVariable(ctx, ses, auditLogVar, int8(1)))
+}
+
+func Test_saveAuditVariable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	ctx := ses.GetRequestContext()
+	setAuditVariables(t, map[string]interface{}{
+		auditLogVar:      int8(1),
+		auditLogUsersVar: "u1,u2",
+	})
+	require.NoError(t, saveAuditVariable(ctx, ses, "AUDIT_LOG"))
+	require.NoError(t, saveAuditVariable(ctx, ses, auditLogUsersVar))
+	require.Contains(t, bh.sqls, fmt.Sprintf(deleteAuditVariableFormat, auditLogVar))
+	require.Contains(t, bh.sqls, fmt.Sprintf(insertAuditVariableFormat, auditLogVar, "on"))
+	require.Contains(t, bh.sqls, fmt.Sprintf(insertAuditVariableFormat, auditLogUsersVar, "u1,u2"))
+}
+
+func Test_loadAuditVariables(t *testing.T) {
+	ctx := context.TODO()
+	setAuditVariables(t, map[string]interface{}{
+		auditLogVar:      int8(0),
+		auditLogUsersVar: "",
+	})
+	exec := newInternalExecutorForTest()
+	exec.sql2result[getAuditVariablesSql] = newMrsForAnalyze(
+		[]string{"variable_name", "variable_value"},
+		[][]interface{}{{auditLogVar, "on"}, {auditLogUsersVar, "u1"}, {"autocommit", "off"}})
+
+	_, autocommit, _ := GSysVariables.GetGlobalSysVar("autocommit")
+	require.NoError(t, loadAuditVariables(ctx, exec, GSysVariables))
+	filter := getAuditFilter(GSysVariables)
+	require.NotNil(t, filter)
+	require.Equal(t, map[string]bool{"u1": true}, filter.users)
+	// the other variables are not loaded
+	_, val, _ := GSysVariables.GetGlobalSysVar("autocommit")
+	require.Equal(t, autocommit, val)
+}
+
+func Test_statementDigest(t *testing.T) {
+	stmt1, err := mysql.ParseOne(context.TODO(), "select a from t where b = 1 and c = 'x'", 1)
+	require.NoError(t, err)
+	stmt2, err := mysql.ParseOne(context.TODO(), "select a from t where b = 2 and c = 'y'", 1)
+	require.NoError(t, err)
+	stmt3, err := mysql.ParseOne(context.TODO(), "select a from t where b = 2", 1)
+	require.NoError(t, err)
+	require.Equal(t, statementDigest(stmt1), statementDigest(stmt2))
+	require.NotEqual(t, statementDigest(stmt1), statementDigest(stmt3))
+	require.Len(t, statementDigest(stmt1), 64)
+}
+
+func Test_auditStatementAndConnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	records := captureAudit(t)
+	ses := newSes(nil, ctrl)
+	ctx := ses.GetRequestContext()
+
+	ddl, err := mysql.ParseOne(ctx, "create user u1 identified by '123456'", 1)
+	require.NoError(t, err)
+	dml, err := mysql.ParseOne(ctx, "insert into t values (1)", 1)
+	require.NoError(t, err)
+
+	// disabled
+	auditStatement(ctx, ses, ddl, nil)
+	auditConnect(ctx, ses, "root", nil)
+	require.Empty(t, *records)
+
+	setAuditVariables(t, map[string]interface{}{
+		auditLogVar:       int8(1),
+		auditLogEventsVar: "connect,dcl",
+	})
+	auditStatement(ctx, ses, ddl, nil)
+	auditStatement(ctx, ses, dml, nil)
+	require.Len(t, *records, 1)
+	r := (*records)[0]
+	require.Equal(t, motrace.AuditEventStatement, r.Event)
+	require.Equal(t, sysAccountName, r.Account)
+	require.Equal(t, rootName, r.User)
+	require.Equal(t, moAdminRoleName, r.Role)
+	require.Equal(t, "DCL", r.QueryType)
+	require.NotContains(t, r.Statement, "123456")
+	require.NotEmpty(t, r.Digest)
+
+	ses.SetTenantInfo(nil)
+	auditConnect(ctx, ses, "acc1:u1", moerr.NewInternalError(ctx, "wrong password"))
+	require.Len(t, *records, 2)
+	r = (*records)[1]
+	require.Equal(t, motrace.AuditEventConnect, r.Event)
+	require.Equal(t, "acc1", r.Account)
+	require.Equal(t, "u1", r.User)
+	require.Error(t, r.Error)
+
+	// the background session is not audited
+	ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: rootName})
+	ses.SetBackgroundSession(true)
+	auditStatement(ctx, ses, ddl, nil)
+	require.Len(t, *records, 2)
+}
@@ -490,6 +490,7 @@ const (
 	PrivilegeTypeExecute
 	PrivilegeTypeCanGrantRoleToOthersInCreateUser // used in checking the privilege of CreateUser with the default role
 	PrivilegeTypeValues
+	PrivilegeTypeBackupAdmin
 )
 
 type PrivilegeScope uint8
@@ -623,6 +624,8 @@ func (pt PrivilegeType) String() string {
 		return "execute"
 	case PrivilegeTypeValues:
 		return "values"
+	case PrivilegeTypeBackupAdmin:
+		return "backup_admin"
 	}
 	panic(fmt.Sprintf("no such privilege type %d", pt))
 }
@@ -699,6 +702,8 @@ func (pt PrivilegeType) Scope() PrivilegeScope {
 		return PrivilegeScopeTable
 	case PrivilegeTypeValues:
 		return PrivilegeScopeTable
+	case PrivilegeTypeBackupAdmin:
+		return PrivilegeScopeSys
 	}
 	panic(fmt.Sprintf("no such privilege type %d", pt))
 }
@@ -719,6 +724,7 @@ var (
 		"mo_role_privs":               0,
 		"mo_user_defined_function":    0,
 		"mo_mysql_compatibility_mode": 0,
+		"mo_account_quota":            0,
 		catalog.AutoIncrTableName:     0,
 	}
 	//predefined tables of the database mo_catalog in every account
@@ -738,6 +744,13 @@ var (
 		catalog.AutoIncrTableName:     0,
 		"mo_indexes":                  0,
 		"mo_pubs":                     0,
+		"mo_column_stats":             0,
+		"mo_column_privs":             0,
+		"mo_policies":                 0,
+		"mo_account_quota":            0,
+		"mo_account_usage":            0,
+		"mo_audit_config":             0,
+		"mo_user_pg_auth":             0,
 	}
 	createAutoTableSql = fmt.Sprintf("create table `%s`(name varchar(770) primary key, offset bigint unsigned, step bigint unsigned);", catalog.AutoIncrTableName)
 	// mo_indexes is a data dictionary table, must be created first when creating tenants, and last when deleting tenants
@@ -783,6 +796,27 @@ var (
 				version bigint unsigned default 0,
 				suspended_time timestamp default NULL
 			);`,
+		`create table mo_account_quota(
+				account_id int unsigned primary key,
+				max_connections bigint,
+				max_queries bigint,
+				query_memory bigint,
+				storage_size bigint,
+				modified_time timestamp
+			);`,
+		`create table mo_account_usage(
+				cn_id varchar(64),
+				account_id int unsigned,
+				connections bigint,
+				queries bigint,
+				report_time bigint,
+				primary key(cn_id, account_id)
+			);`,
+		`create table mo_audit_config(
+				variable_name varchar(64) primary key,
+				variable_value varchar(5000),
+				modified_time timestamp
+			);`,
 		`create table mo_role(
 				role_id int signed auto_increment primary key,
 				role_name varchar(300),
@@ -877,6 +911,41 @@ var (
 				database_collation varchar(64),
 				primary key(proc_id)
 			);`,
+		`create table mo_column_stats(
+				table_id bigint unsigned,
+				column_name varchar(256),
+				row_count double,
+				null_frac double,
+				ndv double,
+				histogram text,
+				analyzed_time timestamp,
+				primary key(table_id, column_name)
+			);`,
+		`create table mo_column_privs(
+				role_id int signed,
+				role_name varchar(100),
+				database_name varchar(5000),
+				table_name varchar(5000),
+				column_name varchar(256),
+				operation_user_id int unsigned,
+				granted_time timestamp,
+				primary key(role_id, database_name, table_name, column_name)
+			);`,
+		`create table mo_policies(
+				policy_name varchar(64),
+				database_name varchar(5000),
+				table_name varchar(5000),
+				role_names text,
+				policy_expr text,
+				creator int unsigned,
+				created_time timestamp,
+				primary key(database_name, table_name, policy_name)
+			);`,
+		`create table mo_user_pg_auth(
+				user_id int signed primary key,
+				scram_verifier varchar(256),
+				md5_password varchar(64)
+			);`,
 	}
 
 	//drop tables for the tenant
@@ -889,6 +958,10 @@ var (
 		`drop table if exists mo_catalog.mo_user_defined_function;`,
 		`drop table if exists mo_catalog.mo_stored_procedure;`,
 		`drop table if exists mo_catalog.mo_mysql_compatibility_mode;`,
+		`drop table if exists mo_catalog.mo_column_stats;`,
+		`drop table if exists mo_catalog.mo_column_privs;`,
+		`drop table if exists mo_catalog.mo_policies;`,
+		`drop table if exists mo_catalog.mo_user_pg_auth;`,
 	}
 	dropMoPubsSql     = `drop table if exists mo_catalog.mo_pubs;`
 	deleteMoPubsSql   = `delete from mo_catalog.mo_pubs;`
@@ -1244,6 +1317,8 @@ const (
 
 	deleteRoleFromMoRolePrivsFormat = `delete from mo_catalog.mo_role_privs where role_id = %d;`
 
+	deleteRoleFromMoColumnPrivsFormat = `delete from mo_catalog.mo_column_privs where role_id = %d;`
+
 	//delete user from mo_user,mo_user_grant
 	deleteUserFromMoUserFormat = `delete from mo_catalog.mo_user where user_id = %d;`
 
@@ -1680,6 +1755,7 @@ func getSqlForDeleteRole(roleId int64) []string {
 		fmt.Sprintf(deleteRoleFromMoUserGrantFormat, roleId),
 		fmt.Sprintf(deleteRoleFromMoRoleGrantFormat, roleId, roleId),
 		fmt.Sprintf(deleteRoleFromMoRolePrivsFormat, roleId),
+		fmt.Sprintf(deleteRoleFromMoColumnPrivsFormat, roleId),
 	}
 }
 
@@ -1691,6 +1767,7 @@ func getSqlForDeleteUser(userId int64) []string {
 	return []string{
 		fmt.Sprintf(deleteUserFromMoUserFormat, userId),
 		fmt.Sprintf(deleteUserFromMoUserGrantFormat, userId),
+		fmt.Sprintf(deletePgAuthOfUserFormat, userId),
 	}
 }
 
@@ -1869,6 +1946,7 @@ var (
 		PrivilegeTypeTableOwnership:    {PrivilegeTypeTableOwnership, privilegeLevelStarStar, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeExecute:           {PrivilegeTypeExecute, privilegeLevelRoutine, objectTypeFunction, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
 		PrivilegeTypeValues:            {PrivilegeTypeValues, privilegeLevelTable, objectTypeTable, objectIDAll, true, "", "", privilegeEntryTypeGeneral, nil},
+		PrivilegeTypeBackupAdmin:       {PrivilegeTypeBackupAdmin, privilegeLevelStar, objectTypeAccount, objectIDAll, false, "", "", privilegeEntryTypeGeneral, nil},
 	}
 
 	//the initial entries of mo_role_privs for the role 'moadmin'
@@ -2435,7 +2513,7 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 	}
 
 	//encryption the password
-	encryption = HashPassWord(password)
+	encryption = hashPasswordOfPlugin(getDefaultAuthPlugin(ses.GetParameterUnit()), password)
 
 	if execResultArrayHasData(erArray) {
 		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
@@ -2461,6 +2539,14 @@ func doAlterUser(ctx context.Context, ses *Session, au *tree.AlterUser) error {
 		}
 	}
 
+	//keep the secrets of the postgresql password authentications
+	for _, sql = range getSqlsForPgAuthOfUser(vr.id, account.GetTenant(), userName, password) {
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+	}
+
 	err = bh.Exec(ctx, "commit;")
 	if err != nil {
 		goto handleFailed
@@ -2485,6 +2571,8 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 	var targetAccountId uint64
 	var version uint64
 	var accountExist bool
+	var adminId int64
+	var quota accountQuota
 	account := ses.GetTenantInfo()
 	if !(account.IsSysTenant() && account.IsMoAdminRole()) {
 		return moerr.NewInternalError(ctx, "tenant %s user %s role %s do not have the privilege to alter the account",
@@ -2501,6 +2589,9 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 	if aa.Comment.Exist {
 		optionBits |= 1 << 2
 	}
+	if aa.Quota.Exist {
+		optionBits |= 1 << 3
+	}
 	optionCount := bits.OnesCount8(optionBits)
 	if optionCount == 0 {
 		return moerr.NewInternalError(ctx, "at least one option at a time")
@@ -2537,6 +2628,13 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 		}
 	}
 
+	if aa.Quota.Exist {
+		//SYS account is not limited by the quotas
+		if isSysTenant(aa.Name) {
+			return moerr.NewInternalError(ctx, "account sys can not be limited by the quota")
+		}
+	}
+
 	bh := ses.GetBackgroundExec(ctx)
 	defer bh.Close()
 
@@ -2611,9 +2709,14 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 				goto handleFailed
 			}
 
+			adminId, err = erArray[0].GetInt64(accountCtx, 0, 0)
+			if err != nil {
+				goto handleFailed
+			}
+
 			//2, update the password
 			//encryption the password
-			encryption := HashPassWord(aa.AuthOption.IdentifiedType.Str)
+			encryption := hashPasswordOfPlugin(getDefaultAuthPlugin(ses.GetParameterUnit()), aa.AuthOption.IdentifiedType.Str)
 			sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, aa.AuthOption.AdminName)
 			if err != nil {
 				goto handleFailed
@@ -2623,6 +2726,14 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 			if err != nil {
 				goto handleFailed
 			}
+
+			//3, keep the secrets of the postgresql password authentications
+			for _, sql = range getSqlsForPgAuthOfUser(adminId, aa.Name, aa.AuthOption.AdminName, aa.AuthOption.IdentifiedType.Str) {
+				err = bh.Exec(accountCtx, sql)
+				if err != nil {
+					goto handleFailed
+				}
+			}
 		}
 
 		//Option 2: alter the comment of the account
@@ -2662,12 +2773,38 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *tree.AlterAccount) er
 				}
 			}
 		}
+
+		//Option 4: set the quotas of the account
+		if aa.Quota.Exist {
+			//the quotas not in the options are kept
+			quota, err = readAccountQuota(ctx, bh, uint32(targetAccountId))
+			if err != nil {
+				goto handleFailed
+			}
+			err = quota.apply(ctx, aa.Quota.Options)
+			if err != nil {
+				goto handleFailed
+			}
+			bh.ClearExecResultSet()
+			err = bh.Exec(ctx, getSqlForDeleteAccountQuota(uint32(targetAccountId)))
+			if err != nil {
+				goto handleFailed
+			}
+			err = bh.Exec(ctx, getSqlForInsertAccountQuota(uint32(targetAccountId), quota))
+			if err != nil {
+				goto handleFailed
+			}
+		}
 	}
 
 	err = bh.Exec(ctx, "commit;")
 	if err != nil {
 		goto handleFailed
 	}
+	//the other cns load the quotas when the users of the account login
+	if accountExist && aa.Quota.Exist {
+		globalAccountQuotas.setQuota(uint32(targetAccountId), quota)
+	}
 	return err
 handleFailed:
 	//ROLLBACK the transaction
@@ -3506,6 +3643,12 @@ func doDropAccount(ctx context.Context, ses *Session, da *tree.DropAccount) erro
 		goto handleFailed
 	}
 
+	//step5: delete the quotas of the account
+	err = bh.Exec(ctx, getSqlForDeleteAccountQuota(uint32(accountId)))
+	if err != nil {
+		goto handleFailed
+	}
+
 	err = bh.Exec(ctx, "commit;")
 	if err != nil {
 		goto handleFailed
@@ -3899,6 +4042,9 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 
 	verifiedRoles := make([]*verifiedRole, len(rp.Roles))
 	checkedPrivilegeTypes := make([]PrivilegeType, len(rp.Privileges))
+	//the columns of REVOKE SELECT (col, ...)
+	var dbName, tblName string
+	columnsOfPrivileges := make([][]string, len(rp.Privileges))
 
 	//put it into the single transaction
 	err = bh.Exec(ctx, "begin;")
@@ -3948,6 +4094,20 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 		if err != nil {
 			goto handleFailed
 		}
+		if len(priv.ColumnList) != 0 {
+			if privType != PrivilegeTypeSelect {
+				err = moerr.NewNotSupported(ctx, "the column privilege %s", privType)
+				goto handleFailed
+			}
+			dbName, tblName, err = getColumnPrivilegeTable(ctx, ses, rp.ObjType, rp.Level)
+			if err != nil {
+				goto handleFailed
+			}
+			columnsOfPrivileges[i], err = getColumnPrivilegeColumns(ctx, ses, dbName, tblName, priv.ColumnList)
+			if err != nil {
+				goto handleFailed
+			}
+		}
 		checkedPrivilegeTypes[i] = privType
 	}
 
@@ -3958,7 +4118,16 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 	}
 
 	//step 3: delete the granted privilege
-	for _, privType = range checkedPrivilegeTypes {
+	for i := range checkedPrivilegeTypes {
+		privType = checkedPrivilegeTypes[i]
+		//the column privileges are kept in mo_column_privs
+		if columnsOfPrivileges[i] != nil {
+			err = revokeColumnPrivilege(ctx, bh, dbName, tblName, columnsOfPrivileges[i], verifiedRoles)
+			if err != nil {
+				goto handleFailed
+			}
+			continue
+		}
 		for _, role := range verifiedRoles {
 			if role == nil {
 				continue
@@ -3980,6 +4149,8 @@ func doRevokePrivilege(ctx context.Context, ses *Session, rp *tree.RevokePrivile
 	if err != nil {
 		goto handleFailed
 	}
+	//the cached column privileges of the account are changed
+	globalTableAccess.invalidate(ses.GetTenantInfo().GetTenantID())
 
 	return err
 
@@ -4183,6 +4354,9 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 	//step 1: get role_id
 	verifiedRoles := make([]*verifiedRole, len(gp.Roles))
 	checkedPrivilegeTypes := make([]PrivilegeType, len(gp.Privileges))
+	//the columns of GRANT SELECT (col, ...)
+	var dbName, tblName string
+	columnsOfPrivileges := make([][]string, len(gp.Privileges))
 
 	//put it into the single transaction
 	err = bh.Exec(ctx, "begin;")
@@ -4245,11 +4419,29 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 			err = moerr.NewInternalError(ctx, "the privilege %s can not be granted", privType)
 			goto handleFailed
 		}
+		if privType.Scope() == PrivilegeScopeSys && !account.IsSysTenant() {
+			err = moerr.NewInternalError(ctx, "the privilege %s can only be granted in the sys account", privType)
+			goto handleFailed
+		}
 		//check the match between the privilegeScope and the objectType
 		err = matchPrivilegeTypeWithObjectType(ctx, privType, objType)
 		if err != nil {
 			goto handleFailed
 		}
+		if len(priv.ColumnList) != 0 {
+			if privType != PrivilegeTypeSelect {
+				err = moerr.NewNotSupported(ctx, "the column privilege %s", privType)
+				goto handleFailed
+			}
+			dbName, tblName, err = getColumnPrivilegeTable(ctx, ses, gp.ObjType, gp.Level)
+			if err != nil {
+				goto handleFailed
+			}
+			columnsOfPrivileges[i], err = getColumnPrivilegeColumns(ctx, ses, dbName, tblName, priv.ColumnList)
+			if err != nil {
+				goto handleFailed
+			}
+		}
 		checkedPrivilegeTypes[i] = privType
 	}
 
@@ -4264,7 +4456,16 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 	//step 5: check exists
 	//step 6: update or insert
 
-	for _, privType = range checkedPrivilegeTypes {
+	for i := range checkedPrivilegeTypes {
+		privType = checkedPrivilegeTypes[i]
+		//the column privileges are kept in mo_column_privs
+		if columnsOfPrivileges[i] != nil {
+			err = grantColumnPrivilege(ctx, bh, ses, dbName, tblName, columnsOfPrivileges[i], verifiedRoles)
+			if err != nil {
+				goto handleFailed
+			}
+			continue
+		}
 		for _, role := range verifiedRoles {
 			sql := getSqlForCheckRoleHasPrivilege(role.id, objType, objId, int64(privType))
 			//check exists
@@ -4316,6 +4517,8 @@ func doGrantPrivilege(ctx context.Context, ses *Session, gp *tree.GrantPrivilege
 	if err != nil {
 		goto handleFailed
 	}
+	//the cached column privileges of the account are changed
+	globalTableAccess.invalidate(ses.GetTenantInfo().GetTenantID())
 
 	return err
 handleFailed:
@@ -4809,6 +5012,8 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		typs = append(typs, PrivilegeTypeCreateRole, PrivilegeTypeAccountAll /*, PrivilegeTypeAccountOwnership*/)
 	case *tree.DropRole:
 		typs = append(typs, PrivilegeTypeDropRole, PrivilegeTypeAccountAll /*, PrivilegeTypeAccountOwnership, PrivilegeTypeRoleOwnership*/)
+	case *tree.CreatePolicy, *tree.DropPolicy:
+		typs = append(typs, PrivilegeTypeManageGrants, PrivilegeTypeAccountAll /*, PrivilegeTypeAccountOwnership*/)
 	case *tree.Grant:
 		if st.Typ == tree.GrantTypeRole {
 			kind = privilegeKindInherit
@@ -4970,7 +5175,7 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		*tree.ShowStatus, *tree.ShowTarget, *tree.ShowTableStatus,
 		*tree.ShowGrants, *tree.ShowCollation, *tree.ShowIndex,
 		*tree.ShowTableNumber, *tree.ShowColumnNumber,
-		*tree.ShowTableValues, *tree.ShowNodeList, *tree.ShowRolesStmt,
+		*tree.ShowTableValues, *tree.ShowNodeList, *tree.ShowRolesStmt, *tree.ShowPolicies,
 		*tree.ShowLocks, *tree.ShowFunctionStatus, *tree.ShowPublications, *tree.ShowSubscriptions,
 		*tree.ShowBackendServers:
 		objType = objectTypeNone
@@ -5013,7 +5218,13 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.MoDump:
 		objType = objectTypeTable
 		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
-	case *tree.Kill:
+	case *tree.AnalyzeStmt:
+		objType = objectTypeTable
+		typs = append(typs, PrivilegeTypeSelect, PrivilegeTypeTableAll, PrivilegeTypeTableOwnership)
+		if st.Table != nil {
+			dbName = string(st.Table.SchemaName)
+		}
+	case *tree.Kill, *tree.Backup:
 		objType = objectTypeNone
 		kind = privilegeKindNone
 	case *tree.LockTableStmt, *tree.UnLockTableStmt:
@@ -5942,6 +6153,22 @@ func authenticateUserCanExecuteStatementWithObjectTypeDatabaseAndTable(ctx conte
 		if err != nil {
 			return false, err
 		}
+		if !ok {
+			//the columns of the tables with the column privileges have been checked by the plan
+			left, err := removeTipsOfColumnPrivileges(ctx, ses, arr)
+			if err != nil {
+				return false, err
+			}
+			if len(left) == len(arr) {
+				return false, nil
+			}
+			if len(left) == 0 {
+				return true, nil
+			}
+			priv = determinePrivilegeSetOfStatement(stmt)
+			convertPrivilegeTipsToPrivilege(priv, left)
+			return determineUserHasPrivilegeSet(ctx, ses, priv, stmt)
+		}
 		return ok, nil
 	}
 	return true, nil
@@ -6236,6 +6463,8 @@ func convertAstPrivilegeTypeToPrivilegeType(ctx context.Context, priv tree.Privi
 		privType = PrivilegeTypeReference
 	case tree.PRIVILEGE_TYPE_STATIC_VALUES:
 		privType = PrivilegeTypeValues
+	case tree.PRIVILEGE_TYPE_DYNAMIC_BACKUP_ADMIN:
+		privType = PrivilegeTypeBackupAdmin
 	default:
 		return 0, moerr.NewInternalError(ctx, "unsupported privilege type %s", priv.ToString())
 	}
@@ -6488,12 +6717,14 @@ func createTablesInMoCatalog(ctx context.Context, bh BackgroundExec, tenant *Ten
 	}
 
 	//encryption the password
-	encryption := HashPassWord(defaultPassword)
+	encryption := hashPasswordOfPlugin(getDefaultAuthPlugin(pu), defaultPassword)
 
 	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, encryption, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID)
 	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, encryption, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID)
 	addSqlIntoSet(initMoUser1)
 	addSqlIntoSet(initMoUser2)
+	addSqlIntoSet(getSqlForInsertPgAuthOfUser(rootID, sysAccountName, rootName, defaultPassword))
+	addSqlIntoSet(getSqlForInsertPgAuthOfUser(dumpID, sysAccountName, dumpName, defaultPassword))
 
 	//step4: add new entries to the mo_role_privs
 	//moadmin role
@@ -6677,7 +6908,7 @@ func InitGeneralTenant(ctx context.Context, ses *Session, ca *tree.CreateAccount
 			goto handleFailed
 		}
 
-		err = createTablesInMoCatalogOfGeneralTenant2(bh, ca, newTenantCtx, newTenant)
+		err = createTablesInMoCatalogOfGeneralTenant2(bh, ca, newTenantCtx, newTenant, ses.GetParameterUnit())
 		if err != nil {
 			goto handleFailed
 		}
@@ -6799,15 +7030,15 @@ handleFailed:
 	return newTenant, newTenantCtx, err
 }
 
-func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *tree.CreateAccount, newTenantCtx context.Context, newTenant *TenantInfo) error {
+func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *tree.CreateAccount, newTenantCtx context.Context, newTenant *TenantInfo, pu *config.ParameterUnit) error {
 	var err error
 	var initDataSqls []string
 	newTenantCtx, span := trace.Debug(newTenantCtx, "createTablesInMoCatalogOfGeneralTenant2")
 	defer span.End()
 	//create tables for the tenant
 	for _, sql := range createSqls {
-		//only the SYS tenant has the table mo_account
-		if strings.HasPrefix(sql, "create table mo_account") {
+		name, _, _ := strings.Cut(strings.TrimPrefix(sql, "create table "), "(")
+		if isSysOnlyTable(name) {
 			continue
 		}
 		err = bh.Exec(newTenantCtx, sql)
@@ -6838,7 +7069,7 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *tree.CreateA
 		return err
 	}
 	//encryption the password
-	encryption := HashPassWord(password)
+	encryption := hashPasswordOfPlugin(getDefaultAuthPlugin(pu), password)
 	status := rootStatus
 	//TODO: fix the status of user or account
 	if ca.StatusOption.Exist {
@@ -6851,6 +7082,7 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *tree.CreateA
 		types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
 		newTenant.GetUserID(), newTenant.GetDefaultRoleID(), accountAdminRoleID)
 	addSqlIntoSet(initMoUser1)
+	addSqlIntoSet(getSqlForInsertPgAuthOfUser(int64(newTenant.GetUserID()), ca.Name, name, password))
 
 	//step4: add new entries to the mo_role_privs
 	//accountadmin role
@@ -7118,7 +7350,7 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 		}
 
 		//encryption the password
-		encryption := HashPassWord(password)
+		encryption := hashPasswordOfPlugin(getDefaultAuthPlugin(ses.GetParameterUnit()), password)
 
 		//TODO: get comment or attribute. there is no field in mo_user to store it.
 		host = user.Hostname
@@ -7160,6 +7392,13 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 			goto handleFailed
 		}
 
+		for _, sql = range getSqlsForPgAuthOfUser(newUserId, tenant.GetTenant(), user.Username, password) {
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				goto handleFailed
+			}
+		}
+
 		initMoUserGrant1 := fmt.Sprintf(initMoUserGrantFormat, newRoleId, newUserId, types.CurrentTimestamp().String2(time.UTC, 0), true)
 		err = bh.Exec(ctx, initMoUserGrant1)
 		if err != nil {
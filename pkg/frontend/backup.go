@@ -0,0 +1,147 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+const backupFormat = `select mo_ctl('dn', 'backup', '%s:%d:%s');`
+
+var (
+	backupOutputColumns = [2]Column{
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Backup_ts",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Files",
+				columnType: defines.MYSQL_TYPE_LONGLONG,
+			},
+		},
+	}
+)
+
+// authenticateUserCanBackup decides the user can back up. A backup is written by
+// the DN with the data of any account, so only the sys account backs up, by the
+// role moadmin or a role granted the privilege backup_admin.
+func authenticateUserCanBackup(ctx context.Context, ses *Session) (bool, error) {
+	tenantInfo := ses.GetTenantInfo()
+	if tenantInfo == nil || !tenantInfo.IsSysTenant() {
+		return false, nil
+	}
+	if tenantInfo.IsMoAdminRole() {
+		return true, nil
+	}
+	priv := &privilege{
+		kind:    privilegeKindGeneral,
+		objType: objectTypeAccount,
+		entries: []privilegeEntry{privilegeEntriesMap[PrivilegeTypeBackupAdmin]},
+	}
+	return determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+}
+
+// doBackup backs up the cluster, an account or a database of the sys account
+// through the DN, which flushes the data and copies a checkpoint of the scope with
+// its objects into the path under the backup root of the DN. Only the sys account
+// backs up, see authenticateUserCanBackup.
+func doBackup(ctx context.Context, ses *Session, stmt *tree.Backup) error {
+	var id uint64
+	yes, err := authenticateUserCanBackup(ctx, ses)
+	if err != nil {
+		return err
+	}
+	if !yes {
+		return moerr.NewInternalError(ctx, "do not have privilege to execute the statement")
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	var sql string
+	switch stmt.Scope {
+	case tree.BackupScopeAccount:
+		sql, err = getSqlForAccountIdAndStatus(ctx, string(stmt.Name), true)
+	case tree.BackupScopeDatabase:
+		sql, err = getSqlForGetDbIdAndType(ctx, string(stmt.Name), true)
+	}
+	if err != nil {
+		return err
+	}
+	if sql != "" {
+		bh.ClearExecResultSet()
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+		erArray, err := getResultSet(ctx, bh)
+		if err != nil {
+			return err
+		}
+		if !execResultArrayHasData(erArray) {
+			return moerr.NewInternalError(ctx, "%s '%s' does not exist", stmt.Scope, stmt.Name)
+		}
+		if stmt.Scope == tree.BackupScopeAccount {
+			accountID, err := erArray[0].GetInt64(ctx, 0, 0)
+			if err != nil {
+				return err
+			}
+			id = uint64(accountID)
+		} else if id, err = erArray[0].GetUint64(ctx, 0, 0); err != nil {
+			return err
+		}
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(backupFormat, stmt.Scope, id, escapeString(stmt.Path))); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInternalError(ctx, "backup returns nothing")
+	}
+	data, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return err
+	}
+	result := struct {
+		Result []db.BackupResp `json:"result"`
+	}{}
+	if err = json.Unmarshal([]byte(data), &result); err != nil {
+		return err
+	}
+
+	rs := &MysqlResultSet{}
+	for _, col := range backupOutputColumns {
+		rs.AddColumn(col)
+	}
+	for _, resp := range result.Result {
+		rs.AddRow([]interface{}{resp.TS, int64(resp.Files)})
+	}
+	ses.SetMysqlResultSet(rs)
+	return nil
+}
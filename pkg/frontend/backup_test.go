@@ -0,0 +1,50 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticateUserCanBackup(t *testing.T) {
+	ctx := context.Background()
+	ses := &Session{}
+
+	// the accounts other than sys never back up
+	ses.tenant = &TenantInfo{
+		Tenant:        "acc1",
+		User:          "root",
+		DefaultRole:   accountAdminRoleName,
+		TenantID:      1,
+		DefaultRoleID: accountAdminRoleID,
+	}
+	yes, err := authenticateUserCanBackup(ctx, ses)
+	require.NoError(t, err)
+	require.False(t, yes)
+
+	ses.tenant = &TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		DefaultRoleID: moAdminRoleID,
+	}
+	yes, err = authenticateUserCanBackup(ctx, ses)
+	require.NoError(t, err)
+	require.True(t, yes)
+}
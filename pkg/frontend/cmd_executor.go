@@ -109,6 +109,11 @@ func Execute(ctx context.Context, ses *Session, proc *process.Process, stmtExec
 		goto handleRet
 	}
 
+	err = checkStorageQuota(ctx, ses, stmtExec.GetAst())
+	if err != nil {
+		goto handleRet
+	}
+
 	err = stmtExec.VerifyTxn(ctx, ses)
 	if err != nil {
 		goto handleRet
@@ -394,6 +394,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
+	var checks []*plan2.CheckDef
 	var subscriptionName string
 	var pubAccountId int32 = -1
 	if sub != nil {
@@ -412,6 +413,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					Width:       attr.Attr.Type.Width,
 					Scale:       attr.Attr.Type.Scale,
 					AutoIncr:    attr.Attr.AutoIncrement,
+					Enumvalues:  attr.Attr.EnumValues,
 					Table:       tableName,
 					NotNullable: attr.Attr.Default != nil && !attr.Attr.Default.NullAbility,
 				},
@@ -463,6 +465,8 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
 					primarykey = k.Pkey
+				case *engine.CheckDef:
+					checks = k.Checks
 				}
 			}
 		} else if commnetDef, ok := def.(*engine.CommentDef); ok {
@@ -521,6 +525,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
 		RefChildTbls: refChildTbls,
+		Checks:       checks,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
 	}
@@ -667,10 +672,26 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef, e *plan2.Expr) (stats
 		cols, _ := table.TableColumns(ctx)
 		fixColumnName(cols, e)
 	}
-	stats, _ = table.Stats(ctx, e, tcc.GetSession().statsCache.GetStatsInfoMap(table.GetTableID(ctx)))
+	ses := tcc.GetSession()
+	s := ses.statsCache.GetStatsInfoMap(table.GetTableID(ctx))
+	if ses.statsCache != nil && !ses.IsBackgroundSession() && dbName != catalog.MO_CATALOG {
+		cache := getColumnStatsCache(ses.GetParameterUnit(), ses.GetAutoIncrCacheManager())
+		s.SetColumnStats(cache.get(getAccountId(ctx), table.GetTableID(ctx)))
+	}
+	stats, _ = table.Stats(ctx, e, s)
 	return stats
 }
 
+// GetTableAccess returns the column privileges and the row level security policies of
+// current user on the table, see getTableAccess.
+func (tcc *TxnCompilerContext) GetTableAccess(dbName string, tableName string) (*plan2.TableAccess, error) {
+	ses := tcc.GetSession()
+	if ses == nil {
+		return nil, nil
+	}
+	return getTableAccess(ses.GetRequestContext(), ses, dbName, tableName)
+}
+
 func (tcc *TxnCompilerContext) GetProcess() *process.Process {
 	tcc.mu.Lock()
 	defer tcc.mu.Unlock()
@@ -234,7 +234,8 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		resetParamRule := plan2.NewResetParamRefRule(requestCtx, executePlan.Args)
 		resetVarRule := plan2.NewResetVarRefRule(cwft.ses.GetTxnCompileCtx(), cwft.ses.GetTxnCompileCtx().GetProcess())
 		constantFoldRule := plan2.NewConstantFoldRule(cwft.ses.GetTxnCompileCtx())
-		vp := plan2.NewVisitPlan(newPlan, []plan2.VisitPlanRule{resetParamRule, resetVarRule, constantFoldRule})
+		partitionPruneRule := plan2.NewPartitionPruneRule(cwft.ses.GetTxnCompileCtx().GetProcess())
+		vp := plan2.NewVisitPlan(newPlan, []plan2.VisitPlanRule{resetParamRule, resetVarRule, constantFoldRule, partitionPruneRule})
 		err = vp.Visit(requestCtx)
 		if err != nil {
 			return nil, err
@@ -419,7 +419,7 @@ func constructByte(obj interface{}, bat *batch.Batch, index int32, ByteChan chan
 			case types.T_int8:
 				val := vector.GetFixedAt[int8](vec, i)
 				writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_int16:
+			case types.T_int16, types.T_year:
 				val := vector.GetFixedAt[int16](vec, i)
 				writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
 			case types.T_int32:
@@ -431,15 +431,19 @@ func constructByte(obj interface{}, bat *batch.Batch, index int32, ByteChan chan
 			case types.T_uint8:
 				val := vector.GetFixedAt[uint8](vec, i)
 				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_uint16:
+			case types.T_uint16, types.T_enum:
 				val := vector.GetFixedAt[uint16](vec, i)
 				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
 			case types.T_uint32:
 				val := vector.GetFixedAt[uint32](vec, i)
 				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_uint64:
+			case types.T_uint64, types.T_set:
 				val := vector.GetFixedAt[uint64](vec, i)
 				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
+			case types.T_bit:
+				val := vector.GetFixedAt[uint64](vec, i)
+				value := addEscapeToString(types.BitToBytes(val, vec.GetType().Width))
+				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
 			case types.T_float32:
 				val := vector.GetFixedAt[float32](vec, i)
 				if vec.GetType().Scale < 0 || vec.GetType().Width == 0 {
@@ -457,6 +461,12 @@ func constructByte(obj interface{}, bat *batch.Batch, index int32, ByteChan chan
 			case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
 				value := addEscapeToString(vec.GetBytesAt(i))
 				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
+			case types.T_vecf32:
+				val := types.VectorToString(types.BytesToVector[float32](vec.GetBytesAt(i)))
+				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, true)
+			case types.T_vecf64:
+				val := types.VectorToString(types.BytesToVector[float64](vec.GetBytesAt(i)))
+				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, true)
 			case types.T_date:
 				val := vector.GetFixedAt[types.Date](vec, i)
 				writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
@@ -632,7 +642,7 @@ func exportDataToCSVFile(oq *outputQueue) error {
 			}
 		// Binary/varbinary has mysql_type_varchar.
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
-			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT:
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_BIT:
 			value, err := oq.mrs.GetValue(oq.ctx, 0, i)
 			if err != nil {
 				return err
@@ -41,7 +41,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
-	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -614,10 +613,20 @@ func doSetVar(ctx context.Context, ses *Session, sv *tree.SetVar) error {
 	setVarFunc := func(system, global bool, name string, value interface{}) error {
 		if system {
 			if global {
+				err = checkAuditVariable(ctx, ses, name, value)
+				if err != nil {
+					return err
+				}
 				err = ses.SetGlobalVar(name, value)
 				if err != nil {
 					return err
 				}
+				if isAuditVariable(name) {
+					err = saveAuditVariable(ctx, ses, name)
+					if err != nil {
+						return err
+					}
+				}
 			} else {
 				err = ses.SetSessionVar(name, value)
 				if err != nil {
@@ -904,25 +913,38 @@ func constructVarBatch(ses *Session, rows [][]interface{}) (*batch.Batch, error)
 	return bat, nil
 }
 
-func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
+func (mce *MysqlCmdExecutor) handleAnalyzeStmt(requestCtx context.Context, stmt *tree.AnalyzeStmt, cwIndex, cwsLen int) error {
+	var err error
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	err = doAnalyzeTable(requestCtx, ses, stmt)
+	if err != nil {
+		return err
+	}
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.GetMysqlResultSet())
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+
+	if err = proto.SendResponse(requestCtx, resp); err != nil {
+		return moerr.NewInternalError(requestCtx, "routine send response failed. error:%v ", err)
 	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.GetDoQueryFunc()(requestCtx, sql)
+	return err
+}
+
+func (mce *MysqlCmdExecutor) handleBackup(requestCtx context.Context, stmt *tree.Backup, cwIndex, cwsLen int) error {
+	var err error
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	err = doBackup(requestCtx, ses, stmt)
+	if err != nil {
+		return err
+	}
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.GetMysqlResultSet())
+	resp := SetNewResponse(ResultResponse, 0, int(COM_QUERY), mer, cwIndex, cwsLen)
+
+	if err = proto.SendResponse(requestCtx, resp); err != nil {
+		return moerr.NewInternalError(requestCtx, "routine send response failed. error:%v ", err)
+	}
+	return err
 }
 
 // Note: for pass the compile quickly. We will remove the comments in the future.
@@ -1145,6 +1167,16 @@ func (mce *MysqlCmdExecutor) handleDropRole(ctx context.Context, dr *tree.DropRo
 	return doDropRole(ctx, mce.GetSession(), dr)
 }
 
+// handleCreatePolicy creates the row level security policy
+func (mce *MysqlCmdExecutor) handleCreatePolicy(ctx context.Context, cp *tree.CreatePolicy) error {
+	return doCreatePolicy(ctx, mce.GetSession(), cp)
+}
+
+// handleDropPolicy drops the row level security policy
+func (mce *MysqlCmdExecutor) handleDropPolicy(ctx context.Context, dp *tree.DropPolicy) error {
+	return doDropPolicy(ctx, mce.GetSession(), dp)
+}
+
 func (mce *MysqlCmdExecutor) handleCreateFunction(ctx context.Context, cf *tree.CreateFunction) error {
 	ses := mce.GetSession()
 	tenant := ses.GetTenantInfo()
@@ -1411,9 +1443,9 @@ func getExplainOption(requestCtx context.Context, options []tree.OptionElem) (*e
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_DOT
 				} else {
 					return nil, moerr.NewInvalidInput(requestCtx, "invalid explain option '%s', valud '%s'", v.Name, v.Value)
 				}
@@ -1534,7 +1566,7 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 		if err != nil {
 			v = int64(1)
 		}
-		stmts, err = parsers.Parse(proc.Ctx, dialect.MYSQL, sql, v.(int64))
+		stmts, err = ses.parseSql(proc.Ctx, sql, v.(int64))
 		if err != nil {
 			return nil, err
 		}
@@ -1703,6 +1735,14 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			as: st,
 		})
+	case *tree.Backup:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&BackupExecutor{
+			resultSetStmtExecutor: &resultSetStmtExecutor{
+				base,
+			},
+			b: st,
+		})
 	case *tree.ExplainAnalyze:
 		ret = (&ExplainAnalyzeExecutor{
 			resultSetStmtExecutor: &resultSetStmtExecutor{
@@ -1901,6 +1941,22 @@ func getStmtExecutor(ses *Session, proc *process.Process, base *baseStmtExecutor
 			},
 			dr: st,
 		})
+	case *tree.CreatePolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&CreatePolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			cp: st,
+		})
+	case *tree.DropPolicy:
+		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
+		ret = (&DropPolicyExecutor{
+			statusStmtExecutor: &statusStmtExecutor{
+				base,
+			},
+			dp: st,
+		})
 	case *tree.Grant:
 		base.ComputationWrapper = InitNullComputationWrapper(ses, st, proc)
 		ret = (&GrantExecutor{
@@ -2063,7 +2119,7 @@ var GetStmtExecList = func(db, sql, user string, eng engine.Engine, proc *proces
 		if err != nil {
 			return nil, err
 		}
-		stmts, err = parsers.Parse(proc.Ctx, dialect.MYSQL, sql, v.(int64))
+		stmts, err = ses.parseSql(proc.Ctx, sql, v.(int64))
 		if err != nil {
 			return nil, err
 		}
@@ -2301,6 +2357,11 @@ func (mce *MysqlCmdExecutor) processLoadLocal(ctx context.Context, param *tree.E
 func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string) (retErr error) {
 	beginInstant := time.Now()
 	ses := mce.GetSession()
+	releaseQuota, err := acquireQueryQuota(requestCtx, ses)
+	if err != nil {
+		return err
+	}
+	defer releaseQuota()
 	ses.getSqlType(sql)
 	ses.SetShowStmtType(NotShowStatement)
 	proto := ses.GetMysqlProtocol()
@@ -2320,6 +2381,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.MaxMsgSize = pu.SV.MaxMessageSize
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.SpillSize = pu.SV.ProcessLimitationSpillSize
 	proc.SessionInfo = process.SessionInfo{
 		User:          ses.GetUserName(),
 		Host:          pu.SV.Host,
@@ -2382,6 +2444,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	var err2 error
 	var columns []interface{}
 	var mrs *MysqlResultSet
+	var cursor *stmtCursor
 	canCache := true
 	var loadLocalErrGroup *errgroup.Group
 	var loadLocalWriter *io.PipeWriter
@@ -2417,6 +2480,11 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				return err
 			}
 		}
+		err = checkStorageQuota(requestCtx, ses, stmt)
+		if err != nil {
+			logStatementStatus(requestCtx, ses, stmt, fail, err)
+			return err
+		}
 
 		/*
 				if it is in an active or multi-statement transaction, we check the type of the statement.
@@ -2574,7 +2642,12 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
-			if err = mce.handleAnalyzeStmt(requestCtx, st); err != nil {
+			if err = mce.handleAnalyzeStmt(requestCtx, st, i, len(cws)); err != nil {
+				goto handleFailed
+			}
+		case *tree.Backup:
+			selfHandle = true
+			if err = mce.handleBackup(requestCtx, st, i, len(cws)); err != nil {
 				goto handleFailed
 			}
 		case *tree.ExplainStmt:
@@ -2682,6 +2755,16 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			if err = mce.handleDropRole(requestCtx, st); err != nil {
 				goto handleFailed
 			}
+		case *tree.CreatePolicy:
+			selfHandle = true
+			if err = mce.handleCreatePolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropPolicy:
+			selfHandle = true
+			if err = mce.handleDropPolicy(requestCtx, st); err != nil {
+				goto handleFailed
+			}
 		case *tree.CreateFunction:
 			selfHandle = true
 			if err = mce.handleCreateFunction(requestCtx, st); err != nil {
@@ -2803,7 +2886,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 		//produce result set
 		case *tree.Select,
 			*tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowSequences, *tree.ShowDatabases, *tree.ShowColumns,
-			*tree.ShowProcessList, *tree.ShowStatus, *tree.ShowTableStatus, *tree.ShowGrants, *tree.ShowRolesStmt,
+			*tree.ShowProcessList, *tree.ShowStatus, *tree.ShowTableStatus, *tree.ShowGrants, *tree.ShowRolesStmt, *tree.ShowPolicies,
 			*tree.ShowIndex, *tree.ShowCreateView, *tree.ShowTarget, *tree.ShowCollation, *tree.ValuesStatement,
 			*tree.ExplainFor, *tree.ExplainStmt, *tree.ShowTableNumber, *tree.ShowColumnNumber, *tree.ShowTableValues, *tree.ShowLocks, *tree.ShowNodeList, *tree.ShowFunctionStatus:
 			columns, err = cw.GetColumns()
@@ -2842,7 +2925,12 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				mysql COM_QUERY response: End after the column has been sent.
 				send EOF packet
 			*/
-			err = proto.SendEOFPacketIf(0, 0)
+			if cursor = ses.getPendingCursor(); cursor != nil {
+				// the rows will be sent by COM_STMT_FETCH
+				err = cursor.open(proto)
+			} else {
+				err = proto.SendEOFPacketIf(0, 0)
+			}
 			if err != nil {
 				goto handleFailed
 			}
@@ -2861,7 +2949,11 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				}
 			}
 			if err = runner.Run(0); err != nil {
-				goto handleFailed
+				if cursor == nil || !cursor.isClosed() {
+					goto handleFailed
+				}
+				// the cursor has been closed before all the rows were fetched
+				err = nil
 			}
 
 			switch ses.GetShowStmtType() {
@@ -2890,10 +2982,13 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				Step 3: Say goodbye
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
+				The EOF of the cursor is sent by COM_STMT_FETCH.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
-			if err != nil {
-				goto handleFailed
+			if cursor == nil {
+				err = proto.sendEOFOrOkPacket(0, 0)
+				if err != nil {
+					goto handleFailed
+				}
 			}
 
 			/*
@@ -3063,6 +3158,15 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			logStatementStatus(requestCtx, ses, stmt, fail, txnErr)
 			return txnErr
 		}
+		//the column privileges, the policies and the column statistics are deleted by the ddl,
+		//only the caches are dropped
+		switch stmt.(type) {
+		case *tree.DropTable, *tree.DropDatabase:
+			globalTableAccess.invalidate(ses.GetTenantInfo().GetTenantID())
+			getColumnStatsCache(ses.GetParameterUnit(), ses.GetAutoIncrCacheManager()).remove(getAccountId(requestCtx))
+		case *tree.TruncateTable:
+			getColumnStatsCache(ses.GetParameterUnit(), ses.GetAutoIncrCacheManager()).remove(getAccountId(requestCtx))
+		}
 		switch stmt.(type) {
 		case *tree.Select:
 			if len(proc.SessionInfo.SeqAddValues) != 0 {
@@ -3078,6 +3182,7 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			*tree.CreateProcedure, *tree.DropProcedure, *tree.CallStmt,
 			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 			*tree.CreateRole, *tree.DropRole, *tree.Revoke, *tree.Grant,
+			*tree.CreatePolicy, *tree.DropPolicy,
 			*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword, *tree.Delete, *tree.TruncateTable, *tree.Use,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
 			resp := mce.setResponse(i, len(cws), rspLen)
@@ -3225,6 +3330,11 @@ func (mce *MysqlCmdExecutor) doComQueryInProgress(requestCtx context.Context, sq
 	var err error
 	beginInstant := time.Now()
 	ses := mce.GetSession()
+	releaseQuota, err := acquireQueryQuota(requestCtx, ses)
+	if err != nil {
+		return err
+	}
+	defer releaseQuota()
 	ses.SetShowStmtType(NotShowStatement)
 	proto := ses.GetMysqlProtocol()
 	ses.SetSql(sql)
@@ -3242,6 +3352,7 @@ func (mce *MysqlCmdExecutor) doComQueryInProgress(requestCtx context.Context, sq
 	proc.Lim.Size = pu.SV.ProcessLimitationSize
 	proc.Lim.BatchRows = pu.SV.ProcessLimitationBatchRows
 	proc.Lim.PartitionRows = pu.SV.ProcessLimitationPartitionRows
+	proc.Lim.SpillSize = pu.SV.ProcessLimitationSpillSize
 	proc.SessionInfo = process.SessionInfo{
 		User:          ses.GetUserName(),
 		Host:          pu.SV.Host,
@@ -3329,6 +3440,12 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 
 	var sql string
 	logDebugf(ses.GetDebugString(), "cmd %v", req.GetCmd())
+	if cursor := ses.getCursor(); cursor != nil {
+		// the statement of the cursor shares the session with the command
+		if resp, handled := mce.execRequestWithCursor(requestCtx, ses, cursor, req); handled {
+			return resp, nil
+		}
+	}
 	ses.SetCmd(req.GetCmd())
 	doComQuery := mce.GetDoQueryFunc()
 	switch req.GetCmd() {
@@ -3398,12 +3515,35 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		if err != nil {
 			return NewGeneralErrorResponse(COM_STMT_EXECUTE, err), nil
 		}
-		err = doComQuery(requestCtx, sql)
+		// the flags is checked in parseStmtExecute
+		if data[4]&CURSOR_TYPE_READ_ONLY != 0 {
+			err = mce.openCursor(requestCtx, binary.LittleEndian.Uint32(data[0:4]), sql)
+		} else {
+			err = doComQuery(requestCtx, sql)
+		}
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
 		}
 		return resp, nil
 
+	case COM_STMT_FETCH:
+		data := req.GetData().([]byte)
+		err = mce.handleStmtFetch(requestCtx, data)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, err)
+		}
+		return resp, nil
+
+	case COM_STMT_SEND_LONG_DATA:
+		data := req.GetData().([]byte)
+		// there is no response for COM_STMT_SEND_LONG_DATA.
+		// the error is reported by COM_STMT_EXECUTE.
+		err = mce.handleStmtSendLongData(requestCtx, data)
+		if err != nil {
+			logErrorf(ses.GetDebugString(), "handle COM_STMT_SEND_LONG_DATA failed. error:%v", err)
+		}
+		return nil, nil
+
 	case COM_STMT_CLOSE:
 		data := req.GetData().([]byte)
 
@@ -3425,6 +3565,9 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		//Payload of COM_STMT_RESET
 		stmtID := binary.LittleEndian.Uint32(data[0:4])
 		stmtName := getPrepareStmtName(stmtID)
+		if preStmt, err := ses.GetPrepareStmt(stmtName); err == nil {
+			preStmt.resetLongData()
+		}
 		sql = fmt.Sprintf("reset prepare %s", stmtName)
 		logInfo(ses.GetDebugString(), "query trace", logutil.ConnectionIdField(ses.GetConnectionID()), logutil.QueryField(sql))
 		err = doComQuery(requestCtx, sql)
@@ -3454,6 +3597,11 @@ func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data [
 	if err != nil {
 		return "", err
 	}
+	// the parameters received via COM_STMT_SEND_LONG_DATA are used by this execution only
+	defer preStmt.resetLongData()
+	if preStmt.longDataErr != nil {
+		return "", preStmt.longDataErr
+	}
 	names, vars, err := ses.GetMysqlProtocol().ParseExecuteData(requestCtx, preStmt, data, pos)
 	if err != nil {
 		return "", err
@@ -3502,9 +3650,15 @@ func convertEngineTypeToMysqlType(ctx context.Context, engineType types.T, col *
 		col.SetSigned(false)
 	case types.T_int16:
 		col.SetColumnType(defines.MYSQL_TYPE_SHORT)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col.SetColumnType(defines.MYSQL_TYPE_SHORT)
 		col.SetSigned(false)
+	case types.T_year:
+		col.SetColumnType(defines.MYSQL_TYPE_YEAR)
+		col.SetSigned(false)
+	case types.T_bit:
+		col.SetColumnType(defines.MYSQL_TYPE_BIT)
+		col.SetSigned(false)
 	case types.T_int32:
 		col.SetColumnType(defines.MYSQL_TYPE_LONG)
 	case types.T_uint32:
@@ -3512,7 +3666,7 @@ func convertEngineTypeToMysqlType(ctx context.Context, engineType types.T, col *
 		col.SetSigned(false)
 	case types.T_int64:
 		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
 		col.SetSigned(false)
 	case types.T_float32:
@@ -3545,6 +3699,8 @@ func convertEngineTypeToMysqlType(ctx context.Context, engineType types.T, col *
 		col.SetColumnType(defines.MYSQL_TYPE_TEXT)
 	case types.T_uuid:
 		col.SetColumnType(defines.MYSQL_TYPE_UUID)
+	case types.T_vecf32, types.T_vecf64:
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 	default:
 		return moerr.NewInternalError(ctx, "RunWhileSend : unsupported type %d", engineType)
 	}
@@ -142,6 +142,10 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
+	AuthSha256Password string = "sha256_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -287,6 +291,9 @@ type MysqlProtocolImpl struct {
 	// indicated by the plugin name field.
 	authResponse []byte
 
+	// the authentication method of the authResponse
+	authPluginName string
+
 	//the default database for the client
 	database string
 
@@ -520,8 +527,8 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 		err = moerr.NewInternalError(requestCtx, "malform packet")
 		return
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	// only support CURSOR_TYPE_NO_CURSOR and CURSOR_TYPE_READ_ONLY flag now
+	if flag&^CURSOR_TYPE_READ_ONLY != 0 {
 		err = moerr.NewInvalidInput(requestCtx, "unsupported Prepare flag '%v'", flag)
 		return
 	}
@@ -560,8 +567,18 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 			varName := getPrepareStmtSessionVarName(i)
 			names[i] = varName
 
-			// TODO :if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
+			// if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
 			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+			if longData, ok := stmt.getLongData(i); ok {
+				vars[i] = longData
+				if (i<<1)+1 < len(stmt.ParamTypes) {
+					switch defines.MysqlType(stmt.ParamTypes[i<<1]) {
+					case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+						vars[i] = string(longData)
+					}
+				}
+				continue
+			}
 
 			if nullBitmaps[i>>3]&(1<<(uint(i)%8)) > 0 {
 				vars[i] = nil
@@ -1075,22 +1092,36 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	var psw []byte
 	var err error
 	var tenant *TenantInfo
+	var authString string
+	var ok bool
 
 	ses := mp.GetSession()
 	if !mp.GetSkipCheckUser() {
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 1")
-		psw, err = ses.AuthenticateUser(mp.GetUserName())
+		authString, err = ses.AuthenticateUser(mp.GetUserName())
 		if err != nil {
 			return err
 		}
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 2")
 
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
+		if authPluginOfPassword(authString) == AuthCachingSha2Password {
+			ok, err = mp.checkSha2Password(ctx, ses.GetTenantInfo(), authString, authResponse)
+		} else {
+			ok, err = mp.checkNativePassword(ctx, authString, authResponse)
+		}
+		if err != nil {
+			return err
+		}
+		if ok {
 			logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
 		} else {
 			return moerr.NewInternalError(ctx, "check password failed")
 		}
+		//the connection is counted into the account after the password has been checked
+		if err = acquireConnectionQuota(ctx, ses); err != nil {
+			return err
+		}
 	} else {
 		logDebugf(mp.getDebugStringUnsafe(), "skip authenticate user")
 		//Get tenant info
@@ -1136,6 +1167,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp41.authResponse
+		mp.authPluginName = resp41.clientPluginName
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
@@ -1163,6 +1195,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp320.authResponse
+		mp.authPluginName = AuthNativePassword
 		mp.capability = mp.capability & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
@@ -1180,10 +1213,17 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	mp.incDebugCount(0)
 	if err := mp.authenticateUser(ctx, mp.authResponse); err != nil {
 		logutil.Errorf("authenticate user failed.error:%v", err)
-		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
-		tipsFormat := "Access denied for user %s. %s"
-		msg := fmt.Sprintf(tipsFormat, mp.username, err.Error())
-		err2 := mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg)
+		var err2 error
+		if moerr.IsMoErrCode(err, moerr.ErrTooManyConnections) {
+			//the user is valid, but the account has too many connections
+			moErr := err.(*moerr.Error)
+			err2 = mp.sendErrPacket(moErr.MySQLCode(), moErr.SqlState(), moErr.Error())
+		} else {
+			fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
+			tipsFormat := "Access denied for user %s. %s"
+			msg := fmt.Sprintf(tipsFormat, mp.username, err.Error())
+			err2 = mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg)
+		}
 		if err2 != nil {
 			logutil.Errorf("send err packet failed.error:%v", err2)
 			return err2
@@ -1254,7 +1294,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
-		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
+		pos = mp.writeStringNUL(data, pos, mp.defaultAuthPlugin())
 	}
 
 	return data[:pos]
@@ -1362,15 +1402,8 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		if !ok {
 			return false, info, moerr.NewInternalError(ctx, "get auth plugin name failed")
 		}
-
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
-			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
-				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
-			}
-			info.clientPluginName = AuthNativePassword
-		}
+	} else {
+		info.clientPluginName = AuthNativePassword
 	}
 
 	// client connection attributes
@@ -1523,6 +1556,136 @@ func (mp *MysqlProtocolImpl) handleClientResponse320(resp320 response320) error
 }
 */
 
+// defaultAuthPlugin returns the authentication method in the handshake
+func (mp *MysqlProtocolImpl) defaultAuthPlugin() string {
+	if mp.SV != nil && strings.EqualFold(mp.SV.DefaultAuthenticationPlugin, AuthCachingSha2Password) {
+		return AuthCachingSha2Password
+	}
+	return AuthNativePassword
+}
+
+// checkNativePassword checks the password stored for mysql_native_password.
+// The client is asked to switch to mysql_native_password if it uses the other method.
+func (mp *MysqlProtocolImpl) checkNativePassword(ctx context.Context, authString string, authResponse []byte) (bool, error) {
+	var err error
+	if mp.authPluginName != AuthNativePassword {
+		if authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthNativePassword); err != nil {
+			return false, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+		}
+	}
+	if len(authString) == 0 {
+		return len(authResponse) == 0, nil
+	}
+	psw, err := GetPassWord(authString)
+	if err != nil {
+		return false, err
+	}
+	return mp.checkPassword(psw, mp.GetSalt(), authResponse), nil
+}
+
+// checkSha2Password checks the password stored for caching_sha2_password and sha256_password.
+// The client is asked to switch to caching_sha2_password if it uses the other method.
+//
+// caching_sha2_password checks the scramble with the cache at first. If the cache misses,
+// the client is asked to perform the full authentication, which sends the password
+// in clear text over TLS, or encrypted with the RSA public key of the server.
+// sha256_password always performs the full authentication.
+func (mp *MysqlProtocolImpl) checkSha2Password(ctx context.Context, tenant *TenantInfo, authString string, authResponse []byte) (bool, error) {
+	var err error
+	var pwd []byte
+	switch mp.authPluginName {
+	case AuthSha256Password:
+		if mp.isSecureTransport() {
+			pwd = bytes.TrimRight(authResponse, "\x00")
+		} else if pwd, err = mp.readEncryptedPassword(ctx, authResponse, sha256PasswordRequestPublicKey); err != nil {
+			return false, err
+		}
+		return checkPlaintextPassword(authString, pwd), nil
+	case AuthCachingSha2Password:
+	default:
+		if authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthCachingSha2Password); err != nil {
+			return false, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+		}
+	}
+
+	// fast authentication. The cache is local to the server, so it is skipped behind the
+	// proxy, which replays the responses of the full authentication to the other servers
+	// in the connection migration.
+	proxied := mp.SV != nil && mp.SV.ProxyEnabled
+	if stage2 := gSha2PasswordCache.get(tenant, authString); stage2 != nil && !proxied {
+		if !checkCachingSha2Scramble(stage2, mp.GetSalt(), authResponse) {
+			return false, nil
+		}
+		return true, mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2FastAuthSuccess}))
+	}
+
+	// full authentication
+	if err = mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2FullAuth})); err != nil {
+		return false, err
+	}
+	data, err := mp.readAuthResponse(ctx)
+	if err != nil {
+		return false, err
+	}
+	if mp.isSecureTransport() {
+		pwd = bytes.TrimRight(data, "\x00")
+	} else if pwd, err = mp.readEncryptedPassword(ctx, data, cachingSha2RequestPublicKey); err != nil {
+		return false, err
+	}
+	if !checkPlaintextPassword(authString, pwd) {
+		return false, nil
+	}
+	gSha2PasswordCache.put(tenant, authString, pwd)
+	return true, nil
+}
+
+// isSecureTransport checks the password can be sent in clear text,
+// which is over TLS or the unix domain socket.
+func (mp *MysqlProtocolImpl) isSecureTransport() bool {
+	if mp.IsTlsEstablished() {
+		return true
+	}
+	if mp.tcpConn == nil {
+		return false
+	}
+	_, ok := mp.tcpConn.RawConn().(*net.UnixConn)
+	return ok
+}
+
+// readEncryptedPassword sends the public key if the client requests it,
+// then decrypts the password from the client.
+func (mp *MysqlProtocolImpl) readEncryptedPassword(ctx context.Context, data []byte, requestPublicKey byte) ([]byte, error) {
+	key, publicKey, err := getAuthRSAKey(ctx, mp.SV)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 1 && data[0] == requestPublicKey {
+		if err = mp.writePackets(mp.makeAuthMoreDataPayload(publicKey)); err != nil {
+			return nil, err
+		}
+		if data, err = mp.readAuthResponse(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	pwd, err := decryptPassword(key, mp.GetSalt(), data)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "decrypt the password failed. error:%v", err)
+	}
+	return pwd, nil
+}
+
+// the server makes a AuthMoreData packet for the data of the authentication method
+func (mp *MysqlProtocolImpl) makeAuthMoreDataPayload(moreData []byte) []byte {
+	data := make([]byte, HeaderOffset+1+len(moreData))
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, 0x01)
+	pos = mp.writeCountOfBytes(data, pos, moreData)
+	return data[:pos]
+}
+
 // the server makes a AuthSwitchRequest that asks the client to authenticate the data with new method
 func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string) []byte {
 	data := make([]byte, HeaderOffset+1+len(authMethodName)+1+len(mp.GetSalt())+1)
@@ -1537,14 +1700,23 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
 	}
+	data, err := mp.readAuthResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mp.authPluginName = authMethodName
+	return data, nil
+}
 
+// readAuthResponse reads the response of the client in the authentication
+func (mp *MysqlProtocolImpl) readAuthResponse(ctx context.Context) ([]byte, error) {
 	read, err := mp.tcpConn.Read(goetty.ReadOptions{})
 	if err != nil {
 		return nil, err
@@ -1943,7 +2115,7 @@ func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResul
 
 		// Binary/varbinary will be sent out as varchar type.
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
-			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_JSON:
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_BIT:
 			if value, err := mrs.GetString(ctx, rowIdx, i); err != nil {
 				return nil, err
 			} else {
@@ -2122,7 +2294,7 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 			}
 		// Binary/varbinary will be sent out as varchar type.
 		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
-			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT:
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TEXT, defines.MYSQL_TYPE_BIT:
 			if value, err2 := mrs.GetString(ctx, r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -2183,6 +2355,11 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 		return nil
 	}
 
+	// the rows of the open cursor are sent by COM_STMT_FETCH
+	if cursor := mp.GetSession().getOpenCursor(); cursor != nil {
+		return cursor.sendRows(mp, mrs, cnt)
+	}
+
 	cmd := mp.GetSession().GetCmd()
 	mp.GetLock().Lock()
 	defer mp.GetLock().Unlock()
@@ -2196,35 +2373,41 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 
 	//make rows into the batch
 	for i := uint64(0); i < cnt; i++ {
-		err = mp.openRow(nil)
+		err = mp.sendResultSetRow(mrs, i, binary)
 		if err != nil {
 			return err
 		}
-		//begin1 := time.Now()
-		if binary {
-			_, err = mp.makeResultSetBinaryRow(nil, mrs, i)
-		} else {
-			_, err = mp.makeResultSetTextRow(nil, mrs, i)
-		}
-		//mp.makeTime += time.Since(begin1)
+	}
 
-		if err != nil {
-			//ERR_Packet in case of error
-			err1 := mp.sendErrPacket(moerr.ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
-			if err1 != nil {
-				return err1
-			}
-			return err
-		}
+	return err
+}
 
-		//output into outbuf
-		err = mp.closeRow(nil)
-		if err != nil {
-			return err
+// sendResultSetRow makes the row of the result set into the outbuf.
+// The caller holds the lock of the protocol.
+func (mp *MysqlProtocolImpl) sendResultSetRow(mrs *MysqlResultSet, rowIdx uint64, binary bool) error {
+	err := mp.openRow(nil)
+	if err != nil {
+		return err
+	}
+	//begin1 := time.Now()
+	if binary {
+		_, err = mp.makeResultSetBinaryRow(nil, mrs, rowIdx)
+	} else {
+		_, err = mp.makeResultSetTextRow(nil, mrs, rowIdx)
+	}
+	//mp.makeTime += time.Since(begin1)
+
+	if err != nil {
+		//ERR_Packet in case of error
+		err1 := mp.sendErrPacket(moerr.ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
+		if err1 != nil {
+			return err1
 		}
+		return err
 	}
 
-	return err
+	//output into outbuf
+	return mp.closeRow(nil)
 }
 
 // open a new row of the resultset
@@ -378,6 +378,14 @@ const (
 	COM_RESET_CONNECTION    CommandType = 0x1f
 )
 
+// the flags of COM_STMT_EXECUTE
+const (
+	CURSOR_TYPE_NO_CURSOR  uint8 = 0x00
+	CURSOR_TYPE_READ_ONLY  uint8 = 0x01
+	CURSOR_TYPE_FOR_UPDATE uint8 = 0x02
+	CURSOR_TYPE_SCROLLABLE uint8 = 0x04
+)
+
 func (ct CommandType) String() string {
 	switch ct {
 	case COM_SLEEP:
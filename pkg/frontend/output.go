@@ -179,9 +179,9 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 		row[i] = vector.GetFixedAt[int8](vec, rowIndex)
 	case types.T_uint8:
 		row[i] = vector.GetFixedAt[uint8](vec, rowIndex)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		row[i] = vector.GetFixedAt[int16](vec, rowIndex)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		row[i] = vector.GetFixedAt[uint16](vec, rowIndex)
 	case types.T_int32:
 		row[i] = vector.GetFixedAt[int32](vec, rowIndex)
@@ -189,8 +189,10 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 		row[i] = vector.GetFixedAt[uint32](vec, rowIndex)
 	case types.T_int64:
 		row[i] = vector.GetFixedAt[int64](vec, rowIndex)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set:
 		row[i] = vector.GetFixedAt[uint64](vec, rowIndex)
+	case types.T_bit:
+		row[i] = types.BitToBytes(vector.GetFixedAt[uint64](vec, rowIndex), vec.GetType().Width)
 	case types.T_float32:
 		val := vector.GetFixedAt[float32](vec, rowIndex)
 		if vec.GetType().Scale < 0 || vec.GetType().Width == 0 {
@@ -207,6 +209,10 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 		}
 	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
 		row[i] = vec.GetBytesAt(rowIndex)
+	case types.T_vecf32:
+		row[i] = []byte(types.VectorToString(types.BytesToVector[float32](vec.GetBytesAt(rowIndex))))
+	case types.T_vecf64:
+		row[i] = []byte(types.VectorToString(types.BytesToVector[float64](vec.GetBytesAt(rowIndex))))
 	case types.T_date:
 		row[i] = vector.GetFixedAt[types.Date](vec, rowIndex)
 	case types.T_datetime:
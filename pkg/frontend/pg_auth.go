@@ -0,0 +1,512 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+// the password authentications of the postgresql wire protocol
+const (
+	pgAuthScramSha256 = "scram-sha-256"
+	pgAuthMD5         = "md5"
+	pgAuthPassword    = "password"
+)
+
+// the codes of the Authentication message
+const (
+	pgAuthOk           int32 = 0
+	pgAuthCleartext    int32 = 3
+	pgAuthMD5Password  int32 = 5
+	pgAuthSASL         int32 = 10
+	pgAuthSASLContinue int32 = 11
+	pgAuthSASLFinal    int32 = 12
+)
+
+const (
+	pgScramMechanism  = "SCRAM-SHA-256"
+	pgScramIterations = 4096
+)
+
+const (
+	getPgAuthOfUserFormat    = `select scram_verifier, md5_password from mo_catalog.mo_user_pg_auth where user_id = %d;`
+	insertPgAuthOfUserFormat = `insert into mo_catalog.mo_user_pg_auth(user_id, scram_verifier, md5_password) values (%d, '%s', '%s');`
+	deletePgAuthOfUserFormat = `delete from mo_catalog.mo_user_pg_auth where user_id = %d;`
+)
+
+// pgAuth is the state of the password authentication.
+type pgAuth struct {
+	method string
+	// the password stored in mo_user
+	password string
+	// the secrets of SCRAM-SHA-256 and md5, nil if the user has none
+	secrets *pgAuthSecrets
+	// saveSecrets denotes the secrets are saved from the cleartext password
+	// after the user is authenticated, since the user has none.
+	saveSecrets bool
+	// the salt of md5
+	salt []byte
+	// the response of the cleartext password or md5
+	response []byte
+	scram    pgScram
+}
+
+// pgAuthSecrets are the secrets of SCRAM-SHA-256 and md5 of the user. mo_user only keeps
+// the hash of the password for the mysql protocol, which can't derive them, so they are
+// kept in mo_user_pg_auth when the password is set.
+type pgAuthSecrets struct {
+	// the login name whose md5 secret is kept
+	login string
+	// the SCRAM verifier, see RFC 5803
+	iterations int
+	salt       []byte
+	storedKey  []byte
+	serverKey  []byte
+	// md5(password + login) in hex
+	md5 string
+}
+
+// pgScram is the state of the SCRAM-SHA-256 exchange.
+type pgScram struct {
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+	// the client-final-message without the proof
+	clientFinal string
+	proof       []byte
+	// sent denotes the server-first-message has been sent
+	sent bool
+}
+
+func pgRandomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return generate_salt(n)
+	}
+	return b
+}
+
+// HandleHandshake handles the startup message. It returns true when the
+// client asks to upgrade to TLS.
+func (pp *PostgresProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
+	if len(payload) < 4 {
+		return false, moerr.NewInvalidInput(ctx, "invalid postgresql startup message")
+	}
+	code := binary.BigEndian.Uint32(payload)
+	switch code {
+	case pgSSLRequestCode:
+		return true, nil
+	case pgGSSENCRequestCode:
+		return false, pp.writeRaw([]byte{'N'})
+	case pgProtocolVersion:
+	default:
+		return false, moerr.NewInvalidInput(ctx, "unsupported postgresql protocol version %d.%d", code>>16, code&0xffff)
+	}
+
+	params := make(map[string]string)
+	fields := bytes.Split(payload[4:], []byte{0})
+	for i := 0; i+1 < len(fields); i += 2 {
+		if len(fields[i]) == 0 {
+			break
+		}
+		params[string(fields[i])] = string(fields[i+1])
+	}
+	user := params["user"]
+	if user == "" {
+		return false, moerr.NewInvalidInput(ctx, "no postgresql user name specified in startup packet")
+	}
+	pp.m.Lock()
+	pp.startupParams = params
+	pp.m.Unlock()
+	pp.SetUserName(user)
+	// libpq uses the user name as the database by default,
+	// which is not a database in general.
+	if db := params["database"]; db != "" && db != user {
+		pp.SetDatabaseName(db)
+	}
+	return false, nil
+}
+
+// startAuthentication fetches the password of the user and asks the client
+// for the password by the configured method.
+func (pp *PostgresProtocolImpl) startAuthentication(ctx context.Context) error {
+	ses := pp.GetSession()
+	if pp.GetSkipCheckUser() {
+		tenant, err := GetTenantInfo(ctx, pp.GetUserName())
+		if err != nil {
+			return err
+		}
+		if ses != nil {
+			ses.SetTenantInfo(tenant)
+		}
+		pp.auth.method = ""
+		return nil
+	}
+
+	psw, err := ses.AuthenticateUser(pp.GetUserName())
+	if err != nil {
+		return err
+	}
+	pp.auth.password = psw
+	tenant := ses.GetTenantInfo()
+	if isSpecial, plaintext, account := isSpecialUser(tenant.GetUser()); isSpecial && account.IsMoAdminRole() {
+		pp.auth.secrets = newPgAuthSecrets(tenant.GetTenant(), tenant.GetUser(), plaintext, pgRandomBytes(16), pgScramIterations)
+	} else if pp.auth.secrets, err = getPgAuthSecretsOfUser(ses); err != nil {
+		return err
+	}
+
+	configured := pgAuthScramSha256
+	if pp.SV != nil && pp.SV.PgAuthMethod != "" {
+		configured = pp.SV.PgAuthMethod
+	}
+	if pp.auth.method, err = pgAuthMethodFor(ctx, configured, pp.GetUserName(), pp.auth.secrets, pp.IsTlsEstablished()); err != nil {
+		return err
+	}
+	// the user has no secrets, they are saved once the cleartext password is checked
+	pp.auth.saveSecrets = pp.auth.method == pgAuthPassword && pp.auth.secrets == nil && strings.ToLower(configured) != pgAuthPassword
+
+	switch pp.auth.method {
+	case pgAuthScramSha256:
+		var b bytes.Buffer
+		b.WriteString(pgScramMechanism)
+		b.Write([]byte{0, 0})
+		return pp.sendAuthentication(pgAuthSASL, b.Bytes())
+	case pgAuthMD5:
+		pp.auth.salt = pgRandomBytes(4)
+		return pp.sendAuthentication(pgAuthMD5Password, pp.auth.salt)
+	default:
+		return pp.sendAuthentication(pgAuthCleartext, nil)
+	}
+}
+
+// pgAuthMethodFor returns the authentication of the user by the configured method.
+// The cleartext password is only sent over TLS. The users without the secrets of
+// SCRAM-SHA-256 and md5, whose passwords were set before the secrets were kept,
+// fall back to the cleartext password over TLS, or are rejected without TLS.
+func pgAuthMethodFor(ctx context.Context, configured, login string, secrets *pgAuthSecrets, tls bool) (string, error) {
+	method := strings.ToLower(configured)
+	switch method {
+	case pgAuthScramSha256, pgAuthMD5:
+		if secrets == nil {
+			if !tls {
+				return "", moerr.NewInternalError(ctx, "%s authentication is not available for user %s until the password is altered or the user connects by TLS once", method, login)
+			}
+			return pgAuthPassword, nil
+		}
+		if method == pgAuthMD5 && secrets.login != login {
+			return "", moerr.NewInternalError(ctx, "md5 authentication of user %s requires the login name %s", login, secrets.login)
+		}
+		return method, nil
+	case pgAuthPassword:
+		if !tls {
+			return "", moerr.NewInternalError(ctx, "cleartext password authentication of user %s requires TLS", login)
+		}
+		return method, nil
+	}
+	return "", moerr.NewInternalError(ctx, "unsupported postgresql authentication method %s", configured)
+}
+
+// handlePasswordMessage handles the response of the client to the
+// authentication request. It returns true when the response is complete.
+func (pp *PostgresProtocolImpl) handlePasswordMessage(ctx context.Context, payload []byte) (bool, error) {
+	if pp.auth.method != pgAuthScramSha256 {
+		pp.auth.response = bytes.TrimRight(payload, "\x00")
+		return true, nil
+	}
+
+	scram := &pp.auth.scram
+	if !scram.sent {
+		// SASLInitialResponse
+		end := bytes.IndexByte(payload, 0)
+		if end < 0 || len(payload) < end+5 {
+			return false, moerr.NewInvalidInput(ctx, "invalid SASLInitialResponse")
+		}
+		if mechanism := string(payload[:end]); mechanism != pgScramMechanism {
+			return false, moerr.NewInvalidInput(ctx, "unsupported SASL mechanism %s", mechanism)
+		}
+		clientFirst := string(payload[end+5:])
+		if strings.HasPrefix(clientFirst, "p=") {
+			return false, moerr.NewNotSupported(ctx, "SCRAM channel binding")
+		}
+		if !strings.HasPrefix(clientFirst, "n,,") && !strings.HasPrefix(clientFirst, "y,,") {
+			return false, moerr.NewInvalidInput(ctx, "invalid SCRAM client-first-message")
+		}
+		scram.clientFirstBare = clientFirst[3:]
+		clientNonce := pgScramAttr(scram.clientFirstBare, 'r')
+		if clientNonce == "" {
+			return false, moerr.NewInvalidInput(ctx, "invalid SCRAM client-first-message")
+		}
+		scram.nonce = clientNonce + base64.StdEncoding.EncodeToString(pgRandomBytes(18))
+		secrets := pp.auth.secrets
+		scram.serverFirst = fmt.Sprintf("r=%s,s=%s,i=%d", scram.nonce, base64.StdEncoding.EncodeToString(secrets.salt), secrets.iterations)
+		scram.sent = true
+		return false, pp.sendAuthentication(pgAuthSASLContinue, []byte(scram.serverFirst))
+	}
+
+	// SASLResponse
+	clientFinal := string(payload)
+	idx := strings.LastIndex(clientFinal, ",p=")
+	if idx < 0 {
+		return false, moerr.NewInvalidInput(ctx, "invalid SCRAM client-final-message")
+	}
+	scram.clientFinal = clientFinal[:idx]
+	if pgScramAttr(scram.clientFinal, 'r') != scram.nonce {
+		return false, moerr.NewInvalidInput(ctx, "invalid SCRAM nonce")
+	}
+	proof, err := base64.StdEncoding.DecodeString(clientFinal[idx+3:])
+	if err != nil {
+		return false, moerr.NewInvalidInput(ctx, "invalid SCRAM proof")
+	}
+	scram.proof = proof
+	return true, nil
+}
+
+// Authenticate checks the password from the client.
+func (pp *PostgresProtocolImpl) Authenticate(ctx context.Context) error {
+	ok := false
+	switch pp.auth.method {
+	case "":
+		logDebugf(pp.getDebugStringUnsafe(), "skip authenticate user")
+		ok = true
+	case pgAuthScramSha256:
+		scram := &pp.auth.scram
+		authMessage := scram.clientFirstBare + "," + scram.serverFirst + "," + scram.clientFinal
+		var serverSignature []byte
+		serverSignature, ok = pgScramVerify(pp.auth.secrets, authMessage, scram.proof)
+		if ok {
+			if err := pp.sendAuthentication(pgAuthSASLFinal, []byte("v="+base64.StdEncoding.EncodeToString(serverSignature))); err != nil {
+				return err
+			}
+		}
+	case pgAuthMD5:
+		ok = subtle.ConstantTimeCompare(pp.auth.response, []byte(pgMD5Response(pp.auth.secrets.md5, pp.auth.salt))) == 1
+	default:
+		ok = checkPlaintextPassword(pp.auth.password, pp.auth.response)
+	}
+	if !ok {
+		return moerr.NewInternalError(ctx, "password authentication failed for user %s", pp.GetUserName())
+	}
+	if pp.auth.saveSecrets {
+		// the failure only makes the user log in by the cleartext password again
+		if err := savePgAuthSecretsOfUser(pp.GetSession(), string(pp.auth.response)); err != nil {
+			logErrorf(pp.getDebugStringUnsafe(), "save the postgresql authentication secrets failed. error:%v", err)
+		}
+	}
+	logInfof(pp.getDebugStringUnsafe(), "check password succeeded")
+	pp.incDebugCount(1)
+	return nil
+}
+
+// pgLoginName returns the login name of the user, whose md5 secret is kept.
+// The account name of the sys account can be omitted.
+func pgLoginName(account, user string) string {
+	if account == sysAccountName {
+		return user
+	}
+	return account + ":" + user
+}
+
+// newPgAuthSecrets derives the secrets from the plaintext of the password.
+func newPgAuthSecrets(account, user string, password, salt []byte, iterations int) *pgAuthSecrets {
+	login := pgLoginName(account, user)
+	saltedPassword := pbkdf2(password, salt, iterations, sha256.Size, sha256.New)
+	storedKey := sha256.Sum256(pgHmac(saltedPassword, "Client Key"))
+	inner := md5.Sum(append(append([]byte{}, password...), login...))
+	return &pgAuthSecrets{
+		login:      login,
+		iterations: iterations,
+		salt:       salt,
+		storedKey:  storedKey[:],
+		serverKey:  pgHmac(saltedPassword, "Server Key"),
+		md5:        hex.EncodeToString(inner[:]),
+	}
+}
+
+// scramVerifier returns the SCRAM verifier in the format of postgresql,
+// SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>.
+func (s *pgAuthSecrets) scramVerifier() string {
+	enc := base64.StdEncoding
+	return fmt.Sprintf("%s$%d:%s$%s:%s", pgScramMechanism, s.iterations,
+		enc.EncodeToString(s.salt), enc.EncodeToString(s.storedKey), enc.EncodeToString(s.serverKey))
+}
+
+// parsePgAuthSecrets parses the secrets kept in mo_user_pg_auth.
+func parsePgAuthSecrets(login, verifier, md5Password string) (*pgAuthSecrets, bool) {
+	parts := strings.Split(verifier, "$")
+	if len(parts) != 3 || parts[0] != pgScramMechanism {
+		return nil, false
+	}
+	iterSalt := strings.SplitN(parts[1], ":", 2)
+	keys := strings.SplitN(parts[2], ":", 2)
+	if len(iterSalt) != 2 || len(keys) != 2 {
+		return nil, false
+	}
+	s := &pgAuthSecrets{login: login, md5: md5Password}
+	var err error
+	if s.iterations, err = strconv.Atoi(iterSalt[0]); err != nil || s.iterations <= 0 {
+		return nil, false
+	}
+	enc := base64.StdEncoding
+	if s.salt, err = enc.DecodeString(iterSalt[1]); err != nil {
+		return nil, false
+	}
+	if s.storedKey, err = enc.DecodeString(keys[0]); err != nil || len(s.storedKey) != sha256.Size {
+		return nil, false
+	}
+	if s.serverKey, err = enc.DecodeString(keys[1]); err != nil || len(s.serverKey) != sha256.Size {
+		return nil, false
+	}
+	return s, true
+}
+
+// getSqlsForPgAuthOfUser returns the sqls keeping the secrets of the postgresql
+// password authentications derived from the new password of the user.
+func getSqlsForPgAuthOfUser(userId int64, account, user, password string) []string {
+	return []string{
+		fmt.Sprintf(deletePgAuthOfUserFormat, userId),
+		getSqlForInsertPgAuthOfUser(userId, account, user, password),
+	}
+}
+
+func getSqlForInsertPgAuthOfUser(userId int64, account, user, password string) string {
+	s := newPgAuthSecrets(account, user, []byte(password), pgRandomBytes(16), pgScramIterations)
+	return fmt.Sprintf(insertPgAuthOfUserFormat, userId, s.scramVerifier(), s.md5)
+}
+
+// getPgAuthSecretsOfUser returns the secrets of the authenticated user, or nil
+// if the user has none.
+func getPgAuthSecretsOfUser(ses *Session) (*pgAuthSecrets, error) {
+	tenant := ses.GetTenantInfo()
+	tenantCtx := context.WithValue(ses.GetRequestContext(), defines.TenantIDKey{}, tenant.GetTenantID())
+	rsset, err := executeSQLInBackgroundSession(tenantCtx, ses, ses.GetMemPool(), ses.GetParameterUnit(),
+		fmt.Sprintf(getPgAuthOfUserFormat, tenant.GetUserID()))
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(rsset) {
+		return nil, nil
+	}
+	verifier, err := rsset[0].GetString(tenantCtx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	md5Password, err := rsset[0].GetString(tenantCtx, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	secrets, ok := parsePgAuthSecrets(pgLoginName(tenant.GetTenant(), tenant.GetUser()), verifier, md5Password)
+	if !ok {
+		return nil, moerr.NewInternalError(tenantCtx, "invalid postgresql authentication secrets of user %s", tenant.GetUser())
+	}
+	return secrets, nil
+}
+
+// savePgAuthSecretsOfUser keeps the secrets of the authenticated user, who has none.
+func savePgAuthSecretsOfUser(ses *Session, password string) error {
+	tenant := ses.GetTenantInfo()
+	tenantCtx := context.WithValue(ses.GetRequestContext(), defines.TenantIDKey{}, tenant.GetTenantID())
+	tenantCtx = context.WithValue(tenantCtx, defines.UserIDKey{}, tenant.GetUserID())
+	tenantCtx = context.WithValue(tenantCtx, defines.RoleIDKey{}, tenant.GetDefaultRoleID())
+	sql := getSqlForInsertPgAuthOfUser(int64(tenant.GetUserID()), tenant.GetTenant(), tenant.GetUser(), password)
+	_, err := executeSQLInBackgroundSession(tenantCtx, ses, ses.GetMemPool(), ses.GetParameterUnit(), sql)
+	return err
+}
+
+// pgMD5Response returns the md5 response of the client to the salt,
+// which is "md5" + md5(md5(password + user) + salt).
+func pgMD5Response(secret string, salt []byte) string {
+	outer := md5.Sum(append([]byte(secret), salt...))
+	return "md5" + hex.EncodeToString(outer[:])
+}
+
+// pgScramAttr returns the value of the attribute in the SCRAM message.
+func pgScramAttr(msg string, name byte) string {
+	for _, attr := range strings.Split(msg, ",") {
+		if len(attr) >= 2 && attr[0] == name && attr[1] == '=' {
+			return attr[2:]
+		}
+	}
+	return ""
+}
+
+func pgHmac(key []byte, msg string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(msg))
+	return h.Sum(nil)
+}
+
+// pgScramVerify checks the proof of the client by the SCRAM verifier and
+// returns the signature of the server. See RFC 5802.
+func pgScramVerify(secrets *pgAuthSecrets, authMessage string, proof []byte) ([]byte, bool) {
+	clientSignature := pgHmac(secrets.storedKey, authMessage)
+	if len(proof) != len(clientSignature) {
+		return nil, false
+	}
+	recovered := make([]byte, len(proof))
+	for i := range proof {
+		recovered[i] = proof[i] ^ clientSignature[i]
+	}
+	if got := sha256.Sum256(recovered); !hmac.Equal(got[:], secrets.storedKey) {
+		return nil, false
+	}
+	return pgHmac(secrets.serverKey, authMessage), true
+}
+
+// pbkdf2 derives the key from the password. See RFC 8018.
+func pbkdf2(password, salt []byte, iter, keyLen int, h func() hash.Hash) []byte {
+	prf := hmac.New(h, password)
+	hashLen := prf.Size()
+	numBlocks := (keyLen + hashLen - 1) / hashLen
+
+	var buf [4]byte
+	dk := make([]byte, 0, numBlocks*hashLen)
+	u := make([]byte, hashLen)
+	for block := 1; block <= numBlocks; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(buf[:], uint32(block))
+		prf.Write(buf[:4])
+		dk = prf.Sum(dk)
+		t := dk[len(dk)-hashLen:]
+		copy(u, t)
+
+		for n := 2; n <= iter; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = u[:0]
+			u = prf.Sum(u)
+			for x := range u {
+				t[x] ^= u[x]
+			}
+		}
+	}
+	return dk[:keyLen]
+}
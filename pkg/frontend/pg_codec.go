@@ -0,0 +1,94 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/fagongzi/goetty/v2/codec"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// pgMaxMessageSize limits the size of the message from the client
+const pgMaxMessageSize = 1 << 30
+
+func NewPgCodec() codec.Codec {
+	return &pgCodec{}
+}
+
+// pgCodec splits the byte stream of the postgresql wire protocol into messages.
+// The regular message is the type byte and the int32 length that counts itself.
+// The startup message, the SSLRequest and the CancelRequest have no type byte.
+// The first byte of their length is always 0, which is never a type byte,
+// so they are decoded as the message with the type 0.
+type pgCodec struct {
+}
+
+type pgMessage struct {
+	Type    byte
+	Payload []byte
+}
+
+func (c *pgCodec) Decode(in *buf.ByteBuf) (interface{}, bool, error) {
+	readable := in.Readable()
+	if readable < 5 {
+		return nil, false, nil
+	}
+
+	header := in.PeekN(0, 5)
+	var typ byte
+	var length int
+	var headerLen int
+	if header[0] == 0 {
+		length = int(binary.BigEndian.Uint32(header[0:4])) - 4
+		headerLen = 4
+	} else {
+		typ = header[0]
+		length = int(binary.BigEndian.Uint32(header[1:5])) - 4
+		headerLen = 5
+	}
+	if length < 0 || length > pgMaxMessageSize {
+		return nil, false, moerr.NewInvalidInput(context.Background(), "invalid postgresql message length: %d", length)
+	}
+
+	if readable < length+headerLen {
+		return nil, false, nil
+	}
+
+	in.Skip(headerLen)
+	in.SetMarkIndex(in.GetReadIndex() + length)
+	payload := in.ReadMarkedData()
+
+	return &pgMessage{
+		Type:    typ,
+		Payload: payload,
+	}, true, nil
+}
+
+func (c *pgCodec) Encode(data interface{}, out *buf.ByteBuf, writer io.Writer) error {
+	x := data.([]byte)
+	xlen := len(x)
+	tlen, err := out.Write(x)
+	if err != nil {
+		return err
+	}
+	if tlen != xlen {
+		return errorLenOfWrittenNotEqLenOfData
+	}
+	return nil
+}
@@ -0,0 +1,1220 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+// the codes in the startup packet
+const (
+	pgProtocolVersion   uint32 = 196608
+	pgCancelRequestCode uint32 = 80877102
+	pgSSLRequestCode    uint32 = 80877103
+	pgGSSENCRequestCode uint32 = 80877104
+)
+
+// the messages from the client
+const (
+	pgMsgBind      byte = 'B'
+	pgMsgClose     byte = 'C'
+	pgMsgDescribe  byte = 'D'
+	pgMsgExecute   byte = 'E'
+	pgMsgFlush     byte = 'H'
+	pgMsgParse     byte = 'P'
+	pgMsgPassword  byte = 'p'
+	pgMsgQuery     byte = 'Q'
+	pgMsgSync      byte = 'S'
+	pgMsgTerminate byte = 'X'
+)
+
+// the messages from the server
+const (
+	pgMsgAuthentication       byte = 'R'
+	pgMsgBackendKeyData       byte = 'K'
+	pgMsgBindComplete         byte = '2'
+	pgMsgCloseComplete        byte = '3'
+	pgMsgCommandComplete      byte = 'C'
+	pgMsgDataRow              byte = 'D'
+	pgMsgEmptyQueryResponse   byte = 'I'
+	pgMsgErrorResponse        byte = 'E'
+	pgMsgNoData               byte = 'n'
+	pgMsgParameterDescription byte = 't'
+	pgMsgParameterStatus      byte = 'S'
+	pgMsgParseComplete        byte = '1'
+	pgMsgReadyForQuery        byte = 'Z'
+	pgMsgRowDescription       byte = 'T'
+)
+
+// the oids of the postgresql types
+const (
+	pgTypeBool      uint32 = 16
+	pgTypeBytea     uint32 = 17
+	pgTypeInt8      uint32 = 20
+	pgTypeInt2      uint32 = 21
+	pgTypeInt4      uint32 = 23
+	pgTypeText      uint32 = 25
+	pgTypeJson      uint32 = 114
+	pgTypeFloat4    uint32 = 700
+	pgTypeFloat8    uint32 = 701
+	pgTypeUnknown   uint32 = 705
+	pgTypeBpchar    uint32 = 1042
+	pgTypeVarchar   uint32 = 1043
+	pgTypeDate      uint32 = 1082
+	pgTypeTime      uint32 = 1083
+	pgTypeTimestamp uint32 = 1114
+	pgTypeNumeric   uint32 = 1700
+	pgTypeUuid      uint32 = 2950
+)
+
+const (
+	pgFormatText   int16 = 0
+	pgFormatBinary int16 = 1
+)
+
+// pgServerVersion is reported to the client as the server_version.
+// The clients check it for the features of the server, so it is the
+// version of postgresql that the protocol is compatible with.
+const pgServerVersion = "13.0"
+
+var (
+	pgEpochDate     = types.DateFromCalendar(2000, 1, 1)
+	pgEpochDatetime = types.DatetimeFromClock(2000, 1, 1, 0, 0, 0, 0)
+)
+
+// pgField describes a column of the result set.
+type pgField struct {
+	name string
+	oid  uint32
+}
+
+// pgStatement is the prepared statement created by the Parse message.
+type pgStatement struct {
+	// query is the sql with the placeholders of mysql
+	query string
+	// prepared denotes the query has been prepared as stmtID.
+	// the query without the parameters that can not be prepared is
+	// executed as a simple query.
+	prepared bool
+	stmtID   uint32
+	// paramIndexes is the index of the parameter for every placeholder
+	paramIndexes []int
+	paramOIDs    []uint32
+	fields       []pgField
+}
+
+// pgPortal is the statement with the parameters bound by the Bind message.
+type pgPortal struct {
+	stmt          *pgStatement
+	params        []any
+	resultFormats []int16
+}
+
+// pgPrepared is the prepared statement sent by SendPrepareResponse.
+type pgPrepared struct {
+	stmtID     uint32
+	paramTypes []uint32
+	fields     []pgField
+}
+
+// pgBuffer builds the messages sent to the client.
+type pgBuffer struct {
+	data  []byte
+	start int
+}
+
+func (b *pgBuffer) begin(typ byte) {
+	b.data = append(b.data, typ, 0, 0, 0, 0)
+	b.start = len(b.data) - 4
+}
+
+func (b *pgBuffer) end() {
+	binary.BigEndian.PutUint32(b.data[b.start:], uint32(len(b.data)-b.start))
+}
+
+func (b *pgBuffer) appendInt16(v int16) {
+	b.data = binary.BigEndian.AppendUint16(b.data, uint16(v))
+}
+
+func (b *pgBuffer) appendInt32(v int32) {
+	b.data = binary.BigEndian.AppendUint32(b.data, uint32(v))
+}
+
+func (b *pgBuffer) appendString(s string) {
+	b.data = append(b.data, s...)
+	b.data = append(b.data, 0)
+}
+
+func (b *pgBuffer) appendBytes(v []byte) {
+	b.data = append(b.data, v...)
+}
+
+var _ MysqlProtocol = &PostgresProtocolImpl{}
+
+// PostgresProtocolImpl implements the postgresql wire protocol. It implements
+// the MysqlProtocol, so that the MysqlCmdExecutor executes the requests
+// and sends the results through it as if the client was a mysql client.
+// The column definitions are collected to the RowDescription, the rows are
+// sent as the DataRow messages and the OK packets become the CommandComplete.
+type PostgresProtocolImpl struct {
+	ProtocolImpl
+
+	SV *config.FrontendParameters
+
+	m sync.Mutex
+
+	ses *Session
+
+	//skip checking the password of the user
+	skipCheckUser bool
+
+	username string
+	database string
+	// the parameters in the startup message
+	startupParams map[string]string
+	// the secret key to cancel the query
+	secretKey uint32
+
+	auth pgAuth
+
+	// the columns of the current result set
+	fields []pgField
+	// the count of the rows of the current result set
+	rows uint64
+	// the formats of the columns, nil means the text
+	resultFormats []int16
+	// inExecute denotes the request is executing a portal,
+	// it does not send the RowDescription.
+	inExecute bool
+	// discard denotes the messages to the client are discarded
+	discard bool
+	// failed denotes an error has been sent or discarded for the request
+	failed  bool
+	lastErr error
+	// the command tags of the statements in the request
+	tags []string
+
+	// the parameters for the COM_STMT_EXECUTE
+	bindParams []any
+	// the statement prepared by the current request
+	prepared *pgPrepared
+
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+	// ignoreTillSync denotes an error happened in the extended query,
+	// the messages are ignored until the Sync.
+	ignoreTillSync bool
+}
+
+func NewPostgresProtocol(connectionID uint32, tcp goetty.IOSession, SV *config.FrontendParameters) *PostgresProtocolImpl {
+	return &PostgresProtocolImpl{
+		ProtocolImpl: ProtocolImpl{
+			io:           NewIOPackage(false),
+			tcpConn:      tcp,
+			salt:         generate_salt(20),
+			connectionID: connectionID,
+		},
+		SV:         SV,
+		secretKey:  binary.BigEndian.Uint32(pgRandomBytes(4)),
+		statements: make(map[string]*pgStatement),
+		portals:    make(map[string]*pgPortal),
+	}
+}
+
+func (pp *PostgresProtocolImpl) GetSession() *Session {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.ses
+}
+
+func (pp *PostgresProtocolImpl) SetSession(ses *Session) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.ses = ses
+}
+
+func (pp *PostgresProtocolImpl) SetSkipCheckUser(b bool) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.skipCheckUser = b
+}
+
+func (pp *PostgresProtocolImpl) GetSkipCheckUser() bool {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.skipCheckUser
+}
+
+func (pp *PostgresProtocolImpl) GetDatabaseName() string {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.database
+}
+
+func (pp *PostgresProtocolImpl) SetDatabaseName(s string) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.database = s
+}
+
+func (pp *PostgresProtocolImpl) GetUserName() string {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.username
+}
+
+func (pp *PostgresProtocolImpl) SetUserName(s string) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.username = s
+}
+
+// GetCapability returns 0, none of the capabilities of mysql is supported.
+func (pp *PostgresProtocolImpl) GetCapability() uint32 {
+	return 0
+}
+
+func (pp *PostgresProtocolImpl) GetConnectAttrs() map[string]string {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.startupParams
+}
+
+func (pp *PostgresProtocolImpl) GetStats() string {
+	return ""
+}
+
+func (pp *PostgresProtocolImpl) ResetStatistics() {}
+
+// GetRequest treats the payload as the text of a simple query.
+func (pp *PostgresProtocolImpl) GetRequest(payload []byte) *Request {
+	return &Request{
+		cmd:  COM_QUERY,
+		data: payload,
+	}
+}
+
+// write sends the messages to the client
+func (pp *PostgresProtocolImpl) write(b *pgBuffer) error {
+	if pp.discard || len(b.data) == 0 {
+		return nil
+	}
+	pp.incDebugCount(4)
+	defer pp.incDebugCount(5)
+	return pp.tcpConn.Write(b.data, goetty.WriteOptions{Flush: true})
+}
+
+// writeRaw sends the bytes without the message header to the client.
+func (pp *PostgresProtocolImpl) writeRaw(data []byte) error {
+	return pp.tcpConn.Write(data, goetty.WriteOptions{Flush: true})
+}
+
+func (pp *PostgresProtocolImpl) sendMessage(typ byte, payload []byte) error {
+	b := &pgBuffer{}
+	b.begin(typ)
+	b.appendBytes(payload)
+	b.end()
+	return pp.write(b)
+}
+
+func (pp *PostgresProtocolImpl) sendAuthentication(code int32, data []byte) error {
+	b := &pgBuffer{}
+	b.begin(pgMsgAuthentication)
+	b.appendInt32(code)
+	b.appendBytes(data)
+	b.end()
+	return pp.write(b)
+}
+
+// sendStartupResponse sends the AuthenticationOk and the states of the
+// session after the authentication.
+func (pp *PostgresProtocolImpl) sendStartupResponse() error {
+	b := &pgBuffer{}
+	b.begin(pgMsgAuthentication)
+	b.appendInt32(0)
+	b.end()
+	status := [][2]string{
+		{"server_version", pgServerVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"session_authorization", pp.GetUserName()},
+	}
+	if name, ok := pp.GetConnectAttrs()["application_name"]; ok {
+		status = append(status, [2]string{"application_name", name})
+	}
+	for _, s := range status {
+		b.begin(pgMsgParameterStatus)
+		b.appendString(s[0])
+		b.appendString(s[1])
+		b.end()
+	}
+	b.begin(pgMsgBackendKeyData)
+	b.appendInt32(int32(pp.ConnectionID()))
+	b.appendInt32(int32(pp.secretKey))
+	b.end()
+	pp.appendReadyForQuery(b)
+	return pp.write(b)
+}
+
+func (pp *PostgresProtocolImpl) appendReadyForQuery(b *pgBuffer) {
+	status := byte('I')
+	ses := pp.GetSession()
+	if ses != nil && (ses.OptionBitsIsSet(OPTION_BEGIN) || ses.InActiveMultiStmtTransaction()) {
+		status = 'T'
+	}
+	b.begin(pgMsgReadyForQuery)
+	b.appendBytes([]byte{status})
+	b.end()
+}
+
+func (pp *PostgresProtocolImpl) sendReadyForQuery() error {
+	b := &pgBuffer{}
+	pp.appendReadyForQuery(b)
+	return pp.write(b)
+}
+
+// sendError sends the ErrorResponse. The error in the extended query makes
+// the messages ignored until the Sync.
+func (pp *PostgresProtocolImpl) sendError(severity string, err error) error {
+	pp.failed = true
+	pp.lastErr = err
+	pp.ignoreTillSync = true
+	code, msg := pgErrorCode(err)
+	if attachAbort := pp.getAbortTransactionErrorInfo(); attachAbort != "" {
+		msg = fmt.Sprintf("%s\n%s", msg, attachAbort)
+	}
+	b := &pgBuffer{}
+	b.begin(pgMsgErrorResponse)
+	b.appendBytes([]byte{'S'})
+	b.appendString(severity)
+	b.appendBytes([]byte{'V'})
+	b.appendString(severity)
+	b.appendBytes([]byte{'C'})
+	b.appendString(code)
+	b.appendBytes([]byte{'M'})
+	b.appendString(msg)
+	b.appendBytes([]byte{0})
+	b.end()
+	return pp.write(b)
+}
+
+func (pp *PostgresProtocolImpl) getAbortTransactionErrorInfo() string {
+	ses := pp.GetSession()
+	if ses != nil && ses.OptionBitsIsSet(OPTION_ATTACH_ABORT_TRANSACTION_ERROR) {
+		ses.ClearOptionBits(OPTION_ATTACH_ABORT_TRANSACTION_ERROR)
+		return abortTransactionErrorInfo()
+	}
+	return ""
+}
+
+// pgErrorCode returns the SQLSTATE and the message of the error.
+func pgErrorCode(err error) (string, string) {
+	myerr, ok := err.(*moerr.Error)
+	if !ok {
+		return "XX000", err.Error()
+	}
+	code := myerr.MySQLCode()
+	if code == moerr.ER_UNKNOWN_ERROR {
+		code = myerr.ErrorCode()
+	}
+	switch code {
+	case moerr.ER_DUP_ENTRY:
+		return "23505", myerr.Error()
+	case moerr.ER_BAD_NULL_ERROR:
+		return "23502", myerr.Error()
+	case moerr.ER_NO_SUCH_TABLE:
+		return "42P01", myerr.Error()
+	case moerr.ER_TABLE_EXISTS_ERROR:
+		return "42P07", myerr.Error()
+	case moerr.ER_BAD_FIELD_ERROR:
+		return "42703", myerr.Error()
+	case moerr.ER_PARSE_ERROR:
+		return "42601", myerr.Error()
+	case moerr.ER_BAD_DB_ERROR:
+		return "3D000", myerr.Error()
+	case moerr.ER_DB_CREATE_EXISTS:
+		return "42P04", myerr.Error()
+	case moerr.ER_DIVISION_BY_ZERO:
+		return "22012", myerr.Error()
+	case moerr.ER_ACCESS_DENIED_ERROR:
+		return "28000", myerr.Error()
+	}
+	if state := myerr.SqlState(); len(state) == 5 && state != DefaultMySQLState {
+		return state, myerr.Error()
+	}
+	return "XX000", myerr.Error()
+}
+
+// beginRequest resets the states of the request.
+// The command tags are made from the statements in the sql.
+func (pp *PostgresProtocolImpl) beginRequest(sql string, inExecute bool, resultFormats []int16) {
+	pp.fields = pp.fields[:0]
+	pp.rows = 0
+	pp.inExecute = inExecute
+	pp.resultFormats = resultFormats
+	pp.failed = false
+	pp.lastErr = nil
+	pp.prepared = nil
+	pp.tags = pp.tags[:0]
+	if sql != "" {
+		for _, s := range parsers.SplitSqlBySemicolon(sql) {
+			pp.tags = append(pp.tags, pgCommandTag(s))
+		}
+	}
+}
+
+// nextTag returns the command tag of the statement that completed.
+func (pp *PostgresProtocolImpl) nextTag(affectedRows uint64, isQuery bool) string {
+	tag := "SELECT"
+	if len(pp.tags) > 0 {
+		tag = pp.tags[0]
+		pp.tags = pp.tags[1:]
+	}
+	if isQuery {
+		return fmt.Sprintf("SELECT %d", pp.rows)
+	}
+	switch tag {
+	case "SELECT":
+		return fmt.Sprintf("SELECT %d", affectedRows)
+	case "INSERT":
+		return fmt.Sprintf("INSERT 0 %d", affectedRows)
+	case "UPDATE", "DELETE":
+		return fmt.Sprintf("%s %d", tag, affectedRows)
+	}
+	return tag
+}
+
+// pgCommandTag returns the command tag of the statement without the count.
+func pgCommandTag(sql string) string {
+	words := pgLeadingWords(sql, 2)
+	if len(words) == 0 {
+		return "SELECT"
+	}
+	switch words[0] {
+	case "SELECT", "WITH", "VALUES", "TABLE":
+		return "SELECT"
+	case "INSERT", "REPLACE":
+		return "INSERT"
+	case "START":
+		return "BEGIN"
+	case "CREATE", "DROP", "ALTER":
+		if len(words) > 1 {
+			return words[0] + " " + words[1]
+		}
+	}
+	return words[0]
+}
+
+// pgLeadingWords returns at most n leading words of the sql in upper case,
+// skipping the comments.
+func pgLeadingWords(sql string, n int) []string {
+	var words []string
+	for i := 0; i < len(sql) && len(words) < n; {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '(':
+			i++
+		case strings.HasPrefix(sql[i:], "--") || c == '#':
+			for i < len(sql) && sql[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(sql[i:], "/*"):
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				return words
+			}
+			i += end + 4
+		case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			j := i
+			for j < len(sql) && (sql[j] == '_' || sql[j] >= 'a' && sql[j] <= 'z' || sql[j] >= 'A' && sql[j] <= 'Z' || sql[j] >= '0' && sql[j] <= '9') {
+				j++
+			}
+			words = append(words, strings.ToUpper(sql[i:j]))
+			i = j
+		default:
+			return words
+		}
+	}
+	return words
+}
+
+func (pp *PostgresProtocolImpl) sendCommandComplete(tag string) error {
+	b := &pgBuffer{}
+	b.begin(pgMsgCommandComplete)
+	b.appendString(tag)
+	b.end()
+	return pp.write(b)
+}
+
+func (pp *PostgresProtocolImpl) sendRowDescription(fields []pgField, formats []int16) error {
+	b := &pgBuffer{}
+	pp.appendRowDescription(b, fields, formats)
+	return pp.write(b)
+}
+
+func (pp *PostgresProtocolImpl) appendRowDescription(b *pgBuffer, fields []pgField, formats []int16) {
+	if len(fields) == 0 {
+		b.begin(pgMsgNoData)
+		b.end()
+		return
+	}
+	b.begin(pgMsgRowDescription)
+	b.appendInt16(int16(len(fields)))
+	for i, field := range fields {
+		b.appendString(field.name)
+		// the oid of the table and the attribute number of the column
+		b.appendInt32(0)
+		b.appendInt16(0)
+		b.appendInt32(int32(field.oid))
+		b.appendInt16(pgTypeSize(field.oid))
+		// the type modifier
+		b.appendInt32(-1)
+		b.appendInt16(pgFormatOf(formats, i))
+	}
+	b.end()
+}
+
+func (pp *PostgresProtocolImpl) appendParameterDescription(b *pgBuffer, oids []uint32) {
+	b.begin(pgMsgParameterDescription)
+	b.appendInt16(int16(len(oids)))
+	for _, oid := range oids {
+		b.appendInt32(int32(oid))
+	}
+	b.end()
+}
+
+// pgFormatOf returns the format of the i-th column. One format applies to
+// all the columns, and no format means the text.
+func pgFormatOf(formats []int16, i int) int16 {
+	switch len(formats) {
+	case 0:
+		return pgFormatText
+	case 1:
+		return formats[0]
+	}
+	if i < len(formats) {
+		return formats[i]
+	}
+	return pgFormatText
+}
+
+func pgTypeSize(oid uint32) int16 {
+	switch oid {
+	case pgTypeBool:
+		return 1
+	case pgTypeInt2:
+		return 2
+	case pgTypeInt4, pgTypeFloat4, pgTypeDate:
+		return 4
+	case pgTypeInt8, pgTypeFloat8, pgTypeTime, pgTypeTimestamp:
+		return 8
+	case pgTypeUuid:
+		return 16
+	}
+	return -1
+}
+
+// pgTypeOfMysqlColumn returns the postgresql type of the column.
+func pgTypeOfMysqlColumn(column *MysqlColumn) uint32 {
+	unsigned := uint32(column.Flag())&defines.UNSIGNED_FLAG != 0
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		return pgTypeBool
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_YEAR:
+		return pgTypeInt2
+	case defines.MYSQL_TYPE_SHORT:
+		if unsigned {
+			return pgTypeInt4
+		}
+		return pgTypeInt2
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		if unsigned {
+			return pgTypeInt8
+		}
+		return pgTypeInt4
+	case defines.MYSQL_TYPE_LONGLONG:
+		if unsigned {
+			return pgTypeNumeric
+		}
+		return pgTypeInt8
+	case defines.MYSQL_TYPE_FLOAT:
+		return pgTypeFloat4
+	case defines.MYSQL_TYPE_DOUBLE:
+		return pgTypeFloat8
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+		return pgTypeNumeric
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
+		return pgTypeVarchar
+	case defines.MYSQL_TYPE_STRING:
+		return pgTypeBpchar
+	case defines.MYSQL_TYPE_DATE:
+		return pgTypeDate
+	case defines.MYSQL_TYPE_TIME:
+		return pgTypeTime
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return pgTypeTimestamp
+	case defines.MYSQL_TYPE_JSON:
+		return pgTypeJson
+	case defines.MYSQL_TYPE_UUID:
+		return pgTypeUuid
+	}
+	// the text is sent as the blob, see convertMysqlTextTypeToBlobType
+	return pgTypeText
+}
+
+// pgTypeOfEngineType returns the postgresql type of the engine type.
+func pgTypeOfEngineType(ctx context.Context, t types.T) (uint32, error) {
+	column := new(MysqlColumn)
+	if err := convertEngineTypeToMysqlType(ctx, t, column); err != nil {
+		return 0, err
+	}
+	return pgTypeOfMysqlColumn(column), nil
+}
+
+func (pp *PostgresProtocolImpl) SendColumnCountPacket(count uint64) error {
+	pp.fields = pp.fields[:0]
+	pp.rows = 0
+	return nil
+}
+
+func (pp *PostgresProtocolImpl) SendColumnDefinitionPacket(ctx context.Context, column Column, cmd int) error {
+	mysqlColumn, ok := column.(*MysqlColumn)
+	if !ok {
+		return moerr.NewInternalError(ctx, "sendColumn need MysqlColumn")
+	}
+	pp.fields = append(pp.fields, pgField{
+		name: mysqlColumn.Name(),
+		oid:  pgTypeOfMysqlColumn(mysqlColumn),
+	})
+	return nil
+}
+
+// SendEOFPacketIf sends the RowDescription after the columns. Executing a
+// portal does not send it, the client asks it by the Describe.
+func (pp *PostgresProtocolImpl) SendEOFPacketIf(warnings, status uint16) error {
+	if pp.inExecute {
+		return nil
+	}
+	return pp.sendRowDescription(pp.fields, pp.resultFormats)
+}
+
+func (pp *PostgresProtocolImpl) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return pp.SendResultSetTextBatchRowSpeedup(mrs, cnt)
+}
+
+func (pp *PostgresProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+	ctx := pp.GetSession().GetRequestContext()
+	b := &pgBuffer{}
+	for r := uint64(0); r < cnt; r++ {
+		b.begin(pgMsgDataRow)
+		b.appendInt16(int16(mrs.GetColumnCount()))
+		for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+			isNil, err := mrs.ColumnIsNull(ctx, r, i)
+			if err != nil {
+				return err
+			}
+			if isNil {
+				b.appendInt32(-1)
+				continue
+			}
+			oid := pgTypeText
+			if i < uint64(len(pp.fields)) {
+				oid = pp.fields[i].oid
+			}
+			var value []byte
+			if pgFormatOf(pp.resultFormats, int(i)) == pgFormatBinary {
+				value, err = pgBinaryValue(ctx, mrs, r, i, oid)
+			} else {
+				value, err = pgTextValue(ctx, mrs, r, i)
+			}
+			if err != nil {
+				return err
+			}
+			b.appendInt32(int32(len(value)))
+			b.appendBytes(value)
+		}
+		b.end()
+	}
+	pp.rows += cnt
+	return pp.write(b)
+}
+
+// pgTextValue returns the text format of the value.
+func pgTextValue(ctx context.Context, mrs *MysqlResultSet, r, i uint64) ([]byte, error) {
+	value, err := mrs.GetValue(ctx, r, i)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return []byte("t"), nil
+		}
+		return []byte("f"), nil
+	case float32:
+		return pgAppendFloat(nil, float64(v), 32), nil
+	case float64:
+		return pgAppendFloat(nil, v, 64), nil
+	case types.Date:
+		return []byte(v.String()), nil
+	case []byte:
+		return v, nil
+	}
+	s, err := mrs.GetString(ctx, r, i)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+func pgAppendFloat(data []byte, v float64, bitSize int) []byte {
+	switch {
+	case math.IsInf(v, 1):
+		return append(data, "Infinity"...)
+	case math.IsInf(v, -1):
+		return append(data, "-Infinity"...)
+	case math.IsNaN(v):
+		return append(data, "NaN"...)
+	}
+	return strconv.AppendFloat(data, v, 'g', -1, bitSize)
+}
+
+// pgBinaryValue returns the binary format of the value.
+func pgBinaryValue(ctx context.Context, mrs *MysqlResultSet, r, i uint64, oid uint32) ([]byte, error) {
+	switch oid {
+	case pgTypeBool:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if v, ok := value.(bool); ok {
+			if v {
+				return []byte{1}, nil
+			}
+			return []byte{0}, nil
+		}
+		n, err := mrs.GetInt64(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if n != 0 {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+		n, err := mrs.GetInt64(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		switch oid {
+		case pgTypeInt2:
+			return binary.BigEndian.AppendUint16(nil, uint16(n)), nil
+		case pgTypeInt4:
+			return binary.BigEndian.AppendUint32(nil, uint32(n)), nil
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(n)), nil
+	case pgTypeFloat4, pgTypeFloat8:
+		f, err := mrs.GetFloat64(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if oid == pgTypeFloat4 {
+			return binary.BigEndian.AppendUint32(nil, math.Float32bits(float32(f))), nil
+		}
+		return binary.BigEndian.AppendUint64(nil, math.Float64bits(f)), nil
+	}
+
+	text, err := pgTextValue(ctx, mrs, r, i)
+	if err != nil {
+		return nil, err
+	}
+	switch oid {
+	case pgTypeDate:
+		d, err := types.ParseDateCast(string(text))
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint32(nil, uint32(int32(d)-int32(pgEpochDate))), nil
+	case pgTypeTimestamp:
+		dt, err := types.ParseDatetime(string(text), 6)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(int64(dt)-int64(pgEpochDatetime))), nil
+	case pgTypeTime:
+		t, err := types.ParseTime(string(text), 6)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(t)), nil
+	case pgTypeUuid:
+		u, err := types.ParseUuid(string(text))
+		if err != nil {
+			return nil, err
+		}
+		return u[:], nil
+	case pgTypeNumeric:
+		return pgEncodeNumeric(ctx, string(text))
+	}
+	// the binary format of the text is the same as the text format
+	return text, nil
+}
+
+// pgEncodeNumeric encodes the decimal string into the binary format of the
+// numeric, which is the digits in base 10000.
+func pgEncodeNumeric(ctx context.Context, s string) ([]byte, error) {
+	var sign uint16
+	if strings.HasPrefix(s, "-") {
+		sign = 0x4000
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return nil, moerr.NewInvalidInput(ctx, "invalid numeric %s", s)
+		}
+	}
+	dscale := len(fracPart)
+	if pad := len(intPart) % 4; pad != 0 {
+		intPart = strings.Repeat("0", 4-pad) + intPart
+	}
+	if pad := len(fracPart) % 4; pad != 0 {
+		fracPart = fracPart + strings.Repeat("0", 4-pad)
+	}
+	digitStr := intPart + fracPart
+	weight := len(intPart)/4 - 1
+	digits := make([]uint16, 0, len(digitStr)/4)
+	for i := 0; i < len(digitStr); i += 4 {
+		d, _ := strconv.ParseUint(digitStr[i:i+4], 10, 16)
+		digits = append(digits, uint16(d))
+	}
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight = 0
+		sign = 0
+	}
+	data := binary.BigEndian.AppendUint16(nil, uint16(len(digits)))
+	data = binary.BigEndian.AppendUint16(data, uint16(int16(weight)))
+	data = binary.BigEndian.AppendUint16(data, sign)
+	data = binary.BigEndian.AppendUint16(data, uint16(dscale))
+	for _, d := range digits {
+		data = binary.BigEndian.AppendUint16(data, d)
+	}
+	return data, nil
+}
+
+// pgDecodeNumeric decodes the binary format of the numeric into the decimal string.
+func pgDecodeNumeric(ctx context.Context, data []byte) (string, error) {
+	if len(data) < 8 {
+		return "", moerr.NewInvalidInput(ctx, "invalid binary numeric")
+	}
+	ndigits := int(binary.BigEndian.Uint16(data))
+	weight := int(int16(binary.BigEndian.Uint16(data[2:])))
+	sign := binary.BigEndian.Uint16(data[4:])
+	dscale := int(binary.BigEndian.Uint16(data[6:]))
+	if len(data) != 8+2*ndigits {
+		return "", moerr.NewInvalidInput(ctx, "invalid binary numeric")
+	}
+	if sign == 0xC000 {
+		return "NaN", nil
+	}
+	var sb strings.Builder
+	if sign == 0x4000 {
+		sb.WriteByte('-')
+	}
+	digit := func(i int) uint16 {
+		if i >= 0 && i < ndigits {
+			return binary.BigEndian.Uint16(data[8+2*i:])
+		}
+		return 0
+	}
+	if weight < 0 {
+		sb.WriteByte('0')
+	} else {
+		sb.WriteString(strconv.Itoa(int(digit(0))))
+		for i := 1; i <= weight; i++ {
+			sb.WriteString(fmt.Sprintf("%04d", digit(i)))
+		}
+	}
+	if dscale > 0 {
+		var frac strings.Builder
+		for i := weight + 1; frac.Len() < dscale; i++ {
+			frac.WriteString(fmt.Sprintf("%04d", digit(i)))
+		}
+		sb.WriteByte('.')
+		sb.WriteString(frac.String()[:dscale])
+	}
+	return sb.String(), nil
+}
+
+// pgDecodeParam decodes the parameter into the value of the user variable.
+func pgDecodeParam(ctx context.Context, oid uint32, format int16, data []byte) (any, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if format == pgFormatText {
+		s := string(data)
+		switch oid {
+		case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+			return strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		case pgTypeFloat4, pgTypeFloat8:
+			return strconv.ParseFloat(strings.TrimSpace(s), 64)
+		case pgTypeBool:
+			switch strings.ToLower(strings.TrimSpace(s)) {
+			case "t", "true", "y", "yes", "on", "1":
+				return true, nil
+			case "f", "false", "n", "no", "off", "0":
+				return false, nil
+			}
+			return nil, moerr.NewInvalidInput(ctx, "invalid boolean %s", s)
+		}
+		return s, nil
+	}
+
+	size := pgTypeSize(oid)
+	if size > 0 && int(size) != len(data) {
+		return nil, moerr.NewInvalidInput(ctx, "invalid binary parameter of the type %d", oid)
+	}
+	switch oid {
+	case pgTypeBool:
+		return data[0] != 0, nil
+	case pgTypeInt2:
+		return int64(int16(binary.BigEndian.Uint16(data))), nil
+	case pgTypeInt4:
+		return int64(int32(binary.BigEndian.Uint32(data))), nil
+	case pgTypeInt8:
+		return int64(binary.BigEndian.Uint64(data)), nil
+	case pgTypeFloat4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), nil
+	case pgTypeFloat8:
+		return math.Float64frombits(binary.BigEndian.Uint64(data)), nil
+	case pgTypeDate:
+		return types.Date(int32(pgEpochDate) + int32(binary.BigEndian.Uint32(data))).String(), nil
+	case pgTypeTimestamp:
+		return types.Datetime(int64(pgEpochDatetime) + int64(binary.BigEndian.Uint64(data))).String2(6), nil
+	case pgTypeTime:
+		return types.Time(int64(binary.BigEndian.Uint64(data))).String2(6), nil
+	case pgTypeUuid:
+		var u types.Uuid
+		copy(u[:], data)
+		return u.ToString(), nil
+	case pgTypeNumeric:
+		return pgDecodeNumeric(ctx, data)
+	case pgTypeText, pgTypeVarchar, pgTypeBpchar, pgTypeJson, pgTypeBytea, pgTypeUnknown, 0:
+		return string(data), nil
+	}
+	return nil, moerr.NewInvalidInput(ctx, "unsupported binary parameter of the type %d", oid)
+}
+
+// sendEOFOrOkPacket completes the result set.
+func (pp *PostgresProtocolImpl) sendEOFOrOkPacket(warnings, status uint16) error {
+	return pp.sendCommandComplete(pp.nextTag(0, true))
+}
+
+func (pp *PostgresProtocolImpl) sendOKPacket(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	return pp.sendCommandComplete(pp.nextTag(affectedRows, false))
+}
+
+func (pp *PostgresProtocolImpl) sendLocalInfileRequest(filename string) error {
+	return moerr.NewNotSupportedNoCtx("load data local through the postgresql protocol")
+}
+
+func (pp *PostgresProtocolImpl) SendResponse(ctx context.Context, resp *Response) error {
+	switch resp.category {
+	case OkResponse:
+		return pp.sendOKPacket(resp.affectedRows, resp.lastInsertId, resp.status, resp.warnings, "")
+	case EoFResponse:
+		return pp.sendEOFOrOkPacket(0, resp.status)
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			return pp.sendOKPacket(0, 0, resp.status, 0, "")
+		}
+		if pp.discard {
+			pp.failed = true
+			pp.lastErr = err
+			return nil
+		}
+		return pp.sendError("ERROR", err)
+	case ResultResponse:
+		mer, _ := resp.data.(*MysqlExecutionResult)
+		if mer == nil {
+			return pp.sendOKPacket(0, 0, resp.status, 0, "")
+		}
+		if mer.Mrs() == nil {
+			return pp.sendOKPacket(mer.AffectedRows(), mer.InsertID(), resp.status, mer.Warnings(), "")
+		}
+		return pp.sendResultSet(ctx, mer.Mrs(), resp.cmd)
+	case LocalInfileRequest:
+		s, _ := resp.data.(string)
+		return pp.sendLocalInfileRequest(s)
+	default:
+		return moerr.NewInternalError(ctx, "unsupported response:%d ", resp.category)
+	}
+}
+
+func (pp *PostgresProtocolImpl) sendResultSet(ctx context.Context, mrs *MysqlResultSet, cmd int) error {
+	if err := pp.SendColumnCountPacket(mrs.GetColumnCount()); err != nil {
+		return err
+	}
+	for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+		column, err := mrs.GetColumn(ctx, i)
+		if err != nil {
+			return err
+		}
+		if err = pp.SendColumnDefinitionPacket(ctx, column, cmd); err != nil {
+			return err
+		}
+	}
+	if err := pp.SendEOFPacketIf(0, 0); err != nil {
+		return err
+	}
+	if err := pp.SendResultSetTextBatchRowSpeedup(mrs, mrs.GetRowCount()); err != nil {
+		return err
+	}
+	return pp.sendEOFOrOkPacket(0, 0)
+}
+
+// SendPrepareResponse keeps the prepared statement for the Parse message.
+func (pp *PostgresProtocolImpl) SendPrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	stmtID, err := GetPrepareStmtID(ctx, stmt.Name)
+	if err != nil {
+		return moerr.NewInternalError(ctx, "can not get Prepare stmtID")
+	}
+	prepared := &pgPrepared{
+		stmtID:     uint32(stmtID),
+		paramTypes: make([]uint32, len(dcPrepare.Prepare.ParamTypes)),
+	}
+	for i, t := range dcPrepare.Prepare.ParamTypes {
+		if prepared.paramTypes[i], err = pgTypeOfEngineType(ctx, types.T(t)); err != nil {
+			return err
+		}
+	}
+	for _, col := range plan2.GetResultColumnsFromPlan(dcPrepare.Prepare.Plan) {
+		column := new(MysqlColumn)
+		if err = convertEngineTypeToMysqlType(ctx, types.T(col.Typ.Id), column); err != nil {
+			return err
+		}
+		prepared.fields = append(prepared.fields, pgField{
+			name: col.Name,
+			oid:  pgTypeOfMysqlColumn(column),
+		})
+	}
+	pp.prepared = prepared
+	return nil
+}
+
+// ParseExecuteData returns the parameters of the Bind message.
+func (pp *PostgresProtocolImpl) ParseExecuteData(ctx context.Context, stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error) {
+	names = make([]string, len(pp.bindParams))
+	for i := range names {
+		names[i] = getPrepareStmtSessionVarName(i)
+	}
+	return names, pp.bindParams, nil
+}
+
+// pgRewritePlaceholders replaces the placeholders $n in the query with the
+// placeholders of mysql. It returns the index of the parameter of every
+// placeholder and the count of the parameters.
+func pgRewritePlaceholders(query string) (string, []int, int) {
+	var sb strings.Builder
+	var indexes []int
+	count := 0
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for j < len(query) {
+				if query[j] == '\\' && c == '\'' {
+					j += 2
+					continue
+				}
+				if query[j] == c {
+					if j+1 < len(query) && query[j+1] == c {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			j = Min(j+1, len(query))
+			sb.WriteString(query[i:j])
+			i = j
+		case strings.HasPrefix(query[i:], "--"):
+			j := strings.IndexByte(query[i:], '\n')
+			if j < 0 {
+				j = len(query) - i
+			}
+			sb.WriteString(query[i : i+j])
+			i += j
+		case strings.HasPrefix(query[i:], "/*"):
+			j := strings.Index(query[i+2:], "*/")
+			if j < 0 {
+				j = len(query) - i
+			} else {
+				j += 4
+			}
+			sb.WriteString(query[i : i+j])
+			i += j
+		case c == '$' && i+1 < len(query) && query[i+1] >= '0' && query[i+1] <= '9':
+			j := i + 1
+			for j < len(query) && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			n, _ := strconv.Atoi(query[i+1 : j])
+			indexes = append(indexes, n-1)
+			count = Max(count, n)
+			sb.WriteByte('?')
+			i = j
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String(), indexes, count
+}
@@ -0,0 +1,384 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/stretchr/testify/require"
+)
+
+// newPgProtocolForTest returns the protocol that records the messages
+// written to the client.
+func newPgProtocolForTest(t *testing.T) (*PostgresProtocolImpl, *[]pgMessage) {
+	ctrl := gomock.NewController(t)
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	var messages []pgMessage
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+		data := msg.([]byte)
+		for len(data) >= 5 {
+			length := int(binary.BigEndian.Uint32(data[1:5]))
+			messages = append(messages, pgMessage{Type: data[0], Payload: data[5 : 1+length]})
+			data = data[1+length:]
+		}
+		if len(data) > 0 {
+			messages = append(messages, pgMessage{Type: data[0]})
+		}
+		return nil
+	}).AnyTimes()
+	return NewPostgresProtocol(1, ioses, nil), &messages
+}
+
+func Test_pgHandleHandshake(t *testing.T) {
+	ctx := context.TODO()
+	pp, messages := newPgProtocolForTest(t)
+
+	ssl := binary.BigEndian.AppendUint32(nil, pgSSLRequestCode)
+	isTls, err := pp.HandleHandshake(ctx, ssl)
+	require.NoError(t, err)
+	require.True(t, isTls)
+
+	startup := binary.BigEndian.AppendUint32(nil, pgProtocolVersion)
+	startup = append(startup, "user\x00dump\x00database\x00db1\x00application_name\x00psql\x00\x00"...)
+	isTls, err = pp.HandleHandshake(ctx, startup)
+	require.NoError(t, err)
+	require.False(t, isTls)
+	require.Equal(t, "dump", pp.GetUserName())
+	require.Equal(t, "db1", pp.GetDatabaseName())
+
+	pp2, _ := newPgProtocolForTest(t)
+	startup = binary.BigEndian.AppendUint32(nil, pgProtocolVersion)
+	startup = append(startup, "user\x00dump\x00database\x00dump\x00\x00"...)
+	_, err = pp2.HandleHandshake(ctx, startup)
+	require.NoError(t, err)
+	require.Equal(t, "", pp2.GetDatabaseName())
+
+	_, err = pp.HandleHandshake(ctx, binary.BigEndian.AppendUint32(nil, 2<<16))
+	require.Error(t, err)
+	_, err = pp.HandleHandshake(ctx, append(binary.BigEndian.AppendUint32(nil, pgProtocolVersion), 0))
+	require.Error(t, err)
+	require.Empty(t, *messages)
+}
+
+func Test_pgSendResultSet(t *testing.T) {
+	ctx := context.TODO()
+	pp, messages := newPgProtocolForTest(t)
+	ses := &Session{}
+	ses.SetRequestContext(ctx)
+	pp.SetSession(ses)
+
+	mrs := &MysqlResultSet{}
+	col1 := &MysqlColumn{}
+	col1.SetName("a")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONG)
+	mrs.AddColumn(col1)
+	col2 := &MysqlColumn{}
+	col2.SetName("b")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs.AddColumn(col2)
+	mrs.AddRow([]interface{}{int32(7), "x"})
+	mrs.AddRow([]interface{}{int32(-1), nil})
+
+	// the simple query in the text format
+	pp.beginRequest("select a, b from t", false, nil)
+	require.NoError(t, pp.sendResultSet(ctx, mrs, int(COM_QUERY)))
+	require.Len(t, *messages, 4)
+	require.Equal(t, byte(pgMsgRowDescription), (*messages)[0].Type)
+	require.Equal(t, uint16(2), binary.BigEndian.Uint16((*messages)[0].Payload))
+	require.Equal(t, byte(pgMsgDataRow), (*messages)[1].Type)
+	row := (*messages)[1].Payload
+	require.Equal(t, uint16(2), binary.BigEndian.Uint16(row))
+	require.Equal(t, uint32(1), binary.BigEndian.Uint32(row[2:]))
+	require.Equal(t, "7", string(row[6:7]))
+	row = (*messages)[2].Payload
+	require.Equal(t, "-1", string(row[6:8]))
+	require.Equal(t, int32(-1), int32(binary.BigEndian.Uint32(row[8:])))
+	require.Equal(t, byte(pgMsgCommandComplete), (*messages)[3].Type)
+	require.Equal(t, "SELECT 2\x00", string((*messages)[3].Payload))
+
+	// the portal with the binary result skips the RowDescription
+	*messages = nil
+	pp.beginRequest("select a, b from t", true, []int16{pgFormatBinary, pgFormatText})
+	require.NoError(t, pp.sendResultSet(ctx, mrs, int(COM_STMT_EXECUTE)))
+	require.Len(t, *messages, 3)
+	row = (*messages)[0].Payload
+	require.Equal(t, uint32(4), binary.BigEndian.Uint32(row[2:]))
+	require.Equal(t, uint32(7), binary.BigEndian.Uint32(row[6:]))
+	require.Equal(t, "x", string(row[14:]))
+
+	// the discarded responses are not written
+	*messages = nil
+	pp.discard = true
+	require.NoError(t, pp.sendResultSet(ctx, mrs, int(COM_QUERY)))
+	pp.discard = false
+	require.Empty(t, *messages)
+}
+
+func Test_pgCodecDecode(t *testing.T) {
+	codec := NewPgCodec()
+	in := buf.NewByteBuf(1024)
+
+	// the startup message without the type byte
+	startup := binary.BigEndian.AppendUint32(nil, 8)
+	startup = binary.BigEndian.AppendUint32(startup, pgProtocolVersion)
+	// the query message in two pieces
+	query := []byte{pgMsgQuery}
+	query = binary.BigEndian.AppendUint32(query, uint32(4+len("select 1\x00")))
+	query = append(query, "select 1\x00"...)
+
+	_, _ = in.Write(startup)
+	_, _ = in.Write(query[:7])
+
+	msg, ok, err := codec.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, byte(0), msg.(*pgMessage).Type)
+	require.Equal(t, uint32(pgProtocolVersion), binary.BigEndian.Uint32(msg.(*pgMessage).Payload))
+
+	_, ok, err = codec.Decode(in)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, _ = in.Write(query[7:])
+	msg, ok, err = codec.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, byte(pgMsgQuery), msg.(*pgMessage).Type)
+	require.Equal(t, "select 1\x00", string(msg.(*pgMessage).Payload))
+
+	bad := []byte{pgMsgQuery, 0, 0, 0, 1}
+	_, _ = in.Write(bad)
+	_, _, err = codec.Decode(in)
+	require.Error(t, err)
+}
+
+func Test_pgRewritePlaceholders(t *testing.T) {
+	kases := []struct {
+		query   string
+		want    string
+		indexes []int
+		count   int
+	}{
+		{"select 1", "select 1", nil, 0},
+		{"select * from t where a = $1 and b = $2", "select * from t where a = ? and b = ?", []int{0, 1}, 2},
+		{"select $2, $1, $2", "select ?, ?, ?", []int{1, 0, 1}, 2},
+		{"select '$1', \"$2\" -- $3\n, $1 /* $4 */", "select '$1', \"$2\" -- $3\n, ? /* $4 */", []int{0}, 1},
+		{"select 'it''s $1', $1", "select 'it''s $1', ?", []int{0}, 1},
+		{"select $a", "select $a", nil, 0},
+	}
+	for _, kase := range kases {
+		got, indexes, count := pgRewritePlaceholders(kase.query)
+		require.Equal(t, kase.want, got, kase.query)
+		require.Equal(t, kase.indexes, indexes, kase.query)
+		require.Equal(t, kase.count, count, kase.query)
+	}
+}
+
+func Test_pgCommandTag(t *testing.T) {
+	kases := []struct {
+		sql  string
+		want string
+	}{
+		{"select 1", "SELECT"},
+		{"  /* hint */ insert into t values (1)", "INSERT"},
+		{"-- comment\nupdate t set a = 1", "UPDATE"},
+		{"delete from t", "DELETE"},
+		{"create table t (a int)", "CREATE TABLE"},
+		{"drop database db", "DROP DATABASE"},
+		{"start transaction", "BEGIN"},
+		{"commit", "COMMIT"},
+		{"", "SELECT"},
+	}
+	for _, kase := range kases {
+		require.Equal(t, kase.want, pgCommandTag(kase.sql), kase.sql)
+	}
+
+	pp := &PostgresProtocolImpl{}
+	pp.beginRequest("insert into t values (1); update t set a = 2; select 1; begin", false, nil)
+	require.Equal(t, "INSERT 0 3", pp.nextTag(3, false))
+	require.Equal(t, "UPDATE 2", pp.nextTag(2, false))
+	pp.rows = 1
+	require.Equal(t, "SELECT 1", pp.nextTag(0, true))
+	require.Equal(t, "BEGIN", pp.nextTag(0, false))
+}
+
+func Test_pgNumeric(t *testing.T) {
+	ctx := context.TODO()
+	for _, s := range []string{"0", "1", "-1", "12345.678", "0.0001", "-98765432109876.5", "10000", "1.10"} {
+		data, err := pgEncodeNumeric(ctx, s)
+		require.NoError(t, err, s)
+		got, err := pgDecodeNumeric(ctx, data)
+		require.NoError(t, err, s)
+		require.Equal(t, s, got)
+	}
+	_, err := pgEncodeNumeric(ctx, "abc")
+	require.Error(t, err)
+}
+
+func Test_pgDecodeParam(t *testing.T) {
+	ctx := context.TODO()
+	kases := []struct {
+		oid    uint32
+		format int16
+		data   []byte
+		want   any
+	}{
+		{pgTypeInt4, pgFormatText, []byte("42"), int64(42)},
+		{pgTypeFloat8, pgFormatText, []byte("1.5"), 1.5},
+		{pgTypeBool, pgFormatText, []byte("t"), true},
+		{pgTypeText, pgFormatText, []byte("abc"), "abc"},
+		{pgTypeInt2, pgFormatBinary, []byte{0xff, 0xfe}, int64(-2)},
+		{pgTypeInt4, pgFormatBinary, binary.BigEndian.AppendUint32(nil, 7), int64(7)},
+		{pgTypeInt8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 1<<40), int64(1 << 40)},
+		{pgTypeFloat8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, math.Float64bits(2.25)), 2.25},
+		{pgTypeBool, pgFormatBinary, []byte{1}, true},
+		{pgTypeDate, pgFormatBinary, binary.BigEndian.AppendUint32(nil, 1), "2000-01-02"},
+		{pgTypeVarchar, pgFormatBinary, []byte("xyz"), "xyz"},
+		{pgTypeInt4, pgFormatText, nil, nil},
+	}
+	for i, kase := range kases {
+		got, err := pgDecodeParam(ctx, kase.oid, kase.format, kase.data)
+		require.NoError(t, err, i)
+		require.Equal(t, kase.want, got, i)
+	}
+
+	_, err := pgDecodeParam(ctx, pgTypeInt4, pgFormatBinary, []byte{1, 2})
+	require.Error(t, err)
+	_, err = pgDecodeParam(ctx, pgTypeBool, pgFormatText, []byte("maybe"))
+	require.Error(t, err)
+}
+
+func Test_pgFormatOf(t *testing.T) {
+	require.Equal(t, int16(pgFormatText), pgFormatOf(nil, 3))
+	require.Equal(t, int16(pgFormatBinary), pgFormatOf([]int16{pgFormatBinary}, 3))
+	require.Equal(t, int16(pgFormatText), pgFormatOf([]int16{pgFormatBinary, pgFormatText}, 1))
+}
+
+func Test_pgMD5Response(t *testing.T) {
+	// md5(md5("secret" + "alice") + salt)
+	salt := []byte{1, 2, 3, 4}
+	secrets := newPgAuthSecrets(sysAccountName, "alice", []byte("secret"), []byte("0123456789abcdef"), pgScramIterations)
+	inner := md5.Sum([]byte("secretalice"))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	require.Equal(t, "md5"+hex.EncodeToString(outer[:]), pgMD5Response(secrets.md5, salt))
+	require.NotEqual(t, pgMD5Response(secrets.md5, salt), pgMD5Response(secrets.md5, []byte{4, 3, 2, 1}))
+
+	// the login name of the other accounts has the account name
+	require.Equal(t, "acc1:alice", newPgAuthSecrets("acc1", "alice", []byte("secret"), salt, 1).login)
+	require.NotEqual(t, secrets.md5, newPgAuthSecrets("acc1", "alice", []byte("secret"), salt, 1).md5)
+}
+
+func Test_pgCleartextPassword(t *testing.T) {
+	stored := HashPassWord("111")
+	require.True(t, checkPlaintextPassword(stored, []byte("111")))
+	require.False(t, checkPlaintextPassword(stored, []byte("112")))
+	require.True(t, checkPlaintextPassword("", nil))
+	require.False(t, checkPlaintextPassword(stored, nil))
+}
+
+func Test_pgAuthMethodFor(t *testing.T) {
+	ctx := context.TODO()
+	secrets := newPgAuthSecrets("acc1", "u1", []byte("111"), []byte("0123456789abcdef"), pgScramIterations)
+	method, err := pgAuthMethodFor(ctx, "SCRAM-SHA-256", "acc1#u1", secrets, false)
+	require.NoError(t, err)
+	require.Equal(t, pgAuthScramSha256, method)
+	method, err = pgAuthMethodFor(ctx, "md5", "acc1:u1", secrets, false)
+	require.NoError(t, err)
+	require.Equal(t, pgAuthMD5, method)
+	// the md5 secret is bound to the login name
+	_, err = pgAuthMethodFor(ctx, "md5", "acc1#u1", secrets, false)
+	require.Error(t, err)
+
+	// the users without secrets fall back to the cleartext password over TLS only
+	_, err = pgAuthMethodFor(ctx, "scram-sha-256", "u1", nil, false)
+	require.Error(t, err)
+	method, err = pgAuthMethodFor(ctx, "md5", "u1", nil, true)
+	require.NoError(t, err)
+	require.Equal(t, pgAuthPassword, method)
+
+	// no cleartext password without TLS
+	_, err = pgAuthMethodFor(ctx, "password", "u1", secrets, false)
+	require.Error(t, err)
+	method, err = pgAuthMethodFor(ctx, "password", "u1", secrets, true)
+	require.NoError(t, err)
+	require.Equal(t, pgAuthPassword, method)
+
+	_, err = pgAuthMethodFor(ctx, "trust", "u1", nil, true)
+	require.Error(t, err)
+}
+
+func Test_pbkdf2(t *testing.T) {
+	// the test vector of RFC 6070
+	got := pbkdf2([]byte("password"), []byte("salt"), 2, 20, sha1.New)
+	require.Equal(t, "ea6c014dc72d6f8ccd1ed92ace1d41f0d8de8957", hex.EncodeToString(got))
+	got = pbkdf2([]byte("password"), []byte("salt"), 4096, 20, sha1.New)
+	require.Equal(t, "4b007901b765489abead49d926f721d065a429c1", hex.EncodeToString(got))
+}
+
+func Test_pgScramVerify(t *testing.T) {
+	password := []byte("pencil")
+	salt := []byte("0123456789abcdef")
+	authMessage := "n=,r=abc,r=abcdef,s=MDEyMzQ1Njc4OWFiY2RlZg==,i=4096,c=biws,r=abcdef"
+
+	// compute the proof of the client
+	saltedPassword := pbkdf2(password, salt, pgScramIterations, sha256.Size, sha256.New)
+	clientKey := pgHmac(saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	clientSignature := pgHmac(storedKey[:], authMessage)
+	proof := make([]byte, len(clientKey))
+	for i := range proof {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+
+	// the server only keeps the verifier
+	verifier := newPgAuthSecrets(sysAccountName, "u1", password, salt, pgScramIterations).scramVerifier()
+	secrets, ok := parsePgAuthSecrets("u1", verifier, "")
+	require.True(t, ok)
+	require.Equal(t, salt, secrets.salt)
+	require.Equal(t, pgScramIterations, secrets.iterations)
+
+	serverSignature, ok := pgScramVerify(secrets, authMessage, proof)
+	require.True(t, ok)
+	serverKey := pgHmac(saltedPassword, "Server Key")
+	require.True(t, hmac.Equal(pgHmac(serverKey, authMessage), serverSignature))
+
+	_, ok = pgScramVerify(newPgAuthSecrets(sysAccountName, "u1", []byte("pen"), salt, pgScramIterations), authMessage, proof)
+	require.False(t, ok)
+	_, ok = pgScramVerify(secrets, authMessage, proof[:8])
+	require.False(t, ok)
+
+	_, ok = parsePgAuthSecrets("u1", "md5abc", "")
+	require.False(t, ok)
+	_, ok = parsePgAuthSecrets("u1", "SCRAM-SHA-256$x:MDEy$a:b", "")
+	require.False(t, ok)
+
+	require.Equal(t, "abcdef", pgScramAttr("c=biws,r=abcdef,p=xyz", 'r'))
+	require.Equal(t, "", pgScramAttr("c=biws", 'p'))
+}
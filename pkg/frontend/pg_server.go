@@ -0,0 +1,524 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"strings"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+)
+
+// pgHandler serves the connections of the postgresql wire protocol.
+// The routines are kept in the RoutineManager with the ones of mysql,
+// so that they share the session management, kill and the metrics.
+type pgHandler struct {
+	rm *RoutineManager
+}
+
+func newPgHandler(rm *RoutineManager) *pgHandler {
+	return &pgHandler{rm: rm}
+}
+
+func (h *pgHandler) Created(rs goetty.IOSession) {
+	logutil.Debugf("get the postgresql connection from %s", rs.RemoteAddress())
+	rm := h.rm
+	pu := rm.getParameterUnit()
+	connID, err := rm.getConnID()
+	if err != nil {
+		logutil.Errorf("failed to get connection ID from HAKeeper: %v", err)
+		return
+	}
+	pro := NewPostgresProtocol(connID, rs, pu.SV)
+	pro.SetSkipCheckUser(rm.GetSkipCheckUser())
+	exe := NewMysqlCmdExecutor()
+	exe.SetRoutineManager(rm)
+	exe.ChooseDoQueryFunc(pu.SV.EnableDoComQueryInProgress)
+
+	routine := NewRoutine(rm.getCtx(), pro, exe, pu.SV, rs)
+
+	ses := NewSession(routine.getProtocol(), nil, pu, GSysVariables, true, rm.aicm)
+	ses.SetRequestContext(routine.getCancelRoutineCtx())
+	ses.SetConnectContext(routine.getCancelRoutineCtx())
+	ses.SetFromRealUser(true)
+	ses.setSkipCheckPrivilege(rm.GetSkipCheckUser())
+	ses.SetDialect(dialect.POSTGRESQL)
+
+	routine.setSession(ses)
+	pro.SetSession(ses)
+
+	// the client sends the startup message first
+	rm.setRoutine(rs, routine)
+}
+
+func (h *pgHandler) Closed(rs goetty.IOSession) {
+	h.rm.Closed(rs)
+}
+
+func (h *pgHandler) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
+	ctx, span := trace.Start(h.rm.getCtx(), "pgHandler.Handler")
+	defer span.End()
+	routine := h.rm.getRoutine(rs)
+	if routine == nil {
+		err := moerr.NewInternalError(ctx, "routine does not exist")
+		logutil.Errorf("%s error:%v", getConnectionInfo(rs), err)
+		return err
+	}
+	message, ok := msg.(*pgMessage)
+	if !ok {
+		return moerr.NewInternalError(ctx, "message is not pgMessage")
+	}
+	pp, ok := routine.getProtocol().(*PostgresProtocolImpl)
+	if !ok {
+		return moerr.NewInternalError(ctx, "protocol is not PostgresProtocolImpl")
+	}
+	routine.setInProcessRequest(true)
+	defer routine.setInProcessRequest(false)
+
+	if !pp.IsEstablished() {
+		return h.handleStartup(ctx, rs, routine, pp, message)
+	}
+
+	if pp.ignoreTillSync && message.Type != pgMsgSync && message.Type != pgMsgTerminate {
+		return nil
+	}
+
+	var err error
+	switch message.Type {
+	case pgMsgQuery:
+		err = h.handleQuery(routine, pp, string(bytes.TrimRight(message.Payload, "\x00")))
+	case pgMsgParse:
+		err = h.handleParse(ctx, routine, pp, message.Payload)
+	case pgMsgBind:
+		err = h.handleBind(ctx, pp, message.Payload)
+	case pgMsgDescribe:
+		err = h.handleDescribe(ctx, pp, message.Payload)
+	case pgMsgExecute:
+		err = h.handleExecute(ctx, routine, pp, message.Payload)
+	case pgMsgClose:
+		err = h.handleClose(ctx, routine, pp, message.Payload)
+	case pgMsgSync:
+		pp.ignoreTillSync = false
+		err = pp.sendReadyForQuery()
+	case pgMsgFlush:
+	case pgMsgTerminate:
+		pp.Quit()
+	default:
+		err = pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "unsupported postgresql message type %q", message.Type))
+	}
+	if err != nil {
+		logErrorf(pp.GetDebugString(), "error:%v", err)
+	}
+	return err
+}
+
+// handleStartup handles the messages before the connection is established.
+func (h *pgHandler) handleStartup(ctx context.Context, rs goetty.IOSession, routine *Routine, pp *PostgresProtocolImpl, message *pgMessage) error {
+	protoInfo := pp.GetDebugString()
+	var err error
+	if message.Type == pgMsgPassword {
+		var done bool
+		done, err = pp.handlePasswordMessage(ctx, message.Payload)
+		if err == nil && done {
+			err = pp.Authenticate(ctx)
+			if err == nil {
+				return h.establish(pp)
+			}
+		}
+		if err != nil {
+			_ = pp.sendError("FATAL", moerr.NewInternalError(ctx, "%s", err.Error()))
+		}
+		return err
+	}
+	if message.Type != 0 {
+		return moerr.NewInvalidInput(ctx, "unexpected postgresql message type %q during startup", message.Type)
+	}
+
+	if len(message.Payload) >= 12 && binary.BigEndian.Uint32(message.Payload) == pgCancelRequestCode {
+		h.cancelRequest(binary.BigEndian.Uint32(message.Payload[4:]), binary.BigEndian.Uint32(message.Payload[8:]))
+		pp.Quit()
+		return nil
+	}
+
+	isTlsHeader, err := pp.HandleHandshake(ctx, message.Payload)
+	if err != nil {
+		logErrorf(protoInfo, "error:%v", err)
+		_ = pp.sendError("FATAL", err)
+		return err
+	}
+	if isTlsHeader {
+		tlsConfig := h.rm.getTlsConfig()
+		if tlsConfig == nil || pp.IsTlsEstablished() {
+			return pp.writeRaw([]byte{'N'})
+		}
+		if err = pp.writeRaw([]byte{'S'}); err != nil {
+			return err
+		}
+		logDebugf(protoInfo, "upgrade to TLS")
+		tlsConn := tls.Server(rs.RawConn(), tlsConfig)
+		newCtx, cancelFun := context.WithTimeout(ctx, 20*time.Second)
+		defer cancelFun()
+		if err = tlsConn.HandshakeContext(newCtx); err != nil {
+			logErrorf(protoInfo, "error:%v", err)
+			return err
+		}
+		rs.UseConn(tlsConn)
+		pp.SetTlsEstablished()
+		return nil
+	}
+	if pp.GetUserName() == "" {
+		// the GSSENCRequest has been declined
+		return nil
+	}
+
+	if err = pp.startAuthentication(ctx); err != nil {
+		logErrorf(protoInfo, "error:%v", err)
+		_ = pp.sendError("FATAL", err)
+		return err
+	}
+	if pp.auth.method == "" {
+		return h.establish(pp)
+	}
+	return nil
+}
+
+func (h *pgHandler) establish(pp *PostgresProtocolImpl) error {
+	pp.SetEstablished()
+	pp.ignoreTillSync = false
+	ses := pp.GetSession()
+	if dbName := pp.GetDatabaseName(); ses != nil && dbName != "" {
+		ses.SetDatabaseName(dbName)
+	}
+	return pp.sendStartupResponse()
+}
+
+// cancelRequest cancels the query of the connection with the secret key.
+func (h *pgHandler) cancelRequest(connID, secretKey uint32) {
+	rm := h.rm
+	rm.mu.RLock()
+	var rt *Routine
+	for _, value := range rm.clients {
+		if pp, ok := value.getProtocol().(*PostgresProtocolImpl); ok &&
+			pp.ConnectionID() == connID && pp.secretKey == secretKey {
+			rt = value
+			break
+		}
+	}
+	rm.mu.RUnlock()
+	if rt != nil {
+		logutil.Infof("cancel the query on the connection %d", connID)
+		rt.killQuery(false, "")
+	}
+}
+
+// execute handles the request in the routine. The responses of the request are
+// discarded if discard is true, and the error is returned.
+func (h *pgHandler) execute(routine *Routine, pp *PostgresProtocolImpl, req *Request, discard bool) error {
+	pp.discard = discard
+	defer func() {
+		pp.discard = false
+	}()
+	if err := routine.handleRequest(req); err != nil {
+		return err
+	}
+	if discard && pp.failed {
+		return pp.lastErr
+	}
+	return nil
+}
+
+// handleQuery handles the simple query.
+func (h *pgHandler) handleQuery(routine *Routine, pp *PostgresProtocolImpl, sql string) error {
+	pp.ignoreTillSync = false
+	if strings.TrimSpace(sql) == "" || strings.TrimSpace(sql) == ";" {
+		if err := pp.sendMessage(pgMsgEmptyQueryResponse, nil); err != nil {
+			return err
+		}
+		return pp.sendReadyForQuery()
+	}
+	pp.beginRequest(sql, false, nil)
+	if err := h.execute(routine, pp, &Request{cmd: COM_QUERY, data: []byte(sql)}, false); err != nil {
+		return err
+	}
+	pp.ignoreTillSync = false
+	return pp.sendReadyForQuery()
+}
+
+// pgReadString reads the null terminated string.
+func pgReadString(ctx context.Context, data []byte, pos int) (string, int, error) {
+	end := bytes.IndexByte(data[pos:], 0)
+	if end < 0 {
+		return "", 0, moerr.NewInvalidInput(ctx, "invalid postgresql message")
+	}
+	return string(data[pos : pos+end]), pos + end + 1, nil
+}
+
+func pgReadInt16(ctx context.Context, data []byte, pos int) (int16, int, error) {
+	if pos+2 > len(data) {
+		return 0, 0, moerr.NewInvalidInput(ctx, "invalid postgresql message")
+	}
+	return int16(binary.BigEndian.Uint16(data[pos:])), pos + 2, nil
+}
+
+func pgReadInt32(ctx context.Context, data []byte, pos int) (int32, int, error) {
+	if pos+4 > len(data) {
+		return 0, 0, moerr.NewInvalidInput(ctx, "invalid postgresql message")
+	}
+	return int32(binary.BigEndian.Uint32(data[pos:])), pos + 4, nil
+}
+
+// handleParse prepares the statement. The query that can not be prepared and
+// has no parameters, like BEGIN, is executed as a simple query later.
+func (h *pgHandler) handleParse(ctx context.Context, routine *Routine, pp *PostgresProtocolImpl, payload []byte) error {
+	name, pos, err := pgReadString(ctx, payload, 0)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	query, pos, err := pgReadString(ctx, payload, pos)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	n, pos, err := pgReadInt16(ctx, payload, pos)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	rewritten, paramIndexes, paramCount := pgRewritePlaceholders(query)
+	stmt := &pgStatement{
+		query:        rewritten,
+		paramIndexes: paramIndexes,
+		paramOIDs:    make([]uint32, Max(int(n), paramCount)),
+	}
+	for i := 0; i < int(n); i++ {
+		var oid int32
+		if oid, pos, err = pgReadInt32(ctx, payload, pos); err != nil {
+			return pp.sendError("ERROR", err)
+		}
+		stmt.paramOIDs[i] = uint32(oid)
+	}
+
+	if old, ok := pp.statements[name]; ok {
+		if name != "" {
+			return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "prepared statement %s already exists", name))
+		}
+		h.closeStatement(routine, pp, old)
+		delete(pp.statements, name)
+	}
+
+	if strings.TrimSpace(stmt.query) != "" {
+		pp.beginRequest("", false, nil)
+		err = h.execute(routine, pp, &Request{cmd: COM_STMT_PREPARE, data: []byte(stmt.query)}, true)
+		if err == nil && pp.prepared != nil {
+			stmt.prepared = true
+			stmt.stmtID = pp.prepared.stmtID
+			stmt.fields = pp.prepared.fields
+			for i, idx := range stmt.paramIndexes {
+				if idx >= 0 && idx < len(stmt.paramOIDs) && stmt.paramOIDs[idx] == 0 && i < len(pp.prepared.paramTypes) {
+					stmt.paramOIDs[idx] = pp.prepared.paramTypes[i]
+				}
+			}
+		} else if len(stmt.paramIndexes) > 0 {
+			if err == nil {
+				err = moerr.NewInternalError(ctx, "can not prepare the statement")
+			}
+			return pp.sendError("ERROR", err)
+		}
+	}
+	for i, oid := range stmt.paramOIDs {
+		if oid == 0 {
+			stmt.paramOIDs[i] = pgTypeText
+		}
+	}
+	pp.statements[name] = stmt
+	return pp.sendMessage(pgMsgParseComplete, nil)
+}
+
+// closeStatement deallocates the prepared statement.
+func (h *pgHandler) closeStatement(routine *Routine, pp *PostgresProtocolImpl, stmt *pgStatement) {
+	if !stmt.prepared {
+		return
+	}
+	data := make([]byte, 4)
+	binary.LittleEndian.PutUint32(data, stmt.stmtID)
+	pp.beginRequest("", false, nil)
+	if err := h.execute(routine, pp, &Request{cmd: COM_STMT_CLOSE, data: data}, true); err != nil {
+		logErrorf(pp.GetDebugString(), "deallocate the prepared statement failed. error:%v", err)
+	}
+	stmt.prepared = false
+}
+
+func (h *pgHandler) handleBind(ctx context.Context, pp *PostgresProtocolImpl, payload []byte) error {
+	portalName, pos, err := pgReadString(ctx, payload, 0)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	stmtName, pos, err := pgReadString(ctx, payload, pos)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	stmt, ok := pp.statements[stmtName]
+	if !ok {
+		return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "prepared statement %s does not exist", stmtName))
+	}
+
+	readFormats := func() ([]int16, error) {
+		var n int16
+		if n, pos, err = pgReadInt16(ctx, payload, pos); err != nil {
+			return nil, err
+		}
+		formats := make([]int16, n)
+		for i := range formats {
+			if formats[i], pos, err = pgReadInt16(ctx, payload, pos); err != nil {
+				return nil, err
+			}
+		}
+		return formats, nil
+	}
+	paramFormats, err := readFormats()
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	n, pos, err := pgReadInt16(ctx, payload, pos)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	if int(n) != len(stmt.paramOIDs) {
+		return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "bind message supplies %d parameters, but prepared statement requires %d", n, len(stmt.paramOIDs)))
+	}
+	params := make([]any, n)
+	for i := range params {
+		var length int32
+		if length, pos, err = pgReadInt32(ctx, payload, pos); err != nil {
+			return pp.sendError("ERROR", err)
+		}
+		if length < 0 {
+			continue
+		}
+		if pos+int(length) > len(payload) {
+			return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "invalid postgresql message"))
+		}
+		// the value is copied out of the payload
+		data := append([]byte{}, payload[pos:pos+int(length)]...)
+		pos += int(length)
+		if params[i], err = pgDecodeParam(ctx, stmt.paramOIDs[i], pgFormatOf(paramFormats, i), data); err != nil {
+			return pp.sendError("ERROR", err)
+		}
+	}
+	resultFormats, err := readFormats()
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+
+	pp.portals[portalName] = &pgPortal{
+		stmt:          stmt,
+		params:        params,
+		resultFormats: resultFormats,
+	}
+	return pp.sendMessage(pgMsgBindComplete, nil)
+}
+
+func (h *pgHandler) handleDescribe(ctx context.Context, pp *PostgresProtocolImpl, payload []byte) error {
+	if len(payload) < 1 {
+		return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "invalid postgresql message"))
+	}
+	name, _, err := pgReadString(ctx, payload, 1)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	b := &pgBuffer{}
+	switch payload[0] {
+	case 'S':
+		stmt, ok := pp.statements[name]
+		if !ok {
+			return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "prepared statement %s does not exist", name))
+		}
+		pp.appendParameterDescription(b, stmt.paramOIDs)
+		pp.appendRowDescription(b, stmt.fields, nil)
+	case 'P':
+		portal, ok := pp.portals[name]
+		if !ok {
+			return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "portal %s does not exist", name))
+		}
+		pp.appendRowDescription(b, portal.stmt.fields, portal.resultFormats)
+	default:
+		return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "invalid describe type %q", payload[0]))
+	}
+	return pp.write(b)
+}
+
+// handleExecute executes the portal. The limit of the rows is not supported,
+// all the rows are returned.
+func (h *pgHandler) handleExecute(ctx context.Context, routine *Routine, pp *PostgresProtocolImpl, payload []byte) error {
+	name, _, err := pgReadString(ctx, payload, 0)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	portal, ok := pp.portals[name]
+	if !ok {
+		return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "portal %s does not exist", name))
+	}
+	stmt := portal.stmt
+	if strings.TrimSpace(stmt.query) == "" {
+		return pp.sendMessage(pgMsgEmptyQueryResponse, nil)
+	}
+
+	pp.beginRequest(stmt.query, true, portal.resultFormats)
+	if !stmt.prepared {
+		return h.execute(routine, pp, &Request{cmd: COM_QUERY, data: []byte(stmt.query)}, false)
+	}
+	pp.bindParams = make([]any, len(stmt.paramIndexes))
+	for i, idx := range stmt.paramIndexes {
+		if idx >= 0 && idx < len(portal.params) {
+			pp.bindParams[i] = portal.params[idx]
+		}
+	}
+	data := make([]byte, 4)
+	binary.LittleEndian.PutUint32(data, stmt.stmtID)
+	return h.execute(routine, pp, &Request{cmd: COM_STMT_EXECUTE, data: data}, false)
+}
+
+func (h *pgHandler) handleClose(ctx context.Context, routine *Routine, pp *PostgresProtocolImpl, payload []byte) error {
+	if len(payload) < 1 {
+		return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "invalid postgresql message"))
+	}
+	name, _, err := pgReadString(ctx, payload, 1)
+	if err != nil {
+		return pp.sendError("ERROR", err)
+	}
+	switch payload[0] {
+	case 'S':
+		if stmt, ok := pp.statements[name]; ok {
+			h.closeStatement(routine, pp, stmt)
+			delete(pp.statements, name)
+			for portalName, portal := range pp.portals {
+				if portal.stmt == stmt {
+					delete(pp.portals, portalName)
+				}
+			}
+		}
+	case 'P':
+		delete(pp.portals, name)
+	default:
+		return pp.sendError("ERROR", moerr.NewInvalidInput(ctx, "invalid close type %q", payload[0]))
+	}
+	return pp.sendMessage(pgMsgCloseComplete, nil)
+}
@@ -0,0 +1,500 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+/*
+The quotas limit the resources consumed by an account. Only the moadmin of the
+sys account can set them:
+
+	alter account acc1 with quota max_connections = 100, storage_size = 10737418240;
+
+	max_connections  the active connections of the account.
+	max_queries      the concurrent queries of the account.
+	query_memory     the bytes allocated by a query from the mpool of the session.
+	storage_size     the bytes of the tables of the account. It is checked
+	                 against mo_table_size before writing into the tables.
+
+0 means unlimited. The quotas are stored in the table mo_catalog.mo_account_quota
+of the sys account. The cn loads the quotas of the account when a user of the
+account logins, and reloads the quotas of the accounts on it every
+accountUsageReportInterval, so the quotas altered on the other cns are enforced
+after it.
+
+The connections and the queries are limited in the whole cluster. Every cn counts
+its own ones, and reports them into the table mo_catalog.mo_account_usage of the
+sys account every accountUsageReportInterval, where it also reads the ones of the
+other cns. A cn checks its own counts plus the latest ones reported by the others,
+so the cns admitting at the same time may exceed the limits until the next report.
+*/
+
+const (
+	quotaMaxConnections = "max_connections"
+	quotaMaxQueries     = "max_queries"
+	quotaQueryMemory    = "query_memory"
+	quotaStorageSize    = "storage_size"
+
+	// the storage used by the account is refreshed at most once in the interval
+	storageUsageInterval = time.Minute
+
+	// the cn reports its connections and queries of the accounts in the interval,
+	// the reports older than accountUsageExpire are of the cns gone.
+	accountUsageReportInterval = 5 * time.Second
+	accountUsageExpire         = 3 * accountUsageReportInterval
+)
+
+const (
+	getAccountQuotaFormat    = `select max_connections, max_queries, query_memory, storage_size from mo_catalog.mo_account_quota where account_id = %d;`
+	deleteAccountQuotaFormat = `delete from mo_catalog.mo_account_quota where account_id = %d;`
+	insertAccountQuotaFormat = `insert into mo_catalog.mo_account_quota(account_id, max_connections, max_queries, query_memory, storage_size, modified_time) values (%d, %d, %d, %d, %d, now());`
+	getAccountQuotasSql      = `select account_id, max_connections, max_queries, query_memory, storage_size from mo_catalog.mo_account_quota;`
+	getStorageUsageFormat    = `select coalesce(sum(mo_table_size(mt.reldatabase, mt.relname)), 0) from mo_catalog.mo_tables as mt where mt.account_id = %d;`
+
+	deleteAccountUsageFormat      = `delete from mo_catalog.mo_account_usage where cn_id = '%s';`
+	insertAccountUsageFormat      = `insert into mo_catalog.mo_account_usage(cn_id, account_id, connections, queries, report_time) values %s;`
+	getAccountUsageOfOthersFormat = `select account_id, sum(connections) as connections, sum(queries) as queries from mo_catalog.mo_account_usage where cn_id != '%s' and report_time > %d group by account_id;`
+	accountUsageValuesFormat      = `('%s', %d, %d, %d, %d)`
+)
+
+func getSqlForAccountQuota(accountId uint32) string {
+	return fmt.Sprintf(getAccountQuotaFormat, accountId)
+}
+
+func getSqlForDeleteAccountQuota(accountId uint32) string {
+	return fmt.Sprintf(deleteAccountQuotaFormat, accountId)
+}
+
+func getSqlForInsertAccountQuota(accountId uint32, q accountQuota) string {
+	return fmt.Sprintf(insertAccountQuotaFormat, accountId, q.maxConnections, q.maxQueries, q.queryMemory, q.storageSize)
+}
+
+func getSqlForStorageUsage(accountId uint32) string {
+	return fmt.Sprintf(getStorageUsageFormat, accountId)
+}
+
+type accountQuota struct {
+	maxConnections int64
+	maxQueries     int64
+	queryMemory    int64
+	storageSize    int64
+}
+
+// apply sets the quotas in the options, the others are kept.
+func (q *accountQuota) apply(ctx context.Context, options []tree.AccountQuotaOption) error {
+	for _, opt := range options {
+		if opt.Value < 0 {
+			return moerr.NewInvalidInput(ctx, "the quota %s can not be negative", opt.Name)
+		}
+		switch opt.Name {
+		case quotaMaxConnections:
+			q.maxConnections = opt.Value
+		case quotaMaxQueries:
+			q.maxQueries = opt.Value
+		case quotaQueryMemory:
+			q.queryMemory = opt.Value
+		case quotaStorageSize:
+			q.storageSize = opt.Value
+		default:
+			return moerr.NewInvalidInput(ctx, "unsupported quota %s", opt.Name)
+		}
+	}
+	return nil
+}
+
+// accountUsage is the quotas and the resources in use of an account on the cn.
+type accountUsage struct {
+	sync.Mutex
+	quota       accountQuota
+	connections int64
+	queries     int64
+	// the connections and the queries of the other cns lastly reported
+	otherConnections int64
+	otherQueries     int64
+	// storageUsed is the result of mo_table_size at storageCheckedAt
+	storageUsed      int64
+	storageCheckedAt time.Time
+}
+
+type accountQuotas struct {
+	sync.Mutex
+	accounts map[uint32]*accountUsage
+}
+
+var globalAccountQuotas = &accountQuotas{
+	accounts: make(map[uint32]*accountUsage),
+}
+
+func (aq *accountQuotas) get(accountId uint32) *accountUsage {
+	aq.Lock()
+	defer aq.Unlock()
+	au, ok := aq.accounts[accountId]
+	if !ok {
+		au = &accountUsage{}
+		aq.accounts[accountId] = au
+	}
+	return au
+}
+
+func (aq *accountQuotas) setQuota(accountId uint32, q accountQuota) {
+	au := aq.get(accountId)
+	au.Lock()
+	defer au.Unlock()
+	au.quota = q
+	au.storageCheckedAt = time.Time{}
+}
+
+// quotaTenantOf returns the tenant limited by the quotas, or nil for the
+// sys account, the background and the internal sessions.
+func quotaTenantOf(ses *Session) *TenantInfo {
+	if ses == nil || ses.IsBackgroundSession() || ses.isInternal {
+		return nil
+	}
+	tenant := ses.GetTenantInfo()
+	if tenant == nil || tenant.IsSysTenant() {
+		return nil
+	}
+	return tenant
+}
+
+// readAccountQuota reads the quotas of the account in the transaction of the bh.
+func readAccountQuota(ctx context.Context, bh BackgroundExec, accountId uint32) (accountQuota, error) {
+	var q accountQuota
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForAccountQuota(accountId))
+	if err != nil {
+		return q, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return q, err
+	}
+	if execResultArrayHasData(erArray) {
+		return scanAccountQuota(ctx, erArray[0])
+	}
+	return q, nil
+}
+
+func scanAccountQuota(ctx context.Context, er ExecResult) (accountQuota, error) {
+	var q accountQuota
+	var err error
+	for i, v := range []*int64{&q.maxConnections, &q.maxQueries, &q.queryMemory, &q.storageSize} {
+		*v, err = er.GetInt64(ctx, 0, uint64(i))
+		if err != nil {
+			return q, err
+		}
+	}
+	return q, nil
+}
+
+// loadAccountQuota refreshes the quotas of the account on the cn when a user
+// of the account logins. The ctx is in the sys account.
+func loadAccountQuota(ctx context.Context, ses *Session, accountId uint32) error {
+	if accountId == sysAccountID {
+		return nil
+	}
+	rsset, err := executeSQLInBackgroundSession(ctx, ses, ses.GetMemPool(), ses.GetParameterUnit(), getSqlForAccountQuota(accountId))
+	if err != nil {
+		// the cluster bootstrapped before the quotas has no such table
+		if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			logutil.Warnf("load the quota of the account %d failed. error:%v", accountId, err)
+			return nil
+		}
+		return err
+	}
+	var q accountQuota
+	if execResultArrayHasData(rsset) {
+		q, err = scanAccountQuota(ctx, rsset[0])
+		if err != nil {
+			return err
+		}
+	}
+	globalAccountQuotas.setQuota(accountId, q)
+	return nil
+}
+
+// acquireConnectionQuota counts the connection of the authenticated user
+// into the account. It fails when the account already has max_connections.
+func acquireConnectionQuota(ctx context.Context, ses *Session) error {
+	tenant := quotaTenantOf(ses)
+	if tenant == nil {
+		return nil
+	}
+	au := globalAccountQuotas.get(tenant.GetTenantID())
+	au.Lock()
+	defer au.Unlock()
+	if au.quota.maxConnections > 0 && au.connections+au.otherConnections >= au.quota.maxConnections {
+		return moerr.NewTooManyConnections(ctx, tenant.GetTenant(), au.quota.maxConnections)
+	}
+	au.connections++
+	ses.connectionQuotaCounted.Store(true)
+	return nil
+}
+
+// releaseConnectionQuota is called when the connection is closed.
+func releaseConnectionQuota(ses *Session) {
+	if !ses.connectionQuotaCounted.CompareAndSwap(true, false) {
+		return
+	}
+	au := globalAccountQuotas.get(ses.GetTenantInfo().GetTenantID())
+	au.Lock()
+	defer au.Unlock()
+	au.connections--
+}
+
+// acquireQueryQuota counts the query into the account and sets the memory
+// budget of the query on the mpool of the session. The returned function
+// must be called after the query.
+func acquireQueryQuota(ctx context.Context, ses *Session) (func(), error) {
+	tenant := quotaTenantOf(ses)
+	if tenant == nil {
+		return func() {}, nil
+	}
+	au := globalAccountQuotas.get(tenant.GetTenantID())
+	au.Lock()
+	if au.quota.maxQueries > 0 && au.queries+au.otherQueries >= au.quota.maxQueries {
+		au.Unlock()
+		return nil, moerr.NewQuotaExceeded(ctx, tenant.GetTenant(), quotaMaxQueries, au.quota.maxQueries)
+	}
+	au.queries++
+	queryMemory := au.quota.queryMemory
+	au.Unlock()
+
+	mp := ses.GetMemPool()
+	if queryMemory > 0 {
+		account := tenant.GetTenant()
+		mp.SetBudget(queryMemory, func() error {
+			return moerr.NewQuotaExceededNoCtx(account, quotaQueryMemory, queryMemory)
+		})
+	}
+	return func() {
+		if queryMemory > 0 {
+			mp.SetBudget(0, nil)
+		}
+		au.Lock()
+		defer au.Unlock()
+		au.queries--
+	}, nil
+}
+
+// writesStorage returns whether the statement writes into the tables. The
+// EXECUTE statement writes if its prepared statement does.
+func writesStorage(ses *Session, stmt tree.Statement) bool {
+	if execute, ok := stmt.(*tree.Execute); ok {
+		prepareStmt, err := ses.GetPrepareStmt(string(execute.Name))
+		if err != nil {
+			// the EXECUTE fails by itself
+			return false
+		}
+		stmt = prepareStmt.PrepareStmt
+	}
+	switch stmt.(type) {
+	case *tree.Insert, *tree.Replace, *tree.Load, *tree.Update:
+		return true
+	}
+	return false
+}
+
+// checkStorageQuota rejects the statements writing into the tables when the
+// storage used by the account reaches the storage_size.
+func checkStorageQuota(ctx context.Context, ses *Session, stmt tree.Statement) error {
+	if !writesStorage(ses, stmt) {
+		return nil
+	}
+	tenant := quotaTenantOf(ses)
+	if tenant == nil {
+		return nil
+	}
+	au := globalAccountQuotas.get(tenant.GetTenantID())
+	au.Lock()
+	limit := au.quota.storageSize
+	used := au.storageUsed
+	stale := time.Since(au.storageCheckedAt) > storageUsageInterval
+	au.Unlock()
+	if limit == 0 {
+		return nil
+	}
+
+	if stale {
+		rsset, err := executeSQLInBackgroundSession(ctx, ses, ses.GetMemPool(), ses.GetParameterUnit(), getSqlForStorageUsage(tenant.GetTenantID()))
+		if err != nil {
+			return err
+		}
+		used = 0
+		if execResultArrayHasData(rsset) {
+			used, err = rsset[0].GetInt64(ctx, 0, 0)
+			if err != nil {
+				return err
+			}
+		}
+		au.Lock()
+		au.storageUsed = used
+		au.storageCheckedAt = time.Now()
+		au.Unlock()
+	}
+
+	if used >= limit {
+		return moerr.NewQuotaExceeded(ctx, tenant.GetTenant(), quotaStorageSize, limit)
+	}
+	return nil
+}
+
+// RunAccountUsageReporter reports the connections and the queries of the accounts
+// on the cn, reads the ones of the other cns, and reloads the quotas of the
+// accounts every accountUsageReportInterval until ctx is done.
+func RunAccountUsageReporter(ctx context.Context, pu *config.ParameterUnit, aicm *defines.AutoIncrCacheManager, cnID string) {
+	exec := NewInternalExecutor(pu, aicm)
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	sysCtx = context.WithValue(sysCtx, defines.UserIDKey{}, uint32(rootID))
+	sysCtx = context.WithValue(sysCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+	ticker := time.NewTicker(accountUsageReportInterval)
+	defer ticker.Stop()
+	for {
+		if err := reportAccountUsage(sysCtx, exec, cnID, time.Now()); err != nil {
+			logutil.Warnf("report the usage of the accounts failed. error:%v", err)
+		}
+		if err := refreshAccountQuotas(sysCtx, exec); err != nil {
+			logutil.Warnf("refresh the quotas of the accounts failed. error:%v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reportAccountUsage replaces the counts of the cn in mo_account_usage, and
+// refreshes the counts of the other cns reported after now - accountUsageExpire.
+func reportAccountUsage(ctx context.Context, exec ie.InternalExecutor, cnID string, now time.Time) error {
+	cnID = escapeString(cnID)
+	var values []string
+	globalAccountQuotas.Lock()
+	for accountId, au := range globalAccountQuotas.accounts {
+		au.Lock()
+		if au.connections > 0 || au.queries > 0 {
+			values = append(values, fmt.Sprintf(accountUsageValuesFormat, cnID, accountId, au.connections, au.queries, now.UnixMilli()))
+		}
+		au.Unlock()
+	}
+	globalAccountQuotas.Unlock()
+
+	opts := ie.NewOptsBuilder().Internal(true).Finish()
+	if err := exec.Exec(ctx, fmt.Sprintf(deleteAccountUsageFormat, cnID), opts); err != nil {
+		return err
+	}
+	if len(values) > 0 {
+		if err := exec.Exec(ctx, fmt.Sprintf(insertAccountUsageFormat, strings.Join(values, ", ")), opts); err != nil {
+			return err
+		}
+	}
+
+	result := exec.Query(ctx, fmt.Sprintf(getAccountUsageOfOthersFormat, cnID, now.Add(-accountUsageExpire).UnixMilli()), opts)
+	if err := result.Error(); err != nil {
+		return err
+	}
+	others := make(map[uint32][2]int64, result.RowCount())
+	for i := uint64(0); i < result.RowCount(); i++ {
+		var row [3]int64
+		for j, name := range []string{"account_id", "connections", "queries"} {
+			value, err := result.StringValueByName(ctx, i, name)
+			if err != nil {
+				return err
+			}
+			if row[j], err = strconv.ParseInt(value, 10, 64); err != nil {
+				return err
+			}
+		}
+		others[uint32(row[0])] = [2]int64{row[1], row[2]}
+	}
+	globalAccountQuotas.Lock()
+	defer globalAccountQuotas.Unlock()
+	for accountId := range others {
+		if _, ok := globalAccountQuotas.accounts[accountId]; !ok {
+			globalAccountQuotas.accounts[accountId] = &accountUsage{}
+		}
+	}
+	for accountId, au := range globalAccountQuotas.accounts {
+		counts := others[accountId]
+		au.Lock()
+		au.otherConnections, au.otherQueries = counts[0], counts[1]
+		au.Unlock()
+	}
+	return nil
+}
+
+// refreshAccountQuotas reloads the quotas of the accounts on the cn from
+// mo_account_quota, where ALTER ACCOUNT on any cn writes them. The account without
+// a row is unlimited.
+func refreshAccountQuotas(ctx context.Context, exec ie.InternalExecutor) error {
+	opts := ie.NewOptsBuilder().Internal(true).Finish()
+	result := exec.Query(ctx, getAccountQuotasSql, opts)
+	if err := result.Error(); err != nil {
+		// the cluster bootstrapped before the quotas has no such table
+		if moerr.IsMoErrCode(err, moerr.ErrNoSuchTable) {
+			return nil
+		}
+		return err
+	}
+	quotas := make(map[uint32]accountQuota, result.RowCount())
+	for i := uint64(0); i < result.RowCount(); i++ {
+		var row [5]int64
+		for j, name := range []string{"account_id", quotaMaxConnections, quotaMaxQueries, quotaQueryMemory, quotaStorageSize} {
+			value, err := result.StringValueByName(ctx, i, name)
+			if err != nil {
+				return err
+			}
+			if row[j], err = strconv.ParseInt(value, 10, 64); err != nil {
+				return err
+			}
+		}
+		quotas[uint32(row[0])] = accountQuota{
+			maxConnections: row[1],
+			maxQueries:     row[2],
+			queryMemory:    row[3],
+			storageSize:    row[4],
+		}
+	}
+
+	globalAccountQuotas.Lock()
+	defer globalAccountQuotas.Unlock()
+	for accountId, au := range globalAccountQuotas.accounts {
+		if accountId == sysAccountID {
+			continue
+		}
+		q := quotas[accountId]
+		au.Lock()
+		if au.quota != q {
+			au.quota = q
+			au.storageCheckedAt = time.Time{}
+		}
+		au.Unlock()
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func newSesOfQuotaAccount(t *testing.T, ctrl *gomock.Controller, accountId uint32, quota accountQuota) *Session {
+	ses := newSes(nil, ctrl)
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:   "acc1",
+		User:     "u1",
+		TenantID: accountId,
+	})
+	globalAccountQuotas.setQuota(accountId, quota)
+	t.Cleanup(func() {
+		globalAccountQuotas.Lock()
+		defer globalAccountQuotas.Unlock()
+		delete(globalAccountQuotas.accounts, accountId)
+	})
+	return ses
+}
+
+func TestAccountQuotaApply(t *testing.T) {
+	ctx := context.Background()
+	q := accountQuota{maxConnections: 10, storageSize: 100}
+	err := q.apply(ctx, []tree.AccountQuotaOption{
+		{Name: quotaMaxQueries, Value: 2},
+		{Name: quotaStorageSize, Value: 0},
+	})
+	require.NoError(t, err)
+	require.Equal(t, accountQuota{maxConnections: 10, maxQueries: 2}, q)
+
+	err = q.apply(ctx, []tree.AccountQuotaOption{{Name: "max_cpu", Value: 1}})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+	err = q.apply(ctx, []tree.AccountQuotaOption{{Name: quotaQueryMemory, Value: -1}})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
+
+func TestConnectionQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses1 := newSesOfQuotaAccount(t, ctrl, 1001, accountQuota{maxConnections: 1})
+	ses2 := newSes(nil, ctrl)
+	ses2.SetTenantInfo(ses1.GetTenantInfo())
+	ctx := ses1.GetRequestContext()
+
+	require.NoError(t, acquireConnectionQuota(ctx, ses1))
+	err := acquireConnectionQuota(ctx, ses2)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrTooManyConnections))
+	require.Equal(t, moerr.ER_TOO_MANY_USER_CONNECTIONS, err.(*moerr.Error).MySQLCode())
+
+	releaseConnectionQuota(ses1)
+	releaseConnectionQuota(ses1)
+	require.NoError(t, acquireConnectionQuota(ctx, ses2))
+	require.Equal(t, int64(1), globalAccountQuotas.get(1001).connections)
+	releaseConnectionQuota(ses2)
+
+	//the sys account is not limited
+	ses3 := newSes(nil, ctrl)
+	require.NoError(t, acquireConnectionQuota(ctx, ses3))
+	require.False(t, ses3.connectionQuotaCounted.Load())
+}
+
+func TestQueryQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSesOfQuotaAccount(t, ctrl, 1002, accountQuota{maxQueries: 1, queryMemory: 1024})
+	ctx := ses.GetRequestContext()
+
+	release, err := acquireQueryQuota(ctx, ses)
+	require.NoError(t, err)
+	_, err = acquireQueryQuota(ctx, ses)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQuotaExceeded))
+	require.Equal(t, moerr.ER_USER_LIMIT_REACHED, err.(*moerr.Error).MySQLCode())
+
+	mp := ses.GetMemPool()
+	_, err = mp.Alloc(2048)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQuotaExceeded))
+
+	release()
+	buf, err := mp.Alloc(2048)
+	require.NoError(t, err)
+	mp.Free(buf)
+
+	release, err = acquireQueryQuota(ctx, ses)
+	require.NoError(t, err)
+	release()
+}
+
+func TestStorageQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ses := newSesOfQuotaAccount(t, ctrl, 1003, accountQuota{storageSize: 4096})
+	ctx := ses.GetRequestContext()
+	bh.sql2result[getSqlForStorageUsage(1003)] = newMrsForAnalyze([]string{"size"}, [][]interface{}{{int64(1024)}})
+
+	require.NoError(t, checkStorageQuota(ctx, ses, &tree.Insert{}))
+	require.Contains(t, bh.sqls, getSqlForStorageUsage(1003))
+
+	//the usage is cached
+	bh.sql2result[getSqlForStorageUsage(1003)] = newMrsForAnalyze([]string{"size"}, [][]interface{}{{int64(8192)}})
+	require.NoError(t, checkStorageQuota(ctx, ses, &tree.Load{}))
+
+	globalAccountQuotas.get(1003).storageCheckedAt = time.Time{}
+	err := checkStorageQuota(ctx, ses, &tree.Update{})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQuotaExceeded))
+
+	//the query is not limited
+	require.NoError(t, checkStorageQuota(ctx, ses, &tree.Select{}))
+
+	//the execute of a prepared insert is limited too
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	require.NoError(t, ses.SetPrepareStmt("s1", &PrepareStmt{Name: "s1", PrepareStmt: &tree.Insert{}}))
+	require.NoError(t, ses.SetPrepareStmt("s2", &PrepareStmt{Name: "s2", PrepareStmt: &tree.Select{}}))
+	err = checkStorageQuota(ctx, ses, &tree.Execute{Name: "s1"})
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQuotaExceeded))
+	require.NoError(t, checkStorageQuota(ctx, ses, &tree.Execute{Name: "s2"}))
+}
+
+func TestReportAccountUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSesOfQuotaAccount(t, ctrl, 1005, accountQuota{maxConnections: 2})
+	ctx := ses.GetRequestContext()
+	require.NoError(t, acquireConnectionQuota(ctx, ses))
+	defer releaseConnectionQuota(ses)
+
+	now := time.Now()
+	exec := newInternalExecutorForTest()
+	exec.sql2result[fmt.Sprintf(getAccountUsageOfOthersFormat, "cn1", now.Add(-accountUsageExpire).UnixMilli())] = newMrsForAnalyze(
+		[]string{"account_id", "connections", "queries"},
+		[][]interface{}{{int64(1005), int64(1), int64(0)}})
+
+	require.NoError(t, reportAccountUsage(ctx, exec, "cn1", now))
+	require.Equal(t, []string{
+		fmt.Sprintf(deleteAccountUsageFormat, "cn1"),
+		fmt.Sprintf(insertAccountUsageFormat, fmt.Sprintf(accountUsageValuesFormat, "cn1", 1005, 1, 0, now.UnixMilli())),
+	}, exec.executed)
+	require.Equal(t, int64(1), globalAccountQuotas.get(1005).otherConnections)
+
+	//the connection on the other cn is counted
+	ses2 := newSes(nil, ctrl)
+	ses2.SetTenantInfo(ses.GetTenantInfo())
+	err := acquireConnectionQuota(ctx, ses2)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrTooManyConnections))
+}
+
+func TestRefreshAccountQuotas(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSesOfQuotaAccount(t, ctrl, 1006, accountQuota{maxQueries: 1})
+	newSesOfQuotaAccount(t, ctrl, 1007, accountQuota{maxQueries: 1})
+	ctx := ses.GetRequestContext()
+
+	//the quotas altered on the other cn
+	exec := newInternalExecutorForTest()
+	exec.sql2result[getAccountQuotasSql] = newMrsForAnalyze(
+		[]string{"account_id", quotaMaxConnections, quotaMaxQueries, quotaQueryMemory, quotaStorageSize},
+		[][]interface{}{{int64(1006), int64(0), int64(2), int64(1024), int64(0)}})
+	require.NoError(t, refreshAccountQuotas(ctx, exec))
+	require.Equal(t, accountQuota{maxQueries: 2, queryMemory: 1024}, globalAccountQuotas.get(1006).quota)
+	//the quotas deleted on the other cn
+	require.Equal(t, accountQuota{}, globalAccountQuotas.get(1007).quota)
+
+	release, err := acquireQueryQuota(ctx, ses)
+	require.NoError(t, err)
+	defer release()
+	release2, err := acquireQueryQuota(ctx, ses)
+	require.NoError(t, err)
+	defer release2()
+}
+
+func TestDoAlterAccountQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	ctx := ses.GetRequestContext()
+	t.Cleanup(func() {
+		globalAccountQuotas.Lock()
+		defer globalAccountQuotas.Unlock()
+		delete(globalAccountQuotas.accounts, 1004)
+	})
+
+	sql, _ := getSqlForCheckTenant(ctx, "acc1")
+	bh.sql2result[sql] = newMrsForAnalyze(
+		[]string{"account_id", "account_name", "status", "version", "suspended_time"},
+		[][]interface{}{{uint64(1004), "acc1", "open", uint64(0), nil}})
+	bh.sql2result[getSqlForAccountQuota(1004)] = newMrsForAnalyze(
+		[]string{"max_connections", "max_queries", "query_memory", "storage_size"},
+		[][]interface{}{{int64(10), int64(0), int64(0), int64(100)}})
+
+	err := doAlterAccount(ctx, ses, &tree.AlterAccount{
+		Name: "acc1",
+		Quota: tree.AccountQuota{
+			Exist:   true,
+			Options: []tree.AccountQuotaOption{{Name: quotaMaxQueries, Value: 5}},
+		},
+	})
+	require.NoError(t, err)
+	quota := accountQuota{maxConnections: 10, maxQueries: 5, storageSize: 100}
+	require.Contains(t, bh.sqls, getSqlForInsertAccountQuota(1004, quota))
+	require.Equal(t, quota, globalAccountQuotas.get(1004).quota)
+
+	//at most one option at a time
+	err = doAlterAccount(ctx, ses, &tree.AlterAccount{
+		Name:    "acc1",
+		Comment: tree.AccountComment{Exist: true, Comment: "c"},
+		Quota:   tree.AccountQuota{Exist: true},
+	})
+	require.Error(t, err)
+
+	err = doAlterAccount(ctx, ses, &tree.AlterAccount{
+		Name:  sysAccountName,
+		Quota: tree.AccountQuota{Exist: true},
+	})
+	require.Error(t, err)
+}
@@ -233,6 +233,15 @@ func (ase *AnalyzeStmtExecutor) ExecuteImpl(ctx context.Context, ses *Session) e
 	return nil
 }
 
+type BackupExecutor struct {
+	*resultSetStmtExecutor
+	b *tree.Backup
+}
+
+func (be *BackupExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doBackup(ctx, ses, be.b)
+}
+
 type ExplainAnalyzeExecutor struct {
 	*resultSetStmtExecutor
 	ea *tree.ExplainAnalyze
@@ -166,7 +166,10 @@ func (rt *Routine) handleRequest(req *Request) error {
 	tenantCtx = context.WithValue(tenantCtx, defines.UserIDKey{}, tenant.GetUserID())
 	tenantCtx = context.WithValue(tenantCtx, defines.RoleIDKey{}, tenant.GetDefaultRoleID())
 	tenantCtx = trace.ContextWithSpanContext(tenantCtx, trace.SpanContextWithID(trace.TraceID(ses.uuid), trace.SpanKindSession))
-	ses.SetRequestContext(tenantCtx)
+	// the statement of the open cursor keeps its own request context
+	if ses.getCursor() == nil {
+		ses.SetRequestContext(tenantCtx)
+	}
 	executor.SetSession(rt.getSession())
 
 	rt.increaseCount(func() {
@@ -176,6 +176,7 @@ func (rm *RoutineManager) Closed(rs goetty.IOSession) {
 				}
 				metric.ConnectionCounter(accountName).Dec()
 			})
+			releaseConnectionQuota(ses)
 			logDebugf(ses.GetDebugString(), "the io session was closed.")
 		}
 		rt.cleanup()
@@ -311,8 +312,10 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 			} else {
 				// client don't ask server to upgrade TLS
 				if err := protocol.Authenticate(ctx); err != nil {
+					auditConnect(ctx, ses, protocol.GetUserName(), err)
 					return err
 				}
+				auditConnect(ctx, ses, protocol.GetUserName(), nil)
 				protocol.SetTlsEstablished()
 				protocol.SetEstablished()
 			}
@@ -324,8 +327,10 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 				return err
 			}
 			if err = protocol.Authenticate(ctx); err != nil {
+				auditConnect(ctx, ses, protocol.GetUserName(), err)
 				return err
 			}
+			auditConnect(ctx, ses, protocol.GetUserName(), nil)
 			protocol.SetEstablished()
 		}
 
@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync/atomic"
@@ -38,6 +39,10 @@ type MOServer struct {
 	uaddr string
 	app   goetty.NetApplication
 	rm    *RoutineManager
+	// pgAddr and pgApp serve the postgresql wire protocol.
+	// pgApp is nil if the pgPort is not configured.
+	pgAddr string
+	pgApp  goetty.NetApplication
 }
 
 func (mo *MOServer) GetRoutineManager() *RoutineManager {
@@ -52,16 +57,30 @@ func (mo *MOServer) Start() error {
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("Server Listening on : %s ", mo.addr)
+	if mo.pgApp != nil {
+		logutil.Infof("Server Listening on : %s for postgresql", mo.pgAddr)
+	}
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
 	logutil.Infof("++++++++++++++++++++++++++++++++++++++++++++++++")
-	return mo.app.Start()
+	if err := mo.app.Start(); err != nil {
+		return err
+	}
+	if mo.pgApp != nil {
+		return mo.pgApp.Start()
+	}
+	return nil
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pgApp != nil {
+		if err := mo.pgApp.Stop(); err != nil {
+			logutil.Errorf("stop the postgresql server failed. error:%v", err)
+		}
+	}
 	return mo.app.Stop()
 }
 
@@ -94,12 +113,33 @@ func NewMOServer(ctx context.Context, addr string, pu *config.ParameterUnit, aic
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
 	}
+	var pgAddr string
+	var pgApp goetty.NetApplication
+	if pu.SV.PgPort > 0 {
+		pgAddr = fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.PgPort)
+		h := newPgHandler(rm)
+		pgApp, err = goetty.NewApplication(
+			pgAddr,
+			h.Handler,
+			goetty.WithAppLogger(logutil.GetGlobalLogger()),
+			goetty.WithAppSessionOptions(
+				goetty.WithSessionCodec(NewPgCodec()),
+				goetty.WithSessionLogger(logutil.GetGlobalLogger()),
+				goetty.WithSessionDisableCompactAfterGrow(),
+				goetty.WithSessionRWBUfferSize(1024*1024, 1024*1024)),
+			goetty.WithAppSessionAware(h))
+		if err != nil {
+			logutil.Panicf("start postgresql server failed with %+v", err)
+		}
+	}
 	initVarByConfig(pu)
 	return &MOServer{
-		addr:  addr,
-		app:   app,
-		uaddr: pu.SV.UnixSocketAddress,
-		rm:    rm,
+		addr:   addr,
+		app:    app,
+		uaddr:  pu.SV.UnixSocketAddress,
+		rm:     rm,
+		pgAddr: pgAddr,
+		pgApp:  pgApp,
 	}
 }
 
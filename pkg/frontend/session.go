@@ -38,6 +38,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
@@ -72,6 +74,9 @@ type Session struct {
 	//cmd from the client
 	cmd CommandType
 
+	//the sql dialect of the client
+	dialect dialect.DialectType
+
 	//for test
 	mrs *MysqlResultSet
 
@@ -104,6 +109,9 @@ type Session struct {
 	prepareStmts map[string]*PrepareStmt
 	lastStmtId   uint32
 
+	// cursor is the cursor opened by COM_STMT_EXECUTE
+	cursor *stmtCursor
+
 	requestCtx context.Context
 	connectCtx context.Context
 
@@ -168,6 +176,9 @@ type Session struct {
 
 	sentRows atomic.Int64
 
+	// the connection has been counted into the quota of the account
+	connectionQuotaCounted atomic.Bool
+
 	createdTime time.Time
 
 	expiredTime time.Time
@@ -191,6 +202,10 @@ type Session struct {
 	// least the commit of the last transaction log of the previous transaction arrives.
 	lastCommitTS timestamp.Timestamp
 	upstream     *Session
+
+	// txnSnapshotTS is set by SET TRANSACTION SNAPSHOT, the transactions of the session
+	// are read-only at it. It is nil if the session reads the latest data.
+	txnSnapshotTS *timestamp.Timestamp
 }
 
 func (ses *Session) SetSeqLastValue(proc *process.Process) {
@@ -281,6 +296,7 @@ func NewSession(proto Protocol, mp *mpool.MPool, pu *config.ParameterUnit, gSysV
 	txnHandler := InitTxnHandler(pu.StorageEngine, pu.TxnClient)
 	ses := &Session{
 		protocol: proto,
+		dialect:  dialect.MYSQL,
 		mp:       mp,
 		pu:       pu,
 		ep: &ExportParam{
@@ -349,6 +365,7 @@ func NewSession(proto Protocol, mp *mpool.MPool, pu *config.ParameterUnit, gSysV
 }
 
 func (ses *Session) Close() {
+	ses.closeCursor()
 	if ses.flag {
 		mp := ses.GetMemPool()
 		mpool.DeleteMPool(mp)
@@ -750,6 +767,30 @@ func (ses *Session) GetCmd() CommandType {
 	return ses.cmd
 }
 
+func (ses *Session) SetDialect(d dialect.DialectType) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.dialect = d
+}
+
+func (ses *Session) GetDialect() dialect.DialectType {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.dialect
+}
+
+// parseSql parses the sql with the dialect of the session.
+// The postgresql grammar only covers a few statements, the sql it can not
+// parse is parsed by the mysql grammar again.
+func (ses *Session) parseSql(ctx context.Context, sql string, lower int64) ([]tree.Statement, error) {
+	if ses.GetDialect() == dialect.POSTGRESQL {
+		if stmts, err := parsers.Parse(ctx, dialect.POSTGRESQL, sql, lower); err == nil {
+			return stmts, nil
+		}
+	}
+	return parsers.Parse(ctx, dialect.MYSQL, sql, lower)
+}
+
 func (ses *Session) SetMysqlResultSet(mrs *MysqlResultSet) {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -890,6 +931,50 @@ func (ses *Session) RemovePrepareStmt(name string) {
 	delete(ses.prepareStmts, name)
 }
 
+func (ses *Session) setCursor(cursor *stmtCursor) {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.cursor = cursor
+}
+
+// getCursor returns the cursor of the session, whether it is open or not
+func (ses *Session) getCursor() *stmtCursor {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.cursor
+}
+
+// getPendingCursor returns the cursor whose column definitions have not been sent
+func (ses *Session) getPendingCursor() *stmtCursor {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.cursor != nil && !ses.cursor.isOpen() {
+		return ses.cursor
+	}
+	return nil
+}
+
+// getOpenCursor returns the cursor that is waiting for COM_STMT_FETCH
+func (ses *Session) getOpenCursor() *stmtCursor {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	if ses.cursor != nil && ses.cursor.isOpen() {
+		return ses.cursor
+	}
+	return nil
+}
+
+// closeCursor closes the cursor and waits for the end of its statement
+func (ses *Session) closeCursor() {
+	ses.mu.Lock()
+	cursor := ses.cursor
+	ses.cursor = nil
+	ses.mu.Unlock()
+	if cursor != nil {
+		cursor.close()
+	}
+}
+
 func (ses *Session) SetSysVar(name string, value interface{}) {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
@@ -1107,8 +1192,8 @@ func (ses *Session) skipAuthForSpecialUser() bool {
 	return false
 }
 
-// AuthenticateUser verifies the password of the user.
-func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
+// AuthenticateUser verifies the user and returns the password stored in the mo_user.
+func (ses *Session) AuthenticateUser(userInput string) (string, error) {
 	var defaultRoleID int64
 	var defaultRole string
 	var tenant *TenantInfo
@@ -1124,7 +1209,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	//Get tenant info
 	tenant, err = GetTenantInfo(ses.GetRequestContext(), userInput)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	ses.SetTenantInfo(tenant)
@@ -1136,7 +1221,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	isSpecial, pwdBytes, specialAccount = isSpecialUser(tenant.GetUser())
 	if isSpecial && specialAccount.IsMoAdminRole() {
 		ses.SetTenantInfo(specialAccount)
-		return GetPassWord(HashPassWordWithByte(pwdBytes))
+		return HashPassWordWithByte(pwdBytes), nil
 	}
 
 	ses.SetTenantInfo(tenant)
@@ -1147,7 +1232,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	sysTenantCtx = context.WithValue(sysTenantCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
 	sqlForCheckTenant, err := getSqlForCheckTenant(sysTenantCtx, tenant.GetTenant())
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	pu := ses.GetParameterUnit()
 	mp := ses.GetMemPool()
@@ -1159,26 +1244,32 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		pu,
 		sqlForCheckTenant)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	if !execResultArrayHasData(rsset) {
-		return nil, moerr.NewInternalError(sysTenantCtx, "there is no tenant %s", tenant.GetTenant())
+		return "", moerr.NewInternalError(sysTenantCtx, "there is no tenant %s", tenant.GetTenant())
 	}
 
 	//account id
 	tenantID, err = rsset[0].GetInt64(sysTenantCtx, 0, 0)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	//account status
 	accountStatus, err = rsset[0].GetString(sysTenantCtx, 0, 2)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	if strings.ToLower(accountStatus) == tree.AccountStatusSuspend.String() {
-		return nil, moerr.NewInternalError(sysTenantCtx, "Account %s is suspended", tenant.GetTenant())
+		return "", moerr.NewInternalError(sysTenantCtx, "Account %s is suspended", tenant.GetTenant())
+	}
+
+	//step1.1 : load the quotas of the account
+	err = loadAccountQuota(sysTenantCtx, ses, uint32(tenantID))
+	if err != nil {
+		return "", err
 	}
 
 	tenant.SetTenantID(uint32(tenantID))
@@ -1191,7 +1282,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	//Get the password of the user in an independent session
 	sqlForPasswordOfUser, err := getSqlForPasswordOfUser(tenantCtx, tenant.GetUser())
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	rsset, err = executeSQLInBackgroundSession(
 		tenantCtx,
@@ -1200,27 +1291,27 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		pu,
 		sqlForPasswordOfUser)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	if !execResultArrayHasData(rsset) {
-		return nil, moerr.NewInternalError(tenantCtx, "there is no user %s", tenant.GetUser())
+		return "", moerr.NewInternalError(tenantCtx, "there is no user %s", tenant.GetUser())
 	}
 
 	userID, err = rsset[0].GetInt64(tenantCtx, 0, 0)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	pwd, err = rsset[0].GetString(tenantCtx, 0, 1)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	//the default_role in the mo_user table.
 	//the default_role is always valid. public or other valid role.
 	defaultRoleID, err = rsset[0].GetInt64(tenantCtx, 0, 2)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	tenant.SetUserID(uint32(userID))
@@ -1242,7 +1333,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		//step4 : check role exists or not
 		sqlForCheckRoleExists, err := getSqlForRoleIdOfRole(tenantCtx, tenant.GetDefaultRole())
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		rsset, err = executeSQLInBackgroundSession(
 			tenantCtx,
@@ -1251,18 +1342,18 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sqlForCheckRoleExists)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "there is no role %s", tenant.GetDefaultRole())
+			return "", moerr.NewInternalError(tenantCtx, "there is no role %s", tenant.GetDefaultRole())
 		}
 
 		logDebugf(sessionInfo, "check granted role of user %s.", tenant)
 		//step4.2 : check the role has been granted to the user or not
 		sqlForRoleOfUser, err := getSqlForRoleOfUser(tenantCtx, userID, tenant.GetDefaultRole())
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		rsset, err = executeSQLInBackgroundSession(
 			tenantCtx,
@@ -1271,16 +1362,16 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sqlForRoleOfUser)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "the role %s has not been granted to the user %s",
+			return "", moerr.NewInternalError(tenantCtx, "the role %s has not been granted to the user %s",
 				tenant.GetDefaultRole(), tenant.GetUser())
 		}
 
 		defaultRoleID, err = rsset[0].GetInt64(tenantCtx, 0, 0)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		tenant.SetDefaultRoleID(uint32(defaultRoleID))
 	} else {
@@ -1294,22 +1385,22 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sql)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "get the default role of the user %s failed", tenant.GetUser())
+			return "", moerr.NewInternalError(tenantCtx, "get the default role of the user %s failed", tenant.GetUser())
 		}
 
 		defaultRole, err = rsset[0].GetString(tenantCtx, 0, 0)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		tenant.SetDefaultRole(defaultRole)
 	}
 
 	logInfo(sessionInfo, tenant.String())
 
-	return GetPassWord(pwd)
+	return pwd, nil
 }
 
 func (ses *Session) GetPrivilege() *privilege {
@@ -1598,6 +1689,15 @@ handleFailed:
 	return nil, err
 }
 
+// HasBackupPrivilege returns whether the user of the session can back up, for the
+// backup through mo_ctl.
+func (sh *SqlHelper) HasBackupPrivilege() (bool, error) {
+	if sh == nil {
+		return false, nil
+	}
+	return authenticateUserCanBackup(sh.ses.GetRequestContext(), sh.ses)
+}
+
 func (ses *Session) updateLastCommitTS(lastCommitTS timestamp.Timestamp) {
 	if lastCommitTS.Greater(ses.lastCommitTS) {
 		ses.lastCommitTS = lastCommitTS
@@ -1607,6 +1707,14 @@ func (ses *Session) updateLastCommitTS(lastCommitTS timestamp.Timestamp) {
 	}
 }
 
+func (ses *Session) setTxnSnapshotTS(ts *timestamp.Timestamp) {
+	ses.txnSnapshotTS = ts
+}
+
+func (ses *Session) getTxnSnapshotTS() *timestamp.Timestamp {
+	return ses.txnSnapshotTS
+}
+
 func (ses *Session) getLastCommitTS() timestamp.Timestamp {
 	minTS := ses.lastCommitTS
 	if ses.upstream != nil {
@@ -0,0 +1,369 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+/*
+The passwords of caching_sha2_password and sha256_password are stored in the
+format of mysql 8.0:
+
+	$A$005$<salt of 20 bytes><digest of 43 bytes>
+
+005 is the count of the rounds in thousands. The digest is the SHA-256 crypt
+of the password and the salt. See https://www.akkadia.org/drepper/SHA-crypt.txt.
+*/
+const (
+	sha2PasswordPrefix     = "$A$"
+	sha2PasswordSaltLen    = 20
+	sha2PasswordDigestLen  = 43
+	sha2PasswordRounds     = 5000
+	sha2PasswordRoundsUnit = 1000
+)
+
+// the characters in the salt and the digest
+const shaCryptItoa64 = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// the status of caching_sha2_password in the AuthMoreData
+const (
+	cachingSha2RequestPublicKey = 0x02
+	cachingSha2FastAuthSuccess  = 0x03
+	cachingSha2FullAuth         = 0x04
+)
+
+// sha256PasswordRequestPublicKey is sent by the client of sha256_password to
+// request the public key
+const sha256PasswordRequestPublicKey = 0x01
+
+// HashPassWordSha256 hashes the password for caching_sha2_password
+func HashPassWordSha256(pwd string) string {
+	if len(pwd) == 0 {
+		return ""
+	}
+	salt := make([]byte, sha2PasswordSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		logutil.Errorf("generate salt failed. error:%v", err)
+	}
+	// the salt is in the string literal of the sql.
+	// it is limited in the characters of the digest.
+	for i := range salt {
+		salt[i] = shaCryptItoa64[salt[i]&0x3f]
+	}
+	return fmt.Sprintf("%s%03X$%s%s", sha2PasswordPrefix, sha2PasswordRounds/sha2PasswordRoundsUnit,
+		salt, shaCrypt256([]byte(pwd), salt, sha2PasswordRounds))
+}
+
+// hashPasswordOfPlugin hashes the password for the authentication plugin
+func hashPasswordOfPlugin(plugin, pwd string) string {
+	if strings.EqualFold(plugin, AuthNativePassword) {
+		return HashPassWord(pwd)
+	}
+	return HashPassWordSha256(pwd)
+}
+
+// getDefaultAuthPlugin returns the authentication plugin of the new passwords
+func getDefaultAuthPlugin(pu *config.ParameterUnit) string {
+	if pu == nil || pu.SV == nil || pu.SV.DefaultAuthenticationPlugin == "" {
+		return AuthNativePassword
+	}
+	return strings.ToLower(pu.SV.DefaultAuthenticationPlugin)
+}
+
+// isSha2Password checks the password is stored for caching_sha2_password
+func isSha2Password(authString string) bool {
+	return strings.HasPrefix(authString, sha2PasswordPrefix)
+}
+
+// authPluginOfPassword returns the authentication plugin of the stored password
+func authPluginOfPassword(authString string) string {
+	if isSha2Password(authString) {
+		return AuthCachingSha2Password
+	}
+	return AuthNativePassword
+}
+
+// parseSha2Password splits the stored password into the rounds, the salt and the digest
+func parseSha2Password(authString string) (int, []byte, string, bool) {
+	s := strings.TrimPrefix(authString, sha2PasswordPrefix)
+	if len(s) != 4+sha2PasswordSaltLen+sha2PasswordDigestLen || s[3] != '$' {
+		return 0, nil, "", false
+	}
+	count, err := strconv.ParseUint(s[:3], 16, 32)
+	if err != nil || count == 0 {
+		return 0, nil, "", false
+	}
+	return int(count) * sha2PasswordRoundsUnit, []byte(s[4 : 4+sha2PasswordSaltLen]), s[4+sha2PasswordSaltLen:], true
+}
+
+// checkPlaintextPassword checks the plaintext of the password against the stored password
+func checkPlaintextPassword(authString string, pwd []byte) bool {
+	if len(authString) == 0 {
+		return len(pwd) == 0
+	}
+	if !isSha2Password(authString) {
+		if len(pwd) == 0 {
+			return false
+		}
+		return strings.EqualFold(authString, HashPassWordWithByte(pwd))
+	}
+	rounds, salt, digest, ok := parseSha2Password(authString)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(shaCrypt256(pwd, salt, rounds)), []byte(digest)) == 1
+}
+
+// shaCrypt256 returns the SHA-256 crypt of the password without the prefix and the salt
+func shaCrypt256(password, salt []byte, rounds int) string {
+	b := sha256.New()
+	b.Write(password)
+	b.Write(salt)
+	b.Write(password)
+	sumB := b.Sum(nil)
+
+	a := sha256.New()
+	a.Write(password)
+	a.Write(salt)
+	for i := len(password); i > 0; i -= sha256.Size {
+		a.Write(sumB[:Min(i, sha256.Size)])
+	}
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			a.Write(sumB)
+		} else {
+			a.Write(password)
+		}
+	}
+	sumA := a.Sum(nil)
+
+	dp := sha256.New()
+	for i := 0; i < len(password); i++ {
+		dp.Write(password)
+	}
+	p := sequenceOfDigest(dp.Sum(nil), len(password))
+
+	ds := sha256.New()
+	for i := 0; i < 16+int(sumA[0]); i++ {
+		ds.Write(salt)
+	}
+	s := sequenceOfDigest(ds.Sum(nil), len(salt))
+
+	c := sumA
+	for i := 0; i < rounds; i++ {
+		h := sha256.New()
+		if i&1 != 0 {
+			h.Write(p)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(s)
+		}
+		if i%7 != 0 {
+			h.Write(p)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(p)
+		}
+		c = h.Sum(nil)
+	}
+
+	var out strings.Builder
+	encode := func(b2, b1, b0 byte, n int) {
+		w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+		for ; n > 0; n-- {
+			out.WriteByte(shaCryptItoa64[w&0x3f])
+			w >>= 6
+		}
+	}
+	for i := 0; i < 10; i++ {
+		j := i * 21
+		encode(c[j%30], c[(j+10)%30], c[(j+20)%30], 4)
+	}
+	encode(0, c[31], c[30], 3)
+	return out.String()
+}
+
+// sequenceOfDigest repeats the digest to n bytes
+func sequenceOfDigest(digest []byte, n int) []byte {
+	seq := make([]byte, 0, n)
+	for len(seq) < n {
+		seq = append(seq, digest[:Min(len(digest), n-len(seq))]...)
+	}
+	return seq
+}
+
+// checkCachingSha2Scramble checks the scramble of the fast authentication.
+// The client sends XOR(SHA256(password), SHA256(SHA256(SHA256(password)), salt)).
+// stage2 is SHA256(SHA256(password)).
+func checkCachingSha2Scramble(stage2, salt, scramble []byte) bool {
+	if len(scramble) != sha256.Size || len(stage2) != sha256.Size {
+		return false
+	}
+	h := sha256.New()
+	h.Write(stage2)
+	h.Write(salt)
+	stage1 := h.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= scramble[i]
+	}
+	got := sha256.Sum256(stage1)
+	return subtle.ConstantTimeCompare(got[:], stage2) == 1
+}
+
+// sha2PasswordCacheEntry keeps SHA256(SHA256(password)) of the user that
+// has passed the full authentication.
+type sha2PasswordCacheEntry struct {
+	authString string
+	stage2     []byte
+}
+
+// sha2PasswordCache is the cache of caching_sha2_password. The entry is
+// dropped when the stored password is changed.
+type sha2PasswordCache struct {
+	sync.Mutex
+	entries map[string]sha2PasswordCacheEntry
+}
+
+var gSha2PasswordCache = &sha2PasswordCache{
+	entries: make(map[string]sha2PasswordCacheEntry),
+}
+
+func sha2PasswordCacheKey(tenant *TenantInfo) string {
+	return strings.ToLower(tenant.GetTenant()) + ":" + tenant.GetUser()
+}
+
+func (c *sha2PasswordCache) get(tenant *TenantInfo, authString string) []byte {
+	c.Lock()
+	defer c.Unlock()
+	key := sha2PasswordCacheKey(tenant)
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if entry.authString != authString {
+		delete(c.entries, key)
+		return nil
+	}
+	return entry.stage2
+}
+
+func (c *sha2PasswordCache) put(tenant *TenantInfo, authString string, pwd []byte) {
+	stage1 := sha256.Sum256(pwd)
+	stage2 := sha256.Sum256(stage1[:])
+	c.Lock()
+	defer c.Unlock()
+	c.entries[sha2PasswordCacheKey(tenant)] = sha2PasswordCacheEntry{
+		authString: authString,
+		stage2:     stage2[:],
+	}
+}
+
+// authRSAKey is the RSA key that encrypts the password without TLS
+type authRSAKey struct {
+	once       sync.Once
+	privateKey *rsa.PrivateKey
+	publicKey  []byte
+	err        error
+}
+
+var gAuthRSAKey = &authRSAKey{}
+
+// getAuthRSAKey loads the RSA private key in the rsaPrivateKeyPath, or
+// generates a key if the path is empty.
+func getAuthRSAKey(ctx context.Context, SV *config.FrontendParameters) (*rsa.PrivateKey, []byte, error) {
+	// the proxy replays the encrypted password to the other servers in the connection
+	// migration, so they must decrypt it with the same key.
+	if SV != nil && SV.ProxyEnabled && SV.RsaPrivateKeyPath == "" {
+		return nil, nil, moerr.NewInternalError(ctx, "rsaPrivateKeyPath is required if the proxy is enabled")
+	}
+	k := gAuthRSAKey
+	k.once.Do(func() {
+		if SV != nil && SV.RsaPrivateKeyPath != "" {
+			k.privateKey, k.err = loadRSAPrivateKey(ctx, SV.RsaPrivateKeyPath)
+		} else {
+			k.privateKey, k.err = rsa.GenerateKey(rand.Reader, 2048)
+		}
+		if k.err != nil {
+			return
+		}
+		var der []byte
+		der, k.err = x509.MarshalPKIXPublicKey(&k.privateKey.PublicKey)
+		if k.err != nil {
+			return
+		}
+		k.publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	})
+	return k.privateKey, k.publicKey, k.err
+}
+
+func loadRSAPrivateKey(ctx context.Context, path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, moerr.NewInternalError(ctx, "no PEM data in %s", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "%s is not a RSA private key", path)
+	}
+	return rsaKey, nil
+}
+
+// decryptPassword decrypts the password that the client encrypts with the
+// public key. The client encrypts XOR(password + '\0', salt) with RSA-OAEP.
+func decryptPassword(key *rsa.PrivateKey, salt, data []byte) ([]byte, error) {
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(salt) > 0 {
+		for i := range plain {
+			plain[i] ^= salt[i%len(salt)]
+		}
+	}
+	return bytes.TrimRight(plain, "\x00"), nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_shaCrypt256(t *testing.T) {
+	// the results of crypt(3) of glibc
+	require.Equal(t, "5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5",
+		shaCrypt256([]byte("Hello world!"), []byte("saltstring"), 5000))
+	require.Equal(t, "3xv.VbSHBb41AL9AvLeujZkZRBAwqFMz2.opqey6IcA",
+		shaCrypt256([]byte("Hello world!"), []byte("saltstringsaltst"), 10000))
+	require.Equal(t, "tnmBRVdOlMerN1PGoR.Nz.UgcICWpmaUcxSHCg/0Mc0",
+		shaCrypt256([]byte("we have a short salt string but not a short password"), []byte("roundstoolow"), 5000))
+}
+
+func Test_HashPassWordSha256(t *testing.T) {
+	require.Equal(t, "", HashPassWordSha256(""))
+
+	stored := HashPassWordSha256("111")
+	require.Len(t, stored, 70)
+	require.True(t, isSha2Password(stored))
+	require.Equal(t, AuthCachingSha2Password, authPluginOfPassword(stored))
+	require.NotEqual(t, stored, HashPassWordSha256("111"))
+
+	rounds, salt, digest, ok := parseSha2Password(stored)
+	require.True(t, ok)
+	require.Equal(t, sha2PasswordRounds, rounds)
+	require.Len(t, salt, sha2PasswordSaltLen)
+	require.Len(t, digest, sha2PasswordDigestLen)
+	_, _, _, ok = parseSha2Password("$A$005$abc")
+	require.False(t, ok)
+
+	require.True(t, checkPlaintextPassword(stored, []byte("111")))
+	require.False(t, checkPlaintextPassword(stored, []byte("112")))
+	require.False(t, checkPlaintextPassword(stored, nil))
+
+	native := HashPassWord("111")
+	require.Equal(t, AuthNativePassword, authPluginOfPassword(native))
+	require.True(t, checkPlaintextPassword(native, []byte("111")))
+	require.False(t, checkPlaintextPassword(native, []byte("112")))
+	require.False(t, checkPlaintextPassword(native, nil))
+	require.True(t, checkPlaintextPassword("", nil))
+
+	require.Equal(t, AuthNativePassword, getDefaultAuthPlugin(nil))
+	pu := &config.ParameterUnit{SV: &config.FrontendParameters{DefaultAuthenticationPlugin: AuthCachingSha2Password}}
+	require.Equal(t, AuthCachingSha2Password, getDefaultAuthPlugin(pu))
+	require.True(t, isSha2Password(hashPasswordOfPlugin(AuthCachingSha2Password, "111")))
+	require.Equal(t, native, hashPasswordOfPlugin(AuthNativePassword, "111"))
+}
+
+// scrambleSha256 computes the scramble of caching_sha2_password like the client
+func scrambleSha256(pwd, salt []byte) []byte {
+	m1 := sha256.Sum256(pwd)
+	m2 := sha256.Sum256(m1[:])
+	h := sha256.New()
+	h.Write(m2[:])
+	h.Write(salt)
+	m3 := h.Sum(nil)
+	for i := range m3 {
+		m3[i] ^= m1[i]
+	}
+	return m3
+}
+
+// encryptPassword encrypts the password with the public key like the client
+func encryptPassword(t *testing.T, publicKey []byte, pwd, salt []byte) []byte {
+	block, _ := pem.Decode(publicKey)
+	require.NotNil(t, block)
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+	plain := append(append([]byte{}, pwd...), 0)
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	data, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, key.(*rsa.PublicKey), plain, nil)
+	require.NoError(t, err)
+	return data
+}
+
+func Test_checkCachingSha2Scramble(t *testing.T) {
+	salt := generate_salt(20)
+	stage1 := sha256.Sum256([]byte("111"))
+	stage2 := sha256.Sum256(stage1[:])
+	require.True(t, checkCachingSha2Scramble(stage2[:], salt, scrambleSha256([]byte("111"), salt)))
+	require.False(t, checkCachingSha2Scramble(stage2[:], salt, scrambleSha256([]byte("112"), salt)))
+	require.False(t, checkCachingSha2Scramble(stage2[:], salt, nil))
+}
+
+func Test_sha2PasswordCache(t *testing.T) {
+	cache := &sha2PasswordCache{entries: make(map[string]sha2PasswordCacheEntry)}
+	tenant := &TenantInfo{Tenant: "acc", User: "u1"}
+	stored := HashPassWordSha256("111")
+	require.Nil(t, cache.get(tenant, stored))
+	cache.put(tenant, stored, []byte("111"))
+	require.NotNil(t, cache.get(tenant, stored))
+	require.Nil(t, cache.get(&TenantInfo{Tenant: "acc", User: "u2"}, stored))
+	// the password has been changed
+	require.Nil(t, cache.get(tenant, HashPassWordSha256("222")))
+	require.Nil(t, cache.get(tenant, stored))
+}
+
+func Test_checkSha2Password(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	_, publicKey, err := getAuthRSAKey(ctx, sv)
+	require.NoError(t, err)
+
+	var written [][]byte
+	var toRead [][]byte
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	ioses.EXPECT().OutBuf().Return(nil).AnyTimes()
+	ioses.EXPECT().RawConn().Return(nil).AnyTimes()
+	ioses.EXPECT().Flush(gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+		data := msg.([]byte)
+		written = append(written, append([]byte{}, data[HeaderLengthOfTheProtocol:]...))
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().Read(gomock.Any()).DoAndReturn(func(goetty.ReadOptions) (interface{}, error) {
+		data := toRead[0]
+		toRead = toRead[1:]
+		return &Packet{Payload: data}, nil
+	}).AnyTimes()
+
+	mp := NewMysqlClientProtocol(0, ioses, 1024, sv)
+	salt := mp.GetSalt()
+	tenant := &TenantInfo{Tenant: "sys", User: "sha2_user"}
+	stored := HashPassWordSha256("111")
+
+	// full authentication with the public key
+	mp.authPluginName = AuthCachingSha2Password
+	toRead = [][]byte{{cachingSha2RequestPublicKey}, encryptPassword(t, publicKey, []byte("111"), salt)}
+	ok, err := mp.checkSha2Password(ctx, tenant, stored, scrambleSha256([]byte("111"), salt))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []byte{0x01, cachingSha2FullAuth}, written[0])
+	require.Equal(t, append([]byte{0x01}, publicKey...), written[1])
+	require.Empty(t, toRead)
+
+	// fast authentication
+	written = nil
+	ok, err = mp.checkSha2Password(ctx, tenant, stored, scrambleSha256([]byte("111"), salt))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, [][]byte{{0x01, cachingSha2FastAuthSuccess}}, written)
+
+	ok, err = mp.checkSha2Password(ctx, tenant, stored, scrambleSha256([]byte("112"), salt))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the full authentication is always done behind the proxy
+	written = nil
+	sv.ProxyEnabled = true
+	sv.RsaPrivateKeyPath = "rsa_private_key.pem"
+	toRead = [][]byte{{cachingSha2RequestPublicKey}, encryptPassword(t, publicKey, []byte("111"), salt)}
+	ok, err = mp.checkSha2Password(ctx, tenant, stored, scrambleSha256([]byte("111"), salt))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []byte{0x01, cachingSha2FullAuth}, written[0])
+	require.Empty(t, toRead)
+
+	sv.RsaPrivateKeyPath = ""
+	_, _, err = getAuthRSAKey(ctx, sv)
+	require.Error(t, err)
+	sv.ProxyEnabled = false
+
+	// switch from mysql_native_password to caching_sha2_password
+	written = nil
+	mp.authPluginName = AuthNativePassword
+	toRead = [][]byte{scrambleSha256([]byte("111"), salt)}
+	ok, err = mp.checkSha2Password(ctx, tenant, stored, []byte{1, 2, 3})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, byte(0xfe), written[0][0])
+	require.Equal(t, AuthCachingSha2Password, mp.authPluginName)
+
+	// sha256_password with the public key
+	written = nil
+	mp.authPluginName = AuthSha256Password
+	toRead = [][]byte{encryptPassword(t, publicKey, []byte("111"), salt)}
+	ok, err = mp.checkSha2Password(ctx, tenant, stored, []byte{sha256PasswordRequestPublicKey})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, [][]byte{append([]byte{0x01}, publicKey...)}, written)
+
+	toRead = [][]byte{encryptPassword(t, publicKey, []byte("112"), salt)}
+	ok, err = mp.checkSha2Password(ctx, tenant, stored, []byte{sha256PasswordRequestPublicKey})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// mysql_native_password
+	mp.authPluginName = AuthNativePassword
+	native, err := GetPassWord(HashPassWord("111"))
+	require.NoError(t, err)
+	ok, err = mp.checkNativePassword(ctx, HashPassWord("111"), scrambleNative(native, salt, []byte("111")))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+// scrambleNative computes the scramble of mysql_native_password like the client
+func scrambleNative(stage2, salt, pwd []byte) []byte {
+	stage1 := HashSha1(pwd)
+	h := sha1.New()
+	h.Write(salt)
+	h.Write(stage2)
+	scramble := h.Sum(nil)
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return scramble
+}
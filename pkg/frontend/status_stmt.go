@@ -362,6 +362,24 @@ func (dre *DropRoleExecutor) ExecuteImpl(ctx context.Context, ses *Session) erro
 	return doDropRole(ctx, ses, dre.dr)
 }
 
+type CreatePolicyExecutor struct {
+	*statusStmtExecutor
+	cp *tree.CreatePolicy
+}
+
+func (cpe *CreatePolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doCreatePolicy(ctx, ses, cpe.cp)
+}
+
+type DropPolicyExecutor struct {
+	*statusStmtExecutor
+	dp *tree.DropPolicy
+}
+
+func (dpe *DropPolicyExecutor) ExecuteImpl(ctx context.Context, ses *Session) error {
+	return doDropPolicy(ctx, ses, dpe.dp)
+}
+
 type GrantExecutor struct {
 	*statusStmtExecutor
 	g *tree.Grant
@@ -0,0 +1,328 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+/*
+stmtCursor is the read only cursor opened by COM_STMT_EXECUTE with the flag
+CURSOR_TYPE_READ_ONLY. See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html.
+
+The statement of the cursor runs in its own goroutine. After the column
+definitions have been sent, the pipeline is suspended in the output callback
+until COM_STMT_FETCH asks for rows. The rows are not materialized, so the
+memory of the cursor is bounded by one batch of the pipeline.
+
+The session has one cursor at most. The statement of the cursor shares the
+session with the commands received while the cursor is open, so only the
+commands that leave the state of the statement alone run along with it:
+COM_STMT_FETCH, COM_STMT_SEND_LONG_DATA, COM_PING, and COM_STMT_CLOSE and
+COM_STMT_RESET of the other statements. COM_QUIT, and COM_STMT_EXECUTE,
+COM_STMT_CLOSE and COM_STMT_RESET of the statement of the cursor close the
+cursor before they run. The other commands are refused until the cursor is
+closed.
+*/
+type stmtCursor struct {
+	stmtID uint32
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// opened is true after the column definitions have been sent
+	opened atomic.Bool
+	// openC is closed after the column definitions have been sent
+	openC chan struct{}
+	// doneC is closed after the statement finishes
+	doneC chan struct{}
+	// err is the error of the statement. It is valid after doneC is closed.
+	err error
+
+	// fetchC receives the count of the rows requested by COM_STMT_FETCH
+	fetchC chan uint32
+	// fetchedC is signaled after the requested rows have been sent
+	fetchedC chan struct{}
+
+	// mu serializes the threads of the pipeline that send rows
+	mu sync.Mutex
+	// remaining is the count of the rows to send for the current COM_STMT_FETCH
+	remaining uint32
+}
+
+func newStmtCursor(ctx context.Context, stmtID uint32) *stmtCursor {
+	c := &stmtCursor{
+		stmtID:   stmtID,
+		openC:    make(chan struct{}),
+		doneC:    make(chan struct{}),
+		fetchC:   make(chan uint32),
+		fetchedC: make(chan struct{}),
+	}
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	return c
+}
+
+func (c *stmtCursor) isOpen() bool {
+	return c.opened.Load()
+}
+
+// open sends the end of the column definitions with SERVER_STATUS_CURSOR_EXISTS.
+// The rows will be sent by COM_STMT_FETCH.
+func (c *stmtCursor) open(proto MysqlProtocol) error {
+	c.opened.Store(true)
+	err := proto.sendEOFOrOkPacket(0, SERVER_STATUS_CURSOR_EXISTS)
+	close(c.openC)
+	return err
+}
+
+// isClosed checks the cursor has been closed by the session
+func (c *stmtCursor) isClosed() bool {
+	return c.ctx.Err() != nil
+}
+
+// close cancels the statement and waits for its end
+func (c *stmtCursor) close() {
+	c.cancel()
+	<-c.doneC
+}
+
+// sendRows sends the rows of the pipeline. It blocks until COM_STMT_FETCH asks for them.
+func (c *stmtCursor) sendRows(mp *MysqlProtocolImpl, mrs *MysqlResultSet, cnt uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := uint64(0); i < cnt; i++ {
+		for c.remaining == 0 {
+			select {
+			case c.remaining = <-c.fetchC:
+			case <-c.ctx.Done():
+				return c.ctx.Err()
+			}
+		}
+
+		mp.GetLock().Lock()
+		err := mp.sendResultSetRow(mrs, i, true)
+		mp.GetLock().Unlock()
+		if err != nil {
+			return err
+		}
+
+		c.remaining--
+		if c.remaining == 0 {
+			select {
+			case c.fetchedC <- struct{}{}:
+			case <-c.ctx.Done():
+				return c.ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+// fetch asks the pipeline to send count rows at most. It returns true if
+// the statement has finished.
+func (c *stmtCursor) fetch(requestCtx context.Context, count uint32) (bool, error) {
+	if count == 0 {
+		select {
+		case <-c.doneC:
+			return true, c.err
+		default:
+			return false, nil
+		}
+	}
+
+	select {
+	case c.fetchC <- count:
+	case <-c.doneC:
+		return true, c.err
+	case <-requestCtx.Done():
+		return false, requestCtx.Err()
+	}
+
+	select {
+	case <-c.fetchedC:
+		return false, nil
+	case <-c.doneC:
+		return true, c.err
+	case <-requestCtx.Done():
+		// the pipeline may be still sending rows
+		c.close()
+		return true, requestCtx.Err()
+	}
+}
+
+// cursorContext keeps the values of the request context, but it is canceled
+// with the connection, because the statement of the cursor lives across
+// the requests.
+type cursorContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c cursorContext) Value(key any) any {
+	return c.values.Value(key)
+}
+
+// openCursor runs the statement of the COM_STMT_EXECUTE for the cursor. It returns
+// after the column definitions have been sent or the statement has finished.
+// The statement that produces no result set runs as usual.
+func (mce *MysqlCmdExecutor) openCursor(requestCtx context.Context, stmtID uint32, sql string) error {
+	ses := mce.GetSession()
+	connCtx := ses.GetConnectContext()
+	if connCtx == nil {
+		connCtx = context.Background()
+	}
+	cursor := newStmtCursor(cursorContext{Context: connCtx, values: requestCtx}, stmtID)
+	ses.setCursor(cursor)
+	// the request context of the session is kept until the cursor is closed
+	ses.SetRequestContext(cursor.ctx)
+
+	doComQuery := mce.GetDoQueryFunc()
+	go func() {
+		defer close(cursor.doneC)
+		defer func() {
+			if e := recover(); e != nil {
+				cursor.err = moerr.ConvertPanicError(cursor.ctx, e)
+			}
+		}()
+		cursor.err = doComQuery(cursor.ctx, sql)
+	}()
+
+	select {
+	case <-cursor.openC:
+		return nil
+	case <-cursor.doneC:
+		ses.closeCursor()
+		return cursor.err
+	case <-requestCtx.Done():
+		ses.closeCursor()
+		return requestCtx.Err()
+	}
+}
+
+// execRequestWithCursor runs the command received while the session has a cursor.
+// It returns false for the command that runs as usual after the cursor is closed.
+func (mce *MysqlCmdExecutor) execRequestWithCursor(requestCtx context.Context, ses *Session, cursor *stmtCursor, req *Request) (*Response, bool) {
+	var err error
+	switch req.GetCmd() {
+	case COM_STMT_FETCH:
+		data := req.GetData().([]byte)
+		err = mce.handleStmtFetch(requestCtx, data)
+		if err != nil {
+			return NewGeneralErrorResponse(COM_STMT_FETCH, err), true
+		}
+		return nil, true
+	case COM_STMT_SEND_LONG_DATA:
+		data := req.GetData().([]byte)
+		err = mce.handleStmtSendLongData(requestCtx, data)
+		if err != nil {
+			logErrorf(ses.GetDebugString(), "handle COM_STMT_SEND_LONG_DATA failed. error:%v", err)
+		}
+		return nil, true
+	case COM_PING:
+		return NewGeneralOkResponse(COM_PING), true
+	case COM_QUIT:
+		ses.closeCursor()
+		return nil, false
+	case COM_STMT_EXECUTE, COM_STMT_CLOSE, COM_STMT_RESET:
+		data := req.GetData().([]byte)
+		if len(data) < 4 {
+			return NewGeneralErrorResponse(req.GetCmd(), moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")), true
+		}
+		stmtID := binary.LittleEndian.Uint32(data[0:4])
+		if stmtID == cursor.stmtID {
+			ses.closeCursor()
+			return nil, false
+		}
+		// the prepared statements are not shared with the statement of the cursor
+		stmtName := getPrepareStmtName(stmtID)
+		switch req.GetCmd() {
+		case COM_STMT_CLOSE:
+			// there is no response for COM_STMT_CLOSE
+			ses.RemovePrepareStmt(stmtName)
+			logDebugf(ses.GetDebugString(), "close the statement %d while the cursor of the statement %d is open", stmtID, cursor.stmtID)
+			return nil, true
+		case COM_STMT_RESET:
+			preStmt, err := ses.GetPrepareStmt(stmtName)
+			if err != nil {
+				return NewGeneralErrorResponse(COM_STMT_RESET, err), true
+			}
+			preStmt.resetLongData()
+			return NewGeneralOkResponse(COM_STMT_RESET), true
+		}
+	}
+	err = moerr.NewInvalidState(requestCtx, "the cursor of the statement (%d) is open, fetch all its rows or close the statement first", cursor.stmtID)
+	return NewGeneralErrorResponse(req.GetCmd(), err), true
+}
+
+// handleStmtFetch sends the rows of the open cursor for COM_STMT_FETCH.
+func (mce *MysqlCmdExecutor) handleStmtFetch(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html
+	if len(data) < 8 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	numRows := binary.LittleEndian.Uint32(data[4:8])
+
+	ses := mce.GetSession()
+	cursor := ses.getOpenCursor()
+	if cursor == nil || cursor.stmtID != stmtID {
+		return moerr.NewInvalidState(requestCtx, "the statement (%d) has no open cursor", stmtID)
+	}
+	logDebugf(ses.GetDebugString(), "fetch %d rows from the cursor of the statement %d", numRows, stmtID)
+
+	finished, err := cursor.fetch(requestCtx, numRows)
+	if finished {
+		ses.closeCursor()
+	}
+	if err != nil {
+		return err
+	}
+	status := SERVER_STATUS_CURSOR_EXISTS
+	if finished {
+		status = SERVER_STATUS_LAST_ROW_SENT
+	}
+	return ses.GetMysqlProtocol().sendEOFOrOkPacket(0, status)
+}
+
+// handleStmtSendLongData keeps the data of the parameter for the next COM_STMT_EXECUTE.
+// COM_STMT_SEND_LONG_DATA has no response.
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_send_long_data.html
+	if len(data) < 6 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	paramIdx := int(binary.LittleEndian.Uint16(data[4:6]))
+
+	ses := mce.GetSession()
+	preStmt, err := ses.GetPrepareStmt(getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	limit := int64(16777216)
+	if val, err := ses.GetSessionVar("max_allowed_packet"); err == nil {
+		if v, ok := val.(int64); ok {
+			limit = v
+		}
+	}
+	preStmt.appendLongData(requestCtx, paramIdx, data[6:], int(limit))
+	logDebugf(ses.GetDebugString(), "receive %d bytes of the parameter %d of the statement %d", len(data)-6, paramIdx, stmtID)
+	return nil
+}
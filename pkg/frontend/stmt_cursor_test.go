@@ -0,0 +1,253 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func newCursorProtocolForTest(t *testing.T, ctrl *gomock.Controller) (*MysqlProtocolImpl, *[][]byte) {
+	var written [][]byte
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Flush(gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+		data := msg.([]byte)
+		written = append(written, append([]byte{}, data[HeaderLengthOfTheProtocol:]...))
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+	ses := &Session{}
+	ses.SetRequestContext(context.TODO())
+	proto.ses = ses
+	return proto, &written
+}
+
+func makeCursorResultSet(rows int) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	col := &MysqlColumn{}
+	col.SetName("a")
+	col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	mrs.AddColumn(col)
+	for i := 0; i < rows; i++ {
+		mrs.AddRow([]interface{}{int64(i)})
+	}
+	return mrs
+}
+
+func Test_stmtCursorFetch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	proto, written := newCursorProtocolForTest(t, ctrl)
+	ses := proto.ses
+	cursor := newStmtCursor(ctx, 1)
+	ses.setCursor(cursor)
+	require.Equal(t, cursor, ses.getPendingCursor())
+	require.Nil(t, ses.getOpenCursor())
+
+	require.NoError(t, cursor.open(proto))
+	// the OK packet with the EOF header for CLIENT_DEPRECATE_EOF
+	require.Equal(t, []byte{defines.EOFHeader, 0, 0, byte(SERVER_STATUS_CURSOR_EXISTS), 0, 0, 0}, (*written)[0][:7])
+	require.Nil(t, ses.getPendingCursor())
+	require.Equal(t, cursor, ses.getOpenCursor())
+
+	// the pipeline
+	mrs := makeCursorResultSet(5)
+	go func() {
+		defer close(cursor.doneC)
+		cursor.err = proto.SendResultSetTextBatchRowSpeedup(mrs, mrs.GetRowCount())
+	}()
+
+	fetch := func(count uint32) (bool, uint8) {
+		seq := proto.GetSequenceId()
+		finished, err := cursor.fetch(ctx, count)
+		require.NoError(t, err)
+		return finished, proto.GetSequenceId() - seq
+	}
+
+	finished, rows := fetch(2)
+	require.False(t, finished)
+	require.Equal(t, uint8(2), rows)
+
+	finished, rows = fetch(0)
+	require.False(t, finished)
+	require.Equal(t, uint8(0), rows)
+
+	finished, rows = fetch(2)
+	require.False(t, finished)
+	require.Equal(t, uint8(2), rows)
+
+	finished, rows = fetch(2)
+	require.True(t, finished)
+	require.Equal(t, uint8(1), rows)
+
+	ses.closeCursor()
+	require.Nil(t, ses.getOpenCursor())
+}
+
+func Test_stmtCursorClose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	proto, _ := newCursorProtocolForTest(t, ctrl)
+	ses := proto.ses
+	cursor := newStmtCursor(ctx, 1)
+	ses.setCursor(cursor)
+	require.NoError(t, cursor.open(proto))
+
+	mrs := makeCursorResultSet(5)
+	go func() {
+		defer close(cursor.doneC)
+		cursor.err = proto.SendResultSetTextBatchRowSpeedup(mrs, mrs.GetRowCount())
+	}()
+
+	finished, err := cursor.fetch(ctx, 1)
+	require.NoError(t, err)
+	require.False(t, finished)
+
+	// the pipeline blocked in the output is canceled
+	ses.closeCursor()
+	require.True(t, cursor.isClosed())
+	require.ErrorIs(t, cursor.err, context.Canceled)
+	require.Nil(t, ses.getOpenCursor())
+}
+
+func Test_stmtSendLongData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	proto, _ := newCursorProtocolForTest(t, ctrl)
+
+	st := tree.NewPrepareString(tree.Identifier(getPrepareStmtName(1)), "select ?, ?")
+	stmts, err := mysql.Parse(ctx, st.Sql, 1)
+	require.NoError(t, err)
+	preparePlan, err := buildPlan(ctx, nil, plan.NewEmptyCompilerContext(), st)
+	require.NoError(t, err)
+	prepareStmt := &PrepareStmt{
+		Name:        preparePlan.GetDcl().GetPrepare().GetName(),
+		PreparePlan: preparePlan,
+		PrepareStmt: stmts[0],
+	}
+
+	prepareStmt.appendLongData(ctx, 0, []byte("hello "), 1024)
+	prepareStmt.appendLongData(ctx, 0, []byte("world"), 1024)
+	prepareStmt.appendLongData(ctx, 1, []byte{0, 1, 2}, 1024)
+
+	var data []byte
+	data = append(data, CURSOR_TYPE_READ_ONLY) // flag
+	data = append(data, 1, 0, 0, 0)            // iteration-count
+	data = append(data, 0)                     // null bitmap
+	data = append(data, 1)                     // new param bound flag
+	data = append(data, uint8(defines.MYSQL_TYPE_VAR_STRING), 0, uint8(defines.MYSQL_TYPE_BLOB), 0)
+
+	names, vars, err := proto.ParseExecuteData(ctx, prepareStmt, data, 0)
+	require.NoError(t, err)
+	require.Len(t, names, 2)
+	require.Equal(t, []any{"hello world", []byte{0, 1, 2}}, vars)
+
+	prepareStmt.resetLongData()
+	_, ok := prepareStmt.getLongData(0)
+	require.False(t, ok)
+
+	// longer than max_allowed_packet
+	prepareStmt.appendLongData(ctx, 0, make([]byte, 10), 16)
+	prepareStmt.appendLongData(ctx, 0, make([]byte, 10), 16)
+	require.Error(t, prepareStmt.longDataErr)
+
+	// unsupported cursor type
+	data[0] = CURSOR_TYPE_FOR_UPDATE
+	_, _, err = proto.ParseExecuteData(ctx, prepareStmt, data, 0)
+	require.Error(t, err)
+}
+
+func Test_execRequestWithCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	proto, _ := newCursorProtocolForTest(t, ctrl)
+	ses := proto.ses
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.prepareStmts[getPrepareStmtName(2)] = &PrepareStmt{Name: getPrepareStmtName(2)}
+	mce := &MysqlCmdExecutor{}
+	mce.SetSession(ses)
+
+	cursor := newStmtCursor(ctx, 1)
+	ses.setCursor(cursor)
+	require.NoError(t, cursor.open(proto))
+	go func() {
+		defer close(cursor.doneC)
+		<-cursor.ctx.Done()
+		cursor.err = cursor.ctx.Err()
+	}()
+	ses.SetCmd(COM_STMT_EXECUTE)
+
+	stmtData := func(stmtID uint32) []byte {
+		return []byte{byte(stmtID), 0, 0, 0}
+	}
+
+	// the command that shares the session with the cursor leaves the command of the session alone
+	resp, err := mce.ExecRequest(ctx, ses, &Request{cmd: COM_PING})
+	require.NoError(t, err)
+	require.Equal(t, OkResponse, resp.category)
+	require.Equal(t, COM_STMT_EXECUTE, ses.GetCmd())
+
+	// the other commands are refused
+	resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_QUERY, data: []byte("select 1")})
+	require.NoError(t, err)
+	require.Equal(t, ErrorResponse, resp.category)
+	require.Equal(t, COM_STMT_EXECUTE, ses.GetCmd())
+	require.Equal(t, cursor, ses.getOpenCursor())
+
+	resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_STMT_EXECUTE, data: stmtData(2)})
+	require.NoError(t, err)
+	require.Equal(t, ErrorResponse, resp.category)
+	require.Equal(t, cursor, ses.getOpenCursor())
+
+	// the other statement is closed without the cursor
+	resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_STMT_CLOSE, data: stmtData(2)})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	_, err = ses.GetPrepareStmt(getPrepareStmtName(2))
+	require.Error(t, err)
+	require.Equal(t, cursor, ses.getOpenCursor())
+
+	// the statement of the cursor closes it
+	resp, err = mce.ExecRequest(ctx, ses, &Request{cmd: COM_STMT_EXECUTE, data: stmtData(1)})
+	require.NoError(t, err)
+	require.Equal(t, ErrorResponse, resp.category)
+	require.True(t, cursor.isClosed())
+	require.Nil(t, ses.getCursor())
+}
@@ -26,6 +26,7 @@ func IsAdministrativeStatement(stmt tree.Statement) bool {
 		*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 		*tree.CreateRole, *tree.DropRole,
 		*tree.Revoke, *tree.Grant,
+		*tree.CreatePolicy, *tree.DropPolicy,
 		*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword:
 		return true
 	case *tree.Use:
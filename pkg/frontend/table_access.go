@@ -0,0 +1,673 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/tidwall/btree"
+)
+
+/*
+The access to a table can be narrower than the table privileges:
+
+ 1. GRANT SELECT (col, ...) ON t grants some columns of the table, it is kept
+    in mo_catalog.mo_column_privs. The table level check of the plan lets the
+    SELECT on the table pass if the user has the column privileges on it, and
+    the binder rejects the columns not granted.
+ 2. CREATE POLICY ... ON t [TO roles] USING (expr) is the row level security
+    policy kept in mo_catalog.mo_policies. The plan adds the expressions of the
+    policies of the roles of the user as the filters of every scan of the table.
+
+Both of them are resolved by TxnCompilerContext.GetTableAccess when the plan
+binds the table. The admin roles are not restricted. DROP TABLE and DROP
+DATABASE delete them in the transaction of the ddl.
+
+The column privileges and the policies of an account are cached on the cn in
+globalTableAccess. The cache is dropped by GRANT, REVOKE, CREATE POLICY and
+DROP POLICY on the cn, and reloaded after tableAccessCacheTTL to see the ones
+on the other cns. The roles of the user are only resolved for the tables
+having the column privileges or the policies.
+*/
+
+const (
+	insertColumnPrivFormat = `insert into mo_catalog.mo_column_privs(role_id, role_name, database_name, table_name, column_name, operation_user_id, granted_time) values (%d, '%s', '%s', '%s', '%s', %d, now());`
+	deleteColumnPrivFormat = `delete from mo_catalog.mo_column_privs where role_id = %d and database_name = '%s' and table_name = '%s' and column_name = '%s';`
+	getColumnPrivsSql      = `select database_name, table_name, role_id, column_name from mo_catalog.mo_column_privs;`
+
+	insertPolicyFormat = `insert into mo_catalog.mo_policies(policy_name, database_name, table_name, role_names, policy_expr, creator, created_time) values ('%s', '%s', '%s', '%s', '%s', %d, now());`
+	checkPolicyFormat  = `select policy_name from mo_catalog.mo_policies where database_name = '%s' and table_name = '%s' and policy_name = '%s';`
+	deletePolicyFormat = `delete from mo_catalog.mo_policies where database_name = '%s' and table_name = '%s' and policy_name = '%s';`
+	getPoliciesSql     = `select database_name, table_name, role_names, policy_expr from mo_catalog.mo_policies order by policy_name;`
+
+	getRoleNamesFormat = `select role_name from mo_catalog.mo_role where role_id in (%s);`
+
+	// the policy is checked by the query before it is created
+	checkPolicyExprFormat = "select 1 from %s.%s where %s limit 0;"
+
+	// the column privileges and the policies cached on the cn are reloaded after the ttl
+	tableAccessCacheTTL = 5 * time.Second
+)
+
+// getSqlForInsertColumnPriv and the ones below escape the names, because the quoted
+// identifiers may have the quotes.
+func getSqlForInsertColumnPriv(roleId int64, roleName, dbName, tblName, column string, userId uint32) string {
+	return fmt.Sprintf(insertColumnPrivFormat, roleId, escapeString(roleName), escapeString(dbName), escapeString(tblName), escapeString(column), userId)
+}
+
+func getSqlForDeleteColumnPriv(roleId int64, dbName, tblName, column string) string {
+	return fmt.Sprintf(deleteColumnPrivFormat, roleId, escapeString(dbName), escapeString(tblName), escapeString(column))
+}
+
+func getSqlForInsertPolicy(policyName, dbName, tblName string, roleNames []string, expr string, creator uint32) string {
+	return fmt.Sprintf(insertPolicyFormat, escapeString(policyName), escapeString(dbName), escapeString(tblName),
+		escapeString(strings.Join(roleNames, ",")), escapeString(expr), creator)
+}
+
+func getSqlForCheckPolicy(policyName, dbName, tblName string) string {
+	return fmt.Sprintf(checkPolicyFormat, escapeString(dbName), escapeString(tblName), escapeString(policyName))
+}
+
+func getSqlForDeletePolicy(policyName, dbName, tblName string) string {
+	return fmt.Sprintf(deletePolicyFormat, escapeString(dbName), escapeString(tblName), escapeString(policyName))
+}
+
+// getColumnPrivilegeTable returns the table of GRANT SELECT (col, ...). The
+// column privileges are only supported on a single table.
+func getColumnPrivilegeTable(ctx context.Context, ses *Session, objType tree.ObjectType, level *tree.PrivilegeLevel) (string, string, error) {
+	if objType == tree.OBJECT_TYPE_TABLE {
+		switch level.Level {
+		case tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE:
+			return level.DbName, level.TabName, nil
+		case tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+			return ses.GetDatabaseName(), level.TabName, nil
+		}
+	}
+	return "", "", moerr.NewNotSupported(ctx, "the column privilege on %s %s", objType.String(), level.String())
+}
+
+// getColumnPrivilegeColumns returns the names of the columns in the column list.
+func getColumnPrivilegeColumns(ctx context.Context, ses *Session, dbName, tblName string, columns []*tree.UnresolvedName) ([]string, error) {
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tblName)
+	if tableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx, dbName, tblName)
+	}
+	names := make([]string, 0, len(columns))
+	for _, column := range columns {
+		found := ""
+		for _, col := range tableDef.Cols {
+			if !col.Hidden && strings.EqualFold(col.Name, column.Parts[0]) {
+				found = col.Name
+				break
+			}
+		}
+		if found == "" {
+			return nil, moerr.NewBadFieldError(ctx, column.Parts[0], tblName)
+		}
+		names = append(names, found)
+	}
+	return names, nil
+}
+
+// grantColumnPrivilege records GRANT SELECT (col, ...) ON t TO roles in the transaction of bh.
+func grantColumnPrivilege(ctx context.Context, bh BackgroundExec, ses *Session, dbName, tblName string, columns []string, roles []*verifiedRole) error {
+	userId := ses.GetTenantInfo().GetUserID()
+	for _, role := range roles {
+		for _, column := range columns {
+			bh.ClearExecResultSet()
+			err := bh.Exec(ctx, getSqlForDeleteColumnPriv(role.id, dbName, tblName, column))
+			if err != nil {
+				return err
+			}
+			bh.ClearExecResultSet()
+			err = bh.Exec(ctx, getSqlForInsertColumnPriv(role.id, role.name, dbName, tblName, column, userId))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// revokeColumnPrivilege deletes the column privileges of REVOKE SELECT (col, ...) ON t FROM roles
+// in the transaction of bh.
+func revokeColumnPrivilege(ctx context.Context, bh BackgroundExec, dbName, tblName string, columns []string, roles []*verifiedRole) error {
+	for _, role := range roles {
+		for _, column := range columns {
+			bh.ClearExecResultSet()
+			err := bh.Exec(ctx, getSqlForDeleteColumnPriv(role.id, dbName, tblName, column))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getPolicyTable returns the database and the name of the table of the policy.
+func getPolicyTable(ctx context.Context, ses *Session, tbl *tree.TableName) (string, string, error) {
+	dbName := string(tbl.SchemaName)
+	if dbName == "" {
+		dbName = ses.GetDatabaseName()
+	}
+	if dbName == "" {
+		return "", "", moerr.NewNoDB(ctx)
+	}
+	return dbName, string(tbl.ObjectName), nil
+}
+
+// doCreatePolicy accomplishes the CreatePolicy statement
+func doCreatePolicy(ctx context.Context, ses *Session, cp *tree.CreatePolicy) error {
+	var err error
+	var erArray []ExecResult
+	var sql string
+	var dbName, tblName string
+	var roleNames []string
+
+	dbName, tblName, err = getPolicyTable(ctx, ses, cp.Table)
+	if err != nil {
+		return err
+	}
+	err = normalizeNamesOfRoles(ctx, cp.Roles)
+	if err != nil {
+		return err
+	}
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+	cp.Expr.Format(fmtCtx)
+	expr := fmtCtx.String()
+
+	account := ses.GetTenantInfo()
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	//the table exists and the expression can be evaluated on it
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, fmt.Sprintf(checkPolicyExprFormat, quoteIdentifier(dbName), quoteIdentifier(tblName), expr))
+	if err != nil {
+		return err
+	}
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	for _, role := range cp.Roles {
+		sql, err = getSqlForRoleIdOfRole(ctx, role.UserName)
+		if err != nil {
+			goto handleFailed
+		}
+		bh.ClearExecResultSet()
+		err = bh.Exec(ctx, sql)
+		if err != nil {
+			goto handleFailed
+		}
+		erArray, err = getResultSet(ctx, bh)
+		if err != nil {
+			goto handleFailed
+		}
+		if !execResultArrayHasData(erArray) {
+			err = moerr.NewInternalError(ctx, "there is no role %s", role.UserName)
+			goto handleFailed
+		}
+		roleNames = append(roleNames, role.UserName)
+	}
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getSqlForCheckPolicy(string(cp.Name), dbName, tblName))
+	if err != nil {
+		goto handleFailed
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		goto handleFailed
+	}
+	if execResultArrayHasData(erArray) {
+		if !cp.IfNotExists {
+			err = moerr.NewInternalError(ctx, "the policy %s on the table %s.%s exists", string(cp.Name), dbName, tblName)
+		}
+		goto handleFailed
+	}
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getSqlForInsertPolicy(string(cp.Name), dbName, tblName, roleNames, expr, account.GetUserID()))
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	globalTableAccess.invalidate(ses.GetTenantInfo().GetTenantID())
+	return err
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// doDropPolicy accomplishes the DropPolicy statement
+func doDropPolicy(ctx context.Context, ses *Session, dp *tree.DropPolicy) error {
+	var err error
+	var erArray []ExecResult
+	var dbName, tblName string
+
+	dbName, tblName, err = getPolicyTable(ctx, ses, dp.Table)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	if err != nil {
+		goto handleFailed
+	}
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getSqlForCheckPolicy(string(dp.Name), dbName, tblName))
+	if err != nil {
+		goto handleFailed
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		goto handleFailed
+	}
+	if !execResultArrayHasData(erArray) {
+		if !dp.IfExists {
+			err = moerr.NewInternalError(ctx, "there is no policy %s on the table %s.%s", string(dp.Name), dbName, tblName)
+		}
+		goto handleFailed
+	}
+
+	bh.ClearExecResultSet()
+	err = bh.Exec(ctx, getSqlForDeletePolicy(string(dp.Name), dbName, tblName))
+	if err != nil {
+		goto handleFailed
+	}
+
+	err = bh.Exec(ctx, "commit;")
+	if err != nil {
+		goto handleFailed
+	}
+	globalTableAccess.invalidate(ses.GetTenantInfo().GetTenantID())
+	return err
+
+handleFailed:
+	//ROLLBACK the transaction
+	rbErr := bh.Exec(ctx, "rollback;")
+	if rbErr != nil {
+		return rbErr
+	}
+	return err
+}
+
+// getRoleSetOfCurrentUser returns the roles in use and all the roles inherited by them.
+func getRoleSetOfCurrentUser(ctx context.Context, bh BackgroundExec, tenant *TenantInfo) (*btree.Set[int64], error) {
+	roleSet := &btree.Set[int64]{}
+	roleSet.Insert(int64(tenant.GetDefaultRoleID()))
+	if err := loadAllSecondaryRoles(ctx, bh, tenant, roleSet); err != nil {
+		return nil, err
+	}
+
+	queue := roleSet.Keys()
+	for len(queue) > 0 {
+		roleId := queue[0]
+		queue = queue[1:]
+		bh.ClearExecResultSet()
+		if err := bh.Exec(ctx, getSqlForInheritedRoleIdOfRoleId(roleId)); err != nil {
+			return nil, err
+		}
+		erArray, err := getResultSet(ctx, bh)
+		if err != nil {
+			return nil, err
+		}
+		if !execResultArrayHasData(erArray) {
+			continue
+		}
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			grantedId, err := erArray[0].GetInt64(ctx, i, 0)
+			if err != nil {
+				return nil, err
+			}
+			if !roleSet.Contains(grantedId) {
+				roleSet.Insert(grantedId)
+				queue = append(queue, grantedId)
+			}
+		}
+	}
+	return roleSet, nil
+}
+
+// getRoleNames returns the names of the roles.
+func getRoleNames(ctx context.Context, bh BackgroundExec, roleSet *btree.Set[int64]) (map[string]bool, error) {
+	ids := make([]string, 0, roleSet.Len())
+	for _, id := range roleSet.Keys() {
+		ids = append(ids, fmt.Sprintf("%d", id))
+	}
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getRoleNamesFormat, strings.Join(ids, ","))); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	if !execResultArrayHasData(erArray) {
+		return names, nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		name, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		names[name] = true
+	}
+	return names, nil
+}
+
+type columnPriv struct {
+	roleId int64
+	column string
+}
+
+type rowPolicy struct {
+	roles []string
+	expr  string
+}
+
+type tableAccessKey struct {
+	dbName  string
+	tblName string
+}
+
+// tableAccessRules are the column privileges and the policies on a table.
+type tableAccessRules struct {
+	columnPrivs []columnPriv
+	policies    []rowPolicy
+}
+
+type accountTableAccess struct {
+	tables   map[tableAccessKey]*tableAccessRules
+	loadedAt time.Time
+}
+
+// tableAccessCache caches the column privileges and the policies of the accounts.
+type tableAccessCache struct {
+	sync.Mutex
+	accounts map[uint32]*accountTableAccess
+	// version is increased by invalidate, the rules loaded before it are not cached
+	version uint64
+}
+
+var globalTableAccess = &tableAccessCache{
+	accounts: make(map[uint32]*accountTableAccess),
+}
+
+// get returns the rules on the table, or nil if there is none.
+func (c *tableAccessCache) get(ctx context.Context, bh BackgroundExec, accountId uint32, dbName, tblName string) (*tableAccessRules, error) {
+	key := tableAccessKey{dbName: dbName, tblName: tblName}
+	c.Lock()
+	account, ok := c.accounts[accountId]
+	version := c.version
+	c.Unlock()
+	if ok && time.Since(account.loadedAt) < tableAccessCacheTTL {
+		return account.tables[key], nil
+	}
+
+	tables, err := loadTableAccessRules(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	c.Lock()
+	if c.version == version {
+		c.accounts[accountId] = &accountTableAccess{tables: tables, loadedAt: time.Now()}
+	}
+	c.Unlock()
+	return tables[key], nil
+}
+
+// invalidate drops the cached rules of the account.
+func (c *tableAccessCache) invalidate(accountId uint32) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.accounts, accountId)
+	c.version++
+}
+
+// loadTableAccessRules returns the column privileges and the policies of the account of bh.
+func loadTableAccessRules(ctx context.Context, bh BackgroundExec) (map[tableAccessKey]*tableAccessRules, error) {
+	tables := make(map[tableAccessKey]*tableAccessRules)
+	rulesOf := func(erArray []ExecResult, i uint64) (*tableAccessRules, error) {
+		dbName, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		tblName, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return nil, err
+		}
+		key := tableAccessKey{dbName: dbName, tblName: tblName}
+		rules, ok := tables[key]
+		if !ok {
+			rules = &tableAccessRules{}
+			tables[key] = rules
+		}
+		return rules, nil
+	}
+
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, getColumnPrivsSql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			rules, err := rulesOf(erArray, i)
+			if err != nil {
+				return nil, err
+			}
+			roleId, err := erArray[0].GetInt64(ctx, i, 2)
+			if err != nil {
+				return nil, err
+			}
+			column, err := erArray[0].GetString(ctx, i, 3)
+			if err != nil {
+				return nil, err
+			}
+			rules.columnPrivs = append(rules.columnPrivs, columnPriv{roleId: roleId, column: column})
+		}
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, getPoliciesSql); err != nil {
+		return nil, err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			rules, err := rulesOf(erArray, i)
+			if err != nil {
+				return nil, err
+			}
+			roleNames, err := erArray[0].GetString(ctx, i, 2)
+			if err != nil {
+				return nil, err
+			}
+			expr, err := erArray[0].GetString(ctx, i, 3)
+			if err != nil {
+				return nil, err
+			}
+			var roles []string
+			if roleNames != "" {
+				roles = strings.Split(roleNames, ",")
+			}
+			rules.policies = append(rules.policies, rowPolicy{roles: roles, expr: expr})
+		}
+	}
+	return tables, nil
+}
+
+// grantedColumns returns the columns granted to the roles in the role set.
+func grantedColumns(privs []columnPriv, roleSet *btree.Set[int64]) []string {
+	var columns []string
+	dedup := make(map[string]bool)
+	for _, priv := range privs {
+		if roleSet.Contains(priv.roleId) && !dedup[priv.column] {
+			dedup[priv.column] = true
+			columns = append(columns, priv.column)
+		}
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// hasTablePrivilege decides the user has the privilege on the table itself.
+func hasTablePrivilege(ctx context.Context, ses *Session, privType PrivilegeType, dbName, tblName string) (bool, error) {
+	priv := &privilege{kind: privilegeKindGeneral, objType: objectTypeTable}
+	convertPrivilegeTipsToPrivilege(priv, privilegeTipsArray{{
+		typ:                   privType,
+		databaseName:          dbName,
+		tableName:             tblName,
+		isClusterTable:        isClusterTable(dbName, tblName),
+		clusterTableOperation: clusterTableSelect,
+	}})
+	return determineUserHasPrivilegeSet(ctx, ses, priv, nil)
+}
+
+// needTableAccess decides the access to the table needs to be checked.
+func needTableAccess(ses *Session, dbName, tblName string) bool {
+	tenant := ses.GetTenantInfo()
+	if tenant == nil || tenant.IsAdminRole() || ses.IsBackgroundSession() {
+		return false
+	}
+	return !isBannedDatabase(dbName) && !isIndexTable(tblName)
+}
+
+// getTableAccess returns the column privileges and the row level security policies of
+// current user on the table.
+func getTableAccess(ctx context.Context, ses *Session, dbName, tblName string) (*plan2.TableAccess, error) {
+	if !needTableAccess(ses, dbName, tblName) {
+		return nil, nil
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	tenant := ses.GetTenantInfo()
+	rules, err := globalTableAccess.get(ctx, bh, tenant.GetTenantID(), dbName, tblName)
+	if err != nil || rules == nil {
+		return nil, err
+	}
+	roleSet, err := getRoleSetOfCurrentUser(ctx, bh, tenant)
+	if err != nil {
+		return nil, err
+	}
+	policies := rules.policies
+	columns := grantedColumns(rules.columnPrivs, roleSet)
+	if len(policies) == 0 && len(columns) == 0 {
+		return nil, nil
+	}
+
+	access := &plan2.TableAccess{}
+	if len(columns) > 0 {
+		ok, err := hasTablePrivilege(ctx, ses, PrivilegeTypeSelect, dbName, tblName)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			access.Columns = columns
+		}
+	}
+	if len(policies) > 0 {
+		roleNames, err := getRoleNames(ctx, bh, roleSet)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range policies {
+			applied := len(policy.roles) == 0
+			for _, role := range policy.roles {
+				if roleNames[role] {
+					applied = true
+					break
+				}
+			}
+			if applied {
+				access.Policies = append(access.Policies, policy.expr)
+			}
+		}
+	}
+	if access.Columns == nil && len(access.Policies) == 0 {
+		return nil, nil
+	}
+	return access, nil
+}
+
+// removeTipsOfColumnPrivileges removes the tips of SELECT on the tables that the
+// user has the column privileges on, the columns are checked by the binder.
+func removeTipsOfColumnPrivileges(ctx context.Context, ses *Session, arr privilegeTipsArray) (privilegeTipsArray, error) {
+	var roleSet *btree.Set[int64]
+	var rules *tableAccessRules
+	var err error
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	ret := make(privilegeTipsArray, 0, len(arr))
+	for _, tips := range arr {
+		if tips.typ != PrivilegeTypeSelect || !needTableAccess(ses, tips.databaseName, tips.tableName) {
+			ret = append(ret, tips)
+			continue
+		}
+		rules, err = globalTableAccess.get(ctx, bh, ses.GetTenantInfo().GetTenantID(), tips.databaseName, tips.tableName)
+		if err != nil {
+			return nil, err
+		}
+		if rules == nil || len(rules.columnPrivs) == 0 {
+			ret = append(ret, tips)
+			continue
+		}
+		if roleSet == nil {
+			if roleSet, err = getRoleSetOfCurrentUser(ctx, bh, ses.GetTenantInfo()); err != nil {
+				return nil, err
+			}
+		}
+		if len(grantedColumns(rules.columnPrivs, roleSet)) == 0 {
+			ret = append(ret, tips)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,249 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/btree"
+)
+
+// backgroundExecEmpty records the executed sqls and returns the empty result
+// set for the query without the result.
+type backgroundExecEmpty struct {
+	backgroundExecTest
+	sqls []string
+}
+
+func (bt *backgroundExecEmpty) Exec(ctx context.Context, s string) error {
+	bt.sqls = append(bt.sqls, s)
+	return bt.backgroundExecTest.Exec(ctx, s)
+}
+
+func (bt *backgroundExecEmpty) GetExecResultSet() []interface{} {
+	if r := bt.sql2result[bt.currentSql]; r != nil {
+		return []interface{}{r}
+	}
+	return []interface{}{&MysqlResultSet{}}
+}
+
+func TestGetRoleSetOfCurrentUser(t *testing.T) {
+	ctx := context.Background()
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bh.sql2result[getSqlForInheritedRoleIdOfRoleId(5)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{{6}, {7}})
+	bh.sql2result[getSqlForInheritedRoleIdOfRoleId(6)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{{7}, {8}})
+	bh.sql2result[getSqlForInheritedRoleIdOfRoleId(8)] = newMrsForInheritedRoleIdOfRoleId([][]interface{}{{5}})
+
+	roleSet, err := getRoleSetOfCurrentUser(ctx, bh, &TenantInfo{DefaultRoleID: 5})
+	require.NoError(t, err)
+	require.Equal(t, []int64{5, 6, 7, 8}, roleSet.Keys())
+}
+
+func TestLoadTableAccessRules(t *testing.T) {
+	ctx := context.Background()
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bh.sql2result[getColumnPrivsSql] = newMrsForAnalyze(
+		[]string{"database_name", "table_name", "role_id", "column_name"},
+		[][]interface{}{{"db", "t", int64(1), "b"}, {"db", "t", int64(2), "a"}, {"db", "t2", int64(3), "c"}})
+	bh.sql2result[getPoliciesSql] = newMrsForAnalyze(
+		[]string{"database_name", "table_name", "role_names", "policy_expr"},
+		[][]interface{}{{"db", "t", "", "a = 1"}, {"db", "t3", "r1,r2", "b > 'x'"}})
+
+	tables, err := loadTableAccessRules(ctx, bh)
+	require.NoError(t, err)
+	require.Equal(t, map[tableAccessKey]*tableAccessRules{
+		{dbName: "db", tblName: "t"}: {
+			columnPrivs: []columnPriv{{roleId: 1, column: "b"}, {roleId: 2, column: "a"}},
+			policies:    []rowPolicy{{expr: "a = 1"}},
+		},
+		{dbName: "db", tblName: "t2"}: {
+			columnPrivs: []columnPriv{{roleId: 3, column: "c"}},
+		},
+		{dbName: "db", tblName: "t3"}: {
+			policies: []rowPolicy{{roles: []string{"r1", "r2"}, expr: "b > 'x'"}},
+		},
+	}, tables)
+}
+
+func TestGrantedColumns(t *testing.T) {
+	roleSet := &btree.Set[int64]{}
+	roleSet.Insert(1)
+	roleSet.Insert(2)
+	privs := []columnPriv{{roleId: 1, column: "b"}, {roleId: 2, column: "a"}, {roleId: 3, column: "c"}, {roleId: 2, column: "b"}}
+	require.Equal(t, []string{"a", "b"}, grantedColumns(privs, roleSet))
+	require.Nil(t, grantedColumns(privs[2:3], roleSet))
+}
+
+func TestGetTableAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	ctx := ses.GetRequestContext()
+	t.Cleanup(func() { globalTableAccess.invalidate(0) })
+
+	// the admin is not restricted
+	access, err := getTableAccess(ctx, ses, "db", "t")
+	require.NoError(t, err)
+	require.Nil(t, access)
+
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        "acc1",
+		User:          "u1",
+		DefaultRole:   "r1",
+		UserID:        10,
+		DefaultRoleID: 5,
+	})
+	bh.sql2result[getPoliciesSql] = newMrsForAnalyze(
+		[]string{"database_name", "table_name", "role_names", "policy_expr"},
+		[][]interface{}{{"db", "t", "", "a = 1"}, {"db", "t", "r1", "b = 2"}, {"db", "t", "r2", "c = 3"}})
+	bh.sql2result[getColumnPrivsSql] = newMrsForAnalyze(
+		[]string{"database_name", "table_name", "role_id", "column_name"},
+		[][]interface{}{{"db", "t", int64(5), "a"}, {"db", "t", int64(6), "b"}})
+	bh.sql2result[fmt.Sprintf(getRoleNamesFormat, "5")] = newMrsForAnalyze(
+		[]string{"role_name"}, [][]interface{}{{"r1"}})
+
+	access, err = getTableAccess(ctx, ses, "db", "t")
+	require.NoError(t, err)
+	require.Equal(t, &plan2.TableAccess{
+		Columns:  []string{"a"},
+		Policies: []string{"a = 1", "b = 2"},
+	}, access)
+
+	// no policy and column privilege on the table, the rules are cached and
+	// the roles of the user are not resolved
+	bh.sqls = nil
+	access, err = getTableAccess(ctx, ses, "db", "t2")
+	require.NoError(t, err)
+	require.Nil(t, access)
+	require.Empty(t, bh.sqls)
+
+	// the rules are loaded again after the invalidation
+	globalTableAccess.invalidate(0)
+	access, err = getTableAccess(ctx, ses, "db", "t2")
+	require.NoError(t, err)
+	require.Nil(t, access)
+	require.Equal(t, []string{getColumnPrivsSql, getPoliciesSql}, bh.sqls)
+
+	// the system databases and the index tables are not restricted
+	access, err = getTableAccess(ctx, ses, "mo_catalog", "t")
+	require.NoError(t, err)
+	require.Nil(t, access)
+}
+
+func Test_doCreateAndDropPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	ctx := ses.GetRequestContext()
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	cp := &tree.CreatePolicy{
+		Name:  "p1",
+		Table: tree.NewTableName("t", tree.ObjectNamePrefix{SchemaName: "db", ExplicitSchema: true}),
+		Roles: []*tree.Role{{UserName: "r1"}},
+		Expr:  tree.NewComparisonExpr(tree.EQUAL, tree.SetUnresolvedName("a"), tree.NewNumValWithType(constant.MakeString("x"), "x", false, tree.P_char)),
+	}
+
+	// no role r1
+	err := doCreatePolicy(ctx, ses, cp)
+	require.Error(t, err)
+
+	sql, _ := getSqlForRoleIdOfRole(ctx, "r1")
+	bh.sql2result[sql] = newMrsForRoleIdOfRole([][]interface{}{{5}})
+	err = doCreatePolicy(ctx, ses, cp)
+	require.NoError(t, err)
+	require.Contains(t, bh.sqls, fmt.Sprintf(insertPolicyFormat, "p1", "db", "t", "r1", "a = \\'x\\'", rootID))
+
+	// the policy exists
+	bh.sql2result[fmt.Sprintf(checkPolicyFormat, "db", "t", "p1")] = newMrsForAnalyze([]string{"policy_name"}, [][]interface{}{{"p1"}})
+	err = doCreatePolicy(ctx, ses, cp)
+	require.Error(t, err)
+	cp.IfNotExists = true
+	err = doCreatePolicy(ctx, ses, cp)
+	require.NoError(t, err)
+
+	dp := &tree.DropPolicy{Name: "p1", Table: cp.Table}
+	globalTableAccess.accounts[sysAccountID] = &accountTableAccess{loadedAt: time.Now()}
+	err = doDropPolicy(ctx, ses, dp)
+	require.NoError(t, err)
+	require.Nil(t, globalTableAccess.accounts[sysAccountID])
+
+	dp.Name = "p2"
+	err = doDropPolicy(ctx, ses, dp)
+	require.Error(t, err)
+	dp.IfExists = true
+	err = doDropPolicy(ctx, ses, dp)
+	require.NoError(t, err)
+}
+
+func Test_tableAccessSqlsEscapeNames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecEmpty{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	ctx := ses.GetRequestContext()
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	// the policy name can not break out of the string literal
+	dp := &tree.DropPolicy{
+		Name:  "p' or '1'='1",
+		Table: tree.NewTableName("t", tree.ObjectNamePrefix{SchemaName: "db", ExplicitSchema: true}),
+	}
+	bh.sql2result[getSqlForCheckPolicy(string(dp.Name), "db", "t")] = newMrsForAnalyze([]string{"policy_name"}, [][]interface{}{{string(dp.Name)}})
+	err := doDropPolicy(ctx, ses, dp)
+	require.NoError(t, err)
+	require.Contains(t, bh.sqls, `delete from mo_catalog.mo_policies where database_name = 'db' and table_name = 't' and policy_name = 'p\' or \'1\'=\'1';`)
+
+	// neither can the column name
+	bh.sqls = nil
+	err = grantColumnPrivilege(ctx, bh, ses, "db", "t", []string{"a' or '1'='1"}, []*verifiedRole{{name: "r1", id: 5}})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`delete from mo_catalog.mo_column_privs where role_id = 5 and database_name = 'db' and table_name = 't' and column_name = 'a\' or \'1\'=\'1';`,
+		fmt.Sprintf(`insert into mo_catalog.mo_column_privs(role_id, role_name, database_name, table_name, column_name, operation_user_id, granted_time) values (5, 'r1', 'db', 't', 'a\' or \'1\'=\'1', %d, now());`, rootID),
+	}, bh.sqls)
+}
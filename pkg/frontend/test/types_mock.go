@@ -326,6 +326,21 @@ func (m *MockExecResult) EXPECT() *MockExecResultMockRecorder {
 	return m.recorder
 }
 
+// GetFloat64 mocks base method.
+func (m *MockExecResult) GetFloat64(ctx context.Context, rindex, cindex uint64) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFloat64", ctx, rindex, cindex)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFloat64 indicates an expected call of GetFloat64.
+func (mr *MockExecResultMockRecorder) GetFloat64(ctx, rindex, cindex interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloat64", reflect.TypeOf((*MockExecResult)(nil).GetFloat64), ctx, rindex, cindex)
+}
+
 // GetInt64 mocks base method.
 func (m *MockExecResult) GetInt64(ctx context.Context, rindex, cindex uint64) (int64, error) {
 	m.ctrl.T.Helper()
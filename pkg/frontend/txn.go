@@ -128,9 +128,12 @@ func (th *TxnHandler) NewTxnOperator() error {
 	rt := moruntime.ProcessLevelRuntime()
 	if rt != nil {
 		if v, ok := rt.GetGlobalVariables(moruntime.TxnOptions); ok {
-			opts = v.([]client.TxnOption)
+			opts = append(opts, v.([]client.TxnOption)...)
 		}
 	}
+	if ts := th.ses.getTxnSnapshotTS(); ts != nil {
+		opts = append(opts, client.WithTxnReadyOnly(), client.WithSnapshotTS(*ts))
+	}
 
 	th.txnOperator, err = th.txnClient.New(
 		th.createTxnCtx(),
@@ -18,6 +18,7 @@ import (
 	"context"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -87,6 +88,37 @@ type PrepareStmt struct {
 	PreparePlan *plan.Plan
 	PrepareStmt tree.Statement
 	ParamTypes  []byte
+
+	// the parameters received via COM_STMT_SEND_LONG_DATA.
+	// they are cleared after COM_STMT_EXECUTE or COM_STMT_RESET.
+	longData    map[int][]byte
+	longDataErr error
+}
+
+// appendLongData appends the data of the parameter received via COM_STMT_SEND_LONG_DATA.
+// The error is reported by the next COM_STMT_EXECUTE because COM_STMT_SEND_LONG_DATA has no response.
+func (prepareStmt *PrepareStmt) appendLongData(ctx context.Context, paramIdx int, data []byte, limit int) {
+	if prepareStmt.longDataErr != nil {
+		return
+	}
+	if prepareStmt.longData == nil {
+		prepareStmt.longData = make(map[int][]byte)
+	}
+	if len(prepareStmt.longData[paramIdx])+len(data) > limit {
+		prepareStmt.longDataErr = moerr.NewInvalidInput(ctx, "parameter %d of prepared statement which is set through COM_STMT_SEND_LONG_DATA is longer than 'max_allowed_packet' bytes", paramIdx)
+		return
+	}
+	prepareStmt.longData[paramIdx] = append(prepareStmt.longData[paramIdx], data...)
+}
+
+func (prepareStmt *PrepareStmt) getLongData(paramIdx int) ([]byte, bool) {
+	data, ok := prepareStmt.longData[paramIdx]
+	return data, ok
+}
+
+func (prepareStmt *PrepareStmt) resetLongData() {
+	prepareStmt.longData = nil
+	prepareStmt.longDataErr = nil
 }
 
 /*
@@ -129,6 +161,8 @@ type ExecResult interface {
 	GetUint64(ctx context.Context, rindex, cindex uint64) (uint64, error)
 
 	GetInt64(ctx context.Context, rindex, cindex uint64) (int64, error)
+
+	GetFloat64(ctx context.Context, rindex, cindex uint64) (float64, error)
 }
 
 func execResultArrayHasData(arr []ExecResult) bool {
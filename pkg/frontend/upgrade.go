@@ -0,0 +1,118 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const getAccountIdsForUpgradeSql = `select account_id from mo_catalog.mo_account;`
+
+// upgradeTables are the tables of mo_catalog added after the first release, the accounts
+// created by the older versions don't have them. They are created by the sqls in createSqls.
+var upgradeTables = []string{
+	"mo_column_stats",
+	"mo_column_privs",
+	"mo_policies",
+	"mo_account_quota",
+	"mo_user_pg_auth",
+	"mo_account_usage",
+	"mo_audit_config",
+}
+
+// isSysOnlyTable returns whether only the sys account has the table of mo_catalog.
+func isSysOnlyTable(name string) bool {
+	return strings.HasPrefix(name, "mo_account") || name == "mo_audit_config"
+}
+
+// UpgradeMoCatalog creates the tables of mo_catalog missing in the existing accounts. It is
+// run by every cn when it starts, the tables are created with IF NOT EXISTS, so it can be
+// run again and again. A failure, like the conflict with the other cns creating the same
+// tables, should be retried by the caller.
+func UpgradeMoCatalog(ctx context.Context, pu *config.ParameterUnit, aicm *defines.AutoIncrCacheManager) error {
+	return upgradeMoCatalog(ctx, NewInternalExecutor(pu, aicm))
+}
+
+func upgradeMoCatalog(ctx context.Context, exec ie.InternalExecutor) error {
+	sqls, err := getUpgradeSqls(ctx)
+	if err != nil {
+		return err
+	}
+
+	opts := ie.NewOptsBuilder().Internal(true).Finish()
+	sysCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	sysCtx = context.WithValue(sysCtx, defines.UserIDKey{}, uint32(rootID))
+	sysCtx = context.WithValue(sysCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+	result := exec.Query(sysCtx, getAccountIdsForUpgradeSql, opts)
+	if err = result.Error(); err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < result.RowCount(); i++ {
+		value, err := result.StringValueByName(ctx, i, "account_id")
+		if err != nil {
+			return err
+		}
+		accountID, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return err
+		}
+
+		accountCtx := sysCtx
+		if accountID != sysAccountID {
+			accountCtx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(accountID))
+			accountCtx = context.WithValue(accountCtx, defines.UserIDKey{}, uint32(rootID))
+			accountCtx = context.WithValue(accountCtx, defines.RoleIDKey{}, uint32(accountAdminRoleID))
+		}
+		for i, sql := range sqls {
+			if accountID != sysAccountID && isSysOnlyTable(upgradeTables[i]) {
+				continue
+			}
+			if err = exec.Exec(accountCtx, sql, opts); err != nil {
+				return err
+			}
+		}
+		logutil.Infof("upgrade mo_catalog of the account %d completed", accountID)
+	}
+	return nil
+}
+
+// getUpgradeSqls returns the sqls creating the upgrade tables if they don't exist.
+func getUpgradeSqls(ctx context.Context) ([]string, error) {
+	sqls := make([]string, 0, len(upgradeTables))
+	for _, name := range upgradeTables {
+		prefix := "create table " + name + "("
+		found := false
+		for _, sql := range createSqls {
+			if strings.HasPrefix(sql, prefix) {
+				sqls = append(sqls, "create table if not exists mo_catalog."+strings.TrimPrefix(sql, "create table "))
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewInternalError(ctx, "no sql creating the table %s", name)
+		}
+	}
+	return sqls, nil
+}
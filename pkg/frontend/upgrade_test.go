@@ -0,0 +1,43 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeMoCatalog(t *testing.T) {
+	ctx := context.Background()
+	exec := newInternalExecutorForTest()
+	exec.sql2result[getAccountIdsForUpgradeSql] = newMrsForAnalyze(
+		[]string{"account_id"}, [][]interface{}{{int32(0)}, {int32(1)}})
+
+	require.NoError(t, upgradeMoCatalog(ctx, exec))
+	// the tables of the sys account, then the ones of the account 1 except the sys only ones
+	expected := append([]string{}, upgradeTables...)
+	for _, name := range upgradeTables {
+		if !isSysOnlyTable(name) {
+			expected = append(expected, name)
+		}
+	}
+	require.Equal(t, len(expected), len(exec.executed))
+	for i, sql := range exec.executed {
+		require.True(t, strings.HasPrefix(sql, "create table if not exists mo_catalog."+expected[i]+"("), sql)
+	}
+}
@@ -303,7 +303,7 @@ func getValueFromVector(vec *vector.Vector, ses *Session, expr *plan2.Expr) (int
 		return vector.MustFixedCol[bool](vec)[0], nil
 	case types.T_int8:
 		return vector.MustFixedCol[int8](vec)[0], nil
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return vector.MustFixedCol[int16](vec)[0], nil
 	case types.T_int32:
 		return vector.MustFixedCol[int32](vec)[0], nil
@@ -311,11 +311,11 @@ func getValueFromVector(vec *vector.Vector, ses *Session, expr *plan2.Expr) (int
 		return vector.MustFixedCol[int64](vec)[0], nil
 	case types.T_uint8:
 		return vector.MustFixedCol[uint8](vec)[0], nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return vector.MustFixedCol[uint16](vec)[0], nil
 	case types.T_uint32:
 		return vector.MustFixedCol[uint32](vec)[0], nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return vector.MustFixedCol[uint64](vec)[0], nil
 	case types.T_float32:
 		return vector.MustFixedCol[float32](vec)[0], nil
@@ -381,6 +381,7 @@ func logStatementStatus(ctx context.Context, ses *Session, stmt tree.Statement,
 	} else {
 		stmtStr = stm.Statement
 	}
+	auditStatement(ctx, ses, stmt, err)
 	logStatementStringStatus(ctx, ses, stmtStr, status, err)
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
 var (
@@ -1218,6 +1219,31 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("tx_read_only", 0, 1, false),
 		Default:           int64(0),
 	},
+	"transaction_snapshot": {
+		Name:              "transaction_snapshot",
+		Scope:             ScopeSession,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("transaction_snapshot"),
+		Default:           "",
+		UpdateSessVar:     updateTransactionSnapshot,
+	},
+	"cte_max_recursion_depth": {
+		Name:              "cte_max_recursion_depth",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("cte_max_recursion_depth", 0, 4294967295, false),
+		Default:           int64(1000),
+	},
+	"ivfflat_probes": {
+		Name:              "ivfflat_probes",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              InitSystemVariableIntType("ivfflat_probes", 1, 65535, false),
+		Default:           int64(4),
+	},
 	"sql_select_limit": {
 		Name:              "sql_select_limit",
 		Scope:             ScopeBoth,
@@ -1267,6 +1293,63 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableStringType("cn_label"),
 		Default:           "",
 	},
+	"audit_log": {
+		Name:              "audit_log",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("audit_log"),
+		Default:           int8(0),
+	},
+	"audit_log_events": {
+		Name:              "audit_log_events",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_events"),
+		Default:           "connect,ddl,dcl",
+	},
+	"audit_log_accounts": {
+		Name:              "audit_log_accounts",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_accounts"),
+		Default:           "",
+	},
+	"audit_log_users": {
+		Name:              "audit_log_users",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_users"),
+		Default:           "",
+	},
+	"audit_log_statement": {
+		Name:              "audit_log_statement",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("audit_log_statement"),
+		Default:           int8(1),
+	},
+}
+
+// updateTransactionSnapshot sets the snapshot the transactions started later in the session
+// read at, they are read-only. The empty value makes them read the latest data again.
+func updateTransactionSnapshot(sess *Session, vars map[string]interface{}, name string, val interface{}) error {
+	value := val.(string)
+	if value == "" {
+		sess.setTxnSnapshotTS(nil)
+	} else {
+		ts, err := plan2.ParseSnapshotTS(sess.requestCtx, value, sess.GetTimeZone())
+		if err != nil {
+			return err
+		}
+		sess.setTxnSnapshotTS(&ts)
+	}
+	vars[name] = value
+	return nil
 }
 
 func updateTimeZone(sess *Session, vars map[string]interface{}, name string, val interface{}) error {
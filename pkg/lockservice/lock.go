@@ -15,6 +15,7 @@
 package lockservice
 
 import (
+	"bytes"
 	"fmt"
 
 	pb "github.com/matrixorigin/matrixone/pkg/pb/lock"
@@ -75,6 +76,69 @@ func (l Lock) isLockRangeStart() bool {
 	return l.value&flagLockRangeStart != 0
 }
 
+func (l Lock) isShared() bool {
+	return l.value&flagLockSharedMode != 0
+}
+
+// isHoldBy returns true if the txn is one of the holders of the lock
+func (l Lock) isHoldBy(txnID []byte) bool {
+	if len(l.holders) == 0 {
+		return bytes.Equal(l.txnID, txnID)
+	}
+	for _, v := range l.holders {
+		if bytes.Equal(v, txnID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l Lock) holdersCount() int {
+	if len(l.holders) == 0 {
+		return 1
+	}
+	return len(l.holders)
+}
+
+// addHolder returns a shared lock which is held by the txn too. The holders
+// is copied, because the old lock may be still read by others.
+func (l Lock) addHolder(txnID []byte) Lock {
+	holders := make([][]byte, 0, l.holdersCount()+1)
+	if len(l.holders) == 0 {
+		holders = append(holders, l.txnID)
+	} else {
+		holders = append(holders, l.holders...)
+	}
+	l.holders = append(holders, txnID)
+	return l
+}
+
+// removeHolder returns a shared lock which is not held by the txn anymore,
+// the first holder left is the txnID of the lock.
+func (l Lock) removeHolder(txnID []byte) Lock {
+	holders := make([][]byte, 0, l.holdersCount())
+	for _, v := range l.holders {
+		if !bytes.Equal(v, txnID) {
+			holders = append(holders, v)
+		}
+	}
+	if len(holders) == 0 {
+		return l
+	}
+	l.txnID = holders[0]
+	l.holders = nil
+	if len(holders) > 1 {
+		l.holders = holders
+	}
+	return l
+}
+
+func (l Lock) toExclusive() Lock {
+	l.value &^= flagLockSharedMode
+	l.value |= flagLockExclusiveMode
+	return l
+}
+
 func (l Lock) getLockMode() pb.LockMode {
 	if l.value&flagLockExclusiveMode != 0 {
 		return pb.LockMode_Exclusive
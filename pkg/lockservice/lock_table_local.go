@@ -129,6 +129,11 @@ func (l *localLockTable) unlock(
 
 	locks.iter(func(key []byte) bool {
 		if lock, ok := l.mu.store.Get(key); ok {
+			// other txns still hold the shared lock
+			if lock.holdersCount() > 1 {
+				l.removeHolderLocked(txn, key, lock, commitTS)
+				return true
+			}
 			if lock.isLockRow() || lock.isLockRangeEnd() {
 				lock.waiter.clearAllNotify(l.bind.ServiceID, "unlock")
 				next := lock.waiter.close(l.bind.ServiceID, notifyValue{ts: commitTS})
@@ -228,10 +233,29 @@ func (l *localLockTable) acquireRowLockLocked(
 			(bytes.Equal(key, row) ||
 				lock.isLockRangeEnd()) {
 			// current txn's lock
-			if bytes.Equal(txn.txnID, lock.txnID) {
-				if w != nil {
-					panic("BUG: can not has a waiter on self txn")
+			if lock.isHoldBy(txn.txnID) &&
+				(mode == pb.LockMode_Shared ||
+					!lock.isShared() ||
+					lock.holdersCount() == 1) {
+				if mode == pb.LockMode_Exclusive &&
+					lock.isShared() {
+					lock = l.upgradeLocked(key, lock)
 				}
+				l.mergeWaiterLocked(w, lock)
+				w = nil
+				continue
+			}
+			// shared locks are compatible, but a new shared lock must wait if
+			// others are waiting for the lock, otherwise the exclusive lock
+			// waiters will be starved. A woken waiter is the first waiter.
+			if !lock.isHoldBy(txn.txnID) &&
+				lock.isLockRow() &&
+				lock.isShared() &&
+				mode == pb.LockMode_Shared &&
+				(w != nil || lock.waiter.waiters.len() == 0) {
+				l.addHolderLocked(txn, [][]byte{key})
+				l.mergeWaiterLocked(w, lock)
+				w = nil
 				continue
 			}
 			w = getWaiter(l.bind.ServiceID, w, txn.txnID)
@@ -262,6 +286,10 @@ func (l *localLockTable) acquireRangeLockLocked(
 		}
 
 		logLocalLockRange(l.bind.ServiceID, txn, l.bind.Table, start, end, mode)
+		if l.acquireSameRangeLockLocked(w, txn, start, end, mode) {
+			w = nil
+			continue
+		}
 		w = getWaiter(l.bind.ServiceID, w, txn.txnID)
 
 		confilct, conflictWith := l.addRangeLockLocked(w, txn, start, end, mode)
@@ -300,7 +328,13 @@ func (l *localLockTable) handleLockConflictLocked(
 	// find conflict, and wait prev txn completed, and a new
 	// waiter added, we need to active deadlock check.
 	txn.setBlocked(w.txnID, w, true)
-	conflictWith.waiter.add(l.bind.ServiceID, w)
+	if conflictWith.isHoldBy(txn.txnID) {
+		// the txn holds the shared lock and waits to upgrade it, it must be the
+		// first waiter, otherwise it waits for the waiters which wait for it.
+		conflictWith.waiter.addFirst(l.bind.ServiceID, w)
+	} else {
+		conflictWith.waiter.add(l.bind.ServiceID, w)
+	}
 	if err := l.detector.check(
 		txn.toWaitTxn(
 			l.bind.ServiceID,
@@ -310,6 +344,126 @@ func (l *localLockTable) handleLockConflictLocked(
 	logLocalLockWaitOn(l.bind.ServiceID, txn, l.bind.Table, w, key, conflictWith)
 }
 
+// acquireSameRangeLockLocked handles the range lock which has the same range
+// as an existing range lock, the shared range locks are compatible. Returns
+// true if the lock is acquired.
+func (l *localLockTable) acquireSameRangeLockLocked(
+	w *waiter,
+	txn *activeTxn,
+	start, end []byte,
+	mode pb.LockMode) bool {
+	startLock, ok := l.mu.store.Get(start)
+	if !ok || !startLock.isLockRangeStart() {
+		return false
+	}
+	key, endLock, ok := l.mu.store.Seek(nextKey(start, nil))
+	if !ok ||
+		!bytes.Equal(key, end) ||
+		!endLock.isLockRangeEnd() {
+		return false
+	}
+
+	if endLock.isHoldBy(txn.txnID) {
+		if mode == pb.LockMode_Shared ||
+			!endLock.isShared() {
+			l.mergeWaiterLocked(w, endLock)
+			return true
+		}
+		if endLock.holdersCount() > 1 {
+			return false
+		}
+		endLock = l.upgradeLocked(end, endLock)
+		l.mergeWaiterLocked(w, endLock)
+		return true
+	}
+
+	if !endLock.isShared() ||
+		mode == pb.LockMode_Exclusive ||
+		(w == nil && endLock.waiter.waiters.len() > 0) {
+		return false
+	}
+	l.addHolderLocked(txn, [][]byte{start, end})
+	l.mergeWaiterLocked(w, endLock)
+	return true
+}
+
+// addHolderLocked adds the txn to the holders of the shared lock. keys is the
+// row, or the start and end of the range.
+func (l *localLockTable) addHolderLocked(
+	txn *activeTxn,
+	keys [][]byte) {
+	txn.lockAdded(l.bind.ServiceID, l.bind.Table, keys, true)
+	for _, key := range keys {
+		v, _ := l.mu.store.Get(key)
+		l.mu.store.Add(key, v.addHolder(txn.txnID))
+	}
+}
+
+// upgradeLocked upgrades the shared lock held only by the current txn to an
+// exclusive lock.
+func (l *localLockTable) upgradeLocked(key []byte, lock Lock) Lock {
+	lock = lock.toExclusive()
+	l.mu.store.Add(key, lock)
+	if lock.isLockRangeEnd() {
+		startKey := l.mustGetRangeStart(key)
+		startLock, _ := l.mu.store.Get(startKey)
+		l.mu.store.Add(startKey, startLock.toExclusive())
+	}
+	return lock
+}
+
+// mergeWaiterLocked moves all the waiters of w to the waiter of the lock. w is
+// the waiter of a woken txn, which acquires the lock by an existing lock rather
+// than adds a new lock with w.
+func (l *localLockTable) mergeWaiterLocked(w *waiter, lock Lock) {
+	if w == nil || w == lock.waiter {
+		return
+	}
+	lock.waiter.add(l.bind.ServiceID, w.waiters.all()...)
+	w.waiters.reset()
+	w.unref(l.bind.ServiceID)
+}
+
+// removeHolderLocked removes the txn from the holders of the shared lock. If
+// the only holder left is waiting to upgrade the lock, the first waiter will
+// be woken, and it must be the upgrading waiter since it's added in front of
+// all others.
+func (l *localLockTable) removeHolderLocked(
+	txn *activeTxn,
+	key []byte,
+	lock Lock,
+	commitTS timestamp.Timestamp) {
+	lock = lock.removeHolder(txn.txnID)
+	if lock.isLockRangeStart() ||
+		lock.holdersCount() > 1 {
+		l.mu.store.Add(key, lock)
+		return
+	}
+
+	// the waiters which have been completed (e.g. dead lock detected) are
+	// skipped when the lock is passed to the next waiter.
+	upgrading := false
+	lock.waiter.waiters.iter(func(txnID []byte) bool {
+		upgrading = bytes.Equal(txnID, lock.txnID)
+		return !upgrading
+	})
+	if upgrading {
+		// the waiters move to the woken waiter, and the lock needs a new waiter
+		old := lock.waiter
+		lock.waiter = acquireWaiter(l.bind.ServiceID, lock.txnID)
+		old.clearAllNotify(l.bind.ServiceID, "unlock shared")
+		next := old.close(l.bind.ServiceID, notifyValue{ts: commitTS})
+		logUnlockTableKeyOnLocal(l.bind.ServiceID, txn, l.bind, key, lock, next)
+		if lock.isLockRangeEnd() {
+			startKey := l.mustGetRangeStart(key)
+			startLock, _ := l.mu.store.Get(startKey)
+			startLock.waiter = lock.waiter
+			l.mu.store.Add(startKey, startLock)
+		}
+	}
+	l.mu.store.Add(key, lock)
+}
+
 func getWaiter(serviceID string, w *waiter, txnID []byte) *waiter {
 	if w != nil {
 		return w
@@ -334,11 +488,17 @@ func (l *localLockTable) addRangeLockLocked(
 		start,
 		upbound,
 		func(key []byte, keyLock Lock) bool {
-			if !bytes.Equal(keyLock.txnID, txn.txnID) {
+			// the shared locks held by others can not be merged
+			if !keyLock.isHoldBy(txn.txnID) ||
+				keyLock.holdersCount() > 1 {
 				conflictWith = keyLock
 				confilctKey = key
 				return false
 			}
+			// the merged lock can not be weaker than the current lock
+			if !keyLock.isShared() {
+				mode = pb.LockMode_Exclusive
+			}
 
 			if keyLock.isLockRangeStart() {
 				prevStartKey = key
@@ -295,6 +295,215 @@ func mustAddTestLock(t *testing.T,
 		granularity)
 }
 
+func TestSharedRowLock(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			shared := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Shared,
+				Policy:      pb.WaitPolicy_Wait,
+			}
+			exclusive := shared
+			exclusive.Mode = pb.LockMode_Exclusive
+
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+			txn3 := []byte("txn3")
+			txn4 := []byte("txn4")
+			row1 := []byte{1}
+
+			// shared locks are compatible
+			_, err := l.Lock(ctx, 0, [][]byte{row1}, txn1, shared)
+			require.NoError(t, err)
+			res, err := l.Lock(ctx, 0, [][]byte{row1}, txn2, shared)
+			require.NoError(t, err)
+			assert.False(t, res.HasConflict)
+
+			acquired := make(chan []byte, 2)
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, err := l.Lock(ctx, 0, [][]byte{row1}, txn3, exclusive)
+				require.NoError(t, err)
+				acquired <- txn3
+				require.NoError(t, l.Unlock(ctx, txn3, timestamp.Timestamp{}))
+			}()
+			waitWaiters(t, l, 0, row1, 1)
+
+			// the shared lock must wait for the exclusive lock waiter
+			go func() {
+				defer wg.Done()
+				_, err := l.Lock(ctx, 0, [][]byte{row1}, txn4, shared)
+				require.NoError(t, err)
+				acquired <- txn4
+				require.NoError(t, l.Unlock(ctx, txn4, timestamp.Timestamp{}))
+			}()
+			waitWaiters(t, l, 0, row1, 2)
+
+			require.NoError(t, l.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			waitWaiters(t, l, 0, row1, 2)
+			require.NoError(t, l.Unlock(ctx, txn2, timestamp.Timestamp{}))
+			wg.Wait()
+			assert.Equal(t, txn3, <-acquired)
+			assert.Equal(t, txn4, <-acquired)
+		},
+	)
+}
+
+func TestUpgradeSharedLock(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			shared := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Shared,
+				Policy:      pb.WaitPolicy_Wait,
+			}
+			exclusive := shared
+			exclusive.Mode = pb.LockMode_Exclusive
+
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+			txn3 := []byte("txn3")
+			row1 := []byte{1}
+			row2 := []byte{2}
+
+			// the only holder upgrades the lock immediately
+			_, err := l.Lock(ctx, 0, [][]byte{row2}, txn1, shared)
+			require.NoError(t, err)
+			_, err = l.Lock(ctx, 0, [][]byte{row2}, txn1, exclusive)
+			require.NoError(t, err)
+			checkLockMode(t, l, 0, row2, pb.LockMode_Exclusive)
+
+			_, err = l.Lock(ctx, 0, [][]byte{row1}, txn1, shared)
+			require.NoError(t, err)
+			_, err = l.Lock(ctx, 0, [][]byte{row1}, txn2, shared)
+			require.NoError(t, err)
+			go func() {
+				_, err := l.Lock(ctx, 0, [][]byte{row1}, txn3, exclusive)
+				require.NoError(t, err)
+				require.NoError(t, l.Unlock(ctx, txn3, timestamp.Timestamp{}))
+			}()
+			waitWaiters(t, l, 0, row1, 1)
+
+			// the upgrade waits for txn2, but goes before txn3
+			c := make(chan struct{})
+			go func() {
+				defer close(c)
+				_, err := l.Lock(ctx, 0, [][]byte{row1}, txn1, exclusive)
+				require.NoError(t, err)
+			}()
+			waitWaiters(t, l, 0, row1, 2)
+
+			require.NoError(t, l.Unlock(ctx, txn2, timestamp.Timestamp{}))
+			<-c
+			checkLockMode(t, l, 0, row1, pb.LockMode_Exclusive)
+			require.NoError(t, l.Unlock(ctx, txn1, timestamp.Timestamp{}))
+		},
+	)
+}
+
+func TestUpgradeSharedLockWithDeadLock(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			shared := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Shared,
+				Policy:      pb.WaitPolicy_Wait,
+			}
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+			row1 := []byte{1}
+
+			_, err := l.Lock(ctx, 0, [][]byte{row1}, txn1, shared)
+			require.NoError(t, err)
+			_, err = l.Lock(ctx, 0, [][]byte{row1}, txn2, shared)
+			require.NoError(t, err)
+
+			// both txns wait for each other to upgrade the lock
+			var wg sync.WaitGroup
+			wg.Add(2)
+			for _, txnID := range [][]byte{txn1, txn2} {
+				go func(txnID []byte) {
+					defer wg.Done()
+					maybeAddTestLockWithDeadlock(t, ctx, l, 0, txnID, [][]byte{row1},
+						pb.Granularity_Row)
+					require.NoError(t, l.Unlock(ctx, txnID, timestamp.Timestamp{}))
+				}(txnID)
+			}
+			wg.Wait()
+		},
+	)
+}
+
+func TestSharedRangeLock(t *testing.T) {
+	runLockServiceTests(
+		t,
+		[]string{"s1"},
+		func(alloc *lockTableAllocator, s []*service) {
+			l := s[0]
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+
+			shared := LockOptions{
+				Granularity: pb.Granularity_Range,
+				Mode:        pb.LockMode_Shared,
+				Policy:      pb.WaitPolicy_Wait,
+			}
+			exclusive := LockOptions{
+				Granularity: pb.Granularity_Row,
+				Mode:        pb.LockMode_Exclusive,
+				Policy:      pb.WaitPolicy_Wait,
+			}
+			txn1 := []byte("txn1")
+			txn2 := []byte("txn2")
+			txn3 := []byte("txn3")
+
+			_, err := l.Lock(ctx, 0, [][]byte{{1}, {3}}, txn1, shared)
+			require.NoError(t, err)
+			res, err := l.Lock(ctx, 0, [][]byte{{1}, {3}}, txn2, shared)
+			require.NoError(t, err)
+			assert.False(t, res.HasConflict)
+
+			c := make(chan struct{})
+			go func() {
+				defer close(c)
+				_, err := l.Lock(ctx, 0, [][]byte{{2}}, txn3, exclusive)
+				require.NoError(t, err)
+				require.NoError(t, l.Unlock(ctx, txn3, timestamp.Timestamp{}))
+			}()
+			waitWaiters(t, l, 0, []byte{3}, 1)
+
+			require.NoError(t, l.Unlock(ctx, txn1, timestamp.Timestamp{}))
+			select {
+			case <-c:
+				assert.Fail(t, "txn3 must wait for txn2")
+			case <-time.After(time.Millisecond * 100):
+			}
+			require.NoError(t, l.Unlock(ctx, txn2, timestamp.Timestamp{}))
+			<-c
+		},
+	)
+}
+
 func TestRangeLock(t *testing.T) {
 	runLockServiceTests(
 		t,
@@ -591,6 +800,22 @@ func runBenchmark(b *testing.B, name string, t uint64) {
 	})
 }
 
+func checkLockMode(
+	t *testing.T,
+	l *service,
+	table uint64,
+	key []byte,
+	mode pb.LockMode) {
+	v, err := l.getLockTable(table)
+	require.NoError(t, err)
+	found := false
+	v.getLock(nil, key, func(lock Lock) {
+		found = true
+		assert.Equal(t, mode, lock.getLockMode())
+	})
+	assert.True(t, found)
+}
+
 func maybeAddTestLockWithDeadlock(
 	t *testing.T,
 	ctx context.Context,
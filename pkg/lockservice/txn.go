@@ -213,6 +213,10 @@ func (txn *activeTxn) fetchWhoWaitingMe(
 				lockKey,
 				func(lock Lock) {
 					lock.waiter.waiters.iter(func(id []byte) bool {
+						// the txn waits to upgrade its own shared lock
+						if bytes.Equal(id, txnID) {
+							return true
+						}
 						if txn := holder.getActiveTxn(id, false, ""); txn != nil {
 							hasDeadLock = !waiters(txn.toWaitTxn(serviceID, bytes.Equal(txn.txnID, id)))
 							return !hasDeadLock
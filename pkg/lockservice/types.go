@@ -206,4 +206,7 @@ type Lock struct {
 	// all lock info will encode into this field to save memory overhead
 	value  byte
 	waiter *waiter
+	// holders is all the txns which hold a shared lock together, it's nil if
+	// only one txn holds the lock.
+	holders [][]byte
 }
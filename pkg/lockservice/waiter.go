@@ -143,6 +143,16 @@ func (w *waiter) add(
 	logWaitersAdded(serviceID, w, waiters...)
 }
 
+// addFirst adds a waiter in front of all waiters, it is used by the txn which
+// holds a shared lock and waits to upgrade it to an exclusive lock.
+func (w *waiter) addFirst(
+	serviceID string,
+	waiter *waiter) {
+	waiter.ref()
+	w.waiters.putFirst(waiter)
+	logWaitersAdded(serviceID, w, waiter)
+}
+
 func (w *waiter) moveTo(serviceID string, to *waiter) {
 	to.waiters.beginChange()
 	to.add(serviceID, w.waiters.all()...)
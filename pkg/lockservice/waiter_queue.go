@@ -23,6 +23,7 @@ type waiterQueue interface {
 	reset()
 	pop() (*waiter, []*waiter)
 	put(...*waiter)
+	putFirst(*waiter)
 	all() []*waiter
 	iter(func([]byte) bool)
 
@@ -75,6 +76,17 @@ func (q *sliceBasedWaiterQueue) put(w ...*waiter) {
 	}
 }
 
+func (q *sliceBasedWaiterQueue) putFirst(w *waiter) {
+	q.Lock()
+	defer q.Unlock()
+	if q.offset > 0 {
+		q.offset--
+		q.waiters[q.offset] = w
+		return
+	}
+	q.waiters = append([]*waiter{w}, q.waiters...)
+}
+
 func (q *sliceBasedWaiterQueue) iter(fn func([]byte) bool) {
 	q.RLock()
 	defer q.RUnlock()
@@ -269,7 +269,7 @@ func newClient(ctx context.Context, cfg ClientConfig) (*client, error) {
 
 func connectToLogServiceByReverseProxy(ctx context.Context,
 	discoveryAddress string, cfg ClientConfig) (*client, error) {
-	si, ok, err := GetShardInfo(discoveryAddress, cfg.LogShardID)
+	si, ok, err := getShardInfo(discoveryAddress, cfg.LogShardID, cfg.TLS)
 	if err != nil {
 		return nil, err
 	}
@@ -314,7 +314,7 @@ func connectToLogService(ctx context.Context,
 		addresses[i], addresses[j] = addresses[j], addresses[i]
 	})
 	for _, addr := range addresses {
-		cc, err := getRPCClient(ctx, addr, c.respPool, c.cfg.MaxMessageSize, cfg.EnableCompress, cfg.Tag)
+		cc, err := getRPCClient(ctx, addr, c.respPool, c.cfg.MaxMessageSize, cfg.EnableCompress, cfg.TLS, cfg.Tag)
 		if err != nil {
 			e = err
 			continue
@@ -511,6 +511,7 @@ func getRPCClient(
 	pool *sync.Pool,
 	maxMessageSize int,
 	enableCompress bool,
+	tlsCfg morpc.TLSConfig,
 	tag ...string) (morpc.RPCClient, error) {
 	mf := func() morpc.Message {
 		return pool.Get().(*RPCResponse)
@@ -523,6 +524,13 @@ func getRPCClient(
 		morpc.WithBackendLogger(logutil.GetGlobalLogger().Named("hakeeper-client-backend")),
 	}
 	backendOpts = append(backendOpts, GetBackendOptions(ctx)...)
+	if tlsCfg.Enable {
+		tc, err := tlsCfg.NewClientTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		backendOpts = append(backendOpts, morpc.WithBackendTLS(tc))
+	}
 
 	// construct morpc.ClientOption
 	clientOpts := []morpc.ClientOption{
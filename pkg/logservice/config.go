@@ -24,6 +24,7 @@ import (
 	"github.com/lni/vfs"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper"
 	"github.com/matrixorigin/matrixone/pkg/util/toml"
 )
@@ -115,6 +116,8 @@ type Config struct {
 		MaxMessageSize toml.ByteSize `toml:"max-message-size"`
 		// EnableCompress enable compress
 		EnableCompress bool `toml:"enable-compress"`
+		// TLS the mutual TLS config of the RPC server
+		TLS morpc.TLSConfig `toml:"tls"`
 	}
 
 	// BootstrapConfig is the configuration specified for the bootstrapping
@@ -293,6 +296,9 @@ func (c *Config) Validate() error {
 	if c.RPC.MaxMessageSize == 0 {
 		return moerr.NewBadConfigNoCtx("MaxMessageSize not set")
 	}
+	if err := c.RPC.TLS.Validate(); err != nil {
+		return err
+	}
 	// validate BootstrapConfig
 	if c.BootstrapConfig.BootstrapCluster {
 		if c.BootstrapConfig.NumOfLogShards == 0 {
@@ -394,6 +400,7 @@ func (c *Config) Fill() {
 	if c.RPC.MaxMessageSize == 0 {
 		c.RPC.MaxMessageSize = toml.ByteSize(defaultMaxMessageSize)
 	}
+	c.RPC.TLS.Adjust()
 }
 
 // HAKeeperClientConfig is the config for HAKeeper clients.
@@ -407,6 +414,8 @@ type HAKeeperClientConfig struct {
 	AllocateIDBatch uint64 `toml:"allocate-id-batch"`
 	// EnableCompress enable compress
 	EnableCompress bool `toml:"enable-compress"`
+	// TLS the mutual TLS config to connect to the Log Services
+	TLS morpc.TLSConfig `toml:"tls"`
 }
 
 // Validate validates the HAKeeperClientConfig.
@@ -417,7 +426,7 @@ func (c *HAKeeperClientConfig) Validate() error {
 	if c.AllocateIDBatch == 0 {
 		c.AllocateIDBatch = 100
 	}
-	return nil
+	return c.TLS.Validate()
 }
 
 // ClientConfig is the configuration for log service clients.
@@ -439,6 +448,8 @@ type ClientConfig struct {
 	MaxMessageSize int
 	// EnableCompress enable compress
 	EnableCompress bool
+	// TLS the mutual TLS config to connect to the Log Services
+	TLS morpc.TLSConfig
 }
 
 // Validate validates the ClientConfig.
@@ -452,7 +463,7 @@ func (c *ClientConfig) Validate() error {
 	if len(c.DiscoveryAddress) == 0 && len(c.ServiceAddresses) == 0 {
 		return moerr.NewBadConfigNoCtx("ServiceAddresses not set")
 	}
-	return nil
+	return c.TLS.Validate()
 }
 
 func splitAddresses(v string) []string {
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper"
 )
 
@@ -263,6 +264,12 @@ func TestHAKeeperClientConfigValidate(t *testing.T) {
 				ServiceAddresses: []string{"localhost:9090"},
 			}, true,
 		},
+		{
+			HAKeeperClientConfig{
+				ServiceAddresses: []string{"localhost:9090"},
+				TLS:              morpc.TLSConfig{Enable: true, CertFile: "cert", KeyFile: "key"},
+			}, false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -438,7 +438,7 @@ func newHAKeeperClient(ctx context.Context,
 
 func connectByReverseProxy(ctx context.Context,
 	discoveryAddress string, cfg HAKeeperClientConfig) (*hakeeperClient, error) {
-	si, ok, err := GetShardInfo(discoveryAddress, hakeeper.DefaultHAKeeperShardID)
+	si, ok, err := getShardInfo(discoveryAddress, hakeeper.DefaultHAKeeperShardID, cfg.TLS)
 	if err != nil {
 		return nil, err
 	}
@@ -483,7 +483,7 @@ func connectToHAKeeper(ctx context.Context,
 		addresses[i], addresses[j] = addresses[j], addresses[i]
 	})
 	for _, addr := range addresses {
-		cc, err := getRPCClient(ctx, addr, c.respPool, defaultMaxMessageSize, cfg.EnableCompress, "connectToHAKeeper")
+		cc, err := getRPCClient(ctx, addr, c.respPool, defaultMaxMessageSize, cfg.EnableCompress, cfg.TLS, "connectToHAKeeper")
 		if err != nil {
 			e = err
 			continue
@@ -138,9 +138,7 @@ func NewService(
 		codecOpts = append(codecOpts, morpc.WithCodecEnableCompress(mp))
 	}
 
-	// TODO: check and fix all these magic numbers
-	codec := morpc.NewMessageCodec(mf, codecOpts...)
-	server, err := morpc.NewRPCServer(LogServiceRPCName, cfg.ServiceListenAddress, codec,
+	serverOpts := []morpc.ServerOption{
 		morpc.WithServerGoettyOptions(goetty.WithSessionReleaseMsgFunc(func(i interface{}) {
 			msg := i.(morpc.RPCMessage)
 			if !msg.InternalMessage() {
@@ -148,7 +146,18 @@ func NewService(
 			}
 		})),
 		morpc.WithServerLogger(service.runtime.Logger().RawLogger()),
-	)
+	}
+	if cfg.RPC.TLS.Enable {
+		tc, err := cfg.RPC.TLS.NewServerTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts, morpc.WithServerTLS(tc))
+	}
+
+	// TODO: check and fix all these magic numbers
+	codec := morpc.NewMessageCodec(mf, codecOpts...)
+	server, err := morpc.NewRPCServer(LogServiceRPCName, cfg.ServiceListenAddress, codec, serverOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -25,6 +25,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 )
 
@@ -39,13 +40,17 @@ type ShardInfo struct {
 // address is usually the reverse proxy that randomly redirect the request to
 // a known Log Service node.
 func GetShardInfo(address string, shardID uint64) (ShardInfo, bool, error) {
+	return getShardInfo(address, shardID, morpc.TLSConfig{})
+}
+
+func getShardInfo(address string, shardID uint64, tlsCfg morpc.TLSConfig) (ShardInfo, bool, error) {
 	respPool := &sync.Pool{}
 	respPool.New = func() interface{} {
 		return &RPCResponse{pool: respPool}
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
-	cc, err := getRPCClient(ctx, address, respPool, defaultMaxMessageSize, false, "GetShardInfo")
+	cc, err := getRPCClient(ctx, address, respPool, defaultMaxMessageSize, false, tlsCfg, "GetShardInfo")
 	if err != nil {
 		return ShardInfo{}, false, err
 	}
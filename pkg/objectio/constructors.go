@@ -36,13 +36,13 @@ func constructorFactory(size int64, algo uint8, noHeaderHint bool) CacheConstruc
 			}
 
 			// no compress
-			if algo == 0 {
+			if algo == compress.None {
 				return data, int64(len(data)), nil
 			}
 
-			// lz4 compress
+			// the algorithm is recorded in the extent
 			decompressed := make([]byte, size)
-			decompressed, err = compress.Decompress(data, decompressed, compress.Lz4)
+			decompressed, err = compress.Decompress(data, decompressed, int(algo))
 			if err != nil {
 				return nil, 0, err
 			}
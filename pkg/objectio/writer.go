@@ -21,7 +21,6 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
-	"github.com/pierrec/lz4/v4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -39,6 +38,10 @@ type objectWriterV1 struct {
 	lastId      uint32
 	name        ObjectName
 	compressBuf []byte
+	// the algorithm and the level to compress the column data,
+	// the metadata is always compressed by lz4 for it's read frequently
+	compressType  compress.T
+	compressLevel int
 }
 
 type blockData struct {
@@ -79,6 +82,8 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 		buffer:   NewObjectBuffer(fileName),
 		blocks:   make([]blockData, 0),
 		lastId:   0,
+
+		compressType: compress.Lz4,
 	}
 	return writer, nil
 }
@@ -93,6 +98,8 @@ func newObjectWriterV1(name ObjectName, fs fileservice.FileService) (*objectWrit
 		buffer:   NewObjectBuffer(fileName),
 		blocks:   make([]blockData, 0),
 		lastId:   0,
+
+		compressType: compress.Lz4,
 	}
 	return writer, nil
 }
@@ -337,20 +344,36 @@ func (w *objectWriterV1) Sync(ctx context.Context, items ...WriteOptions) error
 	return err
 }
 
+// SetCompress sets the algorithm to compress the column data, the level is only used by zstd.
+func (w *objectWriterV1) SetCompress(typ compress.T, level int) {
+	w.compressType = typ
+	w.compressLevel = level
+}
+
 func (w *objectWriterV1) WriteWithCompress(offset uint32, buf []byte) (data []byte, extent Extent, err error) {
-	var tmpData []byte
+	return w.writeWithCompress(offset, buf, compress.Lz4, 0)
+}
+
+func (w *objectWriterV1) writeWithCompress(offset uint32, buf []byte, typ compress.T, level int) (data []byte, extent Extent, err error) {
 	dataLen := len(buf)
-	compressBlockBound := lz4.CompressBlockBound(dataLen)
+	if typ == compress.None {
+		data = make([]byte, dataLen)
+		copy(data, buf)
+		extent = NewExtent(compress.None, offset, uint32(dataLen), uint32(dataLen))
+		return
+	}
+	var tmpData []byte
+	compressBlockBound := compress.CompressBlockBound(dataLen, int(typ))
 	if len(w.compressBuf) < compressBlockBound {
 		w.compressBuf = make([]byte, compressBlockBound)
 	}
-	if tmpData, err = compress.Compress(buf, w.compressBuf[:compressBlockBound], compress.Lz4); err != nil {
+	if tmpData, err = compress.CompressWithLevel(buf, w.compressBuf[:compressBlockBound], int(typ), level); err != nil {
 		return
 	}
 	length := uint32(len(tmpData))
 	data = make([]byte, length)
 	copy(data, tmpData[:length])
-	extent = NewExtent(compress.Lz4, offset, length, uint32(dataLen))
+	extent = NewExtent(uint8(typ), offset, length, uint32(dataLen))
 	return
 }
 
@@ -373,7 +396,7 @@ func (w *objectWriterV1) AddBlock(blockMeta BlockObject, bat *batch.Batch) error
 			return err
 		}
 		var ext Extent
-		if data, ext, err = w.WriteWithCompress(0, buf.Bytes()); err != nil {
+		if data, ext, err = w.writeWithCompress(0, buf.Bytes(), w.compressType, w.compressLevel); err != nil {
 			return err
 		}
 		block.data = append(block.data, data)
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -301,3 +302,43 @@ func newBatch2(mp *mpool.MPool) *batch.Batch {
 	}
 	return testutil.NewBatch(types, false, int(40000*2), mp)
 }
+
+func TestObjectWriterCompress(t *testing.T) {
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(c, nil)
+	assert.Nil(t, err)
+
+	for _, typ := range []compress.T{compress.None, compress.Lz4, compress.Zstd, compress.Snappy} {
+		name := fmt.Sprintf("%s.blk", typ.String())
+		objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+		assert.Nil(t, err)
+		objectWriter.SetCompress(typ, compress.DefaultZstdLevel)
+		_, err = objectWriter.Write(bat)
+		assert.Nil(t, err)
+		blocks, err := objectWriter.WriteEnd(context.Background())
+		assert.Nil(t, err)
+
+		// the codec is recorded in the extent of every column
+		col, err := blocks[0].GetColumn(0)
+		assert.Nil(t, err)
+		assert.Equal(t, uint8(typ), col.Location().Alg())
+
+		objectReader, err := NewObjectReaderWithStr(name, service)
+		assert.Nil(t, err)
+		extent := blocks[0].GetExtent()
+		objectReader.CacheMetaExtent(&extent)
+		vec, err := objectReader.ReadOneBlock(context.Background(), []uint16{0, 3}, 0, mp)
+		assert.Nil(t, err)
+		assert.Equal(t, int8(3), vector.MustFixedCol[int8](vec.Entries[0].Object.(*vector.Vector))[3])
+		assert.Equal(t, int64(3), vector.GetFixedAt[int64](vec.Entries[1].Object.(*vector.Vector), 3))
+	}
+}
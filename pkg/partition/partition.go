@@ -51,7 +51,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		var n bool
 		var v int16
 
@@ -220,7 +220,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		var n bool
 		var v uint16
 
@@ -268,7 +268,7 @@ func Partition(sels []int64, diffs []bool, partitions []int64, vec *vector.Vecto
 			diffs[i] = diffs[i] || (v != w)
 			v = w
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		var n bool
 		var v uint64
 
@@ -54,6 +54,11 @@ const (
 	CmdMethod_SyncCommit CmdMethod = 9
 	// GetCommit get latest commit timestamp of cn.
 	CmdMethod_GetCommit CmdMethod = 10
+	// Backup backs up the data of the cluster, an account or a database.
+	CmdMethod_Backup CmdMethod = 11
+	// HistoryRetention gets how long the DN keeps the history data for the reads at
+	// past timestamps.
+	CmdMethod_HistoryRetention CmdMethod = 12
 )
 
 var CmdMethod_name = map[int32]string{
@@ -68,20 +73,24 @@ var CmdMethod_name = map[int32]string{
 	8:  "Label",
 	9:  "SyncCommit",
 	10: "GetCommit",
+	11: "Backup",
+	12: "HistoryRetention",
 }
 
 var CmdMethod_value = map[string]int32{
-	"Ping":        0,
-	"Flush":       1,
-	"Task":        2,
-	"Checkpoint":  3,
-	"UseSnapshot": 4,
-	"GetSnapshot": 5,
-	"ForceGC":     6,
-	"Inspect":     7,
-	"Label":       8,
-	"SyncCommit":  9,
-	"GetCommit":   10,
+	"Ping":             0,
+	"Flush":            1,
+	"Task":             2,
+	"Checkpoint":       3,
+	"UseSnapshot":      4,
+	"GetSnapshot":      5,
+	"ForceGC":          6,
+	"Inspect":          7,
+	"Label":            8,
+	"SyncCommit":       9,
+	"GetCommit":        10,
+	"Backup":           11,
+	"HistoryRetention": 12,
 }
 
 func (x CmdMethod) String() string {
@@ -628,45 +637,45 @@ func init() {
 func init() { proto.RegisterFile("ctl.proto", fileDescriptor_0646114e50303026) }
 
 var fileDescriptor_0646114e50303026 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xe7, 0x2d, 0x5d, 0x97, 0xb7, 0xff, 0x65, 0x9e, 0x35, 0xfd, 0xa9, 0x26, 0x54, 0xa6,
-	0x1c, 0xd0, 0x84, 0xb6, 0x16, 0x8d, 0x1b, 0x02, 0x24, 0x96, 0xb0, 0xa9, 0xd2, 0x36, 0xa1, 0x64,
-	0x08, 0xb1, 0x5b, 0x9a, 0x9a, 0x24, 0x6a, 0x12, 0x07, 0xdb, 0x41, 0xec, 0xdb, 0x70, 0xe6, 0x93,
-	0xec, 0xc6, 0x3e, 0x01, 0x82, 0x5e, 0xf8, 0x1a, 0x28, 0x4e, 0xda, 0x84, 0xf6, 0x00, 0x48, 0xbb,
-	0xf9, 0x7d, 0xfc, 0xbc, 0x6f, 0x9e, 0x9f, 0xad, 0x18, 0x74, 0x5f, 0xc6, 0xfd, 0x8c, 0x33, 0xc9,
-	0xc8, 0x9a, 0x2f, 0xe3, 0xdd, 0xc3, 0x20, 0x92, 0x61, 0x3e, 0xea, 0xfb, 0x2c, 0x19, 0x04, 0x2c,
-	0x60, 0x03, 0xb5, 0x37, 0xca, 0xdf, 0xab, 0x4a, 0x15, 0x6a, 0x55, 0xf6, 0xec, 0x6e, 0xc9, 0x28,
-	0xa1, 0x42, 0x7a, 0x49, 0x56, 0x0a, 0xe6, 0x21, 0x6c, 0xda, 0x17, 0xaf, 0xa3, 0x34, 0x70, 0xe8,
-	0x87, 0x9c, 0x0a, 0x49, 0xee, 0x83, 0x9e, 0x79, 0xdc, 0x4b, 0xa8, 0xa4, 0xbc, 0x8b, 0xf6, 0xd0,
-	0xbe, 0xee, 0xd4, 0x82, 0xf9, 0x05, 0x81, 0x31, 0xf3, 0x8b, 0x8c, 0xa5, 0x82, 0x92, 0x2e, 0xb4,
-	0x85, 0x64, 0x9c, 0x0e, 0xed, 0xca, 0x3e, 0x2b, 0xc9, 0x43, 0x30, 0x04, 0xe5, 0x1f, 0x23, 0x9f,
-	0xbe, 0x1c, 0x8f, 0x39, 0x15, 0xa2, 0xbb, 0xaa, 0x0c, 0x0b, 0xaa, 0x9a, 0x10, 0x7a, 0x7c, 0x3c,
-	0xb4, 0xbb, 0x6b, 0x7b, 0x68, 0x5f, 0x73, 0x66, 0x65, 0x11, 0x86, 0xd3, 0x2c, 0x8e, 0x7c, 0x6f,
-	0x68, 0x77, 0x35, 0xb5, 0x57, 0x0b, 0xa4, 0x07, 0x10, 0xb3, 0xc0, 0xad, 0x5a, 0x5b, 0x6a, 0xbb,
-	0xa1, 0x98, 0x8f, 0x01, 0xdb, 0x17, 0xae, 0xe4, 0xcd, 0xb4, 0x6a, 0xa2, 0xcc, 0x79, 0xea, 0xca,
-	0x39, 0xde, 0x5c, 0x30, 0xbf, 0x22, 0x68, 0x37, 0x0e, 0xa2, 0x5a, 0x56, 0x64, 0x9a, 0x53, 0x0b,
-	0xe4, 0x00, 0x74, 0xeb, 0xdc, 0x3e, 0xa7, 0x32, 0x64, 0x63, 0x85, 0x65, 0x1c, 0x19, 0xfd, 0xe2,
-	0x6e, 0xac, 0x64, 0x5c, 0xaa, 0x4e, 0x6d, 0x20, 0xcf, 0x00, 0xdc, 0x6b, 0x3f, 0xb5, 0x58, 0x92,
-	0x44, 0x52, 0x41, 0x76, 0x8e, 0xfe, 0x57, 0x76, 0xf7, 0x3a, 0xf5, 0x4b, 0xb9, 0x9a, 0x7d, 0xac,
-	0xdd, 0x7c, 0x7b, 0xb0, 0xe2, 0x34, 0xfc, 0xe4, 0x29, 0xe8, 0xa7, 0x54, 0x56, 0xcd, 0xda, 0x5f,
-	0x34, 0xd7, 0x76, 0xf3, 0x27, 0x82, 0x8d, 0x26, 0xfc, 0x9d, 0x21, 0xed, 0x40, 0xeb, 0x15, 0xe7,
-	0x8c, 0x2b, 0x9a, 0xff, 0x9c, 0xb2, 0x20, 0xcf, 0x7f, 0x03, 0x2d, 0xb3, 0xde, 0x5b, 0xca, 0x5a,
-	0xc6, 0xf9, 0x13, 0x69, 0xab, 0x41, 0x3a, 0x57, 0x17, 0x9a, 0x1b, 0xa4, 0x6f, 0x61, 0x7b, 0xe9,
-	0x3c, 0xc8, 0x31, 0x18, 0x67, 0x9e, 0xa4, 0xa2, 0x32, 0x5d, 0xba, 0x0a, 0xbb, 0x73, 0xb4, 0xd3,
-	0xaf, 0x7f, 0x84, 0xcb, 0xd9, 0xaa, 0x9a, 0xb9, 0xd0, 0x61, 0x5e, 0x01, 0x59, 0x0e, 0x4f, 0x6c,
-	0xd8, 0xb2, 0x72, 0xce, 0x69, 0xfa, 0x2f, 0xa3, 0x17, 0x5b, 0x4c, 0x02, 0xb8, 0x81, 0xa6, 0x32,
-	0x9b, 0xef, 0x60, 0x7b, 0x09, 0xf7, 0x6e, 0x3e, 0xf7, 0xe8, 0x33, 0x02, 0x7d, 0x7e, 0x9b, 0x64,
-	0x03, 0xb4, 0xe2, 0x4f, 0xc6, 0x2b, 0x44, 0x87, 0xd6, 0x49, 0x9c, 0x8b, 0x10, 0xa3, 0x42, 0xbc,
-	0xf4, 0xc4, 0x04, 0xaf, 0x12, 0x03, 0xc0, 0x0a, 0xa9, 0x3f, 0xc9, 0x58, 0x94, 0x4a, 0xbc, 0x46,
-	0xb6, 0xa0, 0xf3, 0x46, 0x50, 0x37, 0xf5, 0x32, 0x11, 0x32, 0x89, 0xb5, 0x42, 0x38, 0xa5, 0x72,
-	0x2e, 0xb4, 0x48, 0x07, 0xda, 0x27, 0x8c, 0xfb, 0xf4, 0xd4, 0xc2, 0xeb, 0x45, 0x31, 0x4c, 0x45,
-	0x46, 0x7d, 0x89, 0xdb, 0xc5, 0x07, 0xce, 0xbc, 0x11, 0x8d, 0xf1, 0x46, 0x31, 0xb6, 0x3e, 0x4e,
-	0xac, 0x93, 0xcd, 0xc6, 0x9d, 0x63, 0x38, 0x7e, 0x71, 0xfb, 0xa3, 0x87, 0x6e, 0xa6, 0x3d, 0x74,
-	0x3b, 0xed, 0xa1, 0xef, 0xd3, 0x1e, 0xba, 0x3a, 0x68, 0x3c, 0x71, 0x89, 0x27, 0x79, 0xf4, 0x89,
-	0xf1, 0x28, 0x88, 0xd2, 0x59, 0x91, 0xd2, 0x41, 0x36, 0x09, 0x06, 0xd9, 0x68, 0xe0, 0xcb, 0x78,
-	0xb4, 0xae, 0xde, 0xb5, 0x27, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x91, 0x8e, 0xeb, 0x7e, 0x29,
-	0x05, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xd1, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc6, 0xe7, 0x2d, 0x5d, 0x97, 0xd3, 0xff, 0x3a, 0xcf, 0x9a, 0xfe, 0x54, 0x13, 0x2a, 0x53,
+	0x2e, 0xd0, 0x84, 0xb6, 0x16, 0x8d, 0x3b, 0x04, 0x48, 0xb4, 0x61, 0x53, 0xa5, 0x6d, 0x42, 0xc9,
+	0x10, 0x62, 0x77, 0xa9, 0x6b, 0x92, 0xa8, 0x49, 0x1c, 0x6c, 0x07, 0xb1, 0x57, 0x42, 0x3c, 0xc8,
+	0xee, 0xd8, 0x13, 0x20, 0xd8, 0x0d, 0xaf, 0x81, 0xe2, 0xa4, 0x4d, 0x68, 0x2f, 0x00, 0x69, 0x77,
+	0x3e, 0x9f, 0xbf, 0x73, 0xf2, 0xfd, 0x6c, 0xc5, 0x60, 0x52, 0x15, 0xf5, 0x52, 0xc1, 0x15, 0x27,
+	0x6b, 0x54, 0x45, 0xbb, 0x87, 0x7e, 0xa8, 0x82, 0x6c, 0xdc, 0xa3, 0x3c, 0xee, 0xfb, 0xdc, 0xe7,
+	0x7d, 0xbd, 0x37, 0xce, 0xde, 0xeb, 0x4a, 0x17, 0x7a, 0x55, 0xf4, 0xec, 0x6e, 0xa9, 0x30, 0x66,
+	0x52, 0x79, 0x71, 0x5a, 0x08, 0xd6, 0x21, 0x6c, 0xda, 0xe7, 0xaf, 0xc3, 0xc4, 0x77, 0xd8, 0x87,
+	0x8c, 0x49, 0x45, 0xee, 0x83, 0x99, 0x7a, 0xc2, 0x8b, 0x99, 0x62, 0xa2, 0x83, 0xf6, 0xd0, 0xbe,
+	0xe9, 0x54, 0x82, 0xf5, 0x19, 0x41, 0x7b, 0xe6, 0x97, 0x29, 0x4f, 0x24, 0x23, 0x1d, 0x68, 0x4a,
+	0xc5, 0x05, 0x1b, 0xd9, 0xa5, 0x7d, 0x56, 0x92, 0x87, 0xd0, 0x96, 0x4c, 0x7c, 0x0c, 0x29, 0x7b,
+	0x39, 0x99, 0x08, 0x26, 0x65, 0x67, 0x55, 0x1b, 0x16, 0x54, 0x3d, 0x21, 0xf0, 0xc4, 0x64, 0x64,
+	0x77, 0xd6, 0xf6, 0xd0, 0xbe, 0xe1, 0xcc, 0xca, 0x3c, 0x8c, 0x60, 0x69, 0x14, 0x52, 0x6f, 0x64,
+	0x77, 0x0c, 0xbd, 0x57, 0x09, 0xa4, 0x0b, 0x10, 0x71, 0xdf, 0x2d, 0x5b, 0x1b, 0x7a, 0xbb, 0xa6,
+	0x58, 0x8f, 0x01, 0xdb, 0xe7, 0xae, 0x12, 0xf5, 0xb4, 0x7a, 0xa2, 0xca, 0x44, 0xe2, 0xaa, 0x39,
+	0xde, 0x5c, 0xb0, 0xbe, 0x22, 0x68, 0xd6, 0x0e, 0xa2, 0x5c, 0x96, 0x64, 0x86, 0x53, 0x09, 0xe4,
+	0x00, 0xcc, 0xe1, 0x99, 0x7d, 0xc6, 0x54, 0xc0, 0x27, 0x1a, 0xab, 0x7d, 0xd4, 0xee, 0xe5, 0x77,
+	0x33, 0x8c, 0x27, 0x85, 0xea, 0x54, 0x06, 0xf2, 0x0c, 0xc0, 0xbd, 0xa2, 0xc9, 0x90, 0xc7, 0x71,
+	0xa8, 0x34, 0x64, 0xeb, 0xe8, 0x7f, 0x6d, 0x77, 0xaf, 0x12, 0x5a, 0xc8, 0xe5, 0xec, 0x81, 0x71,
+	0xfd, 0xed, 0xc1, 0x8a, 0x53, 0xf3, 0x93, 0xa7, 0x60, 0x9e, 0x30, 0x55, 0x36, 0x1b, 0x7f, 0xd1,
+	0x5c, 0xd9, 0xad, 0x9f, 0x08, 0x36, 0xea, 0xf0, 0x77, 0x86, 0xb4, 0x03, 0x8d, 0x57, 0x42, 0x70,
+	0xa1, 0x69, 0xfe, 0x73, 0x8a, 0x82, 0x3c, 0xff, 0x0d, 0xb4, 0xc8, 0x7a, 0x6f, 0x29, 0x6b, 0x11,
+	0xe7, 0x4f, 0xa4, 0x8d, 0x1a, 0xe9, 0x5c, 0x5d, 0x68, 0xae, 0x91, 0xbe, 0x85, 0xed, 0xa5, 0xf3,
+	0x20, 0x03, 0x68, 0x9f, 0x7a, 0x8a, 0xc9, 0xd2, 0x74, 0xe1, 0x6a, 0xec, 0xd6, 0xd1, 0x4e, 0xaf,
+	0xfa, 0x11, 0x2e, 0x66, 0xab, 0x72, 0xe6, 0x42, 0x87, 0x75, 0x09, 0x64, 0x39, 0x3c, 0xb1, 0x61,
+	0x6b, 0x98, 0x09, 0xc1, 0x92, 0x7f, 0x19, 0xbd, 0xd8, 0x62, 0x11, 0xc0, 0x35, 0x34, 0x9d, 0xd9,
+	0x7a, 0x07, 0xdb, 0x4b, 0xb8, 0x77, 0xf3, 0xb9, 0x47, 0x5f, 0x10, 0x98, 0xf3, 0xdb, 0x24, 0x1b,
+	0x60, 0xe4, 0x7f, 0x32, 0x5e, 0x21, 0x26, 0x34, 0x8e, 0xa3, 0x4c, 0x06, 0x18, 0xe5, 0xe2, 0x85,
+	0x27, 0xa7, 0x78, 0x95, 0xb4, 0x01, 0x86, 0x01, 0xa3, 0xd3, 0x94, 0x87, 0x89, 0xc2, 0x6b, 0x64,
+	0x0b, 0x5a, 0x6f, 0x24, 0x73, 0x13, 0x2f, 0x95, 0x01, 0x57, 0xd8, 0xc8, 0x85, 0x13, 0xa6, 0xe6,
+	0x42, 0x83, 0xb4, 0xa0, 0x79, 0xcc, 0x05, 0x65, 0x27, 0x43, 0xbc, 0x9e, 0x17, 0xa3, 0x44, 0xa6,
+	0x8c, 0x2a, 0xdc, 0xcc, 0x3f, 0x70, 0xea, 0x8d, 0x59, 0x84, 0x37, 0xf2, 0xb1, 0xd5, 0x71, 0x62,
+	0x93, 0x6c, 0xd6, 0xee, 0x1c, 0x03, 0x01, 0x58, 0x1f, 0x78, 0x74, 0x9a, 0xa5, 0xb8, 0x35, 0x78,
+	0x71, 0xf3, 0xa3, 0x8b, 0xae, 0x6f, 0xbb, 0xe8, 0xe6, 0xb6, 0x8b, 0xbe, 0xdf, 0x76, 0xd1, 0xe5,
+	0x41, 0xed, 0xb9, 0x8b, 0x3d, 0x25, 0xc2, 0x4f, 0x5c, 0x84, 0x7e, 0x98, 0xcc, 0x8a, 0x84, 0xf5,
+	0xd3, 0xa9, 0xdf, 0x4f, 0xc7, 0x7d, 0xaa, 0xa2, 0xf1, 0xba, 0x7e, 0xe3, 0x9e, 0xfc, 0x1a, 0x00,
+	0xb0, 0x94, 0x83, 0x51, 0x35, 0x05, 0x00, 0x00,
 }
 
 func (m *DNPingRequest) Marshal() (dAtA []byte, err error) {
@@ -54,7 +54,7 @@ func (x Pipeline_PipelineType) String() string {
 }
 
 func (Pipeline_PipelineType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{35, 0}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{36, 0}
 }
 
 type Message struct {
@@ -539,6 +539,61 @@ func (m *Group) GetMultiAggs() []*MultiArguemnt {
 	return nil
 }
 
+type Window struct {
+	Spec                 *plan.WindowSpec `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	WinFuncs             []*plan.Expr     `protobuf:"bytes,2,rep,name=win_funcs,json=winFuncs,proto3" json:"win_funcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Window) Reset()         { *m = Window{} }
+func (m *Window) String() string { return proto.CompactTextString(m) }
+func (*Window) ProtoMessage()    {}
+func (*Window) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac67a7adf3df9c7, []int{6}
+}
+func (m *Window) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Window) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Window.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Window) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Window.Merge(m, src)
+}
+func (m *Window) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Window) XXX_DiscardUnknown() {
+	xxx_messageInfo_Window.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Window proto.InternalMessageInfo
+
+func (m *Window) GetSpec() *plan.WindowSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *Window) GetWinFuncs() []*plan.Expr {
+	if m != nil {
+		return m.WinFuncs
+	}
+	return nil
+}
+
 type Insert struct {
 	Affected uint64 `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
 	IsRemote bool   `protobuf:"varint,2,opt,name=IsRemote,proto3" json:"IsRemote,omitempty"`
@@ -557,7 +612,7 @@ func (m *Insert) Reset()         { *m = Insert{} }
 func (m *Insert) String() string { return proto.CompactTextString(m) }
 func (*Insert) ProtoMessage()    {}
 func (*Insert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{6}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{7}
 }
 func (m *Insert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -646,7 +701,7 @@ func (m *Array) Reset()         { *m = Array{} }
 func (m *Array) String() string { return proto.CompactTextString(m) }
 func (*Array) ProtoMessage()    {}
 func (*Array) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{7}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{8}
 }
 func (m *Array) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +748,7 @@ func (m *Map) Reset()         { *m = Map{} }
 func (m *Map) String() string { return proto.CompactTextString(m) }
 func (*Map) ProtoMessage()    {}
 func (*Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{8}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{9}
 }
 func (m *Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +810,7 @@ func (m *Deletion) Reset()         { *m = Deletion{} }
 func (m *Deletion) String() string { return proto.CompactTextString(m) }
 func (*Deletion) ProtoMessage()    {}
 func (*Deletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{9}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{10}
 }
 func (m *Deletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +957,7 @@ func (m *PreInsert) Reset()         { *m = PreInsert{} }
 func (m *PreInsert) String() string { return proto.CompactTextString(m) }
 func (*PreInsert) ProtoMessage()    {}
 func (*PreInsert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{10}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{11}
 }
 func (m *PreInsert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -967,7 +1022,7 @@ func (m *OnDuplicateKey) Reset()         { *m = OnDuplicateKey{} }
 func (m *OnDuplicateKey) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKey) ProtoMessage()    {}
 func (*OnDuplicateKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{11}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{12}
 }
 func (m *OnDuplicateKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1049,7 +1104,7 @@ func (m *Join) Reset()         { *m = Join{} }
 func (m *Join) String() string { return proto.CompactTextString(m) }
 func (*Join) ProtoMessage()    {}
 func (*Join) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{12}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{13}
 }
 func (m *Join) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,7 +1206,7 @@ func (m *AntiJoin) Reset()         { *m = AntiJoin{} }
 func (m *AntiJoin) String() string { return proto.CompactTextString(m) }
 func (*AntiJoin) ProtoMessage()    {}
 func (*AntiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{13}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{14}
 }
 func (m *AntiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,7 +1302,7 @@ func (m *InnerJoin) Reset()         { *m = InnerJoin{} }
 func (m *InnerJoin) String() string { return proto.CompactTextString(m) }
 func (*InnerJoin) ProtoMessage()    {}
 func (*InnerJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{14}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{15}
 }
 func (m *InnerJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1350,7 +1405,7 @@ func (m *LeftJoin) Reset()         { *m = LeftJoin{} }
 func (m *LeftJoin) String() string { return proto.CompactTextString(m) }
 func (*LeftJoin) ProtoMessage()    {}
 func (*LeftJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{15}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{16}
 }
 func (m *LeftJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1454,7 +1509,7 @@ func (m *RightJoin) Reset()         { *m = RightJoin{} }
 func (m *RightJoin) String() string { return proto.CompactTextString(m) }
 func (*RightJoin) ProtoMessage()    {}
 func (*RightJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{16}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{17}
 }
 func (m *RightJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1563,7 +1618,7 @@ func (m *RightSemiJoin) Reset()         { *m = RightSemiJoin{} }
 func (m *RightSemiJoin) String() string { return proto.CompactTextString(m) }
 func (*RightSemiJoin) ProtoMessage()    {}
 func (*RightSemiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{17}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{18}
 }
 func (m *RightSemiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1658,7 +1713,7 @@ func (m *RightAntiJoin) Reset()         { *m = RightAntiJoin{} }
 func (m *RightAntiJoin) String() string { return proto.CompactTextString(m) }
 func (*RightAntiJoin) ProtoMessage()    {}
 func (*RightAntiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{18}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{19}
 }
 func (m *RightAntiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1753,7 +1808,7 @@ func (m *SemiJoin) Reset()         { *m = SemiJoin{} }
 func (m *SemiJoin) String() string { return proto.CompactTextString(m) }
 func (*SemiJoin) ProtoMessage()    {}
 func (*SemiJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{19}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{20}
 }
 func (m *SemiJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1849,7 +1904,7 @@ func (m *SingleJoin) Reset()         { *m = SingleJoin{} }
 func (m *SingleJoin) String() string { return proto.CompactTextString(m) }
 func (*SingleJoin) ProtoMessage()    {}
 func (*SingleJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{20}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{21}
 }
 func (m *SingleJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1952,7 +2007,7 @@ func (m *MarkJoin) Reset()         { *m = MarkJoin{} }
 func (m *MarkJoin) String() string { return proto.CompactTextString(m) }
 func (*MarkJoin) ProtoMessage()    {}
 func (*MarkJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{21}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{22}
 }
 func (m *MarkJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2050,7 +2105,7 @@ func (m *Product) Reset()         { *m = Product{} }
 func (m *Product) String() string { return proto.CompactTextString(m) }
 func (*Product) ProtoMessage()    {}
 func (*Product) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{22}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{23}
 }
 func (m *Product) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2115,7 +2170,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{23}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{24}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2250,7 @@ func (m *HashBuild) Reset()         { *m = HashBuild{} }
 func (m *HashBuild) String() string { return proto.CompactTextString(m) }
 func (*HashBuild) ProtoMessage()    {}
 func (*HashBuild) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{24}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{25}
 }
 func (m *HashBuild) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2278,7 +2333,7 @@ func (m *ExternalName2ColIndex) Reset()         { *m = ExternalName2ColIndex{} }
 func (m *ExternalName2ColIndex) String() string { return proto.CompactTextString(m) }
 func (*ExternalName2ColIndex) ProtoMessage()    {}
 func (*ExternalName2ColIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{25}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{26}
 }
 func (m *ExternalName2ColIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2332,7 +2387,7 @@ func (m *FileOffset) Reset()         { *m = FileOffset{} }
 func (m *FileOffset) String() string { return proto.CompactTextString(m) }
 func (*FileOffset) ProtoMessage()    {}
 func (*FileOffset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{26}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{27}
 }
 func (m *FileOffset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2387,7 +2442,7 @@ func (m *ExternalScan) Reset()         { *m = ExternalScan{} }
 func (m *ExternalScan) String() string { return proto.CompactTextString(m) }
 func (*ExternalScan) ProtoMessage()    {}
 func (*ExternalScan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{27}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{28}
 }
 func (m *ExternalScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2514,6 +2569,7 @@ type Instruction struct {
 	RightJoin            *RightJoin     `protobuf:"bytes,28,opt,name=right_join,json=rightJoin,proto3" json:"right_join,omitempty"`
 	RightSemiJoin        *RightSemiJoin `protobuf:"bytes,29,opt,name=right_semi_join,json=rightSemiJoin,proto3" json:"right_semi_join,omitempty"`
 	RightAntiJoin        *RightAntiJoin `protobuf:"bytes,30,opt,name=right_anti_join,json=rightAntiJoin,proto3" json:"right_anti_join,omitempty"`
+	Window               *Window        `protobuf:"bytes,31,opt,name=window,proto3" json:"window,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2523,7 +2579,7 @@ func (m *Instruction) Reset()         { *m = Instruction{} }
 func (m *Instruction) String() string { return proto.CompactTextString(m) }
 func (*Instruction) ProtoMessage()    {}
 func (*Instruction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{28}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{29}
 }
 func (m *Instruction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2762,6 +2818,13 @@ func (m *Instruction) GetRightAntiJoin() *RightAntiJoin {
 	return nil
 }
 
+func (m *Instruction) GetWindow() *Window {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
 type AnalysisList struct {
 	List                 []*plan.AnalyzeInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -2773,7 +2836,7 @@ func (m *AnalysisList) Reset()         { *m = AnalysisList{} }
 func (m *AnalysisList) String() string { return proto.CompactTextString(m) }
 func (*AnalysisList) ProtoMessage()    {}
 func (*AnalysisList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{29}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{30}
 }
 func (m *AnalysisList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +2891,7 @@ func (m *Source) Reset()         { *m = Source{} }
 func (m *Source) String() string { return proto.CompactTextString(m) }
 func (*Source) ProtoMessage()    {}
 func (*Source) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{30}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{31}
 }
 func (m *Source) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2934,7 +2997,7 @@ func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
 func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()    {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{31}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{32}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2997,6 +3060,7 @@ type ProcessLimitation struct {
 	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
 	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
 	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	SpillSize            int64    `protobuf:"varint,6,opt,name=spill_size,json=spillSize,proto3" json:"spill_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3006,7 +3070,7 @@ func (m *ProcessLimitation) Reset()         { *m = ProcessLimitation{} }
 func (m *ProcessLimitation) String() string { return proto.CompactTextString(m) }
 func (*ProcessLimitation) ProtoMessage()    {}
 func (*ProcessLimitation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{32}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{33}
 }
 func (m *ProcessLimitation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3070,6 +3134,13 @@ func (m *ProcessLimitation) GetReaderSize() int64 {
 	return 0
 }
 
+func (m *ProcessLimitation) GetSpillSize() int64 {
+	if m != nil {
+		return m.SpillSize
+	}
+	return 0
+}
+
 type ProcessInfo struct {
 	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Lim                  *ProcessLimitation `protobuf:"bytes,2,opt,name=lim,proto3" json:"lim,omitempty"`
@@ -3086,7 +3157,7 @@ func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
 func (m *ProcessInfo) String() string { return proto.CompactTextString(m) }
 func (*ProcessInfo) ProtoMessage()    {}
 func (*ProcessInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{33}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{34}
 }
 func (m *ProcessInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3175,7 +3246,7 @@ func (m *SessionInfo) Reset()         { *m = SessionInfo{} }
 func (m *SessionInfo) String() string { return proto.CompactTextString(m) }
 func (*SessionInfo) ProtoMessage()    {}
 func (*SessionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{34}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{35}
 }
 func (m *SessionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3283,7 +3354,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{35}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{36}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3415,7 +3486,7 @@ func (m *WrapNode) Reset()         { *m = WrapNode{} }
 func (m *WrapNode) String() string { return proto.CompactTextString(m) }
 func (*WrapNode) ProtoMessage()    {}
 func (*WrapNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{36}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{37}
 }
 func (m *WrapNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3471,7 +3542,7 @@ func (m *UuidToRegIdx) Reset()         { *m = UuidToRegIdx{} }
 func (m *UuidToRegIdx) String() string { return proto.CompactTextString(m) }
 func (*UuidToRegIdx) ProtoMessage()    {}
 func (*UuidToRegIdx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7ac67a7adf3df9c7, []int{37}
+	return fileDescriptor_7ac67a7adf3df9c7, []int{38}
 }
 func (m *UuidToRegIdx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3529,6 +3600,7 @@ func init() {
 	proto.RegisterType((*MultiArguemnt)(nil), "pipeline.MultiArguemnt")
 	proto.RegisterType((*Aggregate)(nil), "pipeline.Aggregate")
 	proto.RegisterType((*Group)(nil), "pipeline.Group")
+	proto.RegisterType((*Window)(nil), "pipeline.Window")
 	proto.RegisterType((*Insert)(nil), "pipeline.Insert")
 	proto.RegisterMapType((map[string]int32)(nil), "pipeline.Insert.ParentIdxEntry")
 	proto.RegisterType((*Array)(nil), "pipeline.Array")
@@ -3571,206 +3643,209 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 3169 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4d, 0x6f, 0x1c, 0x47,
-	0x76, 0x9e, 0xef, 0xee, 0x37, 0x33, 0x24, 0x55, 0xd6, 0x47, 0x9b, 0xb2, 0x24, 0xa6, 0x63, 0xc5,
-	0xb2, 0x65, 0x51, 0x30, 0x13, 0x05, 0x46, 0xfc, 0x15, 0x8a, 0x94, 0x9d, 0x89, 0xf5, 0xc1, 0x14,
-	0x69, 0x04, 0x31, 0x82, 0x34, 0x8a, 0xdd, 0x35, 0xc3, 0x36, 0x7b, 0xba, 0x5b, 0xd5, 0x3d, 0x32,
-	0xa9, 0x53, 0x4e, 0x39, 0x24, 0xce, 0x21, 0xc8, 0x1f, 0xf0, 0x1f, 0xc8, 0x29, 0xe7, 0x24, 0xc8,
-	0x2d, 0xc7, 0xec, 0x79, 0x0f, 0xbb, 0xf0, 0x5e, 0x77, 0x6f, 0x7b, 0x34, 0x16, 0x8b, 0xf7, 0xaa,
-	0xfa, 0x63, 0x86, 0xa4, 0x24, 0x2f, 0x16, 0xab, 0x05, 0xd6, 0xb7, 0x7a, 0x1f, 0x55, 0x5d, 0xef,
-	0xa3, 0xde, 0x7b, 0xf5, 0xaa, 0x61, 0x29, 0x0d, 0x53, 0x19, 0x85, 0xb1, 0x5c, 0x4f, 0x55, 0x92,
-	0x27, 0xcc, 0x2a, 0xe0, 0xd5, 0x5b, 0x93, 0x30, 0x3f, 0x98, 0xed, 0xaf, 0xfb, 0xc9, 0xf4, 0xf6,
-	0x24, 0x99, 0x24, 0xb7, 0x89, 0x61, 0x7f, 0x36, 0x26, 0x88, 0x00, 0x1a, 0xe9, 0x89, 0xab, 0x90,
-	0x46, 0x22, 0x36, 0xe3, 0xe5, 0x3c, 0x9c, 0xca, 0x2c, 0x17, 0xd3, 0x54, 0x23, 0xdc, 0xaf, 0x9b,
-	0xd0, 0x7b, 0x20, 0xb3, 0x4c, 0x4c, 0x24, 0x5b, 0x81, 0x56, 0x16, 0x06, 0x4e, 0x63, 0xad, 0x71,
-	0xa3, 0xcd, 0x71, 0x88, 0x18, 0x7f, 0x1a, 0x38, 0x4d, 0x8d, 0xf1, 0xa7, 0x84, 0x91, 0x4a, 0x39,
-	0xad, 0xb5, 0xc6, 0x8d, 0x01, 0xc7, 0x21, 0x63, 0xd0, 0x0e, 0x44, 0x2e, 0x9c, 0x36, 0xa1, 0x68,
-	0xcc, 0xde, 0x80, 0xa5, 0x54, 0x25, 0xbe, 0x17, 0xc6, 0xe3, 0xc4, 0x23, 0x6a, 0x87, 0xa8, 0x03,
-	0xc4, 0x8e, 0xe2, 0x71, 0xb2, 0x8d, 0x5c, 0x0e, 0xf4, 0x44, 0x2c, 0xa2, 0xe3, 0x4c, 0x3a, 0x5d,
-	0x22, 0x17, 0x20, 0x5b, 0x82, 0x66, 0x18, 0x38, 0x3d, 0xfa, 0x6c, 0x33, 0x0c, 0xf0, 0x1b, 0xb3,
-	0x59, 0x18, 0x38, 0x96, 0xfe, 0x06, 0x8e, 0xd9, 0x65, 0xb0, 0xf7, 0x45, 0xee, 0x1f, 0x78, 0x7e,
-	0x9c, 0x3b, 0x36, 0xb1, 0x5a, 0x84, 0xd8, 0x8a, 0x73, 0xb6, 0x0a, 0x96, 0x7f, 0x20, 0xfd, 0xc3,
-	0x6c, 0x36, 0x75, 0x60, 0xad, 0x71, 0x63, 0xc8, 0x4b, 0x18, 0x69, 0x99, 0x7c, 0x3c, 0x93, 0xb1,
-	0x2f, 0x9d, 0xbe, 0x9e, 0x57, 0xc0, 0xee, 0xe7, 0x60, 0x6f, 0x25, 0x71, 0x2c, 0xfd, 0x3c, 0x51,
-	0xec, 0x1a, 0xf4, 0x0b, 0x9d, 0x7b, 0x46, 0x2f, 0x1d, 0x0e, 0x05, 0x6a, 0x14, 0xb0, 0x37, 0x61,
-	0xd9, 0x2f, 0xb8, 0xbd, 0x30, 0x0e, 0xe4, 0x11, 0xa9, 0xaa, 0xc3, 0x97, 0x4a, 0xf4, 0x08, 0xb1,
-	0xee, 0x37, 0x0d, 0xb0, 0xb6, 0xc3, 0x2c, 0xc5, 0xed, 0xb1, 0x4b, 0xd0, 0x1b, 0xcf, 0x62, 0xbf,
-	0x5a, 0xb2, 0x8b, 0xe0, 0x28, 0x60, 0x1f, 0xc0, 0x72, 0x94, 0xf8, 0x22, 0xf2, 0xca, 0xd9, 0x4e,
-	0x73, 0xad, 0x75, 0xa3, 0xbf, 0xf1, 0xea, 0x7a, 0xe9, 0x0b, 0xe5, 0xee, 0xf8, 0x12, 0xf1, 0x56,
-	0xbb, 0xfd, 0x10, 0x56, 0x94, 0x9c, 0x26, 0xb9, 0xac, 0x4d, 0x6f, 0xd1, 0x74, 0x56, 0x4d, 0xff,
-	0x5b, 0x25, 0xd2, 0x87, 0x49, 0x20, 0xf9, 0xb2, 0xe6, 0x2d, 0xa7, 0xbb, 0xff, 0xd9, 0x80, 0xe1,
-	0x83, 0x59, 0x94, 0x87, 0x9b, 0x6a, 0x32, 0x93, 0xd3, 0x38, 0x47, 0xa5, 0x6f, 0x87, 0x59, 0x4e,
-	0x9b, 0xb4, 0x38, 0x8d, 0xd9, 0x0d, 0xb0, 0x3f, 0x55, 0xc9, 0x2c, 0xbd, 0x77, 0x94, 0x16, 0x9b,
-	0x83, 0x75, 0xf2, 0x2f, 0xc4, 0xf0, 0x8a, 0xc8, 0xde, 0x81, 0xfe, 0x23, 0x15, 0x48, 0x75, 0xf7,
-	0x98, 0x78, 0x5b, 0x27, 0x78, 0xeb, 0x64, 0xf6, 0x3a, 0xd8, 0xbb, 0x32, 0x15, 0x4a, 0xe0, 0xae,
-	0xd1, 0x93, 0x6c, 0x5e, 0x21, 0xd0, 0x51, 0x88, 0x79, 0x14, 0x90, 0x1f, 0x75, 0x78, 0x01, 0xba,
-	0x8f, 0xc0, 0xde, 0x9c, 0x4c, 0x94, 0x9c, 0x88, 0x9c, 0xbc, 0x26, 0x49, 0x8d, 0x4e, 0x9b, 0x49,
-	0x4a, 0x9e, 0x89, 0x02, 0x34, 0xb5, 0x00, 0x38, 0x66, 0x57, 0xa1, 0x2d, 0xf5, 0x7e, 0x1a, 0x0b,
-	0xfb, 0x21, 0xbc, 0xfb, 0x5d, 0x03, 0x3a, 0x24, 0x04, 0xfa, 0x57, 0x2c, 0x65, 0xe0, 0xc9, 0x27,
-	0x22, 0x32, 0x3a, 0xb0, 0x10, 0x71, 0xef, 0x89, 0x88, 0x70, 0x47, 0xe1, 0xfe, 0xcc, 0x3f, 0x94,
-	0xb9, 0x39, 0x1c, 0x05, 0x88, 0x94, 0xd8, 0x50, 0x5a, 0x9a, 0x62, 0x40, 0xb6, 0x06, 0x1d, 0xfc,
-	0x44, 0xe6, 0xb4, 0x4f, 0xe8, 0x42, 0x13, 0x90, 0x23, 0x3f, 0x4e, 0x65, 0xe6, 0x74, 0xea, 0x1c,
-	0x7b, 0xc7, 0xa9, 0xe4, 0x9a, 0xc0, 0xde, 0x84, 0xb6, 0x98, 0x4c, 0x32, 0xa7, 0xbb, 0xe8, 0x17,
-	0xa5, 0x16, 0x38, 0x31, 0xb0, 0x3b, 0x60, 0x6b, 0x6b, 0x22, 0x77, 0x8f, 0xb8, 0x2f, 0x55, 0xdc,
-	0x73, 0x86, 0xe6, 0x15, 0xa7, 0xfb, 0x93, 0x26, 0x74, 0x47, 0x71, 0x26, 0x15, 0x1d, 0x21, 0x31,
-	0x1e, 0x4b, 0x3f, 0x97, 0x45, 0x48, 0x28, 0x61, 0xa4, 0x8d, 0x32, 0x4e, 0x1e, 0x64, 0xb4, 0x5b,
-	0xc2, 0xec, 0x8f, 0xa0, 0xa5, 0xe4, 0xd8, 0x28, 0x78, 0x59, 0x8b, 0xf0, 0x68, 0xff, 0x4b, 0xe9,
-	0xe7, 0x5c, 0x8e, 0x39, 0xd2, 0xd8, 0x4d, 0xb0, 0x73, 0xb1, 0x1f, 0x49, 0x2f, 0x90, 0x63, 0xb2,
-	0x76, 0x7f, 0x63, 0xc9, 0xc8, 0x8a, 0xe8, 0x6d, 0x39, 0xe6, 0x56, 0x6e, 0x46, 0xec, 0x23, 0x80,
-	0x54, 0x28, 0x19, 0xe7, 0x5e, 0x18, 0x1c, 0x19, 0xcd, 0x5c, 0xab, 0x44, 0xd1, 0xbb, 0x5d, 0xdf,
-	0x21, 0x96, 0x51, 0x70, 0x74, 0x2f, 0xce, 0xd5, 0x31, 0xb7, 0xd3, 0x02, 0x66, 0x7f, 0x0e, 0x83,
-	0xad, 0x68, 0x96, 0xe5, 0x52, 0xd1, 0xe2, 0x14, 0x6a, 0xe8, 0x4c, 0xe0, 0xf7, 0xea, 0x14, 0x3e,
-	0xc7, 0x87, 0xc7, 0x34, 0x0c, 0x8e, 0xe8, 0xa3, 0xa8, 0xbf, 0x0e, 0xef, 0x86, 0xc1, 0xd1, 0x28,
-	0x38, 0x5a, 0xfd, 0x00, 0x96, 0xe6, 0xbf, 0x86, 0x41, 0xf1, 0x50, 0x1e, 0x93, 0x96, 0x6c, 0x8e,
-	0x43, 0x76, 0x1e, 0x3a, 0x4f, 0x44, 0x34, 0x93, 0x26, 0x1e, 0x68, 0xe0, 0x2f, 0x9a, 0xef, 0x35,
-	0xdc, 0x2b, 0xd0, 0xd9, 0x54, 0x4a, 0x10, 0x8b, 0xc0, 0x81, 0xd3, 0xa0, 0xd5, 0x35, 0xe0, 0xfa,
-	0xd0, 0x7a, 0x20, 0x52, 0x76, 0x1d, 0x9a, 0xd3, 0x94, 0x28, 0xfd, 0x8d, 0x0b, 0x35, 0xbb, 0x89,
-	0x74, 0xfd, 0x41, 0xaa, 0x45, 0x6c, 0x4e, 0xd3, 0xd5, 0x3b, 0xd0, 0x33, 0xe0, 0xf7, 0xda, 0xc3,
-	0xbf, 0x76, 0xc0, 0xda, 0x96, 0x91, 0xcc, 0xc3, 0x24, 0xc6, 0x53, 0xb3, 0x97, 0x19, 0x0b, 0x37,
-	0xf7, 0x32, 0xe6, 0xc2, 0x60, 0xd3, 0xd8, 0x99, 0x27, 0x5f, 0x65, 0xc6, 0xbf, 0xe7, 0x70, 0xc8,
-	0xa3, 0xad, 0x4d, 0xab, 0x48, 0x32, 0xb6, 0xc5, 0xe7, 0x70, 0x78, 0x10, 0x46, 0x77, 0xf5, 0x41,
-	0x68, 0x53, 0x04, 0x2e, 0x40, 0xa4, 0x3c, 0x34, 0x94, 0x8e, 0xa6, 0x18, 0x90, 0xad, 0x41, 0x7f,
-	0x4b, 0xc4, 0x7b, 0x6a, 0x16, 0xfb, 0x22, 0xd7, 0xa6, 0xb2, 0x78, 0x1d, 0xc5, 0xde, 0x84, 0xee,
-	0xb6, 0x8c, 0xb8, 0x1c, 0x1b, 0xa7, 0x3e, 0xe1, 0x60, 0x86, 0xcc, 0x2e, 0x42, 0x77, 0x44, 0xf6,
-	0x72, 0x2c, 0x6d, 0x3d, 0x0d, 0xb1, 0x37, 0x60, 0xf8, 0x28, 0xe6, 0x32, 0xcb, 0x55, 0xe8, 0xa3,
-	0x05, 0x1d, 0x9b, 0xc8, 0xf3, 0x48, 0x14, 0xf0, 0x51, 0xbc, 0x25, 0x32, 0x5f, 0x04, 0x12, 0x99,
-	0x80, 0x98, 0xe6, 0x70, 0xec, 0x26, 0x58, 0x8f, 0xe2, 0x5d, 0x89, 0x5f, 0x75, 0xfa, 0xa7, 0x6f,
-	0xa6, 0x64, 0x60, 0x7f, 0x86, 0x9f, 0xdd, 0x95, 0x79, 0xe1, 0xe0, 0xce, 0x80, 0x66, 0x2c, 0xba,
-	0xfd, 0x3c, 0x13, 0xbb, 0x03, 0x4b, 0x84, 0xf8, 0x3c, 0x0d, 0x04, 0x06, 0xeb, 0xc8, 0x19, 0xd2,
-	0xb4, 0xe1, 0x9c, 0x4b, 0xf0, 0x05, 0xa6, 0x72, 0x67, 0xb8, 0xf3, 0xa5, 0x62, 0x67, 0x65, 0xa4,
-	0x40, 0x3f, 0xe3, 0x25, 0x03, 0xbb, 0x0b, 0xb0, 0x2b, 0x27, 0x53, 0x19, 0xe7, 0x0f, 0x44, 0xea,
-	0x2c, 0x13, 0xbb, 0x5b, 0xb1, 0x17, 0x7e, 0xb2, 0x5e, 0x31, 0x69, 0xff, 0xab, 0xcd, 0x5a, 0xfd,
-	0x10, 0x96, 0x17, 0xc8, 0xdf, 0xcb, 0x1f, 0xff, 0xb1, 0x09, 0xf6, 0x8e, 0x92, 0x26, 0xf0, 0x5c,
-	0x83, 0x7e, 0xe6, 0x1f, 0xc8, 0xa9, 0xf0, 0x62, 0x31, 0x95, 0x66, 0x05, 0xd0, 0xa8, 0x87, 0x62,
-	0x2a, 0xe7, 0xc3, 0x47, 0xf3, 0x39, 0xe1, 0xe3, 0x1f, 0xe0, 0x42, 0x15, 0x3e, 0xbc, 0x54, 0x49,
-	0x2f, 0xa4, 0xcf, 0x98, 0x8c, 0x74, 0xb3, 0x92, 0xb4, 0xdc, 0x41, 0x15, 0x4c, 0x4a, 0x94, 0x16,
-	0x99, 0xa5, 0x27, 0x08, 0xab, 0xf7, 0xe0, 0xd2, 0x19, 0xec, 0xdf, 0x4b, 0x05, 0x3f, 0x6a, 0xa2,
-	0xa9, 0xb7, 0x67, 0x69, 0x14, 0xa2, 0x9f, 0x7f, 0x26, 0x8f, 0x9f, 0x19, 0x80, 0x6f, 0xc0, 0x4a,
-	0x12, 0x7b, 0x41, 0xc1, 0x4e, 0x51, 0xaa, 0x49, 0x3e, 0xba, 0x94, 0x54, 0xab, 0xa0, 0x79, 0xff,
-	0x0e, 0xce, 0xcd, 0x71, 0xca, 0x2a, 0x1b, 0xdf, 0xaa, 0x64, 0x9f, 0xff, 0x74, 0x1d, 0xc4, 0xfc,
-	0xa4, 0xa5, 0x5f, 0x4e, 0xe6, 0xb1, 0x45, 0xa4, 0x6f, 0xbf, 0x68, 0xa4, 0xef, 0x3c, 0xdb, 0x54,
-	0xab, 0x0f, 0xe1, 0xfc, 0x69, 0x1f, 0x3e, 0x45, 0x8f, 0x6b, 0x75, 0x3d, 0x2e, 0xa4, 0xd2, 0x4a,
-	0xa7, 0xff, 0xd4, 0x84, 0xf6, 0x5f, 0x27, 0x61, 0x5c, 0xcf, 0xd6, 0x8d, 0x33, 0xb3, 0x75, 0x73,
-	0x3e, 0x5b, 0xbf, 0x06, 0x96, 0x92, 0x91, 0x17, 0x61, 0x01, 0xd1, 0x22, 0xcd, 0xf6, 0x94, 0x8c,
-	0xee, 0x63, 0x0d, 0xf1, 0x1a, 0x58, 0x7e, 0x62, 0x48, 0x6d, 0x4d, 0xf2, 0x13, 0x4d, 0x2a, 0xca,
-	0x8b, 0xce, 0xe9, 0xe5, 0x45, 0x95, 0xe1, 0xbb, 0x67, 0x67, 0x78, 0x3b, 0x92, 0xe3, 0x1c, 0x8b,
-	0xb8, 0xc0, 0xc4, 0xb8, 0xfa, 0x32, 0x16, 0x12, 0xb7, 0x92, 0x38, 0x60, 0x6f, 0x01, 0xa8, 0x70,
-	0x72, 0x60, 0x38, 0xad, 0x93, 0xb5, 0x18, 0x51, 0x91, 0xd5, 0xfd, 0x79, 0x03, 0xac, 0xcd, 0x38,
-	0x0f, 0x7f, 0x63, 0x65, 0x5c, 0x84, 0xae, 0x92, 0xd9, 0x2c, 0x2a, 0x54, 0x61, 0xa0, 0x52, 0xdc,
-	0xf6, 0xf3, 0xc4, 0xed, 0xbc, 0x90, 0xb8, 0xdd, 0x17, 0x16, 0xb7, 0xf7, 0x2c, 0x71, 0xff, 0xa5,
-	0x09, 0xf6, 0x28, 0x8e, 0xa5, 0xfa, 0xc1, 0xf8, 0x71, 0xe0, 0xfe, 0x73, 0x13, 0xac, 0xfb, 0x72,
-	0x9c, 0xff, 0xa0, 0x8c, 0x38, 0x70, 0xff, 0xb7, 0x09, 0x36, 0x47, 0xe8, 0xf7, 0x4c, 0x1b, 0x6f,
-	0x01, 0x90, 0xac, 0x67, 0xa9, 0x84, 0x34, 0xb1, 0x47, 0x6a, 0xb9, 0x09, 0x7d, 0x2d, 0xad, 0xe6,
-	0xed, 0x9d, 0xe0, 0xd5, 0xca, 0xd8, 0x3b, 0xa9, 0x43, 0xeb, 0x85, 0x75, 0x68, 0x3f, 0x4b, 0x87,
-	0xdf, 0x35, 0x60, 0x48, 0x3a, 0xdc, 0x95, 0xd3, 0xdf, 0x7d, 0x48, 0x59, 0x10, 0xbf, 0xf3, 0xe2,
-	0xe2, 0xff, 0x96, 0xa2, 0x4b, 0x29, 0xfe, 0x4b, 0x89, 0xa8, 0x2f, 0x5d, 0x7c, 0xcc, 0x25, 0x2f,
-	0xc5, 0xf0, 0x2f, 0x27, 0x97, 0x7c, 0xdd, 0x04, 0xd8, 0x0d, 0xe3, 0x49, 0x24, 0x7f, 0x88, 0x9f,
-	0x71, 0xe0, 0xfe, 0x5b, 0x13, 0xac, 0x07, 0x42, 0x1d, 0xfe, 0x61, 0x58, 0x9f, 0xfd, 0x31, 0xf4,
-	0x92, 0x58, 0x9b, 0xe7, 0xa4, 0x5a, 0xba, 0x49, 0x8c, 0x96, 0x72, 0x05, 0xf4, 0x76, 0x54, 0x12,
-	0xcc, 0xfc, 0x79, 0x53, 0x37, 0xce, 0x36, 0x75, 0x73, 0xde, 0xd4, 0xa5, 0x6c, 0xad, 0x33, 0x64,
-	0x73, 0xff, 0xbd, 0x01, 0x43, 0x2a, 0x98, 0x3f, 0x99, 0xc5, 0x3e, 0xdd, 0xda, 0xcf, 0x43, 0x47,
-	0xe4, 0xb9, 0xca, 0xe8, 0x33, 0x36, 0xd7, 0x00, 0x5b, 0x83, 0xb6, 0x92, 0x79, 0x66, 0x3a, 0x73,
-	0x03, 0xd3, 0xe3, 0x48, 0x22, 0xac, 0xb3, 0x89, 0x82, 0x7a, 0x16, 0x6a, 0x92, 0x9d, 0xd2, 0x8f,
-	0x23, 0x3c, 0xda, 0x27, 0x15, 0x4a, 0x4c, 0x33, 0xd3, 0xcf, 0x35, 0x10, 0x63, 0xd0, 0xa6, 0xdb,
-	0x58, 0x87, 0x8a, 0x70, 0x1a, 0xbb, 0xff, 0xd5, 0x00, 0xfb, 0xaf, 0x44, 0x76, 0x70, 0x77, 0x16,
-	0x46, 0x41, 0xd5, 0x2f, 0x43, 0x33, 0xd6, 0xfb, 0x65, 0x68, 0xbe, 0x82, 0x78, 0x20, 0xb2, 0x83,
-	0xa2, 0x63, 0x84, 0x08, 0x9c, 0x5e, 0xf7, 0xa3, 0xd6, 0x99, 0x7e, 0xd4, 0x3e, 0xd1, 0x4c, 0x7b,
-	0x8e, 0x3f, 0xac, 0x41, 0x07, 0x0d, 0x9c, 0x9d, 0xe2, 0x0b, 0x9a, 0xe0, 0x6e, 0xc2, 0x85, 0x7b,
-	0x47, 0xb9, 0x54, 0xb1, 0x88, 0xf0, 0x5e, 0xb9, 0xb1, 0x95, 0x44, 0xd4, 0xae, 0x2d, 0x85, 0x6d,
-	0x54, 0xc2, 0xa2, 0xc2, 0xeb, 0x1d, 0x5e, 0x0d, 0xb8, 0xd7, 0xa1, 0x3f, 0x0e, 0x23, 0xe9, 0x25,
-	0xe3, 0x71, 0xa6, 0xbd, 0x5b, 0x8f, 0xc8, 0x2c, 0x2d, 0x6e, 0x20, 0xf7, 0x57, 0x4d, 0x18, 0x14,
-	0x9f, 0xda, 0xf5, 0xc5, 0x59, 0xe6, 0xbb, 0x0c, 0x36, 0xad, 0x96, 0x85, 0x4f, 0x25, 0xd9, 0xb0,
-	0xc5, 0x2d, 0x44, 0xec, 0x86, 0x4f, 0x25, 0xdb, 0x84, 0x73, 0xb5, 0x4f, 0x79, 0x79, 0x92, 0x8b,
-	0xc8, 0x98, 0xb1, 0xd6, 0x21, 0xaa, 0xb1, 0xf0, 0x65, 0x04, 0x1e, 0xd1, 0x78, 0x0f, 0xb9, 0xd1,
-	0x3d, 0xfc, 0x24, 0x2a, 0x1a, 0x90, 0x0b, 0xee, 0x81, 0x14, 0xf6, 0x29, 0x2c, 0xa3, 0xb4, 0x1b,
-	0x1e, 0xfa, 0xaa, 0x96, 0xf7, 0x44, 0xc7, 0xed, 0x54, 0x9d, 0xf1, 0x61, 0x3c, 0xa7, 0xc2, 0x2b,
-	0x00, 0xbe, 0x92, 0x78, 0xe1, 0xcc, 0x1e, 0x47, 0xd4, 0xc8, 0xb1, 0xb9, 0xad, 0x31, 0xbb, 0x8f,
-	0xa3, 0x52, 0x52, 0x3a, 0x0e, 0x3d, 0xd2, 0x01, 0x49, 0x4a, 0xe7, 0xe1, 0x16, 0xf4, 0x13, 0x15,
-	0x4e, 0xc2, 0xd8, 0xa3, 0xdd, 0x5a, 0xa7, 0xec, 0x16, 0x34, 0xc3, 0x16, 0xee, 0xd9, 0x85, 0xee,
-	0x38, 0x8c, 0x72, 0xa9, 0xe8, 0x15, 0x60, 0xe1, 0x8c, 0x6a, 0x8a, 0xfb, 0xdf, 0x00, 0xfd, 0x51,
-	0x9c, 0xe5, 0x6a, 0xe6, 0x17, 0x4d, 0xaf, 0xb9, 0x56, 0xf1, 0x0a, 0xb4, 0xf4, 0x15, 0x1a, 0x11,
-	0x38, 0x64, 0x7f, 0x02, 0x6d, 0x11, 0xe7, 0xa1, 0xe9, 0x63, 0xd6, 0x5a, 0xe8, 0x45, 0xda, 0xe7,
-	0x44, 0x67, 0xb7, 0xa0, 0x67, 0xfa, 0xed, 0x26, 0x76, 0x9d, 0xda, 0xac, 0x2f, 0x78, 0xd8, 0x3a,
-	0x58, 0x81, 0x79, 0x08, 0x30, 0xa1, 0xbd, 0xb6, 0x74, 0xf1, 0x44, 0xc0, 0x4b, 0x1e, 0xbc, 0x63,
-	0x8b, 0xc9, 0xc4, 0x34, 0x2d, 0x6b, 0x5d, 0x1c, 0xea, 0x51, 0x73, 0xa4, 0xb1, 0x0d, 0x80, 0x10,
-	0x6f, 0x3b, 0xde, 0x97, 0x49, 0x18, 0xd3, 0xa3, 0xc9, 0xdc, 0x26, 0xca, 0x9b, 0x10, 0xb7, 0xc3,
-	0xf2, 0x52, 0x74, 0xdb, 0x04, 0x4b, 0x9a, 0x62, 0x2d, 0xee, 0xa3, 0xb8, 0x2e, 0xe8, 0xa0, 0x59,
-	0x4c, 0xc8, 0xe4, 0x34, 0xd4, 0x13, 0xec, 0xc5, 0x09, 0x45, 0x41, 0xc0, 0xad, 0xac, 0x28, 0x0d,
-	0xee, 0x40, 0x3f, 0xa3, 0xbc, 0xa9, 0xa7, 0x00, 0x4d, 0x39, 0x5f, 0x9b, 0x52, 0x26, 0x55, 0x0e,
-	0x59, 0x95, 0x60, 0x6f, 0x83, 0x3d, 0x15, 0xea, 0x50, 0x4f, 0xea, 0x2f, 0x7e, 0xa7, 0x48, 0x3d,
-	0xdc, 0x9a, 0x16, 0x49, 0xc8, 0x85, 0x36, 0xf1, 0x0e, 0x8a, 0xe6, 0x42, 0xc1, 0xab, 0x6d, 0x84,
-	0x34, 0x76, 0x13, 0x7a, 0xa9, 0x8e, 0xd0, 0xce, 0x90, 0xd8, 0xce, 0xd5, 0xbb, 0x3e, 0x44, 0xe0,
-	0x05, 0x07, 0xfb, 0x08, 0x96, 0x74, 0xcb, 0x62, 0x6c, 0x62, 0xad, 0xb3, 0x44, 0x73, 0x6a, 0xed,
-	0xf3, 0xb9, 0x50, 0xcc, 0x87, 0xf9, 0x5c, 0x64, 0xde, 0x00, 0xc0, 0x28, 0xe7, 0xed, 0x63, 0x54,
-	0x74, 0x96, 0x17, 0xcd, 0x51, 0x06, 0x4c, 0x6e, 0x1f, 0x94, 0xb1, 0xf3, 0x7d, 0x18, 0x4a, 0x73,
-	0xaa, 0xbc, 0xcc, 0x17, 0xb1, 0xb3, 0x42, 0xd3, 0x2e, 0x9e, 0x3c, 0x74, 0x18, 0x3d, 0xf8, 0x40,
-	0xd6, 0x63, 0xc9, 0x0d, 0xe8, 0x9a, 0x96, 0xd6, 0x39, 0x9a, 0xb5, 0xb2, 0xd8, 0x1c, 0xe7, 0x86,
-	0xce, 0xde, 0x86, 0x6e, 0xa0, 0x1b, 0xb6, 0xec, 0x84, 0xeb, 0x99, 0x36, 0x1f, 0x37, 0x1c, 0xec,
-	0xee, 0x42, 0x87, 0xe9, 0x50, 0x1e, 0x3b, 0xaf, 0xd2, 0x2c, 0xe7, 0xac, 0xb6, 0xd1, 0x5c, 0xef,
-	0xe9, 0x33, 0x79, 0x8c, 0xaa, 0xa8, 0x35, 0xdc, 0xce, 0x2f, 0xaa, 0xa2, 0x6c, 0x97, 0x71, 0x3b,
-	0x2d, 0xbb, 0x7f, 0xef, 0x80, 0x95, 0xa8, 0x40, 0x2a, 0x6f, 0xff, 0xd8, 0xb9, 0x40, 0x27, 0xff,
-	0x9c, 0xe9, 0x2c, 0xe9, 0xe7, 0xa2, 0xdd, 0x54, 0xfa, 0xbc, 0x97, 0x68, 0x80, 0xdd, 0x82, 0x41,
-	0xaa, 0x92, 0x2f, 0xa5, 0x9f, 0xeb, 0x50, 0x72, 0xf1, 0xe4, 0x33, 0x93, 0xa1, 0x53, 0x64, 0xa9,
-	0x42, 0xc5, 0xa5, 0xb3, 0x42, 0x05, 0x86, 0xe6, 0x28, 0x9c, 0x86, 0xb9, 0xe3, 0x50, 0xc6, 0xd1,
-	0x40, 0x2d, 0xb2, 0xbf, 0x46, 0x68, 0x03, 0x51, 0xee, 0xca, 0x3e, 0x09, 0x55, 0x96, 0x3b, 0xab,
-	0x94, 0xd6, 0x0a, 0x10, 0x67, 0x84, 0xd9, 0x7d, 0x91, 0xe5, 0xce, 0x65, 0x22, 0x18, 0x08, 0x95,
-	0xa2, 0xcb, 0x0f, 0x72, 0xdb, 0xd7, 0x17, 0x95, 0x52, 0xde, 0x4e, 0x4d, 0x1d, 0x42, 0x4e, 0xfe,
-	0x31, 0x2c, 0xeb, 0x39, 0xd5, 0x19, 0xbc, 0xb2, 0xe8, 0x94, 0x73, 0x57, 0x32, 0x3e, 0x54, 0x73,
-	0x37, 0xb4, 0x72, 0x01, 0x8c, 0x59, 0x7a, 0x81, 0xab, 0xa7, 0x2e, 0x50, 0x46, 0x37, 0xbd, 0x40,
-	0x01, 0xba, 0x77, 0x60, 0xb0, 0x49, 0x8f, 0xb3, 0x61, 0x46, 0x9a, 0xbc, 0x0e, 0xed, 0xb2, 0xca,
-	0x29, 0x4d, 0x44, 0x1c, 0x4f, 0xe5, 0x28, 0x1e, 0x27, 0x9c, 0xc8, 0xee, 0xff, 0x34, 0xa1, 0xbb,
-	0x9b, 0xcc, 0x94, 0x2f, 0x9f, 0xdf, 0xd6, 0xbd, 0x02, 0xa0, 0x0f, 0x1e, 0xd1, 0x9b, 0x3a, 0x65,
-	0x10, 0x86, 0xc8, 0xf5, 0x02, 0xaa, 0x45, 0x19, 0xa3, 0x2c, 0xa0, 0xce, 0x43, 0x67, 0x3f, 0x4a,
-	0xfc, 0x43, 0xf3, 0x72, 0xa8, 0x01, 0x7a, 0xbe, 0x9d, 0x65, 0x07, 0x41, 0xf2, 0x55, 0xec, 0x85,
-	0xfa, 0xe5, 0xb0, 0xcd, 0xa1, 0x40, 0x8d, 0xb0, 0xba, 0x1b, 0x96, 0x0c, 0x22, 0x08, 0x94, 0x49,
-	0x53, 0x83, 0x02, 0xb9, 0x19, 0x04, 0xaa, 0x2c, 0x4c, 0x7b, 0x67, 0x14, 0xa6, 0x6f, 0x43, 0xd9,
-	0xc0, 0x34, 0x81, 0xf4, 0xec, 0x5e, 0xf4, 0x06, 0xd8, 0xe5, 0xfb, 0xbb, 0x09, 0xa2, 0xe7, 0xd7,
-	0xab, 0x17, 0xf9, 0xbd, 0x62, 0xc4, 0x2b, 0x36, 0xf7, 0xef, 0xc1, 0x7a, 0x98, 0x04, 0xa4, 0x53,
-	0xac, 0x4b, 0xa6, 0x7e, 0x3a, 0x33, 0x79, 0x8b, 0xc6, 0xe6, 0xa9, 0x5c, 0x6b, 0xcb, 0x3c, 0x95,
-	0x93, 0x2c, 0x2d, 0x5d, 0xbb, 0xe0, 0x18, 0x9d, 0x34, 0x15, 0xc7, 0x51, 0x22, 0x02, 0x4a, 0xfd,
-	0x36, 0x2f, 0x40, 0xf7, 0x3f, 0x1a, 0x70, 0x6e, 0x47, 0x25, 0xbe, 0xcc, 0xb2, 0xfb, 0xe8, 0xe7,
-	0x82, 0x42, 0x18, 0x83, 0x36, 0x95, 0x20, 0xf8, 0x9d, 0x16, 0xa7, 0x31, 0x5a, 0x47, 0x3f, 0xb7,
-	0xab, 0xe2, 0x51, 0xa8, 0xc5, 0xf5, 0x03, 0x3c, 0xbd, 0x08, 0x95, 0x64, 0x9a, 0xd8, 0xaa, 0x91,
-	0xa9, 0x78, 0xb9, 0x0e, 0x4b, 0xa9, 0x50, 0x79, 0x88, 0xcb, 0xeb, 0x15, 0xda, 0xc4, 0x32, 0x2c,
-	0xb1, 0xb4, 0xca, 0x35, 0xe8, 0x2b, 0x29, 0xf0, 0xf4, 0xd3, 0x32, 0x1d, 0xe2, 0x01, 0x8d, 0xc2,
-	0x75, 0xdc, 0x5f, 0x34, 0xa0, 0x6f, 0xf6, 0x4b, 0x1a, 0xd1, 0xd2, 0x37, 0x4a, 0xe9, 0x6f, 0x41,
-	0x2b, 0x0a, 0xa7, 0xa6, 0x2d, 0x7c, 0x79, 0x2e, 0xca, 0xcf, 0xcb, 0xc8, 0x91, 0x0f, 0xcb, 0x90,
-	0x59, 0x1c, 0x1e, 0x79, 0xa8, 0x6e, 0xb3, 0x69, 0x0b, 0x11, 0x68, 0x09, 0xfa, 0x4f, 0x20, 0x16,
-	0x69, 0x76, 0x90, 0xe4, 0xc6, 0xb1, 0x4a, 0x98, 0xbd, 0x07, 0x83, 0x4c, 0x66, 0x19, 0x4a, 0x13,
-	0xc6, 0xe3, 0xc4, 0xa4, 0xf2, 0x0b, 0xf5, 0x8c, 0x48, 0x54, 0x3a, 0x0a, 0xfd, 0xac, 0x02, 0xd8,
-	0x3b, 0xc0, 0x84, 0x39, 0x48, 0x5e, 0x9c, 0x04, 0xa6, 0x04, 0xea, 0xd2, 0x8d, 0x60, 0xa5, 0xa0,
-	0xa0, 0xc5, 0xe9, 0x6e, 0xf1, 0xe3, 0x06, 0xf4, 0x6b, 0x4b, 0xd1, 0x8f, 0x10, 0x99, 0x54, 0x45,
-	0x65, 0x8a, 0x63, 0xc4, 0x1d, 0x24, 0xe6, 0x99, 0xdb, 0xe6, 0x34, 0x46, 0x9c, 0x4a, 0x22, 0x59,
-	0x78, 0x01, 0x8e, 0xd1, 0xdd, 0x4d, 0x15, 0x42, 0xdb, 0x0e, 0x4c, 0x49, 0x3d, 0xa8, 0x90, 0x23,
-	0x7a, 0xd9, 0x0d, 0x44, 0x2e, 0xf6, 0x45, 0x56, 0xd4, 0xfa, 0x25, 0x8c, 0x6e, 0xf4, 0x44, 0x2a,
-	0xdc, 0x8b, 0x39, 0x29, 0x05, 0x88, 0x7a, 0x44, 0x15, 0x7a, 0x4f, 0x93, 0x58, 0xd2, 0x49, 0x19,
-	0x70, 0x0b, 0x11, 0x5f, 0x24, 0x31, 0x4d, 0x13, 0xbe, 0x9f, 0xcc, 0xe2, 0x9c, 0x0e, 0x88, 0xcd,
-	0x0b, 0xd0, 0xfd, 0x65, 0x1b, 0xac, 0x1d, 0xa3, 0x31, 0xb6, 0x0d, 0xc3, 0xf2, 0x6f, 0x0b, 0xac,
-	0xe0, 0x49, 0xc6, 0xa5, 0x7a, 0xe1, 0xb9, 0xb3, 0x38, 0xa0, 0x72, 0x7f, 0x90, 0xd6, 0xa0, 0xc5,
-	0x7f, 0x36, 0x9a, 0x27, 0xfe, 0xd9, 0x78, 0x1d, 0x5a, 0x8f, 0xd5, 0xf1, 0xfc, 0xfb, 0xff, 0x4e,
-	0x24, 0x62, 0x8e, 0x68, 0xf6, 0x2e, 0xf4, 0x51, 0x5c, 0x2f, 0xa3, 0x98, 0x65, 0x2a, 0xba, 0x5a,
-	0x42, 0xd5, 0xb1, 0x8c, 0x03, 0x32, 0x99, 0xb8, 0xb6, 0x0e, 0x96, 0x7f, 0x10, 0x46, 0x81, 0x92,
-	0xb1, 0xa9, 0x95, 0xd9, 0xc9, 0x2d, 0xf3, 0x92, 0x87, 0xfd, 0x25, 0xac, 0x84, 0x55, 0x25, 0x5a,
-	0x99, 0x7f, 0xce, 0x7d, 0x6a, 0xb5, 0x2a, 0x5f, 0xae, 0xb1, 0x53, 0xb8, 0xbb, 0x80, 0x99, 0xc5,
-	0x93, 0xb1, 0xfe, 0x43, 0xc6, 0xe2, 0x9d, 0x30, 0xbb, 0x17, 0x07, 0xf4, 0x60, 0x9d, 0x55, 0x15,
-	0x1d, 0x65, 0x1c, 0x0a, 0xfe, 0x9a, 0x40, 0xc7, 0xdf, 0x2e, 0x53, 0x51, 0x22, 0x02, 0xac, 0x71,
-	0xd1, 0x05, 0x4d, 0x71, 0x56, 0xdb, 0x76, 0x11, 0x71, 0x38, 0xd1, 0xe9, 0x77, 0x9e, 0x59, 0x76,
-	0xe0, 0xe9, 0x50, 0x8a, 0xfe, 0xde, 0x27, 0xbd, 0x52, 0xa4, 0xdc, 0xc6, 0x60, 0x8a, 0xbe, 0x79,
-	0x1d, 0x96, 0x0a, 0x21, 0x3d, 0x6d, 0xee, 0x01, 0x71, 0x0d, 0x0b, 0xec, 0x16, 0x22, 0xd9, 0xc7,
-	0xb0, 0x32, 0x9b, 0x85, 0x41, 0xe6, 0xe5, 0x89, 0xa7, 0xe4, 0x84, 0x9e, 0xae, 0xf4, 0xab, 0x66,
-	0xad, 0xdc, 0xf9, 0x7c, 0x16, 0x06, 0x7b, 0x09, 0x97, 0x93, 0x51, 0x70, 0xc4, 0x87, 0xc4, 0x5f,
-	0x80, 0xee, 0xc7, 0x30, 0xa8, 0x3b, 0x00, 0xb3, 0xa1, 0xf3, 0x40, 0xaa, 0x89, 0x5c, 0x79, 0x85,
-	0x01, 0x74, 0x1f, 0x26, 0x6a, 0x2a, 0xa2, 0x95, 0x06, 0x8e, 0xf5, 0x7b, 0xf4, 0x4a, 0x93, 0x0d,
-	0xc0, 0xda, 0x11, 0x4a, 0x44, 0x91, 0x8c, 0x56, 0x5a, 0xee, 0xfb, 0x60, 0x15, 0xff, 0xc1, 0xd0,
-	0xc5, 0x14, 0x4f, 0x21, 0xc5, 0x4c, 0x7d, 0xaa, 0x2c, 0x44, 0x50, 0xec, 0x2f, 0x7e, 0x3b, 0x6a,
-	0x56, 0xbf, 0x1d, 0xb9, 0x7f, 0x03, 0x83, 0xfa, 0xe6, 0x8a, 0x9b, 0x43, 0xa3, 0xba, 0x39, 0x9c,
-	0x32, 0x8b, 0xee, 0x3b, 0x2a, 0x99, 0x7a, 0xb5, 0xd0, 0x6c, 0x21, 0x02, 0x3f, 0x73, 0x77, 0xeb,
-	0xff, 0xbe, 0xbd, 0xda, 0xf8, 0xff, 0x6f, 0xaf, 0x36, 0x7e, 0xfa, 0xed, 0xd5, 0x57, 0xbe, 0xf9,
-	0xd9, 0xd5, 0xc6, 0x17, 0xef, 0xd6, 0xfe, 0xf0, 0x9a, 0x8a, 0x5c, 0x85, 0x47, 0xfa, 0xbe, 0x53,
-	0x00, 0xb1, 0xbc, 0x9d, 0x1e, 0x4e, 0x6e, 0xa7, 0xfb, 0xb7, 0x0b, 0x8d, 0xed, 0x77, 0xe9, 0x7f,
-	0xae, 0x3f, 0xfd, 0x75, 0x00, 0x00, 0x00, 0xff, 0xff, 0x56, 0x3b, 0xfb, 0xf1, 0x37, 0x26, 0x00,
-	0x00,
+	// 3224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4b, 0x73, 0x1d, 0x47,
+	0xd5, 0xb9, 0xef, 0x99, 0x73, 0xef, 0x95, 0xe4, 0x8e, 0x1f, 0x13, 0x39, 0xb6, 0xf5, 0xcd, 0x17,
+	0x7f, 0x76, 0xe2, 0x58, 0xae, 0xe8, 0xfb, 0xfc, 0x55, 0x8a, 0xbc, 0x90, 0x25, 0x27, 0x5c, 0xb0,
+	0x6c, 0xd1, 0x52, 0x2a, 0x45, 0x8a, 0x62, 0x6a, 0x34, 0xd3, 0xf7, 0x6a, 0xe2, 0xb9, 0x3d, 0xe3,
+	0x9e, 0xb9, 0xb6, 0xe4, 0x15, 0x2b, 0x16, 0x10, 0x16, 0x14, 0x7f, 0x20, 0xff, 0x81, 0x35, 0x45,
+	0xb1, 0x63, 0xc1, 0x02, 0xd6, 0x2c, 0xa0, 0xc2, 0x86, 0x05, 0xec, 0x58, 0xa6, 0x28, 0xea, 0x9c,
+	0xee, 0x99, 0x3b, 0xf7, 0x4a, 0xb2, 0x1d, 0x8a, 0xc2, 0x54, 0x91, 0x5d, 0x9f, 0x47, 0x3f, 0xce,
+	0xa3, 0x4f, 0x9f, 0x3e, 0xdd, 0xb0, 0x90, 0x46, 0xa9, 0x88, 0x23, 0x29, 0x56, 0x53, 0x95, 0xe4,
+	0x09, 0xb3, 0x0a, 0x78, 0xf9, 0xfa, 0x28, 0xca, 0xf7, 0x27, 0x7b, 0xab, 0x41, 0x32, 0xbe, 0x31,
+	0x4a, 0x46, 0xc9, 0x0d, 0x62, 0xd8, 0x9b, 0x0c, 0x09, 0x22, 0x80, 0x5a, 0xba, 0xe3, 0x32, 0xa4,
+	0xb1, 0x2f, 0x4d, 0x7b, 0x31, 0x8f, 0xc6, 0x22, 0xcb, 0xfd, 0x71, 0xaa, 0x11, 0xee, 0xa7, 0x75,
+	0xe8, 0x6c, 0x89, 0x2c, 0xf3, 0x47, 0x82, 0x2d, 0x41, 0x23, 0x8b, 0x42, 0xa7, 0xb6, 0x52, 0xbb,
+	0xda, 0xe4, 0xd8, 0x44, 0x4c, 0x30, 0x0e, 0x9d, 0xba, 0xc6, 0x04, 0x63, 0xc2, 0x08, 0xa5, 0x9c,
+	0xc6, 0x4a, 0xed, 0x6a, 0x8f, 0x63, 0x93, 0x31, 0x68, 0x86, 0x7e, 0xee, 0x3b, 0x4d, 0x42, 0x51,
+	0x9b, 0xbd, 0x02, 0x0b, 0xa9, 0x4a, 0x02, 0x2f, 0x92, 0xc3, 0xc4, 0x23, 0x6a, 0x8b, 0xa8, 0x3d,
+	0xc4, 0x0e, 0xe4, 0x30, 0xd9, 0x44, 0x2e, 0x07, 0x3a, 0xbe, 0xf4, 0xe3, 0xc3, 0x4c, 0x38, 0x6d,
+	0x22, 0x17, 0x20, 0x5b, 0x80, 0x7a, 0x14, 0x3a, 0x1d, 0x9a, 0xb6, 0x1e, 0x85, 0x38, 0xc7, 0x64,
+	0x12, 0x85, 0x8e, 0xa5, 0xe7, 0xc0, 0x36, 0x3b, 0x0f, 0xf6, 0x9e, 0x9f, 0x07, 0xfb, 0x5e, 0x20,
+	0x73, 0xc7, 0x26, 0x56, 0x8b, 0x10, 0x1b, 0x32, 0x67, 0xcb, 0x60, 0x05, 0xfb, 0x22, 0xb8, 0x9f,
+	0x4d, 0xc6, 0x0e, 0xac, 0xd4, 0xae, 0xf6, 0x79, 0x09, 0x23, 0x2d, 0x13, 0x0f, 0x26, 0x42, 0x06,
+	0xc2, 0xe9, 0xea, 0x7e, 0x05, 0xec, 0x7e, 0x08, 0xf6, 0x46, 0x22, 0xa5, 0x08, 0xf2, 0x44, 0xb1,
+	0x4b, 0xd0, 0x2d, 0x74, 0xee, 0x19, 0xbd, 0xb4, 0x38, 0x14, 0xa8, 0x41, 0xc8, 0xae, 0xc0, 0x62,
+	0x50, 0x70, 0x7b, 0x91, 0x0c, 0xc5, 0x01, 0xa9, 0xaa, 0xc5, 0x17, 0x4a, 0xf4, 0x00, 0xb1, 0xee,
+	0x67, 0x35, 0xb0, 0x36, 0xa3, 0x2c, 0xc5, 0xe5, 0xb1, 0x73, 0xd0, 0x19, 0x4e, 0x64, 0x30, 0x1d,
+	0xb2, 0x8d, 0xe0, 0x20, 0x64, 0x6f, 0xc3, 0x62, 0x9c, 0x04, 0x7e, 0xec, 0x95, 0xbd, 0x9d, 0xfa,
+	0x4a, 0xe3, 0x6a, 0x77, 0xed, 0xc5, 0xd5, 0xd2, 0x17, 0xca, 0xd5, 0xf1, 0x05, 0xe2, 0x9d, 0xae,
+	0xf6, 0x1d, 0x58, 0x52, 0x62, 0x9c, 0xe4, 0xa2, 0xd2, 0xbd, 0x41, 0xdd, 0xd9, 0xb4, 0xfb, 0x47,
+	0xca, 0x4f, 0xef, 0x26, 0xa1, 0xe0, 0x8b, 0x9a, 0xb7, 0xec, 0xee, 0xfe, 0xac, 0x06, 0xfd, 0xad,
+	0x49, 0x9c, 0x47, 0xeb, 0x6a, 0x34, 0x11, 0x63, 0x99, 0xa3, 0xd2, 0x37, 0xa3, 0x2c, 0xa7, 0x45,
+	0x5a, 0x9c, 0xda, 0xec, 0x2a, 0xd8, 0x1f, 0xa8, 0x64, 0x92, 0xde, 0x3e, 0x48, 0x8b, 0xc5, 0xc1,
+	0x2a, 0xf9, 0x17, 0x62, 0xf8, 0x94, 0xc8, 0x5e, 0x87, 0xee, 0x3d, 0x15, 0x0a, 0x75, 0xeb, 0x90,
+	0x78, 0x1b, 0x47, 0x78, 0xab, 0x64, 0xf6, 0x32, 0xd8, 0x3b, 0x22, 0xf5, 0x95, 0x8f, 0xab, 0x46,
+	0x4f, 0xb2, 0xf9, 0x14, 0x81, 0x8e, 0x42, 0xcc, 0x83, 0x90, 0xfc, 0xa8, 0xc5, 0x0b, 0xd0, 0xbd,
+	0x07, 0xf6, 0xfa, 0x68, 0xa4, 0xc4, 0xc8, 0xcf, 0xc9, 0x6b, 0x92, 0xd4, 0xe8, 0xb4, 0x9e, 0xa4,
+	0xe4, 0x99, 0x28, 0x40, 0x5d, 0x0b, 0x80, 0x6d, 0x76, 0x11, 0x9a, 0x42, 0xaf, 0xa7, 0x36, 0xb7,
+	0x1e, 0xc2, 0xbb, 0x5f, 0xd4, 0xa0, 0x45, 0x42, 0xa0, 0x7f, 0x49, 0x21, 0x42, 0x4f, 0x3c, 0xf4,
+	0x63, 0xa3, 0x03, 0x0b, 0x11, 0xb7, 0x1f, 0xfa, 0x31, 0xae, 0x28, 0xda, 0x9b, 0x04, 0xf7, 0x45,
+	0x6e, 0x36, 0x47, 0x01, 0x22, 0x45, 0x1a, 0x4a, 0x43, 0x53, 0x0c, 0xc8, 0x56, 0xa0, 0x85, 0x53,
+	0x64, 0x4e, 0xf3, 0x88, 0x2e, 0x34, 0x01, 0x39, 0xf2, 0xc3, 0x54, 0x64, 0x4e, 0xab, 0xca, 0xb1,
+	0x7b, 0x98, 0x0a, 0xae, 0x09, 0xec, 0x0a, 0x34, 0xfd, 0xd1, 0x28, 0x73, 0xda, 0xf3, 0x7e, 0x51,
+	0x6a, 0x81, 0x13, 0x03, 0xbb, 0x09, 0xb6, 0xb6, 0x26, 0x72, 0x77, 0x88, 0xfb, 0xdc, 0x94, 0x7b,
+	0xc6, 0xd0, 0x7c, 0xca, 0xe9, 0x7e, 0x04, 0xed, 0x8f, 0x22, 0x19, 0x26, 0x8f, 0xd8, 0x2b, 0xd0,
+	0xcc, 0x52, 0x11, 0x90, 0xe4, 0xdd, 0xb5, 0x25, 0xbd, 0x14, 0x4d, 0xdb, 0x49, 0x45, 0xc0, 0x89,
+	0xca, 0xae, 0x80, 0xfd, 0x28, 0x92, 0x1e, 0x3a, 0x70, 0x76, 0x8c, 0x3f, 0x58, 0x8f, 0x22, 0xf9,
+	0x3e, 0xd2, 0xdc, 0xdf, 0xd7, 0xa1, 0x3d, 0x90, 0x99, 0x50, 0xb4, 0x37, 0xfd, 0xe1, 0x50, 0x04,
+	0xb9, 0x28, 0x62, 0x4d, 0x09, 0x23, 0x6d, 0x90, 0x71, 0x72, 0x4d, 0x63, 0xb6, 0x12, 0x66, 0xff,
+	0x05, 0x0d, 0x25, 0x86, 0xc6, 0x72, 0x8b, 0x7a, 0x96, 0x7b, 0x7b, 0x9f, 0x88, 0x20, 0xe7, 0x62,
+	0xc8, 0x91, 0xc6, 0xae, 0x81, 0x9d, 0xfb, 0x7b, 0xb1, 0xf0, 0x42, 0x31, 0x24, 0x37, 0xea, 0xae,
+	0x2d, 0x18, 0x25, 0x22, 0x7a, 0x53, 0x0c, 0xb9, 0x95, 0x9b, 0x16, 0x7b, 0x17, 0x20, 0xf5, 0x95,
+	0x90, 0xb9, 0x17, 0x85, 0x07, 0x46, 0xe5, 0x97, 0xa6, 0x3a, 0xd2, 0xab, 0x5d, 0xdd, 0x26, 0x96,
+	0x41, 0x78, 0x70, 0x5b, 0xe6, 0xea, 0x90, 0xdb, 0x69, 0x01, 0xb3, 0xff, 0x87, 0xde, 0x46, 0x3c,
+	0xc9, 0x72, 0xa1, 0x68, 0x70, 0x8a, 0x61, 0xb4, 0xd9, 0x70, 0xbe, 0x2a, 0x85, 0xcf, 0xf0, 0xe1,
+	0xfe, 0x8f, 0xc2, 0x03, 0x9a, 0x14, 0x0d, 0xd3, 0xe2, 0xed, 0x28, 0x3c, 0x18, 0x84, 0x07, 0xcb,
+	0x6f, 0xc3, 0xc2, 0xec, 0x6c, 0x18, 0x6d, 0xef, 0x8b, 0x43, 0xd2, 0x92, 0xcd, 0xb1, 0xc9, 0x4e,
+	0x43, 0xeb, 0xa1, 0x1f, 0x4f, 0x84, 0x09, 0x34, 0x1a, 0xf8, 0x5a, 0xfd, 0xcd, 0x9a, 0x7b, 0x01,
+	0x5a, 0xeb, 0x4a, 0xf9, 0xc4, 0xe2, 0x63, 0xc3, 0xa9, 0xd1, 0xe8, 0x1a, 0x70, 0x03, 0x68, 0x6c,
+	0xf9, 0x29, 0xbb, 0x0c, 0xf5, 0x71, 0x4a, 0x94, 0xee, 0xda, 0x99, 0x8a, 0x43, 0xf8, 0xe9, 0xea,
+	0x56, 0xaa, 0x45, 0xac, 0x8f, 0xd3, 0xe5, 0x9b, 0xd0, 0xd9, 0x4a, 0xbf, 0xfc, 0x1a, 0x7e, 0xdc,
+	0x02, 0x6b, 0x53, 0xc4, 0x22, 0x8f, 0x12, 0x89, 0xdb, 0x71, 0x37, 0x33, 0x16, 0xae, 0xef, 0x66,
+	0xcc, 0x85, 0xde, 0xba, 0xb1, 0x33, 0x4f, 0x1e, 0x65, 0x66, 0xe3, 0xcc, 0xe0, 0x90, 0x47, 0x5b,
+	0x9b, 0x46, 0x11, 0x64, 0x6c, 0x8b, 0xcf, 0xe0, 0x70, 0x87, 0x0d, 0x6e, 0xe9, 0x1d, 0xd6, 0xa4,
+	0xd0, 0x5e, 0x80, 0x48, 0xb9, 0x6b, 0x28, 0x2d, 0x4d, 0x31, 0x20, 0x5b, 0x81, 0xee, 0x86, 0x2f,
+	0x77, 0xd5, 0x44, 0x06, 0x7e, 0xae, 0x4d, 0x65, 0xf1, 0x2a, 0x8a, 0x5d, 0x81, 0xf6, 0xa6, 0x88,
+	0xb9, 0x18, 0x9a, 0xdd, 0x72, 0xc4, 0xc1, 0x0c, 0x99, 0x9d, 0x85, 0xf6, 0x80, 0xec, 0xe5, 0x58,
+	0xda, 0x7a, 0x1a, 0x62, 0xaf, 0x40, 0xff, 0x9e, 0xe4, 0x22, 0xcb, 0x55, 0x14, 0xa0, 0x05, 0x1d,
+	0x9b, 0xc8, 0xb3, 0x48, 0x14, 0xf0, 0x9e, 0xdc, 0xf0, 0xb3, 0xc0, 0x0f, 0x05, 0x32, 0x01, 0x31,
+	0xcd, 0xe0, 0xd8, 0x35, 0xb0, 0xee, 0xc9, 0x1d, 0x81, 0xb3, 0x3a, 0xdd, 0xe3, 0x17, 0x53, 0x32,
+	0xb0, 0xff, 0xc3, 0x69, 0x77, 0x44, 0x5e, 0x38, 0xb8, 0xd3, 0x5b, 0x69, 0x1c, 0xe3, 0xf6, 0xb3,
+	0x4c, 0xec, 0x26, 0x2c, 0x10, 0xe2, 0xc3, 0x34, 0xf4, 0xf1, 0x14, 0x88, 0x9d, 0x3e, 0x75, 0xeb,
+	0xcf, 0xb8, 0x04, 0x9f, 0x63, 0x2a, 0x57, 0x86, 0x2b, 0x5f, 0x28, 0x56, 0x56, 0x86, 0x20, 0xf4,
+	0x33, 0x5e, 0x32, 0xb0, 0x5b, 0x00, 0x3b, 0x62, 0x34, 0x16, 0x32, 0xdf, 0xf2, 0x53, 0x67, 0x91,
+	0xd8, 0xdd, 0x29, 0x7b, 0xe1, 0x27, 0xab, 0x53, 0x26, 0xed, 0x7f, 0x95, 0x5e, 0xcb, 0xef, 0xc0,
+	0xe2, 0x1c, 0xf9, 0x4b, 0xf9, 0xe3, 0xf7, 0xeb, 0x60, 0x6f, 0x2b, 0x61, 0x02, 0xcf, 0x25, 0xe8,
+	0x66, 0xc1, 0xbe, 0x18, 0xfb, 0x9e, 0xf4, 0xc7, 0xc2, 0x8c, 0x00, 0x1a, 0x75, 0xd7, 0x1f, 0x8b,
+	0xd9, 0xf0, 0x51, 0x7f, 0x4a, 0xf8, 0xf8, 0x1e, 0x9c, 0x99, 0x86, 0x0f, 0x2f, 0x55, 0xc2, 0x8b,
+	0x68, 0x1a, 0x73, 0xd4, 0x5d, 0x9b, 0x4a, 0x5a, 0xae, 0x60, 0x1a, 0x4c, 0x4a, 0x94, 0x16, 0x99,
+	0xa5, 0x47, 0x08, 0xcb, 0xb7, 0xe1, 0xdc, 0x09, 0xec, 0x5f, 0x4a, 0x05, 0xbf, 0xad, 0xa3, 0xa9,
+	0x37, 0x27, 0x69, 0x1c, 0xa1, 0x9f, 0x7f, 0x4b, 0x1c, 0x3e, 0x31, 0x00, 0x5f, 0x85, 0xa5, 0x44,
+	0x7a, 0x61, 0xc1, 0x4e, 0x51, 0xaa, 0x4e, 0x3e, 0xba, 0x90, 0x4c, 0x47, 0x41, 0xf3, 0x7e, 0x07,
+	0x4e, 0xcd, 0x70, 0x8a, 0xe9, 0x31, 0x7f, 0x7d, 0x2a, 0xfb, 0xec, 0xd4, 0x55, 0x10, 0x0f, 0x08,
+	0x2d, 0xfd, 0x62, 0x32, 0x8b, 0x2d, 0x22, 0x7d, 0xf3, 0x59, 0x23, 0x7d, 0xeb, 0xc9, 0xa6, 0x5a,
+	0xbe, 0x0b, 0xa7, 0x8f, 0x9b, 0xf8, 0x18, 0x3d, 0xae, 0x54, 0xf5, 0x38, 0x77, 0x46, 0x4f, 0x75,
+	0xfa, 0x83, 0x3a, 0x34, 0xbf, 0x99, 0x44, 0xb2, 0x9a, 0x06, 0xd4, 0x4e, 0x4c, 0x03, 0xea, 0xb3,
+	0x69, 0xc0, 0x4b, 0x60, 0x29, 0x11, 0x7b, 0x31, 0x66, 0x26, 0x0d, 0xd2, 0x6c, 0x47, 0x89, 0xf8,
+	0x0e, 0x26, 0x27, 0x2f, 0x81, 0x15, 0x24, 0x86, 0xd4, 0xd4, 0xa4, 0x20, 0x89, 0xef, 0x54, 0xf3,
+	0x96, 0xd6, 0xf1, 0x79, 0xcb, 0x34, 0x75, 0x68, 0x9f, 0x9c, 0x3a, 0xd8, 0xb1, 0x18, 0xe6, 0x98,
+	0x1d, 0x86, 0x4e, 0xa7, 0xca, 0xa5, 0x8f, 0x6a, 0x24, 0x6e, 0x24, 0x32, 0x64, 0xaf, 0x02, 0xa8,
+	0x68, 0xb4, 0x6f, 0x38, 0xad, 0xa3, 0x49, 0x1e, 0x51, 0x91, 0xd5, 0xfd, 0x73, 0x0d, 0xac, 0x75,
+	0x99, 0x47, 0xff, 0xb0, 0x32, 0xce, 0x42, 0x5b, 0x89, 0x6c, 0x12, 0x17, 0xaa, 0x30, 0x50, 0x29,
+	0x6e, 0xf3, 0x69, 0xe2, 0xb6, 0x9e, 0x49, 0xdc, 0xf6, 0x33, 0x8b, 0xdb, 0x79, 0x92, 0xb8, 0x3f,
+	0xaa, 0x83, 0x3d, 0x90, 0x52, 0xa8, 0xaf, 0x8c, 0x2f, 0x43, 0xf7, 0x87, 0x75, 0xb0, 0xee, 0x88,
+	0x61, 0xfe, 0x95, 0x32, 0x64, 0xe8, 0xfe, 0xb2, 0x0e, 0x36, 0x47, 0xe8, 0xdf, 0x4c, 0x1b, 0xaf,
+	0x02, 0x90, 0xac, 0x27, 0xa9, 0x84, 0x34, 0xb1, 0x4b, 0x6a, 0xb9, 0x06, 0x5d, 0x2d, 0xad, 0xe6,
+	0xed, 0x1c, 0xe1, 0xd5, 0xca, 0xd8, 0x3d, 0xaa, 0x43, 0xeb, 0x99, 0x75, 0x68, 0x3f, 0x49, 0x87,
+	0x5f, 0xd4, 0xa0, 0x4f, 0x3a, 0xdc, 0x11, 0xe3, 0x7f, 0x7d, 0x48, 0x99, 0x13, 0xbf, 0xf5, 0xec,
+	0xe2, 0xff, 0x93, 0xa2, 0x4b, 0x29, 0xfe, 0x73, 0x89, 0xa8, 0xcf, 0x5d, 0x7c, 0x3c, 0x4b, 0x9e,
+	0x8b, 0xe1, 0x9f, 0xcf, 0x59, 0xf2, 0x69, 0x1d, 0x60, 0x27, 0x92, 0xa3, 0x58, 0x7c, 0x15, 0x3f,
+	0x65, 0xe8, 0xfe, 0xa4, 0x0e, 0xd6, 0x96, 0xaf, 0xee, 0xff, 0x67, 0x58, 0x9f, 0xfd, 0x37, 0x74,
+	0x12, 0xa9, 0xcd, 0x73, 0x54, 0x2d, 0xed, 0x44, 0xa2, 0xa5, 0x5c, 0x1f, 0x3a, 0xdb, 0x2a, 0x09,
+	0x27, 0xc1, 0xac, 0xa9, 0x6b, 0x27, 0x9b, 0xba, 0x3e, 0x6b, 0xea, 0x52, 0xb6, 0xc6, 0x09, 0xb2,
+	0xb9, 0x3f, 0xad, 0x41, 0x9f, 0x12, 0x66, 0xac, 0xd2, 0xd0, 0xad, 0x1d, 0xab, 0x07, 0x79, 0xae,
+	0x32, 0x9a, 0xc6, 0xe6, 0x1a, 0x60, 0x2b, 0xd0, 0x54, 0x22, 0x2f, 0x4a, 0x3c, 0x3d, 0x53, 0xe3,
+	0x48, 0x62, 0xcc, 0xb3, 0x89, 0x82, 0x7a, 0xf6, 0xd5, 0x28, 0x3b, 0xa6, 0xd0, 0x47, 0x78, 0xb4,
+	0x0f, 0x96, 0xf3, 0xc6, 0x99, 0x29, 0x14, 0x1b, 0x08, 0x8b, 0x74, 0x74, 0x1b, 0x6b, 0x51, 0x12,
+	0x4e, 0x6d, 0xf7, 0xe7, 0x35, 0xb0, 0xbf, 0xe1, 0x67, 0xfb, 0xb7, 0x26, 0x51, 0x1c, 0x4e, 0x0b,
+	0x71, 0x68, 0xc6, 0x6a, 0x21, 0x0e, 0xcd, 0x57, 0x10, 0xf7, 0xfd, 0x6c, 0xbf, 0xa8, 0x18, 0x21,
+	0x02, 0xbb, 0x57, 0xfd, 0xa8, 0x71, 0xa2, 0x1f, 0x35, 0x8f, 0x54, 0xe9, 0x9e, 0xe2, 0x0f, 0x2b,
+	0xd0, 0x42, 0x03, 0x67, 0xc7, 0xf8, 0x82, 0x26, 0xb8, 0xeb, 0x70, 0xe6, 0xf6, 0x41, 0x2e, 0x94,
+	0xf4, 0x63, 0xbc, 0x57, 0xae, 0x6d, 0x24, 0x31, 0xd5, 0x81, 0x4b, 0x61, 0x6b, 0x53, 0x61, 0x51,
+	0xe1, 0xd5, 0xd2, 0xb1, 0x06, 0xdc, 0xcb, 0xd0, 0x1d, 0x46, 0xb1, 0xf0, 0x92, 0xe1, 0x30, 0xd3,
+	0xde, 0xad, 0x5b, 0x64, 0x96, 0x06, 0x37, 0x90, 0xfb, 0xb7, 0x3a, 0xf4, 0x8a, 0xa9, 0x76, 0x02,
+	0xff, 0x24, 0xf3, 0x9d, 0x07, 0x9b, 0x46, 0xcb, 0xa2, 0xc7, 0x82, 0x6c, 0xd8, 0xe0, 0x16, 0x22,
+	0x76, 0xa2, 0xc7, 0x82, 0xad, 0xc3, 0xa9, 0xca, 0x54, 0x5e, 0x9e, 0xe4, 0x7e, 0xec, 0x34, 0xe6,
+	0x2b, 0x44, 0x15, 0x16, 0xbe, 0x88, 0xc0, 0x3d, 0x6a, 0xef, 0x22, 0x37, 0xba, 0x47, 0x90, 0xc4,
+	0x45, 0x65, 0x73, 0xce, 0x3d, 0x90, 0xc2, 0x3e, 0x80, 0x45, 0x94, 0x76, 0xcd, 0x43, 0x5f, 0xd5,
+	0xf2, 0x1e, 0xa9, 0xb8, 0x1d, 0xab, 0x33, 0xde, 0x97, 0x55, 0x90, 0x5d, 0x00, 0x08, 0x94, 0xc0,
+	0x0b, 0x67, 0xf6, 0x20, 0xa6, 0x42, 0x8e, 0xcd, 0x6d, 0x8d, 0xd9, 0x79, 0x10, 0x97, 0x92, 0xd2,
+	0x76, 0xe8, 0x90, 0x0e, 0x48, 0x52, 0xda, 0x0f, 0xd7, 0xa1, 0x9b, 0xa8, 0x68, 0x14, 0x49, 0x8f,
+	0x56, 0x6b, 0x1d, 0xb3, 0x5a, 0xd0, 0x0c, 0x1b, 0xb8, 0x66, 0x17, 0xda, 0xc3, 0x28, 0xce, 0x85,
+	0xa2, 0xe7, 0x85, 0xb9, 0x3d, 0xaa, 0x29, 0xee, 0x9f, 0x00, 0xba, 0x03, 0x99, 0xe5, 0x6a, 0x12,
+	0x14, 0x45, 0xaf, 0x99, 0x1a, 0xf4, 0x12, 0x34, 0xf4, 0x15, 0x1a, 0x11, 0xd8, 0x64, 0xff, 0x03,
+	0x4d, 0x5f, 0xe6, 0x91, 0xa9, 0x63, 0x56, 0x6a, 0xf3, 0xc5, 0xb1, 0xcf, 0x89, 0xce, 0xae, 0x43,
+	0xc7, 0x14, 0xf2, 0x4d, 0xec, 0x3a, 0xf6, 0x15, 0xa0, 0xe0, 0x61, 0xab, 0x60, 0x85, 0xe6, 0x85,
+	0xc1, 0x69, 0xcd, 0x0f, 0x5d, 0xbc, 0x3d, 0xf0, 0x92, 0x07, 0xef, 0xd8, 0xfe, 0x68, 0x64, 0x8a,
+	0x96, 0x95, 0x2a, 0x0e, 0x15, 0xbf, 0x39, 0xd2, 0xd8, 0x1a, 0x40, 0x24, 0xa5, 0x50, 0xde, 0x27,
+	0x49, 0x24, 0x9d, 0xce, 0xfc, 0x22, 0xca, 0x9b, 0x10, 0xb7, 0xa3, 0xa2, 0xc9, 0x6e, 0x98, 0x60,
+	0x49, 0x5d, 0xac, 0xf9, 0x75, 0x14, 0xd7, 0x05, 0x1d, 0x34, 0x8b, 0x0e, 0x99, 0x18, 0x47, 0xba,
+	0x83, 0x3d, 0xdf, 0xa1, 0x48, 0x08, 0xf0, 0x89, 0x46, 0xb7, 0xd8, 0x4d, 0xe8, 0x66, 0x74, 0x6e,
+	0xea, 0x2e, 0x40, 0x5d, 0x4e, 0x57, 0xba, 0x94, 0x87, 0x2a, 0x87, 0xac, 0x6c, 0xe3, 0x3c, 0x63,
+	0x5f, 0xdd, 0xd7, 0x9d, 0xba, 0xf3, 0xf3, 0x14, 0x47, 0x0f, 0xb7, 0xc6, 0xa6, 0xc5, 0x5c, 0x68,
+	0x12, 0x6f, 0xaf, 0x28, 0x2e, 0x14, 0xbc, 0xda, 0x46, 0x48, 0x63, 0xd7, 0xa0, 0x93, 0xea, 0x08,
+	0xed, 0xf4, 0x89, 0xed, 0x54, 0xb5, 0xea, 0x43, 0x04, 0x5e, 0x70, 0xb0, 0x77, 0x61, 0x41, 0x97,
+	0x2c, 0x86, 0x26, 0xd6, 0x3a, 0x0b, 0x2b, 0xb5, 0xd9, 0xba, 0xfc, 0x4c, 0x28, 0xe6, 0xfd, 0xbc,
+	0x0a, 0xa2, 0x39, 0x30, 0xca, 0x79, 0x7b, 0x18, 0x15, 0x9d, 0xc5, 0x79, 0x73, 0x94, 0x01, 0x93,
+	0xdb, 0xfb, 0x45, 0x93, 0xbd, 0x05, 0x7d, 0x61, 0x76, 0x95, 0x97, 0x05, 0xbe, 0x74, 0x96, 0xa8,
+	0xdb, 0xd9, 0xa3, 0x9b, 0x0e, 0xa3, 0x07, 0xef, 0x89, 0x0a, 0xc4, 0xae, 0x42, 0xdb, 0x94, 0xb4,
+	0x4e, 0x15, 0x8f, 0x00, 0xb3, 0xc5, 0x71, 0x6e, 0xe8, 0xec, 0x35, 0x68, 0x87, 0xba, 0x60, 0xcb,
+	0x8e, 0xb8, 0x9e, 0x29, 0xf3, 0x71, 0xc3, 0xc1, 0x6e, 0xcd, 0x55, 0x98, 0xb0, 0x02, 0xf3, 0x22,
+	0xf5, 0x72, 0x4e, 0x2a, 0x1b, 0xcd, 0xd4, 0x9e, 0xb0, 0x82, 0xb5, 0x06, 0x50, 0x29, 0xb8, 0x9d,
+	0x9e, 0x57, 0x45, 0x59, 0x2e, 0xe3, 0x76, 0x5a, 0x34, 0xd9, 0xeb, 0x60, 0x25, 0xf8, 0x6a, 0xe4,
+	0xed, 0x1d, 0x3a, 0x67, 0x68, 0xe7, 0x9f, 0x32, 0x95, 0x25, 0xfd, 0x0e, 0x45, 0xaf, 0x1a, 0x9d,
+	0x44, 0x03, 0xec, 0x3a, 0xe0, 0x5b, 0x25, 0x96, 0x9c, 0x74, 0x28, 0x39, 0x7b, 0xf4, 0xfd, 0xca,
+	0xd0, 0x29, 0xb2, 0x4c, 0x43, 0xc5, 0xb9, 0x93, 0x42, 0x05, 0x86, 0xe6, 0x38, 0x1a, 0x47, 0xb9,
+	0xe3, 0xd0, 0x89, 0xa3, 0x81, 0x4a, 0x64, 0x7f, 0x89, 0xd0, 0x06, 0xa2, 0xb3, 0x2b, 0x7b, 0x3f,
+	0x52, 0x59, 0xee, 0x2c, 0xd3, 0xb1, 0x56, 0x80, 0xd8, 0x23, 0xca, 0xee, 0xf8, 0x59, 0xee, 0x9c,
+	0x27, 0x82, 0x81, 0x50, 0x29, 0x3a, 0xfd, 0x20, 0xb7, 0x7d, 0x79, 0x5e, 0x29, 0xe5, 0xed, 0xd4,
+	0xe4, 0x21, 0xd8, 0x64, 0xef, 0xc1, 0xa2, 0xee, 0x33, 0xdd, 0x83, 0x17, 0xe6, 0x9d, 0x72, 0xe6,
+	0x4a, 0xc6, 0xfb, 0xaa, 0x0a, 0x4e, 0x07, 0xc0, 0x98, 0xa5, 0x07, 0xb8, 0x78, 0xec, 0x00, 0x65,
+	0x74, 0xeb, 0xab, 0x2a, 0x88, 0x4e, 0xf6, 0x88, 0x5e, 0x95, 0x9c, 0x4b, 0xf3, 0x4e, 0xa6, 0x5f,
+	0x9b, 0xb8, 0xa1, 0xbb, 0x37, 0xa1, 0xb7, 0x4e, 0xef, 0xc3, 0x51, 0x46, 0x3a, 0xbf, 0x0c, 0xcd,
+	0x32, 0x1f, 0x2a, 0x8d, 0x49, 0x1c, 0x8f, 0x05, 0xbe, 0x31, 0x73, 0x22, 0xbb, 0xbf, 0xa8, 0x43,
+	0x7b, 0x27, 0x99, 0xa8, 0x40, 0x3c, 0xbd, 0x00, 0x7c, 0x01, 0x40, 0x6f, 0x51, 0xa2, 0xd7, 0xf5,
+	0xe1, 0x42, 0x18, 0x22, 0x57, 0x53, 0xad, 0x06, 0x9d, 0x2d, 0x65, 0xaa, 0x75, 0x1a, 0x5a, 0x7b,
+	0x71, 0x12, 0xdc, 0x37, 0x8f, 0x97, 0x1a, 0xc0, 0x09, 0xd3, 0x49, 0xb6, 0x1f, 0x26, 0x8f, 0x24,
+	0x3e, 0xf7, 0xb6, 0xc8, 0xc2, 0x50, 0xa0, 0x06, 0x98, 0x07, 0xf6, 0x4b, 0x06, 0x3f, 0x0c, 0x95,
+	0x39, 0xd0, 0x7a, 0x05, 0x72, 0x3d, 0x0c, 0x55, 0x99, 0xc2, 0x76, 0x4e, 0x48, 0x61, 0x5f, 0x83,
+	0xb2, 0xd4, 0xe9, 0x58, 0x4f, 0x2e, 0x85, 0xb2, 0x35, 0xb0, 0xcb, 0x2f, 0x00, 0x26, 0xdc, 0x9e,
+	0x5e, 0x2d, 0x31, 0xab, 0xbb, 0x45, 0x8b, 0x4f, 0xd9, 0xdc, 0xef, 0x82, 0x85, 0x6f, 0xc6, 0xa8,
+	0x53, 0xcc, 0x60, 0xc6, 0x41, 0x3a, 0x31, 0x27, 0x1c, 0xb5, 0xcd, 0x6b, 0xbd, 0xd6, 0x96, 0x79,
+	0xad, 0x27, 0x59, 0x1a, 0x84, 0xa1, 0x36, 0xba, 0x73, 0xea, 0x1f, 0xc6, 0x89, 0x1f, 0x52, 0x92,
+	0x60, 0xf3, 0x02, 0x74, 0x7f, 0x5d, 0x83, 0x53, 0xdb, 0x2a, 0x09, 0x44, 0x96, 0xdd, 0xc1, 0x1d,
+	0xe1, 0x53, 0xb0, 0x63, 0xd0, 0xa4, 0x64, 0x05, 0xe7, 0x69, 0x70, 0x6a, 0xa3, 0x75, 0xf4, 0x8b,
+	0xbf, 0x2a, 0x9e, 0x8f, 0x1a, 0x5c, 0xff, 0x01, 0xa0, 0xb7, 0xa3, 0x92, 0x4c, 0x1d, 0x1b, 0x15,
+	0x32, 0xa5, 0x39, 0x97, 0x61, 0x21, 0xf5, 0x55, 0x1e, 0xe1, 0xf0, 0x7a, 0x84, 0x26, 0xb1, 0xf4,
+	0x4b, 0x2c, 0x8d, 0x72, 0x09, 0xba, 0x4a, 0xf8, 0x18, 0x27, 0x68, 0x98, 0x16, 0xf1, 0x80, 0x46,
+	0xed, 0x98, 0x55, 0x64, 0x69, 0x14, 0xc7, 0x9a, 0xde, 0xd6, 0xd3, 0x10, 0x06, 0xc9, 0xee, 0x5f,
+	0x6a, 0xd0, 0x35, 0xe2, 0x90, 0xc2, 0xb4, 0x72, 0x6a, 0xa5, 0x72, 0xae, 0x43, 0x23, 0x8e, 0xc6,
+	0xa6, 0xbe, 0x7c, 0x7e, 0xe6, 0xb8, 0x98, 0x55, 0x01, 0x47, 0x3e, 0xcc, 0x67, 0x26, 0x32, 0x3a,
+	0xf0, 0xd0, 0x1a, 0x46, 0x26, 0x0b, 0x11, 0x68, 0x28, 0xfa, 0xc9, 0x20, 0xfd, 0x34, 0xdb, 0x4f,
+	0x72, 0xe3, 0x77, 0x25, 0xcc, 0xde, 0x84, 0x5e, 0x26, 0xb2, 0x0c, 0x85, 0x8d, 0xe4, 0x30, 0x31,
+	0x39, 0xc1, 0x99, 0xea, 0xd1, 0x4a, 0x54, 0xda, 0x29, 0xdd, 0x6c, 0x0a, 0xb0, 0xd7, 0x81, 0xf9,
+	0x66, 0x9f, 0x79, 0x32, 0x09, 0x4d, 0x2e, 0xd5, 0xa6, 0xab, 0xc5, 0x52, 0x41, 0x41, 0x87, 0xa0,
+	0x4b, 0xca, 0xef, 0x6a, 0xd0, 0xad, 0x0c, 0x45, 0x5f, 0x35, 0x32, 0xa1, 0x8a, 0x14, 0x17, 0xdb,
+	0x88, 0xdb, 0x4f, 0xcc, 0x43, 0xbc, 0xcd, 0xa9, 0x8d, 0x38, 0x95, 0xc4, 0xa2, 0x70, 0x12, 0x6c,
+	0xe3, 0x6e, 0x30, 0xe9, 0x0c, 0x2d, 0x3b, 0x34, 0xb9, 0x79, 0x6f, 0x8a, 0x1c, 0xd0, 0x13, 0x31,
+	0xfe, 0x28, 0xd9, 0xf3, 0xb3, 0xe2, 0xd2, 0x50, 0xc2, 0xe8, 0x65, 0x0f, 0x85, 0xc2, 0xb5, 0x98,
+	0x8d, 0x54, 0x80, 0xa8, 0x47, 0x54, 0xa1, 0xf7, 0x38, 0x91, 0x82, 0x36, 0x52, 0x8f, 0x5b, 0x88,
+	0xf8, 0x38, 0x91, 0xd4, 0xcd, 0x0f, 0x82, 0x64, 0x22, 0x73, 0xda, 0x3f, 0x36, 0x2f, 0x40, 0xf7,
+	0xaf, 0x4d, 0xb0, 0xb6, 0x8d, 0xc6, 0xd8, 0x26, 0xf4, 0xcb, 0xff, 0x20, 0x78, 0x15, 0x20, 0x19,
+	0x17, 0xaa, 0x19, 0xec, 0xf6, 0x7c, 0x83, 0xee, 0x0d, 0xbd, 0xb4, 0x02, 0xcd, 0xff, 0x2a, 0xa9,
+	0x1f, 0xf9, 0x55, 0xf2, 0x32, 0x34, 0x1e, 0xa8, 0xc3, 0xd9, 0x1f, 0x0a, 0xdb, 0xb1, 0x2f, 0x39,
+	0xa2, 0xd9, 0x1b, 0xd0, 0x45, 0x71, 0xbd, 0x8c, 0x42, 0x9a, 0xd3, 0x9c, 0x0f, 0x9a, 0x3a, 0xd4,
+	0x71, 0x40, 0x26, 0xdd, 0xc6, 0xd4, 0x30, 0xd8, 0x8f, 0xe2, 0x50, 0x09, 0x69, 0x92, 0x6e, 0x76,
+	0x74, 0xc9, 0xbc, 0xe4, 0x61, 0x5f, 0x87, 0xa5, 0x68, 0x9a, 0xd2, 0x4e, 0xcd, 0x3f, 0xe3, 0x3e,
+	0x95, 0xa4, 0x97, 0x2f, 0x56, 0xd8, 0x29, 0x1a, 0x9e, 0xc1, 0x23, 0xca, 0x13, 0x52, 0xff, 0xe1,
+	0xb1, 0x78, 0x2b, 0xca, 0x6e, 0xcb, 0x90, 0x5e, 0xbe, 0xb3, 0x69, 0x6a, 0x48, 0x47, 0x17, 0x1d,
+	0x02, 0x9a, 0x40, 0xd1, 0xc1, 0x2e, 0xcf, 0xb4, 0xc4, 0x0f, 0x31, 0x59, 0x46, 0x17, 0x34, 0x59,
+	0x5e, 0x65, 0xd9, 0x45, 0x40, 0xe2, 0x44, 0xa7, 0x0f, 0x47, 0x93, 0x6c, 0xdf, 0xd3, 0x91, 0x16,
+	0xfd, 0xbd, 0x4b, 0x7a, 0xa5, 0x40, 0xba, 0x99, 0x3c, 0xd2, 0xbe, 0x79, 0x19, 0x16, 0x0a, 0x21,
+	0x3d, 0x6d, 0xee, 0x1e, 0x71, 0xf5, 0x0b, 0xec, 0x06, 0x22, 0xd9, 0x7b, 0xb0, 0x84, 0x3f, 0x8c,
+	0x32, 0x2f, 0x4f, 0x3c, 0x25, 0x46, 0xf4, 0x06, 0xa6, 0x9f, 0x47, 0x2b, 0x79, 0xd3, 0x87, 0x93,
+	0x28, 0xdc, 0x4d, 0xb8, 0x18, 0x0d, 0xc2, 0x03, 0xde, 0x27, 0xfe, 0x02, 0x74, 0xdf, 0x83, 0x5e,
+	0xd5, 0x01, 0x98, 0x0d, 0xad, 0x2d, 0xa1, 0x46, 0x62, 0xe9, 0x05, 0x06, 0xd0, 0xbe, 0x9b, 0xa8,
+	0xb1, 0x1f, 0x2f, 0xd5, 0xb0, 0xad, 0x1f, 0xb6, 0x97, 0xea, 0xac, 0x07, 0xd6, 0xb6, 0xaf, 0xfc,
+	0x38, 0x16, 0xf1, 0x52, 0xc3, 0x7d, 0x0b, 0xac, 0xe2, 0xa7, 0x0e, 0xdd, 0x70, 0x71, 0x17, 0x52,
+	0x48, 0xd5, 0xbb, 0xca, 0x42, 0x04, 0x1d, 0x0d, 0xc5, 0xc7, 0xa8, 0xfa, 0xf4, 0x63, 0x94, 0xfb,
+	0x6d, 0xe8, 0x55, 0x17, 0x57, 0x5c, 0x41, 0x6a, 0xd3, 0x2b, 0xc8, 0x31, 0xbd, 0xe8, 0xe2, 0xa4,
+	0x92, 0xb1, 0x57, 0x89, 0xdc, 0x16, 0x22, 0x70, 0x9a, 0x5b, 0x1b, 0xbf, 0xfa, 0xfc, 0x62, 0xed,
+	0x37, 0x9f, 0x5f, 0xac, 0xfd, 0xe1, 0xf3, 0x8b, 0x2f, 0x7c, 0xf6, 0xc7, 0x8b, 0xb5, 0x8f, 0xdf,
+	0xa8, 0xfc, 0x41, 0x1b, 0xfb, 0xb9, 0x8a, 0x0e, 0xf4, 0xc5, 0xa9, 0x00, 0xa4, 0xb8, 0x91, 0xde,
+	0x1f, 0xdd, 0x48, 0xf7, 0x6e, 0x14, 0x1a, 0xdb, 0x6b, 0xd3, 0x8f, 0xb3, 0xff, 0xfd, 0xfb, 0x00,
+	0x3d, 0xbd, 0x5a, 0x5e, 0xd9, 0x26, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -4196,6 +4271,59 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Window) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Window) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Window) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WinFuncs) > 0 {
+		for iNdEx := len(m.WinFuncs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WinFuncs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPipeline(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Insert) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -4221,21 +4349,21 @@ func (m *Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA3 := make([]byte, len(m.IdxIdx)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.IdxIdx)*10)
+		var j3 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintPipeline(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintPipeline(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -4335,21 +4463,21 @@ func (m *Array) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Array) > 0 {
-		dAtA8 := make([]byte, len(m.Array)*10)
-		var j7 int
+		dAtA9 := make([]byte, len(m.Array)*10)
+		var j8 int
 		for _, num1 := range m.Array {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j7++
+				j8++
 			}
-			dAtA8[j7] = uint8(num)
-			j7++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintPipeline(dAtA, i, uint64(j7))
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintPipeline(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4498,59 +4626,59 @@ func (m *Deletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnCascadeIdx) > 0 {
-		dAtA10 := make([]byte, len(m.OnCascadeIdx)*10)
-		var j9 int
+		dAtA11 := make([]byte, len(m.OnCascadeIdx)*10)
+		var j10 int
 		for _, num1 := range m.OnCascadeIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j10++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA11[j10] = uint8(num)
+			j10++
 		}
-		i -= j9
-		copy(dAtA[i:], dAtA10[:j9])
-		i = encodeVarintPipeline(dAtA, i, uint64(j9))
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintPipeline(dAtA, i, uint64(j10))
 		i--
 		dAtA[i] = 0x52
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA12 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j11 int
+		dAtA13 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j12 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j11++
+				j12++
 			}
-			dAtA12[j11] = uint8(num)
-			j11++
+			dAtA13[j12] = uint8(num)
+			j12++
 		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintPipeline(dAtA, i, uint64(j11))
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintPipeline(dAtA, i, uint64(j12))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA14 := make([]byte, len(m.IdxIdx)*10)
-		var j13 int
+		dAtA15 := make([]byte, len(m.IdxIdx)*10)
+		var j14 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j14++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintPipeline(dAtA, i, uint64(j13))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintPipeline(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -4749,21 +4877,21 @@ func (m *OnDuplicateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA20 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j19 int
+		dAtA21 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j20 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j20++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintPipeline(dAtA, i, uint64(j19))
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintPipeline(dAtA, i, uint64(j20))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4854,40 +4982,40 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA23 := make([]byte, len(m.ColList)*10)
-		var j22 int
+		dAtA24 := make([]byte, len(m.ColList)*10)
+		var j23 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j22++
+				j23++
 			}
-			dAtA23[j22] = uint8(num)
-			j22++
+			dAtA24[j23] = uint8(num)
+			j23++
 		}
-		i -= j22
-		copy(dAtA[i:], dAtA23[:j22])
-		i = encodeVarintPipeline(dAtA, i, uint64(j22))
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintPipeline(dAtA, i, uint64(j23))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA25 := make([]byte, len(m.RelList)*10)
-		var j24 int
+		dAtA26 := make([]byte, len(m.RelList)*10)
+		var j25 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j24++
+				j25++
 			}
-			dAtA25[j24] = uint8(num)
-			j24++
+			dAtA26[j25] = uint8(num)
+			j25++
 		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintPipeline(dAtA, i, uint64(j24))
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintPipeline(dAtA, i, uint64(j25))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4983,21 +5111,21 @@ func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA28 := make([]byte, len(m.Result)*10)
-		var j27 int
+		dAtA29 := make([]byte, len(m.Result)*10)
+		var j28 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j28++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA29[j28] = uint8(num)
+			j28++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintPipeline(dAtA, i, uint64(j27))
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintPipeline(dAtA, i, uint64(j28))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5093,40 +5221,40 @@ func (m *InnerJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA31 := make([]byte, len(m.ColList)*10)
-		var j30 int
+		dAtA32 := make([]byte, len(m.ColList)*10)
+		var j31 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j30++
+				j31++
 			}
-			dAtA31[j30] = uint8(num)
-			j30++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j30
-		copy(dAtA[i:], dAtA31[:j30])
-		i = encodeVarintPipeline(dAtA, i, uint64(j30))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintPipeline(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA33 := make([]byte, len(m.RelList)*10)
-		var j32 int
+		dAtA34 := make([]byte, len(m.RelList)*10)
+		var j33 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j33++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA34[j33] = uint8(num)
+			j33++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintPipeline(dAtA, i, uint64(j32))
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintPipeline(dAtA, i, uint64(j33))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5222,40 +5350,40 @@ func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA36 := make([]byte, len(m.ColList)*10)
-		var j35 int
+		dAtA37 := make([]byte, len(m.ColList)*10)
+		var j36 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j35++
+				j36++
 			}
-			dAtA36[j35] = uint8(num)
-			j35++
+			dAtA37[j36] = uint8(num)
+			j36++
 		}
-		i -= j35
-		copy(dAtA[i:], dAtA36[:j35])
-		i = encodeVarintPipeline(dAtA, i, uint64(j35))
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintPipeline(dAtA, i, uint64(j36))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA38 := make([]byte, len(m.RelList)*10)
-		var j37 int
+		dAtA39 := make([]byte, len(m.RelList)*10)
+		var j38 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA39[j38] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j37++
+				j38++
 			}
-			dAtA38[j37] = uint8(num)
-			j37++
+			dAtA39[j38] = uint8(num)
+			j38++
 		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintPipeline(dAtA, i, uint64(j37))
+		i -= j38
+		copy(dAtA[i:], dAtA39[:j38])
+		i = encodeVarintPipeline(dAtA, i, uint64(j38))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5365,40 +5493,40 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA41 := make([]byte, len(m.ColList)*10)
-		var j40 int
+		dAtA42 := make([]byte, len(m.ColList)*10)
+		var j41 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j40++
+				j41++
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			dAtA42[j41] = uint8(num)
+			j41++
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintPipeline(dAtA, i, uint64(j40))
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintPipeline(dAtA, i, uint64(j41))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA43 := make([]byte, len(m.RelList)*10)
-		var j42 int
+		dAtA44 := make([]byte, len(m.RelList)*10)
+		var j43 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j43++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA44[j43] = uint8(num)
+			j43++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintPipeline(dAtA, i, uint64(j42))
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintPipeline(dAtA, i, uint64(j43))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5494,21 +5622,21 @@ func (m *RightSemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA46 := make([]byte, len(m.Result)*10)
-		var j45 int
+		dAtA47 := make([]byte, len(m.Result)*10)
+		var j46 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j46++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA47[j46] = uint8(num)
+			j46++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPipeline(dAtA, i, uint64(j45))
+		i -= j46
+		copy(dAtA[i:], dAtA47[:j46])
+		i = encodeVarintPipeline(dAtA, i, uint64(j46))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5604,21 +5732,21 @@ func (m *RightAntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA49 := make([]byte, len(m.Result)*10)
-		var j48 int
+		dAtA50 := make([]byte, len(m.Result)*10)
+		var j49 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j49++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA50[j49] = uint8(num)
+			j49++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPipeline(dAtA, i, uint64(j48))
+		i -= j49
+		copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintPipeline(dAtA, i, uint64(j49))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5714,21 +5842,21 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA52 := make([]byte, len(m.Result)*10)
-		var j51 int
+		dAtA53 := make([]byte, len(m.Result)*10)
+		var j52 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j52++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA53[j52] = uint8(num)
+			j52++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPipeline(dAtA, i, uint64(j51))
+		i -= j52
+		copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintPipeline(dAtA, i, uint64(j52))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5824,40 +5952,40 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.ColList) > 0 {
-		dAtA55 := make([]byte, len(m.ColList)*10)
-		var j54 int
+		dAtA56 := make([]byte, len(m.ColList)*10)
+		var j55 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j54++
+				j55++
 			}
-			dAtA55[j54] = uint8(num)
-			j54++
+			dAtA56[j55] = uint8(num)
+			j55++
 		}
-		i -= j54
-		copy(dAtA[i:], dAtA55[:j54])
-		i = encodeVarintPipeline(dAtA, i, uint64(j54))
+		i -= j55
+		copy(dAtA[i:], dAtA56[:j55])
+		i = encodeVarintPipeline(dAtA, i, uint64(j55))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.RelList) > 0 {
-		dAtA57 := make([]byte, len(m.RelList)*10)
-		var j56 int
+		dAtA58 := make([]byte, len(m.RelList)*10)
+		var j57 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j56++
+				j57++
 			}
-			dAtA57[j56] = uint8(num)
-			j56++
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
-		i -= j56
-		copy(dAtA[i:], dAtA57[:j56])
-		i = encodeVarintPipeline(dAtA, i, uint64(j56))
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintPipeline(dAtA, i, uint64(j57))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5967,21 +6095,21 @@ func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if len(m.Result) > 0 {
-		dAtA60 := make([]byte, len(m.Result)*10)
-		var j59 int
+		dAtA61 := make([]byte, len(m.Result)*10)
+		var j60 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j59++
+				j60++
 			}
-			dAtA60[j59] = uint8(num)
-			j59++
+			dAtA61[j60] = uint8(num)
+			j60++
 		}
-		i -= j59
-		copy(dAtA[i:], dAtA60[:j59])
-		i = encodeVarintPipeline(dAtA, i, uint64(j59))
+		i -= j60
+		copy(dAtA[i:], dAtA61[:j60])
+		i = encodeVarintPipeline(dAtA, i, uint64(j60))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6037,40 +6165,40 @@ func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA62 := make([]byte, len(m.ColList)*10)
-		var j61 int
+		dAtA63 := make([]byte, len(m.ColList)*10)
+		var j62 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j61++
+				j62++
 			}
-			dAtA62[j61] = uint8(num)
-			j61++
+			dAtA63[j62] = uint8(num)
+			j62++
 		}
-		i -= j61
-		copy(dAtA[i:], dAtA62[:j61])
-		i = encodeVarintPipeline(dAtA, i, uint64(j61))
+		i -= j62
+		copy(dAtA[i:], dAtA63[:j62])
+		i = encodeVarintPipeline(dAtA, i, uint64(j62))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA64 := make([]byte, len(m.RelList)*10)
-		var j63 int
+		dAtA65 := make([]byte, len(m.RelList)*10)
+		var j64 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j63++
+				j64++
 			}
-			dAtA64[j63] = uint8(num)
-			j63++
+			dAtA65[j64] = uint8(num)
+			j64++
 		}
-		i -= j63
-		copy(dAtA[i:], dAtA64[:j63])
-		i = encodeVarintPipeline(dAtA, i, uint64(j63))
+		i -= j64
+		copy(dAtA[i:], dAtA65[:j64])
+		i = encodeVarintPipeline(dAtA, i, uint64(j64))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6304,21 +6432,21 @@ func (m *FileOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Offset) > 0 {
-		dAtA66 := make([]byte, len(m.Offset)*10)
-		var j65 int
+		dAtA67 := make([]byte, len(m.Offset)*10)
+		var j66 int
 		for _, num1 := range m.Offset {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j65++
+				j66++
 			}
-			dAtA66[j65] = uint8(num)
-			j65++
+			dAtA67[j66] = uint8(num)
+			j66++
 		}
-		i -= j65
-		copy(dAtA[i:], dAtA66[:j65])
-		i = encodeVarintPipeline(dAtA, i, uint64(j65))
+		i -= j66
+		copy(dAtA[i:], dAtA67[:j66])
+		i = encodeVarintPipeline(dAtA, i, uint64(j66))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6434,21 +6562,21 @@ func (m *ExternalScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.FileSize) > 0 {
-		dAtA69 := make([]byte, len(m.FileSize)*10)
-		var j68 int
+		dAtA70 := make([]byte, len(m.FileSize)*10)
+		var j69 int
 		for _, num1 := range m.FileSize {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA70[j69] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j68++
+				j69++
 			}
-			dAtA69[j68] = uint8(num)
-			j68++
+			dAtA70[j69] = uint8(num)
+			j69++
 		}
-		i -= j68
-		copy(dAtA[i:], dAtA69[:j68])
-		i = encodeVarintPipeline(dAtA, i, uint64(j68))
+		i -= j69
+		copy(dAtA[i:], dAtA70[:j69])
+		i = encodeVarintPipeline(dAtA, i, uint64(j69))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6488,6 +6616,20 @@ func (m *Instruction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPipeline(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
 	if m.RightAntiJoin != nil {
 		{
 			size, err := m.RightAntiJoin.MarshalToSizedBuffer(dAtA[:i])
@@ -7078,6 +7220,11 @@ func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SpillSize != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.SpillSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ReaderSize != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
 		i--
@@ -7131,21 +7278,21 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.AnalysisNodeList) > 0 {
-		dAtA96 := make([]byte, len(m.AnalysisNodeList)*10)
-		var j95 int
+		dAtA98 := make([]byte, len(m.AnalysisNodeList)*10)
+		var j97 int
 		for _, num1 := range m.AnalysisNodeList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j95++
+				j97++
 			}
-			dAtA96[j95] = uint8(num)
-			j95++
+			dAtA98[j97] = uint8(num)
+			j97++
 		}
-		i -= j95
-		copy(dAtA[i:], dAtA96[:j95])
-		i = encodeVarintPipeline(dAtA, i, uint64(j95))
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintPipeline(dAtA, i, uint64(j97))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -7725,6 +7872,28 @@ func (m *Group) ProtoSize() (n int) {
 	return n
 }
 
+func (m *Window) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.ProtoSize()
+		n += 1 + l + sovPipeline(uint64(l))
+	}
+	if len(m.WinFuncs) > 0 {
+		for _, e := range m.WinFuncs {
+			l = e.ProtoSize()
+			n += 1 + l + sovPipeline(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Insert) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -8817,6 +8986,10 @@ func (m *Instruction) ProtoSize() (n int) {
 		l = m.RightAntiJoin.ProtoSize()
 		n += 2 + l + sovPipeline(uint64(l))
 	}
+	if m.Window != nil {
+		l = m.Window.ProtoSize()
+		n += 2 + l + sovPipeline(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8940,6 +9113,9 @@ func (m *ProcessLimitation) ProtoSize() (n int) {
 	if m.ReaderSize != 0 {
 		n += 1 + sovPipeline(uint64(m.ReaderSize))
 	}
+	if m.SpillSize != 0 {
+		n += 1 + sovPipeline(uint64(m.SpillSize))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10262,6 +10438,127 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Window) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPipeline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Window: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Window: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &plan.WindowSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinFuncs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WinFuncs = append(m.WinFuncs, &plan.Expr{})
+			if err := m.WinFuncs[len(m.WinFuncs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPipeline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Insert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17955,6 +18252,42 @@ func (m *Instruction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPipeline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &Window{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -18690,6 +19023,25 @@ func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillSize", wireType)
+			}
+			m.SpillSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpillSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
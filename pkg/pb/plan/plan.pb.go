@@ -12,6 +12,7 @@ import (
 
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	timestamp "github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -286,7 +287,7 @@ func (x ForeignKeyDef_RefAction) String() string {
 }
 
 func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{25, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -323,7 +324,60 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{41, 0}
+}
+
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_PRECEDING   FrameBound_BoundType = 0
+	FrameBound_CURRENT_ROW FrameBound_BoundType = 1
+	FrameBound_FOLLOWING   FrameBound_BoundType = 2
+)
+
+var FrameBound_BoundType_name = map[int32]string{
+	0: "PRECEDING",
+	1: "CURRENT_ROW",
+	2: "FOLLOWING",
+}
+
+var FrameBound_BoundType_value = map[string]int32{
+	"PRECEDING":   0,
+	"CURRENT_ROW": 1,
+	"FOLLOWING":   2,
+}
+
+func (x FrameBound_BoundType) String() string {
+	return proto.EnumName(FrameBound_BoundType_name, int32(x))
+}
+
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{42, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS  FrameClause_FrameType = 0
+	FrameClause_RANGE FrameClause_FrameType = 1
+)
+
+var FrameClause_FrameType_name = map[int32]string{
+	0: "ROWS",
+	1: "RANGE",
+}
+
+var FrameClause_FrameType_value = map[string]int32{
+	"ROWS":  0,
+	"RANGE": 1,
+}
+
+func (x FrameClause_FrameType) String() string {
+	return proto.EnumName(FrameClause_FrameType_name, int32(x))
+}
+
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{43, 0}
 }
 
 type Node_NodeType int32
@@ -450,7 +504,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 0}
 }
 
 type Node_JoinType int32
@@ -496,7 +550,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 1}
 }
 
 type Node_AggMode int32
@@ -524,7 +578,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{48, 2}
 }
 
 type Query_StatementType int32
@@ -561,7 +615,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
 }
 
 type TransationControl_TclType int32
@@ -589,7 +643,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -617,7 +671,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{57, 0}
 }
 
 type DataControl_DclType int32
@@ -657,7 +711,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{62, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -775,7 +829,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{63, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -809,16 +863,18 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{70, 0}
 }
 
 type Type struct {
-	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	NotNullable          bool     `protobuf:"varint,2,opt,name=notNullable,proto3" json:"notNullable,omitempty"`
-	AutoIncr             bool     `protobuf:"varint,3,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
-	Width                int32    `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
-	Scale                int32    `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
-	Table                string   `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	NotNullable bool   `protobuf:"varint,2,opt,name=notNullable,proto3" json:"notNullable,omitempty"`
+	AutoIncr    bool   `protobuf:"varint,3,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
+	Width       int32  `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Scale       int32  `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	Table       string `protobuf:"bytes,6,opt,name=table,proto3" json:"table,omitempty"`
+	// enumvalues is the members of ENUM and SET, see types.FormatEnumValues
+	Enumvalues           string   `protobuf:"bytes,7,opt,name=enumvalues,proto3" json:"enumvalues,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -899,6 +955,13 @@ func (m *Type) GetTable() string {
 	return ""
 }
 
+func (m *Type) GetEnumvalues() string {
+	if m != nil {
+		return m.Enumvalues
+	}
+	return ""
+}
+
 // Const: if a const value can be reprensented by int64 or
 // double, use that, otherwise store a string representation.
 type Const struct {
@@ -2545,10 +2608,13 @@ type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
 	// XXX: Deprecated and to be removed soon.
-	NullAbility          bool     `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NullAbility bool `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
+	// generated is set if the column is a generated column, whose values are
+	// computed from the other columns of the row instead of the default.
+	Generated            *GeneratedCol `protobuf:"bytes,4,opt,name=generated,proto3" json:"generated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Default) Reset()         { *m = Default{} }
@@ -2605,6 +2671,81 @@ func (m *Default) GetNullAbility() bool {
 	return false
 }
 
+func (m *Default) GetGenerated() *GeneratedCol {
+	if m != nil {
+		return m.Generated
+	}
+	return nil
+}
+
+// GeneratedCol is the expression of a generated column. The columns in the
+// expression are referred to by their names, the references to the prior
+// generated columns are replaced by their expressions.
+type GeneratedCol struct {
+	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	// stored is true for STORED columns, whose values are computed on write,
+	// and false for VIRTUAL ones, which are computed on read.
+	Stored               bool     `protobuf:"varint,3,opt,name=stored,proto3" json:"stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeneratedCol) Reset()         { *m = GeneratedCol{} }
+func (m *GeneratedCol) String() string { return proto.CompactTextString(m) }
+func (*GeneratedCol) ProtoMessage()    {}
+func (*GeneratedCol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{20}
+}
+func (m *GeneratedCol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GeneratedCol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GeneratedCol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GeneratedCol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeneratedCol.Merge(m, src)
+}
+func (m *GeneratedCol) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *GeneratedCol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeneratedCol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeneratedCol proto.InternalMessageInfo
+
+func (m *GeneratedCol) GetExpr() *Expr {
+	if m != nil {
+		return m.Expr
+	}
+	return nil
+}
+
+func (m *GeneratedCol) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *GeneratedCol) GetStored() bool {
+	if m != nil {
+		return m.Stored
+	}
+	return false
+}
+
 type OnUpdate struct {
 	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString         string   `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -2617,7 +2758,7 @@ func (m *OnUpdate) Reset()         { *m = OnUpdate{} }
 func (m *OnUpdate) String() string { return proto.CompactTextString(m) }
 func (*OnUpdate) ProtoMessage()    {}
 func (*OnUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{20}
+	return fileDescriptor_2d655ab2f7683c23, []int{21}
 }
 func (m *OnUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +2812,7 @@ func (m *IndexOption) Reset()         { *m = IndexOption{} }
 func (m *IndexOption) String() string { return proto.CompactTextString(m) }
 func (*IndexOption) ProtoMessage()    {}
 func (*IndexOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{21}
+	return fileDescriptor_2d655ab2f7683c23, []int{22}
 }
 func (m *IndexOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2729,7 +2870,7 @@ func (m *PrimaryKeyDef) Reset()         { *m = PrimaryKeyDef{} }
 func (m *PrimaryKeyDef) String() string { return proto.CompactTextString(m) }
 func (*PrimaryKeyDef) ProtoMessage()    {}
 func (*PrimaryKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{22}
+	return fileDescriptor_2d655ab2f7683c23, []int{23}
 }
 func (m *PrimaryKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2812,17 +2953,22 @@ type IndexDef struct {
 	Comment        string   `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
 	Visible        bool     `protobuf:"varint,8,opt,name=visible,proto3" json:"visible,omitempty"`
 	// currently not used
-	Option               *IndexOption `protobuf:"bytes,9,opt,name=option,proto3" json:"option,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Option *IndexOption `protobuf:"bytes,9,opt,name=option,proto3" json:"option,omitempty"`
+	// the algorithm of the index table, e.g. ivfflat, empty means the
+	// unique index or the secondary index
+	IndexAlgo string `protobuf:"bytes,10,opt,name=index_algo,json=indexAlgo,proto3" json:"index_algo,omitempty"`
+	// the number of the lists of the ivfflat index
+	IndexAlgoLists       int64    `protobuf:"varint,11,opt,name=index_algo_lists,json=indexAlgoLists,proto3" json:"index_algo_lists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23}
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2914,6 +3060,20 @@ func (m *IndexDef) GetOption() *IndexOption {
 	return nil
 }
 
+func (m *IndexDef) GetIndexAlgo() string {
+	if m != nil {
+		return m.IndexAlgo
+	}
+	return ""
+}
+
+func (m *IndexDef) GetIndexAlgoLists() int64 {
+	if m != nil {
+		return m.IndexAlgoLists
+	}
+	return 0
+}
+
 type ForeignKeyDef struct {
 	Name                 string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Cols                 []uint64                `protobuf:"varint,2,rep,packed,name=cols,proto3" json:"cols,omitempty"`
@@ -2930,7 +3090,7 @@ func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
 func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyDef) ProtoMessage()    {}
 func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3002,9 +3162,13 @@ func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 }
 
 type CheckDef struct {
+	// Name for anonymous constraints, [TABLE_NAME]_chk_[N] like MySQL
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Name for anonymous constraints, __mo_chk_[INDEX_ID]
-	Check                *Expr    `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// The columns in check are referred to by their names.
+	Check        *Expr  `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	OriginString string `protobuf:"bytes,3,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
+	// The NOT ENFORCED constraints are kept but not checked.
+	Enforced             bool     `protobuf:"varint,4,opt,name=enforced,proto3" json:"enforced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3014,7 +3178,7 @@ func (m *CheckDef) Reset()         { *m = CheckDef{} }
 func (m *CheckDef) String() string { return proto.CompactTextString(m) }
 func (*CheckDef) ProtoMessage()    {}
 func (*CheckDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *CheckDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3057,6 +3221,20 @@ func (m *CheckDef) GetCheck() *Expr {
 	return nil
 }
 
+func (m *CheckDef) GetOriginString() string {
+	if m != nil {
+		return m.OriginString
+	}
+	return ""
+}
+
+func (m *CheckDef) GetEnforced() bool {
+	if m != nil {
+		return m.Enforced
+	}
+	return false
+}
+
 type ClusterByDef struct {
 	Parts []*Expr `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
 	// XXX: Deprecated and to be removed soon.
@@ -3070,7 +3248,7 @@ func (m *ClusterByDef) Reset()         { *m = ClusterByDef{} }
 func (m *ClusterByDef) String() string { return proto.CompactTextString(m) }
 func (*ClusterByDef) ProtoMessage()    {}
 func (*ClusterByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *ClusterByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3125,7 +3303,7 @@ func (m *PropertyDef) Reset()         { *m = PropertyDef{} }
 func (m *PropertyDef) String() string { return proto.CompactTextString(m) }
 func (*PropertyDef) ProtoMessage()    {}
 func (*PropertyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *PropertyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3358,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3234,7 +3412,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3290,7 +3468,7 @@ func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
 func (m *PartitionByDef) String() string { return proto.CompactTextString(m) }
 func (*PartitionByDef) ProtoMessage()    {}
 func (*PartitionByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *PartitionByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3401,7 +3579,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3456,7 +3634,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3694,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3605,7 +3783,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3672,7 +3850,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3856,7 +4034,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{36, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3930,7 +4108,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4002,7 +4180,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4091,7 +4269,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4138,7 +4316,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4187,7 +4365,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4237,11 +4415,139 @@ func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 	return OrderBySpec_INTERNAL
 }
 
+type FrameBound struct {
+	Type FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameBound_BoundType" json:"type,omitempty"`
+	// unbounded is only meaningful for PRECEDING and FOLLOWING
+	Unbounded            bool     `protobuf:"varint,2,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	Val                  *Expr    `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FrameBound) Reset()         { *m = FrameBound{} }
+func (m *FrameBound) String() string { return proto.CompactTextString(m) }
+func (*FrameBound) ProtoMessage()    {}
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
+}
+func (m *FrameBound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameBound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameBound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameBound.Merge(m, src)
+}
+func (m *FrameBound) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameBound) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameBound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameBound proto.InternalMessageInfo
+
+func (m *FrameBound) GetType() FrameBound_BoundType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameBound_PRECEDING
+}
+
+func (m *FrameBound) GetUnbounded() bool {
+	if m != nil {
+		return m.Unbounded
+	}
+	return false
+}
+
+func (m *FrameBound) GetVal() *Expr {
+	if m != nil {
+		return m.Val
+	}
+	return nil
+}
+
+type FrameClause struct {
+	Type                 FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameClause_FrameType" json:"type,omitempty"`
+	Start                *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FrameClause) Reset()         { *m = FrameClause{} }
+func (m *FrameClause) String() string { return proto.CompactTextString(m) }
+func (*FrameClause) ProtoMessage()    {}
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
+}
+func (m *FrameClause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameClause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameClause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrameClause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameClause.Merge(m, src)
+}
+func (m *FrameClause) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameClause) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameClause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrameClause proto.InternalMessageInfo
+
+func (m *FrameClause) GetType() FrameClause_FrameType {
+	if m != nil {
+		return m.Type
+	}
+	return FrameClause_ROWS
+}
+
+func (m *FrameClause) GetStart() *FrameBound {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *FrameClause) GetEnd() *FrameBound {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
 type WindowSpec struct {
 	PartitionBy          []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
 	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
 	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	Frame                *FrameClause   `protobuf:"bytes,5,opt,name=frame,proto3" json:"frame,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -4251,7 +4557,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4308,6 +4614,13 @@ func (m *WindowSpec) GetLag() int32 {
 	return 0
 }
 
+func (m *WindowSpec) GetFrame() *FrameClause {
+	if m != nil {
+		return m.Frame
+	}
+	return nil
+}
+
 type InsertCtx struct {
 	Ref                  *ObjectRef       `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
 	TableDef             *TableDef        `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -4326,7 +4639,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4438,7 +4751,7 @@ func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4610,7 +4923,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4778,18 +5091,29 @@ type Node struct {
 	NotCacheable bool          `protobuf:"varint,29,opt,name=not_cacheable,json=notCacheable,proto3" json:"not_cacheable,omitempty"`
 	InsertCtx    *InsertCtx    `protobuf:"bytes,30,opt,name=insert_ctx,json=insertCtx,proto3" json:"insert_ctx,omitempty"`
 	// used to connect two plans[steps]
-	CurrentStep          int32    `protobuf:"varint,31,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
-	SourceStep           int32    `protobuf:"varint,32,opt,name=source_step,json=sourceStep,proto3" json:"source_step,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CurrentStep int32 `protobuf:"varint,31,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	SourceStep  int32 `protobuf:"varint,32,opt,name=source_step,json=sourceStep,proto3" json:"source_step,omitempty"`
+	// RECURSIVE_CTE
+	UnionAll          bool  `protobuf:"varint,33,opt,name=union_all,json=unionAll,proto3" json:"union_all,omitempty"`
+	MaxRecursionDepth int64 `protobuf:"varint,34,opt,name=max_recursion_depth,json=maxRecursionDepth,proto3" json:"max_recursion_depth,omitempty"`
+	// TABLE_SCAN of partitioned table
+	PartitionPrune *PartitionPrune `protobuf:"bytes,35,opt,name=partition_prune,json=partitionPrune,proto3" json:"partition_prune,omitempty"`
+	// TABLE_SCAN reading the table at a past timestamp, nil means the
+	// snapshot of the current transaction
+	SnapshotTs *timestamp.Timestamp `protobuf:"bytes,36,opt,name=snapshot_ts,json=snapshotTs,proto3" json:"snapshot_ts,omitempty"`
+	// TABLE_SCAN looking up the primary keys of the rows to read in a unique
+	// index table of the table
+	IndexLookup          *IndexLookup `protobuf:"bytes,37,opt,name=index_lookup,json=indexLookup,proto3" json:"index_lookup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5042,6 +5366,244 @@ func (m *Node) GetSourceStep() int32 {
 	return 0
 }
 
+func (m *Node) GetUnionAll() bool {
+	if m != nil {
+		return m.UnionAll
+	}
+	return false
+}
+
+func (m *Node) GetMaxRecursionDepth() int64 {
+	if m != nil {
+		return m.MaxRecursionDepth
+	}
+	return 0
+}
+
+func (m *Node) GetPartitionPrune() *PartitionPrune {
+	if m != nil {
+		return m.PartitionPrune
+	}
+	return nil
+}
+
+func (m *Node) GetSnapshotTs() *timestamp.Timestamp {
+	if m != nil {
+		return m.SnapshotTs
+	}
+	return nil
+}
+
+func (m *Node) GetIndexLookup() *IndexLookup {
+	if m != nil {
+		return m.IndexLookup
+	}
+	return nil
+}
+
+// IndexLookup is a lookup in a unique index table, the primary keys found are
+// pushed down to the table scan as a filter to skip the blocks without them
+type IndexLookup struct {
+	ObjRef   *ObjectRef `protobuf:"bytes,1,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
+	TableDef *TableDef  `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	// the filters on the index column, which is the first column read from
+	// the index table
+	FilterList []*Expr `protobuf:"bytes,3,rep,name=filter_list,json=filterList,proto3" json:"filter_list,omitempty"`
+	// the primary key column of the table scan
+	Pk *Expr `protobuf:"bytes,4,opt,name=pk,proto3" json:"pk,omitempty"`
+	// the nearest neighbor search in an IVFFLAT index table, the primary keys
+	// in the lists nearest to the query are looked up instead of the ones
+	// passing filter_list
+	Ivfflat              *IvfflatLookup `protobuf:"bytes,5,opt,name=ivfflat,proto3" json:"ivfflat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *IndexLookup) Reset()         { *m = IndexLookup{} }
+func (m *IndexLookup) String() string { return proto.CompactTextString(m) }
+func (*IndexLookup) ProtoMessage()    {}
+func (*IndexLookup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
+}
+func (m *IndexLookup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexLookup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexLookup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexLookup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexLookup.Merge(m, src)
+}
+func (m *IndexLookup) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IndexLookup) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexLookup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexLookup proto.InternalMessageInfo
+
+func (m *IndexLookup) GetObjRef() *ObjectRef {
+	if m != nil {
+		return m.ObjRef
+	}
+	return nil
+}
+
+func (m *IndexLookup) GetTableDef() *TableDef {
+	if m != nil {
+		return m.TableDef
+	}
+	return nil
+}
+
+func (m *IndexLookup) GetFilterList() []*Expr {
+	if m != nil {
+		return m.FilterList
+	}
+	return nil
+}
+
+func (m *IndexLookup) GetPk() *Expr {
+	if m != nil {
+		return m.Pk
+	}
+	return nil
+}
+
+func (m *IndexLookup) GetIvfflat() *IvfflatLookup {
+	if m != nil {
+		return m.Ivfflat
+	}
+	return nil
+}
+
+// IvfflatLookup finds the lists of an IVFFLAT index nearest to the query
+type IvfflatLookup struct {
+	// the distance between the query and the centroid column, which is the
+	// third column read from the index table
+	Distance *Expr `protobuf:"bytes,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	// the number of the lists to look up
+	Probes               int64    `protobuf:"varint,2,opt,name=probes,proto3" json:"probes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IvfflatLookup) Reset()         { *m = IvfflatLookup{} }
+func (m *IvfflatLookup) String() string { return proto.CompactTextString(m) }
+func (*IvfflatLookup) ProtoMessage()    {}
+func (*IvfflatLookup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
+}
+func (m *IvfflatLookup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IvfflatLookup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IvfflatLookup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IvfflatLookup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IvfflatLookup.Merge(m, src)
+}
+func (m *IvfflatLookup) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *IvfflatLookup) XXX_DiscardUnknown() {
+	xxx_messageInfo_IvfflatLookup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IvfflatLookup proto.InternalMessageInfo
+
+func (m *IvfflatLookup) GetDistance() *Expr {
+	if m != nil {
+		return m.Distance
+	}
+	return nil
+}
+
+func (m *IvfflatLookup) GetProbes() int64 {
+	if m != nil {
+		return m.Probes
+	}
+	return 0
+}
+
+// PartitionPrune is the partitions of a partitioned table that may contain the rows
+// satisfying the filters of the scan
+type PartitionPrune struct {
+	IsPruned             bool             `protobuf:"varint,1,opt,name=is_pruned,json=isPruned,proto3" json:"is_pruned,omitempty"`
+	SelectedPartitions   []*PartitionItem `protobuf:"bytes,2,rep,name=selected_partitions,json=selectedPartitions,proto3" json:"selected_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
+func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
+func (*PartitionPrune) ProtoMessage()    {}
+func (*PartitionPrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
+}
+func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionPrune.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionPrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionPrune.Merge(m, src)
+}
+func (m *PartitionPrune) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PartitionPrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionPrune.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionPrune proto.InternalMessageInfo
+
+func (m *PartitionPrune) GetIsPruned() bool {
+	if m != nil {
+		return m.IsPruned
+	}
+	return false
+}
+
+func (m *PartitionPrune) GetSelectedPartitions() []*PartitionItem {
+	if m != nil {
+		return m.SelectedPartitions
+	}
+	return nil
+}
+
 type IdList struct {
 	List                 []int64  `protobuf:"varint,1,rep,packed,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5053,7 +5615,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5100,7 +5662,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5159,7 +5721,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5302,7 +5864,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5391,7 +5953,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5495,7 +6057,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5542,7 +6104,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5589,7 +6151,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5644,7 +6206,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5760,7 +6322,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5815,7 +6377,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5956,7 +6518,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6236,7 +6798,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6292,7 +6854,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6354,7 +6916,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6409,7 +6971,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6463,7 +7025,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6520,7 +7082,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6625,7 +7187,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6689,7 +7251,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6761,7 +7323,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6839,7 +7401,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6910,7 +7472,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6981,7 +7543,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7055,7 +7617,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{75, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7171,7 +7733,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7269,7 +7831,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7332,7 +7894,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7396,7 +7958,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7463,7 +8025,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7507,7 +8069,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7582,7 +8144,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7632,7 +8194,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7705,7 +8267,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7789,7 +8351,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7851,7 +8413,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7905,7 +8467,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7956,7 +8518,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8034,7 +8596,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8103,7 +8665,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8157,7 +8719,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8205,7 +8767,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8259,7 +8821,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8305,7 +8867,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8343,6 +8905,8 @@ func init() {
 	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
 	proto.RegisterEnum("plan.ForeignKeyDef_RefAction", ForeignKeyDef_RefAction_name, ForeignKeyDef_RefAction_value)
 	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
+	proto.RegisterEnum("plan.FrameBound_BoundType", FrameBound_BoundType_name, FrameBound_BoundType_value)
+	proto.RegisterEnum("plan.FrameClause_FrameType", FrameClause_FrameType_name, FrameClause_FrameType_value)
 	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
 	proto.RegisterEnum("plan.Node_JoinType", Node_JoinType_name, Node_JoinType_value)
 	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
@@ -8372,6 +8936,7 @@ func init() {
 	proto.RegisterType((*ResultColDef)(nil), "plan.ResultColDef")
 	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
 	proto.RegisterType((*Default)(nil), "plan.Default")
+	proto.RegisterType((*GeneratedCol)(nil), "plan.GeneratedCol")
 	proto.RegisterType((*OnUpdate)(nil), "plan.OnUpdate")
 	proto.RegisterType((*IndexOption)(nil), "plan.IndexOption")
 	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
@@ -8395,6 +8960,8 @@ func init() {
 	proto.RegisterType((*ColData)(nil), "plan.ColData")
 	proto.RegisterType((*RowsetData)(nil), "plan.RowsetData")
 	proto.RegisterType((*OrderBySpec)(nil), "plan.OrderBySpec")
+	proto.RegisterType((*FrameBound)(nil), "plan.FrameBound")
+	proto.RegisterType((*FrameClause)(nil), "plan.FrameClause")
 	proto.RegisterType((*WindowSpec)(nil), "plan.WindowSpec")
 	proto.RegisterType((*InsertCtx)(nil), "plan.InsertCtx")
 	proto.RegisterMapType((map[string]*Expr)(nil), "plan.InsertCtx.OnDuplicateExprEntry")
@@ -8402,6 +8969,9 @@ func init() {
 	proto.RegisterType((*UpdateCtx)(nil), "plan.UpdateCtx")
 	proto.RegisterType((*AnalyzeInfo)(nil), "plan.AnalyzeInfo")
 	proto.RegisterType((*Node)(nil), "plan.Node")
+	proto.RegisterType((*IndexLookup)(nil), "plan.IndexLookup")
+	proto.RegisterType((*IvfflatLookup)(nil), "plan.IvfflatLookup")
+	proto.RegisterType((*PartitionPrune)(nil), "plan.PartitionPrune")
 	proto.RegisterType((*IdList)(nil), "plan.IdList")
 	proto.RegisterType((*ColPosMap)(nil), "plan.ColPosMap")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.ColPosMap.MapEntry")
@@ -8452,471 +9022,499 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 7417 bytes of a gzipped FileDescriptorProto
+	// 7857 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7c, 0x4b, 0x8c, 0x1b, 0xc7,
-	0xb6, 0xd8, 0xf0, 0x4f, 0x1e, 0x7e, 0xa6, 0x55, 0xfa, 0x51, 0xb2, 0x2c, 0x8f, 0xdb, 0xb2, 0x2d,
-	0xcb, 0xbe, 0xb2, 0x3d, 0xfe, 0x3b, 0xbe, 0x78, 0x97, 0x43, 0x52, 0x33, 0xb4, 0x29, 0x72, 0x6e,
-	0x91, 0x23, 0x5d, 0xe7, 0x21, 0x20, 0x9a, 0xec, 0xe6, 0xa8, 0x35, 0xcd, 0x6e, 0xba, 0xbb, 0xa9,
-	0x99, 0xb9, 0xc0, 0x03, 0x2e, 0x10, 0x20, 0x41, 0x56, 0x59, 0x04, 0x48, 0x02, 0xbc, 0x00, 0xb9,
-	0xc9, 0x22, 0x40, 0xde, 0x26, 0xcb, 0x00, 0x41, 0x36, 0x49, 0x36, 0x09, 0x90, 0x45, 0xb2, 0xc8,
-	0x26, 0xd9, 0x24, 0x4e, 0xf0, 0xf6, 0xc1, 0xcb, 0x32, 0x8b, 0xe0, 0x9c, 0xaa, 0xee, 0xae, 0xe6,
-	0x50, 0x57, 0xb2, 0xae, 0xb3, 0x99, 0xe9, 0x3a, 0x9f, 0xaa, 0x53, 0x55, 0xa7, 0xce, 0xa7, 0x3e,
-	0x04, 0x58, 0x3a, 0x86, 0x7b, 0x7f, 0xe9, 0x7b, 0xa1, 0xc7, 0xf2, 0xf8, 0x7d, 0xf3, 0x17, 0xc7,
-	0x76, 0xf8, 0x64, 0x35, 0xbd, 0x3f, 0xf3, 0x16, 0x1f, 0x1e, 0x7b, 0xc7, 0xde, 0x87, 0x84, 0x9c,
-	0xae, 0xe6, 0x54, 0xa2, 0x02, 0x7d, 0x09, 0x26, 0xfd, 0x1f, 0x64, 0x20, 0x3f, 0x3e, 0x5f, 0x5a,
-	0xac, 0x01, 0x59, 0xdb, 0x6c, 0x66, 0x76, 0x32, 0x77, 0x0b, 0x3c, 0x6b, 0x9b, 0x6c, 0x07, 0xaa,
-	0xae, 0x17, 0x0e, 0x56, 0x8e, 0x63, 0x4c, 0x1d, 0xab, 0x99, 0xdd, 0xc9, 0xdc, 0x2d, 0x73, 0x15,
-	0xc4, 0x5e, 0x83, 0x8a, 0xb1, 0x0a, 0xbd, 0x89, 0xed, 0xce, 0xfc, 0x66, 0x8e, 0xf0, 0x65, 0x04,
-	0xf4, 0xdc, 0x99, 0xcf, 0xae, 0x40, 0xe1, 0xd4, 0x36, 0xc3, 0x27, 0xcd, 0x3c, 0xd5, 0x28, 0x0a,
-	0x08, 0x0d, 0x66, 0x86, 0x63, 0x35, 0x0b, 0x02, 0x4a, 0x05, 0x84, 0x86, 0xd4, 0x48, 0x71, 0x27,
-	0x73, 0xb7, 0xc2, 0x45, 0x41, 0xff, 0xcf, 0x05, 0x28, 0xb4, 0x3d, 0x37, 0x08, 0xd9, 0x35, 0x28,
-	0xda, 0x81, 0xbb, 0x72, 0x1c, 0x12, 0xaf, 0xcc, 0x65, 0x89, 0x5d, 0x83, 0x82, 0xfd, 0xe5, 0x33,
-	0xc3, 0x21, 0xe1, 0x0a, 0x07, 0x5b, 0x5c, 0x14, 0x59, 0x13, 0x8a, 0xf6, 0xc7, 0x9f, 0x23, 0x22,
-	0x27, 0x11, 0xb2, 0x4c, 0x98, 0x4f, 0x76, 0x11, 0x93, 0x8f, 0x31, 0x54, 0x26, 0xcc, 0xe7, 0x9f,
-	0x22, 0x06, 0x45, 0xcb, 0x11, 0x86, 0xca, 0xd8, 0xca, 0x8a, 0x5a, 0x41, 0xe9, 0xea, 0xd8, 0xca,
-	0x2a, 0x6a, 0x65, 0x25, 0x5a, 0x29, 0x49, 0x84, 0x2c, 0x13, 0x46, 0xb4, 0x52, 0x8e, 0x31, 0x71,
-	0x2b, 0x2b, 0xd1, 0x4a, 0x65, 0x27, 0x73, 0x37, 0x4f, 0x18, 0xd1, 0xca, 0x15, 0xc8, 0x9b, 0x08,
-	0x87, 0x9d, 0xcc, 0xdd, 0xcc, 0xc1, 0x16, 0xa7, 0x12, 0x42, 0x03, 0x84, 0x56, 0x71, 0x60, 0x10,
-	0x1a, 0x48, 0xe8, 0x14, 0xa1, 0x35, 0x1c, 0x0d, 0x84, 0x4e, 0x25, 0x74, 0x8e, 0xd0, 0xfa, 0x4e,
-	0xe6, 0x6e, 0x16, 0xa1, 0x58, 0x62, 0x37, 0xa1, 0x64, 0x1a, 0xa1, 0x85, 0x88, 0x86, 0xec, 0x72,
-	0x04, 0x40, 0x5c, 0x68, 0x2f, 0x08, 0xb7, 0x2d, 0x3b, 0x1d, 0x01, 0x98, 0x0e, 0x55, 0x24, 0x8b,
-	0xf0, 0x9a, 0xc4, 0xab, 0x40, 0xf6, 0x19, 0xd4, 0x4c, 0x6b, 0x66, 0x2f, 0x0c, 0x47, 0xf4, 0xe9,
-	0xd2, 0x4e, 0xe6, 0x6e, 0x75, 0x77, 0xfb, 0x3e, 0xe9, 0x64, 0x8c, 0x39, 0xd8, 0xe2, 0x29, 0x32,
-	0xf6, 0x25, 0xd4, 0x65, 0xf9, 0xe3, 0x5d, 0x1a, 0x58, 0x46, 0x7c, 0x5a, 0x8a, 0xef, 0xe3, 0xdd,
-	0x2f, 0x0f, 0xb6, 0x78, 0x9a, 0x90, 0xdd, 0x81, 0x1a, 0xb6, 0x1d, 0x84, 0xc6, 0x62, 0x89, 0x8c,
-	0x97, 0xa5, 0x54, 0x29, 0x28, 0x76, 0xeb, 0x69, 0xe0, 0xb9, 0x48, 0x70, 0x45, 0x8e, 0x5b, 0x04,
-	0x60, 0x3b, 0x00, 0xa6, 0x35, 0x37, 0x56, 0x4e, 0x88, 0xe8, 0xab, 0x72, 0x00, 0x15, 0x18, 0xbb,
-	0x0d, 0x95, 0xd5, 0x12, 0x7b, 0xf9, 0xc8, 0x70, 0x9a, 0xd7, 0x24, 0x41, 0x02, 0x42, 0x65, 0xb5,
-	0x83, 0x3d, 0xdb, 0x6d, 0x5e, 0x27, 0x5d, 0x14, 0x05, 0x76, 0x0b, 0x72, 0x81, 0x3f, 0x6b, 0x36,
-	0xa9, 0x27, 0x20, 0x7a, 0xd2, 0x3d, 0x5b, 0xfa, 0x1c, 0xc1, 0x7b, 0x25, 0x28, 0x3c, 0x33, 0x9c,
-	0x95, 0xa5, 0xdf, 0x82, 0xf2, 0xa1, 0xe1, 0x1b, 0x0b, 0x6e, 0xcd, 0x99, 0x06, 0xb9, 0xa5, 0x17,
-	0xc8, 0x15, 0x87, 0x9f, 0x7a, 0x1f, 0x8a, 0x8f, 0x0c, 0x1f, 0x71, 0x0c, 0xf2, 0xae, 0xb1, 0xb0,
-	0x08, 0x59, 0xe1, 0xf4, 0x8d, 0xab, 0x20, 0x38, 0x0f, 0x42, 0x6b, 0x21, 0xd7, 0xa2, 0x2c, 0x21,
-	0xfc, 0xd8, 0xf1, 0xa6, 0x52, 0xdb, 0xcb, 0x5c, 0x96, 0xf4, 0x01, 0x14, 0xdb, 0x9e, 0x83, 0xb5,
-	0x5d, 0x87, 0x92, 0x6f, 0x39, 0x93, 0xa4, 0xb5, 0xa2, 0x6f, 0x39, 0x87, 0x5e, 0x80, 0x88, 0x99,
-	0x27, 0x10, 0x59, 0x81, 0x98, 0x79, 0x84, 0x88, 0xda, 0xcf, 0x25, 0xed, 0xeb, 0x5f, 0x41, 0x85,
-	0x1b, 0xa7, 0xb2, 0xca, 0xab, 0x50, 0x0c, 0xa7, 0xce, 0x44, 0x5a, 0x8c, 0x3c, 0x2f, 0x84, 0x53,
-	0xa7, 0x67, 0x22, 0x18, 0x2b, 0xb4, 0x4d, 0xaa, 0x2f, 0xcf, 0x0b, 0x33, 0xcf, 0xe9, 0x99, 0xfa,
-	0x18, 0xa0, 0xed, 0xf9, 0xfe, 0x2b, 0x8b, 0x73, 0x05, 0x0a, 0xa6, 0xb5, 0x0c, 0x9f, 0x88, 0xf5,
-	0xcc, 0x45, 0x41, 0xbf, 0x07, 0x65, 0x1c, 0xe2, 0xbe, 0x1d, 0x84, 0xec, 0x36, 0xe4, 0x1d, 0x3b,
-	0x08, 0x9b, 0x99, 0x9d, 0xdc, 0xda, 0x04, 0x10, 0x5c, 0xdf, 0x81, 0xf2, 0x43, 0xe3, 0xec, 0x11,
-	0x4e, 0x02, 0xd6, 0x46, 0xb3, 0x21, 0x47, 0x57, 0x4e, 0xcd, 0x3d, 0x80, 0xb1, 0xe1, 0x1f, 0x5b,
-	0x21, 0x59, 0xc3, 0x5b, 0x90, 0x0b, 0xcf, 0x97, 0x44, 0x11, 0x57, 0x87, 0x08, 0x8e, 0x60, 0xfd,
-	0xaf, 0x32, 0x50, 0x1d, 0xad, 0xa6, 0x3f, 0xac, 0x2c, 0xff, 0x1c, 0x7b, 0x74, 0x37, 0xa1, 0x6e,
-	0xec, 0x5e, 0x13, 0xd4, 0x0a, 0x3e, 0xe1, 0xc4, 0x2e, 0xba, 0x9e, 0x69, 0x45, 0x23, 0x54, 0xe0,
-	0x45, 0x2c, 0xf6, 0x4c, 0x34, 0xbf, 0xde, 0x52, 0x8e, 0x77, 0xd6, 0x5b, 0xb2, 0x1d, 0x28, 0xcc,
-	0x9e, 0xd8, 0x8e, 0x49, 0x86, 0x2a, 0xdd, 0x23, 0x81, 0x60, 0x37, 0xa0, 0xec, 0x7b, 0xa7, 0x93,
-	0xc0, 0xfe, 0x6d, 0x64, 0x4e, 0x4b, 0xbe, 0x77, 0x3a, 0xb2, 0x7f, 0x6b, 0xe9, 0x63, 0x69, 0xd3,
-	0x01, 0x8a, 0xa3, 0x76, 0xab, 0xdf, 0xe2, 0xda, 0x16, 0x7e, 0x77, 0x7f, 0xd3, 0x1b, 0x8d, 0x47,
-	0x5a, 0x86, 0x35, 0x00, 0x06, 0xc3, 0xf1, 0x44, 0x96, 0xb3, 0xac, 0x08, 0xd9, 0xde, 0x40, 0xcb,
-	0x21, 0x0d, 0xc2, 0x7b, 0x03, 0x2d, 0xcf, 0x4a, 0x90, 0x6b, 0x0d, 0xbe, 0xd7, 0x0a, 0xf4, 0xd1,
-	0xef, 0x6b, 0x45, 0xfd, 0x9f, 0x65, 0xa1, 0x32, 0x9c, 0x3e, 0xb5, 0x66, 0x21, 0xf6, 0x19, 0xd5,
-	0xd1, 0xf2, 0x9f, 0x59, 0x3e, 0x75, 0x3b, 0xc7, 0x65, 0x09, 0x3b, 0x62, 0x4e, 0xa9, 0x73, 0x39,
-	0x9e, 0x35, 0xa7, 0x44, 0x37, 0x7b, 0x62, 0x2d, 0x0c, 0xea, 0x1c, 0xd2, 0x51, 0x09, 0xd5, 0xdf,
-	0x9b, 0x3e, 0xa5, 0xee, 0xe5, 0x38, 0x7e, 0xb2, 0x37, 0xa0, 0x2a, 0xea, 0x98, 0x90, 0xee, 0x15,
-	0x68, 0x2c, 0x40, 0x80, 0x06, 0xb8, 0x02, 0xae, 0x43, 0xc9, 0x9c, 0x0a, 0xa4, 0xf0, 0x14, 0x45,
-	0x73, 0x4a, 0x08, 0xe4, 0xa4, 0x5a, 0x05, 0xb2, 0x24, 0x39, 0x09, 0x44, 0x04, 0x37, 0xa0, 0xec,
-	0x4d, 0x9f, 0x0a, 0x6c, 0x99, 0xb0, 0x25, 0x6f, 0xfa, 0x94, 0x50, 0xef, 0xc3, 0xa5, 0x60, 0x35,
-	0x0d, 0x66, 0xbe, 0xbd, 0x0c, 0x6d, 0xcf, 0x15, 0x34, 0x15, 0xa2, 0xd1, 0x54, 0x04, 0x11, 0xdf,
-	0x81, 0xc6, 0x72, 0x35, 0x9d, 0x18, 0xb3, 0x99, 0xb7, 0x72, 0x43, 0x9c, 0x45, 0xa0, 0x91, 0xaf,
-	0x2d, 0x57, 0xd3, 0x96, 0x00, 0xf6, 0x4c, 0xfd, 0x1f, 0x65, 0x40, 0x1b, 0x29, 0xac, 0x0f, 0xad,
-	0xd0, 0xd8, 0xb8, 0xa4, 0x5f, 0x07, 0x50, 0xaa, 0x12, 0x0a, 0x51, 0x31, 0xa2, 0x7a, 0xd4, 0xfe,
-	0xe6, 0x52, 0xfd, 0x7d, 0x13, 0x6a, 0x11, 0x1f, 0x61, 0xf3, 0x84, 0xad, 0x4a, 0x58, 0xd4, 0xe3,
-	0x60, 0x35, 0x55, 0x47, 0xb2, 0x14, 0xac, 0x88, 0x5b, 0xff, 0xdf, 0x19, 0x28, 0x3f, 0x58, 0xb9,
-	0x33, 0x14, 0x8d, 0xbd, 0x05, 0xf9, 0xf9, 0xca, 0x9d, 0x49, 0x4d, 0x97, 0xb6, 0x3b, 0x9e, 0x65,
-	0x4e, 0x48, 0x5c, 0x5d, 0x86, 0x7f, 0x8c, 0xab, 0xf2, 0xc2, 0xea, 0x42, 0xb8, 0xfe, 0x8f, 0x65,
-	0x8d, 0x0f, 0x1c, 0xe3, 0x98, 0x95, 0x21, 0x3f, 0x18, 0x0e, 0xba, 0xda, 0x16, 0xab, 0x41, 0xb9,
-	0x37, 0x18, 0x77, 0xf9, 0xa0, 0xd5, 0xd7, 0x32, 0xa4, 0x8c, 0xe3, 0xd6, 0x5e, 0xbf, 0xab, 0x65,
-	0x11, 0xf3, 0x68, 0xd8, 0x6f, 0x8d, 0x7b, 0xfd, 0xae, 0x96, 0x17, 0x18, 0xde, 0x6b, 0x8f, 0xb5,
-	0x32, 0xd3, 0xa0, 0x76, 0xc8, 0x87, 0x9d, 0xa3, 0x76, 0x77, 0x32, 0x38, 0xea, 0xf7, 0x35, 0x8d,
-	0x5d, 0x86, 0xed, 0x18, 0x32, 0x14, 0xc0, 0x1d, 0x64, 0x79, 0xd4, 0xe2, 0x2d, 0xbe, 0xaf, 0xfd,
-	0x8a, 0x95, 0x21, 0xd7, 0xda, 0xdf, 0xd7, 0x7e, 0x97, 0xc1, 0xaf, 0xc7, 0xbd, 0x81, 0xf6, 0xbb,
-	0x2c, 0x6b, 0x40, 0xe5, 0xe1, 0x70, 0x30, 0x1c, 0x0f, 0x07, 0xbd, 0xb6, 0xf6, 0xbb, 0xbc, 0xfe,
-	0xcf, 0x73, 0x90, 0x47, 0x81, 0xff, 0xf0, 0xc2, 0x66, 0xaf, 0x41, 0x66, 0x46, 0xf3, 0x50, 0xdd,
-	0xad, 0x0a, 0x1c, 0x45, 0x20, 0x07, 0x5b, 0x3c, 0x83, 0xa3, 0x90, 0x11, 0x2b, 0xb4, 0xba, 0xdb,
-	0x10, 0xc8, 0xc8, 0x96, 0x23, 0x7e, 0xc9, 0x6e, 0x41, 0xe6, 0x99, 0x5c, 0xae, 0x35, 0x81, 0x17,
-	0xd6, 0x1c, 0xb1, 0xcf, 0xd8, 0x0e, 0xe4, 0x66, 0x9e, 0x88, 0x2e, 0x62, 0xbc, 0x30, 0x88, 0x07,
-	0x5b, 0x1c, 0x51, 0xec, 0x2d, 0xc8, 0xf9, 0xc6, 0x29, 0xa9, 0x76, 0x3c, 0x13, 0xb1, 0xc5, 0x45,
-	0x22, 0xdf, 0x38, 0x45, 0x21, 0xe6, 0xa4, 0xe0, 0xb1, 0x10, 0xd1, 0x54, 0x62, 0x33, 0x73, 0xf6,
-	0x36, 0xe4, 0x82, 0xd5, 0x94, 0x94, 0xbc, 0xba, 0x7b, 0xe9, 0x82, 0x29, 0xc2, 0x6a, 0x82, 0xd5,
-	0x94, 0xbd, 0x03, 0xf9, 0x99, 0xe7, 0xfb, 0xa4, 0xe8, 0xb1, 0xeb, 0x4d, 0x6c, 0x34, 0x86, 0x0f,
-	0x88, 0x67, 0x3b, 0x90, 0x09, 0x49, 0xc7, 0x63, 0xa2, 0xc4, 0x48, 0x62, 0x83, 0x21, 0xbb, 0x23,
-	0x2d, 0x6f, 0x55, 0x95, 0x29, 0xb2, 0xcb, 0x58, 0x0f, 0x62, 0x99, 0x0e, 0xb9, 0x85, 0x71, 0x46,
-	0x11, 0x4b, 0x4c, 0x14, 0x19, 0x64, 0x94, 0x69, 0x61, 0x9c, 0xed, 0x15, 0x21, 0x6f, 0x9d, 0x2d,
-	0x7d, 0xfd, 0x06, 0x54, 0xe2, 0x78, 0x81, 0xd5, 0x20, 0x63, 0x48, 0x0b, 0x93, 0x31, 0xf4, 0xbb,
-	0xe8, 0xbe, 0xa3, 0x88, 0x20, 0x8d, 0xc3, 0x52, 0x64, 0x77, 0x32, 0x53, 0xfd, 0x1b, 0xa8, 0x71,
-	0x2b, 0x58, 0x39, 0x61, 0xdb, 0x73, 0x3a, 0xd6, 0x9c, 0x7d, 0x00, 0x10, 0x97, 0x03, 0xe9, 0x26,
-	0x92, 0x59, 0xe8, 0x58, 0x73, 0xae, 0xe0, 0xf5, 0xbf, 0x99, 0x23, 0xe7, 0xd9, 0x11, 0x9e, 0x4e,
-	0xba, 0xb4, 0x8c, 0xe2, 0xd2, 0xe2, 0xe5, 0x9c, 0x4d, 0x7b, 0xe8, 0x27, 0xb6, 0x69, 0x5a, 0x6e,
-	0xe4, 0x89, 0x45, 0x89, 0xdd, 0x81, 0x9c, 0xe1, 0x1c, 0x93, 0x6a, 0x34, 0x76, 0x59, 0xd4, 0xe8,
-	0x62, 0xe9, 0x5b, 0x41, 0x20, 0x74, 0xcf, 0x70, 0x8e, 0x23, 0xcd, 0x2c, 0x6c, 0xd6, 0xcc, 0x1b,
-	0x50, 0x76, 0xbd, 0x70, 0x42, 0x51, 0x70, 0x91, 0x6a, 0x2f, 0xc9, 0x58, 0x9c, 0xbd, 0x0b, 0x25,
-	0x19, 0xbf, 0x48, 0xc5, 0xa8, 0x0b, 0xe6, 0x8e, 0x00, 0xf2, 0x08, 0xcb, 0x9a, 0xe8, 0x5f, 0x17,
-	0x0b, 0xcb, 0x0d, 0x23, 0x23, 0x28, 0x8b, 0xec, 0x7d, 0xa8, 0x78, 0xee, 0x44, 0x04, 0x39, 0x52,
-	0x27, 0xe4, 0x24, 0x0d, 0xdd, 0x23, 0x82, 0xf2, 0xb2, 0x27, 0xbf, 0x50, 0x14, 0xc7, 0x3b, 0x9d,
-	0xcc, 0x0c, 0x5f, 0x98, 0xbf, 0x32, 0x2f, 0x39, 0xde, 0x69, 0xdb, 0xf0, 0x4d, 0x76, 0x0b, 0x2a,
-	0x33, 0x67, 0x15, 0x84, 0x96, 0xbf, 0x77, 0x4e, 0x1a, 0x51, 0xe6, 0x09, 0x00, 0xdb, 0x5f, 0xfa,
-	0xf6, 0xc2, 0xf0, 0xcf, 0x45, 0xe8, 0xca, 0xa3, 0x22, 0xba, 0xe4, 0xe5, 0x89, 0x6d, 0x9e, 0x51,
-	0xf0, 0x5a, 0xe0, 0xa2, 0xa0, 0xff, 0x00, 0x25, 0xd9, 0x07, 0xb4, 0x40, 0xa8, 0x1b, 0xe9, 0x75,
-	0x2b, 0x2c, 0x10, 0xc2, 0xd9, 0x5b, 0x50, 0xf7, 0x7c, 0xfb, 0xd8, 0x76, 0x27, 0x41, 0xe8, 0xdb,
-	0xee, 0xb1, 0x9c, 0x97, 0x9a, 0x00, 0x8e, 0x08, 0x86, 0x66, 0x13, 0xc7, 0x6f, 0x62, 0x4c, 0x6d,
-	0xc7, 0x0e, 0xcf, 0xe5, 0x2c, 0x55, 0x11, 0xd6, 0x12, 0x20, 0x7d, 0x08, 0xe5, 0xa8, 0xc7, 0x3f,
-	0x4b, 0x9b, 0xfa, 0x5f, 0x83, 0x6a, 0xcf, 0x35, 0xad, 0xb3, 0x21, 0x79, 0x02, 0xf6, 0x01, 0xb0,
-	0x99, 0x6f, 0x19, 0xa1, 0x35, 0xb1, 0xce, 0x42, 0xdf, 0x98, 0x88, 0xbc, 0x47, 0xa4, 0x35, 0x9a,
-	0xc0, 0x74, 0x11, 0x31, 0xa6, 0x14, 0xe8, 0xbf, 0x66, 0xa0, 0x7e, 0x28, 0x86, 0xe8, 0x3b, 0xeb,
-	0xbc, 0x23, 0x02, 0xc3, 0x59, 0xa4, 0xc0, 0x79, 0x4e, 0xdf, 0xec, 0x36, 0x54, 0x97, 0x27, 0xd6,
-	0xf9, 0x24, 0x15, 0x79, 0x55, 0x10, 0xd4, 0x26, 0x55, 0x7d, 0x0f, 0x8a, 0x1e, 0xb5, 0x2e, 0x8d,
-	0x97, 0xb4, 0x0a, 0x8a, 0x58, 0x5c, 0x12, 0x30, 0x1d, 0xea, 0x71, 0x55, 0xaa, 0x67, 0x91, 0x95,
-	0x91, 0x67, 0xb9, 0x02, 0x05, 0x44, 0x05, 0xcd, 0xc2, 0x4e, 0x0e, 0xc3, 0x27, 0x2a, 0xb0, 0x8f,
-	0xa0, 0x3e, 0xf3, 0x16, 0xcb, 0x49, 0xc4, 0x2e, 0xcd, 0x58, 0x7a, 0x89, 0x55, 0x91, 0xe4, 0x50,
-	0xd4, 0xa5, 0xff, 0xc3, 0x2c, 0x94, 0x49, 0x06, 0xb9, 0xca, 0x6c, 0xf3, 0x2c, 0x5a, 0x65, 0x15,
-	0x5e, 0xb0, 0xcd, 0xb3, 0x9e, 0x89, 0x0e, 0xd2, 0x46, 0x92, 0x89, 0xb2, 0xd6, 0x2a, 0x04, 0x89,
-	0x44, 0x59, 0x1a, 0x7e, 0x18, 0x34, 0x73, 0x42, 0x14, 0x2a, 0xe0, 0x32, 0x5c, 0xb9, 0xf6, 0x0f,
-	0x2b, 0x21, 0x7d, 0x99, 0xcb, 0x12, 0xbb, 0x0b, 0x9a, 0xa8, 0x8c, 0x06, 0x5d, 0x75, 0x8d, 0x0d,
-	0x82, 0xd3, 0x98, 0x47, 0xf1, 0x84, 0xa0, 0xb1, 0xce, 0xd0, 0xb4, 0x89, 0xf5, 0x06, 0x04, 0xea,
-	0x22, 0x44, 0x5d, 0x49, 0xa5, 0xf4, 0x4a, 0x6a, 0x42, 0xe9, 0x99, 0x1d, 0xd8, 0x38, 0xab, 0x65,
-	0xa1, 0xe3, 0xb2, 0xa8, 0x4c, 0x43, 0xe5, 0x05, 0xd3, 0xa0, 0xff, 0x87, 0x2c, 0xd4, 0x1f, 0x78,
-	0xbe, 0x65, 0x1f, 0xbb, 0xc9, 0xbc, 0x5f, 0x88, 0x1e, 0x22, 0x5d, 0xc8, 0x2a, 0xba, 0xf0, 0x06,
-	0x54, 0xe7, 0x82, 0x71, 0x12, 0x4e, 0x45, 0x46, 0x90, 0xe7, 0x20, 0x41, 0xe3, 0xa9, 0x83, 0x6b,
-	0x20, 0x22, 0x20, 0xe6, 0x3c, 0x31, 0x47, 0x4c, 0x68, 0xfc, 0xd8, 0xd7, 0x64, 0x0c, 0x4c, 0xcb,
-	0xb1, 0x42, 0x31, 0x40, 0x8d, 0xdd, 0xd7, 0xa5, 0xab, 0x51, 0x65, 0xba, 0xcf, 0xad, 0x79, 0x8b,
-	0x3c, 0x0f, 0xda, 0x86, 0x0e, 0x91, 0x4b, 0x5e, 0x69, 0x48, 0x8a, 0x2f, 0xc9, 0x2b, 0xd6, 0x9b,
-	0x3e, 0x86, 0x4a, 0x0c, 0xc6, 0x08, 0x81, 0x77, 0x65, 0x54, 0xb0, 0xc5, 0xaa, 0x50, 0x6a, 0xb7,
-	0x46, 0xed, 0x56, 0xa7, 0xab, 0x65, 0x10, 0x35, 0xea, 0x8e, 0x45, 0x24, 0x90, 0x65, 0xdb, 0x50,
-	0xc5, 0x52, 0xa7, 0xfb, 0xa0, 0x75, 0xd4, 0x1f, 0x6b, 0x39, 0x56, 0x87, 0xca, 0x60, 0x38, 0x69,
-	0xb5, 0xc7, 0xbd, 0xe1, 0x40, 0xcb, 0xeb, 0xbf, 0x82, 0x72, 0xfb, 0x89, 0x35, 0x3b, 0x79, 0xde,
-	0x28, 0x52, 0xa0, 0x6d, 0xcd, 0x4e, 0xa4, 0xdb, 0x5f, 0x0b, 0xb4, 0xad, 0xd9, 0x89, 0xde, 0x81,
-	0x5a, 0x3b, 0xb2, 0x61, 0x58, 0xcb, 0x4e, 0xa4, 0x75, 0x17, 0x93, 0x0d, 0xa9, 0x81, 0x1b, 0x9c,
-	0x83, 0xfe, 0x19, 0x54, 0x0f, 0x7d, 0x6f, 0x69, 0xf9, 0x21, 0x55, 0xa2, 0x41, 0xee, 0xc4, 0x3a,
-	0x97, 0x92, 0xe0, 0x67, 0x92, 0x96, 0x64, 0xd5, 0xb4, 0x64, 0x17, 0xca, 0x11, 0xdb, 0x4b, 0xf3,
-	0xfc, 0x09, 0x5a, 0x0d, 0xe2, 0xb1, 0xad, 0x00, 0x1b, 0xbb, 0x0f, 0xb0, 0x8c, 0x01, 0x52, 0xec,
-	0x28, 0x84, 0x91, 0x95, 0x73, 0x85, 0x42, 0xff, 0xab, 0x1c, 0x34, 0x0e, 0x0d, 0x3f, 0xb4, 0x71,
-	0x2a, 0x44, 0xa7, 0xdf, 0x85, 0x7c, 0x78, 0xbe, 0xb4, 0x64, 0x8e, 0x73, 0x39, 0x8e, 0x7f, 0x04,
-	0x0d, 0xf9, 0x29, 0x22, 0x60, 0x5f, 0x43, 0x63, 0x19, 0x81, 0x27, 0x64, 0x3f, 0xc5, 0xc0, 0xae,
-	0xb3, 0xd0, 0x78, 0xd5, 0x97, 0x6a, 0x91, 0xfd, 0x12, 0xae, 0xa4, 0x79, 0xad, 0x20, 0x48, 0xec,
-	0x96, 0x3a, 0xd0, 0x97, 0x53, 0x8c, 0x82, 0x8c, 0xb5, 0xe1, 0x52, 0xc2, 0x3e, 0xf3, 0x9c, 0xd5,
-	0xc2, 0x0d, 0x64, 0x40, 0x76, 0x6d, 0xad, 0xf5, 0xb6, 0xc0, 0x72, 0x6d, 0xb9, 0x06, 0x61, 0x3a,
-	0xd4, 0x62, 0xd8, 0x60, 0xb5, 0xa0, 0x05, 0x90, 0xe7, 0x29, 0x18, 0xfb, 0x04, 0x20, 0x2e, 0x07,
-	0xcd, 0x22, 0x8d, 0xe7, 0x7a, 0xff, 0x7a, 0xa1, 0xb5, 0xe0, 0x0a, 0x19, 0xfa, 0x46, 0xc3, 0x39,
-	0xf6, 0x7c, 0x3b, 0x7c, 0xb2, 0x20, 0xab, 0x91, 0xe3, 0x09, 0x80, 0x8c, 0x53, 0x30, 0xc1, 0x90,
-	0x3d, 0x66, 0x91, 0x06, 0xa4, 0x61, 0x07, 0xa3, 0xd5, 0x34, 0xae, 0x17, 0xdd, 0x4e, 0xd2, 0xcb,
-	0x45, 0x70, 0x2c, 0x93, 0x95, 0x44, 0xc2, 0x87, 0xc1, 0x31, 0xdb, 0x85, 0xab, 0x09, 0x51, 0x62,
-	0xef, 0x82, 0x26, 0x90, 0xa5, 0x4c, 0x86, 0x2f, 0x36, 0x7a, 0x81, 0xfe, 0x2d, 0xd4, 0x53, 0xb3,
-	0xf3, 0x42, 0x07, 0x78, 0x03, 0xca, 0xf8, 0x1f, 0xdd, 0x9f, 0x54, 0xc0, 0x12, 0x96, 0x47, 0xa1,
-	0xaf, 0x5b, 0xa0, 0xad, 0x8f, 0x35, 0xbb, 0x43, 0xe9, 0x3d, 0x4d, 0xca, 0xc5, 0x95, 0x13, 0xa1,
-	0x30, 0x1f, 0xbb, 0x38, 0x89, 0x59, 0x92, 0xfa, 0xc2, 0x64, 0xe9, 0xff, 0x24, 0xab, 0xc8, 0x8c,
-	0x23, 0xce, 0xde, 0x56, 0xd5, 0x4f, 0x59, 0xec, 0xc9, 0x98, 0x91, 0x85, 0x7f, 0x0f, 0x34, 0xcf,
-	0x37, 0x6d, 0xd7, 0xa0, 0xed, 0x06, 0x31, 0xdc, 0xd8, 0x85, 0x3a, 0xdf, 0x96, 0xf0, 0x43, 0x09,
-	0x66, 0x3b, 0x50, 0x35, 0xad, 0x38, 0x97, 0x93, 0x99, 0x98, 0x0a, 0x52, 0xbd, 0x41, 0x3e, 0xed,
-	0x0d, 0xde, 0x85, 0x8a, 0x63, 0x05, 0xc1, 0x24, 0x7c, 0x62, 0xb8, 0xe4, 0x2f, 0xd3, 0x9d, 0x2e,
-	0x23, 0x72, 0xfc, 0xc4, 0x70, 0x91, 0xd0, 0x76, 0x27, 0xb4, 0x7c, 0x23, 0x85, 0x4a, 0x11, 0xda,
-	0x2e, 0x85, 0xca, 0xe8, 0x67, 0xaf, 0x6c, 0x9a, 0x58, 0xe9, 0x86, 0xd8, 0xc5, 0x79, 0xd5, 0x5f,
-	0x87, 0xd2, 0x23, 0xdb, 0x3a, 0x95, 0xf6, 0xef, 0x99, 0x6d, 0x9d, 0x46, 0xf6, 0x0f, 0xbf, 0xf5,
-	0x7f, 0x55, 0x82, 0x32, 0x11, 0x77, 0x9e, 0xbf, 0xad, 0xf3, 0x53, 0x82, 0xdd, 0x1d, 0xe9, 0x95,
-	0xf2, 0x1b, 0x42, 0x6c, 0xe1, 0xa3, 0x5e, 0x07, 0xe1, 0x4a, 0x27, 0x64, 0x50, 0x84, 0x07, 0xae,
-	0x10, 0x44, 0x6e, 0xbd, 0x54, 0x44, 0x20, 0x14, 0xfc, 0xe0, 0xc8, 0x3c, 0x3f, 0x01, 0xb0, 0xfb,
-	0x50, 0x46, 0x09, 0x29, 0x67, 0x2d, 0xa9, 0x86, 0x85, 0xfa, 0x10, 0xe5, 0x42, 0xbc, 0x14, 0x4e,
-	0x1d, 0x2c, 0xa0, 0xdd, 0xc2, 0x90, 0x44, 0xa6, 0x27, 0xd1, 0x22, 0x55, 0x63, 0x2a, 0x4e, 0x04,
-	0xec, 0x2e, 0x94, 0x28, 0x0a, 0xb0, 0x82, 0x66, 0x4d, 0x35, 0x90, 0x51, 0x88, 0xc2, 0x23, 0x34,
-	0x7b, 0x0f, 0x0a, 0xf3, 0x13, 0xeb, 0x3c, 0x68, 0xd6, 0xd5, 0x85, 0x9f, 0xf2, 0x6f, 0x5c, 0x50,
-	0xb0, 0x3b, 0xd0, 0xf0, 0xad, 0xf9, 0x84, 0x36, 0x6c, 0xd0, 0x21, 0x07, 0xcd, 0x06, 0xf9, 0xdb,
-	0x9a, 0x6f, 0xcd, 0xdb, 0x08, 0x1c, 0x4f, 0x9d, 0x80, 0xbd, 0x03, 0x45, 0xf2, 0x34, 0x41, 0x73,
-	0x5b, 0x6d, 0x39, 0x72, 0x5b, 0x5c, 0x62, 0xd9, 0x2e, 0x54, 0x12, 0xe3, 0x70, 0x95, 0x3a, 0x74,
-	0x65, 0xcd, 0xea, 0x90, 0xb1, 0xe6, 0x09, 0x19, 0xfb, 0x18, 0x40, 0x06, 0xe0, 0x93, 0xe9, 0x39,
-	0xed, 0x67, 0x56, 0xe3, 0x14, 0x44, 0x71, 0x6a, 0x6a, 0x98, 0xfe, 0x2e, 0x14, 0xd0, 0x17, 0x04,
-	0xcd, 0xeb, 0x24, 0xcd, 0xa5, 0xb4, 0xa3, 0xa0, 0xde, 0x11, 0x9e, 0xdd, 0x85, 0x32, 0xaa, 0xd0,
-	0x04, 0x27, 0xaa, 0xa9, 0x66, 0x1e, 0x52, 0xdf, 0x30, 0xf6, 0xb1, 0x4e, 0x47, 0x3f, 0x38, 0xec,
-	0x1e, 0xe4, 0x4d, 0x6b, 0x1e, 0x34, 0x6f, 0x50, 0x8d, 0xd7, 0x94, 0x19, 0xc3, 0x60, 0xa0, 0x63,
-	0xcd, 0x85, 0x03, 0x41, 0x1a, 0x76, 0x00, 0x0d, 0x54, 0xb0, 0x5d, 0x0a, 0x67, 0x71, 0xc8, 0x9b,
-	0x37, 0x89, 0xeb, 0xcd, 0x35, 0xae, 0x81, 0x24, 0xa2, 0x09, 0xea, 0xba, 0xa1, 0x7f, 0xce, 0xeb,
-	0xae, 0x0a, 0x63, 0x37, 0xa1, 0x6c, 0x07, 0x7d, 0x6f, 0x76, 0x62, 0x99, 0xcd, 0xd7, 0xc4, 0xf9,
-	0x44, 0x54, 0x66, 0x5f, 0x41, 0x9d, 0x54, 0x0e, 0x8b, 0xd8, 0x78, 0xf3, 0x96, 0xea, 0xd8, 0xc6,
-	0x2a, 0x8a, 0xa7, 0x29, 0x6f, 0xee, 0x53, 0x5a, 0x42, 0xba, 0xfa, 0xd9, 0x9a, 0x63, 0x4d, 0xe9,
-	0x98, 0xe2, 0x81, 0x0f, 0xb6, 0x54, 0xff, 0xba, 0x57, 0x80, 0x9c, 0x69, 0xcd, 0x6f, 0xfe, 0x0a,
-	0xd8, 0xc5, 0x4e, 0xbc, 0xc8, 0xcb, 0x17, 0xa4, 0x97, 0xff, 0x3a, 0xfb, 0x65, 0x46, 0xff, 0x0a,
-	0xea, 0x29, 0xbd, 0xdf, 0x18, 0xe1, 0x88, 0x28, 0xd9, 0x10, 0xfb, 0xc6, 0x35, 0x2e, 0x0a, 0xfa,
-	0x7f, 0xcc, 0x40, 0x61, 0x14, 0x1a, 0x61, 0xc0, 0x5e, 0x83, 0xca, 0xd4, 0xf1, 0x66, 0x27, 0x13,
-	0x77, 0xb5, 0x90, 0x3b, 0xb2, 0x65, 0x02, 0xa0, 0xab, 0xa3, 0x20, 0x33, 0x08, 0x89, 0x37, 0xc3,
-	0xe9, 0x1b, 0x97, 0xbe, 0xb7, 0x0a, 0x67, 0x6e, 0x48, 0x4b, 0x3f, 0xc3, 0x65, 0x09, 0xed, 0xa0,
-	0xef, 0x9d, 0xd2, 0x86, 0x64, 0x9e, 0x10, 0x51, 0x11, 0xa3, 0xce, 0x27, 0x46, 0xf0, 0x64, 0x61,
-	0x2c, 0x93, 0xfd, 0xca, 0x0c, 0xaf, 0x4a, 0xd8, 0x08, 0x49, 0x5e, 0x03, 0x61, 0x03, 0x26, 0x58,
-	0x6f, 0x91, 0xf0, 0x65, 0x02, 0xb4, 0xdd, 0x10, 0x6d, 0x70, 0x60, 0x39, 0xd6, 0x2c, 0xb4, 0x9f,
-	0x61, 0xe2, 0x56, 0x12, 0xec, 0x0a, 0x48, 0x7f, 0x0f, 0x4a, 0x68, 0x64, 0x8c, 0xd0, 0x40, 0xb7,
-	0x65, 0x1a, 0xa1, 0xb1, 0x69, 0x2f, 0x18, 0xe1, 0xfa, 0x87, 0x00, 0xdc, 0x3b, 0x0d, 0xac, 0x90,
-	0xa8, 0xdf, 0x54, 0x32, 0xaa, 0x58, 0x81, 0x65, 0x55, 0xc2, 0x60, 0xe9, 0xff, 0x2d, 0x03, 0xd5,
-	0xa1, 0x6f, 0xe2, 0xe2, 0x18, 0x2d, 0xad, 0xd9, 0x0b, 0xfd, 0x22, 0x5a, 0x30, 0xcf, 0x71, 0x8c,
-	0xd8, 0xab, 0xa0, 0x05, 0x8b, 0x00, 0xec, 0x63, 0xc8, 0xcf, 0x1d, 0xe3, 0x98, 0xc6, 0x2e, 0x8e,
-	0x8e, 0x95, 0xea, 0xa3, 0xef, 0x07, 0x8e, 0x71, 0xcc, 0x89, 0x54, 0xff, 0xd3, 0xb8, 0x7d, 0xda,
-	0x61, 0x53, 0xf7, 0xd5, 0xb6, 0x68, 0x7f, 0x76, 0xd4, 0xd6, 0x32, 0xac, 0x0c, 0xf9, 0x4e, 0x77,
-	0xd4, 0x16, 0x31, 0x31, 0x46, 0xc7, 0xa3, 0xc9, 0x83, 0x1e, 0x1f, 0x8d, 0xb5, 0x3c, 0x6d, 0xf8,
-	0x12, 0xa0, 0xdf, 0x1a, 0x8d, 0xb5, 0x32, 0x03, 0x28, 0x1e, 0x0d, 0x7a, 0xbf, 0x3e, 0xea, 0x6a,
-	0x9a, 0xfe, 0x77, 0x33, 0x00, 0x8f, 0x6d, 0xd7, 0xf4, 0x4e, 0xa9, 0x73, 0xbf, 0x50, 0xe2, 0x1f,
-	0x34, 0x19, 0x17, 0x47, 0xb1, 0xba, 0x4c, 0xac, 0x0d, 0xfb, 0x00, 0xca, 0x1e, 0x8a, 0x86, 0xa4,
-	0x59, 0xd5, 0x5e, 0x28, 0x3d, 0xe2, 0x25, 0x4f, 0x14, 0x50, 0x9b, 0x1c, 0xcb, 0x30, 0xe5, 0x3e,
-	0x3e, 0x7d, 0xa3, 0xbe, 0xe3, 0x70, 0x88, 0x73, 0x42, 0xfc, 0xd4, 0x7f, 0x9f, 0x87, 0x4a, 0xcf,
-	0x0d, 0x2c, 0x3f, 0x6c, 0x87, 0x67, 0xec, 0x4d, 0xc8, 0xf9, 0xd6, 0xfc, 0x79, 0x1b, 0x94, 0x88,
-	0x63, 0xef, 0x47, 0xba, 0x63, 0x5a, 0x73, 0x19, 0x6e, 0x36, 0xd2, 0xd6, 0x42, 0xea, 0x52, 0x87,
-	0x36, 0xeb, 0x35, 0x4c, 0x6f, 0x56, 0x4b, 0xc7, 0x9e, 0x61, 0x22, 0x6e, 0x9b, 0x67, 0x94, 0x3f,
-	0x16, 0x78, 0xc3, 0x73, 0x3b, 0x11, 0xb8, 0x67, 0x9e, 0xb1, 0x43, 0xb8, 0x94, 0xa2, 0xa4, 0x49,
-	0x17, 0x7e, 0xed, 0x4e, 0xe4, 0x1c, 0xa4, 0x94, 0xf7, 0x87, 0x09, 0x2b, 0x0e, 0x92, 0xb0, 0x47,
-	0xdb, 0x5e, 0x1a, 0x4a, 0x4e, 0xc6, 0x3c, 0x9b, 0x60, 0x7f, 0x44, 0x34, 0x70, 0xa1, 0x3f, 0x98,
-	0x06, 0xcb, 0x43, 0x12, 0x91, 0x10, 0x9f, 0x51, 0x38, 0x50, 0x20, 0x04, 0x0a, 0xf5, 0x4b, 0x8a,
-	0x3d, 0x2d, 0xda, 0x32, 0x3e, 0x6b, 0x96, 0xa8, 0x96, 0xdb, 0xeb, 0xd2, 0x1c, 0x12, 0x45, 0xcf,
-	0x94, 0x76, 0xb1, 0xb2, 0x8c, 0xca, 0xec, 0x0b, 0xa8, 0x47, 0xfe, 0x40, 0xec, 0x3d, 0x94, 0x37,
-	0xb8, 0x04, 0x1a, 0x35, 0x5e, 0x9b, 0x29, 0xa5, 0x9b, 0x03, 0xb8, 0xb2, 0xa9, 0x8f, 0x1b, 0xcc,
-	0xd5, 0x8e, 0x6a, 0xae, 0xd6, 0xf2, 0xa3, 0xd8, 0x74, 0xdd, 0xfc, 0x86, 0x52, 0x0c, 0x45, 0xca,
-	0x9f, 0x64, 0xf8, 0xfe, 0xa2, 0x08, 0x15, 0x91, 0x36, 0xa6, 0x54, 0x24, 0xf7, 0x5c, 0x15, 0xb9,
-	0x0d, 0x39, 0x1c, 0xaf, 0xac, 0x1a, 0x95, 0xf4, 0xcc, 0xbe, 0x1d, 0x84, 0x1c, 0x11, 0xec, 0x03,
-	0xa9, 0x42, 0x1d, 0x74, 0x53, 0x39, 0xd5, 0x0d, 0xc7, 0x2a, 0x94, 0x10, 0x60, 0x42, 0x25, 0x72,
-	0x5c, 0xda, 0xea, 0xc8, 0xab, 0xed, 0xb6, 0xe9, 0xc8, 0xea, 0xa1, 0xb1, 0x8c, 0x0e, 0x0d, 0xdb,
-	0x9e, 0xf3, 0x73, 0xcc, 0xfb, 0x17, 0xb0, 0xed, 0xb9, 0x13, 0xdf, 0x0a, 0x42, 0xdf, 0x9e, 0x85,
-	0x54, 0x55, 0x69, 0x73, 0x55, 0x75, 0xcf, 0xe5, 0x92, 0x0c, 0x6b, 0x7c, 0x27, 0xcd, 0x88, 0x35,
-	0x97, 0xa9, 0x66, 0x85, 0x0e, 0x1b, 0xf8, 0x0c, 0x1a, 0x18, 0x71, 0x1b, 0xc1, 0xcc, 0x30, 0x2d,
-	0xaa, 0xbf, 0xb2, 0xb9, 0xfe, 0x9a, 0xe7, 0xb6, 0x05, 0x15, 0x56, 0xbf, 0x9b, 0x62, 0xc3, 0xda,
-	0x61, 0xc3, 0x18, 0x27, 0x3c, 0xd8, 0xd4, 0xa7, 0x29, 0x1e, 0x5c, 0xb4, 0xd5, 0x8d, 0x23, 0x9e,
-	0x70, 0xe1, 0xc2, 0xdd, 0x83, 0xab, 0x0a, 0x97, 0x32, 0xfe, 0xb5, 0xcd, 0xe3, 0xcf, 0x62, 0xee,
-	0xa3, 0x78, 0x22, 0x7e, 0x01, 0xe0, 0xb9, 0x93, 0xc0, 0x12, 0x03, 0x58, 0xdf, 0xdc, 0xc1, 0xb2,
-	0xe7, 0x8e, 0x2c, 0x1a, 0xbb, 0x7b, 0x31, 0x39, 0x76, 0xac, 0xb1, 0xa1, 0x63, 0x82, 0xb6, 0x47,
-	0x1a, 0x14, 0xd1, 0x62, 0x87, 0xb6, 0x37, 0x76, 0x48, 0x50, 0x63, 0x67, 0xbe, 0x26, 0xdb, 0x82,
-	0xd4, 0x4a, 0x47, 0xb4, 0xcd, 0x1d, 0x69, 0x10, 0x57, 0xd2, 0x89, 0xfb, 0x29, 0x13, 0x70, 0xe9,
-	0x39, 0xda, 0x17, 0xaf, 0x79, 0xfd, 0x2f, 0x73, 0x50, 0x6d, 0xb9, 0x86, 0x73, 0xfe, 0x5b, 0xab,
-	0xe7, 0xce, 0x3d, 0xb1, 0xab, 0xb6, 0x5c, 0x85, 0x13, 0x74, 0xcf, 0x72, 0x03, 0xbd, 0x42, 0x10,
-	0xf4, 0x8b, 0xec, 0x0d, 0xa8, 0x7a, 0xab, 0x30, 0xc6, 0x8b, 0x2d, 0x75, 0x10, 0x20, 0x22, 0x88,
-	0xf9, 0xc9, 0x97, 0xe7, 0x14, 0x7e, 0xf2, 0xe4, 0x09, 0x7f, 0x1c, 0x0a, 0xc4, 0xfc, 0x44, 0xf0,
-	0x16, 0xd4, 0x43, 0x7b, 0x81, 0x5d, 0x76, 0x83, 0xd5, 0xc2, 0x32, 0xc5, 0x95, 0x0b, 0x71, 0x8a,
-	0xdf, 0x96, 0x30, 0xac, 0x65, 0x61, 0x2d, 0x3c, 0xff, 0x5c, 0xd4, 0x52, 0x14, 0xb5, 0x08, 0x10,
-	0xd5, 0xf2, 0x01, 0xb0, 0x53, 0xc3, 0x0e, 0x27, 0xe9, 0xaa, 0x44, 0x62, 0xad, 0x21, 0x66, 0xac,
-	0x56, 0x77, 0x0d, 0x8a, 0xa6, 0x1d, 0x9c, 0xf4, 0x86, 0x64, 0xf0, 0x72, 0x5c, 0x96, 0x30, 0xec,
-	0x08, 0x3e, 0xe9, 0x0d, 0x27, 0xd3, 0x73, 0xb9, 0xf3, 0x9d, 0xe3, 0x65, 0x04, 0xec, 0x9d, 0x87,
-	0xb4, 0x63, 0x48, 0x48, 0xd1, 0x5b, 0x3a, 0x5c, 0xa3, 0x1d, 0xef, 0x1c, 0x6f, 0x20, 0xbc, 0x87,
-	0xe0, 0x36, 0x42, 0xd9, 0x3d, 0xb8, 0x44, 0x94, 0xb2, 0xe3, 0x82, 0xb4, 0x4a, 0xa4, 0xdb, 0x88,
-	0x18, 0x12, 0x5c, 0xd0, 0xde, 0x82, 0x8a, 0x6b, 0x85, 0xa7, 0x9e, 0x8f, 0xd2, 0xd4, 0xc4, 0xe8,
-	0xc5, 0x00, 0x0c, 0x5a, 0x83, 0x99, 0xe1, 0xa2, 0xf0, 0xb4, 0x1b, 0x8e, 0xf2, 0xc8, 0x32, 0xbb,
-	0x8d, 0x03, 0x8f, 0x36, 0x9e, 0xb0, 0x0d, 0x31, 0x24, 0x09, 0x44, 0xff, 0xd7, 0xdb, 0x90, 0x1f,
-	0x78, 0xa6, 0xc5, 0x3e, 0x82, 0x0a, 0x1d, 0x33, 0x5f, 0xdc, 0xb2, 0x41, 0x34, 0xfd, 0xa1, 0xc8,
-	0xb6, 0xec, 0xca, 0xaf, 0xe7, 0x1f, 0x4c, 0xbf, 0x09, 0x85, 0x00, 0xc3, 0x44, 0xb9, 0x09, 0x23,
-	0x8f, 0xc5, 0x28, 0x72, 0xe4, 0x02, 0x83, 0x22, 0x53, 0x86, 0xe3, 0x5b, 0x2e, 0xd9, 0xc2, 0x02,
-	0x8f, 0xcb, 0x14, 0x4e, 0xf8, 0x1e, 0xae, 0xac, 0x09, 0x1d, 0x13, 0x15, 0x36, 0x84, 0x13, 0x02,
-	0x4f, 0xe7, 0xf8, 0x1f, 0x41, 0xe5, 0xa9, 0x67, 0xbb, 0x42, 0xf0, 0xe2, 0x05, 0xc1, 0xbf, 0xf5,
-	0x6c, 0xb1, 0xd7, 0x54, 0x7e, 0x2a, 0xbf, 0xd8, 0x5b, 0x50, 0xf2, 0x5c, 0x51, 0x77, 0xe9, 0x42,
-	0xdd, 0x45, 0xcf, 0xed, 0x8b, 0xe3, 0xa7, 0xfa, 0x74, 0x85, 0x39, 0x18, 0x92, 0x5a, 0xf3, 0x50,
-	0x6e, 0xad, 0x54, 0x09, 0x38, 0x74, 0xfb, 0xd6, 0x3c, 0x64, 0xef, 0x43, 0x75, 0x6e, 0x3b, 0xe8,
-	0x18, 0xa9, 0xb2, 0xca, 0x85, 0xca, 0x40, 0xa0, 0xa9, 0xc2, 0xb7, 0xa1, 0x7c, 0xec, 0x7b, 0xab,
-	0x25, 0x86, 0x3d, 0x70, 0x71, 0x33, 0x83, 0x70, 0x7b, 0xe7, 0xd8, 0x7b, 0xfa, 0xb4, 0xdd, 0x63,
-	0x5c, 0xeb, 0xd2, 0xcc, 0xa5, 0x7a, 0x1f, 0xe1, 0x47, 0x16, 0xd5, 0x6a, 0x1c, 0x1f, 0x8b, 0xf6,
-	0x6b, 0x17, 0x6b, 0x35, 0x8e, 0x8f, 0xa9, 0xf1, 0xf7, 0xa1, 0x7c, 0x6a, 0xbb, 0x93, 0x60, 0x69,
-	0xcd, 0x48, 0x45, 0xe2, 0xb3, 0xb9, 0x24, 0x8c, 0xe3, 0xa5, 0x53, 0xdb, 0xa5, 0x78, 0x4e, 0x0d,
-	0xd0, 0x1a, 0x2f, 0x0c, 0xd0, 0x76, 0xa0, 0xe0, 0xd8, 0x0b, 0x3b, 0xa4, 0x0b, 0x41, 0x6b, 0xbe,
-	0x9b, 0x10, 0x4c, 0x87, 0xa2, 0x37, 0x9f, 0x63, 0x67, 0xb4, 0x0b, 0x24, 0x12, 0xa3, 0xba, 0xc7,
-	0xf0, 0x2c, 0x7d, 0x2d, 0x28, 0x76, 0xda, 0xb1, 0x7b, 0x0c, 0xcf, 0xd2, 0xf1, 0x1b, 0x7b, 0x41,
-	0xfc, 0xb6, 0x2b, 0x33, 0x37, 0x24, 0x9e, 0x3c, 0xb3, 0x66, 0xcd, 0xcb, 0x1b, 0x4d, 0x6d, 0x35,
-	0x62, 0x78, 0x64, 0xcd, 0xd0, 0xff, 0x7a, 0xd3, 0xa7, 0x64, 0xf3, 0xaf, 0x6c, 0x8e, 0x23, 0x8b,
-	0xde, 0xf4, 0x29, 0x5a, 0xfc, 0x8f, 0xa1, 0xea, 0x53, 0x72, 0x30, 0xa1, 0x1c, 0xe2, 0xaa, 0x3a,
-	0xbc, 0x49, 0xd6, 0xc0, 0xc1, 0x4f, 0x32, 0x88, 0xb7, 0xa0, 0x2e, 0x0e, 0x73, 0xc4, 0xee, 0x7d,
-	0x40, 0x59, 0x76, 0x85, 0xd7, 0x08, 0x28, 0x76, 0xf6, 0x29, 0x62, 0x10, 0x3b, 0xea, 0x34, 0x24,
-	0xd7, 0x55, 0x21, 0xc4, 0xd6, 0x39, 0x0d, 0x89, 0x19, 0x7d, 0x62, 0xc6, 0x34, 0xb5, 0x5d, 0x13,
-	0x15, 0x27, 0x34, 0x8e, 0x83, 0x66, 0x93, 0xd6, 0x55, 0x55, 0xc2, 0xc6, 0xc6, 0x71, 0xc0, 0x3e,
-	0x85, 0x9a, 0x21, 0xac, 0xfa, 0xc4, 0x76, 0xe7, 0x5e, 0xf3, 0x86, 0x7a, 0xac, 0xa0, 0xd8, 0x7b,
-	0x5e, 0x35, 0x14, 0xe3, 0xff, 0x05, 0xb0, 0x68, 0x03, 0x85, 0x02, 0x5a, 0xa1, 0x6d, 0x37, 0x2f,
-	0x68, 0xdb, 0xb6, 0xdc, 0x41, 0x89, 0xaf, 0xd8, 0xec, 0x00, 0x06, 0xfe, 0x86, 0xe3, 0x58, 0x8e,
-	0x1d, 0x2c, 0x28, 0xa1, 0x2e, 0x70, 0x15, 0x74, 0x31, 0xb6, 0xbc, 0xf5, 0x72, 0xb1, 0x25, 0x8e,
-	0xa0, 0xeb, 0x85, 0x93, 0x99, 0x31, 0x7b, 0x62, 0x11, 0xe3, 0xeb, 0xb4, 0x3c, 0x6b, 0xae, 0x17,
-	0xb6, 0x23, 0x18, 0x8e, 0xa0, 0x30, 0x75, 0x34, 0x82, 0xb7, 0xd5, 0x11, 0x8c, 0x03, 0x5f, 0x74,
-	0x43, 0x49, 0xde, 0x50, 0x9b, 0xad, 0x7c, 0x72, 0x93, 0x41, 0x68, 0x2d, 0x9b, 0x6f, 0x08, 0x81,
-	0x25, 0x6c, 0x14, 0x5a, 0x4b, 0xba, 0x37, 0xe2, 0xad, 0xfc, 0x99, 0x25, 0x28, 0x76, 0x88, 0x02,
-	0x04, 0x08, 0x09, 0xf4, 0xff, 0x92, 0x83, 0x72, 0x64, 0x2c, 0x59, 0x15, 0x4a, 0x47, 0x83, 0xef,
-	0x06, 0xc3, 0xc7, 0x03, 0x6d, 0x0b, 0x33, 0xaa, 0x47, 0xad, 0xfe, 0x51, 0x77, 0x32, 0x6a, 0xb7,
-	0x06, 0xe2, 0x4a, 0x0d, 0x5d, 0x6e, 0x10, 0xe5, 0x2c, 0xbb, 0x04, 0xf5, 0x07, 0x47, 0x03, 0x3a,
-	0x84, 0x10, 0xa0, 0x1c, 0x82, 0xba, 0xbf, 0x11, 0x69, 0x9b, 0x00, 0xe5, 0x11, 0xf4, 0xb0, 0x35,
-	0xee, 0xf2, 0x5e, 0x04, 0x2a, 0x60, 0x2b, 0x87, 0x7c, 0xf8, 0x6d, 0xb7, 0x3d, 0xd6, 0x80, 0x5d,
-	0x85, 0x4b, 0x31, 0x4b, 0x54, 0x9d, 0x56, 0xc5, 0x04, 0x30, 0x62, 0xd3, 0xae, 0x60, 0x25, 0xbc,
-	0xdb, 0x3e, 0xe2, 0xa3, 0xde, 0xa3, 0xee, 0xa4, 0x3d, 0xee, 0x6a, 0x57, 0x31, 0x15, 0x1c, 0xf5,
-	0x06, 0xdf, 0x69, 0xd7, 0x58, 0x1d, 0x2a, 0xf8, 0x25, 0x6a, 0xbf, 0x4e, 0xc9, 0xe2, 0xfe, 0xbe,
-	0x76, 0x1b, 0xab, 0xe8, 0xf4, 0x46, 0xe3, 0xde, 0xa0, 0x3d, 0xd6, 0xde, 0xc0, 0x7c, 0xf0, 0x41,
-	0xaf, 0x3f, 0xee, 0x72, 0x6d, 0x07, 0x79, 0xbf, 0x1d, 0xf6, 0x06, 0xda, 0x9b, 0x74, 0x2f, 0xa3,
-	0xf5, 0xf0, 0xb0, 0xdf, 0xd5, 0x74, 0xaa, 0x71, 0xc8, 0xc7, 0xda, 0x5b, 0xac, 0x02, 0x85, 0xa3,
-	0x01, 0xca, 0x71, 0x07, 0x2b, 0xa7, 0xcf, 0x49, 0xab, 0xdf, 0xd7, 0xde, 0x56, 0xb2, 0xca, 0x77,
-	0xf0, 0xfb, 0x71, 0x6f, 0xd0, 0x19, 0x3e, 0xd6, 0xde, 0x45, 0xb2, 0x3d, 0x3e, 0x6c, 0x75, 0xda,
-	0x98, 0x7c, 0xde, 0xc5, 0x0a, 0x46, 0x87, 0xfd, 0xde, 0x58, 0x7b, 0x0f, 0xa9, 0xf6, 0x5b, 0xe3,
-	0x83, 0x2e, 0xd7, 0xee, 0xe1, 0x77, 0x6b, 0x34, 0xea, 0xf2, 0xb1, 0xb6, 0x8b, 0xdf, 0xbd, 0x01,
-	0x7d, 0x7f, 0x42, 0xb5, 0x1e, 0x76, 0x5a, 0xe3, 0xae, 0xf6, 0x29, 0x7e, 0x77, 0xba, 0xfd, 0xee,
-	0xb8, 0xab, 0x7d, 0x86, 0xb5, 0x52, 0x16, 0x3c, 0xc2, 0xa1, 0xfa, 0x1c, 0x47, 0x21, 0x2e, 0x92,
-	0x3c, 0x5f, 0x60, 0x43, 0x0f, 0x7b, 0x83, 0xa3, 0x91, 0xf6, 0x25, 0x12, 0xd3, 0x27, 0x61, 0xbe,
-	0xd2, 0x9f, 0x42, 0x39, 0x72, 0x25, 0x48, 0xd5, 0x1b, 0x0c, 0xba, 0x5c, 0xdb, 0xc2, 0x4e, 0xf6,
-	0xbb, 0x0f, 0xc6, 0x5a, 0x06, 0x81, 0xbc, 0xb7, 0x7f, 0x30, 0xd6, 0xb2, 0xf8, 0x39, 0x3c, 0xc2,
-	0xa1, 0xc9, 0xd1, 0x20, 0x74, 0x1f, 0xf6, 0xb4, 0x3c, 0x7e, 0xb5, 0x06, 0xe3, 0x9e, 0x56, 0xa0,
-	0x41, 0xea, 0x0d, 0xf6, 0xfb, 0x5d, 0xad, 0x88, 0xd0, 0x87, 0x2d, 0xfe, 0x9d, 0x56, 0x42, 0xa6,
-	0xd6, 0xe1, 0x61, 0xff, 0x7b, 0xad, 0xac, 0xdf, 0x85, 0x52, 0xeb, 0xf8, 0xf8, 0x21, 0xba, 0xe5,
-	0x32, 0xe4, 0x1f, 0x1c, 0xf5, 0xfb, 0xe2, 0x36, 0xd6, 0xde, 0x70, 0x3c, 0x1e, 0x3e, 0xd4, 0x32,
-	0x38, 0x27, 0xe3, 0xe1, 0xa1, 0x96, 0xd5, 0x6f, 0x41, 0x51, 0x44, 0x95, 0x94, 0x27, 0x47, 0xd7,
-	0xd9, 0x72, 0xf2, 0x0a, 0x9b, 0x07, 0x95, 0x38, 0xba, 0x63, 0xf7, 0x20, 0xb7, 0x30, 0x96, 0x32,
-	0xe3, 0x69, 0xae, 0xc5, 0x7e, 0xf7, 0x1f, 0x1a, 0x4b, 0x91, 0xf8, 0x21, 0xd1, 0xcd, 0xcf, 0xa1,
-	0x1c, 0x01, 0x7e, 0x52, 0x8e, 0xf5, 0x2f, 0xf3, 0x50, 0xe9, 0x28, 0x06, 0xe9, 0x8f, 0xce, 0xb1,
-	0x94, 0x2c, 0x28, 0xf7, 0xd2, 0x59, 0x50, 0xfe, 0x45, 0x59, 0x50, 0xe1, 0x55, 0xb3, 0xa0, 0xe2,
-	0xcb, 0x65, 0x41, 0xa5, 0x97, 0xc9, 0x82, 0xee, 0x5c, 0xc8, 0x82, 0x44, 0x8e, 0x95, 0xce, 0x7b,
-	0xd2, 0xd9, 0x47, 0xe5, 0x45, 0xd9, 0x47, 0x3a, 0xa3, 0x80, 0x17, 0x64, 0x14, 0xe9, 0x5c, 0xa5,
-	0xfa, 0x07, 0x73, 0x95, 0x8d, 0xd9, 0x47, 0xed, 0xe5, 0xb2, 0x0f, 0xb4, 0xab, 0x86, 0x3b, 0x09,
-	0xfd, 0x95, 0x3b, 0x33, 0x42, 0x11, 0xa4, 0x96, 0x79, 0x15, 0x63, 0x54, 0x09, 0xd2, 0xff, 0x22,
-	0x0b, 0x85, 0x5f, 0xaf, 0x2c, 0xff, 0x9c, 0x7d, 0x0e, 0x95, 0x20, 0x5c, 0x84, 0x6a, 0x20, 0x7a,
-	0x43, 0x34, 0x40, 0x78, 0x8a, 0x23, 0xad, 0x85, 0xe5, 0x86, 0x22, 0xaa, 0x43, 0x5a, 0x5a, 0x94,
-	0x57, 0x30, 0xea, 0xb4, 0x96, 0xe2, 0xe4, 0xa7, 0xc0, 0x45, 0x01, 0xa3, 0x13, 0x8c, 0x4a, 0xa3,
-	0x04, 0x1d, 0x92, 0xc8, 0x90, 0x0b, 0x04, 0x46, 0x27, 0xb4, 0xbd, 0x19, 0x9d, 0x3f, 0xa4, 0xa2,
-	0x13, 0x81, 0xc1, 0x70, 0xf5, 0x89, 0x65, 0xa0, 0x1b, 0x8d, 0xee, 0x30, 0xc4, 0x65, 0xd6, 0x84,
-	0x92, 0xe3, 0x19, 0xe6, 0xd8, 0x38, 0x8e, 0x6e, 0xd9, 0xc8, 0xa2, 0xfe, 0x18, 0xea, 0x29, 0x61,
-	0xd3, 0xee, 0x00, 0xad, 0x40, 0xb7, 0x8f, 0x96, 0x28, 0xa3, 0x18, 0xaf, 0xac, 0x62, 0xb0, 0x72,
-	0x8a, 0x21, 0xcb, 0x93, 0x69, 0xea, 0xf2, 0xfd, 0xae, 0x56, 0xd0, 0xff, 0x69, 0x16, 0x2e, 0x8d,
-	0x7d, 0xc3, 0x0d, 0x0c, 0x71, 0xb2, 0xe5, 0x86, 0xbe, 0xe7, 0xb0, 0xaf, 0xa1, 0x1c, 0xce, 0x1c,
-	0x75, 0xdc, 0xde, 0x90, 0x33, 0xbf, 0x4e, 0x7a, 0x7f, 0x3c, 0x73, 0x68, 0xf4, 0x4a, 0xa1, 0xf8,
-	0x60, 0xbf, 0x80, 0xc2, 0xd4, 0x3a, 0xb6, 0x5d, 0xb9, 0x01, 0x73, 0x75, 0x9d, 0x71, 0x0f, 0x91,
-	0x07, 0x5b, 0x5c, 0x50, 0xb1, 0x8f, 0xa0, 0x38, 0xf3, 0x16, 0x18, 0xf4, 0xe5, 0xd4, 0xb3, 0x52,
-	0xb5, 0x21, 0xc4, 0x1e, 0x6c, 0x71, 0x49, 0xc7, 0x3e, 0x87, 0xb2, 0xef, 0x39, 0xce, 0xd4, 0x98,
-	0x9d, 0xc8, 0xf3, 0xd5, 0xe6, 0x3a, 0x0f, 0x97, 0xf8, 0x83, 0x2d, 0x1e, 0xd3, 0xea, 0xf7, 0xa1,
-	0x24, 0x85, 0xc5, 0x01, 0xd8, 0xeb, 0xee, 0xf7, 0xe4, 0xd8, 0xb5, 0x87, 0x0f, 0x1f, 0xf6, 0xc6,
-	0xe2, 0x6c, 0x9f, 0x0f, 0xfb, 0xfd, 0xbd, 0x56, 0xfb, 0x3b, 0x2d, 0xbb, 0x57, 0x86, 0xa2, 0x41,
-	0xfb, 0xda, 0xfa, 0xdf, 0xca, 0xc0, 0xf6, 0x5a, 0x07, 0xd8, 0x97, 0x90, 0x5f, 0x78, 0x66, 0x34,
-	0x3c, 0x77, 0x36, 0xf6, 0x52, 0x29, 0xa3, 0x05, 0xe6, 0xc4, 0xa1, 0x7f, 0x05, 0x8d, 0x34, 0x5c,
-	0xb9, 0xb4, 0x58, 0x87, 0x0a, 0xef, 0xb6, 0x3a, 0x93, 0xe1, 0xa0, 0xff, 0xbd, 0xf0, 0xeb, 0x54,
-	0x7c, 0xcc, 0x7b, 0xe3, 0xae, 0x96, 0xd5, 0xff, 0x14, 0xb4, 0xf5, 0x81, 0x61, 0xfb, 0xb0, 0x3d,
-	0xf3, 0x16, 0x4b, 0xc7, 0x12, 0x87, 0x72, 0xc9, 0x94, 0xdd, 0xde, 0x30, 0x92, 0x92, 0x8c, 0x66,
-	0xac, 0x31, 0x4b, 0x95, 0xf5, 0xbf, 0x01, 0xec, 0xe2, 0x08, 0xfe, 0x7c, 0xd5, 0xff, 0x8f, 0x0c,
-	0xe4, 0x0f, 0x1d, 0xc3, 0x65, 0x6f, 0x41, 0x81, 0x2e, 0x04, 0xca, 0x4d, 0xd5, 0xaa, 0xb2, 0x22,
-	0x51, 0x2d, 0x08, 0xc7, 0xde, 0x87, 0x5c, 0x38, 0x73, 0xa4, 0x0e, 0x5d, 0x7f, 0x8e, 0xf2, 0x1d,
-	0x6c, 0x71, 0xa4, 0x62, 0x77, 0x21, 0x67, 0x9a, 0x8e, 0x54, 0x20, 0x79, 0x28, 0x85, 0xc1, 0x71,
-	0xc7, 0x9a, 0xdb, 0xae, 0x2d, 0xaf, 0x27, 0x22, 0x09, 0x7b, 0x1b, 0x72, 0xe6, 0xcc, 0x91, 0x6a,
-	0x73, 0x29, 0xa1, 0x54, 0x2a, 0x34, 0x67, 0x0e, 0xbb, 0x0f, 0xb5, 0x56, 0x18, 0x62, 0xf0, 0x67,
-	0xa2, 0xc8, 0xe9, 0x6b, 0x71, 0x08, 0xe1, 0x29, 0xfc, 0x5e, 0x11, 0xe8, 0xf9, 0x8b, 0xfe, 0x01,
-	0x5d, 0xd7, 0x5b, 0x2d, 0x5c, 0x34, 0x05, 0xe2, 0x6b, 0xc3, 0x16, 0xb6, 0xc4, 0xe8, 0xff, 0x37,
-	0x0b, 0x55, 0xa5, 0x71, 0xf6, 0x29, 0x94, 0xcd, 0xf4, 0xaa, 0xbb, 0x71, 0x41, 0xc2, 0xfb, 0x9d,
-	0x68, 0xbd, 0x99, 0x52, 0x97, 0xbf, 0x82, 0x3a, 0x9a, 0xd2, 0x67, 0x86, 0x6f, 0xa3, 0x59, 0x0e,
-	0xe4, 0x98, 0xc9, 0xb8, 0x77, 0x64, 0x85, 0x8f, 0x22, 0xcc, 0xc1, 0x16, 0xaf, 0x05, 0x4a, 0x99,
-	0xbd, 0x07, 0xa5, 0xa5, 0x6f, 0x2d, 0x0d, 0xdf, 0x92, 0x63, 0x57, 0x8f, 0x4e, 0x8f, 0x08, 0x78,
-	0xb0, 0xc5, 0x23, 0x3c, 0x92, 0x5a, 0x67, 0xd6, 0x6c, 0x15, 0x5a, 0x72, 0xf0, 0xea, 0x51, 0x87,
-	0x08, 0x88, 0xa4, 0x12, 0xcf, 0x76, 0x31, 0xd9, 0x30, 0x1c, 0xc7, 0x23, 0x03, 0x5d, 0x50, 0x73,
-	0x98, 0x4e, 0x0c, 0x17, 0xef, 0x1e, 0xa2, 0x92, 0x7e, 0x0c, 0x25, 0xd9, 0x31, 0x0c, 0xa5, 0x46,
-	0xdd, 0xf1, 0xe4, 0x51, 0x8b, 0xf7, 0x30, 0xa4, 0x1d, 0x69, 0x5b, 0xb8, 0x5c, 0xf7, 0x79, 0x6b,
-	0x20, 0xcd, 0x1b, 0xef, 0x3e, 0x1a, 0x7e, 0xd7, 0xd5, 0xb2, 0xe2, 0xc8, 0x61, 0xf0, 0xbd, 0x96,
-	0x13, 0x61, 0x6b, 0xf7, 0xb0, 0xc5, 0xd1, 0xba, 0x55, 0xa1, 0xd4, 0xfd, 0x4d, 0xb7, 0x7d, 0x34,
-	0xee, 0x6a, 0x05, 0x5c, 0x41, 0x9d, 0x6e, 0xab, 0xdf, 0x1f, 0xb6, 0xd1, 0xf4, 0x15, 0xf7, 0x2a,
-	0x50, 0x9a, 0x89, 0x91, 0xd4, 0xff, 0x4d, 0x1d, 0x1a, 0x69, 0x2d, 0x61, 0x5f, 0x40, 0xd9, 0x34,
-	0x53, 0x33, 0x70, 0x6b, 0x93, 0x36, 0xdd, 0xef, 0x98, 0xd1, 0x24, 0x88, 0x0f, 0xf6, 0x66, 0xa4,
-	0xd3, 0xd9, 0x0b, 0x3a, 0x1d, 0x69, 0xf4, 0x9f, 0xc0, 0xb6, 0xbc, 0x7c, 0x87, 0xb9, 0xdd, 0xd4,
-	0x08, 0xac, 0xb4, 0xc2, 0xb6, 0x09, 0xd9, 0x91, 0xb8, 0x83, 0x2d, 0xde, 0x98, 0xa5, 0x20, 0xec,
-	0x1b, 0x68, 0x18, 0xb4, 0x43, 0x10, 0xf3, 0xe7, 0xd5, 0x23, 0xbf, 0x16, 0xe2, 0x14, 0xf6, 0xba,
-	0xa1, 0x02, 0x50, 0x4d, 0x4c, 0xdf, 0x5b, 0x26, 0xcc, 0x05, 0x55, 0x4d, 0x3a, 0xbe, 0xb7, 0x54,
-	0x78, 0x6b, 0xa6, 0x52, 0x66, 0x9f, 0x43, 0x4d, 0x4a, 0x9e, 0x3c, 0x94, 0x8a, 0x57, 0x8f, 0x10,
-	0x9b, 0x22, 0x82, 0x83, 0x2d, 0x5e, 0x9d, 0x25, 0x45, 0xf6, 0x09, 0x54, 0x85, 0xc0, 0x82, 0xad,
-	0xa4, 0x6a, 0x02, 0x49, 0x1b, 0x71, 0x81, 0x11, 0x97, 0xd8, 0x47, 0x00, 0x24, 0xa7, 0x7a, 0x3e,
-	0xb0, 0x9d, 0x08, 0x19, 0xb1, 0x54, 0xcc, 0xa8, 0xa0, 0x88, 0x27, 0x0e, 0x6c, 0x2b, 0x17, 0xc5,
-	0xa3, 0x03, 0xce, 0x44, 0x3c, 0x71, 0x40, 0x1b, 0x8b, 0x27, 0xd8, 0xe0, 0x82, 0x78, 0x11, 0x97,
-	0x10, 0x4f, 0x30, 0x45, 0xe2, 0x09, 0x9e, 0xea, 0xba, 0x78, 0x11, 0x0b, 0x89, 0x27, 0x38, 0xbe,
-	0x81, 0x46, 0x14, 0xad, 0xc8, 0x4e, 0xd5, 0x52, 0x37, 0x07, 0x24, 0x2e, 0xea, 0x58, 0x3d, 0x54,
-	0x01, 0xc8, 0x1d, 0x3c, 0xf1, 0x4e, 0x95, 0xe5, 0x5d, 0x57, 0xb9, 0x47, 0x4f, 0xbc, 0x53, 0x75,
-	0x7d, 0xd7, 0x03, 0x15, 0x80, 0xd2, 0x8a, 0x2e, 0xd2, 0xc5, 0x8b, 0x86, 0x2a, 0x2d, 0xf5, 0xf0,
-	0x91, 0x6d, 0x9d, 0xa2, 0xb4, 0x46, 0x54, 0xc0, 0x41, 0xa1, 0xd3, 0xd8, 0x50, 0x34, 0xb6, 0xad,
-	0x0e, 0x0a, 0x9d, 0x41, 0x47, 0x2d, 0x81, 0x13, 0x97, 0x50, 0xb7, 0x56, 0xae, 0xca, 0xa6, 0xa9,
-	0xba, 0x75, 0xe4, 0xa6, 0x18, 0x6b, 0x82, 0x54, 0xb2, 0x26, 0xab, 0x22, 0xb0, 0x7e, 0x58, 0x59,
-	0xee, 0xcc, 0x92, 0x3b, 0x36, 0xa9, 0x55, 0x31, 0x92, 0xb8, 0x64, 0x55, 0x44, 0x90, 0x58, 0xaf,
-	0x63, 0x76, 0xb6, 0xae, 0xd7, 0x0a, 0x33, 0xe9, 0x75, 0xcc, 0x1a, 0x2f, 0xa8, 0x98, 0xf7, 0xf2,
-	0x85, 0x05, 0xa5, 0x30, 0x8b, 0x05, 0x15, 0x01, 0xf4, 0xff, 0x93, 0x87, 0x92, 0xb4, 0x03, 0xec,
-	0x32, 0x6c, 0xb7, 0x79, 0xb7, 0x35, 0xee, 0x4e, 0x3a, 0xad, 0x71, 0x6b, 0xaf, 0x35, 0x42, 0x5f,
-	0xce, 0xa0, 0xd1, 0xc2, 0xac, 0x36, 0x81, 0x65, 0xd0, 0xb8, 0x75, 0xf8, 0xf0, 0x30, 0x01, 0x65,
-	0x99, 0x06, 0x35, 0xc9, 0x2b, 0xde, 0x27, 0xe4, 0xd8, 0x36, 0x54, 0x05, 0xa3, 0x00, 0xd0, 0x01,
-	0x2a, 0x71, 0x89, 0x72, 0x41, 0x61, 0xe9, 0x0d, 0x3a, 0xdd, 0xdf, 0x68, 0xc5, 0x84, 0x45, 0x00,
-	0x4a, 0x31, 0x8b, 0x28, 0x97, 0x51, 0x98, 0x31, 0x3f, 0x1a, 0xb4, 0x93, 0x76, 0x2a, 0xc8, 0x24,
-	0xab, 0x79, 0xd4, 0xeb, 0x3e, 0xd6, 0x00, 0x99, 0x44, 0x2d, 0x54, 0xae, 0x62, 0x34, 0x42, 0x95,
-	0x50, 0xb1, 0xc6, 0xae, 0xc3, 0xe5, 0xd1, 0xc1, 0xf0, 0xf1, 0x44, 0x30, 0xc5, 0x5d, 0xa8, 0xb3,
-	0x2b, 0xa0, 0x29, 0x08, 0x51, 0x7d, 0x03, 0x9b, 0x24, 0x68, 0x44, 0x38, 0xd2, 0xb6, 0xe9, 0xbe,
-	0x24, 0xc2, 0xc6, 0xc2, 0xb4, 0x6b, 0xd8, 0x15, 0xc1, 0x3a, 0xec, 0x1f, 0x3d, 0x1c, 0x8c, 0xb4,
-	0x4b, 0x28, 0x04, 0x41, 0x84, 0xe4, 0x2c, 0xae, 0x26, 0x71, 0x08, 0x97, 0xc9, 0x47, 0x20, 0xec,
-	0x71, 0x8b, 0x0f, 0x7a, 0x83, 0xfd, 0x91, 0x76, 0x25, 0xae, 0xb9, 0xcb, 0xf9, 0x90, 0x8f, 0xb4,
-	0xab, 0x31, 0x60, 0x34, 0x6e, 0x8d, 0x8f, 0x46, 0xda, 0xb5, 0x58, 0xca, 0x43, 0x3e, 0x6c, 0x77,
-	0x47, 0xa3, 0x7e, 0x6f, 0x34, 0xd6, 0xae, 0xb3, 0xab, 0x70, 0x29, 0x91, 0x28, 0x22, 0x6e, 0x2a,
-	0x82, 0xf2, 0xfd, 0xee, 0x58, 0xbb, 0x11, 0x8b, 0xd1, 0x1e, 0xf6, 0xfb, 0x2d, 0xda, 0x09, 0xb9,
-	0x89, 0x44, 0xfd, 0x61, 0xfb, 0xbb, 0xa8, 0x37, 0xaf, 0xa1, 0x5c, 0x47, 0x03, 0x15, 0x74, 0x4b,
-	0x51, 0x8d, 0x51, 0xf7, 0xd7, 0x47, 0xdd, 0x41, 0xbb, 0xab, 0xbd, 0x9e, 0xa8, 0x46, 0x0c, 0xbb,
-	0x1d, 0xab, 0x46, 0x0c, 0x7a, 0x23, 0x6e, 0x33, 0x02, 0x8d, 0xb4, 0x9d, 0xbd, 0x1a, 0xbd, 0x21,
-	0x94, 0x8e, 0x48, 0xff, 0x16, 0x98, 0xfa, 0xd6, 0x47, 0xde, 0xf3, 0x66, 0x90, 0x9f, 0xfb, 0xde,
-	0x22, 0xba, 0x87, 0x81, 0xdf, 0xb4, 0x81, 0xb6, 0x9a, 0xd2, 0xf9, 0x69, 0x72, 0x31, 0x40, 0x05,
-	0xe9, 0x7f, 0x9e, 0x81, 0x46, 0xda, 0x09, 0x31, 0x1d, 0xea, 0xf6, 0x7c, 0xe2, 0x7a, 0xa1, 0xb8,
-	0x8b, 0x1c, 0xc8, 0xbb, 0xe2, 0x55, 0x7b, 0x3e, 0xf0, 0x42, 0xba, 0x8c, 0x4c, 0x09, 0x4d, 0xec,
-	0x53, 0x44, 0xad, 0x71, 0x99, 0xf5, 0xe0, 0x72, 0xea, 0x79, 0x53, 0xea, 0x26, 0x78, 0x33, 0x7e,
-	0x1f, 0xb2, 0x26, 0x3f, 0x67, 0xc1, 0x05, 0x98, 0x7e, 0x00, 0xf5, 0x94, 0x87, 0x63, 0xaf, 0x41,
-	0xc5, 0x9e, 0xa7, 0xe5, 0x2a, 0xdb, 0xf3, 0x17, 0x0b, 0xa5, 0xef, 0x43, 0x4d, 0x75, 0x77, 0xaf,
-	0x5e, 0xd1, 0x1b, 0x50, 0x79, 0x70, 0x12, 0x5d, 0x4c, 0x57, 0xef, 0xc6, 0x57, 0xe4, 0xd5, 0x8d,
-	0xff, 0x95, 0x85, 0xaa, 0xe2, 0x1f, 0x5f, 0x6a, 0x38, 0x6f, 0x41, 0x25, 0xb4, 0x16, 0x4b, 0xcf,
-	0x37, 0x64, 0x34, 0x51, 0xe6, 0x09, 0x20, 0x25, 0x4e, 0x6e, 0x6d, 0xb0, 0x53, 0xfb, 0xd8, 0xf9,
-	0x17, 0xec, 0x63, 0x7f, 0x0c, 0x35, 0xe5, 0x3a, 0x7a, 0x20, 0xf7, 0x31, 0x2e, 0x6c, 0x63, 0x27,
-	0x57, 0xd3, 0x03, 0x76, 0x15, 0x8a, 0xf3, 0x93, 0x89, 0x39, 0x15, 0x57, 0x04, 0x2b, 0xbc, 0x30,
-	0x3f, 0xe9, 0x4c, 0xe9, 0x02, 0xcf, 0x3c, 0x36, 0xfc, 0x25, 0x91, 0xd1, 0xce, 0x23, 0xf3, 0x7e,
-	0x17, 0x4a, 0xf3, 0x13, 0x71, 0xd7, 0xbb, 0xac, 0x26, 0xf8, 0xf1, 0xb8, 0xf1, 0xe2, 0xfc, 0x84,
-	0xee, 0x7d, 0x7f, 0x05, 0xda, 0xda, 0xd5, 0xc2, 0x40, 0xee, 0x51, 0xac, 0x0b, 0xb5, 0x9d, 0xbe,
-	0x66, 0x18, 0xe8, 0xff, 0x2e, 0x03, 0x8d, 0x24, 0x9e, 0xc0, 0xb9, 0x65, 0xf7, 0xd4, 0x37, 0x91,
-	0xcd, 0xf5, 0x90, 0x03, 0x49, 0xee, 0x8f, 0xcf, 0x97, 0xe2, 0x71, 0xcb, 0xa6, 0xfb, 0x85, 0x9b,
-	0x6e, 0xeb, 0xe7, 0x36, 0xdd, 0xd6, 0xd7, 0xf7, 0x21, 0x37, 0x3e, 0x5f, 0x8a, 0x34, 0x12, 0x4d,
-	0x98, 0x08, 0x57, 0x85, 0xf1, 0xa2, 0xdd, 0xb5, 0xef, 0xba, 0xdf, 0x8b, 0x4b, 0x31, 0x87, 0xbc,
-	0xf7, 0xb0, 0xc5, 0xbf, 0x9f, 0x20, 0x80, 0x8c, 0xfc, 0x83, 0x21, 0xef, 0xf6, 0xf6, 0x07, 0x04,
-	0xc8, 0x53, 0x92, 0x99, 0x88, 0xd8, 0x32, 0xcd, 0x07, 0x27, 0xea, 0x1b, 0xbc, 0x4c, 0xea, 0x0d,
-	0x5e, 0x7c, 0x8b, 0x51, 0x7d, 0x9a, 0x10, 0xc6, 0x4f, 0x08, 0x22, 0x65, 0xcc, 0x25, 0xca, 0xc8,
-	0xde, 0x85, 0xfc, 0xfc, 0xc4, 0x3a, 0x4f, 0x07, 0x8d, 0xe9, 0x7b, 0x83, 0x44, 0xa0, 0xff, 0x98,
-	0x01, 0x96, 0x12, 0x44, 0xc4, 0x31, 0xaf, 0x2a, 0xcb, 0x17, 0xd0, 0x94, 0x0f, 0x55, 0x04, 0x95,
-	0x7c, 0x75, 0x33, 0x41, 0x59, 0xc4, 0x90, 0x5e, 0x15, 0x78, 0x6a, 0x2e, 0xb9, 0x1c, 0xc9, 0x3e,
-	0x04, 0xf1, 0xd8, 0xa2, 0xe7, 0xce, 0xbd, 0x74, 0xc6, 0xa6, 0xac, 0x29, 0x9e, 0xd0, 0xb0, 0x7b,
-	0x70, 0x49, 0x9d, 0x34, 0xf1, 0x7c, 0xa2, 0x40, 0x4b, 0x68, 0x3b, 0x99, 0x35, 0x5a, 0x67, 0xfa,
-	0xdf, 0xcf, 0xc0, 0xe5, 0xb4, 0x42, 0xfc, 0x71, 0xbd, 0x4c, 0xbf, 0x15, 0xc9, 0xad, 0xbf, 0x15,
-	0xd9, 0xa4, 0x4f, 0xf9, 0x8d, 0xfa, 0xf4, 0xb7, 0x33, 0x70, 0x45, 0x19, 0xfd, 0x24, 0xf2, 0xfc,
-	0xff, 0x24, 0x99, 0xf2, 0x64, 0x24, 0x9f, 0x7a, 0x32, 0xa2, 0xff, 0x79, 0x0e, 0x20, 0x91, 0x24,
-	0x65, 0x7a, 0x32, 0x7f, 0xc8, 0xf4, 0xbc, 0xc4, 0x15, 0x28, 0x3b, 0x98, 0xa4, 0xcf, 0x6a, 0x72,
-	0xd1, 0x65, 0x73, 0xf5, 0x9c, 0x86, 0x7d, 0x0c, 0x25, 0xb1, 0x03, 0x13, 0x6d, 0xa8, 0x5d, 0x5f,
-	0x5f, 0xc9, 0xf7, 0xe5, 0x3b, 0x8e, 0x88, 0xee, 0xe6, 0x5f, 0x66, 0xa0, 0x28, 0x1f, 0x71, 0xdc,
-	0x83, 0x3c, 0x86, 0x7b, 0x72, 0x9b, 0xe1, 0xca, 0x26, 0x23, 0x40, 0x3f, 0x75, 0x80, 0xf6, 0xe2,
-	0x3e, 0x14, 0x0d, 0xd3, 0x9c, 0xcc, 0x4f, 0xd2, 0xbb, 0x56, 0x6b, 0xeb, 0xf1, 0x60, 0x8b, 0x17,
-	0x0c, 0x5a, 0x98, 0x5f, 0x40, 0x05, 0xe9, 0x45, 0x16, 0x90, 0x72, 0x67, 0x17, 0x57, 0xce, 0xc1,
-	0x16, 0x2f, 0x1b, 0xd1, 0x2a, 0xfa, 0x65, 0x3a, 0xe9, 0x10, 0x6a, 0x7d, 0xf3, 0x02, 0xeb, 0x73,
-	0xd2, 0x0f, 0x65, 0x4f, 0xea, 0x5f, 0x64, 0xa1, 0x12, 0x27, 0x44, 0xaf, 0xec, 0xc3, 0x92, 0x5f,
-	0xbf, 0xc8, 0x29, 0xbf, 0x7e, 0xb1, 0xbe, 0x92, 0xc4, 0xe5, 0xfd, 0x3c, 0x19, 0x93, 0xed, 0xb4,
-	0xbe, 0x06, 0x17, 0xcf, 0xdd, 0x0a, 0x2f, 0x79, 0xee, 0x76, 0x03, 0x84, 0x4e, 0x4c, 0x6c, 0x93,
-	0x52, 0xca, 0x3c, 0x2f, 0x51, 0xb9, 0x67, 0xae, 0x3f, 0x24, 0x2a, 0xd1, 0xb5, 0x65, 0xf5, 0x21,
-	0xd1, 0x73, 0x5f, 0x18, 0x94, 0x9f, 0xff, 0xc2, 0xe0, 0x07, 0xa8, 0xc4, 0x49, 0xcf, 0xab, 0x0f,
-	0xd8, 0x4f, 0xf1, 0xb2, 0xfa, 0x9f, 0x45, 0x11, 0x55, 0x9c, 0x73, 0xfc, 0xb1, 0x11, 0x55, 0xaa,
-	0xf9, 0xdc, 0x0b, 0x9a, 0x3f, 0x13, 0x91, 0x4e, 0xdc, 0xf8, 0xcf, 0xac, 0x25, 0xea, 0x04, 0xe6,
-	0x53, 0x13, 0xa8, 0x6f, 0xcb, 0x68, 0x2d, 0xce, 0x96, 0xfe, 0x6d, 0x26, 0x0a, 0x85, 0xe2, 0xdb,
-	0xd1, 0xcf, 0xb5, 0x26, 0x71, 0x6b, 0x59, 0xb5, 0xb5, 0x57, 0xf6, 0x23, 0xef, 0x42, 0x41, 0x5d,
-	0x6c, 0x1b, 0x7c, 0x88, 0xc0, 0xaf, 0x3f, 0xbc, 0x2b, 0xac, 0x3f, 0xbc, 0xd3, 0x75, 0x69, 0x10,
-	0x45, 0x17, 0xae, 0x44, 0xf5, 0x46, 0x8f, 0x06, 0xb1, 0x80, 0x6e, 0xbc, 0x92, 0xb8, 0x93, 0x9f,
-	0xde, 0xcd, 0x9f, 0xcd, 0x91, 0xfc, 0x98, 0x81, 0x7a, 0x6a, 0x73, 0xe1, 0x15, 0x84, 0xd9, 0x68,
-	0x07, 0x72, 0x2f, 0x69, 0x07, 0xf2, 0xaf, 0x60, 0x07, 0x0a, 0x7f, 0xd0, 0x0e, 0x14, 0xd7, 0xed,
-	0x80, 0xfe, 0xf7, 0x32, 0xf1, 0xf3, 0x38, 0x51, 0xd9, 0x26, 0xe7, 0x92, 0xd9, 0xe8, 0x5c, 0x6e,
-	0xc7, 0x3f, 0x7f, 0xd0, 0xeb, 0x88, 0x93, 0x9e, 0x3a, 0x57, 0x20, 0xec, 0x2b, 0xb8, 0x21, 0xf6,
-	0x69, 0x85, 0xa9, 0x9e, 0x78, 0xf3, 0x49, 0xfc, 0xe3, 0x08, 0xf2, 0x0a, 0xf1, 0x35, 0x41, 0x20,
-	0x1e, 0x51, 0xce, 0x93, 0x9f, 0x60, 0xe8, 0x41, 0x3d, 0xb5, 0x31, 0xa3, 0xfc, 0x4a, 0x4a, 0x46,
-	0xfd, 0x95, 0x14, 0xb6, 0x03, 0x85, 0xd3, 0x27, 0x96, 0x6f, 0x6d, 0xf8, 0x6d, 0x03, 0x81, 0xd0,
-	0xbf, 0x81, 0x9a, 0xba, 0x85, 0xcb, 0x3e, 0x80, 0x82, 0x1d, 0x5a, 0x8b, 0xe8, 0xc6, 0xf8, 0xb5,
-	0x8b, 0xbb, 0xbc, 0xf4, 0xf4, 0x4b, 0x10, 0xe9, 0xbf, 0xcf, 0x80, 0xb6, 0x8e, 0x53, 0x7e, 0xca,
-	0x25, 0xf3, 0x9c, 0x9f, 0x72, 0xc9, 0xa6, 0x84, 0xdc, 0xf0, 0x73, 0x2c, 0xc9, 0x2d, 0xdb, 0xfc,
-	0x73, 0x6e, 0xd9, 0xb2, 0x77, 0xa0, 0xec, 0x5b, 0xf4, 0xf3, 0x19, 0x66, 0x7a, 0xfb, 0x5c, 0x3c,
-	0x29, 0x8a, 0x70, 0xfa, 0xdf, 0xc9, 0x40, 0x49, 0xee, 0x37, 0x6f, 0x7c, 0x3f, 0xf0, 0x1e, 0x94,
-	0xc4, 0x4f, 0x69, 0x44, 0x3f, 0x00, 0x71, 0xe1, 0xc8, 0x32, 0xc2, 0xb3, 0xdb, 0x62, 0x17, 0x3e,
-	0xfd, 0x60, 0x8f, 0x76, 0xeb, 0x09, 0x8e, 0xda, 0x44, 0x87, 0x70, 0xb4, 0xbf, 0x1b, 0xc8, 0xb3,
-	0x5d, 0x20, 0xd0, 0x18, 0x21, 0xfa, 0x2f, 0xa1, 0x24, 0xf7, 0xb3, 0x37, 0x8a, 0xf2, 0xa2, 0x1f,
-	0xa2, 0xd8, 0x01, 0x48, 0x36, 0xb8, 0x37, 0xd5, 0xa0, 0x3b, 0xf2, 0xc5, 0x44, 0xdf, 0x9b, 0x9d,
-	0x50, 0xc8, 0xfa, 0x21, 0x94, 0x9d, 0xe8, 0x0d, 0x48, 0xe6, 0xf9, 0x6f, 0x40, 0x62, 0x22, 0x76,
-	0x0f, 0x62, 0xf3, 0xfe, 0xa2, 0x40, 0x4b, 0x6f, 0x01, 0x24, 0x3b, 0x6f, 0xec, 0x13, 0x19, 0x26,
-	0x22, 0x28, 0x52, 0x9f, 0xf5, 0xc6, 0xe8, 0xc2, 0x8e, 0x42, 0xa6, 0x37, 0xa0, 0xa6, 0x6e, 0xdf,
-	0xdd, 0x7b, 0x13, 0x6a, 0xea, 0x6f, 0x07, 0xd0, 0xc9, 0x95, 0xe7, 0x5a, 0xe2, 0x21, 0x40, 0xff,
-	0xb7, 0x9f, 0x6a, 0x99, 0x7b, 0x7f, 0xa6, 0x3c, 0x8a, 0x23, 0x1a, 0x99, 0x03, 0xd1, 0xad, 0x95,
-	0x7e, 0x6f, 0xd0, 0x6d, 0x71, 0xca, 0x78, 0xe8, 0xc9, 0xc0, 0x41, 0x6b, 0x74, 0x20, 0xb2, 0x23,
-	0x89, 0x21, 0x40, 0x8e, 0x6e, 0x40, 0xb4, 0x06, 0xfb, 0x5d, 0x71, 0x4b, 0x85, 0x3e, 0xe3, 0x2d,
-	0xa2, 0x02, 0xdd, 0x94, 0xe8, 0x8d, 0xc6, 0x5a, 0x91, 0x69, 0x50, 0xc3, 0xaf, 0x18, 0x57, 0xba,
-	0xf7, 0x2b, 0x68, 0x3e, 0xef, 0x48, 0x0a, 0x6b, 0x6d, 0x1f, 0xb4, 0xe8, 0xd8, 0xaf, 0x06, 0xe5,
-	0xc1, 0x70, 0x22, 0x4a, 0x19, 0x56, 0x85, 0x12, 0xef, 0xf6, 0xbb, 0xb4, 0x21, 0x77, 0xef, 0x77,
-	0x19, 0x65, 0x96, 0xa2, 0x23, 0x89, 0x18, 0x20, 0xbb, 0xab, 0x82, 0xb8, 0x65, 0x98, 0x5a, 0x86,
-	0x5d, 0x03, 0x96, 0x02, 0xf5, 0xbd, 0x99, 0xe1, 0x68, 0x59, 0xda, 0x7a, 0x8b, 0xe0, 0x8f, 0x7d,
-	0x3b, 0xb4, 0xb4, 0x1c, 0x7b, 0x1d, 0x6e, 0xc4, 0xb0, 0xbe, 0x77, 0x7a, 0xe8, 0xdb, 0x9e, 0x6f,
-	0x87, 0xe7, 0x02, 0x9d, 0xdf, 0xfb, 0x93, 0x7f, 0xff, 0xe3, 0xed, 0xcc, 0x7f, 0xfa, 0xf1, 0x76,
-	0xe6, 0xbf, 0xff, 0x78, 0x7b, 0xeb, 0xf7, 0xff, 0xf3, 0x76, 0xe6, 0xaf, 0xab, 0x3f, 0xac, 0xb6,
-	0x30, 0x42, 0xdf, 0x3e, 0x13, 0xce, 0x2e, 0x2a, 0xb8, 0xd6, 0x87, 0xcb, 0x93, 0xe3, 0x0f, 0x97,
-	0xd3, 0x0f, 0x71, 0x46, 0xa7, 0x45, 0xfa, 0x7d, 0xb5, 0x4f, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x59, 0x3e, 0x7b, 0x87, 0xa2, 0x4d, 0x00, 0x00,
+	0xb6, 0x98, 0x9a, 0x7f, 0x1e, 0x92, 0x33, 0xad, 0xd2, 0x8f, 0x92, 0x65, 0x79, 0xdc, 0xd6, 0xb5,
+	0x65, 0x5d, 0x5b, 0xb6, 0xc7, 0x7f, 0xe7, 0x1a, 0xf7, 0x72, 0x38, 0xd4, 0x88, 0x36, 0x45, 0xce,
+	0x2d, 0x72, 0xa4, 0xeb, 0x3c, 0x04, 0x44, 0x93, 0xdd, 0x1c, 0xb5, 0xa7, 0xd9, 0x4d, 0x77, 0x37,
+	0x35, 0x33, 0x17, 0x78, 0xc0, 0x0d, 0x02, 0x24, 0xc8, 0x3a, 0x40, 0x36, 0x2f, 0x40, 0x6e, 0x12,
+	0x20, 0x40, 0x1e, 0x02, 0x64, 0x93, 0xe0, 0x05, 0xd9, 0x25, 0xd9, 0x24, 0x40, 0x16, 0xc9, 0x22,
+	0x9b, 0x24, 0x8b, 0x3c, 0x27, 0x78, 0xfb, 0xe0, 0x65, 0x99, 0x45, 0x70, 0x4e, 0x55, 0x77, 0x57,
+	0x93, 0x94, 0x25, 0xeb, 0xfa, 0x6d, 0x66, 0xaa, 0xce, 0xa7, 0xfa, 0xd4, 0xef, 0xfc, 0xaa, 0x8a,
+	0x00, 0x0b, 0xd7, 0xf4, 0xee, 0x2d, 0x02, 0x3f, 0xf2, 0x59, 0x01, 0xcb, 0x37, 0xde, 0x3d, 0x76,
+	0xa2, 0x27, 0xcb, 0xc9, 0xbd, 0xa9, 0x3f, 0x7f, 0xef, 0xd8, 0x3f, 0xf6, 0xdf, 0x23, 0xe4, 0x64,
+	0x39, 0xa3, 0x1a, 0x55, 0xa8, 0x24, 0x98, 0x6e, 0x6c, 0x47, 0xce, 0xdc, 0x0e, 0x23, 0x73, 0xbe,
+	0x10, 0x00, 0xe3, 0xcf, 0x34, 0x28, 0x8c, 0xce, 0x17, 0x36, 0xdb, 0x82, 0x9c, 0x63, 0x35, 0xb5,
+	0x1d, 0xed, 0x4e, 0x91, 0xe7, 0x1c, 0x8b, 0xed, 0x40, 0xcd, 0xf3, 0xa3, 0xfe, 0xd2, 0x75, 0xcd,
+	0x89, 0x6b, 0x37, 0x73, 0x3b, 0xda, 0x9d, 0x0a, 0x57, 0x41, 0xec, 0x15, 0xa8, 0x9a, 0xcb, 0xc8,
+	0x1f, 0x3b, 0xde, 0x34, 0x68, 0xe6, 0x09, 0x5f, 0x41, 0x40, 0xd7, 0x9b, 0x06, 0xec, 0x32, 0x14,
+	0x4f, 0x1d, 0x2b, 0x7a, 0xd2, 0x2c, 0x50, 0x8b, 0xa2, 0x82, 0xd0, 0x70, 0x6a, 0xba, 0x76, 0xb3,
+	0x28, 0xa0, 0x54, 0x41, 0x68, 0x44, 0x1f, 0x29, 0xed, 0x68, 0x77, 0xaa, 0x5c, 0x54, 0xd8, 0x2d,
+	0x00, 0xdb, 0x5b, 0xce, 0x9f, 0x9a, 0xee, 0xd2, 0x0e, 0x9b, 0x65, 0x42, 0x29, 0x10, 0xe3, 0xbf,
+	0x14, 0xa1, 0xd8, 0xf6, 0xbd, 0x30, 0x62, 0x57, 0xa1, 0xe4, 0x84, 0xde, 0xd2, 0x75, 0x49, 0xfc,
+	0x0a, 0x97, 0x35, 0x76, 0x15, 0x8a, 0xce, 0x67, 0x4f, 0x4d, 0x97, 0x84, 0x2f, 0x3e, 0xb8, 0xc0,
+	0x45, 0x95, 0x35, 0xa1, 0xe4, 0x7c, 0xf0, 0x09, 0x22, 0xf2, 0x12, 0x21, 0xeb, 0x84, 0xf9, 0x70,
+	0x17, 0x31, 0x85, 0x04, 0xf3, 0xe1, 0x6e, 0x8c, 0xf9, 0xe4, 0x23, 0xc4, 0xa0, 0xe8, 0x79, 0xc2,
+	0x50, 0x1d, 0xbf, 0xb2, 0xa4, 0xaf, 0xa0, 0xf4, 0x0d, 0xfc, 0xca, 0x32, 0xfe, 0xca, 0x52, 0x7c,
+	0xa5, 0x2c, 0x11, 0xb2, 0x4e, 0x18, 0xf1, 0x95, 0x4a, 0x82, 0x49, 0xbe, 0xb2, 0x14, 0x5f, 0xa9,
+	0xee, 0x68, 0x77, 0x0a, 0x84, 0x11, 0x5f, 0xb9, 0x0c, 0x05, 0x0b, 0xe1, 0xb0, 0xa3, 0xdd, 0xd1,
+	0x1e, 0x5c, 0xe0, 0x05, 0x4b, 0x42, 0x43, 0x84, 0xd6, 0x70, 0x74, 0x10, 0x1a, 0x4a, 0xe8, 0x04,
+	0xa1, 0x75, 0x1c, 0x0d, 0x84, 0x4e, 0x24, 0x74, 0x86, 0xd0, 0xc6, 0x8e, 0x76, 0x27, 0x87, 0x50,
+	0xac, 0xb1, 0x1b, 0x50, 0xb6, 0xcc, 0xc8, 0x46, 0xc4, 0x96, 0xec, 0x72, 0x0c, 0x40, 0x1c, 0x2e,
+	0x17, 0xc4, 0x6d, 0xcb, 0x4e, 0xc7, 0x00, 0x66, 0x40, 0x0d, 0xc9, 0x62, 0xbc, 0x2e, 0xf1, 0x2a,
+	0x90, 0x7d, 0x0c, 0x75, 0xcb, 0x9e, 0x3a, 0x73, 0xd3, 0x15, 0x7d, 0xba, 0xb8, 0xa3, 0xdd, 0xa9,
+	0xed, 0x6e, 0xdf, 0xa3, 0x45, 0x9c, 0x60, 0x1e, 0x5c, 0xe0, 0x19, 0x32, 0xf6, 0x19, 0x34, 0x64,
+	0xfd, 0x83, 0x5d, 0x1a, 0x58, 0x46, 0x7c, 0x7a, 0x86, 0xef, 0x83, 0xdd, 0xcf, 0x1e, 0x5c, 0xe0,
+	0x59, 0x42, 0x76, 0x1b, 0xea, 0xc9, 0xfa, 0x46, 0xc6, 0x4b, 0x52, 0xaa, 0x0c, 0x14, 0xbb, 0xf5,
+	0x6d, 0xe8, 0x7b, 0x48, 0x70, 0x59, 0x8e, 0x5b, 0x0c, 0x60, 0x3b, 0x00, 0x96, 0x3d, 0x33, 0x97,
+	0x6e, 0x84, 0xe8, 0x2b, 0x72, 0x00, 0x15, 0x18, 0xbb, 0x05, 0xd5, 0xe5, 0x02, 0x7b, 0xf9, 0xc8,
+	0x74, 0x9b, 0x57, 0x25, 0x41, 0x0a, 0xc2, 0xc5, 0xec, 0x84, 0x7b, 0x8e, 0xd7, 0xbc, 0x86, 0x38,
+	0x2e, 0x2a, 0xec, 0x26, 0xe4, 0xc3, 0x60, 0xda, 0x6c, 0x52, 0x4f, 0x40, 0xf4, 0xa4, 0x73, 0xb6,
+	0x08, 0x38, 0x82, 0xf7, 0xca, 0x50, 0xa4, 0x45, 0x6d, 0xdc, 0x84, 0xca, 0xa1, 0x19, 0x98, 0x73,
+	0x6e, 0xcf, 0x98, 0x0e, 0xf9, 0x85, 0x1f, 0xca, 0x1d, 0x89, 0x45, 0xa3, 0x07, 0xa5, 0x47, 0x66,
+	0x80, 0x38, 0x06, 0x05, 0xcf, 0x9c, 0xdb, 0x84, 0xac, 0x72, 0x2a, 0xe3, 0x2e, 0x08, 0xcf, 0xc3,
+	0xc8, 0x9e, 0xcb, 0xbd, 0x2a, 0x6b, 0x08, 0x3f, 0x76, 0xfd, 0x89, 0x5c, 0xed, 0x15, 0x2e, 0x6b,
+	0x46, 0x1f, 0x4a, 0x6d, 0xdf, 0xc5, 0xd6, 0xae, 0x41, 0x39, 0xb0, 0xdd, 0x71, 0xfa, 0xb5, 0x52,
+	0x60, 0xbb, 0x87, 0x7e, 0x88, 0x88, 0xa9, 0x2f, 0x10, 0x39, 0x81, 0x98, 0xfa, 0x84, 0x88, 0xbf,
+	0x9f, 0x4f, 0xbf, 0x6f, 0x7c, 0x0e, 0x55, 0x6e, 0x9e, 0xca, 0x26, 0xaf, 0x40, 0x29, 0x9a, 0xb8,
+	0x63, 0xa9, 0x51, 0x0a, 0xbc, 0x18, 0x4d, 0xdc, 0xae, 0x85, 0x60, 0x6c, 0xd0, 0xb1, 0xa8, 0xbd,
+	0x02, 0x2f, 0x4e, 0x7d, 0xb7, 0x6b, 0x19, 0x23, 0x80, 0xb6, 0x1f, 0x04, 0x2f, 0x2d, 0xce, 0x65,
+	0x28, 0x5a, 0xf6, 0x22, 0x7a, 0x22, 0xf6, 0x33, 0x17, 0x15, 0xe3, 0x2e, 0x54, 0x70, 0x88, 0x7b,
+	0x4e, 0x18, 0xb1, 0x5b, 0x50, 0x70, 0x9d, 0x30, 0x6a, 0x6a, 0x3b, 0xf9, 0x95, 0x09, 0x20, 0xb8,
+	0xb1, 0x03, 0x95, 0x87, 0xe6, 0xd9, 0x23, 0x9c, 0x04, 0x76, 0x59, 0xce, 0x86, 0x1c, 0x5d, 0x39,
+	0x35, 0x77, 0x01, 0x46, 0x66, 0x70, 0x6c, 0x47, 0xa4, 0x2d, 0x6f, 0x42, 0x3e, 0x3a, 0x5f, 0x10,
+	0x45, 0xd2, 0x1c, 0x22, 0x38, 0x82, 0x8d, 0xbf, 0xd4, 0xa0, 0x36, 0x5c, 0x4e, 0xbe, 0x5b, 0xda,
+	0xc1, 0x39, 0xf6, 0xe8, 0x4e, 0x4a, 0xbd, 0xb5, 0x7b, 0x55, 0x50, 0x2b, 0xf8, 0x94, 0x13, 0xbb,
+	0xe8, 0xf9, 0x96, 0x1d, 0x8f, 0x50, 0x91, 0x97, 0xb0, 0xda, 0xb5, 0x50, 0x3d, 0xfb, 0x0b, 0x39,
+	0xde, 0x39, 0x7f, 0xc1, 0x76, 0xa0, 0x38, 0x7d, 0xe2, 0xb8, 0x56, 0xb3, 0xa0, 0x8a, 0x40, 0x3d,
+	0x12, 0x08, 0x76, 0x1d, 0x2a, 0x81, 0x7f, 0x3a, 0x0e, 0x9d, 0xdf, 0xc6, 0xea, 0xb6, 0x1c, 0xf8,
+	0xa7, 0x43, 0xe7, 0xb7, 0xb6, 0x31, 0x92, 0x3a, 0x1f, 0xa0, 0x34, 0x6c, 0xb7, 0x7a, 0x2d, 0xae,
+	0x5f, 0xc0, 0x72, 0xe7, 0x37, 0xdd, 0xe1, 0x68, 0xa8, 0x6b, 0x6c, 0x0b, 0xa0, 0x3f, 0x18, 0x8d,
+	0x65, 0x3d, 0xc7, 0x4a, 0x90, 0xeb, 0xf6, 0xf5, 0x3c, 0xd2, 0x20, 0xbc, 0xdb, 0xd7, 0x0b, 0xac,
+	0x0c, 0xf9, 0x56, 0xff, 0x1b, 0xbd, 0x48, 0x85, 0x5e, 0x4f, 0x2f, 0x19, 0xff, 0x34, 0x07, 0xd5,
+	0xc1, 0xe4, 0x5b, 0x7b, 0x1a, 0x61, 0x9f, 0x71, 0x39, 0xda, 0xc1, 0x53, 0x3b, 0xa0, 0x6e, 0xe7,
+	0xb9, 0xac, 0x61, 0x47, 0xac, 0x09, 0x75, 0x2e, 0xcf, 0x73, 0xd6, 0x84, 0xe8, 0xa6, 0x4f, 0xec,
+	0xb9, 0xd9, 0xcc, 0x4b, 0x3a, 0xaa, 0xe1, 0xf2, 0xf7, 0x27, 0xdf, 0x52, 0xf7, 0xf2, 0x1c, 0x8b,
+	0xec, 0x35, 0xa8, 0x89, 0x36, 0xc6, 0xb4, 0xf6, 0x8a, 0xc2, 0x22, 0x08, 0x50, 0x1f, 0x77, 0xc0,
+	0x35, 0x28, 0x5b, 0x13, 0x81, 0x14, 0x96, 0xa4, 0x64, 0x4d, 0x08, 0x81, 0x9c, 0xd4, 0xaa, 0x40,
+	0x4a, 0x5b, 0x22, 0x40, 0x44, 0x70, 0x1d, 0x2a, 0xfe, 0xe4, 0x5b, 0x81, 0xad, 0x10, 0xb6, 0xec,
+	0x4f, 0xbe, 0x25, 0xd4, 0xcf, 0xe1, 0x62, 0xb8, 0x9c, 0x84, 0xd3, 0xc0, 0x59, 0x44, 0x8e, 0xef,
+	0x09, 0x9a, 0x2a, 0xd1, 0xe8, 0x2a, 0x82, 0x88, 0x6f, 0xc3, 0xd6, 0x62, 0x39, 0x19, 0x9b, 0xd3,
+	0xa9, 0xbf, 0xf4, 0x22, 0x9c, 0x45, 0xa0, 0x91, 0xaf, 0x2f, 0x96, 0x93, 0x96, 0x00, 0x76, 0x2d,
+	0xe3, 0x1f, 0x68, 0xa0, 0x0f, 0x15, 0xd6, 0x87, 0x76, 0x64, 0x6e, 0xdc, 0xd2, 0xaf, 0x02, 0x28,
+	0x4d, 0x89, 0x05, 0x51, 0x35, 0xe3, 0x76, 0xd4, 0xfe, 0xe6, 0x33, 0xfd, 0x7d, 0x1d, 0xea, 0x31,
+	0x1f, 0x61, 0x0b, 0x84, 0xad, 0x49, 0x58, 0xdc, 0xe3, 0x70, 0x39, 0x51, 0x47, 0xb2, 0x1c, 0x2e,
+	0x89, 0xdb, 0xf8, 0x3f, 0x1a, 0x54, 0xee, 0x2f, 0xbd, 0x29, 0x8a, 0xc6, 0xde, 0x80, 0xc2, 0x6c,
+	0xe9, 0x4d, 0x9b, 0x9a, 0xaa, 0xbb, 0x93, 0x59, 0xe6, 0x84, 0xc4, 0xdd, 0x65, 0x06, 0xc7, 0xb8,
+	0x2b, 0xd7, 0x76, 0x17, 0xc2, 0x8d, 0x7f, 0x28, 0x5b, 0xbc, 0xef, 0x9a, 0xc7, 0xac, 0x02, 0x85,
+	0xfe, 0xa0, 0xdf, 0xd1, 0x2f, 0xb0, 0x3a, 0x54, 0xba, 0xfd, 0x51, 0x87, 0xf7, 0x5b, 0x3d, 0x5d,
+	0xa3, 0xc5, 0x38, 0x6a, 0xed, 0xf5, 0x3a, 0x7a, 0x0e, 0x31, 0x8f, 0x06, 0xbd, 0xd6, 0xa8, 0xdb,
+	0xeb, 0xe8, 0x05, 0x81, 0xe1, 0xdd, 0xf6, 0x48, 0xaf, 0x30, 0x1d, 0xea, 0x87, 0x7c, 0xb0, 0x7f,
+	0xd4, 0xee, 0x8c, 0xfb, 0x47, 0xbd, 0x9e, 0xae, 0xb3, 0x4b, 0xb0, 0x9d, 0x40, 0x06, 0x02, 0xb8,
+	0x83, 0x2c, 0x8f, 0x5a, 0xbc, 0xc5, 0x0f, 0xf4, 0x5f, 0xb1, 0x0a, 0xe4, 0x5b, 0x07, 0x07, 0xfa,
+	0xef, 0x34, 0x2c, 0x3d, 0xee, 0xf6, 0xf5, 0xdf, 0xe5, 0xd8, 0x16, 0x54, 0x1f, 0x0e, 0xfa, 0x83,
+	0xd1, 0xa0, 0xdf, 0x6d, 0xeb, 0xbf, 0x2b, 0x18, 0xff, 0x2c, 0x0f, 0x05, 0x14, 0xf8, 0x87, 0x37,
+	0x36, 0x7b, 0x05, 0xb4, 0x29, 0xcd, 0x43, 0x6d, 0xb7, 0x26, 0x70, 0xe4, 0x81, 0x3c, 0xb8, 0xc0,
+	0x35, 0x1c, 0x05, 0x4d, 0xec, 0xd0, 0xda, 0xee, 0x96, 0x40, 0xc6, 0xba, 0x1c, 0xf1, 0x0b, 0x76,
+	0x13, 0xb4, 0xa7, 0x72, 0xbb, 0xd6, 0x05, 0x5e, 0x68, 0x73, 0xc4, 0x3e, 0x65, 0x3b, 0x90, 0x9f,
+	0xfa, 0xc2, 0xbb, 0x48, 0xf0, 0x42, 0x21, 0x3e, 0xb8, 0xc0, 0x11, 0xc5, 0xde, 0x80, 0x7c, 0x60,
+	0x9e, 0x36, 0x4b, 0xea, 0x4c, 0x24, 0x1a, 0x17, 0x89, 0x02, 0xf3, 0x14, 0x85, 0x98, 0x35, 0xcb,
+	0xaa, 0x10, 0xf1, 0x54, 0xe2, 0x67, 0x66, 0xec, 0x67, 0x90, 0x0f, 0x97, 0x13, 0x5a, 0xe4, 0xb5,
+	0xdd, 0x8b, 0x6b, 0xaa, 0x08, 0x9b, 0x09, 0x97, 0x13, 0xf6, 0x26, 0x14, 0xa6, 0x7e, 0x10, 0x34,
+	0xab, 0xaa, 0xe9, 0x4d, 0x75, 0x34, 0xba, 0x0f, 0x88, 0x67, 0x3b, 0xa0, 0x45, 0x4d, 0x50, 0x89,
+	0x52, 0x25, 0x89, 0x1f, 0x8c, 0xd8, 0x6d, 0xa9, 0x79, 0x6b, 0xaa, 0x4c, 0xb1, 0x5e, 0xc6, 0x76,
+	0x10, 0xcb, 0x0c, 0xc8, 0xcf, 0xcd, 0xb3, 0x66, 0x5d, 0x25, 0x8a, 0x15, 0x32, 0xca, 0x34, 0x37,
+	0xcf, 0xf6, 0x4a, 0x50, 0xb0, 0xcf, 0x16, 0x81, 0x71, 0x1d, 0xaa, 0x89, 0xbf, 0xc0, 0xea, 0xa0,
+	0x99, 0x52, 0xc3, 0x68, 0xa6, 0x71, 0x07, 0x40, 0xa2, 0x3e, 0xd8, 0xfd, 0x2c, 0x8b, 0xc3, 0x5a,
+	0xac, 0x77, 0xb4, 0x89, 0xf1, 0x0b, 0xa8, 0x73, 0x3b, 0x5c, 0xba, 0x51, 0xdb, 0x77, 0xf7, 0xed,
+	0x19, 0x7b, 0x07, 0x20, 0xa9, 0x87, 0xd2, 0x4c, 0xa4, 0xb3, 0xb0, 0x6f, 0xcf, 0xb8, 0x82, 0x37,
+	0xfe, 0x56, 0x1e, 0x4a, 0x92, 0x31, 0x35, 0x69, 0x9a, 0x62, 0xd2, 0x92, 0xed, 0x9c, 0xcb, 0x5a,
+	0xe8, 0x27, 0x8e, 0x65, 0xd9, 0x5e, 0x6c, 0x89, 0x45, 0x8d, 0xdd, 0x86, 0xbc, 0xe9, 0x1e, 0xd3,
+	0xd2, 0xd8, 0xda, 0x65, 0xf1, 0x47, 0xe7, 0x8b, 0xc0, 0x0e, 0x43, 0xb1, 0xf6, 0x4c, 0xf7, 0x38,
+	0x5e, 0x99, 0xc5, 0xcd, 0x2b, 0xf3, 0x3a, 0x54, 0x3c, 0x3f, 0x1a, 0x93, 0x17, 0x5c, 0xa2, 0xd6,
+	0xcb, 0xd2, 0x57, 0x67, 0x6f, 0x41, 0x59, 0xfa, 0x2f, 0x72, 0x61, 0x34, 0x04, 0xf3, 0xbe, 0x00,
+	0xf2, 0x18, 0xcb, 0x9a, 0x68, 0x5f, 0xe7, 0x73, 0xdb, 0x8b, 0x62, 0x25, 0x28, 0xab, 0xec, 0xe7,
+	0x50, 0xf5, 0xbd, 0xb1, 0x70, 0x72, 0x9a, 0x55, 0x75, 0x92, 0x06, 0xde, 0x11, 0x41, 0x79, 0xc5,
+	0x97, 0x25, 0x14, 0xc5, 0xf5, 0x4f, 0xc7, 0x53, 0x33, 0x10, 0xea, 0xaf, 0xc2, 0xcb, 0xae, 0x7f,
+	0xda, 0x36, 0x03, 0x8b, 0xdd, 0x84, 0xea, 0xd4, 0x5d, 0x86, 0x91, 0x1d, 0xec, 0x9d, 0xd3, 0x8a,
+	0xa8, 0xf0, 0x14, 0x80, 0xdf, 0x5f, 0x04, 0xce, 0xdc, 0x0c, 0xce, 0x85, 0xeb, 0xca, 0xe3, 0x2a,
+	0x9a, 0xe4, 0xc5, 0x89, 0x63, 0x9d, 0x91, 0xf3, 0x5a, 0xe4, 0xa2, 0x62, 0xfc, 0x13, 0x0d, 0xca,
+	0xb2, 0x13, 0xec, 0x96, 0x58, 0x1c, 0xd9, 0x8d, 0x2b, 0x54, 0x10, 0xc2, 0xd9, 0x1b, 0xd0, 0xf0,
+	0x03, 0xe7, 0xd8, 0xf1, 0xc6, 0x61, 0x14, 0x38, 0xde, 0xb1, 0x9c, 0x98, 0xba, 0x00, 0x0e, 0x09,
+	0x86, 0x7a, 0x13, 0x07, 0x70, 0x6c, 0x4e, 0x1c, 0xd7, 0x89, 0xce, 0xe5, 0x34, 0xd5, 0x10, 0xd6,
+	0x12, 0x20, 0xf6, 0x3e, 0x54, 0x8f, 0x6d, 0xcf, 0x0e, 0xcc, 0xc8, 0x8e, 0x6d, 0xaf, 0x9c, 0xb1,
+	0x83, 0x18, 0x8c, 0x5b, 0x24, 0x25, 0x32, 0x4e, 0xa0, 0xae, 0xa2, 0x7e, 0x1a, 0x49, 0xd1, 0x6a,
+	0x46, 0x7e, 0x60, 0x5b, 0xf1, 0x52, 0x12, 0x35, 0x63, 0x00, 0x95, 0x78, 0x46, 0x7e, 0x92, 0x0f,
+	0x19, 0x7f, 0x0d, 0x6a, 0x5d, 0xcf, 0xb2, 0xcf, 0x06, 0x64, 0xa9, 0xd8, 0x3b, 0xc0, 0xa6, 0x81,
+	0x6d, 0x46, 0xf6, 0xd8, 0x3e, 0x8b, 0x02, 0x73, 0x2c, 0xe2, 0x36, 0x11, 0x76, 0xe9, 0x02, 0xd3,
+	0x41, 0xc4, 0x08, 0xe1, 0xc6, 0x7f, 0xd3, 0xa0, 0x71, 0x28, 0xa6, 0xf0, 0x6b, 0xfb, 0x7c, 0x5f,
+	0x38, 0xae, 0xd3, 0x78, 0x83, 0x15, 0x38, 0x95, 0xd9, 0x2d, 0xa8, 0x2d, 0x4e, 0xec, 0xf3, 0x71,
+	0xc6, 0x33, 0xac, 0x22, 0xa8, 0x4d, 0x5b, 0xe9, 0x6d, 0x28, 0xf9, 0xf4, 0xf5, 0x66, 0x5e, 0xd5,
+	0x5a, 0x8a, 0x58, 0x5c, 0x12, 0x30, 0x03, 0x1a, 0x49, 0x53, 0xaa, 0xe5, 0x93, 0x8d, 0x91, 0xe5,
+	0xbb, 0x0c, 0x45, 0x44, 0x85, 0xcd, 0xe2, 0x4e, 0x1e, 0xdd, 0x3b, 0xaa, 0xb0, 0xf7, 0xa1, 0x31,
+	0xf5, 0xe7, 0x8b, 0x71, 0xcc, 0x2e, 0xd5, 0x6c, 0x56, 0x05, 0xd4, 0x90, 0xe4, 0x50, 0xb4, 0x65,
+	0xfc, 0x79, 0x0e, 0x2a, 0x24, 0x83, 0xd4, 0x02, 0x8e, 0x75, 0x16, 0x6b, 0x81, 0x2a, 0x2f, 0x3a,
+	0xd6, 0x59, 0xd7, 0x42, 0x03, 0xee, 0x20, 0xc9, 0x58, 0xd1, 0x05, 0x55, 0x82, 0xc4, 0xa2, 0x2c,
+	0xcc, 0x20, 0x0a, 0x9b, 0x79, 0x21, 0x0a, 0x55, 0x70, 0x6e, 0x97, 0x9e, 0xf3, 0xdd, 0x52, 0x48,
+	0x5f, 0xe1, 0xb2, 0xc6, 0xee, 0x80, 0x2e, 0x1a, 0xa3, 0x41, 0x57, 0x4d, 0xf7, 0x16, 0xc1, 0x69,
+	0xcc, 0x63, 0x7f, 0x47, 0xd0, 0xd8, 0x67, 0xa8, 0x7a, 0x85, 0x3e, 0x00, 0x02, 0x75, 0x10, 0xa2,
+	0xee, 0xf4, 0x72, 0x76, 0xa7, 0x37, 0xa1, 0xfc, 0xd4, 0x09, 0x1d, 0x9c, 0xd5, 0x8a, 0xd8, 0x83,
+	0xb2, 0xaa, 0x4c, 0x43, 0xf5, 0x79, 0xd3, 0x90, 0x74, 0xdb, 0x74, 0x8f, 0xfd, 0x26, 0x28, 0xdd,
+	0x6e, 0xb9, 0xc7, 0x7e, 0xda, 0x11, 0x44, 0x8f, 0x51, 0xff, 0x87, 0xa4, 0x0c, 0xf2, 0x7c, 0x2b,
+	0x21, 0x42, 0xeb, 0x10, 0x1a, 0xff, 0x31, 0x07, 0x8d, 0xfb, 0x7e, 0x60, 0x3b, 0xc7, 0x5e, 0xba,
+	0x80, 0xd6, 0xdc, 0xa4, 0x78, 0x51, 0xe5, 0x94, 0x45, 0xf5, 0x1a, 0xd4, 0x66, 0x82, 0x71, 0x1c,
+	0x4d, 0x44, 0xe8, 0x53, 0xe0, 0x20, 0x41, 0xa3, 0x89, 0x8b, 0x7b, 0x3d, 0x26, 0x20, 0xe6, 0x02,
+	0x31, 0xc7, 0x4c, 0xa8, 0xe5, 0xd9, 0x17, 0xa4, 0xf5, 0x2c, 0xdb, 0xb5, 0x23, 0x31, 0xd2, 0x5b,
+	0xbb, 0xaf, 0x4a, 0x9b, 0xaa, 0xca, 0x74, 0x8f, 0xdb, 0xb3, 0x16, 0x99, 0x58, 0x54, 0x82, 0xfb,
+	0x44, 0xce, 0xbe, 0x50, 0x35, 0x66, 0xe9, 0x05, 0x79, 0xc5, 0xc6, 0x35, 0x46, 0x50, 0x4d, 0xc0,
+	0xe8, 0x0a, 0xf1, 0x8e, 0x74, 0x7f, 0x2e, 0xb0, 0x1a, 0x94, 0xdb, 0xad, 0x61, 0xbb, 0xb5, 0xdf,
+	0xd1, 0x35, 0x44, 0x0d, 0x3b, 0x23, 0xe1, 0xf2, 0xe4, 0xd8, 0x36, 0xd4, 0xb0, 0xb6, 0xdf, 0xb9,
+	0xdf, 0x3a, 0xea, 0x8d, 0xf4, 0x3c, 0x6b, 0x40, 0xb5, 0x3f, 0x18, 0xb7, 0xda, 0xa3, 0xee, 0xa0,
+	0xaf, 0x17, 0x8c, 0xbf, 0xa9, 0x41, 0xa5, 0xfd, 0xc4, 0x9e, 0x9e, 0x3c, 0x6b, 0x18, 0x29, 0xa4,
+	0xb0, 0xa7, 0x27, 0xcd, 0xdc, 0x9a, 0xc2, 0x10, 0x88, 0x75, 0x8d, 0x91, 0xdf, 0xa0, 0x9a, 0x6e,
+	0x40, 0xc5, 0xf6, 0x66, 0x7e, 0x30, 0x95, 0x0a, 0xb2, 0xc2, 0x93, 0xba, 0xb1, 0x0f, 0xf5, 0x76,
+	0xac, 0xee, 0x51, 0x8c, 0x9d, 0x78, 0x03, 0xac, 0xc7, 0x65, 0x02, 0xb1, 0xc9, 0x8e, 0x1a, 0x1f,
+	0x43, 0xed, 0x30, 0xf0, 0x17, 0x76, 0x10, 0x51, 0x23, 0x3a, 0xe4, 0x4f, 0xec, 0x73, 0xd9, 0x15,
+	0x2c, 0xa6, 0x11, 0x5c, 0x4e, 0x8d, 0xe0, 0x76, 0xa1, 0x12, 0xb3, 0xbd, 0x30, 0xcf, 0x2f, 0xa1,
+	0x21, 0x79, 0x1c, 0x3b, 0xc4, 0x8f, 0xdd, 0x03, 0x58, 0x24, 0x00, 0x29, 0x76, 0xec, 0xed, 0xc9,
+	0xc6, 0xb9, 0x42, 0x61, 0xfc, 0x65, 0x1e, 0xb6, 0x0e, 0xcd, 0x20, 0x72, 0x70, 0x32, 0x45, 0xa7,
+	0xdf, 0x82, 0x42, 0x74, 0xbe, 0xb0, 0x65, 0x38, 0x78, 0x29, 0x71, 0x15, 0x05, 0x0d, 0x99, 0x74,
+	0x22, 0x60, 0x5f, 0xc0, 0xd6, 0x22, 0x06, 0x8f, 0x49, 0x95, 0x8b, 0x99, 0x59, 0x65, 0xa1, 0xf1,
+	0x6a, 0x2c, 0xd4, 0x2a, 0xfb, 0x12, 0x2e, 0x67, 0x79, 0xed, 0x30, 0x4c, 0x55, 0xa8, 0x3a, 0xd0,
+	0x97, 0x32, 0x8c, 0x82, 0x8c, 0xb5, 0xe1, 0x62, 0xca, 0x3e, 0xf5, 0xdd, 0xe5, 0xdc, 0x0b, 0xa5,
+	0xb9, 0xbb, 0xba, 0xf2, 0xf5, 0xb6, 0xc0, 0x72, 0x7d, 0xb1, 0x02, 0x61, 0x06, 0xd4, 0x13, 0x58,
+	0x7f, 0x39, 0xa7, 0x2d, 0x54, 0xe0, 0x19, 0x18, 0xfb, 0x10, 0x20, 0xa9, 0x87, 0xcd, 0xd2, 0x4e,
+	0x7e, 0x43, 0xff, 0xba, 0x91, 0x3d, 0xe7, 0x0a, 0x19, 0xba, 0x11, 0xa8, 0x3a, 0x02, 0x27, 0x7a,
+	0x32, 0x27, 0x05, 0x96, 0xe7, 0x29, 0x80, 0xd4, 0x4b, 0x38, 0xc6, 0xe8, 0x26, 0x61, 0x91, 0xba,
+	0x6c, 0xcb, 0x09, 0x87, 0xcb, 0x49, 0xd2, 0x2e, 0xae, 0xe7, 0xb4, 0x97, 0xf3, 0xf0, 0x58, 0xc6,
+	0x75, 0xa9, 0x84, 0x0f, 0xc3, 0x63, 0xb6, 0x0b, 0x57, 0x52, 0xa2, 0x54, 0xf5, 0x86, 0x4d, 0x20,
+	0xa5, 0x9d, 0x0e, 0x5f, 0xa2, 0x7f, 0x43, 0xe3, 0x2b, 0x68, 0x64, 0x66, 0xe7, 0xb9, 0xb6, 0xf8,
+	0x3a, 0x54, 0xf0, 0x3f, 0xee, 0x2b, 0xb9, 0x00, 0xcb, 0x58, 0x1f, 0x46, 0x81, 0x61, 0x83, 0xbe,
+	0x3a, 0xd6, 0xec, 0x36, 0x65, 0x42, 0xb0, 0xb8, 0x61, 0xe7, 0xc4, 0x28, 0x0c, 0x5d, 0xd7, 0x27,
+	0x31, 0x47, 0x52, 0xaf, 0x4d, 0x96, 0xf1, 0x8f, 0x72, 0xd0, 0xc8, 0x8c, 0x38, 0xfb, 0x99, 0xba,
+	0xfc, 0x14, 0x6d, 0x91, 0x8e, 0x19, 0x19, 0x9b, 0xb7, 0x41, 0xf7, 0x03, 0xcb, 0xf1, 0x4c, 0xca,
+	0xcc, 0x88, 0xe1, 0xc6, 0x2e, 0x34, 0xf8, 0xb6, 0x84, 0x1f, 0x4a, 0x30, 0xe6, 0x94, 0x2d, 0x3b,
+	0x09, 0x7b, 0xa5, 0xf6, 0x50, 0x41, 0xaa, 0x61, 0x2a, 0x64, 0x0d, 0xd3, 0x5b, 0x50, 0x75, 0xed,
+	0x30, 0x1c, 0x47, 0x4f, 0x4c, 0xaf, 0x59, 0x5c, 0xeb, 0x74, 0x05, 0x91, 0xa3, 0x27, 0xa6, 0x87,
+	0x84, 0x8e, 0x37, 0x96, 0x69, 0xe3, 0xd2, 0x3a, 0xa1, 0xe3, 0x51, 0x54, 0x81, 0x26, 0xff, 0xf2,
+	0xa6, 0x89, 0x95, 0x16, 0x91, 0xad, 0xcf, 0xab, 0xf1, 0x2a, 0x94, 0x1f, 0x39, 0xf6, 0xa9, 0x54,
+	0xa0, 0x4f, 0x1d, 0xfb, 0x34, 0x56, 0xa0, 0x58, 0x36, 0xfe, 0x4d, 0x19, 0x2a, 0x44, 0xbc, 0xff,
+	0xec, 0x0c, 0xd8, 0x8f, 0x89, 0x0b, 0x76, 0xa0, 0x90, 0x98, 0xa6, 0x55, 0x57, 0x84, 0x30, 0x68,
+	0x68, 0x85, 0xe0, 0xa4, 0x50, 0x84, 0x33, 0x50, 0x25, 0x88, 0xcc, 0x52, 0x55, 0x85, 0x4f, 0x16,
+	0x7e, 0xe7, 0xca, 0x94, 0x48, 0x0a, 0x60, 0xf7, 0xa0, 0x82, 0x12, 0x52, 0x78, 0x5f, 0x56, 0x15,
+	0x0b, 0xf5, 0x21, 0x0e, 0x1b, 0x79, 0x39, 0x9a, 0xb8, 0x58, 0x41, 0xbd, 0x85, 0xde, 0x51, 0xb3,
+	0xa6, 0xd2, 0x66, 0xdc, 0x3b, 0x4e, 0x04, 0xec, 0x0e, 0x94, 0xc9, 0x8e, 0xdb, 0x61, 0xb3, 0xae,
+	0x2a, 0xc8, 0xd8, 0x5b, 0xe2, 0x31, 0x9a, 0xbd, 0x0d, 0xc5, 0xd9, 0x89, 0x7d, 0x1e, 0x36, 0x1b,
+	0xea, 0xc6, 0xcf, 0x58, 0x48, 0x2e, 0x28, 0x30, 0xb5, 0x12, 0xd8, 0xb3, 0x31, 0xe5, 0xb6, 0xd0,
+	0xa4, 0x87, 0xcd, 0x2d, 0xb2, 0xd8, 0xf5, 0xc0, 0x9e, 0xb5, 0x11, 0x38, 0x9a, 0xb8, 0x21, 0x7b,
+	0x13, 0x4a, 0x64, 0xaa, 0xc2, 0xe6, 0xb6, 0xfa, 0xe5, 0xd8, 0xee, 0x71, 0x89, 0x65, 0xbb, 0x50,
+	0x4d, 0x95, 0xc3, 0x15, 0xea, 0xd0, 0xe5, 0x15, 0xad, 0x43, 0xca, 0x9a, 0xa7, 0x64, 0xec, 0x03,
+	0x00, 0x19, 0xab, 0x8c, 0x27, 0xe7, 0xcd, 0xab, 0xaa, 0xef, 0xaf, 0x1a, 0x35, 0x35, 0xa2, 0x79,
+	0x0b, 0x8a, 0x68, 0x0b, 0xc2, 0xe6, 0xb5, 0x9d, 0x7c, 0xea, 0x32, 0x29, 0xc6, 0x8b, 0x0b, 0x3c,
+	0xbb, 0x03, 0x15, 0x5c, 0x42, 0x63, 0x9c, 0xa8, 0xa6, 0x1a, 0xa4, 0xc9, 0xf5, 0x86, 0x6e, 0x98,
+	0x7d, 0x3a, 0xfc, 0xce, 0x65, 0x77, 0xa1, 0x60, 0xd9, 0xb3, 0xb0, 0x79, 0x7d, 0x27, 0x9f, 0x2a,
+	0xe3, 0x78, 0xd5, 0x61, 0x4c, 0x27, 0x0c, 0x08, 0xd2, 0xb0, 0x07, 0xb0, 0x85, 0x0b, 0x6c, 0x97,
+	0x3c, 0x6b, 0x1c, 0xf2, 0xe6, 0x0d, 0xe2, 0x7a, 0x7d, 0x85, 0xab, 0x2f, 0x89, 0x68, 0x82, 0x3a,
+	0x5e, 0x14, 0x9c, 0xf3, 0x86, 0xa7, 0xc2, 0xd0, 0xa8, 0x3b, 0x61, 0xcf, 0x9f, 0x9e, 0xd8, 0x56,
+	0xf3, 0x15, 0x61, 0xd4, 0xe3, 0x3a, 0xfb, 0x1c, 0x1a, 0xb4, 0xe4, 0xb0, 0x8a, 0x1f, 0x6f, 0xde,
+	0x54, 0x0d, 0xdb, 0x48, 0x45, 0xf1, 0x2c, 0xe5, 0x8d, 0x03, 0x0a, 0xe0, 0xb0, 0xc8, 0x3e, 0x5e,
+	0x31, 0xac, 0x99, 0x35, 0xa6, 0x58, 0x60, 0x4c, 0xc7, 0xa7, 0x84, 0x7b, 0x45, 0xc8, 0x5b, 0xf6,
+	0xec, 0xc6, 0xaf, 0x80, 0xad, 0x77, 0xe2, 0x79, 0x56, 0xbe, 0x28, 0xad, 0xfc, 0x17, 0xb9, 0xcf,
+	0x34, 0xe3, 0x73, 0x68, 0x64, 0xd6, 0xfd, 0x46, 0x17, 0x49, 0x38, 0xec, 0xa6, 0x48, 0xb1, 0xd7,
+	0xb9, 0xa8, 0x18, 0xff, 0x49, 0x83, 0xe2, 0x30, 0x32, 0xa3, 0x10, 0x8f, 0xc4, 0x26, 0xae, 0x3f,
+	0x3d, 0x19, 0x7b, 0xcb, 0xb9, 0x4c, 0x5e, 0x57, 0x08, 0x80, 0xa6, 0x8e, 0xdc, 0xd4, 0x30, 0x22,
+	0x5e, 0x8d, 0x53, 0x19, 0xb7, 0xbe, 0xbf, 0x8c, 0xa6, 0x5e, 0x44, 0x5b, 0x5f, 0xe3, 0xb2, 0x86,
+	0x7a, 0x30, 0xf0, 0x4f, 0x29, 0x77, 0x5b, 0x20, 0x44, 0x5c, 0x45, 0xbf, 0xf5, 0x89, 0x19, 0x3e,
+	0x99, 0x9b, 0x8b, 0x34, 0xb5, 0xab, 0xf1, 0x9a, 0x84, 0x61, 0x7a, 0x17, 0xa5, 0x10, 0x5a, 0x01,
+	0xdb, 0x2d, 0x11, 0xbe, 0x42, 0x80, 0xb6, 0x17, 0xa1, 0x0e, 0x0e, 0x6d, 0xd7, 0x9e, 0x46, 0xce,
+	0x53, 0x0c, 0x71, 0xcb, 0x82, 0x5d, 0x01, 0x19, 0x6f, 0x43, 0x19, 0x95, 0x8c, 0x19, 0x99, 0x68,
+	0xb6, 0x2c, 0x33, 0x32, 0x37, 0xa5, 0xcd, 0x11, 0x6e, 0xbc, 0x07, 0xc0, 0xfd, 0xd3, 0xd0, 0x8e,
+	0x88, 0xfa, 0x75, 0x25, 0xb8, 0x4b, 0x16, 0xb0, 0x6c, 0x4a, 0x28, 0x2c, 0xe3, 0xbf, 0x6b, 0x50,
+	0x1b, 0x04, 0x16, 0x6e, 0x8e, 0xe1, 0xc2, 0x9e, 0x3e, 0xd7, 0x2e, 0xa2, 0x06, 0xf3, 0x5d, 0xd7,
+	0x4c, 0xac, 0x4a, 0x95, 0xa7, 0x00, 0xf6, 0x01, 0x14, 0x66, 0xae, 0x29, 0xdc, 0xd0, 0xc4, 0xbf,
+	0x56, 0x9a, 0x8f, 0xcb, 0x98, 0x77, 0xe4, 0x44, 0x6a, 0xfc, 0x11, 0xd4, 0x14, 0x60, 0x26, 0x05,
+	0x79, 0x81, 0x52, 0xd9, 0xc3, 0xb6, 0x8e, 0x89, 0xc2, 0xc2, 0x7e, 0x67, 0xd8, 0x16, 0x5e, 0x35,
+	0xfa, 0xd7, 0xc3, 0xf1, 0xfd, 0x2e, 0x1f, 0x8e, 0xf4, 0x02, 0xe5, 0xc6, 0x09, 0xd0, 0x6b, 0x0d,
+	0x31, 0x21, 0x09, 0x50, 0x3a, 0xea, 0x77, 0x7f, 0x7d, 0xd4, 0xd1, 0x75, 0xe3, 0x5f, 0x6a, 0x00,
+	0xf7, 0x03, 0x73, 0x6e, 0xef, 0xf9, 0x4b, 0xcf, 0x62, 0xf7, 0x32, 0x8e, 0xde, 0x0d, 0xa9, 0xdc,
+	0x12, 0xfc, 0x3d, 0xfa, 0xab, 0xf8, 0x7b, 0x37, 0xa1, 0xba, 0xf4, 0x26, 0x08, 0xb4, 0x2d, 0x79,
+	0x88, 0x93, 0x02, 0x30, 0xff, 0x13, 0x1f, 0x59, 0xae, 0x1c, 0x21, 0x3d, 0x35, 0x5d, 0xe3, 0x0b,
+	0xa8, 0x26, 0xcd, 0xa1, 0xe7, 0x7f, 0xc8, 0x3b, 0xed, 0xce, 0x7e, 0xb7, 0x7f, 0xa0, 0x5f, 0xc0,
+	0x3e, 0xb4, 0x8f, 0x38, 0xef, 0xf4, 0x47, 0x63, 0x3e, 0x78, 0xac, 0x6b, 0x88, 0xbf, 0x3f, 0xe8,
+	0xf5, 0x06, 0x8f, 0x11, 0x9f, 0x33, 0xfe, 0xb9, 0x06, 0x35, 0x12, 0xab, 0xed, 0x9a, 0xcb, 0xd0,
+	0x66, 0xef, 0x65, 0xe4, 0x7e, 0x45, 0x91, 0x5b, 0x10, 0x88, 0xb2, 0x22, 0xf8, 0x9b, 0x50, 0x0c,
+	0x23, 0x33, 0x88, 0x9a, 0x39, 0x35, 0x13, 0x98, 0xf6, 0x94, 0x0b, 0x34, 0x66, 0xf9, 0x6c, 0xcf,
+	0x6a, 0xe6, 0x9f, 0x41, 0x85, 0x48, 0x63, 0x07, 0xaa, 0x49, 0xf3, 0x38, 0x0f, 0x7c, 0xf0, 0x78,
+	0xa8, 0x5f, 0x60, 0x55, 0x28, 0xf2, 0x56, 0xff, 0xa0, 0xa3, 0x6b, 0xc6, 0xbf, 0xd6, 0x00, 0x1e,
+	0x3b, 0x9e, 0xe5, 0x9f, 0xd2, 0x12, 0x7a, 0x57, 0xf1, 0x32, 0x51, 0x31, 0xaf, 0xaf, 0xd5, 0xda,
+	0x22, 0xd5, 0xe9, 0xec, 0x1d, 0xa8, 0xf8, 0xb8, 0x00, 0x90, 0x34, 0xa7, 0x6a, 0x65, 0x65, 0xdd,
+	0xf0, 0xb2, 0x2f, 0x2a, 0xb8, 0x67, 0x5d, 0xdb, 0xb4, 0xe4, 0xc1, 0x12, 0x95, 0x51, 0xab, 0xe0,
+	0xa2, 0x13, 0x07, 0xdb, 0x58, 0x44, 0x35, 0x3f, 0x0b, 0xe2, 0x70, 0x3c, 0x69, 0x50, 0x19, 0x31,
+	0x2e, 0xf0, 0xc6, 0xef, 0x0b, 0x50, 0xed, 0x7a, 0xa1, 0x1d, 0x44, 0xed, 0xe8, 0x8c, 0xbd, 0x0e,
+	0xf9, 0xc0, 0x9e, 0x3d, 0x2b, 0xb5, 0x8e, 0x38, 0x4c, 0xbc, 0x89, 0xad, 0x6c, 0xd9, 0x33, 0x39,
+	0xba, 0x5b, 0x59, 0xe5, 0x2d, 0xb7, 0xf6, 0x3e, 0x1d, 0x33, 0xe9, 0x18, 0xaf, 0x2e, 0x17, 0xae,
+	0x33, 0xc5, 0x14, 0x0d, 0x26, 0xcc, 0x30, 0xb3, 0x50, 0xe4, 0x5b, 0xbe, 0xb7, 0x1f, 0x83, 0xbb,
+	0xd6, 0x19, 0x3b, 0x84, 0x8b, 0x19, 0x4a, 0xda, 0x83, 0xc2, 0xcd, 0xb8, 0x1d, 0xdb, 0x6a, 0x29,
+	0xe5, 0xbd, 0x41, 0xca, 0x8a, 0xa3, 0x29, 0xcc, 0xc3, 0xb6, 0x9f, 0x85, 0x92, 0xcd, 0xb7, 0xce,
+	0xc6, 0xd8, 0x1f, 0xe1, 0x9c, 0xad, 0xf5, 0x07, 0x13, 0x24, 0xf2, 0x78, 0x4f, 0xa4, 0x4a, 0xce,
+	0xc8, 0x3b, 0x2b, 0x12, 0x02, 0x85, 0xfa, 0x92, 0x42, 0x01, 0x9b, 0x0e, 0x3b, 0xce, 0x9a, 0x65,
+	0x6a, 0xe5, 0xd6, 0xaa, 0x34, 0x87, 0x44, 0xd1, 0xb5, 0xa4, 0x99, 0xaa, 0x2e, 0xe2, 0x3a, 0xfb,
+	0x14, 0x1a, 0xb1, 0x79, 0x16, 0x59, 0xa9, 0xca, 0x06, 0x0b, 0x4d, 0xa3, 0xc6, 0xeb, 0x53, 0xa5,
+	0x76, 0xa3, 0x0f, 0x97, 0x37, 0xf5, 0x71, 0x83, 0xf5, 0xd8, 0x51, 0xad, 0xc7, 0x4a, 0xb8, 0x9a,
+	0x58, 0x92, 0x1b, 0xbf, 0xa0, 0x88, 0x4f, 0x91, 0xf2, 0x47, 0xd9, 0xa1, 0x3f, 0x2d, 0x41, 0x55,
+	0xe4, 0x01, 0x32, 0x4b, 0x24, 0xff, 0xcc, 0x25, 0x72, 0x0b, 0xf2, 0x38, 0x5e, 0x39, 0xd5, 0x49,
+	0xec, 0x5a, 0x98, 0x3f, 0xe1, 0x88, 0x60, 0xef, 0xc8, 0x25, 0xb4, 0x8f, 0x5e, 0x43, 0x5e, 0xf5,
+	0x8a, 0x92, 0x25, 0x94, 0x12, 0x60, 0x7c, 0x2b, 0x92, 0x16, 0x94, 0x04, 0x2b, 0xa8, 0xdf, 0x6d,
+	0xd3, 0x61, 0xeb, 0x43, 0x73, 0x11, 0x1f, 0x77, 0x63, 0x36, 0xf3, 0x27, 0x98, 0xf7, 0x4f, 0x61,
+	0xdb, 0xf7, 0xc6, 0x81, 0x8d, 0x39, 0x85, 0x69, 0x44, 0x4d, 0x95, 0x37, 0x37, 0xd5, 0xf0, 0x3d,
+	0x2e, 0xc9, 0xb0, 0xc5, 0x37, 0xb3, 0x8c, 0xd8, 0x72, 0x85, 0x5a, 0x56, 0xe8, 0xf0, 0x03, 0x1f,
+	0xc3, 0x16, 0x06, 0x40, 0x66, 0x38, 0x35, 0x2d, 0x9b, 0xda, 0xaf, 0x6e, 0x6e, 0xbf, 0xee, 0x7b,
+	0x6d, 0x41, 0x85, 0xcd, 0xef, 0x66, 0xd8, 0xb0, 0x75, 0xd8, 0x30, 0xc6, 0x29, 0x0f, 0x7e, 0xea,
+	0xa3, 0x0c, 0x0f, 0x6e, 0xda, 0xda, 0xc6, 0x11, 0x4f, 0xb9, 0x70, 0xe3, 0xee, 0xc1, 0x15, 0x85,
+	0x4b, 0x19, 0xff, 0xfa, 0xe6, 0xf1, 0x67, 0x09, 0xf7, 0x51, 0x32, 0x11, 0xef, 0x02, 0xf8, 0xde,
+	0x38, 0xb4, 0xc5, 0x00, 0x36, 0x36, 0x77, 0xb0, 0xe2, 0x7b, 0x43, 0x1b, 0x4b, 0xec, 0x6e, 0x42,
+	0x8e, 0x1d, 0xdb, 0xda, 0xd0, 0x31, 0x41, 0xdb, 0xa5, 0x15, 0x14, 0xd3, 0x62, 0x87, 0xb6, 0x37,
+	0x76, 0x48, 0x50, 0x63, 0x67, 0xbe, 0x80, 0x8b, 0x92, 0x5a, 0xe9, 0x88, 0xbe, 0xb9, 0x23, 0x5b,
+	0xc4, 0x95, 0x76, 0xe2, 0x5e, 0x46, 0x05, 0x5c, 0x7c, 0xc6, 0xea, 0x4b, 0xf6, 0xbc, 0xf1, 0x17,
+	0x79, 0xa8, 0xb5, 0x3c, 0xd3, 0x3d, 0xff, 0xad, 0xdd, 0xf5, 0x66, 0xbe, 0x48, 0x3c, 0x2e, 0x96,
+	0xd1, 0x18, 0xbd, 0x25, 0x79, 0xf4, 0x53, 0x25, 0x08, 0xba, 0x29, 0x98, 0x14, 0xf4, 0x97, 0x51,
+	0x82, 0x17, 0x87, 0x41, 0x20, 0x40, 0x44, 0x90, 0xf0, 0x93, 0x6b, 0x95, 0x57, 0xf8, 0xc9, 0xb1,
+	0x4a, 0xf9, 0x13, 0xcf, 0x2c, 0xe1, 0x27, 0x82, 0x37, 0xa0, 0x81, 0x57, 0x4d, 0xc6, 0x53, 0xdf,
+	0x0b, 0x97, 0x73, 0xdb, 0x12, 0x97, 0x85, 0xc4, 0xfd, 0x93, 0xb6, 0x84, 0x61, 0x2b, 0x73, 0x7b,
+	0xee, 0x07, 0xe7, 0xa2, 0x95, 0x92, 0x68, 0x45, 0x80, 0xa8, 0x95, 0x77, 0x80, 0x9d, 0x9a, 0x4e,
+	0x34, 0xce, 0x36, 0x25, 0xf2, 0x1c, 0x3a, 0x62, 0x46, 0x6a, 0x73, 0x57, 0xa1, 0x64, 0x39, 0xe1,
+	0x49, 0x77, 0x40, 0x0a, 0x2f, 0xcf, 0x65, 0x0d, 0xbd, 0xc0, 0xf0, 0xc3, 0xee, 0x60, 0x3c, 0x39,
+	0x97, 0x67, 0x36, 0x79, 0x5e, 0x41, 0xc0, 0xde, 0x79, 0x44, 0xb9, 0x64, 0x42, 0x8a, 0xde, 0xd2,
+	0xb1, 0x30, 0xe5, 0x69, 0xf3, 0x7c, 0x0b, 0xe1, 0x5d, 0x04, 0xb7, 0x11, 0xca, 0xee, 0xc2, 0x45,
+	0xa2, 0x94, 0x1d, 0x17, 0xa4, 0x22, 0x5b, 0xbb, 0x8d, 0x88, 0xc1, 0x32, 0x4a, 0x68, 0x6f, 0x42,
+	0xd5, 0xb3, 0xa3, 0x53, 0x3f, 0x40, 0x69, 0xea, 0x62, 0xf4, 0x12, 0x00, 0xc6, 0x10, 0xe1, 0xd4,
+	0xf4, 0x50, 0xf8, 0x66, 0x43, 0xca, 0x23, 0xeb, 0x78, 0xd9, 0xcb, 0x21, 0x1d, 0x4f, 0xd8, 0x2d,
+	0x31, 0x24, 0x29, 0xc4, 0xf8, 0x57, 0x17, 0xa1, 0xd0, 0xf7, 0x2d, 0x1b, 0xcf, 0x5f, 0xe8, 0x82,
+	0xc4, 0x7a, 0x06, 0x0d, 0xd1, 0xf4, 0x87, 0x1c, 0x93, 0x8a, 0x27, 0x4b, 0xcf, 0xbe, 0x52, 0xf1,
+	0x3a, 0x79, 0x2d, 0x94, 0x7d, 0x57, 0x0e, 0x74, 0xc9, 0x91, 0xe7, 0x02, 0x83, 0x22, 0x53, 0xc0,
+	0x19, 0xd8, 0x1e, 0xe9, 0xc2, 0x22, 0x4f, 0xea, 0xe4, 0x77, 0x04, 0x3e, 0xee, 0x2c, 0x4a, 0x61,
+	0x6f, 0xc8, 0x49, 0xd4, 0x24, 0x9e, 0x6e, 0xa0, 0xbc, 0x0f, 0xd5, 0x6f, 0x7d, 0xc7, 0x13, 0x82,
+	0x97, 0xd6, 0x04, 0xff, 0xca, 0x77, 0x44, 0xea, 0xaf, 0xf2, 0xad, 0x2c, 0xb1, 0x37, 0xa0, 0xec,
+	0x7b, 0xa2, 0xed, 0xf2, 0x5a, 0xdb, 0x25, 0xdf, 0xeb, 0x89, 0x83, 0xd3, 0xc6, 0x64, 0x89, 0x21,
+	0x31, 0x92, 0xda, 0xb3, 0x48, 0x66, 0xba, 0x6a, 0x04, 0x1c, 0x78, 0x3d, 0x7b, 0x86, 0xa7, 0x77,
+	0xb5, 0x99, 0xe3, 0xa2, 0x61, 0xa4, 0xc6, 0xaa, 0x6b, 0x8d, 0x81, 0x40, 0x53, 0x83, 0x3f, 0x83,
+	0xca, 0x71, 0xe0, 0x2f, 0x17, 0xe8, 0x1f, 0xc1, 0x1a, 0x65, 0x99, 0x70, 0x7b, 0xe7, 0xd8, 0x7b,
+	0x2a, 0x3a, 0xde, 0x31, 0xee, 0xf5, 0x66, 0x6d, 0x8d, 0xb4, 0x16, 0xe3, 0x87, 0x36, 0xb5, 0x6a,
+	0x1e, 0x1f, 0x8b, 0xef, 0xd7, 0xd7, 0x5b, 0x35, 0x8f, 0x8f, 0xe9, 0xe3, 0x3f, 0x87, 0xca, 0x29,
+	0x66, 0x97, 0x17, 0xf6, 0xb4, 0xd9, 0x50, 0xbd, 0xc4, 0xd4, 0xdf, 0xe3, 0xe5, 0x53, 0xc7, 0xc3,
+	0x42, 0xc6, 0x93, 0xdb, 0x7a, 0xae, 0x27, 0xb7, 0x03, 0x45, 0xd7, 0x99, 0x3b, 0x11, 0x5d, 0x65,
+	0x5b, 0xb1, 0xdd, 0x84, 0x60, 0x06, 0x94, 0xfc, 0xd9, 0x0c, 0x3b, 0xa3, 0xaf, 0x91, 0x48, 0x8c,
+	0x6a, 0x1e, 0xa3, 0xb3, 0xec, 0x85, 0xb6, 0xc4, 0x68, 0x27, 0xe6, 0x31, 0x3a, 0xcb, 0xfa, 0x6f,
+	0xec, 0x39, 0xfe, 0xdb, 0x2e, 0x34, 0x12, 0xe2, 0xf1, 0x53, 0x7b, 0xda, 0xbc, 0xb4, 0x51, 0xd5,
+	0xd6, 0x62, 0x86, 0x47, 0xf6, 0x14, 0xed, 0x2f, 0xde, 0x5c, 0x41, 0x9d, 0x7f, 0x79, 0xb3, 0x1f,
+	0x59, 0xf2, 0x27, 0xdf, 0xa2, 0xc6, 0xff, 0x00, 0x6a, 0x01, 0xc5, 0x6a, 0x63, 0x0a, 0xe9, 0xae,
+	0xa8, 0xc3, 0x9b, 0x06, 0x71, 0x1c, 0x82, 0xa4, 0x8c, 0xea, 0x4c, 0x1c, 0xf3, 0x89, 0x73, 0x9d,
+	0x90, 0x92, 0x1e, 0x55, 0x5e, 0x27, 0xa0, 0x38, 0xf3, 0x21, 0x8f, 0x41, 0x1c, 0x91, 0xd0, 0x90,
+	0x5c, 0x53, 0x85, 0x10, 0x67, 0x21, 0x34, 0x24, 0x56, 0x5c, 0xc4, 0x00, 0x76, 0xe2, 0x78, 0x16,
+	0x2e, 0x9c, 0xc8, 0x3c, 0x0e, 0x9b, 0x4d, 0xda, 0x57, 0x35, 0x09, 0x1b, 0x99, 0xc7, 0x21, 0xfb,
+	0x08, 0xea, 0xa6, 0xd0, 0xea, 0x63, 0xc7, 0x9b, 0xf9, 0xcd, 0xeb, 0xaa, 0x5b, 0xad, 0xe8, 0x7b,
+	0x5e, 0x33, 0xd3, 0x0a, 0xfb, 0x14, 0x58, 0x9c, 0xcf, 0x22, 0x87, 0x56, 0xac, 0xb6, 0x1b, 0x6b,
+	0xab, 0x6d, 0x5b, 0x26, 0xb4, 0x92, 0xcb, 0x61, 0x3b, 0x80, 0x11, 0x82, 0xe9, 0xba, 0xb6, 0xeb,
+	0x84, 0x73, 0xca, 0x6f, 0x14, 0xb9, 0x0a, 0x5a, 0xf7, 0x2d, 0x6f, 0xbe, 0x98, 0x6f, 0x89, 0x23,
+	0x88, 0xc7, 0xf2, 0x53, 0x73, 0xfa, 0xc4, 0x26, 0xc6, 0x57, 0x69, 0x7b, 0xd6, 0x3d, 0x3f, 0x6a,
+	0xc7, 0x30, 0x1c, 0x41, 0xa1, 0xea, 0x68, 0x04, 0x6f, 0xa9, 0x23, 0x98, 0x38, 0xbe, 0x68, 0x86,
+	0xd2, 0xb8, 0xa1, 0x3e, 0x5d, 0x06, 0x64, 0x26, 0xc3, 0xc8, 0x5e, 0x34, 0x5f, 0x13, 0x02, 0x4b,
+	0xd8, 0x30, 0xb2, 0x17, 0x74, 0xe3, 0xc9, 0x5f, 0x06, 0x53, 0x5b, 0x50, 0xec, 0x10, 0x05, 0x08,
+	0x10, 0x11, 0xbc, 0x82, 0xb1, 0x26, 0x46, 0x4c, 0xa6, 0xeb, 0x36, 0x5f, 0x17, 0x19, 0x1d, 0x02,
+	0xb4, 0x5c, 0x34, 0xc3, 0x97, 0xe6, 0x26, 0x3a, 0x75, 0xd3, 0x65, 0x80, 0xc7, 0x01, 0x63, 0x71,
+	0xbb, 0xce, 0x20, 0xb5, 0x7c, 0x71, 0x6e, 0x9e, 0xf1, 0x18, 0xb3, 0x8f, 0x08, 0xf6, 0x25, 0x6c,
+	0xa7, 0x21, 0xd8, 0x22, 0x58, 0x7a, 0x76, 0xf3, 0x8d, 0x8d, 0x39, 0xb5, 0x43, 0xc4, 0xf1, 0xad,
+	0x45, 0xa6, 0xce, 0x3e, 0x86, 0x5a, 0xe8, 0x99, 0x8b, 0xf0, 0x89, 0x1f, 0x8d, 0xa3, 0xb0, 0x79,
+	0x5b, 0xb2, 0xa6, 0x57, 0x95, 0x47, 0x71, 0x89, 0x43, 0x4c, 0x38, 0xa2, 0x55, 0x22, 0x8e, 0x11,
+	0x5d, 0xdf, 0x3f, 0x59, 0x2e, 0x9a, 0x3f, 0x5b, 0x3b, 0x96, 0xec, 0x11, 0x82, 0xd7, 0x9c, 0xb4,
+	0x62, 0xfc, 0xd7, 0x3c, 0x54, 0x62, 0x2b, 0x81, 0xc7, 0x69, 0x47, 0xfd, 0xaf, 0xfb, 0x83, 0xc7,
+	0x7d, 0xfd, 0x02, 0x46, 0xf6, 0x8f, 0x5a, 0xbd, 0xa3, 0xce, 0x78, 0xd8, 0x6e, 0xf5, 0xc5, 0x2d,
+	0x38, 0xba, 0x8f, 0x24, 0xea, 0x39, 0x76, 0x11, 0x1a, 0xf7, 0x8f, 0xfa, 0x74, 0x9c, 0x26, 0x40,
+	0x79, 0x04, 0x75, 0x7e, 0x23, 0xd2, 0x07, 0x02, 0x54, 0x40, 0xd0, 0xc3, 0xd6, 0xa8, 0xc3, 0xbb,
+	0x31, 0xa8, 0x88, 0x5f, 0x39, 0xe4, 0x83, 0xaf, 0x3a, 0xed, 0x91, 0x0e, 0xec, 0x0a, 0x5c, 0x4c,
+	0x58, 0xe2, 0xe6, 0xf4, 0x1a, 0x26, 0x22, 0x62, 0x36, 0xfd, 0x32, 0x36, 0xc2, 0x3b, 0xed, 0x23,
+	0x3e, 0xec, 0x3e, 0xea, 0x8c, 0xdb, 0xa3, 0x8e, 0x7e, 0x05, 0x43, 0xe1, 0x61, 0xb7, 0xff, 0xb5,
+	0x7e, 0x15, 0xa3, 0x77, 0x2c, 0x89, 0xd6, 0xaf, 0x51, 0xd2, 0xe2, 0xe0, 0x40, 0xbf, 0x85, 0x4d,
+	0xec, 0x77, 0x87, 0xa3, 0x6e, 0xbf, 0x3d, 0xd2, 0x5f, 0xc3, 0xbc, 0xc4, 0xfd, 0x6e, 0x6f, 0xd4,
+	0xe1, 0xfa, 0x0e, 0xf2, 0x7e, 0x35, 0xe8, 0xf6, 0xf5, 0xd7, 0x11, 0x3a, 0x6c, 0x3d, 0x3c, 0xec,
+	0x75, 0x74, 0x83, 0x5a, 0x1c, 0xf0, 0x91, 0xfe, 0x06, 0x06, 0xd7, 0x47, 0x7d, 0x94, 0xe3, 0x36,
+	0x36, 0x4e, 0xc5, 0x31, 0xde, 0xe9, 0xfb, 0x99, 0x92, 0xdd, 0x78, 0x13, 0xcb, 0x8f, 0xbb, 0xfd,
+	0xfd, 0xc1, 0x63, 0xfd, 0x2d, 0x24, 0xdb, 0xe3, 0x83, 0xd6, 0x7e, 0x1b, 0x93, 0x20, 0x77, 0xb0,
+	0x81, 0xe1, 0x61, 0xaf, 0x3b, 0xd2, 0xdf, 0x46, 0xaa, 0x83, 0xd6, 0xe8, 0x41, 0x87, 0xeb, 0x77,
+	0xb1, 0xdc, 0x1a, 0x0e, 0x3b, 0x7c, 0xa4, 0xef, 0x62, 0xb9, 0xdb, 0xa7, 0xf2, 0x87, 0xd4, 0xea,
+	0xe1, 0x7e, 0x6b, 0xd4, 0xd1, 0x3f, 0xc2, 0xf2, 0x7e, 0xa7, 0xd7, 0x19, 0x75, 0xf4, 0x8f, 0xb1,
+	0x55, 0xca, 0xc6, 0x0c, 0x71, 0xa8, 0x3e, 0xc1, 0x51, 0x48, 0xaa, 0x24, 0xcf, 0xa7, 0xf8, 0xa1,
+	0x87, 0xdd, 0xfe, 0xd1, 0x50, 0xff, 0x0c, 0x89, 0xa9, 0x48, 0x98, 0xcf, 0x8d, 0x6f, 0xa1, 0x12,
+	0xdb, 0x50, 0xa4, 0xea, 0xf6, 0xfb, 0x1d, 0xbc, 0xd6, 0x58, 0x81, 0x42, 0xaf, 0x73, 0x7f, 0xa4,
+	0x6b, 0x08, 0xe4, 0xdd, 0x83, 0x07, 0x23, 0x3d, 0x87, 0xc5, 0xc1, 0x11, 0x0e, 0x4d, 0x9e, 0x06,
+	0xa1, 0xf3, 0xb0, 0xab, 0x17, 0xb0, 0xd4, 0xea, 0x8f, 0xba, 0x7a, 0x91, 0x06, 0xa9, 0xdb, 0x3f,
+	0xe8, 0x75, 0xf4, 0x12, 0x42, 0x1f, 0xb6, 0xf8, 0xd7, 0x7a, 0x19, 0x99, 0x5a, 0x87, 0x87, 0xbd,
+	0x6f, 0xf4, 0x8a, 0x71, 0x07, 0xca, 0xad, 0xe3, 0xe3, 0x87, 0xe8, 0x8f, 0x54, 0xa0, 0x70, 0x1f,
+	0xcf, 0x5f, 0xe9, 0x02, 0xe5, 0xde, 0x60, 0x34, 0x1a, 0x3c, 0xd4, 0x35, 0x9c, 0x93, 0xd1, 0xe0,
+	0x50, 0xcf, 0x19, 0xff, 0x43, 0x93, 0xf7, 0x27, 0xc4, 0xea, 0x53, 0xd5, 0xb5, 0xf6, 0xc3, 0xea,
+	0xfa, 0x47, 0x45, 0xfe, 0x2b, 0x16, 0x3e, 0xff, 0x83, 0x16, 0xfe, 0x06, 0xe4, 0x16, 0x27, 0x1b,
+	0xee, 0x8d, 0xe6, 0x16, 0x27, 0xec, 0x5d, 0x28, 0x3b, 0x4f, 0x67, 0x33, 0xd7, 0x8c, 0x64, 0x2e,
+	0x43, 0xfa, 0x28, 0x5d, 0x01, 0x94, 0x1b, 0x2a, 0xa6, 0x31, 0x06, 0xd0, 0xc8, 0x60, 0xd8, 0x9b,
+	0x50, 0xb1, 0x9c, 0x30, 0x32, 0xbd, 0xa9, 0xbd, 0x21, 0xa7, 0x97, 0xe0, 0xd0, 0x69, 0x5d, 0x04,
+	0xfe, 0xc4, 0x8e, 0x9d, 0x70, 0x59, 0x33, 0x42, 0xe5, 0xb4, 0x54, 0x28, 0x87, 0x57, 0xa0, 0xea,
+	0x84, 0x42, 0xa9, 0x58, 0xf2, 0xa2, 0x49, 0xc5, 0x09, 0x09, 0x67, 0xb1, 0x7d, 0xb8, 0x24, 0x32,
+	0x97, 0xb6, 0x35, 0x56, 0x8e, 0x11, 0x73, 0xcf, 0x3e, 0x46, 0x64, 0x31, 0x7d, 0x02, 0x0e, 0x8d,
+	0x9b, 0x50, 0x12, 0x31, 0x0f, 0xa5, 0x7b, 0xe2, 0x6b, 0xc2, 0x79, 0x79, 0x35, 0xd8, 0x87, 0x6a,
+	0x12, 0x7b, 0xb0, 0xbb, 0x78, 0x4f, 0x6d, 0x21, 0xe3, 0xf1, 0xe6, 0x4a, 0x64, 0x72, 0xef, 0xa1,
+	0xb9, 0x10, 0x69, 0x09, 0x24, 0xba, 0xf1, 0x09, 0x54, 0x62, 0xc0, 0x8f, 0xca, 0x00, 0xfc, 0x59,
+	0x01, 0xaa, 0xfb, 0x8a, 0xb9, 0xfc, 0x83, 0x33, 0x00, 0x4a, 0x8c, 0x9e, 0x7f, 0xe1, 0x18, 0xbd,
+	0xf0, 0xbc, 0x18, 0xbd, 0xf8, 0xb2, 0x31, 0x7a, 0xe9, 0xc5, 0x62, 0xf4, 0xf2, 0x8b, 0xc4, 0xe8,
+	0xb7, 0xd7, 0x62, 0x74, 0x91, 0x01, 0xc8, 0x46, 0xe5, 0xd9, 0xd8, 0xb8, 0xfa, 0xbc, 0xd8, 0x38,
+	0x1b, 0xef, 0xc2, 0x73, 0xe2, 0xdd, 0x6c, 0x24, 0x5d, 0xfb, 0xc1, 0x48, 0x7a, 0x63, 0x6c, 0x5c,
+	0x7f, 0xb1, 0xd8, 0x18, 0xad, 0xbe, 0xe9, 0x8d, 0xa3, 0x60, 0xe9, 0x61, 0x9e, 0x8a, 0xfc, 0xe3,
+	0x0a, 0xaf, 0x61, 0x04, 0x25, 0x41, 0xc6, 0x9f, 0xe6, 0xa0, 0xf8, 0x6b, 0xbc, 0xc9, 0xc9, 0x3e,
+	0x81, 0x6a, 0x18, 0xcd, 0x23, 0x35, 0x4c, 0xba, 0x2e, 0x3e, 0x40, 0x78, 0x8a, 0x72, 0x6c, 0x3c,
+	0x57, 0x15, 0x31, 0x07, 0xd2, 0x62, 0x89, 0x1e, 0xe8, 0x44, 0xf6, 0x42, 0x6c, 0xa1, 0x22, 0x17,
+	0x15, 0xf4, 0x9d, 0x31, 0x66, 0x0a, 0xb3, 0x8a, 0x05, 0xad, 0x28, 0x17, 0x08, 0xf4, 0x9d, 0xe9,
+	0x2c, 0x24, 0x3e, 0xac, 0xcc, 0xf8, 0xce, 0x02, 0x83, 0xc1, 0xd4, 0x13, 0xdb, 0x44, 0x27, 0x2f,
+	0xbe, 0x7b, 0x95, 0xd4, 0xf1, 0xbc, 0xc3, 0xf5, 0x4d, 0x6b, 0x64, 0x1e, 0xc7, 0xb7, 0x17, 0x65,
+	0xd5, 0x78, 0x0c, 0x8d, 0x8c, 0xb0, 0x59, 0x9b, 0x8d, 0xaa, 0xba, 0xd3, 0x43, 0x73, 0xa1, 0x29,
+	0x16, 0x26, 0xa7, 0x58, 0x95, 0xbc, 0x62, 0x6d, 0x0a, 0x64, 0x3f, 0x3a, 0xfc, 0xa0, 0xa3, 0x17,
+	0x8d, 0x7f, 0x9c, 0x83, 0x8b, 0xa3, 0xc0, 0xf4, 0x42, 0x53, 0x1c, 0x83, 0x7b, 0x51, 0xe0, 0xbb,
+	0xec, 0x0b, 0xa8, 0x44, 0x53, 0x57, 0x1d, 0xb7, 0xd7, 0xe4, 0xcc, 0xaf, 0x92, 0xde, 0x1b, 0x4d,
+	0x5d, 0x1a, 0xbd, 0x72, 0x24, 0x0a, 0xec, 0x5d, 0x28, 0x4e, 0xec, 0x63, 0xc7, 0x93, 0xea, 0xfa,
+	0xca, 0x2a, 0xe3, 0x1e, 0x22, 0xf1, 0x81, 0x10, 0x51, 0xb1, 0xf7, 0xf1, 0xe6, 0xe8, 0x1c, 0x43,
+	0x92, 0xbc, 0x7a, 0xb1, 0x42, 0xfd, 0x10, 0x62, 0xf1, 0x11, 0x90, 0xa0, 0x63, 0x9f, 0xe0, 0x95,
+	0x7e, 0xd7, 0x9d, 0x98, 0xd3, 0x58, 0x7f, 0x37, 0x57, 0x79, 0xb8, 0xc4, 0x3f, 0xb8, 0xc0, 0x13,
+	0x5a, 0xe3, 0x1e, 0x94, 0xa5, 0xb0, 0x38, 0x00, 0x7b, 0x9d, 0x83, 0xae, 0x1c, 0xbb, 0xf6, 0xe0,
+	0xe1, 0xc3, 0xee, 0x48, 0x5c, 0x25, 0xe2, 0x83, 0x5e, 0x6f, 0xaf, 0xd5, 0xfe, 0x5a, 0xcf, 0xed,
+	0x55, 0xa0, 0x64, 0xd2, 0x21, 0x98, 0xf1, 0xb7, 0x35, 0xd8, 0x5e, 0xe9, 0x00, 0xfb, 0x0c, 0x0a,
+	0x73, 0xdf, 0x8a, 0x87, 0xe7, 0xf6, 0xc6, 0x5e, 0x2a, 0x75, 0x34, 0x93, 0x9c, 0x38, 0x8c, 0xcf,
+	0x61, 0x2b, 0x0b, 0x57, 0x2e, 0x83, 0x37, 0xa0, 0xca, 0x3b, 0xad, 0xfd, 0xf1, 0xa0, 0xdf, 0xfb,
+	0x46, 0x38, 0x5f, 0x54, 0x7d, 0xcc, 0xbb, 0xa3, 0x8e, 0x9e, 0x33, 0xfe, 0x08, 0xf4, 0xd5, 0x81,
+	0x61, 0x07, 0xb0, 0x8d, 0x17, 0xf2, 0x5c, 0x5b, 0x9c, 0xe0, 0xa7, 0x53, 0x76, 0x6b, 0xc3, 0x48,
+	0x4a, 0x32, 0x9a, 0xb1, 0xad, 0x69, 0xa6, 0x6e, 0xfc, 0x0d, 0x60, 0xeb, 0x23, 0xf8, 0xd3, 0x35,
+	0xff, 0xe7, 0x1a, 0x14, 0x0e, 0x5d, 0x13, 0xef, 0x9b, 0x14, 0xe9, 0xa2, 0x75, 0x53, 0x53, 0x33,
+	0x0e, 0xb4, 0x23, 0x71, 0x59, 0x10, 0x8e, 0xfd, 0x1c, 0xf2, 0xd1, 0xd4, 0x95, 0x6b, 0xe8, 0xda,
+	0x33, 0x16, 0x1f, 0xde, 0x89, 0x8e, 0xa6, 0x98, 0x7e, 0xcd, 0x5b, 0x56, 0x7c, 0x28, 0x24, 0xbd,
+	0x6d, 0x0c, 0xdd, 0xf6, 0xed, 0x99, 0xe3, 0x39, 0xf2, 0xda, 0x37, 0x92, 0xe0, 0xc5, 0x6f, 0x6b,
+	0xea, 0x36, 0x0b, 0xaa, 0x93, 0x8c, 0x94, 0x4a, 0x83, 0xd6, 0x14, 0x5d, 0xff, 0x7a, 0x2b, 0x8a,
+	0x30, 0x34, 0xb1, 0x50, 0xe4, 0xec, 0x75, 0x63, 0x84, 0xf0, 0x0c, 0x1e, 0x2f, 0x65, 0x23, 0xca,
+	0x78, 0x87, 0xae, 0x41, 0x2f, 0xe7, 0x78, 0x07, 0x53, 0x96, 0x36, 0x9c, 0xc4, 0x48, 0x8c, 0xf1,
+	0xff, 0x72, 0x50, 0x53, 0x3e, 0xce, 0x3e, 0x82, 0x8a, 0x35, 0x75, 0x37, 0x68, 0x2b, 0x85, 0xe8,
+	0xde, 0x7e, 0xbc, 0xdf, 0x2c, 0x51, 0xc0, 0x83, 0x67, 0x54, 0xa5, 0x4f, 0xcd, 0xc0, 0x41, 0xb5,
+	0x1c, 0x36, 0x73, 0x6a, 0x54, 0x36, 0xb4, 0xa3, 0x47, 0x31, 0x06, 0xdf, 0x80, 0x85, 0x4a, 0x9d,
+	0xbd, 0x8d, 0x57, 0x8d, 0xed, 0x85, 0x19, 0xd8, 0x72, 0xec, 0xe4, 0x69, 0xe5, 0xa1, 0x00, 0xe2,
+	0x93, 0x30, 0x89, 0x47, 0x52, 0xfb, 0xcc, 0x9e, 0x2e, 0x23, 0xbb, 0x59, 0x50, 0x49, 0x3b, 0x02,
+	0x88, 0xa4, 0x12, 0xcf, 0x76, 0x31, 0x14, 0x36, 0x5d, 0xd7, 0x27, 0x05, 0x5d, 0x54, 0x23, 0xec,
+	0xfd, 0x04, 0x2e, 0xde, 0x93, 0xc5, 0x35, 0xe3, 0x18, 0xca, 0xb2, 0x63, 0xe8, 0xef, 0xe2, 0x0d,
+	0xbe, 0x47, 0x2d, 0xde, 0xc5, 0xb8, 0x43, 0x1e, 0x7b, 0x1d, 0xf0, 0x56, 0x5f, 0xaa, 0x37, 0xde,
+	0x79, 0x34, 0xf8, 0x1a, 0xdf, 0x47, 0xd0, 0xf9, 0x64, 0xff, 0x1b, 0x3d, 0x2f, 0x62, 0x8b, 0xce,
+	0x61, 0x8b, 0xa3, 0x76, 0xab, 0x41, 0xb9, 0xf3, 0x9b, 0x4e, 0xfb, 0x68, 0xd4, 0xd1, 0x8b, 0xb8,
+	0x83, 0xf6, 0x3b, 0xad, 0x5e, 0x6f, 0xd0, 0x46, 0xd5, 0x57, 0xda, 0xab, 0xe2, 0xd5, 0x1a, 0x1a,
+	0x49, 0xe3, 0xdf, 0x36, 0x60, 0x2b, 0xbb, 0x4a, 0xd8, 0xa7, 0x50, 0xb1, 0xac, 0xcc, 0x0c, 0xdc,
+	0xdc, 0xb4, 0x9a, 0xee, 0xed, 0x5b, 0xf1, 0x24, 0x88, 0x02, 0x66, 0xd1, 0xc4, 0x9a, 0xce, 0xad,
+	0xad, 0xe9, 0x78, 0x45, 0xff, 0x12, 0xb6, 0xe5, 0xa5, 0x61, 0xcc, 0x3c, 0x4c, 0xcc, 0xd0, 0xce,
+	0x2e, 0xd8, 0x36, 0x21, 0xf7, 0x25, 0xee, 0xc1, 0x05, 0xbe, 0x35, 0xcd, 0x40, 0xd8, 0x2f, 0x60,
+	0xcb, 0x24, 0xef, 0x36, 0xe1, 0x2f, 0xa8, 0xce, 0x69, 0x0b, 0x71, 0x0a, 0x7b, 0xc3, 0x54, 0x01,
+	0xb8, 0x4c, 0xac, 0xc0, 0x5f, 0xa4, 0xcc, 0x45, 0x75, 0x99, 0xec, 0x07, 0xfe, 0x42, 0xe1, 0xad,
+	0x5b, 0x4a, 0x9d, 0x7d, 0x02, 0x75, 0x29, 0x79, 0xfa, 0x40, 0x35, 0xd9, 0x3d, 0x42, 0x6c, 0xf2,
+	0x08, 0xf0, 0xe5, 0xe3, 0x34, 0xad, 0xb2, 0x0f, 0xa1, 0x26, 0x04, 0x16, 0x6c, 0x65, 0x75, 0x25,
+	0x90, 0xb4, 0x31, 0x17, 0x98, 0x49, 0x8d, 0xbd, 0x0f, 0x40, 0x72, 0xaa, 0xa7, 0x57, 0xdb, 0xa9,
+	0x90, 0x31, 0x4b, 0xd5, 0x8a, 0x2b, 0x8a, 0x78, 0xe2, 0x76, 0x47, 0x75, 0x5d, 0x3c, 0x0a, 0x3e,
+	0x52, 0xf1, 0xa8, 0x9a, 0x8a, 0x27, 0xd8, 0x60, 0x4d, 0xbc, 0x98, 0x0b, 0xcc, 0xa4, 0x96, 0x88,
+	0x27, 0x78, 0x6a, 0xab, 0xe2, 0xc5, 0x2c, 0x55, 0x2b, 0xae, 0xe0, 0xb4, 0xc5, 0xde, 0x8a, 0xec,
+	0x54, 0x3d, 0x73, 0xcd, 0x48, 0xe2, 0xe2, 0x8e, 0x35, 0x22, 0x15, 0x80, 0xdc, 0xe1, 0x13, 0xff,
+	0x54, 0xd9, 0xde, 0x0d, 0x95, 0x7b, 0xf8, 0xc4, 0x3f, 0x55, 0xf7, 0x77, 0x23, 0x54, 0x01, 0x28,
+	0xad, 0xe8, 0x22, 0xdd, 0xd2, 0xda, 0x52, 0xa5, 0xa5, 0x1e, 0xe2, 0xbd, 0x1a, 0x94, 0xd6, 0x8c,
+	0x2b, 0x38, 0x28, 0x74, 0x75, 0x23, 0x12, 0x1f, 0xdb, 0x56, 0x07, 0x85, 0x2e, 0xac, 0xc4, 0x5f,
+	0x02, 0x37, 0xa9, 0xe1, 0xda, 0x5a, 0x7a, 0x2a, 0x9b, 0xae, 0xae, 0xad, 0x23, 0x2f, 0xc3, 0x58,
+	0x17, 0xa4, 0x92, 0x35, 0xdd, 0x15, 0xa1, 0xfd, 0xdd, 0xd2, 0xc6, 0x88, 0xe9, 0xe2, 0xfa, 0xae,
+	0x18, 0x4a, 0x5c, 0xba, 0x2b, 0x62, 0x48, 0xb2, 0xae, 0x13, 0x76, 0xb6, 0xba, 0xae, 0x15, 0xe6,
+	0xba, 0xa5, 0xd4, 0xd3, 0x0d, 0x95, 0xf0, 0x5e, 0x5a, 0xdb, 0x50, 0x0a, 0x73, 0xc3, 0x54, 0x01,
+	0xc6, 0xff, 0x2d, 0x40, 0x59, 0xea, 0x01, 0x7c, 0x7d, 0xd5, 0xe6, 0x9d, 0xd6, 0xa8, 0x33, 0xde,
+	0x6f, 0x8d, 0x5a, 0x7b, 0xad, 0x21, 0xda, 0x72, 0x06, 0x5b, 0x2d, 0x4c, 0x3d, 0xa4, 0x30, 0x0d,
+	0x95, 0xdb, 0x3e, 0x1f, 0x1c, 0xa6, 0xa0, 0x1c, 0xbe, 0xe5, 0x92, 0xbc, 0xe2, 0xdd, 0x57, 0x1e,
+	0x6f, 0x2a, 0x08, 0x46, 0x01, 0xa0, 0xdb, 0x16, 0xc4, 0x25, 0xea, 0x45, 0x85, 0xa5, 0xdb, 0xdf,
+	0xef, 0xfc, 0x46, 0x2f, 0xa5, 0x2c, 0x02, 0x50, 0x4e, 0x58, 0x44, 0xbd, 0x82, 0xc2, 0x8c, 0xf8,
+	0x51, 0xbf, 0x9d, 0x7e, 0xa7, 0x8a, 0x4c, 0xb2, 0x99, 0x47, 0xdd, 0xce, 0x63, 0x1d, 0x90, 0x49,
+	0xb4, 0x42, 0xf5, 0x1a, 0x7a, 0x23, 0xd4, 0x08, 0x55, 0xeb, 0xec, 0x1a, 0x5c, 0x1a, 0x3e, 0x18,
+	0x3c, 0x1e, 0x0b, 0xa6, 0xa4, 0x0b, 0x0d, 0x76, 0x19, 0x74, 0x05, 0x21, 0x9a, 0xdf, 0xc2, 0x4f,
+	0x12, 0x34, 0x26, 0x1c, 0xea, 0xdb, 0xf8, 0x49, 0x82, 0x8d, 0x84, 0x6a, 0xd7, 0xb1, 0x2b, 0x82,
+	0x75, 0xd0, 0x3b, 0x7a, 0xd8, 0x1f, 0xea, 0x17, 0x51, 0x08, 0x82, 0x08, 0xc9, 0x59, 0xd2, 0x4c,
+	0x6a, 0x10, 0x2e, 0x91, 0x8d, 0x40, 0xd8, 0xe3, 0x16, 0xef, 0x77, 0xfb, 0x07, 0x43, 0xfd, 0x72,
+	0xd2, 0x72, 0x87, 0xf3, 0x01, 0x1f, 0xea, 0x57, 0x12, 0xc0, 0x70, 0xd4, 0x1a, 0x1d, 0x0d, 0xf5,
+	0xab, 0x89, 0x94, 0x87, 0x7c, 0xd0, 0xee, 0x0c, 0x87, 0xbd, 0xee, 0x70, 0xa4, 0x5f, 0xc3, 0x4c,
+	0x54, 0x2a, 0x51, 0x4c, 0xdc, 0x54, 0x04, 0xe5, 0x07, 0x9d, 0x91, 0x7e, 0x3d, 0x11, 0xa3, 0x3d,
+	0xe8, 0xe1, 0x93, 0xbc, 0x41, 0x5f, 0xbf, 0x81, 0x44, 0xbd, 0x41, 0xfb, 0xeb, 0xb8, 0x37, 0xaf,
+	0xa0, 0x5c, 0x47, 0x7d, 0x15, 0x74, 0x53, 0x59, 0x1a, 0xc3, 0xce, 0xaf, 0x8f, 0x3a, 0xfd, 0x76,
+	0x47, 0x7f, 0x35, 0x5d, 0x1a, 0x09, 0xec, 0x56, 0xb2, 0x34, 0x12, 0xd0, 0x6b, 0xc9, 0x37, 0x63,
+	0xd0, 0x50, 0xdf, 0xd9, 0xab, 0xd3, 0xdb, 0x6c, 0x69, 0x88, 0x8c, 0xaf, 0x80, 0xa9, 0x6f, 0x28,
+	0xe5, 0xfb, 0x14, 0x06, 0x85, 0x59, 0xe0, 0xcf, 0xe3, 0x4b, 0x5b, 0x58, 0xa6, 0xf4, 0xee, 0x72,
+	0x42, 0xa7, 0xfb, 0xe9, 0x2d, 0x22, 0x15, 0x64, 0xfc, 0x89, 0x06, 0x5b, 0x59, 0x23, 0x84, 0xe7,
+	0x2a, 0xce, 0x6c, 0x8c, 0xb9, 0x5b, 0x7a, 0x43, 0x11, 0xca, 0xd4, 0x43, 0xcd, 0x99, 0xf5, 0xfd,
+	0x88, 0x1e, 0x51, 0x50, 0x40, 0x93, 0xd8, 0x14, 0xd1, 0x6a, 0x52, 0x67, 0x5d, 0xb8, 0x94, 0x79,
+	0x36, 0x9a, 0x79, 0xc1, 0xd2, 0x4c, 0xde, 0xdd, 0xad, 0xc8, 0xcf, 0x59, 0xb8, 0x06, 0x33, 0x1e,
+	0x40, 0x23, 0x63, 0xe1, 0x28, 0x25, 0x32, 0xcb, 0xca, 0x55, 0x71, 0x66, 0xcf, 0x17, 0xca, 0x38,
+	0x80, 0xba, 0x6a, 0xee, 0x5e, 0xbe, 0xa1, 0xd7, 0xa0, 0x7a, 0xff, 0x24, 0x7e, 0x50, 0xa3, 0xbe,
+	0xe9, 0xa9, 0xca, 0x7b, 0x5e, 0xff, 0x3b, 0x07, 0x35, 0xc5, 0x3e, 0xbe, 0xd0, 0x70, 0xde, 0x84,
+	0x6a, 0x64, 0xcf, 0x17, 0x7e, 0x60, 0x4a, 0x6f, 0xa2, 0xc2, 0x53, 0x40, 0x46, 0x9c, 0xfc, 0xca,
+	0x60, 0x67, 0x72, 0x65, 0x85, 0xe7, 0xe4, 0xca, 0x3e, 0x88, 0xd3, 0xc6, 0x52, 0x63, 0x17, 0x37,
+	0x1f, 0xb2, 0xa4, 0x4f, 0x6a, 0x42, 0xbc, 0xcb, 0x3b, 0x3b, 0x19, 0x5b, 0x13, 0x71, 0x9f, 0xb8,
+	0x8a, 0x57, 0x52, 0xf7, 0x27, 0x74, 0xdb, 0x6f, 0x96, 0x28, 0xfe, 0x32, 0x61, 0x2a, 0xb3, 0x58,
+	0xbd, 0xdf, 0x81, 0xf2, 0xec, 0x44, 0x3c, 0x2d, 0xa9, 0xa8, 0x01, 0x7e, 0x32, 0x6e, 0xbc, 0x34,
+	0x3b, 0xa1, 0x67, 0x26, 0x9f, 0x83, 0xbe, 0x72, 0x0f, 0x39, 0x6c, 0x56, 0x37, 0x0a, 0xb5, 0x9d,
+	0xbd, 0x93, 0x1c, 0x1a, 0xff, 0x5e, 0x83, 0xad, 0xd4, 0x9f, 0xc0, 0xb9, 0x65, 0x77, 0xc5, 0x33,
+	0x41, 0xe1, 0xc3, 0x35, 0x57, 0x5d, 0x0e, 0x24, 0xc1, 0x57, 0x83, 0xe2, 0xd1, 0xe0, 0xa6, 0xcb,
+	0xc8, 0x9b, 0x5e, 0x19, 0xe5, 0x37, 0xbd, 0x32, 0x32, 0x0e, 0x20, 0x3f, 0x3a, 0x5f, 0x88, 0x30,
+	0x12, 0x55, 0x98, 0x70, 0x57, 0x85, 0xf2, 0xa2, 0x14, 0xe8, 0xd7, 0x9d, 0x6f, 0xc4, 0x0d, 0xba,
+	0x43, 0xde, 0x7d, 0xd8, 0xe2, 0xdf, 0x8c, 0x11, 0x40, 0x4a, 0xfe, 0xfe, 0x80, 0x77, 0xba, 0x07,
+	0x7d, 0x02, 0x14, 0x28, 0xc8, 0x4c, 0x45, 0x6c, 0x59, 0xd6, 0xfd, 0x13, 0xf5, 0x6d, 0xb3, 0x96,
+	0x79, 0xdb, 0x9c, 0x5c, 0x79, 0x56, 0x9f, 0x54, 0x45, 0xb1, 0x50, 0xc9, 0x62, 0xcc, 0xa7, 0x8b,
+	0x11, 0x2f, 0x2e, 0xe3, 0x1d, 0xe2, 0xac, 0xd3, 0x98, 0xbd, 0x64, 0x4c, 0x04, 0xc6, 0xf7, 0x1a,
+	0xb0, 0x8c, 0x20, 0xc2, 0x8f, 0x79, 0x59, 0x59, 0x3e, 0x85, 0xa6, 0x7c, 0x2e, 0x23, 0xa8, 0xe4,
+	0x6b, 0xc6, 0x31, 0xca, 0x22, 0x86, 0xf4, 0x8a, 0xc0, 0xd3, 0xe7, 0xd2, 0x9b, 0xd4, 0xec, 0x3d,
+	0x10, 0xaf, 0xa5, 0xf0, 0x58, 0x2b, 0x1b, 0xb1, 0x29, 0x7b, 0x8a, 0xa7, 0x34, 0x78, 0x48, 0xaf,
+	0x4e, 0x9a, 0x78, 0xf6, 0x55, 0xa4, 0x2d, 0xb4, 0x9d, 0xce, 0x1a, 0xed, 0x33, 0xe3, 0xef, 0x6b,
+	0x70, 0x29, 0xbb, 0x20, 0xfe, 0xb0, 0x5e, 0x66, 0xdf, 0xb8, 0xe5, 0x57, 0xdf, 0xb8, 0x6d, 0x5a,
+	0x4f, 0x85, 0x8d, 0xeb, 0xe9, 0xef, 0x68, 0x70, 0x59, 0x19, 0xfd, 0xd4, 0xf3, 0xfc, 0x2b, 0x92,
+	0x4c, 0x79, 0xea, 0x56, 0xc8, 0x3c, 0x75, 0x33, 0xfe, 0x24, 0x0f, 0x90, 0x4a, 0x92, 0x51, 0x3d,
+	0xda, 0x0f, 0xa9, 0x9e, 0x17, 0xb8, 0xa0, 0xe7, 0x84, 0xe3, 0xec, 0x49, 0x62, 0x3e, 0x7e, 0x99,
+	0xa2, 0x9e, 0x22, 0xb2, 0x0f, 0xa0, 0x2c, 0x32, 0x30, 0x71, 0x42, 0xed, 0xda, 0xea, 0x4e, 0xbe,
+	0x27, 0x9f, 0x8d, 0xc5, 0x74, 0x37, 0xfe, 0x42, 0x83, 0x92, 0x80, 0xd1, 0x1d, 0xf1, 0xc0, 0x8f,
+	0x5f, 0xb1, 0x5f, 0xde, 0xa4, 0x04, 0xe8, 0x27, 0x64, 0x50, 0x5f, 0xdc, 0x83, 0x92, 0x69, 0x59,
+	0xe3, 0xd9, 0x49, 0x36, 0x6b, 0xb5, 0xb2, 0x1f, 0x31, 0x3d, 0x61, 0x62, 0x81, 0x7d, 0x0a, 0x55,
+	0xa4, 0x17, 0x51, 0x40, 0xc6, 0x9c, 0xad, 0xef, 0x1c, 0x4c, 0x42, 0x99, 0xb2, 0xcc, 0xbe, 0xcc,
+	0x06, 0x1d, 0x62, 0x59, 0xdf, 0x58, 0x63, 0x7d, 0x46, 0xf8, 0xa1, 0xe4, 0xa4, 0xfe, 0x45, 0x0e,
+	0xaa, 0x49, 0x40, 0xf4, 0xd2, 0x36, 0x2c, 0xfd, 0xd5, 0xa1, 0xbc, 0xfa, 0xab, 0x43, 0x2b, 0x3b,
+	0x49, 0xbc, 0xf4, 0x29, 0x90, 0x32, 0xd9, 0xce, 0xae, 0xd7, 0x70, 0xfd, 0x54, 0xb8, 0xf8, 0x82,
+	0xa7, 0xc2, 0xd7, 0x41, 0xac, 0x09, 0xbc, 0x93, 0x52, 0xa2, 0xd7, 0x21, 0x65, 0xaa, 0x77, 0xad,
+	0xd5, 0x77, 0x8b, 0xe5, 0x9d, 0xfc, 0xca, 0xbb, 0xc5, 0x67, 0x3e, 0x47, 0xaa, 0x3c, 0xfb, 0x39,
+	0xd2, 0x77, 0x50, 0x4d, 0x82, 0x9e, 0x97, 0x1f, 0xb0, 0x1f, 0x63, 0x65, 0x8d, 0x3f, 0x8e, 0x3d,
+	0xaa, 0x24, 0xe6, 0xf8, 0x43, 0x3d, 0xaa, 0xcc, 0xe7, 0xf3, 0xcf, 0xf9, 0xfc, 0x99, 0xf0, 0x74,
+	0x92, 0x8f, 0xff, 0xc4, 0xab, 0x44, 0x9d, 0xc0, 0x42, 0x66, 0x02, 0x8d, 0x6d, 0xe9, 0xad, 0x25,
+	0xd1, 0xd2, 0xbf, 0xd3, 0x62, 0x57, 0x28, 0x79, 0x4a, 0xf1, 0x4c, 0x6d, 0x92, 0x7c, 0x2d, 0xa7,
+	0x7e, 0xed, 0xa5, 0xed, 0xc8, 0x5b, 0x50, 0x54, 0x37, 0xdb, 0x06, 0x1b, 0x22, 0xf0, 0xab, 0x0f,
+	0x86, 0x8b, 0xab, 0x0f, 0x86, 0x0d, 0x43, 0x2a, 0x44, 0xd1, 0x85, 0xcb, 0x71, 0xbb, 0xf1, 0x63,
+	0x67, 0xac, 0xa0, 0x19, 0xaf, 0xa6, 0xe6, 0xe4, 0xc7, 0x77, 0xf3, 0x27, 0x33, 0x24, 0xdf, 0x6b,
+	0xd0, 0xc8, 0x24, 0x17, 0x5e, 0x42, 0x98, 0x8d, 0x7a, 0x20, 0xff, 0x82, 0x7a, 0xa0, 0xf0, 0x12,
+	0x7a, 0xa0, 0xf8, 0x83, 0x7a, 0xa0, 0xb4, 0xaa, 0x07, 0x8c, 0xbf, 0xa7, 0x25, 0x6f, 0x69, 0x45,
+	0x63, 0x9b, 0x8c, 0x8b, 0xb6, 0xd1, 0xb8, 0xdc, 0x4a, 0x7e, 0x56, 0xa6, 0xbb, 0x2f, 0x4e, 0x7a,
+	0x1a, 0x5c, 0x81, 0xb0, 0xcf, 0xe1, 0xba, 0xc8, 0xd3, 0x0a, 0x55, 0x3d, 0xf6, 0x67, 0xf1, 0x2f,
+	0xda, 0x74, 0xe3, 0x9b, 0xf0, 0x57, 0x05, 0x81, 0x78, 0xfc, 0x3d, 0x4b, 0x7f, 0xda, 0xa6, 0x0b,
+	0x8d, 0x4c, 0x62, 0x46, 0xf9, 0xf5, 0x29, 0x4d, 0xfd, 0xf5, 0x29, 0x3c, 0x52, 0x3a, 0x7d, 0x62,
+	0x07, 0xf6, 0x86, 0xdf, 0x8c, 0x11, 0x08, 0xfc, 0x85, 0x0e, 0x35, 0x85, 0xcb, 0xde, 0x81, 0xa2,
+	0x13, 0xd9, 0xf3, 0xf8, 0x79, 0xc9, 0xd5, 0xf5, 0x2c, 0x2f, 0x1d, 0xf0, 0x0a, 0x22, 0xe3, 0xf7,
+	0xf8, 0x1b, 0x3b, 0x2b, 0x38, 0xe5, 0x27, 0xb2, 0xb4, 0x67, 0xfc, 0x44, 0x56, 0x2e, 0x23, 0xe4,
+	0x86, 0x9f, 0xb9, 0x4a, 0xef, 0x80, 0x17, 0x9e, 0x71, 0x07, 0x1c, 0xcf, 0xc0, 0x03, 0x9b, 0x7e,
+	0x96, 0xc8, 0x6a, 0x16, 0xd7, 0x88, 0x12, 0x9c, 0xf1, 0x77, 0x35, 0x28, 0xcb, 0x7c, 0xf3, 0xc6,
+	0xc7, 0x46, 0x6f, 0x43, 0x59, 0xfc, 0x44, 0x51, 0x7c, 0xa0, 0xbd, 0x76, 0x64, 0x19, 0xe3, 0xf1,
+	0x19, 0x0d, 0xa2, 0xb2, 0x8f, 0x43, 0x28, 0x5b, 0x4f, 0x70, 0x5c, 0x4d, 0x74, 0x08, 0x47, 0xf9,
+	0xdd, 0x50, 0x9e, 0xed, 0x02, 0x81, 0x30, 0x8b, 0x13, 0x1a, 0x5f, 0x42, 0x59, 0xe6, 0xb3, 0x37,
+	0x8a, 0xf2, 0xbc, 0x1f, 0xf8, 0xd9, 0x01, 0x48, 0x13, 0xdc, 0x9b, 0x5a, 0x30, 0x5c, 0xf9, 0xbc,
+	0x0a, 0x13, 0x62, 0xe4, 0xb2, 0xbe, 0x87, 0xbf, 0x12, 0x22, 0x1f, 0x8c, 0x69, 0xcf, 0x7e, 0x30,
+	0x96, 0x10, 0xb1, 0xbb, 0x90, 0xa8, 0xf7, 0xe7, 0x39, 0x5a, 0x46, 0x0b, 0x20, 0xcd, 0xbc, 0xe1,
+	0x1b, 0xe3, 0xe4, 0xd9, 0x59, 0xbc, 0x7c, 0x56, 0x3f, 0x86, 0x32, 0x71, 0x85, 0xcc, 0xd8, 0x82,
+	0xba, 0x9a, 0xbe, 0xbb, 0xfb, 0x3a, 0xd4, 0xd5, 0xdf, 0x64, 0xa1, 0x93, 0x2b, 0xdf, 0xb3, 0xc5,
+	0xab, 0xa1, 0xde, 0x6f, 0x3f, 0xd2, 0xb5, 0xbb, 0x7f, 0xac, 0xbc, 0xa0, 0x25, 0x1a, 0x19, 0x03,
+	0xd1, 0xd5, 0xa2, 0x5e, 0xb7, 0xdf, 0x69, 0x71, 0x8a, 0x78, 0xe8, 0x7d, 0xd1, 0x83, 0xd6, 0xf0,
+	0x81, 0x88, 0x8e, 0x24, 0x86, 0x00, 0xf9, 0xf4, 0xa1, 0x0b, 0x5d, 0x25, 0xa2, 0x62, 0x92, 0x22,
+	0x2a, 0x22, 0x23, 0x65, 0x6f, 0x4a, 0x98, 0x3e, 0xc2, 0x52, 0x82, 0x2b, 0xdf, 0xfd, 0x15, 0x34,
+	0x9f, 0x75, 0x24, 0x85, 0xad, 0xb6, 0x1f, 0xb4, 0xe8, 0xd8, 0xaf, 0x0e, 0x95, 0xfe, 0x60, 0x2c,
+	0x6a, 0x1a, 0x1e, 0x19, 0xf0, 0x4e, 0xaf, 0x43, 0x09, 0xb9, 0xbb, 0xbf, 0xd3, 0x94, 0x59, 0x8a,
+	0x8f, 0x24, 0x12, 0x80, 0xec, 0xae, 0x0a, 0xe2, 0xb6, 0x69, 0xe9, 0x1a, 0xbb, 0x0a, 0x2c, 0x03,
+	0xea, 0xf9, 0x53, 0xd3, 0xd5, 0x73, 0x94, 0x7a, 0x8b, 0xe1, 0x8f, 0x03, 0x27, 0xb2, 0xf5, 0x3c,
+	0x7b, 0x15, 0xae, 0x27, 0xb0, 0x9e, 0x7f, 0x7a, 0x18, 0x38, 0xf8, 0x6c, 0xfb, 0x5c, 0xa0, 0x0b,
+	0x7b, 0xbf, 0xfc, 0x0f, 0xdf, 0xdf, 0xd2, 0xfe, 0xf3, 0xf7, 0xb7, 0xb4, 0xff, 0xf9, 0xfd, 0xad,
+	0x0b, 0xbf, 0xff, 0x5f, 0xb7, 0xb4, 0xbf, 0xae, 0xfe, 0xc2, 0xe5, 0xdc, 0x8c, 0x02, 0xe7, 0x4c,
+	0x18, 0xbb, 0xb8, 0xe2, 0xd9, 0xef, 0x2d, 0x4e, 0x8e, 0xdf, 0x5b, 0x4c, 0xde, 0xc3, 0x19, 0x9d,
+	0x94, 0xe8, 0x77, 0x2d, 0x3f, 0xfc, 0xff, 0x03, 0x00, 0x76, 0xec, 0xd9, 0x08, 0x2b, 0x53, 0x00,
+	0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -8943,6 +9541,13 @@ func (m *Type) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Enumvalues) > 0 {
+		i -= len(m.Enumvalues)
+		copy(dAtA[i:], m.Enumvalues)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Enumvalues)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Table) > 0 {
 		i -= len(m.Table)
 		copy(dAtA[i:], m.Table)
@@ -10504,6 +11109,18 @@ func (m *Default) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Generated != nil {
+		{
+			size, err := m.Generated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NullAbility {
 		i--
 		if m.NullAbility {
@@ -10536,7 +11153,7 @@ func (m *Default) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *OnUpdate) Marshal() (dAtA []byte, err error) {
+func (m *GeneratedCol) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10546,12 +11163,12 @@ func (m *OnUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OnUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *GeneratedCol) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OnUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GeneratedCol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10560,6 +11177,16 @@ func (m *OnUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Stored {
+		i--
+		if m.Stored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.OriginString) > 0 {
 		i -= len(m.OriginString)
 		copy(dAtA[i:], m.OriginString)
@@ -10582,7 +11209,53 @@ func (m *OnUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *IndexOption) Marshal() (dAtA []byte, err error) {
+func (m *OnUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Expr != nil {
+		{
+			size, err := m.Expr.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexOption) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10689,20 +11362,20 @@ func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Cols) > 0 {
-		dAtA27 := make([]byte, len(m.Cols)*10)
-		var j26 int
+		dAtA29 := make([]byte, len(m.Cols)*10)
+		var j28 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j26++
+				j28++
 			}
-			dAtA27[j26] = uint8(num)
-			j26++
+			dAtA29[j28] = uint8(num)
+			j28++
 		}
-		i -= j26
-		copy(dAtA[i:], dAtA27[:j26])
-		i = encodeVarintPlan(dAtA, i, uint64(j26))
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintPlan(dAtA, i, uint64(j28))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -10733,6 +11406,18 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IndexAlgoLists != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.IndexAlgoLists))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.IndexAlgo) > 0 {
+		i -= len(m.IndexAlgo)
+		copy(dAtA[i:], m.IndexAlgo)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAlgo)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Option != nil {
 		{
 			size, err := m.Option.MarshalToSizedBuffer(dAtA[:i])
@@ -10850,20 +11535,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x28
 	}
 	if len(m.ForeignCols) > 0 {
-		dAtA30 := make([]byte, len(m.ForeignCols)*10)
-		var j29 int
+		dAtA32 := make([]byte, len(m.ForeignCols)*10)
+		var j31 int
 		for _, num := range m.ForeignCols {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j31++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA32[j31] = uint8(num)
+			j31++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintPlan(dAtA, i, uint64(j29))
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintPlan(dAtA, i, uint64(j31))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -10873,20 +11558,20 @@ func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.Cols) > 0 {
-		dAtA32 := make([]byte, len(m.Cols)*10)
-		var j31 int
+		dAtA34 := make([]byte, len(m.Cols)*10)
+		var j33 int
 		for _, num := range m.Cols {
 			for num >= 1<<7 {
-				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j31++
+				j33++
 			}
-			dAtA32[j31] = uint8(num)
-			j31++
+			dAtA34[j33] = uint8(num)
+			j33++
 		}
-		i -= j31
-		copy(dAtA[i:], dAtA32[:j31])
-		i = encodeVarintPlan(dAtA, i, uint64(j31))
+		i -= j33
+		copy(dAtA[i:], dAtA34[:j33])
+		i = encodeVarintPlan(dAtA, i, uint64(j33))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10924,6 +11609,23 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Enforced {
+		i--
+		if m.Enforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OriginString) > 0 {
+		i -= len(m.OriginString)
+		copy(dAtA[i:], m.OriginString)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OriginString)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Check != nil {
 		{
 			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
@@ -11604,20 +12306,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA42 := make([]byte, len(m.RefChildTbls)*10)
-		var j41 int
+		dAtA44 := make([]byte, len(m.RefChildTbls)*10)
+		var j43 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j41++
+				j43++
 			}
-			dAtA42[j41] = uint8(num)
-			j41++
+			dAtA44[j43] = uint8(num)
+			j43++
 		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintPlan(dAtA, i, uint64(j41))
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintPlan(dAtA, i, uint64(j43))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -12025,6 +12727,116 @@ func (m *OrderBySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrameBound) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Val != nil {
+		{
+			size, err := m.Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Unbounded {
+		i--
+		if m.Unbounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameClause) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameClause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameClause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -12049,6 +12861,18 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Frame != nil {
+		{
+			size, err := m.Frame.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Lag != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
 		i--
@@ -12144,21 +12968,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA49 := make([]byte, len(m.IdxIdx)*10)
-		var j48 int
+		dAtA55 := make([]byte, len(m.IdxIdx)*10)
+		var j54 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j54++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA55[j54] = uint8(num)
+			j54++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPlan(dAtA, i, uint64(j48))
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintPlan(dAtA, i, uint64(j54))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12203,21 +13027,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA52 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j51 int
+		dAtA58 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j57 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j57++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPlan(dAtA, i, uint64(j51))
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintPlan(dAtA, i, uint64(j57))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12403,21 +13227,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA56 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j55 int
+		dAtA62 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j61 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j55++
+				j61++
 			}
-			dAtA56[j55] = uint8(num)
-			j55++
+			dAtA62[j61] = uint8(num)
+			j61++
 		}
-		i -= j55
-		copy(dAtA[i:], dAtA56[:j55])
-		i = encodeVarintPlan(dAtA, i, uint64(j55))
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintPlan(dAtA, i, uint64(j61))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -12436,21 +13260,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA58 := make([]byte, len(m.IdxIdx)*10)
-		var j57 int
+		dAtA64 := make([]byte, len(m.IdxIdx)*10)
+		var j63 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j57++
+				j63++
 			}
-			dAtA58[j57] = uint8(num)
-			j57++
+			dAtA64[j63] = uint8(num)
+			j63++
 		}
-		i -= j57
-		copy(dAtA[i:], dAtA58[:j57])
-		i = encodeVarintPlan(dAtA, i, uint64(j57))
+		i -= j63
+		copy(dAtA[i:], dAtA64[:j63])
+		i = encodeVarintPlan(dAtA, i, uint64(j63))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12648,6 +13472,67 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IndexLookup != nil {
+		{
+			size, err := m.IndexLookup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.SnapshotTs != nil {
+		{
+			size, err := m.SnapshotTs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.PartitionPrune != nil {
+		{
+			size, err := m.PartitionPrune.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxRecursionDepth != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.MaxRecursionDepth))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.UnionAll {
+		i--
+		if m.UnionAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.SourceStep != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.SourceStep))
 		i--
@@ -12740,21 +13625,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA63 := make([]byte, len(m.BindingTags)*10)
-		var j62 int
+		dAtA72 := make([]byte, len(m.BindingTags)*10)
+		var j71 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j71++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA72[j71] = uint8(num)
+			j71++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j71
+		copy(dAtA[i:], dAtA72[:j71])
+		i = encodeVarintPlan(dAtA, i, uint64(j71))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -13007,21 +13892,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA73 := make([]byte, len(m.Children)*10)
-		var j72 int
+		dAtA82 := make([]byte, len(m.Children)*10)
+		var j81 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j81++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA82[j81] = uint8(num)
+			j81++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintPlan(dAtA, i, uint64(j72))
+		i -= j81
+		copy(dAtA[i:], dAtA82[:j81])
+		i = encodeVarintPlan(dAtA, i, uint64(j81))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -13050,6 +13935,190 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IndexLookup) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexLookup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexLookup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ivfflat != nil {
+		{
+			size, err := m.Ivfflat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Pk != nil {
+		{
+			size, err := m.Pk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FilterList) > 0 {
+		for iNdEx := len(m.FilterList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FilterList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TableDef != nil {
+		{
+			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjRef != nil {
+		{
+			size, err := m.ObjRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IvfflatLookup) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IvfflatLookup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IvfflatLookup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Probes != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Probes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Distance != nil {
+		{
+			size, err := m.Distance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionPrune) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionPrune) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionPrune) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SelectedPartitions) > 0 {
+		for iNdEx := len(m.SelectedPartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelectedPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.IsPruned {
+		i--
+		if m.IsPruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IdList) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -13075,21 +14144,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA76 := make([]byte, len(m.List)*10)
-		var j75 int
+		dAtA90 := make([]byte, len(m.List)*10)
+		var j89 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA90[j89] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j75++
+				j89++
 			}
-			dAtA76[j75] = uint8(num)
-			j75++
+			dAtA90[j89] = uint8(num)
+			j89++
 		}
-		i -= j75
-		copy(dAtA[i:], dAtA76[:j75])
-		i = encodeVarintPlan(dAtA, i, uint64(j75))
+		i -= j89
+		copy(dAtA[i:], dAtA90[:j89])
+		i = encodeVarintPlan(dAtA, i, uint64(j89))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13231,21 +14300,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnCascadeIdx) > 0 {
-		dAtA78 := make([]byte, len(m.OnCascadeIdx)*10)
-		var j77 int
+		dAtA92 := make([]byte, len(m.OnCascadeIdx)*10)
+		var j91 int
 		for _, num1 := range m.OnCascadeIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j91++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA92[j91] = uint8(num)
+			j91++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintPlan(dAtA, i, uint64(j77))
+		i -= j91
+		copy(dAtA[i:], dAtA92[:j91])
+		i = encodeVarintPlan(dAtA, i, uint64(j91))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -13264,21 +14333,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA80 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j79 int
+		dAtA94 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j93 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j79++
+				j93++
 			}
-			dAtA80[j79] = uint8(num)
-			j79++
+			dAtA94[j93] = uint8(num)
+			j93++
 		}
-		i -= j79
-		copy(dAtA[i:], dAtA80[:j79])
-		i = encodeVarintPlan(dAtA, i, uint64(j79))
+		i -= j93
+		copy(dAtA[i:], dAtA94[:j93])
+		i = encodeVarintPlan(dAtA, i, uint64(j93))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13297,21 +14366,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA82 := make([]byte, len(m.IdxIdx)*10)
-		var j81 int
+		dAtA96 := make([]byte, len(m.IdxIdx)*10)
+		var j95 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j95++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA96[j95] = uint8(num)
+			j95++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintPlan(dAtA, i, uint64(j81))
+		i -= j95
+		copy(dAtA[i:], dAtA96[:j95])
+		i = encodeVarintPlan(dAtA, i, uint64(j95))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -13432,21 +14501,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA84 := make([]byte, len(m.Steps)*10)
-		var j83 int
+		dAtA98 := make([]byte, len(m.Steps)*10)
+		var j97 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j83++
+				j97++
 			}
-			dAtA84[j83] = uint8(num)
-			j83++
+			dAtA98[j97] = uint8(num)
+			j97++
 		}
-		i -= j83
-		copy(dAtA[i:], dAtA84[:j83])
-		i = encodeVarintPlan(dAtA, i, uint64(j83))
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintPlan(dAtA, i, uint64(j97))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15236,20 +16305,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA125 := make([]byte, len(m.ForeignTbl)*10)
-		var j124 int
+		dAtA139 := make([]byte, len(m.ForeignTbl)*10)
+		var j138 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA125[j124] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA139[j138] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j124++
+				j138++
 			}
-			dAtA125[j124] = uint8(num)
-			j124++
+			dAtA139[j138] = uint8(num)
+			j138++
 		}
-		i -= j124
-		copy(dAtA[i:], dAtA125[:j124])
-		i = encodeVarintPlan(dAtA, i, uint64(j124))
+		i -= j138
+		copy(dAtA[i:], dAtA139[:j138])
+		i = encodeVarintPlan(dAtA, i, uint64(j138))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -15685,20 +16754,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA131 := make([]byte, len(m.ForeignTbl)*10)
-		var j130 int
+		dAtA145 := make([]byte, len(m.ForeignTbl)*10)
+		var j144 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA131[j130] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA145[j144] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j130++
+				j144++
 			}
-			dAtA131[j130] = uint8(num)
-			j130++
+			dAtA145[j144] = uint8(num)
+			j144++
 		}
-		i -= j130
-		copy(dAtA[i:], dAtA131[:j130])
-		i = encodeVarintPlan(dAtA, i, uint64(j130))
+		i -= j144
+		copy(dAtA[i:], dAtA145[:j144])
+		i = encodeVarintPlan(dAtA, i, uint64(j144))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -15775,20 +16844,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA134 := make([]byte, len(m.AccountIDs)*10)
-		var j133 int
+		dAtA148 := make([]byte, len(m.AccountIDs)*10)
+		var j147 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA134[j133] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA148[j147] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j133++
+				j147++
 			}
-			dAtA134[j133] = uint8(num)
-			j133++
+			dAtA148[j147] = uint8(num)
+			j147++
 		}
-		i -= j133
-		copy(dAtA[i:], dAtA134[:j133])
-		i = encodeVarintPlan(dAtA, i, uint64(j133))
+		i -= j147
+		copy(dAtA[i:], dAtA148[:j147])
+		i = encodeVarintPlan(dAtA, i, uint64(j147))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -16000,21 +17069,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA138 := make([]byte, len(m.ParamTypes)*10)
-		var j137 int
+		dAtA152 := make([]byte, len(m.ParamTypes)*10)
+		var j151 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA138[j137] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA152[j151] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j137++
+				j151++
 			}
-			dAtA138[j137] = uint8(num)
-			j137++
+			dAtA152[j151] = uint8(num)
+			j151++
 		}
-		i -= j137
-		copy(dAtA[i:], dAtA138[:j137])
-		i = encodeVarintPlan(dAtA, i, uint64(j137))
+		i -= j151
+		copy(dAtA[i:], dAtA152[:j151])
+		i = encodeVarintPlan(dAtA, i, uint64(j151))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -16284,6 +17353,10 @@ func (m *Type) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.Enumvalues)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17064,6 +18137,33 @@ func (m *Default) ProtoSize() (n int) {
 	if m.NullAbility {
 		n += 2
 	}
+	if m.Generated != nil {
+		l = m.Generated.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GeneratedCol) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expr != nil {
+		l = m.Expr.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Stored {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17186,6 +18286,13 @@ func (m *IndexDef) ProtoSize() (n int) {
 		l = m.Option.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.IndexAlgo)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.IndexAlgoLists != 0 {
+		n += 1 + sovPlan(uint64(m.IndexAlgoLists))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17245,6 +18352,13 @@ func (m *CheckDef) ProtoSize() (n int) {
 		l = m.Check.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.OriginString)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Enforced {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17732,6 +18846,51 @@ func (m *OrderBySpec) ProtoSize() (n int) {
 	return n
 }
 
+func (m *FrameBound) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Unbounded {
+		n += 2
+	}
+	if m.Val != nil {
+		l = m.Val.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrameClause) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Start != nil {
+		l = m.Start.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *WindowSpec) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -17756,6 +18915,10 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if m.Lag != 0 {
 		n += 1 + sovPlan(uint64(m.Lag))
 	}
+	if m.Frame != nil {
+		l = m.Frame.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18147,12 +19310,104 @@ func (m *Node) ProtoSize() (n int) {
 	if m.SourceStep != 0 {
 		n += 2 + sovPlan(uint64(m.SourceStep))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UnionAll {
+		n += 3
 	}
-	return n
-}
-
+	if m.MaxRecursionDepth != 0 {
+		n += 2 + sovPlan(uint64(m.MaxRecursionDepth))
+	}
+	if m.PartitionPrune != nil {
+		l = m.PartitionPrune.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.SnapshotTs != nil {
+		l = m.SnapshotTs.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.IndexLookup != nil {
+		l = m.IndexLookup.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IndexLookup) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjRef != nil {
+		l = m.ObjRef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.TableDef != nil {
+		l = m.TableDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.FilterList) > 0 {
+		for _, e := range m.FilterList {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.Pk != nil {
+		l = m.Pk.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Ivfflat != nil {
+		l = m.Ivfflat.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IvfflatLookup) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Distance != nil {
+		l = m.Distance.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Probes != 0 {
+		n += 1 + sovPlan(uint64(m.Probes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionPrune) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPruned {
+		n += 2
+	}
+	if len(m.SelectedPartitions) > 0 {
+		for _, e := range m.SelectedPartitions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *IdList) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -19813,6 +21068,38 @@ func (m *Type) Unmarshal(dAtA []byte) error {
 			}
 			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enumvalues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enumvalues = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -23201,6 +24488,42 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NullAbility = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Generated == nil {
+				m.Generated = &GeneratedCol{}
+			}
+			if err := m.Generated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -23223,7 +24546,7 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OnUpdate) Unmarshal(dAtA []byte) error {
+func (m *GeneratedCol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23246,10 +24569,10 @@ func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: GeneratedCol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GeneratedCol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23320,6 +24643,26 @@ func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 			}
 			m.OriginString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stored = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -23342,7 +24685,7 @@ func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IndexOption) Unmarshal(dAtA []byte) error {
+func (m *OnUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23365,17 +24708,17 @@ func (m *IndexOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateExtraTable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -23385,12 +24728,60 @@ func (m *IndexOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CreateExtraTable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -23413,7 +24804,7 @@ func (m *IndexOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrimaryKeyDef) Unmarshal(dAtA []byte) error {
+func (m *IndexOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23436,37 +24827,108 @@ func (m *PrimaryKeyDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrimaryKeyDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: IndexOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrimaryKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IndexOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateExtraTable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				m.Cols = append(m.Cols, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateExtraTable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrimaryKeyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrimaryKeyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrimaryKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Cols = append(m.Cols, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
 						return io.ErrUnexpectedEOF
 					}
 					b := dAtA[iNdEx]
@@ -23980,6 +25442,57 @@ func (m *IndexDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexAlgo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoLists", wireType)
+			}
+			m.IndexAlgoLists = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexAlgoLists |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -24391,6 +25904,58 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enforced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -27209,7 +28774,7 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WindowSpec) Unmarshal(dAtA []byte) error {
+func (m *FrameBound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27232,17 +28797,17 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -27252,29 +28817,34 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= FrameBound_BoundType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbounded", wireType)
 			}
-			m.PartitionBy = append(m.PartitionBy, &Expr{})
-			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.Unbounded = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27301,33 +28871,296 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
-			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Val == nil {
+				m.Val = &Expr{}
+			}
+			if err := m.Val.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
-			}
-			m.Lead = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lead |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameClause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameClause_FrameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &FrameBound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &FrameBound{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionBy = append(m.PartitionBy, &Expr{})
+			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
+			}
+			m.Lead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lead |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
 			}
 			m.Lag = 0
 			for shift := uint(0); ; shift += 7 {
@@ -27344,6 +29177,42 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Frame == nil {
+				m.Frame = &FrameClause{}
+			}
+			if err := m.Frame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -29162,9 +31031,218 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
-		case 5:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectList = append(m.ProjectList, &Expr{})
+			if err := m.ProjectList[len(m.ProjectList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinType", wireType)
+			}
+			m.JoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinType |= Node_JoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnList = append(m.OnList, &Expr{})
+			if err := m.OnList[len(m.OnList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOnLeft", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BuildOnLeft = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilterList = append(m.FilterList, &Expr{})
+			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = append(m.GroupBy, &Expr{})
+			if err := m.GroupBy[len(m.GroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupingSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupingSet = append(m.GroupingSet, &Expr{})
+			if err := m.GroupingSet[len(m.GroupingSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29191,16 +31269,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProjectList = append(m.ProjectList, &Expr{})
-			if err := m.ProjectList[len(m.ProjectList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AggList = append(m.AggList, &Expr{})
+			if err := m.AggList[len(m.AggList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinType", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
 			}
-			m.JoinType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29210,14 +31288,31 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JoinType |= Node_JoinType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinSpec == nil {
+				m.WinSpec = &WindowSpec{}
+			}
+			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29244,16 +31339,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnList = append(m.OnList, &Expr{})
-			if err := m.OnList[len(m.OnList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOnLeft", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29263,15 +31358,31 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BuildOnLeft = bool(v != 0)
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &Expr{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29298,14 +31409,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FilterList = append(m.FilterList, &Expr{})
-			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Offset == nil {
+				m.Offset = &Expr{}
+			}
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCtx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29332,14 +31445,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupBy = append(m.GroupBy, &Expr{})
-			if err := m.GroupBy[len(m.GroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UpdateCtx == nil {
+				m.UpdateCtx = &UpdateCtx{}
+			}
+			if err := m.UpdateCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupingSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29366,14 +31481,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupingSet = append(m.GroupingSet, &Expr{})
-			if err := m.GroupingSet[len(m.GroupingSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDefVec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29400,14 +31517,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggList = append(m.AggList, &Expr{})
-			if err := m.AggList[len(m.AggList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TableDefVec = append(m.TableDefVec, &TableDef{})
+			if err := m.TableDefVec[len(m.TableDefVec)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29434,16 +31551,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WinSpec == nil {
-				m.WinSpec = &WindowSpec{}
+			if m.ObjRef == nil {
+				m.ObjRef = &ObjectRef{}
 			}
-			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsetData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29470,14 +31587,48 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
-			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RowsetData == nil {
+				m.RowsetData = &RowsetData{}
+			}
+			if err := m.RowsetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraOptions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCtx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29504,16 +31655,92 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &Expr{}
+			if m.DeleteCtx == nil {
+				m.DeleteCtx = &DeleteCtx{}
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeleteCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 24:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BindingTags = append(m.BindingTags, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BindingTags) == 0 {
+					m.BindingTags = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BindingTags = append(m.BindingTags, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingTags", wireType)
+			}
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzeInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29540,16 +31767,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Offset == nil {
-				m.Offset = &Expr{}
+			if m.AnalyzeInfo == nil {
+				m.AnalyzeInfo = &AnalyzeInfo{}
 			}
-			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnalyzeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCtx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TblFuncExprList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29576,16 +31803,33 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateCtx == nil {
-				m.UpdateCtx = &UpdateCtx{}
-			}
-			if err := m.UpdateCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TblFuncExprList = append(m.TblFuncExprList, &Expr{})
+			if err := m.TblFuncExprList[len(m.TblFuncExprList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29612,16 +31856,36 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
+			if m.ClusterTable == nil {
+				m.ClusterTable = &ClusterTable{}
 			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClusterTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotCacheable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotCacheable = bool(v != 0)
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDefVec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsertCtx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29648,16 +31912,18 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableDefVec = append(m.TableDefVec, &TableDef{})
-			if err := m.TableDefVec[len(m.TableDefVec)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InsertCtx == nil {
+				m.InsertCtx = &InsertCtx{}
+			}
+			if err := m.InsertCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjRef", wireType)
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
 			}
-			var msglen int
+			m.CurrentStep = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29667,31 +31933,72 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CurrentStep |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceStep", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			m.SourceStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceStep |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnionAll", wireType)
 			}
-			if m.ObjRef == nil {
-				m.ObjRef = &ObjectRef{}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ObjRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnionAll = bool(v != 0)
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecursionDepth", wireType)
 			}
-			iNdEx = postIndex
-		case 21:
+			m.MaxRecursionDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecursionDepth |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowsetData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionPrune", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29718,18 +32025,18 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RowsetData == nil {
-				m.RowsetData = &RowsetData{}
+			if m.PartitionPrune == nil {
+				m.PartitionPrune = &PartitionPrune{}
 			}
-			if err := m.RowsetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartitionPrune.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 22:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotTs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29739,27 +32046,31 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtraOptions = string(dAtA[iNdEx:postIndex])
+			if m.SnapshotTs == nil {
+				m.SnapshotTs = &timestamp.Timestamp{}
+			}
+			if err := m.SnapshotTs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 23:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteCtx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexLookup", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29786,92 +32097,67 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteCtx == nil {
-				m.DeleteCtx = &DeleteCtx{}
+			if m.IndexLookup == nil {
+				m.IndexLookup = &IndexLookup{}
 			}
-			if err := m.DeleteCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexLookup.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 24:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BindingTags = append(m.BindingTags, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BindingTags) == 0 {
-					m.BindingTags = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BindingTags = append(m.BindingTags, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BindingTags", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 25:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexLookup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexLookup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexLookup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29898,16 +32184,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AnalyzeInfo == nil {
-				m.AnalyzeInfo = &AnalyzeInfo{}
+			if m.ObjRef == nil {
+				m.ObjRef = &ObjectRef{}
 			}
-			if err := m.AnalyzeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TblFuncExprList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29934,16 +32220,18 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TblFuncExprList = append(m.TblFuncExprList, &Expr{})
-			if err := m.TblFuncExprList[len(m.TblFuncExprList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
 			}
-			m.Parallelism = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29953,14 +32241,29 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Parallelism |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 28:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilterList = append(m.FilterList, &Expr{})
+			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29987,18 +32290,18 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterTable == nil {
-				m.ClusterTable = &ClusterTable{}
+			if m.Pk == nil {
+				m.Pk = &Expr{}
 			}
-			if err := m.ClusterTable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotCacheable", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ivfflat", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -30008,15 +32311,82 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NotCacheable = bool(v != 0)
-		case 30:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ivfflat == nil {
+				m.Ivfflat = &IvfflatLookup{}
+			}
+			if err := m.Ivfflat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IvfflatLookup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IvfflatLookup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IvfflatLookup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsertCtx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30043,18 +32413,18 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InsertCtx == nil {
-				m.InsertCtx = &InsertCtx{}
+			if m.Distance == nil {
+				m.Distance = &Expr{}
 			}
-			if err := m.InsertCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Distance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 31:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Probes", wireType)
 			}
-			m.CurrentStep = 0
+			m.Probes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -30064,16 +32434,67 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentStep |= int32(b&0x7F) << shift
+				m.Probes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 32:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionPrune) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionPrune: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionPrune: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPruned", wireType)
 			}
-			m.SourceStep = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -30083,11 +32504,46 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceStep |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPruned = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedPartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SelectedPartitions = append(m.SelectedPartitions, &PartitionItem{})
+			if err := m.SelectedPartitions[len(m.SelectedPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -105,6 +105,11 @@ type clientConn struct {
 	// handshakePack is a cached info, used in connection migration.
 	// When connection is transferred, we use it to rebuild handshake.
 	handshakePack *frontend.Packet
+	// authResps are the responses of client to the extra auth requests of
+	// CN server after the handshake response, such as AuthSwitchRequest and
+	// the AuthMoreData of caching_sha2_password. They are cached with the
+	// handshakePack and replayed in connection migration.
+	authResps [][]byte
 	// connID records the connection ID.
 	connID uint32
 	// account is parsed from login information.
@@ -269,6 +274,12 @@ func (c *clientConn) handleKillQuery(e *killQueryEvent, resp chan<- []byte) erro
 	}
 	defer func() { _ = sc.Close() }()
 
+	if r, err = c.finishAuth(sc, r, false); err != nil {
+		c.log.Error("failed to authenticate with backend server", zap.Error(err))
+		sendErr(err.Error())
+		return err
+	}
+
 	if !isOKPacket(r) {
 		c.log.Error("failed to connect to cn to handle kill query event",
 			zap.String("query", e.stmt), zap.String("error", string(r)))
@@ -319,6 +330,9 @@ func (c *clientConn) connectToBackend(sendToClient bool) (ServerConn, error) {
 	if err != nil {
 		return nil, err
 	}
+	if r, err = c.finishAuth(sc, r, sendToClient); err != nil {
+		return nil, err
+	}
 	if sendToClient {
 		// r is the packet received from CN server, send r to client.
 		if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
@@ -17,6 +17,7 @@ package proxy
 import (
 	"context"
 	"encoding/binary"
+	"io"
 	"net"
 	"sync"
 	"testing"
@@ -501,3 +502,89 @@ func TestClientConn_SendErrToClient(t *testing.T) {
 	cc.SendErrToClient("err msg1")
 	wg.Wait()
 }
+
+// authServerConn replies the auth responses with the scripted packets.
+type authServerConn struct {
+	mockServerConn
+	replies   [][]byte
+	authResps [][]byte
+}
+
+func (s *authServerConn) ContinueAuth(authResp []byte) (*frontend.Packet, error) {
+	if authResp != nil {
+		s.authResps = append(s.authResps, authResp)
+	}
+	r := s.replies[0]
+	s.replies = s.replies[1:]
+	return bytesToPacket(r), nil
+}
+
+func makeTestPacket(payload ...byte) []byte {
+	return append([]byte{byte(len(payload)), 0, 0, 0}, payload...)
+}
+
+func TestClientConn_FinishAuth(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c, ok := cc.(*clientConn)
+	require.True(t, ok)
+
+	local, remote := net.Pipe()
+	c.conn.UseConn(local)
+
+	authSwitch := makeTestPacket(0xFE, 'x', 0)
+	fullAuth := makeTestPacket(0x01, 0x04)
+	fastAuth := makeTestPacket(0x01, 0x03)
+	ok1 := makeOKPacket()
+
+	// the client answers the AuthSwitchRequest and the full authentication
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, resp := range [][]byte{{1, 2, 3}, {4}} {
+			header := make([]byte, 4)
+			_, err := io.ReadFull(remote, header)
+			require.NoError(t, err)
+			payload := make([]byte, int(header[0]))
+			_, err = io.ReadFull(remote, payload)
+			require.NoError(t, err)
+			_, err = remote.Write(makeTestPacket(resp...))
+			require.NoError(t, err)
+		}
+	}()
+	sc := &authServerConn{replies: [][]byte{fullAuth, ok1}}
+	r, err := c.finishAuth(sc, authSwitch, true)
+	require.NoError(t, err)
+	require.True(t, isOKPacket(r))
+	wg.Wait()
+	require.Equal(t, [][]byte{{1, 2, 3}, {4}}, sc.authResps)
+	require.Equal(t, [][]byte{{1, 2, 3}, {4}}, c.authResps)
+
+	// replay the responses in migration
+	sc = &authServerConn{replies: [][]byte{fullAuth, ok1}}
+	r, err = c.finishAuth(sc, authSwitch, false)
+	require.NoError(t, err)
+	require.True(t, isOKPacket(r))
+	require.Equal(t, [][]byte{{1, 2, 3}, {4}}, sc.authResps)
+
+	// the fast authentication needs no response
+	sc = &authServerConn{replies: [][]byte{fastAuth, ok1}}
+	r, err = c.finishAuth(sc, authSwitch, false)
+	require.NoError(t, err)
+	require.True(t, isOKPacket(r))
+	require.Equal(t, [][]byte{{1, 2, 3}}, sc.authResps)
+
+	// no response to replay
+	sc = &authServerConn{replies: [][]byte{fullAuth, fullAuth, fullAuth}}
+	_, err = c.finishAuth(sc, authSwitch, false)
+	require.Error(t, err)
+
+	// no extra round trip
+	r, err = c.finishAuth(&authServerConn{}, ok1, false)
+	require.NoError(t, err)
+	require.True(t, isOKPacket(r))
+	_ = remote.Close()
+}
@@ -61,6 +61,55 @@ func (c *clientConn) handleHandshakeResp() error {
 	return nil
 }
 
+// finishAuth handles the extra round trips of the authentication between
+// client and CN server. r is the packet from CN server after the handshake
+// response. If interactive is true, the auth requests of CN server are sent
+// to client, and the responses of client are kept. Otherwise, the kept
+// responses are replayed, which happens in connection migration. It returns
+// the last packet from CN server, which is the OK or the ERR packet.
+// CN servers behind the proxy always do the full authentication of
+// caching_sha2_password with the shared RSA key, so the kept responses
+// are accepted by any of them.
+func (c *clientConn) finishAuth(sc ServerConn, r []byte, interactive bool) ([]byte, error) {
+	next := 0
+	if interactive {
+		c.authResps = c.authResps[:0]
+	}
+	for isAuthSwitchRequestPacket(r) || isAuthMoreDataPacket(r) {
+		var authResp []byte
+		fastAuthSuccess := isFastAuthSuccessPacket(r)
+		if interactive {
+			if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
+				return nil, err
+			}
+			if !fastAuthSuccess {
+				pack, err := c.readPacket()
+				if err != nil {
+					return nil, err
+				}
+				c.mysqlProto.AddSequenceId(1)
+				// copy the payload out of the read buffer. It is not nil
+				// even if the payload is empty.
+				authResp = append([]byte{}, pack.Payload...)
+				c.authResps = append(c.authResps, authResp)
+			}
+		} else if !fastAuthSuccess {
+			if next >= len(c.authResps) {
+				return nil, withCode(moerr.NewInternalErrorNoCtx("no auth response of client to replay"),
+					codeAuthFailed)
+			}
+			authResp = c.authResps[next]
+			next++
+		}
+		p, err := sc.ContinueAuth(authResp)
+		if err != nil {
+			return nil, err
+		}
+		r = packetToBytes(p)
+	}
+	return r, nil
+}
+
 func (s *serverConn) parseConnID(p *frontend.Packet) error {
 	if len(p.Payload) < 2 {
 		return moerr.NewInternalErrorNoCtx("protocol error: payload is too short")
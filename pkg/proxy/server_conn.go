@@ -37,6 +37,11 @@ type ServerConn interface {
 	// HandleHandshake handles the handshake communication with CN server.
 	// handshakeResp is a auth packet received from client.
 	HandleHandshake(handshakeResp *frontend.Packet) (*frontend.Packet, error)
+	// ContinueAuth handles the extra round trips of the authentication after
+	// the handshake response. It writes authResp, which is the response of client
+	// to the previous auth request of CN server, if it is not nil, and returns
+	// the next packet from CN server.
+	ContinueAuth(authResp []byte) (*frontend.Packet, error)
 	// ExecStmt executes a simple statement, it sends a query to backend server.
 	// After it finished, server connection should be closed immediately because
 	// it is a temp connection.
@@ -137,6 +142,16 @@ func (s *serverConn) HandleHandshake(handshakeResp *frontend.Packet) (*frontend.
 	return r, nil
 }
 
+// ContinueAuth implements the ServerConn interface.
+func (s *serverConn) ContinueAuth(authResp []byte) (*frontend.Packet, error) {
+	if authResp != nil {
+		if err := s.mysqlProto.WritePacket(authResp); err != nil {
+			return nil, err
+		}
+	}
+	return s.readPacket()
+}
+
 // ExecStmt implements the ServerConn interface.
 func (s *serverConn) ExecStmt(stmt string, resp chan<- []byte) error {
 	req := make([]byte, 1, len(stmt)+1)
@@ -70,6 +70,9 @@ func (s *mockServerConn) RawConn() net.Conn { return s.conn }
 func (s *mockServerConn) HandleHandshake(_ *frontend.Packet) (*frontend.Packet, error) {
 	return nil, nil
 }
+func (s *mockServerConn) ContinueAuth(_ []byte) (*frontend.Packet, error) {
+	return nil, nil
+}
 func (s *mockServerConn) ExecStmt(stmt string, resp chan<- []byte) error {
 	sendResp(makeOKPacket(), resp)
 	return nil
@@ -59,6 +59,32 @@ func isErrPacket(p []byte) bool {
 	return false
 }
 
+// isAuthSwitchRequestPacket returns true if []byte is a MySQL AuthSwitchRequest packet.
+func isAuthSwitchRequestPacket(p []byte) bool {
+	if len(p) > 4 && p[4] == 0xFE {
+		return true
+	}
+	return false
+}
+
+// isAuthMoreDataPacket returns true if []byte is a MySQL AuthMoreData packet.
+func isAuthMoreDataPacket(p []byte) bool {
+	if len(p) > 4 && p[4] == 0x01 {
+		return true
+	}
+	return false
+}
+
+// isFastAuthSuccessPacket returns true if []byte is the AuthMoreData packet
+// of caching_sha2_password, which tells the fast authentication succeeds.
+// The OK packet follows it without the response of the client.
+func isFastAuthSuccessPacket(p []byte) bool {
+	if len(p) == 6 && p[4] == 0x01 && p[5] == 0x03 {
+		return true
+	}
+	return false
+}
+
 // packetToBytes convert Packet to bytes.
 func packetToBytes(p *frontend.Packet) []byte {
 	if p == nil || len(p.Payload) == 0 {
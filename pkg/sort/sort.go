@@ -102,7 +102,7 @@ func Sort(desc, nullsLast, hasNull bool, os []int64, vec *vector.Vector, strCol
 		} else {
 			genericSort(col, os, genericGreater[int8])
 		}
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		col := vector.MustFixedCol[int16](vec)
 		if !desc {
 			genericSort(col, os, genericLess[int16])
@@ -130,7 +130,7 @@ func Sort(desc, nullsLast, hasNull bool, os []int64, vec *vector.Vector, strCol
 		} else {
 			genericSort(col, os, genericGreater[uint8])
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := vector.MustFixedCol[uint16](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint16])
@@ -144,7 +144,7 @@ func Sort(desc, nullsLast, hasNull bool, os []int64, vec *vector.Vector, strCol
 		} else {
 			genericSort(col, os, genericGreater[uint32])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		col := vector.MustFixedCol[uint64](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint64])
@@ -214,7 +214,7 @@ func Sort(desc, nullsLast, hasNull bool, os []int64, vec *vector.Vector, strCol
 		} else {
 			genericSort(col, os, uuidGreater)
 		}
-	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
+	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_vecf32, types.T_vecf64:
 		if strCol == nil {
 			strCol = vector.MustStrCol(vec)
 		}
@@ -0,0 +1,100 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggut
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/stretchr/testify/require"
+)
+
+func newJsonVector(t *testing.T, m *mpool.MPool, strs ...string) *vector.Vector {
+	vec := vector.NewVec(types.T_json.ToType())
+	for _, s := range strs {
+		bj, err := types.ParseStringToByteJson(s)
+		require.NoError(t, err)
+		dt, err := bj.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, vector.AppendBytes(vec, dt, false, m))
+	}
+	return vec
+}
+
+func evalJsonAgg(t *testing.T, m *mpool.MPool, a agg.Agg[any]) []string {
+	v, err := a.Eval(m)
+	require.NoError(t, err)
+	defer v.Free(m)
+	ret := make([]string, v.Length())
+	for i := range ret {
+		if !v.GetNulls().Contains(uint64(i)) {
+			ret[i] = types.DecodeJson(v.GetBytesAt(i)).String()
+		}
+	}
+	return ret
+}
+
+func TestJsonAgg(t *testing.T) {
+	m := mpool.MustNewZeroNoFixed()
+	typ := types.T_json.ToType()
+	ret, err := agg.ReturnType(agg.AggregateJsonArrayAgg, typ)
+	require.NoError(t, err)
+	require.Equal(t, types.T_json, ret.Oid)
+
+	kases := []struct {
+		op     int
+		input  []string
+		merge  []string
+		expect string
+	}{
+		{agg.AggregateJsonArrayAgg, []string{`[1]`, `[null]`}, []string{`[{"a": 1}]`}, `[1, null, {"a": 1}]`},
+		{agg.AggregateJsonObjectAgg, []string{`{"b": 1}`, `{"a": 2}`}, []string{`{"b": [3]}`}, `{"a": 2, "b": [3]}`},
+	}
+	for _, kase := range kases {
+		vec := newJsonVector(t, m, kase.input...)
+		vec2 := newJsonVector(t, m, kase.merge...)
+
+		// the second group is empty
+		a0, err := agg.New(kase.op, false, typ)
+		require.NoError(t, err)
+		require.NoError(t, a0.Grows(2, m))
+		for i := range kase.input {
+			require.NoError(t, a0.Fill(0, int64(i), 1, []*vector.Vector{vec}))
+		}
+
+		// the partial result survives marshal and unmarshal
+		data, err := a0.MarshalBinary()
+		require.NoError(t, err)
+		a1, err := agg.New(kase.op, false, typ)
+		require.NoError(t, err)
+		require.NoError(t, a1.UnmarshalBinary(data))
+		require.NoError(t, a1.WildAggReAlloc(m))
+
+		a2, err := agg.New(kase.op, false, typ)
+		require.NoError(t, err)
+		require.NoError(t, a2.Grows(1, m))
+		for i := range kase.merge {
+			require.NoError(t, a2.Fill(0, int64(i), 1, []*vector.Vector{vec2}))
+		}
+		require.NoError(t, a1.Merge(a2, 0, 0))
+		require.Equal(t, []string{kase.expect, ""}, evalJsonAgg(t, m, a1))
+
+		vec.Free(m)
+		vec2.Free(m)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// JsonAgg is the private part of JSON_ARRAYAGG and JSON_OBJECTAGG. The binder
+// wraps their arguments with JSON_ARRAY and JSON_OBJECT, so every input is a
+// json array of one element or a json object of one member, and the result of a
+// group is the concatenation of its inputs.
+type JsonAgg struct {
+	IsObject bool
+	// Vals are the encoded json inputs of every group.
+	Vals [][][]byte
+}
+
+func JsonAggReturnType(_ []types.Type) types.Type {
+	return types.T_json.ToType()
+}
+
+func NewJsonAgg(isObject bool) *JsonAgg {
+	return &JsonAgg{IsObject: isObject}
+}
+
+func (a *JsonAgg) Grows(cnt int) {
+	for i := 0; i < cnt; i++ {
+		a.Vals = append(a.Vals, nil)
+	}
+}
+
+func (a *JsonAgg) Eval(vs [][]byte) [][]byte {
+	for i := range vs {
+		if len(a.Vals[i]) == 0 {
+			continue
+		}
+		docs := make([]bytejson.ByteJson, len(a.Vals[i]))
+		for j, v := range a.Vals[i] {
+			docs[j] = types.DecodeJson(v)
+		}
+		var bj bytejson.ByteJson
+		if a.IsObject {
+			// the inputs are built by JSON_OBJECT, so they are always objects.
+			bj, _ = bytejson.ConcatObjects(docs)
+		} else {
+			bj = bytejson.ConcatArrays(docs)
+		}
+		vs[i], _ = bj.Marshal()
+	}
+	return vs
+}
+
+func (a *JsonAgg) Fill(i int64, value []byte, _ []byte, z int64, isEmpty bool, isNull bool) ([]byte, bool) {
+	if !isNull {
+		for j := int64(0); j < z; j++ {
+			a.Vals[i] = append(a.Vals[i], append([]byte{}, value...))
+		}
+		return nil, false
+	}
+	return nil, isEmpty
+}
+
+func (a *JsonAgg) Merge(xIndex int64, yIndex int64, _ []byte, _ []byte, xEmpty bool, yEmpty bool, yAgg any) ([]byte, bool) {
+	if !yEmpty {
+		ya := yAgg.(*JsonAgg)
+		a.Vals[xIndex] = append(a.Vals[xIndex], ya.Vals[yIndex]...)
+		return nil, false
+	}
+	return nil, xEmpty
+}
+
+func (a *JsonAgg) MarshalBinary() ([]byte, error) {
+	return types.Encode(&a.Vals)
+}
+
+func (a *JsonAgg) UnmarshalBinary(data []byte) error {
+	// avoid resulting errors caused by morpc overusing memory
+	copyData := make([]byte, len(data))
+	copy(copyData, data)
+	return types.Decode(copyData, &a.Vals)
+}
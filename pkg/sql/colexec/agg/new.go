@@ -48,6 +48,8 @@ func ReturnType(op int, typ types.Type) (types.Type, error) {
 		otyp = StdDevPopReturnType([]types.Type{typ})
 	case AggregateMedian:
 		otyp = MedianReturnType([]types.Type{typ})
+	case AggregateJsonArrayAgg, AggregateJsonObjectAgg:
+		otyp = JsonAggReturnType([]types.Type{typ})
 	}
 	if otyp.Oid == types.T_any {
 		return typ, moerr.NewInternalErrorNoCtx("'%v' not support %s", typ, Names[op])
@@ -85,6 +87,10 @@ func New(op int, dist bool, typ types.Type) (Agg[any], error) {
 		return newAnyValue(typ, dist), nil
 	case AggregateMedian:
 		return newMedian(typ, dist), nil
+	case AggregateJsonArrayAgg:
+		return newJsonAgg(AggregateJsonArrayAgg, typ, dist), nil
+	case AggregateJsonObjectAgg:
+		return newJsonAgg(AggregateJsonObjectAgg, typ, dist), nil
 	}
 	panic(moerr.NewInternalErrorNoCtx("unsupported type '%s' for aggregate %s", typ, Names[op]))
 }
@@ -730,6 +736,17 @@ func newMedian(typ types.Type, dist bool) Agg[any] {
 	panic(moerr.NewNotSupportedNoCtx("median on type '%s'", typ))
 }
 
+func newJsonAgg(op int, typ types.Type, dist bool) Agg[any] {
+	if typ.Oid != types.T_json {
+		panic(moerr.NewNotSupportedNoCtx("%s on type '%s'", Names[op], typ))
+	}
+	if dist {
+		panic(moerr.NewNotSupportedNoCtx("%s in distinct mode", Names[op]))
+	}
+	aggPriv := NewJsonAgg(op == AggregateJsonObjectAgg)
+	return NewUnaryAgg(op, aggPriv, false, typ, JsonAggReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
+
 func newGenericAnyValue[T any](typ types.Type, dist bool) Agg[any] {
 	aggPriv := NewAnyValue[T]()
 	if dist {
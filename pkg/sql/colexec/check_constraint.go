@@ -0,0 +1,72 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// CheckConstraints evaluates the enforced check constraints of the table on
+// the rows to write. Like MySQL, a row violates a constraint only if its
+// expression is false, NULL is not a violation.
+func CheckConstraints(proc *process.Process, bat *batch.Batch, tableDef *plan.TableDef) error {
+	if len(tableDef.Checks) == 0 || bat.Length() == 0 {
+		return nil
+	}
+
+	nameToPos := batchNameToPos(bat)
+	for _, check := range tableDef.Checks {
+		if !check.Enforced {
+			continue
+		}
+		expr, err := exprOfBatch(proc, check.Check, nameToPos)
+		if err != nil {
+			return err
+		}
+		vec, err := EvalExpr(bat, proc, expr)
+		if err != nil {
+			return err
+		}
+		violated := checkViolated(vec)
+		if !vectorInBatch(bat, vec) {
+			vec.Free(proc.Mp())
+		}
+		if violated {
+			return moerr.NewCheckConstraintViolated(proc.Ctx, check.Name)
+		}
+	}
+	return nil
+}
+
+func checkViolated(vec *vector.Vector) bool {
+	if vec.IsConstNull() {
+		return false
+	}
+	vals := vector.MustFixedCol[bool](vec)
+	if vec.IsConst() {
+		return !vals[0]
+	}
+	nsp := vec.GetNulls()
+	for i, v := range vals {
+		if !v && !nsp.Contains(uint64(i)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// newCheckTestTableDef returns the table definition of the generated column
+// tests with the constraint a > 0.
+func newCheckTestTableDef(t *testing.T, enforced bool) *plan.TableDef {
+	int64Typ := &plan.Type{Id: int32(types.T_int64)}
+	id, _, _, err := function.GetFunctionByName(context.Background(), ">",
+		[]types.Type{types.T_int64.ToType(), types.T_int64.ToType()})
+	require.NoError(t, err)
+	tableDef := newGeneratedTestTableDef(t, false)
+	tableDef.Checks = []*plan.CheckDef{
+		{
+			Name: "t_chk_1",
+			Check: &plan.Expr{
+				Typ: &plan.Type{Id: int32(types.T_bool)},
+				Expr: &plan.Expr_F{
+					F: &plan.Function{
+						Func: &plan.ObjectRef{Obj: id, ObjName: ">"},
+						Args: []*plan.Expr{
+							{
+								Typ:  int64Typ,
+								Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "a"}},
+							},
+							{
+								Typ:  int64Typ,
+								Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: 0}}},
+							},
+						},
+					},
+				},
+			},
+			Enforced: enforced,
+		},
+	}
+	return tableDef
+}
+
+func TestCheckConstraints(t *testing.T) {
+	proc := testutil.NewProcess()
+	tableDef := newCheckTestTableDef(t, true)
+
+	// NULL doesn't violate the constraint
+	bat := newGeneratedTestBatch(t, []int64{1, 2, 0}, []bool{false, false, true})
+	require.NoError(t, CheckConstraints(proc, bat, tableDef))
+
+	bat = newGeneratedTestBatch(t, []int64{1, 0}, []bool{false, false})
+	err := CheckConstraints(proc, bat, tableDef)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCheckConstraintViolated))
+	// the expression shared by the operators is not changed
+	require.Equal(t, int32(0), tableDef.Checks[0].Check.GetF().Args[0].GetCol().ColPos)
+
+	// the constraint not enforced is not checked
+	tableDef = newCheckTestTableDef(t, false)
+	require.NoError(t, CheckConstraints(proc, bat, tableDef))
+}
@@ -116,12 +116,20 @@ func FilterAndUpdateByRowId(
 				}
 			}
 
+			if err = FillGeneratedColumns(proc, updateBatch, tableDef); err != nil {
+				return 0, err
+			}
+
 			// check new rows not null
 			err := BatchDataNotNullCheck(updateBatch, tableDef, proc.Ctx)
 			if err != nil {
 				return 0, err
 			}
 
+			if err = CheckConstraints(proc, updateBatch, tableDef); err != nil {
+				return 0, err
+			}
+
 			//  append hidden columns
 			//if info.compositePkey != "" {
 			//	util.FillCompositeClusterByBatch(updateBatch, info.compositePkey, proc)
@@ -143,7 +151,10 @@ func FilterAndUpdateByRowId(
 			}
 
 			// write unique key table
-			WriteUniqueTable(nil, proc, updateBatch, tableDef, info.updateNameToPos, info.pkPos, uniqueRel)
+			err = WriteUniqueTable(nil, proc, updateBatch, tableDef, info.updateNameToPos, info.pkPos, uniqueRel)
+			if err != nil {
+				return 0, err
+			}
 
 			// write origin table
 			err = rels[i].Write(proc.Ctx, updateBatch)
@@ -155,6 +166,9 @@ func FilterAndUpdateByRowId(
 	return affectedRows, nil
 }
 
+// WriteUniqueTable writes the rows of the batch into the index tables of the unique indexes,
+// and then the ivfflat indexes, of the table. The relations of the index tables are in
+// the same order, see getRel of compile.
 func WriteUniqueTable(s3Writers []*S3Writer, proc *process.Process, updateBatch *batch.Batch,
 	tableDef *plan.TableDef, updateNameToPos map[string]int, pkPos int, rels []engine.Relation) error {
 	if tableDef.Indexes == nil {
@@ -225,6 +239,20 @@ func WriteUniqueTable(s3Writers []*S3Writer, proc *process.Process, updateBatch
 		}
 	}
 
+	// the table with ivfflat indexes is never written to s3 by the insert, see compile
+	if s3Writers != nil {
+		return nil
+	}
+	for _, indexDef := range tableDef.Indexes {
+		if !IsIvfflatIndex(indexDef) {
+			continue
+		}
+		if err := writeIvfflatTable(proc, updateBatch, indexDef, updateNameToPos, pkPos, rels[uIdx]); err != nil {
+			return err
+		}
+		uIdx++
+	}
+
 	return nil
 }
 
@@ -449,6 +477,10 @@ func BatchDataNotNullCheck(tmpBat *batch.Batch, tableDef *plan.TableDef, ctx con
 	}
 
 	for j := range tmpBat.Vecs {
+		// the virtual generated columns are checked by FillGeneratedColumns
+		if gen := tableDef.Cols[j].GetDefault().GetGenerated(); gen != nil && !gen.Stored {
+			continue
+		}
 		nsp := tmpBat.Vecs[j].GetNulls()
 		if tableDef.Cols[j].Default != nil && !tableDef.Cols[j].Default.NullAbility && nulls.Any(nsp) {
 			return moerr.NewConstraintViolation(ctx, fmt.Sprintf("Column '%s' cannot be null", tmpBat.Attrs[j]))
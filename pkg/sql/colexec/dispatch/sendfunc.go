@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -48,9 +49,12 @@ const (
 func sendToAllLocalFunc(bat *batch.Batch, ap *Argument, proc *process.Process) (bool, error) {
 	refCountAdd := int64(len(ap.LocalRegs) - 1)
 	atomic.AddInt64(&bat.Cnt, refCountAdd)
-	if jm, ok := bat.Ht.(*hashmap.JoinMap); ok {
-		jm.IncRef(refCountAdd)
-		jm.SetDupCount(int64(len(ap.LocalRegs)))
+	switch ht := bat.Ht.(type) {
+	case *hashmap.JoinMap:
+		ht.IncRef(refCountAdd)
+		ht.SetDupCount(int64(len(ap.LocalRegs)))
+	case *spill.Partitions:
+		ht.IncRef(refCountAdd)
 	}
 
 	for _, reg := range ap.LocalRegs {
@@ -98,6 +98,10 @@ func Prepare(proc *process.Process, arg any) error {
 			return moerr.NewNotSupported(proc.Ctx, "the jsonline format '%s' is not supported now", param.Extern.JsonData)
 		}
 	}
+	if param.Extern.Format == tree.PARQUET && param.Extern.Local {
+		param.Fileparam.End = true
+		return moerr.NewNotSupported(proc.Ctx, "load local parquet file")
+	}
 	param.IgnoreLineTag = int(param.Extern.Tail.IgnoredLines)
 	param.IgnoreLine = param.IgnoreLineTag
 	if len(param.FileList) == 0 {
@@ -151,7 +155,7 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		proc.SetInputBatch(nil)
 		return true, nil
 	}
-	if param.plh == nil {
+	if param.plh == nil && param.parqh == nil {
 		if param.Fileparam.FileIndex >= len(param.FileList) {
 			proc.SetInputBatch(nil)
 			return true, nil
@@ -281,7 +285,7 @@ func FilterFileList(ctx context.Context, node *plan.Node, proc *process.Process,
 
 func IsSysTable(dbName string, tableName string) bool {
 	if dbName == "system" {
-		return tableName == "statement_info" || tableName == "rawlog"
+		return tableName == "statement_info" || tableName == "rawlog" || tableName == "system_audit"
 	} else if dbName == "system_metrics" {
 		return tableName == "metric"
 	}
@@ -702,18 +706,6 @@ func needRead(ctx context.Context, param *ExternalParam, proc *process.Process,
 		return true
 	}
 
-	notReportErrCtx := errutil.ContextWithNoReport(proc.Ctx, true)
-	// if expr match no columns, just eval expr
-	if len(param.Filter.columns) == 0 {
-		bat := batch.NewWithSize(0)
-		defer bat.Clean(proc.Mp())
-		ifNeed, err := plan2.EvalFilterExpr(notReportErrCtx, param.Filter.FilterExpr, bat, proc)
-		if err != nil {
-			return true
-		}
-		return ifNeed
-	}
-
 	dataLength := len(param.Filter.columns)
 	datas := make([][2]any, dataLength)
 	dataTypes := make([]uint8, dataLength)
@@ -732,6 +724,23 @@ func needRead(ctx context.Context, param *ExternalParam, proc *process.Process,
 		}
 		datas[i] = [2]any{min, max}
 	}
+	return evalFilterByMinMax(param, proc, datas, dataTypes)
+}
+
+// evalFilterByMinMax returns false if the filter can't be true on any of the values between min and max.
+func evalFilterByMinMax(param *ExternalParam, proc *process.Process, datas [][2]any, dataTypes []uint8) bool {
+	notReportErrCtx := errutil.ContextWithNoReport(proc.Ctx, true)
+	// if expr match no columns, just eval expr
+	if len(datas) == 0 {
+		bat := batch.NewWithSize(0)
+		defer bat.Clean(proc.Mp())
+		ifNeed, err := plan2.EvalFilterExpr(notReportErrCtx, param.Filter.FilterExpr, bat, proc)
+		if err != nil {
+			return true
+		}
+		return ifNeed
+	}
+
 	// use all min/max data to build []vectors.
 	buildVectors := plan2.BuildVectorsByData(datas, dataTypes, proc.Mp())
 	bat := batch.NewWithSize(param.Filter.maxCol + 1)
@@ -867,6 +876,8 @@ func ScanZonemapFile(ctx context.Context, param *ExternalParam, proc *process.Pr
 func ScanFileData(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
 	if strings.HasSuffix(param.Fileparam.Filepath, ".tae") || param.Extern.QueryResult {
 		return ScanZonemapFile(ctx, param, proc)
+	} else if param.Extern.Format == tree.PARQUET {
+		return ScanParquetFile(ctx, param, proc)
 	} else {
 		return ScanCsvFile(ctx, param, proc)
 	}
@@ -0,0 +1,784 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// the parquet file is read through the fileservice by blocks of this size at least,
+	// since the page headers are parsed by many small reads.
+	parquetReadBlockSize = 1 << 20
+)
+
+var unixEpochDate = types.DateFromCalendar(1970, 1, 1)
+
+func init() {
+	// only UNCOMPRESSED, GZIP and SNAPPY are supported by default
+	goparquet.RegisterBlockCompressor(parquet.CompressionCodec_ZSTD, zstdCompressor{})
+}
+
+// zstdCompressor shares a single encoder and decoder for all the pages, since
+// EncodeAll and DecodeAll are safe for concurrent use. They are created at the
+// first use, so the goroutines of the decoder are not started by the package
+// initialization.
+type zstdCompressor struct{}
+
+var zstdCodec struct {
+	once    sync.Once
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+	err     error
+}
+
+func getZstdCodec() (*zstd.Encoder, *zstd.Decoder, error) {
+	zstdCodec.once.Do(func() {
+		zstdCodec.encoder, zstdCodec.err = zstd.NewWriter(nil)
+		if zstdCodec.err != nil {
+			return
+		}
+		zstdCodec.decoder, zstdCodec.err = zstd.NewReader(nil)
+	})
+	return zstdCodec.encoder, zstdCodec.decoder, zstdCodec.err
+}
+
+func (zstdCompressor) CompressBlock(block []byte) ([]byte, error) {
+	w, _, err := getZstdCodec()
+	if err != nil {
+		return nil, err
+	}
+	return w.EncodeAll(block, nil), nil
+}
+
+func (zstdCompressor) DecompressBlock(block []byte) ([]byte, error) {
+	_, r, err := getZstdCodec()
+	if err != nil {
+		return nil, err
+	}
+	return r.DecodeAll(block, nil)
+}
+
+// ParquetHandler keeps the state of the parquet file being read. The file is
+// read by row groups, and a row group is skipped if its statistics can't match
+// the pushed down filter.
+type ParquetHandler struct {
+	meta   *parquet.FileMetaData
+	reader *goparquet.FileReader
+	// the parquet column of each attr, nil for the columns not read from the file
+	cols []*parquetColumn
+	// the row group being read and the rows of it already read
+	offset int
+	rows   int64
+	loaded bool
+}
+
+type parquetColumn struct {
+	elem *parquet.SchemaElement
+	// the index of the column chunk in the row group
+	chunk int
+	// the type mapped from the parquet type
+	typ types.Type
+	// the microseconds of the unit of TIME and TIMESTAMP, negative for nanoseconds
+	unit int64
+}
+
+// parquetFileReader reads the parquet file through the fileservice,
+// so the file can be on both the local disk and s3.
+type parquetFileReader struct {
+	ctx  context.Context
+	fs   fileservice.ETLFileService
+	path string
+	size int64
+	// the last block read from the file
+	buf    []byte
+	bufOff int64
+}
+
+func (r *parquetFileReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	end := off + int64(len(p))
+	if off < r.bufOff || end > r.bufOff+int64(len(r.buf)) {
+		size := int64(len(p))
+		if size < parquetReadBlockSize {
+			size = parquetReadBlockSize
+		}
+		if off+size > r.size {
+			size = r.size - off
+		}
+		vec := fileservice.IOVector{
+			FilePath: r.path,
+			Entries: []fileservice.IOEntry{
+				0: {
+					Offset: off,
+					Size:   size,
+				},
+			},
+		}
+		if err := r.fs.Read(r.ctx, &vec); err != nil {
+			return 0, err
+		}
+		r.buf = vec.Entries[0].Data
+		r.bufOff = off
+	}
+	n := copy(p, r.buf[off-r.bufOff:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// ParquetTypeToMoType maps the logical type, the converted type of the legacy writers,
+// or the physical type if there is neither of them, of a parquet column to the type of matrixone.
+func ParquetTypeToMoType(ctx context.Context, elem *parquet.SchemaElement) (types.Type, error) {
+	if lt := elem.LogicalType; lt != nil {
+		switch {
+		case lt.STRING != nil, lt.ENUM != nil:
+			return types.T_varchar.ToType(), nil
+		case lt.JSON != nil:
+			return types.T_json.ToType(), nil
+		case lt.BSON != nil:
+			return types.T_blob.ToType(), nil
+		case lt.UUID != nil:
+			return types.T_uuid.ToType(), nil
+		case lt.INTEGER != nil:
+			return parquetIntegerType(ctx, elem, lt.INTEGER.BitWidth, lt.INTEGER.IsSigned)
+		case lt.DECIMAL != nil:
+			return parquetDecimalType(ctx, elem, lt.DECIMAL.Precision, lt.DECIMAL.Scale)
+		case lt.DATE != nil:
+			return types.T_date.ToType(), nil
+		case lt.TIME != nil:
+			return types.New(types.T_time, 0, parquetTimeScale(lt.TIME.Unit)), nil
+		case lt.TIMESTAMP != nil:
+			if lt.TIMESTAMP.IsAdjustedToUTC {
+				return types.New(types.T_timestamp, 0, parquetTimeScale(lt.TIMESTAMP.Unit)), nil
+			}
+			return types.New(types.T_datetime, 0, parquetTimeScale(lt.TIMESTAMP.Unit)), nil
+		}
+		return types.Type{}, moerr.NewNotSupported(ctx, "the parquet type %s of column '%s'", lt, elem.Name)
+	}
+
+	if ct := elem.ConvertedType; ct != nil {
+		switch *ct {
+		case parquet.ConvertedType_UTF8, parquet.ConvertedType_ENUM:
+			return types.T_varchar.ToType(), nil
+		case parquet.ConvertedType_JSON:
+			return types.T_json.ToType(), nil
+		case parquet.ConvertedType_BSON:
+			return types.T_blob.ToType(), nil
+		case parquet.ConvertedType_INT_8:
+			return parquetIntegerType(ctx, elem, 8, true)
+		case parquet.ConvertedType_INT_16:
+			return parquetIntegerType(ctx, elem, 16, true)
+		case parquet.ConvertedType_INT_32:
+			return parquetIntegerType(ctx, elem, 32, true)
+		case parquet.ConvertedType_INT_64:
+			return parquetIntegerType(ctx, elem, 64, true)
+		case parquet.ConvertedType_UINT_8:
+			return parquetIntegerType(ctx, elem, 8, false)
+		case parquet.ConvertedType_UINT_16:
+			return parquetIntegerType(ctx, elem, 16, false)
+		case parquet.ConvertedType_UINT_32:
+			return parquetIntegerType(ctx, elem, 32, false)
+		case parquet.ConvertedType_UINT_64:
+			return parquetIntegerType(ctx, elem, 64, false)
+		case parquet.ConvertedType_DECIMAL:
+			return parquetDecimalType(ctx, elem, elem.GetPrecision(), elem.GetScale())
+		case parquet.ConvertedType_DATE:
+			return types.T_date.ToType(), nil
+		case parquet.ConvertedType_TIME_MILLIS:
+			return types.New(types.T_time, 0, 3), nil
+		case parquet.ConvertedType_TIME_MICROS:
+			return types.New(types.T_time, 0, 6), nil
+		case parquet.ConvertedType_TIMESTAMP_MILLIS:
+			return types.New(types.T_timestamp, 0, 3), nil
+		case parquet.ConvertedType_TIMESTAMP_MICROS:
+			return types.New(types.T_timestamp, 0, 6), nil
+		}
+		return types.Type{}, moerr.NewNotSupported(ctx, "the parquet type %s of column '%s'", ct, elem.Name)
+	}
+
+	if elem.Type != nil {
+		switch *elem.Type {
+		case parquet.Type_BOOLEAN:
+			return types.T_bool.ToType(), nil
+		case parquet.Type_INT32:
+			return types.T_int32.ToType(), nil
+		case parquet.Type_INT64:
+			return types.T_int64.ToType(), nil
+		case parquet.Type_FLOAT:
+			return types.T_float32.ToType(), nil
+		case parquet.Type_DOUBLE:
+			return types.T_float64.ToType(), nil
+		case parquet.Type_INT96:
+			// the legacy timestamp written by impala and spark, it's always in UTC
+			return types.New(types.T_timestamp, 0, 6), nil
+		case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+			return types.T_blob.ToType(), nil
+		}
+	}
+	return types.Type{}, moerr.NewNotSupported(ctx, "the parquet type of column '%s'", elem.Name)
+}
+
+func parquetIntegerType(ctx context.Context, elem *parquet.SchemaElement, bitWidth int8, signed bool) (types.Type, error) {
+	switch bitWidth {
+	case 8:
+		if signed {
+			return types.T_int8.ToType(), nil
+		}
+		return types.T_uint8.ToType(), nil
+	case 16:
+		if signed {
+			return types.T_int16.ToType(), nil
+		}
+		return types.T_uint16.ToType(), nil
+	case 32:
+		if signed {
+			return types.T_int32.ToType(), nil
+		}
+		return types.T_uint32.ToType(), nil
+	case 64:
+		if signed {
+			return types.T_int64.ToType(), nil
+		}
+		return types.T_uint64.ToType(), nil
+	}
+	return types.Type{}, moerr.NewNotSupported(ctx, "the parquet integer of %d bits of column '%s'", bitWidth, elem.Name)
+}
+
+func parquetDecimalType(ctx context.Context, elem *parquet.SchemaElement, precision, scale int32) (types.Type, error) {
+	if elem.GetType() == parquet.Type_BYTE_ARRAY || elem.GetType() == parquet.Type_FIXED_LEN_BYTE_ARRAY {
+		// the unscaled value must be put into a decimal128
+		if elem.GetType() == parquet.Type_BYTE_ARRAY || elem.GetTypeLength() > 16 {
+			return types.Type{}, moerr.NewNotSupported(ctx, "the parquet decimal of column '%s' with precision %d", elem.Name, precision)
+		}
+	}
+	if precision <= 18 {
+		return types.New(types.T_decimal64, precision, scale), nil
+	}
+	if precision <= 38 {
+		return types.New(types.T_decimal128, precision, scale), nil
+	}
+	return types.Type{}, moerr.NewNotSupported(ctx, "the parquet decimal of column '%s' with precision %d", elem.Name, precision)
+}
+
+func parquetTimeScale(unit *parquet.TimeUnit) int32 {
+	if unit != nil && unit.MILLIS != nil {
+		return 3
+	}
+	return 6
+}
+
+func parquetTimeUnit(elem *parquet.SchemaElement) int64 {
+	var unit *parquet.TimeUnit
+	if lt := elem.LogicalType; lt != nil {
+		if lt.TIME != nil {
+			unit = lt.TIME.Unit
+		} else if lt.TIMESTAMP != nil {
+			unit = lt.TIMESTAMP.Unit
+		}
+	} else if ct := elem.ConvertedType; ct != nil {
+		if *ct == parquet.ConvertedType_TIME_MILLIS || *ct == parquet.ConvertedType_TIMESTAMP_MILLIS {
+			return 1000
+		}
+	}
+	switch {
+	case unit == nil || unit.MICROS != nil:
+		return 1
+	case unit.MILLIS != nil:
+		return 1000
+	}
+	return -1000
+}
+
+// isParquetTypeCompatible returns true if the values of the parquet column can be
+// loaded into the table column without loss.
+func isParquetTypeCompatible(src, dst types.Type) bool {
+	switch {
+	case src.Oid == dst.Oid:
+		if src.Oid.IsDecimal() {
+			return src.Scale == dst.Scale && src.Width <= dst.Width
+		}
+		return true
+	case dst.Oid.IsMySQLString():
+		return src.Oid.IsMySQLString()
+	case dst.Oid == types.T_json:
+		return src.Oid == types.T_varchar
+	case dst.Oid.IsSignedInt():
+		return (src.Oid.IsSignedInt() && src.Oid.TypeLen() <= dst.Oid.TypeLen()) ||
+			(src.Oid.IsUnsignedInt() && src.Oid.TypeLen() < dst.Oid.TypeLen())
+	case dst.Oid.IsUnsignedInt():
+		return src.Oid.IsUnsignedInt() && src.Oid.TypeLen() <= dst.Oid.TypeLen()
+	case dst.Oid == types.T_float64:
+		return src.Oid == types.T_float32
+	case dst.Oid == types.T_decimal128:
+		return src.Oid == types.T_decimal64 && src.Scale == dst.Scale
+	case dst.Oid == types.T_datetime:
+		return src.Oid == types.T_timestamp
+	}
+	return false
+}
+
+func getParquetHandler(param *ExternalParam, proc *process.Process) (*ParquetHandler, error) {
+	fs, readPath, err := plan2.GetForETLWithType(param.Extern, param.Fileparam.Filepath)
+	if err != nil {
+		return nil, err
+	}
+	var size int64 = -1
+	if param.Fileparam.FileIndex-1 < len(param.FileSize) {
+		size = param.FileSize[param.Fileparam.FileIndex-1]
+	}
+	if size < 0 {
+		entry, err := fs.StatFile(param.Ctx, readPath)
+		if err != nil {
+			return nil, err
+		}
+		size = entry.Size
+	}
+	r := io.NewSectionReader(&parquetFileReader{
+		ctx:  param.Ctx,
+		fs:   fs,
+		path: readPath,
+		size: size,
+	}, 0, size)
+	meta, err := goparquet.ReadFileMetaDataWithContext(param.Ctx, r, true)
+	if err != nil {
+		return nil, moerr.NewInvalidInput(param.Ctx, "the file '%s' is not a parquet file: %v", param.Fileparam.Filepath, err)
+	}
+
+	h := &ParquetHandler{
+		meta: meta,
+		cols: make([]*parquetColumn, len(param.Attrs)),
+	}
+	var names []string
+	for i, attr := range param.Attrs {
+		if catalog.ContainExternalHidenCol(attr) {
+			continue
+		}
+		//for cluster table, the column account_id need not be filled here
+		if param.ClusterTable.GetIsClusterTable() && int(param.ClusterTable.GetColumnIndexOfAccountId()) == i {
+			continue
+		}
+		c, err := getParquetColumn(param.Ctx, meta, attr, param.Fileparam.Filepath)
+		if err != nil {
+			return nil, err
+		}
+		dst := makeType(param.Cols, i)
+		if !isParquetTypeCompatible(c.typ, dst) {
+			return nil, moerr.NewInvalidInput(param.Ctx, "the parquet column '%s' of type %s can't be loaded into the column '%s' of type %s",
+				c.elem.Name, c.typ.String(), attr, dst.String())
+		}
+		h.cols[i] = c
+		names = append(names, c.elem.Name)
+	}
+
+	// only the projected columns are read from the file
+	h.reader, err = goparquet.NewFileReaderWithOptions(r,
+		goparquet.WithFileMetaData(meta),
+		goparquet.WithColumns(names...),
+		goparquet.WithReaderContext(param.Ctx))
+	if err != nil {
+		return nil, moerr.NewInvalidInput(param.Ctx, "failed to read the parquet file '%s': %v", param.Fileparam.Filepath, err)
+	}
+	return h, nil
+}
+
+// getParquetColumn finds the top level column of the name case-insensitively.
+func getParquetColumn(ctx context.Context, meta *parquet.FileMetaData, name string, path string) (*parquetColumn, error) {
+	var elem *parquet.SchemaElement
+	// the first element is the root, and the children of a group follow it
+	for i := 1; i < len(meta.Schema); i++ {
+		if strings.EqualFold(meta.Schema[i].Name, name) {
+			elem = meta.Schema[i]
+			break
+		}
+		i += parquetDescendantCnt(meta.Schema, i)
+	}
+	if elem == nil {
+		return nil, moerr.NewInvalidInput(ctx, "the column '%s' is not found in the parquet file '%s'", name, path)
+	}
+	if elem.GetNumChildren() > 0 || elem.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+		return nil, moerr.NewNotSupported(ctx, "the nested parquet column '%s'", elem.Name)
+	}
+	typ, err := ParquetTypeToMoType(ctx, elem)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &parquetColumn{
+		elem:  elem,
+		chunk: -1,
+		typ:   typ,
+		unit:  parquetTimeUnit(elem),
+	}
+	if len(meta.RowGroups) > 0 {
+		for i, chunk := range meta.RowGroups[0].Columns {
+			if p := chunk.GetMetaData().GetPathInSchema(); len(p) == 1 && p[0] == elem.Name {
+				c.chunk = i
+				break
+			}
+		}
+	}
+	return c, nil
+}
+
+func parquetDescendantCnt(schema []*parquet.SchemaElement, idx int) int {
+	cnt := 0
+	for i := 0; i < int(schema[idx].GetNumChildren()); i++ {
+		cnt += 1 + parquetDescendantCnt(schema, idx+cnt+1)
+	}
+	return cnt
+}
+
+// ScanParquetFile read batch data from the parquet file, one row group may be split into several batches.
+func ScanParquetFile(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	_, span := trace.Start(ctx, "ScanParquetFile")
+	defer span.End()
+	var err error
+	if param.parqh == nil {
+		param.parqh, err = getParquetHandler(param, proc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	h := param.parqh
+	bat, err := h.getBatch(param, proc)
+	if err != nil {
+		param.parqh = nil
+		return nil, err
+	}
+
+	if h.offset >= len(h.meta.RowGroups) {
+		param.parqh = nil
+		param.Fileparam.FileFin++
+		if param.Fileparam.FileFin >= param.Fileparam.FileCnt {
+			param.Fileparam.End = true
+		}
+	}
+	return bat, nil
+}
+
+func (h *ParquetHandler) getBatch(param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	if !h.loaded {
+		for h.offset < len(h.meta.RowGroups) && !h.needRead(param, proc, h.offset) {
+			h.offset++
+		}
+		if h.offset >= len(h.meta.RowGroups) {
+			return makeBatch(param, 0, proc), nil
+		}
+		// the position of SeekToRowGroup starts from 1
+		if err := h.reader.SeekToRowGroupWithContext(param.Ctx, h.offset+1); err != nil {
+			return nil, moerr.NewInvalidInput(param.Ctx, "failed to read the parquet file '%s': %v", param.Fileparam.Filepath, err)
+		}
+		h.loaded = true
+		h.rows = 0
+	}
+
+	numRows := h.meta.RowGroups[h.offset].NumRows
+	n := int(numRows - h.rows)
+	if n > ONE_BATCH_MAX_ROW {
+		n = ONE_BATCH_MAX_ROW
+	}
+	bat := makeBatch(param, n, proc)
+	for j := 0; j < n; j++ {
+		row, err := h.reader.NextRowWithContext(param.Ctx)
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return nil, moerr.NewInvalidInput(param.Ctx, "failed to read the parquet file '%s': %v", param.Fileparam.Filepath, err)
+		}
+		for i, c := range h.cols {
+			vec := bat.Vecs[i]
+			if c == nil {
+				if catalog.ContainExternalHidenCol(param.Attrs[i]) {
+					err = vector.SetStringAt(vec, j, param.Fileparam.Filepath, proc.Mp())
+				}
+			} else {
+				err = c.setValue(vec, j, row[c.elem.Name], proc.Mp())
+			}
+			if err != nil {
+				bat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+
+	h.rows += int64(n)
+	if h.rows >= numRows {
+		h.loaded = false
+		h.offset++
+	}
+	bat.SetZs(n, proc.Mp())
+	bat.Cnt = 1
+	return bat, nil
+}
+
+// needRead returns false if the statistics of the row group show that no row can pass the filter.
+func (h *ParquetHandler) needRead(param *ExternalParam, proc *process.Process, rowGroup int) bool {
+	if !param.Filter.exprMono || param.Filter.FilterExpr == nil {
+		return true
+	}
+	chunks := h.meta.RowGroups[rowGroup].Columns
+	datas := make([][2]any, len(param.Filter.defColumns))
+	dataTypes := make([]uint8, len(param.Filter.defColumns))
+	for i, idx := range param.Filter.defColumns {
+		c := h.cols[idx]
+		if c == nil || c.chunk < 0 || c.chunk >= len(chunks) {
+			return true
+		}
+		dataTypes[i] = uint8(param.Cols[idx].Typ.Id)
+		min, max, ok := c.getMinMax(chunks[c.chunk].GetMetaData().GetStatistics(), types.T(dataTypes[i]))
+		if !ok {
+			return true
+		}
+		datas[i] = [2]any{min, max}
+	}
+	return evalFilterByMinMax(param, proc, datas, dataTypes)
+}
+
+func (c *parquetColumn) getInteger(v any) uint64 {
+	switch x := v.(type) {
+	case int32:
+		if c.typ.Oid.IsUnsignedInt() {
+			return uint64(uint32(x))
+		}
+		return uint64(int64(x))
+	case int64:
+		return uint64(x)
+	}
+	return 0
+}
+
+func (c *parquetColumn) getMicros(v any) int64 {
+	if x, ok := v.([12]byte); ok {
+		return goparquet.Int96ToTime(x).UnixMicro()
+	}
+	x := int64(c.getInteger(v))
+	if c.unit < 0 {
+		return x / -c.unit
+	}
+	return x * c.unit
+}
+
+func (c *parquetColumn) getDecimal128(v any) types.Decimal128 {
+	b, ok := v.([]byte)
+	if !ok {
+		x := int64(c.getInteger(v))
+		d := types.Decimal128{B0_63: uint64(x)}
+		if x < 0 {
+			d.B64_127 = math.MaxUint64
+		}
+		return d
+	}
+	// the unscaled value is a big-endian two's complement number
+	var d types.Decimal128
+	if len(b) > 0 && b[0]&0x80 != 0 {
+		d = types.Decimal128{B0_63: math.MaxUint64, B64_127: math.MaxUint64}
+	}
+	for _, x := range b {
+		d.B64_127 = d.B64_127<<8 | d.B0_63>>56
+		d.B0_63 = d.B0_63<<8 | uint64(x)
+	}
+	return d
+}
+
+func (c *parquetColumn) setValue(vec *vector.Vector, idx int, v any, mp *mpool.MPool) error {
+	if v == nil {
+		nulls.Add(vec.GetNulls(), uint64(idx))
+		return nil
+	}
+	switch vec.GetType().Oid {
+	case types.T_bool:
+		return vector.SetFixedAt(vec, idx, v.(bool))
+	case types.T_int8:
+		return vector.SetFixedAt(vec, idx, int8(c.getInteger(v)))
+	case types.T_int16:
+		return vector.SetFixedAt(vec, idx, int16(c.getInteger(v)))
+	case types.T_int32:
+		return vector.SetFixedAt(vec, idx, int32(c.getInteger(v)))
+	case types.T_int64:
+		return vector.SetFixedAt(vec, idx, int64(c.getInteger(v)))
+	case types.T_uint8:
+		return vector.SetFixedAt(vec, idx, uint8(c.getInteger(v)))
+	case types.T_uint16:
+		return vector.SetFixedAt(vec, idx, uint16(c.getInteger(v)))
+	case types.T_uint32:
+		return vector.SetFixedAt(vec, idx, uint32(c.getInteger(v)))
+	case types.T_uint64:
+		return vector.SetFixedAt(vec, idx, c.getInteger(v))
+	case types.T_float32:
+		return vector.SetFixedAt(vec, idx, v.(float32))
+	case types.T_float64:
+		if x, ok := v.(float32); ok {
+			return vector.SetFixedAt(vec, idx, float64(x))
+		}
+		return vector.SetFixedAt(vec, idx, v.(float64))
+	case types.T_decimal64:
+		return vector.SetFixedAt(vec, idx, types.Decimal64(c.getDecimal128(v).B0_63))
+	case types.T_decimal128:
+		return vector.SetFixedAt(vec, idx, c.getDecimal128(v))
+	case types.T_date:
+		return vector.SetFixedAt(vec, idx, unixEpochDate+types.Date(v.(int32)))
+	case types.T_time:
+		return vector.SetFixedAt(vec, idx, types.Time(c.getMicros(v)))
+	case types.T_timestamp:
+		return vector.SetFixedAt(vec, idx, types.UnixMicroToTimestamp(c.getMicros(v)))
+	case types.T_datetime:
+		return vector.SetFixedAt(vec, idx, types.UnixMicroToTimestamp(c.getMicros(v)).ToDatetime(time.UTC))
+	case types.T_uuid:
+		var u types.Uuid
+		copy(u[:], v.([]byte))
+		return vector.SetFixedAt(vec, idx, u)
+	case types.T_json:
+		byteJson, err := types.ParseStringToByteJson(string(v.([]byte)))
+		if err != nil {
+			return moerr.NewInvalidInputNoCtx("the value '%s' of the parquet column '%s' is not json", v, c.elem.Name)
+		}
+		jsonBytes, err := types.EncodeJson(byteJson)
+		if err != nil {
+			return err
+		}
+		return vector.SetBytesAt(vec, idx, jsonBytes, mp)
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		return vector.SetBytesAt(vec, idx, v.([]byte), mp)
+	}
+	return moerr.NewNotSupportedNoCtx("load the parquet column '%s' into the type %s", c.elem.Name, vec.GetType().String())
+}
+
+// getMinMax decodes the min and max values of the column chunk statistics as the values of the
+// table column type. It returns false if there are no statistics or the type is not supported.
+func (c *parquetColumn) getMinMax(stats *parquet.Statistics, oid types.T) (any, any, bool) {
+	if stats == nil {
+		return nil, nil, false
+	}
+	minBytes, maxBytes := stats.MinValue, stats.MaxValue
+	if minBytes == nil || maxBytes == nil {
+		// the deprecated min and max are ordered by signed comparison, they are
+		// trustworthy only for the plain numeric types.
+		if c.elem.LogicalType != nil || c.elem.ConvertedType != nil {
+			return nil, nil, false
+		}
+		minBytes, maxBytes = stats.Min, stats.Max
+	}
+	if minBytes == nil || maxBytes == nil {
+		return nil, nil, false
+	}
+	min, ok := c.decodePlain(minBytes)
+	if !ok {
+		return nil, nil, false
+	}
+	max, ok := c.decodePlain(maxBytes)
+	if !ok {
+		return nil, nil, false
+	}
+	if min, ok = c.getStatValue(min, oid); !ok {
+		return nil, nil, false
+	}
+	if max, ok = c.getStatValue(max, oid); !ok {
+		return nil, nil, false
+	}
+	return min, max, true
+}
+
+// decodePlain decodes the value of the statistics, which is in the PLAIN encoding
+// except that the byte arrays have no length prefix.
+func (c *parquetColumn) decodePlain(b []byte) (any, bool) {
+	switch c.elem.GetType() {
+	case parquet.Type_BOOLEAN:
+		if len(b) == 1 {
+			return b[0] != 0, true
+		}
+	case parquet.Type_INT32:
+		if len(b) == 4 {
+			return int32(binary.LittleEndian.Uint32(b)), true
+		}
+	case parquet.Type_INT64:
+		if len(b) == 8 {
+			return int64(binary.LittleEndian.Uint64(b)), true
+		}
+	case parquet.Type_FLOAT:
+		if len(b) == 4 {
+			return math.Float32frombits(binary.LittleEndian.Uint32(b)), true
+		}
+	case parquet.Type_DOUBLE:
+		if len(b) == 8 {
+			return math.Float64frombits(binary.LittleEndian.Uint64(b)), true
+		}
+	case parquet.Type_BYTE_ARRAY:
+		return b, true
+	}
+	return nil, false
+}
+
+func (c *parquetColumn) getStatValue(v any, oid types.T) (any, bool) {
+	switch oid {
+	case types.T_bool:
+		return v, true
+	case types.T_int8:
+		return int8(c.getInteger(v)), true
+	case types.T_int16:
+		return int16(c.getInteger(v)), true
+	case types.T_int32:
+		return int32(c.getInteger(v)), true
+	case types.T_int64:
+		return int64(c.getInteger(v)), true
+	case types.T_uint8:
+		return uint8(c.getInteger(v)), true
+	case types.T_uint16:
+		return uint16(c.getInteger(v)), true
+	case types.T_uint32:
+		return uint32(c.getInteger(v)), true
+	case types.T_uint64:
+		return c.getInteger(v), true
+	case types.T_float32:
+		return v, true
+	case types.T_float64:
+		if x, ok := v.(float32); ok {
+			return float64(x), true
+		}
+		return v, true
+	case types.T_date:
+		return unixEpochDate + types.Date(v.(int32)), true
+	case types.T_timestamp:
+		return types.UnixMicroToTimestamp(c.getMicros(v)), true
+	case types.T_datetime:
+		return types.UnixMicroToTimestamp(c.getMicros(v)).ToDatetime(time.UTC), true
+	case types.T_char, types.T_varchar, types.T_text:
+		return v, true
+	}
+	return nil, false
+}
@@ -0,0 +1,294 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	goparquet "github.com/fraugster/parquet-go"
+	"github.com/fraugster/parquet-go/parquet"
+	"github.com/fraugster/parquet-go/parquetschema"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const parquetTestSchema = `message test {
+	required int64 a;
+	optional binary b (STRING);
+	required double c;
+	required int32 d (DATE);
+	required int64 e (TIMESTAMP(MICROS, true));
+	required int64 f (DECIMAL(10, 2));
+}`
+
+// writeParquetFile writes 30 rows into 3 row groups, the column a is from 0 to 29.
+func writeParquetFile(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "test.parquet")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	sd, err := parquetschema.ParseSchemaDefinition(parquetTestSchema)
+	require.NoError(t, err)
+	w := goparquet.NewFileWriter(f,
+		goparquet.WithSchemaDefinition(sd),
+		goparquet.WithCompressionCodec(parquet.CompressionCodec_ZSTD))
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 10; j++ {
+			n := int64(i*10 + j)
+			row := map[string]interface{}{
+				"a": n,
+				"c": float64(n) / 2,
+				"d": int32(n),
+				"e": n * 1000000,
+				"f": n * 100,
+			}
+			if n%2 == 1 {
+				row["b"] = []byte(fmt.Sprintf("str%d", n))
+			}
+			require.NoError(t, w.AddData(row))
+		}
+		require.NoError(t, w.FlushRowGroup())
+	}
+	require.NoError(t, w.Close())
+	return path
+}
+
+func newParquetParam(proc *process.Process, path string, cols []*plan.ColDef, filter *plan.Expr) *ExternalParam {
+	attrs := make([]string, len(cols))
+	name2ColIndex := make(map[string]int32, len(cols))
+	for i, col := range cols {
+		attrs[i] = col.Name
+		name2ColIndex[col.Name] = int32(i)
+	}
+	return &ExternalParam{
+		ExParamConst: ExParamConst{
+			Attrs:         attrs,
+			Cols:          cols,
+			FileList:      []string{path},
+			Name2ColIndex: name2ColIndex,
+			Ctx:           context.Background(),
+			Extern: &tree.ExternParam{
+				ExParamConst: tree.ExParamConst{
+					Filepath: path,
+					Format:   tree.PARQUET,
+					Tail:     &tree.TailParameter{},
+				},
+				ExParam: tree.ExParam{
+					FileService: proc.FileService,
+					Ctx:         context.Background(),
+				},
+			},
+		},
+		ExParam: ExParam{
+			Fileparam: &ExFileparam{},
+			Filter: &FilterParam{
+				FilterExpr: filter,
+			},
+		},
+	}
+}
+
+func scanParquet(t *testing.T, proc *process.Process, param *ExternalParam) []*batch.Batch {
+	require.NoError(t, Prepare(proc, &Argument{Es: param}))
+	param.Fileparam.Filepath = param.FileList[0]
+	param.Fileparam.FileIndex = 1
+	var bats []*batch.Batch
+	for !param.Fileparam.End {
+		bat, err := ScanFileData(context.Background(), param, proc)
+		require.NoError(t, err)
+		if bat.Length() > 0 {
+			bats = append(bats, bat)
+		}
+	}
+	return bats
+}
+
+func makeColDef(name string, typ types.Type) *plan.ColDef {
+	return &plan.ColDef{
+		Name: name,
+		Typ: &plan.Type{
+			Id:    int32(typ.Oid),
+			Width: typ.Width,
+			Scale: typ.Scale,
+		},
+	}
+}
+
+func TestParquetTypeToMoType(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		column string
+		expect types.Type
+	}{
+		{"required boolean a;", types.T_bool.ToType()},
+		{"required int32 a;", types.T_int32.ToType()},
+		{"required double a;", types.T_float64.ToType()},
+		{"required binary a;", types.T_blob.ToType()},
+		{"required int96 a;", types.New(types.T_timestamp, 0, 6)},
+		{"required int32 a (INT(8, true));", types.T_int8.ToType()},
+		{"required int32 a (INT(32, false));", types.T_uint32.ToType()},
+		{"required int32 a (UINT_16);", types.T_uint16.ToType()},
+		{"required binary a (STRING);", types.T_varchar.ToType()},
+		{"required binary a (UTF8);", types.T_varchar.ToType()},
+		{"required binary a (JSON);", types.T_json.ToType()},
+		{"required fixed_len_byte_array(16) a (UUID);", types.T_uuid.ToType()},
+		{"required int32 a (DATE);", types.T_date.ToType()},
+		{"required int64 a (TIME(MICROS, true));", types.New(types.T_time, 0, 6)},
+		{"required int64 a (TIMESTAMP(MILLIS, true));", types.New(types.T_timestamp, 0, 3)},
+		{"required int64 a (TIMESTAMP(MICROS, false));", types.New(types.T_datetime, 0, 6)},
+		{"required int64 a (DECIMAL(10, 2));", types.New(types.T_decimal64, 10, 2)},
+		{"required fixed_len_byte_array(16) a (DECIMAL(30, 4));", types.New(types.T_decimal128, 30, 4)},
+	}
+	for _, c := range cases {
+		sd, err := parquetschema.ParseSchemaDefinition("message test { " + c.column + " }")
+		require.NoError(t, err, c.column)
+		typ, err := ParquetTypeToMoType(ctx, sd.RootColumn.Children[0].SchemaElement)
+		require.NoError(t, err, c.column)
+		require.Equal(t, c.expect, typ, c.column)
+	}
+
+	sd, err := parquetschema.ParseSchemaDefinition("message test { required fixed_len_byte_array(20) a (DECIMAL(40, 0)); }")
+	require.NoError(t, err)
+	_, err = ParquetTypeToMoType(ctx, sd.RootColumn.Children[0].SchemaElement)
+	require.Error(t, err)
+}
+
+func TestScanParquetFile(t *testing.T) {
+	path := writeParquetFile(t)
+	proc := testutil.NewProcess()
+	proc.FileService = testutil.NewFS()
+
+	// only the projected columns are read, and the int64 of a is widened into decimal
+	cols := []*plan.ColDef{
+		makeColDef("a", types.T_int64.ToType()),
+		makeColDef("B", types.T_varchar.ToType()),
+		makeColDef("d", types.T_date.ToType()),
+		makeColDef("e", types.New(types.T_timestamp, 0, 6)),
+		makeColDef("f", types.New(types.T_decimal128, 20, 2)),
+	}
+	param := newParquetParam(proc, path, cols, nil)
+	bats := scanParquet(t, proc, param)
+	require.Equal(t, 3, len(bats))
+
+	row := int64(0)
+	for _, bat := range bats {
+		require.Equal(t, 5, len(bat.Vecs))
+		as := vector.MustFixedCol[int64](bat.Vecs[0])
+		ds := vector.MustFixedCol[types.Date](bat.Vecs[2])
+		es := vector.MustFixedCol[types.Timestamp](bat.Vecs[3])
+		fs := vector.MustFixedCol[types.Decimal128](bat.Vecs[4])
+		for i := 0; i < bat.Length(); i++ {
+			require.Equal(t, row, as[i])
+			if row%2 == 0 {
+				require.True(t, bat.Vecs[1].GetNulls().Contains(uint64(i)))
+			} else {
+				require.Equal(t, fmt.Sprintf("str%d", row), bat.Vecs[1].GetStringAt(i))
+			}
+			require.Equal(t, types.DateFromCalendar(1970, 1, 1)+types.Date(row), ds[i])
+			require.Equal(t, types.UnixToTimestamp(row), es[i])
+			require.Equal(t, types.Decimal128{B0_63: uint64(row * 100)}, fs[i])
+			row++
+		}
+	}
+	require.Equal(t, int64(30), row)
+}
+
+func TestScanParquetFileSkipRowGroups(t *testing.T) {
+	path := writeParquetFile(t)
+	proc := testutil.NewProcess()
+	proc.FileService = testutil.NewFS()
+
+	int64Typ := types.T_int64.ToType()
+	fid, retTyp, _, err := function.GetFunctionByName(context.Background(), ">=", []types.Type{int64Typ, int64Typ})
+	require.NoError(t, err)
+	// a >= 25
+	filter := &plan.Expr{
+		Typ: &plan.Type{Id: int32(retTyp.Oid)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fid, ObjName: ">="},
+				Args: []*plan.Expr{
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "a"}},
+					},
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: 25}}},
+					},
+				},
+			},
+		},
+	}
+
+	cols := []*plan.ColDef{
+		makeColDef("a", int64Typ),
+		makeColDef("c", types.T_float64.ToType()),
+	}
+	param := newParquetParam(proc, path, cols, filter)
+	bats := scanParquet(t, proc, param)
+	// only the last row group can match the filter
+	require.Equal(t, 1, len(bats))
+	require.Equal(t, int64(20), vector.MustFixedCol[int64](bats[0].Vecs[0])[0])
+	require.Equal(t, float64(10), vector.MustFixedCol[float64](bats[0].Vecs[1])[0])
+}
+
+func TestScanParquetFileIncompatibleType(t *testing.T) {
+	path := writeParquetFile(t)
+	proc := testutil.NewProcess()
+	proc.FileService = testutil.NewFS()
+
+	for _, col := range []*plan.ColDef{
+		makeColDef("a", types.T_int32.ToType()),
+		makeColDef("b", types.T_int64.ToType()),
+		makeColDef("f", types.New(types.T_decimal64, 18, 4)),
+		makeColDef("not_exist", types.T_int64.ToType()),
+	} {
+		param := newParquetParam(proc, path, []*plan.ColDef{col}, nil)
+		require.NoError(t, Prepare(proc, &Argument{Es: param}))
+		param.Fileparam.Filepath = path
+		param.Fileparam.FileIndex = 1
+		_, err := ScanFileData(context.Background(), param, proc)
+		require.Error(t, err, col.Name)
+	}
+}
+
+func TestZstdCompressor(t *testing.T) {
+	var c zstdCompressor
+	data := []byte("the pages of the parquet file share the zstd codec")
+	compressed, err := c.CompressBlock(data)
+	require.NoError(t, err)
+	decompressed, err := c.DecompressBlock(compressed)
+	require.NoError(t, err)
+	require.Equal(t, data, decompressed)
+
+	w1, r1, err := getZstdCodec()
+	require.NoError(t, err)
+	w2, r2, err := getZstdCodec()
+	require.NoError(t, err)
+	require.True(t, w1 == w2 && r1 == r2)
+}
@@ -64,6 +64,7 @@ type ExParam struct {
 	prevStr   string
 	reader    io.ReadCloser
 	plh       *ParseLineHandler
+	parqh     *ParquetHandler
 	Fileparam *ExFileparam
 	Zoneparam *ZonemapFileparam
 	Filter    *FilterParam
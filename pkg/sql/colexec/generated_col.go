@@ -0,0 +1,155 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// FillGeneratedColumns computes the generated columns of the rows to write.
+// The STORED columns are filled with the values of their expressions, the
+// VIRTUAL ones are computed on read, so they are only checked against NOT NULL
+// and filled with NULLs.
+func FillGeneratedColumns(proc *process.Process, bat *batch.Batch, tableDef *plan.TableDef) error {
+	var nameToPos map[string]int32
+	for _, col := range tableDef.Cols {
+		gen := col.GetDefault().GetGenerated()
+		if gen == nil {
+			continue
+		}
+		if nameToPos == nil {
+			nameToPos = batchNameToPos(bat)
+		}
+		pos, ok := nameToPos[col.Name]
+		if !ok {
+			continue
+		}
+
+		expr, err := exprOfBatch(proc, gen.Expr, nameToPos)
+		if err != nil {
+			return err
+		}
+		vec, err := EvalExpr(bat, proc, expr)
+		if err != nil {
+			return err
+		}
+		result, err := generatedResult(proc, bat, col, vec, gen.Stored)
+		if !vectorInBatch(bat, vec) {
+			vec.Free(proc.Mp())
+		}
+		if err != nil {
+			return err
+		}
+
+		bat.Vecs[pos].Free(proc.Mp())
+		bat.SetVector(pos, result)
+	}
+	return nil
+}
+
+// exprOfBatch returns a copy of an expression stored in the table definition,
+// whose columns refer to the vectors of the batch by their names. The
+// expression is shared by the operators of the table, so it is not changed in
+// place.
+func exprOfBatch(proc *process.Process, expr *plan.Expr, nameToPos map[string]int32) (*plan.Expr, error) {
+	data, err := expr.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	expr = &plan.Expr{}
+	if err = expr.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	if err = remapColRefsByName(proc, expr, nameToPos); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+func remapColRefsByName(proc *process.Process, expr *plan.Expr, nameToPos map[string]int32) error {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if err := remapColRefsByName(proc, arg, nameToPos); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		for _, e := range exprImpl.List.List {
+			if err := remapColRefsByName(proc, e, nameToPos); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_Col:
+		pos, ok := nameToPos[exprImpl.Col.Name]
+		if !ok {
+			return moerr.NewInternalError(proc.Ctx, "column '%s' not found in batch", exprImpl.Col.Name)
+		}
+		exprImpl.Col.RelPos = 0
+		exprImpl.Col.ColPos = pos
+	}
+	return nil
+}
+
+func generatedResult(proc *process.Process, bat *batch.Batch, col *plan.ColDef, vec *vector.Vector, stored bool) (*vector.Vector, error) {
+	length := bat.Length()
+	typ := types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale)
+	if col.NotNull || !col.Default.NullAbility {
+		if vec.IsConstNull() || (!vec.IsConst() && nulls.Any(vec.GetNulls())) {
+			return nil, moerr.NewConstraintViolation(proc.Ctx, fmt.Sprintf("Column '%s' cannot be null", col.Name))
+		}
+	}
+	if !stored {
+		vec = vector.NewConstNull(typ, length, proc.Mp())
+	}
+
+	result := proc.GetVector(typ)
+	var err error
+	if vec.IsConst() {
+		err = result.UnionMulti(vec, 0, length, proc.Mp())
+	} else {
+		err = vector.GetUnionFunction(typ, proc.Mp())(result, vec)
+	}
+	if err != nil {
+		result.Free(proc.Mp())
+		return nil, err
+	}
+	return result, nil
+}
+
+func batchNameToPos(bat *batch.Batch) map[string]int32 {
+	nameToPos := make(map[string]int32, len(bat.Attrs))
+	for i, attr := range bat.Attrs {
+		nameToPos[attr] = int32(i)
+	}
+	return nameToPos
+}
+
+func vectorInBatch(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
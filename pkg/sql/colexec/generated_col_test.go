@@ -0,0 +1,120 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newGeneratedTestTableDef(t *testing.T, notNull bool) *plan.TableDef {
+	int64Typ := &plan.Type{Id: int32(types.T_int64)}
+	id, _, _, err := function.GetFunctionByName(context.Background(), "+",
+		[]types.Type{types.T_int64.ToType(), types.T_int64.ToType()})
+	require.NoError(t, err)
+	// a + 1
+	expr := &plan.Expr{
+		Typ: int64Typ,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: id, ObjName: "+"},
+				Args: []*plan.Expr{
+					{
+						Typ:  int64Typ,
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "a"}},
+					},
+					{
+						Typ:  int64Typ,
+						Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: 1}}},
+					},
+				},
+			},
+		},
+	}
+	return &plan.TableDef{
+		Name: "t",
+		Cols: []*plan.ColDef{
+			{Name: "b", Typ: int64Typ, Default: &plan.Default{
+				NullAbility: true,
+				Generated:   &plan.GeneratedCol{Expr: expr, Stored: true},
+			}},
+			{Name: "a", Typ: int64Typ, Default: &plan.Default{NullAbility: true}},
+			{Name: "c", Typ: int64Typ, NotNull: notNull, Default: &plan.Default{
+				NullAbility: !notNull,
+				Generated:   &plan.GeneratedCol{Expr: expr},
+			}},
+		},
+	}
+}
+
+func newGeneratedTestBatch(t *testing.T, a []int64, aNulls []bool) *batch.Batch {
+	proc := testutil.NewProcess()
+	bat := batch.New(true, []string{"b", "a", "c"})
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.NewVec(types.T_int64.ToType())
+	}
+	for i := range a {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(0), true, proc.Mp()))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[1], a[i], aNulls[i], proc.Mp()))
+		require.NoError(t, vector.AppendFixed(bat.Vecs[2], int64(0), true, proc.Mp()))
+	}
+	bat.SetZs(len(a), proc.Mp())
+	return bat
+}
+
+func TestFillGeneratedColumns(t *testing.T) {
+	proc := testutil.NewProcess()
+	tableDef := newGeneratedTestTableDef(t, false)
+	bat := newGeneratedTestBatch(t, []int64{1, 2, 0}, []bool{false, false, true})
+
+	require.NoError(t, FillGeneratedColumns(proc, bat, tableDef))
+	// the stored column is computed
+	b := bat.GetVector(0)
+	require.Equal(t, 3, b.Length())
+	require.Equal(t, []int64{2, 3}, vector.MustFixedCol[int64](b)[:2])
+	require.True(t, b.GetNulls().Contains(2))
+	// the virtual column is not stored
+	c := bat.GetVector(2)
+	require.Equal(t, 3, c.Length())
+	for i := uint64(0); i < 3; i++ {
+		require.True(t, c.GetNulls().Contains(i))
+	}
+	// the expression shared by the operators is not changed
+	require.Equal(t, int32(0), tableDef.Cols[0].Default.Generated.Expr.GetF().Args[0].GetCol().ColPos)
+	require.NoError(t, BatchDataNotNullCheck(bat, tableDef, proc.Ctx))
+}
+
+func TestFillGeneratedColumnsNotNull(t *testing.T) {
+	proc := testutil.NewProcess()
+	tableDef := newGeneratedTestTableDef(t, true)
+
+	bat := newGeneratedTestBatch(t, []int64{1, 2}, []bool{false, false})
+	require.NoError(t, FillGeneratedColumns(proc, bat, tableDef))
+	// the virtual not null column is checked before it's filled with nulls
+	require.NoError(t, BatchDataNotNullCheck(bat, tableDef, proc.Ctx))
+
+	bat = newGeneratedTestBatch(t, []int64{1, 0}, []bool{false, true})
+	err := FillGeneratedColumns(proc, bat, tableDef)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrConstraintViolation))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_col/group_concat"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -196,6 +197,11 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	var err error
 	bat := proc.InputBatch()
 	if bat == nil {
+		if ctr.parts != nil {
+			if err := ctr.mergeSpilledGroups(proc); err != nil {
+				return false, err
+			}
+		}
 		if ctr.bat != nil {
 			if ap.NeedEval {
 				for i, ag := range ctr.bat.Aggs {
@@ -322,7 +328,49 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	if err != nil {
 		return false, err
 	}
-	return false, err
+	return false, ctr.spillGroups(proc)
+}
+
+// spillGroups writes the groups collected so far to the local file service
+// if the query exceeds its memory budget, the groups start again from scratch
+// and all of them are merged partition by partition at the end.
+func (ctr *container) spillGroups(proc *process.Process) error {
+	if !spill.CanSpillGroups(ctr.bat.Aggs) {
+		return nil
+	}
+	if !spill.NeedSpill(proc, int64(ctr.bat.Size())+ctr.hashMapSize()) {
+		return nil
+	}
+	if ctr.parts == nil {
+		parts, err := spill.NewPartitions(proc, spill.DefaultPartitions)
+		if err != nil {
+			return err
+		}
+		ctr.parts = parts
+	}
+	if err := ctr.parts.SpillGroups(proc, ctr.bat); err != nil {
+		return err
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.cleanHashMap()
+	return nil
+}
+
+func (ctr *container) mergeSpilledGroups(proc *process.Process) error {
+	if ctr.bat != nil {
+		if err := ctr.parts.SpillGroups(proc, ctr.bat); err != nil {
+			return err
+		}
+		ctr.cleanBatch(proc.Mp())
+		ctr.cleanHashMap()
+	}
+	bat, err := ctr.parts.MergeGroups(proc)
+	if err != nil {
+		return err
+	}
+	ctr.bat = bat
+	ctr.cleanPartitions(proc)
+	return nil
 }
 
 func (ctr *container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
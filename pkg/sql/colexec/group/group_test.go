@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -122,6 +123,51 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	int64Typ := types.T_int64.ToType()
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	// every batch exceeds the memory budget, so the groups are spilled after each batch
+	proc.Lim.SpillSize = 1
+	arg := &Argument{
+		NeedEval: true,
+		Exprs:    []*plan.Expr{newExpression(0)},
+		Aggs:     []agg.Aggregate{{Op: agg.AggregateSum, E: newExpression(1)}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+
+	inputs := [][2][]int64{
+		{{1, 2, 3, 1}, {10, 20, 30, 40}},
+		{{2, 3, 4}, {1, 2, 3}},
+	}
+	for _, input := range inputs {
+		proc.Reg.InputBatch = testutil.NewBatchWithVectors([]*vector.Vector{
+			testutil.NewInt64Vector(len(input[0]), int64Typ, proc.Mp(), false, input[0]),
+			testutil.NewInt64Vector(len(input[1]), int64Typ, proc.Mp(), false, input[1]),
+		}, nil)
+		_, err := Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		require.Nil(t, arg.ctr.bat)
+		require.NotNil(t, arg.ctr.parts)
+	}
+	proc.Reg.InputBatch = nil
+	end, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	result := make(map[int64]int64)
+	bat := proc.Reg.InputBatch
+	keys := vector.MustFixedCol[int64](bat.Vecs[0])
+	sums := vector.MustFixedCol[int64](bat.Vecs[1])
+	for i, key := range keys {
+		result[key] = sums[i]
+	}
+	require.Equal(t, map[int64]int64{1: 50, 2: 21, 3: 32, 4: 3}, result)
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+	proc.FreeVectors()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []groupTestCase{
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/multi_col/group_concat"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -64,6 +65,9 @@ type container struct {
 	mapAggType map[int32]int
 
 	bat *batch.Batch
+
+	// parts holds the groups spilled under memory pressure.
+	parts *spill.Partitions
 }
 
 type Argument struct {
@@ -83,6 +87,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
 		ctr.cleanHashMap()
+		ctr.cleanPartitions(proc)
 	}
 }
 
@@ -146,3 +151,20 @@ func (ctr *container) cleanHashMap() {
 		ctr.strHashMap = nil
 	}
 }
+
+func (ctr *container) cleanPartitions(proc *process.Process) {
+	if ctr.parts != nil {
+		ctr.parts.Free(proc)
+		ctr.parts = nil
+	}
+}
+
+func (ctr *container) hashMapSize() int64 {
+	switch {
+	case ctr.intHashMap != nil:
+		return ctr.intHashMap.Size()
+	case ctr.strHashMap != nil:
+		return ctr.strHashMap.Size()
+	}
+	return 0
+}
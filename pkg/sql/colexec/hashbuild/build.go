@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -43,13 +44,17 @@ func Prepare(proc *process.Process, arg any) error {
 		ap.ctr.evecs = make([]evalVector, len(ap.Conditions))
 		ap.ctr.nullSels = make([]int32, 0)
 	}
-	ap.ctr.bat = batch.NewWithSize(len(ap.Typs))
-	ap.ctr.bat.Zs = proc.Mp().GetSels()
+	ap.ctr.bat = newEmptyBatch(ap, proc)
+	return nil
+}
+
+func newEmptyBatch(ap *Argument, proc *process.Process) *batch.Batch {
+	bat := batch.NewWithSize(len(ap.Typs))
+	bat.Zs = proc.Mp().GetSels()
 	for i, typ := range ap.Typs {
-		ap.ctr.bat.Vecs[i] = vector.NewVec(typ)
+		bat.Vecs[i] = vector.NewVec(typ)
 	}
-
-	return nil
+	return bat
 }
 
 func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool, error) {
@@ -70,7 +75,14 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (bool,
 			}
 			ctr.state = End
 		default:
-			if ctr.bat != nil {
+			if ctr.parts != nil {
+				// the rows are spilled, send the partitions with an empty batch
+				ctr.cleanHashMap()
+				ctr.bat.Ht = ctr.parts
+				proc.SetInputBatch(ctr.bat)
+				ctr.bat = nil
+				ctr.parts = nil
+			} else if ctr.bat != nil {
 				if ap.NeedHashMap {
 					ctr.bat.Ht = hashmap.NewJoinMap(ctr.sels, ctr.nullSels, nil, ctr.mp, ctr.hasNull)
 				}
@@ -108,12 +120,77 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 			return err
 		}
 		bat.Clean(proc.Mp())
+		if ap.CanSpill {
+			if err = ctr.spill(ap, proc, anal, false); err != nil {
+				return err
+			}
+		}
+	}
+	if ctr.parts != nil {
+		if err = ctr.spill(ap, proc, anal, true); err != nil {
+			return err
+		}
+		return ctr.parts.Flush(proc)
 	}
 	if ctr.bat == nil || ctr.bat.Length() == 0 || !ap.NeedHashMap {
 		return nil
 	}
+	return ctr.buildHashMap(ap, proc, anal)
+}
+
+// spill writes the rows by partitions if the query exceeds its memory budget,
+// all the following rows are spilled too once it happens.
+func (ctr *container) spill(ap *Argument, proc *process.Process, anal process.Analyze, force bool) error {
+	if ctr.parts == nil {
+		if !force && !spill.NeedSpill(proc, int64(ctr.bat.Size())) {
+			return nil
+		}
+		parts, err := spill.NewPartitions(proc, spill.DefaultPartitions)
+		if err != nil {
+			return err
+		}
+		ctr.parts = parts
+	}
+	if ctr.bat.Length() == 0 {
+		return nil
+	}
+	ctr.cleanEvalVectors(proc.Mp())
+	if err := ctr.evalJoinCondition(ctr.bat, ap.Conditions, proc, anal); err != nil {
+		return err
+	}
+	if err := ctr.parts.Write(proc, ctr.bat, ctr.vecs); err != nil {
+		return err
+	}
+	ctr.cleanEvalVectors(proc.Mp())
+	ctr.cleanBatch(proc.Mp())
+	ctr.bat = newEmptyBatch(ap, proc)
+	return nil
+}
+
+// NewJoinMap builds the hash map of bat on the join conditions, it is used
+// to join the rows spilled by partitions.
+func NewJoinMap(proc *process.Process, anal process.Analyze, bat *batch.Batch, conds []*plan.Expr) (*hashmap.JoinMap, error) {
+	mp, err := hashmap.NewStrMap(false, 0, 0, proc.Mp())
+	if err != nil {
+		return nil, err
+	}
+	ctr := &container{
+		bat:   bat,
+		mp:    mp,
+		vecs:  make([]*vector.Vector, len(conds)),
+		evecs: make([]evalVector, len(conds)),
+	}
+	defer ctr.cleanEvalVectors(proc.Mp())
+	if err = ctr.buildHashMap(&Argument{Conditions: conds}, proc, anal); err != nil {
+		mp.Free()
+		return nil, err
+	}
+	return hashmap.NewJoinMap(ctr.sels, nil, nil, mp, ctr.hasNull), nil
+}
+
+func (ctr *container) buildHashMap(ap *Argument, proc *process.Process, anal process.Analyze) error {
 	ctr.cleanEvalVectors(proc.Mp())
-	if err = ctr.evalJoinCondition(ctr.bat, ap.Conditions, proc, anal); err != nil {
+	if err := ctr.evalJoinCondition(ctr.bat, ap.Conditions, proc, anal); err != nil {
 		return err
 	}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -49,6 +50,9 @@ type container struct {
 	mp *hashmap.StrHashMap
 
 	nullSels []int32
+
+	// parts holds the rows spilled under memory pressure.
+	parts *spill.Partitions
 }
 
 type Argument struct {
@@ -62,6 +66,9 @@ type Argument struct {
 	Conditions  []*plan.Expr
 
 	IsRight bool
+	// CanSpill means the rows can be spilled by partitions under memory
+	// pressure, the join then joins the spilled rows partition by partition.
+	CanSpill bool
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
@@ -73,6 +80,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		if !arg.NeedHashMap {
 			ctr.cleanHashMap()
 		}
+		ctr.cleanPartitions(proc)
 	}
 }
 
@@ -98,3 +106,10 @@ func (ctr *container) cleanHashMap() {
 		ctr.mp = nil
 	}
 }
+
+func (ctr *container) cleanPartitions(proc *process.Process) {
+	if ctr.parts != nil {
+		ctr.parts.Free(proc)
+		ctr.parts = nil
+	}
+}
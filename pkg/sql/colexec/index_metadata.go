@@ -16,6 +16,7 @@ package colexec
 
 import (
 	"context"
+	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -279,7 +280,11 @@ func buildInsertIndexMetaBatch(tableId uint64, databaseId uint64, ct *engine.Con
 					if err != nil {
 						return nil, err
 					}
-					err = vector.AppendBytes(vec_options, []byte(""), true, proc.Mp())
+					if index.IndexAlgo != "" {
+						err = vector.AppendBytes(vec_options, []byte(indexAlgoOptions(index)), false, proc.Mp())
+					} else {
+						err = vector.AppendBytes(vec_options, []byte(""), true, proc.Mp())
+					}
 					if err != nil {
 						return nil, err
 					}
@@ -363,3 +368,11 @@ func buildInsertIndexMetaBatch(tableId uint64, databaseId uint64, ct *engine.Con
 	bat.SetZs(bat.GetVector(0).Length(), proc.Mp())
 	return bat, nil
 }
+
+// indexAlgoOptions returns the options of the index algorithm kept in mo_indexes
+func indexAlgoOptions(index *plan.IndexDef) string {
+	if index.IndexAlgoLists > 0 {
+		return fmt.Sprintf("algo=%s,lists=%d", index.IndexAlgo, index.IndexAlgoLists)
+	}
+	return fmt.Sprintf("algo=%s", index.IndexAlgo)
+}
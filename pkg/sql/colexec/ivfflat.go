@@ -0,0 +1,159 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The most ivfflat indexes whose centroids are cached
+const ivfflatCentroidsCacheSize = 1024
+
+// ivfflatCentroids are the centroids of the lists of an ivfflat index, the centroid
+// of the list lists[i] is centroids[i].
+type ivfflatCentroids struct {
+	lists     []int64
+	centroids [][]byte
+}
+
+// The centroids are written only when the index table is created, and an index
+// table is never reused by another index, so they are cached by the table id.
+var ivfflatCentroidsCache = struct {
+	sync.Mutex
+	m map[uint64]*ivfflatCentroids
+}{
+	m: make(map[uint64]*ivfflatCentroids),
+}
+
+// IsIvfflatIndex returns whether the index is an ivfflat index, whose index table
+// is written by DML as well as the unique indexes.
+func IsIvfflatIndex(indexDef *plan.IndexDef) bool {
+	return indexDef.IndexAlgo == catalog.IndexAlgoIvfflat && indexDef.TableExist
+}
+
+// HasIvfflatIndex returns whether the table has an ivfflat index.
+func HasIvfflatIndex(tableDef *plan.TableDef) bool {
+	for _, indexDef := range tableDef.Indexes {
+		if IsIvfflatIndex(indexDef) {
+			return true
+		}
+	}
+	return false
+}
+
+// writeIvfflatTable writes the rows of the primary keys of the batch into the lists
+// of the nearest centroids of the ivfflat index.
+func writeIvfflatTable(proc *process.Process, bat *batch.Batch, indexDef *plan.IndexDef,
+	nameToPos map[string]int, pkPos int, rel engine.Relation) error {
+	if pkPos == -1 {
+		return nil
+	}
+	lists, centroids, err := GetIvfflatCentroids(proc.Ctx, proc, rel)
+	if err != nil {
+		return err
+	}
+	// the index was created on an empty table, the search reads the whole table
+	if len(lists) == 0 {
+		return nil
+	}
+
+	listBat, err := util.BuildIvfflatListBatch(bat.Vecs[nameToPos[indexDef.Parts[0]]], bat.Vecs[pkPos], lists, centroids, proc)
+	if err != nil {
+		return err
+	}
+	defer listBat.Clean(proc.Mp())
+	if listBat.Length() == 0 {
+		return nil
+	}
+	return rel.Write(proc.Ctx, listBat)
+}
+
+// GetIvfflatCentroids returns the lists of the ivfflat index and their centroids, the centroid
+// of the list lists[i] is centroids[i]. There is no list if the index was created on an empty
+// table.
+func GetIvfflatCentroids(ctx context.Context, proc *process.Process, rel engine.Relation) ([]int64, [][]byte, error) {
+	id := rel.GetTableID(ctx)
+	ivfflatCentroidsCache.Lock()
+	cs, ok := ivfflatCentroidsCache.m[id]
+	ivfflatCentroidsCache.Unlock()
+	if ok {
+		return cs.lists, cs.centroids, nil
+	}
+
+	cs, err := readIvfflatCentroids(ctx, proc, rel)
+	if err != nil {
+		return nil, nil, err
+	}
+	ivfflatCentroidsCache.Lock()
+	defer ivfflatCentroidsCache.Unlock()
+	if len(ivfflatCentroidsCache.m) >= ivfflatCentroidsCacheSize {
+		for k := range ivfflatCentroidsCache.m {
+			delete(ivfflatCentroidsCache.m, k)
+			break
+		}
+	}
+	ivfflatCentroidsCache.m[id] = cs
+	return cs.lists, cs.centroids, nil
+}
+
+// readIvfflatCentroids reads the centroid rows, which have no primary key, of the index table.
+func readIvfflatCentroids(ctx context.Context, proc *process.Process, rel engine.Relation) (*ivfflatCentroids, error) {
+	ranges, err := rel.Ranges(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	rds, err := rel.NewReader(ctx, 1, nil, ranges)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, rd := range rds {
+			_ = rd.Close()
+		}
+	}()
+
+	cs := new(ivfflatCentroids)
+	attrs := []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName, catalog.IndexTableCentroidColName}
+	for _, rd := range rds {
+		for {
+			bat, err := rd.Read(ctx, attrs, nil, proc.Mp(), nil)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			ids := vector.MustFixedCol[int64](bat.Vecs[0])
+			for i, id := range ids {
+				if !bat.Vecs[1].GetNulls().Contains(uint64(i)) || bat.Vecs[2].GetNulls().Contains(uint64(i)) {
+					continue
+				}
+				cs.lists = append(cs.lists, id)
+				cs.centroids = append(cs.centroids, append([]byte(nil), bat.Vecs[2].GetBytesAt(i)...))
+			}
+			bat.Clean(proc.Mp())
+		}
+	}
+	return cs, nil
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -60,12 +62,28 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 
 			if bat == nil {
 				ctr.state = End
+				if ctr.buildParts != nil {
+					if err := ctr.probeParts.Flush(proc); err != nil {
+						ap.Free(proc, true)
+						return false, err
+					}
+					ctr.state = ProbeSpilled
+				}
 				continue
 			}
 			if bat.Length() == 0 {
 				bat.Clean(proc.Mp())
 				continue
 			}
+			if ctr.buildParts != nil {
+				err := ctr.spillProbe(bat, ap, proc, anal, isFirst)
+				proc.PutBatch(bat)
+				if err != nil {
+					ap.Free(proc, true)
+					return false, err
+				}
+				continue
+			}
 			if ctr.bat == nil || ctr.bat.Length() == 0 {
 				proc.PutBatch(bat)
 				continue
@@ -76,6 +94,30 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 			}
 			return false, nil
 
+		case ProbeSpilled:
+			if len(ctr.blocks) == 0 {
+				if ctr.part == ctr.buildParts.Len() {
+					ctr.state = End
+					continue
+				}
+				if err := ctr.loadPartition(ap, proc, anal); err != nil {
+					ap.Free(proc, true)
+					return false, err
+				}
+				continue
+			}
+			bat, err := ctr.blocks[0].Read(proc.Ctx, proc.Mp())
+			if err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			ctr.blocks = ctr.blocks[1:]
+			if err := ctr.probe(bat, ap, proc, anal, false, isLast); err != nil {
+				ap.Free(proc, true)
+				return false, err
+			}
+			return false, nil
+
 		default:
 			ap.Free(proc, false)
 			proc.SetInputBatch(nil)
@@ -89,6 +131,17 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	bat := <-proc.Reg.MergeReceivers[1].Ch
 	anal.WaitStop(start)
 	if bat != nil {
+		// the build side is spilled, so is the probe side
+		if parts, ok := bat.Ht.(*spill.Partitions); ok {
+			bat.Clean(proc.Mp())
+			ctr.buildParts = parts
+			probeParts, err := spill.NewPartitions(proc, parts.Len())
+			if err != nil {
+				return err
+			}
+			ctr.probeParts = probeParts
+			return nil
+		}
 		ctr.bat = bat
 		ctr.mp = bat.Ht.(*hashmap.JoinMap).Dup()
 		anal.Alloc(ctr.mp.Map().Size())
@@ -96,6 +149,54 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 	return nil
 }
 
+// spillProbe spills the probe rows by the same partitions as the build side.
+func (ctr *container) spillProbe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool) error {
+	anal.Input(bat, isFirst)
+	idxFlg := false
+	if err := ctr.evalJoinCondition(bat, ap.Conditions[0], proc, &idxFlg, anal); err != nil {
+		return err
+	}
+	defer ctr.cleanEvalVectors(proc.Mp())
+	return ctr.probeParts.Write(proc, bat, ctr.vecs)
+}
+
+// loadPartition loads the build rows of the next partition and builds its hash map,
+// the partition is skipped if either side has no rows.
+func (ctr *container) loadPartition(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	ctr.cleanBatch(proc.Mp())
+	ctr.cleanHashMap()
+
+	part := ctr.part
+	ctr.part++
+	if len(ctr.probeParts.Blocks(part)) == 0 {
+		return nil
+	}
+	var err error
+	for _, blk := range ctr.buildParts.Blocks(part) {
+		bat, err := blk.Read(proc.Ctx, proc.Mp())
+		if err != nil {
+			return err
+		}
+		if ctr.bat == nil {
+			ctr.bat = bat
+			continue
+		}
+		ctr.bat, err = ctr.bat.Append(proc.Ctx, proc.Mp(), bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	if ctr.bat == nil {
+		return nil
+	}
+	if ctr.mp, err = hashbuild.NewJoinMap(proc, anal, ctr.bat, ap.Conditions[1]); err != nil {
+		return err
+	}
+	ctr.blocks = ctr.probeParts.Blocks(part)
+	return nil
+}
+
 func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool, isLast bool) error {
 	defer proc.PutBatch(bat)
 	anal.Input(bat, isFirst)
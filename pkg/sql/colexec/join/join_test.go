@@ -17,15 +17,18 @@ package join
 import (
 	"bytes"
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -110,6 +113,58 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinSpill(t *testing.T) {
+	int64Typ := types.T_int64.ToType()
+	tc := newTestCase([]bool{false}, []types.Type{int64Typ}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+		[][]*plan.Expr{
+			{
+				newExpr(0, int64Typ),
+			},
+			{
+				newExpr(0, int64Typ),
+			},
+		})
+	tc.arg.Cond = nil
+	tc.barg.CanSpill = true
+	// the build side exceeds the memory budget, so both sides are spilled
+	tc.proc.Lim.SpillSize = 1
+	nb0 := tc.proc.Mp().CurrNB()
+
+	bat := hashBuildWithBatch(t, tc, testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(4, int64Typ, tc.proc.Mp(), false, []int64{1, 2, 3, 3}),
+	}, nil))
+	require.Equal(t, 0, bat.Length())
+	_, ok := bat.Ht.(*spill.Partitions)
+	require.True(t, ok)
+
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(4, int64Typ, tc.proc.Mp(), false, []int64{3, 1, 4, 1}),
+	}, nil)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- bat
+
+	var probe, build []int64
+	for {
+		ok, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		if ok {
+			break
+		}
+		rbat := tc.proc.Reg.InputBatch
+		probe = append(probe, vector.MustFixedCol[int64](rbat.Vecs[0])...)
+		build = append(build, vector.MustFixedCol[int64](rbat.Vecs[1])...)
+		rbat.Clean(tc.proc.Mp())
+	}
+	sort.Slice(probe, func(i, j int) bool { return probe[i] < probe[j] })
+	sort.Slice(build, func(i, j int) bool { return build[i] < build[j] })
+	require.Equal(t, []int64{1, 1, 3, 3}, probe)
+	require.Equal(t, probe, build)
+	tc.arg.Free(tc.proc, false)
+	tc.proc.FreeVectors()
+	require.Equal(t, nb0, tc.proc.Mp().CurrNB())
+}
+
 /*
 func TestLowCardinalityJoin(t *testing.T) {
 	tc := newTestCase([]bool{false}, []types.Type{types.T_varchar.ToType()}, []colexec.ResultPos{colexec.NewResultPos(1, 0)},
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -28,6 +29,7 @@ import (
 const (
 	Build = iota
 	Probe
+	ProbeSpilled
 	End
 )
 
@@ -47,6 +49,15 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.JoinMap
+
+	// buildParts and probeParts are the rows of both sides spilled by
+	// partitions, they are joined partition by partition.
+	buildParts *spill.Partitions
+	probeParts *spill.Partitions
+	// part is the next partition to join, and blocks are the probe
+	// rows of the partition being joined.
+	part   int
+	blocks []spill.Block
 }
 
 type Argument struct {
@@ -65,6 +76,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
 		ctr.cleanHashMap()
+		ctr.cleanPartitions(proc)
 	}
 }
 
@@ -90,3 +102,15 @@ func (ctr *container) cleanEvalVectors(mp *mpool.MPool) {
 		}
 	}
 }
+
+func (ctr *container) cleanPartitions(proc *process.Process) {
+	if ctr.buildParts != nil {
+		ctr.buildParts.Free(proc)
+		ctr.buildParts = nil
+	}
+	if ctr.probeParts != nil {
+		ctr.probeParts.Free(proc)
+		ctr.probeParts = nil
+	}
+	ctr.blocks = nil
+}
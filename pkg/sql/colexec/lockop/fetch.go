@@ -53,7 +53,7 @@ func GetFetchRowsFunc(t types.Type) FetchLockRowsFunc {
 		return fetchBoolRows
 	case types.T_int8:
 		return fetchInt8Rows
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return fetchInt16Rows
 	case types.T_int32:
 		return fetchInt32Rows
@@ -61,11 +61,11 @@ func GetFetchRowsFunc(t types.Type) FetchLockRowsFunc {
 		return fetchInt64Rows
 	case types.T_uint8:
 		return fetchUint8Rows
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return fetchUint16Rows
 	case types.T_uint32:
 		return fetchUint32Rows
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return fetchUint64Rows
 	case types.T_float32:
 		return fetchFloat32Rows
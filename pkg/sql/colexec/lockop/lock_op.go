@@ -103,7 +103,7 @@ func Call(
 			priVec,
 			target.primaryColumnType,
 			DefaultLockOptions(arg.parker).
-				WithLockMode(lock.LockMode_Exclusive).
+				WithLockMode(arg.mode).
 				WithFetchLockRowsFunc(target.fetcher).
 				WithMaxBytesPerLock(int(proc.LockService.GetConfig().MaxLockRowBytes)).
 				WithFilterRows(target.filter, filterCols),
@@ -274,6 +274,14 @@ func NewArgument() *Argument {
 	return &Argument{}
 }
 
+// WithLockMode set the lock mode of all lock targets, Exclusive is the default. The
+// Shared mode is used to read rows which can not be modified by others, e.g. SELECT
+// ... FOR SHARE and foreign key checks.
+func (arg *Argument) WithLockMode(mode lock.LockMode) *Argument {
+	arg.mode = mode
+	return arg
+}
+
 // AddLockTarget add lock targets
 func (arg *Argument) AddLockTarget(
 	tableID uint64,
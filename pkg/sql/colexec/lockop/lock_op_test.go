@@ -99,6 +99,42 @@ func TestCallLockOpWithConflict(t *testing.T) {
 	)
 }
 
+func TestCallLockOpWithSharedLock(t *testing.T) {
+	runLockOpTest(
+		t,
+		[]uint64{1},
+		[][]int32{{0, 1, 2}},
+		func(proc *process.Process, arg *Argument) {
+			require.NoError(t, Prepare(proc, arg))
+			arg.WithLockMode(lock.LockMode_Shared)
+
+			arg.parker.Reset()
+			arg.parker.EncodeInt32(0)
+			sharedRow := arg.parker.Bytes()
+			_, err := proc.LockService.Lock(
+				proc.Ctx,
+				1,
+				[][]byte{sharedRow},
+				[]byte("txn01"),
+				lock.LockOptions{Granularity: lock.Granularity_Row, Mode: lock.LockMode_Shared})
+			require.NoError(t, err)
+
+			// no conflict with the shared lock
+			_, err = Call(0, proc, arg, false, false)
+			require.NoError(t, err)
+
+			vec := proc.InputBatch().GetVector(1)
+			values := vector.MustFixedCol[types.TS](vec)
+			assert.Equal(t, 3, len(values))
+			for _, v := range values {
+				assert.Equal(t, types.TS{}, v)
+			}
+			require.NoError(t, proc.LockService.Unlock(proc.Ctx, []byte("txn01"), timestamp.Timestamp{}))
+		},
+		client.WithEnableRefreshExpression(),
+	)
+}
+
 func TestCallLockOpWithConflictWithRefreshNotEnabled(t *testing.T) {
 	runLockOpTest(
 		t,
@@ -51,6 +51,7 @@ type LockOptions struct {
 // Argument lock op argument.
 type Argument struct {
 	parker  *types.Packer
+	mode    lock.LockMode
 	targets []lockTarget
 }
 
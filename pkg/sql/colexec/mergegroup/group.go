@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -63,6 +64,12 @@ func Call(idx int, proc *process.Process, arg interface{}, isFirst bool, isLast
 			}
 			ctr.state = Eval
 		case Eval:
+			if ctr.parts != nil {
+				if err := ctr.mergeSpilledGroups(proc); err != nil {
+					ctr.state = End
+					return false, err
+				}
+			}
 			if ctr.bat != nil {
 				if ap.NeedEval {
 					for i, agg := range ctr.bat.Aggs {
@@ -178,6 +185,51 @@ func (ctr *container) process(bat *batch.Batch, proc *process.Process) error {
 	if err != nil {
 		return err
 	}
+	if ctr.typ == H0 {
+		return nil
+	}
+	return ctr.spillGroups(proc)
+}
+
+// spillGroups writes the groups merged so far to the local file service if
+// the query exceeds its memory budget, the groups start again from scratch
+// and all of them are merged partition by partition at the end.
+func (ctr *container) spillGroups(proc *process.Process) error {
+	if !spill.CanSpillGroups(ctr.bat.Aggs) {
+		return nil
+	}
+	if !spill.NeedSpill(proc, int64(ctr.bat.Size())+ctr.hashMapSize()) {
+		return nil
+	}
+	if ctr.parts == nil {
+		parts, err := spill.NewPartitions(proc, spill.DefaultPartitions)
+		if err != nil {
+			return err
+		}
+		ctr.parts = parts
+	}
+	if err := ctr.parts.SpillGroups(proc, ctr.bat); err != nil {
+		return err
+	}
+	ctr.cleanBatch(proc.Mp())
+	ctr.cleanHashMap()
+	return nil
+}
+
+func (ctr *container) mergeSpilledGroups(proc *process.Process) error {
+	if ctr.bat != nil {
+		if err := ctr.parts.SpillGroups(proc, ctr.bat); err != nil {
+			return err
+		}
+		ctr.cleanBatch(proc.Mp())
+		ctr.cleanHashMap()
+	}
+	bat, err := ctr.parts.MergeGroups(proc)
+	if err != nil {
+		return err
+	}
+	ctr.bat = bat
+	ctr.cleanPartitions(proc)
 	return nil
 }
 
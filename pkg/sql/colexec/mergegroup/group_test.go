@@ -22,6 +22,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
@@ -127,6 +129,33 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	tc := newTestCase(nil, true, nil)
+	// every batch exceeds the memory budget, so the groups are spilled after each batch
+	tc.proc.Lim.SpillSize = 1
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	// the partial results of sum(v) group by k
+	tc.proc.Reg.MergeReceivers[0].Ch <- newGroups(t, tc.proc, []int64{1, 2, 3}, []int64{10, 20, 30})
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- newGroups(t, tc.proc, []int64{3, 4, 1}, []int64{1, 2, 3})
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	end, err := Call(0, tc.proc, tc.arg, false, false)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	result := make(map[int64]int64)
+	bat := tc.proc.Reg.InputBatch
+	keys := vector.MustFixedCol[int64](bat.Vecs[0])
+	sums := vector.MustFixedCol[int64](bat.Vecs[1])
+	for i, key := range keys {
+		result[key] = sums[i]
+	}
+	require.Equal(t, map[int64]int64{1: 13, 2: 20, 3: 31, 4: 2}, result)
+	bat.Clean(tc.proc.Mp())
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []groupTestCase{
@@ -188,3 +217,21 @@ func newTestCase(flgs []bool, needEval bool, ts []types.Type) groupTestCase {
 func newBatch(t *testing.T, flgs []bool, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
 	return testutil.NewBatch(ts, false, int(rows), proc.Mp())
 }
+
+// newGroups returns the partial result of sum(v) group by k, keys are distinct.
+func newGroups(t *testing.T, proc *process.Process, keys, vs []int64) *batch.Batch {
+	typ := types.T_int64.ToType()
+	bat := testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(len(keys), typ, proc.Mp(), false, keys),
+	}, nil)
+	ag, err := agg.New(agg.AggregateSum, false, typ)
+	require.NoError(t, err)
+	require.NoError(t, ag.Grows(len(keys), proc.Mp()))
+	vec := testutil.NewInt64Vector(len(vs), typ, proc.Mp(), false, vs)
+	for i := range vs {
+		require.NoError(t, ag.Fill(int64(i), int64(i), 1, []*vector.Vector{vec}))
+	}
+	vec.Free(proc.Mp())
+	bat.Aggs = []agg.Agg[any]{ag}
+	return bat
+}
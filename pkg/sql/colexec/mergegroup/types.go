@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -46,6 +47,9 @@ type container struct {
 
 	bat *batch.Batch
 
+	// parts holds the groups spilled under memory pressure.
+	parts *spill.Partitions
+
 	// aliveMergeReceiver is a count for no-close receiver
 	aliveMergeReceiver int
 	// receiverListener is a structure to listen all the merge receiver.
@@ -63,6 +67,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
 		ctr.cleanHashMap()
+		ctr.cleanPartitions(proc)
 		ctr.cleanReceiver(mp)
 	}
 }
@@ -85,6 +90,23 @@ func (ctr *container) cleanHashMap() {
 	}
 }
 
+func (ctr *container) cleanPartitions(proc *process.Process) {
+	if ctr.parts != nil {
+		ctr.parts.Free(proc)
+		ctr.parts = nil
+	}
+}
+
+func (ctr *container) hashMapSize() int64 {
+	switch {
+	case ctr.intHashMap != nil:
+		return ctr.intHashMap.Size()
+	case ctr.strHashMap != nil:
+		return ctr.strHashMap.Size()
+	}
+	return 0
+}
+
 func (ctr *container) cleanReceiver(mp *mpool.MPool) {
 	listeners := ctr.receiverListener
 	alive := len(listeners)
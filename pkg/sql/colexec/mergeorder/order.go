@@ -21,9 +21,11 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -68,6 +70,11 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 	anal.Start()
 	defer anal.Stop()
 
+	// output the rest of the spilled runs
+	if len(ctr.cursors) > 0 {
+		return ctr.outputRuns(proc, ap, anal, isLast)
+	}
+
 	// get batch from merge receivers and do merge sort.
 	// save the unordered result in ctr.bat.
 	// save the ordered index list in ctr.finalSelectList
@@ -94,12 +101,28 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (b
 		if err = mergeSort(proc, bat, ap, ctr, anal); err != nil {
 			break
 		}
+		if err = ctr.spillRun(proc, false); err != nil {
+			break
+		}
 	}
 	if err != nil {
 		ap.Free(proc, true)
 		return false, err
 	}
 
+	// some rows are spilled, merge all the sorted runs.
+	if len(ctr.runs) > 0 {
+		if err = ctr.spillRun(proc, true); err != nil {
+			ap.Free(proc, true)
+			return false, err
+		}
+		if err = ctr.openRuns(proc); err != nil {
+			ap.Free(proc, true)
+			return false, err
+		}
+		return ctr.outputRuns(proc, ap, anal, isLast)
+	}
+
 	// remove and clean unnecessary vector
 	// shuffle the ctr.bat
 	if ctr.bat != nil {
@@ -266,6 +289,136 @@ func (ctr *container) mergeSort2(bat2 *batch.Batch, proc *process.Process) error
 	return nil
 }
 
+// spillRun writes the sorted rows to a new run if the query exceeds its memory budget.
+func (ctr *container) spillRun(proc *process.Process, force bool) error {
+	if ctr.bat == nil {
+		return nil
+	}
+	if !force && !spill.NeedSpill(proc, int64(ctr.bat.Size())) {
+		return nil
+	}
+	if ctr.spiller == nil {
+		spiller, err := spill.New(proc)
+		if err != nil {
+			return err
+		}
+		ctr.spiller = spiller
+	}
+
+	var bats []*batch.Batch
+	defer func() {
+		for _, bat := range bats {
+			bat.Clean(proc.Mp())
+		}
+	}()
+	sels := make([]int32, 0, spill.RunBatchRows)
+	for i := 0; i < len(ctr.finalSelectList); i += spill.RunBatchRows {
+		sels = sels[:0]
+		for j := i; j < len(ctr.finalSelectList) && j < i+spill.RunBatchRows; j++ {
+			sels = append(sels, int32(ctr.finalSelectList[j]))
+		}
+		bat := batch.NewWithSize(len(ctr.bat.Vecs))
+		bats = append(bats, bat)
+		bat.Zs = make([]int64, len(sels))
+		for j, sel := range sels {
+			bat.Zs[j] = ctr.bat.Zs[sel]
+		}
+		for j, vec := range ctr.bat.Vecs {
+			bat.Vecs[j] = vector.NewVec(*vec.GetType())
+			if err := bat.Vecs[j].Union(vec, sels, proc.Mp()); err != nil {
+				return err
+			}
+		}
+	}
+	run, err := ctr.spiller.Write(proc.Ctx, bats...)
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, run)
+	ctr.cleanBatch(proc.Mp())
+	ctr.finalSelectList = nil
+	return nil
+}
+
+// openRuns reads the first batch of each run to start the merge.
+func (ctr *container) openRuns(proc *process.Process) error {
+	for _, run := range ctr.runs {
+		if run.Len() == 0 {
+			continue
+		}
+		bat, err := run.Read(proc.Ctx, 0, proc.Mp())
+		if err != nil {
+			return err
+		}
+		ctr.cursors = append(ctr.cursors, &cursor{run: run, bat: bat})
+	}
+	return nil
+}
+
+// outputRuns merges the spilled runs and outputs at most spill.RunBatchRows
+// rows each time, it returns true once all the rows are output.
+func (ctr *container) outputRuns(proc *process.Process, ap *Argument, anal process.Analyze, isLast bool) (bool, error) {
+	bat := batch.NewWithSize(ctr.n)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.NewVec(*ctr.cursors[0].bat.Vecs[i].GetType())
+	}
+	for bat.Length() < spill.RunBatchRows && len(ctr.cursors) > 0 {
+		k := ctr.minCursor()
+		c := ctr.cursors[k]
+		for i := range bat.Vecs {
+			if err := bat.Vecs[i].UnionOne(c.bat.Vecs[i], c.row, proc.Mp()); err != nil {
+				bat.Clean(proc.Mp())
+				ap.Free(proc, true)
+				return false, err
+			}
+		}
+		bat.Zs = append(bat.Zs, c.bat.Zs[c.row])
+		if c.row++; c.row < int64(c.bat.Length()) {
+			continue
+		}
+		c.bat.Clean(proc.Mp())
+		if c.idx++; c.idx < c.run.Len() {
+			b, err := c.run.Read(proc.Ctx, c.idx, proc.Mp())
+			if err != nil {
+				ctr.cursors = append(ctr.cursors[:k], ctr.cursors[k+1:]...)
+				bat.Clean(proc.Mp())
+				ap.Free(proc, true)
+				return false, err
+			}
+			c.bat, c.row = b, 0
+			continue
+		}
+		ctr.cursors = append(ctr.cursors[:k], ctr.cursors[k+1:]...)
+	}
+	anal.Output(bat, isLast)
+	proc.SetInputBatch(bat)
+	if len(ctr.cursors) > 0 {
+		return false, nil
+	}
+	ap.Free(proc, false)
+	return true, nil
+}
+
+// minCursor returns the cursor whose current row comes first in the order.
+func (ctr *container) minCursor() int {
+	k := 0
+	for i := 1; i < len(ctr.cursors); i++ {
+		for j, cmp := range ctr.cmps {
+			cmp.Set(0, ctr.cursors[i].bat.GetVector(ctr.compare0Index[j]))
+			cmp.Set(1, ctr.cursors[k].bat.GetVector(ctr.compare0Index[j]))
+		}
+		for _, cmp := range ctr.cmps {
+			if r := cmp.Compare(0, 1, ctr.cursors[i].row, ctr.cursors[k].row); r != 0 {
+				if r < 0 {
+					k = i
+				}
+				break
+			}
+		}
+	}
+	return k
+}
+
 func generateSelectList(j int64) []int64 {
 	list := make([]int64, j)
 	var i int64
@@ -150,6 +150,38 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	int64Typ := types.T_int64.ToType()
+	tc := newTestCase([]types.Type{int64Typ}, []*plan.OrderBySpec{{Expr: newExpression(0), Flag: 0}})
+	// every batch exceeds the memory budget, so each one is spilled as a sorted run
+	tc.proc.Lim.SpillSize = 1
+	require.NoError(t, Prepare(tc.proc, tc.arg))
+	tc.proc.Reg.MergeReceivers[0].Ch <- testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(3, int64Typ, tc.proc.Mp(), false, []int64{1, 4, 6}),
+	}, nil)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(4, int64Typ, tc.proc.Mp(), false, []int64{2, 3, 5, 7}),
+	}, nil)
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+	var result []int64
+	for {
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		bat := tc.proc.Reg.InputBatch
+		require.Equal(t, 1, len(bat.Vecs))
+		result = append(result, vector.MustFixedCol[int64](bat.Vecs[0])...)
+		bat.Clean(tc.proc.Mp())
+		if end {
+			break
+		}
+	}
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, result)
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs := []orderTestCase{
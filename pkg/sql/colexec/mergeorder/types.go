@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -40,6 +41,20 @@ type container struct {
 	unionFlag                    []uint8
 	compare0Index, compare1Index []int32
 	finalSelectList              []int64
+
+	// spiller and runs hold the sorted runs spilled under memory pressure,
+	// cursors are used to merge them once all the batches are received.
+	spiller *spill.Spiller
+	runs    []*spill.Run
+	cursors []*cursor
+}
+
+// cursor is the current position of a spilled run during the merge.
+type cursor struct {
+	run *spill.Run
+	idx int // index of bat in the run
+	bat *batch.Batch
+	row int64
 }
 
 type Argument struct {
@@ -52,6 +67,8 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	if ctr != nil {
 		mp := proc.Mp()
 		ctr.cleanBatch(mp)
+		ctr.cleanCursors(mp)
+		ctr.cleanSpiller(proc)
 		ctr.cleanReceiver(mp)
 	}
 }
@@ -63,6 +80,21 @@ func (ctr *container) cleanBatch(mp *mpool.MPool) {
 	}
 }
 
+func (ctr *container) cleanCursors(mp *mpool.MPool) {
+	for _, c := range ctr.cursors {
+		c.bat.Clean(mp)
+	}
+	ctr.cursors = nil
+}
+
+func (ctr *container) cleanSpiller(proc *process.Process) {
+	if ctr.spiller != nil {
+		ctr.spiller.Free(proc.Ctx)
+		ctr.spiller = nil
+		ctr.runs = nil
+	}
+}
+
 func (ctr *container) cleanReceiver(mp *mpool.MPool) {
 	listeners := ctr.receiverListener
 	alive := len(listeners)
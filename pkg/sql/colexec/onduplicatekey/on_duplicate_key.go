@@ -106,17 +106,12 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 	oldRowIdVec := vector.MustFixedCol[types.Rowid](originBatch.Vecs[rowIdIdx])
 	delRowIdVec := vector.NewVec(types.T_Rowid.ToType())
 
-	var oldUniqueRowIdVec []types.Rowid
-	var oldUniquePkVec *vector.Vector
-	var delUniqueRowIdVec *vector.Vector
-	var delUniquePkVec *vector.Vector
-	if len(insertArg.IdxIdx) > 0 {
-		// for now, only support one unique constraint
-		oldUniqueRowIdVec = vector.MustFixedCol[types.Rowid](originBatch.Vecs[insertArg.IdxIdx[0]])
-		oldUniquePkVec = originBatch.Vecs[insertArg.IdxIdx[0]+1]
-
-		delUniqueRowIdVec = vector.NewVec(types.T_Rowid.ToType())
-		delUniquePkVec = vector.NewVec(*oldUniquePkVec.GetType())
+	// the rows of the index tables of the old rows, which are deleted with the old rows
+	oldUniqueRowIdVecs := make([][]types.Rowid, len(insertArg.IdxIdx))
+	delUniqueRowIdVecs := make([]*vector.Vector, len(insertArg.IdxIdx))
+	for j, idx := range insertArg.IdxIdx {
+		oldUniqueRowIdVecs[j] = vector.MustFixedCol[types.Rowid](originBatch.Vecs[idx])
+		delUniqueRowIdVecs[j] = vector.NewVec(types.T_Rowid.ToType())
 	}
 
 	for i := 0; i < originBatch.Length(); i++ {
@@ -177,12 +172,12 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 					return nil, err
 				}
 
-				if len(insertArg.IdxIdx) > 0 {
-					err := vector.AppendFixed(delUniqueRowIdVec, oldUniqueRowIdVec[i], false, proc.GetMPool())
-					if err != nil {
-						return nil, err
+				for j, idx := range insertArg.IdxIdx {
+					// the old row may be in no list of the ivfflat index
+					if originBatch.Vecs[idx].GetNulls().Contains(uint64(i)) {
+						continue
 					}
-					err = delUniquePkVec.UnionOne(oldUniquePkVec, int64(i), proc.GetMPool())
+					err := vector.AppendFixed(delUniqueRowIdVecs[j], oldUniqueRowIdVecs[j][i], false, proc.GetMPool())
 					if err != nil {
 						return nil, err
 					}
@@ -220,17 +215,15 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 		}
 
 		// delete unique table rows
-		if len(insertArg.IdxIdx) > 0 {
-			// when refactor finish, we use these code:
-			// deleteUniqueBatch := batch.New(true, []string{catalog.Row_ID, catalog.IndexTableIndexColName})
-			// deleteUniqueBatch.SetZs(delUniqueRowIdVec.Length(), proc.Mp())
-			// deleteUniqueBatch.SetVector(0, delUniqueRowIdVec)
-			// deleteUniqueBatch.SetVector(1, delUniquePkVec)
+		for j, delUniqueRowIdVec := range delUniqueRowIdVecs {
+			if delUniqueRowIdVec.Length() == 0 {
+				continue
+			}
 			deleteUniqueBatch := batch.New(true, []string{catalog.Row_ID})
 			deleteUniqueBatch.SetZs(delUniqueRowIdVec.Length(), proc.Mp())
 			deleteUniqueBatch.SetVector(0, delUniqueRowIdVec)
 
-			err := insertArg.UniqueSource[0].Delete(proc.Ctx, deleteUniqueBatch, catalog.Row_ID)
+			err := insertArg.UniqueSource[j].Delete(proc.Ctx, deleteUniqueBatch, catalog.Row_ID)
 			if err != nil {
 				deleteUniqueBatch.Clean(proc.Mp())
 				return nil, err
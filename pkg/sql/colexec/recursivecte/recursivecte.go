@@ -0,0 +1,198 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.UnionAll {
+		buf.WriteString(fmt.Sprintf("recursive cte(union all, max depth %d)", ap.MaxDepth))
+	} else {
+		buf.WriteString(fmt.Sprintf("recursive cte(union, max depth %d)", ap.MaxDepth))
+	}
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	var err error
+
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	if !ap.UnionAll {
+		ap.ctr.hashTable, err = hashmap.NewStrMap(true, 0, 0, proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Call sends the rows of the anchor to the next operator as soon as they arrive,
+// they are the work table of the first iteration. Once all of them are received,
+// the recursive member is run repeatedly over the rows produced by the last
+// iteration until no more rows are produced, and all the rows produced by the
+// recursive member are sent in one batch.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	bat := proc.InputBatch()
+	if bat == nil {
+		if err := ctr.recurse(ap, proc); err != nil {
+			ap.Free(proc, true)
+			return false, err
+		}
+		if ctr.bat == nil {
+			proc.SetInputBatch(nil)
+			ap.Free(proc, false)
+			return true, nil
+		}
+		anal.Alloc(int64(ctr.bat.Size()))
+		anal.Output(ctr.bat, isLast)
+		proc.SetInputBatch(ctr.bat)
+		ctr.bat = nil
+		ap.Free(proc, false)
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		proc.PutBatch(bat)
+		proc.SetInputBatch(&batch.Batch{})
+		return false, nil
+	}
+	anal.Input(bat, isFirst)
+
+	rows, err := ctr.distinct(ap, proc, bat)
+	if rows != bat {
+		proc.PutBatch(bat)
+	}
+	if err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+	if ctr.work, err = appendBatch(proc, ctr.work, rows); err != nil {
+		rows.Clean(proc.Mp())
+		ap.Free(proc, true)
+		return false, err
+	}
+	anal.Output(rows, isLast)
+	proc.SetInputBatch(rows)
+	return false, nil
+}
+
+func (ctr *container) recurse(ap *Argument, proc *process.Process) error {
+	for i := int64(1); ctr.work != nil && ctr.work.Length() > 0; i++ {
+		if i > ap.MaxDepth {
+			return moerr.NewCTEMaxRecursionDepth(proc.Ctx, i)
+		}
+		res, err := ap.Step(proc, ctr.work)
+		if err != nil {
+			return err
+		}
+		ctr.work.Clean(proc.Mp())
+		ctr.work = nil
+		if res == nil {
+			break
+		}
+
+		rows, err := ctr.distinct(ap, proc, res)
+		if rows != res {
+			res.Clean(proc.Mp())
+		}
+		if err != nil {
+			return err
+		}
+		ctr.work = rows
+		if ctr.bat, err = appendBatch(proc, ctr.bat, rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// distinct returns the rows of bat which have never been seen before,
+// bat itself is returned if the CTE is defined by UNION ALL.
+func (ctr *container) distinct(ap *Argument, proc *process.Process, bat *batch.Batch) (*batch.Batch, error) {
+	if ap.UnionAll {
+		return bat, nil
+	}
+
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i := range bat.Vecs {
+		rbat.Vecs[i] = vector.NewVec(*bat.Vecs[i].GetType())
+	}
+
+	inserted := make([]uint8, hashmap.UnitLimit)
+	count := bat.Length()
+	itr := ctr.hashTable.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		oldHashGroup := ctr.hashTable.GroupCount()
+
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		vs, _, err := itr.Insert(i, n, bat.Vecs)
+		if err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+		for j := range inserted[:n] {
+			inserted[j] = 0
+		}
+		rows := oldHashGroup
+		for j, v := range vs {
+			if v > rows {
+				// ensure that the same value will only be inserted once.
+				rows++
+				inserted[j] = 1
+				rbat.Zs = append(rbat.Zs, 1)
+			}
+		}
+
+		insertCount := int(ctr.hashTable.GroupCount() - oldHashGroup)
+		if insertCount > 0 {
+			for pos := range bat.Vecs {
+				if err := rbat.Vecs[pos].UnionBatch(bat.Vecs[pos], int64(i), insertCount, inserted[:n], proc.Mp()); err != nil {
+					rbat.Clean(proc.Mp())
+					return nil, err
+				}
+			}
+		}
+	}
+	return rbat, nil
+}
+
+// appendBatch appends a copy of src to dst, dst is created if it is nil.
+func appendBatch(proc *process.Process, dst, src *batch.Batch) (*batch.Batch, error) {
+	if dst == nil {
+		dst = batch.NewWithSize(len(src.Vecs))
+		for i, vec := range src.Vecs {
+			dst.Vecs[i] = vector.NewVec(*vec.GetType())
+		}
+	}
+	return dst.Append(proc.Ctx, proc.Mp(), src)
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+var int64Typ = types.T_int64.ToType()
+
+type recursiveCteTestCase struct {
+	arg    *Argument
+	proc   *process.Process
+	anchor [][]int64
+	expect []int64
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{UnionAll: true, MaxDepth: 10}, buf)
+	require.Equal(t, "recursive cte(union all, max depth 10)", buf.String())
+}
+
+func TestRecursiveCte(t *testing.T) {
+	tcs := []recursiveCteTestCase{
+		// n + 1 while n < 5
+		newTestCase(true, 1000, [][]int64{{1, 3}, {1}}, []int64{1, 3, 1, 2, 4, 2, 3, 5, 3, 4, 4, 5, 5},
+			func(n int64) (int64, bool) { return n + 1, n < 5 }),
+		// (n + 1) % 3, the duplicate rows are removed
+		newTestCase(false, 1000, [][]int64{{1, 1}, {0}}, []int64{1, 0, 2},
+			func(n int64) (int64, bool) { return (n + 1) % 3, true }),
+	}
+
+	for _, tc := range tcs {
+		rows, end, err := runTestCase(tc)
+		require.NoError(t, err)
+		require.True(t, end)
+		require.Equal(t, tc.expect, rows)
+		tc.arg.Free(tc.proc, false)
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+func TestMaxRecursionDepth(t *testing.T) {
+	tc := newTestCase(true, 10, [][]int64{{1}}, nil,
+		func(n int64) (int64, bool) { return n + 1, true })
+	_, _, err := runTestCase(tc)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCTEMaxRecursionDepth))
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
+func runTestCase(tc recursiveCteTestCase) ([]int64, bool, error) {
+	var rows []int64
+
+	if err := Prepare(tc.proc, tc.arg); err != nil {
+		return nil, false, err
+	}
+	collect := func() {
+		if bat := tc.proc.Reg.InputBatch; bat != nil {
+			rows = append(rows, vector.MustFixedCol[int64](bat.Vecs[0])...)
+			bat.Clean(tc.proc.Mp())
+		}
+	}
+	for _, vals := range tc.anchor {
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, vals)
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		if err != nil || end {
+			return nil, end, err
+		}
+		collect()
+	}
+	tc.proc.Reg.InputBatch = &batch.Batch{}
+	if _, err := Call(0, tc.proc, tc.arg, false, false); err != nil {
+		return nil, false, err
+	}
+	tc.proc.Reg.InputBatch = nil
+	end, err := Call(0, tc.proc, tc.arg, false, false)
+	if err != nil {
+		return nil, end, err
+	}
+	collect()
+	return rows, end, nil
+}
+
+// newTestCase returns a test case whose recursive member maps each row n of the
+// work table to f(n), and the row is dropped if f returns false.
+func newTestCase(unionAll bool, maxDepth int64, anchor [][]int64, expect []int64, f func(int64) (int64, bool)) recursiveCteTestCase {
+	return recursiveCteTestCase{
+		proc: testutil.NewProcessWithMPool(mpool.MustNewZero()),
+		arg: &Argument{
+			UnionAll: unionAll,
+			MaxDepth: maxDepth,
+			Step: func(proc *process.Process, workTable *batch.Batch) (*batch.Batch, error) {
+				var vals []int64
+				for _, n := range vector.MustFixedCol[int64](workTable.Vecs[0]) {
+					if v, ok := f(n); ok {
+						vals = append(vals, v)
+					}
+				}
+				if len(vals) == 0 {
+					return nil, nil
+				}
+				return newBatch(proc, vals), nil
+			},
+		},
+		anchor: anchor,
+		expect: expect,
+	}
+}
+
+func newBatch(proc *process.Process, vals []int64) *batch.Batch {
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(len(vals), int64Typ, proc.Mp(), false, vals),
+	}, nil)
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recursivecte
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// StepFunc runs the recursive member of a recursive CTE once, the work table
+// holds the rows produced by the last iteration.
+// The returned batch belongs to the caller, it is nil if no rows are produced.
+type StepFunc func(proc *process.Process, workTable *batch.Batch) (*batch.Batch, error)
+
+type container struct {
+	// hashTable is used to remove the duplicate rows if the CTE is defined by UNION.
+	hashTable *hashmap.StrHashMap
+
+	// work holds the rows produced by the last iteration.
+	work *batch.Batch
+	// bat holds all the rows produced by the recursive member.
+	bat *batch.Batch
+}
+
+type Argument struct {
+	ctr *container
+	// UnionAll is false if the anchor and the recursive member are combined by UNION DISTINCT.
+	UnionAll bool
+	// MaxDepth is the max number of iterations, it is @@cte_max_recursion_depth.
+	MaxDepth int64
+	Step     StepFunc
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanBatch(mp)
+		ctr.cleanHashMap()
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.work != nil {
+		ctr.work.Clean(mp)
+		ctr.work = nil
+	}
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+}
+
+func (ctr *container) cleanHashMap() {
+	if ctr.hashTable != nil {
+		ctr.hashTable.Free()
+		ctr.hashTable = nil
+	}
+}
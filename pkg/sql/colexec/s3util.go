@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -64,6 +65,9 @@ type S3Writer struct {
 
 	typs []types.Type
 	ufs  []func(*vector.Vector, *vector.Vector, int64) error // function pointers for type conversion
+
+	// the compression option of the table, see compress.ParseCompression
+	compression string
 }
 
 const (
@@ -121,6 +125,11 @@ func (w *S3Writer) SetSortIdx(sortIdx int) {
 	w.sortIndex = sortIdx
 }
 
+// SetCompression sets the compression option of the table, lz4 if it's empty.
+func (w *S3Writer) SetCompression(compression string) {
+	w.compression = compression
+}
+
 // AllocS3Writers Alloc S3 writers for origin table.
 func AllocS3Writers(tableDef *plan.TableDef) ([]*S3Writer, error) {
 	uniqueNums := 0
@@ -133,10 +142,11 @@ func AllocS3Writers(tableDef *plan.TableDef) ([]*S3Writer, error) {
 	writers := make([]*S3Writer, 1+uniqueNums)
 	for i := range writers {
 		writers[i] = &S3Writer{
-			sortIndex: -1,
-			pk:        make(map[string]struct{}),
-			idx:       int16(i),
-			sels:      make([]int64, options.DefaultBlockMaxRows),
+			sortIndex:   -1,
+			pk:          make(map[string]struct{}),
+			idx:         int16(i),
+			sels:        make([]int64, options.DefaultBlockMaxRows),
+			compression: getTableCompression(tableDef),
 		}
 		for j := 0; j < int(options.DefaultBlockMaxRows); j++ {
 			writers[i].sels[j] = int64(j)
@@ -188,6 +198,20 @@ func AllocS3Writers(tableDef *plan.TableDef) ([]*S3Writer, error) {
 	return writers, nil
 }
 
+// getTableCompression returns the compression option of the table
+func getTableCompression(tableDef *plan.TableDef) string {
+	for _, def := range tableDef.Defs {
+		if proDef, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
+			for _, p := range proDef.Properties.Properties {
+				if p.Key == catalog.SystemRelAttr_Compression {
+					return p.Value
+				}
+			}
+		}
+	}
+	return ""
+}
+
 func (w *S3Writer) ResetMetaLocBat() {
 	// A simple explanation of the two vectors held by metaLocBat
 	// vecs[0] to mark which table this metaLoc belongs to: [0] means insertTable itself, [1] means the first uniqueIndex table, [2] means the second uniqueIndex table and so on
@@ -351,7 +375,7 @@ func (w *S3Writer) MergeBlock(length int, proc *process.Process, cacheOvershold
 			merge = NewMerge(len(bats), sort.NewBoolLess(), getFixedCols[bool](bats, pos), nulls)
 		case types.T_int8:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[int8](), getFixedCols[int8](bats, pos), nulls)
-		case types.T_int16:
+		case types.T_int16, types.T_year:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[int16](), getFixedCols[int16](bats, pos), nulls)
 		case types.T_int32:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[int32](), getFixedCols[int32](bats, pos), nulls)
@@ -359,11 +383,11 @@ func (w *S3Writer) MergeBlock(length int, proc *process.Process, cacheOvershold
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[int64](), getFixedCols[int64](bats, pos), nulls)
 		case types.T_uint8:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint8](), getFixedCols[uint8](bats, pos), nulls)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint16](), getFixedCols[uint16](bats, pos), nulls)
 		case types.T_uint32:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint32](), getFixedCols[uint32](bats, pos), nulls)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[uint64](), getFixedCols[uint64](bats, pos), nulls)
 		case types.T_float32:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[float32](), getFixedCols[float32](bats, pos), nulls)
@@ -383,7 +407,7 @@ func (w *S3Writer) MergeBlock(length int, proc *process.Process, cacheOvershold
 			merge = NewMerge(len(bats), sort.NewDecimal128Less(), getFixedCols[types.Decimal128](bats, pos), nulls)
 		case types.T_uuid:
 			merge = NewMerge(len(bats), sort.NewUuidCompLess(), getFixedCols[types.Uuid](bats, pos), nulls)
-		case types.T_char, types.T_varchar, types.T_blob, types.T_text:
+		case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 			merge = NewMerge(len(bats), sort.NewGenericCompLess[string](), getStrCols(bats, pos), nulls)
 		}
 		if _, err := w.generateWriter(proc); err != nil {
@@ -477,6 +501,11 @@ func (w *S3Writer) generateWriter(proc *process.Process) (objectio.ObjectName, e
 	if err != nil {
 		return nil, err
 	}
+	typ, level, err := compress.ParseCompression(w.compression)
+	if err != nil {
+		return nil, err
+	}
+	w.writer.SetCompress(typ, level)
 	w.lengths = w.lengths[:0]
 	return segId, err
 }
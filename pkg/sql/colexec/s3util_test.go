@@ -17,8 +17,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/stretchr/testify/require"
 )
@@ -55,3 +57,29 @@ func TestSortKey(t *testing.T) {
 		require.Equal(t, cols2[i], res[i])
 	}
 }
+
+func TestTableCompression(t *testing.T) {
+	tableDef := &plan.TableDef{
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{Key: catalog.SystemRelAttr_Kind, Value: catalog.SystemOrdinaryRel},
+							{Key: catalog.SystemRelAttr_Compression, Value: "zstd:9"},
+						},
+					},
+				},
+			},
+		},
+	}
+	writers, err := AllocS3Writers(tableDef)
+	require.NoError(t, err)
+	for _, w := range writers {
+		require.Equal(t, "zstd:9", w.compression)
+	}
+
+	writers, err = AllocS3Writers(&plan.TableDef{})
+	require.NoError(t, err)
+	require.Equal(t, "", writers[0].compression)
+}
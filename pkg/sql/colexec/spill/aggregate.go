@@ -0,0 +1,203 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// CanSpillGroups returns false if the state of any aggregation can't be spilled.
+func CanSpillGroups(aggs []agg.Agg[any]) bool {
+	for _, ag := range aggs {
+		if ag.GetOperatorId() == agg.AggregateGroupConcat {
+			return false
+		}
+	}
+	return true
+}
+
+// SpillGroups spills the partial result of an aggregation, the vectors of bat are
+// the group keys and bat.Aggs are the states of the aggregations. bat is not changed.
+func (p *Partitions) SpillGroups(proc *process.Process, bat *batch.Batch) error {
+	bats, err := partitionBatch(proc, bat, bat.Vecs, len(p.blocks))
+	if err != nil {
+		return err
+	}
+	return p.write(proc, bats)
+}
+
+// MergeGroups merges the spilled partial results partition by partition, only the
+// states of one partition are hashed at a time. It returns the group keys and the
+// merged states of the aggregations, nil is returned if nothing has been spilled.
+func (p *Partitions) MergeGroups(proc *process.Process) (*batch.Batch, error) {
+	var result *batch.Batch
+
+	for i := range p.blocks {
+		bat, err := mergePartition(proc, p.blocks[i])
+		if err != nil {
+			if result != nil {
+				result.Clean(proc.Mp())
+			}
+			return nil, err
+		}
+		if bat == nil {
+			continue
+		}
+		if result == nil {
+			result = bat
+			continue
+		}
+		err = appendGroups(proc, result, bat)
+		bat.Clean(proc.Mp())
+		if err != nil {
+			result.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func mergePartition(proc *process.Process, blocks []Block) (*batch.Batch, error) {
+	var bat *batch.Batch
+	var hm *hashmap.StrHashMap
+
+	defer func() {
+		if hm != nil {
+			hm.Free()
+		}
+	}()
+	inserted := make([]uint8, hashmap.UnitLimit)
+	for _, blk := range blocks {
+		b, err := blk.Read(proc.Ctx, proc.Mp())
+		if err != nil {
+			if bat != nil {
+				bat.Clean(proc.Mp())
+			}
+			return nil, err
+		}
+		if bat == nil {
+			if bat, err = newGroups(b); err != nil {
+				b.Clean(proc.Mp())
+				return nil, err
+			}
+			if hm, err = hashmap.NewStrMap(true, 0, 0, proc.Mp()); err != nil {
+				b.Clean(proc.Mp())
+				bat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+		err = mergeGroups(proc, hm, bat, b, inserted)
+		b.Clean(proc.Mp())
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+// newGroups returns an empty batch of the same keys and aggregations as bat.
+func newGroups(bat *batch.Batch) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.NewVec(*vec.GetType())
+	}
+	rbat.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+	for i, ag := range bat.Aggs {
+		nag, err := newAgg(ag)
+		if err != nil {
+			return nil, err
+		}
+		rbat.Aggs[i] = nag
+	}
+	return rbat, nil
+}
+
+// mergeGroups merges the groups of bat into rbat, hm holds the keys of rbat.
+func mergeGroups(proc *process.Process, hm *hashmap.StrHashMap, rbat, bat *batch.Batch, inserted []uint8) error {
+	count := bat.Length()
+	itr := hm.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := hm.GroupCount()
+		vals, _, err := itr.Insert(i, n, bat.Vecs)
+		if err != nil {
+			return err
+		}
+		cnt := 0
+		for k, v := range vals[:n] {
+			inserted[k] = 0
+			if v > rows {
+				inserted[k] = 1
+				rows++
+				cnt++
+				rbat.Zs = append(rbat.Zs, 0)
+			}
+			rbat.Zs[v-1] += bat.Zs[i+k]
+		}
+		if cnt > 0 {
+			for j, vec := range rbat.Vecs {
+				if err := vec.UnionBatch(bat.Vecs[j], int64(i), cnt, inserted[:n], proc.Mp()); err != nil {
+					return err
+				}
+			}
+			for _, ag := range rbat.Aggs {
+				if err := ag.Grows(cnt, proc.Mp()); err != nil {
+					return err
+				}
+			}
+		}
+		for j, ag := range rbat.Aggs {
+			if err := ag.BatchMerge(bat.Aggs[j], int64(i), inserted[:n], vals); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// appendGroups appends the groups of bat to rbat, no key of bat is in rbat.
+func appendGroups(proc *process.Process, rbat, bat *batch.Batch) error {
+	start := rbat.Length()
+	count := bat.Length()
+	for i, vec := range rbat.Vecs {
+		if err := vec.UnionBatch(bat.Vecs[i], 0, count, nil, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs...)
+
+	os := make([]uint8, count)
+	vps := make([]uint64, count)
+	for i := range vps {
+		vps[i] = uint64(start + i + 1)
+	}
+	for i, ag := range rbat.Aggs {
+		if err := ag.Grows(count, proc.Mp()); err != nil {
+			return err
+		}
+		if err := ag.BatchMerge(bat.Aggs[i], 0, os, vps); err != nil {
+			return err
+		}
+	}
+	return nil
+}
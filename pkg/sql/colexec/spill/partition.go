@@ -0,0 +1,228 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"sync/atomic"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func NewPartitions(proc *process.Process, n int) (*Partitions, error) {
+	s, err := New(proc)
+	if err != nil {
+		return nil, err
+	}
+	return &Partitions{
+		cnt:     1,
+		spiller: s,
+		blocks:  make([][]Block, n),
+		bufs:    make([]*batch.Batch, n),
+	}, nil
+}
+
+// Len returns the number of partitions.
+func (p *Partitions) Len() int {
+	return len(p.blocks)
+}
+
+// Blocks returns the spilled batches of the i-th partition.
+func (p *Partitions) Blocks(i int) []Block {
+	return p.blocks[i]
+}
+
+func (p *Partitions) IncRef(ref int64) {
+	atomic.AddInt64(&p.cnt, ref)
+}
+
+func (p *Partitions) Free(proc *process.Process) {
+	if atomic.AddInt64(&p.cnt, -1) != 0 {
+		return
+	}
+	for i, bat := range p.bufs {
+		if bat != nil {
+			bat.Clean(proc.Mp())
+			p.bufs[i] = nil
+		}
+	}
+	p.blocks = nil
+	p.spiller.Free(proc.Ctx)
+}
+
+// Write splits the rows of bat by the hash of keys, the rows are buffered and
+// written once enough rows are buffered. bat is not changed.
+func (p *Partitions) Write(proc *process.Process, bat *batch.Batch, keys []*vector.Vector) error {
+	bats, err := partitionBatch(proc, bat, keys, len(p.blocks))
+	if err != nil {
+		return err
+	}
+	for i, b := range bats {
+		if b == nil {
+			continue
+		}
+		p.rows += b.Length()
+		if p.bufs[i] == nil {
+			p.bufs[i] = b
+			continue
+		}
+		_, err = p.bufs[i].Append(proc.Ctx, proc.Mp(), b)
+		b.Clean(proc.Mp())
+		if err != nil {
+			cleanBatches(proc, bats[i+1:])
+			return err
+		}
+	}
+	if p.rows >= BufferRows {
+		return p.Flush(proc)
+	}
+	return nil
+}
+
+// Flush writes all the buffered rows.
+func (p *Partitions) Flush(proc *process.Process) error {
+	bats := p.bufs
+	p.bufs = make([]*batch.Batch, len(p.blocks))
+	p.rows = 0
+	return p.write(proc, bats)
+}
+
+// write writes the batches of all the partitions in one run, bats[i] is
+// the batch of the i-th partition and it may be nil.
+func (p *Partitions) write(proc *process.Process, bats []*batch.Batch) error {
+	defer cleanBatches(proc, bats)
+
+	parts := make([]int, 0, len(bats))
+	wbats := make([]*batch.Batch, 0, len(bats))
+	for i, bat := range bats {
+		if bat != nil && bat.Length() > 0 {
+			parts = append(parts, i)
+			wbats = append(wbats, bat)
+		}
+	}
+	if len(wbats) == 0 {
+		return nil
+	}
+	run, err := p.spiller.Write(proc.Ctx, wbats...)
+	if err != nil {
+		return err
+	}
+	for j, i := range parts {
+		p.blocks[i] = append(p.blocks[i], Block{Run: run, Idx: j})
+	}
+	return nil
+}
+
+// partitionBatch splits the rows of bat into n batches by the hash of keys,
+// the batch of a partition is nil if no rows fall into it.
+// The states of the aggregations are split too if bat has any.
+func partitionBatch(proc *process.Process, bat *batch.Batch, keys []*vector.Vector, n int) ([]*batch.Batch, error) {
+	sels := make([][]int32, n)
+	for row, part := range partitionRows(keys, bat.Length(), n) {
+		sels[part] = append(sels[part], int32(row))
+	}
+
+	mp := proc.Mp()
+	bats := make([]*batch.Batch, n)
+	for i := range bats {
+		if len(sels[i]) == 0 {
+			continue
+		}
+		b := batch.NewWithSize(len(bat.Vecs))
+		bats[i] = b
+		b.Zs = make([]int64, len(sels[i]))
+		for j, sel := range sels[i] {
+			b.Zs[j] = bat.Zs[sel]
+		}
+		for j, vec := range bat.Vecs {
+			b.Vecs[j] = vector.NewVec(*vec.GetType())
+			if err := b.Vecs[j].Union(vec, sels[i], mp); err != nil {
+				cleanBatches(proc, bats)
+				return nil, err
+			}
+		}
+		if len(bat.Aggs) == 0 {
+			continue
+		}
+		b.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+		for j, ag := range bat.Aggs {
+			nag, err := newAgg(ag)
+			if err != nil {
+				cleanBatches(proc, bats)
+				return nil, err
+			}
+			b.Aggs[j] = nag
+			if err = nag.Grows(len(sels[i]), mp); err != nil {
+				cleanBatches(proc, bats)
+				return nil, err
+			}
+			for k, sel := range sels[i] {
+				if err = nag.Merge(ag, int64(k), int64(sel)); err != nil {
+					cleanBatches(proc, bats)
+					return nil, err
+				}
+			}
+		}
+	}
+	return bats, nil
+}
+
+// partitionRows returns the partition of each row by the hash of keys,
+// the rows of the same keys always fall into the same partition.
+func partitionRows(keys []*vector.Vector, rows int, n int) []int {
+	var buf []byte
+
+	parts := make([]int, rows)
+	for i := 0; i < rows; i++ {
+		buf = buf[:0]
+		for _, vec := range keys {
+			if vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+				buf = append(buf, 0)
+				continue
+			}
+			buf = append(buf, 1)
+			buf = append(buf, rawBytesAt(vec, i)...)
+		}
+		parts[i] = int(xxhash.Sum64(buf) % uint64(n))
+	}
+	return parts
+}
+
+func rawBytesAt(vec *vector.Vector, i int) []byte {
+	if vec.GetType().IsVarlen() {
+		return vec.GetBytesAt(i)
+	}
+	if vec.IsConst() {
+		i = 0
+	}
+	size := vec.GetType().TypeSize()
+	return vec.UnsafeGetRawData()[i*size : (i+1)*size]
+}
+
+func newAgg(ag agg.Agg[any]) (agg.Agg[any], error) {
+	return agg.New(ag.GetOperatorId(), ag.IsDistinct(), ag.GetInputTypes()[0])
+}
+
+func cleanBatches(proc *process.Process, bats []*batch.Batch) {
+	for i, bat := range bats {
+		if bat != nil {
+			bat.Clean(proc.Mp())
+			bats[i] = nil
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NeedSpill returns true if the memory used by the query exceeds its budget,
+// size is the memory held by the operator asking.
+func NeedSpill(proc *process.Process, size int64) bool {
+	limit := proc.Lim.SpillSize
+	if limit <= 0 || size <= 0 {
+		return false
+	}
+	return proc.Mp().CurrNB() > limit && size*minSpillFraction >= limit
+}
+
+func New(proc *process.Process) (*Spiller, error) {
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return &Spiller{
+		fs:  fs,
+		dir: path.Join(Dir, uuid.NewString()),
+	}, nil
+}
+
+// Write writes the batches to a new run.
+func (s *Spiller) Write(ctx context.Context, bats ...*batch.Batch) (*Run, error) {
+	run := &Run{
+		fs:      s.fs,
+		path:    fmt.Sprintf("%s/%d", s.dir, s.seq),
+		offsets: make([]int64, 0, len(bats)+1),
+	}
+	s.seq++
+
+	vec := fileservice.IOVector{
+		FilePath: run.path,
+		Entries:  make([]fileservice.IOEntry, 0, len(bats)),
+		NoCache:  true,
+	}
+	offset := int64(0)
+	for _, bat := range bats {
+		data, err := types.Encode(bat)
+		if err != nil {
+			return nil, err
+		}
+		vec.Entries = append(vec.Entries, fileservice.IOEntry{
+			Offset: offset,
+			Size:   int64(len(data)),
+			Data:   data,
+		})
+		run.offsets = append(run.offsets, offset)
+		offset += int64(len(data))
+	}
+	run.offsets = append(run.offsets, offset)
+	if len(vec.Entries) == 0 {
+		return run, nil
+	}
+	if err := s.fs.Write(ctx, vec); err != nil {
+		return nil, err
+	}
+	s.paths = append(s.paths, run.path)
+	return run, nil
+}
+
+// Free removes all the files written by the spiller.
+func (s *Spiller) Free(ctx context.Context) {
+	if len(s.paths) > 0 {
+		_ = s.fs.Delete(ctx, s.paths...)
+		s.paths = nil
+	}
+}
+
+// Len returns the number of batches of the run.
+func (r *Run) Len() int {
+	return len(r.offsets) - 1
+}
+
+// Read reads the i-th batch of the run, the memory of the batch is allocated from mp.
+func (r *Run) Read(ctx context.Context, i int, mp *mpool.MPool) (*batch.Batch, error) {
+	vec := &fileservice.IOVector{
+		FilePath: r.path,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: r.offsets[i],
+				Size:   r.offsets[i+1] - r.offsets[i],
+			},
+		},
+		NoCache: true,
+	}
+	if err := r.fs.Read(ctx, vec); err != nil {
+		return nil, err
+	}
+	return decodeBatch(vec.Entries[0].Data, mp)
+}
+
+func (b Block) Read(ctx context.Context, mp *mpool.MPool) (*batch.Batch, error) {
+	return b.Run.Read(ctx, b.Idx, mp)
+}
+
+// decodeBatch decodes a batch and copies its vectors and aggregations into mp.
+func decodeBatch(data []byte, mp *mpool.MPool) (*batch.Batch, error) {
+	bat := new(batch.Batch)
+	if err := types.Decode(data, bat); err != nil {
+		return nil, err
+	}
+	for i, vec := range bat.Vecs {
+		dup, err := vec.Dup(mp)
+		if err != nil {
+			for j := 0; j < i; j++ {
+				bat.Vecs[j].Free(mp)
+			}
+			return nil, err
+		}
+		bat.Vecs[i] = dup
+	}
+	for i, ag := range bat.Aggs {
+		if err := ag.WildAggReAlloc(mp); err != nil {
+			for j := 0; j < i; j++ {
+				bat.Aggs[j].Free(mp)
+			}
+			for _, vec := range bat.Vecs {
+				vec.Free(mp)
+			}
+			return nil, err
+		}
+	}
+	return bat, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+var int64Typ = types.T_int64.ToType()
+
+func TestNeedSpill(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	bat := newBatch(proc, []int64{1, 2, 3})
+	defer bat.Clean(proc.Mp())
+
+	require.False(t, NeedSpill(proc, int64(bat.Size())))
+	proc.Lim.SpillSize = 1
+	require.True(t, NeedSpill(proc, int64(bat.Size())))
+	require.False(t, NeedSpill(proc, 0))
+	proc.Lim.SpillSize = 1 << 40
+	require.False(t, NeedSpill(proc, int64(bat.Size())))
+}
+
+func TestRun(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	s, err := New(proc)
+	require.NoError(t, err)
+
+	bat0 := newBatch(proc, []int64{1, 2, 3})
+	bat1 := newBatch(proc, []int64{4, 5})
+	run, err := s.Write(proc.Ctx, bat0, bat1)
+	require.NoError(t, err)
+	bat0.Clean(proc.Mp())
+	bat1.Clean(proc.Mp())
+
+	require.Equal(t, 2, run.Len())
+	bat, err := run.Read(proc.Ctx, 1, proc.Mp())
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 5}, vector.MustFixedCol[int64](bat.Vecs[0]))
+	bat.Clean(proc.Mp())
+
+	bat, err = Block{Run: run, Idx: 0}.Read(proc.Ctx, proc.Mp())
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3}, vector.MustFixedCol[int64](bat.Vecs[0]))
+	bat.Clean(proc.Mp())
+
+	s.Free(proc.Ctx)
+	_, err = run.Read(proc.Ctx, 0, proc.Mp())
+	require.Error(t, err)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestPartitions(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	parts, err := NewPartitions(proc, 4)
+	require.NoError(t, err)
+
+	// the same keys are written twice, they must fall into the same partitions
+	for i := 0; i < 2; i++ {
+		bat := newBatch(proc, []int64{1, 2, 3, 4, 5, 6, 7, 8})
+		require.NoError(t, parts.Write(proc, bat, bat.Vecs))
+		bat.Clean(proc.Mp())
+	}
+	require.NoError(t, parts.Flush(proc))
+
+	var rows []int64
+	for i := 0; i < parts.Len(); i++ {
+		var keys []int64
+		for _, blk := range parts.Blocks(i) {
+			bat, err := blk.Read(proc.Ctx, proc.Mp())
+			require.NoError(t, err)
+			keys = append(keys, vector.MustFixedCol[int64](bat.Vecs[0])...)
+			bat.Clean(proc.Mp())
+		}
+		for _, key := range keys {
+			require.Equal(t, 2, countOf(keys, key))
+		}
+		rows = append(rows, keys...)
+	}
+	require.Equal(t, 16, len(rows))
+
+	parts.IncRef(1)
+	parts.Free(proc)
+	require.NotNil(t, parts.Blocks(0))
+	parts.Free(proc)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestGroups(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	parts, err := NewPartitions(proc, 4)
+	require.NoError(t, err)
+
+	// sum(v) group by k, the partial results are spilled twice
+	for i := 0; i < 2; i++ {
+		bat := newSumBatch(t, proc, []int64{1, 2, 3}, []int64{10, 20, 30})
+		require.True(t, CanSpillGroups(bat.Aggs))
+		require.NoError(t, parts.SpillGroups(proc, bat))
+		bat.Clean(proc.Mp())
+	}
+	bat := newSumBatch(t, proc, []int64{3, 4}, []int64{30, 40})
+	require.NoError(t, parts.SpillGroups(proc, bat))
+	bat.Clean(proc.Mp())
+
+	bat, err = parts.MergeGroups(proc)
+	require.NoError(t, err)
+	vec, err := bat.Aggs[0].Eval(proc.Mp())
+	require.NoError(t, err)
+
+	result := make(map[int64]int64)
+	keys := vector.MustFixedCol[int64](bat.Vecs[0])
+	sums := vector.MustFixedCol[int64](vec)
+	for i, key := range keys {
+		result[key] = sums[i]
+	}
+	require.Equal(t, map[int64]int64{1: 20, 2: 40, 3: 90, 4: 40}, result)
+	require.Equal(t, 4, len(bat.Zs))
+
+	vec.Free(proc.Mp())
+	bat.Clean(proc.Mp())
+	parts.Free(proc)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func newBatch(proc *process.Process, vs []int64) *batch.Batch {
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(len(vs), int64Typ, proc.Mp(), false, vs),
+	}, nil)
+}
+
+// newSumBatch returns the partial result of sum(v) group by k, keys are distinct.
+func newSumBatch(t *testing.T, proc *process.Process, keys, vs []int64) *batch.Batch {
+	bat := newBatch(proc, keys)
+	ag, err := agg.New(agg.AggregateSum, false, int64Typ)
+	require.NoError(t, err)
+	require.NoError(t, ag.Grows(len(keys), proc.Mp()))
+	vec := testutil.NewInt64Vector(len(vs), int64Typ, proc.Mp(), false, vs)
+	for i := range vs {
+		require.NoError(t, ag.Fill(int64(i), int64(i), 1, []*vector.Vector{vec}))
+	}
+	vec.Free(proc.Mp())
+	bat.Aggs = []agg.Agg[any]{ag}
+	return bat
+}
+
+func countOf(vs []int64, v int64) int {
+	cnt := 0
+	for _, x := range vs {
+		if x == v {
+			cnt++
+		}
+	}
+	return cnt
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+const (
+	// Dir is the directory of the spilled files in the local file service.
+	Dir = "spill"
+	// DefaultPartitions is the number of partitions the spilled rows are split into.
+	DefaultPartitions = 16
+	// BufferRows is the number of rows buffered by Partitions before they are written.
+	BufferRows = 8192 * DefaultPartitions
+	// RunBatchRows is the max number of rows of a batch in a sorted run.
+	RunBatchRows = 8192
+	// minSpillFraction means an operator holding less than 1/minSpillFraction of the
+	// memory budget never spills, it frees too little memory to be worth it.
+	minSpillFraction = 16
+)
+
+// Spiller writes the spilled batches of an operator to the local file service,
+// all the files it wrote are removed by Free.
+type Spiller struct {
+	fs    fileservice.FileService
+	dir   string
+	seq   int
+	paths []string
+}
+
+// Run is a spilled file, it holds a sequence of batches which are read back one by one.
+type Run struct {
+	fs   fileservice.FileService
+	path string
+	// offsets[i] is where the i-th batch starts, the last one is the size of the file.
+	offsets []int64
+}
+
+// Block locates a batch of a run.
+type Block struct {
+	Run *Run
+	Idx int
+}
+
+// Partitions are the rows spilled by an operator, they are split by the hash of
+// their keys so that the rows of the same keys always fall into the same partition,
+// and each partition can be processed alone once all the rows are spilled.
+// Partitions can be shared by several operators, the files are removed once all of
+// them free it.
+type Partitions struct {
+	cnt     int64
+	spiller *Spiller
+	// blocks[i] are the spilled batches of the i-th partition.
+	blocks [][]Block
+	// bufs hold the rows which are not written yet.
+	bufs []*batch.Batch
+	rows int
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// kinds of window function
+const (
+	RowNumber = iota
+	Rank
+	DenseRank
+	Lag
+	Lead
+	FirstValue
+	LastValue
+	// Aggregate is an aggregate function used with an OVER clause.
+	Aggregate
+)
+
+// WinFunc is a window function evaluated by the window operator.
+type WinFunc struct {
+	Kind int
+	// Op is the aggregate operator, only used if Kind is Aggregate.
+	Op   int
+	Args []*plan.Expr
+	Typ  types.Type
+}
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
+type container struct {
+	// bat holds all the input rows, they are sorted by
+	// the partition keys and the order keys already.
+	bat *batch.Batch
+
+	partVecs  []evalVector
+	orderVecs []evalVector
+	argVecs   [][]evalVector
+
+	// partEnds[i] is the exclusive end of the partition of row i
+	// and peerStarts / peerEnds are the bounds of its peer group.
+	partStarts []int64
+	partEnds   []int64
+	peerStarts []int64
+	peerEnds   []int64
+
+	// keys are the order keys used to find the bounds of a RANGE frame with offsets.
+	keys []float64
+	desc bool
+}
+
+type Argument struct {
+	ctr *container
+	// WinSpec is the partition, order and frame of all the functions.
+	WinSpec *plan.WindowSpec
+	// WinFuncs are the original window function expressions.
+	WinFuncs []*plan.Expr
+	Funcs    []WinFunc
+}
+
+func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	ctr := arg.ctr
+	if ctr != nil {
+		mp := proc.Mp()
+		ctr.cleanEvalVectors(mp)
+		ctr.cleanBatch(mp)
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+}
+
+func (ctr *container) cleanEvalVectors(mp *mpool.MPool) {
+	free := func(vecs []evalVector) {
+		for i := range vecs {
+			if vecs[i].needFree && vecs[i].vec != nil {
+				vecs[i].vec.Free(mp)
+				vecs[i].vec = nil
+			}
+		}
+	}
+	free(ctr.partVecs)
+	free(ctr.orderVecs)
+	for i := range ctr.argVecs {
+		free(ctr.argVecs[i])
+	}
+	ctr.partVecs = nil
+	ctr.orderVecs = nil
+	ctr.argVecs = nil
+}
@@ -0,0 +1,505 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compare"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("window([")
+	for i, f := range ap.WinFuncs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v", f))
+	}
+	buf.WriteString("])")
+}
+
+func Prepare(_ *process.Process, arg any) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	return nil
+}
+
+// Call receives all the rows first, the window functions can only be
+// evaluated once the whole partition is known.
+// The input must be sorted by the partition keys and then the order keys.
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+
+	bat := proc.InputBatch()
+	if bat == nil {
+		if ctr.bat == nil {
+			proc.SetInputBatch(nil)
+			ap.Free(proc, false)
+			return true, nil
+		}
+		if err := ctr.process(ap, proc); err != nil {
+			ap.Free(proc, true)
+			return false, err
+		}
+		anal.Alloc(int64(ctr.bat.Size()))
+		anal.Output(ctr.bat, isLast)
+		proc.SetInputBatch(ctr.bat)
+		ctr.bat = nil
+		ap.Free(proc, false)
+		return true, nil
+	}
+	defer proc.PutBatch(bat)
+	if len(bat.Vecs) == 0 {
+		return false, nil
+	}
+	anal.Input(bat, isFirst)
+	proc.SetInputBatch(&batch.Batch{})
+
+	if ctr.bat == nil {
+		ctr.bat = batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.NewVec(*vec.GetType())
+		}
+	}
+	if _, err := ctr.bat.Append(proc.Ctx, proc.Mp(), bat); err != nil {
+		ap.Free(proc, true)
+		return false, err
+	}
+	return false, nil
+}
+
+func (ctr *container) process(ap *Argument, proc *process.Process) error {
+	var err error
+
+	n := ctr.bat.Length()
+	if ctr.partVecs, err = ctr.evalVectors(proc, ap.WinSpec.PartitionBy, n); err != nil {
+		return err
+	}
+	orderExprs := make([]*plan.Expr, len(ap.WinSpec.OrderBy))
+	for i, o := range ap.WinSpec.OrderBy {
+		orderExprs[i] = o.Expr
+	}
+	if ctr.orderVecs, err = ctr.evalVectors(proc, orderExprs, n); err != nil {
+		return err
+	}
+	ctr.argVecs = make([][]evalVector, len(ap.Funcs))
+	for i, f := range ap.Funcs {
+		if ctr.argVecs[i], err = ctr.evalVectors(proc, f.Args, n); err != nil {
+			return err
+		}
+	}
+
+	ctr.splitPartitions(n)
+	ctr.splitPeers(ap.WinSpec.OrderBy, n)
+	if fc := ap.WinSpec.Frame; fc != nil && fc.Type == plan.FrameClause_RANGE && len(ctr.orderVecs) == 1 {
+		ctr.keys = orderKeys(ctr.orderVecs[0].vec)
+		ctr.desc = ap.WinSpec.OrderBy[0].Flag&plan.OrderBySpec_DESC != 0
+	}
+
+	results := make([]*vector.Vector, 0, len(ap.Funcs))
+	for i := range ap.Funcs {
+		vec, err := ctr.evalFunc(ap, i, proc)
+		if err != nil {
+			for _, r := range results {
+				r.Free(proc.Mp())
+			}
+			return err
+		}
+		results = append(results, vec)
+	}
+	ctr.bat.Vecs = append(ctr.bat.Vecs, results...)
+	return nil
+}
+
+// evalVectors evaluates the expressions on all the rows, constant results are
+// expanded so that every vector can be accessed by row number.
+func (ctr *container) evalVectors(proc *process.Process, exprs []*plan.Expr, n int) ([]evalVector, error) {
+	vecs := make([]evalVector, len(exprs))
+	for i, expr := range exprs {
+		vec, err := colexec.EvalExpr(ctr.bat, proc, expr)
+		if err != nil {
+			for j := 0; j < i; j++ {
+				if vecs[j].needFree {
+					vecs[j].vec.Free(proc.Mp())
+				}
+			}
+			return nil, err
+		}
+		vecs[i].vec = vec
+		vecs[i].needFree = true
+		for j := range ctr.bat.Vecs {
+			if ctr.bat.Vecs[j] == vec {
+				vecs[i].needFree = false
+				break
+			}
+		}
+		if vec.IsConst() {
+			full := vector.NewVec(*vec.GetType())
+			if err = full.UnionMulti(vec, 0, n, proc.Mp()); err != nil {
+				full.Free(proc.Mp())
+				return nil, err
+			}
+			if vecs[i].needFree {
+				vec.Free(proc.Mp())
+			}
+			vecs[i].vec = full
+			vecs[i].needFree = true
+		}
+	}
+	return vecs, nil
+}
+
+func newCompares(vecs []evalVector, orderBy []*plan.OrderBySpec) []compare.Compare {
+	cmps := make([]compare.Compare, len(vecs))
+	for i := range vecs {
+		desc, nullsLast := false, false
+		if orderBy != nil {
+			desc = orderBy[i].Flag&plan.OrderBySpec_DESC != 0
+			if orderBy[i].Flag&plan.OrderBySpec_NULLS_FIRST != 0 {
+				nullsLast = false
+			} else if orderBy[i].Flag&plan.OrderBySpec_NULLS_LAST != 0 {
+				nullsLast = true
+			} else {
+				nullsLast = desc
+			}
+		}
+		cmps[i] = compare.New(*vecs[i].vec.GetType(), desc, nullsLast)
+		cmps[i].Set(0, vecs[i].vec)
+		cmps[i].Set(1, vecs[i].vec)
+	}
+	return cmps
+}
+
+func sameRow(cmps []compare.Compare, i, j int64) bool {
+	for _, cmp := range cmps {
+		if cmp.Compare(0, 1, i, j) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (ctr *container) splitPartitions(n int) {
+	cmps := newCompares(ctr.partVecs, nil)
+	ctr.partStarts = make([]int64, n)
+	ctr.partEnds = make([]int64, n)
+	start := int64(0)
+	for i := int64(1); i <= int64(n); i++ {
+		if i == int64(n) || !sameRow(cmps, i-1, i) {
+			for j := start; j < i; j++ {
+				ctr.partStarts[j] = start
+				ctr.partEnds[j] = i
+			}
+			start = i
+		}
+	}
+}
+
+// splitPeers finds the peer groups, rows are peers if they are in the same
+// partition and have the same order keys.
+func (ctr *container) splitPeers(orderBy []*plan.OrderBySpec, n int) {
+	cmps := newCompares(ctr.orderVecs, orderBy)
+	ctr.peerStarts = make([]int64, n)
+	ctr.peerEnds = make([]int64, n)
+	start := int64(0)
+	for i := int64(1); i <= int64(n); i++ {
+		if i == int64(n) || ctr.partStarts[i] == i || !sameRow(cmps, i-1, i) {
+			for j := start; j < i; j++ {
+				ctr.peerStarts[j] = start
+				ctr.peerEnds[j] = i
+			}
+			start = i
+		}
+	}
+}
+
+func (ctr *container) evalFunc(ap *Argument, idx int, proc *process.Process) (*vector.Vector, error) {
+	f := ap.Funcs[idx]
+	n := ctr.bat.Length()
+	switch f.Kind {
+	case RowNumber, Rank, DenseRank:
+		rs := make([]int64, n)
+		var dense int64
+		for i := 0; i < n; i++ {
+			ps := ctr.partStarts[i]
+			if int64(i) == ps {
+				dense = 0
+			}
+			if ctr.peerStarts[i] == int64(i) {
+				dense++
+			}
+			switch f.Kind {
+			case RowNumber:
+				rs[i] = int64(i) - ps + 1
+			case Rank:
+				rs[i] = ctr.peerStarts[i] - ps + 1
+			default:
+				rs[i] = dense
+			}
+		}
+		vec := vector.NewVec(types.T_int64.ToType())
+		if err := vector.AppendFixedList(vec, rs, nil, proc.Mp()); err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+		return vec, nil
+
+	case Lag, Lead:
+		args := ctr.argVecs[idx]
+		offset := int64(1)
+		if len(args) > 1 {
+			if args[1].vec.GetNulls().Contains(0) {
+				return nil, moerr.NewInvalidInput(proc.Ctx, "the offset of window function can not be null")
+			}
+			offset = vector.MustFixedCol[int64](args[1].vec)[0]
+		}
+		if f.Kind == Lag {
+			offset = -offset
+		}
+		vec := vector.NewVec(f.Typ)
+		for i := 0; i < n; i++ {
+			var err error
+			j := int64(i) + offset
+			if j >= ctr.partStarts[i] && j < ctr.partEnds[i] {
+				err = vec.UnionOne(args[0].vec, j, proc.Mp())
+			} else if len(args) > 2 {
+				err = vec.UnionOne(args[2].vec, int64(i), proc.Mp())
+			} else {
+				err = vec.UnionNull(proc.Mp())
+			}
+			if err != nil {
+				vec.Free(proc.Mp())
+				return nil, err
+			}
+		}
+		return vec, nil
+
+	case FirstValue, LastValue:
+		arg := ctr.argVecs[idx][0].vec
+		vec := vector.NewVec(f.Typ)
+		for i := 0; i < n; i++ {
+			var err error
+			start, end := ctr.frame(ap.WinSpec.Frame, int64(i))
+			if start >= end {
+				err = vec.UnionNull(proc.Mp())
+			} else if f.Kind == FirstValue {
+				err = vec.UnionOne(arg, start, proc.Mp())
+			} else {
+				err = vec.UnionOne(arg, end-1, proc.Mp())
+			}
+			if err != nil {
+				vec.Free(proc.Mp())
+				return nil, err
+			}
+		}
+		return vec, nil
+
+	case Aggregate:
+		return ctr.evalAgg(ap, idx, proc)
+	}
+	return nil, moerr.NewInternalError(proc.Ctx, "unsupported window function kind %d", f.Kind)
+}
+
+// evalAgg evaluates an aggregate function on the frame of every row, each row is a group of the agg.
+// If the frame of a row contains the frame of the previous row and starts at the same row,
+// the previous result is reused and only the new rows are filled.
+func (ctr *container) evalAgg(ap *Argument, idx int, proc *process.Process) (*vector.Vector, error) {
+	f := ap.Funcs[idx]
+	n := ctr.bat.Length()
+	vecs := []*vector.Vector{ctr.argVecs[idx][0].vec}
+
+	ag, err := agg.New(f.Op, false, *vecs[0].GetType())
+	if err != nil {
+		return nil, err
+	}
+	if err = ag.Grows(n, proc.Mp()); err != nil {
+		ag.Free(proc.Mp())
+		return nil, err
+	}
+
+	var prevStart, prevEnd int64
+	for i := int64(0); i < int64(n); i++ {
+		start, end := ctr.frame(ap.WinSpec.Frame, i)
+		from := start
+		if i > ctr.partStarts[i] && start == prevStart && end >= prevEnd && prevStart < prevEnd {
+			if err = ag.Merge(ag, i, i-1); err != nil {
+				ag.Free(proc.Mp())
+				return nil, err
+			}
+			from = prevEnd
+		}
+		for j := from; j < end; j++ {
+			if err = ag.Fill(i, j, 1, vecs); err != nil {
+				ag.Free(proc.Mp())
+				return nil, err
+			}
+		}
+		prevStart, prevEnd = start, end
+	}
+	return ag.Eval(proc.Mp())
+}
+
+// frame returns the rows [start, end) of the window frame of row i.
+func (ctr *container) frame(fc *plan.FrameClause, i int64) (int64, int64) {
+	ps, pe := ctr.partStarts[i], ctr.partEnds[i]
+	if fc == nil {
+		return ps, ctr.peerEnds[i]
+	}
+	start := ctr.bound(fc, fc.Start, i, false)
+	end := ctr.bound(fc, fc.End, i, true)
+	if start < ps {
+		start = ps
+	}
+	if end > pe {
+		end = pe
+	}
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+func (ctr *container) bound(fc *plan.FrameClause, b *plan.FrameBound, i int64, isEnd bool) int64 {
+	ps, pe := ctr.partStarts[i], ctr.partEnds[i]
+	switch b.Type {
+	case plan.FrameBound_PRECEDING:
+		if b.Unbounded {
+			return ps
+		}
+	case plan.FrameBound_FOLLOWING:
+		if b.Unbounded {
+			return pe
+		}
+	case plan.FrameBound_CURRENT_ROW:
+		if fc.Type == plan.FrameClause_ROWS {
+			if isEnd {
+				return i + 1
+			}
+			return i
+		}
+		if isEnd {
+			return ctr.peerEnds[i]
+		}
+		return ctr.peerStarts[i]
+	}
+
+	offset := b.Val.GetC().GetI64Val()
+	if b.Type == plan.FrameBound_PRECEDING {
+		offset = -offset
+	}
+	if fc.Type == plan.FrameClause_ROWS {
+		if isEnd {
+			return i + offset + 1
+		}
+		return i + offset
+	}
+	return ctr.rangeBound(offset, i, isEnd)
+}
+
+// rangeBound finds the frame bound of a RANGE frame with an offset, the frame
+// contains the rows whose order key is in [key(i) - offset, key(i) + offset]
+// along the order direction.
+func (ctr *container) rangeBound(offset int64, i int64, isEnd bool) int64 {
+	vec := ctr.orderVecs[0].vec
+	nsp := vec.GetNulls()
+	if nsp.Contains(uint64(i)) {
+		// the frame of a null key only contains its peers
+		if isEnd {
+			return ctr.peerEnds[i]
+		}
+		return ctr.peerStarts[i]
+	}
+
+	// nulls are either at the beginning or the end of the partition
+	ps, pe := ctr.partStarts[i], ctr.partEnds[i]
+	for ps < pe && nsp.Contains(uint64(ps)) {
+		ps++
+	}
+	for pe > ps && nsp.Contains(uint64(pe-1)) {
+		pe--
+	}
+
+	keys, desc := ctr.keys, ctr.desc
+	target := keys[i] + float64(offset)
+	if desc {
+		target = keys[i] - float64(offset)
+	}
+	cnt := int(pe - ps)
+	pos := sort.Search(cnt, func(j int) bool {
+		k := keys[ps+int64(j)]
+		if desc {
+			if isEnd {
+				return k < target
+			}
+			return k <= target
+		}
+		if isEnd {
+			return k > target
+		}
+		return k >= target
+	})
+	return ps + int64(pos)
+}
+
+func orderKeys(vec *vector.Vector) []float64 {
+	var keys []float64
+	switch vec.GetType().Oid {
+	case types.T_int8:
+		keys = toFloat64(vector.MustFixedCol[int8](vec))
+	case types.T_int16:
+		keys = toFloat64(vector.MustFixedCol[int16](vec))
+	case types.T_int32:
+		keys = toFloat64(vector.MustFixedCol[int32](vec))
+	case types.T_int64:
+		keys = toFloat64(vector.MustFixedCol[int64](vec))
+	case types.T_uint8:
+		keys = toFloat64(vector.MustFixedCol[uint8](vec))
+	case types.T_uint16:
+		keys = toFloat64(vector.MustFixedCol[uint16](vec))
+	case types.T_uint32:
+		keys = toFloat64(vector.MustFixedCol[uint32](vec))
+	case types.T_uint64:
+		keys = toFloat64(vector.MustFixedCol[uint64](vec))
+	case types.T_float32:
+		keys = toFloat64(vector.MustFixedCol[float32](vec))
+	case types.T_float64:
+		keys = vector.MustFixedCol[float64](vec)
+	}
+	return keys
+}
+
+func toFloat64[T int8 | int16 | int32 | int64 | uint8 | uint16 | uint32 | uint64 | float32](vs []T) []float64 {
+	rs := make([]float64, len(vs))
+	for i, v := range vs {
+		rs[i] = float64(v)
+	}
+	return rs
+}
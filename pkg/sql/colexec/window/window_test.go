@@ -0,0 +1,197 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+var int64Typ = types.T_int64.ToType()
+
+// the input is (p, o, v), partitioned by p and ordered by o
+var (
+	inputP = []int64{1, 1, 1, 2, 2}
+	inputO = []int64{1, 2, 2, 1, 3}
+	inputV = []int64{10, 20, 30, 40, 50}
+)
+
+type windowTestCase struct {
+	arg    *Argument
+	proc   *process.Process
+	expect []int64
+	nulls  []uint64
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	tc := newTestCase(nil, WinFunc{Kind: RowNumber, Typ: int64Typ}, nil, nil)
+	String(tc.arg, buf)
+	require.Equal(t, "window([])", buf.String())
+}
+
+func TestWindow(t *testing.T) {
+	tcs := []windowTestCase{
+		newTestCase(nil, WinFunc{Kind: RowNumber, Typ: int64Typ},
+			[]int64{1, 2, 3, 1, 2}, nil),
+		newTestCase(nil, WinFunc{Kind: Rank, Typ: int64Typ},
+			[]int64{1, 2, 2, 1, 2}, nil),
+		newTestCase(nil, WinFunc{Kind: DenseRank, Typ: int64Typ},
+			[]int64{1, 2, 2, 1, 2}, nil),
+		newTestCase(nil, WinFunc{Kind: Lag, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2)}},
+			[]int64{0, 10, 20, 0, 40}, []uint64{0, 3}),
+		newTestCase(nil, WinFunc{Kind: Lead, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2), newConstExpr(2), newConstExpr(-1)}},
+			[]int64{30, -1, -1, -1, -1}, nil),
+		// the default frame is RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW
+		newTestCase(nil, WinFunc{Kind: Aggregate, Op: agg.AggregateSum, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2)}},
+			[]int64{10, 60, 60, 40, 90}, nil),
+		newTestCase(nil, WinFunc{Kind: LastValue, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2)}},
+			[]int64{10, 30, 30, 40, 50}, nil),
+		newTestCase(
+			newFrame(plan.FrameClause_ROWS, newBound(plan.FrameBound_PRECEDING, 1), newBound(plan.FrameBound_FOLLOWING, 1)),
+			WinFunc{Kind: Aggregate, Op: agg.AggregateSum, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2)}},
+			[]int64{30, 60, 50, 90, 90}, nil),
+		newTestCase(
+			newFrame(plan.FrameClause_ROWS, newBound(plan.FrameBound_FOLLOWING, 1), newUnboundedBound(plan.FrameBound_FOLLOWING)),
+			WinFunc{Kind: FirstValue, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2)}},
+			[]int64{20, 30, 0, 50, 0}, []uint64{2, 4}),
+		newTestCase(
+			newFrame(plan.FrameClause_RANGE, newBound(plan.FrameBound_PRECEDING, 1), newBound(plan.FrameBound_CURRENT_ROW, 0)),
+			WinFunc{Kind: Aggregate, Op: agg.AggregateSum, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2)}},
+			[]int64{10, 60, 60, 40, 50}, nil),
+		newTestCase(
+			newFrame(plan.FrameClause_RANGE, newUnboundedBound(plan.FrameBound_PRECEDING), newUnboundedBound(plan.FrameBound_FOLLOWING)),
+			WinFunc{Kind: Aggregate, Op: agg.AggregateCount, Typ: int64Typ, Args: []*plan.Expr{newColExpr(2)}},
+			[]int64{3, 3, 3, 2, 2}, nil),
+	}
+
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+
+		// the rows are split into two batches
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, 0, 2)
+		end, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		require.False(t, end)
+		tc.proc.Reg.InputBatch = newBatch(tc.proc, 2, 5)
+		end, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		require.False(t, end)
+		tc.proc.Reg.InputBatch = &batch.Batch{}
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		tc.proc.Reg.InputBatch = nil
+		end, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		require.True(t, end)
+
+		result := tc.proc.Reg.InputBatch
+		require.Equal(t, 4, len(result.Vecs))
+		vec := result.Vecs[3]
+		require.Equal(t, len(tc.expect), vec.Length())
+		for i, v := range tc.expect {
+			if vec.GetNulls().Contains(uint64(i)) {
+				require.Contains(t, tc.nulls, uint64(i))
+				continue
+			}
+			require.NotContains(t, tc.nulls, uint64(i))
+			require.Equal(t, v, vector.GetFixedAt[int64](vec, i), "row %d", i)
+		}
+		result.Clean(tc.proc.Mp())
+		tc.arg.Free(tc.proc, false)
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+func newTestCase(frame *plan.FrameClause, f WinFunc, expect []int64, nulls []uint64) windowTestCase {
+	return windowTestCase{
+		proc: testutil.NewProcessWithMPool(mpool.MustNewZero()),
+		arg: &Argument{
+			WinSpec: &plan.WindowSpec{
+				PartitionBy: []*plan.Expr{newColExpr(0)},
+				OrderBy:     []*plan.OrderBySpec{{Expr: newColExpr(1), Flag: plan.OrderBySpec_ASC}},
+				Frame:       frame,
+			},
+			Funcs: []WinFunc{f},
+		},
+		expect: expect,
+		nulls:  nulls,
+	}
+}
+
+func newFrame(typ plan.FrameClause_FrameType, start, end *plan.FrameBound) *plan.FrameClause {
+	return &plan.FrameClause{
+		Type:  typ,
+		Start: start,
+		End:   end,
+	}
+}
+
+func newBound(typ plan.FrameBound_BoundType, offset int64) *plan.FrameBound {
+	return &plan.FrameBound{
+		Type: typ,
+		Val:  newConstExpr(offset),
+	}
+}
+
+func newUnboundedBound(typ plan.FrameBound_BoundType) *plan.FrameBound {
+	return &plan.FrameBound{
+		Type:      typ,
+		Unbounded: true,
+	}
+}
+
+func newColExpr(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+func newConstExpr(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_I64Val{I64Val: v},
+			},
+		},
+	}
+}
+
+func newBatch(proc *process.Process, start, end int) *batch.Batch {
+	n := end - start
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(n, int64Typ, proc.Mp(), false, inputP[start:end]),
+		testutil.NewInt64Vector(n, int64Typ, proc.Mp(), false, inputO[start:end]),
+		testutil.NewInt64Vector(n, int64Typ, proc.Mp(), false, inputV[start:end]),
+	}, nil)
+}
@@ -116,6 +116,9 @@ func (c *Compile) Compile(ctx context.Context, pn *plan.Plan, u any, fill func(a
 		if e := recover(); e != nil {
 			err = moerr.ConvertPanicError(ctx, e)
 		}
+		if err != nil {
+			c.closeSnapshotTxns()
+		}
 	}()
 	// with values
 	c.proc.Ctx = perfcounter.WithCounterSet(c.proc.Ctx, &c.s3CounterSet)
@@ -263,6 +266,7 @@ func (c *Compile) Run(_ uint64) error {
 	go func() {
 		wg.Wait()
 		c.scope = nil
+		c.closeSnapshotTxns()
 		close(errC)
 	}()
 	for e := range errC {
@@ -350,9 +354,18 @@ func (c *Compile) compileScope(ctx context.Context, pn *plan.Plan) ([]*Scope, er
 				Plan:  pn,
 			}}, nil
 		case plan.DataDefinition_TRUNCATE_TABLE:
+			var preScopes []*Scope
+			var err error
+			if pn.AttachedPlan != nil {
+				preScopes, err = c.compileAttachedScope(ctx, pn.AttachedPlan)
+				if err != nil {
+					return nil, err
+				}
+			}
 			return []*Scope{{
-				Magic: TruncateTable,
-				Plan:  pn,
+				Magic:     TruncateTable,
+				Plan:      pn,
+				PreScopes: preScopes,
 			}}, nil
 		case plan.DataDefinition_CREATE_SEQUENCE:
 			return []*Scope{{
@@ -402,16 +415,21 @@ func (c *Compile) cnListStrategy() {
 	}
 }
 
+// compileAttachedScope compiles the attached plan and the ones chained to it
 func (c *Compile) compileAttachedScope(ctx context.Context, attachedPlan *plan.Plan) ([]*Scope, error) {
-	query := attachedPlan.Plan.(*plan.Plan_Query)
-	attachedScope, err := c.compileQuery(ctx, query.Query)
-	if err != nil {
-		return nil, err
-	}
-	for _, s := range attachedScope {
-		s.Plan = attachedPlan
+	var scopes []*Scope
+	for ; attachedPlan != nil; attachedPlan = attachedPlan.AttachedPlan {
+		query := attachedPlan.Plan.(*plan.Plan_Query)
+		attachedScope, err := c.compileQuery(ctx, query.Query)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range attachedScope {
+			s.Plan = attachedPlan
+		}
+		scopes = append(scopes, attachedScope...)
 	}
-	return attachedScope, nil
+	return scopes, nil
 }
 
 func (c *Compile) compileQuery(ctx context.Context, qry *plan.Query) ([]*Scope, error) {
@@ -568,7 +586,10 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 		}
 		nodeStats := qry.Nodes[insertNode.Children[0]].Stats
 
-		if nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag {
+		// the rows of the ivfflat indexes are assigned to the lists by the centroids read in
+		// the txn, so the table with ivfflat indexes is not written to s3 by the remote CNs
+		if (nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag) &&
+			!colexec.HasIvfflatIndex(insertNode.TableDef) {
 			// use distributed-insert
 			arg.IsRemote = true
 			for _, scope := range ss {
@@ -685,6 +706,12 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(node, ss))), nil
 	case plan.Node_TABLE_SCAN:
+		if n.IndexLookup != nil {
+			var err error
+			if n, err = c.compileIndexLookup(n); err != nil {
+				return nil, err
+			}
+		}
 		ss, err := c.compileTableScan(n)
 		if err != nil {
 			return nil, err
@@ -715,6 +742,38 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 			ss = c.compileGroup(n, ss, ns)
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
+	case plan.Node_WINDOW:
+		curr := c.anal.curr
+		c.setAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, step, n.Children[0], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.setAnalyzeCurrent(ss, curr)
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, c.compileWindow(ctx, n, ss)))), nil
+	case plan.Node_RECURSIVE_CTE:
+		// only the anchor is compiled here, the recursive member is compiled
+		// again for each iteration over the rows of the last iteration.
+		curr := c.anal.curr
+		c.setAnalyzeCurrent(nil, int(n.Children[0]))
+		ss, err := c.compilePlanScope(ctx, step, n.Children[0], ns)
+		if err != nil {
+			return nil, err
+		}
+		c.setAnalyzeCurrent(ss, curr)
+		return c.compileSort(n, c.compileRecursiveCte(ctx, n, ns, step, ss)), nil
+	case plan.Node_MATERIAL_SCAN:
+		bat, err := c.constructWorkTableBatch(ctx, n)
+		if err != nil {
+			return nil, err
+		}
+		ds := &Scope{
+			Magic:      Normal,
+			DataSource: &Source{Bat: bat},
+			NodeInfo:   engine.Node{Addr: c.addr, Mcpu: 1},
+			Proc:       process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes()),
+		}
+		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, []*Scope{ds}))), nil
 	case plan.Node_JOIN:
 		curr := c.anal.curr
 		c.setAnalyzeCurrent(nil, int(n.Children[0]))
@@ -1015,8 +1074,12 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 	for j, col := range n.TableDef.Cols {
 		attrs[j] = col.Name
 	}
-	if c.proc != nil && c.proc.TxnOperator != nil {
-		ts = c.proc.TxnOperator.Txn().SnapshotTS
+	txnOp, err := c.getTxnOperator(n)
+	if err != nil {
+		panic(err)
+	}
+	if txnOp != nil {
+		ts = txnOp.Txn().SnapshotTS
 	}
 	{
 		var cols []*plan.ColDef
@@ -1027,14 +1090,14 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 		if n.ObjRef.PubAccountId != -1 {
 			ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(n.ObjRef.PubAccountId))
 		}
-		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+		db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 		if err != nil {
 			panic(err)
 		}
 		rel, err = db.Relation(ctx, n.TableDef.Name)
 		if err != nil {
 			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
 			if e != nil {
 				panic(e)
 			}
@@ -1055,10 +1118,11 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 				cols = append(cols, &plan.ColDef{
 					Name: attr.Attr.Name,
 					Typ: &plan.Type{
-						Id:       int32(attr.Attr.Type.Oid),
-						Width:    attr.Attr.Type.Width,
-						Scale:    attr.Attr.Type.Scale,
-						AutoIncr: attr.Attr.AutoIncrement,
+						Id:         int32(attr.Attr.Type.Oid),
+						Width:      attr.Attr.Type.Width,
+						Scale:      attr.Attr.Type.Scale,
+						AutoIncr:   attr.Attr.AutoIncrement,
+						Enumvalues: attr.Attr.EnumValues,
 					},
 					Primary:   attr.Attr.Primary,
 					Default:   attr.Attr.Default,
@@ -1080,7 +1144,7 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 	// prcoess partitioned table
 	var partitionRelNames []string
 	if n.TableDef.Partition != nil {
-		partitionRelNames = append(partitionRelNames, n.TableDef.Partition.PartitionTableNames...)
+		partitionRelNames = append(partitionRelNames, getPartitionTableNames(n)...)
 	}
 
 	s = &Scope{
@@ -1097,6 +1161,9 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 			Expr:                   colexec.RewriteFilterExprList(n.FilterList),
 		},
 	}
+	if n.SnapshotTs != nil {
+		s.DataSource.TxnOperator = txnOp
+	}
 	s.Proc = process.NewWithAnalyze(c.proc, c.ctx, 0, c.anal.Nodes())
 	return s
 }
@@ -1471,6 +1538,103 @@ func (c *Compile) compileOrder(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
+// compileWindow merges all the sorted input into one pipeline, since the window
+// functions are evaluated on whole partitions.
+func (c *Compile) compileWindow(ctx context.Context, n *plan.Node, ss []*Scope) []*Scope {
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:  vm.Window,
+		Idx: c.anal.curr,
+		Arg: constructWindow(ctx, n.WinSpec, n.AggList),
+	})
+	return []*Scope{rs}
+}
+
+func (c *Compile) compileRecursiveCte(ctx context.Context, n *plan.Node, ns []*plan.Node, step int32, ss []*Scope) []*Scope {
+	rs := c.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op:  vm.RecursiveCte,
+		Idx: c.anal.curr,
+		Arg: constructRecursiveCte(n, func(proc *process.Process, workTable *batch.Batch) (*batch.Batch, error) {
+			return c.runRecursiveMember(ctx, step, n.Children[1], ns, workTable)
+		}),
+	})
+	return []*Scope{rs}
+}
+
+// runRecursiveMember compiles the recursive member of a recursive CTE and runs
+// it over the work table, it returns the rows produced by the recursive member.
+// The recursive member is always run by the current CN.
+func (c *Compile) runRecursiveMember(ctx context.Context, step int32, nodeID int32, ns []*plan.Node, workTable *batch.Batch) (*batch.Batch, error) {
+	var result *batch.Batch
+
+	rc := New(c.addr, c.db, c.sql, c.uid, c.ctx, c.e, c.proc, c.stmt)
+	rc.info = c.info
+	rc.anal = &anaylze{
+		qry:       c.anal.qry,
+		analInfos: c.anal.analInfos,
+		curr:      int(nodeID),
+	}
+	rc.cnList = engine.Nodes{engine.Node{
+		Addr: c.addr,
+		Mcpu: c.NumCPU()},
+	}
+	rc.workTable = workTable
+
+	ss, err := rc.compilePlanScope(ctx, step, nodeID, ns)
+	if err != nil {
+		return nil, err
+	}
+	rs := rc.newMergeScope(ss)
+	rs.appendInstruction(vm.Instruction{
+		Op: vm.Output,
+		Arg: &output.Argument{
+			Func: func(_ any, bat *batch.Batch) error {
+				var err error
+
+				if bat == nil {
+					return nil
+				}
+				// the batch will be put back by the output operator
+				if result == nil {
+					result = batch.NewWithSize(len(bat.Vecs))
+					for i, vec := range bat.Vecs {
+						result.Vecs[i] = vector.NewVec(*vec.GetType())
+					}
+				}
+				result, err = result.Append(ctx, c.proc.Mp(), bat)
+				return err
+			},
+		},
+	})
+	if err = rs.MergeRun(rc); err != nil {
+		if result != nil {
+			result.Clean(c.proc.Mp())
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// constructWorkTableBatch returns a copy of the work table of the recursive CTE
+// being run, the columns are picked by the MATERIAL_SCAN node.
+func (c *Compile) constructWorkTableBatch(ctx context.Context, n *plan.Node) (*batch.Batch, error) {
+	if c.workTable == nil {
+		return nil, moerr.NewInternalError(ctx, "the work table of recursive CTE '%s' is not ready", n.TableDef.Name)
+	}
+	bat := batch.NewWithSize(len(n.TableDef.Cols))
+	for i, col := range n.TableDef.Cols {
+		vec, err := c.workTable.Vecs[col.ColId].Dup(c.proc.Mp())
+		if err != nil {
+			bat.Clean(c.proc.Mp())
+			return nil, err
+		}
+		bat.Vecs[i] = vec
+	}
+	bat.SetZs(c.workTable.Length(), c.proc.Mp())
+	return bat, nil
+}
+
 func (c *Compile) compileOffset(n *plan.Node, ss []*Scope) []*Scope {
 	currentFirstFlag := c.anal.isFirst
 	for i := range ss {
@@ -1982,14 +2146,18 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	if n.ObjRef.PubAccountId != -1 {
 		ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(n.ObjRef.PubAccountId))
 	}
-	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, c.proc.TxnOperator)
+	txnOp, err := c.getTxnOperator(n)
+	if err != nil {
+		return nil, err
+	}
+	db, err = c.e.Database(ctx, n.ObjRef.SchemaName, txnOp)
 	if err != nil {
 		return nil, err
 	}
 	rel, err = db.Relation(ctx, n.TableDef.Name)
 	if err != nil {
 		var e error // avoid contamination of error messages
-		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, c.proc.TxnOperator)
+		db, e = c.e.Database(ctx, defines.TEMPORARY_DBNAME, txnOp)
 		if e != nil {
 			return nil, err
 		}
@@ -2016,11 +2184,7 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 
 	if n.TableDef.Partition != nil {
 		isPartitionTable = true
-		partitionInfo := n.TableDef.Partition
-		partitionNum := int(partitionInfo.PartitionNum)
-		partitionTableNames := partitionInfo.PartitionTableNames
-		for i := 0; i < partitionNum; i++ {
-			partTableName := partitionTableNames[i]
+		for _, partTableName := range getPartitionTableNames(n) {
 			subrelation, err := db.Relation(ctx, partTableName)
 			if err != nil {
 				return nil, err
@@ -2039,7 +2203,7 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	logutil.Infof("cn generateNodes, tbl %d ranges is %d", tblId, expectedLen)
 
 	// If ranges == 0, dont know what type of table is this
-	if len(ranges) == 0 && n.TableDef.TableType != catalog.SystemOrdinaryRel {
+	if len(ranges) == 0 && n.TableDef.TableType != catalog.SystemOrdinaryRel && n.SnapshotTs == nil {
 		nodes = make(engine.Nodes, len(c.cnList))
 		for i, node := range c.cnList {
 			if isPartitionTable {
@@ -2069,6 +2233,11 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	if isLaunchMode(c.cnList) {
 		return putBlocksInCurrentCN(c, ranges, rel, n), nil
 	}
+	// the txn at the snapshot of AS OF TIMESTAMP only lives in the current CN, it is
+	// not sent with the remote scopes, so the table is read in the current CN.
+	if n.SnapshotTs != nil {
+		return putBlocksInCurrentCN(c, ranges, rel, n), nil
+	}
 	// disttae engine , hash s3 objects to fixed CN
 	if engineType == engine.Disttae {
 		return hashBlocksToFixedCN(c, ranges, rel, n), nil
@@ -2238,6 +2407,18 @@ func updateScopesLastFlag(updateScopes []*Scope) {
 	}
 }
 
+// getPartitionTableNames returns the partition tables to be scanned, the ones pruned by the filters are skipped.
+func getPartitionTableNames(n *plan.Node) []string {
+	if n.PartitionPrune != nil && n.PartitionPrune.IsPruned {
+		names := make([]string, len(n.PartitionPrune.SelectedPartitions))
+		for i, partition := range n.PartitionPrune.SelectedPartitions {
+			names[i] = partition.PartitionTableName
+		}
+		return names
+	}
+	return n.TableDef.Partition.PartitionTableNames
+}
+
 func isLaunchMode(cnlist engine.Nodes) bool {
 	for i := range cnlist {
 		if !isSameCN(cnlist[0].Addr, cnlist[i].Addr) {
@@ -2299,7 +2480,7 @@ func rowsetDataToVector(ctx context.Context, proc *process.Process, exprs []*pla
 			vector.AppendFixed(vec, vector.MustFixedCol[bool](tmp)[0], false, proc.Mp())
 		case types.T_int8:
 			vector.AppendFixed(vec, vector.MustFixedCol[int8](tmp)[0], false, proc.Mp())
-		case types.T_int16:
+		case types.T_int16, types.T_year:
 			vector.AppendFixed(vec, vector.MustFixedCol[int16](tmp)[0], false, proc.Mp())
 		case types.T_int32:
 			vector.AppendFixed(vec, vector.MustFixedCol[int32](tmp)[0], false, proc.Mp())
@@ -2307,17 +2488,17 @@ func rowsetDataToVector(ctx context.Context, proc *process.Process, exprs []*pla
 			vector.AppendFixed(vec, vector.MustFixedCol[int64](tmp)[0], false, proc.Mp())
 		case types.T_uint8:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint8](tmp)[0], false, proc.Mp())
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint16](tmp)[0], false, proc.Mp())
 		case types.T_uint32:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint32](tmp)[0], false, proc.Mp())
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			vector.AppendFixed(vec, vector.MustFixedCol[uint64](tmp)[0], false, proc.Mp())
 		case types.T_float32:
 			vector.AppendFixed(vec, vector.MustFixedCol[float32](tmp)[0], false, proc.Mp())
 		case types.T_float64:
 			vector.AppendFixed(vec, vector.MustFixedCol[float64](tmp)[0], false, proc.Mp())
-		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 			vector.AppendBytes(vec, tmp.GetBytesAt(0), false, proc.Mp())
 		case types.T_date:
 			vector.AppendFixed(vec, vector.MustFixedCol[types.Date](tmp)[0], false, proc.Mp())
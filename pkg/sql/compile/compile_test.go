@@ -16,10 +16,13 @@ package compile
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -62,6 +65,8 @@ func init() {
 		newTestCase("select * from R limit 10", new(testing.T)),
 		newTestCase("select count(*) from R group by uid", new(testing.T)),
 		newTestCase("select count(distinct uid) from R", new(testing.T)),
+		newTestCase("with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 5) select * from qn", new(testing.T)),
+		newTestCase("with recursive qn as (select uid from R union select qn.uid from qn join S on qn.uid = S.uid) select * from qn", new(testing.T)),
 		newTestCase("insert into R values('1', '2', '3')", new(testing.T)),
 		newTestCase("insert into R select * from R", new(testing.T)),
 	}
@@ -94,6 +99,44 @@ func TestCompile(t *testing.T) {
 	}
 }
 
+func TestCompileRecursiveCte(t *testing.T) {
+	ctx := context.TODO()
+	cases := []struct {
+		sql    string
+		expect []int64
+	}{
+		{"with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 5) select * from qn", []int64{1, 2, 3, 4, 5}},
+		// the recursion ends once no new rows are produced
+		{"with recursive qn(n) as (select 1 union select (n + 1) % 3 from qn) select * from qn", []int64{0, 1, 2}},
+	}
+	for _, cs := range cases {
+		tc := newTestCase(cs.sql, t)
+		var rows []int64
+		c := New("test", "test", tc.sql, "", ctx, tc.e, tc.proc, tc.stmt)
+		err := c.Compile(ctx, tc.pn, nil, func(_ any, bat *batch.Batch) error {
+			if bat != nil {
+				rows = append(rows, vector.MustFixedCol[int64](bat.Vecs[0])...)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		err = c.Run(0)
+		require.NoError(t, err)
+		sort.Slice(rows, func(i, j int) bool { return rows[i] < rows[j] })
+		require.Equal(t, cs.expect, rows)
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+
+	// the recursion never ends
+	tc := newTestCase("with recursive qn(n) as (select 1 union all select n + 1 from qn) select * from qn", t)
+	c := New("test", "test", tc.sql, "", ctx, tc.e, tc.proc, tc.stmt)
+	err := c.Compile(ctx, tc.pn, nil, testPrint)
+	require.NoError(t, err)
+	err = c.Run(0)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCTEMaxRecursionDepth))
+}
+
 func TestCompileWithFaults(t *testing.T) {
 	// Enable this line to trigger the Hung.
 	// fault.Enable()
@@ -99,17 +99,14 @@ func (s *Scope) DropDatabase(c *Compile) error {
 		}
 		return moerr.NewErrDropNonExistsDB(c.ctx, dbName)
 	}
-	err := c.e.Delete(c.ctx, dbName, c.proc.TxnOperator)
-	if err != nil {
-		return err
-	}
 
+	// the additional sqls may read the tables of the database in mo_tables
 	for i := 0; i < len(s.PreScopes); i++ {
 		if err := <-errChan; err != nil {
 			return err
 		}
 	}
-	return nil
+	return c.e.Delete(c.ctx, dbName, c.proc.TxnOperator)
 }
 
 // Drop the old view, and create the new view.
@@ -390,6 +387,8 @@ func (s *Scope) AlterTable(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.PrimaryKeyDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.CheckDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
 	if !originHasFkDef {
@@ -761,6 +760,10 @@ func (s *Scope) CreateIndex(c *Compile) error {
 			indexBat.Clean(c.proc.Mp())
 		}
 		// other situation is not supported now and check in plan
+	} else if indexDef.IndexAlgo == catalog.IndexAlgoIvfflat {
+		if err = buildIvfflatIndexTable(c, d, r, indexDef, qry.OriginTablePrimaryKey); err != nil {
+			return err
+		}
 	}
 
 	err = colexec.InsertOneIndexMetadata(c.e, c.ctx, d, c.proc, qry.Table, indexDef)
@@ -770,6 +773,66 @@ func (s *Scope) CreateIndex(c *Compile) error {
 	return nil
 }
 
+// buildIvfflatIndexTable reads all the vectors of the table, clusters them into
+// lists and writes the lists into the index table.
+func buildIvfflatIndexTable(c *Compile, d engine.Database, r engine.Relation, indexDef *plan.IndexDef, pkName string) error {
+	attrs := []string{indexDef.Parts[0], pkName}
+	ret, err := r.Ranges(c.ctx, nil)
+	if err != nil {
+		return err
+	}
+	rds, err := r.NewReader(c.ctx, 1, nil, ret)
+	if err != nil {
+		return err
+	}
+	var vecCol, pkCol *vector.Vector
+	defer func() {
+		if vecCol != nil {
+			vecCol.Free(c.proc.Mp())
+			pkCol.Free(c.proc.Mp())
+		}
+	}()
+	for {
+		bat, err := rds[0].Read(c.ctx, attrs, nil, c.proc.Mp(), nil)
+		if err != nil {
+			rds[0].Close()
+			return err
+		}
+		if bat == nil {
+			break
+		}
+		if vecCol == nil {
+			vecCol = vector.NewVec(*bat.Vecs[0].GetType())
+			pkCol = vector.NewVec(*bat.Vecs[1].GetType())
+		}
+		if err = vecCol.UnionBatch(bat.Vecs[0], 0, bat.Vecs[0].Length(), nil, c.proc.Mp()); err != nil {
+			rds[0].Close()
+			return err
+		}
+		if err = pkCol.UnionBatch(bat.Vecs[1], 0, bat.Vecs[1].Length(), nil, c.proc.Mp()); err != nil {
+			rds[0].Close()
+			return err
+		}
+	}
+	if err = rds[0].Close(); err != nil {
+		return err
+	}
+	if vecCol == nil || vecCol.Length() == 0 {
+		return nil
+	}
+
+	indexBat, err := util.BuildIvfflatIndexBatch(vecCol, pkCol, indexDef.IndexAlgoLists, c.proc)
+	if err != nil {
+		return err
+	}
+	defer indexBat.Clean(c.proc.Mp())
+	indexR, err := d.Relation(c.ctx, indexDef.IndexTableName)
+	if err != nil {
+		return err
+	}
+	return indexR.Write(c.ctx, indexBat)
+}
+
 func (s *Scope) DropIndex(c *Compile) error {
 	errChan := make(chan error, len(s.PreScopes))
 	for i := range s.PreScopes {
@@ -1080,6 +1143,14 @@ func (s *Scope) TruncateTable(c *Compile) error {
 		return err
 	}
 
+	// execute additional sql pipeline, currently, only delete operations are performed
+	for _, ps := range s.PreScopes {
+		if ps.Magic == Deletion {
+			if _, err = ps.Delete(c); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -1293,6 +1364,12 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if len(tableDef.Checks) > 0 {
+		c.Cts = append(c.Cts, &engine.CheckDef{
+			Checks: tableDef.Checks,
+		})
+	}
+
 	if len(c.Cts) > 0 {
 		exeDefs = append(exeDefs, c)
 	}
@@ -1327,6 +1404,7 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 				Comment:       col.GetComment(),
 				ClusterBy:     col.ClusterBy,
 				AutoIncrement: col.Typ.GetAutoIncr(),
+				EnumValues:    col.Typ.GetEnumvalues(),
 			},
 		}
 	}
@@ -0,0 +1,265 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// compileIndexLookup looks up the primary keys of the rows to read in the index table of
+// the table scan, and returns a copy of the scan which filters the blocks and the rows by
+// the keys. The filters of the scan are kept, so the scan is returned as it is if the index
+// table can't be looked up.
+func (c *Compile) compileIndexLookup(n *plan.Node) (*plan.Node, error) {
+	lookup := n.IndexLookup
+
+	ctx := c.ctx
+	if util.TableIsClusterTable(n.TableDef.GetTableType()) {
+		ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
+	}
+	if n.ObjRef.PubAccountId != -1 {
+		ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(n.ObjRef.PubAccountId))
+	}
+	// the index table is read at the same snapshot as the table
+	txnOp, err := c.getTxnOperator(n)
+	if err != nil {
+		return nil, err
+	}
+	db, err := c.e.Database(ctx, lookup.ObjRef.SchemaName, txnOp)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := db.Relation(ctx, lookup.TableDef.Name)
+	if err != nil {
+		logutil.Infof("skip the lookup in the index table %s: %v", lookup.TableDef.Name, err)
+		return n, nil
+	}
+
+	var keys *vector.Vector
+	if lookup.Ivfflat != nil {
+		keys, err = c.readIvfflatKeys(ctx, rel, lookup)
+		if err != nil {
+			return nil, err
+		}
+		// the index has no list, the whole table is read
+		if keys == nil {
+			return n, nil
+		}
+	} else {
+		keys, err = c.readIndexLookupKeys(ctx, rel, lookup)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer keys.Free(c.proc.Mp())
+
+	filter, err := plan2.BuildIndexLookupFilter(c.proc, lookup, keys)
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		return n, nil
+	}
+
+	// the plan may be cached, so the node is not changed
+	node := *n
+	node.FilterList = make([]*plan.Expr, 0, len(n.FilterList)+1)
+	node.FilterList = append(node.FilterList, n.FilterList...)
+	node.FilterList = append(node.FilterList, filter)
+	return &node, nil
+}
+
+// readIndexLookupKeys returns the primary keys of the rows of the index table which pass
+// the filters of the lookup.
+func (c *Compile) readIndexLookupKeys(ctx context.Context, rel engine.Relation, lookup *plan.IndexLookup) (*vector.Vector, error) {
+	expr, _ := plan2.HandleFiltersForZM(lookup.FilterList, c.proc)
+	keys := newIndexLookupKeys(lookup)
+	filter := colexec.RewriteFilterExprList(lookup.FilterList)
+	attrs := []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName}
+	err := c.scanRelation(ctx, rel, attrs, expr, func(bat *batch.Batch) error {
+		return c.unionIndexLookupKeys(keys, bat, filter)
+	})
+	if err != nil {
+		keys.Free(c.proc.Mp())
+		return nil, err
+	}
+	return keys, nil
+}
+
+// ivfflatCentroid is the centroid of a list of the ivfflat index
+type ivfflatCentroid struct {
+	list     int64
+	distance float64
+}
+
+// readIvfflatKeys returns the primary keys in the lists of the ivfflat index nearest to the
+// query, or nil if the index has no list. Only the blocks of the index table which may have
+// the rows of the lists are read.
+func (c *Compile) readIvfflatKeys(ctx context.Context, rel engine.Relation, lookup *plan.IndexLookup) (*vector.Vector, error) {
+	mp := c.proc.Mp()
+	lists, centroids, err := colexec.GetIvfflatCentroids(ctx, c.proc, rel)
+	if err != nil {
+		return nil, err
+	}
+	if len(lists) == 0 {
+		return nil, nil
+	}
+
+	// the distance refers to the centroid column, which is the third column of the index table
+	var centroidTyp *plan.Type
+	for _, col := range lookup.TableDef.Cols {
+		if col.Name == catalog.IndexTableCentroidColName {
+			centroidTyp = col.Typ
+		}
+	}
+	if centroidTyp == nil {
+		return nil, nil
+	}
+	bat := batch.NewWithSize(3)
+	defer bat.Clean(mp)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewConstNull(types.New(types.T(lookup.Pk.Typ.Id), lookup.Pk.Typ.Width, lookup.Pk.Typ.Scale), len(lists), mp)
+	bat.Vecs[2] = vector.NewVec(types.New(types.T(centroidTyp.Id), centroidTyp.Width, centroidTyp.Scale))
+	for i, list := range lists {
+		if err = vector.AppendFixed(bat.Vecs[0], list, false, mp); err != nil {
+			return nil, err
+		}
+		if err = vector.AppendBytes(bat.Vecs[2], centroids[i], false, mp); err != nil {
+			return nil, err
+		}
+	}
+	bat.SetZs(len(lists), mp)
+	vec, err := colexec.EvalExpr(bat, c.proc, lookup.Ivfflat.Distance)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Free(mp)
+
+	distances := vector.MustFixedCol[float64](vec)
+	nearest := make([]ivfflatCentroid, 0, len(lists))
+	for i, list := range lists {
+		if !vec.GetNulls().Contains(uint64(i)) {
+			nearest = append(nearest, ivfflatCentroid{list: list, distance: distances[i]})
+		}
+	}
+	sort.Slice(nearest, func(i, j int) bool {
+		return nearest[i].distance < nearest[j].distance
+	})
+	if int64(len(nearest)) > lookup.Ivfflat.Probes {
+		nearest = nearest[:lookup.Ivfflat.Probes]
+	}
+	probed := make([]int64, len(nearest))
+	for i := range nearest {
+		probed[i] = nearest[i].list
+	}
+
+	keys := newIndexLookupKeys(lookup)
+	if len(probed) == 0 {
+		return keys, nil
+	}
+	filter, err := plan2.BuildIvfflatListFilter(ctx, lookup, probed)
+	if err != nil {
+		keys.Free(mp)
+		return nil, err
+	}
+	expr, _ := plan2.HandleFiltersForZM([]*plan.Expr{filter}, c.proc)
+	attrs := []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName}
+	err = c.scanRelation(ctx, rel, attrs, expr, func(bat *batch.Batch) error {
+		return c.unionIndexLookupKeys(keys, bat, filter)
+	})
+	if err != nil {
+		keys.Free(mp)
+		return nil, err
+	}
+	return keys, nil
+}
+
+// scanRelation reads the columns of the rows of the relation, which may pass the filter,
+// batch by batch.
+func (c *Compile) scanRelation(ctx context.Context, rel engine.Relation, attrs []string, expr *plan.Expr, fn func(*batch.Batch) error) error {
+	ranges, err := rel.Ranges(ctx, expr)
+	if err != nil {
+		return err
+	}
+	rds, err := rel.NewReader(ctx, 1, expr, ranges)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, rd := range rds {
+			_ = rd.Close()
+		}
+	}()
+
+	for _, rd := range rds {
+		for {
+			bat, err := rd.Read(ctx, attrs, expr, c.proc.Mp(), nil)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			err = fn(bat)
+			bat.Clean(c.proc.Mp())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func newIndexLookupKeys(lookup *plan.IndexLookup) *vector.Vector {
+	return vector.NewVec(types.New(types.T(lookup.Pk.Typ.Id), lookup.Pk.Typ.Width, lookup.Pk.Typ.Scale))
+}
+
+// unionIndexLookupKeys appends the primary keys of the rows of the batch which pass the filter.
+func (c *Compile) unionIndexLookupKeys(keys *vector.Vector, bat *batch.Batch, filter *plan.Expr) error {
+	vec, err := colexec.EvalExpr(bat, c.proc, filter)
+	if err != nil {
+		return err
+	}
+	defer vec.Free(c.proc.Mp())
+
+	bs := vector.MustFixedCol[bool](vec)
+	if vec.IsConst() {
+		if vec.IsConstNull() || !bs[0] {
+			return nil
+		}
+		return keys.UnionBatch(bat.Vecs[1], 0, bat.Length(), nil, c.proc.Mp())
+	}
+	for i, b := range bs {
+		if b && !vec.GetNulls().Contains(uint64(i)) {
+			if err = keys.UnionOne(bat.Vecs[1], int64(i), c.proc.Mp()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
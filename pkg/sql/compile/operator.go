@@ -63,6 +63,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/rightanti"
@@ -72,6 +73,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
@@ -337,6 +339,7 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Nbucket:     t.Nbucket,
 			Typs:        t.Typs,
 			Conditions:  t.Conditions,
+			CanSpill:    t.CanSpill,
 		}
 	case vm.External:
 		t := sourceIns.Arg.(*external.Argument)
@@ -992,6 +995,55 @@ func constructGroup(ctx context.Context, n, cn *plan.Node, ibucket, nbucket int,
 	}
 }
 
+func constructWindow(ctx context.Context, spec *plan.WindowSpec, winFuncs []*plan.Expr) *window.Argument {
+	funcs := make([]window.WinFunc, len(winFuncs))
+	for i, expr := range winFuncs {
+		f := expr.Expr.(*plan.Expr_F)
+		obj := int64(uint64(f.F.Func.Obj) & function.DistinctMask)
+		fid, _ := function.DecodeOverloadID(obj)
+		funcs[i] = window.WinFunc{
+			Args: f.F.Args,
+			Typ:  types.New(types.T(expr.Typ.Id), expr.Typ.Width, expr.Typ.Scale),
+		}
+		switch fid {
+		case function.ROW_NUMBER:
+			funcs[i].Kind = window.RowNumber
+		case function.RANK:
+			funcs[i].Kind = window.Rank
+		case function.DENSE_RANK:
+			funcs[i].Kind = window.DenseRank
+		case function.LAG:
+			funcs[i].Kind = window.Lag
+		case function.LEAD:
+			funcs[i].Kind = window.Lead
+		case function.FIRST_VALUE:
+			funcs[i].Kind = window.FirstValue
+		case function.LAST_VALUE:
+			funcs[i].Kind = window.LastValue
+		default:
+			fun, err := function.GetFunctionByID(ctx, obj)
+			if err != nil {
+				panic(err)
+			}
+			funcs[i].Kind = window.Aggregate
+			funcs[i].Op = fun.AggregateInfo
+		}
+	}
+	return &window.Argument{
+		WinSpec:  spec,
+		WinFuncs: winFuncs,
+		Funcs:    funcs,
+	}
+}
+
+func constructRecursiveCte(n *plan.Node, step recursivecte.StepFunc) *recursivecte.Argument {
+	return &recursivecte.Argument{
+		UnionAll: n.UnionAll,
+		MaxDepth: n.MaxRecursionDepth,
+		Step:     step,
+	}
+}
+
 // ibucket: bucket number
 // nbucket:
 // construct operator argument
@@ -1323,6 +1375,7 @@ func constructHashBuild(in vm.Instruction, proc *process.Process) *hashbuild.Arg
 			NeedHashMap: true,
 			Typs:        arg.Typs,
 			Conditions:  arg.Conditions[1],
+			CanSpill:    true,
 		}
 	case vm.Left:
 		arg := in.Arg.(*left.Argument)
@@ -1568,6 +1621,22 @@ func getRel(ctx context.Context, proc *process.Process, eg engine.Engine, ref *p
 					continue
 				}
 			}
+			// the ivfflat index tables are written after the unique index tables, see colexec.WriteUniqueTable
+			for _, indexdef := range tableDef.Indexes {
+				if !colexec.IsIvfflatIndex(indexdef) {
+					continue
+				}
+				var indexTable engine.Relation
+				if isTemp {
+					indexTable, err = dbSource.Relation(ctx, engine.GetTempTableName(oldDbName, indexdef.IndexTableName))
+				} else {
+					indexTable, err = dbSource.Relation(ctx, indexdef.IndexTableName)
+				}
+				if err != nil {
+					return nil, nil, err
+				}
+				uniqueIndexTables = append(uniqueIndexTables, indexTable)
+			}
 		}
 	}
 	return relation, uniqueIndexTables, err
@@ -209,14 +209,18 @@ func (s *Scope) ParallelRun(c *Compile, remote bool) error {
 		if util.TableIsClusterTable(s.DataSource.TableDef.GetTableType()) {
 			ctx = context.WithValue(ctx, defines.TenantIDKey{}, catalog.System_Account)
 		}
-		db, err = c.e.Database(ctx, s.DataSource.SchemaName, s.Proc.TxnOperator)
+		txnOp := s.Proc.TxnOperator
+		if s.DataSource.TxnOperator != nil {
+			txnOp = s.DataSource.TxnOperator
+		}
+		db, err = c.e.Database(ctx, s.DataSource.SchemaName, txnOp)
 		if err != nil {
 			return err
 		}
 		rel, err = db.Relation(ctx, s.DataSource.RelationName)
 		if err != nil {
 			var e error // avoid contamination of error messages
-			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, s.Proc.TxnOperator)
+			db, e = c.e.Database(c.ctx, defines.TEMPORARY_DBNAME, txnOp)
 			if e != nil {
 				return e
 			}
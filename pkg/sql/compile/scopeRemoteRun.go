@@ -78,6 +78,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -859,6 +860,11 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 		in.OrderBy = t.Fs
 	case *mergeorder.Argument:
 		in.OrderBy = t.Fs
+	case *window.Argument:
+		in.Window = &pipeline.Window{
+			Spec:     t.WinSpec,
+			WinFuncs: t.WinFuncs,
+		}
 	case *connector.Argument:
 		idx, ctx0 := ctx.root.findRegister(t.Reg)
 		if ctx0.root.isRemote(ctx0, 0) && !ctx0.isDescendant(ctx) {
@@ -1223,6 +1229,9 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext) (vm.In
 		v.Arg = &mergeorder.Argument{
 			Fs: opr.OrderBy,
 		}
+	case vm.Window:
+		t := opr.GetWindow()
+		v.Arg = constructWindow(context.TODO(), t.Spec, t.WinFuncs)
 	case vm.TableFunction:
 		v.Arg = &table_function.Argument{
 			Attrs:  opr.TableFunction.Attrs,
@@ -1400,6 +1409,7 @@ func convertToPipelineLimitation(lim process.Limitation) *pipeline.ProcessLimita
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		SpillSize:     lim.SpillSize,
 	}
 }
 
@@ -1411,6 +1421,7 @@ func convertToProcessLimitation(lim *pipeline.ProcessLimitation) process.Limitat
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		SpillSize:     lim.SpillSize,
 	}
 }
 
@@ -0,0 +1,72 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+)
+
+// getTxnOperator returns the txn a table scan reads with. The scan of AS OF TIMESTAMP
+// reads with a read-only txn at its snapshot, the txn is shared by all the scans at
+// the same timestamp and is closed after the query is done.
+func (c *Compile) getTxnOperator(n *plan.Node) (client.TxnOperator, error) {
+	if n.SnapshotTs == nil {
+		return c.proc.TxnOperator, nil
+	}
+	key := n.SnapshotTs.DebugString()
+	if op, ok := c.snapshotTxns[key]; ok {
+		return op, nil
+	}
+	if c.proc.TxnClient == nil {
+		return nil, moerr.NewInternalError(c.ctx, "must set txn client")
+	}
+
+	// the snapshot is in the past, it is not bounded by the snapshot of the current txn
+	op, err := c.proc.TxnClient.New(c.ctx, timestamp.Timestamp{},
+		client.WithTxnReadyOnly(), client.WithSnapshotTS(*n.SnapshotTs))
+	if err != nil {
+		return nil, err
+	}
+	if err = c.e.New(c.ctx, op); err != nil {
+		_ = op.Rollback(c.ctx)
+		return nil, err
+	}
+	if c.snapshotTxns == nil {
+		c.snapshotTxns = make(map[string]client.TxnOperator)
+	}
+	c.snapshotTxns[key] = op
+	return op, nil
+}
+
+// closeSnapshotTxns closes the read-only txns opened for the AS OF TIMESTAMP scans.
+func (c *Compile) closeSnapshotTxns() {
+	for ts, op := range c.snapshotTxns {
+		ctx, cancel := context.WithTimeout(context.Background(), c.e.Hints().CommitOrRollbackTimeout)
+		if err := c.e.Rollback(ctx, op); err != nil {
+			logutil.Errorf("close the txn of snapshot %s failed: %v", ts, err)
+		}
+		if err := op.Rollback(ctx); err != nil {
+			logutil.Errorf("close the txn of snapshot %s failed: %v", ts, err)
+		}
+		cancel()
+	}
+	c.snapshotTxns = nil
+}
@@ -84,6 +84,10 @@ type Source struct {
 	TableDef               *plan.TableDef
 	Timestamp              timestamp.Timestamp
 	AccountId              int32
+	// TxnOperator is the txn to read the table with, nil means the txn of the scope.
+	// It is set for the table read at a past timestamp by AS OF TIMESTAMP, which is always
+	// read in the current CN as the txn is not sent to the remote CNs.
+	TxnOperator client.TxnOperator
 }
 
 // Col is the information of attribute
@@ -186,6 +190,14 @@ type Compile struct {
 	s3CounterSet perfcounter.CounterSet
 
 	stepRegs map[int32][]*process.WaitRegister
+
+	// workTable holds the rows read by the recursive member of a recursive CTE,
+	// it is only set for the compile of the recursive member.
+	workTable *batch.Batch
+
+	// snapshotTxns holds the read-only txns of the AS OF TIMESTAMP scans, keyed by
+	// the debug strings of their snapshot timestamps.
+	snapshotTxns map[string]client.TxnOperator
 }
 
 type RemoteReceivRegInfo struct {
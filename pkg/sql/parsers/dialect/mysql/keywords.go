@@ -95,6 +95,7 @@ func init() {
 		"subpartition":             SUBPARTITION,
 		"subpartitions":            SUBPARTITIONS,
 		"snapshot":                 SNAPSHOT,
+		"always":                   ALWAYS,
 		"sysdate":                  SYSDATE,
 		"create":                   CREATE,
 		"cluster":                  CLUSTER,
@@ -172,7 +173,7 @@ func init() {
 		"fields":                   FIELDS,
 		"file":                     FILE,
 		"fixed":                    FIXED,
-		"generated":                UNUSED,
+		"generated":                GENERATED,
 		"geometry":                 GEOMETRY,
 		"geometrycollection":       GEOMETRYCOLLECTION,
 		"get":                      UNUSED,
@@ -215,10 +216,17 @@ func init() {
 		"join":                     JOIN,
 		"json":                     JSON,
 		"uuid":                     UUID,
+		"vecf32":                   VECF32,
+		"vecf64":                   VECF64,
+		"ivfflat":                  IVFFLAT,
+		"lists":                    LISTS,
+		"quota":                    QUOTA,
 		"key":                      KEY,
 		"keys":                     KEYS,
 		"key_block_size":           KEY_BLOCK_SIZE,
 		"kill":                     KILL,
+		"backup":                   BACKUP,
+		"backup_admin":             BACKUP_ADMIN,
 		"language":                 LANGUAGE,
 		"last":                     LAST,
 		"leading":                  LEADING,
@@ -283,6 +291,7 @@ func init() {
 		"nulls":                    NULLS,
 		"numeric":                  NUMERIC,
 		"none":                     NONE,
+		"of":                       OF,
 		"offset":                   OFFSET,
 		"on":                       ON,
 		"only":                     ONLY,
@@ -330,6 +339,7 @@ func init() {
 		"redundant":                REDUNDANT,
 		"read_write":               UNUSED,
 		"real":                     REAL,
+		"recursive":                RECURSIVE,
 		"references":               REFERENCES,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
@@ -351,6 +361,8 @@ func init() {
 		"rlike":                    REGEXP,
 		"rollback":                 ROLLBACK,
 		"role":                     ROLE,
+		"policy":                   POLICY,
+		"policies":                 POLICIES,
 		"routine":                  ROUTINE,
 		"row":                      ROW,
 		"row_format":               ROW_FORMAT,
@@ -392,7 +404,7 @@ func init() {
 		"stats_auto_recalc":        STATS_AUTO_RECALC,
 		"stats_persistent":         STATS_PERSISTENT,
 		"stats_sample_pages":       STATS_SAMPLE_PAGES,
-		"stored":                   UNUSED,
+		"stored":                   STORED,
 		"storage":                  STORAGE,
 		"straight_join":            STRAIGHT_JOIN,
 		"stream":                   STREAM,
@@ -439,7 +451,7 @@ func init() {
 		"varchar":                  VARCHAR,
 		"varcharacter":             UNUSED,
 		"varying":                  UNUSED,
-		"virtual":                  UNUSED,
+		"virtual":                  VIRTUAL,
 		"view":                     VIEW,
 		"visible":                  VISIBLE,
 		"week":                     WEEK,
@@ -39,522 +39,536 @@ const ORDER = 57361
 const BY = 57362
 const LIMIT = 57363
 const OFFSET = 57364
-const FOR = 57365
-const CONNECT = 57366
-const MANAGE = 57367
-const GRANTS = 57368
-const OWNERSHIP = 57369
-const REFERENCE = 57370
-const LOWER_THAN_SET = 57371
-const SET = 57372
-const ALL = 57373
-const DISTINCT = 57374
-const DISTINCTROW = 57375
-const AS = 57376
-const EXISTS = 57377
-const ASC = 57378
-const DESC = 57379
-const INTO = 57380
-const DUPLICATE = 57381
-const DEFAULT = 57382
-const LOCK = 57383
-const KEYS = 57384
-const NULLS = 57385
-const FIRST = 57386
-const LAST = 57387
-const VALUES = 57388
-const NEXT = 57389
-const VALUE = 57390
-const SHARE = 57391
-const MODE = 57392
-const SQL_NO_CACHE = 57393
-const SQL_CACHE = 57394
-const JOIN = 57395
-const STRAIGHT_JOIN = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const INNER = 57399
-const OUTER = 57400
-const CROSS = 57401
-const NATURAL = 57402
-const USE = 57403
-const FORCE = 57404
-const LOWER_THAN_ON = 57405
-const ON = 57406
-const USING = 57407
-const SUBQUERY_AS_EXPR = 57408
-const LOWER_THAN_STRING = 57409
-const ID = 57410
-const AT_ID = 57411
-const AT_AT_ID = 57412
-const STRING = 57413
-const VALUE_ARG = 57414
-const LIST_ARG = 57415
-const COMMENT = 57416
-const COMMENT_KEYWORD = 57417
-const QUOTE_ID = 57418
-const INTEGRAL = 57419
-const HEX = 57420
-const BIT_LITERAL = 57421
-const FLOAT = 57422
-const HEXNUM = 57423
-const NULL = 57424
-const TRUE = 57425
-const FALSE = 57426
-const LOWER_THAN_CHARSET = 57427
-const CHARSET = 57428
-const UNIQUE = 57429
-const KEY = 57430
-const OR = 57431
-const PIPE_CONCAT = 57432
-const XOR = 57433
-const AND = 57434
-const NOT = 57435
-const BETWEEN = 57436
-const CASE = 57437
-const WHEN = 57438
-const THEN = 57439
-const ELSE = 57440
-const END = 57441
-const ELSEIF = 57442
-const LOWER_THAN_EQ = 57443
-const LE = 57444
-const GE = 57445
-const NE = 57446
-const NULL_SAFE_EQUAL = 57447
-const IS = 57448
-const LIKE = 57449
-const REGEXP = 57450
-const IN = 57451
-const ASSIGNMENT = 57452
-const ILIKE = 57453
-const SHIFT_LEFT = 57454
-const SHIFT_RIGHT = 57455
-const DIV = 57456
-const MOD = 57457
-const UNARY = 57458
-const COLLATE = 57459
-const BINARY = 57460
-const UNDERSCORE_BINARY = 57461
-const INTERVAL = 57462
-const OUT = 57463
-const INOUT = 57464
-const BEGIN = 57465
-const START = 57466
-const TRANSACTION = 57467
-const COMMIT = 57468
-const ROLLBACK = 57469
-const WORK = 57470
-const CONSISTENT = 57471
-const SNAPSHOT = 57472
-const CHAIN = 57473
-const NO = 57474
-const RELEASE = 57475
-const PRIORITY = 57476
-const QUICK = 57477
-const BIT = 57478
-const TINYINT = 57479
-const SMALLINT = 57480
-const MEDIUMINT = 57481
-const INT = 57482
-const INTEGER = 57483
-const BIGINT = 57484
-const INTNUM = 57485
-const REAL = 57486
-const DOUBLE = 57487
-const FLOAT_TYPE = 57488
-const DECIMAL = 57489
-const NUMERIC = 57490
-const DECIMAL_VALUE = 57491
-const TIME = 57492
-const TIMESTAMP = 57493
-const DATETIME = 57494
-const YEAR = 57495
-const CHAR = 57496
-const VARCHAR = 57497
-const BOOL = 57498
-const CHARACTER = 57499
-const VARBINARY = 57500
-const NCHAR = 57501
-const TEXT = 57502
-const TINYTEXT = 57503
-const MEDIUMTEXT = 57504
-const LONGTEXT = 57505
-const BLOB = 57506
-const TINYBLOB = 57507
-const MEDIUMBLOB = 57508
-const LONGBLOB = 57509
-const JSON = 57510
-const ENUM = 57511
-const UUID = 57512
-const GEOMETRY = 57513
-const POINT = 57514
-const LINESTRING = 57515
-const POLYGON = 57516
-const GEOMETRYCOLLECTION = 57517
-const MULTIPOINT = 57518
-const MULTILINESTRING = 57519
-const MULTIPOLYGON = 57520
-const INT1 = 57521
-const INT2 = 57522
-const INT3 = 57523
-const INT4 = 57524
-const INT8 = 57525
-const S3OPTION = 57526
-const SQL_SMALL_RESULT = 57527
-const SQL_BIG_RESULT = 57528
-const SQL_BUFFER_RESULT = 57529
-const LOW_PRIORITY = 57530
-const HIGH_PRIORITY = 57531
-const DELAYED = 57532
-const CREATE = 57533
-const ALTER = 57534
-const DROP = 57535
-const RENAME = 57536
-const ANALYZE = 57537
-const ADD = 57538
-const RETURNS = 57539
-const SCHEMA = 57540
-const TABLE = 57541
-const SEQUENCE = 57542
-const INDEX = 57543
-const VIEW = 57544
-const TO = 57545
-const IGNORE = 57546
-const IF = 57547
-const PRIMARY = 57548
-const COLUMN = 57549
-const CONSTRAINT = 57550
-const SPATIAL = 57551
-const FULLTEXT = 57552
-const FOREIGN = 57553
-const KEY_BLOCK_SIZE = 57554
-const SHOW = 57555
-const DESCRIBE = 57556
-const EXPLAIN = 57557
-const DATE = 57558
-const ESCAPE = 57559
-const REPAIR = 57560
-const OPTIMIZE = 57561
-const TRUNCATE = 57562
-const MAXVALUE = 57563
-const PARTITION = 57564
-const REORGANIZE = 57565
-const LESS = 57566
-const THAN = 57567
-const PROCEDURE = 57568
-const TRIGGER = 57569
-const STATUS = 57570
-const VARIABLES = 57571
-const ROLE = 57572
-const PROXY = 57573
-const AVG_ROW_LENGTH = 57574
-const STORAGE = 57575
-const DISK = 57576
-const MEMORY = 57577
-const CHECKSUM = 57578
-const COMPRESSION = 57579
-const DATA = 57580
-const DIRECTORY = 57581
-const DELAY_KEY_WRITE = 57582
-const ENCRYPTION = 57583
-const ENGINE = 57584
-const MAX_ROWS = 57585
-const MIN_ROWS = 57586
-const PACK_KEYS = 57587
-const ROW_FORMAT = 57588
-const STATS_AUTO_RECALC = 57589
-const STATS_PERSISTENT = 57590
-const STATS_SAMPLE_PAGES = 57591
-const DYNAMIC = 57592
-const COMPRESSED = 57593
-const REDUNDANT = 57594
-const COMPACT = 57595
-const FIXED = 57596
-const COLUMN_FORMAT = 57597
-const AUTO_RANDOM = 57598
-const RESTRICT = 57599
-const CASCADE = 57600
-const ACTION = 57601
-const PARTIAL = 57602
-const SIMPLE = 57603
-const CHECK = 57604
-const ENFORCED = 57605
-const RANGE = 57606
-const LIST = 57607
-const ALGORITHM = 57608
-const LINEAR = 57609
-const PARTITIONS = 57610
-const SUBPARTITION = 57611
-const SUBPARTITIONS = 57612
-const CLUSTER = 57613
-const TYPE = 57614
-const ANY = 57615
-const SOME = 57616
-const EXTERNAL = 57617
-const LOCALFILE = 57618
-const URL = 57619
-const PREPARE = 57620
-const DEALLOCATE = 57621
-const RESET = 57622
-const EXTENSION = 57623
-const INCREMENT = 57624
-const CYCLE = 57625
-const MINVALUE = 57626
-const PUBLICATION = 57627
-const SUBSCRIPTIONS = 57628
-const PUBLICATIONS = 57629
-const PROPERTIES = 57630
-const PARSER = 57631
-const VISIBLE = 57632
-const INVISIBLE = 57633
-const BTREE = 57634
-const HASH = 57635
-const RTREE = 57636
-const BSI = 57637
-const ZONEMAP = 57638
-const LEADING = 57639
-const BOTH = 57640
-const TRAILING = 57641
-const UNKNOWN = 57642
-const EXPIRE = 57643
-const ACCOUNT = 57644
-const ACCOUNTS = 57645
-const UNLOCK = 57646
-const DAY = 57647
-const NEVER = 57648
-const PUMP = 57649
-const MYSQL_COMPATIBILITY_MODE = 57650
-const SECOND = 57651
-const ASCII = 57652
-const COALESCE = 57653
-const COLLATION = 57654
-const HOUR = 57655
-const MICROSECOND = 57656
-const MINUTE = 57657
-const MONTH = 57658
-const QUARTER = 57659
-const REPEAT = 57660
-const REVERSE = 57661
-const ROW_COUNT = 57662
-const WEEK = 57663
-const REVOKE = 57664
-const FUNCTION = 57665
-const PRIVILEGES = 57666
-const TABLESPACE = 57667
-const EXECUTE = 57668
-const SUPER = 57669
-const GRANT = 57670
-const OPTION = 57671
-const REFERENCES = 57672
-const REPLICATION = 57673
-const SLAVE = 57674
-const CLIENT = 57675
-const USAGE = 57676
-const RELOAD = 57677
-const FILE = 57678
-const TEMPORARY = 57679
-const ROUTINE = 57680
-const EVENT = 57681
-const SHUTDOWN = 57682
-const NULLX = 57683
-const AUTO_INCREMENT = 57684
-const APPROXNUM = 57685
-const SIGNED = 57686
-const UNSIGNED = 57687
-const ZEROFILL = 57688
-const ENGINES = 57689
-const LOW_CARDINALITY = 57690
-const ADMIN_NAME = 57691
-const RANDOM = 57692
-const SUSPEND = 57693
-const ATTRIBUTE = 57694
-const HISTORY = 57695
-const REUSE = 57696
-const CURRENT = 57697
-const OPTIONAL = 57698
-const FAILED_LOGIN_ATTEMPTS = 57699
-const PASSWORD_LOCK_TIME = 57700
-const UNBOUNDED = 57701
-const SECONDARY = 57702
-const USER = 57703
-const IDENTIFIED = 57704
-const CIPHER = 57705
-const ISSUER = 57706
-const X509 = 57707
-const SUBJECT = 57708
-const SAN = 57709
-const REQUIRE = 57710
-const SSL = 57711
-const NONE = 57712
-const PASSWORD = 57713
-const MAX_QUERIES_PER_HOUR = 57714
-const MAX_UPDATES_PER_HOUR = 57715
-const MAX_CONNECTIONS_PER_HOUR = 57716
-const MAX_USER_CONNECTIONS = 57717
-const FORMAT = 57718
-const VERBOSE = 57719
-const CONNECTION = 57720
-const TRIGGERS = 57721
-const PROFILES = 57722
-const LOAD = 57723
-const INFILE = 57724
-const TERMINATED = 57725
-const OPTIONALLY = 57726
-const ENCLOSED = 57727
-const ESCAPED = 57728
-const STARTING = 57729
-const LINES = 57730
-const ROWS = 57731
-const IMPORT = 57732
-const MODUMP = 57733
-const OVER = 57734
-const PRECEDING = 57735
-const FOLLOWING = 57736
-const GROUPS = 57737
-const DATABASES = 57738
-const TABLES = 57739
-const SEQUENCES = 57740
-const EXTENDED = 57741
-const FULL = 57742
-const PROCESSLIST = 57743
-const FIELDS = 57744
-const COLUMNS = 57745
-const OPEN = 57746
-const ERRORS = 57747
-const WARNINGS = 57748
-const INDEXES = 57749
-const SCHEMAS = 57750
-const NODE = 57751
-const LOCKS = 57752
-const ROLES = 57753
-const TABLE_NUMBER = 57754
-const COLUMN_NUMBER = 57755
-const TABLE_VALUES = 57756
-const TABLE_SIZE = 57757
-const NAMES = 57758
-const GLOBAL = 57759
-const SESSION = 57760
-const ISOLATION = 57761
-const LEVEL = 57762
-const READ = 57763
-const WRITE = 57764
-const ONLY = 57765
-const REPEATABLE = 57766
-const COMMITTED = 57767
-const UNCOMMITTED = 57768
-const SERIALIZABLE = 57769
-const LOCAL = 57770
-const EVENTS = 57771
-const PLUGINS = 57772
-const CURRENT_TIMESTAMP = 57773
-const DATABASE = 57774
-const CURRENT_TIME = 57775
-const LOCALTIME = 57776
-const LOCALTIMESTAMP = 57777
-const UTC_DATE = 57778
-const UTC_TIME = 57779
-const UTC_TIMESTAMP = 57780
-const REPLACE = 57781
-const CONVERT = 57782
-const SEPARATOR = 57783
-const TIMESTAMPDIFF = 57784
-const CURRENT_DATE = 57785
-const CURRENT_USER = 57786
-const CURRENT_ROLE = 57787
-const SECOND_MICROSECOND = 57788
-const MINUTE_MICROSECOND = 57789
-const MINUTE_SECOND = 57790
-const HOUR_MICROSECOND = 57791
-const HOUR_SECOND = 57792
-const HOUR_MINUTE = 57793
-const DAY_MICROSECOND = 57794
-const DAY_SECOND = 57795
-const DAY_MINUTE = 57796
-const DAY_HOUR = 57797
-const YEAR_MONTH = 57798
-const SQL_TSI_HOUR = 57799
-const SQL_TSI_DAY = 57800
-const SQL_TSI_WEEK = 57801
-const SQL_TSI_MONTH = 57802
-const SQL_TSI_QUARTER = 57803
-const SQL_TSI_YEAR = 57804
-const SQL_TSI_SECOND = 57805
-const SQL_TSI_MINUTE = 57806
-const RECURSIVE = 57807
-const CONFIG = 57808
-const DRAINER = 57809
-const MATCH = 57810
-const AGAINST = 57811
-const BOOLEAN = 57812
-const LANGUAGE = 57813
-const WITH = 57814
-const QUERY = 57815
-const EXPANSION = 57816
-const ADDDATE = 57817
-const BIT_AND = 57818
-const BIT_OR = 57819
-const BIT_XOR = 57820
-const CAST = 57821
-const COUNT = 57822
-const APPROX_COUNT_DISTINCT = 57823
-const APPROX_PERCENTILE = 57824
-const CURDATE = 57825
-const CURTIME = 57826
-const DATE_ADD = 57827
-const DATE_SUB = 57828
-const EXTRACT = 57829
-const GROUP_CONCAT = 57830
-const MAX = 57831
-const MID = 57832
-const MIN = 57833
-const NOW = 57834
-const POSITION = 57835
-const SESSION_USER = 57836
-const STD = 57837
-const STDDEV = 57838
-const MEDIAN = 57839
-const STDDEV_POP = 57840
-const STDDEV_SAMP = 57841
-const SUBDATE = 57842
-const SUBSTR = 57843
-const SUBSTRING = 57844
-const SUM = 57845
-const SYSDATE = 57846
-const SYSTEM_USER = 57847
-const TRANSLATE = 57848
-const TRIM = 57849
-const VARIANCE = 57850
-const VAR_POP = 57851
-const VAR_SAMP = 57852
-const AVG = 57853
-const RANK = 57854
-const NEXTVAL = 57855
-const SETVAL = 57856
-const CURRVAL = 57857
-const LASTVAL = 57858
-const ARROW = 57859
-const ROW = 57860
-const OUTFILE = 57861
-const HEADER = 57862
-const MAX_FILE_SIZE = 57863
-const FORCE_QUOTE = 57864
-const PARALLEL = 57865
-const UNUSED = 57866
-const BINDINGS = 57867
-const DO = 57868
-const DECLARE = 57869
-const LOOP = 57870
-const WHILE = 57871
-const LEAVE = 57872
-const ITERATE = 57873
-const UNTIL = 57874
-const CALL = 57875
-const SPBEGIN = 57876
-const BACKEND = 57877
-const SERVERS = 57878
-const KILL = 57879
-const QUERY_RESULT = 57880
+const OF = 57365
+const FOR = 57366
+const CONNECT = 57367
+const MANAGE = 57368
+const GRANTS = 57369
+const OWNERSHIP = 57370
+const REFERENCE = 57371
+const BACKUP_ADMIN = 57372
+const LOWER_THAN_SET = 57373
+const SET = 57374
+const ALL = 57375
+const DISTINCT = 57376
+const DISTINCTROW = 57377
+const AS = 57378
+const EXISTS = 57379
+const ASC = 57380
+const DESC = 57381
+const INTO = 57382
+const DUPLICATE = 57383
+const DEFAULT = 57384
+const LOCK = 57385
+const KEYS = 57386
+const NULLS = 57387
+const FIRST = 57388
+const LAST = 57389
+const VALUES = 57390
+const NEXT = 57391
+const VALUE = 57392
+const SHARE = 57393
+const MODE = 57394
+const SQL_NO_CACHE = 57395
+const SQL_CACHE = 57396
+const JOIN = 57397
+const STRAIGHT_JOIN = 57398
+const LEFT = 57399
+const RIGHT = 57400
+const INNER = 57401
+const OUTER = 57402
+const CROSS = 57403
+const NATURAL = 57404
+const USE = 57405
+const FORCE = 57406
+const LOWER_THAN_ON = 57407
+const ON = 57408
+const USING = 57409
+const SUBQUERY_AS_EXPR = 57410
+const LOWER_THAN_STRING = 57411
+const ID = 57412
+const AT_ID = 57413
+const AT_AT_ID = 57414
+const STRING = 57415
+const VALUE_ARG = 57416
+const LIST_ARG = 57417
+const COMMENT = 57418
+const COMMENT_KEYWORD = 57419
+const QUOTE_ID = 57420
+const INTEGRAL = 57421
+const HEX = 57422
+const BIT_LITERAL = 57423
+const FLOAT = 57424
+const HEXNUM = 57425
+const NULL = 57426
+const TRUE = 57427
+const FALSE = 57428
+const LOWER_THAN_CHARSET = 57429
+const CHARSET = 57430
+const UNIQUE = 57431
+const KEY = 57432
+const OR = 57433
+const PIPE_CONCAT = 57434
+const XOR = 57435
+const AND = 57436
+const NOT = 57437
+const BETWEEN = 57438
+const CASE = 57439
+const WHEN = 57440
+const THEN = 57441
+const ELSE = 57442
+const END = 57443
+const ELSEIF = 57444
+const LOWER_THAN_EQ = 57445
+const LE = 57446
+const GE = 57447
+const NE = 57448
+const NULL_SAFE_EQUAL = 57449
+const IS = 57450
+const LIKE = 57451
+const REGEXP = 57452
+const IN = 57453
+const ASSIGNMENT = 57454
+const ILIKE = 57455
+const SHIFT_LEFT = 57456
+const SHIFT_RIGHT = 57457
+const DIV = 57458
+const MOD = 57459
+const UNARY = 57460
+const COLLATE = 57461
+const BINARY = 57462
+const UNDERSCORE_BINARY = 57463
+const INTERVAL = 57464
+const OUT = 57465
+const INOUT = 57466
+const BEGIN = 57467
+const START = 57468
+const TRANSACTION = 57469
+const COMMIT = 57470
+const ROLLBACK = 57471
+const WORK = 57472
+const CONSISTENT = 57473
+const SNAPSHOT = 57474
+const CHAIN = 57475
+const NO = 57476
+const RELEASE = 57477
+const PRIORITY = 57478
+const QUICK = 57479
+const BIT = 57480
+const TINYINT = 57481
+const SMALLINT = 57482
+const MEDIUMINT = 57483
+const INT = 57484
+const INTEGER = 57485
+const BIGINT = 57486
+const INTNUM = 57487
+const REAL = 57488
+const DOUBLE = 57489
+const FLOAT_TYPE = 57490
+const DECIMAL = 57491
+const NUMERIC = 57492
+const DECIMAL_VALUE = 57493
+const TIME = 57494
+const TIMESTAMP = 57495
+const DATETIME = 57496
+const YEAR = 57497
+const CHAR = 57498
+const VARCHAR = 57499
+const BOOL = 57500
+const CHARACTER = 57501
+const VARBINARY = 57502
+const NCHAR = 57503
+const TEXT = 57504
+const TINYTEXT = 57505
+const MEDIUMTEXT = 57506
+const LONGTEXT = 57507
+const BLOB = 57508
+const TINYBLOB = 57509
+const MEDIUMBLOB = 57510
+const LONGBLOB = 57511
+const JSON = 57512
+const ENUM = 57513
+const UUID = 57514
+const VECF32 = 57515
+const VECF64 = 57516
+const GEOMETRY = 57517
+const POINT = 57518
+const LINESTRING = 57519
+const POLYGON = 57520
+const GEOMETRYCOLLECTION = 57521
+const MULTIPOINT = 57522
+const MULTILINESTRING = 57523
+const MULTIPOLYGON = 57524
+const INT1 = 57525
+const INT2 = 57526
+const INT3 = 57527
+const INT4 = 57528
+const INT8 = 57529
+const S3OPTION = 57530
+const SQL_SMALL_RESULT = 57531
+const SQL_BIG_RESULT = 57532
+const SQL_BUFFER_RESULT = 57533
+const LOW_PRIORITY = 57534
+const HIGH_PRIORITY = 57535
+const DELAYED = 57536
+const CREATE = 57537
+const ALTER = 57538
+const DROP = 57539
+const RENAME = 57540
+const ANALYZE = 57541
+const ADD = 57542
+const RETURNS = 57543
+const SCHEMA = 57544
+const TABLE = 57545
+const SEQUENCE = 57546
+const INDEX = 57547
+const VIEW = 57548
+const TO = 57549
+const IGNORE = 57550
+const IF = 57551
+const PRIMARY = 57552
+const COLUMN = 57553
+const CONSTRAINT = 57554
+const SPATIAL = 57555
+const FULLTEXT = 57556
+const FOREIGN = 57557
+const KEY_BLOCK_SIZE = 57558
+const SHOW = 57559
+const DESCRIBE = 57560
+const EXPLAIN = 57561
+const DATE = 57562
+const ESCAPE = 57563
+const REPAIR = 57564
+const OPTIMIZE = 57565
+const TRUNCATE = 57566
+const MAXVALUE = 57567
+const PARTITION = 57568
+const REORGANIZE = 57569
+const LESS = 57570
+const THAN = 57571
+const PROCEDURE = 57572
+const TRIGGER = 57573
+const STATUS = 57574
+const VARIABLES = 57575
+const ROLE = 57576
+const PROXY = 57577
+const AVG_ROW_LENGTH = 57578
+const STORAGE = 57579
+const DISK = 57580
+const MEMORY = 57581
+const POLICY = 57582
+const POLICIES = 57583
+const CHECKSUM = 57584
+const COMPRESSION = 57585
+const DATA = 57586
+const DIRECTORY = 57587
+const DELAY_KEY_WRITE = 57588
+const ENCRYPTION = 57589
+const ENGINE = 57590
+const MAX_ROWS = 57591
+const MIN_ROWS = 57592
+const PACK_KEYS = 57593
+const ROW_FORMAT = 57594
+const STATS_AUTO_RECALC = 57595
+const STATS_PERSISTENT = 57596
+const STATS_SAMPLE_PAGES = 57597
+const DYNAMIC = 57598
+const COMPRESSED = 57599
+const REDUNDANT = 57600
+const COMPACT = 57601
+const FIXED = 57602
+const COLUMN_FORMAT = 57603
+const AUTO_RANDOM = 57604
+const GENERATED = 57605
+const ALWAYS = 57606
+const STORED = 57607
+const VIRTUAL = 57608
+const RESTRICT = 57609
+const CASCADE = 57610
+const ACTION = 57611
+const PARTIAL = 57612
+const SIMPLE = 57613
+const CHECK = 57614
+const ENFORCED = 57615
+const RANGE = 57616
+const LIST = 57617
+const ALGORITHM = 57618
+const LINEAR = 57619
+const PARTITIONS = 57620
+const SUBPARTITION = 57621
+const SUBPARTITIONS = 57622
+const CLUSTER = 57623
+const TYPE = 57624
+const ANY = 57625
+const SOME = 57626
+const EXTERNAL = 57627
+const LOCALFILE = 57628
+const URL = 57629
+const PREPARE = 57630
+const DEALLOCATE = 57631
+const RESET = 57632
+const EXTENSION = 57633
+const INCREMENT = 57634
+const CYCLE = 57635
+const MINVALUE = 57636
+const PUBLICATION = 57637
+const SUBSCRIPTIONS = 57638
+const PUBLICATIONS = 57639
+const PROPERTIES = 57640
+const PARSER = 57641
+const VISIBLE = 57642
+const INVISIBLE = 57643
+const BTREE = 57644
+const HASH = 57645
+const RTREE = 57646
+const BSI = 57647
+const IVFFLAT = 57648
+const LISTS = 57649
+const QUOTA = 57650
+const ZONEMAP = 57651
+const LEADING = 57652
+const BOTH = 57653
+const TRAILING = 57654
+const UNKNOWN = 57655
+const EXPIRE = 57656
+const ACCOUNT = 57657
+const ACCOUNTS = 57658
+const UNLOCK = 57659
+const DAY = 57660
+const NEVER = 57661
+const PUMP = 57662
+const MYSQL_COMPATIBILITY_MODE = 57663
+const SECOND = 57664
+const ASCII = 57665
+const COALESCE = 57666
+const COLLATION = 57667
+const HOUR = 57668
+const MICROSECOND = 57669
+const MINUTE = 57670
+const MONTH = 57671
+const QUARTER = 57672
+const REPEAT = 57673
+const REVERSE = 57674
+const ROW_COUNT = 57675
+const WEEK = 57676
+const REVOKE = 57677
+const FUNCTION = 57678
+const PRIVILEGES = 57679
+const TABLESPACE = 57680
+const EXECUTE = 57681
+const SUPER = 57682
+const GRANT = 57683
+const OPTION = 57684
+const REFERENCES = 57685
+const REPLICATION = 57686
+const SLAVE = 57687
+const CLIENT = 57688
+const USAGE = 57689
+const RELOAD = 57690
+const FILE = 57691
+const TEMPORARY = 57692
+const ROUTINE = 57693
+const EVENT = 57694
+const SHUTDOWN = 57695
+const NULLX = 57696
+const AUTO_INCREMENT = 57697
+const APPROXNUM = 57698
+const SIGNED = 57699
+const UNSIGNED = 57700
+const ZEROFILL = 57701
+const ENGINES = 57702
+const LOW_CARDINALITY = 57703
+const ADMIN_NAME = 57704
+const RANDOM = 57705
+const SUSPEND = 57706
+const ATTRIBUTE = 57707
+const HISTORY = 57708
+const REUSE = 57709
+const CURRENT = 57710
+const OPTIONAL = 57711
+const FAILED_LOGIN_ATTEMPTS = 57712
+const PASSWORD_LOCK_TIME = 57713
+const UNBOUNDED = 57714
+const SECONDARY = 57715
+const USER = 57716
+const IDENTIFIED = 57717
+const CIPHER = 57718
+const ISSUER = 57719
+const X509 = 57720
+const SUBJECT = 57721
+const SAN = 57722
+const REQUIRE = 57723
+const SSL = 57724
+const NONE = 57725
+const PASSWORD = 57726
+const MAX_QUERIES_PER_HOUR = 57727
+const MAX_UPDATES_PER_HOUR = 57728
+const MAX_CONNECTIONS_PER_HOUR = 57729
+const MAX_USER_CONNECTIONS = 57730
+const FORMAT = 57731
+const VERBOSE = 57732
+const CONNECTION = 57733
+const TRIGGERS = 57734
+const PROFILES = 57735
+const LOAD = 57736
+const INFILE = 57737
+const TERMINATED = 57738
+const OPTIONALLY = 57739
+const ENCLOSED = 57740
+const ESCAPED = 57741
+const STARTING = 57742
+const LINES = 57743
+const ROWS = 57744
+const IMPORT = 57745
+const MODUMP = 57746
+const OVER = 57747
+const PRECEDING = 57748
+const FOLLOWING = 57749
+const GROUPS = 57750
+const DATABASES = 57751
+const TABLES = 57752
+const SEQUENCES = 57753
+const EXTENDED = 57754
+const FULL = 57755
+const PROCESSLIST = 57756
+const FIELDS = 57757
+const COLUMNS = 57758
+const OPEN = 57759
+const ERRORS = 57760
+const WARNINGS = 57761
+const INDEXES = 57762
+const SCHEMAS = 57763
+const NODE = 57764
+const LOCKS = 57765
+const ROLES = 57766
+const TABLE_NUMBER = 57767
+const COLUMN_NUMBER = 57768
+const TABLE_VALUES = 57769
+const TABLE_SIZE = 57770
+const NAMES = 57771
+const GLOBAL = 57772
+const SESSION = 57773
+const ISOLATION = 57774
+const LEVEL = 57775
+const READ = 57776
+const WRITE = 57777
+const ONLY = 57778
+const REPEATABLE = 57779
+const COMMITTED = 57780
+const UNCOMMITTED = 57781
+const SERIALIZABLE = 57782
+const LOCAL = 57783
+const EVENTS = 57784
+const PLUGINS = 57785
+const CURRENT_TIMESTAMP = 57786
+const DATABASE = 57787
+const CURRENT_TIME = 57788
+const LOCALTIME = 57789
+const LOCALTIMESTAMP = 57790
+const UTC_DATE = 57791
+const UTC_TIME = 57792
+const UTC_TIMESTAMP = 57793
+const REPLACE = 57794
+const CONVERT = 57795
+const SEPARATOR = 57796
+const TIMESTAMPDIFF = 57797
+const CURRENT_DATE = 57798
+const CURRENT_USER = 57799
+const CURRENT_ROLE = 57800
+const SECOND_MICROSECOND = 57801
+const MINUTE_MICROSECOND = 57802
+const MINUTE_SECOND = 57803
+const HOUR_MICROSECOND = 57804
+const HOUR_SECOND = 57805
+const HOUR_MINUTE = 57806
+const DAY_MICROSECOND = 57807
+const DAY_SECOND = 57808
+const DAY_MINUTE = 57809
+const DAY_HOUR = 57810
+const YEAR_MONTH = 57811
+const SQL_TSI_HOUR = 57812
+const SQL_TSI_DAY = 57813
+const SQL_TSI_WEEK = 57814
+const SQL_TSI_MONTH = 57815
+const SQL_TSI_QUARTER = 57816
+const SQL_TSI_YEAR = 57817
+const SQL_TSI_SECOND = 57818
+const SQL_TSI_MINUTE = 57819
+const RECURSIVE = 57820
+const CONFIG = 57821
+const DRAINER = 57822
+const MATCH = 57823
+const AGAINST = 57824
+const BOOLEAN = 57825
+const LANGUAGE = 57826
+const WITH = 57827
+const QUERY = 57828
+const EXPANSION = 57829
+const ADDDATE = 57830
+const BIT_AND = 57831
+const BIT_OR = 57832
+const BIT_XOR = 57833
+const CAST = 57834
+const COUNT = 57835
+const APPROX_COUNT_DISTINCT = 57836
+const APPROX_PERCENTILE = 57837
+const CURDATE = 57838
+const CURTIME = 57839
+const DATE_ADD = 57840
+const DATE_SUB = 57841
+const EXTRACT = 57842
+const GROUP_CONCAT = 57843
+const MAX = 57844
+const MID = 57845
+const MIN = 57846
+const NOW = 57847
+const POSITION = 57848
+const SESSION_USER = 57849
+const STD = 57850
+const STDDEV = 57851
+const MEDIAN = 57852
+const STDDEV_POP = 57853
+const STDDEV_SAMP = 57854
+const SUBDATE = 57855
+const SUBSTR = 57856
+const SUBSTRING = 57857
+const SUM = 57858
+const SYSDATE = 57859
+const SYSTEM_USER = 57860
+const TRANSLATE = 57861
+const TRIM = 57862
+const VARIANCE = 57863
+const VAR_POP = 57864
+const VAR_SAMP = 57865
+const AVG = 57866
+const RANK = 57867
+const NEXTVAL = 57868
+const SETVAL = 57869
+const CURRVAL = 57870
+const LASTVAL = 57871
+const ARROW = 57872
+const ROW = 57873
+const OUTFILE = 57874
+const HEADER = 57875
+const MAX_FILE_SIZE = 57876
+const FORCE_QUOTE = 57877
+const PARALLEL = 57878
+const UNUSED = 57879
+const BINDINGS = 57880
+const DO = 57881
+const DECLARE = 57882
+const LOOP = 57883
+const WHILE = 57884
+const LEAVE = 57885
+const ITERATE = 57886
+const UNTIL = 57887
+const CALL = 57888
+const SPBEGIN = 57889
+const BACKEND = 57890
+const SERVERS = 57891
+const KILL = 57892
+const BACKUP = 57893
+const QUERY_RESULT = 57894
 
 var yyToknames = [...]string{
 	"$end",
@@ -579,12 +593,14 @@ var yyToknames = [...]string{
 	"BY",
 	"LIMIT",
 	"OFFSET",
+	"OF",
 	"FOR",
 	"CONNECT",
 	"MANAGE",
 	"GRANTS",
 	"OWNERSHIP",
 	"REFERENCE",
+	"BACKUP_ADMIN",
 	"LOWER_THAN_SET",
 	"SET",
 	"ALL",
@@ -744,6 +760,8 @@ var yyToknames = [...]string{
 	"JSON",
 	"ENUM",
 	"UUID",
+	"VECF32",
+	"VECF64",
 	"GEOMETRY",
 	"POINT",
 	"LINESTRING",
@@ -809,6 +827,8 @@ var yyToknames = [...]string{
 	"STORAGE",
 	"DISK",
 	"MEMORY",
+	"POLICY",
+	"POLICIES",
 	"CHECKSUM",
 	"COMPRESSION",
 	"DATA",
@@ -830,6 +850,10 @@ var yyToknames = [...]string{
 	"FIXED",
 	"COLUMN_FORMAT",
 	"AUTO_RANDOM",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
 	"RESTRICT",
 	"CASCADE",
 	"ACTION",
@@ -869,6 +893,9 @@ var yyToknames = [...]string{
 	"HASH",
 	"RTREE",
 	"BSI",
+	"IVFFLAT",
+	"LISTS",
+	"QUOTA",
 	"ZONEMAP",
 	"LEADING",
 	"BOTH",
@@ -1111,6 +1138,7 @@ var yyToknames = [...]string{
 	"BACKEND",
 	"SERVERS",
 	"KILL",
+	"BACKUP",
 	"QUERY_RESULT",
 	"';'",
 	"':'",
@@ -1125,3847 +1153,4078 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:9375
+//line mysql_sql.y:9627
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 109,
-	21, 625,
-	-2, 606,
-	-1, 123,
-	218, 842,
-	-2, 913,
-	-1, 145,
-	42, 446,
-	218, 446,
-	245, 453,
-	246, 453,
-	424, 446,
-	-2, 479,
-	-1, 181,
-	557, 1572,
-	-2, 365,
-	-1, 498,
-	294, 130,
-	399, 130,
-	-2, 1486,
-	-1, 561,
-	67, 1292,
-	-2, 1626,
-	-1, 562,
-	67, 1310,
-	-2, 1597,
-	-1, 566,
-	67, 1311,
-	-2, 1625,
-	-1, 589,
-	67, 1222,
-	-2, 1687,
-	-1, 590,
-	67, 1223,
-	-2, 1686,
-	-1, 591,
-	67, 1224,
-	-2, 1676,
-	-1, 592,
-	67, 1651,
-	-2, 1671,
-	-1, 593,
-	67, 1652,
-	-2, 1672,
-	-1, 594,
-	67, 1653,
-	-2, 1678,
-	-1, 595,
-	67, 1654,
-	-2, 1661,
-	-1, 596,
-	67, 1655,
-	-2, 1669,
-	-1, 597,
-	67, 1656,
-	-2, 1679,
-	-1, 598,
-	67, 1657,
-	-2, 1680,
-	-1, 599,
-	67, 1658,
-	-2, 1685,
-	-1, 600,
-	67, 1659,
-	-2, 1690,
-	-1, 601,
-	67, 1660,
-	-2, 1691,
-	-1, 603,
-	67, 1289,
-	-2, 1478,
+	-1, 112,
+	21, 647,
+	-2, 628,
+	-1, 127,
+	222, 868,
+	-2, 941,
+	-1, 150,
+	44, 466,
+	222, 466,
+	249, 473,
+	250, 473,
+	437, 466,
+	-2, 499,
+	-1, 186,
+	571, 1612,
+	-2, 381,
+	-1, 517,
+	304, 134,
+	412, 134,
+	-2, 1525,
+	-1, 580,
+	69, 1327,
+	-2, 1668,
+	-1, 581,
+	69, 1345,
+	-2, 1638,
+	-1, 585,
+	69, 1346,
+	-2, 1667,
+	-1, 608,
+	69, 1257,
+	-2, 1736,
+	-1, 609,
+	69, 1258,
+	-2, 1735,
 	-1, 610,
-	67, 1298,
-	-2, 1504,
+	69, 1259,
+	-2, 1725,
+	-1, 611,
+	69, 1700,
+	-2, 1720,
+	-1, 612,
+	69, 1701,
+	-2, 1721,
+	-1, 613,
+	69, 1702,
+	-2, 1727,
 	-1, 614,
-	67, 1302,
-	-2, 1543,
+	69, 1703,
+	-2, 1710,
 	-1, 615,
-	67, 1303,
-	-2, 1621,
-	-1, 623,
-	67, 1313,
-	-2, 1606,
-	-1, 625,
-	67, 1315,
-	-2, 1616,
-	-1, 626,
-	67, 1316,
-	-2, 1641,
-	-1, 637,
-	67, 1200,
-	-2, 1681,
-	-1, 638,
-	67, 1201,
-	-2, 1682,
-	-1, 639,
-	67, 1202,
-	-2, 1683,
-	-1, 643,
-	21, 626,
-	-2, 589,
-	-1, 712,
-	419, 479,
-	420, 479,
-	-2, 447,
-	-1, 754,
-	105, 1478,
-	116, 1478,
-	136, 1478,
-	-2, 1453,
-	-1, 854,
-	21, 626,
-	-2, 589,
-	-1, 953,
-	21, 625,
-	-2, 1105,
-	-1, 1294,
-	67, 1360,
-	-2, 1623,
-	-1, 1295,
-	67, 1361,
-	-2, 1624,
-	-1, 1427,
-	68, 767,
-	-2, 773,
-	-1, 1750,
-	68, 1439,
-	137, 1439,
-	-2, 1608,
-	-1, 1751,
-	68, 1439,
-	137, 1439,
-	-2, 1607,
-	-1, 1752,
-	68, 1417,
-	137, 1417,
-	-2, 1594,
-	-1, 1753,
-	68, 1418,
-	137, 1418,
-	-2, 1599,
-	-1, 1754,
-	68, 1419,
-	137, 1419,
-	-2, 1531,
-	-1, 1755,
-	68, 1420,
-	137, 1420,
-	-2, 1525,
-	-1, 1756,
-	68, 1421,
-	137, 1421,
-	-2, 1469,
-	-1, 1757,
-	68, 1422,
-	137, 1422,
-	-2, 1596,
-	-1, 1758,
-	68, 1423,
-	137, 1423,
-	-2, 1529,
-	-1, 1759,
-	68, 1424,
-	137, 1424,
-	-2, 1524,
-	-1, 1760,
-	68, 1425,
-	137, 1425,
+	69, 1704,
+	-2, 1718,
+	-1, 616,
+	69, 1705,
+	-2, 1728,
+	-1, 617,
+	69, 1706,
+	-2, 1729,
+	-1, 618,
+	69, 1707,
+	-2, 1734,
+	-1, 619,
+	69, 1708,
+	-2, 1739,
+	-1, 620,
+	69, 1709,
+	-2, 1740,
+	-1, 622,
+	69, 1324,
 	-2, 1517,
-	-1, 1762,
-	68, 1428,
-	137, 1428,
-	-2, 1641,
-	-1, 1763,
-	68, 1408,
-	137, 1408,
-	-2, 1626,
-	-1, 1764,
-	68, 1437,
-	137, 1437,
-	-2, 1597,
-	-1, 1765,
-	68, 1437,
-	137, 1437,
-	-2, 1625,
-	-1, 1766,
-	68, 1437,
-	137, 1437,
-	-2, 1487,
-	-1, 1767,
-	68, 1435,
-	137, 1435,
-	-2, 1616,
-	-1, 1768,
-	68, 1432,
-	137, 1432,
+	-1, 629,
+	69, 1333,
+	-2, 1543,
+	-1, 633,
+	69, 1337,
+	-2, 1583,
+	-1, 634,
+	69, 1338,
+	-2, 1663,
+	-1, 642,
+	69, 1348,
+	-2, 1647,
+	-1, 644,
+	69, 1350,
+	-2, 1658,
+	-1, 645,
+	69, 1351,
+	-2, 1683,
+	-1, 656,
+	69, 1235,
+	-2, 1730,
+	-1, 657,
+	69, 1236,
+	-2, 1731,
+	-1, 658,
+	69, 1237,
+	-2, 1732,
+	-1, 662,
+	21, 648,
+	-2, 611,
+	-1, 736,
+	432, 499,
+	433, 499,
+	-2, 467,
+	-1, 779,
+	107, 1517,
+	118, 1517,
+	138, 1517,
+	-2, 1490,
+	-1, 882,
+	21, 648,
+	-2, 611,
+	-1, 982,
+	21, 647,
+	-2, 1139,
+	-1, 1337,
+	69, 1395,
+	-2, 1665,
+	-1, 1338,
+	69, 1396,
+	-2, 1666,
+	-1, 1473,
+	70, 791,
+	-2, 797,
+	-1, 1808,
+	70, 1476,
+	139, 1476,
+	-2, 1649,
+	-1, 1809,
+	70, 1476,
+	139, 1476,
+	-2, 1648,
+	-1, 1810,
+	70, 1452,
+	139, 1452,
+	-2, 1635,
+	-1, 1811,
+	70, 1453,
+	139, 1453,
+	-2, 1640,
+	-1, 1812,
+	70, 1454,
+	139, 1454,
+	-2, 1571,
+	-1, 1813,
+	70, 1455,
+	139, 1455,
+	-2, 1565,
+	-1, 1814,
+	70, 1456,
+	139, 1456,
+	-2, 1508,
+	-1, 1815,
+	70, 1457,
+	139, 1457,
+	-2, 1637,
+	-1, 1816,
+	70, 1458,
+	139, 1458,
+	-2, 1569,
+	-1, 1817,
+	70, 1459,
+	139, 1459,
+	-2, 1564,
+	-1, 1818,
+	70, 1460,
+	139, 1460,
+	-2, 1557,
+	-1, 1820,
+	70, 1463,
+	139, 1463,
+	-2, 1683,
+	-1, 1823,
+	70, 1443,
+	139, 1443,
+	-2, 1668,
+	-1, 1824,
+	70, 1474,
+	139, 1474,
+	-2, 1638,
+	-1, 1825,
+	70, 1474,
+	139, 1474,
+	-2, 1667,
+	-1, 1826,
+	70, 1474,
+	139, 1474,
+	-2, 1526,
+	-1, 1827,
+	70, 1472,
+	139, 1472,
+	-2, 1658,
+	-1, 1828,
+	70, 1469,
+	139, 1469,
+	-2, 1549,
+	-1, 1829,
+	69, 1425,
+	70, 1425,
+	139, 1425,
+	374, 1425,
+	375, 1425,
+	376, 1425,
+	-2, 1507,
+	-1, 1830,
+	69, 1426,
+	70, 1426,
+	139, 1426,
+	374, 1426,
+	375, 1426,
+	376, 1426,
 	-2, 1509,
-	-1, 1769,
-	67, 1390,
-	68, 1390,
-	137, 1390,
-	361, 1390,
-	362, 1390,
-	363, 1390,
-	-2, 1468,
-	-1, 1770,
-	67, 1391,
-	68, 1391,
-	137, 1391,
-	361, 1391,
-	362, 1391,
-	363, 1391,
-	-2, 1470,
-	-1, 1771,
-	67, 1394,
-	68, 1394,
-	137, 1394,
-	361, 1394,
-	362, 1394,
-	363, 1394,
-	-2, 1598,
-	-1, 1772,
-	67, 1396,
-	68, 1396,
-	137, 1396,
-	361, 1396,
-	362, 1396,
-	363, 1396,
-	-2, 1581,
-	-1, 1773,
-	67, 1398,
-	68, 1398,
-	137, 1398,
-	361, 1398,
-	362, 1398,
-	363, 1398,
-	-2, 1530,
-	-1, 1774,
-	67, 1400,
-	68, 1400,
-	137, 1400,
-	361, 1400,
-	362, 1400,
-	363, 1400,
-	-2, 1513,
-	-1, 1775,
-	67, 1401,
-	68, 1401,
-	137, 1401,
-	361, 1401,
-	362, 1401,
-	363, 1401,
-	-2, 1514,
-	-1, 1776,
-	67, 1403,
-	68, 1403,
-	137, 1403,
-	361, 1403,
-	362, 1403,
-	363, 1403,
-	-2, 1467,
-	-1, 1777,
-	68, 1442,
-	137, 1442,
-	361, 1442,
-	362, 1442,
-	363, 1442,
-	-2, 1492,
-	-1, 1778,
-	68, 1442,
-	137, 1442,
-	361, 1442,
-	362, 1442,
-	363, 1442,
-	-2, 1505,
-	-1, 1779,
-	68, 1445,
-	137, 1445,
-	361, 1445,
-	362, 1445,
-	363, 1445,
-	-2, 1488,
-	-1, 1780,
-	68, 1442,
-	137, 1442,
-	361, 1442,
-	362, 1442,
-	363, 1442,
-	-2, 1566,
-	-1, 1793,
-	88, 877,
-	132, 877,
-	171, 877,
-	174, 877,
-	258, 877,
-	-2, 870,
-	-1, 1902,
-	21, 625,
-	-2, 717,
-	-1, 2080,
-	88, 877,
-	132, 877,
-	171, 877,
-	174, 877,
-	258, 877,
-	-2, 871,
-	-1, 2092,
-	65, 533,
-	137, 533,
-	-2, 1008,
-	-1, 2110,
-	279, 1073,
-	-2, 1052,
-	-1, 2370,
-	279, 1073,
-	-2, 1053,
-	-1, 2503,
-	88, 877,
-	132, 877,
-	171, 877,
-	174, 877,
-	-2, 956,
-	-1, 2506,
-	88, 877,
-	132, 877,
-	171, 877,
-	174, 877,
-	-2, 956,
-	-1, 2516,
-	65, 533,
-	137, 533,
-	-2, 1009,
-	-1, 2614,
-	88, 877,
-	132, 877,
-	171, 877,
-	174, 877,
-	-2, 957,
-	-1, 2904,
-	68, 928,
-	137, 928,
-	-2, 877,
-	-1, 2908,
-	68, 928,
-	137, 928,
-	-2, 877,
-	-1, 2922,
-	68, 932,
-	137, 932,
-	-2, 877,
-	-1, 2927,
-	68, 933,
-	137, 933,
-	-2, 877,
+	-1, 1831,
+	69, 1429,
+	70, 1429,
+	139, 1429,
+	374, 1429,
+	375, 1429,
+	376, 1429,
+	-2, 1639,
+	-1, 1832,
+	69, 1431,
+	70, 1431,
+	139, 1431,
+	374, 1431,
+	375, 1431,
+	376, 1431,
+	-2, 1621,
+	-1, 1833,
+	69, 1433,
+	70, 1433,
+	139, 1433,
+	374, 1433,
+	375, 1433,
+	376, 1433,
+	-2, 1570,
+	-1, 1834,
+	69, 1435,
+	70, 1435,
+	139, 1435,
+	374, 1435,
+	375, 1435,
+	376, 1435,
+	-2, 1553,
+	-1, 1835,
+	69, 1436,
+	70, 1436,
+	139, 1436,
+	374, 1436,
+	375, 1436,
+	376, 1436,
+	-2, 1554,
+	-1, 1836,
+	69, 1438,
+	70, 1438,
+	139, 1438,
+	374, 1438,
+	375, 1438,
+	376, 1438,
+	-2, 1506,
+	-1, 1837,
+	70, 1479,
+	139, 1479,
+	374, 1479,
+	375, 1479,
+	376, 1479,
+	-2, 1531,
+	-1, 1838,
+	70, 1479,
+	139, 1479,
+	374, 1479,
+	375, 1479,
+	376, 1479,
+	-2, 1544,
+	-1, 1839,
+	70, 1482,
+	139, 1482,
+	374, 1482,
+	375, 1482,
+	376, 1482,
+	-2, 1527,
+	-1, 1840,
+	70, 1479,
+	139, 1479,
+	374, 1479,
+	375, 1479,
+	376, 1479,
+	-2, 1606,
+	-1, 1854,
+	90, 905,
+	134, 905,
+	173, 905,
+	176, 905,
+	264, 905,
+	-2, 898,
+	-1, 1963,
+	21, 647,
+	-2, 739,
+	-1, 2150,
+	90, 905,
+	134, 905,
+	173, 905,
+	176, 905,
+	264, 905,
+	-2, 899,
+	-1, 2162,
+	67, 555,
+	139, 555,
+	-2, 1036,
+	-1, 2180,
+	289, 1107,
+	-2, 1081,
+	-1, 2445,
+	289, 1107,
+	-2, 1082,
+	-1, 2583,
+	90, 905,
+	134, 905,
+	173, 905,
+	176, 905,
+	-2, 984,
+	-1, 2586,
+	90, 905,
+	134, 905,
+	173, 905,
+	176, 905,
+	-2, 984,
+	-1, 2596,
+	67, 555,
+	139, 555,
+	-2, 1037,
+	-1, 2701,
+	90, 905,
+	134, 905,
+	173, 905,
+	176, 905,
+	-2, 985,
+	-1, 3019,
+	70, 956,
+	139, 956,
+	-2, 905,
+	-1, 3023,
+	70, 956,
+	139, 956,
+	-2, 905,
+	-1, 3037,
+	70, 960,
+	139, 960,
+	-2, 905,
+	-1, 3042,
+	70, 961,
+	139, 961,
+	-2, 905,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 34495
+const yyLast = 36817
 
 var yyAct = [...]int{
-	528, 2908, 1213, 1489, 2907, 2887, 172, 2916, 507, 2798,
-	509, 2846, 1275, 530, 2816, 2581, 2838, 2674, 2586, 2382,
-	2757, 2646, 2758, 1728, 2725, 1087, 2458, 2741, 2745, 2607,
-	2668, 644, 2459, 2606, 984, 2690, 2658, 2584, 1204, 417,
-	1448, 2635, 1278, 558, 2095, 2613, 2347, 1138, 423, 2576,
-	428, 428, 2526, 2175, 1271, 2176, 428, 444, 451, 1546,
-	2486, 451, 157, 2371, 2161, 2171, 2394, 1830, 2168, 511,
-	2456, 1985, 1638, 1607, 2444, 2197, 2174, 1833, 2322, 462,
-	2427, 1748, 2319, 1896, 2317, 2081, 753, 1200, 1746, 506,
-	1046, 1521, 1559, 1849, 2393, 2608, 1802, 456, 848, 1738,
-	1984, 2345, 2026, 2227, 1634, 1409, 500, 1616, 2266, 501,
-	1615, 1608, 1935, 2210, 1539, 1492, 1581, 1633, 1897, 1885,
-	1212, 1522, 1064, 1524, 2063, 1062, 690, 2059, 759, 2112,
-	1485, 1831, 1417, 53, 168, 8, 1952, 1274, 167, 7,
-	6, 1435, 1666, 1801, 508, 1269, 1635, 36, 803, 417,
-	510, 1195, 1147, 1169, 1543, 108, 1786, 422, 35, 1744,
-	2027, 1324, 1308, 1459, 1260, 1458, 26, 1614, 15, 1076,
-	1450, 1645, 172, 13, 172, 865, 794, 795, 518, 1205,
-	14, 1096, 1176, 757, 440, 499, 501, 1611, 1095, 1597,
-	1020, 1571, 1268, 745, 1904, 1434, 1476, 641, 437, 1330,
-	1122, 1329, 464, 1072, 449, 707, 1088, 23, 16, 158,
-	10, 1130, 689, 154, 1168, 687, 465, 151, 985, 2260,
-	1044, 2260, 450, 448, 1652, 445, 1987, 1642, 2451, 1941,
-	446, 1939, 719, 1938, 790, 1936, 792, 447, 643, 746,
-	787, 1183, 1179, 791, 787, 786, 156, 1181, 787, 424,
-	1108, 922, 923, 924, 921, 922, 923, 924, 921, 2574,
-	2223, 2221, 1586, 2664, 2659, 2577, 2457, 1413, 979, 2734,
-	1610, 433, 642, 155, 2789, 49, 147, 124, 416, 652,
-	1036, 2700, 2599, 454, 155, 155, 763, 1350, 155, 885,
-	155, 8, 155, 155, 2598, 7, 1972, 785, 1639, 1980,
-	155, 2709, 49, 147, 124, 1227, 2289, 460, 760, 1650,
-	155, 762, 1220, 1790, 1916, 155, 461, 49, 147, 124,
-	2242, 1224, 919, 1557, 2235, 2701, 107, 1917, 1217, 900,
-	152, 1037, 901, 1421, 1422, 1084, 1245, 769, 764, 768,
-	770, 152, 1226, 2061, 1953, 152, 107, 152, 645, 1219,
-	152, 729, 632, 2834, 631, 633, 634, 152, 635, 636,
-	903, 1093, 1094, 2832, 774, 1091, 1472, 152, 767, 1090,
-	1093, 1094, 152, 734, 653, 1277, 733, 2761, 2762, 917,
-	756, 893, 755, 2594, 895, 912, 1261, 1721, 1104, 1265,
-	2666, 1105, 922, 923, 924, 921, 2060, 2735, 2736, 2820,
-	2821, 2669, 2670, 2671, 2672, 2460, 2460, 2228, 2727, 2727,
-	2730, 2662, 896, 1264, 1967, 2229, 772, 2230, 1280, 868,
-	859, 2740, 2469, 775, 1540, 1536, 2788, 428, 2331, 1532,
-	2487, 1646, 898, 2333, 1256, 2494, 2323, 428, 858, 1346,
-	765, 1876, 1785, 1343, 2682, 2051, 2255, 1345, 1342, 1344,
-	1348, 1349, 1594, 451, 451, 1347, 428, 1182, 1180, 738,
-	2604, 773, 2066, 2389, 495, 853, 855, 497, 1189, 1188,
-	1977, 123, 496, 153, 1107, 888, 735, 915, 916, 2253,
-	2328, 2329, 914, 2575, 889, 905, 2827, 2327, 906, 1266,
-	2222, 899, 2165, 145, 1878, 2330, 2685, 797, 2601, 766,
-	758, 2338, 1881, 1364, 2791, 2792, 2344, 891, 2593, 2836,
-	1263, 1082, 868, 2351, 2595, 955, 908, 2760, 2697, 894,
-	897, 2402, 2403, 1279, 2075, 2076, 2077, 2078, 852, 2750,
-	2088, 453, 452, 857, 2547, 737, 2746, 2901, 2917, 880,
-	2855, 2831, 2800, 890, 1651, 2716, 1071, 1286, 1289, 1290,
-	2862, 1655, 1657, 1658, 1859, 2539, 1555, 1556, 1287, 2648,
-	858, 2409, 902, 854, 2866, 1858, 2796, 2797, 1117, 2800,
-	771, 2841, 2072, 2552, 2553, 2530, 1126, 763, 1125, 910,
-	911, 878, 2325, 2146, 1086, 1085, 1110, 1069, 904, 449,
-	449, 1353, 1354, 1355, 1356, 1357, 1358, 1351, 1352, 760,
-	870, 869, 762, 1068, 2918, 2912, 736, 2888, 448, 448,
-	445, 445, 2534, 2691, 892, 446, 446, 849, 2305, 1262,
-	2924, 1106, 447, 447, 909, 2508, 861, 862, 2572, 988,
-	2636, 2637, 2638, 2640, 2639, 1667, 1047, 460, 2724, 1123,
-	885, 1640, 2473, 1973, 2259, 1907, 763, 907, 1640, 1643,
-	1640, 1848, 1052, 2258, 1056, 989, 1055, 1054, 957, 958,
-	959, 960, 455, 1042, 423, 1045, 1836, 2313, 760, 2698,
-	1839, 762, 1017, 1654, 877, 873, 874, 2268, 2267, 2199,
-	2201, 1059, 787, 787, 863, 787, 2790, 2050, 787, 690,
-	2842, 2699, 1732, 870, 869, 1040, 787, 1424, 961, 787,
-	684, 685, 686, 1937, 1425, 1093, 1094, 1184, 1734, 1733,
-	1653, 2737, 2738, 682, 1641, 1093, 1094, 1083, 1038, 1039,
-	1843, 1731, 1423, 884, 654, 655, 1092, 2621, 2324, 2837,
-	2334, 2911, 1089, 2867, 2930, 428, 642, 1119, 2683, 1541,
-	2256, 2093, 2065, 920, 2647, 2424, 50, 2356, 417, 417,
-	417, 2600, 125, 1142, 1142, 1451, 428, 1048, 1049, 1050,
-	1051, 50, 1053, 125, 125, 2420, 1057, 125, 1981, 125,
-	2326, 125, 125, 451, 1045, 423, 879, 1172, 1172, 125,
-	1288, 1535, 758, 1149, 1656, 1533, 997, 998, 172, 125,
-	1257, 2923, 1451, 2605, 125, 2069, 2070, 417, 1840, 502,
-	1835, 1144, 2532, 920, 1741, 1837, 2531, 1140, 1140, 2068,
-	2147, 2149, 2150, 2151, 2148, 2839, 2840, 2929, 1070, 2094,
-	885, 1043, 658, 2535, 2536, 1080, 1726, 1742, 1743, 1238,
-	1239, 730, 2920, 1098, 1099, 1894, 1101, 1102, 1103, 2200,
-	730, 1190, 778, 783, 784, 1211, 1574, 1214, 1842, 1258,
-	2902, 1022, 1222, 1846, 1844, 2897, 1838, 1955, 1845, 1024,
-	2504, 739, 2891, 2094, 2890, 1677, 922, 923, 924, 921,
-	1078, 1079, 1243, 657, 2871, 2342, 1895, 660, 659, 1228,
-	1073, 1077, 1077, 1077, 1853, 1142, 920, 1142, 858, 2885,
-	643, 922, 923, 924, 921, 922, 923, 924, 921, 1698,
-	1118, 2921, 1697, 1073, 1061, 1073, 2848, 2810, 1722, 1972,
-	1202, 1203, 2287, 2424, 732, 1097, 2768, 731, 1100, 1648,
-	646, 1242, 883, 732, 2898, 1109, 731, 1111, 1788, 1241,
-	2763, 1648, 1165, 1648, 1259, 2718, 1895, 1676, 2717, 2714,
-	2713, 1725, 1895, 1648, 1296, 1297, 1298, 1299, 1300, 1301,
-	1302, 1303, 1304, 1305, 1306, 1307, 1193, 1124, 1196, 1197,
-	1319, 1320, 1136, 1137, 2712, 2711, 2686, 1328, 1572, 788,
-	789, 920, 1150, 2554, 793, 2849, 2811, 1367, 1368, 1369,
-	1377, 433, 763, 1276, 882, 2687, 763, 1133, 1134, 1135,
-	1383, 1273, 1173, 1384, 1164, 1163, 1218, 1174, 2411, 2687,
-	1225, 2343, 2194, 1386, 2719, 1391, 1392, 1806, 2687, 2687,
-	2056, 449, 2053, 780, 781, 782, 2032, 1291, 646, 1988,
-	1252, 1207, 1270, 1210, 1960, 1787, 1254, 1074, 1918, 1970,
-	448, 1964, 445, 2687, 2687, 2687, 1185, 446, 920, 1251,
-	1962, 1248, 1918, 1407, 447, 1229, 1247, 428, 1234, 1433,
-	1142, 1437, 1639, 1439, 1440, 1957, 885, 883, 428, 1824,
-	1950, 690, 1230, 1727, 1449, 1702, 1630, 2412, 1142, 1553,
-	1948, 1895, 1060, 1119, 1946, 1944, 1322, 1127, 444, 1410,
-	1250, 1249, 643, 1246, 1805, 920, 1267, 1376, 920, 2850,
-	1359, 1360, 2519, 1363, 1317, 1318, 1723, 1471, 1806, 2493,
-	1958, 1378, 1272, 2357, 2004, 1477, 1477, 1706, 1119, 1963,
-	1119, 1705, 1119, 2212, 1385, 428, 1387, 1433, 1433, 1475,
-	1696, 1142, 1519, 1531, 1958, 1432, 1310, 1075, 417, 1951,
-	1142, 1438, 843, 840, 841, 842, 1018, 2096, 2009, 1949,
-	2008, 2007, 2005, 1945, 1945, 1441, 1442, 1443, 851, 1687,
-	1686, 1975, 1552, 1806, 1685, 1647, 428, 1433, 1142, 1974,
-	1564, 428, 428, 1567, 2361, 1722, 1235, 1906, 1570, 1966,
-	1821, 1693, 1576, 1362, 1678, 937, 920, 1629, 1579, 172,
-	920, 1429, 172, 172, 1231, 172, 966, 1436, 2250, 920,
-	871, 851, 1457, 1515, 1516, 846, 844, 1479, 2352, 1388,
-	1366, 1365, 2751, 1065, 2006, 1454, 1537, 1066, 1466, 1467,
-	1452, 1453, 1129, 1414, 1131, 656, 1408, 2880, 920, 920,
-	1377, 1377, 1618, 920, 1648, 1132, 1074, 1377, 1377, 2868,
-	1850, 2425, 1625, 1561, 1542, 1236, 851, 2416, 1563, 2413,
-	2261, 1585, 2166, 1961, 1588, 1589, 2752, 1591, 1909, 860,
-	1073, 1464, 1936, 1565, 1566, 2622, 1449, 2353, 1436, 1445,
-	1142, 1637, 1469, 1465, 2449, 1460, 1470, 1462, 1463, 1473,
-	1474, 1480, 1456, 1446, 1077, 1481, 1461, 1482, 2511, 2509,
-	1468, 945, 946, 938, 939, 940, 941, 942, 943, 944,
-	937, 1550, 1551, 1397, 1128, 1270, 1631, 1995, 1619, 2623,
-	1930, 2354, 1478, 925, 938, 939, 940, 941, 942, 943,
-	944, 937, 954, 1325, 1660, 1673, 1518, 1520, 1583, 1316,
-	963, 1538, 2512, 2510, 1664, 1665, 1075, 1613, 1325, 1177,
-	661, 1583, 2010, 2011, 1613, 1313, 1315, 1312, 2214, 1314,
-	1558, 1431, 968, 924, 921, 1547, 1548, 1549, 2785, 921,
-	1562, 2542, 2541, 763, 922, 923, 924, 921, 1582, 2231,
-	763, 531, 540, 2450, 2124, 1580, 2123, 532, 2118, 539,
-	533, 537, 536, 534, 535, 760, 2116, 2523, 762, 2280,
-	2906, 2865, 760, 449, 2894, 762, 2856, 2602, 495, 1599,
-	1381, 497, 1703, 2169, 2851, 1622, 496, 2318, 2801, 1710,
-	1689, 1382, 448, 2776, 445, 922, 923, 924, 921, 446,
-	2753, 2702, 1628, 1177, 1620, 2660, 447, 2628, 2625, 1623,
-	1627, 1624, 541, 1632, 2279, 2864, 2603, 500, 2624, 858,
-	1781, 935, 945, 946, 938, 939, 940, 941, 942, 943,
-	944, 937, 428, 428, 428, 763, 1803, 922, 923, 924,
-	921, 1529, 2513, 1688, 538, 2490, 1810, 1119, 1662, 1663,
-	2491, 1668, 922, 923, 924, 921, 1814, 760, 2332, 2246,
-	762, 1659, 2226, 2452, 2225, 1661, 922, 923, 924, 921,
-	1119, 2141, 2140, 2139, 2895, 1672, 2157, 858, 2136, 2130,
-	2127, 1310, 922, 923, 924, 921, 2126, 459, 1602, 2492,
-	1601, 427, 427, 1940, 1389, 1390, 1600, 435, 1393, 1394,
-	1395, 1396, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405,
-	922, 923, 924, 921, 1749, 2156, 2155, 1899, 1899, 1531,
-	1899, 2826, 2153, 1825, 936, 935, 945, 946, 938, 939,
-	940, 941, 942, 943, 944, 937, 858, 940, 941, 942,
-	943, 944, 937, 1142, 428, 1596, 1782, 928, 929, 930,
-	931, 932, 933, 934, 926, 2154, 1595, 1720, 1232, 858,
-	423, 2152, 1035, 1172, 2582, 1531, 1171, 1171, 1925, 1852,
-	1927, 2822, 1829, 2786, 172, 1851, 2722, 1854, 1855, 1856,
-	1857, 1817, 2143, 1860, 1861, 1862, 1863, 1864, 1865, 1866,
-	1867, 1868, 1869, 1870, 1871, 1872, 1873, 1914, 1789, 1823,
-	2684, 1901, 1735, 1905, 2661, 988, 922, 923, 924, 921,
-	1903, 2612, 1811, 1675, 2580, 1997, 2578, 2558, 1812, 2556,
-	1968, 2142, 2704, 1637, 1729, 1730, 2162, 1815, 1816, 2525,
-	1142, 989, 1142, 1822, 1142, 1820, 1931, 2489, 2755, 858,
-	922, 923, 924, 921, 2488, 2485, 2478, 1077, 1818, 1932,
-	2019, 1819, 763, 2472, 1749, 1986, 1924, 922, 923, 924,
-	921, 922, 923, 924, 921, 1879, 1178, 2419, 1142, 2013,
-	922, 923, 924, 921, 760, 2417, 2407, 762, 2744, 922,
-	923, 924, 921, 2588, 2020, 2406, 1978, 2310, 2673, 1142,
-	2309, 2257, 1910, 1911, 1912, 2224, 1915, 2205, 2144, 2022,
-	2137, 922, 923, 924, 921, 2133, 922, 923, 924, 921,
-	1281, 1282, 1283, 1284, 1285, 2012, 1923, 1921, 1920, 2132,
-	2131, 1724, 1140, 588, 587, 2024, 922, 923, 924, 921,
-	1604, 858, 1922, 2587, 1982, 1598, 2021, 1420, 2551, 1233,
-	996, 1929, 992, 1140, 991, 967, 847, 1999, 2506, 1993,
-	2505, 1979, 1681, 2503, 1326, 1327, 922, 923, 924, 921,
-	1361, 922, 923, 924, 921, 2477, 2464, 1270, 1371, 1971,
-	155, 1969, 2043, 147, 124, 1976, 2455, 2454, 1142, 2443,
-	2442, 2073, 2475, 2362, 2285, 1433, 2278, 2270, 2265, 2209,
-	2055, 2092, 2052, 1947, 2283, 1989, 1990, 2098, 1943, 2028,
-	1942, 1711, 1992, 1701, 2033, 922, 923, 924, 921, 1411,
-	1699, 1695, 2107, 1415, 1694, 2003, 1418, 922, 923, 924,
-	921, 2057, 1692, 1683, 1680, 2115, 2054, 152, 922, 923,
-	924, 921, 2282, 2120, 2121, 2122, 1679, 1603, 1406, 2125,
-	1380, 2281, 1379, 1202, 1203, 1370, 155, 1154, 543, 109,
-	2047, 2044, 1152, 1899, 109, 922, 923, 924, 921, 2919,
-	2879, 2083, 2873, 2158, 922, 923, 924, 921, 850, 2062,
-	2863, 2089, 1433, 858, 1531, 1531, 1531, 1531, 856, 2099,
-	2860, 2858, 2775, 2720, 986, 858, 1531, 1192, 2644, 1899,
-	2632, 2629, 2566, 1197, 2564, 2549, 2548, 876, 1142, 2082,
-	2113, 2110, 434, 152, 2113, 109, 2545, 2544, 2538, 428,
-	428, 2498, 1201, 1194, 2114, 1436, 1063, 2159, 2119, 2071,
-	1411, 2086, 2085, 172, 2100, 2084, 1411, 1411, 172, 8,
-	1206, 2104, 2105, 7, 2091, 2097, 2128, 2129, 2190, 1209,
-	1198, 2042, 2134, 2135, 1956, 2109, 1908, 1874, 1207, 1377,
-	1210, 1377, 2111, 1804, 2241, 1311, 2117, 2245, 2041, 152,
-	2164, 1568, 1428, 1142, 1427, 1255, 2252, 1584, 2177, 1221,
-	1587, 2138, 1199, 1590, 2101, 1019, 1592, 1016, 2103, 1015,
-	2177, 922, 923, 924, 921, 2215, 2102, 1014, 1013, 1012,
-	2219, 1011, 1010, 2163, 2167, 2178, 2179, 2180, 2181, 2106,
-	1009, 761, 2040, 2189, 1008, 109, 1410, 2193, 1007, 2191,
-	1006, 2240, 2192, 1005, 2206, 2203, 1004, 2202, 1003, 1002,
-	109, 643, 109, 2238, 1001, 922, 923, 924, 921, 2244,
-	1000, 999, 995, 2213, 994, 993, 2273, 2217, 2275, 990,
-	2216, 983, 2254, 2249, 982, 980, 979, 858, 978, 977,
-	976, 975, 2234, 2321, 974, 973, 2237, 2232, 972, 971,
-	970, 2239, 969, 2336, 965, 428, 2039, 2248, 964, 887,
-	845, 2263, 2546, 2038, 1809, 858, 858, 858, 2262, 2428,
-	2429, 763, 1792, 875, 1531, 1803, 2274, 2360, 763, 922,
-	923, 924, 921, 2364, 2806, 2236, 922, 923, 924, 921,
-	2269, 2804, 2243, 2392, 2759, 2395, 2431, 2395, 2395, 2276,
-	2277, 2074, 1670, 1919, 2400, 1674, 1606, 886, 2290, 1142,
-	1142, 2434, 2291, 2292, 2293, 2294, 2312, 2295, 2296, 2297,
-	2298, 2299, 2300, 2301, 2302, 2314, 2186, 2433, 2306, 2184,
-	2183, 2187, 1749, 2311, 2185, 2182, 2271, 2272, 2905, 1965,
-	428, 678, 2307, 2308, 1684, 2321, 2340, 1959, 2188, 2341,
-	1891, 1892, 1691, 1433, 1433, 2049, 2404, 2405, 2390, 2391,
-	1829, 1829, 1829, 1140, 1140, 2358, 1115, 2348, 2349, 2355,
-	1704, 2359, 763, 1707, 1708, 1709, 2082, 1514, 1712, 1713,
-	1714, 1715, 1716, 1717, 1718, 1719, 2316, 1148, 2569, 2398,
-	2568, 2396, 2397, 2013, 1887, 1890, 1891, 1892, 1888, 2315,
-	1889, 1893, 2453, 425, 647, 648, 649, 650, 1186, 2367,
-	95, 1114, 1954, 1116, 1983, 1120, 1121, 646, 2421, 2422,
-	1729, 1730, 763, 52, 2567, 1021, 2418, 2415, 2414, 51,
-	1215, 1807, 2410, 913, 1783, 1569, 2368, 2037, 881, 428,
-	2739, 2432, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
-	2036, 2363, 2436, 1167, 429, 2365, 2366, 2439, 2440, 2441,
-	922, 923, 924, 921, 430, 2108, 1153, 680, 2448, 675,
-	2058, 665, 1799, 922, 923, 924, 921, 431, 677, 676,
-	109, 109, 761, 432, 1447, 2035, 1426, 1366, 1365, 2465,
-	1033, 1034, 1031, 1032, 2813, 663, 2466, 2034, 1877, 669,
-	1029, 1030, 2468, 1027, 1028, 2438, 1517, 2467, 922, 923,
-	924, 921, 2471, 1113, 1112, 2479, 1626, 1067, 1023, 1433,
-	922, 923, 924, 921, 646, 2502, 2423, 1411, 1411, 1411,
-	2874, 2794, 647, 648, 649, 650, 1899, 1531, 2516, 2782,
-	674, 2435, 2780, 2031, 673, 646, 1451, 2747, 2732, 2731,
-	662, 953, 1171, 2729, 668, 2721, 2655, 2481, 2654, 1142,
-	2579, 2480, 2462, 2524, 2030, 2484, 922, 923, 924, 921,
-	428, 666, 2029, 2461, 2446, 2483, 1026, 2445, 2211, 2392,
-	2808, 2807, 2808, 2247, 2497, 2518, 2496, 922, 923, 924,
-	921, 1794, 664, 1682, 872, 922, 923, 924, 921, 2025,
-	2807, 1433, 2540, 2463, 1081, 858, 681, 159, 3, 60,
-	2, 1554, 1146, 2527, 2522, 2499, 2500, 2501, 2390, 2514,
-	1, 1419, 922, 923, 924, 921, 2016, 651, 2515, 2571,
-	667, 172, 2195, 2196, 2437, 2560, 2198, 1644, 1875, 1784,
-	2335, 1058, 683, 1372, 858, 2550, 1240, 777, 1994, 922,
-	923, 924, 921, 1996, 867, 1237, 2557, 2555, 866, 864,
-	2561, 2014, 2015, 2596, 1323, 2562, 545, 1609, 2160, 2017,
-	2018, 922, 923, 924, 921, 2651, 2812, 2559, 1430, 858,
-	1142, 1142, 2023, 2845, 1025, 858, 2774, 2615, 2815, 1444,
-	2615, 1253, 529, 2573, 2723, 2665, 1321, 2583, 2778, 2667,
-	2177, 679, 1411, 2585, 1649, 2045, 2046, 1418, 918, 2517,
-	2233, 2597, 703, 581, 556, 2520, 981, 1223, 2521, 922,
-	923, 924, 921, 1216, 2288, 858, 858, 2610, 2618, 858,
-	858, 2616, 2619, 2611, 1140, 2527, 779, 555, 2495, 2177,
-	2067, 2518, 2696, 672, 776, 1449, 1483, 2652, 1882, 704,
-	1593, 2663, 1187, 1208, 1191, 2656, 2657, 2620, 2633, 2634,
-	2589, 2507, 2642, 2643, 2630, 2350, 2649, 2087, 2915, 2641,
-	2904, 1887, 1890, 1891, 1892, 1888, 2886, 1889, 1893, 2681,
-	1829, 2872, 2799, 2900, 2830, 2650, 2861, 1560, 2592, 2590,
-	2591, 2854, 1560, 1560, 2795, 466, 1534, 1151, 2693, 415,
-	743, 2645, 434, 1605, 467, 1808, 2787, 2631, 670, 1791,
-	671, 2080, 2079, 858, 2679, 1292, 927, 1309, 2303, 2304,
-	962, 505, 1671, 517, 2064, 858, 109, 2688, 2383, 2204,
-	59, 58, 57, 56, 1575, 2695, 2694, 180, 2703, 547,
-	179, 2771, 2817, 527, 526, 2706, 2710, 525, 524, 523,
-	1886, 1884, 1883, 1526, 1525, 1573, 2401, 1847, 2715, 1841,
-	1484, 2756, 2707, 2708, 2537, 2626, 2627, 2145, 858, 2533,
-	2529, 2408, 2614, 2733, 2369, 2370, 2748, 2376, 2728, 2726,
-	1798, 802, 798, 800, 801, 799, 2002, 109, 1998, 1826,
-	1828, 109, 2743, 1827, 2346, 1740, 1739, 2742, 1737, 1736,
-	2769, 2772, 109, 1041, 2749, 2680, 2482, 1747, 1745, 2754,
-	2430, 109, 2426, 2337, 1617, 1416, 2218, 2773, 2220, 2764,
-	2765, 2766, 2767, 2048, 1527, 2781, 1523, 2783, 2784, 1880,
-	1793, 2779, 2777, 86, 85, 93, 1411, 136, 46, 164,
-	163, 1411, 166, 165, 162, 1933, 1934, 161, 2793, 1175,
-	160, 2617, 640, 37, 33, 12, 2819, 2802, 11, 2805,
-	2803, 34, 21, 22, 20, 1244, 19, 25, 2809, 2818,
-	32, 31, 30, 102, 101, 858, 29, 2264, 2823, 100,
-	99, 98, 97, 2824, 28, 18, 41, 40, 39, 9,
-	92, 90, 2844, 27, 91, 2833, 2835, 88, 89, 2284,
-	87, 71, 70, 69, 2847, 2843, 83, 82, 2852, 81,
-	858, 80, 79, 78, 77, 702, 68, 67, 66, 65,
-	64, 2857, 2853, 2859, 75, 84, 76, 74, 73, 72,
-	2819, 2870, 63, 62, 61, 121, 122, 120, 119, 858,
-	118, 858, 117, 2818, 2869, 116, 115, 42, 43, 44,
-	45, 2876, 2881, 2878, 132, 131, 133, 135, 2847, 2882,
-	858, 137, 2825, 134, 2889, 129, 2896, 127, 130, 2899,
-	2828, 128, 2893, 1795, 1796, 1797, 126, 54, 17, 24,
-	4, 0, 0, 0, 2903, 0, 0, 0, 2910, 0,
-	0, 0, 2914, 2913, 0, 0, 0, 1813, 2922, 2399,
-	0, 0, 2925, 0, 2927, 1276, 2910, 2928, 0, 2926,
-	0, 2914, 155, 0, 49, 147, 124, 0, 0, 0,
-	0, 0, 0, 0, 0, 692, 0, 0, 0, 0,
-	0, 0, 148, 0, 1276, 0, 1276, 0, 0, 140,
-	0, 0, 0, 149, 0, 0, 0, 0, 107, 0,
-	0, 0, 2374, 0, 0, 1276, 0, 0, 0, 0,
-	0, 1530, 0, 96, 0, 0, 0, 0, 155, 152,
-	49, 147, 124, 0, 0, 0, 2384, 0, 0, 0,
-	0, 0, 0, 0, 0, 1148, 0, 730, 148, 2377,
-	0, 0, 0, 0, 0, 140, 2372, 0, 0, 149,
-	0, 2387, 2388, 0, 107, 0, 0, 2373, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 109, 0, 96,
-	109, 109, 0, 109, 0, 152, 0, 0, 936, 935,
-	945, 946, 938, 939, 940, 941, 942, 943, 944, 937,
-	0, 0, 111, 112, 2378, 113, 114, 1700, 0, 0,
-	0, 922, 923, 924, 921, 0, 0, 948, 761, 952,
-	2474, 0, 0, 0, 0, 761, 2877, 2476, 0, 0,
-	732, 0, 0, 731, 109, 949, 951, 947, 0, 950,
-	936, 935, 945, 946, 938, 939, 940, 941, 942, 943,
-	944, 937, 0, 0, 0, 0, 0, 0, 111, 112,
-	0, 113, 114, 0, 0, 0, 0, 716, 0, 0,
-	123, 146, 153, 0, 94, 693, 936, 935, 945, 946,
-	938, 939, 940, 941, 942, 943, 944, 937, 0, 0,
-	1350, 0, 145, 139, 138, 2386, 0, 1834, 0, 55,
-	0, 0, 722, 0, 0, 0, 0, 0, 0, 0,
-	953, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2380, 0, 0, 0, 123, 146, 153, 0,
-	94, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1350, 0, 0, 0, 2379, 2381, 0, 0, 145, 139,
-	138, 0, 0, 0, 0, 55, 0, 141, 142, 143,
-	0, 0, 715, 714, 0, 1411, 0, 0, 2563, 0,
-	0, 2565, 0, 0, 0, 0, 0, 0, 0, 713,
-	0, 0, 0, 150, 0, 2570, 0, 0, 691, 0,
-	0, 0, 2090, 0, 0, 0, 0, 0, 0, 694,
-	725, 103, 0, 0, 0, 144, 0, 104, 0, 0,
-	0, 0, 0, 141, 142, 143, 0, 0, 0, 2389,
-	0, 0, 0, 720, 0, 0, 0, 0, 0, 0,
-	0, 2375, 0, 0, 0, 0, 0, 2385, 0, 150,
-	0, 0, 1346, 0, 0, 0, 1343, 0, 0, 0,
-	1345, 1342, 1344, 1348, 1349, 721, 726, 103, 1347, 0,
-	105, 144, 0, 104, 0, 0, 0, 0, 0, 0,
-	48, 0, 710, 0, 708, 712, 729, 0, 0, 0,
-	709, 706, 705, 0, 711, 696, 697, 695, 698, 699,
-	700, 701, 1346, 727, 728, 0, 1343, 0, 0, 0,
-	1345, 1342, 1344, 1348, 1349, 723, 724, 0, 1347, 0,
-	2207, 2208, 0, 0, 0, 0, 105, 0, 50, 0,
-	0, 0, 0, 0, 0, 0, 48, 1902, 1991, 0,
-	0, 0, 2678, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 718, 0, 0, 0, 0, 0, 0, 2689,
-	0, 125, 936, 935, 945, 946, 938, 939, 940, 941,
-	942, 943, 944, 937, 818, 0, 0, 0, 0, 2705,
-	0, 0, 0, 1530, 50, 0, 0, 0, 0, 0,
-	0, 0, 109, 1331, 1332, 1333, 1334, 1335, 1336, 1337,
-	1338, 1339, 1340, 1341, 1353, 1354, 1355, 1356, 1357, 1358,
-	1351, 1352, 0, 0, 0, 106, 38, 125, 0, 0,
-	0, 717, 47, 5, 0, 2678, 110, 0, 0, 0,
-	0, 818, 0, 2875, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1331, 1332, 1333, 1334, 1335, 1336, 1337,
-	1338, 1339, 1340, 1341, 1353, 1354, 1355, 1356, 1357, 1358,
-	1351, 1352, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 106, 38, 0, 0, 0, 2339, 806, 47, 0,
-	0, 0, 110, 936, 935, 945, 946, 938, 939, 940,
-	941, 942, 943, 944, 937, 0, 0, 826, 830, 832,
-	834, 836, 837, 839, 0, 843, 840, 841, 842, 0,
-	0, 821, 822, 823, 824, 804, 805, 827, 0, 807,
-	0, 808, 809, 810, 811, 812, 813, 814, 815, 816,
-	817, 819, 825, 0, 806, 2678, 0, 0, 796, 0,
-	829, 831, 833, 835, 838, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 826, 830, 832, 834, 836, 837,
-	839, 1560, 843, 840, 841, 842, 0, 0, 821, 822,
-	823, 824, 804, 805, 827, 0, 807, 820, 808, 809,
-	810, 811, 812, 813, 814, 815, 816, 817, 819, 825,
-	2286, 0, 0, 0, 0, 1669, 0, 829, 831, 833,
-	835, 838, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 109, 0, 0, 0, 0, 2884, 936,
-	935, 945, 946, 938, 939, 940, 941, 942, 943, 944,
-	937, 0, 0, 0, 820, 0, 0, 0, 0, 0,
-	936, 935, 945, 946, 938, 939, 940, 941, 942, 943,
-	944, 937, 0, 0, 0, 0, 0, 0, 0, 0,
-	2470, 936, 935, 945, 946, 938, 939, 940, 941, 942,
-	943, 944, 937, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1530, 1530, 1530, 1530, 0, 0, 0, 0,
-	0, 0, 0, 0, 1530, 2000, 2001, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 109, 0, 0, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 351,
-	563, 2543, 0, 0, 0, 0, 0, 0, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 519, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 554, 0, 0, 343, 298, 0,
-	0, 828, 0, 611, 619, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 512, 0, 0, 544, 588,
-	587, 531, 540, 0, 0, 242, 178, 532, 0, 539,
-	533, 537, 536, 534, 535, 0, 603, 109, 0, 0,
-	0, 0, 0, 503, 516, 2675, 520, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 828, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	513, 514, 1530, 0, 0, 0, 564, 0, 515, 0,
-	0, 559, 541, 542, 0, 0, 0, 109, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 538, 562, 566, 254, 625, 560,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 626, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 557, 0, 0, 0, 374, 0, 0,
-	609, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 561, 0, 334, 316, 622, 504, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	607, 312, 0, 0, 621, 602, 604, 605, 608, 612,
-	613, 614, 615, 616, 618, 620, 624, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	2676, 0, 0, 0, 2677, 0, 623, 0, 0, 0,
-	378, 0, 0, 0, 0, 1530, 565, 302, 303, 304,
-	305, 610, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 632, 606, 631, 633,
-	634, 630, 635, 636, 617, 522, 0, 569, 628, 627,
-	629, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 109,
-	0, 0, 0, 0, 227, 0, 285, 0, 326, 265,
-	595, 574, 575, 576, 521, 577, 572, 573, 596, 567,
-	592, 593, 546, 570, 578, 591, 579, 594, 597, 598,
-	637, 638, 585, 639, 582, 599, 590, 589, 580, 568,
-	600, 601, 553, 548, 583, 584, 571, 586, 549, 550,
-	551, 552, 351, 563, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 519, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 554, 0, 0,
-	343, 298, 0, 0, 0, 0, 611, 619, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 0,
-	0, 544, 588, 587, 531, 540, 0, 0, 242, 178,
-	532, 0, 539, 533, 537, 536, 534, 535, 0, 603,
-	0, 0, 0, 0, 0, 0, 503, 516, 0, 520,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 513, 514, 0, 0, 0, 0, 564,
-	0, 515, 0, 0, 559, 541, 542, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 538, 562, 566,
-	254, 625, 560, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 626, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 557, 0, 0, 0,
-	374, 0, 0, 609, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 561, 0, 334, 316, 622, 504,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 1374, 1373, 1375, 386, 267,
-	225, 226, 421, 607, 312, 0, 0, 621, 602, 604,
-	605, 608, 612, 613, 614, 615, 616, 618, 620, 624,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 623,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 565,
-	302, 303, 304, 305, 610, 0, 247, 398, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 632,
-	606, 631, 633, 634, 630, 635, 636, 617, 522, 0,
-	569, 628, 627, 629, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 595, 574, 575, 576, 521, 577, 572,
-	573, 596, 567, 592, 593, 546, 570, 578, 591, 579,
-	594, 597, 598, 637, 638, 585, 639, 582, 599, 590,
-	589, 580, 568, 600, 601, 553, 548, 583, 584, 571,
-	586, 549, 550, 551, 552, 351, 563, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 519,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	554, 0, 0, 343, 298, 0, 0, 0, 0, 611,
-	619, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 512, 0, 0, 544, 588, 587, 531, 540, 0,
-	0, 242, 178, 532, 0, 539, 533, 537, 536, 534,
-	535, 0, 603, 0, 0, 0, 0, 0, 0, 503,
-	516, 0, 520, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 513, 514, 0, 0,
-	0, 0, 564, 0, 515, 0, 0, 559, 541, 542,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	538, 562, 566, 254, 625, 560, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 626, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 557,
-	0, 0, 0, 374, 0, 0, 609, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 561, 0, 334,
-	316, 622, 504, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 607, 312, 0, 0,
-	621, 602, 604, 605, 608, 612, 613, 614, 615, 616,
-	618, 620, 624, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 2676, 0, 0, 0,
-	2677, 0, 623, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 565, 302, 303, 304, 305, 610, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 632, 606, 631, 633, 634, 630, 635, 636,
-	617, 522, 0, 569, 628, 627, 629, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 595, 574, 575, 576,
-	521, 577, 572, 573, 596, 567, 592, 593, 546, 570,
-	578, 591, 579, 594, 597, 598, 637, 638, 585, 639,
-	582, 599, 590, 589, 580, 568, 600, 601, 553, 548,
-	583, 584, 571, 586, 549, 550, 551, 552, 351, 563,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 519, 0, 0, 0, 260, 1412, 0, 284,
-	0, 0, 0, 554, 0, 0, 343, 298, 0, 0,
-	0, 0, 611, 619, 0, 0, 0, 0, 0, 0,
-	0, 1544, 0, 0, 512, 0, 0, 544, 588, 587,
-	531, 540, 0, 0, 242, 178, 532, 0, 539, 533,
-	537, 536, 534, 535, 0, 603, 0, 0, 0, 0,
-	0, 0, 503, 516, 0, 520, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
-	514, 0, 0, 0, 0, 564, 0, 515, 0, 0,
-	1545, 541, 542, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 538, 562, 566, 254, 625, 560, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 626, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 557, 0, 0, 0, 374, 0, 0, 609,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	561, 0, 334, 316, 622, 504, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 607,
-	312, 0, 0, 621, 602, 604, 605, 608, 612, 613,
-	614, 615, 616, 618, 620, 624, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 623, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 565, 302, 303, 304, 305,
-	610, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 632, 606, 631, 633, 634,
-	630, 635, 636, 617, 522, 0, 569, 628, 627, 629,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 595,
-	574, 575, 576, 521, 577, 572, 573, 596, 567, 592,
-	593, 546, 570, 578, 591, 579, 594, 597, 598, 637,
-	638, 585, 639, 582, 599, 590, 589, 580, 568, 600,
-	601, 553, 548, 583, 584, 571, 586, 549, 550, 551,
-	552, 155, 351, 563, 382, 383, 384, 406, 368, 0,
-	418, 0, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 519, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 956, 0, 0,
-	343, 298, 0, 0, 0, 0, 611, 619, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 512, 0,
-	0, 544, 588, 587, 531, 540, 0, 0, 242, 178,
-	532, 0, 539, 533, 537, 536, 534, 535, 0, 603,
-	0, 0, 0, 0, 0, 0, 503, 516, 0, 520,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 513, 514, 0, 0, 0, 0, 564,
-	0, 515, 0, 0, 559, 541, 542, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 538, 562, 566,
-	254, 625, 560, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 626, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 557, 0, 0, 0,
-	374, 0, 0, 609, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 561, 0, 334, 316, 622, 504,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 607, 312, 0, 0, 621, 602, 604,
-	605, 608, 612, 613, 614, 615, 616, 618, 620, 624,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 623,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 565,
-	302, 303, 304, 305, 610, 0, 247, 398, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 632,
-	606, 631, 633, 634, 630, 635, 636, 617, 522, 0,
-	569, 628, 627, 629, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	125, 326, 265, 595, 574, 575, 576, 521, 577, 572,
-	573, 596, 567, 592, 593, 546, 570, 578, 591, 579,
-	594, 597, 598, 637, 638, 585, 639, 582, 599, 590,
-	589, 580, 568, 600, 601, 553, 548, 583, 584, 571,
-	586, 549, 550, 551, 552, 351, 563, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 519,
-	0, 0, 0, 260, 2883, 0, 284, 0, 0, 0,
-	554, 0, 0, 343, 298, 0, 0, 0, 0, 611,
-	619, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 512, 0, 0, 544, 588, 587, 531, 540, 0,
-	0, 242, 178, 532, 0, 539, 533, 537, 536, 534,
-	535, 0, 603, 0, 0, 0, 0, 0, 0, 503,
-	516, 0, 520, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 513, 514, 0, 0,
-	0, 0, 564, 0, 515, 0, 0, 559, 541, 542,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	538, 562, 566, 254, 625, 560, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 626, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 557,
-	0, 0, 0, 374, 0, 0, 609, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 561, 0, 334,
-	316, 622, 504, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 607, 312, 0, 0,
-	621, 602, 604, 605, 608, 612, 613, 614, 615, 616,
-	618, 620, 624, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 623, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 565, 302, 303, 304, 305, 610, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 632, 606, 631, 633, 634, 630, 635, 636,
-	617, 522, 0, 569, 628, 627, 629, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 595, 574, 575, 576,
-	521, 577, 572, 573, 596, 567, 592, 593, 546, 570,
-	578, 591, 579, 594, 597, 598, 637, 638, 585, 639,
-	582, 599, 590, 589, 580, 568, 600, 601, 553, 548,
-	583, 584, 571, 586, 549, 550, 551, 552, 351, 563,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 519, 0, 0, 0, 260, 1412, 0, 284,
-	0, 0, 0, 554, 0, 0, 343, 298, 0, 0,
-	0, 0, 611, 619, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 512, 0, 0, 544, 588, 587,
-	531, 540, 0, 0, 242, 178, 532, 0, 539, 533,
-	537, 536, 534, 535, 0, 603, 0, 0, 0, 0,
-	0, 0, 503, 516, 0, 520, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
-	514, 0, 0, 0, 0, 564, 0, 515, 0, 0,
-	559, 541, 542, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 538, 562, 566, 254, 625, 560, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 626, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 557, 0, 0, 0, 374, 0, 0, 609,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	561, 0, 334, 316, 622, 504, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 607,
-	312, 0, 0, 621, 602, 604, 605, 608, 612, 613,
-	614, 615, 616, 618, 620, 624, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 623, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 565, 302, 303, 304, 305,
-	610, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 632, 606, 631, 633, 634,
-	630, 635, 636, 617, 522, 0, 569, 628, 627, 629,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 595,
-	574, 575, 576, 521, 577, 572, 573, 596, 567, 592,
-	593, 546, 570, 578, 591, 579, 594, 597, 598, 637,
-	638, 585, 639, 582, 599, 590, 589, 580, 568, 600,
-	601, 553, 548, 583, 584, 571, 586, 549, 550, 551,
-	552, 351, 563, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 519, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 554, 0, 0, 343,
-	298, 0, 0, 0, 0, 611, 619, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 512, 0, 0,
-	544, 588, 587, 531, 540, 0, 0, 242, 178, 532,
-	0, 539, 533, 537, 536, 534, 535, 0, 603, 0,
-	0, 0, 0, 0, 0, 503, 516, 0, 520, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 513, 514, 1170, 0, 0, 0, 564, 0,
-	515, 0, 0, 559, 541, 542, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 538, 562, 566, 254,
-	625, 560, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 626, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 557, 0, 0, 0, 374,
-	0, 0, 609, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 561, 0, 334, 316, 622, 504, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 607, 312, 0, 0, 621, 602, 604, 605,
-	608, 612, 613, 614, 615, 616, 618, 620, 624, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 623, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 565, 302,
-	303, 304, 305, 610, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 632, 606,
-	631, 633, 634, 630, 635, 636, 617, 522, 0, 569,
-	628, 627, 629, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 595, 574, 575, 576, 521, 577, 572, 573,
-	596, 567, 592, 593, 546, 570, 578, 591, 579, 594,
-	597, 598, 637, 638, 585, 639, 582, 599, 590, 589,
-	580, 568, 600, 601, 553, 548, 583, 584, 571, 586,
-	549, 550, 551, 552, 0, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 351, 563, 0, 0, 1690, 0,
-	0, 0, 0, 0, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 519, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 554,
-	0, 0, 343, 298, 0, 0, 0, 0, 611, 619,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	512, 0, 0, 544, 588, 587, 531, 540, 0, 0,
-	242, 178, 532, 0, 539, 533, 537, 536, 534, 535,
-	0, 603, 0, 0, 0, 0, 0, 0, 503, 516,
-	0, 520, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 513, 514, 0, 0, 0,
-	0, 564, 0, 515, 0, 0, 559, 541, 542, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 538,
-	562, 566, 254, 625, 560, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	626, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 557, 0,
-	0, 0, 374, 0, 0, 609, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 561, 0, 334, 316,
-	622, 504, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 607, 312, 0, 0, 621,
-	602, 604, 605, 608, 612, 613, 614, 615, 616, 618,
-	620, 624, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 623, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 565, 302, 303, 304, 305, 610, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 632, 606, 631, 633, 634, 630, 635, 636, 617,
-	522, 0, 569, 628, 627, 629, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 595, 574, 575, 576, 521,
-	577, 572, 573, 596, 567, 592, 593, 546, 570, 578,
-	591, 579, 594, 597, 598, 637, 638, 585, 639, 582,
-	599, 590, 589, 580, 568, 600, 601, 553, 548, 583,
-	584, 571, 586, 549, 550, 551, 552, 351, 563, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 519, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 554, 0, 0, 343, 298, 0, 0, 0,
-	0, 611, 619, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 512, 0, 0, 544, 588, 587, 531,
-	540, 0, 0, 242, 178, 532, 0, 539, 533, 537,
-	536, 534, 535, 0, 603, 0, 0, 0, 0, 0,
-	0, 503, 516, 0, 520, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 513, 514,
-	0, 0, 0, 0, 564, 0, 515, 0, 0, 559,
-	541, 542, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 538, 562, 566, 254, 625, 560, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 626, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 557, 0, 0, 0, 374, 0, 0, 609, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 561,
-	0, 334, 316, 622, 504, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 607, 312,
-	0, 0, 621, 602, 604, 605, 608, 612, 613, 614,
-	615, 616, 618, 620, 624, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 623, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 565, 302, 303, 304, 305, 610,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 632, 606, 631, 633, 634, 630,
-	635, 636, 617, 522, 0, 569, 628, 627, 629, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 595, 574,
-	575, 576, 521, 577, 572, 573, 596, 567, 592, 593,
-	546, 570, 578, 591, 579, 594, 597, 598, 637, 638,
-	585, 639, 582, 599, 590, 589, 580, 568, 600, 601,
-	553, 548, 583, 584, 571, 586, 549, 550, 551, 552,
-	351, 563, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	1293, 0, 0, 0, 519, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 554, 0, 0, 343, 298,
-	0, 0, 0, 0, 611, 619, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 512, 0, 0, 544,
-	588, 587, 531, 540, 0, 0, 242, 178, 532, 0,
-	539, 533, 537, 536, 534, 535, 0, 603, 0, 0,
-	0, 0, 0, 0, 0, 516, 0, 520, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 513, 514, 0, 0, 0, 0, 564, 0, 515,
-	0, 0, 559, 541, 542, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 538, 562, 566, 254, 625,
-	560, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 626, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 557, 0, 0, 0, 374, 0,
-	0, 609, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 561, 0, 334, 316, 622, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 1294,
-	1295, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 607, 312, 0, 0, 621, 602, 604, 605, 608,
-	612, 613, 614, 615, 616, 618, 620, 624, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 623, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 565, 302, 303,
-	304, 305, 610, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 632, 606, 631,
-	633, 634, 630, 635, 636, 617, 522, 0, 569, 628,
-	627, 629, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 595, 574, 575, 576, 521, 577, 572, 573, 596,
-	567, 592, 593, 546, 570, 578, 591, 579, 594, 597,
-	598, 637, 638, 585, 639, 582, 599, 590, 589, 580,
-	568, 600, 601, 553, 548, 583, 584, 571, 586, 549,
-	550, 551, 552, 351, 563, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 519, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 554, 0,
-	0, 343, 298, 0, 0, 0, 0, 611, 619, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 544, 588, 587, 531, 540, 0, 0, 242,
-	178, 532, 0, 539, 533, 537, 536, 534, 535, 0,
-	603, 0, 0, 0, 0, 0, 0, 503, 516, 0,
-	520, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 513, 514, 0, 0, 0, 0,
-	564, 0, 515, 0, 0, 559, 541, 542, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 538, 562,
-	566, 254, 625, 560, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 626,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 557, 0, 0,
-	0, 374, 0, 0, 609, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 561, 0, 334, 316, 622,
-	504, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 607, 312, 0, 0, 621, 602,
-	604, 605, 608, 612, 613, 614, 615, 616, 618, 620,
-	624, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	623, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	565, 302, 303, 304, 305, 610, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	632, 606, 631, 633, 634, 630, 635, 636, 617, 522,
-	0, 569, 628, 627, 629, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 595, 574, 575, 576, 521, 577,
-	572, 573, 596, 567, 592, 593, 546, 570, 578, 591,
-	579, 594, 597, 598, 637, 638, 585, 639, 582, 599,
-	590, 589, 580, 568, 600, 601, 553, 548, 583, 584,
-	571, 586, 549, 550, 551, 552, 351, 563, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	519, 0, 0, 0, 260, 0, 0, 284, 0, 0,
-	0, 554, 0, 0, 343, 298, 0, 0, 0, 0,
-	611, 619, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 512, 0, 0, 544, 588, 587, 531, 540,
-	0, 0, 242, 178, 532, 0, 539, 533, 537, 536,
-	534, 535, 0, 603, 0, 0, 0, 0, 0, 0,
-	0, 516, 0, 520, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 513, 514, 0,
-	0, 0, 0, 564, 0, 515, 0, 0, 559, 541,
-	542, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 538, 562, 566, 254, 625, 560, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 626, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	557, 0, 0, 0, 374, 0, 0, 609, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 561, 0,
-	334, 316, 622, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 328, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 607, 312, 0,
-	0, 621, 602, 604, 605, 608, 612, 613, 614, 615,
-	616, 618, 620, 624, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 0, 623, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 565, 302, 303, 304, 305, 610, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 632, 606, 631, 633, 634, 630, 635,
-	636, 617, 522, 0, 569, 628, 627, 629, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 595, 574, 575,
-	576, 521, 577, 572, 573, 596, 567, 592, 593, 546,
-	570, 578, 591, 579, 594, 597, 598, 637, 638, 585,
-	639, 582, 599, 590, 589, 580, 568, 600, 601, 553,
-	548, 583, 584, 571, 586, 549, 550, 551, 552, 0,
-	0, 0, 382, 383, 384, 406, 368, 0, 418, 155,
-	351, 49, 147, 124, 0, 0, 0, 0, 0, 0,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 148,
-	0, 0, 0, 0, 0, 0, 140, 0, 260, 0,
-	149, 284, 0, 0, 0, 107, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	96, 0, 0, 0, 0, 0, 152, 0, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 169, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	547, 1252, 1538, 3022, 3023, 3002, 177, 3031, 526, 528,
+	1318, 549, 2952, 2960, 2928, 2858, 2907, 2666, 2766, 2671,
+	2814, 2862, 2457, 2695, 2863, 2736, 1784, 2825, 2846, 2536,
+	2694, 2760, 2842, 1122, 2537, 2265, 2693, 663, 1013, 2784,
+	434, 2669, 1243, 2750, 1495, 2724, 1174, 2165, 577, 2422,
+	441, 2700, 446, 446, 1321, 2661, 1314, 2245, 446, 462,
+	469, 2606, 162, 469, 2246, 1595, 2564, 2231, 2471, 2238,
+	2446, 2052, 1891, 530, 1660, 2241, 2534, 1694, 2522, 2244,
+	480, 2267, 1894, 2504, 2395, 1863, 1957, 2390, 1570, 2392,
+	2470, 2420, 2299, 1609, 1497, 1804, 1910, 1578, 1541, 876,
+	474, 1796, 1232, 2094, 525, 1097, 2151, 2051, 1668, 519,
+	1239, 520, 2339, 1806, 1690, 1669, 1452, 1998, 778, 1661,
+	2282, 1632, 1588, 1689, 1573, 784, 1251, 1946, 1958, 1571,
+	713, 2131, 2127, 2182, 1534, 1892, 173, 8, 1862, 1095,
+	172, 7, 6, 1481, 1460, 2017, 36, 55, 2095, 445,
+	445, 1317, 829, 1312, 434, 453, 1722, 1691, 26, 1206,
+	1078, 1802, 1183, 1592, 529, 1846, 1508, 1507, 440, 1111,
+	1367, 15, 1351, 1244, 1648, 13, 1303, 177, 14, 177,
+	894, 820, 821, 1131, 1701, 537, 1667, 527, 518, 1213,
+	520, 1311, 1273, 1622, 782, 1165, 1664, 458, 111, 769,
+	35, 1130, 1965, 1480, 455, 467, 1525, 712, 1109, 660,
+	1373, 1372, 163, 1049, 1157, 23, 482, 466, 16, 10,
+	483, 770, 1076, 1123, 156, 1205, 159, 710, 2333, 731,
+	463, 1014, 468, 2333, 464, 1708, 2054, 465, 662, 816,
+	1698, 818, 2529, 2004, 2002, 2001, 1999, 1220, 1216, 817,
+	812, 813, 161, 813, 1143, 813, 442, 1218, 2659, 2295,
+	2293, 433, 951, 952, 953, 950, 1637, 2756, 2751, 743,
+	2662, 951, 952, 953, 950, 2535, 1456, 1008, 2834, 1663,
+	451, 661, 844, 671, 160, 160, 51, 152, 128, 2684,
+	2897, 160, 160, 914, 160, 472, 160, 2794, 8, 2640,
+	160, 160, 7, 2039, 811, 788, 1068, 1266, 2047, 1695,
+	160, 2683, 51, 152, 128, 2805, 160, 478, 51, 152,
+	128, 2362, 1259, 1263, 1706, 1850, 1978, 479, 1393, 2314,
+	110, 948, 2307, 651, 110, 650, 652, 653, 1256, 654,
+	655, 2795, 673, 157, 1265, 1979, 1607, 1467, 1468, 1287,
+	157, 157, 1407, 157, 664, 157, 785, 1069, 787, 1258,
+	157, 2018, 1119, 1304, 2947, 2129, 1308, 1297, 929, 157,
+	922, 930, 2945, 924, 753, 157, 674, 1521, 672, 1128,
+	1129, 1139, 1126, 1320, 1140, 832, 1125, 1128, 1129, 941,
+	1307, 2679, 758, 2866, 2867, 757, 946, 781, 780, 932,
+	1777, 925, 2835, 2836, 2758, 854, 858, 860, 862, 864,
+	865, 867, 2300, 871, 868, 869, 870, 2827, 2128, 849,
+	850, 851, 852, 830, 831, 855, 2301, 833, 2302, 834,
+	835, 836, 837, 838, 839, 840, 841, 842, 843, 845,
+	846, 847, 853, 2538, 2896, 446, 2932, 2933, 2830, 2754,
+	857, 859, 861, 863, 866, 446, 886, 1323, 2761, 2762,
+	2763, 2764, 2538, 897, 2032, 887, 2827, 1219, 1217, 1589,
+	2840, 469, 469, 1142, 446, 1309, 2547, 2565, 2404, 885,
+	927, 1581, 918, 2406, 762, 1702, 127, 848, 158, 881,
+	883, 1389, 2689, 2572, 2396, 1386, 1306, 1299, 897, 1388,
+	1385, 1387, 1391, 1392, 759, 920, 675, 1390, 150, 1937,
+	2774, 783, 2328, 1845, 944, 945, 2134, 923, 926, 2678,
+	2044, 1645, 1585, 1226, 1225, 2680, 2400, 2777, 2899, 2900,
+	2401, 2402, 2326, 984, 2119, 2901, 2865, 2466, 943, 928,
+	1117, 919, 878, 917, 2660, 2403, 823, 880, 2294, 2686,
+	2235, 1939, 884, 951, 952, 953, 950, 2411, 909, 1942,
+	2739, 514, 2939, 761, 516, 1322, 2949, 2419, 1707, 515,
+	2426, 905, 2851, 2725, 2726, 2727, 2729, 2730, 882, 886,
+	2728, 2479, 2480, 1329, 1332, 1333, 2158, 1152, 1605, 1606,
+	2791, 2629, 939, 940, 1330, 1711, 1713, 1714, 471, 2847,
+	3032, 470, 1018, 2944, 3016, 1305, 467, 467, 2970, 788,
+	931, 2909, 921, 2144, 2145, 2146, 2147, 2148, 466, 466,
+	2977, 2398, 1108, 1141, 2067, 2068, 2905, 2906, 2738, 2909,
+	2812, 463, 463, 2619, 760, 464, 464, 2981, 465, 465,
+	2860, 2859, 2621, 1396, 1397, 1398, 1399, 1400, 1401, 1394,
+	1395, 2610, 1017, 899, 898, 754, 1696, 890, 892, 1920,
+	785, 1919, 787, 2551, 2486, 2216, 1696, 2140, 2332, 3033,
+	2955, 1161, 1696, 2634, 2635, 1066, 1067, 1160, 907, 788,
+	1121, 1120, 1145, 1102, 3027, 1074, 441, 1077, 899, 898,
+	1897, 2614, 1101, 3039, 3003, 2785, 906, 1046, 934, 2417,
+	877, 935, 2378, 902, 903, 2588, 889, 891, 813, 813,
+	813, 813, 713, 2898, 813, 1723, 813, 2657, 2793, 1079,
+	986, 987, 988, 989, 990, 2000, 478, 1128, 1129, 937,
+	785, 1298, 787, 1709, 1221, 1128, 1129, 2824, 756, 1697,
+	1158, 755, 2040, 914, 1969, 2412, 1699, 1466, 1465, 2792,
+	856, 1127, 1065, 2331, 1909, 1118, 661, 2269, 2271, 446,
+	1900, 1154, 1085, 1089, 1124, 1088, 1087, 473, 2386, 52,
+	1276, 2685, 434, 434, 434, 52, 129, 129, 1178, 1178,
+	1710, 446, 2519, 129, 129, 1092, 129, 2118, 129, 2956,
+	2048, 2407, 129, 129, 2950, 1590, 1790, 2397, 1072, 469,
+	1077, 441, 129, 1209, 1209, 908, 783, 2133, 129, 1470,
+	933, 1904, 3026, 1471, 177, 2775, 1185, 2329, 1026, 1027,
+	2399, 2737, 1789, 434, 1103, 1180, 1896, 1331, 913, 2418,
+	1276, 1898, 1115, 1272, 1176, 1176, 2690, 1792, 1791, 1712,
+	1133, 1134, 1173, 1136, 1137, 1138, 938, 705, 1582, 1080,
+	1081, 1082, 1083, 1084, 1075, 1086, 1150, 1070, 1071, 1090,
+	2137, 2138, 2341, 2340, 1300, 1469, 3038, 754, 676, 936,
+	677, 1227, 2708, 1250, 2136, 1253, 1754, 1275, 1184, 1753,
+	1261, 1955, 2612, 2837, 2838, 1274, 2611, 1051, 1899, 1584,
+	1901, 1053, 2692, 2431, 1113, 1114, 2217, 2219, 2220, 2221,
+	2218, 1285, 2413, 1104, 2615, 2616, 1110, 1112, 1112, 1112,
+	2982, 1914, 662, 1301, 1178, 1267, 1178, 886, 3045, 2270,
+	1799, 2874, 2953, 2954, 707, 708, 709, 1153, 1498, 1110,
+	1782, 1110, 2163, 1230, 949, 1233, 1234, 1275, 2501, 1094,
+	1319, 1903, 1276, 1800, 1801, 1274, 1907, 1905, 949, 477,
+	756, 1906, 1625, 755, 1144, 1498, 1146, 1282, 1283, 2497,
+	680, 1241, 1242, 1132, 914, 2164, 1135, 3044, 3035, 1202,
+	1171, 1172, 3017, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
+	1346, 1347, 1348, 1349, 1350, 1159, 912, 949, 1956, 1362,
+	1363, 914, 665, 1168, 1169, 1170, 1371, 804, 809, 810,
+	763, 951, 952, 953, 950, 1410, 1411, 1412, 2020, 1420,
+	1186, 679, 2584, 451, 665, 682, 681, 1778, 1426, 1848,
+	911, 1427, 1201, 1257, 788, 2039, 1211, 1264, 788, 1210,
+	1316, 1200, 1429, 1434, 1435, 3012, 949, 3036, 467, 1956,
+	3006, 1704, 3005, 1246, 1222, 1249, 2986, 1781, 1294, 1275,
+	466, 1956, 951, 952, 953, 950, 2164, 1274, 2962, 2124,
+	1293, 1334, 2922, 463, 2873, 1313, 1302, 464, 2868, 1296,
+	465, 2121, 1106, 1290, 2025, 2817, 1623, 1289, 2501, 446,
+	1450, 1479, 1178, 1483, 1980, 1485, 1486, 1487, 1284, 1278,
+	2816, 446, 2810, 912, 713, 1695, 1268, 1496, 2360, 2809,
+	2808, 1178, 1885, 2571, 3013, 446, 446, 1269, 662, 1704,
+	1154, 1704, 949, 462, 1453, 1704, 1847, 1292, 1783, 1758,
+	1291, 1288, 2807, 1685, 1419, 1402, 1403, 2963, 1406, 1310,
+	1603, 2923, 1520, 2781, 1047, 1315, 1421, 2781, 2780, 1093,
+	1526, 1526, 2636, 1154, 2818, 1154, 2488, 1154, 2319, 1428,
+	446, 1430, 1479, 1479, 2264, 1524, 1178, 1568, 1580, 1867,
+	1478, 2781, 1353, 434, 1484, 1178, 1360, 1361, 2781, 2781,
+	806, 807, 808, 1365, 1107, 2100, 1476, 964, 974, 975,
+	967, 968, 969, 970, 971, 972, 973, 966, 1491, 2055,
+	914, 2781, 446, 1479, 1178, 879, 1614, 1615, 446, 446,
+	1618, 2036, 1505, 1506, 2029, 1621, 1162, 2781, 1504, 1627,
+	1513, 1980, 1405, 3000, 2027, 2489, 177, 1867, 2964, 177,
+	177, 2022, 177, 1956, 2015, 1519, 1515, 1516, 1522, 1523,
+	2013, 1564, 1565, 1482, 2599, 2432, 2009, 2284, 2166, 1431,
+	2042, 2041, 1586, 1602, 949, 2031, 1882, 1532, 1488, 1489,
+	1490, 2007, 1501, 1866, 1779, 1762, 1761, 1451, 949, 1420,
+	1420, 1671, 1752, 1743, 521, 1457, 1420, 1420, 1749, 2436,
+	1867, 1678, 1611, 2023, 1742, 1613, 1734, 1741, 1636, 1591,
+	1703, 1639, 1640, 2028, 1642, 1110, 1499, 1500, 1279, 1610,
+	2023, 1616, 1617, 2016, 1968, 1610, 1610, 1518, 1496, 2014,
+	1514, 1493, 1528, 1178, 1693, 2008, 1492, 1482, 1684, 1529,
+	1503, 1112, 1530, 1509, 1531, 1511, 1512, 1510, 1630, 966,
+	2008, 2995, 1867, 1778, 949, 949, 1733, 1475, 1517, 1270,
+	2983, 949, 949, 995, 900, 1599, 1600, 1601, 1672, 879,
+	874, 2071, 1686, 949, 678, 1313, 949, 1527, 2323, 1704,
+	872, 2427, 951, 952, 953, 950, 1716, 1280, 1106, 1567,
+	1569, 2852, 1587, 879, 1596, 1597, 1598, 1720, 1721, 871,
+	868, 869, 870, 1911, 1666, 2076, 2502, 2075, 2074, 2072,
+	2709, 1666, 1432, 1433, 2591, 1612, 1436, 1437, 1438, 1439,
+	1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448, 1732, 1608,
+	2493, 2490, 1633, 2589, 1631, 2853, 788, 2334, 1409, 1408,
+	1999, 1650, 2428, 788, 969, 970, 971, 972, 973, 966,
+	467, 1098, 1166, 1106, 2710, 1099, 467, 2236, 2592, 2527,
+	1164, 2026, 466, 1167, 1759, 1971, 1277, 888, 466, 814,
+	815, 1766, 2062, 2073, 819, 463, 1993, 2590, 1368, 464,
+	1729, 463, 465, 1675, 1634, 464, 2429, 785, 465, 787,
+	1107, 1683, 1673, 683, 785, 1214, 787, 1634, 1359, 1368,
+	1681, 1680, 2286, 519, 1105, 886, 1841, 1682, 1688, 953,
+	950, 1676, 1477, 1677, 1356, 1358, 1355, 2892, 1357, 446,
+	446, 446, 950, 1864, 951, 952, 953, 950, 1807, 550,
+	559, 1440, 788, 1871, 1154, 551, 2624, 558, 552, 556,
+	555, 553, 554, 1163, 2623, 1875, 3021, 2303, 1724, 2194,
+	2193, 1715, 2188, 2186, 2239, 1107, 2603, 514, 1717, 1154,
+	516, 951, 952, 953, 950, 515, 886, 1728, 3009, 2980,
+	2971, 1353, 2530, 1424, 1718, 1719, 951, 952, 953, 950,
+	2687, 2569, 2227, 785, 1425, 787, 2967, 2003, 2225, 1890,
+	560, 2223, 2213, 965, 964, 974, 975, 967, 968, 969,
+	970, 971, 972, 973, 966, 2965, 1960, 1960, 1580, 1960,
+	2077, 2078, 1756, 2979, 2921, 1886, 1856, 1857, 1858, 2688,
+	2570, 2226, 557, 2391, 2910, 886, 2883, 2224, 1776, 1873,
+	2222, 2212, 2854, 1178, 446, 951, 952, 953, 950, 2796,
+	1876, 1877, 1874, 2752, 2528, 2715, 1842, 2712, 1018, 2711,
+	886, 441, 2593, 1878, 1209, 2568, 1580, 2405, 2318, 1988,
+	2298, 1990, 2297, 2211, 2938, 177, 2210, 1912, 2209, 1915,
+	1916, 1917, 1918, 1807, 2206, 1921, 1922, 1923, 1924, 1925,
+	1926, 1927, 1928, 1929, 1930, 1931, 1932, 1933, 1934, 1976,
+	1913, 1793, 2200, 1962, 2197, 1966, 1964, 1849, 1017, 2196,
+	1655, 1872, 1884, 965, 964, 974, 975, 967, 968, 969,
+	970, 971, 972, 973, 966, 2034, 1654, 1653, 1496, 1693,
+	951, 952, 953, 950, 1881, 1883, 1178, 1994, 1178, 2064,
+	1178, 1184, 1652, 1651, 1647, 886, 1646, 1149, 1112, 1151,
+	1271, 1155, 1156, 1064, 1987, 2667, 2934, 1985, 2798, 2893,
+	1879, 2822, 2776, 1880, 2753, 788, 1992, 2699, 2049, 2665,
+	2663, 2642, 2037, 1940, 1178, 2080, 2353, 2638, 2765, 1191,
+	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 2232, 2605,
+	2088, 1204, 2567, 2566, 2045, 1178, 951, 952, 953, 950,
+	1972, 1973, 1974, 2563, 1214, 2090, 1977, 967, 968, 969,
+	970, 971, 972, 973, 966, 2556, 785, 2550, 787, 2053,
+	2079, 1983, 2352, 1986, 2496, 2494, 2484, 954, 2483, 1984,
+	1176, 951, 952, 953, 950, 2383, 983, 2092, 2382, 886,
+	1995, 2089, 2330, 2296, 992, 951, 952, 953, 950, 2276,
+	2214, 1176, 974, 975, 967, 968, 969, 970, 971, 972,
+	973, 966, 2122, 2046, 2207, 2203, 997, 2202, 467, 2201,
+	2060, 957, 958, 959, 960, 961, 962, 963, 955, 1745,
+	466, 1788, 1785, 1786, 2111, 2066, 2038, 1313, 1178, 2043,
+	2035, 2141, 446, 463, 607, 606, 1479, 464, 1787, 1780,
+	465, 1657, 2162, 1649, 1464, 1463, 2096, 2912, 2168, 1025,
+	1021, 2101, 3034, 1020, 996, 2056, 2057, 875, 2586, 2033,
+	2585, 2583, 2555, 2177, 2081, 2070, 951, 952, 953, 950,
+	951, 952, 953, 950, 1744, 1215, 2185, 951, 952, 953,
+	950, 2542, 2533, 2532, 2190, 2191, 2192, 2894, 2521, 2520,
+	2195, 2059, 1234, 2437, 2358, 2856, 2351, 951, 952, 953,
+	950, 2125, 2343, 2338, 1960, 2281, 2112, 2123, 2115, 2120,
+	951, 952, 953, 950, 2228, 2153, 1241, 1242, 951, 952,
+	953, 950, 2130, 1479, 886, 1580, 1580, 1580, 1580, 2142,
+	2012, 2169, 2011, 2152, 2010, 2006, 886, 1580, 2087, 2160,
+	1960, 2005, 160, 1767, 1757, 152, 128, 2247, 1960, 2171,
+	1178, 1755, 1751, 2173, 2159, 1750, 2183, 1748, 2170, 2247,
+	2183, 446, 446, 446, 1739, 2174, 2175, 1736, 1735, 562,
+	112, 2139, 1656, 1449, 1423, 112, 177, 1422, 1413, 1482,
+	2161, 177, 8, 160, 2180, 1190, 7, 1188, 2167, 2994,
+	2198, 2199, 1246, 2260, 1249, 2988, 2204, 2205, 2978, 2975,
+	2179, 157, 2973, 2882, 1420, 2820, 1420, 2181, 2801, 2313,
+	1015, 2187, 2317, 1229, 2234, 2779, 951, 952, 953, 950,
+	1178, 2734, 2721, 2325, 2716, 452, 2650, 2648, 112, 2631,
+	2630, 2627, 2208, 2626, 2622, 2184, 2618, 2578, 2287, 2172,
+	2576, 1240, 157, 2291, 1231, 1096, 2229, 1208, 1208, 2233,
+	2189, 2156, 2237, 2155, 2248, 2249, 2250, 2251, 2278, 2279,
+	2280, 2261, 2259, 2176, 2845, 2154, 1245, 1453, 2263, 662,
+	1248, 1238, 2312, 2274, 2277, 2273, 2263, 2262, 1237, 2310,
+	1235, 2110, 2916, 2802, 2021, 2316, 1970, 951, 952, 953,
+	950, 1935, 1865, 2285, 1354, 2289, 157, 2346, 2288, 2348,
+	1687, 2322, 1619, 1474, 2327, 886, 951, 952, 953, 950,
+	1473, 2394, 1260, 1236, 2311, 2778, 1048, 1045, 2306, 2309,
+	2304, 2409, 1044, 1043, 446, 1042, 1737, 786, 1807, 1041,
+	1040, 112, 1039, 1038, 2321, 886, 886, 886, 951, 952,
+	953, 950, 2335, 2336, 1580, 1864, 112, 2435, 112, 2308,
+	788, 1037, 1036, 2439, 2344, 2345, 2315, 788, 1890, 1890,
+	1890, 2342, 1035, 2469, 1034, 2472, 2347, 2472, 2472, 1033,
+	2349, 2350, 1032, 1031, 2477, 1030, 1029, 2363, 1028, 1178,
+	1178, 2364, 2365, 2366, 2367, 2379, 2368, 2369, 2370, 2371,
+	2372, 2373, 2374, 2375, 1324, 1325, 1326, 1327, 1328, 1024,
+	2387, 1023, 1022, 2384, 951, 952, 953, 950, 1019, 1012,
+	446, 1011, 1009, 1008, 1007, 2394, 2385, 1006, 1005, 1004,
+	1003, 2414, 1002, 1479, 1479, 2481, 2482, 2423, 2424, 2152,
+	2434, 2468, 2433, 2467, 1001, 1176, 1176, 2415, 1369, 1370,
+	2430, 1000, 2628, 2673, 1404, 2438, 2914, 2672, 999, 2440,
+	2441, 2416, 1414, 2389, 788, 2633, 998, 2473, 2474, 2553,
+	994, 993, 2442, 916, 873, 2080, 951, 952, 953, 950,
+	951, 952, 953, 950, 2531, 2505, 2506, 1870, 951, 952,
+	953, 950, 951, 952, 953, 950, 795, 789, 794, 796,
+	1853, 904, 2864, 1454, 2508, 3010, 2143, 1458, 2498, 2499,
+	1461, 2491, 2495, 2487, 2492, 2443, 788, 1610, 666, 667,
+	668, 669, 446, 800, 2509, 2356, 1731, 792, 1982, 1981,
+	2500, 665, 1851, 793, 1659, 915, 2256, 98, 2513, 2475,
+	2511, 2257, 2516, 2517, 2518, 2512, 2510, 54, 951, 952,
+	953, 950, 2355, 53, 2526, 965, 964, 974, 975, 967,
+	968, 969, 970, 971, 972, 973, 966, 2117, 2258, 2254,
+	1952, 1953, 2543, 2354, 2255, 951, 952, 953, 950, 2544,
+	2253, 798, 1189, 951, 952, 953, 950, 2252, 801, 2109,
+	2546, 2545, 443, 448, 3020, 2549, 951, 952, 953, 950,
+	2380, 2381, 1479, 449, 2557, 2030, 2024, 2108, 790, 450,
+	2582, 2107, 951, 952, 953, 950, 2653, 1563, 2652, 2548,
+	1454, 1960, 1580, 2596, 2388, 1223, 1454, 1454, 1454, 799,
+	951, 952, 953, 950, 951, 952, 953, 950, 2106, 112,
+	112, 786, 1843, 2019, 1178, 447, 2559, 2050, 2604, 1785,
+	1786, 1620, 2562, 1050, 2651, 1254, 2105, 446, 910, 2839,
+	2718, 951, 952, 953, 950, 2178, 2469, 791, 2126, 1635,
+	2574, 2104, 1638, 2598, 1860, 1641, 1494, 2575, 1643, 951,
+	952, 953, 950, 2925, 2577, 1472, 1409, 1408, 1479, 1938,
+	2561, 1566, 886, 1148, 951, 952, 953, 950, 1062, 1063,
+	2607, 1060, 1061, 2579, 2580, 2581, 2602, 2594, 2467, 1147,
+	2595, 982, 1058, 1059, 2515, 2247, 2656, 2103, 2597, 177,
+	1056, 1057, 942, 1679, 2600, 1100, 1052, 2601, 2644, 2989,
+	2903, 2889, 886, 665, 2632, 2887, 2102, 2283, 797, 2848,
+	951, 952, 953, 950, 2832, 2831, 2829, 2637, 2821, 2641,
+	2681, 2746, 2745, 2664, 2625, 2247, 2645, 2558, 2646, 951,
+	952, 953, 950, 2540, 2539, 2524, 1055, 2643, 2099, 886,
+	1178, 1178, 2523, 1498, 2320, 886, 2702, 2098, 1855, 2702,
+	1738, 2658, 901, 2097, 2918, 2917, 2917, 2093, 2918, 2668,
+	2620, 951, 952, 953, 950, 1116, 2541, 62, 1890, 2,
+	951, 952, 953, 950, 1604, 2682, 951, 952, 953, 950,
+	951, 952, 953, 950, 164, 3, 2697, 886, 886, 1182,
+	1726, 886, 886, 1730, 2703, 1, 1176, 2607, 2706, 1462,
+	2705, 670, 2698, 2266, 2598, 666, 667, 668, 669, 1496,
+	2514, 2743, 2268, 1700, 1054, 1960, 2813, 2639, 665, 2674,
+	2748, 2749, 2084, 2722, 2723, 1936, 1844, 2732, 2733, 2717,
+	2408, 2061, 1740, 1091, 706, 2731, 1415, 803, 2740, 896,
+	1747, 1281, 895, 893, 2773, 951, 952, 953, 950, 2713,
+	2714, 1364, 1366, 2741, 951, 952, 953, 950, 1760, 564,
+	1662, 1763, 1764, 1765, 2787, 2230, 1768, 1769, 1770, 1771,
+	1772, 1773, 1774, 1775, 951, 952, 953, 950, 2742, 2924,
+	2959, 2881, 886, 2927, 1295, 548, 2771, 1948, 1951, 1952,
+	1953, 1949, 2823, 1950, 1954, 886, 2757, 2885, 2782, 2759,
+	2815, 2747, 2670, 1705, 947, 2305, 2789, 2788, 727, 600,
+	575, 2797, 1010, 1262, 1255, 2361, 2800, 805, 2806, 574,
+	2573, 2135, 2790, 1868, 695, 802, 728, 1644, 2755, 1224,
+	1247, 2811, 1943, 1187, 1228, 2707, 2587, 2425, 452, 2157,
+	3030, 3019, 886, 2819, 3001, 2987, 2833, 2908, 3015, 2849,
+	2943, 2828, 2976, 2826, 2677, 1948, 1951, 1952, 1953, 1949,
+	2675, 1950, 1954, 112, 2676, 2969, 2904, 484, 1583, 2844,
+	432, 767, 2843, 2735, 1658, 485, 1869, 2895, 2850, 2876,
+	2720, 2879, 693, 1852, 694, 2150, 2149, 1335, 956, 1352,
+	2376, 2855, 2377, 991, 524, 1727, 536, 2132, 2458, 2880,
+	2869, 2870, 2871, 2872, 2275, 61, 60, 2888, 59, 2890,
+	2891, 58, 1626, 185, 2886, 2884, 566, 184, 2878, 2929,
+	546, 1454, 1454, 1454, 545, 544, 112, 543, 542, 1947,
+	112, 1945, 2902, 1944, 1575, 2815, 1574, 1624, 2478, 1908,
+	1902, 112, 2911, 2931, 1533, 2915, 2861, 2913, 1208, 2803,
+	2804, 112, 2617, 2215, 2930, 2920, 2919, 2613, 2609, 2485,
+	2701, 2444, 2445, 2451, 886, 1859, 828, 2935, 824, 826,
+	827, 2936, 2875, 825, 2069, 2065, 1887, 1889, 1888, 2421,
+	1798, 1797, 1795, 1794, 2958, 2946, 2948, 2941, 2951, 1073,
+	160, 2957, 51, 152, 128, 2961, 2772, 2560, 1805, 1803,
+	2966, 2507, 2503, 886, 2410, 1670, 1459, 2116, 844, 1576,
+	1572, 1941, 153, 2968, 2972, 1854, 2974, 89, 88, 145,
+	96, 141, 48, 154, 2931, 2985, 1319, 169, 110, 168,
+	171, 170, 167, 1996, 886, 2930, 886, 1997, 2984, 166,
+	1212, 165, 701, 99, 2991, 2704, 2993, 2996, 659, 157,
+	38, 37, 2961, 2937, 2063, 886, 2997, 1319, 2940, 1319,
+	33, 3011, 2082, 2083, 3014, 3008, 3004, 12, 11, 34,
+	2085, 2086, 21, 22, 20, 1286, 19, 25, 1319, 3018,
+	32, 31, 3025, 2091, 30, 105, 3029, 3028, 104, 29,
+	103, 715, 102, 3037, 101, 100, 3040, 28, 18, 42,
+	3025, 3043, 3042, 1454, 3041, 3029, 2113, 2114, 1461, 41,
+	40, 832, 9, 95, 93, 822, 27, 94, 91, 92,
+	78, 90, 115, 116, 73, 117, 118, 72, 71, 86,
+	85, 854, 858, 860, 862, 864, 865, 867, 84, 871,
+	868, 869, 870, 83, 82, 849, 850, 851, 852, 830,
+	831, 855, 81, 833, 754, 834, 835, 836, 837, 838,
+	839, 840, 841, 842, 843, 845, 846, 847, 853, 80,
+	703, 726, 698, 70, 687, 69, 857, 859, 861, 863,
+	866, 700, 699, 68, 67, 66, 77, 87, 79, 76,
+	75, 74, 127, 151, 158, 65, 97, 64, 685, 63,
+	125, 126, 691, 124, 123, 122, 121, 1579, 692, 120,
+	119, 43, 45, 848, 150, 144, 143, 44, 46, 47,
+	136, 57, 160, 137, 51, 152, 128, 135, 138, 140,
+	142, 139, 133, 131, 134, 132, 130, 756, 2992, 56,
+	755, 17, 24, 4, 153, 0, 0, 0, 0, 697,
+	0, 145, 0, 696, 0, 154, 0, 0, 0, 684,
+	110, 0, 0, 690, 0, 112, 0, 0, 112, 112,
+	0, 112, 0, 0, 0, 99, 740, 0, 0, 0,
+	0, 157, 0, 688, 716, 146, 147, 148, 965, 964,
+	974, 975, 967, 968, 969, 970, 971, 972, 973, 966,
+	0, 0, 0, 0, 686, 0, 0, 0, 786, 0,
+	0, 746, 0, 0, 155, 786, 0, 0, 704, 0,
+	0, 0, 719, 112, 2290, 0, 2292, 0, 0, 112,
+	0, 0, 106, 0, 0, 0, 149, 0, 107, 0,
+	0, 977, 689, 981, 0, 0, 1454, 0, 0, 0,
+	0, 1454, 0, 0, 115, 116, 0, 117, 118, 978,
+	980, 976, 0, 979, 965, 964, 974, 975, 967, 968,
+	969, 970, 971, 972, 973, 966, 0, 739, 738, 2990,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2337,
+	0, 108, 0, 0, 0, 0, 0, 737, 0, 0,
+	0, 50, 0, 0, 982, 2359, 714, 951, 952, 953,
+	950, 0, 2357, 702, 0, 0, 0, 717, 749, 0,
+	0, 0, 0, 0, 127, 151, 158, 0, 97, 965,
+	964, 974, 975, 967, 968, 969, 970, 971, 972, 973,
+	966, 744, 0, 0, 0, 0, 150, 144, 143, 52,
+	844, 0, 0, 57, 0, 965, 964, 974, 975, 967,
+	968, 969, 970, 971, 972, 973, 966, 0, 0, 0,
+	0, 0, 0, 745, 750, 0, 856, 0, 0, 1561,
+	0, 0, 129, 0, 0, 0, 1393, 0, 0, 0,
+	734, 0, 732, 736, 753, 0, 0, 0, 733, 730,
+	729, 0, 735, 720, 721, 718, 722, 723, 724, 725,
+	0, 751, 752, 0, 2476, 1563, 0, 146, 147, 148,
+	0, 0, 0, 747, 748, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 109, 39, 0, 0,
+	0, 0, 0, 49, 5, 1393, 155, 113, 114, 0,
+	0, 0, 1543, 832, 0, 0, 0, 0, 0, 0,
+	742, 0, 0, 0, 106, 0, 0, 0, 149, 0,
+	107, 0, 0, 854, 858, 860, 862, 864, 865, 867,
+	0, 871, 868, 869, 870, 0, 0, 849, 850, 851,
+	852, 830, 831, 855, 0, 833, 0, 834, 835, 836,
+	837, 838, 839, 840, 841, 842, 843, 845, 846, 847,
+	853, 2058, 0, 0, 0, 0, 0, 0, 857, 859,
+	861, 863, 866, 108, 0, 0, 0, 1963, 0, 741,
+	0, 0, 0, 50, 0, 965, 964, 974, 975, 967,
+	968, 969, 970, 971, 972, 973, 966, 0, 0, 1389,
+	0, 0, 0, 1386, 0, 848, 0, 1388, 1385, 1387,
+	1391, 1392, 0, 0, 0, 1390, 0, 0, 0, 0,
+	0, 0, 0, 1561, 0, 1579, 0, 0, 2552, 0,
+	0, 52, 0, 0, 112, 2554, 1537, 1536, 0, 0,
+	1535, 0, 0, 0, 0, 1547, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1551, 0, 1389, 1563,
+	0, 0, 1386, 0, 129, 0, 1388, 1385, 1387, 1391,
+	1392, 0, 0, 1725, 1390, 0, 1540, 0, 0, 0,
+	0, 112, 1542, 1544, 1546, 0, 1548, 1549, 1550, 1552,
+	1553, 1554, 1556, 1557, 1558, 1559, 1543, 965, 964, 974,
+	975, 967, 968, 969, 970, 971, 972, 973, 966, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 109, 39,
+	0, 0, 0, 0, 0, 49, 0, 0, 0, 113,
+	114, 0, 0, 0, 0, 0, 0, 0, 1562, 0,
+	1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383,
+	1384, 1396, 1397, 1398, 1399, 1400, 1401, 1394, 1395, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1454, 1560, 0,
+	2647, 0, 0, 2649, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2654, 1539, 0, 0, 2655, 1374,
+	1375, 1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384,
+	1396, 1397, 1398, 1399, 1400, 1401, 1394, 1395, 0, 0,
+	0, 0, 0, 0, 1555, 0, 0, 0, 0, 0,
+	0, 1545, 0, 0, 0, 0, 0, 0, 0, 1547,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1551, 2691, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 112, 0, 0, 856, 0,
+	1540, 0, 0, 0, 0, 0, 1542, 1544, 1546, 0,
+	1548, 1549, 1550, 1552, 1553, 1554, 1556, 1557, 1558, 1559,
+	0, 0, 0, 0, 0, 0, 0, 2719, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1562, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1579, 1579, 1579, 1579, 0, 0,
+	0, 0, 2770, 0, 0, 0, 1579, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2783, 1560, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1539,
+	0, 2799, 0, 0, 0, 112, 0, 0, 0, 0,
+	112, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1555, 0,
+	0, 0, 112, 0, 0, 1545, 0, 0, 0, 112,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2770, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2841, 360, 582, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2857, 0, 0, 0,
+	0, 538, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 573, 0, 0, 351, 306, 0, 0, 0,
+	0, 630, 638, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 531, 0, 0, 563, 607, 606, 550,
+	559, 0, 0, 249, 183, 551, 112, 558, 552, 556,
+	555, 553, 554, 0, 622, 0, 0, 0, 0, 0,
+	0, 522, 535, 2767, 539, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1579, 0, 0, 0, 2770, 532, 533,
+	0, 0, 0, 0, 583, 0, 534, 0, 112, 578,
+	560, 561, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 557, 581, 585, 261, 644, 579, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 645, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 2999, 0,
+	0, 0, 0, 576, 0, 0, 0, 383, 0, 0,
+	628, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 580, 0, 342, 324, 641, 523, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 626,
+	320, 0, 0, 640, 621, 623, 624, 627, 631, 632,
+	633, 634, 635, 637, 639, 643, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 2768,
+	0, 1579, 0, 2769, 0, 642, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 584, 310, 311, 312, 313,
+	629, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 651, 625, 650, 652, 653,
+	649, 654, 655, 636, 541, 0, 588, 647, 646, 648,
+	0, 0, 0, 0, 0, 0, 0, 0, 112, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 614,
+	593, 594, 595, 540, 596, 591, 592, 615, 586, 611,
+	612, 565, 589, 597, 610, 598, 613, 616, 617, 656,
+	657, 604, 658, 601, 618, 609, 608, 599, 587, 619,
+	620, 572, 567, 602, 603, 590, 605, 568, 569, 570,
+	571, 360, 582, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 538, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 573, 0,
+	0, 351, 306, 0, 0, 0, 0, 630, 638, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
+	0, 0, 563, 607, 606, 550, 559, 0, 0, 249,
+	183, 551, 0, 558, 552, 556, 555, 553, 554, 0,
+	622, 0, 0, 0, 0, 0, 0, 522, 535, 0,
+	539, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 532, 533, 0, 0, 0, 0,
+	583, 0, 534, 0, 0, 578, 560, 561, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 557, 581,
+	585, 261, 644, 579, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 645,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 576,
+	0, 0, 0, 383, 0, 0, 628, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 580, 0, 342,
+	324, 641, 523, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 1417, 1416, 1418,
+	396, 274, 231, 232, 439, 626, 320, 0, 0, 640,
+	621, 623, 624, 627, 631, 632, 633, 634, 635, 637,
+	639, 643, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 642, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 584, 310, 311, 312, 313, 629, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 651, 625, 650, 652, 653, 649, 654, 655, 636,
+	541, 0, 588, 647, 646, 648, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 293, 0, 334, 272, 614, 593, 594, 595, 540,
+	596, 591, 592, 615, 586, 611, 612, 565, 589, 597,
+	610, 598, 613, 616, 617, 656, 657, 604, 658, 601,
+	618, 609, 608, 599, 587, 619, 620, 572, 567, 602,
+	603, 590, 605, 568, 569, 570, 571, 360, 582, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 0, 538, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 573, 0, 0, 351, 306, 0,
+	0, 0, 0, 630, 638, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 0, 0, 563, 607,
+	606, 550, 559, 0, 0, 249, 183, 551, 0, 558,
+	552, 556, 555, 553, 554, 0, 622, 0, 0, 0,
+	0, 0, 0, 522, 535, 0, 539, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	532, 533, 0, 0, 0, 0, 583, 0, 534, 0,
+	0, 578, 560, 561, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 557, 581, 585, 261, 644, 579,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 645, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 576, 0, 0, 0, 383,
+	0, 0, 628, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 580, 0, 342, 324, 641, 523, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 626, 320, 0, 0, 640, 621, 623, 624, 627,
+	631, 632, 633, 634, 635, 637, 639, 643, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 2768, 0, 0, 0, 2769, 0, 642, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 584, 310, 311,
+	312, 313, 629, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 651, 625, 650,
+	652, 653, 649, 654, 655, 636, 541, 0, 588, 647,
+	646, 648, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 614, 593, 594, 595, 540, 596, 591, 592, 615,
+	586, 611, 612, 565, 589, 597, 610, 598, 613, 616,
+	617, 656, 657, 604, 658, 601, 618, 609, 608, 599,
+	587, 619, 620, 572, 567, 602, 603, 590, 605, 568,
+	569, 570, 571, 360, 582, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 538,
+	0, 0, 0, 267, 1455, 0, 292, 0, 0, 0,
+	573, 0, 0, 351, 306, 0, 0, 0, 0, 630,
+	638, 0, 0, 0, 0, 0, 0, 0, 1593, 0,
+	0, 531, 0, 0, 563, 607, 606, 550, 559, 0,
+	0, 249, 183, 551, 0, 558, 552, 556, 555, 553,
+	554, 0, 622, 0, 0, 0, 0, 0, 0, 522,
+	535, 0, 539, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 532, 533, 0, 0,
+	0, 0, 583, 0, 534, 0, 0, 1594, 560, 561,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	557, 581, 585, 261, 644, 579, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 645, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 576, 0, 0, 0, 383, 0, 0, 628, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 580,
+	0, 342, 324, 641, 523, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 626, 320, 0,
+	0, 640, 621, 623, 624, 627, 631, 632, 633, 634,
+	635, 637, 639, 643, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 642, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 584, 310, 311, 312, 313, 629, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 651, 625, 650, 652, 653, 649, 654,
+	655, 636, 541, 0, 588, 647, 646, 648, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 614, 593, 594,
+	595, 540, 596, 591, 592, 615, 586, 611, 612, 565,
+	589, 597, 610, 598, 613, 616, 617, 656, 657, 604,
+	658, 601, 618, 609, 608, 599, 587, 619, 620, 572,
+	567, 602, 603, 590, 605, 568, 569, 570, 571, 160,
+	360, 582, 392, 393, 394, 416, 377, 0, 436, 0,
+	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 538, 0, 0, 0,
+	267, 0, 0, 292, 0, 0, 0, 985, 0, 0,
+	351, 306, 0, 0, 0, 0, 630, 638, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 531, 0,
+	0, 563, 607, 606, 550, 559, 0, 0, 249, 183,
+	551, 0, 558, 552, 556, 555, 553, 554, 0, 622,
+	0, 0, 0, 0, 0, 0, 522, 535, 0, 539,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 532, 533, 0, 0, 0, 0, 583,
+	0, 534, 0, 0, 578, 560, 561, 0, 0, 0,
+	0, 240, 356, 373, 250, 347, 387, 255, 354, 245,
+	321, 344, 0, 0, 242, 371, 353, 303, 286, 287,
+	241, 0, 339, 265, 278, 262, 319, 557, 581, 585,
+	261, 644, 579, 381, 244, 0, 380, 318, 367, 372,
+	304, 298, 243, 369, 302, 297, 290, 269, 645, 417,
+	418, 283, 330, 296, 331, 284, 308, 307, 309, 0,
+	0, 0, 0, 0, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 576, 0,
+	0, 0, 383, 0, 0, 628, 0, 0, 0, 355,
+	0, 0, 291, 0, 0, 0, 580, 0, 342, 324,
+	641, 523, 0, 340, 294, 368, 332, 374, 357, 382,
+	336, 333, 234, 358, 264, 305, 422, 423, 246, 248,
+	260, 266, 268, 270, 271, 314, 315, 327, 346, 361,
+	362, 363, 263, 256, 341, 257, 280, 258, 235, 282,
+	239, 359, 384, 348, 259, 237, 328, 366, 0, 276,
+	337, 301, 238, 300, 329, 365, 364, 247, 391, 397,
+	398, 403, 0, 404, 0, 0, 0, 412, 424, 425,
+	426, 428, 429, 430, 431, 0, 0, 0, 0, 406,
+	0, 0, 419, 420, 421, 0, 0, 0, 0, 396,
+	274, 231, 232, 439, 626, 320, 0, 0, 640, 621,
+	623, 624, 627, 631, 632, 633, 634, 635, 637, 639,
+	643, 438, 0, 0, 0, 0, 0, 437, 326, 0,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 352, 376, 389, 407, 410, 0, 0,
+	0, 236, 409, 0, 0, 0, 0, 0, 0, 0,
+	642, 0, 0, 0, 388, 0, 0, 0, 0, 0,
+	584, 310, 311, 312, 313, 629, 0, 254, 408, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 401, 402, 273, 279,
+	427, 281, 253, 325, 275, 386, 288, 0, 413, 0,
+	414, 0, 0, 0, 0, 317, 285, 349, 289, 295,
+	338, 385, 323, 343, 251, 375, 350, 299, 0, 0,
+	651, 625, 650, 652, 653, 649, 654, 655, 636, 541,
+	0, 588, 647, 646, 648, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	293, 129, 334, 272, 614, 593, 594, 595, 540, 596,
+	591, 592, 615, 586, 611, 612, 565, 589, 597, 610,
+	598, 613, 616, 617, 656, 657, 604, 658, 601, 618,
+	609, 608, 599, 587, 619, 620, 572, 567, 602, 603,
+	590, 605, 568, 569, 570, 571, 360, 582, 0, 392,
+	393, 394, 416, 377, 0, 436, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 0, 0,
+	0, 0, 538, 0, 0, 0, 267, 2998, 0, 292,
+	0, 0, 0, 573, 0, 0, 351, 306, 0, 0,
+	0, 0, 630, 638, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 531, 0, 0, 563, 607, 606,
+	550, 559, 0, 0, 249, 183, 551, 0, 558, 552,
+	556, 555, 553, 554, 0, 622, 0, 0, 0, 0,
+	0, 0, 522, 535, 0, 539, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 532,
+	533, 0, 0, 0, 0, 583, 0, 534, 0, 0,
+	578, 560, 561, 0, 0, 0, 0, 240, 356, 373,
+	250, 347, 387, 255, 354, 245, 321, 344, 0, 0,
+	242, 371, 353, 303, 286, 287, 241, 0, 339, 265,
+	278, 262, 319, 557, 581, 585, 261, 644, 579, 381,
+	244, 0, 380, 318, 367, 372, 304, 298, 243, 369,
+	302, 297, 290, 269, 645, 417, 418, 283, 330, 296,
+	331, 284, 308, 307, 309, 0, 0, 0, 0, 0,
+	411, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 576, 0, 0, 0, 383, 0,
+	0, 628, 0, 0, 0, 355, 0, 0, 291, 0,
+	0, 0, 580, 0, 342, 324, 641, 523, 0, 340,
+	294, 368, 332, 374, 357, 382, 336, 333, 234, 358,
+	264, 305, 422, 423, 246, 248, 260, 266, 268, 270,
+	271, 314, 315, 327, 346, 361, 362, 363, 263, 256,
+	341, 257, 280, 258, 235, 282, 239, 359, 384, 348,
+	259, 237, 328, 366, 0, 276, 337, 301, 238, 300,
+	329, 365, 364, 247, 391, 397, 398, 403, 0, 404,
+	0, 0, 0, 412, 424, 425, 426, 428, 429, 430,
+	431, 0, 0, 0, 0, 406, 0, 0, 419, 420,
+	421, 0, 0, 0, 0, 396, 274, 231, 232, 439,
+	626, 320, 0, 0, 640, 621, 623, 624, 627, 631,
+	632, 633, 634, 635, 637, 639, 643, 438, 0, 0,
+	0, 0, 0, 437, 326, 0, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 352,
+	376, 389, 407, 410, 0, 0, 0, 236, 409, 0,
+	0, 0, 0, 0, 0, 0, 642, 0, 0, 0,
+	388, 0, 0, 0, 0, 0, 584, 310, 311, 312,
+	313, 629, 0, 254, 408, 335, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 401, 402, 273, 279, 427, 281, 253, 325,
+	275, 386, 288, 0, 413, 0, 414, 0, 0, 0,
+	0, 317, 285, 349, 289, 295, 338, 385, 323, 343,
+	251, 375, 350, 299, 0, 0, 651, 625, 650, 652,
+	653, 649, 654, 655, 636, 541, 0, 588, 647, 646,
+	648, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 293, 0, 334, 272,
+	614, 593, 594, 595, 540, 596, 591, 592, 615, 586,
+	611, 612, 565, 589, 597, 610, 598, 613, 616, 617,
+	656, 657, 604, 658, 601, 618, 609, 608, 599, 587,
+	619, 620, 572, 567, 602, 603, 590, 605, 568, 569,
+	570, 571, 360, 582, 0, 392, 393, 394, 416, 377,
+	0, 436, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 0, 0, 0, 0, 538, 0,
+	0, 0, 267, 1455, 0, 292, 0, 0, 0, 573,
+	0, 0, 351, 306, 0, 0, 0, 0, 630, 638,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	531, 0, 0, 563, 607, 606, 550, 559, 0, 0,
+	249, 183, 551, 0, 558, 552, 556, 555, 553, 554,
+	0, 622, 0, 0, 0, 0, 0, 0, 522, 535,
+	0, 539, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 532, 533, 0, 0, 0,
+	0, 583, 0, 534, 0, 0, 578, 560, 561, 0,
+	0, 0, 0, 240, 356, 373, 250, 347, 387, 255,
+	354, 245, 321, 344, 0, 0, 242, 371, 353, 303,
+	286, 287, 241, 0, 339, 265, 278, 262, 319, 557,
+	581, 585, 261, 644, 579, 381, 244, 0, 380, 318,
+	367, 372, 304, 298, 243, 369, 302, 297, 290, 269,
+	645, 417, 418, 283, 330, 296, 331, 284, 308, 307,
+	309, 0, 0, 0, 0, 0, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	576, 0, 0, 0, 383, 0, 0, 628, 0, 0,
+	0, 355, 0, 0, 291, 0, 0, 0, 580, 0,
+	342, 324, 641, 523, 0, 340, 294, 368, 332, 374,
+	357, 382, 336, 333, 234, 358, 264, 305, 422, 423,
+	246, 248, 260, 266, 268, 270, 271, 314, 315, 327,
+	346, 361, 362, 363, 263, 256, 341, 257, 280, 258,
+	235, 282, 239, 359, 384, 348, 259, 237, 328, 366,
+	0, 276, 337, 301, 238, 300, 329, 365, 364, 247,
+	391, 397, 398, 403, 0, 404, 0, 0, 0, 412,
+	424, 425, 426, 428, 429, 430, 431, 0, 0, 0,
+	0, 406, 0, 0, 419, 420, 421, 0, 0, 0,
+	0, 396, 274, 231, 232, 439, 626, 320, 0, 0,
+	640, 621, 623, 624, 627, 631, 632, 633, 634, 635,
+	637, 639, 643, 438, 0, 0, 0, 0, 0, 437,
+	326, 0, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 352, 376, 389, 407, 410,
+	0, 0, 0, 236, 409, 0, 0, 0, 0, 0,
+	0, 0, 642, 0, 0, 0, 388, 0, 0, 0,
+	0, 0, 584, 310, 311, 312, 313, 629, 0, 254,
+	408, 335, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 401, 402,
+	273, 279, 427, 281, 253, 325, 275, 386, 288, 0,
+	413, 0, 414, 0, 0, 0, 0, 317, 285, 349,
+	289, 295, 338, 385, 323, 343, 251, 375, 350, 299,
+	0, 0, 651, 625, 650, 652, 653, 649, 654, 655,
+	636, 541, 0, 588, 647, 646, 648, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 293, 0, 334, 272, 614, 593, 594, 595,
+	540, 596, 591, 592, 615, 586, 611, 612, 565, 589,
+	597, 610, 598, 613, 616, 617, 656, 657, 604, 658,
+	601, 618, 609, 608, 599, 587, 619, 620, 572, 567,
+	602, 603, 590, 605, 568, 569, 570, 571, 360, 582,
+	0, 392, 393, 394, 416, 377, 0, 436, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	0, 0, 0, 0, 538, 0, 0, 0, 267, 0,
+	0, 292, 0, 0, 0, 573, 0, 0, 351, 306,
+	0, 0, 0, 0, 630, 638, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 531, 0, 0, 563,
+	607, 606, 550, 559, 0, 0, 249, 183, 551, 0,
+	558, 552, 556, 555, 553, 554, 0, 622, 0, 0,
+	0, 0, 0, 0, 522, 535, 0, 539, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 532, 533, 1207, 0, 0, 0, 583, 0, 534,
+	0, 0, 578, 560, 561, 0, 0, 0, 0, 240,
+	356, 373, 250, 347, 387, 255, 354, 245, 321, 344,
+	0, 0, 242, 371, 353, 303, 286, 287, 241, 0,
+	339, 265, 278, 262, 319, 557, 581, 585, 261, 644,
+	579, 381, 244, 0, 380, 318, 367, 372, 304, 298,
+	243, 369, 302, 297, 290, 269, 645, 417, 418, 283,
+	330, 296, 331, 284, 308, 307, 309, 0, 0, 0,
+	0, 0, 411, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 576, 0, 0, 0,
+	383, 0, 0, 628, 0, 0, 0, 355, 0, 0,
+	291, 0, 0, 0, 580, 0, 342, 324, 641, 523,
+	0, 340, 294, 368, 332, 374, 357, 382, 336, 333,
+	234, 358, 264, 305, 422, 423, 246, 248, 260, 266,
+	268, 270, 271, 314, 315, 327, 346, 361, 362, 363,
+	263, 256, 341, 257, 280, 258, 235, 282, 239, 359,
+	384, 348, 259, 237, 328, 366, 0, 276, 337, 301,
+	238, 300, 329, 365, 364, 247, 391, 397, 398, 403,
+	0, 404, 0, 0, 0, 412, 424, 425, 426, 428,
+	429, 430, 431, 0, 0, 0, 0, 406, 0, 0,
+	419, 420, 421, 0, 0, 0, 0, 396, 274, 231,
+	232, 439, 626, 320, 0, 0, 640, 621, 623, 624,
+	627, 631, 632, 633, 634, 635, 637, 639, 643, 438,
+	0, 0, 0, 0, 0, 437, 326, 0, 345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 352, 376, 389, 407, 410, 0, 0, 0, 236,
+	409, 0, 0, 0, 0, 0, 0, 0, 642, 0,
+	0, 0, 388, 0, 0, 0, 0, 0, 584, 310,
+	311, 312, 313, 629, 0, 254, 408, 335, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 401, 402, 273, 279, 427, 281,
+	253, 325, 275, 386, 288, 0, 413, 0, 414, 0,
+	0, 0, 0, 317, 285, 349, 289, 295, 338, 385,
+	323, 343, 251, 375, 350, 299, 0, 0, 651, 625,
+	650, 652, 653, 649, 654, 655, 636, 541, 0, 588,
+	647, 646, 648, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 293, 0,
+	334, 272, 614, 593, 594, 595, 540, 596, 591, 592,
+	615, 586, 611, 612, 565, 589, 597, 610, 598, 613,
+	616, 617, 656, 657, 604, 658, 601, 618, 609, 608,
+	599, 587, 619, 620, 572, 567, 602, 603, 590, 605,
+	568, 569, 570, 571, 0, 0, 0, 392, 393, 394,
+	416, 377, 0, 436, 0, 360, 582, 0, 0, 1746,
+	0, 0, 0, 0, 0, 435, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 538, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 573, 0, 0, 351, 306, 0, 0, 0,
+	0, 630, 638, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 531, 0, 0, 563, 607, 606, 550,
+	559, 0, 0, 249, 183, 551, 0, 558, 552, 556,
+	555, 553, 554, 0, 622, 0, 0, 0, 0, 0,
+	0, 522, 535, 0, 539, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 532, 533,
+	0, 0, 0, 0, 583, 0, 534, 0, 0, 578,
+	560, 561, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 557, 581, 585, 261, 644, 579, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 645, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 576, 0, 0, 0, 383, 0, 0,
+	628, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 580, 0, 342, 324, 641, 523, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 626,
+	320, 0, 0, 640, 621, 623, 624, 627, 631, 632,
+	633, 634, 635, 637, 639, 643, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 642, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 584, 310, 311, 312, 313,
+	629, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 651, 625, 650, 652, 653,
+	649, 654, 655, 636, 541, 0, 588, 647, 646, 648,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 614,
+	593, 594, 595, 540, 596, 591, 592, 615, 586, 611,
+	612, 565, 589, 597, 610, 598, 613, 616, 617, 656,
+	657, 604, 658, 601, 618, 609, 608, 599, 587, 619,
+	620, 572, 567, 602, 603, 590, 605, 568, 569, 570,
+	571, 360, 582, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 538, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 573, 0,
+	0, 351, 306, 0, 0, 0, 0, 630, 638, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 531,
+	0, 0, 563, 607, 606, 550, 559, 0, 0, 249,
+	183, 551, 0, 558, 552, 556, 555, 553, 554, 0,
+	622, 0, 0, 0, 0, 0, 0, 522, 535, 0,
+	539, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 532, 533, 0, 0, 0, 0,
+	583, 0, 534, 0, 0, 578, 560, 561, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 557, 581,
+	585, 261, 644, 579, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 645,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 576,
+	0, 0, 0, 383, 0, 0, 628, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 580, 0, 342,
+	324, 641, 523, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 626, 320, 0, 0, 640,
+	621, 623, 624, 627, 631, 632, 633, 634, 635, 637,
+	639, 643, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 642, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 584, 310, 311, 312, 313, 629, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 651, 625, 650, 652, 653, 649, 654, 655, 636,
+	541, 0, 588, 647, 646, 648, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 123, 146, 153,
-	0, 94, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 170, 0, 0, 0, 347, 0, 0, 283, 145,
-	139, 138, 390, 0, 334, 316, 55, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 141, 142, 143, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	369, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 173, 0, 0, 0, 181, 0,
-	0, 0, 144, 0, 182, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 105, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 48, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 50, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 125, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 0, 0, 0, 382, 383, 384, 406,
-	368, 351, 183, 38, 171, 174, 176, 175, 0, 47,
-	5, 0, 314, 110, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 987, 0, 0,
-	177, 0, 0, 531, 540, 0, 0, 242, 178, 532,
-	0, 539, 533, 537, 536, 534, 535, 0, 245, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 541, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 538, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 0, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 155, 351, 49, 147, 124, 0,
-	0, 0, 0, 0, 0, 0, 314, 438, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 443, 0, 0, 177, 0, 0, 0, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 442, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 439, 441, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	50, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 125, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 818, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	806, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	1769, 1771, 1772, 1773, 1774, 1775, 1776, 0, 1780, 1777,
-	1778, 1779, 311, 0, 1764, 1765, 1766, 1767, 804, 1750,
-	1770, 0, 1751, 310, 1752, 1753, 1754, 1755, 1756, 1757,
-	1758, 1759, 1760, 1761, 1762, 1768, 322, 288, 323, 276,
-	300, 299, 301, 829, 831, 833, 835, 838, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	1763, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 828, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 0, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 1836,
-	1839, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1840, 374,
-	0, 0, 0, 1835, 0, 1834, 347, 1832, 1837, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 1838,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1577, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 1578, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 922, 923, 924, 921, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 742, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 750, 751, 0,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 754, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 732, 372, 237,
-	731, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 740, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 741, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 744, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 752,
-	747, 748, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 749, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	0, 293, 0, 334, 272, 614, 593, 594, 595, 540,
+	596, 591, 592, 615, 586, 611, 612, 565, 589, 597,
+	610, 598, 613, 616, 617, 656, 657, 604, 658, 601,
+	618, 609, 608, 599, 587, 619, 620, 572, 567, 602,
+	603, 590, 605, 568, 569, 570, 571, 360, 582, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 1336,
+	0, 0, 0, 538, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 573, 0, 0, 351, 306, 0,
+	0, 0, 0, 630, 638, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 531, 0, 0, 563, 607,
+	606, 550, 559, 0, 0, 249, 183, 551, 0, 558,
+	552, 556, 555, 553, 554, 0, 622, 0, 0, 0,
+	0, 0, 0, 0, 535, 0, 539, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	532, 533, 0, 0, 0, 0, 583, 0, 534, 0,
+	0, 578, 560, 561, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 557, 581, 585, 261, 644, 579,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 645, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 576, 0, 0, 0, 383,
+	0, 0, 628, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 580, 0, 342, 324, 641, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 1337, 1338, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 626, 320, 0, 0, 640, 621, 623, 624, 627,
+	631, 632, 633, 634, 635, 637, 639, 643, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 642, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 584, 310, 311,
+	312, 313, 629, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 651, 625, 650,
+	652, 653, 649, 654, 655, 636, 541, 0, 588, 647,
+	646, 648, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 614, 593, 594, 595, 540, 596, 591, 592, 615,
+	586, 611, 612, 565, 589, 597, 610, 598, 613, 616,
+	617, 656, 657, 604, 658, 601, 618, 609, 608, 599,
+	587, 619, 620, 572, 567, 602, 603, 590, 605, 568,
+	569, 570, 571, 360, 582, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 538,
+	0, 0, 0, 267, 0, 0, 292, 0, 0, 0,
+	573, 0, 0, 351, 306, 0, 0, 0, 0, 630,
+	638, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 563, 607, 606, 550, 559, 0,
+	0, 249, 183, 551, 0, 558, 552, 556, 555, 553,
+	554, 0, 622, 0, 0, 0, 0, 0, 0, 522,
+	535, 0, 539, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 532, 533, 0, 0,
+	0, 0, 583, 0, 534, 0, 0, 578, 560, 561,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	557, 581, 585, 261, 644, 579, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 645, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 576, 0, 0, 0, 383, 0, 0, 628, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 580,
+	0, 342, 324, 641, 523, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 626, 320, 0,
+	0, 640, 621, 623, 624, 627, 631, 632, 633, 634,
+	635, 637, 639, 643, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 642, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 584, 310, 311, 312, 313, 629, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 651, 625, 650, 652, 653, 649, 654,
+	655, 636, 541, 0, 588, 647, 646, 648, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 614, 593, 594,
+	595, 540, 596, 591, 592, 615, 586, 611, 612, 565,
+	589, 597, 610, 598, 613, 616, 617, 656, 657, 604,
+	658, 601, 618, 609, 608, 599, 587, 619, 620, 572,
+	567, 602, 603, 590, 605, 568, 569, 570, 571, 360,
+	582, 0, 392, 393, 394, 416, 377, 0, 436, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 538, 0, 0, 0, 267,
+	0, 0, 292, 0, 0, 0, 573, 0, 0, 351,
+	306, 0, 0, 0, 0, 630, 638, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 531, 0, 0,
+	563, 607, 606, 550, 559, 0, 0, 249, 183, 551,
+	0, 558, 552, 556, 555, 553, 554, 0, 622, 0,
+	0, 0, 0, 0, 0, 0, 535, 0, 539, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 532, 533, 0, 0, 0, 0, 583, 0,
+	534, 0, 0, 578, 560, 561, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 557, 581, 585, 261,
+	644, 579, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 645, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 576, 0, 0,
+	0, 383, 0, 0, 628, 0, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 580, 0, 342, 324, 641,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 336,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 626, 320, 0, 0, 640, 621, 623,
+	624, 627, 631, 632, 633, 634, 635, 637, 639, 643,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 0, 642,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 584,
+	310, 311, 312, 313, 629, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 651,
+	625, 650, 652, 653, 649, 654, 655, 636, 541, 0,
+	588, 647, 646, 648, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	0, 334, 272, 614, 593, 594, 595, 540, 596, 591,
+	592, 615, 586, 611, 612, 565, 589, 597, 610, 598,
+	613, 616, 617, 656, 657, 604, 658, 601, 618, 609,
+	608, 599, 587, 619, 620, 572, 567, 602, 603, 590,
+	605, 568, 569, 570, 571, 0, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 160, 360, 51, 152, 128,
+	0, 0, 0, 0, 0, 0, 435, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 145, 0, 267, 0, 154, 292,
+	0, 0, 0, 110, 0, 0, 351, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 99, 0,
+	0, 0, 0, 0, 157, 0, 0, 182, 0, 0,
+	0, 0, 0, 0, 249, 183, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 356, 373,
+	250, 347, 387, 255, 354, 245, 321, 344, 0, 0,
+	242, 371, 353, 303, 286, 287, 241, 0, 339, 265,
+	278, 262, 319, 0, 370, 399, 261, 390, 0, 381,
+	244, 0, 380, 318, 367, 372, 304, 298, 243, 369,
+	302, 297, 290, 269, 415, 417, 418, 283, 330, 296,
+	331, 284, 308, 307, 309, 0, 0, 0, 0, 0,
+	411, 0, 0, 0, 0, 0, 0, 127, 151, 158,
+	0, 97, 0, 0, 0, 0, 0, 0, 383, 0,
+	0, 175, 0, 0, 0, 355, 0, 0, 291, 150,
+	144, 143, 400, 0, 342, 324, 57, 0, 0, 340,
+	294, 368, 332, 374, 357, 382, 336, 333, 234, 358,
+	264, 305, 422, 423, 246, 248, 260, 266, 268, 270,
+	271, 314, 315, 327, 346, 361, 362, 363, 263, 256,
+	341, 257, 280, 258, 235, 282, 239, 359, 384, 348,
+	259, 237, 328, 366, 0, 276, 337, 301, 238, 300,
+	329, 365, 364, 247, 391, 397, 398, 403, 0, 404,
+	146, 147, 148, 412, 424, 425, 426, 428, 429, 430,
+	431, 0, 0, 0, 0, 406, 0, 0, 419, 420,
+	421, 0, 0, 0, 0, 396, 274, 231, 232, 378,
+	0, 320, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 316, 395, 178, 0, 0, 0, 186, 0, 0,
+	0, 149, 0, 187, 326, 0, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 352,
+	376, 389, 407, 410, 0, 0, 0, 236, 409, 0,
+	0, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	388, 0, 0, 0, 0, 0, 405, 310, 311, 312,
+	313, 277, 0, 254, 408, 335, 108, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 50, 0, 0, 0,
+	0, 0, 401, 402, 273, 279, 427, 281, 253, 325,
+	275, 386, 288, 0, 413, 0, 414, 0, 0, 0,
+	0, 317, 285, 349, 289, 295, 338, 385, 323, 343,
+	251, 375, 350, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 52, 0, 0, 226, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 293, 129, 334, 272,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 0, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 0, 227, 228,
+	229, 230, 0, 0, 0, 392, 393, 394, 416, 377,
+	360, 188, 39, 176, 179, 181, 180, 0, 49, 5,
+	0, 322, 113, 189, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	267, 0, 0, 292, 0, 0, 0, 0, 0, 0,
+	351, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1016, 0,
+	0, 182, 0, 0, 550, 559, 0, 0, 249, 183,
+	551, 0, 558, 552, 556, 555, 553, 554, 0, 252,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 560, 0, 0, 0, 0,
+	0, 240, 356, 373, 250, 347, 387, 255, 354, 245,
+	321, 344, 0, 0, 242, 371, 353, 303, 286, 287,
+	241, 0, 339, 265, 278, 262, 319, 557, 370, 399,
+	261, 390, 0, 381, 244, 0, 380, 318, 367, 372,
+	304, 298, 243, 369, 302, 297, 290, 269, 415, 417,
+	418, 283, 330, 296, 331, 284, 308, 307, 309, 0,
+	0, 0, 0, 0, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 383, 0, 0, 0, 0, 0, 0, 355,
+	0, 0, 291, 0, 0, 0, 400, 0, 342, 324,
+	0, 0, 0, 340, 294, 368, 332, 374, 357, 382,
+	336, 333, 234, 358, 264, 305, 422, 423, 246, 248,
+	260, 266, 268, 270, 271, 314, 315, 327, 346, 361,
+	362, 363, 263, 256, 341, 257, 280, 258, 235, 282,
+	239, 359, 384, 348, 259, 237, 328, 366, 0, 276,
+	337, 301, 238, 300, 329, 365, 364, 247, 391, 397,
+	398, 403, 0, 404, 0, 0, 0, 412, 424, 425,
+	426, 428, 429, 430, 431, 0, 0, 0, 0, 406,
+	0, 0, 419, 420, 421, 0, 0, 0, 0, 396,
+	274, 231, 232, 439, 0, 320, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 316, 395, 0, 0, 0,
+	0, 438, 0, 0, 0, 0, 0, 437, 326, 0,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 352, 376, 389, 407, 410, 0, 0,
+	0, 236, 409, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 388, 0, 0, 0, 0, 0,
+	405, 310, 311, 312, 313, 277, 0, 254, 408, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 401, 402, 273, 279,
+	427, 281, 253, 325, 275, 386, 288, 0, 413, 0,
+	414, 0, 0, 0, 0, 317, 285, 349, 289, 295,
+	338, 385, 323, 343, 251, 375, 350, 299, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	293, 0, 334, 272, 190, 191, 192, 193, 194, 195,
 	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	155, 351, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 107, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 152, 1621, 0,
-	177, 0, 0, 0, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 125,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 155, 351, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	107, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 152, 1612, 0, 177, 0, 0, 0, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 125, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 155, 351,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 107, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1528, 0, 0, 177, 0,
-	0, 0, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 390, 0, 334, 316, 0, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 0, 0, 0, 0, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 125, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 351, 0, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 0, 0, 0,
-	343, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 750, 751, 0, 0, 0, 0, 242, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 754,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 0, 361, 389,
-	254, 380, 732, 372, 237, 731, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 405, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	374, 0, 0, 0, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 390, 0, 334, 316, 0, 0,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 308, 385, 0, 0, 0, 0,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 395,
-	302, 303, 304, 305, 270, 0, 247, 398, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 752, 747, 748, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 749, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 184, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 0, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	0, 221, 222, 223, 224, 351, 0, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 2170, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 2173, 0, 0, 2172, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 1145, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	1143, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1141, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	1139, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 1143, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1141, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
+	206, 207, 208, 209, 210, 211, 0, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 0, 227, 228, 229, 230, 0, 0, 0, 392,
+	393, 394, 416, 377, 0, 436, 160, 360, 51, 152,
+	128, 0, 0, 0, 0, 0, 0, 435, 322, 0,
+	456, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 461, 0, 0, 182, 0,
+	0, 0, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 459, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 0, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 457, 460, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 52, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 129, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 0, 360, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 0, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 844, 0, 0, 0, 0,
+	0, 0, 0, 0, 267, 0, 0, 292, 0, 0,
+	0, 0, 0, 0, 351, 306, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 182, 0, 0, 0, 0,
+	0, 0, 249, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 252, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 832, 0,
+	0, 0, 0, 0, 0, 240, 356, 373, 250, 347,
+	387, 255, 354, 245, 321, 344, 0, 0, 1829, 1831,
+	1832, 1833, 1834, 1835, 1836, 0, 1840, 1837, 1838, 1839,
+	319, 0, 1824, 1825, 1826, 1827, 830, 1808, 1830, 0,
+	1809, 318, 1810, 1811, 1812, 1813, 1814, 1815, 1816, 1817,
+	1818, 1819, 1820, 1821, 1822, 1828, 330, 296, 331, 284,
+	308, 307, 309, 857, 859, 861, 863, 866, 411, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 383, 0, 0, 0,
+	0, 0, 0, 355, 0, 0, 291, 0, 0, 0,
+	1823, 0, 342, 324, 0, 0, 0, 340, 294, 368,
+	332, 374, 357, 382, 336, 333, 234, 358, 264, 305,
+	422, 423, 246, 248, 260, 266, 268, 270, 271, 314,
+	315, 327, 346, 361, 362, 363, 263, 256, 341, 257,
+	280, 258, 235, 282, 239, 359, 384, 348, 259, 237,
+	328, 366, 0, 276, 337, 301, 238, 300, 329, 365,
+	364, 247, 391, 397, 398, 403, 0, 404, 0, 0,
+	0, 412, 424, 425, 426, 428, 429, 430, 431, 0,
+	0, 0, 0, 406, 0, 0, 419, 420, 421, 0,
+	0, 0, 0, 396, 274, 231, 232, 439, 0, 320,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 316,
+	395, 0, 0, 0, 0, 438, 0, 0, 0, 0,
+	0, 437, 326, 0, 345, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 352, 376, 389,
+	407, 410, 0, 0, 0, 236, 409, 0, 0, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 388, 0,
+	0, 0, 0, 0, 405, 310, 311, 312, 313, 277,
+	0, 254, 408, 335, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	401, 402, 273, 279, 427, 281, 253, 325, 275, 386,
+	288, 0, 413, 0, 414, 0, 0, 0, 0, 317,
+	285, 349, 289, 295, 338, 385, 323, 343, 251, 375,
+	350, 299, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 226, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 233, 856, 293, 0, 334, 272, 190, 191,
 	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2814, 0, 177, 588, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 1143,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2528,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 0, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
+	0, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 0, 227, 228, 229, 230,
+	360, 0, 0, 392, 393, 394, 416, 377, 0, 436,
+	0, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 0, 0, 0, 0, 0, 0, 0, 0,
+	267, 0, 0, 292, 0, 0, 0, 0, 0, 0,
+	351, 306, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 182, 0, 0, 0, 0, 0, 0, 249, 183,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 252,
+	1897, 1900, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 356, 373, 250, 347, 387, 255, 354, 245,
+	321, 344, 0, 0, 242, 371, 353, 303, 286, 287,
+	241, 0, 339, 265, 278, 262, 319, 0, 370, 399,
+	261, 390, 0, 381, 244, 0, 380, 318, 367, 372,
+	304, 298, 243, 369, 302, 297, 290, 269, 415, 417,
+	418, 283, 330, 296, 331, 284, 308, 307, 309, 0,
+	0, 0, 0, 0, 411, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1901, 383, 0, 0, 0, 1896, 0, 1895, 355,
+	1893, 1898, 291, 0, 0, 0, 400, 0, 342, 324,
+	0, 0, 0, 340, 294, 368, 332, 374, 357, 382,
+	336, 333, 234, 358, 264, 305, 422, 423, 246, 248,
+	260, 266, 268, 270, 271, 314, 315, 327, 346, 361,
+	362, 363, 263, 256, 341, 257, 280, 258, 235, 282,
+	239, 359, 384, 348, 259, 237, 328, 366, 1899, 276,
+	337, 301, 238, 300, 329, 365, 364, 247, 391, 397,
+	398, 403, 0, 404, 0, 0, 0, 412, 424, 425,
+	426, 428, 429, 430, 431, 0, 0, 0, 0, 406,
+	0, 0, 419, 420, 421, 0, 0, 0, 0, 396,
+	274, 231, 232, 439, 0, 320, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 316, 395, 0, 0, 0,
+	0, 438, 0, 0, 0, 0, 0, 437, 326, 0,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 352, 376, 389, 407, 410, 0, 0,
+	0, 236, 409, 0, 0, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 388, 0, 0, 0, 0, 0,
+	405, 310, 311, 312, 313, 277, 0, 254, 408, 335,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 401, 402, 273, 279,
+	427, 281, 253, 325, 275, 386, 288, 0, 413, 0,
+	414, 0, 0, 0, 0, 317, 285, 349, 289, 295,
+	338, 385, 323, 343, 251, 375, 350, 299, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 233, 0,
+	293, 0, 334, 272, 190, 191, 192, 193, 194, 195,
 	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 1143, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1141, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1898, 0, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 1900, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
+	206, 207, 208, 209, 210, 211, 0, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 0, 227, 228, 229, 230, 360, 0, 0, 392,
+	393, 394, 416, 377, 0, 436, 0, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 0, 0,
+	0, 1959, 0, 0, 0, 0, 267, 0, 0, 292,
+	0, 0, 0, 0, 0, 0, 351, 306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 182, 0, 0,
+	1961, 0, 0, 0, 249, 183, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 252, 0, 0, 951, 952,
+	953, 950, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 240, 356, 373,
+	250, 347, 387, 255, 354, 245, 321, 344, 0, 0,
+	242, 371, 353, 303, 286, 287, 241, 0, 339, 265,
+	278, 262, 319, 0, 370, 399, 261, 390, 0, 381,
+	244, 0, 380, 318, 367, 372, 304, 298, 243, 369,
+	302, 297, 290, 269, 415, 417, 418, 283, 330, 296,
+	331, 284, 308, 307, 309, 0, 0, 0, 0, 0,
+	411, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 383, 0,
+	0, 0, 0, 0, 0, 355, 0, 0, 291, 0,
+	0, 0, 400, 0, 342, 324, 0, 0, 0, 340,
+	294, 368, 332, 374, 357, 382, 336, 333, 234, 358,
+	264, 305, 422, 423, 246, 248, 260, 266, 268, 270,
+	271, 314, 315, 327, 346, 361, 362, 363, 263, 256,
+	341, 257, 280, 258, 235, 282, 239, 359, 384, 348,
+	259, 237, 328, 366, 0, 276, 337, 301, 238, 300,
+	329, 365, 364, 247, 391, 397, 398, 403, 0, 404,
+	0, 0, 0, 412, 424, 425, 426, 428, 429, 430,
+	431, 0, 0, 0, 0, 406, 0, 0, 419, 420,
+	421, 0, 0, 0, 0, 396, 274, 231, 232, 439,
+	0, 320, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 316, 395, 0, 0, 0, 0, 438, 0, 0,
+	0, 0, 0, 437, 326, 0, 345, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 352,
+	376, 389, 407, 410, 0, 0, 0, 236, 409, 0,
+	0, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	388, 0, 0, 0, 0, 0, 405, 310, 311, 312,
+	313, 277, 0, 254, 408, 335, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 401, 402, 273, 279, 427, 281, 253, 325,
+	275, 386, 288, 0, 413, 0, 414, 0, 0, 0,
+	0, 317, 285, 349, 289, 295, 338, 385, 323, 343,
+	251, 375, 350, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 226, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 233, 0, 293, 0, 334, 272,
 	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 351, 0, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 1913, 0, 284, 0, 0,
-	0, 0, 0, 0, 343, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 1143, 0,
-	0, 0, 242, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 0, 361, 389, 254, 380, 0, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 405, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 374, 0, 0, 0, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 390, 0,
-	334, 316, 0, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 328, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 308, 385,
-	0, 0, 0, 0, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 0, 370, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 395, 302, 303, 304, 305, 270, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 0,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 0, 221, 222, 223, 224, 351,
-	0, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 0, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2892, 0, 177, 0,
-	0, 0, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 390, 0, 334, 316, 0, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 0, 0, 0, 0, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 0, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 0, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 0, 227, 228,
+	229, 230, 360, 0, 0, 392, 393, 394, 416, 377,
+	0, 436, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 0, 0, 0, 1628, 0, 0,
+	0, 0, 267, 0, 0, 292, 0, 0, 0, 0,
+	0, 0, 351, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 182, 0, 0, 1629, 0, 0, 0,
+	249, 183, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 0, 951, 952, 953, 950, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 356, 373, 250, 347, 387, 255,
+	354, 245, 321, 344, 0, 0, 242, 371, 353, 303,
+	286, 287, 241, 0, 339, 265, 278, 262, 319, 0,
+	370, 399, 261, 390, 0, 381, 244, 0, 380, 318,
+	367, 372, 304, 298, 243, 369, 302, 297, 290, 269,
+	415, 417, 418, 283, 330, 296, 331, 284, 308, 307,
+	309, 0, 0, 0, 0, 0, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 0, 0, 0, 0, 0,
+	0, 355, 0, 0, 291, 0, 0, 0, 400, 0,
+	342, 324, 0, 0, 0, 340, 294, 368, 332, 374,
+	357, 382, 336, 333, 234, 358, 264, 305, 422, 423,
+	246, 248, 260, 266, 268, 270, 271, 314, 315, 327,
+	346, 361, 362, 363, 263, 256, 341, 257, 280, 258,
+	235, 282, 239, 359, 384, 348, 259, 237, 328, 366,
+	0, 276, 337, 301, 238, 300, 329, 365, 364, 247,
+	391, 397, 398, 403, 0, 404, 0, 0, 0, 412,
+	424, 425, 426, 428, 429, 430, 431, 0, 0, 0,
+	0, 406, 0, 0, 419, 420, 421, 0, 0, 0,
+	0, 396, 274, 231, 232, 439, 0, 320, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 395, 0,
+	0, 0, 0, 438, 0, 0, 0, 0, 0, 437,
+	326, 0, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 352, 376, 389, 407, 410,
+	0, 0, 0, 236, 409, 0, 0, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 388, 0, 0, 0,
+	0, 0, 405, 310, 311, 312, 313, 277, 0, 254,
+	408, 335, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 401, 402,
+	273, 279, 427, 281, 253, 325, 275, 386, 288, 0,
+	413, 0, 414, 0, 0, 0, 0, 317, 285, 349,
+	289, 295, 338, 385, 323, 343, 251, 375, 350, 299,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 226, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 293, 0, 334, 272, 190, 191, 192, 193,
 	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 351, 0, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 0, 0, 0,
-	343, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 588, 0, 0, 0, 0, 0, 242, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 0, 361, 389,
-	254, 380, 0, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 405, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	374, 0, 0, 0, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 390, 0, 334, 316, 0, 0,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 308, 385, 0, 0, 0, 0,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 395,
-	302, 303, 304, 305, 270, 0, 247, 398, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 184, 185, 186, 187, 188, 189, 190,
+	204, 205, 206, 207, 208, 209, 210, 211, 0, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 0, 227, 228, 229, 230, 360, 0,
+	0, 392, 393, 394, 416, 377, 0, 436, 0, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	0, 0, 0, 0, 0, 0, 0, 0, 267, 766,
+	0, 292, 0, 0, 0, 0, 0, 0, 351, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 182,
+	774, 775, 0, 0, 0, 0, 249, 183, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 779, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	356, 776, 250, 347, 387, 255, 354, 245, 321, 344,
+	0, 0, 242, 371, 353, 303, 286, 287, 241, 0,
+	339, 265, 278, 262, 319, 0, 370, 399, 261, 390,
+	756, 381, 244, 755, 380, 318, 367, 372, 304, 298,
+	243, 369, 302, 297, 290, 269, 415, 417, 418, 283,
+	330, 296, 331, 284, 308, 307, 309, 0, 0, 0,
+	0, 0, 411, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	383, 0, 0, 0, 0, 0, 0, 355, 0, 0,
+	291, 0, 0, 0, 400, 0, 342, 324, 0, 0,
+	0, 340, 294, 368, 332, 374, 357, 382, 764, 333,
+	234, 358, 264, 305, 422, 423, 246, 248, 260, 266,
+	268, 270, 271, 314, 315, 327, 346, 361, 362, 363,
+	263, 256, 341, 257, 280, 258, 235, 282, 239, 359,
+	384, 348, 259, 237, 328, 366, 0, 276, 337, 301,
+	238, 300, 329, 365, 364, 247, 391, 397, 398, 403,
+	0, 404, 0, 0, 0, 412, 424, 425, 426, 428,
+	429, 430, 431, 0, 0, 0, 0, 406, 0, 0,
+	419, 420, 421, 0, 0, 0, 0, 396, 274, 231,
+	232, 439, 0, 320, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 316, 395, 0, 0, 0, 0, 438,
+	0, 0, 0, 0, 0, 437, 326, 0, 345, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 352, 376, 389, 407, 410, 0, 0, 0, 236,
+	409, 0, 0, 0, 0, 0, 0, 765, 379, 0,
+	0, 0, 388, 0, 0, 0, 0, 0, 768, 310,
+	311, 312, 313, 277, 0, 254, 408, 335, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 401, 402, 273, 279, 427, 281,
+	253, 325, 275, 386, 288, 0, 413, 0, 414, 0,
+	0, 0, 0, 777, 771, 772, 289, 295, 338, 385,
+	323, 343, 251, 375, 350, 773, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 226,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 233, 0, 293, 0,
+	334, 272, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	208, 209, 210, 211, 0, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 0,
+	227, 228, 229, 230, 160, 360, 0, 392, 393, 394,
+	416, 377, 0, 436, 0, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 110, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 1674, 0, 182, 0, 0, 0,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 129, 334, 272, 190,
 	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 0, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	0, 221, 222, 223, 224, 351, 0, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2829, 0, 0, 177, 0, 0, 0, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	2770, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2609, 0, 0,
-	177, 0, 0, 0, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 2653, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 0, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 328, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 0, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1528, 0, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 160, 360, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 0, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 267, 0, 0, 292, 0, 0, 0, 110,
+	0, 0, 351, 306, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	157, 1665, 0, 182, 0, 0, 0, 0, 0, 0,
+	249, 183, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 252, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 356, 373, 250, 347, 387, 255,
+	354, 245, 321, 344, 0, 0, 242, 371, 353, 303,
+	286, 287, 241, 0, 339, 265, 278, 262, 319, 0,
+	370, 399, 261, 390, 0, 381, 244, 0, 380, 318,
+	367, 372, 304, 298, 243, 369, 302, 297, 290, 269,
+	415, 417, 418, 283, 330, 296, 331, 284, 308, 307,
+	309, 0, 0, 0, 0, 0, 411, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 0, 0, 0, 0, 0,
+	0, 355, 0, 0, 291, 0, 0, 0, 400, 0,
+	342, 324, 0, 0, 0, 340, 294, 368, 332, 374,
+	357, 382, 336, 333, 234, 358, 264, 305, 422, 423,
+	246, 248, 260, 266, 268, 270, 271, 314, 315, 327,
+	346, 361, 362, 363, 263, 256, 341, 257, 280, 258,
+	235, 282, 239, 359, 384, 348, 259, 237, 328, 366,
+	0, 276, 337, 301, 238, 300, 329, 365, 364, 247,
+	391, 397, 398, 403, 0, 404, 0, 0, 0, 412,
+	424, 425, 426, 428, 429, 430, 431, 0, 0, 0,
+	0, 406, 0, 0, 419, 420, 421, 0, 0, 0,
+	0, 396, 274, 231, 232, 439, 0, 320, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 316, 395, 0,
+	0, 0, 0, 438, 0, 0, 0, 0, 0, 437,
+	326, 0, 345, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 352, 376, 389, 407, 410,
+	0, 0, 0, 236, 409, 0, 0, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 388, 0, 0, 0,
+	0, 0, 405, 310, 311, 312, 313, 277, 0, 254,
+	408, 335, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 401, 402,
+	273, 279, 427, 281, 253, 325, 275, 386, 288, 0,
+	413, 0, 414, 0, 0, 0, 0, 317, 285, 349,
+	289, 295, 338, 385, 323, 343, 251, 375, 350, 299,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 226, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	233, 0, 293, 129, 334, 272, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
+	204, 205, 206, 207, 208, 209, 210, 211, 0, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 0, 227, 228, 229, 230, 160, 360,
+	0, 392, 393, 394, 416, 377, 0, 436, 0, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 0, 292, 0, 0, 0, 110, 0, 0, 351,
+	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1577, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 249, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 0, 370, 399, 261,
+	390, 0, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 415, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 0, 0, 0, 0, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 400, 0, 342, 324, 0,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 336,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 0, 320, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 395, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 405,
+	310, 311, 312, 313, 277, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	129, 334, 272, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 0, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	0, 227, 228, 229, 230, 360, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 182, 774, 775, 0,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 779, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 776, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 756, 381, 244,
+	755, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	777, 771, 772, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 773, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 2240, 0, 0, 0, 0, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 2243, 0, 0, 2242, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 360, 0, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 267, 1181, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 1179, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1177, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 0, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 277, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 360, 0, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 1175, 0, 292, 0, 0, 0,
+	0, 0, 0, 351, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 182, 0, 0, 1179, 0, 0,
+	0, 249, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1177, 0, 0,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	0, 370, 399, 261, 390, 0, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 415, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 400,
+	0, 342, 324, 0, 0, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 0, 320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 395,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 405, 310, 311, 312, 313, 277, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 190, 191, 192,
 	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2447, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 351, 0, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 0, 284, 0, 0,
-	0, 0, 0, 0, 343, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 2320, 0,
-	0, 0, 242, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 0, 361, 389, 254, 380, 0, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 405, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 374, 0, 0, 0, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 390, 0,
-	334, 316, 0, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 328, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 308, 385,
-	0, 0, 0, 0, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 0, 370, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 395, 302, 303, 304, 305, 270, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 0,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 0, 221, 222, 223, 224, 351,
-	0, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 0, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2251, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 390, 0, 334, 316, 0, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 253, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 0, 0, 0, 0, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 0,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 227, 0, 285, 0, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 351, 0, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 0, 284, 0, 0, 0, 0, 0, 0,
-	343, 298, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 1143, 0, 0, 0, 242, 178,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 233, 348, 364, 243, 339, 377, 248, 346, 238,
-	313, 336, 0, 0, 235, 362, 345, 295, 278, 279,
-	234, 0, 331, 258, 271, 255, 311, 0, 361, 389,
-	254, 380, 0, 372, 237, 0, 371, 310, 358, 363,
-	296, 290, 236, 360, 294, 289, 282, 262, 405, 275,
-	322, 288, 323, 276, 300, 299, 301, 0, 0, 0,
-	0, 0, 401, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	374, 0, 0, 0, 0, 0, 0, 347, 0, 0,
-	283, 0, 0, 0, 390, 0, 334, 316, 0, 0,
-	0, 332, 286, 359, 324, 365, 349, 373, 328, 325,
-	228, 350, 257, 297, 239, 241, 253, 259, 261, 263,
-	264, 306, 307, 319, 338, 352, 353, 354, 256, 249,
-	333, 250, 273, 251, 229, 340, 252, 231, 320, 357,
-	0, 269, 329, 293, 232, 292, 321, 356, 355, 240,
-	381, 387, 388, 393, 0, 394, 0, 0, 0, 402,
-	407, 408, 409, 411, 412, 413, 414, 0, 0, 0,
-	0, 396, 0, 0, 0, 0, 0, 0, 386, 267,
-	225, 226, 421, 0, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 308, 385, 0, 0, 0, 0,
-	420, 0, 0, 0, 0, 0, 419, 318, 0, 337,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 344, 367, 379, 397, 400, 0, 0, 0,
-	230, 399, 0, 0, 0, 0, 0, 0, 0, 370,
-	0, 0, 0, 378, 0, 0, 0, 0, 0, 395,
-	302, 303, 304, 305, 270, 0, 247, 398, 327, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 391, 392, 266, 272, 410,
-	274, 246, 317, 268, 376, 280, 0, 403, 0, 404,
-	0, 0, 0, 0, 309, 277, 341, 281, 287, 330,
-	375, 315, 335, 244, 366, 342, 291, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	220, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 227, 0, 285,
-	0, 326, 265, 184, 185, 186, 187, 188, 189, 190,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 0,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 0, 227, 228, 229, 230, 360,
+	0, 0, 392, 393, 394, 416, 377, 0, 436, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 0, 292, 0, 0, 0, 0, 0, 0, 351,
+	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2926, 0,
+	182, 607, 0, 0, 0, 0, 0, 249, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 0, 370, 399, 261,
+	390, 0, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 415, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 0, 0, 0, 0, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 400, 0, 342, 324, 0,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 336,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 0, 320, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 395, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 405,
+	310, 311, 312, 313, 277, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	0, 334, 272, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 0, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	0, 227, 228, 229, 230, 360, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 182, 0, 0, 1179,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2608,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
 	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 0, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	0, 221, 222, 223, 224, 351, 0, 0, 382, 383,
-	384, 406, 368, 0, 418, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 177, 0, 0, 1900, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1636, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 328, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 0, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1928, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 1926, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 0, 0, 0,
-	382, 383, 384, 406, 368, 351, 418, 0, 0, 1800,
-	0, 0, 0, 0, 0, 0, 314, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 0, 284, 0, 0, 0,
-	0, 0, 0, 343, 298, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 177, 0, 0, 0, 0, 0,
-	0, 242, 178, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 233, 348, 364, 243, 339, 377,
-	248, 346, 238, 313, 336, 0, 0, 235, 362, 345,
-	295, 278, 279, 234, 0, 331, 258, 271, 255, 311,
-	0, 361, 389, 254, 380, 0, 372, 237, 0, 371,
-	310, 358, 363, 296, 290, 236, 360, 294, 289, 282,
-	262, 405, 275, 322, 288, 323, 276, 300, 299, 301,
-	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 374, 0, 0, 0, 0, 0, 0,
-	347, 0, 0, 283, 0, 0, 0, 390, 0, 334,
-	316, 0, 0, 0, 332, 286, 359, 324, 365, 349,
-	373, 328, 325, 228, 350, 257, 297, 239, 241, 253,
-	259, 261, 263, 264, 306, 307, 319, 338, 352, 353,
-	354, 256, 249, 333, 250, 273, 251, 229, 340, 252,
-	231, 320, 357, 0, 269, 329, 293, 232, 292, 321,
-	356, 355, 240, 381, 387, 388, 393, 0, 394, 0,
-	0, 0, 402, 407, 408, 409, 411, 412, 413, 414,
-	0, 0, 0, 0, 396, 0, 0, 0, 0, 0,
-	0, 386, 267, 225, 226, 421, 0, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 308, 385, 0,
-	0, 0, 0, 420, 0, 0, 0, 0, 0, 419,
-	318, 0, 337, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 344, 367, 379, 397, 400,
-	0, 0, 0, 230, 399, 0, 0, 0, 0, 0,
-	0, 0, 370, 0, 0, 0, 378, 0, 0, 0,
-	0, 0, 395, 302, 303, 304, 305, 270, 0, 247,
-	398, 327, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 391, 392,
-	266, 272, 410, 274, 246, 317, 268, 376, 280, 0,
-	403, 0, 404, 0, 0, 0, 0, 309, 277, 341,
-	281, 287, 330, 375, 315, 335, 244, 366, 342, 291,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 220, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	227, 0, 285, 0, 326, 265, 184, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 0, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 0, 221, 222, 223, 224, 351, 0,
-	0, 382, 383, 384, 406, 368, 0, 418, 0, 314,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 0, 284,
-	0, 0, 0, 0, 0, 0, 343, 298, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	1143, 0, 0, 0, 242, 178, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 233, 348, 364,
-	243, 339, 377, 248, 346, 238, 313, 336, 0, 0,
-	235, 362, 345, 295, 278, 279, 234, 0, 331, 258,
-	271, 255, 311, 0, 361, 389, 254, 380, 0, 372,
-	237, 0, 371, 310, 358, 363, 296, 290, 236, 360,
-	294, 289, 282, 262, 405, 275, 322, 288, 323, 276,
-	300, 299, 301, 0, 0, 0, 0, 0, 401, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 0, 347, 0, 0, 283, 0, 0, 0,
-	390, 0, 334, 316, 0, 0, 0, 332, 286, 359,
-	324, 365, 349, 373, 1455, 325, 228, 350, 257, 297,
-	239, 241, 253, 259, 261, 263, 264, 306, 307, 319,
-	338, 352, 353, 354, 256, 249, 333, 250, 273, 251,
-	229, 340, 252, 231, 320, 357, 0, 269, 329, 293,
-	232, 292, 321, 356, 355, 240, 381, 387, 388, 393,
-	0, 394, 0, 0, 0, 402, 407, 408, 409, 411,
-	412, 413, 414, 0, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 386, 267, 225, 226, 421, 0,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	308, 385, 0, 0, 0, 0, 420, 0, 0, 0,
-	0, 0, 419, 318, 0, 337, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 344, 367,
-	379, 397, 400, 0, 0, 0, 230, 399, 0, 0,
-	0, 0, 0, 0, 0, 370, 0, 0, 0, 378,
-	0, 0, 0, 0, 0, 395, 302, 303, 304, 305,
-	270, 0, 247, 398, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 391, 392, 266, 272, 410, 274, 246, 317, 268,
-	376, 280, 0, 403, 0, 404, 0, 0, 0, 0,
-	309, 277, 341, 281, 287, 330, 375, 315, 335, 244,
-	366, 342, 291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 220, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 227, 0, 285, 0, 326, 265, 184,
-	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 0, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 218, 219, 0, 221, 222, 223,
-	224, 351, 0, 0, 382, 383, 384, 406, 368, 0,
-	418, 0, 314, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 0, 284, 0, 0, 0, 0, 0, 0, 343,
-	298, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	177, 0, 0, 0, 0, 0, 0, 242, 178, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	233, 348, 364, 243, 339, 377, 248, 346, 238, 313,
-	336, 0, 0, 235, 362, 345, 295, 278, 279, 234,
-	0, 331, 258, 271, 255, 311, 0, 361, 389, 254,
-	380, 0, 372, 237, 0, 371, 310, 358, 363, 296,
-	290, 236, 360, 294, 289, 282, 262, 405, 275, 322,
-	288, 323, 276, 300, 299, 301, 0, 0, 0, 0,
-	0, 401, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 374,
-	0, 0, 1166, 0, 0, 0, 347, 0, 0, 283,
-	0, 0, 0, 390, 0, 334, 316, 0, 0, 0,
-	332, 286, 359, 324, 365, 349, 373, 328, 325, 228,
-	350, 257, 297, 239, 241, 253, 259, 261, 263, 264,
-	306, 307, 319, 338, 352, 353, 354, 256, 249, 333,
-	250, 273, 251, 229, 340, 252, 231, 320, 357, 0,
-	269, 329, 293, 232, 292, 321, 356, 355, 240, 381,
-	387, 388, 393, 0, 394, 0, 0, 0, 402, 407,
-	408, 409, 411, 412, 413, 414, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 386, 267, 225,
-	226, 421, 0, 312, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 308, 385, 0, 0, 0, 0, 420,
-	0, 0, 0, 0, 0, 419, 318, 0, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 344, 367, 379, 397, 400, 0, 0, 0, 230,
-	399, 0, 0, 0, 0, 0, 0, 0, 370, 0,
-	0, 0, 378, 0, 0, 0, 0, 0, 395, 302,
-	303, 304, 305, 270, 0, 247, 398, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 391, 392, 266, 272, 410, 274,
-	246, 317, 268, 376, 280, 0, 403, 0, 404, 0,
-	0, 0, 0, 309, 277, 341, 281, 287, 330, 375,
-	315, 335, 244, 366, 342, 291, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 220,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 227, 0, 285, 0,
-	326, 265, 184, 185, 186, 187, 188, 189, 190, 191,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 0, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 218, 219, 0,
-	221, 222, 223, 224, 351, 0, 0, 382, 383, 384,
-	406, 368, 0, 418, 0, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 0, 284, 0, 0, 0, 0,
-	0, 0, 343, 298, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 0, 0, 0,
-	242, 178, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 233, 348, 364, 243, 339, 377, 248,
-	346, 238, 313, 336, 0, 0, 235, 362, 345, 295,
-	278, 279, 234, 0, 331, 258, 271, 255, 311, 0,
-	361, 389, 254, 380, 0, 372, 237, 0, 371, 310,
-	358, 363, 296, 290, 236, 360, 294, 289, 282, 262,
-	405, 275, 322, 288, 323, 276, 300, 299, 301, 0,
-	0, 0, 0, 0, 401, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 347,
-	0, 0, 283, 0, 0, 0, 390, 0, 334, 316,
-	0, 0, 0, 332, 286, 359, 324, 365, 349, 373,
-	328, 325, 228, 350, 257, 297, 239, 241, 253, 259,
-	261, 263, 264, 306, 307, 319, 338, 352, 353, 354,
-	256, 249, 333, 250, 273, 251, 229, 340, 252, 231,
-	320, 357, 0, 269, 329, 293, 232, 292, 321, 356,
-	355, 240, 381, 387, 388, 393, 0, 394, 0, 0,
-	0, 402, 407, 408, 409, 411, 412, 413, 414, 0,
-	0, 0, 0, 396, 0, 0, 0, 0, 0, 0,
-	386, 267, 225, 226, 421, 0, 312, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 308, 385, 0, 0,
-	0, 0, 420, 0, 0, 0, 0, 0, 419, 318,
-	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 344, 367, 379, 397, 400, 0,
-	0, 0, 230, 399, 0, 0, 0, 0, 0, 0,
-	0, 370, 0, 0, 0, 378, 0, 0, 0, 0,
-	0, 395, 302, 303, 304, 305, 270, 0, 247, 398,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 391, 392, 266,
-	272, 410, 274, 246, 317, 268, 376, 280, 0, 403,
-	0, 404, 0, 0, 0, 0, 309, 277, 341, 281,
-	287, 330, 375, 315, 335, 244, 366, 342, 291, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 220, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 688, 0, 0, 0, 227,
-	0, 285, 0, 326, 265, 184, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 0, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 0, 221, 222, 223, 224, 351, 0, 0,
-	382, 383, 384, 406, 368, 0, 418, 0, 314, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 0, 284, 0,
-	0, 0, 0, 0, 0, 343, 298, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 177, 0, 0, 0,
-	0, 0, 0, 242, 178, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 233, 348, 364, 243,
-	339, 377, 248, 346, 238, 313, 336, 0, 0, 235,
-	362, 345, 295, 278, 279, 234, 0, 331, 258, 271,
-	255, 311, 0, 361, 389, 254, 380, 0, 372, 237,
-	0, 371, 310, 358, 363, 296, 290, 236, 360, 294,
-	289, 282, 262, 405, 275, 322, 288, 323, 276, 300,
-	299, 301, 0, 0, 0, 0, 0, 401, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 347, 0, 0, 283, 0, 0, 0, 390,
-	0, 334, 316, 0, 0, 0, 332, 286, 359, 324,
-	365, 349, 373, 457, 325, 228, 350, 257, 297, 239,
-	241, 253, 259, 261, 263, 264, 306, 307, 319, 338,
-	352, 353, 354, 256, 249, 333, 250, 273, 251, 229,
-	340, 252, 231, 320, 357, 0, 269, 329, 293, 232,
-	292, 321, 356, 355, 240, 381, 387, 388, 393, 0,
-	394, 0, 0, 0, 402, 407, 408, 409, 411, 412,
-	413, 414, 0, 0, 0, 0, 396, 0, 0, 0,
-	0, 0, 0, 386, 267, 225, 226, 421, 0, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 308,
-	385, 0, 0, 0, 0, 420, 0, 0, 0, 0,
-	0, 419, 318, 0, 337, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 344, 367, 379,
-	397, 400, 0, 0, 0, 230, 399, 0, 0, 0,
-	0, 0, 0, 458, 370, 0, 0, 0, 378, 0,
-	0, 0, 0, 0, 395, 302, 303, 304, 305, 270,
-	0, 247, 398, 327, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	391, 392, 266, 272, 410, 274, 246, 317, 268, 376,
-	280, 0, 403, 0, 404, 0, 0, 0, 0, 309,
-	277, 341, 281, 287, 330, 375, 315, 335, 244, 366,
-	342, 291, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 220, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 227, 0, 285, 0, 326, 265, 184, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	0, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 0, 221, 222, 223, 224,
-	351, 0, 0, 382, 383, 384, 406, 368, 0, 418,
-	0, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	0, 284, 0, 0, 0, 0, 0, 0, 343, 298,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 0, 0, 0, 0, 242, 178, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 182, 0, 0, 1179, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1177, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
-	348, 364, 243, 339, 377, 248, 346, 238, 313, 336,
-	0, 0, 235, 362, 345, 295, 278, 279, 234, 0,
-	331, 258, 271, 255, 311, 0, 361, 389, 254, 380,
-	0, 372, 237, 0, 371, 310, 358, 363, 296, 290,
-	236, 360, 294, 289, 282, 262, 405, 275, 322, 288,
-	323, 276, 300, 299, 301, 0, 0, 0, 0, 0,
-	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 436, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 347, 0, 0, 283, 0,
-	0, 0, 390, 0, 334, 316, 0, 0, 0, 332,
-	286, 359, 324, 365, 349, 373, 328, 325, 228, 350,
-	257, 297, 239, 241, 253, 259, 261, 263, 264, 306,
-	307, 319, 338, 352, 353, 354, 256, 249, 333, 250,
-	273, 251, 229, 340, 252, 231, 320, 357, 0, 269,
-	329, 293, 232, 292, 321, 356, 355, 240, 381, 387,
-	388, 393, 0, 394, 0, 0, 0, 402, 407, 408,
-	409, 411, 412, 413, 414, 0, 0, 0, 0, 396,
-	0, 0, 0, 0, 0, 0, 386, 267, 225, 226,
-	421, 0, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 308, 385, 0, 0, 0, 0, 420, 0,
-	0, 0, 0, 0, 419, 318, 0, 337, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	344, 367, 379, 397, 400, 0, 0, 0, 230, 399,
-	0, 0, 0, 0, 0, 0, 0, 370, 0, 0,
-	0, 378, 0, 0, 0, 0, 0, 395, 302, 303,
-	304, 305, 270, 0, 247, 398, 327, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 391, 392, 266, 272, 410, 274, 246,
-	317, 268, 376, 280, 0, 403, 0, 404, 0, 0,
-	0, 0, 309, 277, 341, 281, 287, 330, 375, 315,
-	335, 244, 366, 342, 291, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 220, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 227, 0, 285, 0, 326,
-	265, 184, 185, 186, 187, 188, 189, 190, 191, 192,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 360, 0, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 1959, 0, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 1961, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 0, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 277, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 360, 0, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 2272, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 0, 292, 0, 0, 0,
+	0, 0, 0, 351, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 182, 0, 0, 1961, 0, 0,
+	0, 249, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	0, 370, 399, 261, 390, 0, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 415, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 400,
+	0, 342, 324, 0, 0, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 0, 320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 395,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 405, 310, 311, 312, 313, 277, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 190, 191, 192,
 	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 0, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 0, 221,
-	222, 223, 224, 351, 0, 0, 382, 383, 384, 406,
-	368, 0, 418, 0, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	426, 260, 0, 0, 284, 0, 0, 0, 0, 0,
-	0, 343, 298, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 177, 0, 0, 0, 0, 0, 0, 242,
-	178, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 233, 348, 364, 243, 339, 377, 248, 346,
-	238, 313, 336, 0, 0, 235, 362, 345, 295, 278,
-	279, 234, 0, 331, 258, 271, 255, 311, 0, 361,
-	389, 254, 380, 0, 372, 237, 0, 371, 310, 358,
-	363, 296, 290, 236, 360, 294, 289, 282, 262, 405,
-	275, 322, 288, 323, 276, 300, 299, 301, 0, 0,
-	0, 0, 0, 401, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 347, 0,
-	0, 283, 0, 0, 0, 390, 0, 334, 316, 0,
-	0, 0, 332, 286, 359, 324, 365, 349, 373, 328,
-	325, 228, 350, 257, 297, 239, 241, 253, 259, 261,
-	263, 264, 306, 307, 319, 338, 352, 353, 354, 256,
-	249, 333, 250, 273, 251, 229, 340, 252, 231, 320,
-	357, 0, 269, 329, 293, 232, 292, 321, 356, 355,
-	240, 381, 387, 388, 393, 0, 394, 0, 0, 0,
-	402, 407, 408, 409, 411, 412, 413, 414, 0, 0,
-	0, 0, 396, 0, 0, 0, 0, 0, 0, 386,
-	267, 225, 226, 421, 0, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 308, 385, 0, 0, 0,
-	0, 420, 0, 0, 0, 0, 0, 419, 318, 0,
-	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 344, 367, 379, 397, 400, 0, 0,
-	0, 230, 399, 0, 0, 0, 0, 0, 0, 0,
-	370, 0, 0, 0, 378, 0, 0, 0, 0, 0,
-	395, 302, 303, 304, 305, 270, 0, 247, 398, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 391, 392, 266, 272,
-	410, 274, 246, 317, 268, 376, 280, 0, 403, 0,
-	404, 0, 0, 0, 0, 309, 277, 341, 281, 287,
-	330, 375, 315, 335, 244, 366, 342, 291, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 227, 0,
-	285, 0, 326, 265, 184, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 0, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 0, 221, 222, 223, 224, 351, 0, 0, 382,
-	383, 384, 406, 368, 0, 418, 0, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 0, 284, 0, 0,
-	0, 0, 0, 0, 343, 298, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 0, 0,
-	0, 0, 242, 178, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 233, 348, 364, 243, 339,
-	377, 248, 346, 238, 313, 336, 0, 0, 235, 362,
-	345, 295, 278, 279, 234, 0, 331, 258, 271, 255,
-	311, 0, 361, 389, 254, 380, 0, 372, 237, 0,
-	371, 310, 358, 363, 296, 290, 236, 360, 294, 289,
-	282, 262, 405, 275, 322, 288, 323, 276, 300, 299,
-	301, 0, 0, 0, 0, 0, 401, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 374, 0, 0, 0, 0, 0,
-	0, 347, 0, 0, 283, 0, 0, 0, 390, 0,
-	334, 316, 0, 0, 0, 332, 286, 359, 324, 365,
-	349, 373, 328, 325, 228, 350, 257, 297, 239, 241,
-	253, 259, 261, 263, 264, 306, 307, 319, 338, 352,
-	353, 354, 256, 249, 333, 250, 273, 251, 229, 340,
-	252, 231, 320, 357, 0, 269, 329, 293, 232, 292,
-	321, 356, 355, 240, 381, 387, 388, 393, 0, 394,
-	0, 0, 0, 402, 407, 408, 409, 411, 412, 413,
-	414, 0, 0, 0, 0, 396, 0, 0, 0, 0,
-	0, 0, 386, 267, 225, 226, 421, 0, 312, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 308, 385,
-	0, 0, 0, 0, 420, 0, 0, 0, 0, 0,
-	419, 318, 0, 337, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 344, 367, 379, 397,
-	400, 0, 0, 0, 230, 399, 0, 0, 0, 0,
-	0, 0, 0, 370, 0, 0, 0, 378, 0, 0,
-	0, 0, 0, 395, 302, 303, 304, 305, 270, 0,
-	247, 398, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 391,
-	392, 266, 272, 410, 274, 246, 317, 268, 376, 280,
-	0, 403, 0, 404, 0, 0, 0, 0, 309, 277,
-	341, 281, 287, 330, 375, 315, 335, 244, 366, 342,
-	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 220, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 227, 0, 285, 0, 326, 265, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 0,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 0, 221, 222, 223, 224, 351,
-	0, 0, 382, 383, 384, 406, 368, 0, 418, 0,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 0,
-	284, 0, 0, 0, 0, 0, 0, 343, 298, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 0, 0, 0, 242, 178, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 233, 348,
-	364, 243, 339, 377, 248, 346, 238, 313, 336, 0,
-	0, 235, 362, 345, 295, 278, 279, 234, 0, 331,
-	258, 271, 255, 311, 0, 361, 389, 254, 380, 0,
-	372, 237, 0, 371, 310, 358, 363, 296, 290, 236,
-	360, 294, 289, 282, 262, 405, 275, 322, 288, 323,
-	276, 300, 299, 301, 0, 0, 0, 0, 0, 401,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 347, 0, 0, 283, 0, 0,
-	0, 390, 0, 334, 316, 0, 0, 0, 332, 286,
-	359, 324, 365, 349, 373, 328, 325, 228, 350, 257,
-	297, 239, 241, 498, 259, 261, 263, 264, 306, 307,
-	319, 338, 352, 353, 354, 256, 249, 333, 250, 273,
-	251, 229, 340, 252, 231, 320, 357, 0, 269, 329,
-	293, 232, 292, 321, 356, 355, 240, 381, 387, 388,
-	393, 0, 394, 0, 0, 0, 402, 407, 408, 409,
-	411, 412, 413, 414, 0, 0, 0, 0, 396, 0,
-	0, 0, 0, 0, 0, 386, 267, 225, 226, 421,
-	0, 312, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 308, 385, 0, 0, 0, 0, 420, 0, 0,
-	0, 0, 0, 419, 318, 0, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 344,
-	367, 379, 397, 400, 0, 0, 0, 230, 399, 0,
-	0, 0, 0, 0, 0, 0, 370, 0, 0, 0,
-	378, 0, 0, 0, 0, 0, 395, 302, 303, 304,
-	305, 270, 0, 247, 398, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1512, 0, 391, 392, 266, 272, 410, 274, 246, 317,
-	268, 376, 280, 0, 403, 0, 404, 0, 0, 818,
-	0, 309, 277, 341, 281, 287, 330, 375, 315, 335,
-	244, 366, 342, 291, 1514, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 220, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1494, 0, 0, 227, 0, 285, 0, 326, 265,
-	184, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 0, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 0, 221, 222,
-	223, 224, 806, 0, 0, 382, 383, 384, 406, 368,
-	0, 418, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 826, 830, 832, 834, 836, 837, 839, 1512,
-	843, 840, 841, 842, 0, 0, 821, 822, 823, 824,
-	804, 805, 827, 0, 807, 0, 808, 809, 810, 811,
-	812, 813, 814, 815, 816, 817, 819, 825, 0, 0,
-	0, 0, 0, 1514, 0, 829, 831, 833, 835, 838,
-	0, 0, 0, 0, 0, 0, 0, 0, 1512, 0,
-	0, 0, 0, 1488, 1487, 0, 0, 1486, 0, 0,
-	2909, 0, 1498, 0, 0, 0, 0, 0, 0, 0,
-	1494, 0, 820, 1502, 0, 0, 0, 0, 0, 0,
-	0, 0, 1514, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1491, 0, 0, 0, 1493, 1495, 1497,
-	0, 1499, 1500, 1501, 1503, 1504, 1505, 1507, 1508, 1509,
-	1510, 1512, 0, 0, 0, 0, 0, 0, 0, 1494,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1514, 0, 0, 0, 1513,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2692,
-	0, 0, 1494, 0, 0, 0, 1511, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1490, 0, 0, 477, 0, 476, 483,
-	473, 1498, 0, 0, 0, 0, 0, 0, 0, 0,
-	480, 481, 1502, 482, 486, 0, 0, 468, 0, 0,
-	0, 0, 1506, 0, 0, 0, 0, 491, 0, 1496,
-	0, 0, 1491, 0, 0, 0, 1493, 1495, 1497, 0,
-	1499, 1500, 1501, 1503, 1504, 1505, 1507, 1508, 1509, 1510,
-	1498, 477, 0, 476, 483, 473, 495, 0, 0, 497,
-	0, 1502, 0, 0, 496, 480, 481, 0, 482, 486,
-	0, 0, 468, 0, 0, 0, 0, 0, 0, 0,
-	0, 1491, 491, 0, 0, 1493, 1495, 1497, 1513, 1499,
-	1500, 1501, 1503, 1504, 1505, 1507, 1508, 1509, 1510, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 495, 0, 1498, 497, 0, 828, 0, 0, 496,
-	0, 0, 0, 0, 1502, 1511, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1513, 0, 0,
-	0, 0, 1490, 0, 1491, 0, 0, 0, 1493, 1495,
-	1497, 0, 1499, 1500, 1501, 1503, 1504, 1505, 1507, 1508,
-	1509, 1510, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1506, 0, 0, 1511, 0, 0, 0, 1496, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1490, 0, 0, 469, 471, 470, 0, 0, 0,
-	1513, 0, 0, 0, 475, 0, 0, 477, 0, 476,
-	483, 473, 0, 0, 0, 0, 479, 0, 0, 0,
-	1506, 480, 481, 494, 482, 486, 0, 1496, 468, 0,
-	472, 0, 0, 0, 463, 0, 0, 1511, 491, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 469,
-	471, 470, 0, 0, 1490, 0, 0, 0, 0, 475,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 479, 0, 0, 0, 0, 0, 0, 494, 0,
-	0, 0, 0, 1506, 0, 472, 0, 0, 0, 0,
-	1496, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 474,
-	478, 484, 0, 485, 487, 0, 0, 488, 489, 490,
-	0, 0, 492, 493, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 474, 478, 484, 0, 485, 487,
-	0, 0, 488, 489, 490, 0, 0, 492, 493, 0,
-	0, 0, 0, 0, 0, 469, 471, 470, 0, 0,
-	0, 0, 0, 0, 0, 475, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 479, 0, 0,
-	0, 0, 0, 0, 494, 0, 0, 0, 0, 0,
-	0, 472, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 0,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 0, 227, 228, 229, 230, 360,
+	0, 0, 392, 393, 394, 416, 377, 0, 436, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	1975, 0, 292, 0, 0, 0, 0, 0, 0, 351,
+	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	182, 0, 0, 1179, 0, 0, 0, 249, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 0, 370, 399, 261,
+	390, 0, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 415, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 0, 0, 0, 0, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 400, 0, 342, 324, 0,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 336,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 0, 320, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 395, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 405,
+	310, 311, 312, 313, 277, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	0, 334, 272, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 0, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	0, 227, 228, 229, 230, 360, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 0, 0, 0,
+	1967, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 182, 0, 0, 1961,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	3007, 0, 182, 0, 0, 0, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 360, 0, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 607,
+	0, 0, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 0, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 277, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 360, 0, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 0, 292, 0, 0, 0,
+	0, 0, 0, 351, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2942, 0, 0, 182, 0, 0, 0, 0, 0,
+	0, 249, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	0, 370, 399, 261, 390, 0, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 415, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 400,
+	0, 342, 324, 0, 0, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 0, 320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 395,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 405, 310, 311, 312, 313, 277, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 0,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 0, 227, 228, 229, 230, 360,
+	0, 0, 392, 393, 394, 416, 377, 0, 436, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 0, 292, 0, 0, 0, 0, 0, 0, 351,
+	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 249, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 0, 370, 399, 261,
+	390, 0, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 415, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 0, 0, 0, 2877, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 400, 0, 342, 324, 0,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 336,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 0, 320, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 395, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 405,
+	310, 311, 312, 313, 277, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	0, 334, 272, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 0, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	0, 227, 228, 229, 230, 360, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2696, 0, 0, 182, 0, 0, 0,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 2744, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 360, 0, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 0, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2436, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 0, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 277, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 360, 0, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 0, 292, 0, 0, 0,
+	0, 0, 0, 351, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1577, 0, 0, 182, 0, 0, 0, 0, 0,
+	0, 249, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	0, 370, 399, 261, 390, 0, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 415, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 400,
+	0, 342, 324, 0, 0, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 0, 320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 395,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 405, 310, 311, 312, 313, 277, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 0,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 0, 227, 228, 229, 230, 360,
+	0, 0, 392, 393, 394, 416, 377, 0, 436, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 0, 292, 0, 0, 0, 0, 0, 0, 351,
+	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 249, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2525, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 0, 370, 399, 261,
+	390, 0, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 415, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 0, 0, 0, 0, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 400, 0, 342, 324, 0,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 336,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 0, 320, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 395, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 405,
+	310, 311, 312, 313, 277, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	0, 334, 272, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 0, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	0, 227, 228, 229, 230, 360, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 182, 0, 0, 2393,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2324, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 360, 0, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 1179, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 0, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 277, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 360, 0, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 0, 292, 0, 0, 0,
+	0, 0, 0, 351, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 182, 0, 0, 1961, 0, 0,
+	0, 249, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	0, 370, 399, 261, 390, 0, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 415, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 400,
+	0, 342, 324, 0, 0, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 0, 320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 395,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 405, 310, 311, 312, 313, 277, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 0,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 0, 227, 228, 229, 230, 360,
+	0, 0, 392, 393, 394, 416, 377, 0, 436, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 0, 292, 0, 0, 0, 0, 0, 0, 351,
+	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 249, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1692, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 0, 370, 399, 261,
+	390, 0, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 415, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 0, 0, 0, 0, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 400, 0, 342, 324, 0,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 336,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 0, 320, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 395, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 405,
+	310, 311, 312, 313, 277, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	0, 334, 272, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 0, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	0, 227, 228, 229, 230, 360, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1991, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 182, 0, 0, 1989, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 0, 0, 0,
+	392, 393, 394, 416, 377, 360, 436, 0, 0, 1861,
+	0, 0, 0, 0, 0, 0, 322, 0, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	0, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 182, 0, 0, 1179, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 1502, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 360, 0, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 0, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 1203, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 277, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 360, 0, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 0, 292, 0, 0, 0,
+	0, 0, 0, 351, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 182, 0, 0, 0, 0, 0,
+	0, 249, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	0, 370, 399, 261, 390, 0, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 415, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 400,
+	0, 342, 324, 0, 0, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 260, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 0, 320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 316, 395,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 0, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 405, 310, 311, 312, 313, 277, 0,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 711, 0, 0,
+	0, 233, 0, 293, 0, 334, 272, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 0,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 0, 227, 228, 229, 230, 360,
+	0, 0, 392, 393, 394, 416, 377, 0, 436, 0,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 0, 0, 0, 0, 0, 0, 0, 0, 267,
+	0, 0, 292, 0, 0, 0, 0, 0, 0, 351,
+	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	182, 0, 0, 0, 0, 0, 0, 249, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 356, 373, 250, 347, 387, 255, 354, 245, 321,
+	344, 0, 0, 242, 371, 353, 303, 286, 287, 241,
+	0, 339, 265, 278, 262, 319, 0, 370, 399, 261,
+	390, 0, 381, 244, 0, 380, 318, 367, 372, 304,
+	298, 243, 369, 302, 297, 290, 269, 415, 417, 418,
+	283, 330, 296, 331, 284, 308, 307, 309, 0, 0,
+	0, 0, 0, 411, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 0, 0, 0, 0, 0, 0, 355, 0,
+	0, 291, 0, 0, 0, 400, 0, 342, 324, 0,
+	0, 0, 340, 294, 368, 332, 374, 357, 382, 475,
+	333, 234, 358, 264, 305, 422, 423, 246, 248, 260,
+	266, 268, 270, 271, 314, 315, 327, 346, 361, 362,
+	363, 263, 256, 341, 257, 280, 258, 235, 282, 239,
+	359, 384, 348, 259, 237, 328, 366, 0, 276, 337,
+	301, 238, 300, 329, 365, 364, 247, 391, 397, 398,
+	403, 0, 404, 0, 0, 0, 412, 424, 425, 426,
+	428, 429, 430, 431, 0, 0, 0, 0, 406, 0,
+	0, 419, 420, 421, 0, 0, 0, 0, 396, 274,
+	231, 232, 439, 0, 320, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 316, 395, 0, 0, 0, 0,
+	438, 0, 0, 0, 0, 0, 437, 326, 0, 345,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 352, 376, 389, 407, 410, 0, 0, 0,
+	236, 409, 0, 0, 0, 0, 0, 0, 476, 379,
+	0, 0, 0, 388, 0, 0, 0, 0, 0, 405,
+	310, 311, 312, 313, 277, 0, 254, 408, 335, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 401, 402, 273, 279, 427,
+	281, 253, 325, 275, 386, 288, 0, 413, 0, 414,
+	0, 0, 0, 0, 317, 285, 349, 289, 295, 338,
+	385, 323, 343, 251, 375, 350, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 233, 0, 293,
+	0, 334, 272, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 209, 210, 211, 0, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	0, 227, 228, 229, 230, 360, 0, 0, 392, 393,
+	394, 416, 377, 0, 436, 0, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 0, 0, 0,
+	0, 0, 0, 0, 0, 267, 0, 0, 292, 0,
+	0, 0, 0, 0, 0, 351, 306, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 182, 0, 0, 0,
+	0, 0, 0, 249, 183, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 356, 373, 250,
+	347, 387, 255, 354, 245, 321, 344, 0, 0, 242,
+	371, 353, 303, 286, 287, 241, 0, 339, 265, 278,
+	262, 319, 0, 370, 399, 261, 390, 0, 381, 244,
+	0, 380, 318, 367, 372, 304, 298, 243, 369, 302,
+	297, 290, 269, 415, 417, 418, 283, 330, 296, 331,
+	284, 308, 307, 309, 0, 0, 0, 0, 0, 411,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 454, 0, 0, 383, 0, 0,
+	0, 0, 0, 0, 355, 0, 0, 291, 0, 0,
+	0, 400, 0, 342, 324, 0, 0, 0, 340, 294,
+	368, 332, 374, 357, 382, 336, 333, 234, 358, 264,
+	305, 422, 423, 246, 248, 260, 266, 268, 270, 271,
+	314, 315, 327, 346, 361, 362, 363, 263, 256, 341,
+	257, 280, 258, 235, 282, 239, 359, 384, 348, 259,
+	237, 328, 366, 0, 276, 337, 301, 238, 300, 329,
+	365, 364, 247, 391, 397, 398, 403, 0, 404, 0,
+	0, 0, 412, 424, 425, 426, 428, 429, 430, 431,
+	0, 0, 0, 0, 406, 0, 0, 419, 420, 421,
+	0, 0, 0, 0, 396, 274, 231, 232, 439, 0,
+	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 395, 0, 0, 0, 0, 438, 0, 0, 0,
+	0, 0, 437, 326, 0, 345, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 352, 376,
+	389, 407, 410, 0, 0, 0, 236, 409, 0, 0,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 388,
+	0, 0, 0, 0, 0, 405, 310, 311, 312, 313,
+	277, 0, 254, 408, 335, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 401, 402, 273, 279, 427, 281, 253, 325, 275,
+	386, 288, 0, 413, 0, 414, 0, 0, 0, 0,
+	317, 285, 349, 289, 295, 338, 385, 323, 343, 251,
+	375, 350, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 226, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 233, 0, 293, 0, 334, 272, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
+	211, 0, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 0, 227, 228, 229,
+	230, 360, 0, 0, 392, 393, 394, 416, 377, 0,
+	436, 0, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
+	444, 267, 0, 0, 292, 0, 0, 0, 0, 0,
+	0, 351, 306, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 182, 0, 0, 0, 0, 0, 0, 249,
+	183, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 356, 373, 250, 347, 387, 255, 354,
+	245, 321, 344, 0, 0, 242, 371, 353, 303, 286,
+	287, 241, 0, 339, 265, 278, 262, 319, 0, 370,
+	399, 261, 390, 0, 381, 244, 0, 380, 318, 367,
+	372, 304, 298, 243, 369, 302, 297, 290, 269, 415,
+	417, 418, 283, 330, 296, 331, 284, 308, 307, 309,
+	0, 0, 0, 0, 0, 411, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 291, 0, 0, 0, 400, 0, 342,
+	324, 0, 0, 0, 340, 294, 368, 332, 374, 357,
+	382, 336, 333, 234, 358, 264, 305, 422, 423, 246,
+	248, 260, 266, 268, 270, 271, 314, 315, 327, 346,
+	361, 362, 363, 263, 256, 341, 257, 280, 258, 235,
+	282, 239, 359, 384, 348, 259, 237, 328, 366, 0,
+	276, 337, 301, 238, 300, 329, 365, 364, 247, 391,
+	397, 398, 403, 0, 404, 0, 0, 0, 412, 424,
+	425, 426, 428, 429, 430, 431, 0, 0, 0, 0,
+	406, 0, 0, 419, 420, 421, 0, 0, 0, 0,
+	396, 274, 231, 232, 439, 0, 320, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 316, 395, 0, 0,
+	0, 0, 438, 0, 0, 0, 0, 0, 437, 326,
+	0, 345, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 352, 376, 389, 407, 410, 0,
+	0, 0, 236, 409, 0, 0, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 388, 0, 0, 0, 0,
+	0, 405, 310, 311, 312, 313, 277, 0, 254, 408,
+	335, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 402, 273,
+	279, 427, 281, 253, 325, 275, 386, 288, 0, 413,
+	0, 414, 0, 0, 0, 0, 317, 285, 349, 289,
+	295, 338, 385, 323, 343, 251, 375, 350, 299, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	474, 478, 484, 0, 485, 487, 0, 0, 488, 489,
-	490, 0, 0, 492, 493,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 233,
+	0, 293, 0, 334, 272, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 207, 208, 209, 210, 211, 0, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 0, 227, 228, 229, 230, 360, 0, 0,
+	392, 393, 394, 416, 377, 0, 436, 0, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 267, 0, 0,
+	292, 0, 0, 0, 0, 0, 0, 351, 306, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 182, 0,
+	0, 0, 0, 0, 0, 249, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 356,
+	373, 250, 347, 387, 255, 354, 245, 321, 344, 0,
+	0, 242, 371, 353, 303, 286, 287, 241, 0, 339,
+	265, 278, 262, 319, 0, 370, 399, 261, 390, 0,
+	381, 244, 0, 380, 318, 367, 372, 304, 298, 243,
+	369, 302, 297, 290, 269, 415, 417, 418, 283, 330,
+	296, 331, 284, 308, 307, 309, 0, 0, 0, 0,
+	0, 411, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	0, 0, 0, 0, 0, 0, 355, 0, 0, 291,
+	0, 0, 0, 400, 0, 342, 324, 0, 0, 0,
+	340, 294, 368, 332, 374, 357, 382, 336, 333, 234,
+	358, 264, 305, 422, 423, 246, 248, 260, 266, 268,
+	270, 271, 314, 315, 327, 346, 361, 362, 363, 263,
+	256, 341, 257, 280, 258, 235, 282, 239, 359, 384,
+	348, 259, 237, 328, 366, 0, 276, 337, 301, 238,
+	300, 329, 365, 364, 247, 391, 397, 398, 403, 0,
+	404, 0, 0, 0, 412, 424, 425, 426, 428, 429,
+	430, 431, 0, 0, 0, 0, 406, 0, 0, 419,
+	420, 421, 0, 0, 0, 0, 396, 274, 231, 232,
+	439, 0, 320, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 316, 395, 0, 0, 0, 0, 438, 0,
+	0, 0, 0, 0, 437, 326, 0, 345, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	352, 376, 389, 407, 410, 0, 0, 0, 236, 409,
+	0, 0, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 388, 0, 0, 0, 0, 0, 405, 310, 311,
+	312, 313, 277, 0, 254, 408, 335, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 401, 402, 273, 279, 427, 281, 253,
+	325, 275, 386, 288, 0, 413, 0, 414, 0, 0,
+	0, 0, 317, 285, 349, 289, 295, 338, 385, 323,
+	343, 251, 375, 350, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 233, 0, 293, 0, 334,
+	272, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210, 211, 0, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 0, 227,
+	228, 229, 230, 360, 0, 0, 392, 393, 394, 416,
+	377, 0, 436, 0, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 0, 0, 267, 0, 0, 292, 0, 0, 0,
+	0, 0, 0, 351, 306, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 182, 0, 0, 0, 0, 0,
+	0, 249, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 356, 373, 250, 347, 387,
+	255, 354, 245, 321, 344, 0, 0, 242, 371, 353,
+	303, 286, 287, 241, 0, 339, 265, 278, 262, 319,
+	0, 370, 399, 261, 390, 0, 381, 244, 0, 380,
+	318, 367, 372, 304, 298, 243, 369, 302, 297, 290,
+	269, 415, 417, 418, 283, 330, 296, 331, 284, 308,
+	307, 309, 0, 0, 0, 0, 0, 411, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 0, 0, 0, 0,
+	0, 0, 355, 0, 0, 291, 0, 0, 0, 400,
+	0, 342, 324, 0, 0, 0, 340, 294, 368, 332,
+	374, 357, 382, 336, 333, 234, 358, 264, 305, 422,
+	423, 246, 248, 517, 266, 268, 270, 271, 314, 315,
+	327, 346, 361, 362, 363, 263, 256, 341, 257, 280,
+	258, 235, 282, 239, 359, 384, 348, 259, 237, 328,
+	366, 0, 276, 337, 301, 238, 300, 329, 365, 364,
+	247, 391, 397, 398, 403, 0, 404, 0, 0, 0,
+	412, 424, 425, 426, 428, 429, 430, 431, 0, 0,
+	0, 0, 406, 0, 0, 419, 420, 421, 0, 0,
+	0, 0, 396, 274, 231, 232, 439, 0, 320, 0,
+	0, 0, 0, 0, 0, 0, 1561, 0, 316, 395,
+	0, 0, 0, 0, 438, 0, 0, 0, 0, 0,
+	437, 326, 0, 345, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 352, 376, 389, 407,
+	410, 0, 1563, 0, 236, 409, 0, 0, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 388, 0, 0,
+	0, 0, 0, 405, 310, 311, 312, 313, 277, 3024,
+	254, 408, 335, 0, 0, 0, 0, 0, 0, 1543,
+	0, 0, 0, 0, 0, 0, 1561, 0, 0, 401,
+	402, 273, 279, 427, 281, 253, 325, 275, 386, 288,
+	0, 413, 0, 414, 0, 0, 0, 0, 317, 285,
+	349, 289, 295, 338, 385, 323, 343, 251, 375, 350,
+	299, 0, 1563, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 226, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1543,
+	0, 233, 0, 293, 0, 334, 272, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 207, 208, 209, 210, 211, 0,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 0, 227, 228, 229, 230, 0,
+	0, 0, 392, 393, 394, 416, 377, 0, 436, 2786,
+	0, 0, 1547, 0, 495, 0, 494, 502, 491, 0,
+	435, 0, 0, 1551, 0, 0, 0, 0, 0, 498,
+	499, 0, 500, 505, 501, 0, 0, 486, 0, 0,
+	0, 0, 0, 1540, 0, 0, 0, 510, 0, 1542,
+	1544, 1546, 0, 1548, 1549, 1550, 1552, 1553, 1554, 1556,
+	1557, 1558, 1559, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 514, 0, 0, 516,
+	0, 0, 0, 0, 515, 0, 0, 0, 0, 0,
+	0, 495, 1547, 494, 502, 491, 0, 0, 0, 0,
+	0, 0, 0, 1551, 0, 1562, 498, 499, 0, 500,
+	505, 501, 0, 0, 486, 0, 0, 0, 0, 0,
+	0, 0, 0, 1540, 510, 0, 0, 0, 0, 1542,
+	1544, 1546, 0, 1548, 1549, 1550, 1552, 1553, 1554, 1556,
+	1557, 1558, 1559, 0, 0, 1560, 0, 0, 0, 0,
+	0, 0, 0, 514, 0, 495, 516, 494, 502, 491,
+	0, 515, 1539, 0, 0, 0, 0, 0, 0, 0,
+	498, 499, 0, 500, 505, 501, 0, 0, 486, 0,
+	0, 0, 0, 0, 0, 1562, 0, 0, 510, 0,
+	0, 1555, 0, 0, 0, 0, 0, 0, 1545, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2462, 0, 487, 489, 488, 0,
+	2449, 0, 0, 0, 0, 1560, 493, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 497, 0,
+	0, 0, 1539, 0, 2459, 513, 0, 0, 0, 0,
+	0, 0, 490, 0, 0, 0, 481, 2452, 0, 0,
+	0, 0, 0, 0, 2447, 0, 0, 0, 0, 2464,
+	2465, 1555, 0, 0, 0, 2448, 0, 0, 1545, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 487, 489, 488, 0, 0, 0, 0,
+	0, 0, 0, 493, 0, 0, 0, 0, 0, 0,
+	0, 0, 2453, 0, 0, 497, 0, 0, 0, 0,
+	0, 0, 513, 0, 0, 0, 0, 0, 0, 490,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 487, 489, 488,
+	492, 496, 503, 0, 504, 506, 0, 493, 507, 508,
+	509, 0, 0, 511, 512, 0, 0, 0, 0, 497,
+	0, 0, 0, 0, 0, 0, 513, 0, 0, 0,
+	0, 0, 0, 490, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2463, 0, 1895, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2455, 0, 0, 0, 0, 492, 496, 503,
+	0, 504, 506, 0, 0, 507, 508, 509, 0, 0,
+	511, 512, 0, 0, 0, 0, 2454, 2456, 2461, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 492, 496, 503, 0, 504, 506, 0, 0, 507,
+	508, 509, 0, 0, 511, 512, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2466, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2450, 0, 0, 0, 0, 0, 2460,
 }
 
 var yyPact = [...]int{
-	2922, -1000, -1000, -1000, -310, 10649, -1000, -1000, -1000, -1000,
+	2920, -1000, -1000, -1000, -318, 11025, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 32645, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 32645, -306, 32122,
-	32122, -1000, -1000, 1770, -1000, 31599, 11714, 32645, 237, 236,
-	32645, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 35176,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 35176,
+	-313, 34640, 34640, -1000, -1000, 1952, -1000, 34104, 12116, 35176,
+	296, 293, 35176, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 446, -1000, 31076, -1000, -1000, -1000,
-	-1000, -1000, -1000, 415, 33926, 33168, 8546, -263, -1000, 2346,
-	-116, 579, 583, 728, 728, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2072, 495, 30553, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 547, -1000, 33568,
+	-1000, -1000, -1000, -1000, -1000, -1000, 500, 36264, 35712, 8870,
+	-267, -1000, 2639, -125, 44, 721, 726, 864, 864, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2891, 715, 33032,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 2919, 157, 495, 13806, -32,
-	-34, 2346, 280, 121, -1000, 811, 2978, 143, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 8546,
-	8546, 10649, -314, 10649, 8546, 32645, 32645, -1000, -1000, -1000,
-	-1000, 415, 33926, 8546, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	3004, 172, 715, 14797, -29, -30, 2639, 286, 2086, -1000,
+	964, 3152, 146, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 8870, 8870, 11025, -322, 11025, 8870,
+	35176, 35176, -1000, -1000, -1000, -1000, 500, 36264, 8870, 44,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4982,320 +5241,333 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -34,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -30, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 3451, -1000, 1060, -1000, -1000,
-	-1000, -1000, 2013, 1059, 1683, 378, 32645, -1000, 1055, 378,
-	-1000, -1000, -1000, 2346, 2346, -1000, 32645, 32645, 25, 1144,
-	-1000, 305, 290, 300, 1054, -1000, -1000, -1000, -1000, -1000,
-	2399, -1000, 32645, 32645, 2028, 32645, -1000, 1318, 334, 33981,
-	2223, 920, 503, 2063, -1000, -1000, 2012, -1000, 134, 165,
-	113, 269, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 166,
-	-1000, 2227, -1000, -1000, 136, -1000, -1000, 128, -1000, -1000,
-	-1000, -35, -1000, -1000, -1000, -1000, -1000, -1000, -123, -1000,
-	-1000, 606, 1429, 8546, -1000, 1452, -1000, 2982, -1000, -1000,
-	-1000, -1000, 5921, 10115, 10115, 10115, 10115, -1000, -1000, 1902,
-	8546, 2011, 2007, -1000, -1000, -1000, -1000, -1000, 1050, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1682, 9592, -1000, 2005, 2003, 2002, 2001, 1998, 1997,
-	1994, 1993, 1992, 1991, 1989, 1988, 1987, 1984, 1827, 11180,
-	1982, 1681, 1679, 1978, 1977, 1975, 1677, 1827, 1827, 1974,
-	1973, 1967, 1962, 1961, 1959, 1956, 1953, 1951, 1947, 1943,
-	1935, 1934, 1932, 1931, 1930, 1922, 1920, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2916, -1000, 1212, -1000, -1000, -1000, -1000, -1000,
+	2215, 1202, 1802, 457, 35176, -1000, 1201, 457, -1000, -1000,
+	-1000, 2639, 2639, -1000, 35176, 35176, 57, 1330, -1000, 300,
+	306, 282, 1196, -1000, -1000, -1000, -1000, -1000, 2577, -1000,
+	35176, 35176, 2244, 35176, -1000, 1455, 427, 36341, 2431, 954,
+	604, 2279, -1000, -1000, 2214, -1000, 189, 150, 148, 478,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 166, -1000, 2505,
+	-1000, -1000, -1000, 179, -1000, -1000, 152, -1000, -1000, -1000,
+	-31, -1000, -1000, -1000, -1000, -1000, -1000, -127, -1000, -1000,
+	795, 1401, 8870, -1000, 1724, -1000, 3184, -1000, -1000, -1000,
+	-1000, 6179, 10478, 10478, 10478, 10478, -1000, -1000, 2047, 8870,
+	2212, 2211, -1000, -1000, -1000, -1000, -1000, 1195, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1799, 9942, -1000, 2207, 2199, 2192, 2185, 2173, 2171, 2170,
+	2169, 2168, 2165, 2164, 2163, 2162, 2160, 1961, 11569, 2159,
+	1798, 1795, 2153, 2152, 2150, 1794, 1961, 1961, 2129, 2127,
+	2126, 2124, 2123, 2120, 2115, 2113, 2103, 2102, 2084, 2083,
+	2081, 2080, 2076, 2074, 2073, 2068, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	999, -1000, 1918, 2207, 2317, 1846, 2376, 2292, 2289, 2281,
-	2279, 1493, -1000, -1000, -1000, -158, -1000, -1000, 570, -1000,
-	545, -1000, 32645, 32645, 32645, 414, 414, 414, 414, 414,
-	434, 414, 441, 440, 438, 414, -1000, -1000, -1000, -1000,
-	-1000, -1000, 529, -1000, -1000, -1000, -1000, 935, 32645, -1000,
-	1859, 1090, 2314, 358, 342, 1090, 264, -1000, 1211, 1211,
-	1211, 1211, 1090, 293, 339, 2317, 2317, -49, 1211, -66,
-	1090, 1090, -66, 1090, 1090, 1090, 172, -303, -1000, -1000,
-	-1000, 1211, 341, 1211, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 2304, 2303, 415, 32645, 85, 32645, 415, 415, 418,
-	1318, 331, 329, 940, 1189, -1000, 1109, 32645, 32645, 32645,
-	1109, 1109, 17470, 16947, -1000, 32645, -1000, 2317, 1846, -1000,
-	1794, 2218, 1789, 1846, 415, 415, 415, 415, 415, 415,
-	415, 415, 32645, 32645, 30030, 415, 7490, 7490, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 10649, 1314, 1576,
-	140, -88, -308, 164, -1000, -1000, 32645, 2188, 107, -1000,
-	-1000, -1000, 1830, -1000, 1856, 1856, 1856, 1856, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1883, 1915, -1000,
-	-1000, 1855, 1855, 1855, 1830, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1873, 1873, 1882, 1873, 32645, 8546, 32645, 2212, 282, 1912,
-	-1000, 32645, 275, 2317, 2207, 2317, -1000, -1000, 1048, 1489,
-	1676, -1000, -1000, 305, 1098, -1000, 744, -1000, -1000, -1000,
-	-1000, 32645, 263, -1000, -1000, 1662, 1908, -1000, 403, 785,
-	919, -1000, 170, 34137, 26361, 1318, 26361, 32645, -1000, -1000,
-	-1000, -1000, -1000, -1000, -39, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 124, -1000,
-	8546, 8546, 8546, 8546, 8546, -1000, 463, 9069, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 10115, 10115, 10115, 10115, 10115,
-	10115, 10115, 10115, 10115, 10115, 10115, 10115, 1898, 1222, 10115,
-	10115, 10115, 10115, 2218, 2448, 939, 201, -1000, -1000, -1000,
-	-1000, -1000, 1229, 1429, 8546, 8546, 32645, -1000, 2980, 8546,
-	8546, 3030, 8546, 2276, 8546, 8546, 8546, 1787, 4351, 32645,
-	8546, -1000, 1784, 1782, -1000, -1000, 1322, 8546, -1000, -1000,
-	8546, -1000, -1000, 8546, 10115, 8546, -1000, -1000, -1000, 117,
-	2276, 2276, 8546, 8546, 2276, 2276, 2276, 1169, 2276, 2276,
-	2276, 2276, 2276, 2276, 2276, 2276, 1780, 2317, -263, 6967,
-	-1000, -269, 2207, 8546, -1000, -1000, 8546, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1674, -106, 576, 548, 556,
-	-1000, 2272, -1000, 1907, 1905, 1045, 32645, 1246, 32645, 26361,
-	32645, 1318, 32645, 32645, 414, 414, 414, 32645, 418, -1000,
-	32645, 935, 2270, 32645, 2350, 10115, 10115, 29507, 1211, 1090,
-	-1000, -1000, 32645, -1000, -1000, -1000, 1211, 32645, 1211, 1211,
-	2350, 1211, -1000, -1000, -1000, 1090, 1090, -1000, -1000, -1000,
-	-1000, 1211, 1211, -1000, -1000, 2350, 32645, -48, 2350, 2350,
-	-58, -1000, -1000, -1000, 32645, 32645, 414, 32645, -1000, 32645,
-	-1000, 32645, -1000, -1000, 32645, 33574, 32645, 32645, 2296, -1000,
-	26361, 32645, 24269, -1000, -1000, 398, 394, 15901, 351, 26361,
-	5397, -1000, -1000, 1109, 1109, 1109, 5397, 5397, 1020, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 932, -1000, 118, 2207,
-	-1000, -1000, -1000, -1000, -1000, 32645, 32645, 26361, 1318, 32645,
-	32645, 32645, 32645, -1000, 1904, -1000, 2220, 32645, 831, -1000,
-	-1000, 13283, 1042, 831, -1000, 1230, -1000, 8546, 10649, -283,
-	8546, 10649, 10649, 8546, 10649, -1000, 8546, 89, -1000, -1000,
-	-1000, -1000, 1487, -1000, 1476, -1000, -1000, -1000, 1672, 1672,
-	-1000, 1427, -1000, -1000, -1000, -1000, 1421, -1000, -1000, 1419,
-	-1000, -1000, 1779, 606, -1000, 1667, 2062, -265, -1000, 14854,
-	32645, 32645, -1000, -1000, -265, -1000, 14330, 32645, 2207, -1000,
-	2207, 32645, -1000, 2313, -1000, 305, 207, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1041, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 929, -1000, 32645, -1000, -1000, 170, 26361,
-	27407, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 161, -1000,
-	-1000, 156, -1000, 429, 52, 1087, -1000, -1000, 88, 151,
-	472, 1429, -1000, 1250, 1250, 1255, -1000, 467, -1000, -1000,
-	-1000, -1000, 1902, -1000, -1000, -1000, 1161, 1312, -1000, 1423,
-	1423, 1046, 1046, 1046, 1046, 1046, 1182, 1182, -1000, -1000,
-	-1000, 5921, 1898, 10115, 10115, 10115, 10115, 401, 401, 3593,
-	3551, -1000, 8546, 1214, -1000, 8546, 1589, 800, 1038, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 995,
+	-1000, 2067, 2423, 2515, 1993, 2556, 2497, 2489, 2478, 2475,
+	1632, -1000, -1000, 528, 35176, 35176, -1000, -145, -1000, -1000,
+	707, -1000, 646, -1000, 35176, 35176, 35176, 493, 493, 493,
+	493, 493, 493, 540, 493, 546, 545, 543, 493, -1000,
+	-1000, -1000, -1000, -1000, -1000, 631, -1000, -1000, -1000, -1000,
+	1000, 35176, -1000, 1996, 1306, 2511, 443, 434, 1306, 1408,
+	330, -1000, 1343, 1343, 1343, 1343, 1306, 318, 431, 2515,
+	2515, -45, 1343, -56, 1306, 1306, -56, 1306, 1306, 1306,
+	161, -312, -1000, -1000, -1000, 1343, 433, 1343, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2487, 2471, 500, 35176, 91,
+	35176, 500, 500, 515, 1455, 426, 420, 1067, 1406, -1000,
+	1315, 35176, 35176, 35176, 1315, 1315, 693, 18552, 18016, -1000,
+	35176, -1000, 2515, 1993, -1000, 1937, 2322, 1935, 1993, 500,
+	500, 500, 500, 500, 500, 500, 500, 500, 35176, 35176,
+	32496, 500, 7787, 7787, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 11025, 1663, 1793, 144, -91, -315, 178,
+	-1000, -1000, 35176, 2393, 149, -1000, -1000, -1000, 1964, -1000,
+	1995, 1995, 1995, 1995, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2031, 2064, -1000, 2029, 2022, -1000, 1992,
+	1992, 1992, 1964, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2017, 2017,
+	2021, 2017, 35176, 8870, 35176, 2425, 290, 2063, -1000, 35176,
+	275, 2515, 2423, 2515, -1000, -1000, 1191, 1629, 758, 1329,
+	-1000, 306, -1000, 1218, -1000, 870, -1000, -1000, -1000, -1000,
+	35176, 274, -1000, -1000, 1781, 298, -1000, 464, 847, 1051,
+	-1000, 143, 36405, 28736, 1455, 28736, 35176, -1000, -1000, -1000,
+	-1000, -1000, -1000, -44, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 153, -1000, 8870,
+	8870, 8870, 8870, 8870, -1000, 497, 9406, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 10478, 10478, 10478, 10478, 10478, 10478,
+	10478, 10478, 10478, 10478, 10478, 10478, 2045, 1369, 10478, 10478,
+	10478, 10478, 2322, 2621, 1034, 208, -1000, -1000, -1000, -1000,
+	-1000, 1368, 1401, 8870, 8870, 35176, -1000, 3254, 8870, 8870,
+	3313, 8870, 2463, 8870, 8870, 8870, 1928, 4570, 35176, 8870,
+	-1000, 1927, 1924, -1000, -1000, 1473, 8870, -1000, -1000, 8870,
+	-1000, -1000, 8870, 10478, 8870, -1000, -1000, -1000, 156, 2463,
+	2463, 8870, 8870, 2463, 2463, 2463, 1375, 2463, 2463, 2463,
+	2463, 2463, 2463, 2463, 2463, 1923, 2515, -267, 7251, -1000,
+	-273, 2423, 8870, -1000, -1000, 8870, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1790, 1789, 524, 523, -105, 717,
+	658, 663, -1000, 2459, -1000, 2061, 2054, 1189, 35176, 1385,
+	35176, 28736, 35176, 1455, 35176, 35176, 35176, 493, 493, 493,
+	35176, 515, -1000, 35176, 1000, 2450, 35176, 2567, 10478, 10478,
+	31960, 1343, 1306, -1000, 35176, 35176, -1000, -1000, -1000, 35176,
+	-1000, 1343, 35176, 1343, 1343, 2567, 1343, -1000, -1000, -1000,
+	1306, 1306, -1000, -1000, -1000, -1000, 1343, 1343, -1000, -1000,
+	2567, 35176, -50, 2567, 2567, -53, -1000, -1000, -1000, 35176,
+	35176, 493, 35176, -1000, 35176, -1000, 35176, -1000, -1000, 35176,
+	3413, 35176, 35176, 2469, -1000, 28736, 35176, 26592, -1000, -1000,
+	448, 489, 16944, 394, 28736, 5642, -1000, -1000, 1315, 1315,
+	1315, 5642, 5642, 5642, 1109, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 991, -1000, 137, 2423, -1000, -1000, -1000, -1000,
+	-1000, 35176, 35176, 28736, 1455, 35176, 35176, 35176, 35176, 35176,
+	-1000, 2053, -1000, 2424, 35176, 937, -1000, -1000, 14261, 1180,
+	937, -1000, 1364, -1000, 8870, 11025, -292, 8870, 11025, 11025,
+	8870, 11025, -1000, 8870, 145, -1000, -1000, -1000, -1000, 1625,
+	-1000, 1623, -1000, -1000, -1000, 1788, 1788, 1622, 1621, -1000,
+	1606, -1000, -1000, -1000, -1000, 1605, -1000, -1000, 1589, -1000,
+	-1000, 1922, 795, -1000, 1786, 2278, -269, -1000, 15871, 35176,
+	35176, -1000, -1000, -269, -1000, 15334, 35176, 2423, -1000, 2423,
+	35176, -1000, 2509, 306, -1000, -1000, -1000, 698, -1000, 306,
+	247, -1000, -1000, -1000, -1000, 1170, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 984, -1000, 35176, 2051, -1000,
+	-1000, 143, 28736, 29808, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 170, -1000, -1000, 167, -1000, 522, 93, 1210, -1000,
+	-1000, 99, 160, 575, 1401, -1000, 1384, 1384, 1396, -1000,
+	509, -1000, -1000, -1000, -1000, 2047, -1000, -1000, -1000, 1690,
+	1056, -1000, 1288, 1288, 1188, 1188, 1188, 1188, 1188, 1643,
+	1643, -1000, -1000, -1000, 6179, 2045, 10478, 10478, 10478, 10478,
+	477, 477, 1553, 3567, -1000, 8870, 1347, -1000, 8870, 2300,
+	1259, 1138, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1778,
-	1766, 1747, 2398, 1765, 8546, -1000, -1000, 1086, 1082, 1081,
-	-1000, 1385, 8023, -1000, -1000, -1000, 1764, 1035, 1756, -1000,
-	-1000, -1000, 1753, 1052, 834, 1752, 2930, 1745, 928, 8546,
-	8546, 1043, 1039, 8546, 8546, 8546, 8546, 1743, 8546, 8546,
-	8546, 8546, 8546, 8546, 8546, 8546, -22, -1000, -1000, 1028,
-	-1000, 1429, -1000, 1658, -1000, 804, 926, -1000, 1598, -1000,
-	-1000, -1000, -1000, 574, 542, 559, 32645, 689, 12237, 32645,
-	1859, 2219, 76, -1000, 888, -1000, 52, -136, 683, 2027,
-	2396, 32645, 32645, 32645, 2258, 28984, -1000, 1896, 1016, -1000,
-	-1000, 8546, -1000, -1000, 2019, 32645, 32645, 2350, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 32645, 2350, 2350, 1090, 1211,
-	-1000, -1000, 1211, -1000, -1000, 1211, -1000, 1034, -1000, 32645,
-	-1000, -1000, -1000, 1859, 922, -1000, 12760, 630, 433, -1000,
-	1125, 1125, 752, 1125, 1125, 1125, 1125, 309, 298, 1125,
-	1125, 1125, 1125, 1125, 1125, 1125, 1125, 1125, 1125, 1125,
-	1125, 1125, 1125, 1890, -1000, 75, 2288, 169, 888, 183,
-	2538, 805, -1000, -1000, -1000, -1000, 19562, 19562, 15378, 19562,
-	-1000, 1100, -1000, -1000, 425, -1000, -1000, 683, -1000, -1000,
-	-1000, 1889, 1143, -1000, -1000, 11180, -1000, 5397, 5397, 5397,
-	-1000, -1000, 20085, 32645, -1000, -131, -1000, -112, -1000, 891,
-	-1000, -1000, 915, 683, 2059, 891, 891, -1000, 12237, 32645,
-	-1000, 2350, 7490, -1000, 24269, -1000, -1000, 28453, -1000, 27930,
-	2350, 1198, -1000, 10649, 1559, 132, -1000, 160, -316, 129,
-	1401, 127, 1429, -1000, -1000, 1742, 1740, 1007, -1000, 1006,
-	1735, 1002, 992, -1000, -75, -1000, 2193, 720, -1000, 1887,
-	-1000, 987, 2121, -1000, 887, -1000, 1138, 972, -1000, 720,
-	963, 2113, 887, -1000, -1000, 1033, 19, -1000, -1000, 32645,
-	1662, 961, 27407, 772, -1000, 423, 1023, 1015, -1000, 26361,
-	125, 26361, -1000, 26361, -1000, -1000, 279, -1000, 32645, 2196,
-	-1000, -1000, -1000, 1592, -333, -1000, -1000, -1000, -1000, -1000,
-	951, -1000, 401, 401, 3593, 3294, -1000, 10115, -1000, 10115,
-	2400, 1195, -1000, 8546, 1525, 3394, 971, 19039, 32645, -1000,
-	-1000, 8546, 8546, -1000, 2378, -1000, -1000, -1000, -1000, 8546,
-	8546, 1645, -1000, 32645, -1000, -1000, -1000, -1000, 19039, -1000,
-	10115, -1000, 8546, 901, 2351, -22, -22, 2324, 2316, 2295,
-	948, -22, 2249, 2237, 2202, 2189, 2015, 2008, 1944, 1900,
-	-1000, 1884, 6967, -1000, -75, 8546, 8546, 8546, 2132, -1000,
-	-1000, -1000, -1000, -1000, 537, 92, 1734, 875, -1000, -1000,
-	32645, -1000, -1000, -1000, 1732, 873, -1000, -1000, -1000, 33569,
-	1856, 1856, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1883, -1000, -1000, 1855, 1855, 1855, 1830, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1873, 1873, 1882,
-	1873, -1000, 2256, -1000, -25, 1125, 421, 26361, 325, -1000,
-	32645, 2057, 215, 2157, 32645, 1868, 1865, 1864, 231, 33569,
-	32645, 726, -1000, 1001, 2978, -1000, 32645, 1429, -1000, 1318,
-	-1000, 1090, -1000, 2350, 1012, -1000, -1000, 2350, 1090, 1090,
-	1211, 32645, -1000, 2251, 33574, -1000, -1000, -1000, -1000, 33569,
-	577, -1000, 580, 414, 32645, 1286, 580, 1278, 1861, -1000,
-	-1000, -1000, 32645, 32645, 32645, 1276, 1274, -1000, 32645, 1417,
-	-1000, 1411, 1125, 1125, 1410, 1657, 1656, 1642, 1125, 1125,
-	1409, 1637, 26884, 1404, 1403, 1402, 1552, 1635, 543, 1492,
-	1486, 1446, 32645, 1860, 1563, -25, 1125, 167, 1137, 421,
-	1316, 16424, 32645, 24269, 24269, 24269, 24269, -1000, 2102, 2097,
-	-1000, 2096, 2093, 2115, 32645, 24269, 1859, -1000, 26884, -1000,
-	-1000, -1000, 2218, 934, 2161, 618, 8546, 26361, 1634, 351,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 32645, 32645,
-	1731, -1000, 2381, -1000, 799, -1000, -1000, 977, -1000, 2381,
-	1240, -312, 10649, 1217, 1149, -1000, 8546, 10649, 8546, -284,
-	155, -286, -1000, -1000, -1000, 1632, -1000, -1000, -1000, 1395,
-	-1000, 1393, 2, 15, 1269, -265, 6967, 278, 32645, -265,
-	32645, 6967, -1000, 32645, 274, -265, 32645, 1390, -1000, -1000,
-	-1000, 2388, 26361, 1318, 1064, 25838, -1000, 133, -1000, 159,
-	373, 1628, -1000, 437, 84, -1000, 1135, 1592, -1000, -1000,
-	-1000, 10115, -1000, -1000, -1000, -1000, 1429, 8546, 1730, -1000,
-	520, 520, 1729, -1000, 1856, 1856, -1000, 1830, 1855, 1830,
-	520, 520, 1728, -1000, 1356, 1783, -1000, 1774, 1736, 8546,
-	-1000, 1726, 3572, 775, -152, -22, -1000, -1000, -1000, -22,
-	-22, -22, -22, -1000, -22, -22, -22, -22, -22, -22,
-	-22, -22, 379, -1000, 2, 1429, 1429, -1000, -1000, 2118,
-	-1000, 1627, 1624, 689, 33569, 453, 12237, 2179, 283, 1320,
-	-1000, -1000, 25315, 359, -1000, -1000, -1000, 447, 110, 1389,
-	354, -1000, 32645, 182, 32645, -1000, -1000, -1000, -1000, -1000,
-	2157, -1000, 743, 204, 12760, 12760, 12760, 212, 1178, -1000,
-	378, 682, 967, 24269, 32645, -1000, 23746, 1725, -1000, 683,
-	2350, -1000, 32645, -1000, 2350, 2350, 1090, -1000, 283, -1000,
-	2932, -1000, 32645, -1000, 32645, -1000, 32645, 32645, 414, 8546,
-	-1000, -1000, -1000, 32645, -1000, 214, -1000, -1000, 19039, 19039,
-	-1000, -1000, -1000, -1000, 1622, 1613, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 311, 32645,
-	930, -1000, 1134, 1320, 25315, 1132, 1612, 359, -1000, 1604,
-	-1000, 628, 32645, 32645, -1000, 776, -1000, 1126, 2025, 2052,
-	2025, -1000, -1000, -1000, -1000, 2094, -1000, 2078, -1000, -1000,
-	776, -1000, -1000, -1000, -1000, -1000, 618, -1000, 2302, 580,
-	580, 580, 1722, 772, 1721, -1000, -1000, -1000, -1000, -1000,
-	2379, 2374, 24792, 2379, -1000, -312, 1162, -1000, 1263, 126,
-	1371, 32645, -1000, -1000, -1000, 1719, 1718, -271, 6, 2373,
-	2362, 2410, -1000, 1708, 771, -265, -1000, -1000, 720, -1000,
-	-1000, -1000, -265, -1000, 720, -1000, -1000, 1318, -1000, 152,
-	-1000, -1000, -1000, -1000, -1000, -1000, 34, -1000, 32645, -1000,
-	1592, 1590, 82, -1000, 1429, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1918, 1917, 2131, 2575, 1914, 8870, -1000, -1000,
+	1207, 1204, 1193, -1000, 1824, 8334, -1000, -1000, -1000, 1907,
+	1130, 1905, -1000, -1000, -1000, 1902, 1192, 809, 1901, 1443,
+	1894, 980, 8870, 8870, 1186, 1185, 8870, 8870, 8870, 8870,
+	1893, 8870, 8870, 8870, 8870, 8870, 8870, 8870, 8870, -22,
+	-1000, -1000, 1184, -1000, 1401, -1000, 1784, -1000, 908, 979,
+	-1000, 1804, -1000, -1000, -1000, 1783, 1766, -1000, -1000, 673,
+	644, 686, 35176, 803, 12653, 35176, 1996, 2415, 134, -1000,
+	977, -1000, 93, -137, 852, 2276, 2243, 2573, 35176, 35176,
+	35176, 2448, 31424, -1000, 2043, 1183, -1000, -1000, 8870, -1000,
+	-1000, 2230, 35176, 35176, 2567, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 35176, 2567, 2567, 1306, 1343, -1000,
+	-1000, 1343, -1000, -1000, 1343, -1000, 1108, -1000, 35176, -1000,
+	-1000, -1000, 1996, 963, -1000, 13189, 719, 532, -1000, 1266,
+	1266, 777, 1266, 1266, 1266, 1266, 399, 397, 1266, 1266,
+	1266, 1266, 1266, 1266, 1266, 1266, 1266, 1266, 1266, 1266,
+	1266, 1266, 2042, -1000, 130, 2467, 213, 977, 227, 2740,
+	849, -1000, -1000, -1000, -1000, 20696, 20696, 16408, 22304, -1000,
+	1225, -1000, -1000, 520, -1000, -1000, 852, -1000, -1000, -1000,
+	2037, 1328, -1000, -1000, 11569, -1000, 5642, 5642, 5642, -1000,
+	-1000, -1000, 21768, 35176, -1000, -132, -1000, -107, -1000, 945,
+	-1000, -1000, 956, 852, 2273, 2272, 945, 945, -1000, 12653,
+	35176, -1000, 2567, 7787, -1000, 26592, -1000, -1000, 30880, -1000,
+	30344, 2567, 1342, -1000, 11025, 1698, 141, -1000, 169, -317,
+	140, 1453, 139, 1401, -1000, -1000, 1891, 1885, 1181, -1000,
+	1166, 1884, 1882, 1880, 1160, 1154, -1000, -71, -1000, 2412,
+	869, -1000, 2035, -1000, 1151, 2368, -1000, 935, -1000, 1324,
+	1144, -1000, 869, 1134, 2367, 935, -1000, -1000, 1107, 56,
+	-1000, 306, -1000, -1000, 35176, 1781, 1131, 35176, 29808, 886,
+	-1000, 518, 1103, 1102, -1000, 28736, 162, 28736, -1000, 28736,
+	-1000, -1000, 288, -1000, 35176, 2417, -1000, -1000, -1000, 1704,
+	-337, -1000, -1000, -1000, -1000, -1000, 1119, -1000, 477, 477,
+	1553, 3455, -1000, 10478, -1000, 10478, 2601, 1338, -1000, 8870,
+	1597, 250, 1206, 20160, 35176, -22, -1000, 8870, 8870, -1000,
+	2592, -1000, -1000, -1000, -1000, 8870, 8870, 1943, -1000, 35176,
+	-1000, -1000, -1000, -1000, 20160, -1000, 10478, -1000, 8870, 973,
+	2527, -22, -22, 2523, 2517, 2508, 1105, -22, 2476, 2457,
+	2411, 2396, 2378, 2351, 2347, 2329, -1000, 2032, 7251, -1000,
+	-71, 8870, 8870, 8870, 2332, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 635, 168, 1859, 932, -1000, -1000, 35176, -1000,
+	-1000, -1000, 1857, 920, -1000, -1000, -1000, 3358, 1995, 1995,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2031,
+	-1000, 2029, 2022, -1000, 1992, 1992, 1992, 1964, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2017, 2017, 2021,
+	2017, -1000, 2442, -1000, -16, 1266, 473, 28736, 416, -1000,
+	35176, 35176, 2250, 294, 2385, 35176, 2016, 2004, 2002, 277,
+	3358, 35176, 917, -1000, 1100, 3152, -1000, 35176, 1401, -1000,
+	1455, -1000, 1306, -1000, 2567, 1057, -1000, -1000, 2567, 1306,
+	1306, 1343, 35176, -1000, 2439, 3413, -1000, -1000, -1000, -1000,
+	3358, 599, -1000, 668, 493, 35176, 1431, 668, 1430, 2001,
+	-1000, -1000, -1000, 35176, 35176, 35176, 1428, 1427, -1000, 35176,
+	1588, -1000, 1583, 1266, 1266, 1581, 1754, 1752, 1750, 1266,
+	1266, 1563, 1749, 29272, 1557, 1555, 1552, 1520, 1735, 623,
+	1519, 1516, 1510, 35176, 1997, 1673, -16, 1266, 212, 1320,
+	473, 1445, 17480, 35176, 26592, 26592, 26592, 26592, -1000, 2342,
+	2335, -1000, 2324, 2291, 2323, 35176, 26592, 1996, -1000, 29272,
+	-1000, -1000, -1000, 2322, 1084, 2672, 694, 21232, 8870, 28736,
+	1734, 394, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	35176, 35176, 35176, 1855, -1000, 2530, -1000, 900, -1000, -1000,
+	1099, -1000, 2530, 1372, -319, 11025, 1351, 1305, -1000, 8870,
+	11025, 8870, -298, 200, -300, -1000, -1000, -1000, 1728, -1000,
+	-1000, -1000, -1000, -1000, 1551, -1000, 1549, -6, 13, 1425,
+	-269, 7251, 284, 35176, -269, 35176, 7251, -1000, 35176, 281,
+	-269, 35176, 1547, -1000, -1000, -1000, -1000, 1078, 2569, 28736,
+	1455, 1222, 28200, -1000, 173, -1000, 164, 437, 1727, -1000,
+	533, 94, -1000, 1300, 1704, -1000, -1000, -1000, 10478, -1000,
+	-1000, -1000, -1000, 1401, 8870, 1853, -1000, 703, 703, 1852,
+	-1000, 1995, 1995, -1000, 1964, 1992, 1964, 703, 703, 1846,
+	-1000, -1000, 1712, 2313, -1000, 2292, 2265, 8870, -1000, 1844,
+	3275, 959, -150, -22, -1000, -1000, -1000, -22, -22, -22,
+	-22, -1000, -22, -22, -22, -22, -22, -22, -22, -22,
+	459, -1000, -6, 1401, 1401, -1000, -1000, 2364, -1000, 1723,
+	1720, 803, 3358, 550, 12653, 2392, 291, 1514, -1000, -1000,
+	27664, 415, -1000, -1000, -1000, 484, 147, 1546, 402, -1000,
+	35176, 225, 678, 35176, -1000, -1000, -1000, -1000, -1000, -1000,
+	2385, -1000, 565, 255, 13189, 13189, 13189, 259, 1331, -1000,
+	457, 826, 1097, 26592, 35176, -1000, 26056, 1843, -1000, 852,
+	2567, -1000, 35176, -1000, 2567, 2567, 1306, -1000, 291, -1000,
+	36438, -1000, 35176, -1000, 35176, -1000, 35176, 35176, 493, 8870,
+	-1000, -1000, -1000, 35176, -1000, 264, -1000, -1000, 20160, 20160,
+	-1000, -1000, -1000, -1000, 1713, 1711, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	8546, -1000, -1000, -1000, 1724, -1000, -1000, 8546, 1707, 1583,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 410, 35176,
+	1076, -1000, 1294, 1514, 27664, 1293, 1710, 415, -1000, 1709,
+	-1000, 820, 35176, 35176, -1000, 939, -1000, 1269, 2229, 2248,
+	2229, -1000, -1000, -1000, -1000, 2301, -1000, 2295, -1000, -1000,
+	939, -1000, -1000, -1000, -1000, -1000, 694, -1000, 2500, 668,
+	668, 668, 612, 1839, 886, 1838, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2564, 2555, 27128, 2564, -1000, -319, 1325, -1000,
+	1512, 138, 1438, 35176, -1000, -1000, -1000, 1833, 1832, -275,
+	30, 2554, 2553, 2593, -1000, 1831, 878, -269, -1000, -1000,
+	869, -1000, -1000, -1000, -269, -1000, 869, -1000, -1000, -1000,
+	1455, -1000, 154, -1000, -1000, -1000, -1000, -1000, -1000, 75,
+	-1000, 35176, -1000, 1704, 1702, 89, -1000, 1401, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2325, -1000, 2361, -271, -1000, -1000, -1000,
-	-1000, -1000, -1000, 33569, -1000, 1288, -1000, -1000, 1582, 51,
-	-1000, -1000, -1000, 1581, 1574, 1376, -1000, -1000, 1420, 964,
-	63, -1000, -1000, -1000, -1000, -1000, -1000, 1316, 32645, 1854,
-	-1000, 1125, 1125, 1125, 32645, 1695, 723, -1000, 1692, 1690,
-	387, 1200, 1199, -1000, 1373, 19562, 24269, 23746, 739, -1000,
-	956, -1000, -1000, -1000, 2350, -1000, -1000, 2350, -1000, -1000,
-	2932, -1000, -1000, 1293, 10115, -1000, -1000, 1566, 18516, 535,
-	572, 1851, -1000, 276, 2409, -1000, 1262, 1261, -1000, 32645,
-	-1000, 1850, -1000, 1849, 2017, 245, 1839, 1838, 32645, 1680,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 322,
-	905, -1000, 1563, 1556, -1000, 51, 1554, -1000, -1000, -1000,
-	32645, 628, 628, 2325, 32645, 6967, -1000, -1000, 8546, 1837,
-	-1000, 8546, -1000, -1000, -1000, -1000, -1000, 1835, 2191, -1000,
-	-1000, -1000, -1000, -1000, -1000, 8546, 8546, -1000, -1000, 406,
-	10649, -287, 148, -1000, -1000, -1000, -273, 1553, -1000, -1000,
-	2360, 1551, 1501, 32645, -1000, 720, 720, 683, -1000, -1000,
-	-58, -1000, -1000, -1000, 1675, -1000, 1625, -22, -1000, 102,
-	8546, -273, -194, -1000, -1000, -1000, -1000, 262, -1000, -1000,
-	176, -1000, -1000, 1347, 420, -1000, -1000, 628, 22700, 19039,
-	18516, 1548, -1000, 33835, 12760, 124, 33835, 586, 1176, -1000,
-	1349, -1000, 1339, -1000, 2350, 739, 956, -1000, -1000, 1040,
-	-1000, -1000, -1000, -1000, 3593, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1338, 1834,
-	-96, -1000, -1000, 1833, 22700, 22700, 321, 321, 22700, 22700,
-	1831, 464, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	2317, -1000, -1000, 1429, 32645, 1429, 23223, -1000, 2358, 2356,
-	1429, 606, -1000, -312, 32645, 32645, -275, 1336, -1000, 1541,
-	9, -1000, -1000, 608, -277, -15, 1, -1000, -1000, -1000,
-	1630, -1000, 3828, -1000, -1000, -1000, 606, -275, 32645, 371,
-	1537, -1000, -1000, 174, -1000, -1000, 898, -1000, 1830, 8546,
-	-1000, -1000, -1000, 374, 33772, -1000, -1000, -1000, -58, 374,
-	369, 202, -1000, 1332, -1000, -1000, 2325, -1000, 1564, 8546,
-	1827, -184, 22700, 897, 896, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 872, 871, 22700, -1000, -1000, -1000, 265, -1000,
-	870, 867, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1826,
-	-1000, -1000, 2355, -1000, 1513, 417, 5, 1, -1000, 2353,
-	8, 2349, 2348, -1000, -1000, 4874, -266, -13, 301, -1000,
-	2226, -1000, -1000, 33, -1000, -1000, -1000, 22700, 2204, 1620,
-	248, 2347, 33835, -1000, -1000, 248, -1000, 229, -1000, 1123,
-	-1000, 1331, -1000, 2317, -1000, 1580, -1000, 2050, -1000, 100,
-	862, -1000, -1000, -1000, -1000, 848, -1000, -1000, -1000, 22177,
-	32645, 1501, -1000, 1825, 1324, 6, 4, 2342, -1000, 1501,
-	2339, 1501, 1501, 1254, -1000, -1000, -1000, -1000, -1000, 1510,
-	-1000, 197, -1000, -1000, 2204, -1000, 2331, 285, -1000, -1000,
-	-1000, -1000, 1319, -1000, -1000, 464, -1000, 2047, 2040, 2387,
-	-1000, -1000, -1000, -1000, 197, 197, 197, 197, 116, -1000,
-	-1000, 839, -1000, -1000, 2284, 17993, -6, -1000, -1000, -1000,
-	1508, -1000, 1501, -1000, -1000, 4874, -1000, -1000, 1125, 1458,
-	180, -1000, -1000, -1000, 21654, 256, 296, 286, -1000, 419,
-	-1000, -1000, -1000, -1000, 2389, -1000, 2406, 541, 541, -1000,
-	-1000, 32645, -1000, 32645, -1000, 838, -1000, -1000, -1000, 953,
-	-1000, -1000, -1000, -1000, -1000, 1315, -1000, 32645, -1000, 32645,
-	254, 1307, 10115, 1824, 10115, 1823, 267, 1813, -1000, -1000,
-	-1000, 1341, 288, -1000, -1000, 596, -1000, 1124, -1000, 21131,
-	32645, -1000, -1000, 806, 1805, 2330, -1000, 3415, 32645, 3018,
-	32645, 1803, 1112, 10115, -1000, -1000, -1000, 32645, 6444, -1000,
-	753, -1000, -1000, 368, 258, -1000, 796, -1000, 794, 20608,
-	1305, 1416, -1000, -1000, 1429, 32645, 787, -1000, 32645, 250,
-	-1000, -1000, -1000, 782, -1000, -1000, -1000, -1000, 368, 2112,
-	-1000, 1301, -1000, -1000, 33723, 490, -1000, -1000, 33723, 252,
-	-1000, 362, 1802, -1000, -1000, 764, -1000, 32645, 553, 8546,
-	-1000, 252, 33835, -1000, 8546, 749, -1000, 33835, 666, -1000,
-	-1000,
+	-1000, -1000, -1000, 8870, -1000, -1000, -1000, 2209, -1000, -1000,
+	8870, 1812, 1700, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2524, -1000, 2547, -275,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3358, -1000, 1424, -1000,
+	-1000, 1688, 85, -1000, -1000, -1000, 1678, 1677, 1544, -1000,
+	-1000, 1509, 966, 108, -1000, -1000, -1000, -1000, -1000, -1000,
+	1445, 35176, 1991, 1455, 1988, -1000, 1266, 1266, 1266, 35176,
+	1811, 873, -1000, 1810, 1808, 463, 1322, 1303, -1000, 1541,
+	20696, 26592, 26056, 912, -1000, 1096, -1000, -1000, -1000, 2567,
+	-1000, -1000, 2567, -1000, -1000, 36438, -1000, -1000, 1440, 10478,
+	-1000, -1000, 1674, 19624, 609, 649, 1987, -1000, 344, 2587,
+	-1000, 361, 1985, 1422, 1414, -1000, 35176, -1000, 1984, -1000,
+	1982, 2195, 292, 1981, 1980, 35176, 2205, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 418, 1072, -1000, 1673,
+	1662, -203, 85, 1656, -1000, -1000, -1000, 35176, 820, 820,
+	2524, 35176, 7251, -1000, -1000, 8870, 1978, -1000, 8870, -1000,
+	-1000, -1000, -1000, -1000, 1977, 2409, -1000, -1000, -1000, 8870,
+	-1000, -1000, -1000, 8870, 8870, -1000, -1000, 491, 11025, -301,
+	196, -1000, -1000, -1000, -281, 1655, -1000, -1000, 2543, 1654,
+	1640, 35176, -1000, 869, 869, 852, -1000, -1000, -53, -1000,
+	-1000, -1000, 2197, -1000, 2193, -22, -1000, 100, 8870, -281,
+	-190, -1000, -1000, -1000, -1000, 269, -1000, -1000, 214, -1000,
+	-1000, 1508, 450, -1000, -1000, 820, 8870, 825, 24984, 20160,
+	19624, 1652, -1000, 3607, 13189, 153, 3607, 729, 1299, -1000,
+	1538, -1000, 1536, -1000, 2567, 912, 1096, -1000, -1000, 1143,
+	-1000, -1000, -1000, -1000, 1553, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1534, 1975,
+	-128, 2434, 8870, -1000, -1000, 1973, 24984, 24984, 254, 254,
+	24984, 24984, 1972, 531, -1000, -1000, -1000, -1000, -1000, -1000,
+	235, -1000, -1000, -1000, 2515, -1000, -1000, 1401, 35176, 1401,
+	25520, -1000, 2542, 2541, 13725, 1401, 795, -1000, -319, 35176,
+	35176, -284, 1532, -1000, 1649, 34, -1000, -1000, 799, -286,
+	-14, 45, -1000, -1000, -1000, 1668, -1000, 4034, -1000, -1000,
+	-1000, 795, -284, 35176, 435, 1647, -1000, -1000, 192, -1000,
+	-1000, 2065, 1966, 1068, -1000, 1964, 8870, -1000, -1000, -1000,
+	452, 36130, -1000, -1000, -1000, -53, 452, 439, 216, -1000,
+	1528, -1000, -1000, 2524, -1000, 1648, 8870, 1961, 1959, 2033,
+	-183, 24984, 1052, 1030, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1029, 1022, 24984, -1000, -1000, -1000, 340, 35176,
+	-1000, 1020, 1005, -1000, -1000, -1000, -1000, 694, -1000, -1000,
+	-1000, 1956, -1000, -1000, 2538, -1000, 1646, 512, 49, 45,
+	-1000, 2536, 33, 2535, 2534, -1000, -1000, 5106, -270, -21,
+	460, -1000, 2433, -1000, -1000, 69, -1000, -1000, -1000, 8870,
+	-1000, 24984, 2421, 2014, 301, 2529, 3607, -1000, -1000, 301,
+	-1000, 262, -1000, 1280, -1000, 1521, -1000, 2515, -1000, 1845,
+	-1000, 8870, 358, 2246, -1000, 106, 998, -1000, -1000, -1000,
+	-1000, 994, -1000, 782, -1000, 1266, -1000, -1000, 24448, -1000,
+	35176, 1640, -1000, 1954, 1515, 30, 0, 2525, -1000, 1640,
+	2521, 1640, 1640, 1391, -1000, -1000, -1000, -1000, -1000, 1644,
+	-1000, 1837, 211, -1000, -1000, 2421, -1000, 2520, 335, -1000,
+	-1000, -1000, -1000, 1513, -1000, -1000, 531, 1797, -1000, -1000,
+	-1000, -1000, 2200, 2036, 2581, -1000, -1000, -1000, -1000, 211,
+	211, 211, 211, 177, 35176, 1503, -1000, -1000, 992, -1000,
+	-1000, 2461, 19088, 28, -1000, -1000, -1000, 1641, -1000, 1640,
+	-1000, -1000, 5106, -1000, -1000, -1000, 1266, 1559, 246, -1000,
+	-1000, 1266, -1000, 23912, 308, 303, 295, -1000, 474, -1000,
+	-1000, -1000, 358, -1000, 2585, -1000, 2582, 638, 638, -1000,
+	-1000, -1000, -1000, 35176, -1000, 35176, -1000, 988, -1000, -1000,
+	-1000, 1080, -1000, -1000, -1000, -1000, -1000, 1494, -1000, 35176,
+	1475, -1000, 35176, 312, 1459, 10478, 1953, 10478, 1950, 327,
+	1949, -1000, -1000, -1000, -1000, 1497, 351, -1000, -1000, 771,
+	-1000, 1223, -1000, 23376, 35176, -1000, -1000, -1000, 976, 1946,
+	2519, -1000, 3249, 35176, 3108, 35176, 1940, 1214, 10478, -1000,
+	-1000, -1000, 35176, 6715, -1000, 1075, -1000, -1000, 451, 317,
+	-1000, 972, -1000, 970, 22840, 1457, 2245, -1000, -1000, 1401,
+	35176, 965, -1000, 35176, 307, -1000, -1000, -1000, 902, -1000,
+	-1000, -1000, -1000, 451, 2356, -1000, 1435, -1000, -1000, 36050,
+	567, -1000, -1000, 36050, 304, -1000, 423, 1803, -1000, -1000,
+	898, -1000, 35176, 624, 8870, -1000, 304, 3607, -1000, 8870,
+	897, -1000, 3607, 848, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 140, 2427, 209, 138, 2900, 62, 210, 173, 168,
-	208, 2899, 2898, 2249, 2243, 2897, 2896, 2891, 2888, 2887,
-	2885, 2883, 2881, 2877, 2876, 2875, 2874, 2870, 2869, 2868,
-	2867, 2866, 2865, 2862, 2860, 2858, 2857, 2856, 2855, 207,
-	2854, 2853, 2852, 2849, 2848, 2847, 2846, 2845, 2844, 2840,
-	2839, 2838, 2837, 2836, 2835, 2834, 2833, 2832, 2831, 2829,
-	2827, 2826, 2823, 2822, 2821, 2820, 2818, 2817, 2814, 166,
-	2813, 2230, 2811, 2810, 2809, 2808, 2807, 2806, 2805, 184,
-	2804, 2802, 2801, 2800, 2799, 2796, 2794, 2793, 2792, 2791,
-	2790, 2787, 2786, 2785, 2784, 2783, 2782, 180, 2781, 134,
-	158, 2778, 2775, 2774, 2773, 2772, 197, 190, 42, 2771,
-	37, 2770, 182, 2769, 116, 2767, 112, 2766, 2765, 2764,
-	2763, 2762, 2760, 2759, 2758, 2757, 2755, 2754, 2753, 68,
-	2750, 2749, 147, 155, 217, 1858, 213, 214, 153, 143,
-	91, 2746, 2223, 2744, 121, 194, 123, 23, 2743, 132,
-	2735, 128, 31, 25, 206, 107, 40, 125, 98, 2734,
-	187, 65, 2733, 80, 2732, 2730, 220, 159, 2728, 88,
-	2727, 2726, 2725, 2723, 185, 157, 2719, 2718, 99, 2716,
-	2715, 101, 2714, 46, 2713, 131, 2710, 1451, 96, 92,
-	2709, 2708, 2706, 81, 2705, 2704, 2703, 2702, 148, 2701,
-	2700, 108, 63, 2697, 2695, 2694, 45, 2692, 47, 2691,
-	52, 2690, 2689, 2687, 2684, 49, 2683, 2682, 16, 20,
-	22, 2681, 19, 2680, 130, 2679, 2677, 2676, 3, 2675,
-	191, 170, 74, 113, 2674, 348, 2673, 2672, 2671, 119,
-	2670, 503, 2669, 2668, 2667, 2664, 2663, 95, 2662, 189,
-	36, 2661, 71, 100, 86, 163, 165, 2660, 2659, 2657,
-	93, 83, 54, 0, 2654, 118, 2653, 2652, 2651, 222,
-	2650, 198, 169, 196, 122, 205, 188, 2649, 2648, 67,
-	2644, 124, 69, 89, 8, 2643, 144, 2642, 799, 142,
-	2641, 162, 2640, 120, 2, 111, 2639, 2638, 34, 218,
-	2637, 2636, 2635, 85, 2632, 2631, 90, 115, 2630, 2629,
-	2628, 33, 2627, 29, 27, 2626, 79, 2625, 216, 2624,
-	137, 104, 145, 141, 117, 192, 195, 55, 53, 2623,
-	1215, 114, 72, 21, 2621, 193, 2620, 239, 211, 2619,
-	154, 2616, 202, 316, 164, 2615, 146, 12, 35, 28,
-	2614, 9, 2611, 102, 160, 2610, 2609, 17, 2608, 18,
-	2606, 2604, 2603, 2602, 5, 2601, 2596, 2590, 1, 7,
-	2588, 4, 178, 2587, 2585, 2581, 2577, 38, 151, 2574,
-	87, 179, 2573, 2572, 70, 2571, 2570, 2569, 1497, 2564,
-	2563, 2562, 2560, 2558, 2557, 2556, 2544, 2543, 2537, 73,
-	43, 2536, 2534, 2533, 2532, 59, 105, 2530, 2528, 2524,
-	2523, 30, 136, 2519, 15, 2518, 26, 24, 32, 2515,
-	103, 2514, 13, 150, 2512, 2511, 14, 2508, 2506, 10,
-	11, 2503, 2496, 94, 2495, 66, 41, 129, 77, 2488,
-	64, 167, 110, 2487, 2486, 201, 199, 161, 2484, 133,
-	212, 215, 2479, 175, 2478, 2475, 2474, 2467, 2466, 2463,
-	713, 2462, 2461, 200, 44, 76, 82, 2460, 2459, 2458,
-	60, 127, 84, 78, 171, 2457, 156, 2456, 2454, 75,
-	2453, 2452, 2447, 2441, 2440, 152, 2432, 2431, 2430, 2429,
-	203, 181, 2424,
+	0, 142, 2624, 212, 140, 3183, 62, 219, 175, 171,
+	218, 3182, 3181, 2363, 2357, 3179, 3176, 3175, 3174, 3173,
+	3172, 3171, 3170, 3169, 3168, 3167, 3163, 3160, 3159, 3158,
+	3157, 3152, 3151, 3150, 3149, 3146, 3145, 3144, 3143, 3141,
+	3140, 215, 3139, 3137, 3135, 3131, 3130, 3129, 3128, 3127,
+	3126, 3125, 3124, 3123, 3115, 3113, 3111, 3109, 3092, 3084,
+	3083, 3078, 3070, 3069, 3068, 3067, 3064, 3061, 3060, 3059,
+	3058, 3057, 158, 3056, 2347, 3054, 3053, 3052, 3050, 3049,
+	3039, 3038, 197, 3037, 3035, 3034, 3032, 3030, 3029, 3028,
+	3025, 3024, 3021, 3020, 3017, 3016, 3015, 3014, 3013, 3012,
+	178, 3009, 136, 200, 3008, 3007, 3000, 2991, 2990, 2988,
+	209, 213, 54, 2985, 41, 2981, 189, 2980, 121, 2979,
+	117, 2977, 2973, 2972, 2971, 2970, 2969, 2967, 2962, 2961,
+	2960, 2958, 2957, 69, 2955, 2951, 146, 198, 224, 1989,
+	226, 225, 159, 138, 88, 2950, 2402, 2949, 129, 202,
+	124, 26, 2947, 144, 2946, 125, 37, 33, 223, 115,
+	44, 139, 99, 2945, 196, 75, 2944, 83, 2942, 2941,
+	222, 161, 2939, 95, 2938, 2937, 2936, 2929, 188, 168,
+	2923, 2922, 101, 2921, 2920, 91, 2919, 49, 2918, 135,
+	2917, 97, 85, 93, 2916, 2915, 2914, 113, 2913, 2910,
+	2909, 2908, 152, 2906, 2905, 112, 70, 2903, 2902, 2901,
+	51, 2900, 46, 2899, 61, 2898, 2897, 2893, 2892, 55,
+	2890, 2889, 12, 21, 24, 2886, 22, 2884, 134, 2880,
+	2879, 2878, 2, 2877, 193, 94, 78, 120, 2876, 354,
+	2874, 2873, 2871, 127, 2869, 352, 2868, 2867, 2865, 2864,
+	2860, 23, 2859, 174, 43, 2858, 71, 107, 118, 166,
+	167, 2857, 2856, 2853, 96, 86, 56, 0, 2852, 128,
+	2851, 2848, 2846, 232, 2845, 204, 169, 206, 105, 229,
+	201, 2844, 2838, 72, 2837, 131, 73, 104, 8, 2836,
+	187, 2835, 1264, 156, 2834, 172, 2833, 126, 1, 119,
+	2832, 2830, 38, 231, 2829, 2828, 2827, 106, 2826, 2825,
+	160, 98, 2824, 2823, 2822, 36, 2820, 30, 32, 2817,
+	80, 2816, 220, 2815, 151, 114, 153, 143, 123, 191,
+	203, 64, 57, 2814, 1344, 122, 77, 25, 2813, 199,
+	2811, 221, 195, 2810, 163, 2808, 216, 327, 176, 2807,
+	157, 10, 39, 28, 2806, 16, 2805, 103, 148, 2804,
+	2800, 18, 2794, 19, 2792, 2790, 2788, 2787, 15, 5,
+	2785, 2784, 2781, 4, 7, 2780, 3, 185, 2779, 2777,
+	2776, 2775, 42, 102, 2774, 110, 173, 2770, 2769, 76,
+	2768, 2767, 2766, 949, 2765, 2764, 2762, 2761, 2760, 2759,
+	2757, 2755, 2754, 2753, 74, 48, 2752, 2750, 2749, 2748,
+	65, 116, 2745, 2744, 2743, 2742, 31, 145, 2739, 17,
+	2737, 29, 27, 34, 2736, 92, 2732, 11, 164, 2725,
+	2724, 14, 2723, 2721, 9, 13, 2720, 2719, 90, 2718,
+	68, 45, 133, 82, 2705, 67, 186, 108, 2700, 2699,
+	211, 210, 170, 2692, 147, 207, 227, 2683, 180, 2682,
+	2681, 2679, 2677, 192, 2676, 847, 2674, 2673, 214, 47,
+	79, 89, 2670, 2666, 2665, 66, 132, 87, 2657, 20,
+	2656, 84, 184, 2653, 165, 2652, 2650, 81, 2643, 35,
+	2641, 2639, 2635, 162, 2629, 2614, 2609, 2607, 208, 183,
+	2605,
 }
 
-//line mysql_sql.y:9375
+//line mysql_sql.y:9627
 type yySymType struct {
 	union interface{}
 	id    int
@@ -5324,6 +5596,21 @@ func (st *yySymType) accountIdentifiedUnion() tree.AccountIdentified {
 	return v
 }
 
+func (st *yySymType) accountQuotaUnion() tree.AccountQuota {
+	v, _ := st.union.(tree.AccountQuota)
+	return v
+}
+
+func (st *yySymType) accountQuotaOptionUnion() tree.AccountQuotaOption {
+	v, _ := st.union.(tree.AccountQuotaOption)
+	return v
+}
+
+func (st *yySymType) accountQuotaOptionsUnion() []tree.AccountQuotaOption {
+	v, _ := st.union.([]tree.AccountQuotaOption)
+	return v
+}
+
 func (st *yySymType) accountRoleUnion() *tree.Role {
 	v, _ := st.union.(*tree.Role)
 	return v
@@ -6160,177 +6447,182 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 484, 488, 488, 5, 5, 2, 6, 6, 3,
+	0, 492, 496, 496, 5, 5, 2, 6, 6, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	1, 1, 1, 1, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 104, 482, 482, 482, 483, 483, 101, 121,
-	120, 123, 123, 122, 122, 119, 119, 115, 118, 118,
-	117, 117, 116, 111, 113, 113, 112, 114, 114, 102,
-	90, 103, 432, 432, 431, 431, 430, 430, 385, 385,
-	429, 429, 429, 428, 428, 428, 427, 427, 426, 426,
-	425, 425, 423, 423, 424, 422, 421, 421, 421, 419,
-	419, 419, 415, 415, 417, 416, 416, 418, 410, 410,
-	413, 413, 411, 411, 411, 411, 414, 409, 409, 409,
-	408, 408, 89, 89, 89, 332, 332, 88, 88, 346,
-	346, 346, 346, 346, 344, 344, 344, 344, 344, 344,
-	343, 343, 342, 342, 347, 347, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 345, 345, 345, 345,
-	345, 345, 345, 345, 345, 345, 80, 80, 80, 80,
-	83, 83, 83, 84, 341, 341, 341, 81, 82, 82,
-	331, 331, 336, 336, 335, 335, 335, 335, 335, 335,
-	335, 335, 335, 335, 335, 335, 340, 340, 340, 338,
-	338, 337, 337, 339, 339, 74, 74, 74, 77, 76,
-	330, 330, 330, 330, 330, 330, 330, 330, 330, 75,
-	75, 75, 75, 75, 75, 70, 70, 70, 70, 70,
-	69, 69, 71, 71, 328, 328, 327, 85, 85, 86,
-	486, 486, 485, 487, 487, 487, 487, 87, 93, 93,
-	93, 93, 93, 93, 93, 92, 92, 95, 95, 94,
-	96, 79, 79, 79, 79, 79, 78, 78, 78, 78,
-	78, 78, 78, 78, 78, 456, 456, 456, 458, 458,
-	267, 268, 489, 270, 266, 266, 266, 452, 452, 453,
-	454, 455, 455, 455, 91, 11, 11, 11, 11, 11,
-	11, 68, 73, 223, 223, 224, 224, 224, 224, 225,
-	225, 225, 225, 225, 226, 227, 227, 66, 72, 72,
-	469, 469, 67, 476, 476, 388, 388, 281, 281, 280,
-	280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
-	280, 280, 280, 280, 280, 392, 393, 277, 39, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 46, 45, 45, 45, 317,
-	317, 44, 490, 490, 256, 256, 55, 48, 56, 57,
-	58, 59, 60, 61, 43, 54, 54, 54, 54, 54,
-	54, 54, 54, 54, 64, 64, 404, 404, 492, 492,
-	492, 62, 63, 387, 387, 387, 53, 52, 51, 50,
-	49, 49, 42, 42, 41, 41, 47, 127, 128, 274,
-	274, 274, 276, 276, 272, 491, 491, 359, 359, 275,
-	275, 40, 40, 40, 40, 65, 273, 273, 255, 271,
-	271, 271, 12, 12, 10, 15, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 23, 24, 26,
-	325, 325, 322, 25, 18, 17, 20, 16, 19, 21,
-	22, 22, 9, 9, 9, 9, 13, 13, 14, 139,
-	139, 188, 188, 464, 464, 460, 460, 461, 461, 461,
-	462, 462, 463, 463, 97, 398, 398, 398, 398, 398,
-	398, 8, 162, 162, 161, 161, 397, 397, 397, 397,
-	397, 397, 329, 329, 441, 441, 441, 442, 160, 160,
-	155, 155, 399, 399, 295, 443, 443, 407, 407, 406,
-	406, 405, 405, 158, 158, 159, 159, 142, 142, 107,
-	107, 412, 412, 412, 412, 420, 420, 384, 384, 215,
-	215, 250, 250, 251, 251, 132, 132, 133, 133, 133,
-	133, 133, 133, 449, 449, 451, 451, 450, 157, 157,
-	153, 153, 154, 154, 154, 152, 152, 151, 150, 150,
-	149, 147, 147, 147, 148, 148, 148, 135, 135, 135,
-	134, 134, 134, 134, 134, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 136, 136, 457,
-	457, 457, 389, 389, 389, 395, 395, 232, 232, 233,
-	233, 231, 231, 137, 137, 138, 138, 138, 138, 230,
-	230, 229, 140, 140, 146, 145, 145, 141, 141, 141,
-	141, 240, 240, 239, 239, 239, 239, 100, 105, 105,
-	106, 165, 165, 238, 237, 237, 237, 164, 164, 163,
-	163, 156, 156, 144, 144, 144, 144, 236, 143, 234,
-	481, 481, 480, 480, 479, 477, 477, 477, 478, 478,
-	478, 478, 434, 434, 434, 434, 434, 261, 261, 261,
-	265, 265, 264, 264, 264, 264, 264, 269, 7, 7,
-	7, 7, 7, 30, 30, 30, 30, 30, 30, 30,
-	30, 36, 173, 174, 37, 175, 175, 176, 176, 177,
-	177, 178, 179, 180, 180, 180, 180, 35, 166, 166,
-	167, 167, 168, 168, 169, 170, 170, 170, 172, 171,
-	34, 27, 465, 468, 466, 466, 470, 470, 470, 471,
-	471, 471, 472, 472, 28, 124, 129, 129, 126, 131,
-	131, 131, 131, 131, 125, 467, 473, 473, 473, 326,
-	326, 323, 324, 324, 321, 320, 320, 320, 475, 475,
-	474, 474, 474, 262, 262, 29, 316, 316, 318, 319,
-	319, 319, 310, 310, 310, 310, 33, 314, 314, 315,
-	315, 315, 315, 315, 311, 311, 313, 313, 309, 309,
-	309, 309, 309, 32, 130, 130, 308, 308, 306, 306,
-	304, 304, 305, 305, 303, 303, 303, 307, 307, 31,
-	31, 31, 109, 108, 108, 108, 253, 253, 252, 252,
-	110, 38, 200, 200, 373, 373, 373, 373, 373, 391,
-	391, 391, 374, 374, 374, 375, 375, 375, 376, 376,
-	376, 376, 376, 390, 390, 348, 348, 349, 349, 349,
-	352, 352, 365, 365, 366, 366, 364, 364, 371, 371,
-	370, 370, 369, 369, 368, 368, 367, 367, 367, 367,
-	362, 362, 361, 361, 350, 350, 350, 350, 350, 351,
-	351, 351, 360, 360, 363, 363, 206, 206, 207, 207,
-	207, 228, 228, 228, 228, 228, 228, 228, 228, 228,
-	228, 228, 228, 228, 228, 228, 228, 228, 228, 228,
-	228, 228, 228, 228, 228, 228, 228, 228, 228, 228,
-	439, 439, 440, 209, 209, 209, 213, 213, 213, 213,
-	213, 213, 208, 208, 210, 210, 189, 189, 187, 187,
-	181, 181, 182, 182, 183, 183, 183, 186, 186, 184,
-	184, 185, 185, 185, 185, 334, 334, 437, 437, 438,
-	438, 433, 433, 433, 436, 436, 436, 436, 436, 435,
-	435, 190, 248, 248, 248, 263, 263, 263, 263, 247,
-	247, 247, 205, 205, 204, 204, 202, 202, 202, 202,
-	202, 202, 202, 202, 202, 202, 202, 202, 202, 202,
-	202, 333, 333, 278, 278, 279, 279, 222, 221, 221,
-	221, 221, 221, 219, 220, 218, 218, 218, 218, 218,
-	217, 217, 216, 216, 216, 312, 312, 214, 214, 212,
-	212, 212, 211, 211, 211, 372, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 284, 284, 284, 286,
-	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
-	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
-	246, 287, 287, 292, 292, 448, 448, 447, 191, 191,
-	191, 192, 192, 192, 192, 192, 192, 192, 192, 192,
-	201, 201, 201, 357, 357, 357, 357, 357, 358, 358,
-	358, 355, 355, 356, 356, 296, 297, 297, 396, 396,
-	353, 353, 354, 245, 245, 245, 245, 245, 245, 245,
-	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
-	403, 403, 403, 242, 242, 242, 242, 242, 242, 242,
-	242, 242, 242, 242, 242, 242, 242, 242, 242, 459,
-	459, 459, 444, 444, 444, 445, 445, 445, 445, 445,
-	445, 445, 445, 445, 445, 445, 445, 446, 446, 446,
-	446, 446, 446, 446, 446, 446, 446, 446, 446, 446,
-	446, 446, 446, 446, 244, 244, 244, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 298, 298, 299, 299, 400, 400, 400, 400,
-	400, 400, 401, 401, 402, 402, 402, 402, 394, 394,
-	394, 394, 394, 394, 394, 394, 394, 394, 394, 394,
-	394, 394, 394, 394, 394, 394, 394, 394, 394, 394,
-	394, 394, 394, 394, 394, 394, 394, 285, 241, 241,
-	241, 300, 293, 293, 294, 294, 288, 288, 288, 288,
+	4, 4, 4, 108, 108, 108, 107, 490, 490, 490,
+	491, 491, 104, 125, 124, 127, 127, 126, 126, 123,
+	123, 119, 122, 122, 121, 121, 120, 115, 117, 117,
+	116, 118, 118, 105, 93, 106, 437, 437, 436, 436,
+	435, 435, 390, 390, 434, 434, 434, 433, 433, 433,
+	432, 432, 431, 431, 430, 430, 428, 428, 429, 427,
+	426, 426, 426, 424, 424, 424, 420, 420, 422, 421,
+	421, 423, 415, 415, 418, 418, 416, 416, 416, 416,
+	419, 414, 414, 414, 413, 413, 92, 92, 92, 336,
+	336, 91, 91, 350, 350, 350, 350, 350, 348, 348,
+	348, 348, 348, 348, 347, 347, 346, 346, 351, 351,
+	349, 349, 349, 349, 349, 349, 349, 349, 349, 349,
+	349, 349, 349, 349, 349, 349, 349, 349, 349, 349,
+	349, 349, 349, 349, 349, 349, 349, 349, 349, 349,
+	349, 349, 349, 349, 349, 349, 349, 349, 349, 349,
+	349, 349, 349, 349, 349, 349, 349, 349, 349, 349,
+	349, 83, 83, 83, 83, 86, 86, 86, 87, 345,
+	345, 345, 84, 85, 85, 335, 335, 340, 340, 339,
+	339, 339, 339, 339, 339, 339, 339, 339, 339, 339,
+	339, 339, 344, 344, 344, 342, 342, 341, 341, 343,
+	343, 77, 77, 77, 80, 79, 334, 334, 334, 334,
+	334, 334, 334, 334, 334, 78, 78, 78, 78, 78,
+	78, 73, 73, 73, 73, 73, 72, 72, 74, 74,
+	332, 332, 331, 88, 88, 89, 494, 494, 493, 495,
+	495, 495, 495, 90, 96, 96, 96, 96, 96, 96,
+	96, 95, 95, 98, 98, 97, 99, 82, 82, 82,
+	82, 82, 81, 81, 81, 81, 81, 81, 81, 81,
+	81, 81, 81, 461, 461, 461, 463, 463, 463, 271,
+	272, 497, 274, 270, 270, 270, 457, 457, 458, 459,
+	460, 460, 460, 94, 94, 94, 11, 11, 11, 11,
+	11, 11, 71, 76, 227, 227, 228, 228, 228, 228,
+	229, 229, 229, 229, 229, 230, 231, 231, 69, 478,
+	478, 480, 480, 479, 75, 75, 474, 474, 70, 484,
+	484, 393, 393, 285, 285, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 397, 398, 281, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 41, 41, 41, 41, 41, 41, 41, 41,
+	41, 41, 48, 47, 47, 47, 321, 321, 46, 498,
+	498, 260, 260, 57, 50, 68, 68, 68, 58, 59,
+	60, 61, 62, 63, 45, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 66, 66, 409, 409, 500, 500,
+	500, 64, 65, 392, 392, 392, 55, 54, 53, 52,
+	51, 51, 44, 44, 43, 43, 49, 131, 132, 278,
+	278, 278, 280, 280, 276, 499, 499, 363, 363, 279,
+	279, 42, 42, 42, 42, 67, 277, 277, 259, 275,
+	275, 275, 12, 12, 10, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 15, 15, 15, 23, 24,
+	26, 329, 329, 326, 25, 27, 18, 17, 20, 16,
+	19, 21, 22, 22, 9, 9, 9, 9, 13, 13,
+	14, 143, 143, 192, 192, 469, 469, 465, 465, 466,
+	466, 466, 467, 467, 468, 468, 100, 403, 403, 403,
+	403, 403, 403, 8, 166, 166, 165, 165, 402, 402,
+	402, 402, 402, 402, 333, 333, 446, 446, 446, 447,
+	164, 164, 159, 159, 404, 404, 299, 448, 448, 412,
+	412, 411, 411, 410, 410, 162, 162, 163, 163, 146,
+	146, 111, 111, 417, 417, 417, 417, 425, 425, 389,
+	389, 219, 219, 254, 254, 255, 255, 136, 136, 137,
+	137, 137, 137, 137, 137, 454, 454, 456, 456, 455,
+	161, 161, 157, 157, 158, 158, 158, 156, 156, 155,
+	154, 154, 153, 151, 151, 151, 152, 152, 152, 139,
+	139, 139, 138, 138, 138, 138, 138, 239, 239, 239,
+	239, 239, 239, 239, 239, 239, 239, 239, 239, 140,
+	140, 462, 462, 462, 394, 394, 394, 400, 400, 236,
+	236, 237, 237, 235, 235, 141, 141, 142, 142, 142,
+	142, 234, 234, 233, 144, 144, 150, 149, 149, 145,
+	145, 145, 145, 244, 244, 243, 243, 243, 243, 103,
+	109, 109, 110, 169, 169, 242, 241, 241, 241, 168,
+	168, 167, 167, 160, 160, 148, 148, 148, 148, 240,
+	147, 238, 238, 489, 489, 488, 488, 487, 485, 485,
+	485, 486, 486, 486, 486, 439, 439, 439, 439, 439,
+	265, 265, 265, 269, 269, 268, 268, 268, 268, 268,
+	273, 7, 7, 7, 7, 7, 7, 32, 32, 32,
+	32, 32, 32, 32, 32, 38, 177, 178, 39, 179,
+	179, 180, 180, 181, 181, 182, 183, 184, 184, 184,
+	184, 37, 170, 170, 171, 171, 172, 172, 173, 174,
+	174, 174, 176, 175, 36, 28, 470, 473, 471, 471,
+	475, 475, 475, 476, 476, 476, 477, 477, 29, 128,
+	133, 133, 130, 135, 135, 135, 135, 135, 129, 472,
+	481, 481, 481, 330, 330, 327, 328, 328, 325, 324,
+	324, 324, 483, 483, 482, 482, 482, 266, 266, 30,
+	31, 31, 320, 320, 322, 323, 323, 323, 314, 314,
+	314, 314, 35, 318, 318, 319, 319, 319, 319, 319,
+	319, 315, 315, 317, 317, 313, 313, 313, 313, 313,
+	313, 34, 134, 134, 312, 312, 310, 310, 308, 308,
+	309, 309, 307, 307, 307, 311, 311, 33, 33, 33,
+	113, 112, 112, 112, 257, 257, 256, 256, 114, 40,
+	204, 204, 378, 378, 378, 378, 378, 396, 396, 396,
+	379, 379, 379, 380, 380, 380, 381, 381, 381, 381,
+	381, 395, 395, 352, 352, 353, 353, 353, 356, 356,
+	370, 370, 371, 371, 369, 369, 376, 376, 375, 375,
+	374, 374, 373, 373, 372, 372, 372, 372, 366, 366,
+	365, 365, 354, 354, 354, 354, 354, 355, 355, 355,
+	364, 364, 367, 367, 210, 210, 211, 211, 211, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 444, 444,
+	445, 213, 213, 213, 217, 217, 217, 217, 217, 217,
+	212, 212, 214, 214, 193, 193, 191, 191, 185, 185,
+	186, 186, 187, 187, 187, 190, 190, 188, 188, 189,
+	189, 189, 189, 338, 338, 442, 442, 443, 443, 438,
+	438, 438, 441, 441, 441, 441, 441, 441, 440, 440,
+	194, 252, 252, 252, 267, 267, 267, 267, 251, 251,
+	251, 209, 209, 208, 208, 206, 206, 206, 206, 206,
+	206, 206, 206, 206, 206, 206, 206, 206, 206, 206,
+	206, 206, 368, 368, 368, 337, 337, 282, 282, 283,
+	283, 226, 225, 225, 225, 225, 225, 223, 224, 222,
+	222, 222, 222, 222, 221, 221, 220, 220, 220, 316,
+	316, 218, 218, 216, 216, 216, 215, 215, 215, 377,
+	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
 	288, 288, 288, 290, 290, 290, 290, 290, 290, 290,
-	290, 290, 290, 290, 283, 283, 283, 283, 283, 283,
-	283, 283, 283, 283, 283, 289, 289, 291, 291, 302,
-	302, 302, 301, 301, 301, 301, 301, 301, 301, 203,
-	203, 203, 203, 282, 282, 282, 282, 282, 282, 282,
-	282, 282, 282, 282, 193, 193, 193, 193, 197, 197,
-	199, 199, 199, 199, 199, 199, 199, 199, 199, 199,
-	199, 199, 199, 199, 198, 198, 198, 198, 196, 196,
-	196, 196, 196, 194, 194, 194, 194, 194, 194, 194,
-	194, 194, 194, 194, 194, 194, 194, 194, 194, 98,
-	99, 99, 195, 249, 249, 377, 377, 380, 380, 378,
-	378, 379, 381, 381, 381, 382, 382, 382, 383, 383,
-	383, 386, 386, 254, 254, 254, 260, 260, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 258, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 257, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
-	257, 257,
+	290, 290, 290, 290, 290, 290, 290, 290, 290, 290,
+	290, 290, 290, 290, 250, 250, 291, 291, 296, 296,
+	453, 453, 452, 195, 195, 195, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 205, 205, 205, 361, 361,
+	361, 361, 361, 362, 362, 362, 359, 359, 360, 360,
+	300, 301, 301, 401, 401, 357, 357, 358, 249, 249,
+	249, 249, 249, 249, 249, 249, 249, 249, 249, 249,
+	249, 249, 249, 249, 249, 408, 408, 408, 246, 246,
+	246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
+	246, 246, 246, 246, 464, 464, 464, 449, 449, 449,
+	450, 450, 450, 450, 450, 450, 450, 450, 450, 450,
+	450, 450, 451, 451, 451, 451, 451, 451, 451, 451,
+	451, 451, 451, 451, 451, 451, 451, 451, 451, 248,
+	248, 248, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 302, 302, 303,
+	303, 405, 405, 405, 405, 405, 405, 406, 406, 407,
+	407, 407, 407, 399, 399, 399, 399, 399, 399, 399,
+	399, 399, 399, 399, 399, 399, 399, 399, 399, 399,
+	399, 399, 399, 399, 399, 399, 399, 399, 399, 399,
+	399, 399, 289, 245, 245, 245, 304, 297, 297, 298,
+	298, 292, 292, 292, 292, 292, 292, 292, 294, 294,
+	294, 294, 294, 294, 294, 294, 294, 294, 294, 287,
+	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
+	293, 293, 295, 295, 306, 306, 306, 305, 305, 305,
+	305, 305, 305, 305, 207, 207, 207, 207, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 197,
+	197, 197, 197, 201, 201, 203, 203, 203, 203, 203,
+	203, 203, 203, 203, 203, 203, 203, 203, 203, 202,
+	202, 202, 202, 200, 200, 200, 200, 200, 198, 198,
+	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 198, 198, 101, 102, 102, 199,
+	253, 253, 382, 382, 385, 385, 383, 383, 384, 386,
+	386, 386, 387, 387, 387, 388, 388, 388, 391, 391,
+	258, 258, 258, 264, 264, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 263,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261,
 }
 
 var yyR2 = [...]int{
@@ -6339,44 +6631,46 @@ var yyR2 = [...]int{
 	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 4, 0, 1, 1, 0, 1, 5, 2,
-	2, 6, 9, 6, 9, 4, 7, 8, 0, 1,
-	1, 2, 4, 6, 1, 2, 4, 0, 2, 10,
-	10, 2, 0, 2, 1, 3, 3, 3, 0, 2,
-	1, 3, 5, 0, 2, 3, 1, 3, 1, 1,
-	1, 3, 1, 1, 1, 1, 0, 3, 3, 0,
-	3, 3, 0, 1, 3, 0, 1, 3, 0, 2,
-	1, 2, 3, 4, 3, 3, 1, 0, 1, 1,
-	0, 1, 8, 5, 7, 0, 3, 8, 5, 1,
-	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 2, 2,
-	2, 2, 2, 1, 2, 2, 2, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 2, 2, 2,
-	1, 2, 1, 2, 2, 1, 2, 1, 1, 2,
-	2, 1, 1, 1, 3, 2, 2, 2, 2, 2,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	3, 4, 4, 6, 1, 1, 1, 2, 4, 6,
-	1, 4, 1, 3, 3, 4, 4, 4, 3, 3,
-	2, 4, 4, 2, 2, 2, 1, 1, 1, 1,
-	1, 1, 3, 1, 3, 1, 1, 1, 2, 2,
-	0, 1, 4, 2, 4, 1, 5, 3, 2, 1,
-	2, 2, 4, 4, 5, 2, 1, 3, 4, 4,
-	1, 2, 9, 7, 1, 3, 3, 1, 1, 3,
-	1, 3, 2, 1, 2, 1, 2, 2, 1, 1,
-	1, 1, 1, 1, 1, 4, 4, 2, 4, 3,
-	3, 1, 1, 1, 1, 1, 2, 3, 4, 7,
-	2, 3, 3, 4, 5, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 2,
-	1, 1, 1, 1, 6, 1, 1, 1, 1, 1,
-	1, 7, 4, 1, 3, 2, 2, 2, 1, 2,
-	2, 2, 3, 2, 3, 1, 1, 7, 7, 8,
-	0, 4, 7, 0, 3, 0, 2, 0, 1, 1,
-	1, 1, 4, 2, 2, 3, 3, 4, 5, 3,
-	4, 4, 2, 2, 2, 3, 0, 1, 1, 1,
+	1, 1, 1, 4, 5, 5, 4, 0, 1, 1,
+	0, 1, 5, 2, 2, 6, 9, 6, 9, 4,
+	7, 8, 0, 1, 1, 2, 4, 6, 1, 2,
+	4, 0, 2, 10, 10, 2, 0, 2, 1, 3,
+	3, 3, 0, 2, 1, 3, 5, 0, 2, 3,
+	1, 3, 1, 1, 1, 3, 1, 1, 1, 1,
+	0, 3, 3, 0, 3, 3, 0, 1, 3, 0,
+	1, 3, 0, 2, 1, 2, 3, 4, 3, 3,
+	1, 0, 1, 1, 0, 1, 8, 5, 7, 0,
+	3, 8, 5, 1, 3, 3, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 4, 1, 3,
+	1, 2, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 2, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 2, 2, 2, 1, 2, 1, 1, 2, 2,
+	1, 2, 1, 1, 2, 2, 1, 1, 1, 3,
+	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 3, 4, 4, 6, 1,
+	1, 1, 2, 4, 6, 1, 4, 1, 3, 3,
+	4, 4, 4, 3, 3, 3, 2, 4, 4, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 3, 1,
+	3, 1, 1, 1, 2, 2, 0, 1, 4, 2,
+	4, 1, 5, 3, 2, 1, 2, 2, 4, 4,
+	5, 2, 1, 3, 4, 4, 1, 2, 9, 7,
+	1, 3, 3, 1, 1, 3, 1, 3, 2, 1,
+	2, 1, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 4, 4, 2, 4, 3, 3, 1, 1, 1,
+	1, 1, 2, 3, 4, 7, 2, 5, 6, 3,
+	3, 4, 5, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 2, 1,
+	1, 1, 1, 3, 6, 7, 1, 1, 1, 1,
+	1, 1, 7, 4, 1, 3, 2, 2, 2, 1,
+	2, 2, 2, 3, 2, 3, 1, 1, 8, 0,
+	3, 1, 3, 3, 7, 8, 0, 4, 7, 0,
+	3, 0, 2, 0, 1, 1, 1, 1, 4, 2,
+	2, 3, 3, 4, 5, 3, 4, 4, 2, 2,
+	2, 3, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 2, 5, 5, 0,
-	2, 7, 0, 1, 0, 1, 5, 3, 3, 2,
+	1, 1, 4, 2, 5, 5, 0, 2, 7, 0,
+	1, 0, 1, 5, 3, 2, 4, 4, 3, 2,
 	4, 4, 4, 4, 4, 1, 1, 1, 3, 2,
 	3, 1, 1, 1, 6, 8, 0, 1, 1, 1,
 	1, 5, 5, 0, 1, 1, 3, 3, 3, 4,
@@ -6384,102 +6678,105 @@ var yyR2 = [...]int{
 	2, 2, 0, 2, 2, 1, 1, 1, 1, 0,
 	1, 4, 4, 5, 4, 3, 1, 3, 1, 1,
 	3, 5, 2, 3, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 4, 4, 4,
-	1, 3, 1, 4, 6, 4, 4, 4, 3, 6,
-	3, 5, 1, 1, 2, 2, 11, 8, 9, 1,
-	3, 2, 4, 0, 2, 0, 1, 1, 1, 1,
-	0, 1, 0, 1, 4, 2, 1, 5, 4, 4,
-	2, 5, 0, 2, 1, 3, 2, 1, 5, 4,
-	4, 2, 0, 5, 0, 1, 3, 3, 1, 3,
-	1, 3, 1, 3, 4, 0, 1, 0, 1, 1,
-	3, 1, 1, 0, 4, 1, 3, 2, 1, 0,
-	8, 0, 4, 7, 4, 0, 2, 0, 2, 0,
-	2, 0, 4, 1, 3, 1, 1, 4, 3, 4,
-	5, 4, 5, 2, 3, 1, 3, 6, 0, 3,
-	0, 1, 2, 4, 4, 0, 1, 3, 1, 3,
-	3, 0, 1, 1, 0, 2, 2, 3, 3, 3,
-	1, 3, 3, 3, 3, 1, 2, 2, 1, 2,
-	2, 1, 2, 2, 1, 2, 2, 7, 7, 1,
-	1, 1, 0, 1, 1, 1, 1, 0, 2, 0,
-	3, 0, 2, 1, 3, 1, 2, 3, 5, 0,
-	1, 2, 1, 3, 1, 1, 1, 4, 4, 4,
-	3, 2, 2, 2, 3, 2, 3, 4, 1, 3,
-	4, 0, 2, 1, 1, 2, 2, 0, 1, 2,
-	4, 1, 3, 1, 3, 2, 3, 1, 4, 3,
-	0, 1, 1, 2, 5, 2, 2, 2, 0, 2,
-	3, 3, 0, 1, 3, 1, 3, 0, 1, 2,
-	1, 1, 0, 1, 2, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	4, 1, 3, 1, 4, 6, 6, 4, 4, 4,
+	3, 6, 3, 5, 1, 1, 2, 2, 11, 8,
+	9, 1, 3, 2, 4, 0, 2, 0, 1, 1,
+	1, 1, 0, 1, 0, 1, 4, 2, 1, 5,
+	4, 4, 2, 5, 0, 2, 1, 3, 2, 1,
+	5, 4, 4, 2, 0, 5, 0, 1, 3, 3,
+	1, 3, 1, 3, 1, 3, 4, 0, 1, 0,
+	1, 1, 3, 1, 1, 0, 4, 1, 3, 2,
+	1, 0, 8, 0, 4, 7, 4, 0, 2, 0,
+	2, 0, 2, 0, 4, 1, 3, 1, 1, 4,
+	3, 4, 5, 4, 5, 2, 3, 1, 3, 6,
+	0, 3, 0, 1, 2, 4, 4, 0, 1, 3,
+	1, 3, 3, 0, 1, 1, 0, 2, 2, 3,
+	3, 3, 1, 3, 3, 3, 3, 1, 2, 2,
+	1, 2, 2, 1, 2, 2, 1, 2, 2, 7,
+	7, 1, 1, 1, 0, 1, 1, 1, 1, 0,
+	2, 0, 3, 0, 2, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 3, 1, 1, 1, 4,
+	4, 4, 3, 2, 2, 2, 3, 2, 3, 4,
+	1, 3, 4, 0, 2, 1, 1, 2, 2, 0,
+	1, 2, 4, 1, 3, 1, 3, 2, 3, 1,
+	4, 3, 7, 0, 1, 1, 2, 5, 2, 2,
+	2, 0, 2, 3, 3, 0, 1, 3, 1, 3,
+	0, 1, 2, 1, 1, 0, 1, 2, 1, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 7, 1, 1, 7, 1, 3, 0, 1, 1,
-	3, 1, 3, 0, 1, 1, 1, 12, 1, 3,
-	0, 1, 1, 3, 1, 1, 2, 4, 1, 1,
-	7, 7, 1, 4, 1, 1, 3, 4, 3, 0,
-	1, 1, 0, 2, 7, 8, 0, 2, 6, 0,
-	2, 2, 3, 3, 4, 1, 0, 2, 2, 1,
-	3, 2, 1, 3, 2, 1, 3, 2, 0, 1,
-	3, 4, 3, 1, 1, 4, 1, 3, 1, 1,
-	1, 1, 0, 1, 1, 1, 11, 0, 2, 3,
-	2, 3, 1, 1, 1, 3, 3, 4, 0, 2,
-	2, 2, 2, 6, 0, 4, 1, 1, 0, 3,
-	0, 1, 1, 2, 4, 4, 4, 0, 1, 11,
-	9, 11, 2, 2, 4, 5, 1, 3, 0, 3,
-	5, 10, 0, 2, 0, 3, 2, 4, 3, 0,
-	2, 1, 0, 2, 3, 0, 2, 3, 0, 3,
-	2, 4, 3, 0, 1, 0, 6, 0, 3, 5,
-	0, 4, 0, 3, 1, 3, 4, 5, 0, 3,
-	1, 3, 2, 3, 1, 2, 0, 4, 6, 5,
-	0, 2, 0, 2, 4, 5, 4, 5, 1, 5,
-	6, 5, 0, 3, 0, 1, 0, 1, 1, 3,
-	2, 3, 3, 4, 4, 3, 3, 3, 3, 4,
-	4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 4, 5, 4,
-	1, 3, 3, 0, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
-	0, 1, 1, 3, 1, 1, 1, 7, 7, 2,
-	1, 7, 7, 8, 5, 0, 1, 0, 1, 1,
-	1, 1, 3, 3, 1, 1, 1, 1, 1, 0,
-	1, 3, 1, 3, 5, 1, 1, 1, 1, 1,
-	3, 5, 0, 1, 1, 2, 1, 2, 2, 1,
-	1, 2, 2, 2, 2, 2, 1, 5, 6, 4,
-	1, 1, 2, 0, 1, 1, 2, 5, 0, 1,
-	1, 2, 2, 3, 3, 1, 1, 2, 2, 2,
-	0, 1, 2, 2, 2, 0, 3, 0, 3, 1,
-	1, 1, 1, 1, 1, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 1, 1,
-	1, 1, 3, 5, 2, 2, 2, 2, 1, 1,
-	2, 5, 6, 6, 6, 1, 1, 1, 1, 1,
-	4, 0, 2, 0, 1, 1, 2, 4, 1, 2,
-	2, 1, 2, 2, 1, 2, 2, 2, 2, 2,
-	0, 1, 1, 2, 2, 2, 2, 2, 1, 1,
-	1, 2, 5, 0, 1, 3, 0, 1, 0, 2,
-	0, 1, 6, 8, 6, 5, 5, 6, 6, 6,
-	6, 5, 6, 6, 6, 6, 6, 6, 6, 6,
-	1, 1, 1, 4, 4, 6, 8, 6, 4, 5,
-	4, 4, 4, 3, 4, 6, 6, 7, 4, 1,
+	1, 1, 1, 1, 1, 7, 1, 1, 7, 1,
+	3, 0, 1, 1, 3, 1, 3, 0, 1, 1,
+	1, 12, 1, 3, 0, 1, 1, 3, 1, 1,
+	2, 4, 1, 1, 7, 7, 1, 4, 1, 1,
+	3, 4, 3, 0, 1, 1, 0, 2, 7, 8,
+	0, 2, 6, 0, 2, 2, 3, 3, 4, 1,
+	0, 2, 2, 1, 3, 2, 1, 3, 2, 1,
+	3, 2, 0, 1, 3, 4, 3, 1, 1, 4,
+	10, 12, 1, 3, 1, 1, 1, 1, 0, 1,
+	1, 1, 11, 0, 2, 3, 2, 3, 1, 1,
+	3, 1, 3, 3, 4, 0, 2, 2, 2, 2,
+	2, 6, 0, 4, 1, 1, 0, 3, 0, 1,
+	1, 2, 4, 4, 4, 0, 1, 11, 9, 11,
+	2, 2, 4, 5, 1, 3, 0, 3, 5, 10,
+	0, 2, 0, 3, 2, 4, 3, 0, 2, 1,
+	0, 2, 3, 0, 2, 3, 0, 3, 2, 4,
+	3, 0, 1, 0, 6, 0, 3, 5, 0, 4,
+	0, 3, 1, 3, 4, 5, 0, 3, 1, 3,
+	2, 3, 1, 2, 0, 4, 6, 5, 0, 2,
+	0, 2, 4, 5, 4, 5, 1, 5, 6, 5,
+	0, 3, 0, 1, 0, 1, 1, 3, 2, 3,
+	3, 4, 4, 3, 3, 3, 3, 4, 4, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 4, 5, 4, 1, 3,
+	3, 0, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 3, 0, 1,
+	1, 3, 1, 1, 1, 7, 7, 2, 1, 7,
+	7, 8, 5, 0, 1, 0, 1, 1, 1, 1,
+	3, 3, 1, 1, 1, 1, 1, 1, 0, 1,
+	3, 1, 3, 5, 1, 1, 1, 1, 1, 3,
+	5, 0, 1, 1, 2, 1, 2, 2, 1, 1,
+	2, 2, 2, 2, 2, 1, 5, 6, 4, 1,
+	7, 5, 0, 1, 1, 1, 2, 0, 1, 1,
+	2, 5, 0, 1, 1, 2, 2, 3, 3, 1,
+	1, 2, 2, 2, 0, 1, 2, 2, 2, 0,
+	3, 0, 3, 1, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 1, 1, 1, 1, 3, 5, 2, 2,
+	2, 2, 1, 1, 2, 5, 6, 6, 6, 1,
+	1, 1, 1, 1, 4, 5, 0, 2, 0, 1,
+	1, 2, 4, 1, 2, 2, 1, 2, 2, 1,
+	2, 2, 2, 2, 2, 0, 1, 1, 2, 2,
+	2, 2, 2, 1, 1, 1, 2, 5, 0, 1,
+	3, 0, 1, 0, 2, 0, 1, 6, 8, 6,
+	5, 5, 6, 6, 6, 6, 5, 6, 6, 6,
+	6, 6, 6, 6, 6, 1, 1, 1, 4, 4,
+	6, 8, 6, 4, 5, 4, 4, 4, 3, 4,
+	6, 6, 7, 4, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 8, 4, 2, 3, 2, 4, 2, 2, 4,
+	6, 2, 2, 4, 6, 4, 2, 0, 1, 2,
+	3, 1, 1, 1, 1, 1, 1, 0, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 8, 4, 2, 3,
-	2, 4, 2, 2, 4, 6, 2, 2, 4, 6,
-	4, 2, 0, 1, 2, 3, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 0, 1,
-	1, 3, 0, 1, 1, 3, 3, 3, 3, 3,
-	2, 1, 1, 3, 4, 3, 4, 3, 4, 3,
-	4, 3, 4, 1, 3, 4, 4, 5, 4, 5,
-	3, 4, 5, 6, 1, 0, 2, 1, 1, 1,
+	1, 1, 3, 0, 1, 1, 3, 0, 1, 1,
+	3, 3, 3, 3, 3, 2, 1, 1, 3, 4,
+	3, 4, 3, 4, 3, 4, 3, 4, 1, 3,
+	4, 4, 5, 4, 5, 3, 4, 5, 6, 1,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 2, 3,
+	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 3, 1, 1, 1, 2, 1,
+	2, 2, 2, 1, 2, 2, 2, 2, 2, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 4, 4, 1, 4, 4, 2, 3, 5, 1,
+	1, 3, 0, 1, 0, 3, 0, 3, 3, 0,
+	3, 5, 0, 3, 5, 0, 1, 1, 0, 1,
+	1, 2, 2, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 1, 2,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 4, 4, 1, 2,
-	3, 5, 1, 1, 3, 0, 1, 0, 3, 0,
-	3, 3, 0, 3, 5, 0, 3, 5, 0, 1,
-	1, 0, 1, 1, 2, 2, 0, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -6504,617 +6801,639 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1,
+	1,
 }
 
 var yyChk = [...]int{
-	-1000, -484, -488, -2, -5, 551, -1, -4, -99, -74,
-	-7, -101, -102, -8, -97, -9, -10, -12, -78, -92,
-	-94, -96, -95, -39, -11, -91, -69, -70, -80, -85,
-	-88, -89, -90, -103, -98, -100, -132, -104, 544, -75,
-	-76, -77, -30, -29, -28, -27, -124, 550, 408, 12,
-	456, -13, -14, -449, -15, 237, -266, -267, -268, -270,
-	-489, -40, -41, -42, -49, -50, -51, -52, -53, -62,
-	-63, -64, -43, -44, -45, -48, -46, -55, -56, -57,
-	-58, -59, -60, -61, -47, -127, -128, -65, -67, -66,
-	-72, -68, -73, -126, 212, -71, 61, -81, -82, -83,
-	-84, -86, -87, 339, 345, 398, 543, 46, -133, -135,
-	554, 140, 141, 143, 144, -31, -32, -33, -34, -35,
-	-36, -38, -37, 208, 14, 489, -16, -19, -17, -20,
-	-18, -25, -26, -24, -21, -23, -125, -22, 232, 231,
-	37, 295, 296, 297, 343, 230, 209, 13, 30, 41,
-	321, -134, 67, 210, -136, 10, 556, -6, -3, -2,
-	-111, -115, -119, -122, -123, -120, -121, -4, -99, 98,
-	222, 545, -263, 335, 546, 548, 547, 70, 78, -257,
-	-259, 339, 345, 543, 492, 493, 494, 495, 496, 497,
-	498, 499, 500, 501, 502, 503, 504, 505, 506, 507,
-	508, 509, 510, 511, 512, 513, 515, 516, 517, 518,
-	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
-	459, 530, 531, 532, 533, 319, 320, 486, 249, 273,
-	369, 276, 283, 140, 159, 153, 181, 173, 148, 253,
-	288, 254, 77, 143, 442, 88, 420, 395, 146, 268,
-	270, 272, 275, 255, 169, 164, 267, 251, 162, 256,
-	39, 257, 186, 258, 259, 491, 416, 318, 422, 280,
-	393, 163, 417, 271, 419, 188, 192, 434, 157, 158,
-	424, 436, 185, 229, 42, 488, 241, 437, 190, 184,
-	180, 445, 284, 282, 183, 156, 179, 252, 50, 194,
-	193, 195, 389, 390, 391, 392, 260, 261, 333, 433,
-	176, 165, 323, 149, 22, 440, 236, 421, 346, 262,
-	277, 285, 189, 191, 243, 248, 490, 397, 247, 281,
-	438, 161, 240, 269, 235, 441, 150, 348, 263, 144,
-	274, 435, 444, 49, 361, 155, 147, 226, 141, 245,
-	250, 11, 264, 265, 266, 287, 286, 278, 177, 242,
-	182, 167, 154, 178, 142, 244, 443, 362, 541, 321,
-	378, 175, 172, 246, 219, 439, 423, 145, 382, 363,
-	170, 289, 537, 538, 539, 334, 317, 290, 291, 168,
-	233, 414, 415, 292, 294, 388, 310, 364, 396, 370,
-	365, 201, 298, 426, 428, 187, 540, 299, 300, 301,
-	418, 302, 303, 304, 305, -339, -337, -263, 543, 345,
-	339, 321, -175, -263, 555, -142, 38, -187, -263, -142,
-	-71, -14, -13, -134, -135, -187, 216, -271, 23, 393,
-	-79, 394, 212, 67, -263, -9, -8, -97, -69, -132,
-	-269, -263, 295, 295, -269, 216, -263, 247, 377, -388,
-	222, -343, -316, 248, -342, -318, -345, -319, 31, 208,
-	210, 209, 244, 14, 343, 218, 12, 10, 344, 230,
-	24, 25, 27, 13, 345, 347, 28, 348, 351, 352,
-	353, 41, 356, 357, 237, 70, 78, 73, 255, -174,
-	-263, -294, -288, 95, 238, -290, -283, -284, -286, -429,
-	-423, -282, 67, 122, 123, 130, 96, -285, -372, 35,
-	98, 496, 457, -242, -243, -244, -245, -246, -263, -424,
-	-422, 73, 79, 82, 85, 86, 84, 83, 166, 81,
-	74, 134, 135, -135, 70, -444, 504, -258, 525, 530,
-	531, 532, 533, 524, 46, -394, -402, 215, -400, 133,
-	171, 233, 167, 12, 128, 388, 168, 501, 521, 459,
-	505, 528, 498, 499, 493, 494, 495, 497, 506, 508,
-	520, -403, 516, 526, 527, 514, 529, 72, 71, 519,
-	518, 507, 502, 503, 509, 492, 500, 510, 511, 517,
-	522, 523, 327, 88, 328, 329, 449, 322, 330, 222,
-	393, 55, 331, 332, 333, 334, 335, 456, 336, 56,
-	337, 326, 237, 378, 338, 170, 187, 461, 460, 462,
-	453, 450, 448, 451, 452, 454, 455, 512, 513, 515,
-	-105, -106, 535, -151, -152, -235, 19, 6, 7, 8,
-	9, -482, 395, 490, 145, 142, -330, 145, 94, 150,
-	149, -330, 298, 243, 340, 219, 319, 378, 302, 247,
-	-310, -308, -390, 292, 288, 217, 227, 226, 89, 449,
-	215, 354, -460, -461, 205, 206, 207, -451, 482, -450,
-	-263, 329, 26, 216, 340, 428, 426, 427, 429, 430,
-	431, 432, -54, -404, -387, 423, 422, -275, 415, 421,
-	413, 425, 416, 320, 304, 303, 208, 552, 483, -254,
-	364, 396, 243, 446, 447, 341, 397, 434, 435, 417,
-	88, 174, 171, 219, 216, 319, 449, 378, 302, -460,
-	247, 377, 40, -336, 388, -335, -337, 434, 435, 445,
-	71, 72, 433, -254, 88, 414, 414, -152, -235, -151,
-	-133, -135, -100, -449, 217, 319, 378, 247, 218, 216,
-	219, 449, 295, 340, 243, 302, -389, -457, 31, -395,
-	202, 203, 204, 32, 33, -1, 102, 556, -288, -288,
-	-6, 557, -6, -288, -263, -263, 137, -193, -197, -194,
-	-196, -195, -199, -198, 171, 172, 133, 175, 177, 178,
-	179, 180, 181, 182, 183, 184, 185, 186, 30, 187,
-	233, 167, 168, 169, 170, 188, 153, 173, 487, 196,
-	154, 197, 155, 198, 156, 199, 157, 158, 200, 159,
-	162, 163, 164, 161, 136, 67, 136, 73, -158, 239,
-	-187, 136, -158, -152, -151, -152, -187, -247, -263, 395,
-	105, -79, -79, 394, -452, -453, -454, -456, 212, 394,
-	393, 136, 15, -269, -269, 65, -187, -318, 247, -343,
-	-316, 35, 64, 137, 220, 137, 64, 67, 341, 319,
-	378, 342, 449, 216, 354, 219, 247, 355, 319, 378,
-	216, 219, 449, 247, 319, 216, 219, 378, 247, 355,
-	413, 414, 219, 26, 346, 349, 350, 414, -408, 445,
-	137, 94, 91, 92, 93, -288, 112, -301, 105, 106,
-	107, 108, 109, 110, 111, 119, 118, 129, 122, 123,
-	124, 125, 126, 127, 128, 120, 121, 115, 95, 113,
-	117, 114, 97, -135, -288, -294, 46, -286, -286, -286,
-	-286, -372, -292, -288, 67, 67, 136, 73, -288, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, -401, 67, 67, -298, -299, 67, 67, -282, -247,
-	67, 73, 73, 67, 67, 67, 73, -299, -299, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, -152, 137, 67,
-	-107, 38, -154, 21, -136, -135, 20, 31, 32, 31,
-	32, 31, 32, 31, 32, 79, 438, 489, 148, 149,
-	150, -173, -263, -175, -166, -263, -306, 222, -306, -306,
-	-306, -306, 218, -306, 216, 216, 216, -306, -462, 152,
-	137, -451, -157, 67, -274, 113, 117, 23, 245, 245,
-	-274, 282, -490, -491, 15, 115, -272, -491, -272, -272,
-	-274, -492, 218, 424, 42, 246, 245, -153, -154, -153,
-	418, 414, -359, 419, 420, -276, -491, -275, -274, -274,
-	-275, -274, -274, -274, 216, 219, 449, 302, 553, -490,
-	245, -490, 30, 30, -388, -187, -388, 483, -255, -263,
-	-388, -388, -463, 221, -318, 247, 247, 137, 105, 23,
-	-338, 105, 116, -337, -337, -337, -338, -338, -208, 40,
-	-262, 133, -263, 73, -208, 40, -486, -485, -187, -153,
-	-136, -135, 68, 68, 68, -388, -388, -388, -388, -388,
-	-388, -388, -388, -269, -166, -175, 222, -388, -137, -138,
-	124, -288, -263, -137, -3, -113, -112, 99, 100, 102,
-	546, 335, 545, 549, 543, -337, 40, -383, 362, 361,
-	-377, -379, 67, -378, 67, -378, -378, -378, 67, 67,
-	-380, 67, -380, -380, -377, -381, 67, -381, -382, 67,
-	-381, -263, -293, -294, -263, 38, -397, 46, -132, 67,
-	30, 67, -263, -398, 46, -132, 67, 30, -153, -107,
-	-154, 136, 79, 73, -79, 68, 137, -455, 85, 86,
-	-458, 185, 177, -263, -93, 73, -7, -8, -9, -10,
-	-39, -69, -132, -425, -423, 67, 31, 387, 64, 15,
-	-344, 216, 449, 340, 243, 219, 319, -342, -325, -322,
-	-320, -262, -318, -321, -320, -347, -247, 414, -108, 399,
-	294, -288, -288, -288, -288, -288, 84, 95, 317, 85,
-	86, -283, -302, 31, 290, 291, -284, -284, -284, -284,
-	-284, -284, -284, -284, -284, -284, -284, -284, -291, -300,
-	-372, 67, 115, 113, 117, 114, 97, -286, -286, -284,
-	-284, 68, 137, -448, -447, 99, -288, -288, -263, -445,
-	-446, 463, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 331, 326, 332, 330, 322, 338, 333, 334,
-	170, 480, 481, 474, 475, 476, 477, 478, 479, -293,
-	-293, -288, -445, -293, -241, 32, 31, -294, -294, -294,
-	68, -288, -459, 315, 314, 316, -155, -263, -293, 68,
-	68, 68, 79, -294, -294, -293, -284, -293, -446, -241,
-	-241, -294, -294, -241, -241, -241, -241, 124, -241, -241,
-	-241, -241, -241, -241, -241, -241, 68, -153, -106, -406,
-	-405, -288, 40, 536, -107, -288, -150, -149, -288, -483,
-	73, 439, 440, 146, 149, 148, 34, 67, 67, 136,
-	-187, 95, -465, -263, -326, -323, -320, -263, -316, -263,
-	-263, -306, -306, -306, -187, -463, -450, 34, -156, -263,
-	-231, 16, -286, -286, -320, 247, -490, -274, -256, -255,
-	-276, -271, -276, -276, -231, -491, -274, -274, -276, -272,
-	-231, -263, 414, -231, -231, -359, -273, -263, -273, -306,
-	-255, -256, -256, -187, -223, -224, 213, 210, 209, -228,
-	359, 249, -307, 253, 77, 254, 395, 255, 218, 257,
-	258, 259, 229, 260, 261, 262, 388, 263, 264, 265,
-	266, 342, 6, 305, 40, -465, -465, 30, -326, -263,
-	-145, -140, -144, -141, -146, -234, -236, -143, 67, -187,
-	-135, -263, 31, 387, -341, 387, 31, -316, -335, -331,
-	73, 388, -321, -340, 64, 133, -405, -338, -338, -338,
-	-340, -340, 132, 137, -487, 438, 439, 205, -107, -189,
-	-187, -465, -325, -316, -263, -189, -189, -263, 67, 35,
-	-263, -230, 137, -229, 15, -264, -263, 34, 73, 136,
-	-230, -114, -112, 101, -288, -6, 545, -288, -6, -6,
-	-288, -6, -288, -386, 363, 79, 79, -249, 73, -249,
-	79, 79, 79, 68, 73, -329, 64, -399, -295, -443,
-	535, -160, 68, -155, -441, -442, -155, -159, -263, -399,
-	-160, 68, -441, -107, -107, -263, 23, -79, -453, 136,
-	137, -156, -344, -324, -321, -346, 124, -263, -332, 137,
-	489, 558, 71, 220, -475, -474, 379, 68, 137, -409,
-	221, 456, 73, 559, 201, 84, 317, 85, 86, -372,
-	-294, -291, -286, -286, -284, -284, -289, 234, -289, 94,
-	-288, -287, -447, 101, -288, 34, 137, 65, 136, 68,
-	68, 15, 15, 68, -288, 68, 68, 68, 68, 15,
-	15, -288, 68, 136, 68, 68, 68, 68, 65, 68,
-	137, 68, 137, -294, -288, 68, 68, -288, -288, -288,
-	-294, 68, -288, -288, -288, -288, -288, -288, -288, -288,
-	-354, 409, 137, 68, 73, 137, 22, 137, -147, 36,
-	37, 147, 150, 150, 149, -174, -176, -177, -178, -179,
-	-180, 115, 138, 139, -167, -168, -169, -170, -193, -247,
-	172, 175, 177, 178, 179, 180, 181, 182, 183, 184,
-	185, 186, 187, 233, 167, 168, 169, 170, 188, 153,
-	173, 154, 155, 156, 157, 158, 159, 162, 163, 164,
-	161, -263, -157, 35, -468, 366, -476, 137, 40, -474,
-	449, -309, 65, -130, 15, -187, -187, -187, -200, 34,
-	15, -139, -188, -263, 67, 68, 137, -288, -317, 65,
-	-263, -256, -231, -187, -263, -231, -231, -274, -276, -276,
-	-272, 136, -255, -157, 137, -183, -190, -184, -186, -247,
-	-279, -185, 227, -438, 225, 223, 89, 228, 279, 90,
-	218, -225, 218, 90, 224, 228, 223, -226, 218, -260,
-	105, -260, -254, 132, -260, -260, -260, -260, 256, 256,
-	-260, -260, -260, -260, -260, -260, -260, -260, -260, -260,
-	-260, -260, -260, -260, 67, -469, 366, 30, 325, -476,
-	-131, 319, 30, -237, -238, -239, -240, 53, 57, 59,
-	54, 55, 56, 60, 30, 137, -261, -265, 34, -263,
-	73, -261, -135, -140, -145, -261, 67, 220, 67, 105,
-	-340, -340, -340, 40, -262, -485, 445, 439, 137, 64,
-	-167, -175, -231, -138, -140, -263, 73, -263, 124, -231,
-	102, -6, 100, -118, -117, -116, 103, 543, 549, 102,
-	102, 102, 68, 68, 68, 137, 68, 68, 68, 137,
-	68, 137, -412, 419, 39, 137, 67, 68, 137, 46,
-	137, 105, 68, 137, 68, 46, 136, 395, -263, -423,
-	68, -346, 137, 220, 136, 136, -322, 345, -262, -324,
-	20, 489, -247, 38, -253, -252, 73, 559, 68, -289,
-	-289, 94, -286, -283, 68, 102, -288, 100, -191, -193,
-	361, 362, -192, -198, 133, 171, 233, 170, 169, 167,
-	361, 362, -208, -263, -288, -288, 68, -288, -288, 15,
-	-263, -208, -284, -288, -152, 68, -353, -354, -353, 68,
-	68, 68, 68, -353, 68, 68, 68, 68, 68, 68,
-	68, 68, 67, -405, -412, -288, -288, -149, -148, 43,
-	150, 353, 68, 137, -247, 68, 137, -193, 34, -471,
-	421, 368, -260, -281, -280, 321, 41, -392, 388, 374,
-	375, -323, 247, -263, 64, 309, 310, 311, 312, -304,
-	-305, -303, -307, -465, 67, 67, 67, -373, 299, -193,
-	-187, -139, -263, 15, 137, -464, 136, -1, -263, -316,
-	-274, -231, -491, -231, -274, -274, -276, -263, 34, -224,
-	-193, -185, -437, -438, -306, -263, 90, -437, 90, 67,
-	-263, -263, -263, 90, 90, -263, 79, 79, -260, -260,
-	79, 73, 73, 73, -260, -260, 79, 73, -265, 79,
-	79, 79, 79, 40, 73, -213, 40, 267, 271, 268,
-	269, 270, 79, 40, 79, 40, 79, 40, -263, 67,
-	-439, -440, 73, -471, -260, 325, 105, -281, -129, 77,
-	31, -161, 213, 210, -465, -328, -327, -247, -144, -144,
-	-144, -144, 53, 53, 53, 58, 53, 58, 53, -239,
-	-328, -146, -157, -265, 68, -481, -480, -479, -477, 61,
-	221, 62, -293, -324, -277, 73, -331, -187, -187, 68,
-	-233, 17, 136, -233, 98, -6, -114, -116, -288, -6,
-	-288, 545, 335, 546, 73, 79, 79, -420, 405, 400,
-	402, 90, -295, -407, -406, 46, -132, -155, -399, -442,
-	-405, -263, 46, -132, -399, -263, 79, 15, -321, -316,
-	124, 124, -263, 346, -332, 73, 367, 73, 216, 560,
-	137, 105, -253, -283, -288, 68, -201, 158, 157, -201,
-	68, -378, -378, -377, -380, -377, -201, -201, 68, 68,
-	23, 68, 68, 68, -288, 68, 68, 137, -396, 458,
-	-353, -353, -353, -353, -353, -353, -353, -353, -353, -353,
-	-353, -353, -353, -297, -296, 239, -420, 44, 45, 73,
-	73, -178, -193, 214, -169, 40, -132, -472, 77, -466,
-	73, -263, -473, 77, 369, 135, 323, 40, 370, 371,
-	385, 318, 79, 79, 376, -467, -263, -162, 319, -187,
-	-303, -254, 132, 258, 302, -181, -182, -183, -181, -181,
-	-374, 301, 20, 79, 123, -158, 65, 136, -140, -188,
-	-263, 124, 68, -231, -263, -231, -231, -274, -132, -205,
-	-204, -202, 84, 95, 40, 359, -203, 77, 132, 272,
-	250, 273, -222, -278, 64, 365, 223, 89, 90, 347,
-	-279, -435, -263, -433, -435, -263, -433, -433, -306, -288,
-	-263, -227, 307, 308, -208, -208, 73, 73, -209, 250,
-	-189, 68, 137, 105, -472, -466, 105, 73, -473, 73,
-	137, -161, -161, -231, 137, 105, -164, -163, 64, 65,
-	-165, 64, -163, 53, 53, -231, -479, -478, 23, -438,
-	-438, -438, 68, 68, -232, 18, 20, 124, -232, 102,
-	100, 102, 102, -263, 68, 68, -384, 537, -416, -418,
-	400, 20, 20, 13, 68, -399, -399, -316, -332, 388,
-	-187, -252, 73, 560, -288, 68, -288, 68, 73, -152,
-	20, -384, -171, -193, -282, 73, -470, 379, 73, 73,
-	79, 40, 79, 135, 372, -393, -129, -161, 67, -260,
-	-260, -260, -263, 68, 137, 68, 68, -375, 238, 79,
-	123, 79, 123, 79, -261, -140, -263, -231, -464, 136,
-	-231, -231, -202, 84, -284, 73, -210, -262, 133, -211,
-	40, 271, 267, -212, 40, 251, 252, -214, 67, 279,
-	13, 90, 90, -187, 67, 67, 65, 289, 67, 67,
-	-435, 68, 251, 252, 68, -440, 73, -470, 73, -465,
-	-152, -327, -405, -288, 67, -288, 67, 53, 19, 17,
-	-288, -294, 222, -6, 546, 335, -215, 538, 73, 20,
-	73, -414, 73, -328, -110, -410, -359, 68, 68, -353,
-	-356, -355, -358, 406, 281, 412, -294, -215, 488, 20,
-	489, 322, 40, 79, 40, 373, -311, -313, -247, 67,
-	-208, -210, 73, -206, -207, -228, -183, -109, -108, -206,
-	-376, 141, 79, 123, 79, 79, -231, -231, 79, 67,
-	-400, -312, 67, -311, -311, -436, 309, 310, 311, 313,
-	312, -436, -311, -311, 67, -334, -333, 280, 95, -153,
-	-156, -434, -263, 223, 20, 20, -263, -263, -250, 539,
-	79, 73, 402, -385, 540, -419, 405, -413, -411, 400,
-	401, 402, 403, 68, -357, 97, 372, 376, -288, -250,
-	-172, -263, 73, 367, 73, 322, 68, 137, -377, -288,
-	-348, 239, 137, -228, -110, -348, -391, 149, 300, 489,
-	79, 123, 79, -152, 68, -288, -298, -217, -216, 485,
-	-311, 68, 68, 68, 68, -311, 280, 68, 68, 137,
-	67, 20, 73, -421, 221, -417, -418, 404, -411, 20,
-	402, 20, 20, -357, 535, 410, 411, 410, 411, 34,
-	388, -314, -313, -147, 68, -349, 288, 20, -228, -349,
-	300, 79, 123, 79, -153, 68, -221, -219, -220, 64,
-	417, 277, 278, 68, -314, -314, -314, -314, 68, -263,
-	223, -251, -263, -414, -428, 67, 79, -416, -415, -417,
-	20, -414, 20, -414, -414, 94, 73, -315, 229, 77,
-	489, 307, 308, -147, 20, -350, 281, 282, -351, -363,
-	284, 79, -333, -220, 64, -219, 64, 14, 13, -222,
-	68, 137, -432, 30, 68, -427, -426, -248, -422, -263,
-	405, 406, 73, -414, -357, -260, 73, 306, -247, 67,
-	-361, 285, 67, -359, 67, -359, 90, 310, -218, 274,
-	275, 30, 149, -218, -263, -431, -430, -429, 68, 137,
-	136, 79, -263, -347, -352, 286, 79, -284, 67, -284,
-	67, -360, 283, 67, 84, 40, 276, 137, 105, -426,
-	-263, 68, -365, 67, 20, 68, -347, 68, -347, 67,
-	105, -284, -430, 40, -288, 136, -366, -364, 239, -351,
-	68, 68, 68, -347, 79, 68, -263, 68, 137, -263,
-	-362, 287, 68, -364, -367, 46, 79, -371, -368, 67,
-	-228, 241, 115, -371, -228, -370, -369, 286, 242, 67,
-	68, 137, -263, 238, 67, -294, -369, -368, -294, 68,
-	68,
+	-1000, -492, -496, -2, -5, 564, -1, -4, -102, -77,
+	-7, -104, -105, -8, -100, -9, -10, -12, -81, -95,
+	-97, -99, -98, -41, -11, -94, -72, -73, -83, -88,
+	-91, -92, -93, -106, -101, -103, -136, -107, -108, 557,
+	-78, -79, -80, -32, -30, -31, -29, -28, -128, 563,
+	421, 12, 469, -13, -14, -454, -15, 241, -270, -271,
+	-272, -274, -497, -42, -43, -44, -51, -52, -53, -54,
+	-55, -64, -65, -66, -45, -46, -47, -50, -68, -48,
+	-57, -58, -59, -60, -61, -62, -63, -49, -131, -132,
+	-67, -70, -69, -75, -71, -76, -130, 216, -74, 63,
+	-84, -85, -86, -87, -89, -90, 352, 358, 411, 556,
+	48, -137, -139, 567, 568, 142, 143, 145, 146, -33,
+	-34, -35, -36, -37, -38, -40, -39, 212, 14, 502,
+	-16, -19, -17, -20, -18, -25, -27, -26, -24, -21,
+	-23, -129, -22, 236, 235, 39, 305, 306, 307, 356,
+	234, 213, 13, 32, 43, 334, -138, 69, 214, -140,
+	10, 570, -6, -3, -2, -115, -119, -123, -126, -127,
+	-124, -125, -4, -102, 100, 226, 558, -267, 348, 559,
+	561, 560, 72, 80, -261, -263, 352, 358, 556, 568,
+	505, 506, 507, 508, 509, 510, 511, 512, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 528, 529, 530, 531, 532, 533, 534, 535,
+	536, 537, 538, 539, 540, 541, 472, 543, 544, 545,
+	546, 332, 333, 499, 253, 279, 382, 286, 293, 281,
+	142, 161, 155, 183, 175, 150, 259, 298, 260, 79,
+	145, 455, 90, 433, 408, 148, 274, 276, 278, 285,
+	261, 171, 166, 273, 255, 164, 262, 41, 263, 188,
+	264, 265, 504, 429, 331, 435, 290, 406, 165, 430,
+	277, 432, 280, 192, 196, 447, 159, 160, 437, 449,
+	187, 233, 44, 501, 245, 450, 194, 186, 182, 458,
+	294, 292, 185, 158, 181, 256, 52, 198, 197, 199,
+	402, 403, 404, 405, 266, 267, 346, 446, 178, 167,
+	336, 151, 22, 453, 240, 434, 359, 268, 287, 295,
+	193, 195, 247, 252, 503, 410, 251, 291, 451, 163,
+	244, 275, 239, 454, 152, 361, 269, 146, 284, 448,
+	457, 51, 374, 157, 149, 230, 143, 249, 254, 282,
+	11, 270, 271, 272, 297, 296, 288, 179, 246, 184,
+	169, 156, 180, 144, 248, 456, 375, 554, 334, 391,
+	177, 174, 250, 223, 283, 452, 436, 147, 395, 376,
+	172, 299, 550, 551, 552, 347, 330, 300, 301, 170,
+	237, 427, 428, 302, 304, 401, 320, 377, 409, 383,
+	378, 205, 308, 439, 441, 189, 553, 190, 191, 323,
+	324, 325, 257, 258, 309, 310, 311, 431, 312, 313,
+	314, 315, -343, -341, -267, 568, 556, 358, 352, 334,
+	-179, -267, 569, -146, 40, -191, -267, -146, -74, -14,
+	-13, -138, -139, -191, 220, -275, 24, 406, -82, 216,
+	407, 69, -267, -9, -8, -100, -72, -136, -273, -267,
+	305, 305, -273, 220, -267, 251, 390, -393, 226, -347,
+	-320, 252, -346, -322, -349, -323, 33, 212, 214, 213,
+	248, 14, 356, 222, 12, 10, 357, 234, 25, 26,
+	28, 30, 13, 358, 360, 29, 361, 364, 365, 366,
+	43, 369, 370, 241, 72, 80, 75, 261, -178, -267,
+	-298, -292, 97, 242, -294, -287, -288, -290, -434, -428,
+	-286, 69, 124, 125, 132, 98, -289, -377, 37, 100,
+	509, 470, -246, -247, -248, -249, -250, -267, -429, -427,
+	75, 81, 84, 87, 88, 86, 85, 168, 83, 76,
+	136, 137, -139, 72, -449, 517, -262, 538, 543, 544,
+	545, 546, 537, 48, -399, -407, 219, -405, 135, 173,
+	237, 169, 12, 130, 401, 170, 514, 534, 472, 518,
+	541, 511, 512, 506, 507, 508, 510, 519, 521, 533,
+	-408, 529, 539, 540, 527, 542, 74, 73, 532, 531,
+	520, 515, 516, 522, 505, 513, 523, 524, 530, 535,
+	536, 340, 90, 341, 342, 462, 335, 343, 226, 406,
+	57, 344, 345, 346, 347, 348, 469, 349, 58, 350,
+	339, 241, 391, 351, 172, 189, 474, 473, 475, 466,
+	463, 461, 464, 465, 467, 468, 525, 526, 528, -109,
+	-110, 548, -155, -156, -239, 19, 6, 7, 8, 9,
+	-490, 408, 503, 298, 332, 462, 147, 144, -334, 147,
+	96, 152, 151, -334, 308, 247, 353, 223, 332, 391,
+	312, 251, 257, -314, -312, -395, 302, 298, 221, 231,
+	230, 91, 462, 219, 367, -465, -466, 209, 210, 211,
+	-456, 495, -455, -267, 342, 27, 220, 353, 441, 258,
+	439, 440, 442, 443, 444, 445, -56, -409, -392, 436,
+	435, -279, 428, 434, 426, 438, 429, 333, 314, 313,
+	212, 565, 496, -258, 377, 409, 247, 459, 460, 354,
+	410, 447, 448, 430, 90, 176, 173, 223, 220, 332,
+	462, 391, 312, -465, 251, 390, 42, -340, 401, -339,
+	-341, 447, 448, 458, 73, 74, 144, 446, -258, 90,
+	427, 427, -156, -239, -155, -137, -139, -103, -454, 221,
+	332, 391, 251, 257, 222, 220, 223, 462, 305, 353,
+	247, 312, -394, -462, 33, -400, 206, 207, 208, 34,
+	35, -1, 104, 570, -292, -292, -6, 571, -6, -292,
+	-267, -267, 139, -197, -201, -198, -200, -199, -203, -202,
+	173, 174, 135, 177, 179, 180, 181, 182, 183, 184,
+	185, 186, 187, 188, 32, 189, 190, 191, 237, 169,
+	170, 171, 172, 192, 155, 175, 500, 200, 156, 201,
+	157, 202, 158, 203, 159, 160, 204, 161, 164, 165,
+	166, 163, 138, 69, 138, 75, -162, 243, -191, 138,
+	-162, -156, -155, -156, -191, -251, -267, 408, 107, 406,
+	-82, 407, -82, -457, -458, -459, -461, 216, 407, 406,
+	138, 15, -273, -273, 67, -191, -322, 251, -347, -320,
+	37, 66, 139, 224, 139, 66, 69, 354, 332, 391,
+	355, 462, 220, 367, 223, 251, 368, 332, 391, 220,
+	223, 462, 251, 332, 220, 223, 391, 251, 368, 426,
+	427, 223, 27, 359, 362, 363, 427, -413, 458, 139,
+	96, 93, 94, 95, -292, 114, -305, 107, 108, 109,
+	110, 111, 112, 113, 121, 120, 131, 124, 125, 126,
+	127, 128, 129, 130, 122, 123, 117, 97, 115, 119,
+	116, 99, -139, -292, -298, 48, -290, -290, -290, -290,
+	-377, -296, -292, 69, 69, 138, 75, -292, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	-406, 69, 69, -302, -303, 69, 69, -286, -251, 69,
+	75, 75, 69, 69, 69, 75, -303, -303, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, -156, 139, 69, -111,
+	40, -158, 21, -140, -139, 20, 33, 34, 33, 34,
+	33, 34, 33, 34, 81, 224, -267, -267, 451, 502,
+	150, 151, 152, -177, -267, -179, -170, -267, -310, 226,
+	-310, -310, -310, -310, -310, 222, -310, 220, 220, 220,
+	-310, -467, 154, 139, -456, -161, 69, -278, 115, 119,
+	24, 249, 249, -278, -499, 66, 15, 117, 292, -498,
+	-499, -276, -499, -276, -276, -278, -500, 222, 437, 44,
+	250, 249, -157, -158, -157, 431, 427, -363, 432, 433,
+	-280, -499, -279, -278, -278, -279, -278, -278, -278, 220,
+	223, 462, 312, 566, -498, 249, -498, 32, 32, -393,
+	-191, -393, 496, -259, -267, -393, -393, -468, 225, -322,
+	251, 251, 139, 107, 24, -342, 107, 118, -341, -341,
+	-341, -342, -342, 149, -212, 42, -266, 135, -267, 75,
+	-212, 42, -494, -493, -191, -157, -140, -139, 70, 70,
+	70, -393, -393, -393, -393, -393, -393, -393, -393, -393,
+	-273, -170, -179, 226, -393, -141, -142, 126, -292, -267,
+	-141, -3, -117, -116, 101, 102, 104, 559, 348, 558,
+	562, 556, -341, 42, -388, 375, 374, -382, -384, 69,
+	-383, 69, -383, -383, -383, 69, 69, 69, 69, -385,
+	69, -385, -385, -382, -386, 69, -386, -387, 69, -386,
+	-267, -297, -298, -267, 40, -402, 48, -136, 69, 32,
+	69, -267, -403, 48, -136, 69, 32, -157, -111, -158,
+	138, 81, 75, -463, 187, 179, 72, 107, -82, 70,
+	139, -460, 87, 88, -463, -267, -96, 75, -7, -8,
+	-9, -10, -41, -72, -136, -430, -428, 69, 433, 33,
+	400, 66, 15, -348, 220, 462, 353, 247, 223, 332,
+	-346, -329, -326, -324, -266, -322, -325, -324, -351, -251,
+	427, -112, 412, 304, -292, -292, -292, -292, -292, 86,
+	97, 330, 87, 88, -287, -306, 33, 300, 301, -288,
+	-288, -288, -288, -288, -288, -288, -288, -288, -288, -288,
+	-288, -295, -304, -377, 69, 117, 115, 119, 116, 99,
+	-290, -290, -288, -288, 70, 139, -453, -452, 101, -292,
+	-292, -267, -450, -451, 476, 477, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 344, 339, 345, 343, 335,
+	351, 346, 347, 172, 493, 494, 487, 488, 489, 490,
+	491, 492, -297, -297, -292, -450, -297, -245, 34, 33,
+	-298, -298, -298, 70, -292, -464, 328, 327, 329, -159,
+	-267, -297, 70, 70, 70, 81, -298, -298, -297, -288,
+	-297, -451, -245, -245, -298, -298, -245, -245, -245, -245,
+	126, -245, -245, -245, -245, -245, -245, -245, -245, 70,
+	-157, -110, -411, -410, -292, 42, 549, -111, -292, -154,
+	-153, -292, -491, 75, 75, 224, 224, 452, 453, 148,
+	151, 150, 36, 69, 69, 138, -191, 97, -470, -267,
+	-330, -327, -324, -267, -320, -267, -267, -267, -310, -310,
+	-310, -191, -468, -455, 36, -160, -267, -235, 16, -290,
+	-290, -324, 251, -498, -278, -191, -191, -260, -259, -280,
+	-275, -280, -280, -235, -499, -278, -278, -280, -276, -235,
+	-267, 427, -235, -235, -363, -277, -267, -277, -310, -259,
+	-260, -260, -191, -227, -228, 217, 214, 213, -232, 372,
+	253, -311, 259, 79, 260, 408, 261, 222, 263, 264,
+	265, 233, 266, 267, 268, 401, 269, 270, 271, 272,
+	355, 6, 315, 42, -470, -470, 32, -330, -267, -149,
+	-144, -148, -145, -150, -238, -240, -147, 69, -191, -139,
+	-267, 33, 400, -345, 400, 33, -320, -339, -335, 75,
+	401, -325, -344, 66, 135, -410, -342, -342, -342, -344,
+	-344, -344, 134, 139, -495, 451, 452, 209, -111, -193,
+	-191, -470, -329, -320, -267, -267, -193, -193, -267, 69,
+	37, -267, -234, 139, -233, 15, -268, -267, 36, 75,
+	138, -234, -118, -116, 103, -292, -6, 558, -292, -6,
+	-6, -292, -6, -292, -391, 376, 81, 81, -253, 75,
+	-253, 81, 81, 81, 81, 81, 70, 75, -333, 66,
+	-404, -299, -448, 548, -164, 70, -159, -446, -447, -159,
+	-163, -267, -404, -164, 70, -446, -111, -111, -267, 24,
+	-82, -463, -82, -458, 138, 139, -160, 69, -348, -328,
+	-325, -350, 126, -267, -336, 139, 502, 572, 73, 224,
+	-483, -482, 392, 70, 139, -414, 225, 469, 75, 573,
+	205, 86, 330, 87, 88, -377, -298, -295, -290, -290,
+	-288, -288, -293, 238, -293, 96, -292, -291, -452, 103,
+	-292, 36, 139, 67, 138, 70, 70, 15, 15, 70,
+	-292, 70, 70, 70, 70, 15, 15, -292, 70, 138,
+	70, 70, 70, 70, 67, 70, 139, 70, 139, -298,
+	-292, 70, 70, -292, -292, -292, -298, 70, -292, -292,
+	-292, -292, -292, -292, -292, -292, -358, 422, 139, 70,
+	75, 139, 22, 139, -151, 38, 39, 75, 75, 149,
+	152, 152, 151, -178, -180, -181, -182, -183, -184, 117,
+	140, 141, -171, -172, -173, -174, -197, -251, 174, 177,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 237, 169, 170, 171, 172, 192, 155,
+	175, 156, 157, 158, 159, 160, 161, 164, 165, 166,
+	163, -267, -161, 37, -473, 379, -484, 139, 42, -482,
+	462, 66, -313, 67, -134, 15, -191, -191, -191, -204,
+	36, 15, -143, -192, -267, 69, 70, 139, -292, -321,
+	67, -267, -260, -235, -191, -267, -235, -235, -278, -280,
+	-280, -276, 138, -259, -161, 139, -187, -194, -188, -190,
+	-251, -283, -189, 231, -443, 229, 227, 91, 232, 289,
+	92, 222, -229, 222, 92, 228, 232, 227, -230, 222,
+	-264, 107, -264, -258, 134, -264, -264, -264, -264, 262,
+	262, -264, -264, -264, -264, -264, -264, -264, -264, -264,
+	-264, -264, -264, -264, -264, 69, -474, 379, 32, 338,
+	-484, -135, 332, 32, -241, -242, -243, -244, 55, 59,
+	61, 56, 57, 58, 62, 32, 139, -265, -269, 36,
+	-267, 75, -265, -139, -144, -149, -265, 36, 69, 224,
+	69, 107, -344, -344, -344, 42, -266, -493, 458, 452,
+	139, 66, 66, -171, -179, -235, -142, -144, -267, 75,
+	-267, 126, -235, 104, -6, 102, -122, -121, -120, 105,
+	556, 562, 104, 104, 104, 70, 70, 70, 139, 70,
+	70, 70, 70, 70, 139, 70, 139, -417, 432, 41,
+	139, 69, 70, 139, 48, 139, 107, 70, 139, 70,
+	48, 138, 408, -82, -267, -428, 70, -160, -350, 139,
+	224, 138, 138, -326, 358, -266, -328, 20, 502, -251,
+	40, -257, -256, 75, 573, 70, -293, -293, 96, -290,
+	-287, 70, 104, -292, 102, -195, -197, 374, 375, -196,
+	-202, 135, 173, 237, 172, 171, 169, 374, 375, -212,
+	-267, -358, -292, -292, 70, -292, -292, 15, -267, -212,
+	-288, -292, -156, 70, -357, -358, -357, 70, 70, 70,
+	70, -357, 70, 70, 70, 70, 70, 70, 70, 70,
+	69, -410, -417, -292, -292, -153, -152, 45, 152, 366,
+	70, 139, -251, 70, 139, -197, 36, -476, 434, 381,
+	-264, -285, -284, 334, 43, -397, 401, 387, 388, -327,
+	251, -267, -191, 66, 319, 320, 321, 322, 323, -308,
+	-309, -307, -311, -470, 69, 69, 69, -378, 309, -197,
+	-191, -143, -267, 15, 139, -469, 138, -1, -267, -320,
+	-278, -235, -499, -235, -278, -278, -280, -267, 36, -228,
+	-197, -189, -442, -443, -310, -267, 92, -442, 92, 69,
+	-267, -267, -267, 92, 92, -267, 81, 81, -264, -264,
+	81, 75, 75, 75, -264, -264, 81, 75, -269, 81,
+	81, 81, 81, 42, 75, -217, 42, 273, 277, 274,
+	275, 276, 81, 42, 81, 42, 81, 42, -267, 69,
+	-444, -445, 75, -476, -264, 338, 107, -285, -133, 79,
+	33, -165, 217, 214, -470, -332, -331, -251, -148, -148,
+	-148, -148, 55, 55, 55, 60, 55, 60, 55, -243,
+	-332, -150, -161, -269, 70, -489, -488, -487, -485, 63,
+	225, 64, 23, -297, -328, -281, 75, -335, -191, -191,
+	-191, 70, -237, 17, 138, -237, 100, -6, -118, -120,
+	-292, -6, -292, 558, 348, 559, 75, 81, 81, -425,
+	418, 413, 415, 92, -299, -412, -411, 48, -136, -159,
+	-404, -447, -410, -267, 48, -136, -404, -267, 81, 70,
+	15, -325, -320, 126, 126, -267, 359, -336, 75, 380,
+	75, 220, 574, 139, 107, -257, -287, -292, 70, -205,
+	160, 159, -205, 70, -383, -383, -382, -385, -382, -205,
+	-205, 70, 70, 24, 70, 70, 70, -292, 70, 70,
+	139, -401, 471, -357, -357, -357, -357, -357, -357, -357,
+	-357, -357, -357, -357, -357, -357, -301, -300, 243, -425,
+	46, 47, 75, 75, -182, -197, 218, -173, 42, -136,
+	-477, 79, -471, 75, -267, -481, 79, 382, 137, 336,
+	42, 383, 384, 398, 331, 81, 81, 389, -472, -267,
+	-166, 332, 67, 224, -191, -307, -258, 134, 264, 312,
+	-185, -186, -187, -185, -185, -379, 311, 20, 81, 125,
+	-162, 67, 138, -144, -192, -267, 126, 70, -235, -267,
+	-235, -235, -278, -136, -209, -208, -206, 86, 97, 42,
+	372, -207, 79, 134, 278, 254, 279, -226, -282, 66,
+	378, 280, 36, 227, 91, 92, 360, -283, -440, -267,
+	-438, -440, -267, -438, -438, -310, -292, -267, -231, 317,
+	318, -212, -212, 75, 75, -213, 254, -193, 70, 139,
+	107, -477, -471, 107, 75, -481, 75, 139, -165, -165,
+	-235, 139, 107, -168, -167, 66, 67, -169, 66, -167,
+	55, 55, -235, -487, -486, 24, -443, -443, -443, 170,
+	70, 70, -236, 18, 20, 126, -236, 104, 102, 104,
+	104, -267, 70, 70, -389, 550, -421, -423, 413, 20,
+	20, 13, 70, -404, -404, -320, -336, 401, -191, -256,
+	75, 574, -292, 70, -292, 70, 75, -156, 20, -389,
+	-175, -197, -286, 75, -475, 392, 75, 75, 81, 42,
+	81, 137, 385, -398, -133, -165, 69, -320, 69, -264,
+	-264, -264, -267, 70, 139, 70, 70, -380, 242, 81,
+	125, 81, 125, 81, -265, -144, -267, -235, -469, 138,
+	-235, -235, -206, 86, -288, 75, -214, -266, 135, -215,
+	42, 277, 273, -216, 42, 255, 256, -218, 69, 289,
+	13, 281, 69, 92, 92, -191, 69, 69, 67, 299,
+	69, 69, -440, 70, 255, 256, 70, -445, 75, -478,
+	502, -475, 75, -470, -156, -331, -410, -292, 69, -292,
+	69, 55, 19, 17, -292, -292, -298, 226, -6, 559,
+	348, -219, 551, 75, 20, 75, -419, 75, -332, -114,
+	-415, -363, 70, 70, -357, -360, -359, -362, 419, 291,
+	425, -298, -219, 501, 20, 502, 335, 42, 81, 42,
+	386, -292, 67, -315, -317, -251, 69, -212, -214, 75,
+	-210, -211, -232, -187, -113, -112, -210, -381, 143, 81,
+	125, 81, 81, -235, -235, 81, 69, -405, 36, -292,
+	-316, 69, -315, -315, -441, 319, 320, 321, 326, 322,
+	323, -441, -315, -315, 69, -338, -337, 290, 97, 325,
+	-157, -160, -439, -267, 227, 20, 20, -265, -267, -267,
+	-254, 552, 81, 75, 415, -390, 553, -424, 418, -418,
+	-416, 413, 414, 415, 416, 70, -361, 99, 385, 389,
+	-292, -254, -176, -267, 75, 380, 75, 335, 70, 69,
+	70, 139, -382, -292, -352, 243, 139, -232, -114, -352,
+	-396, 151, 310, 502, 81, 125, 81, -156, 70, -292,
+	-302, 69, 70, -221, -220, 498, -315, 70, 70, 70,
+	70, -315, 290, -480, -479, -267, 70, 70, 139, -489,
+	69, 20, 75, -426, 225, -422, -423, 417, -416, 20,
+	415, 20, 20, -361, 548, 423, 424, 423, 424, 36,
+	401, -292, -318, -317, -151, 70, -353, 298, 20, -232,
+	-353, 310, 81, 125, 81, -157, 70, -292, -368, 283,
+	282, -225, -223, -224, 66, 430, 287, 288, 70, -318,
+	-318, -318, -318, 70, 139, -264, -267, 227, -255, -267,
+	-419, -433, 69, 81, -421, -420, -422, 20, -419, 20,
+	-419, -419, 96, 75, 70, -319, 233, 79, 502, 317,
+	318, 324, -151, 20, -354, 291, 292, -355, -367, 294,
+	81, -337, 70, -224, 66, -223, 66, 14, 13, -226,
+	-479, 81, 70, 139, -437, 32, 70, -432, -431, -252,
+	-427, -267, 418, 419, 75, -419, -361, -264, 75, 316,
+	-264, -251, 69, -365, 295, 69, -363, 69, -363, 92,
+	320, -368, -222, 284, 285, 32, 151, -222, -267, -436,
+	-435, -434, 70, 139, 138, 81, -267, 81, -351, -356,
+	296, 81, -288, 69, -288, 69, -364, 293, 69, 86,
+	42, 286, 139, 107, -431, -267, 70, -370, 69, 20,
+	70, -351, 70, -351, 69, 107, -288, -435, 42, -292,
+	138, -371, -369, 243, -355, 70, 70, 70, -351, 81,
+	70, -267, 70, 139, -267, -366, 297, 70, -369, -372,
+	48, 81, -376, -373, 69, -232, 245, 117, -376, -232,
+	-375, -374, 296, 246, 69, 70, 139, -267, 242, 69,
+	-298, -374, -373, -298, 70, 70,
 }
 
 var yyDef = [...]int{
 	23, -2, 1, 2, 3, 19, 4, 20, 21, 22,
 	24, 25, 26, 27, 28, 29, 30, 31, 32, 33,
 	34, 35, 36, 37, 38, 39, 40, 41, 42, 43,
-	44, 45, 46, 47, 48, 49, 50, 51, 0, 245,
-	246, 247, 748, 749, 750, 751, 752, 0, 0, 0,
-	0, 522, 523, 0, 494, 0, 0, 0, 0, 0,
-	0, 388, 389, 390, 391, 392, 393, 394, 395, 396,
-	397, 398, 399, 400, 401, 402, 403, 404, 405, 406,
-	407, 408, 409, 410, 411, 412, 413, 414, 335, 336,
-	337, 338, 339, 340, 0, 270, 266, 206, 207, 208,
-	209, 277, 278, 365, 0, 0, 0, 0, 605, -2,
-	53, 259, 0, 250, 250, 753, 754, 755, 756, 757,
-	758, 759, 760, -2, 535, 0, 495, 496, 497, 498,
-	499, 500, 501, 502, 503, 504, 505, 506, 324, 325,
-	326, 320, 321, 323, 322, -2, 0, 535, 0, 0,
-	0, 625, 0, 0, 640, 662, 23, 0, 7, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 0,
-	0, 19, 0, 19, 0, 0, 0, 1045, 1046, 1047,
-	1048, -2, 1512, 1491, 1661, 1662, 1663, 1664, 1665, 1666,
-	1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675, 1676,
-	1677, 1678, 1679, 1680, 1681, 1682, 1683, 1684, 1685, 1686,
-	1687, 1688, 1689, 1690, 1691, 1692, 1693, 1694, 1695, 1696,
-	1697, 1698, 1699, 1700, 1701, 1458, 1459, 1460, 1461, 1462,
-	1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472,
-	1473, 1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482,
-	1483, 1484, 1485, 1486, 1487, 1488, 1489, 1490, 1492, 1493,
-	1494, 1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503,
-	1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511, 1513, 1514,
+	44, 45, 46, 47, 48, 49, 50, 51, 52, 0,
+	251, 252, 253, 771, 772, 773, 774, 775, 776, 0,
+	0, 0, 0, 544, 545, 0, 514, 0, 0, 0,
+	0, 0, 0, 404, 405, 406, 407, 408, 409, 410,
+	411, 412, 413, 414, 415, 416, 417, 418, 419, 420,
+	421, 422, 423, 424, 425, 426, 427, 428, 429, 430,
+	431, 346, 347, 348, 349, 350, 351, 0, 276, 272,
+	211, 212, 213, 214, 283, 284, 381, 0, 0, 0,
+	0, 627, -2, 57, 0, 265, 0, 256, 256, 777,
+	778, 779, 780, 781, 782, 783, 784, -2, 557, 0,
+	515, 516, 517, 518, 519, 520, 521, 522, 523, 524,
+	525, 526, 527, 333, 334, 335, 329, 330, 332, 331,
+	-2, 0, 557, 0, 0, 0, 647, 0, 0, 662,
+	684, 23, 0, 7, 9, 10, 11, 12, 13, 14,
+	15, 16, 17, 18, 0, 0, 19, 0, 19, 0,
+	0, 0, 1074, 1075, 1076, 1077, -2, 1552, 1530, 1497,
+	1710, 1711, 1712, 1713, 1714, 1715, 1716, 1717, 1718, 1719,
+	1720, 1721, 1722, 1723, 1724, 1725, 1726, 1727, 1728, 1729,
+	1730, 1731, 1732, 1733, 1734, 1735, 1736, 1737, 1738, 1739,
+	1740, 1741, 1742, 1743, 1744, 1745, 1746, 1747, 1748, 1749,
+	1750, 1495, 1496, 1498, 1499, 1500, 1501, 1502, 1503, 1504,
+	1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514,
 	1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524,
-	1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534,
-	1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544,
-	1545, 1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554,
-	1555, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564,
-	1565, 1566, 1567, 1568, 1569, 1570, 1571, 1573, 1574, 1575,
-	1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585,
-	1586, 1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595,
-	1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605,
-	1606, 1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614, 1615,
-	1616, 1617, 1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625,
-	1626, 1627, 1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635,
-	1636, 1637, 1638, 1639, 1640, 1641, 1642, 1643, 1644, 1645,
-	1646, 1647, 1648, 1649, 1650, 0, 243, 241, 1491, 1512,
-	1572, 1605, 0, 765, 0, 583, 0, 588, 1008, 583,
-	271, 524, 525, 625, 625, 492, 0, 306, 0, 1504,
-	310, 0, 0, 0, 489, 301, 302, 303, 304, 305,
-	0, 747, 0, 0, 297, 0, 265, 1563, 0, 0,
-	0, 0, 0, 0, 150, 836, 152, 838, 156, 163,
-	0, 0, 168, 169, 172, 173, 174, 175, 176, 0,
-	180, 0, 182, 185, 0, 187, 188, 0, 191, 192,
-	193, 0, 203, 204, 205, 839, 840, 841, -2, 81,
-	763, 1429, 1324, 0, 1331, 1332, 1343, 1354, 1118, 1119,
-	1120, 1121, 0, 0, 0, 0, 0, 1128, 1129, 0,
-	1143, 1665, 0, 1135, 1136, 1137, 1138, 1139, 90, 102,
-	103, 1373, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 0, 1297, 1105, 1045, 0, 1673, 0, 1693, 1698,
-	1699, 1700, 1701, 1692, 0, 0, 1282, 0, 1272, 0,
-	0, -2, -2, 0, 0, 1631, -2, 1670, 1689, 1697,
-	1674, 1696, 1667, 1668, 1662, 1663, 1664, 1666, 1675, 1677,
-	1688, 0, 1684, 1694, 1695, 0, 0, 104, 105, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, 1288, -2, 1290, 1291, 1293, 1294, 1295, 1296,
-	-2, 1299, 1300, 1301, -2, -2, 1304, 1305, 1306, 1307,
-	1308, 1309, 1312, -2, 1314, -2, -2, 1284, 1285, 1286,
-	1287, 1276, 1277, 1278, 1279, 1280, 1281, -2, -2, -2,
-	625, 698, 0, -2, 0, 0, 0, 645, 648, 651,
-	654, 0, 54, 55, 260, 261, 249, 251, 0, 255,
-	0, 248, 0, 0, 0, 868, 868, 868, 868, 868,
-	0, 868, 0, 0, 0, 868, 843, 844, 845, 866,
-	867, 914, 540, 536, 537, 538, 539, 613, 0, 615,
-	618, 469, 416, 0, 0, 469, 0, 429, 422, 0,
-	0, 0, 469, 0, 0, 620, 620, 0, 472, 479,
-	469, 469, -2, 469, 469, 469, 0, 0, 435, 436,
-	437, 422, 0, 422, 441, 442, 443, 454, 455, 480,
-	1453, 0, 0, 365, 0, 365, 0, 365, 365, 542,
-	1563, 0, 0, 217, 1631, 222, 0, 1511, 1577, 1526,
-	0, 0, 1544, 0, -2, 0, 287, 620, 0, 626,
-	0, 625, 0, 0, 365, 365, 365, 365, 365, 365,
-	365, 365, 0, 0, 0, 365, 0, 0, 663, 664,
-	659, 660, 661, 665, 666, 5, 6, 19, 0, 0,
-	0, 0, 0, 0, 60, 59, 0, 1430, 1448, 1385,
-	1386, 1387, 1435, 1389, 1439, 1439, 1439, 1439, 1417, 1418,
-	1419, 1420, 1421, 1422, 1423, 1424, 1425, 0, 0, 1428,
-	1408, 1437, 1437, 1437, 1435, 1432, 1390, 1391, 1392, 1393,
-	1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403,
-	1442, 1442, 1445, 1442, 0, 1322, 0, 0, 0, 0,
-	587, 0, 0, 620, -2, 0, 493, 307, 1049, 0,
-	0, 311, 312, 0, 0, 327, 0, 330, 315, 316,
-	317, 0, 0, 299, 300, 0, 0, 267, 0, 0,
-	0, 366, 0, 0, 0, 0, 0, 0, 160, 157,
-	164, 167, 177, 184, 0, 196, 198, 201, 158, 165,
-	170, 171, 178, 199, 159, 161, 162, 166, 200, 202,
-	179, 183, 197, 181, 186, 189, 190, 195, 0, 131,
-	0, 0, 0, 0, 0, 1330, 0, 0, 1362, 1363,
-	1364, 1365, 1366, 1367, 1368, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, -2, 1324, 0, 0, 1124, 1125, 1126,
-	1127, 1130, 0, 1144, 0, 0, 0, 1383, 0, 1322,
-	1322, 0, 1322, 1318, 0, 0, 0, 0, 0, 0,
-	1322, 1258, 0, 0, 1260, 1273, 0, 0, 1262, 1263,
-	0, 1266, 1267, 1322, 0, 1322, 1271, 1254, 1255, 0,
-	1318, 1318, 0, 0, 1318, 1318, 1318, 1318, 1318, 1318,
-	1318, 1318, 1318, 1318, 1318, 1318, 0, 620, 0, 0,
-	608, 0, 589, 0, 642, 644, 0, 646, 647, 649,
-	650, 652, 653, 655, 656, 56, 0, 0, 253, 0,
-	258, 0, 762, 0, 0, 778, 0, 0, 0, 0,
-	0, 0, 0, 0, 868, 868, 868, 0, 542, 541,
-	0, 614, 0, 0, 671, 0, 0, 0, 422, 469,
-	427, 428, 424, 423, 475, 476, 472, 0, 472, 472,
-	671, 0, 448, 449, 450, 469, 469, 456, 621, 457,
-	458, 472, 0, 477, 478, 671, 0, 0, 671, 671,
-	0, 466, 467, 468, 0, 0, 868, 0, 485, 424,
-	439, 424, 1454, 1455, 0, 877, 0, 0, 0, 488,
-	0, 0, 0, 543, 210, 0, 0, 0, 0, 0,
-	0, 239, 240, 0, 0, 0, 0, 0, 230, 233,
-	1002, 1003, 833, 834, 234, 235, 279, 280, 0, 589,
-	641, 643, 637, 638, 639, 0, 0, 0, 0, 0,
-	0, 0, 0, 518, 0, 520, 0, 0, 679, 673,
-	675, 742, 90, 679, 8, 77, 74, 0, 19, 0,
-	0, 19, 19, 0, 19, 244, 0, 1451, 1449, 1450,
-	1388, 1436, 0, 1413, 0, 1414, 1415, 1416, 0, 0,
-	1409, 0, 1410, 1411, 1412, 1404, 0, 1405, 1406, 0,
-	1407, 242, 0, 1323, 766, 0, 562, 575, 557, 0,
-	564, 0, 1009, 544, 575, 546, 0, 564, 589, 611,
-	589, 0, 308, 0, 313, 0, 0, 329, 331, 332,
-	333, 318, 319, 490, 295, 296, 288, 289, 290, 291,
-	292, 293, 294, 298, 100, 0, 268, 269, 0, 0,
-	0, 144, 145, 146, 147, 148, 149, 151, 135, 510,
-	512, 825, 837, 0, 828, 0, 154, 194, 127, 0,
-	0, 1325, 1326, 1327, 1328, 1329, 1333, 0, 1335, 1337,
-	1339, 1341, 0, 1359, -2, -2, 1106, 1107, 1108, 1109,
-	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1344, 1357,
-	1358, 0, 0, 0, 0, 0, 0, 1355, 1355, 1350,
-	0, 1122, 0, 1141, 1145, 0, 0, 0, 91, 1317,
-	1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234,
-	1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244,
-	1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 0,
-	0, 1324, 0, 0, 0, 1319, 1320, 0, 0, 0,
-	1213, 0, 0, 1219, 1220, 1221, 0, 570, 0, 1283,
-	1259, 1274, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 697, 699, 0,
-	579, 581, 582, 0, 609, 622, 627, 628, 631, 52,
-	57, 262, 263, 0, 0, 257, 0, -2, 780, 0,
-	618, 0, 0, 792, 363, 819, 0, 0, 835, 858,
-	864, 0, 0, 0, 892, 0, 616, 0, 0, 711,
-	415, 0, 470, 471, 419, 1563, 424, 671, 430, 425,
-	431, 474, 432, 433, 434, 0, 671, 671, 469, 472,
-	459, 473, 472, 462, 463, 0, 481, 486, 482, 0,
-	484, 438, 440, 618, 342, 343, 0, 0, 0, 348,
-	1456, 1456, 0, 1456, 1456, 1456, 1456, 0, 0, 1456,
-	1456, 1456, 1456, 1456, 1456, 1456, 1456, 1456, 1456, 1456,
-	1456, 1456, 1456, 0, 878, 360, 0, 0, 363, 809,
-	684, 0, 685, 686, 682, 713, 737, 737, 0, 737,
-	717, 1008, 211, 212, 0, 214, 215, 216, 223, 218,
-	220, 0, 0, 224, 236, 237, 238, 0, 0, 0,
-	228, 229, 0, 0, 282, 283, 285, 0, 607, 507,
-	1006, 508, 509, 513, 0, 515, 516, 517, 780, 0,
-	814, 671, 0, 680, 0, 676, 743, 0, 745, 0,
-	671, 0, 75, 19, 0, 68, 65, 0, 0, 0,
-	0, 0, 1431, 1384, 1452, 0, 0, 0, 1433, 0,
-	0, 0, 0, 58, 591, 551, 0, 556, 572, 0,
-	576, 0, 0, 568, 561, 565, 0, 0, 585, 545,
-	0, 0, 550, 610, 612, 1050, 0, 314, 328, 0,
-	0, 0, 0, 138, 822, 0, 139, 143, 133, 0,
-	0, 0, 827, 0, 824, 829, 0, 153, 0, 0,
-	128, 129, 883, 888, 0, 1334, 1336, 1338, 1340, 1342,
-	0, 1345, 1355, 1355, 1351, 0, 1346, 0, 1348, 0,
-	1325, 0, 1146, 0, 0, 0, 0, 0, 0, 1203,
-	1204, 0, 0, 1208, 0, 1210, 1211, 1212, 1214, 0,
-	0, 0, 1218, 0, 1257, 1275, 1261, 1264, 0, 1268,
-	0, 1270, 0, 625, 0, 1180, 1180, 0, 0, 0,
-	0, 1180, 0, 0, 0, 0, 0, 0, 0, 0,
-	1140, 0, 0, 700, 591, 0, 0, 0, 634, 632,
-	633, 264, 252, 254, 0, 0, 0, 768, 769, 771,
-	0, 774, 775, 776, 0, 781, 782, 784, 785, 0,
+	1525, 1526, 1527, 1528, 1529, 1531, 1532, 1533, 1534, 1535,
+	1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544, 1545,
+	1546, 1547, 1548, 1549, 1550, 1551, 1553, 1554, 1555, 1556,
+	1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566,
+	1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576,
+	1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586,
+	1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595, 1596,
+	1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605, 1606,
+	1607, 1608, 1609, 1610, 1611, 1613, 1614, 1615, 1616, 1617,
+	1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625, 1626, 1627,
+	1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635, 1636, 1637,
+	1638, 1639, 1640, 1641, 1642, 1643, 1644, 1645, 1646, 1647,
+	1648, 1649, 1650, 1651, 1652, 1653, 1654, 1655, 1656, 1657,
+	1658, 1659, 1660, 1661, 1662, 1663, 1664, 1665, 1666, 1667,
+	1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675, 1676, 1677,
+	1678, 1679, 1680, 1681, 1682, 1683, 1684, 1685, 1686, 1687,
+	1688, 1689, 1690, 1691, 1692, 1693, 1694, 1695, 1696, 1697,
+	1698, 1699, 0, 249, 247, 1497, 1530, 1552, 1612, 1646,
+	0, 789, 0, 605, 0, 610, 1036, 605, 277, 546,
+	547, 647, 647, 512, 0, 312, 0, 1543, 316, 0,
+	0, 0, 509, 307, 308, 309, 310, 311, 0, 770,
+	0, 0, 303, 0, 271, 1603, 0, 0, 0, 0,
+	0, 0, 154, 862, 156, 864, 160, 167, 0, 0,
+	172, 173, 176, 177, 178, 179, 180, 0, 184, 0,
+	186, 187, 190, 0, 192, 193, 0, 196, 197, 198,
+	0, 208, 209, 210, 865, 866, 867, -2, 85, 787,
+	1466, 1359, 0, 1366, 1367, 1378, 1389, 1152, 1153, 1154,
+	1155, 0, 0, 0, 0, 0, 1162, 1163, 0, 1178,
+	1714, 0, 1169, 1170, 1171, 1172, 1173, 94, 106, 107,
+	1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417,
+	0, 1332, 1139, 1074, 0, 1722, 0, 1742, 1747, 1748,
+	1749, 1750, 1741, 0, 0, 1317, 0, 1307, 0, 0,
+	-2, -2, 0, 0, 1673, -2, 1719, 1738, 1746, 1723,
+	1745, 1716, 1717, 1711, 1712, 1713, 1715, 1724, 1726, 1737,
+	0, 1733, 1743, 1744, 0, 0, 108, 109, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, 1496, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, 1323, -2, 1325, 1326, 1328, 1329, 1330, 1331, -2,
+	1334, 1335, 1336, -2, -2, 1339, 1340, 1341, 1342, 1343,
+	1344, 1347, -2, 1349, -2, -2, 1319, 1320, 1321, 1322,
+	1311, 1312, 1313, 1314, 1315, 1316, -2, -2, -2, 647,
+	720, 0, -2, 0, 0, 0, 667, 670, 673, 676,
+	0, 58, 59, 0, 0, 0, 266, 267, 255, 257,
+	0, 261, 0, 254, 0, 0, 0, 896, 896, 896,
+	896, 896, 896, 0, 896, 0, 0, 0, 896, 869,
+	870, 871, 894, 895, 942, 562, 558, 559, 560, 561,
+	635, 0, 637, 640, 489, 433, 0, 0, 489, 445,
+	0, 449, 439, 0, 0, 0, 489, 0, 0, 642,
+	642, 0, 492, 499, 489, 489, -2, 489, 489, 489,
+	0, 0, 455, 456, 457, 439, 0, 439, 461, 462,
+	463, 474, 475, 500, 1490, 0, 0, 381, 0, 381,
+	0, 381, 381, 564, 1603, 0, 0, 222, 1673, 227,
+	0, 1551, 1617, 1566, 0, 0, 1641, 1584, 0, -2,
+	0, 293, 642, 0, 648, 0, 647, 0, 0, 381,
+	381, 381, 381, 381, 381, 381, 381, 381, 0, 0,
+	0, 381, 0, 0, 685, 686, 681, 682, 683, 687,
+	688, 5, 6, 19, 0, 0, 0, 0, 0, 0,
+	64, 63, 0, 1467, 1485, 1420, 1421, 1422, 1472, 1424,
+	1476, 1476, 1476, 1476, 1452, 1453, 1454, 1455, 1456, 1457,
+	1458, 1459, 1460, 0, 0, 1463, 0, 0, 1443, 1474,
+	1474, 1474, 1472, 1469, 1425, 1426, 1427, 1428, 1429, 1430,
+	1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438, 1479, 1479,
+	1482, 1479, 0, 1357, 0, 0, 0, 0, 609, 0,
+	0, 642, -2, 0, 513, 313, 1078, 0, 0, 0,
+	320, 0, 319, 0, 336, 0, 339, 323, 324, 325,
+	0, 0, 305, 306, 0, 343, 273, 0, 0, 0,
+	382, 0, 0, 0, 0, 0, 0, 164, 161, 168,
+	171, 181, 189, 0, 201, 203, 206, 162, 169, 174,
+	175, 182, 204, 163, 165, 166, 170, 205, 207, 183,
+	188, 202, 185, 191, 194, 195, 200, 0, 135, 0,
+	0, 0, 0, 0, 1365, 0, 0, 1397, 1398, 1399,
+	1400, 1401, 1402, 1403, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, -2, 1359, 0, 0, 1158, 1159, 1160, 1161,
+	1164, 0, 1179, 0, 0, 0, 1418, 0, 1357, 1357,
+	0, 1357, 1353, 0, 0, 0, 0, 0, 0, 1357,
+	1293, 0, 0, 1295, 1308, 0, 0, 1297, 1298, 0,
+	1301, 1302, 1357, 0, 1357, 1306, 1289, 1290, 0, 1353,
+	1353, 0, 0, 1353, 1353, 1353, 1353, 1353, 1353, 1353,
+	1353, 1353, 1353, 1353, 1353, 0, 642, 0, 0, 630,
+	0, 611, 0, 664, 666, 0, 668, 669, 671, 672,
+	674, 675, 677, 678, 60, 0, 0, 0, 0, 0,
+	259, 0, 264, 0, 786, 0, 0, 802, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 896, 896, 896,
+	0, 564, 563, 0, 636, 0, 0, 693, 0, 0,
+	0, 439, 489, 444, 0, 0, 495, 496, 448, 441,
+	440, 492, 0, 492, 492, 693, 0, 468, 469, 470,
+	489, 489, 476, 643, 477, 478, 492, 0, 497, 498,
+	693, 0, 0, 693, 693, 0, 486, 487, 488, 0,
+	0, 896, 0, 505, 441, 459, 441, 1491, 1492, 0,
+	905, 0, 0, 0, 508, 0, 0, 0, 565, 215,
+	0, 0, 0, 0, 0, 0, 245, 246, 0, 0,
+	0, 0, 0, 0, 236, 239, 1030, 1031, 857, 858,
+	240, 241, 285, 286, 0, 611, 663, 665, 659, 660,
+	661, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	540, 0, 542, 0, 0, 701, 695, 697, 765, 94,
+	701, 8, 81, 78, 0, 19, 0, 0, 19, 19,
+	0, 19, 250, 0, 1488, 1486, 1487, 1423, 1473, 0,
+	1448, 0, 1449, 1450, 1451, 0, 0, 0, 0, 1444,
+	0, 1445, 1446, 1447, 1439, 0, 1440, 1441, 0, 1442,
+	248, 0, 1358, 790, 0, 584, 597, 579, 0, 586,
+	0, 1037, 566, 597, 568, 0, 586, 611, 633, 611,
+	0, 314, 0, 0, 326, 327, 328, 0, 321, 0,
+	0, 338, 340, 341, 342, 510, 301, 302, 294, 295,
+	296, 297, 298, 299, 300, 304, 104, 0, 0, 274,
+	275, 0, 0, 0, 148, 149, 150, 151, 152, 153,
+	155, 139, 531, 533, 849, 863, 0, 852, 0, 158,
+	199, 131, 0, 0, 1360, 1361, 1362, 1363, 1364, 1368,
+	0, 1370, 1372, 1374, 1376, 0, 1394, -2, -2, 1140,
+	1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150,
+	1151, 1379, 1392, 1393, 0, 0, 0, 0, 0, 0,
+	1390, 1390, 1385, 0, 1156, 0, 1176, 1180, 0, 0,
+	0, 95, 1352, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
+	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
+	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
+	1287, 1288, 0, 0, 1359, 0, 0, 0, 1354, 1355,
+	0, 0, 0, 1248, 0, 0, 1254, 1255, 1256, 0,
+	592, 0, 1318, 1294, 1309, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	719, 721, 0, 601, 603, 604, 0, 631, 644, 649,
+	650, 653, 56, 61, 53, 0, 0, 268, 269, 0,
+	0, 263, 0, -2, 804, 0, 640, 0, 0, 816,
+	379, 843, 0, 0, 859, 0, 885, 892, 0, 0,
+	0, 920, 0, 638, 0, 0, 733, 432, 0, 490,
+	491, 436, 1603, 441, 693, 446, 447, 450, 442, 451,
+	494, 452, 453, 454, 0, 693, 693, 489, 492, 479,
+	493, 492, 482, 483, 0, 501, 506, 502, 0, 504,
+	458, 460, 640, 353, 354, 0, 0, 0, 359, 1493,
+	1493, 0, 1493, 1493, 1493, 1493, 0, 0, 1493, 1493,
+	1493, 1493, 1493, 1493, 1493, 1493, 1493, 1493, 1493, 1493,
+	1493, 1493, 0, 906, 376, 0, 0, 379, 833, 706,
+	0, 707, 708, 704, 735, 760, 760, 0, 760, 739,
+	1036, 216, 217, 0, 219, 220, 221, 228, 223, 225,
+	0, 0, 229, 242, 243, 244, 0, 0, 0, 233,
+	234, 235, 0, 0, 288, 289, 291, 0, 629, 528,
+	1034, 529, 530, 534, 0, 0, 537, 538, 539, 804,
+	0, 838, 693, 0, 702, 0, 698, 766, 0, 768,
+	0, 693, 0, 79, 19, 0, 72, 69, 0, 0,
+	0, 0, 0, 1468, 1419, 1489, 0, 0, 0, 1470,
+	0, 0, 0, 0, 0, 0, 62, 613, 573, 0,
+	578, 594, 0, 598, 0, 0, 590, 583, 587, 0,
+	0, 607, 567, 0, 0, 572, 632, 634, 1079, 0,
+	317, 0, 322, 337, 0, 0, 0, 0, 0, 142,
+	846, 0, 143, 147, 137, 0, 0, 0, 851, 0,
+	848, 853, 0, 157, 0, 0, 132, 133, 911, 916,
+	0, 1369, 1371, 1373, 1375, 1377, 0, 1380, 1390, 1390,
+	1386, 0, 1381, 0, 1383, 0, 1360, 0, 1181, 0,
+	0, 0, 0, 0, 0, 1238, 1239, 0, 0, 1243,
+	0, 1245, 1246, 1247, 1249, 0, 0, 0, 1253, 0,
+	1292, 1310, 1296, 1299, 0, 1303, 0, 1305, 0, 647,
+	0, 1215, 1215, 0, 0, 0, 0, 1215, 0, 0,
+	0, 0, 0, 0, 0, 0, 1174, 0, 0, 722,
+	613, 0, 0, 0, 656, 654, 655, 54, 55, 270,
+	258, 260, 0, 0, 0, 792, 793, 795, 0, 798,
+	799, 800, 0, 805, 806, 808, 809, 0, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, 1535,
+	-2, 1685, 1686, -2, -2, -2, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, 779, 0, 869, 799, 1456, 367, 0, 0, 821,
-	0, 0, 0, -2, 0, 0, 0, 0, 894, 0,
-	0, 0, 529, 533, 23, 619, 0, 672, 417, 0,
-	418, 469, 426, 671, 1008, 451, 452, 671, 469, 469,
-	472, 0, 483, 0, 877, 345, 1014, 1015, 1016, 0,
-	0, 1020, 1027, 868, 1075, 0, 1027, 0, 0, 1029,
-	1030, 346, 0, 0, 0, 0, 0, 347, 0, 0,
-	1457, 0, 1456, 1456, 0, 0, 0, 0, 1456, 1456,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 799, 1456, 0, 0, 367,
-	806, 0, 0, 0, 0, 0, 0, 704, 0, 0,
-	703, 0, 0, 0, 0, 0, 618, 738, 0, 740,
-	741, 715, -2, 0, 684, 720, 1322, 0, 0, 0,
-	225, 226, 227, 231, 232, 281, 284, 286, 0, 0,
-	0, 521, 669, 674, 681, 744, 746, 91, 677, 669,
-	0, 78, 19, 77, 69, 70, 0, 19, 0, 0,
-	0, 0, 1441, 1440, 1426, 0, 1427, 1438, 1443, 0,
-	1446, 0, 595, 0, 0, 575, 577, 0, 0, 575,
-	0, 0, 584, 0, 0, 575, 0, 0, 491, 101,
-	334, 0, 0, 0, 0, 0, 511, 0, 826, 135,
-	0, 0, 155, 0, 0, 886, 0, 888, 1321, 1347,
-	1349, 0, 1356, 1352, 1123, 1131, 1142, 0, 0, 1148,
-	1160, 1160, 0, 1151, 1439, 1439, 1154, 1435, 1437, 1435,
-	1160, 1160, 0, 92, 0, 0, 1209, 0, 0, 0,
-	571, 0, 0, 0, 1178, 1180, 1185, 1181, 1186, 1180,
-	1180, 1180, 1180, 1191, 1180, 1180, 1180, 1180, 1180, 1180,
-	1180, 1180, 1176, 580, 595, 623, 624, 629, 630, 0,
-	256, 0, 0, 773, 0, 0, 0, 786, 0, 802,
-	800, 801, 0, 816, 368, 369, 370, 371, 0, 0,
-	0, 820, 0, 552, 0, 859, 860, 861, 862, 863,
-	-2, 872, 0, 0, 1010, 1010, 1010, 902, 0, 893,
-	583, 0, -2, 0, 0, 531, 0, 0, 712, 420,
-	671, 444, 0, 460, 671, 671, 469, 487, 0, 344,
-	-2, 1019, 1039, 1028, 1039, 1076, 1039, 1039, 868, 0,
-	349, 350, 351, 0, 353, 0, 961, 962, 0, 0,
-	965, 966, 967, 968, 0, 0, 971, 972, 973, 974,
-	975, 976, 977, 978, 979, 980, 996, 997, 998, 999,
-	1000, 1001, 981, 982, 983, 984, 985, 986, 993, 0,
-	0, 990, 0, 802, 0, 0, 0, 816, 808, 0,
-	810, 811, 0, 0, 554, 671, 274, 0, 707, 701,
-	0, 690, 705, 706, 693, 0, 695, 0, 691, 692,
-	671, 683, 714, 739, 716, 719, 721, 722, 728, 0,
-	0, 0, 0, 213, 0, 387, 219, 1007, 514, 519,
-	667, 0, 0, 667, 73, 76, 0, 71, 0, 0,
-	0, 0, 63, 61, 1434, 0, 0, 597, 115, 0,
-	0, 0, 573, 0, 578, 575, 560, 569, 559, 566,
-	567, 586, 575, 549, 548, 1051, 309, 0, 823, 135,
-	140, 141, 142, 136, 134, 830, 0, 832, 0, 884,
-	888, 0, 0, 1353, 1147, 1132, 1149, 1161, 1162, 1150,
-	1133, 1152, 1153, 1155, 1156, 1157, 1158, 1159, 1134, 1205,
-	0, 1207, 1215, 1216, 0, 1265, 1269, 0, 0, 0,
-	1184, 1187, 1188, 1189, 1190, 1192, 1193, 1194, 1195, 1196,
-	1197, 1198, 1199, 625, 1177, 0, 597, 635, 636, 761,
-	764, 770, 772, 0, 783, 0, 790, 791, 0, 0,
-	794, 795, 804, 0, 0, 0, 373, 374, 0, 0,
-	0, 386, 382, 383, 384, 364, 815, 806, 0, 0,
-	873, 1456, 1456, 1456, 0, 0, 1011, 1012, 0, 0,
-	905, 0, 0, 896, 0, 737, 0, 0, 671, 530,
-	533, 534, 617, 421, 671, 464, 461, 671, 341, 1041,
-	-2, 1054, 1056, 0, 0, 1059, 1060, 0, 0, 0,
-	0, 1097, 1066, 0, 0, 1070, 0, 1371, 1372, 0,
-	1074, 0, 1040, 0, 1031, 1040, 0, 0, 1039, 0,
-	352, 354, 355, 356, 963, 964, 969, 970, 987, 0,
-	0, 989, 0, 0, 357, 0, 0, 358, 362, 807,
-	0, 812, 813, 625, 0, 0, 687, 708, 0, 0,
-	688, 0, 689, 694, 696, 273, 723, 0, 0, 725,
-	726, 727, 718, 221, 657, 0, 0, 678, 658, 0,
-	19, 0, 0, 66, 1444, 1447, 599, 0, 596, 116,
-	0, 0, 0, 0, 574, 558, 547, 137, 132, 831,
-	118, 887, 889, 885, 0, 1217, 0, 1180, 1179, 1173,
-	0, 599, 0, 789, 787, 803, 793, 0, 817, 818,
-	0, 375, 376, 0, 379, 385, 805, 553, 0, 0,
-	0, 0, 865, -2, 0, 0, -2, 908, 0, 903,
-	0, 895, 0, 898, 671, 671, -2, 527, 532, 0,
-	445, 465, 1055, 1057, 1058, 1061, 1062, 1004, 1005, 1063,
-	1102, 1103, 1104, 1064, 1099, 1100, 1101, 1065, 0, 0,
-	0, 1369, 1370, 1095, 0, 0, 0, 0, 0, 0,
-	0, 1025, 994, 995, 988, 991, 992, 361, 359, 555,
-	620, 275, 276, 709, 0, 702, 732, 729, 0, 0,
-	668, 670, 67, 72, 0, 0, 601, 0, 598, 0,
-	592, 594, 126, 563, 88, 109, 0, 1206, 1256, 1183,
-	0, 1174, 0, 1168, 1169, 1170, 1175, 601, 0, 0,
-	0, 372, 377, 0, 380, 381, 0, 854, 1435, 0,
-	874, 875, 876, 915, -2, 958, 1013, 880, 118, 915,
-	899, 0, 906, 0, 904, 897, 625, 528, 0, 0,
-	1272, 1090, 0, 0, 0, 1032, 1034, 1035, 1036, 1037,
-	1038, 1033, 0, 0, 0, 1024, 1026, 1071, 0, 272,
-	0, 0, 733, 735, 730, 731, 62, 64, 79, 0,
-	600, 117, 0, 80, 0, 106, 0, 119, 120, 0,
-	0, 0, 0, 1182, 1171, 0, 0, 0, 0, 590,
-	0, 788, 796, 0, 798, 378, 847, 0, 631, 0,
-	917, 0, 877, 960, 882, 917, 891, 0, 901, 0,
-	910, 0, 907, 620, 1098, 0, 1069, 1078, 1091, 0,
-	0, 847, 847, 847, 847, 0, 1072, 710, 724, 0,
-	0, 0, 89, 93, 0, 115, 112, 0, 121, 0,
-	0, 0, 0, 0, 1163, 1164, 1166, 1165, 1167, 0,
-	797, 846, 855, 856, 631, 879, 0, 954, 959, 881,
-	900, 909, 0, 912, 526, 1067, 1077, 1079, 1080, 0,
-	1092, 1093, 1094, 1096, 1017, 1018, 1021, 1022, 0, 734,
-	736, 0, 603, 593, 82, 0, 0, 110, 111, 113,
-	0, 122, 0, 124, 125, 0, 777, 848, 1456, 0,
-	0, 852, 853, 857, 0, 942, 0, 0, 948, 0,
-	955, 911, 1068, 1081, 0, 1082, 0, 0, 0, 1023,
-	602, 0, 890, 0, 94, 0, 96, 98, 99, 1042,
-	107, 108, 114, 123, 1172, 0, 850, 0, 918, 0,
-	920, 0, 0, 0, 0, 0, 952, 0, 1083, 1085,
-	1086, 0, 0, 1084, 604, 83, 84, 0, 95, 0,
-	0, 849, 851, 0, 922, 0, 943, 0, 0, 0,
-	0, 0, 0, 0, 1087, 1089, 1088, 0, 0, 97,
-	1043, 919, 916, 0, 954, 944, 0, 946, 0, 0,
-	0, 0, 85, 86, 87, 0, 0, 924, 0, 940,
-	945, 947, 949, 0, 953, 951, 1044, 923, 0, 936,
-	921, 0, 950, 925, -2, 0, 941, 926, -2, 0,
-	934, 0, 0, 927, 935, 0, 930, 0, 0, 0,
-	929, 0, -2, 937, 0, 0, 931, -2, 0, 939,
-	938,
+	-2, 803, 0, 897, 823, 1493, 383, 0, 0, 845,
+	0, 0, 0, 0, -2, 0, 0, 0, 0, 922,
+	0, 0, 0, 551, 555, 23, 641, 0, 694, 434,
+	0, 435, 489, 443, 693, 1036, 471, 472, 693, 489,
+	489, 492, 0, 503, 0, 905, 356, 1042, 1043, 1044,
+	0, 0, 1048, 1055, 896, 1109, 0, 1055, 0, 0,
+	1057, 1058, 357, 0, 0, 0, 0, 0, 358, 0,
+	0, 1494, 0, 1493, 1493, 0, 0, 0, 0, 1493,
+	1493, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 823, 1493, 0, 0,
+	383, 830, 0, 0, 0, 0, 0, 0, 726, 0,
+	0, 725, 0, 0, 0, 0, 0, 640, 761, 0,
+	763, 764, 737, -2, 0, 706, 743, 0, 1357, 0,
+	0, 0, 230, 231, 232, 237, 238, 287, 290, 292,
+	0, 0, 0, 0, 543, 691, 696, 703, 767, 769,
+	95, 699, 691, 0, 82, 19, 81, 73, 74, 0,
+	19, 0, 0, 0, 0, 1478, 1477, 1461, 0, 1462,
+	1464, 1465, 1475, 1480, 0, 1483, 0, 617, 0, 0,
+	597, 599, 0, 0, 597, 0, 0, 606, 0, 0,
+	597, 0, 0, 318, 511, 105, 344, 0, 0, 0,
+	0, 0, 0, 532, 0, 850, 139, 0, 0, 159,
+	0, 0, 914, 0, 916, 1356, 1382, 1384, 0, 1391,
+	1387, 1157, 1165, 1177, 0, 0, 1183, 1195, 1195, 0,
+	1186, 1476, 1476, 1189, 1472, 1474, 1472, 1195, 1195, 0,
+	96, 1175, 0, 0, 1244, 0, 0, 0, 593, 0,
+	0, 0, 1213, 1215, 1220, 1216, 1221, 1215, 1215, 1215,
+	1215, 1226, 1215, 1215, 1215, 1215, 1215, 1215, 1215, 1215,
+	1211, 602, 617, 645, 646, 651, 652, 0, 262, 0,
+	0, 797, 0, 0, 0, 810, 0, 826, 824, 825,
+	0, 840, 384, 385, 386, 387, 0, 0, 0, 844,
+	0, 574, 0, 0, 886, 887, 888, 889, 890, 891,
+	-2, 900, 0, 0, 1038, 1038, 1038, 930, 0, 921,
+	605, 0, -2, 0, 0, 553, 0, 0, 734, 437,
+	693, 464, 0, 480, 693, 693, 489, 507, 0, 355,
+	-2, 1047, 1068, 1056, 1068, 1110, 1068, 1068, 896, 0,
+	360, 361, 362, 0, 364, 0, 989, 990, 0, 0,
+	993, 994, 995, 996, 0, 0, 999, 1000, 1001, 1002,
+	1003, 1004, 1005, 1006, 1007, 1008, 1024, 1025, 1026, 1027,
+	1028, 1029, 1009, 1010, 1011, 1012, 1013, 1014, 1021, 0,
+	0, 1018, 0, 826, 0, 0, 0, 840, 832, 0,
+	834, 835, 0, 0, 576, 693, 280, 0, 729, 723,
+	0, 712, 727, 728, 715, 0, 717, 0, 713, 714,
+	693, 705, 736, 762, 738, 741, 744, 745, 751, 0,
+	0, 0, 0, 0, 218, 0, 403, 224, 1035, 535,
+	536, 541, 689, 0, 0, 689, 77, 80, 0, 75,
+	0, 0, 0, 0, 67, 65, 1471, 0, 0, 619,
+	119, 0, 0, 0, 595, 0, 600, 597, 582, 591,
+	581, 588, 589, 608, 597, 571, 570, 1080, 315, 345,
+	0, 847, 139, 144, 145, 146, 140, 138, 854, 0,
+	856, 0, 912, 916, 0, 0, 1388, 1182, 1166, 1184,
+	1196, 1197, 1185, 1167, 1187, 1188, 1190, 1191, 1192, 1193,
+	1194, 1168, 1240, 0, 1242, 1250, 1251, 0, 1300, 1304,
+	0, 0, 0, 1219, 1222, 1223, 1224, 1225, 1227, 1228,
+	1229, 1230, 1231, 1232, 1233, 1234, 647, 1212, 0, 619,
+	657, 658, 785, 788, 794, 796, 0, 807, 0, 814,
+	815, 0, 0, 818, 819, 828, 0, 0, 0, 389,
+	390, 0, 0, 0, 402, 398, 399, 400, 380, 839,
+	830, 0, 0, 0, 0, 901, 1493, 1493, 1493, 0,
+	0, 1039, 1040, 0, 0, 933, 0, 0, 924, 0,
+	760, 0, 0, 693, 552, 555, 556, 639, 438, 693,
+	484, 481, 693, 352, 1070, -2, 1083, 1085, 0, 0,
+	1088, 1089, 0, 0, 0, 0, 1131, 1095, 0, 0,
+	1099, 0, 0, 0, 1406, 1407, 0, 1108, 0, 1069,
+	0, 1059, 1069, 0, 0, 1068, 0, 363, 365, 366,
+	367, 991, 992, 997, 998, 1015, 0, 0, 1017, 0,
+	0, 369, 0, 0, 374, 378, 831, 0, 836, 837,
+	647, 0, 0, 709, 730, 0, 0, 710, 0, 711,
+	716, 718, 279, 746, 0, 0, 748, 749, 750, 0,
+	740, 226, 679, 0, 0, 700, 680, 0, 19, 0,
+	0, 70, 1481, 1484, 621, 0, 618, 120, 0, 0,
+	0, 0, 596, 580, 569, 141, 136, 855, 122, 915,
+	917, 913, 0, 1252, 0, 1215, 1214, 1208, 0, 621,
+	0, 813, 811, 827, 817, 0, 841, 842, 0, 391,
+	392, 0, 395, 401, 829, 575, 0, 0, 0, 0,
+	0, 0, 893, -2, 0, 0, -2, 936, 0, 931,
+	0, 923, 0, 926, 693, 693, -2, 549, 554, 0,
+	465, 485, 1084, 1086, 1087, 1090, 1091, 1032, 1033, 1092,
+	1136, 1137, 1138, 1093, 1133, 1134, 1135, 1094, 0, 0,
+	0, 0, 0, 1404, 1405, 1129, 0, 0, 0, 0,
+	0, 0, 0, 1053, 1022, 1023, 1016, 1019, 1020, 368,
+	0, 377, 375, 577, 642, 281, 282, 731, 0, 724,
+	755, 752, 0, 0, 760, 690, 692, 71, 76, 0,
+	0, 623, 0, 620, 0, 614, 616, 130, 585, 92,
+	113, 0, 1241, 1291, 1218, 0, 1209, 0, 1203, 1204,
+	1205, 1210, 623, 0, 0, 0, 388, 393, 0, 396,
+	397, 0, 0, 0, 881, 1472, 0, 902, 903, 904,
+	943, -2, 986, 1041, 908, 122, 943, 927, 0, 934,
+	0, 932, 925, 647, 550, 0, 0, 1307, 0, 0,
+	1124, 0, 0, 0, 1060, 1062, 1063, 1064, 1065, 1066,
+	1067, 1061, 0, 0, 0, 1052, 1054, 1105, 0, 0,
+	278, 0, 0, 756, 758, 753, 754, 743, 66, 68,
+	83, 0, 622, 121, 0, 84, 0, 110, 0, 123,
+	124, 0, 0, 0, 0, 1217, 1206, 0, 0, 0,
+	0, 612, 0, 812, 820, 0, 822, 394, 860, 0,
+	873, 0, 653, 0, 945, 0, 905, 988, 910, 945,
+	919, 0, 929, 0, 938, 0, 935, 642, 1132, 0,
+	1098, 0, 1102, 1112, 1125, 0, 0, 873, 873, 873,
+	873, 0, 1106, 370, 371, 1493, 732, 747, 0, 742,
+	0, 0, 93, 97, 0, 119, 116, 0, 125, 0,
+	0, 0, 0, 0, 1198, 1199, 1201, 1200, 1202, 0,
+	821, 0, 872, 882, 883, 653, 907, 0, 982, 987,
+	909, 928, 937, 0, 940, 548, 1096, 0, 1101, 1103,
+	1104, 1111, 1113, 1114, 0, 1126, 1127, 1128, 1130, 1045,
+	1046, 1049, 1050, 0, 0, 0, 757, 759, 0, 625,
+	615, 86, 0, 0, 114, 115, 117, 0, 126, 0,
+	128, 129, 0, 801, 861, 874, 1493, 0, 0, 878,
+	879, 1493, 884, 0, 970, 0, 0, 976, 0, 983,
+	939, 1097, 1102, 1115, 0, 1116, 0, 0, 0, 1051,
+	372, 373, 624, 0, 918, 0, 98, 0, 100, 102,
+	103, 1071, 111, 112, 118, 127, 1207, 0, 876, 0,
+	0, 946, 0, 948, 0, 0, 0, 0, 0, 980,
+	0, 1100, 1117, 1119, 1120, 0, 0, 1118, 626, 87,
+	88, 0, 99, 0, 0, 875, 877, 880, 0, 950,
+	0, 971, 0, 0, 0, 0, 0, 0, 0, 1121,
+	1123, 1122, 0, 0, 101, 1072, 947, 944, 0, 982,
+	972, 0, 974, 0, 0, 0, 0, 89, 90, 91,
+	0, 0, 952, 0, 968, 973, 975, 977, 0, 981,
+	979, 1073, 951, 0, 964, 949, 0, 978, 953, -2,
+	0, 969, 954, -2, 0, 962, 0, 0, 955, 963,
+	0, 958, 0, 0, 0, 957, 0, -2, 965, 0,
+	0, 959, -2, 0, 967, 966,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 96, 3, 3, 3, 127, 119, 3,
-	67, 68, 124, 122, 137, 123, 136, 125, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 557, 556,
-	106, 105, 107, 3, 558, 3, 3, 3, 3, 3,
+	3, 3, 3, 98, 3, 3, 3, 129, 121, 3,
+	69, 70, 126, 124, 139, 125, 138, 127, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 571, 570,
+	108, 107, 109, 3, 572, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 129, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 131, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 559, 118, 560, 130,
+	3, 3, 3, 573, 120, 574, 132,
 }
 
 var yyTok2 = [...]int{
@@ -7124,13 +7443,13 @@ var yyTok2 = [...]int{
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
-	62, 63, 64, 65, 66, 69, 70, 71, 72, 73,
+	62, 63, 64, 65, 66, 67, 68, 71, 72, 73,
 	74, 75, 76, 77, 78, 79, 80, 81, 82, 83,
 	84, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 97, 98, 99, 100, 101, 102, 103, 104,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	120, 121, 126, 128, 131, 132, 133, 134, 135, 138,
-	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
+	94, 95, 96, 97, 99, 100, 101, 102, 103, 104,
+	105, 106, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 122, 123, 128, 130, 133, 134, 135, 136,
+	137, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
@@ -7203,7 +7522,10 @@ var yyTok3 = [...]int{
 	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
 	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
-	57880, 555, 0,
+	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
+	57885, 560, 57886, 561, 57887, 562, 57888, 563, 57889, 564,
+	57890, 565, 57891, 566, 57892, 567, 57893, 568, 57894, 569,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -7553,13 +7875,13 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:710
+//line mysql_sql.y:720
 		{
 			yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:717
+//line mysql_sql.y:727
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -7567,7 +7889,7 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:733
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -7576,7 +7898,7 @@ yydefault:
 	case 6:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:731
+//line mysql_sql.y:741
 		{
 			yyLOCAL = tree.NewCompoundStmt(yyDollar[2].statementsUnion())
 		}
@@ -7584,7 +7906,7 @@ yydefault:
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:737
+//line mysql_sql.y:747
 		{
 			yyLOCAL = []tree.Statement{yyDollar[1].statementUnion()}
 		}
@@ -7592,7 +7914,7 @@ yydefault:
 	case 8:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:741
+//line mysql_sql.y:751
 		{
 			yyLOCAL = append(yyDollar[1].statementsUnion(), yyDollar[3].statementUnion())
 		}
@@ -7600,7 +7922,7 @@ yydefault:
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:756
+//line mysql_sql.y:766
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7608,7 +7930,7 @@ yydefault:
 	case 19:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:760
+//line mysql_sql.y:770
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7616,7 +7938,7 @@ yydefault:
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:766
+//line mysql_sql.y:776
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7624,7 +7946,7 @@ yydefault:
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:771
+//line mysql_sql.y:781
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7632,7 +7954,7 @@ yydefault:
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:775
+//line mysql_sql.y:785
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7640,15 +7962,50 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:807
+//line mysql_sql.y:817
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 53:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:825
+		{
+			yyLOCAL = &tree.Backup{
+				Scope: tree.BackupScopeCluster,
+				Path:  yyDollar[4].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 54:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:832
+		{
+			yyLOCAL = &tree.Backup{
+				Scope: tree.BackupScopeAccount,
+				Name:  tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				Path:  yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 55:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:840
+		{
+			yyLOCAL = &tree.Backup{
+				Scope: tree.BackupScopeDatabase,
+				Name:  tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				Path:  yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:814
+//line mysql_sql.y:850
 		{
 			var connectionId uint64
 			switch v := yyDollar[3].item.(type) {
@@ -7668,20 +8025,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 57:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:834
+//line mysql_sql.y:870
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:840
+//line mysql_sql.y:876
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7689,10 +8046,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:847
+//line mysql_sql.y:883
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7700,20 +8057,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 60:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:855
+//line mysql_sql.y:891
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:861
+//line mysql_sql.y:897
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist:       true,
@@ -7721,10 +8078,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 62:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:870
+//line mysql_sql.y:906
 		{
 			yyLOCAL = &tree.CallStmt{
 				Name: yyDollar[2].procNameUnion(),
@@ -7732,30 +8089,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 63:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:880
+//line mysql_sql.y:916
 		{
 			yyLOCAL = &tree.LeaveStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:888
+//line mysql_sql.y:924
 		{
 			yyLOCAL = &tree.IterateStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 65:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:896
+//line mysql_sql.y:932
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: "",
@@ -7764,10 +8121,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 66:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:904
+//line mysql_sql.y:940
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7776,10 +8133,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 67:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:914
+//line mysql_sql.y:950
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: "",
@@ -7788,10 +8145,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 68:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:922
+//line mysql_sql.y:958
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7800,10 +8157,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:932
+//line mysql_sql.y:968
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: "",
@@ -7811,10 +8168,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 70:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:939
+//line mysql_sql.y:975
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7822,10 +8179,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 71:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:948
+//line mysql_sql.y:984
 		{
 			yyLOCAL = &tree.IfStmt{
 				Cond:  yyDollar[2].exprUnion(),
@@ -7835,42 +8192,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:958
+//line mysql_sql.y:994
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:962
+//line mysql_sql.y:998
 		{
 			yyLOCAL = yyDollar[1].elseIfClauseListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:968
+//line mysql_sql.y:1004
 		{
 			yyLOCAL = []*tree.ElseIfStmt{yyDollar[1].elseIfClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:972
+//line mysql_sql.y:1008
 		{
 			yyLOCAL = append(yyDollar[1].elseIfClauseListUnion(), yyDollar[2].elseIfClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ElseIfStmt
-//line mysql_sql.y:978
+//line mysql_sql.y:1014
 		{
 			yyLOCAL = &tree.ElseIfStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7878,10 +8235,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 77:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:987
+//line mysql_sql.y:1023
 		{
 			yyLOCAL = &tree.CaseStmt{
 				Expr:  yyDollar[2].exprUnion(),
@@ -7890,26 +8247,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:997
+//line mysql_sql.y:1033
 		{
 			yyLOCAL = []*tree.WhenStmt{yyDollar[1].whenClause2Union()}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:1001
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseList2Union(), yyDollar[2].whenClause2Union())
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 80:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.WhenStmt
-//line mysql_sql.y:1007
+//line mysql_sql.y:1043
 		{
 			yyLOCAL = &tree.WhenStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7917,26 +8274,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1016
+//line mysql_sql.y:1052
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1020
+//line mysql_sql.y:1056
 		{
 			yyLOCAL = yyDollar[2].statementsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 83:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1026
+//line mysql_sql.y:1062
 		{
 			ep := &tree.ExportParam{
 				Outfile:     true,
@@ -7953,10 +8310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 84:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1047
+//line mysql_sql.y:1083
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -7968,52 +8325,52 @@ yydefault:
 			yyLOCAL.(*tree.Load).Param.Parallel = yyDollar[10].unsignedOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1060
+//line mysql_sql.y:1096
 		{
 			yyLOCAL = &tree.LoadExtension{
 				Name: tree.Identifier(yyDollar[2].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 86:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1067
+//line mysql_sql.y:1103
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1071
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1077
+//line mysql_sql.y:1113
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1081
+//line mysql_sql.y:1117
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1087
+//line mysql_sql.y:1123
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -8021,10 +8378,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1094
+//line mysql_sql.y:1130
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -8032,18 +8389,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 92:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1102
+//line mysql_sql.y:1138
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1106
+//line mysql_sql.y:1142
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8056,58 +8413,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1120
+//line mysql_sql.y:1156
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1124
+//line mysql_sql.y:1160
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 96:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1128
+//line mysql_sql.y:1164
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1133
+//line mysql_sql.y:1169
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1137
+//line mysql_sql.y:1173
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1141
+//line mysql_sql.y:1177
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1147
+//line mysql_sql.y:1183
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8117,10 +8474,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1156
+//line mysql_sql.y:1192
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8130,58 +8487,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1167
+//line mysql_sql.y:1203
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1171
+//line mysql_sql.y:1207
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1177
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1181
+//line mysql_sql.y:1217
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1187
+//line mysql_sql.y:1223
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1191
+//line mysql_sql.y:1227
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1197
+//line mysql_sql.y:1233
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8204,10 +8561,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1221
+//line mysql_sql.y:1257
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -8226,42 +8583,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 110:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1240
+//line mysql_sql.y:1276
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1244
+//line mysql_sql.y:1280
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1248
+//line mysql_sql.y:1284
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 113:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1253
+//line mysql_sql.y:1289
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1257
+//line mysql_sql.y:1293
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -8269,10 +8626,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1264
+//line mysql_sql.y:1300
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[3].str,
@@ -8280,42 +8637,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 116:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1272
+//line mysql_sql.y:1308
 		{
 			yyVAL.str = ""
 		}
-	case 114:
+	case 118:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1279
+//line mysql_sql.y:1315
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 115:
+	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1284
+//line mysql_sql.y:1320
 		{
 			yyVAL.str = "\n"
 		}
-	case 117:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1291
+//line mysql_sql.y:1327
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 118:
+	case 122:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1296
+//line mysql_sql.y:1332
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1300
+//line mysql_sql.y:1336
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -8338,36 +8695,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1324
+//line mysql_sql.y:1360
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1328
+//line mysql_sql.y:1364
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1334
+//line mysql_sql.y:1370
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1340
+//line mysql_sql.y:1376
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -8386,10 +8743,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1358
+//line mysql_sql.y:1394
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8407,10 +8764,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 129:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1375
+//line mysql_sql.y:1411
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8428,50 +8785,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 131:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1398
+//line mysql_sql.y:1434
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1402
+//line mysql_sql.y:1438
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1406
+//line mysql_sql.y:1442
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 134:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1411
+//line mysql_sql.y:1447
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1415
+//line mysql_sql.y:1451
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 136:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1421
+//line mysql_sql.y:1457
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -8485,10 +8842,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 137:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1470
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -8500,10 +8857,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 138:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1445
+//line mysql_sql.y:1481
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -8516,26 +8873,26 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1458
+//line mysql_sql.y:1494
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1462
+//line mysql_sql.y:1498
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 141:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1472
+//line mysql_sql.y:1508
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -8549,10 +8906,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 142:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1485
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -8564,30 +8921,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1498
+//line mysql_sql.y:1534
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1504
+//line mysql_sql.y:1540
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1510
+//line mysql_sql.y:1546
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -8595,10 +8952,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1517
+//line mysql_sql.y:1553
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -8607,10 +8964,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1525
+//line mysql_sql.y:1561
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -8618,74 +8975,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1534
+//line mysql_sql.y:1570
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1538
+//line mysql_sql.y:1574
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1542
+//line mysql_sql.y:1578
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1546
+//line mysql_sql.y:1582
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1550
+//line mysql_sql.y:1586
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1554
+//line mysql_sql.y:1590
 		{
 			yyLOCAL = tree.OBJECT_TYPE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1561
+//line mysql_sql.y:1597
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1565
+//line mysql_sql.y:1601
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1571
+//line mysql_sql.y:1607
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8693,10 +9050,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1578
+//line mysql_sql.y:1614
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8704,426 +9061,434 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1587
+//line mysql_sql.y:1623
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1591
+//line mysql_sql.y:1627
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1597
+//line mysql_sql.y:1633
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1601
+//line mysql_sql.y:1637
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1605
+//line mysql_sql.y:1641
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1609
+//line mysql_sql.y:1645
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1613
+//line mysql_sql.y:1649
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1617
+//line mysql_sql.y:1653
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1621
+//line mysql_sql.y:1657
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1625
+//line mysql_sql.y:1661
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1629
+//line mysql_sql.y:1665
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1633
+//line mysql_sql.y:1669
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1637
+//line mysql_sql.y:1673
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1641
+//line mysql_sql.y:1677
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1645
+//line mysql_sql.y:1681
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1649
+//line mysql_sql.y:1685
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1653
+//line mysql_sql.y:1689
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1657
+//line mysql_sql.y:1693
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1661
+//line mysql_sql.y:1697
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1665
+//line mysql_sql.y:1701
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1669
+//line mysql_sql.y:1705
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1673
+//line mysql_sql.y:1709
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1677
+//line mysql_sql.y:1713
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1681
+//line mysql_sql.y:1717
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1685
+//line mysql_sql.y:1721
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1689
+//line mysql_sql.y:1725
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1693
+//line mysql_sql.y:1729
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CONNECT
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1697
+//line mysql_sql.y:1733
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1701
+//line mysql_sql.y:1737
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 187:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.PrivilegeType
+//line mysql_sql.y:1741
+		{
+			yyLOCAL = tree.PRIVILEGE_TYPE_DYNAMIC_BACKUP_ADMIN
+		}
+		yyVAL.union = yyLOCAL
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1705
+//line mysql_sql.y:1745
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1709
+//line mysql_sql.y:1749
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1713
+//line mysql_sql.y:1753
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1717
+//line mysql_sql.y:1757
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1721
+//line mysql_sql.y:1761
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1725
+//line mysql_sql.y:1765
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCE
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1729
+//line mysql_sql.y:1769
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1733
+//line mysql_sql.y:1773
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1737
+//line mysql_sql.y:1777
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1741
+//line mysql_sql.y:1781
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1745
+//line mysql_sql.y:1785
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1749
+//line mysql_sql.y:1789
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1753
+//line mysql_sql.y:1793
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1757
+//line mysql_sql.y:1797
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1761
+//line mysql_sql.y:1801
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1765
+//line mysql_sql.y:1805
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1769
+//line mysql_sql.y:1809
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1773
+//line mysql_sql.y:1813
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1777
+//line mysql_sql.y:1817
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1781
+//line mysql_sql.y:1821
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1785
+//line mysql_sql.y:1825
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1789
+//line mysql_sql.y:1829
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1793
+//line mysql_sql.y:1833
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRUNCATE
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1805
+//line mysql_sql.y:1845
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole: false,
@@ -9131,10 +9496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 216:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1852
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9142,10 +9507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 217:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1819
+//line mysql_sql.y:1859
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9153,90 +9518,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 218:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1828
+//line mysql_sql.y:1868
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1858
+//line mysql_sql.y:1898
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1862
+//line mysql_sql.y:1902
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1866
+//line mysql_sql.y:1906
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 222:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1872
+//line mysql_sql.y:1912
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 223:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1878
+//line mysql_sql.y:1918
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 224:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1882
+//line mysql_sql.y:1922
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 226:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1889
+//line mysql_sql.y:1929
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 222:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1895
+//line mysql_sql.y:1935
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1899
+//line mysql_sql.y:1939
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1905
+//line mysql_sql.y:1945
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9245,10 +9610,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 230:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1913
+//line mysql_sql.y:1953
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9258,10 +9623,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 226:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1922
+//line mysql_sql.y:1962
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9270,10 +9635,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1930
+//line mysql_sql.y:1970
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9282,10 +9647,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1938
+//line mysql_sql.y:1978
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9309,10 +9674,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1961
+//line mysql_sql.y:2001
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9336,10 +9701,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 235:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line mysql_sql.y:2024
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   "transaction_snapshot",
+				Value:  yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 236:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1984
+//line mysql_sql.y:2032
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9347,10 +9724,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 237:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1991
+//line mysql_sql.y:2039
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9358,10 +9735,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 238:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1998
+//line mysql_sql.y:2046
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     strings.ToLower(yyDollar[1].str),
@@ -9370,10 +9747,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2006
+//line mysql_sql.y:2054
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9381,10 +9758,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2013
+//line mysql_sql.y:2061
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9392,10 +9769,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 241:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2020
+//line mysql_sql.y:2068
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9403,212 +9780,212 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2029
+//line mysql_sql.y:2077
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2033
+//line mysql_sql.y:2081
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2037
+//line mysql_sql.y:2085
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2043
+//line mysql_sql.y:2091
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 240:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2047
+//line mysql_sql.y:2095
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 241:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2053
+//line mysql_sql.y:2101
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 242:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2057
+//line mysql_sql.y:2105
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
 		}
-	case 243:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2063
+//line mysql_sql.y:2111
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2067
+//line mysql_sql.y:2115
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2078
+//line mysql_sql.y:2126
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2084
+//line mysql_sql.y:2132
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 256:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2089
+//line mysql_sql.y:2137
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2093
+//line mysql_sql.y:2141
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2097
+//line mysql_sql.y:2145
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 259:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2101
+//line mysql_sql.y:2149
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 260:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2105
+//line mysql_sql.y:2153
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2109
+//line mysql_sql.y:2157
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 262:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2113
+//line mysql_sql.y:2161
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2117
+//line mysql_sql.y:2165
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 264:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2121
+//line mysql_sql.y:2169
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2127
+//line mysql_sql.y:2175
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2131
+//line mysql_sql.y:2179
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2135
+//line mysql_sql.y:2183
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2139
+//line mysql_sql.y:2187
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2144
+//line mysql_sql.y:2192
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 270:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2149
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2155
+//line mysql_sql.y:2203
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9616,20 +9993,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2162
+//line mysql_sql.y:2210
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2168
+//line mysql_sql.y:2216
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9637,10 +10014,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2175
+//line mysql_sql.y:2223
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9648,10 +10025,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2182
+//line mysql_sql.y:2230
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9659,19 +10036,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2192
+//line mysql_sql.y:2240
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 278:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2199
+//line mysql_sql.y:2247
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -9683,10 +10060,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 279:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2210
+//line mysql_sql.y:2258
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -9696,222 +10073,248 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2221
+//line mysql_sql.y:2269
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2225
+//line mysql_sql.y:2273
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:2231
+//line mysql_sql.y:2279
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2241
+//line mysql_sql.y:2289
 		{
 			yyLOCAL = &tree.LockTableStmt{TableLocks: yyDollar[3].tableLocksUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2247
+//line mysql_sql.y:2295
 		{
 			yyLOCAL = []tree.TableLock{yyDollar[1].tableLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2251
+//line mysql_sql.y:2299
 		{
 			yyLOCAL = append(yyDollar[1].tableLocksUnion(), yyDollar[3].tableLockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 288:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLock
-//line mysql_sql.y:2257
+//line mysql_sql.y:2305
 		{
 			yyLOCAL = tree.TableLock{*yyDollar[1].tableNameUnion(), yyDollar[2].tableLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2263
+//line mysql_sql.y:2311
 		{
 			yyLOCAL = tree.TableLockRead
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2267
+//line mysql_sql.y:2315
 		{
 			yyLOCAL = tree.TableLockReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2271
+//line mysql_sql.y:2319
 		{
 			yyLOCAL = tree.TableLockWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2275
+//line mysql_sql.y:2323
 		{
 			yyLOCAL = tree.TableLockLowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2281
+//line mysql_sql.y:2329
 		{
 			yyLOCAL = &tree.UnLockTableStmt{}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 300:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2293
+//line mysql_sql.y:2341
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 301:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2299
+//line mysql_sql.y:2347
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 302:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2303
+//line mysql_sql.y:2351
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2309
+//line mysql_sql.y:2357
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2313
+//line mysql_sql.y:2361
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2319
+//line mysql_sql.y:2367
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2325
+//line mysql_sql.y:2373
 		{
 			yyLOCAL = tree.NewReset(tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 311:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2335
+//line mysql_sql.y:2383
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 306:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2341
+//line mysql_sql.y:2389
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2345
+//line mysql_sql.y:2393
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2349
+//line mysql_sql.y:2397
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 309:
+	case 315:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2353
+//line mysql_sql.y:2401
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2357
+//line mysql_sql.y:2405
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 317:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2361
+//line mysql_sql.y:2409
 		{
-			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
+			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
+			optionElem := tree.MakeOptionElem("format", yyDollar[4].str)
+			options := tree.MakeOptions(optionElem)
+			explainStmt.Options = options
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 318:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2417
+		{
+			explainStmt := tree.NewExplainAnalyze(yyDollar[6].statementUnion(), "text")
+			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+			optionElem2 := tree.MakeOptionElem("format", yyDollar[5].str)
+			options := tree.MakeOptions(optionElem1)
+			options = append(options, optionElem2)
+			explainStmt.Options = options
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 319:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2427
+		{
+			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
 			options := tree.MakeOptions(optionElem)
 			explainStmt.Options = options
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2369
+//line mysql_sql.y:2435
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -9920,10 +10323,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 321:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2377
+//line mysql_sql.y:2443
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -9934,10 +10337,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 322:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2387
+//line mysql_sql.y:2453
 		{
 			if tree.IsContainAnalyze(yyDollar[3].epxlainOptionsUnion()) {
 				explainStmt := tree.NewExplainAnalyze(yyDollar[5].statementUnion(), "text")
@@ -9950,66 +10353,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2428
+//line mysql_sql.y:2495
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2432
+//line mysql_sql.y:2499
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:2438
+//line mysql_sql.y:2505
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 339:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2444
+//line mysql_sql.y:2511
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 331:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2449
+//line mysql_sql.y:2516
 		{
 			yyVAL.str = "true"
 		}
-	case 332:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2450
+//line mysql_sql.y:2517
 		{
 			yyVAL.str = "false"
 		}
-	case 333:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2451
+//line mysql_sql.y:2518
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 334:
+	case 343:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2523
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 344:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2456
+//line mysql_sql.y:2527
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 345:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2531
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[6].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 352:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2471
+//line mysql_sql.y:2546
 		{
 			yyLOCAL = &tree.AlterView{
 				Name:     yyDollar[4].tableNameUnion(),
@@ -10019,10 +10438,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 353:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2482
+//line mysql_sql.y:2557
 		{
 			yyLOCAL = &tree.AlterTable{
 				Table:   yyDollar[3].tableNameUnion(),
@@ -10030,26 +10449,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2491
+//line mysql_sql.y:2566
 		{
 			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2495
+//line mysql_sql.y:2570
 		{
 			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 356:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2501
+//line mysql_sql.y:2576
 		{
 			opt := &tree.AlterOptionAdd{
 				Def: yyDollar[2].tableDefUnion(),
@@ -10057,34 +10476,34 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2508
+//line mysql_sql.y:2583
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2512
+//line mysql_sql.y:2587
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2516
+//line mysql_sql.y:2591
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[1].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2522
+//line mysql_sql.y:2597
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropIndex,
@@ -10092,10 +10511,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2529
+//line mysql_sql.y:2604
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropKey,
@@ -10103,10 +10522,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2536
+//line mysql_sql.y:2611
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropColumn,
@@ -10114,10 +10533,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2543
+//line mysql_sql.y:2618
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropForeignKey,
@@ -10125,20 +10544,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2550
+//line mysql_sql.y:2625
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ: tree.AlterTableDropPrimaryKey,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2558
+//line mysql_sql.y:2633
 		{
 			yyLOCAL = &tree.AlterOptionAlterIndex{
 				Visibility: yyDollar[3].indexVisibilityUnion(),
@@ -10146,26 +10565,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2567
+//line mysql_sql.y:2642
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_VISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 367:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2571
+//line mysql_sql.y:2646
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_INVISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 368:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2578
+//line mysql_sql.y:2653
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -10173,13 +10592,62 @@ yydefault:
 				AuthOption:   yyDollar[5].alterAccountAuthOptionUnion(),
 				StatusOption: yyDollar[6].accountStatusUnion(),
 				Comment:      yyDollar[7].accountCommentUnion(),
+				Quota:        yyDollar[8].accountQuotaUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 369:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.AccountQuota
+//line mysql_sql.y:2665
+		{
+			yyLOCAL = tree.AccountQuota{
+				Exist: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AccountQuota
+//line mysql_sql.y:2671
+		{
+			yyLOCAL = tree.AccountQuota{
+				Exist:   true,
+				Options: yyDollar[3].accountQuotaOptionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 371:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AccountQuotaOption
+//line mysql_sql.y:2680
+		{
+			yyLOCAL = []tree.AccountQuotaOption{yyDollar[1].accountQuotaOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AccountQuotaOption
+//line mysql_sql.y:2684
+		{
+			yyLOCAL = append(yyDollar[1].accountQuotaOptionsUnion(), yyDollar[3].accountQuotaOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 373:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AccountQuotaOption
+//line mysql_sql.y:2690
+		{
+			yyLOCAL = tree.AccountQuotaOption{
+				Name:  yyDollar[1].cstrUnion().Compare(),
+				Value: yyDollar[3].item.(int64),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 374:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2590
+//line mysql_sql.y:2699
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				DbName:         yyDollar[3].str,
@@ -10188,10 +10656,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 375:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2598
+//line mysql_sql.y:2707
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				AccountName:    yyDollar[4].str,
@@ -10200,20 +10668,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 376:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2607
+//line mysql_sql.y:2716
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 377:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2613
+//line mysql_sql.y:2722
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist:          true,
@@ -10223,10 +10691,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 378:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2624
+//line mysql_sql.y:2733
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -10237,222 +10705,222 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 379:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2635
+//line mysql_sql.y:2744
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2639
+//line mysql_sql.y:2748
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 381:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2644
+//line mysql_sql.y:2753
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2648
+//line mysql_sql.y:2757
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 383:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2653
+//line mysql_sql.y:2762
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 384:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2657
+//line mysql_sql.y:2766
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2673
+//line mysql_sql.y:2782
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2677
+//line mysql_sql.y:2786
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2681
+//line mysql_sql.y:2790
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2685
+//line mysql_sql.y:2794
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2689
+//line mysql_sql.y:2798
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 390:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2693
+//line mysql_sql.y:2802
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2697
+//line mysql_sql.y:2806
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2701
+//line mysql_sql.y:2810
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2705
+//line mysql_sql.y:2814
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 394:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2709
+//line mysql_sql.y:2818
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2713
+//line mysql_sql.y:2822
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2717
+//line mysql_sql.y:2826
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2721
+//line mysql_sql.y:2830
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 398:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2725
+//line mysql_sql.y:2834
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2729
+//line mysql_sql.y:2838
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2733
+//line mysql_sql.y:2842
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2739
+//line mysql_sql.y:2848
 		{
 			yyVAL.item = nil
 		}
-	case 386:
+	case 402:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2744
+//line mysql_sql.y:2853
 		{
 			yyVAL.item = nil
 		}
-	case 415:
+	case 432:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2782
+//line mysql_sql.y:2892
 		{
 			yyLOCAL = &tree.ShowCollation{}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2787
+//line mysql_sql.y:2897
 		{
 			yyLOCAL = &tree.ShowGrants{ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 434:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2791
+//line mysql_sql.y:2901
 		{
 			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname, Roles: yyDollar[5].rolesUnion(), ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 435:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2795
+//line mysql_sql.y:2905
 		{
 			s := &tree.ShowGrants{}
 			roles := []*tree.Role{tree.NewRole(yyDollar[5].cstrUnion().Compare())}
@@ -10461,44 +10929,44 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 436:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2804
+//line mysql_sql.y:2914
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2808
+//line mysql_sql.y:2918
 		{
 			yyLOCAL = yyDollar[2].rolesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 438:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2814
+//line mysql_sql.y:2924
 		{
 			yyLOCAL = &tree.ShowTableStatus{DbName: yyDollar[5].str, Like: yyDollar[6].comparisionExprUnion(), Where: yyDollar[7].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 439:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2819
+//line mysql_sql.y:2929
 		{
 		}
-	case 424:
+	case 441:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2823
+//line mysql_sql.y:2933
 		{
 		}
-	case 426:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2828
+//line mysql_sql.y:2938
 		{
 			yyLOCAL = &tree.ShowFunctionStatus{
 				Like:  yyDollar[4].comparisionExprUnion(),
@@ -10506,68 +10974,92 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 444:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2837
+//line mysql_sql.y:2947
 		{
 			yyLOCAL = &tree.ShowRolesStmt{
 				Like: yyDollar[3].comparisionExprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 445:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2955
+		{
+			yyLOCAL = &tree.ShowPolicies{}
+		}
+		yyVAL.union = yyLOCAL
+	case 446:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2959
+		{
+			yyLOCAL = &tree.ShowPolicies{Table: yyDollar[4].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 447:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2963
+		{
+			yyLOCAL = &tree.ShowPolicies{Table: yyDollar[4].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2845
+//line mysql_sql.y:2969
 		{
 			yyLOCAL = &tree.ShowNodeList{}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2851
+//line mysql_sql.y:2975
 		{
 			yyLOCAL = &tree.ShowLocks{}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2857
+//line mysql_sql.y:2981
 		{
 			yyLOCAL = &tree.ShowTableNumber{DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 451:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2863
+//line mysql_sql.y:2987
 		{
 			yyLOCAL = &tree.ShowColumnNumber{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2869
+//line mysql_sql.y:2993
 		{
 			yyLOCAL = &tree.ShowTableValues{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2875
+//line mysql_sql.y:2999
 		{
 			yyLOCAL = &tree.ShowTableSize{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2881
+//line mysql_sql.y:3005
 		{
 			s := yyDollar[2].statementUnion().(*tree.ShowTarget)
 			s.Like = yyDollar[3].comparisionExprUnion()
@@ -10575,82 +11067,82 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2890
+//line mysql_sql.y:3014
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowConfig}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2894
+//line mysql_sql.y:3018
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2898
+//line mysql_sql.y:3022
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowEngines}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2902
+//line mysql_sql.y:3026
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowTriggers}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2906
+//line mysql_sql.y:3030
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProcedureStatus}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2910
+//line mysql_sql.y:3034
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowEvents}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2914
+//line mysql_sql.y:3038
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPlugins}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2918
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPrivileges}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2922
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProfiles}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 464:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2928
+//line mysql_sql.y:3052
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[5].tableNameUnion(),
@@ -10658,10 +11150,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 465:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2935
+//line mysql_sql.y:3059
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[7].cstrUnion().Compare()), ExplicitSchema: true}
 			tbl := tree.NewTableName(tree.Identifier(yyDollar[5].cstrUnion().Compare()), prefix)
@@ -10671,20 +11163,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 466:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2945
+//line mysql_sql.y:3069
 		{
 		}
-	case 447:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2947
+//line mysql_sql.y:3071
 		{
 		}
-	case 451:
+	case 471:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2956
+//line mysql_sql.y:3080
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -10693,10 +11185,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 472:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2966
+//line mysql_sql.y:3090
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -10705,58 +11197,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2975
+//line mysql_sql.y:3099
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2979
+//line mysql_sql.y:3103
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2983
+//line mysql_sql.y:3107
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2989
+//line mysql_sql.y:3113
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2995
+//line mysql_sql.y:3119
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3001
+//line mysql_sql.y:3125
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 479:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3007
+//line mysql_sql.y:3131
 		{
 			yyLOCAL = &tree.ShowSequences{
 				DBName: yyDollar[3].str,
@@ -10764,10 +11256,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 480:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3016
+//line mysql_sql.y:3140
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -10778,10 +11270,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 481:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3026
+//line mysql_sql.y:3150
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -10792,26 +11284,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 482:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3038
+//line mysql_sql.y:3162
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 483:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3042
+//line mysql_sql.y:3166
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 484:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3048
+//line mysql_sql.y:3172
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -10824,10 +11316,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 485:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3060
+//line mysql_sql.y:3184
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -10840,196 +11332,196 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3074
+//line mysql_sql.y:3198
 		{
 			yyLOCAL = &tree.ShowAccounts{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3080
+//line mysql_sql.y:3204
 		{
 			yyLOCAL = &tree.ShowPublications{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3087
+//line mysql_sql.y:3211
 		{
 			yyLOCAL = &tree.ShowSubscriptions{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 489:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3092
+//line mysql_sql.y:3216
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3096
+//line mysql_sql.y:3220
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3100
+//line mysql_sql.y:3224
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3105
+//line mysql_sql.y:3229
 		{
 			yyVAL.str = ""
 		}
-	case 473:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3109
+//line mysql_sql.y:3233
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 474:
+	case 494:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3115
+//line mysql_sql.y:3239
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3128
+//line mysql_sql.y:3252
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3132
+//line mysql_sql.y:3256
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3138
+//line mysql_sql.y:3262
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 502:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3143
+//line mysql_sql.y:3267
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3147
+//line mysql_sql.y:3271
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3151
+//line mysql_sql.y:3275
 		{
 			yyLOCAL = &tree.ShowCreatePublications{Name: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3157
+//line mysql_sql.y:3281
 		{
 			yyLOCAL = &tree.ShowBackendServers{}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3163
+//line mysql_sql.y:3287
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3167
+//line mysql_sql.y:3291
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3173
+//line mysql_sql.y:3297
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 489:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3179
+//line mysql_sql.y:3303
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3183
+//line mysql_sql.y:3307
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 511:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3187
+//line mysql_sql.y:3311
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3193
+//line mysql_sql.y:3317
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 513:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3197
+//line mysql_sql.y:3321
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 528:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3220
+//line mysql_sql.y:3345
 		{
 			yyLOCAL = &tree.DropSequence{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11037,10 +11529,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 529:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3229
+//line mysql_sql.y:3354
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11048,10 +11540,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3238
+//line mysql_sql.y:3363
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11059,26 +11551,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 531:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3247
+//line mysql_sql.y:3372
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3251
+//line mysql_sql.y:3376
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3257
+//line mysql_sql.y:3382
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -11086,10 +11578,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3266
+//line mysql_sql.y:3391
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11097,10 +11589,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 535:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3275
+//line mysql_sql.y:3400
+		{
+			yyLOCAL = &tree.DropPolicy{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:    yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 536:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3410
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].cstrUnion().Compare()),
@@ -11109,42 +11613,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3285
+//line mysql_sql.y:3420
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3291
+//line mysql_sql.y:3426
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3297
+//line mysql_sql.y:3432
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3303
+//line mysql_sql.y:3438
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 541:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3309
+//line mysql_sql.y:3444
 		{
 			yyLOCAL = &tree.DropFunction{
 				Name: yyDollar[3].functionNameUnion(),
@@ -11152,10 +11656,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3318
+//line mysql_sql.y:3453
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[3].procNameUnion(),
@@ -11163,10 +11667,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 543:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3325
+//line mysql_sql.y:3460
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[5].procNameUnion(),
@@ -11174,28 +11678,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3336
+//line mysql_sql.y:3471
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3341
+//line mysql_sql.y:3476
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 548:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3348
+//line mysql_sql.y:3483
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -11212,10 +11716,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 549:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3364
+//line mysql_sql.y:3499
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11225,10 +11729,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 550:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3377
+//line mysql_sql.y:3512
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11238,69 +11742,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3388
+//line mysql_sql.y:3523
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3392
+//line mysql_sql.y:3527
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3398
+//line mysql_sql.y:3533
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 554:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3403
+//line mysql_sql.y:3538
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3410
+//line mysql_sql.y:3545
 		{
 		}
-	case 534:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3412
+//line mysql_sql.y:3547
 		{
 		}
-	case 535:
+	case 557:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3415
+//line mysql_sql.y:3550
 		{
 		}
-	case 540:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3424
+//line mysql_sql.y:3559
 		{
 		}
-	case 542:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3428
+//line mysql_sql.y:3563
 		{
 		}
-	case 544:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3433
+//line mysql_sql.y:3568
 		{
 			rep := yyDollar[4].replaceUnion()
 			rep.Table = yyDollar[2].tableExprUnion()
@@ -11308,10 +11812,10 @@ yydefault:
 			yyLOCAL = rep
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3442
+//line mysql_sql.y:3577
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11319,20 +11823,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3449
+//line mysql_sql.y:3584
 		{
 			yyLOCAL = &tree.Replace{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 569:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3455
+//line mysql_sql.y:3590
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11341,10 +11845,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3463
+//line mysql_sql.y:3598
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11352,10 +11856,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 571:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3470
+//line mysql_sql.y:3605
 		{
 			yyLOCAL = &tree.Replace{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11363,10 +11867,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3477
+//line mysql_sql.y:3612
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of replace can not be empty")
@@ -11385,10 +11889,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 573:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3497
+//line mysql_sql.y:3632
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -11397,42 +11901,42 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3506
+//line mysql_sql.y:3641
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3510
+//line mysql_sql.y:3645
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3516
+//line mysql_sql.y:3651
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3520
+//line mysql_sql.y:3655
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3526
+//line mysql_sql.y:3661
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11440,20 +11944,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3533
+//line mysql_sql.y:3668
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 580:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3539
+//line mysql_sql.y:3674
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11462,10 +11966,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 581:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3547
+//line mysql_sql.y:3682
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11473,10 +11977,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3554
+//line mysql_sql.y:3689
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11484,10 +11988,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3561
+//line mysql_sql.y:3696
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -11506,50 +12010,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 584:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3580
+//line mysql_sql.y:3715
 		{
 			yyLOCAL = []*tree.UpdateExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 585:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3584
+//line mysql_sql.y:3719
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3589
+//line mysql_sql.y:3724
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3593
+//line mysql_sql.y:3728
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3597
+//line mysql_sql.y:3732
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:3603
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -11557,155 +12061,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3612
+//line mysql_sql.y:3747
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3616
+//line mysql_sql.y:3751
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3622
+//line mysql_sql.y:3757
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 571:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3626
+//line mysql_sql.y:3761
 		{
 			yyVAL.str = yyDollar[3].cstrUnion().Compare()
 		}
-	case 572:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3632
+//line mysql_sql.y:3767
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3636
+//line mysql_sql.y:3771
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3642
+//line mysql_sql.y:3777
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3647
+//line mysql_sql.y:3782
 		{
 		}
-	case 577:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3651
+//line mysql_sql.y:3786
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3658
+//line mysql_sql.y:3793
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3662
+//line mysql_sql.y:3797
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3669
+//line mysql_sql.y:3804
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3674
+//line mysql_sql.y:3809
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3678
+//line mysql_sql.y:3813
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3684
+//line mysql_sql.y:3819
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3688
+//line mysql_sql.y:3823
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3694
+//line mysql_sql.y:3829
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3698
+//line mysql_sql.y:3833
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3703
+//line mysql_sql.y:3838
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 612:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3707
+//line mysql_sql.y:3842
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -11718,10 +12222,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 613:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3720
+//line mysql_sql.y:3855
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -11729,10 +12233,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3727
+//line mysql_sql.y:3862
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -11740,10 +12244,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 615:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3734
+//line mysql_sql.y:3869
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -11762,10 +12266,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3752
+//line mysql_sql.y:3887
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -11784,38 +12288,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3771
+//line mysql_sql.y:3906
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3777
+//line mysql_sql.y:3912
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3784
+//line mysql_sql.y:3919
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3788
+//line mysql_sql.y:3923
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -11828,115 +12332,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3801
+//line mysql_sql.y:3936
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3805
+//line mysql_sql.y:3940
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 623:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3810
+//line mysql_sql.y:3945
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 624:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3814
+//line mysql_sql.y:3949
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3821
+//line mysql_sql.y:3956
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3826
+//line mysql_sql.y:3961
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3833
+//line mysql_sql.y:3968
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 629:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3839
+//line mysql_sql.y:3974
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3843
+//line mysql_sql.y:3978
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 631:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3847
+//line mysql_sql.y:3982
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 632:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3851
+//line mysql_sql.y:3986
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 633:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3855
+//line mysql_sql.y:3990
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 634:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3859
+//line mysql_sql.y:3994
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3865
+//line mysql_sql.y:4000
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -11944,10 +12448,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3872
+//line mysql_sql.y:4007
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -11955,26 +12459,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3881
+//line mysql_sql.y:4016
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3885
+//line mysql_sql.y:4020
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 639:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:3891
+//line mysql_sql.y:4026
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
@@ -11982,178 +12486,178 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3899
+//line mysql_sql.y:4034
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3903
+//line mysql_sql.y:4038
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3908
+//line mysql_sql.y:4043
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3912
+//line mysql_sql.y:4047
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3918
+//line mysql_sql.y:4053
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 645:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3922
+//line mysql_sql.y:4057
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3926
+//line mysql_sql.y:4061
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 647:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3931
+//line mysql_sql.y:4066
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3935
+//line mysql_sql.y:4070
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3941
+//line mysql_sql.y:4076
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3947
+//line mysql_sql.y:4082
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3951
+//line mysql_sql.y:4086
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:3957
+//line mysql_sql.y:4092
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3962
+//line mysql_sql.y:4097
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3966
+//line mysql_sql.y:4101
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3970
+//line mysql_sql.y:4105
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3975
+//line mysql_sql.y:4110
 		{
 			yyLOCAL = tree.DefaultNullsPosition
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 657:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3979
+//line mysql_sql.y:4114
 		{
 			yyLOCAL = tree.NullsFirst
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 658:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3983
+//line mysql_sql.y:4118
 		{
 			yyLOCAL = tree.NullsLast
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3989
+//line mysql_sql.y:4124
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3993
+//line mysql_sql.y:4128
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3997
+//line mysql_sql.y:4132
 		{
 			valuesStmt := yyDollar[2].statementUnion().(*tree.ValuesStatement)
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{
@@ -12166,18 +12670,18 @@ yydefault:
 			}}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4011
+//line mysql_sql.y:4146
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4015
+//line mysql_sql.y:4150
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12188,10 +12692,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4025
+//line mysql_sql.y:4160
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12202,10 +12706,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4035
+//line mysql_sql.y:4170
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12216,10 +12720,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4045
+//line mysql_sql.y:4180
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12230,10 +12734,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4057
+//line mysql_sql.y:4192
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12242,10 +12746,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4065
+//line mysql_sql.y:4200
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12254,10 +12758,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4073
+//line mysql_sql.y:4208
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12266,10 +12770,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4082
+//line mysql_sql.y:4217
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12278,10 +12782,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4090
+//line mysql_sql.y:4225
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12290,10 +12794,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4098
+//line mysql_sql.y:4233
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12302,10 +12806,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4106
+//line mysql_sql.y:4241
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12314,10 +12818,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4114
+//line mysql_sql.y:4249
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12326,10 +12830,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 675:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4122
+//line mysql_sql.y:4257
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12338,10 +12842,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4130
+//line mysql_sql.y:4265
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12350,10 +12854,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4138
+//line mysql_sql.y:4273
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12362,10 +12866,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4146
+//line mysql_sql.y:4281
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12374,10 +12878,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 679:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4156
+//line mysql_sql.y:4291
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -12389,10 +12893,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 680:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4167
+//line mysql_sql.y:4302
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -12405,149 +12909,149 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4181
+//line mysql_sql.y:4316
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 660:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4185
+//line mysql_sql.y:4320
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 661:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4189
+//line mysql_sql.y:4324
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 662:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4194
+//line mysql_sql.y:4329
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4198
+//line mysql_sql.y:4333
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4202
+//line mysql_sql.y:4337
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 689:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4211
+//line mysql_sql.y:4346
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4215
+//line mysql_sql.y:4350
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 691:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4220
+//line mysql_sql.y:4355
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4224
+//line mysql_sql.y:4359
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 693:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4229
+//line mysql_sql.y:4364
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4233
+//line mysql_sql.y:4368
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4239
+//line mysql_sql.y:4374
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4243
+//line mysql_sql.y:4378
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4249
+//line mysql_sql.y:4384
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4253
+//line mysql_sql.y:4388
 		{
 			yyDollar[2].cstrUnion().SetConfig(0)
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4258
+//line mysql_sql.y:4393
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 700:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4262
+//line mysql_sql.y:4397
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 701:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4267
+//line mysql_sql.y:4402
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -12556,28 +13060,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4275
+//line mysql_sql.y:4410
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4281
+//line mysql_sql.y:4416
 		{
 			yyLOCAL = &tree.From{
 				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4289
+//line mysql_sql.y:4424
 		{
 			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
 				yyLOCAL = t
@@ -12586,26 +13090,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4297
+//line mysql_sql.y:4432
 		{
 			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4307
+//line mysql_sql.y:4442
 		{
 			yyLOCAL = yyDollar[1].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4313
+//line mysql_sql.y:4448
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12615,10 +13119,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 710:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4322
+//line mysql_sql.y:4457
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12628,10 +13132,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 711:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4331
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12641,10 +13145,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4340
+//line mysql_sql.y:4475
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12653,15 +13157,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4350
+//line mysql_sql.y:4485
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 692:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4354
+//line mysql_sql.y:4489
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -12669,34 +13173,34 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 693:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4364
+//line mysql_sql.y:4499
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 694:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4368
+//line mysql_sql.y:4503
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 695:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4372
+//line mysql_sql.y:4507
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 696:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4376
+//line mysql_sql.y:4511
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 697:
+	case 719:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4382
+//line mysql_sql.y:4517
 		{
 			yyLOCAL = &tree.ValuesStatement{
 				Rows:    yyDollar[2].rowsExprsUnion(),
@@ -12705,130 +13209,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4392
+//line mysql_sql.y:4527
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4396
+//line mysql_sql.y:4531
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 722:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4402
+//line mysql_sql.y:4537
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4408
+//line mysql_sql.y:4543
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4412
+//line mysql_sql.y:4547
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4418
+//line mysql_sql.y:4553
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 704:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4424
+//line mysql_sql.y:4559
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 705:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4428
+//line mysql_sql.y:4563
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 706:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4432
+//line mysql_sql.y:4567
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 707:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4438
+//line mysql_sql.y:4573
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4442
+//line mysql_sql.y:4577
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4448
+//line mysql_sql.y:4583
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 732:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4452
+//line mysql_sql.y:4587
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4458
+//line mysql_sql.y:4593
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4462
+//line mysql_sql.y:4597
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4468
+//line mysql_sql.y:4603
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4472
+//line mysql_sql.y:4607
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -12839,10 +13343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4482
+//line mysql_sql.y:4617
 		{
 			if yyDollar[2].str != "" {
 				yyLOCAL = &tree.AliasedTableExpr{
@@ -12856,26 +13360,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4495
+//line mysql_sql.y:4630
 		{
 			yyLOCAL = yyDollar[2].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:4501
+//line mysql_sql.y:4636
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 740:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4507
+//line mysql_sql.y:4642
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].cstrUnion().Compare()))
 			yyLOCAL = &tree.TableFunction{
@@ -12887,10 +13391,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:4520
+//line mysql_sql.y:4655
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -12901,34 +13405,49 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 742:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:4665
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[6].str),
+				},
+				IndexHints:    yyDollar[7].indexHintListUnion(),
+				AsOfTimestamp: yyDollar[5].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 743:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4531
+//line mysql_sql.y:4677
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4538
+//line mysql_sql.y:4684
 		{
 			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4542
+//line mysql_sql.y:4688
 		{
 			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 747:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.IndexHint
-//line mysql_sql.y:4548
+//line mysql_sql.y:4694
 		{
 			yyLOCAL = &tree.IndexHint{
 				IndexNames: yyDollar[4].strsUnion(),
@@ -12937,176 +13456,176 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4558
+//line mysql_sql.y:4704
 		{
 			yyLOCAL = tree.HintUse
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4562
+//line mysql_sql.y:4708
 		{
 			yyLOCAL = tree.HintIgnore
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4566
+//line mysql_sql.y:4712
 		{
 			yyLOCAL = tree.HintForce
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 751:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4571
+//line mysql_sql.y:4717
 		{
 			yyLOCAL = tree.HintForScan
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4575
+//line mysql_sql.y:4721
 		{
 			yyLOCAL = tree.HintForJoin
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4579
+//line mysql_sql.y:4725
 		{
 			yyLOCAL = tree.HintForOrderBy
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 754:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4583
+//line mysql_sql.y:4729
 		{
 			yyLOCAL = tree.HintForGroupBy
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 755:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4588
+//line mysql_sql.y:4734
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4592
+//line mysql_sql.y:4738
 		{
 			yyLOCAL = []string{yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4596
+//line mysql_sql.y:4742
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4600
+//line mysql_sql.y:4746
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4604
+//line mysql_sql.y:4750
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 760:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4609
+//line mysql_sql.y:4755
 		{
 			yyVAL.str = ""
 		}
-	case 738:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4613
+//line mysql_sql.y:4759
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 739:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4617
+//line mysql_sql.y:4763
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 740:
+	case 763:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4623
+//line mysql_sql.y:4769
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 742:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4629
+//line mysql_sql.y:4775
 		{
 			yyLOCAL = tree.NewCStr("", yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4633
+//line mysql_sql.y:4779
 		{
 			yyLOCAL = yyDollar[1].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4783
 		{
 			yyLOCAL = yyDollar[2].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4641
+//line mysql_sql.y:4787
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 769:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4645
+//line mysql_sql.y:4791
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[2].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4651
+//line mysql_sql.y:4797
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 761:
+	case 785:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4684
+//line mysql_sql.y:4831
 		{
 			yyLOCAL = &tree.CreateExtension{
 				Language: yyDollar[3].str,
@@ -13115,22 +13634,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4694
+//line mysql_sql.y:4841
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 763:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4700
+//line mysql_sql.y:4847
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 764:
+	case 788:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4706
+//line mysql_sql.y:4853
 		{
 			yyLOCAL = &tree.CreateProcedure{
 				Name: yyDollar[3].procNameUnion(),
@@ -13139,100 +13658,100 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4716
+//line mysql_sql.y:4863
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[1].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 790:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4721
+//line mysql_sql.y:4868
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().ToLower()), ExplicitSchema: true}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[3].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 791:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4727
+//line mysql_sql.y:4874
 		{
 			yyLOCAL = tree.ProcedureArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 793:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4734
+//line mysql_sql.y:4881
 		{
 			yyLOCAL = tree.ProcedureArgs{yyDollar[1].procArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 794:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4738
+//line mysql_sql.y:4885
 		{
 			yyLOCAL = append(yyDollar[1].procArgsUnion(), yyDollar[3].procArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArg
-//line mysql_sql.y:4744
+//line mysql_sql.y:4891
 		{
 			yyLOCAL = tree.ProcedureArg(yyDollar[1].procArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 796:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureArgDecl
-//line mysql_sql.y:4750
+//line mysql_sql.y:4897
 		{
 			yyLOCAL = tree.NewProcedureArgDecl(yyDollar[1].procArgTypeUnion(), yyDollar[2].unresolvedNameUnion(), yyDollar[3].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 797:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4755
+//line mysql_sql.y:4902
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4759
+//line mysql_sql.y:4906
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4763
+//line mysql_sql.y:4910
 		{
 			yyLOCAL = tree.TYPE_OUT
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 800:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4767
+//line mysql_sql.y:4914
 		{
 			yyLOCAL = tree.TYPE_INOUT
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 801:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4774
+//line mysql_sql.y:4921
 		{
 			yyLOCAL = &tree.CreateFunction{
 				Name:       yyDollar[3].functionNameUnion(),
@@ -13243,98 +13762,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4786
+//line mysql_sql.y:4933
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 803:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4791
+//line mysql_sql.y:4938
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 804:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4797
+//line mysql_sql.y:4944
 		{
 			yyLOCAL = tree.FunctionArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4804
+//line mysql_sql.y:4951
 		{
 			yyLOCAL = tree.FunctionArgs{yyDollar[1].funcArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 807:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4808
+//line mysql_sql.y:4955
 		{
 			yyLOCAL = append(yyDollar[1].funcArgsUnion(), yyDollar[3].funcArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArg
-//line mysql_sql.y:4814
+//line mysql_sql.y:4961
 		{
 			yyLOCAL = tree.FunctionArg(yyDollar[1].funcArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4820
+//line mysql_sql.y:4967
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(nil, yyDollar[1].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4824
+//line mysql_sql.y:4971
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 811:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4828
+//line mysql_sql.y:4975
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4834
+//line mysql_sql.y:4981
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 789:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReturnType
-//line mysql_sql.y:4840
+//line mysql_sql.y:4987
 		{
 			yyLOCAL = tree.NewReturnType(yyDollar[1].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 814:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4846
+//line mysql_sql.y:4993
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[4].tableNameUnion(),
@@ -13344,10 +13863,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 815:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4857
+//line mysql_sql.y:5004
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -13358,16 +13877,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4869
+//line mysql_sql.y:5016
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 793:
+	case 817:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:4875
+//line mysql_sql.y:5022
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -13376,22 +13895,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4885
+//line mysql_sql.y:5032
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 795:
+	case 819:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4889
+//line mysql_sql.y:5036
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 796:
+	case 820:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4895
+//line mysql_sql.y:5042
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13399,20 +13918,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 821:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4902
+//line mysql_sql.y:5049
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 822:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4908
+//line mysql_sql.y:5055
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -13420,20 +13939,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 823:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4916
+//line mysql_sql.y:5063
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 824:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4922
+//line mysql_sql.y:5069
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13441,10 +13960,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 825:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4929
+//line mysql_sql.y:5076
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13452,20 +13971,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 826:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4937
+//line mysql_sql.y:5084
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 827:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4943
+//line mysql_sql.y:5090
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -13473,10 +13992,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 828:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4952
+//line mysql_sql.y:5099
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -13487,10 +14006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 829:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4964
+//line mysql_sql.y:5111
 		{
 			yyLOCAL = &tree.CreatePublication{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13501,22 +14020,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 830:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4975
+//line mysql_sql.y:5122
 		{
 			yyVAL.str = ""
 		}
-	case 807:
+	case 831:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4979
+//line mysql_sql.y:5126
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 808:
+	case 832:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4985
+//line mysql_sql.y:5132
 		{
 			yyLOCAL = &tree.AlterPublication{
 				IfExists:    yyDollar[3].boolValUnion(),
@@ -13526,58 +14045,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 833:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4995
+//line mysql_sql.y:5142
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:4999
+//line mysql_sql.y:5146
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				All: true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 835:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5005
+//line mysql_sql.y:5152
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				SetAccounts: yyDollar[2].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5011
+//line mysql_sql.y:5158
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				AddAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 837:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5017
+//line mysql_sql.y:5164
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				DropAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 838:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5026
+//line mysql_sql.y:5173
 		{
 			yyLOCAL = &tree.DropPublication{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -13585,26 +14104,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5035
+//line mysql_sql.y:5182
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 816:
+	case 840:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5040
+//line mysql_sql.y:5187
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 841:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5046
+//line mysql_sql.y:5193
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13613,10 +14132,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 842:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5054
+//line mysql_sql.y:5201
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13625,26 +14144,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5160
+//line mysql_sql.y:5307
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 844:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5164
+//line mysql_sql.y:5311
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5170
+//line mysql_sql.y:5317
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13653,26 +14172,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5180
+//line mysql_sql.y:5327
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5184
+//line mysql_sql.y:5331
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 848:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5190
+//line mysql_sql.y:5337
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13681,50 +14200,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5200
+//line mysql_sql.y:5347
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 850:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5204
+//line mysql_sql.y:5351
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5208
+//line mysql_sql.y:5355
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5213
+//line mysql_sql.y:5360
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 853:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5217
+//line mysql_sql.y:5364
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 854:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5223
+//line mysql_sql.y:5370
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13732,20 +14251,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 855:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5230
+//line mysql_sql.y:5377
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 856:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5236
+//line mysql_sql.y:5383
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -13753,16 +14272,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5245
+//line mysql_sql.y:5392
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 835:
+	case 859:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5252
+//line mysql_sql.y:5399
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13770,90 +14289,117 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 860:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5408
+		{
+			yyLOCAL = &tree.CreatePolicy{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:       yyDollar[6].tableNameUnion(),
+				Expr:        yyDollar[9].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 861:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5417
+		{
+			yyLOCAL = &tree.CreatePolicy{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Table:       yyDollar[6].tableNameUnion(),
+				Roles:       yyDollar[8].rolesUnion(),
+				Expr:        yyDollar[11].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5261
+//line mysql_sql.y:5429
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 863:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5265
+//line mysql_sql.y:5433
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:5271
+//line mysql_sql.y:5439
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5285
+//line mysql_sql.y:5453
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5289
+//line mysql_sql.y:5457
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5293
+//line mysql_sql.y:5461
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 868:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5298
+//line mysql_sql.y:5466
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5302
+//line mysql_sql.y:5470
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5306
+//line mysql_sql.y:5474
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 871:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5310
+//line mysql_sql.y:5478
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 872:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5316
+//line mysql_sql.y:5484
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -13872,18 +14418,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 873:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5335
+//line mysql_sql.y:5503
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5339
+//line mysql_sql.y:5507
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -13899,128 +14445,146 @@ yydefault:
 					opt1.ParserName = opt2.ParserName
 				} else if opt2.Visible != tree.VISIBLE_TYPE_INVALID {
 					opt1.Visible = opt2.Visible
+				} else if opt2.Lists > 0 {
+					opt1.Lists = opt2.Lists
 				}
 				yyLOCAL = opt1
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5361
+//line mysql_sql.y:5531
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5365
+//line mysql_sql.y:5535
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5369
+//line mysql_sql.y:5539
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5373
+//line mysql_sql.y:5543
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5377
+//line mysql_sql.y:5547
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 880:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line mysql_sql.y:5551
+		{
+			yyLOCAL = &tree.IndexOption{Lists: uint64(yyDollar[3].item.(int64))}
+		}
+		yyVAL.union = yyLOCAL
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5383
+//line mysql_sql.y:5557
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 882:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5387
+//line mysql_sql.y:5561
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5393
+//line mysql_sql.y:5567
 		{
 			// Order is parsed but just ignored as MySQL dtree.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 884:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5398
+//line mysql_sql.y:5572
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 885:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5403
+//line mysql_sql.y:5577
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 886:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5407
+//line mysql_sql.y:5581
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 887:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5411
+//line mysql_sql.y:5585
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 888:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5415
+//line mysql_sql.y:5589
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 889:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5419
+//line mysql_sql.y:5593
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 890:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line mysql_sql.y:5597
+		{
+			yyLOCAL = tree.INDEX_TYPE_IVFFLAT
+		}
+		yyVAL.union = yyLOCAL
+	case 891:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5425
+//line mysql_sql.y:5603
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -14030,114 +14594,114 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 892:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5436
+//line mysql_sql.y:5614
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 893:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5440
+//line mysql_sql.y:5618
 		{
 			yyLOCAL = &tree.SubscriptionOption{From: tree.Identifier(yyDollar[2].str), Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 896:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5450
+//line mysql_sql.y:5628
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 897:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5454
+//line mysql_sql.y:5632
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 898:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5459
+//line mysql_sql.y:5637
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5463
+//line mysql_sql.y:5641
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 900:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5469
+//line mysql_sql.y:5647
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 901:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5473
+//line mysql_sql.y:5651
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 902:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5479
+//line mysql_sql.y:5657
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 903:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5483
+//line mysql_sql.y:5661
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 904:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5487
+//line mysql_sql.y:5665
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 905:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5492
+//line mysql_sql.y:5670
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5496
+//line mysql_sql.y:5674
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 907:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5502
+//line mysql_sql.y:5680
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -14150,10 +14714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 908:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5514
+//line mysql_sql.y:5692
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -14163,10 +14727,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 909:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5523
+//line mysql_sql.y:5701
 		{
 			yyLOCAL = &tree.CreateTable{
 				IsClusterTable:  true,
@@ -14179,19 +14743,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 910:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5536
+//line mysql_sql.y:5714
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 911:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5543
+//line mysql_sql.y:5721
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14202,10 +14766,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 912:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5553
+//line mysql_sql.y:5731
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14214,10 +14778,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 913:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5561
+//line mysql_sql.y:5739
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14227,43 +14791,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5572
+//line mysql_sql.y:5750
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5576
+//line mysql_sql.y:5754
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5581
+//line mysql_sql.y:5759
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5585
+//line mysql_sql.y:5763
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 918:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:5592
+//line mysql_sql.y:5770
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -14274,10 +14838,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 919:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5603
+//line mysql_sql.y:5781
 		{
 			yyLOCAL = &tree.CreateSequence{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14291,10 +14855,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 920:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5616
+//line mysql_sql.y:5794
 		{
 			locale := ""
 			fstr := "bigint"
@@ -14309,26 +14873,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 921:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5630
+//line mysql_sql.y:5808
 		{
 			yyLOCAL = yyDollar[2].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 922:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5634
+//line mysql_sql.y:5812
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5638
+//line mysql_sql.y:5816
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14336,10 +14900,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 924:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5645
+//line mysql_sql.y:5823
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14347,10 +14911,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 925:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5652
+//line mysql_sql.y:5830
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14358,10 +14922,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5659
+//line mysql_sql.y:5837
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14369,42 +14933,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 927:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5666
+//line mysql_sql.y:5844
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 928:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5670
+//line mysql_sql.y:5848
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5674
+//line mysql_sql.y:5852
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 930:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5678
+//line mysql_sql.y:5856
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5682
+//line mysql_sql.y:5860
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: false,
@@ -14412,10 +14976,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 932:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5689
+//line mysql_sql.y:5867
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: true,
@@ -14423,18 +14987,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 933:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5696
+//line mysql_sql.y:5874
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5700
+//line mysql_sql.y:5878
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: false,
@@ -14442,10 +15006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 935:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5707
+//line mysql_sql.y:5885
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: true,
@@ -14453,18 +15017,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 936:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5714
+//line mysql_sql.y:5892
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 937:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5718
+//line mysql_sql.y:5896
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14472,10 +15036,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 938:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5725
+//line mysql_sql.y:5903
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14483,10 +15047,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 939:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5732
+//line mysql_sql.y:5910
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14494,10 +15058,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 940:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5739
+//line mysql_sql.y:5917
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14505,34 +15069,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 941:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5746
+//line mysql_sql.y:5924
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5750
+//line mysql_sql.y:5928
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 943:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5755
+//line mysql_sql.y:5933
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 944:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5759
+//line mysql_sql.y:5937
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -14542,18 +15106,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5769
+//line mysql_sql.y:5947
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5773
+//line mysql_sql.y:5951
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: []*tree.UnresolvedName{yyDollar[3].unresolvedNameUnion()},
@@ -14561,28 +15125,28 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 947:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5780
+//line mysql_sql.y:5958
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: yyDollar[4].unresolveNamesUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 948:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5787
+//line mysql_sql.y:5965
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 949:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5791
+//line mysql_sql.y:5969
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -14591,42 +15155,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 950:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5800
+//line mysql_sql.y:5978
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5804
+//line mysql_sql.y:5982
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5810
+//line mysql_sql.y:5988
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 953:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5814
+//line mysql_sql.y:5992
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 954:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5820
+//line mysql_sql.y:5998
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14636,10 +15200,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 955:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5829
+//line mysql_sql.y:6007
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14649,42 +15213,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 956:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5839
+//line mysql_sql.y:6017
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 957:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5843
+//line mysql_sql.y:6021
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5849
+//line mysql_sql.y:6027
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 959:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5853
+//line mysql_sql.y:6031
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 960:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5859
+//line mysql_sql.y:6037
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14692,10 +15256,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 961:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5866
+//line mysql_sql.y:6044
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14703,67 +15267,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 962:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5875
+//line mysql_sql.y:6053
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 963:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5879
+//line mysql_sql.y:6057
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 964:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5884
+//line mysql_sql.y:6062
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 965:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5888
+//line mysql_sql.y:6066
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 966:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5893
+//line mysql_sql.y:6071
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 967:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5897
+//line mysql_sql.y:6075
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 968:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5902
+//line mysql_sql.y:6080
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 969:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5906
+//line mysql_sql.y:6084
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14773,18 +15337,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 970:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5916
+//line mysql_sql.y:6094
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 971:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5920
+//line mysql_sql.y:6098
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14794,10 +15358,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 972:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5931
+//line mysql_sql.y:6109
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14806,10 +15370,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 973:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5939
+//line mysql_sql.y:6117
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14818,10 +15382,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 974:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5947
+//line mysql_sql.y:6125
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14830,10 +15394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 975:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5955
+//line mysql_sql.y:6133
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14842,10 +15406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 977:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5966
+//line mysql_sql.y:6144
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14855,10 +15419,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 978:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5975
+//line mysql_sql.y:6153
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14869,10 +15433,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 979:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5985
+//line mysql_sql.y:6163
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -14882,487 +15446,487 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 980:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5995
+//line mysql_sql.y:6173
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 981:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5999
+//line mysql_sql.y:6177
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 982:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6004
+//line mysql_sql.y:6182
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6008
+//line mysql_sql.y:6186
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 984:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6013
+//line mysql_sql.y:6191
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6017
+//line mysql_sql.y:6195
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 986:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6023
+//line mysql_sql.y:6201
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 987:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6027
+//line mysql_sql.y:6205
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 988:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6031
+//line mysql_sql.y:6209
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 989:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6037
+//line mysql_sql.y:6215
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 990:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6041
+//line mysql_sql.y:6219
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 991:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6045
+//line mysql_sql.y:6223
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 992:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6049
+//line mysql_sql.y:6227
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 993:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6053
+//line mysql_sql.y:6231
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 994:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6057
+//line mysql_sql.y:6235
 		{
 			str := util.DealCommentString(yyDollar[3].str)
 			yyLOCAL = tree.NewTableOptionComment(str)
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 995:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6062
+//line mysql_sql.y:6240
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 996:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6066
+//line mysql_sql.y:6244
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 997:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6070
+//line mysql_sql.y:6248
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 998:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6074
+//line mysql_sql.y:6252
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 999:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6078
+//line mysql_sql.y:6256
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1000:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6082
+//line mysql_sql.y:6260
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1001:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6086
+//line mysql_sql.y:6264
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1002:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6090
+//line mysql_sql.y:6268
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1003:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6094
+//line mysql_sql.y:6272
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1004:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6098
+//line mysql_sql.y:6276
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1005:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6102
+//line mysql_sql.y:6280
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1006:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6106
+//line mysql_sql.y:6284
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1007:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6110
+//line mysql_sql.y:6288
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1008:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6114
+//line mysql_sql.y:6292
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1009:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6118
+//line mysql_sql.y:6296
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1010:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6122
+//line mysql_sql.y:6300
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1011:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6126
+//line mysql_sql.y:6304
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1012:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6130
+//line mysql_sql.y:6308
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1013:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6134
+//line mysql_sql.y:6312
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1014:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6138
+//line mysql_sql.y:6316
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1015:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6142
+//line mysql_sql.y:6320
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].cstrUnion().Compare(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1016:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6146
+//line mysql_sql.y:6324
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1017:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6150
+//line mysql_sql.y:6328
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1018:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6157
+//line mysql_sql.y:6335
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1019:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6161
+//line mysql_sql.y:6339
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:6167
+//line mysql_sql.y:6345
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1021:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6172
+//line mysql_sql.y:6350
 		{
 			yyVAL.str = ""
 		}
-	case 994:
+	case 1022:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6176
+//line mysql_sql.y:6354
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 995:
+	case 1023:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6180
+//line mysql_sql.y:6358
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 996:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6186
+//line mysql_sql.y:6364
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6190
+//line mysql_sql.y:6368
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6194
+//line mysql_sql.y:6372
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1027:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6198
+//line mysql_sql.y:6376
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1028:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6202
+//line mysql_sql.y:6380
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1029:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6206
+//line mysql_sql.y:6384
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6220
+//line mysql_sql.y:6398
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1035:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6224
+//line mysql_sql.y:6402
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1036:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6233
+//line mysql_sql.y:6411
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6238
+//line mysql_sql.y:6416
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6244
+//line mysql_sql.y:6422
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6251
+//line mysql_sql.y:6429
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1041:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6255
+//line mysql_sql.y:6433
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1042:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6261
+//line mysql_sql.y:6439
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1043:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6265
+//line mysql_sql.y:6443
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1044:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6269
+//line mysql_sql.y:6447
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1045:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6275
+//line mysql_sql.y:6453
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15372,10 +15936,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1046:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6284
+//line mysql_sql.y:6462
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -15385,6 +15949,8 @@ yydefault:
 					keyTyp = tree.INDEX_TYPE_ZONEMAP
 				case "bsi":
 					keyTyp = tree.INDEX_TYPE_BSI
+				case "ivfflat":
+					keyTyp = tree.INDEX_TYPE_IVFFLAT
 				default:
 					yylex.Error("Invail the type of index")
 					return 1
@@ -15399,10 +15965,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1047:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6309
+//line mysql_sql.y:6489
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -15412,23 +15978,25 @@ yydefault:
 					v.ConstraintSymbol = yyDollar[1].str
 				case *tree.UniqueIndex:
 					v.ConstraintSymbol = yyDollar[1].str
+				case *tree.CheckIndex:
+					v.ConstraintSymbol = yyDollar[1].str
 				}
 			}
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1048:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6323
+//line mysql_sql.y:6505
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1049:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6329
+//line mysql_sql.y:6511
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15438,10 +16006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1050:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6338
+//line mysql_sql.y:6520
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15451,10 +16019,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1051:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6347
+//line mysql_sql.y:6529
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -15465,10 +16033,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1052:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6357
+//line mysql_sql.y:6539
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -15476,297 +16044,297 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1053:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6365
+//line mysql_sql.y:6547
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1055:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6371
+//line mysql_sql.y:6553
 		{
 			yyVAL.str = ""
 		}
-	case 1028:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6375
+//line mysql_sql.y:6557
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1031:
+	case 1059:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6385
+//line mysql_sql.y:6567
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 1032:
+	case 1060:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6391
+//line mysql_sql.y:6573
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1061:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6397
+//line mysql_sql.y:6579
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].cstrUnion().Compare()
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1068:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6411
+//line mysql_sql.y:6594
 		{
 			yyVAL.str = ""
 		}
-	case 1040:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6415
+//line mysql_sql.y:6598
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 1041:
+	case 1070:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:6421
+//line mysql_sql.y:6604
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1071:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6427
+//line mysql_sql.y:6610
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1072:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6431
+//line mysql_sql.y:6614
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1073:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6435
+//line mysql_sql.y:6618
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1074:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6441
+//line mysql_sql.y:6624
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1075:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6445
+//line mysql_sql.y:6628
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1076:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6449
+//line mysql_sql.y:6632
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1077:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6453
+//line mysql_sql.y:6636
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1078:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6459
+//line mysql_sql.y:6642
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1079:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6463
+//line mysql_sql.y:6646
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1080:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6467
+//line mysql_sql.y:6650
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1081:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6472
+//line mysql_sql.y:6655
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1082:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6476
+//line mysql_sql.y:6659
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1083:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6482
+//line mysql_sql.y:6665
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1084:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6486
+//line mysql_sql.y:6669
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1085:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6492
+//line mysql_sql.y:6675
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1086:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6496
+//line mysql_sql.y:6679
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1087:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6500
+//line mysql_sql.y:6683
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1088:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6504
+//line mysql_sql.y:6687
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1089:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6508
+//line mysql_sql.y:6691
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1090:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6512
+//line mysql_sql.y:6695
 		{
 			str := util.DealCommentString(yyDollar[2].str)
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(str), str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1091:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6517
+//line mysql_sql.y:6700
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1092:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6521
+//line mysql_sql.y:6704
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1093:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6525
+//line mysql_sql.y:6708
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1094:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6529
+//line mysql_sql.y:6712
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1095:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6533
+//line mysql_sql.y:6716
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1096:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6537
+//line mysql_sql.y:6720
 		{
-			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), true, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1097:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6541
+//line mysql_sql.y:6724
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1098:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6545
+//line mysql_sql.y:6728
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -15780,58 +16348,98 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1099:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6558
+//line mysql_sql.y:6741
 		{
 			yyLOCAL = tree.NewAttributeLowCardinality()
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1100:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:6745
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[5].exprUnion(), yyDollar[7].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1101:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line mysql_sql.y:6749
+		{
+			yyLOCAL = tree.NewAttributeGeneratedAlways(yyDollar[3].exprUnion(), yyDollar[5].boolValUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1102:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6754
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 1103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6758
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 1104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6564
+//line mysql_sql.y:6762
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6768
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 1106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6568
+//line mysql_sql.y:6772
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1107:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6573
+//line mysql_sql.y:6777
 		{
 			yyVAL.str = ""
 		}
-	case 1074:
+	case 1108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6577
+//line mysql_sql.y:6781
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1075:
+	case 1109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6583
+//line mysql_sql.y:6787
 		{
 			yyVAL.str = ""
 		}
-	case 1076:
+	case 1110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6587
+//line mysql_sql.y:6791
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 1077:
+	case 1111:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:6593
+//line mysql_sql.y:6797
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -15842,10 +16450,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1112:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6605
+//line mysql_sql.y:6809
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15853,10 +16461,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6612
+//line mysql_sql.y:6816
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15864,10 +16472,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6619
+//line mysql_sql.y:6823
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15875,10 +16483,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6626
+//line mysql_sql.y:6830
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15886,10 +16494,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6633
+//line mysql_sql.y:6837
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -15897,339 +16505,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1117:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6642
+//line mysql_sql.y:6846
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1118:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6648
+//line mysql_sql.y:6852
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6654
+//line mysql_sql.y:6858
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6658
+//line mysql_sql.y:6862
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6662
+//line mysql_sql.y:6866
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6666
+//line mysql_sql.y:6870
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6670
+//line mysql_sql.y:6874
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1124:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6675
+//line mysql_sql.y:6879
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6682
+//line mysql_sql.y:6886
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6686
+//line mysql_sql.y:6890
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1128:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6690
+//line mysql_sql.y:6894
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1129:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6695
+//line mysql_sql.y:6899
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1130:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6699
+//line mysql_sql.y:6903
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1131:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6704
+//line mysql_sql.y:6908
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1132:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6708
+//line mysql_sql.y:6912
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:6724
+//line mysql_sql.y:6928
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6730
+//line mysql_sql.y:6934
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6734
+//line mysql_sql.y:6938
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6738
+//line mysql_sql.y:6942
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1143:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6742
+//line mysql_sql.y:6946
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6746
+//line mysql_sql.y:6950
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1145:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6750
+//line mysql_sql.y:6954
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6754
+//line mysql_sql.y:6958
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6758
+//line mysql_sql.y:6962
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6762
+//line mysql_sql.y:6966
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6766
+//line mysql_sql.y:6970
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6770
+//line mysql_sql.y:6974
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6774
+//line mysql_sql.y:6978
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6778
+//line mysql_sql.y:6982
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6784
+//line mysql_sql.y:6988
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6788
+//line mysql_sql.y:6992
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6792
+//line mysql_sql.y:6996
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1156:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6796
+//line mysql_sql.y:7000
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1157:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6800
+//line mysql_sql.y:7004
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6804
+//line mysql_sql.y:7008
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6808
+//line mysql_sql.y:7012
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6812
+//line mysql_sql.y:7016
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1127:
+	case 1161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6816
+//line mysql_sql.y:7020
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1128:
+	case 1162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6820
+//line mysql_sql.y:7024
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1129:
+	case 1163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6824
+//line mysql_sql.y:7028
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6828
+//line mysql_sql.y:7032
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1165:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6833
+//line mysql_sql.y:7037
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -16238,26 +16846,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1132:
+	case 1166:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6841
+//line mysql_sql.y:7045
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1133:
+	case 1167:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6846
+//line mysql_sql.y:7050
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1134:
+	case 1168:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6850
+//line mysql_sql.y:7054
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -16267,50 +16875,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1135:
+	case 1169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6859
+//line mysql_sql.y:7063
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6863
+//line mysql_sql.y:7067
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1171:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6867
+//line mysql_sql.y:7071
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1138:
+	case 1172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6871
+//line mysql_sql.y:7075
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1139:
+	case 1173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6875
+//line mysql_sql.y:7079
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1140:
+	case 1174:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6881
+//line mysql_sql.y:7085
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16319,58 +16927,71 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1141:
+	case 1175:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:7093
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1176:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6890
+//line mysql_sql.y:7103
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1142:
+	case 1177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6894
+//line mysql_sql.y:7107
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1178:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6899
+//line mysql_sql.y:7112
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6903
+//line mysql_sql.y:7116
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6909
+//line mysql_sql.y:7122
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6913
+//line mysql_sql.y:7126
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1147:
+	case 1182:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:6919
+//line mysql_sql.y:7132
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -16378,19 +16999,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1148:
+	case 1183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6928
+//line mysql_sql.y:7141
 		{
 			t := yyVAL.columnTypeUnion()
 			if strings.ToLower(t.InternalType.FamilyString) == "binary" {
 				t.InternalType.Scale = -1
 			}
 		}
-	case 1149:
+	case 1184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6934
+//line mysql_sql.y:7147
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16408,10 +17029,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1150:
+	case 1185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6951
+//line mysql_sql.y:7164
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16426,10 +17047,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1152:
+	case 1187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6968
+//line mysql_sql.y:7181
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16443,10 +17064,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1153:
+	case 1188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6981
+//line mysql_sql.y:7194
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16460,10 +17081,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1154:
+	case 1189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6994
+//line mysql_sql.y:7207
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16476,10 +17097,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1155:
+	case 1190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7006
+//line mysql_sql.y:7219
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16494,10 +17115,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1156:
+	case 1191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7020
+//line mysql_sql.y:7233
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16513,10 +17134,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1157:
+	case 1192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7035
+//line mysql_sql.y:7248
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16532,10 +17153,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1158:
+	case 1193:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7050
+//line mysql_sql.y:7263
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16553,10 +17174,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1159:
+	case 1194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7067
+//line mysql_sql.y:7280
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16571,83 +17192,83 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1160:
+	case 1195:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7082
+//line mysql_sql.y:7295
 		{
 		}
-	case 1163:
+	case 1198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7088
+//line mysql_sql.y:7301
 		{
 			yyLOCAL = &tree.WindowFrameBoundCurrentRow{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1164:
+	case 1199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7092
+//line mysql_sql.y:7305
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1165:
+	case 1200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7096
+//line mysql_sql.y:7309
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1166:
+	case 1201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7102
+//line mysql_sql.y:7315
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1167:
+	case 1202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7106
+//line mysql_sql.y:7319
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1168:
+	case 1203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7114
+//line mysql_sql.y:7327
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_ROWS
 		}
 		yyVAL.union = yyLOCAL
-	case 1169:
+	case 1204:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7118
+//line mysql_sql.y:7331
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_RANGE
 		}
 		yyVAL.union = yyLOCAL
-	case 1170:
+	case 1205:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7122
+//line mysql_sql.y:7335
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_GROUPS
 		}
 		yyVAL.union = yyLOCAL
-	case 1171:
+	case 1206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7128
+//line mysql_sql.y:7341
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16655,10 +17276,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1172:
+	case 1207:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7135
+//line mysql_sql.y:7348
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16667,70 +17288,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1173:
+	case 1208:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7144
+//line mysql_sql.y:7357
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1174:
+	case 1209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7148
+//line mysql_sql.y:7361
 		{
 			yyLOCAL = yyDollar[1].windowFrameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1175:
+	case 1210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7155
+//line mysql_sql.y:7368
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1176:
+	case 1211:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7160
+//line mysql_sql.y:7373
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1177:
+	case 1212:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7164
+//line mysql_sql.y:7377
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1178:
+	case 1213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7169
+//line mysql_sql.y:7382
 		{
 			yyVAL.str = ","
 		}
-	case 1179:
+	case 1214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7173
+//line mysql_sql.y:7386
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1180:
+	case 1215:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7178
+//line mysql_sql.y:7391
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1182:
+	case 1217:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7185
+//line mysql_sql.y:7398
 		{
 			yyLOCAL = &tree.WindowSpec{
 				PartitionBy: yyDollar[3].exprsUnion(),
@@ -16739,10 +17360,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1183:
+	case 1218:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7195
+//line mysql_sql.y:7408
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16754,10 +17375,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1184:
+	case 1219:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7206
+//line mysql_sql.y:7419
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16768,10 +17389,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1185:
+	case 1220:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7216
+//line mysql_sql.y:7429
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16781,10 +17402,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1186:
+	case 1221:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7225
+//line mysql_sql.y:7438
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16794,10 +17415,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1187:
+	case 1222:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7234
+//line mysql_sql.y:7447
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16808,10 +17429,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1188:
+	case 1223:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7244
+//line mysql_sql.y:7457
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16822,10 +17443,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1189:
+	case 1224:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7254
+//line mysql_sql.y:7467
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16836,10 +17457,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1190:
+	case 1225:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7264
+//line mysql_sql.y:7477
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16850,10 +17471,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1191:
+	case 1226:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7274
+//line mysql_sql.y:7487
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -16864,10 +17485,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1192:
+	case 1227:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7284
+//line mysql_sql.y:7497
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16878,10 +17499,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1193:
+	case 1228:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7294
+//line mysql_sql.y:7507
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16892,10 +17513,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1194:
+	case 1229:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7304
+//line mysql_sql.y:7517
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16906,10 +17527,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1195:
+	case 1230:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7314
+//line mysql_sql.y:7527
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16920,10 +17541,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1196:
+	case 1231:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7324
+//line mysql_sql.y:7537
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16934,10 +17555,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1197:
+	case 1232:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7334
+//line mysql_sql.y:7547
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16948,10 +17569,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1198:
+	case 1233:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7344
+//line mysql_sql.y:7557
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16962,10 +17583,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1199:
+	case 1234:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7354
+//line mysql_sql.y:7567
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16976,10 +17597,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1203:
+	case 1238:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7371
+//line mysql_sql.y:7584
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16988,10 +17609,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1204:
+	case 1239:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7379
+//line mysql_sql.y:7592
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17000,10 +17621,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1205:
+	case 1240:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7387
+//line mysql_sql.y:7600
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17012,10 +17633,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1206:
+	case 1241:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7395
+//line mysql_sql.y:7608
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17024,10 +17645,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1207:
+	case 1242:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7403
+//line mysql_sql.y:7616
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -17038,10 +17659,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1208:
+	case 1243:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7413
+//line mysql_sql.y:7626
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17050,10 +17671,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1209:
+	case 1244:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7421
+//line mysql_sql.y:7634
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17063,10 +17684,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1210:
+	case 1245:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7430
+//line mysql_sql.y:7643
 		{
 			name := tree.SetUnresolvedName("nextval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17075,10 +17696,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1211:
+	case 1246:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7438
+//line mysql_sql.y:7651
 		{
 			name := tree.SetUnresolvedName("setval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17087,10 +17708,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1212:
+	case 1247:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7446
+//line mysql_sql.y:7659
 		{
 			name := tree.SetUnresolvedName("currval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17099,10 +17720,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1213:
+	case 1248:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7454
+//line mysql_sql.y:7667
 		{
 			name := tree.SetUnresolvedName("lastval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17111,10 +17732,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1214:
+	case 1249:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7462
+//line mysql_sql.y:7675
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)
@@ -17126,10 +17747,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1215:
+	case 1250:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7473
+//line mysql_sql.y:7686
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
@@ -17140,10 +17761,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1216:
+	case 1251:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7483
+//line mysql_sql.y:7696
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64)
@@ -17156,10 +17777,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1217:
+	case 1252:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7495
+//line mysql_sql.y:7708
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64)
@@ -17171,10 +17792,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1218:
+	case 1253:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7506
+//line mysql_sql.y:7719
 		{
 			column := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
@@ -17184,16 +17805,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1225:
+	case 1260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7528
+//line mysql_sql.y:7741
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1254:
+	case 1289:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7564
+//line mysql_sql.y:7777
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17206,10 +17827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1255:
+	case 1290:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7576
+//line mysql_sql.y:7789
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17222,10 +17843,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1256:
+	case 1291:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7588
+//line mysql_sql.y:7801
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -17236,10 +17857,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1257:
+	case 1292:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7599
+//line mysql_sql.y:7812
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17248,10 +17869,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1258:
+	case 1293:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7607
+//line mysql_sql.y:7820
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17259,10 +17880,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1259:
+	case 1294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7614
+//line mysql_sql.y:7827
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17270,10 +17891,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1260:
+	case 1295:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7621
+//line mysql_sql.y:7834
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17286,10 +17907,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1261:
+	case 1296:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7633
+//line mysql_sql.y:7846
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -17298,10 +17919,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1262:
+	case 1297:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7641
+//line mysql_sql.y:7854
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17312,10 +17933,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1263:
+	case 1298:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7651
+//line mysql_sql.y:7864
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17326,10 +17947,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1264:
+	case 1299:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7661
+//line mysql_sql.y:7874
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -17338,10 +17959,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1265:
+	case 1300:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7669
+//line mysql_sql.y:7882
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -17353,10 +17974,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1266:
+	case 1301:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7680
+//line mysql_sql.y:7893
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -17366,10 +17987,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1267:
+	case 1302:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7689
+//line mysql_sql.y:7902
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -17379,10 +18000,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1268:
+	case 1303:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7698
+//line mysql_sql.y:7911
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -17391,10 +18012,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1269:
+	case 1304:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7706
+//line mysql_sql.y:7919
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -17405,10 +18026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1270:
+	case 1305:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7716
+//line mysql_sql.y:7929
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -17417,10 +18038,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1271:
+	case 1306:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7724
+//line mysql_sql.y:7937
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -17430,34 +18051,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1272:
+	case 1307:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7734
+//line mysql_sql.y:7947
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1273:
+	case 1308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7738
+//line mysql_sql.y:7951
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1274:
+	case 1309:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7744
+//line mysql_sql.y:7957
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1275:
+	case 1310:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7748
+//line mysql_sql.y:7961
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -17468,20 +18089,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1282:
+	case 1317:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7767
+//line mysql_sql.y:7980
 		{
 		}
-	case 1283:
+	case 1318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7769
+//line mysql_sql.y:7982
 		{
 		}
-	case 1317:
+	case 1352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7810
+//line mysql_sql.y:8023
 		{
 			name := tree.SetUnresolvedName("interval")
 			str := strings.ToLower(yyDollar[3].str)
@@ -17492,90 +18113,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1318:
+	case 1353:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7821
+//line mysql_sql.y:8034
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1319:
+	case 1354:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7825
+//line mysql_sql.y:8038
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1320:
+	case 1355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7829
+//line mysql_sql.y:8042
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1321:
+	case 1356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:7835
+//line mysql_sql.y:8048
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1322:
+	case 1357:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7840
+//line mysql_sql.y:8053
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1323:
+	case 1358:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7844
+//line mysql_sql.y:8057
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1324:
+	case 1359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7850
+//line mysql_sql.y:8063
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1325:
+	case 1360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7854
+//line mysql_sql.y:8067
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1326:
+	case 1361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7861
+//line mysql_sql.y:8074
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1327:
+	case 1362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7865
+//line mysql_sql.y:8078
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1328:
+	case 1363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7869
+//line mysql_sql.y:8082
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -17584,355 +18205,355 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1329:
+	case 1364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7877
+//line mysql_sql.y:8090
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1330:
+	case 1365:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7881
+//line mysql_sql.y:8094
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1331:
+	case 1366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7885
+//line mysql_sql.y:8098
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1332:
+	case 1367:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7889
+//line mysql_sql.y:8102
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1333:
+	case 1368:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7895
+//line mysql_sql.y:8108
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1334:
+	case 1369:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7899
+//line mysql_sql.y:8112
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1335:
+	case 1370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7903
+//line mysql_sql.y:8116
 		{
 			yyLOCAL = tree.NewIsUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1336:
+	case 1371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7907
+//line mysql_sql.y:8120
 		{
 			yyLOCAL = tree.NewIsNotUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1337:
+	case 1372:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7911
+//line mysql_sql.y:8124
 		{
 			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1338:
+	case 1373:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7915
+//line mysql_sql.y:8128
 		{
 			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1339:
+	case 1374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7919
+//line mysql_sql.y:8132
 		{
 			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1340:
+	case 1375:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7923
+//line mysql_sql.y:8136
 		{
 			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1341:
+	case 1376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7927
+//line mysql_sql.y:8140
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1342:
+	case 1377:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7931
+//line mysql_sql.y:8144
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1344:
+	case 1379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7939
+//line mysql_sql.y:8152
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1345:
+	case 1380:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7943
+//line mysql_sql.y:8156
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1346:
+	case 1381:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7947
+//line mysql_sql.y:8160
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1347:
+	case 1382:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7951
+//line mysql_sql.y:8164
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1348:
+	case 1383:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7955
+//line mysql_sql.y:8168
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1349:
+	case 1384:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7959
+//line mysql_sql.y:8172
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1350:
+	case 1385:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7963
+//line mysql_sql.y:8176
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1351:
+	case 1386:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7967
+//line mysql_sql.y:8180
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1352:
+	case 1387:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7971
+//line mysql_sql.y:8184
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1353:
+	case 1388:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7975
+//line mysql_sql.y:8188
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1355:
+	case 1390:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7981
+//line mysql_sql.y:8194
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1356:
+	case 1391:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7985
+//line mysql_sql.y:8198
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1357:
+	case 1392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7991
+//line mysql_sql.y:8204
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1358:
+	case 1393:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7995
+//line mysql_sql.y:8208
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1359:
+	case 1394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8002
+//line mysql_sql.y:8215
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1360:
+	case 1395:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8006
+//line mysql_sql.y:8219
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1361:
+	case 1396:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8010
+//line mysql_sql.y:8223
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1362:
+	case 1397:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8016
+//line mysql_sql.y:8229
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1363:
+	case 1398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8020
+//line mysql_sql.y:8233
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1364:
+	case 1399:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8024
+//line mysql_sql.y:8237
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1365:
+	case 1400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8028
+//line mysql_sql.y:8241
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1366:
+	case 1401:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8032
+//line mysql_sql.y:8245
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1367:
+	case 1402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8036
+//line mysql_sql.y:8249
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1368:
+	case 1403:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8040
+//line mysql_sql.y:8253
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1369:
+	case 1404:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8046
+//line mysql_sql.y:8259
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1370:
+	case 1405:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8050
+//line mysql_sql.y:8263
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1371:
+	case 1406:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8054
+//line mysql_sql.y:8267
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1372:
+	case 1407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8058
+//line mysql_sql.y:8271
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1373:
+	case 1408:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8064
+//line mysql_sql.y:8277
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1374:
+	case 1409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8068
+//line mysql_sql.y:8281
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -17946,59 +18567,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1375:
+	case 1410:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8081
+//line mysql_sql.y:8294
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1376:
+	case 1411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8086
+//line mysql_sql.y:8299
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1377:
+	case 1412:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8090
+//line mysql_sql.y:8303
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1378:
+	case 1413:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8094
+//line mysql_sql.y:8307
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1379:
+	case 1414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8098
+//line mysql_sql.y:8311
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 1380:
+	case 1415:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8102
+//line mysql_sql.y:8315
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1381:
+	case 1416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8106
+//line mysql_sql.y:8319
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -18013,53 +18634,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1382:
+	case 1417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8120
+//line mysql_sql.y:8333
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1383:
+	case 1418:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8124
+//line mysql_sql.y:8337
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_ScoreBinary)
 		}
 		yyVAL.union = yyLOCAL
-	case 1384:
+	case 1419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8131
+//line mysql_sql.y:8344
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1388:
+	case 1423:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8142
+//line mysql_sql.y:8355
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1389:
+	case 1424:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8147
+//line mysql_sql.y:8360
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1390:
+	case 1425:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8153
+//line mysql_sql.y:8366
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18072,10 +18693,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1391:
+	case 1426:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8165
+//line mysql_sql.y:8378
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18088,10 +18709,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1392:
+	case 1427:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8177
+//line mysql_sql.y:8390
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18104,10 +18725,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1393:
+	case 1428:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8189
+//line mysql_sql.y:8402
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18121,10 +18742,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1394:
+	case 1429:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8202
+//line mysql_sql.y:8415
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18138,10 +18759,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1395:
+	case 1430:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8215
+//line mysql_sql.y:8428
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18155,10 +18776,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1396:
+	case 1431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8228
+//line mysql_sql.y:8441
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18172,10 +18793,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1397:
+	case 1432:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8241
+//line mysql_sql.y:8454
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18189,10 +18810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1398:
+	case 1433:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8254
+//line mysql_sql.y:8467
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18206,10 +18827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1399:
+	case 1434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8267
+//line mysql_sql.y:8480
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18223,10 +18844,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1400:
+	case 1435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8280
+//line mysql_sql.y:8493
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18240,10 +18861,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1401:
+	case 1436:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8293
+//line mysql_sql.y:8506
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18257,10 +18878,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1402:
+	case 1437:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8306
+//line mysql_sql.y:8519
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18274,10 +18895,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1403:
+	case 1438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8319
+//line mysql_sql.y:8532
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18291,10 +18912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1404:
+	case 1439:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8334
+//line mysql_sql.y:8547
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18322,10 +18943,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1405:
+	case 1440:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8361
+//line mysql_sql.y:8574
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18367,10 +18988,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1406:
+	case 1441:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8403
+//line mysql_sql.y:8616
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -18407,10 +19028,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1407:
+	case 1442:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8451
+//line mysql_sql.y:8664
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18426,10 +19047,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1408:
+	case 1443:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8468
+//line mysql_sql.y:8681
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18442,10 +19063,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1409:
+	case 1444:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8480
+//line mysql_sql.y:8693
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18466,10 +19087,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1410:
+	case 1445:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8500
+//line mysql_sql.y:8713
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18490,10 +19111,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1411:
+	case 1446:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8520
+//line mysql_sql.y:8733
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18514,10 +19135,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1412:
+	case 1447:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8540
+//line mysql_sql.y:8753
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18532,10 +19153,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1413:
+	case 1448:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8556
+//line mysql_sql.y:8769
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18549,10 +19170,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1414:
+	case 1449:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8569
+//line mysql_sql.y:8782
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18566,10 +19187,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1415:
+	case 1450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8582
+//line mysql_sql.y:8795
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18583,10 +19204,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1416:
+	case 1451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8595
+//line mysql_sql.y:8808
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18600,10 +19221,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1417:
+	case 1452:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8608
+//line mysql_sql.y:8821
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18616,10 +19237,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1418:
+	case 1453:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8620
+//line mysql_sql.y:8833
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18632,10 +19253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1419:
+	case 1454:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8632
+//line mysql_sql.y:8845
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18648,10 +19269,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1420:
+	case 1455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8644
+//line mysql_sql.y:8857
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18664,10 +19285,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1421:
+	case 1456:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8656
+//line mysql_sql.y:8869
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18680,10 +19301,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1422:
+	case 1457:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8668
+//line mysql_sql.y:8881
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18696,10 +19317,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1423:
+	case 1458:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8680
+//line mysql_sql.y:8893
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18712,10 +19333,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1424:
+	case 1459:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8692
+//line mysql_sql.y:8905
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18728,10 +19349,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1425:
+	case 1460:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8704
+//line mysql_sql.y:8917
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18744,10 +19365,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1426:
+	case 1461:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8716
+//line mysql_sql.y:8929
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18761,10 +19382,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1427:
+	case 1462:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8729
+//line mysql_sql.y:8942
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18778,10 +19399,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1428:
+	case 1463:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8742
+//line mysql_sql.y:8955
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18795,20 +19416,54 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1429:
+	case 1464:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:8968
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.VectorFamily,
+					FamilyString: yyDollar[1].str,
+					DisplayWith:  int32(yyDollar[3].item.(int64)),
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_FLOAT),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1465:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:8981
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.VectorFamily,
+					FamilyString: yyDollar[1].str,
+					DisplayWith:  int32(yyDollar[3].item.(int64)),
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_DOUBLE),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8757
+//line mysql_sql.y:8996
 		{
 			yyLOCAL = &tree.Do{
 				Exprs: yyDollar[2].exprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1430:
+	case 1467:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8765
+//line mysql_sql.y:9004
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18817,10 +19472,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1431:
+	case 1468:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8774
+//line mysql_sql.y:9013
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18829,10 +19484,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1432:
+	case 1469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8784
+//line mysql_sql.y:9023
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18845,75 +19500,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1433:
+	case 1470:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8807
+//line mysql_sql.y:9046
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1434:
+	case 1471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8812
+//line mysql_sql.y:9051
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1435:
+	case 1472:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8818
+//line mysql_sql.y:9057
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1437:
+	case 1474:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8825
+//line mysql_sql.y:9064
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1438:
+	case 1475:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8829
+//line mysql_sql.y:9068
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1439:
+	case 1476:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8834
+//line mysql_sql.y:9073
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1440:
+	case 1477:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8838
+//line mysql_sql.y:9077
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1441:
+	case 1478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8844
+//line mysql_sql.y:9083
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1442:
+	case 1479:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8850
+//line mysql_sql.y:9089
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -18921,10 +19576,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1443:
+	case 1480:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8857
+//line mysql_sql.y:9096
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18932,10 +19587,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1444:
+	case 1481:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8864
+//line mysql_sql.y:9103
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18943,10 +19598,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1445:
+	case 1482:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8873
+//line mysql_sql.y:9112
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 38, // this is the default precision for decimal
@@ -18954,10 +19609,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1446:
+	case 1483:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8880
+//line mysql_sql.y:9119
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18965,10 +19620,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1447:
+	case 1484:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8887
+//line mysql_sql.y:9126
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18976,52 +19631,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1448:
+	case 1485:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8896
+//line mysql_sql.y:9135
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1449:
+	case 1486:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8900
+//line mysql_sql.y:9139
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1450:
+	case 1487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8904
+//line mysql_sql.y:9143
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1451:
+	case 1488:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8910
+//line mysql_sql.y:9149
 		{
 		}
-	case 1452:
+	case 1489:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8912
+//line mysql_sql.y:9151
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1456:
+	case 1493:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8922
+//line mysql_sql.y:9161
 		{
 			yyVAL.str = ""
 		}
-	case 1457:
+	case 1494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:8926
+//line mysql_sql.y:9165
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
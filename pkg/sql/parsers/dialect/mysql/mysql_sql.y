@@ -194,6 +194,9 @@ import (
     accountIdentified tree.AccountIdentified
     accountStatus tree.AccountStatus
     accountComment tree.AccountComment
+    accountQuota tree.AccountQuota
+    accountQuotaOption tree.AccountQuotaOption
+    accountQuotaOptions []tree.AccountQuotaOption
     accountCommentOrAttribute tree.AccountCommentOrAttribute
     userIdentified *tree.AccountIdentified
     accountRole *tree.Role
@@ -230,7 +233,7 @@ import (
 %token LEX_ERROR
 %nonassoc EMPTY
 %left <str> UNION EXCEPT INTERSECT MINUS
-%token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR CONNECT MANAGE GRANTS OWNERSHIP REFERENCE
+%token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET OF FOR CONNECT MANAGE GRANTS OWNERSHIP REFERENCE BACKUP_ADMIN
 %nonassoc LOWER_THAN_SET
 %nonassoc <str> SET
 %token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST
@@ -282,7 +285,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8 S3OPTION
 
@@ -296,9 +299,11 @@ import (
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
+%token <str> POLICY POLICIES
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM
+%token <str> GENERATED ALWAYS STORED VIRTUAL
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
 %token <str> RANGE LIST ALGORITHM LINEAR PARTITIONS SUBPARTITION SUBPARTITIONS CLUSTER
 %token <str> TYPE ANY SOME EXTERNAL LOCALFILE URL
@@ -315,7 +320,8 @@ import (
 %token <str> PROPERTIES
 
 // Index
-%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI
+%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT LISTS
+%token <str> QUOTA
 %token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN
 
 // Alter
@@ -419,15 +425,15 @@ import (
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt truncate_table_stmt
 %type <statement> delete_without_using_stmt delete_with_using_stmt
 %type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_prepare_stmt drop_view_stmt drop_function_stmt drop_procedure_stmt drop_sequence_stmt
-%type <statement> drop_account_stmt drop_role_stmt drop_user_stmt
-%type <statement> create_account_stmt create_user_stmt create_role_stmt
+%type <statement> drop_account_stmt drop_role_stmt drop_user_stmt drop_policy_stmt
+%type <statement> create_account_stmt create_user_stmt create_role_stmt create_policy_stmt
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt create_function_stmt create_extension_stmt create_procedure_stmt create_sequence_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt show_target_filter_stmt show_table_status_stmt show_grants_stmt show_collation_stmt show_accounts_stmt show_roles_stmt
 %type <statement> show_tables_stmt show_sequences_stmt show_process_stmt show_errors_stmt show_warnings_stmt show_target
 %type <statement> show_function_status_stmt show_node_list_stmt show_locks_stmt
 %type <statement> show_table_num_stmt show_column_num_stmt show_table_values_stmt show_table_size_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> show_servers_stmt
+%type <statement> show_servers_stmt show_policies_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
@@ -444,7 +450,7 @@ import (
 %type <statement> call_stmt
 %type <statement> mo_dump_stmt
 %type <statement> load_extension_stmt
-%type <statement> kill_stmt
+%type <statement> kill_stmt backup_stmt
 %type <rowsExprs> row_constructor_list
 %type <exprs>  row_constructor
 %type <exportParm> export_data_param_opt
@@ -610,7 +616,7 @@ import (
 %type <windowFrameUnit> window_frame_unit
 %type <str> fields_or_columns
 %type <int64Val> algorithm_opt partition_num_opt sub_partition_num_opt
-%type <boolVal> linear_opt
+%type <boolVal> linear_opt stored_opt
 %type <partition> partition
 %type <partitions> partition_list_opt partition_list
 %type <values> values_opt
@@ -680,6 +686,9 @@ import (
 %type <accountIdentified> account_identified
 %type <accountStatus> account_status_option
 %type <accountComment> account_comment_opt
+%type <accountQuota> account_quota_opt
+%type <accountQuotaOption> account_quota_option
+%type <accountQuotaOptions> account_quota_option_list
 %type <accountCommentOrAttribute> user_comment_or_attribute_opt
 %type <userIdentified> user_identified user_identified_opt
 %type <accountRole> default_role_opt
@@ -690,6 +699,7 @@ import (
 %type <indexHintList> index_hint_list index_hint_list_opt
 
 %token <str> KILL
+%token <str> BACKUP
 %type <killOption> kill_opt
 %type <statementOption> statement_id_opt
 %token <str> QUERY_RESULT
@@ -808,6 +818,32 @@ normal_stmt:
         $$ = $1
     }
 |   kill_stmt
+|   backup_stmt
+
+backup_stmt:
+    BACKUP CLUSTER TO STRING
+    {
+        $$ = &tree.Backup{
+            Scope: tree.BackupScopeCluster,
+            Path: $4,
+        }
+    }
+|   BACKUP ACCOUNT ident TO STRING
+    {
+        $$ = &tree.Backup{
+            Scope: tree.BackupScopeAccount,
+            Name: tree.Identifier($3.Compare()),
+            Path: $5,
+        }
+    }
+|   BACKUP DATABASE ident TO STRING
+    {
+        $$ = &tree.Backup{
+            Scope: tree.BackupScopeDatabase,
+            Name: tree.Identifier($3.Compare()),
+            Path: $5,
+        }
+    }
 
 kill_stmt:
     KILL kill_opt INTEGRAL statement_id_opt
@@ -1701,6 +1737,10 @@ priv_type:
     {
         $$ = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
     }
+|    BACKUP_ADMIN
+    {
+        $$ = tree.PRIVILEGE_TYPE_DYNAMIC_BACKUP_ADMIN
+    }
 |    SHOW TABLES
     {
         $$ = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
@@ -1980,6 +2020,14 @@ var_assignment:
             Value: $3,
         }
     }
+|   TRANSACTION SNAPSHOT set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            System: true,
+            Name: "transaction_snapshot",
+            Value: $3,
+        }
+    }
 |   NAMES charset_name
     {
         $$ = &tree.VarAssignmentExpr{
@@ -2357,6 +2405,24 @@ explain_stmt:
     {
         $$ = tree.NewExplainStmt($2, "text")
     }
+|   explain_sym FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, "text")
+        optionElem := tree.MakeOptionElem("format", $4)
+        options := tree.MakeOptions(optionElem)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
+|   explain_sym ANALYZE FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($6, "text")
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("format", $5)
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym VERBOSE explainable_stmt
     {
         explainStmt := tree.NewExplainStmt($3, "text")
@@ -2404,6 +2470,7 @@ explain_option_key:
 explain_foramt_value:
     JSON
 |   TEXT
+|   ID
 
 
 prepare_sym:
@@ -2452,10 +2519,18 @@ utility_option_arg:
 
 
 analyze_stmt:
-    ANALYZE TABLE table_name '(' column_list ')'
+    ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
+|   ANALYZE TABLE table_name '(' column_list ')'
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
+|   ANALYZE TABLE table_name COLUMNS '(' column_list ')'
+    {
+        $$ = tree.NewAnalyzeStmt($3, $6)
+    }
 
 alter_stmt:
     alter_user_stmt
@@ -2574,7 +2649,7 @@ visibility:
 
 
 alter_account_stmt:
-    ALTER ACCOUNT exists_opt account_name alter_account_auth_option account_status_option account_comment_opt
+    ALTER ACCOUNT exists_opt account_name alter_account_auth_option account_status_option account_comment_opt account_quota_opt
     {
         $$ = &tree.AlterAccount{
             IfExists:$3,
@@ -2582,6 +2657,40 @@ alter_account_stmt:
             AuthOption:$5,
             StatusOption:$6,
             Comment:$7,
+            Quota:$8,
+        }
+    }
+
+account_quota_opt:
+    {
+        $$ = tree.AccountQuota{
+            Exist: false,
+        }
+    }
+|   WITH QUOTA account_quota_option_list
+    {
+        $$ = tree.AccountQuota{
+            Exist: true,
+            Options: $3,
+        }
+    }
+
+account_quota_option_list:
+    account_quota_option
+    {
+        $$ = []tree.AccountQuotaOption{$1}
+    }
+|   account_quota_option_list ',' account_quota_option
+    {
+        $$ = append($1, $3)
+    }
+
+account_quota_option:
+    ident equal_opt INTEGRAL
+    {
+        $$ = tree.AccountQuotaOption{
+            Name: $1.Compare(),
+            Value: $3.(int64),
         }
     }
 
@@ -2764,6 +2873,7 @@ show_stmt:
 |   show_table_status_stmt
 |   show_grants_stmt
 |   show_roles_stmt
+|   show_policies_stmt
 |   show_collation_stmt
 |   show_function_status_stmt
 |   show_node_list_stmt
@@ -2840,6 +2950,20 @@ show_roles_stmt:
         }
     }
 
+show_policies_stmt:
+    SHOW POLICIES
+    {
+        $$ = &tree.ShowPolicies{}
+    }
+|   SHOW POLICIES from_or_in table_name
+    {
+        $$ = &tree.ShowPolicies{Table: $4}
+    }
+|   SHOW POLICIES ON table_name
+    {
+        $$ = &tree.ShowPolicies{Table: $4}
+    }
+
 show_node_list_stmt:
     SHOW NODE LIST
     {
@@ -3208,6 +3332,7 @@ drop_ddl_stmt:
 |   drop_view_stmt
 |   drop_index_stmt
 |   drop_role_stmt
+|   drop_policy_stmt
 |   drop_user_stmt
 |   drop_account_stmt
 |   drop_function_stmt
@@ -3270,6 +3395,16 @@ drop_role_stmt:
         }
     }
 
+drop_policy_stmt:
+    DROP POLICY exists_opt ident ON table_name
+    {
+        $$ = &tree.DropPolicy{
+            IfExists: $3,
+            Name: tree.Identifier($4.Compare()),
+            Table: $6,
+        }
+    }
+
 drop_index_stmt:
     DROP INDEX exists_opt ident ON table_name
     {
@@ -4526,6 +4661,17 @@ aliased_table_name:
             IndexHints: $3,
         }
     }
+|   table_name AS OF TIMESTAMP expression as_opt_id index_hint_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($6),
+            },
+            IndexHints: $7,
+            AsOfTimestamp: $5,
+        }
+    }
 
 index_hint_list_opt:
 	{
@@ -4665,6 +4811,7 @@ stmt_name:
 create_stmt:
     create_ddl_stmt
 |   create_role_stmt
+|   create_policy_stmt
 |   create_user_stmt
 |   create_account_stmt
 |   create_publication_stmt
@@ -5256,6 +5403,27 @@ create_role_stmt:
         }
     }
 
+create_policy_stmt:
+    CREATE POLICY not_exists_opt ident ON table_name USING '(' expression ')'
+    {
+        $$ = &tree.CreatePolicy{
+            IfNotExists: $3,
+            Name: tree.Identifier($4.Compare()),
+            Table: $6,
+            Expr: $9,
+        }
+    }
+|   CREATE POLICY not_exists_opt ident ON table_name TO role_spec_list USING '(' expression ')'
+    {
+        $$ = &tree.CreatePolicy{
+            IfNotExists: $3,
+            Name: tree.Identifier($4.Compare()),
+            Table: $6,
+            Roles: $8,
+            Expr: $11,
+        }
+    }
+
 role_spec_list:
     role_spec
     {
@@ -5351,6 +5519,8 @@ index_option_list:
                 opt1.ParserName = opt2.ParserName
             } else if opt2.Visible != tree.VISIBLE_TYPE_INVALID {
                 opt1.Visible = opt2.Visible
+            } else if opt2.Lists > 0 {
+                opt1.Lists = opt2.Lists
             }
             $$ = opt1
         }
@@ -5377,6 +5547,10 @@ index_option:
     {
         $$ = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
     }
+|   LISTS equal_opt INTEGRAL
+    {
+        $$ = &tree.IndexOption{Lists: uint64($3.(int64))}
+    }
 
 index_column_list:
     index_column
@@ -5419,6 +5593,10 @@ using_opt:
     {
         $$ = tree.INDEX_TYPE_BSI
     }
+|    USING IVFFLAT
+    {
+        $$ = tree.INDEX_TYPE_IVFFLAT
+    }
 
 create_database_stmt:
     CREATE database_or_schema not_exists_opt ident subcription_opt create_option_list_opt
@@ -6290,6 +6468,8 @@ index_def:
                 keyTyp = tree.INDEX_TYPE_ZONEMAP
             case "bsi":
                 keyTyp = tree.INDEX_TYPE_BSI
+            case "ivfflat":
+                keyTyp = tree.INDEX_TYPE_IVFFLAT
             default:
                 yylex.Error("Invail the type of index")
                 return 1
@@ -6315,6 +6495,8 @@ constaint_def:
                 v.ConstraintSymbol = $1
             case *tree.UniqueIndex:
                 v.ConstraintSymbol = $1
+            case *tree.CheckIndex:
+                v.ConstraintSymbol = $1
             }
         }
         $$ = $2
@@ -6363,7 +6545,7 @@ constraint_elem:
 
 enforce_opt:
     {
-        $$ = false
+        $$ = true
     }
 |    enforce
 
@@ -6406,6 +6588,7 @@ index_type:
 |    RTREE
 |    ZONEMAP
 |    BSI
+|    IVFFLAT
 
 index_name:
     {
@@ -6535,7 +6718,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheck($4, false, $1)
+        $$ = tree.NewAttributeCheck($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -6558,6 +6741,27 @@ column_attribute_elem:
     {
 	$$ = tree.NewAttributeLowCardinality()
     }
+|   GENERATED ALWAYS AS '(' expression ')' stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($5, $7)
+    }
+|   AS '(' expression ')' stored_opt
+    {
+        $$ = tree.NewAttributeGeneratedAlways($3, $5)
+    }
+
+stored_opt:
+    {
+        $$ = false
+    }
+|   VIRTUAL
+    {
+        $$ = false
+    }
+|   STORED
+    {
+        $$ = true
+    }
 
 enforce:
     ENFORCED
@@ -6885,6 +7089,15 @@ function_call_window:
             WindowSpec: $4,
         }
     }
+|   ID '(' expression_list_opt ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
 
 else_opt:
     {
@@ -8752,6 +8965,32 @@ char_type:
     },
     }
 }
+|   VECF32 '(' INTEGRAL ')'
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.VectorFamily,
+                FamilyString: $1,
+                DisplayWith: int32($3.(int64)),
+                Locale: &locale,
+                Oid:    uint32(defines.MYSQL_TYPE_FLOAT),
+            },
+        }
+    }
+|   VECF64 '(' INTEGRAL ')'
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.VectorFamily,
+                FamilyString: $1,
+                DisplayWith: int32($3.(int64)),
+                Locale: &locale,
+                Oid:    uint32(defines.MYSQL_TYPE_DOUBLE),
+            },
+        }
+    }
 
 do_stmt:
     DO expression_list
@@ -9025,6 +9264,7 @@ equal_opt:
 //|   NONE
 //|   NULL
 //|   NULLS
+//|   OF
 //|   ON
 //|   OR
 //|   ORDER
@@ -9121,12 +9361,14 @@ equal_opt:
 non_reserved_keyword:
     ACCOUNT
 |   ACCOUNTS
+|   BACKUP
 |   AGAINST
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ATTRIBUTE
 |   ACTION
 |   ALGORITHM
+|   ALWAYS
 |   BEGIN
 |   BIGINT
 |   BIT
@@ -9170,6 +9412,7 @@ non_reserved_keyword:
 |   FULL
 |   FIXED
 |   FIELDS
+|   GENERATED
 |   GEOMETRY
 |   GEOMETRYCOLLECTION
 |   GLOBAL
@@ -9248,6 +9491,7 @@ non_reserved_keyword:
 |   START
 |   STATUS
 |   STORAGE
+|   STORED
 |	STREAM
 |   STATS_AUTO_RECALC
 |   STATS_PERSISTENT
@@ -9272,6 +9516,7 @@ non_reserved_keyword:
 |   VARCHAR
 |   VARIABLES
 |   VIEW
+|   VIRTUAL
 |   WRITE
 |   WARNINGS
 |   WORK
@@ -9304,6 +9549,13 @@ non_reserved_keyword:
 |   ROLES
 |   UUID
 |   PARALLEL
+|   VECF32
+|   VECF64
+|   IVFFLAT
+|   LISTS
+|   QUOTA
+|   POLICY
+|   POLICIES
 |   INCREMENT
 |   CYCLE
 |   MINVALUE
@@ -134,6 +134,28 @@ var (
 		output: "select algo_alarm_record.* from algo_alarm_record inner join (select id from algo_alarm_record use index(algo_alarm_record_algo_id_first_id_created_at_index) where first_id = 0 and created_at >= 2022-09-18 00:00:00 and created_at <= 2022-10-18 00:00:00 and algo_id not in (9808, 9809) order by id desc limit 10 offset 0) as e on e.id = algo_alarm_record.id order by algo_alarm_record.id desc",
 	}, {
 		input: "select a from t1 use index(b)",
+	}, {
+		input:  "select a from t1 as of timestamp '2023-04-01 12:00:00' as t use index(b)",
+		output: "select a from t1 as of timestamp 2023-04-01 12:00:00 as t use index(b)",
+	}, {
+		input:  "select * from t1 AS OF TIMESTAMP now() - interval 1 hour join t2 on t1.a = t2.a",
+		output: "select * from t1 as of timestamp now() - interval(1, hour) inner join t2 on t1.a = t2.a",
+	}, {
+		input:  "BACKUP CLUSTER TO '/data/backup'",
+		output: "backup cluster to '/data/backup'",
+	}, {
+		input: "backup account acc1 to 's3,endpoint,region,bucket,key,secret,prefix:backup'",
+	}, {
+		input: "backup database db1 to '/data/backup'",
+	}, {
+		input:  "GRANT BACKUP_ADMIN ON ACCOUNT * TO r1",
+		output: "grant backup_admin on account * to r1",
+	}, {
+		input:  "create table backup (a int)",
+		output: "create table backup (a int)",
+	}, {
+		input:  "set transaction snapshot '2023-04-01 12:00:00'",
+		output: "set transaction_snapshot = 2023-04-01 12:00:00",
 	}, {
 		input:  "SELECT   id,cid,status,ip,stream   FROM camera     WHERE (cid_type = ?)",
 		output: "select id, cid, status, ip, stream from camera where (cid_type = ?)",
@@ -182,6 +204,11 @@ var (
 	}, {
 		input:  "create table t1 (a datetime on update CURRENT_TIMESTAMP(1))",
 		output: "create table t1 (a datetime(26) on update current_timestamp(1))",
+	}, {
+		input:  "create table t1 (a int, b int generated always as (a + 1) stored, c int as (b * 2), d json, e varchar(10) as (json_unquote(json_extract(d, '$.name'))) virtual not null)",
+		output: "create table t1 (a int, b int generated always as (a + 1) stored, c int generated always as (b * 2) virtual, d json, e varchar(10) generated always as (json_unquote(json_extract(d, $.name))) virtual not null)",
+	}, {
+		input: "create table generated (stored int, virtual int, always int)",
 	}, {
 		input:  `create table table10 (a int primary key, b varchar(10)) checksum=0 COMMENT="asdf"`,
 		output: "create table table10 (a int primary key, b varchar(10)) checksum = 0 comment = asdf",
@@ -546,6 +573,8 @@ var (
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input: "with recursive tw(n) as (select 1 union all select n + 1 from tw where n < 10) select * from tw",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
@@ -648,6 +677,9 @@ var (
 		output: "create table t (a int) properties(a = b)",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
+	}, {
+		input:  "create table t (a int check (a > 0), b int, constraint c1 check (b < a) not enforced, check (b > 0))",
+		output: "create table t (a int constraint check (a > 0) enforced, b int, constraint c1 check (b < a) not enforced, check (b > 0) enforced)",
 	}, {
 		input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 	}, {
@@ -1229,6 +1261,15 @@ var (
 		}, {
 			input:  "explain (format text) select a from A",
 			output: "explain (format text) select a from a",
+		}, {
+			input:  "explain (format dot) select a from A",
+			output: "explain (format dot) select a from a",
+		}, {
+			input:  "explain format = json select a from A",
+			output: "explain (format json) select a from a",
+		}, {
+			input:  "explain analyze format = dot select a from A",
+			output: "explain (analyze,format dot) select a from a",
 		}, {
 			input:  "explain analyze select * from t",
 			output: "explain (analyze) select * from t",
@@ -1467,6 +1508,11 @@ var (
 		}, {
 			input:  "analyze table part (a,b )",
 			output: "analyze table part(a, b)",
+		}, {
+			input:  "analyze table part columns (a,b )",
+			output: "analyze table part(a, b)",
+		}, {
+			input: "analyze table db.part",
 		}, {
 			input:  "select $ from t into outfile '/Users/tmp/test'",
 			output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
@@ -1537,6 +1583,11 @@ var (
 		}, {
 			input:  "alter account nihao admin_name 'admin' identified with '123'",
 			output: "alter account nihao admin_name 'admin' identified with '******'",
+		}, {
+			input:  "alter account nihao with quota max_connections 10, query_memory = 1073741824",
+			output: "alter account nihao with quota max_connections = 10, query_memory = 1073741824",
+		}, {
+			input: "alter account if exists nihao open with quota max_queries = 0, storage_size = 107374182400",
 		}, {
 			input: "create user if not exists abc1 identified by '123', abc2 identified by '234', abc3 identified by '111' default role def_role " +
 				"password expire " +
@@ -1716,6 +1767,14 @@ var (
 			input: `create table t2 (a uuid primary key, b varchar(10))`,
 		}, {
 			input: `create table t3 (a int, b uuid, primary key idx (a, b))`,
+		}, {
+			input: `create table t4 (a int primary key, b vecf32(3), c vecf64(128))`,
+		}, {
+			input:  `create table t5 (a int primary key, b vecf32(3), index idx using ivfflat (b) lists 10)`,
+			output: `create table t5 (a int primary key, b vecf32(3), index idx using ivfflat (b) lists 10 )`,
+		}, {
+			input:  "create index idx1 using ivfflat on t1 (b) lists = 4",
+			output: "create index idx1 using ivfflat on t1 (b) lists 4 ",
 		}, {
 			input:  `DO SLEEP(5)`,
 			output: `do sleep(5)`,
@@ -1833,6 +1892,12 @@ var (
 		{
 			input: `select count(a) over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1`,
 		},
+		{
+			input: `select row_number() over (partition by col1 order by col3) from t1`,
+		},
+		{
+			input: `select lag(a, 2) over (order by col3 desc), first_value(a) over (rows between unbounded preceding and current row) from t1`,
+		},
 		{
 			input: "alter account if exists abc",
 		},
@@ -2182,6 +2247,34 @@ var (
 			input:  "show roles like '%dafgda_'",
 			output: "show roles like %dafgda_",
 		},
+		{
+			input:  "create policy p1 on t1 using (dept = 'sales')",
+			output: "create policy p1 on t1 using (dept = sales)",
+		},
+		{
+			input:  "create policy if not exists p1 on db.t1 to r1, r2 using (a > 1 and b < 10)",
+			output: "create policy if not exists p1 on db.t1 to r1, r2 using (a > 1 and b < 10)",
+		},
+		{
+			input:  "drop policy if exists p1 on t1",
+			output: "drop policy if exists p1 on t1",
+		},
+		{
+			input:  "show policies",
+			output: "show policies",
+		},
+		{
+			input:  "show policies from db.t1",
+			output: "show policies on db.t1",
+		},
+		{
+			input:  "grant select (a, b) on table t1 to r1",
+			output: "grant select(a, b) on table t1 to r1",
+		},
+		{
+			input:  "create table policy (policies int)",
+			output: "create table policy (policies int)",
+		},
 		{
 			input:  "create procedure test1 (in param1 int) 'test test'",
 			output: "create procedure test1 (in param1 int) 'test test'",
@@ -92,6 +92,8 @@ type AlterAccount struct {
 	StatusOption AccountStatus
 	//comment or not
 	Comment AccountComment
+	//quota or not
+	Quota AccountQuota
 }
 
 func (ca *AlterAccount) Format(ctx *FmtCtx) {
@@ -103,11 +105,36 @@ func (ca *AlterAccount) Format(ctx *FmtCtx) {
 	ca.AuthOption.Format(ctx)
 	ca.StatusOption.Format(ctx)
 	ca.Comment.Format(ctx)
+	ca.Quota.Format(ctx)
 }
 
 func (ca *AlterAccount) GetStatementType() string { return "Alter Account" }
 func (ca *AlterAccount) GetQueryType() string     { return QueryTypeDCL }
 
+// AccountQuota is the resource limits of the account in
+// ALTER ACCOUNT ... WITH QUOTA name = value, ...
+type AccountQuota struct {
+	Exist   bool
+	Options []AccountQuotaOption
+}
+
+func (node *AccountQuota) Format(ctx *FmtCtx) {
+	if node.Exist {
+		ctx.WriteString(" with quota ")
+		for i, opt := range node.Options {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			ctx.WriteString(fmt.Sprintf("%s = %d", opt.Name, opt.Value))
+		}
+	}
+}
+
+type AccountQuotaOption struct {
+	Name  string
+	Value int64
+}
+
 type AlterView struct {
 	statementImpl
 	IfExists bool
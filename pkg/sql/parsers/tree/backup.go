@@ -0,0 +1,58 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type BackupScope int
+
+const (
+	BackupScopeCluster BackupScope = iota
+	BackupScopeAccount
+	BackupScopeDatabase
+)
+
+func (s BackupScope) String() string {
+	switch s {
+	case BackupScopeCluster:
+		return "cluster"
+	case BackupScopeAccount:
+		return "account"
+	case BackupScopeDatabase:
+		return "database"
+	default:
+		return ""
+	}
+}
+
+// Backup is BACKUP {CLUSTER | ACCOUNT name | DATABASE name} TO 'path'
+type Backup struct {
+	statementImpl
+	Scope BackupScope
+	Name  Identifier
+	Path  string
+}
+
+func (node *Backup) Format(ctx *FmtCtx) {
+	ctx.WriteString("backup ")
+	ctx.WriteString(node.Scope.String())
+	if node.Scope != BackupScopeCluster {
+		ctx.WriteByte(' ')
+		node.Name.Format(ctx)
+	}
+	ctx.WriteString(" to ")
+	ctx.WriteString("'" + FormatString(node.Path) + "'")
+}
+
+func (node *Backup) GetStatementType() string { return "Backup" }
+func (node *Backup) GetQueryType() string     { return QueryTypeOth }
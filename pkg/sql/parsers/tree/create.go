@@ -523,7 +523,14 @@ type AttributeGeneratedAlways struct {
 }
 
 func (node *AttributeGeneratedAlways) Format(ctx *FmtCtx) {
+	ctx.WriteString("generated always as (")
 	node.Expr.Format(ctx)
+	ctx.WriteString(")")
+	if node.Stored {
+		ctx.WriteString(" stored")
+	} else {
+		ctx.WriteString(" virtual")
+	}
 }
 
 func NewAttributeGeneratedAlways(e Expr, s bool) *AttributeGeneratedAlways {
@@ -735,6 +742,8 @@ func (it IndexType) ToString() string {
 		return "bsi"
 	case INDEX_TYPE_ZONEMAP:
 		return "zonemap"
+	case INDEX_TYPE_IVFFLAT:
+		return "ivfflat"
 	default:
 		return "Unknown IndexType"
 	}
@@ -747,6 +756,7 @@ const (
 	INDEX_TYPE_RTREE
 	INDEX_TYPE_BSI
 	INDEX_TYPE_ZONEMAP
+	INDEX_TYPE_IVFFLAT
 )
 
 type VisibleType int
@@ -777,6 +787,8 @@ type IndexOption struct {
 	Visible                  VisibleType
 	EngineAttribute          string
 	SecondaryEngineAttribute string
+	// Lists is the number of the lists of the IVFFLAT index
+	Lists uint64
 }
 
 // Must follow the following sequence when test
@@ -796,6 +808,11 @@ func (node *IndexOption) Format(ctx *FmtCtx) {
 		ctx.WriteString(node.Comment)
 		ctx.WriteByte(' ')
 	}
+	if node.Lists != 0 {
+		ctx.WriteString("lists ")
+		ctx.WriteString(strconv.FormatUint(node.Lists, 10))
+		ctx.WriteByte(' ')
+	}
 	if node.Visible != VISIBLE_TYPE_INVALID {
 		ctx.WriteString(node.Visible.ToString())
 	}
@@ -1050,16 +1067,22 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
-	Expr     Expr
-	Enforced bool
+	ConstraintSymbol string
+	Expr             Expr
+	Enforced         bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.ConstraintSymbol != "" {
+		ctx.WriteString("constraint " + node.ConstraintSymbol + " ")
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
@@ -689,16 +689,19 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 
 		if node.WindowSpec.WindowFrame != nil {
 			frame := node.WindowSpec.WindowFrame
+			if len(node.WindowSpec.PartitionBy) > 0 || len(node.WindowSpec.OrderBy) > 0 {
+				ctx.WriteByte(' ')
+			}
 
 			switch frame.Unit {
 			case WIN_FRAME_UNIT_ROWS:
-				ctx.WriteString(" rows ")
+				ctx.WriteString("rows ")
 
 			case WIN_FRAME_UNIT_RANGE:
-				ctx.WriteString(" range ")
+				ctx.WriteString("range ")
 
 			case WIN_FRAME_UNIT_GROUPS:
-				ctx.WriteString(" groups ")
+				ctx.WriteString("groups ")
 			}
 
 			if frame.EndBound == nil {
@@ -28,6 +28,7 @@ type FmtCtx struct {
 	// quoteString string
 	quoteString       bool
 	singleQuoteString bool
+	hiddenValue       bool
 }
 
 func NewFmtCtx(dialectType dialect.DialectType, opts ...FmtCtxOption) *FmtCtx {
@@ -58,6 +59,13 @@ func WithSingleQuoteString() FmtCtxOption {
 	})
 }
 
+// WithHiddenValue writes the literals as '?', like the digest of the statement in MySQL.
+func WithHiddenValue() FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.hiddenValue = true
+	})
+}
+
 // NodeFormatter for formatted output of the node.
 type NodeFormatter interface {
 	Format(ctx *FmtCtx)
@@ -89,6 +97,9 @@ func (ctx *FmtCtx) PrintExpr(currentExpr Expr, expr Expr, left bool) {
 }
 
 func (ctx *FmtCtx) WriteValue(t P_TYPE, v string) (int, error) {
+	if ctx.hiddenValue {
+		return ctx.WriteString("?")
+	}
 	if ctx.quoteString {
 		switch t {
 		case P_char:
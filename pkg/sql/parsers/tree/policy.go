@@ -0,0 +1,91 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+// CREATE POLICY statement, the row level security policy. The rows of the
+// table are only visible to the roles if they satisfy the expression.
+type CreatePolicy struct {
+	statementImpl
+	IfNotExists bool
+	Name        Identifier
+	Table       *TableName
+	// Roles are the roles the policy applies to, empty means all the roles.
+	Roles []*Role
+	Expr  Expr
+}
+
+func (node *CreatePolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("create policy ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+	if len(node.Roles) > 0 {
+		ctx.WriteString(" to ")
+		for i, r := range node.Roles {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			r.Format(ctx)
+		}
+	}
+	ctx.WriteString(" using (")
+	node.Expr.Format(ctx)
+	ctx.WriteByte(')')
+}
+
+func (node *CreatePolicy) GetStatementType() string { return "Create Policy" }
+func (node *CreatePolicy) GetQueryType() string     { return QueryTypeDCL }
+
+// DROP POLICY statement
+type DropPolicy struct {
+	statementImpl
+	IfExists bool
+	Name     Identifier
+	Table    *TableName
+}
+
+func (node *DropPolicy) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop policy ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+}
+
+func (node *DropPolicy) GetStatementType() string { return "Drop Policy" }
+func (node *DropPolicy) GetQueryType() string     { return QueryTypeDCL }
+
+// SHOW POLICIES statement
+type ShowPolicies struct {
+	showImpl
+	// Table is nil if the policies of all the tables are shown.
+	Table *TableName
+}
+
+func (node *ShowPolicies) Format(ctx *FmtCtx) {
+	ctx.WriteString("show policies")
+	if node.Table != nil {
+		ctx.WriteString(" on ")
+		node.Table.Format(ctx)
+	}
+}
+
+func (node *ShowPolicies) GetStatementType() string { return "Show Policies" }
+func (node *ShowPolicies) GetQueryType() string     { return QueryTypeOth }
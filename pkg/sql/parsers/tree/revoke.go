@@ -320,6 +320,8 @@ func (node *PrivilegeType) ToString() string {
 		return "truncate"
 	case PRIVILEGE_TYPE_STATIC_REFERENCE:
 		return "reference"
+	case PRIVILEGE_TYPE_DYNAMIC_BACKUP_ADMIN:
+		return "backup_admin"
 	default:
 		return "Unknown PrivilegeType"
 	}
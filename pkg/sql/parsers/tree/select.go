@@ -453,10 +453,16 @@ type AliasedTableExpr struct {
 	Expr       TableExpr
 	As         AliasClause
 	IndexHints []*IndexHint
+	// AsOfTimestamp is the timestamp of AS OF TIMESTAMP, the table is read at it if set
+	AsOfTimestamp Expr
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
+	if node.AsOfTimestamp != nil {
+		ctx.WriteString(" as of timestamp ")
+		node.AsOfTimestamp.Format(ctx)
+	}
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
@@ -43,6 +43,8 @@ const (
 	BlobFamily
 
 	GeometryFamily
+	// VectorFamily is VECF32(n) and VECF64(n), Oid is the element type
+	VectorFamily
 )
 
 type IntervalDurationField struct {
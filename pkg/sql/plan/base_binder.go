@@ -255,6 +255,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	relPos := NotFound
 	colPos := NotFound
 	var typ *plan.Type
+	var colBinding *Binding
 	localErrCtx := errutil.ContextWithNoReport(b.GetContext(), true)
 
 	if len(table) == 0 {
@@ -264,6 +265,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 				colPos = binding.colIdByName[col]
 				typ = binding.types[colPos]
 				table = binding.table
+				colBinding = binding
 			} else {
 				return nil, moerr.NewInvalidInput(b.GetContext(), "ambiguous column reference '%v'", name)
 			}
@@ -279,6 +281,7 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 			if colPos != NotFound {
 				typ = binding.types[colPos]
 				relPos = binding.tag
+				colBinding = binding
 			} else {
 				err = moerr.NewInvalidInput(localErrCtx, "column '%s' does not exist", name)
 			}
@@ -288,8 +291,15 @@ func (b *baseBinder) baseBindColRef(astExpr *tree.UnresolvedName, depth int32, i
 	}
 
 	if colPos != NotFound {
+		if colBinding.colIsDenied != nil && colBinding.colIsDenied[colPos] {
+			return nil, moerr.NewColumnAccessDenied(b.GetContext(), "SELECT", col, table)
+		}
 		b.boundCols = append(b.boundCols, table+"."+col)
 
+		if expr, err = b.bindVirtualColumn(colBinding, colPos, depth); err != nil || expr != nil {
+			return
+		}
+
 		expr = &plan.Expr{
 			Typ: typ,
 		}
@@ -710,6 +720,19 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 		}
 	case "trim":
 		astArgs = astArgs[1:]
+	case "json_arrayagg":
+		// rewrite 'json_arrayagg(expr)' to 'json_arrayagg(json_array(expr))',
+		// so the aggregate only concatenates the json arrays, and NULL is kept as json null
+		if len(astArgs) != 1 {
+			return nil, moerr.NewInvalidArg(b.GetContext(), "json_arrayagg function need one arg", len(astArgs))
+		}
+		astArgs = []tree.Expr{tree.NewFuncExpr(tree.FUNC_TYPE_DEFAULT, tree.SetUnresolvedName("json_array"), astArgs, nil)}
+	case "json_objectagg":
+		// rewrite 'json_objectagg(key, value)' to 'json_objectagg(json_object(key, value))'
+		if len(astArgs) != 2 {
+			return nil, moerr.NewInvalidArg(b.GetContext(), "json_objectagg function need two args", len(astArgs))
+		}
+		astArgs = []tree.Expr{tree.NewFuncExpr(tree.FUNC_TYPE_DEFAULT, tree.SetUnresolvedName("json_object"), astArgs, nil)}
 	}
 	// bind ast function's args
 	args := make([]*Expr, len(astArgs))
@@ -810,7 +833,10 @@ func bindFuncExprAndConstFold(ctx context.Context, proc *process.Process, name s
 }
 
 func bindFuncExprImplByPlanExpr(ctx context.Context, name string, args []*Expr) (*plan.Expr, error) {
-	var err error
+	args, err := resetEnumBitYearArgs(ctx, name, args)
+	if err != nil {
+		return nil, err
+	}
 
 	// deal with some special function
 	switch name {
@@ -1355,6 +1381,10 @@ func appendCastBeforeExpr(ctx context.Context, expr *Expr, toType *Type, isBin .
 	if expr.Typ.Id == int32(types.T_any) {
 		return expr, nil
 	}
+	expr, done, err := castEnumExpr(ctx, expr, toType)
+	if err != nil || done {
+		return expr, err
+	}
 	toType.NotNullable = expr.Typ.NotNullable
 	argsType := []types.Type{
 		makeTypeByPlan2Expr(expr),
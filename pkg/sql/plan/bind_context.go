@@ -29,6 +29,7 @@ func NewBindContext(builder *QueryBuilder, parent *BindContext) *BindContext {
 		groupByAst:     make(map[string]int32),
 		aggregateByAst: make(map[string]int32),
 		projectByExpr:  make(map[string]int32),
+		windowBySpec:   make(map[string]int32),
 		aliasMap:       make(map[string]int32),
 		bindingByTag:   make(map[int32]*Binding),
 		bindingByTable: make(map[string]*Binding),
@@ -50,6 +51,15 @@ func (bc *BindContext) rootTag() int32 {
 	}
 }
 
+func (bc *BindContext) isWindowTag(tag int32) bool {
+	for _, t := range bc.windowTags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
 func (bc *BindContext) findCTE(name string) *CTERef {
 	if cte, ok := bc.cteByName[name]; ok {
 		return cte
@@ -67,6 +77,11 @@ func (bc *BindContext) findCTE(name string) *CTERef {
 		parent = bc.parent
 	}
 
+	// a recursive CTE can refer to itself inside its definition
+	if parent != nil && parent.recursiveCTE != nil {
+		return parent.recursiveCTE
+	}
+
 	return nil
 }
 
@@ -314,6 +329,20 @@ func (bc *BindContext) qualifyColumnNames(astExpr tree.Expr, selectList tree.Sel
 				return nil, err
 			}
 		}
+		if exprImpl.WindowSpec != nil {
+			for i := range exprImpl.WindowSpec.PartitionBy {
+				exprImpl.WindowSpec.PartitionBy[i], err = bc.qualifyColumnNames(exprImpl.WindowSpec.PartitionBy[i], selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+			for _, order := range exprImpl.WindowSpec.OrderBy {
+				order.Expr, err = bc.qualifyColumnNames(order.Expr, selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
 
 	case *tree.RangeCond:
 		exprImpl.Left, err = bc.qualifyColumnNames(exprImpl.Left, selectList, expandAlias)
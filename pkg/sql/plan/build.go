@@ -145,6 +145,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildShowTableValues(stmt, ctx)
 	case *tree.ShowRolesStmt:
 		return buildShowRoles(stmt, ctx)
+	case *tree.ShowPolicies:
+		return buildShowPolicies(stmt, ctx)
 	case *tree.SetVar:
 		return buildSetVariables(stmt, ctx)
 	case *tree.Execute:
@@ -178,7 +178,7 @@ func getUpdateTableInfo(ctx CompilerContext, stmt *tree.Update) (*dmlTableInfo,
 				newTblInfo.haveConstraint = true
 			} else {
 				for _, indexdef := range tblDef.Indexes {
-					if indexdef.Unique {
+					if indexdef.Unique || isIvfflatIndexDef(indexdef) {
 						newTblInfo.haveConstraint = true
 						break
 					}
@@ -283,7 +283,7 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 			tblInfo.haveConstraint = true
 		} else {
 			for _, indexdef := range tableDef.Indexes {
-				if indexdef.Unique {
+				if indexdef.Unique || isIvfflatIndexDef(indexdef) {
 					tblInfo.haveConstraint = true
 					break
 				}
@@ -485,6 +485,9 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 		}
 
 	case *tree.SelectClause:
+		if err = checkInsertGeneratedColumns(builder.GetContext(), tableDef, insertColumns, colToIdx); err != nil {
+			return err
+		}
 		astSlt = stmt.Rows
 
 		subCtx := NewBindContext(builder, bindCtx)
@@ -494,6 +497,9 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 		}
 
 	case *tree.ParenSelect:
+		if err = checkInsertGeneratedColumns(builder.GetContext(), tableDef, insertColumns, colToIdx); err != nil {
+			return err
+		}
 		astSlt = slt.Select
 
 		subCtx := NewBindContext(builder, bindCtx)
@@ -870,6 +876,17 @@ func initDeleteStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 	return nil
 }
 
+// checkInsertGeneratedColumns checks that the rows of the select to insert
+// don't set the generated columns.
+func checkInsertGeneratedColumns(ctx context.Context, tableDef *TableDef, insertColumns []string, colToIdx map[string]int) error {
+	for _, name := range insertColumns {
+		if tableDef.Cols[colToIdx[name]].Default.GetGenerated() != nil {
+			return moerr.NewNonDefaultValueForGeneratedColumn(ctx, name, tableDef.Name)
+		}
+	}
+	return nil
+}
+
 func checkNotNull(ctx context.Context, expr *Expr, tableDef *TableDef, col *ColDef) error {
 	isConstantNull := false
 	if ef, ok := expr.Expr.(*plan.Expr_C); ok {
@@ -904,6 +921,10 @@ func forceCastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, er
 	if targetType.Id == 0 {
 		return expr, nil
 	}
+	expr, done, err := castEnumExpr(ctx, expr, targetType)
+	if err != nil || done {
+		return expr, err
+	}
 	t1, t2 := makeTypeByPlan2Expr(expr), makeTypeByPlan2Type(targetType)
 	if t1.Eq(t2) {
 		return expr, nil
@@ -966,6 +987,11 @@ func initUpdateStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 			if _, ok := updateKeysMap[coldef.Name]; ok {
 				pos := newColPosMap[coldef.Name]
 				posExpr := lastNode.ProjectList[pos]
+				// the generated column can only be set to default, its value is
+				// computed when writing
+				if coldef.Default.GetGenerated() != nil && posExpr.Typ != nil {
+					return moerr.NewNonDefaultValueForGeneratedColumn(builder.GetContext(), coldef.Name, tableDef.Name)
+				}
 				if posExpr.Typ == nil { // set col = default
 					lastNode.ProjectList[pos], err = getDefaultExpr(builder.GetContext(), coldef)
 					if err != nil {
@@ -973,9 +999,11 @@ func initUpdateStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 					}
 					posExpr = lastNode.ProjectList[pos]
 				}
-				err = checkNotNull(builder.GetContext(), posExpr, tableDef, coldef)
-				if err != nil {
-					return err
+				if coldef.Default.GetGenerated() == nil {
+					err = checkNotNull(builder.GetContext(), posExpr, tableDef, coldef)
+					if err != nil {
+						return err
+					}
 				}
 				lastNode.ProjectList[pos], err = forceCastExpr(builder.GetContext(), posExpr, coldef.Typ)
 				if err != nil {
@@ -1180,6 +1208,16 @@ func rewriteDmlSelectInfo(builder *QueryBuilder, bindCtx *BindContext, info *dml
 					info.onIdxTbl = append(info.onIdxTbl, idxRef)
 				}
 			}
+
+			// the rows of the ivfflat indexes are after the ones of the unique indexes
+			for _, indexdef := range tableDef.Indexes {
+				if isIvfflatIndexDef(indexdef) && indexdef.TableExist {
+					err := appendIvfflatIndexJoin(builder, bindCtx, info, tableDef, indexdef, baseNodeId, oldColPosMap, typMap)
+					if err != nil {
+						return err
+					}
+				}
+			}
 		}
 	}
 
@@ -1588,6 +1626,8 @@ func buildValueScan(
 					if err != nil {
 						return err
 					}
+				} else if col.Default.GetGenerated() != nil {
+					return moerr.NewNonDefaultValueForGeneratedColumn(builder.GetContext(), col.Name, tableDef.Name)
 				} else {
 					defExpr, err = binder.BindExpr(r[i], 0, true)
 					if err != nil {
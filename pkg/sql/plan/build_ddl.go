@@ -25,6 +25,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -368,10 +369,26 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionCompression:
+			if _, _, err := compress.ParseCompression(opt.Compression); err != nil {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "compression '%s' of table, the supported are none, lz4, snappy, zstd and zstd:<level>", opt.Compression)
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   catalog.SystemRelAttr_Compression,
+								Value: strings.ToLower(opt.Compression),
+							},
+						},
+					},
+				},
+			})
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
-		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum, *tree.TableOptionCompression,
+		// 	*tree.TableOptionAvgRowLength, *tree.TableOptionChecksum,
 		// 	*tree.TableOptionConnection, *tree.TableOptionPassword, *tree.TableOptionKeyBlockSize,
 		// 	*tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionDelayKeyWrite,
 		// 	*tree.TableOptionRowFormat, *tree.TableOptionStatsPersistent, *tree.TableOptionStatsAutoRecalc,
@@ -562,6 +579,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 	colMap := make(map[string]*ColDef)
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
+	generatedCols := make(map[string]*tree.AttributeGeneratedAlways)
+	var checks []checkConstraintDef
 	for _, item := range stmt.Defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -578,6 +597,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			var pks []string
 			var comment string
 			var auto_incr bool
+			var generated *tree.AttributeGeneratedAlways
 			for _, attr := range def.Attributes {
 				switch attribute := attr.(type) {
 				case *tree.AttributePrimaryKey, *tree.AttributeKey:
@@ -611,6 +631,15 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 						Name: def.Name.Parts[0],
 					})
 					indexs = append(indexs, def.Name.Parts[0])
+				case *tree.AttributeGeneratedAlways:
+					generated = attribute
+				case *tree.AttributeCheckConstraint:
+					checks = append(checks, checkConstraintDef{
+						name:     attribute.Name,
+						col:      def.Name.Parts[0],
+						expr:     attribute.Expr,
+						enforced: attribute.Enforced,
+					})
 				}
 			}
 			if len(pks) > 0 {
@@ -632,6 +661,18 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			if err != nil {
 				return err
 			}
+			if generated != nil {
+				if defaultValue.Expr != nil {
+					return moerr.NewUnsupportedActionOnGeneratedColumn(ctx.GetContext(), "DEFAULT")
+				}
+				if auto_incr {
+					return moerr.NewUnsupportedActionOnGeneratedColumn(ctx.GetContext(), "AUTO_INCREMENT")
+				}
+				if onUpdateExpr != nil {
+					return moerr.NewUnsupportedActionOnGeneratedColumn(ctx.GetContext(), "ON UPDATE")
+				}
+				generatedCols[def.Name.Parts[0]] = generated
+			}
 
 			if !checkTableColumnNameValid(def.Name.Parts[0]) {
 				return moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", def.Name.Parts[0])
@@ -664,6 +705,10 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			}
 		case *tree.Index:
 			secondaryIndexInfos = append(secondaryIndexInfos, def)
+			// the ivfflat index checks its column itself
+			if isIvfflatIndex(def) {
+				continue
+			}
 			for _, key := range def.KeyParts {
 				name := key.ColName.Parts[0]
 				indexs = append(indexs, name)
@@ -685,7 +730,13 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			createTable.FkCols = append(createTable.FkCols, fkData.Cols)
 			createTable.TableDef.Fkeys = append(createTable.TableDef.Fkeys, fkData.Def)
 
-		case *tree.CheckIndex, *tree.FullTextIndex:
+		case *tree.CheckIndex:
+			checks = append(checks, checkConstraintDef{
+				name:     def.ConstraintSymbol,
+				expr:     def.Expr,
+				enforced: def.Enforced,
+			})
+		case *tree.FullTextIndex:
 			// unsupport in plan. will support in next version.
 			return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
 		default:
@@ -693,6 +744,13 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		}
 	}
 
+	if err := buildGeneratedColumns(ctx, createTable.TableDef, generatedCols); err != nil {
+		return err
+	}
+	if err := buildCheckConstraints(ctx, createTable.TableDef, checks); err != nil {
+		return err
+	}
+
 	//add cluster table attribute
 	if stmt.IsClusterTable {
 		if _, ok := colMap[util.GetClusterTableAttributeName()]; ok {
@@ -734,6 +792,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			if _, ok := colMap[primaryKey]; !ok {
 				return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' doesn't exist in table", primaryKey)
 			}
+			if isVirtualColumn(colMap[primaryKey]) {
+				return moerr.NewUnsupportedActionOnGeneratedColumn(ctx.GetContext(), "Defining a virtual generated column as primary key")
+			}
 		}
 		if len(primaryKeys) == 1 {
 			pkeyName = primaryKeys[0]
@@ -819,6 +880,12 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		if colMap[str].Typ.Id == int32(types.T_json) {
 			return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", str))
 		}
+		if isVectorType(colMap[str].Typ) {
+			return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", str))
+		}
+		if isVirtualColumn(colMap[str]) {
+			return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("index on virtual generated column '%s', use a STORED generated column instead", str))
+		}
 	}
 
 	// check Constraint Name (include index/ unique)
@@ -835,7 +902,7 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		}
 	}
 	if len(secondaryIndexInfos) != 0 {
-		err = buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, ctx)
+		err = buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, pkeyName, ctx)
 		if err != nil {
 			return err
 		}
@@ -977,6 +1044,9 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 			}
+			if isVectorType(colMap[name].Typ) {
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
+			}
 			indexParts = append(indexParts, name)
 		}
 
@@ -1052,7 +1122,7 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 	return nil
 }
 
-func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, ctx CompilerContext) error {
+func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
 	nameCount := make(map[string]int)
 
 	for _, indexInfo := range indexInfos {
@@ -1075,6 +1145,12 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 			}
+			if isVectorType(colMap[name].Typ) && !isIvfflatIndex(indexInfo) {
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
+			}
+			if !isVectorType(colMap[name].Typ) && isIvfflatIndex(indexInfo) {
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("IVFFLAT index on column '%s' which is not VECTOR", name))
+			}
 			indexParts = append(indexParts, name)
 		}
 
@@ -1098,6 +1174,11 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 		} else {
 			indexDef.Comment = ""
 		}
+		if isIvfflatIndex(indexInfo) {
+			if err := buildIvfflatIndexTable(createTable, indexDef, indexInfo, colMap, pkeyName, ctx); err != nil {
+				return err
+			}
+		}
 		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
 	}
 	return nil
@@ -1105,6 +1186,7 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 
 func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, error) {
 	truncateTable := &plan.TruncateTable{}
+	var attachedPlan *Plan
 
 	truncateTable.Database = string(stmt.Name.SchemaName)
 	if truncateTable.Database == "" {
@@ -1151,6 +1233,16 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 				}
 			}
 		}
+
+		// the column statistics of the table, the truncated table has a new id
+		if truncateTable.Database != catalog.MO_CATALOG {
+			var err error
+			attachedPlan, err = attachCatalogDeletionPlan(ctx, attachedPlan, catalog.MO_COLUMN_STATS,
+				fmt.Sprintf(deleteMoColumnStatsWithTableIdFormat, tableDef.TblId))
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return &Plan{
@@ -1162,6 +1254,7 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 				},
 			},
 		},
+		AttachedPlan: attachedPlan,
 	}, nil
 }
 
@@ -1252,6 +1345,27 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 				}
 			}
 		}
+
+		// the column privileges, the policies and the column statistics of the table
+		if dropTable.Database != catalog.MO_CATALOG && dropTable.Table != "" {
+			var err error
+			attachedPlan, err = attachCatalogDeletionPlan(ctx, attachedPlan, catalog.MO_COLUMN_STATS,
+				fmt.Sprintf(deleteMoColumnStatsWithTableIdFormat, tableDef.TblId))
+			if err != nil {
+				return nil, err
+			}
+			dbName, tblName := escapeString(dropTable.Database), escapeString(dropTable.Table)
+			attachedPlan, err = attachCatalogDeletionPlan(ctx, attachedPlan, catalog.MO_COLUMN_PRIVS,
+				fmt.Sprintf(deleteMoColumnPrivsWithTableNameFormat, dbName, tblName))
+			if err != nil {
+				return nil, err
+			}
+			attachedPlan, err = attachCatalogDeletionPlan(ctx, attachedPlan, catalog.MO_POLICIES,
+				fmt.Sprintf(deleteMoPoliciesWithTableNameFormat, dbName, tblName))
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
@@ -1271,8 +1385,37 @@ var (
 	deleteMoIndexesWithTableIdFormat             = `delete from mo_catalog.mo_indexes where table_id = %v;`
 	deleteMoIndexesWithTableIdAndIndexNameFormat = `delete from mo_catalog.mo_indexes where table_id = %v and name = '%s';`
 	updateMoIndexesVisibleFormat                 = `update mo_catalog.mo_indexes set is_visible = %v where table_id = %v and name = '%s';`
+
+	deleteMoColumnPrivsWithTableNameFormat    = `delete from mo_catalog.mo_column_privs where database_name = '%s' and table_name = '%s';`
+	deleteMoColumnPrivsWithDatabaseNameFormat = `delete from mo_catalog.mo_column_privs where database_name = '%s';`
+	deleteMoPoliciesWithTableNameFormat       = `delete from mo_catalog.mo_policies where database_name = '%s' and table_name = '%s';`
+	deleteMoPoliciesWithDatabaseNameFormat    = `delete from mo_catalog.mo_policies where database_name = '%s';`
+	deleteMoColumnStatsWithTableIdFormat      = `delete from mo_catalog.mo_column_stats where table_id = %v;`
+	// the tables of the database are deleted from mo_tables after the statistics
+	deleteMoColumnStatsWithDatabaseIdFormat = `delete from mo_catalog.mo_column_stats where table_id in (select rel_id from mo_catalog.mo_tables where reldatabase_id = %v);`
 )
 
+// escapeString escapes the string in the single quoted literal of the sql
+func escapeString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	return strings.ReplaceAll(s, "'", "\\'")
+}
+
+// attachCatalogDeletionPlan chains the plan of the sql deleting the rows of the table in
+// mo_catalog to the attached plans, so the rows are deleted in the transaction of the ddl.
+// The table may not exist, e.g. it has been dropped by DROP ACCOUNT before the databases.
+func attachCatalogDeletionPlan(ctx CompilerContext, attachedPlan *Plan, tblName, sql string) (*Plan, error) {
+	if _, tableDef := ctx.Resolve(catalog.MO_CATALOG, tblName); tableDef == nil {
+		return attachedPlan, nil
+	}
+	deletionPlan, err := buildIndexMetadataPlan(sql, ctx)
+	if err != nil {
+		return nil, err
+	}
+	deletionPlan.AttachedPlan = attachedPlan
+	return deletionPlan, nil
+}
+
 // Build a plan to modify index metadata
 func buildIndexMetadataPlan(sql string, ctx CompilerContext) (*Plan, error) {
 	stmt, err := parsers.ParseOne(ctx.GetContext(), dialect.MYSQL, sql, 1)
@@ -1384,6 +1527,24 @@ func buildDropDatabase(stmt *tree.DropDatabase, ctx CompilerContext) (*Plan, err
 		if err != nil {
 			return nil, err
 		}
+
+		// the column privileges, the policies and the column statistics of the tables of the database
+		attachedPlan, err = attachCatalogDeletionPlan(ctx, attachedPlan, catalog.MO_COLUMN_STATS,
+			fmt.Sprintf(deleteMoColumnStatsWithDatabaseIdFormat, databaseId))
+		if err != nil {
+			return nil, err
+		}
+		dbName := escapeString(dropDB.Database)
+		attachedPlan, err = attachCatalogDeletionPlan(ctx, attachedPlan, catalog.MO_COLUMN_PRIVS,
+			fmt.Sprintf(deleteMoColumnPrivsWithDatabaseNameFormat, dbName))
+		if err != nil {
+			return nil, err
+		}
+		attachedPlan, err = attachCatalogDeletionPlan(ctx, attachedPlan, catalog.MO_POLICIES,
+			fmt.Sprintf(deleteMoPoliciesWithDatabaseNameFormat, dbName))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &Plan{
@@ -1464,10 +1625,11 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 		createIndex.TableExist = true
 	}
 	if sIdx != nil {
-		if err := buildSecondaryIndexDef(index, []*tree.Index{sIdx}, colMap, ctx); err != nil {
+		if err := buildSecondaryIndexDef(index, []*tree.Index{sIdx}, colMap, oriPriKeyName, ctx); err != nil {
 			return nil, err
 		}
-		createIndex.TableExist = false
+		// the ivfflat index has the index table
+		createIndex.TableExist = index.TableDef.Indexes[0].TableExist
 	}
 	createIndex.Index = index
 	createIndex.Table = tableName
@@ -1773,9 +1935,12 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 				}
 
 				oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
+				if isIvfflatIndex(def) {
+					return nil, moerr.NewNotSupported(ctx.GetContext(), "add IVFFLAT index by ALTER TABLE, use CREATE INDEX instead")
+				}
 
 				indexInfo := &plan.CreateTable{TableDef: &TableDef{}}
-				if err := buildSecondaryIndexDef(indexInfo, []*tree.Index{def}, colMap, ctx); err != nil {
+				if err := buildSecondaryIndexDef(indexInfo, []*tree.Index{def}, colMap, oriPriKeyName, ctx); err != nil {
 					return nil, err
 				}
 
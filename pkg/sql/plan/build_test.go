@@ -19,9 +19,11 @@ import (
 	"context"
 	"encoding/json"
 	"os"
+	"sort"
 	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -474,6 +476,30 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestJsonFunctionSQLBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		`select json_set('{"a": 1}', '$.b', n_name, '$.c', n_nationkey) from nation`,
+		`select json_insert('[1]', '$[1]', null), json_replace('[1]', '$[0]', 2.5)`,
+		`select json_remove('{"a": 1}', '$.a'), json_merge_patch('{"a": 1}', '{"b": 2}', null)`,
+		`select json_array(), json_array(n_name, n_regionkey, null) from nation`,
+		`select json_object(), json_object('name', n_name, 'key', n_nationkey) from nation`,
+		`select json_contains('[1, 2]', '1'), json_contains('{"a": [1]}', '1', '$.a')`,
+		`select json_keys('{"a": 1}'), json_length('[1]', '$'), json_type('1'), json_valid(n_comment) from nation`,
+		`select n_regionkey, json_arrayagg(n_name), json_objectagg(n_name, n_nationkey) from nation group by n_regionkey`,
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		`select json_set('{"a": 1}', '$.b')`,                  // the path without value
+		`select json_object('a')`,                             // the key without value
+		`select json_keys(n_nationkey) from nation`,           // not a json document
+		`select json_arrayagg(n_name, n_comment) from nation`, // too many args
+		`select json_objectagg(n_name) from nation`,           // too few args
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 // test join table plan building
 func TestJoinTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer(false)
@@ -594,6 +620,81 @@ func TestCTESqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestRecursiveCTESqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// should pass
+	sqls := []string{
+		"WITH RECURSIVE qn (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 10) SELECT * FROM qn",
+		"WITH RECURSIVE qn AS (SELECT N_NATIONKEY AS k, N_NAME FROM NATION WHERE N_NATIONKEY = 0 UNION SELECT NATION.N_NATIONKEY, NATION.N_NAME FROM NATION JOIN qn ON NATION.N_REGIONKEY = qn.k) SELECT N_NAME FROM qn WHERE k > 1",
+		"WITH RECURSIVE qn AS (SELECT N_NAME FROM NATION) SELECT * FROM qn",
+		"WITH RECURSIVE qn (a, b) AS (SELECT 1, 'x' UNION ALL SELECT a + 1, CONCAT(b, 'x') FROM qn WHERE a < 5) SELECT q1.a, q2.b FROM qn q1, qn q2 WHERE q1.a = q2.a",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"WITH RECURSIVE qn AS (SELECT * FROM qn) SELECT * FROM qn",
+		"WITH RECURSIVE qn (n) AS (SELECT n + 1 FROM qn UNION ALL SELECT 1) SELECT * FROM qn",
+		"WITH RECURSIVE qn (n) AS (SELECT 1 UNION ALL SELECT SUM(n) FROM qn) SELECT * FROM qn",
+		"WITH RECURSIVE qn (n) AS (SELECT 1 UNION ALL SELECT q1.n FROM qn q1, qn q2) SELECT * FROM qn",
+		"WITH RECURSIVE qn (n) AS (SELECT 1 UNION ALL SELECT n, n FROM qn) SELECT * FROM qn",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestWindowSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	// should pass
+	sqls := []string{
+		"SELECT N_NAME, ROW_NUMBER() OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, RANK() OVER (ORDER BY N_NATIONKEY DESC), DENSE_RANK() OVER (ORDER BY N_NATIONKEY DESC) FROM NATION",
+		"SELECT LAG(N_NAME, 2, 'none') OVER (ORDER BY N_NATIONKEY), LEAD(N_NAME) OVER (ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT SUM(N_NATIONKEY) OVER (PARTITION BY N_REGIONKEY ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING) FROM NATION",
+		"SELECT FIRST_VALUE(N_NAME) OVER (ORDER BY N_NATIONKEY RANGE BETWEEN 2 PRECEDING AND CURRENT ROW) FROM NATION",
+		"SELECT N_REGIONKEY, SUM(N_NATIONKEY), RANK() OVER (ORDER BY SUM(N_NATIONKEY)) FROM NATION GROUP BY N_REGIONKEY",
+		"SELECT N_NAME FROM NATION ORDER BY ROW_NUMBER() OVER (ORDER BY N_NATIONKEY)",
+		"SELECT * FROM (SELECT N_NAME, ROW_NUMBER() OVER (ORDER BY N_NATIONKEY) AS RN FROM NATION) T WHERE RN < 3",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT ROW_NUMBER() FROM NATION",
+		"SELECT N_NAME FROM NATION WHERE ROW_NUMBER() OVER (ORDER BY N_NATIONKEY) > 1",
+		"SELECT N_REGIONKEY FROM NATION GROUP BY N_REGIONKEY HAVING RANK() OVER (ORDER BY N_REGIONKEY) > 1",
+		"SELECT SUM(RANK() OVER (ORDER BY N_NATIONKEY)) OVER () FROM NATION",
+		"SELECT LAG(N_NAME, -1) OVER (ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT SUM(N_NATIONKEY) OVER (ORDER BY N_NAME RANGE BETWEEN 1 PRECEDING AND CURRENT ROW) FROM NATION",
+		"SELECT SUM(N_NATIONKEY) OVER (ROWS BETWEEN CURRENT ROW AND 1 PRECEDING) FROM NATION",
+		"SELECT SUM(N_NATIONKEY) OVER (ORDER BY N_NATIONKEY GROUPS BETWEEN 1 PRECEDING AND CURRENT ROW) FROM NATION",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestIndexHintSqlBuilder(t *testing.T) {
+	mock := newIndexMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"SELECT * FROM constraint_test.dept FORCE INDEX (dname) WHERE dname = 'sales'",
+		"SELECT * FROM constraint_test.dept USE INDEX (dname) WHERE dname IN ('sales', 'research')",
+		"SELECT * FROM constraint_test.dept IGNORE INDEX (dname) WHERE dname > 'sales'",
+		"SELECT * FROM constraint_test.dept USE INDEX () WHERE dname = 'sales'",
+		"SELECT * FROM constraint_test.dept FORCE INDEX (PRIMARY) WHERE deptno = 1",
+		"SELECT e.ename, d.dname FROM constraint_test.emp e FORCE INDEX (ename), constraint_test.dept d WHERE e.deptno = d.deptno AND e.ename = 'SMITH' AND e.job = 'CLERK'",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT * FROM constraint_test.dept FORCE INDEX (loc) WHERE dname = 'sales'",
+		"SELECT * FROM constraint_test.dept IGNORE INDEX (dname, idx) WHERE dname = 'sales'",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestInsert(t *testing.T) {
 	mock := NewMockOptimizer(false)
 	// should pass
@@ -736,6 +837,44 @@ func TestTcl(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+// attachedTables returns the tables scanned by the attached plans
+func attachedTables(logicPlan *Plan) []string {
+	var tables []string
+	for p := logicPlan.AttachedPlan; p != nil; p = p.AttachedPlan {
+		for _, node := range p.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				tables = append(tables, node.ObjRef.ObjName)
+			}
+		}
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+func TestDropDeletesCatalogRows(t *testing.T) {
+	mock := NewMockOptimizer(true)
+
+	logicPlan, err := runOneStmt(mock, t, "drop table tpch.nation")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	assert.Equal(t, []string{catalog.MO_COLUMN_PRIVS, catalog.MO_COLUMN_STATS, catalog.MO_POLICIES}, attachedTables(logicPlan))
+
+	logicPlan, err = runOneStmt(mock, t, "drop database tpch")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	assert.Equal(t, []string{catalog.MO_COLUMN_PRIVS, catalog.MO_COLUMN_STATS, catalog.MO_INDEXES, catalog.MO_POLICIES, catalog.MO_TABLES}, attachedTables(logicPlan))
+
+	logicPlan, err = runOneStmt(mock, t, "truncate tpch.nation")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	assert.Equal(t, []string{catalog.MO_COLUMN_STATS}, attachedTables(logicPlan))
+
+	assert.Equal(t, `a\\b\'c`, escapeString(`a\b'c`))
+}
+
 func TestDdl(t *testing.T) {
 	mock := NewMockOptimizer(true)
 	// should pass
@@ -765,6 +904,9 @@ func TestDdl(t *testing.T) {
 		"create view v_nation as select n_nationkey,n_name,n_regionkey,n_comment from nation",
 		"CREATE TABLE t1(id INT PRIMARY KEY,name VARCHAR(25),deptId INT,CONSTRAINT fk_t1 FOREIGN KEY(deptId) REFERENCES nation(n_nationkey)) COMMENT='xxxxx'",
 		"create table t2(empno int unsigned,ename varchar(15),job varchar(10)) cluster by(empno,ename)",
+		"create table t3(a int, b varchar(20)) compression='zstd:9'",
+		"create table t4(a int, b varchar(20)) compression='snappy'",
+		"create table t5(a enum('x', 'y''z'), b set('p', 'q') default 'p,q', c bit, d bit(64), e year, f year(4))",
 		"lock tables nation read",
 		"lock tables nation write, supplier read",
 		"unlock tables",
@@ -783,6 +925,12 @@ func TestDdl(t *testing.T) {
 		"drop table tpch.tbl_not_exist", //database not exists
 		"drop table db_not_exist.tbl",   //table not exists
 		"create table t6(empno int unsigned,ename varchar(15) auto_increment) cluster by(empno,ename)",
+		"create table t7(a int) compression='zlib'",
+		"create table t8(a int) compression='zstd:30'",
+		"create table t9(a enum('x', 'X'))",
+		"create table t9(a set('x', 'y,z'))",
+		"create table t9(a bit(65))",
+		"create table t9(a year(2))",
 		"lock tables t3 read",
 		"lock tables t1 read, t1 write",
 		"lock tables nation read, nation write",
@@ -43,6 +43,17 @@ import (
 
 func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*plan.Type, error) {
 	if n, ok := typ.(*tree.T); ok {
+		if n.InternalType.Family == tree.VectorFamily {
+			// VECF32(n) and VECF64(n), the dimension is kept in the width
+			dim := n.InternalType.DisplayWith
+			if dim <= 0 || dim > types.MaxVectorDimension {
+				return nil, moerr.NewOutOfRange(ctx, "vector", " dimension should be in [1, %d]", types.MaxVectorDimension)
+			}
+			if defines.MysqlType(n.InternalType.Oid) == defines.MYSQL_TYPE_DOUBLE {
+				return &plan.Type{Id: int32(types.T_vecf64), Width: dim}, nil
+			}
+			return &plan.Type{Id: int32(types.T_vecf32), Width: dim}, nil
+		}
 		switch defines.MysqlType(n.InternalType.Oid) {
 		case defines.MYSQL_TYPE_TINY:
 			if n.InternalType.Unsigned {
@@ -148,6 +159,30 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*pla
 			return &plan.Type{Id: int32(types.T_decimal64), Width: n.InternalType.DisplayWith, Scale: n.InternalType.Scale}, nil
 		case defines.MYSQL_TYPE_BOOL:
 			return &plan.Type{Id: int32(types.T_bool)}, nil
+		case defines.MYSQL_TYPE_BIT:
+			width := n.InternalType.DisplayWith
+			// bit(0) and bit are bit(1)
+			if width <= 0 {
+				width = 1
+			}
+			if width > types.MaxBitLen {
+				return nil, moerr.NewOutOfRange(ctx, "bit", " typeLen is over the MaxBitLen: %v", types.MaxBitLen)
+			}
+			return &plan.Type{Id: int32(types.T_bit), Width: width}, nil
+		case defines.MYSQL_TYPE_YEAR:
+			if w := n.InternalType.DisplayWith; w != -1 && w != 0 && w != 4 {
+				return nil, moerr.NewInvalidInput(ctx, "supports only YEAR or YEAR(4) column")
+			}
+			return &plan.Type{Id: int32(types.T_year), Width: 4}, nil
+		case defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET:
+			members, err := checkEnumValues(ctx, n.InternalType.Oid, n.InternalType.EnumValues)
+			if err != nil {
+				return nil, err
+			}
+			if defines.MysqlType(n.InternalType.Oid) == defines.MYSQL_TYPE_ENUM {
+				return &plan.Type{Id: int32(types.T_enum), Enumvalues: types.FormatEnumValues(members)}, nil
+			}
+			return &plan.Type{Id: int32(types.T_set), Enumvalues: types.FormatEnumValues(members)}, nil
 		case defines.MYSQL_TYPE_BLOB:
 			return &plan.Type{Id: int32(types.T_blob)}, nil
 		case defines.MYSQL_TYPE_TEXT:
@@ -169,6 +204,36 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*pla
 	return nil, moerr.NewInternalError(ctx, "unknown data type")
 }
 
+// checkEnumValues checks the members of ENUM or SET, the trailing spaces of
+// the members are removed like MySQL.
+func checkEnumValues(ctx context.Context, oid uint32, values []string) ([]string, error) {
+	name, maxLen := "enum", types.MaxEnumLen
+	if defines.MysqlType(oid) == defines.MYSQL_TYPE_SET {
+		name, maxLen = "set", types.MaxSetLen
+	}
+	if len(values) == 0 {
+		return nil, moerr.NewInvalidInput(ctx, "%s type needs at least one member", name)
+	}
+	if len(values) > maxLen {
+		return nil, moerr.NewOutOfRange(ctx, name, " the number of the members is over %v", maxLen)
+	}
+	members := make([]string, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for i, v := range values {
+		v = strings.TrimRight(v, " ")
+		if name == "set" && strings.Contains(v, ",") {
+			return nil, moerr.NewInvalidInput(ctx, "illegal set '%s' value found during parsing", v)
+		}
+		key := strings.ToLower(v)
+		if _, ok := seen[key]; ok {
+			return nil, moerr.NewInvalidInput(ctx, "column has duplicated value '%s' in %s", v, strings.ToUpper(name))
+		}
+		seen[key] = struct{}{}
+		members[i] = v
+	}
+	return members, nil
+}
+
 func buildDefaultExpr(col *tree.ColumnTableDef, typ *plan.Type, proc *process.Process) (*plan.Default, error) {
 	nullAbility := true
 	var expr tree.Expr = nil
@@ -388,7 +453,8 @@ func getAccountInfoOfClusterTable(ctx CompilerContext, accounts tree.IdentifierL
 }
 
 func getDefaultExpr(ctx context.Context, d *plan.ColDef) (*Expr, error) {
-	if !d.Default.NullAbility && d.Default.Expr == nil && !d.Typ.AutoIncr {
+	// the generated columns are computed when writing, see colexec.FillGeneratedColumns
+	if !d.Default.NullAbility && d.Default.Expr == nil && !d.Typ.AutoIncr && d.Default.Generated == nil {
 		return nil, moerr.NewInvalidInput(ctx, "invalid default value")
 	}
 	if d.Default.Expr == nil {
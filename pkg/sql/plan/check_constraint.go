@@ -0,0 +1,195 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// checkConstraintDef is a CHECK constraint of a CREATE TABLE statement, col is
+// the name of the column for a column constraint, or empty for a table one.
+type checkConstraintDef struct {
+	name     string
+	col      string
+	expr     tree.Expr
+	enforced bool
+}
+
+// NewCheckBinder returns the binder of the expression of the check constraint
+// named name of tableDef, col is the column of a column check constraint.
+func NewCheckBinder(builder *QueryBuilder, ctx *BindContext, tableDef *TableDef, name string, col string) *CheckBinder {
+	b := &CheckBinder{
+		tableDef: tableDef,
+		name:     name,
+		col:      col,
+	}
+	b.sysCtx = builder.GetContext()
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+	return b
+}
+
+func (b *CheckBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	switch astExpr.(type) {
+	case *tree.VarExpr, *tree.ParamExpr:
+		return nil, moerr.NewCheckConstraintFunctionNotAllowed(b.GetContext(), b.name)
+	}
+	return b.baseBindExpr(astExpr, depth, isRoot)
+}
+
+func (b *CheckBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
+	name := astExpr.Parts[0]
+	if b.col != "" && name != b.col {
+		return nil, moerr.NewColumnCheckConstraintReferencesOtherColumn(b.GetContext(), b.name)
+	}
+	for _, col := range b.tableDef.Cols {
+		if col.Name == name && col.Typ.AutoIncr {
+			return nil, moerr.NewCheckConstraintRefersAutoIncrementColumn(b.GetContext(), b.name)
+		}
+	}
+
+	expr, err := b.baseBindColRef(astExpr, depth, isRoot)
+	if err != nil {
+		return nil, err
+	}
+	// the virtual column is already replaced by its expression, whose columns
+	// have their names
+	if colRef := expr.GetCol(); colRef != nil {
+		colRef.Name = b.tableDef.Cols[colRef.ColPos].Name
+	}
+	return expr, nil
+}
+
+func (b *CheckBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewCheckConstraintFunctionNotAllowed(b.GetContext(), b.name)
+}
+
+func (b *CheckBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewCheckConstraintFunctionNotAllowed(b.GetContext(), b.name)
+}
+
+func (b *CheckBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewCheckConstraintFunctionNotAllowed(b.GetContext(), b.name)
+}
+
+// buildCheckConstraints binds the check constraints of the table and appends
+// them to tableDef. The anonymous constraints are named [TABLE_NAME]_chk_[N]
+// like MySQL, N is the position of the constraint in the table.
+func buildCheckConstraints(ctx CompilerContext, tableDef *TableDef, defs []checkConstraintDef) error {
+	if len(defs) == 0 {
+		return nil
+	}
+
+	names := make(map[string]bool, len(defs))
+	for _, def := range defs {
+		if def.name == "" {
+			continue
+		}
+		if names[def.name] {
+			return moerr.NewCheckConstraintDupName(ctx.GetContext(), def.name)
+		}
+		names[def.name] = true
+	}
+	n := 0
+	for i := range defs {
+		if defs[i].name != "" {
+			continue
+		}
+		for {
+			n++
+			name := fmt.Sprintf("%s_chk_%d", tableDef.Name, n)
+			if !names[name] {
+				defs[i].name = name
+				names[name] = true
+				break
+			}
+		}
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return err
+	}
+
+	for _, def := range defs {
+		binder := NewCheckBinder(builder, bindContext, tableDef, def.name, def.col)
+		expr, err := binder.BindExpr(def.expr, 0, true)
+		if err != nil {
+			return err
+		}
+		if err = checkCheckConstraintFunctions(ctx, def.name, expr); err != nil {
+			return err
+		}
+		if expr.Typ.Id != int32(types.T_bool) {
+			expr, err = makePlan2CastExpr(ctx.GetContext(), expr, &plan.Type{Id: int32(types.T_bool)})
+			if err != nil {
+				return err
+			}
+		}
+
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+		def.expr.Format(fmtCtx)
+		tableDef.Checks = append(tableDef.Checks, &plan.CheckDef{
+			Name:         def.name,
+			Check:        expr,
+			OriginString: fmtCtx.String(),
+			Enforced:     def.enforced,
+		})
+	}
+	return nil
+}
+
+// checkCheckConstraintFunctions checks that the expression of a check
+// constraint is deterministic.
+func checkCheckConstraintFunctions(ctx CompilerContext, name string, expr *Expr) error {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		f, err := function.GetFunctionByID(ctx.GetContext(), exprImpl.F.Func.GetObj())
+		if err != nil {
+			return err
+		}
+		if f.Volatile || f.RealTimeRelated {
+			return moerr.NewCheckConstraintFunctionNotAllowed(ctx.GetContext(), name)
+		}
+		for _, arg := range exprImpl.F.Args {
+			if err = checkCheckConstraintFunctions(ctx, name, arg); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		for _, e := range exprImpl.List.List {
+			if err := checkCheckConstraintFunctions(ctx, name, e); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_V, *plan.Expr_P, *plan.Expr_Sub:
+		return moerr.NewCheckConstraintFunctionNotAllowed(ctx.GetContext(), name)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCheckConstraints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	logicPlan, err := runOneStmt(mock, t, `create table chk_t (
+		a int check (a > 0),
+		b int,
+		c int as (b * 2) virtual,
+		constraint b_chk check (b < a) not enforced,
+		check (c),
+		check (a + c < 100))`)
+	require.NoError(t, err)
+	checks := logicPlan.GetDdl().GetCreateTable().TableDef.Checks
+	require.Equal(t, 4, len(checks))
+
+	require.Equal(t, "chk_t_chk_1", checks[0].Name)
+	require.Equal(t, "a > 0", checks[0].OriginString)
+	require.True(t, checks[0].Enforced)
+	require.Equal(t, []string{"a"}, colRefNames(checks[0].Check, nil))
+
+	require.Equal(t, "b_chk", checks[1].Name)
+	require.False(t, checks[1].Enforced)
+
+	// the expression is casted to bool
+	require.Equal(t, "chk_t_chk_2", checks[2].Name)
+	require.Equal(t, int32(types.T_bool), checks[2].Check.Typ.Id)
+	// the virtual column is replaced by its expression
+	require.Equal(t, []string{"a", "b"}, colRefNames(checks[3].Check, nil))
+
+	require.Equal(t, checks[3], DeepCopyTableDef(logicPlan.GetDdl().GetCreateTable().TableDef).Checks[3])
+}
+
+func TestBuildCheckConstraintsError(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	tests := []struct {
+		sql  string
+		code uint16
+	}{
+		{"create table t (a int check (b > 0), b int)", moerr.ErrColumnCheckConstraintReferencesOtherColumn},
+		{"create table t (a int auto_increment, check (a > 0))", moerr.ErrCheckConstraintRefersAutoIncrementColumn},
+		{"create table t (a varchar(36), check (a != uuid()))", moerr.ErrCheckConstraintFunctionNotAllowed},
+		{"create table t (a datetime, check (a > now()))", moerr.ErrCheckConstraintFunctionNotAllowed},
+		{"create table t (a int, check (a > @v))", moerr.ErrCheckConstraintFunctionNotAllowed},
+		{"create table t (a int, check (a > (select 1)))", moerr.ErrCheckConstraintFunctionNotAllowed},
+		{"create table t (a int, constraint c check (a > 0), constraint c check (a < 10))", moerr.ErrCheckConstraintDupName},
+	}
+	for _, test := range tests {
+		_, err := runOneStmt(mock, t, test.sql)
+		require.True(t, moerr.IsMoErrCode(err, test.code), "sql: %s, err: %v", test.sql, err)
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// HistogramBucketNum is the number of the buckets of the histograms built by ANALYZE TABLE
+const HistogramBucketNum = 100
+
+// HistogramSampleRows is the number of the rows sampled to build a histogram, the
+// histograms are built by the samples, so ANALYZE TABLE never holds the whole table
+const HistogramSampleRows = 30000
+
+// HistogramBucket holds the non-null values of a column between Lower and Upper
+type HistogramBucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count float64 `json:"count"`
+	Ndv   float64 `json:"ndv"`
+}
+
+// Histogram is an equi-depth histogram, the buckets are sorted by the values and each of
+// them holds about the same number of rows. So the frequent values get narrow buckets, which
+// makes the estimation much better than min/max on skewed data.
+type Histogram struct {
+	Buckets []HistogramBucket `json:"buckets"`
+}
+
+// ColumnStats is the statistics of a column collected by ANALYZE TABLE
+type ColumnStats struct {
+	RowCount float64
+	NullFrac float64
+	// the ndv is estimated by HyperLogLog
+	Ndv float64
+	// only the numeric columns have histograms
+	Histogram *Histogram
+}
+
+// CanBuildHistogram returns true if the values of the type can be put into a histogram,
+// which needs them to be converted to float64 and keep the order.
+func CanBuildHistogram(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64:
+		return true
+	}
+	return false
+}
+
+func (h *Histogram) rowCount() float64 {
+	var cnt float64
+	for _, bucket := range h.Buckets {
+		cnt += bucket.Count
+	}
+	return cnt
+}
+
+// equalFrac returns the fraction of the values equal to val,
+// the values of a bucket are assumed to be evenly distributed.
+func (h *Histogram) equalFrac(val float64) float64 {
+	total := h.rowCount()
+	if total == 0 {
+		return 0
+	}
+	for _, bucket := range h.Buckets {
+		if val < bucket.Lower {
+			break
+		}
+		if val <= bucket.Upper {
+			return bucket.Count / math.Max(bucket.Ndv, 1) / total
+		}
+	}
+	return 0
+}
+
+// lessFrac returns the fraction of the values less than val, or not greater than val if inclusive.
+func (h *Histogram) lessFrac(val float64, inclusive bool) float64 {
+	total := h.rowCount()
+	if total == 0 {
+		return 0
+	}
+	var cnt float64
+	for _, bucket := range h.Buckets {
+		if val > bucket.Upper {
+			cnt += bucket.Count
+			continue
+		}
+		if val < bucket.Lower {
+			break
+		}
+		if bucket.Upper > bucket.Lower {
+			cnt += bucket.Count * (val - bucket.Lower) / (bucket.Upper - bucket.Lower)
+		}
+		if inclusive {
+			cnt += bucket.Count / math.Max(bucket.Ndv, 1)
+		}
+		break
+	}
+	return math.Min(cnt/total, 1)
+}
+
+// SetColumnStats replaces the column statistics with the ones loaded from mo_catalog.
+func (sc *StatsInfoMap) SetColumnStats(columnStats map[string]*ColumnStats) {
+	sc.ColumnStatsMap = columnStats
+}
+
+// BuildHistogram builds the equi-depth histogram from the sampled non-null values of a column,
+// the values are sorted in place. The counts of the buckets are scaled up to the non-null rows
+// of the column, and the ndvs of them are scaled up by the ndv of the column.
+func BuildHistogram(values []float64, nonNullCnt, ndv float64) *Histogram {
+	histogram := &Histogram{}
+	if len(values) == 0 {
+		return histogram
+	}
+	sort.Float64s(values)
+
+	sampleNdv := 1
+	for i := 1; i < len(values); i++ {
+		if values[i] != values[i-1] {
+			sampleNdv++
+		}
+	}
+	countScale := nonNullCnt / float64(len(values))
+	ndvScale := math.Max(ndv/float64(sampleNdv), 1)
+
+	depth := (len(values) + HistogramBucketNum - 1) / HistogramBucketNum
+	for start := 0; start < len(values); {
+		end := start + depth
+		if end > len(values) {
+			end = len(values)
+		}
+		// the same values are always in the same bucket
+		for end < len(values) && values[end] == values[end-1] {
+			end++
+		}
+		// the values sampled more than once are likely all the values around them, while
+		// the ones sampled once stand for the values not sampled
+		var repeated, singletons float64
+		for i := start; i < end; {
+			j := i + 1
+			for j < end && values[j] == values[i] {
+				j++
+			}
+			if j-i == 1 {
+				singletons++
+			} else {
+				repeated++
+			}
+			i = j
+		}
+		count := float64(end-start) * countScale
+		histogram.Buckets = append(histogram.Buckets, HistogramBucket{
+			Lower: values[start],
+			Upper: values[end-1],
+			Count: count,
+			Ndv:   math.Min(repeated+singletons*ndvScale, count),
+		})
+		start = end
+	}
+	return histogram
+}
+
+// estimateOutCntByColumnStats estimates the output lines by the statistics collected by
+// ANALYZE TABLE, it returns false if the column of the filter was not analyzed.
+func estimateOutCntByColumnStats(expr *plan.Expr, funcName string, tableCnt float64, s *StatsInfoMap) (float64, bool) {
+	if s == nil || len(s.ColumnStatsMap) == 0 {
+		return 0, false
+	}
+
+	switch funcName {
+	case "isnull", "isnotnull":
+		col, ok := expr.Expr.(*plan.Expr_F).F.Args[0].Expr.(*plan.Expr_Col)
+		if !ok {
+			return 0, false
+		}
+		cs := s.ColumnStatsMap[col.Col.Name]
+		if cs == nil {
+			return 0, false
+		}
+		if funcName == "isnull" {
+			return tableCnt * cs.NullFrac, true
+		}
+		return tableCnt * (1 - cs.NullFrac), true
+	}
+
+	ok, col, constExpr, _ := CheckStrictFilter(expr)
+	if !ok {
+		return 0, false
+	}
+	cs := s.ColumnStatsMap[col.Name]
+	if cs == nil {
+		return 0, false
+	}
+	nonNullCnt := tableCnt * (1 - cs.NullFrac)
+
+	val, isNumeric := constToFloat64(constExpr)
+	if cs.Histogram == nil || !isNumeric {
+		if funcName == "=" && cs.Ndv > 0 {
+			return nonNullCnt / cs.Ndv, true
+		}
+		return 0, false
+	}
+
+	switch funcName {
+	case "=":
+		return nonNullCnt * cs.Histogram.equalFrac(val), true
+	case "<":
+		return nonNullCnt * cs.Histogram.lessFrac(val, false), true
+	case "<=":
+		return nonNullCnt * cs.Histogram.lessFrac(val, true), true
+	case ">":
+		return nonNullCnt * (1 - cs.Histogram.lessFrac(val, true)), true
+	case ">=":
+		return nonNullCnt * (1 - cs.Histogram.lessFrac(val, false)), true
+	}
+	return 0, false
+}
+
+func constToFloat64(c *plan.Const) (float64, bool) {
+	switch v := c.Value.(type) {
+	case *plan.Const_I8Val:
+		return float64(v.I8Val), true
+	case *plan.Const_I16Val:
+		return float64(v.I16Val), true
+	case *plan.Const_I32Val:
+		return float64(v.I32Val), true
+	case *plan.Const_I64Val:
+		return float64(v.I64Val), true
+	case *plan.Const_U8Val:
+		return float64(v.U8Val), true
+	case *plan.Const_U16Val:
+		return float64(v.U16Val), true
+	case *plan.Const_U32Val:
+		return float64(v.U32Val), true
+	case *plan.Const_U64Val:
+		return float64(v.U64Val), true
+	case *plan.Const_Fval:
+		return float64(v.Fval), true
+	case *plan.Const_Dval:
+		return v.Dval, true
+	}
+	return 0, false
+}
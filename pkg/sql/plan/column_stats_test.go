@@ -0,0 +1,115 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func makeColumnStatsFilter(funcName string, val int64) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{ObjName: funcName},
+				Args: []*plan.Expr{
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "a"}},
+					},
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: val}}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEstimateOutCntByColumnStats(t *testing.T) {
+	s := NewStatsInfoMap()
+	// 60% of the rows are 1, the others are spread over [2, 10]
+	s.SetColumnStats(map[string]*ColumnStats{
+		"a": {
+			RowCount: 100,
+			NullFrac: 0.2,
+			Ndv:      4,
+			Histogram: &Histogram{
+				Buckets: []HistogramBucket{
+					{Lower: 1, Upper: 1, Count: 60, Ndv: 1},
+					{Lower: 2, Upper: 10, Count: 20, Ndv: 3},
+				},
+			},
+		},
+	})
+
+	cases := []struct {
+		funcName string
+		val      int64
+		outcnt   float64
+	}{
+		{"=", 1, 600},
+		{"=", 6, 66.66666666666667},
+		{"=", 20, 1},
+		{"<", 2, 600},
+		{"<=", 1, 600},
+		{">", 1, 200},
+		{">=", 6, 100},
+		{">", 10, 1},
+	}
+	for _, c := range cases {
+		outcnt := EstimateOutCnt(makeColumnStatsFilter(c.funcName, c.val), "", 1000, 1000, s)
+		require.InDelta(t, c.outcnt, outcnt, 0.001, "%s %d", c.funcName, c.val)
+	}
+
+	isNull := &plan.Expr{
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{ObjName: "isnull"},
+				Args: []*plan.Expr{{Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "a"}}}},
+			},
+		},
+	}
+	require.Equal(t, float64(200), EstimateOutCnt(isNull, "", 1000, 1000, s))
+}
+
+func TestBuildHistogram(t *testing.T) {
+	require.Empty(t, BuildHistogram(nil, 0, 0).Buckets)
+
+	// 60% of the sampled values are 1, the others are spread over [2, 1001]
+	values := make([]float64, 0, 2500)
+	for i := 0; i < 1000; i++ {
+		values = append(values, float64(1000-i+1))
+	}
+	for i := 0; i < 1500; i++ {
+		values = append(values, 1)
+	}
+	histogram := BuildHistogram(values, 25000, 2002)
+
+	// the value 1 is in one bucket, though it is deeper than the others
+	require.Equal(t, HistogramBucket{Lower: 1, Upper: 1, Count: 15000, Ndv: 1}, histogram.Buckets[0])
+	require.Equal(t, float64(25000), histogram.rowCount())
+	for i, bucket := range histogram.Buckets[1:] {
+		require.Less(t, histogram.Buckets[i].Upper, bucket.Lower)
+		require.Equal(t, float64(250), bucket.Count)
+		// the ndv of the column is twice of the sampled one
+		require.Equal(t, float64(50), bucket.Ndv)
+	}
+	require.InDelta(t, 0.6, histogram.equalFrac(1), 0.001)
+}
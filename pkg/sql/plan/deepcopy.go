@@ -30,6 +30,17 @@ func DeepCopyExprList(list []*Expr) []*Expr {
 	return newList
 }
 
+func DeepCopyFrameBound(bound *plan.FrameBound) *plan.FrameBound {
+	if bound == nil {
+		return nil
+	}
+	return &plan.FrameBound{
+		Type:      bound.Type,
+		Unbounded: bound.Unbounded,
+		Val:       DeepCopyExpr(bound.Val),
+	}
+}
+
 func DeepCopyOrderBy(orderBy *plan.OrderBySpec) *plan.OrderBySpec {
 	if orderBy == nil {
 		return nil
@@ -284,31 +295,33 @@ func DeepCopyUpdateCtx(ctx *plan.UpdateCtx) *plan.UpdateCtx {
 
 func DeepCopyNode(node *plan.Node) *plan.Node {
 	newNode := &Node{
-		NodeType:        node.NodeType,
-		NodeId:          node.NodeId,
-		ExtraOptions:    node.ExtraOptions,
-		Children:        make([]int32, len(node.Children)),
-		JoinType:        node.JoinType,
-		BuildOnLeft:     node.BuildOnLeft,
-		BindingTags:     make([]int32, len(node.BindingTags)),
-		Limit:           DeepCopyExpr(node.Limit),
-		Offset:          DeepCopyExpr(node.Offset),
-		ProjectList:     make([]*plan.Expr, len(node.ProjectList)),
-		OnList:          make([]*plan.Expr, len(node.OnList)),
-		FilterList:      make([]*plan.Expr, len(node.FilterList)),
-		GroupBy:         make([]*plan.Expr, len(node.GroupBy)),
-		GroupingSet:     make([]*plan.Expr, len(node.GroupingSet)),
-		AggList:         make([]*plan.Expr, len(node.AggList)),
-		OrderBy:         make([]*plan.OrderBySpec, len(node.OrderBy)),
-		DeleteCtx:       DeepCopyDeleteCtx(node.DeleteCtx),
-		UpdateCtx:       DeepCopyUpdateCtx(node.UpdateCtx),
-		TableDefVec:     make([]*plan.TableDef, len(node.TableDefVec)),
-		TblFuncExprList: make([]*plan.Expr, len(node.TblFuncExprList)),
-		ClusterTable:    DeepCopyClusterTable(node.GetClusterTable()),
-		InsertCtx:       DeepCopyInsertCtx(node.InsertCtx),
-		NotCacheable:    node.NotCacheable,
-		CurrentStep:     node.CurrentStep,
-		SourceStep:      node.SourceStep,
+		NodeType:          node.NodeType,
+		NodeId:            node.NodeId,
+		ExtraOptions:      node.ExtraOptions,
+		Children:          make([]int32, len(node.Children)),
+		JoinType:          node.JoinType,
+		BuildOnLeft:       node.BuildOnLeft,
+		BindingTags:       make([]int32, len(node.BindingTags)),
+		Limit:             DeepCopyExpr(node.Limit),
+		Offset:            DeepCopyExpr(node.Offset),
+		ProjectList:       make([]*plan.Expr, len(node.ProjectList)),
+		OnList:            make([]*plan.Expr, len(node.OnList)),
+		FilterList:        make([]*plan.Expr, len(node.FilterList)),
+		GroupBy:           make([]*plan.Expr, len(node.GroupBy)),
+		GroupingSet:       make([]*plan.Expr, len(node.GroupingSet)),
+		AggList:           make([]*plan.Expr, len(node.AggList)),
+		OrderBy:           make([]*plan.OrderBySpec, len(node.OrderBy)),
+		DeleteCtx:         DeepCopyDeleteCtx(node.DeleteCtx),
+		UpdateCtx:         DeepCopyUpdateCtx(node.UpdateCtx),
+		TableDefVec:       make([]*plan.TableDef, len(node.TableDefVec)),
+		TblFuncExprList:   make([]*plan.Expr, len(node.TblFuncExprList)),
+		ClusterTable:      DeepCopyClusterTable(node.GetClusterTable()),
+		InsertCtx:         DeepCopyInsertCtx(node.InsertCtx),
+		NotCacheable:      node.NotCacheable,
+		CurrentStep:       node.CurrentStep,
+		SourceStep:        node.SourceStep,
+		UnionAll:          node.UnionAll,
+		MaxRecursionDepth: node.MaxRecursionDepth,
 	}
 
 	copy(newNode.Children, node.Children)
@@ -371,6 +384,13 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		for idx, orderBy := range node.WinSpec.OrderBy {
 			newNode.WinSpec.OrderBy[idx] = DeepCopyOrderBy(orderBy)
 		}
+		if node.WinSpec.Frame != nil {
+			newNode.WinSpec.Frame = &plan.FrameClause{
+				Type:  node.WinSpec.Frame.Type,
+				Start: DeepCopyFrameBound(node.WinSpec.Frame.Start),
+				End:   DeepCopyFrameBound(node.WinSpec.Frame.End),
+			}
+		}
 	}
 
 	if node.TableDef != nil {
@@ -390,9 +410,57 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		newNode.TblFuncExprList[idx] = DeepCopyExpr(expr)
 	}
 
+	if node.PartitionPrune != nil {
+		newNode.PartitionPrune = &plan.PartitionPrune{
+			IsPruned:           node.PartitionPrune.IsPruned,
+			SelectedPartitions: make([]*plan.PartitionItem, len(node.PartitionPrune.SelectedPartitions)),
+		}
+		for i, e := range node.PartitionPrune.SelectedPartitions {
+			newNode.PartitionPrune.SelectedPartitions[i] = DeepCopyPartitionItem(e)
+		}
+	}
+
+	if node.SnapshotTs != nil {
+		ts := *node.SnapshotTs
+		newNode.SnapshotTs = &ts
+	}
+
+	if node.IndexLookup != nil {
+		newNode.IndexLookup = &plan.IndexLookup{
+			ObjRef:     DeepCopyObjectRef(node.IndexLookup.ObjRef),
+			TableDef:   DeepCopyTableDef(node.IndexLookup.TableDef),
+			FilterList: make([]*plan.Expr, len(node.IndexLookup.FilterList)),
+			Pk:         DeepCopyExpr(node.IndexLookup.Pk),
+		}
+		for i, e := range node.IndexLookup.FilterList {
+			newNode.IndexLookup.FilterList[i] = DeepCopyExpr(e)
+		}
+		if node.IndexLookup.Ivfflat != nil {
+			newNode.IndexLookup.Ivfflat = &plan.IvfflatLookup{
+				Distance: DeepCopyExpr(node.IndexLookup.Ivfflat.Distance),
+				Probes:   node.IndexLookup.Ivfflat.Probes,
+			}
+		}
+	}
+
 	return newNode
 }
 
+func DeepCopyPartitionItem(e *plan.PartitionItem) *plan.PartitionItem {
+	if e == nil {
+		return nil
+	}
+	return &plan.PartitionItem{
+		PartitionName:      e.PartitionName,
+		OrdinalPosition:    e.OrdinalPosition,
+		Description:        e.Description,
+		Comment:            e.Comment,
+		LessThan:           DeepCopyExprList(e.LessThan),
+		InValues:           DeepCopyExprList(e.InValues),
+		PartitionTableName: e.PartitionTableName,
+	}
+}
+
 func DeepCopyDefault(def *plan.Default) *plan.Default {
 	if def == nil {
 		return nil
@@ -401,6 +469,18 @@ func DeepCopyDefault(def *plan.Default) *plan.Default {
 		NullAbility:  def.NullAbility,
 		Expr:         DeepCopyExpr(def.Expr),
 		OriginString: def.OriginString,
+		Generated:    DeepCopyGeneratedCol(def.Generated),
+	}
+}
+
+func DeepCopyGeneratedCol(gen *plan.GeneratedCol) *plan.GeneratedCol {
+	if gen == nil {
+		return nil
+	}
+	return &plan.GeneratedCol{
+		Expr:         DeepCopyExpr(gen.Expr),
+		OriginString: gen.OriginString,
+		Stored:       gen.Stored,
 	}
 }
 
@@ -414,6 +494,7 @@ func DeepCopyType(typ *plan.Type) *plan.Type {
 		Width:       typ.Width,
 		Scale:       typ.Scale,
 		AutoIncr:    typ.AutoIncr,
+		Enumvalues:  typ.Enumvalues,
 	}
 }
 
@@ -462,6 +543,8 @@ func DeepCopyIndexDef(indexDef *plan.IndexDef) *plan.IndexDef {
 		TableExist:     indexDef.TableExist,
 		IndexTableName: indexDef.IndexTableName,
 		Comment:        indexDef.Comment,
+		IndexAlgo:      indexDef.IndexAlgo,
+		IndexAlgoLists: indexDef.IndexAlgoLists,
 	}
 
 	newParts := make([]string, len(indexDef.Parts))
@@ -504,6 +587,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		Name2ColIndex: table.Name2ColIndex,
 		Indexes:       make([]*IndexDef, len(table.Indexes)),
 		Fkeys:         make([]*plan.ForeignKeyDef, len(table.Fkeys)),
+		Checks:        make([]*plan.CheckDef, len(table.Checks)),
 	}
 
 	for idx, col := range table.Cols {
@@ -514,6 +598,10 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		newTable.Fkeys[idx] = DeepCopyFkey(fkey)
 	}
 
+	for idx, check := range table.Checks {
+		newTable.Checks[idx] = DeepCopyCheck(check)
+	}
+
 	if table.TblFunc != nil {
 		newTable.TblFunc = &plan.TableFunction{
 			Name:  table.TblFunc.Name,
@@ -568,15 +656,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		}
 
 		for i, e := range table.Partition.Partitions {
-			partitionDef.Partitions[i] = &plan.PartitionItem{
-				PartitionName:      e.PartitionName,
-				OrdinalPosition:    e.OrdinalPosition,
-				Description:        e.Description,
-				Comment:            e.Comment,
-				LessThan:           DeepCopyExprList(e.LessThan),
-				InValues:           DeepCopyExprList(e.InValues),
-				PartitionTableName: e.PartitionTableName,
-			}
+			partitionDef.Partitions[i] = DeepCopyPartitionItem(e)
 		}
 		newTable.Partition = partitionDef
 	}
@@ -842,6 +922,18 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 	return newDf
 }
 
+func DeepCopyCheck(check *plan.CheckDef) *plan.CheckDef {
+	if check == nil {
+		return nil
+	}
+	return &plan.CheckDef{
+		Name:         check.Name,
+		Check:        DeepCopyExpr(check.Check),
+		OriginString: check.OriginString,
+		Enforced:     check.Enforced,
+	}
+}
+
 func DeepCopyFkey(fkey *ForeignKeyDef) *ForeignKeyDef {
 	def := &ForeignKeyDef{
 		Name:        fkey.Name,
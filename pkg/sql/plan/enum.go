@@ -0,0 +1,154 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+/*
+ENUM and SET columns keep the index (the bitmap for SET) of the members, the
+members are only known by the plan (plan.Type.Enumvalues). So the plan adds
+the conversion between the values and the storage:
+
+ 1. cast_value_to_enum / cast_value_to_set when the values are written into
+    the column or cast to the column type.
+ 2. cast_enum_to_value / cast_set_to_value when the column is used as a
+    string, e.g. compared with a string, passed to a string function or
+    returned to the client.
+
+In the numeric context, e.g. compared with a number or arithmetic, the index
+is used like MySQL.
+*/
+
+func isEnumType(typ *plan.Type) bool {
+	if typ == nil || typ.Enumvalues == "" {
+		return false
+	}
+	return typ.Id == int32(types.T_enum) || typ.Id == int32(types.T_set)
+}
+
+func isNumericType(typ *plan.Type) bool {
+	t := types.T(typ.Id)
+	return t.IsInteger() || t.IsFloat() || t.IsDecimal() || t == types.T_bool ||
+		t == types.T_bit || t == types.T_year
+}
+
+// appendCastFromEnum converts the index of ENUM or the bitmap of SET to the members.
+func appendCastFromEnum(ctx context.Context, expr *Expr) (*Expr, error) {
+	name := "cast_enum_to_value"
+	if expr.Typ.Id == int32(types.T_set) {
+		name = "cast_set_to_value"
+	}
+	e, err := bindFuncExprImplByPlanExpr(ctx, name, []*Expr{expr, makePlan2StringConstExprWithType(expr.Typ.Enumvalues)})
+	if err != nil {
+		return nil, err
+	}
+	e.Typ.Width = types.MaxVarcharLen
+	return e, nil
+}
+
+// appendCastToEnum converts the values to the index of ENUM or the bitmap of SET.
+func appendCastToEnum(ctx context.Context, expr *Expr, targetType *Type) (*Expr, error) {
+	if isEnumType(expr.Typ) {
+		if expr.Typ.Id == targetType.Id && expr.Typ.Enumvalues == targetType.Enumvalues {
+			return expr, nil
+		}
+		// the members are different, convert it by the value
+		var err error
+		if expr, err = appendCastFromEnum(ctx, expr); err != nil {
+			return nil, err
+		}
+	}
+	name := "cast_value_to_enum"
+	if targetType.Id == int32(types.T_set) {
+		name = "cast_value_to_set"
+	}
+	e, err := bindFuncExprImplByPlanExpr(ctx, name, []*Expr{expr, makePlan2StringConstExprWithType(targetType.Enumvalues)})
+	if err != nil {
+		return nil, err
+	}
+	typ := DeepCopyType(targetType)
+	typ.NotNullable = e.Typ.NotNullable
+	e.Typ = typ
+	return e, nil
+}
+
+// castEnumExpr adds the conversion if the cast is from or to ENUM and SET,
+// it returns true if the cast is done.
+func castEnumExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, bool, error) {
+	if isEnumType(targetType) {
+		e, err := appendCastToEnum(ctx, expr, targetType)
+		return e, true, err
+	}
+	if isEnumType(expr.Typ) && !isNumericType(targetType) {
+		e, err := appendCastFromEnum(ctx, expr)
+		return e, false, err
+	}
+	return expr, false, nil
+}
+
+// resetEnumBitYearArgs resets the arguments of the function. ENUM and SET are
+// converted to the members unless they are used with numbers, BIT and YEAR are
+// used as integers.
+func resetEnumBitYearArgs(ctx context.Context, name string, args []*Expr) ([]*Expr, error) {
+	switch name {
+	case "cast_value_to_enum", "cast_enum_to_value", "cast_value_to_set", "cast_set_to_value":
+		return args, nil
+	}
+	var err error
+	for i, arg := range args {
+		switch types.T(arg.Typ.Id) {
+		case types.T_enum, types.T_set:
+			numeric := false
+			for j := range args {
+				if j != i && isNumericType(args[j].Typ) {
+					numeric = true
+				}
+			}
+			if !numeric && isEnumType(arg.Typ) {
+				args[i], err = appendCastFromEnum(ctx, arg)
+			} else {
+				args[i], err = appendCastBeforeExpr(ctx, arg, &plan.Type{Id: int32(types.T_uint64)})
+			}
+		case types.T_bit:
+			args[i], err = appendCastBeforeExpr(ctx, arg, &plan.Type{Id: int32(types.T_uint64)})
+		case types.T_year:
+			args[i], err = appendCastBeforeExpr(ctx, arg, &plan.Type{Id: int32(types.T_int64)})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+// resetEnumProjection converts ENUM and SET to the members before the results
+// are returned to the client.
+func resetEnumProjection(ctx context.Context, exprs []*Expr) error {
+	for i, e := range exprs {
+		if isEnumType(e.Typ) {
+			ne, err := appendCastFromEnum(ctx, e)
+			if err != nil {
+				return err
+			}
+			exprs[i] = ne
+		}
+	}
+	return nil
+}
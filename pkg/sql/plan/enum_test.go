@@ -0,0 +1,73 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableWithEnum(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	p, err := runOneStmt(mock, t, "create table t1(a enum('x ', 'y''z'), b set('p', 'q'), c bit(10), d year)")
+	require.NoError(t, err)
+	cols := p.GetDdl().GetCreateTable().TableDef.Cols
+
+	require.Equal(t, int32(types.T_enum), cols[0].Typ.Id)
+	require.Equal(t, "'x','y''z'", cols[0].Typ.Enumvalues)
+	require.Equal(t, int32(types.T_set), cols[1].Typ.Id)
+	require.Equal(t, "'p','q'", cols[1].Typ.Enumvalues)
+	require.Equal(t, int32(types.T_bit), cols[2].Typ.Id)
+	require.Equal(t, int32(10), cols[2].Typ.Width)
+	require.Equal(t, int32(types.T_year), cols[3].Typ.Id)
+}
+
+func TestCastEnumExpr(t *testing.T) {
+	ctx := context.TODO()
+	enumType := &plan.Type{Id: int32(types.T_enum), Enumvalues: "'a','b'"}
+	col := &Expr{
+		Typ:  DeepCopyType(enumType),
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{Name: "a"}},
+	}
+
+	// the same members, nothing to do
+	e, done, err := castEnumExpr(ctx, col, enumType)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, col, e)
+
+	// string to enum
+	e, done, err = castEnumExpr(ctx, makePlan2StringConstExprWithType("b"), enumType)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, "cast_value_to_enum", e.GetF().Func.ObjName)
+	require.Equal(t, enumType.Enumvalues, e.Typ.Enumvalues)
+
+	// enum to string
+	e, done, err = castEnumExpr(ctx, col, &plan.Type{Id: int32(types.T_varchar)})
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, "cast_enum_to_value", e.GetF().Func.ObjName)
+
+	// enum to number, use the index
+	e, done, err = castEnumExpr(ctx, col, &plan.Type{Id: int32(types.T_int64)})
+	require.NoError(t, err)
+	require.False(t, done)
+	require.Equal(t, col, e)
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// explainJson writes the plan in the same model as BuildJsonPlan, the whole
+// document is a single line of the buffer without any line break. The uuid is
+// always the nil uuid so that the output of the same plan never changes.
+func (e *ExplainQueryImpl) explainJson(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	data, err := e.buildExplainData(ctx, uuid.Nil, options)
+	if err != nil {
+		return err
+	}
+	js, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	buffer.PushNewLine(string(js), true, 0)
+	return nil
+}
+
+// explainDot writes the plan as a Graphviz digraph. Unlike explainJson, the graph
+// has a line break after each statement, but the whole graph is pushed as one row
+// of the buffer, so it is returned as a single value. Each step of the plan is a
+// cluster of the graph, and the edges go from the children to their parents as
+// the data flows.
+func (e *ExplainQueryImpl) explainDot(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	data, err := e.buildExplainData(ctx, uuid.Nil, options)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("digraph plan {\n")
+	buf.WriteString("  node [shape=box, fontname=\"Courier\"];\n")
+	for _, step := range data.Steps {
+		indent := "  "
+		if len(data.Steps) > 1 {
+			fmt.Fprintf(&buf, "  subgraph cluster_%d {\n", step.Step)
+			fmt.Fprintf(&buf, "    label=\"Step %d\";\n", step.Step)
+			indent = "    "
+		}
+		for _, node := range step.GraphData.Nodes {
+			fmt.Fprintf(&buf, "%sn%s [label=\"%s\"];\n", indent, node.NodeId, dotNodeLabel(&node, options))
+		}
+		for _, edge := range step.GraphData.Edges {
+			fmt.Fprintf(&buf, "%sn%s -> n%s", indent, edge.Src, edge.Dst)
+			if options.Analyze {
+				fmt.Fprintf(&buf, " [label=\"%d rows\"]", edge.Output)
+			}
+			buf.WriteString(";\n")
+		}
+		if len(data.Steps) > 1 {
+			buf.WriteString("  }\n")
+		}
+	}
+	buf.WriteString("}")
+	buffer.PushNewLine(buf.String(), true, 0)
+	return nil
+}
+
+func (e *ExplainQueryImpl) buildExplainData(ctx context.Context, uuid uuid.UUID, options *ExplainOptions) (*ExplainData, error) {
+	// the titles and labels of the nodes are always described in text
+	textOptions := *options
+	textOptions.Format = EXPLAIN_FORMAT_TEXT
+
+	nodes := e.QueryPlan.Nodes
+	expdata := NewExplainData(uuid)
+	for index, rootNodeId := range e.QueryPlan.Steps {
+		graphData := NewGraphData()
+		if err := PreOrderPlan(ctx, nodes[rootNodeId], nodes, graphData, &textOptions); err != nil {
+			return nil, err
+		}
+		if err := graphData.StatisticsGlobalResource(ctx); err != nil {
+			return nil, err
+		}
+		step := NewStep(index)
+		step.GraphData = *graphData
+		expdata.Steps = append(expdata.Steps, *step)
+	}
+	return expdata, nil
+}
+
+// dotNodeLabel returns the label of a node, the lines are left justified.
+func dotNodeLabel(node *Node, options *ExplainOptions) string {
+	var lines []string
+
+	title := node.Name
+	if node.Title != "" {
+		title += " " + node.Title
+	}
+	lines = append(lines, title)
+	lines = append(lines, fmt.Sprintf("cost=%.2f rows=%.2f", node.Stats.Cost, node.Stats.Outcnt))
+	if options.Verbose {
+		for _, label := range node.Labels {
+			lines = append(lines, fmt.Sprintf("%s: %s", label.Name, dotLabelValue(label.Value)))
+		}
+	}
+	if options.Analyze {
+		for _, v := range node.Statistics.Time {
+			lines = append(lines, fmt.Sprintf("%s=%d%s", v.Name, v.Value, v.Unit))
+		}
+		for _, v := range node.Statistics.Throughput {
+			lines = append(lines, fmt.Sprintf("%s=%d", v.Name, v.Value))
+		}
+		for _, v := range node.Statistics.Memory {
+			lines = append(lines, fmt.Sprintf("%s=%d%s", v.Name, v.Value, v.Unit))
+		}
+	}
+
+	var buf strings.Builder
+	for _, line := range lines {
+		buf.WriteString(dotEscape(line))
+		buf.WriteString("\\l")
+	}
+	return buf.String()
+}
+
+func dotLabelValue(value any) string {
+	switch v := value.(type) {
+	case []string:
+		return strings.Join(v, ", ")
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func dotEscape(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "\"", "\\\"")
+	s = strings.ReplaceAll(s, "\n", "\\l")
+	return s
+}
@@ -17,6 +17,7 @@ package explain
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -254,7 +255,7 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 	}
 
 	// Get Aggregate function info
-	if len(ndesc.Node.AggList) > 0 {
+	if len(ndesc.Node.AggList) > 0 && ndesc.Node.NodeType != plan.Node_WINDOW {
 		aggListInfo, err := ndesc.GetAggregationInfo(ctx, options)
 		if err != nil {
 			return nil, err
@@ -262,6 +263,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, aggListInfo)
 	}
 
+	// Get Window function info
+	if ndesc.Node.NodeType == plan.Node_WINDOW {
+		windowInfo, err := ndesc.GetWindowInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, windowInfo...)
+	}
+
 	// Get Filter list info
 	if len(ndesc.Node.FilterList) > 0 {
 		filterInfo, err := ndesc.GetFilterConditionInfo(ctx, options)
@@ -271,6 +281,21 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, filterInfo)
 	}
 
+	// Get partition prune info
+	if ndesc.Node.PartitionPrune != nil && ndesc.Node.PartitionPrune.IsPruned {
+		lines = append(lines, ndesc.GetPartitionPruneInfo(ctx, options))
+	}
+
+	// Get snapshot info of the table read at a past timestamp
+	if ndesc.Node.SnapshotTs != nil {
+		lines = append(lines, "Snapshot: "+ndesc.Node.SnapshotTs.DebugString())
+	}
+
+	// Get the index table looked up for the primary keys to read
+	if ndesc.Node.IndexLookup != nil {
+		lines = append(lines, "Index Lookup: "+ndesc.Node.IndexLookup.TableDef.Name)
+	}
+
 	// Get Limit And Offset info
 	if ndesc.Node.Limit != nil {
 		var temp string
@@ -352,6 +377,10 @@ func (ndesc *NodeDescribeImpl) GetFilterConditionInfo(ctx context.Context, optio
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetPartitionPruneInfo(ctx context.Context, options *ExplainOptions) string {
+	return "Hit Partition: " + strings.Join(GetPartitionPruneLabelValue(ndesc.Node.PartitionPrune), ", ")
+}
+
 func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	result := "Group Key: "
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -398,6 +427,33 @@ func (ndesc *NodeDescribeImpl) GetAggregationInfo(ctx context.Context, options *
 	return result, nil
 }
 
+func (ndesc *NodeDescribeImpl) GetWindowInfo(ctx context.Context, options *ExplainOptions) ([]string, error) {
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		funcs := "Window Functions: "
+		for i, v := range ndesc.Node.GetAggList() {
+			if i > 0 {
+				funcs += ", "
+			}
+			descV, err := describeExpr(ctx, v, options)
+			if err != nil {
+				return nil, err
+			}
+			funcs += descV
+		}
+		winSpecDescImpl := &WinSpecDescribeImpl{WinSpec: ndesc.Node.WinSpec}
+		spec, err := winSpecDescImpl.GetDescription(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		return []string{funcs, "Window Spec: " + spec}, nil
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return nil, moerr.NewNYI(ctx, "explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return nil, moerr.NewNYI(ctx, "explain format dot")
+	}
+	return nil, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetOrderByInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	var result string
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -545,8 +601,64 @@ type WinSpecDescribeImpl struct {
 }
 
 func (w *WinSpecDescribeImpl) GetDescription(ctx context.Context, options *ExplainOptions) (string, error) {
-	// TODO implement me
-	panic("implement me")
+	var result string
+	if options.Format == EXPLAIN_FORMAT_TEXT || options.Format == EXPLAIN_FORMAT_JSON {
+		if len(w.WinSpec.PartitionBy) > 0 {
+			result += "partition by "
+			partitionDescImpl := NewExprListDescribeImpl(w.WinSpec.PartitionBy)
+			describe, err := partitionDescImpl.GetDescription(ctx, options)
+			if err != nil {
+				return result, err
+			}
+			result += describe
+		}
+		if len(w.WinSpec.OrderBy) > 0 {
+			if len(result) > 0 {
+				result += " "
+			}
+			result += "order by "
+			orderByDescImpl := NewOrderByDescribeImpl(w.WinSpec.OrderBy)
+			describe, err := orderByDescImpl.GetDescription(ctx, options)
+			if err != nil {
+				return result, err
+			}
+			result += describe
+		}
+		if frame := w.WinSpec.Frame; frame != nil {
+			if len(result) > 0 {
+				result += " "
+			}
+			result += strings.ToLower(frame.Type.String()) + " between "
+			start, err := describeFrameBound(ctx, frame.Start, options)
+			if err != nil {
+				return result, err
+			}
+			end, err := describeFrameBound(ctx, frame.End, options)
+			if err != nil {
+				return result, err
+			}
+			result += start + " and " + end
+		}
+		return result, nil
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return "", moerr.NewNYI(ctx, "explain format dot")
+	}
+	return result, nil
+}
+
+func describeFrameBound(ctx context.Context, bound *plan.FrameBound, options *ExplainOptions) (string, error) {
+	if bound.Type == plan.FrameBound_CURRENT_ROW {
+		return "current row", nil
+	}
+	typ := strings.ToLower(bound.Type.String())
+	if bound.Unbounded {
+		return "unbounded " + typ, nil
+	}
+	val, err := describeExpr(ctx, bound.Val, options)
+	if err != nil {
+		return "", err
+	}
+	return val + " " + typ, nil
 }
 
 type RowsetDataDescribeImpl struct {
@@ -36,6 +36,13 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	switch options.Format {
+	case EXPLAIN_FORMAT_JSON:
+		return e.explainJson(ctx, buffer, options)
+	case EXPLAIN_FORMAT_DOT:
+		return e.explainDot(ctx, buffer, options)
+	}
+
 	nodes := e.QueryPlan.Nodes
 	for index, rootNodeID := range e.QueryPlan.Steps {
 		logutil.Infof("------------------------------------Query Plan-%v ---------------------------------------------", index)
@@ -54,37 +61,16 @@ func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataB
 }
 
 func (e *ExplainQueryImpl) BuildJsonPlan(ctx context.Context, uuid uuid.UUID, options *ExplainOptions) *ExplainData {
-	nodes := e.QueryPlan.Nodes
-	expdata := NewExplainData(uuid)
-	for index, rootNodeId := range e.QueryPlan.Steps {
-		graphData := NewGraphData()
-		err := PreOrderPlan(ctx, nodes[rootNodeId], nodes, graphData, options)
-		if err != nil {
-			var errdata *ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
+	expdata, err := e.buildExplainData(ctx, uuid, options)
+	if err != nil {
+		var errdata *ExplainData
+		if moErr, ok := err.(*moerr.Error); ok {
+			errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
+		} else {
+			newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
+			errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
 		}
-		err = graphData.StatisticsGlobalResource(ctx)
-		if err != nil {
-			var errdata *ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
-		}
-
-		step := NewStep(index)
-		step.GraphData = *graphData
-
-		expdata.Steps = append(expdata.Steps, *step)
+		return errdata
 	}
 	return expdata
 }
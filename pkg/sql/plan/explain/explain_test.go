@@ -16,6 +16,7 @@ package explain
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSingleSql(t *testing.T) {
@@ -177,6 +179,63 @@ func TestDerivedTableQuery(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestWindowQuery(t *testing.T) {
+	sqls := []string{
+		"explain select n_name, row_number() over (partition by n_regionkey order by n_nationkey) from NATION",
+		"explain verbose select n_name, rank() over (order by n_nationkey desc), sum(n_nationkey) over (partition by n_regionkey rows between 1 preceding and unbounded following) from NATION",
+		"explain verbose select * from (select n_name, lag(n_name, 1, 'none') over (order by n_nationkey) ln from NATION) a where a.ln > 'A'",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestRecursiveCTEQuery(t *testing.T) {
+	sqls := []string{
+		"explain verbose with recursive qn (n) as (select 1 union all select n + 1 from qn where n < 10) select * from qn where n > 5",
+		"explain verbose with recursive qn as (select n_nationkey k, n_name from NATION where n_nationkey = 0 union select NATION.n_nationkey, NATION.n_name from NATION join qn on NATION.n_regionkey = qn.k) select n_name from qn",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestExplainFormat(t *testing.T) {
+	sqls := []string{
+		"explain (format json) select n_name from NATION where n_nationkey > 10",
+		"explain (format dot) select n_name from NATION where n_nationkey > 10",
+		"explain format = json select * from NATION join REGION on n_regionkey = r_regionkey",
+		"explain format = dot select * from NATION join REGION on n_regionkey = r_regionkey",
+		"explain (verbose true, format dot) select n_regionkey, count(*) from NATION group by n_regionkey",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+
+	ctx := mockOptimizer.CurrentContext()
+	logicPlan, err := runSingleSql(mockOptimizer, t, "select n_name from NATION join REGION on n_regionkey = r_regionkey")
+	require.NoError(t, err)
+
+	es := NewExplainDefaultOptions()
+	es.Format = EXPLAIN_FORMAT_JSON
+	buffer := NewExplainDataBuffer()
+	err = NewExplainQueryImpl(logicPlan.GetQuery()).ExplainPlan(ctx.GetContext(), buffer, es)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(buffer.Lines))
+	require.NotContains(t, buffer.Lines[0], "\n")
+	data := &ExplainData{}
+	require.NoError(t, json.Unmarshal([]byte(buffer.Lines[0]), data))
+	require.Equal(t, 1, len(data.Steps))
+	require.NotEmpty(t, data.Steps[0].GraphData.Nodes)
+	require.NotEmpty(t, data.Steps[0].GraphData.Edges)
+
+	es.Format = EXPLAIN_FORMAT_DOT
+	buffer = NewExplainDataBuffer()
+	err = NewExplainQueryImpl(logicPlan.GetQuery()).ExplainPlan(ctx.GetContext(), buffer, es)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(buffer.Lines))
+	require.True(t, strings.HasPrefix(buffer.Lines[0], "digraph plan {"))
+	require.True(t, strings.HasSuffix(buffer.Lines[0], "}"))
+	require.Contains(t, buffer.Lines[0], "->")
+}
+
 // Collection query
 func TestCollectionQuery(t *testing.T) {
 	sqls := []string{
@@ -353,6 +412,8 @@ func runOneStmt(opt plan.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return moerr.NewInvalidInput(ctx, "explain format %v", v.Value)
 				}
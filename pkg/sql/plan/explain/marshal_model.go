@@ -146,6 +146,7 @@ func NewGraphData() *GraphData {
 
 func NewGlobal() *Global {
 	statistics := Statistics{
+		Time:       make([]StatisticValue, 0),
 		Memory:     make([]StatisticValue, 0),
 		Throughput: make([]StatisticValue, 0),
 		IO:         make([]StatisticValue, 0),
@@ -167,6 +168,7 @@ func NewLabel(name string, value interface{}) *Label {
 
 func NewStatistics() *Statistics {
 	return &Statistics{
+		Time:       make([]StatisticValue, 0),
 		Memory:     make([]StatisticValue, 0),
 		Throughput: make([]StatisticValue, 0),
 		IO:         make([]StatisticValue, 0),
@@ -218,6 +218,13 @@ func (m MarshalNodeImpl) GetNodeTitle(ctx context.Context, options *ExplainOptio
 		if err != nil {
 			return result, err
 		}
+	case plan.Node_WINDOW:
+		//"title" : "RANK() OVER (PARTITION BY ...)",
+		exprs := NewExprListDescribeImpl(m.node.AggList)
+		result, err = exprs.GetDescription(ctx, options)
+		if err != nil {
+			return result, err
+		}
 	case plan.Node_FILTER:
 		//"title" : "(D_0.D_MONTH_SEQ >= 1189) AND (D_0.D_MONTH_SEQ <= 1200)",
 		exprs := NewExprListDescribeImpl(m.node.FilterList)
@@ -285,6 +292,13 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 			Value: len(tableDef.Cols),
 		})
 
+		if m.node.PartitionPrune != nil && m.node.PartitionPrune.IsPruned {
+			labels = append(labels, Label{
+				Name:  "Hit partitions",
+				Value: GetPartitionPruneLabelValue(m.node.PartitionPrune),
+			})
+		}
+
 	case plan.Node_INSERT:
 		tableDef := m.node.TableDef
 		objRef := m.node.ObjRef
@@ -385,6 +399,25 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 				Value: value,
 			})
 		}
+	case plan.Node_WINDOW:
+		value, err := GetExprsLabelValue(ctx, m.node.AggList, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, Label{
+			Name:  "Window functions",
+			Value: value,
+		})
+
+		winSpecDescImpl := &WinSpecDescribeImpl{WinSpec: m.node.WinSpec}
+		spec, err := winSpecDescImpl.GetDescription(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, Label{
+			Name:  "Window spec",
+			Value: []string{spec},
+		})
 	case plan.Node_FILTER:
 		value, err := GetExprsLabelValue(ctx, m.node.FilterList, options)
 		if err != nil {
@@ -720,6 +753,14 @@ func GetTableColsLableValue(ctx context.Context, cols []*plan.ColDef, options *E
 	return columns
 }
 
+func GetPartitionPruneLabelValue(prune *plan.PartitionPrune) []string {
+	partitions := make([]string, len(prune.SelectedPartitions))
+	for i, partition := range prune.SelectedPartitions {
+		partitions[i] = partition.PartitionName
+	}
+	return partitions
+}
+
 func GetUpdateTableColsLableValue(cols map[string]int32, db string, tname string, options *ExplainOptions) []string {
 	columns := make([]string, len(cols))
 	i := 0
@@ -986,4 +986,32 @@ var aggregates = map[int]Functions{
 			},
 		},
 	},
+	JSON_ARRAYAGG: {
+		Id:          JSON_ARRAYAGG,
+		Flag:        plan.Function_AGG,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: generalTypeCheckForUnaryAggregate,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Args:          []types.T{types.T_json},
+				ReturnTyp:     types.T_json,
+				AggregateInfo: agg.AggregateJsonArrayAgg,
+			},
+		},
+	},
+	JSON_OBJECTAGG: {
+		Id:          JSON_OBJECTAGG,
+		Flag:        plan.Function_AGG,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: generalTypeCheckForUnaryAggregate,
+		Overloads: []Function{
+			{
+				Index:         0,
+				Args:          []types.T{types.T_json},
+				ReturnTyp:     types.T_json,
+				AggregateInfo: agg.AggregateJsonObjectAgg,
+			},
+		},
+	},
 }
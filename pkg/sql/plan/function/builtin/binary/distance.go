@@ -0,0 +1,70 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/distance"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// vectorDistance computes fn for each pair of the vectors, fn returns false
+// if the distance is undefined and the result is NULL.
+func vectorDistance[T types.RealNumbers](parameters []*vector.Vector, result vector.FunctionResultWrapper, length int,
+	fn func(v1, v2 []T) (float64, bool)) error {
+	rs := vector.MustFunctionResult[float64](result)
+	p1 := vector.GenerateFunctionStrParameter(parameters[0])
+	p2 := vector.GenerateFunctionStrParameter(parameters[1])
+	for i := uint64(0); i < uint64(length); i++ {
+		b1, null1 := p1.GetStrValue(i)
+		b2, null2 := p2.GetStrValue(i)
+		if null1 || null2 {
+			if err := rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		v1, v2 := types.BytesToVector[T](b1), types.BytesToVector[T](b2)
+		if err := distance.CheckDimension(v1, v2); err != nil {
+			return err
+		}
+		d, ok := fn(v1, v2)
+		if err := rs.Append(d, !ok); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// L2Distance is the implementation of 'l2_distance', the euclidean distance
+func L2Distance[T types.RealNumbers](parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return vectorDistance(parameters, result, length, func(v1, v2 []T) (float64, bool) {
+		return distance.L2Distance(v1, v2), true
+	})
+}
+
+// CosineDistance is the implementation of 'cosine_distance', it's NULL if any
+// of the vectors is zero.
+func CosineDistance[T types.RealNumbers](parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return vectorDistance(parameters, result, length, distance.CosineDistance[T])
+}
+
+// InnerProduct is the implementation of 'inner_product', the dot product
+func InnerProduct[T types.RealNumbers](parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return vectorDistance(parameters, result, length, func(v1, v2 []T) (float64, bool) {
+		return distance.InnerProduct(v1, v2), true
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func vecf32s(vs ...[]float32) []string {
+	r := make([]string, len(vs))
+	for i, v := range vs {
+		r[i] = string(types.VectorToBytes(v))
+	}
+	return r
+}
+
+func vecf64s(vs ...[]float64) []string {
+	r := make([]string, len(vs))
+	for i, v := range vs {
+		r[i] = string(types.VectorToBytes(v))
+	}
+	return r
+}
+
+func TestVectorDistance(t *testing.T) {
+	proc := testutil.NewProcess()
+	cases := []struct {
+		info   string
+		inputs []testutil.FunctionTestInput
+		expect testutil.FunctionTestResult
+		fn     func([]*vector.Vector, vector.FunctionResultWrapper, *process.Process, int) error
+	}{
+		{
+			info: "l2_distance",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_vecf32.ToType(),
+					vecf32s([]float32{0, 0}, []float32{1, 1}, nil), []bool{false, false, true}),
+				testutil.NewFunctionTestInput(types.T_vecf32.ToType(),
+					vecf32s([]float32{3, 4}, []float32{1, 1}, []float32{1, 1}), nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+				[]float64{5, 0, 0}, []bool{false, false, true}),
+			fn: L2Distance[float32],
+		},
+		{
+			info: "cosine_distance",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_vecf64.ToType(),
+					vecf64s([]float64{1, 0}, []float64{0, 0}), nil),
+				testutil.NewFunctionTestInput(types.T_vecf64.ToType(),
+					vecf64s([]float64{0, 1}, []float64{1, 1}), nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+				[]float64{1, 0}, []bool{false, true}),
+			fn: CosineDistance[float64],
+		},
+		{
+			info: "inner_product",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_vecf32.ToType(),
+					vecf32s([]float32{1, 2, 3}), nil),
+				testutil.NewFunctionTestInput(types.T_vecf32.ToType(),
+					vecf32s([]float32{4, 5, 6}), nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+				[]float64{32}, nil),
+			fn: InnerProduct[float32],
+		},
+		{
+			info: "different dimensions",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_vecf32.ToType(),
+					vecf32s([]float32{1, 2, 3}), nil),
+				testutil.NewFunctionTestInput(types.T_vecf32.ToType(),
+					vecf32s([]float32{4, 5}), nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_float64.ToType(), true,
+				[]float64{0}, nil),
+			fn: L2Distance[float32],
+		},
+	}
+	for _, c := range cases {
+		fcTC := testutil.NewFunctionTestCase(proc, c.inputs, c.expect, c.fn)
+		s, info := fcTC.Run()
+		require.True(t, s, "case is '%s', err info is '%s'", c.info, info)
+	}
+}
@@ -78,7 +78,8 @@ func showType(s [][]byte, showLen bool) ([]string, error) {
 		if err != nil {
 			return nil, err
 		}
-		if showLen {
+		// the members of ENUM and SET are kept in mo_columns.attr_enum
+		if showLen && tp.Oid != types.T_enum && tp.Oid != types.T_set {
 			ret[i] = fmt.Sprintf("%s(%d)", tp.String(), tp.Width)
 		} else {
 			ret[i] = tp.String()
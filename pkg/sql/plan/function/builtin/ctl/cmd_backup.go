@@ -0,0 +1,110 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/ctl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// moAdminRoleID is the id of the role moadmin of the sys account.
+const moAdminRoleID = 0
+
+// handleBackup backs up into a path, the parameter is "scope:id:path", the scope
+// is cluster, account or database, and the id is the account id or database id.
+// The path is relative to the backup root of the DN. A backup has the data of any
+// account, so only the sys account backs up, see checkBackupPrivilege.
+func handleBackup() handleFunc {
+	handle := getDNHandlerFunc(
+		pb.CmdMethod_Backup,
+		func(_ string) ([]uint64, error) {
+			return nil, nil
+		},
+		func(dnShardID uint64, parameter string, proc *process.Process) ([]byte, error) {
+			req, err := parseBackupParameter(parameter)
+			if err != nil {
+				return nil, moerr.NewInvalidInput(proc.Ctx, "invalid backup parameter '%s'", parameter)
+			}
+			return types.Encode(req)
+		},
+		func(data []byte) (interface{}, error) {
+			resp := &db.BackupResp{}
+			types.Decode(data, resp)
+			return resp, nil
+		})
+	return func(proc *process.Process,
+		service serviceType,
+		parameter string,
+		sender requestSender) (pb.CtlResult, error) {
+		if err := checkBackupPrivilege(proc); err != nil {
+			return pb.CtlResult{}, err
+		}
+		return handle(proc, service, parameter, sender)
+	}
+}
+
+// checkBackupPrivilege checks the session backs up in the sys account, by the
+// role moadmin or a role granted the privilege backup_admin. The background
+// sessions of the frontend, like the one of the BACKUP statement, run as moadmin.
+func checkBackupPrivilege(proc *process.Process) error {
+	if proc.SessionInfo.AccountId != catalog.System_Account {
+		return moerr.NewInternalError(proc.Ctx, "only the sys account can back up")
+	}
+	if proc.SessionInfo.RoleId == moAdminRoleID {
+		return nil
+	}
+	if proc.SessionInfo.SqlHelper != nil {
+		yes, err := proc.SessionInfo.SqlHelper.HasBackupPrivilege()
+		if err != nil {
+			return err
+		}
+		if yes {
+			return nil
+		}
+	}
+	return moerr.NewInternalError(proc.Ctx, "do not have privilege to back up")
+}
+
+func parseBackupParameter(parameter string) (db.Backup, error) {
+	parts := strings.SplitN(parameter, ":", 3)
+	if len(parts) != 3 || parts[2] == "" {
+		return db.Backup{}, moerr.NewInvalidInputNoCtx("backup parameter")
+	}
+	id, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return db.Backup{}, err
+	}
+	req := db.Backup{
+		Scope: strings.ToLower(parts[0]),
+		Path:  parts[2],
+	}
+	switch req.Scope {
+	case "cluster":
+	case "account":
+		req.AccountID = uint32(id)
+	case "database":
+		req.DatabaseID = id
+	default:
+		return db.Backup{}, moerr.NewInvalidInputNoCtx("backup scope %s", parts[0])
+	}
+	return req, nil
+}
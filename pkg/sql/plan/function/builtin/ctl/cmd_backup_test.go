@@ -0,0 +1,81 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBackupParameter(t *testing.T) {
+	req, err := parseBackupParameter("cluster:0:/tmp/backup")
+	require.NoError(t, err)
+	require.Equal(t, db.Backup{Scope: "cluster", Path: "/tmp/backup"}, req)
+
+	req, err = parseBackupParameter("ACCOUNT:2:s3,endpoint,region,bucket,key,secret,prefix:backup")
+	require.NoError(t, err)
+	require.Equal(t, db.Backup{
+		Scope:     "account",
+		AccountID: 2,
+		Path:      "s3,endpoint,region,bucket,key,secret,prefix:backup",
+	}, req)
+
+	req, err = parseBackupParameter("database:1001:/tmp/backup")
+	require.NoError(t, err)
+	require.Equal(t, db.Backup{Scope: "database", DatabaseID: 1001, Path: "/tmp/backup"}, req)
+
+	for _, parameter := range []string{"", "cluster", "cluster:0", "cluster:0:", "table:1:/tmp", "account:a:/tmp"} {
+		_, err = parseBackupParameter(parameter)
+		require.Error(t, err, parameter)
+	}
+}
+
+type backupPrivilegeHelper struct {
+	yes bool
+}
+
+func (h *backupPrivilegeHelper) ExecSql(string) ([]interface{}, error) {
+	return nil, nil
+}
+
+func (h *backupPrivilegeHelper) HasBackupPrivilege() (bool, error) {
+	return h.yes, nil
+}
+
+func TestCheckBackupPrivilege(t *testing.T) {
+	proc := process.New(context.Background(), nil, nil, nil, nil, nil, nil)
+
+	// moadmin of the sys account
+	require.NoError(t, checkBackupPrivilege(proc))
+
+	// the other accounts never back up
+	proc.SessionInfo.AccountId = 1
+	require.Error(t, checkBackupPrivilege(proc))
+	proc.SessionInfo.SqlHelper = &backupPrivilegeHelper{yes: true}
+	require.Error(t, checkBackupPrivilege(proc))
+
+	// the other roles of the sys account need backup_admin
+	proc.SessionInfo.AccountId = 0
+	proc.SessionInfo.RoleId = 3
+	require.NoError(t, checkBackupPrivilege(proc))
+	proc.SessionInfo.SqlHelper = &backupPrivilegeHelper{}
+	require.Error(t, checkBackupPrivilege(proc))
+	proc.SessionInfo.SqlHelper = nil
+	require.Error(t, checkBackupPrivilege(proc))
+}
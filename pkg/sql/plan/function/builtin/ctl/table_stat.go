@@ -392,5 +392,6 @@ var (
 		catalog.AutoIncrTableName:     0,
 		"mo_indexes":                  0,
 		"mo_pubs":                     0,
+		"mo_column_stats":             0,
 	}
 )
@@ -0,0 +1,168 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inside
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+The functions below are added by the plan to convert the values of ENUM and
+SET columns, the last parameter is always the constant members of the column
+formatted by types.FormatEnumValues.
+*/
+
+func enumMembers(v *vector.Vector) []string {
+	members, _ := vector.GenerateFunctionStrParameter(v).GetStrValue(0)
+	return types.ParseEnumValues(string(members))
+}
+
+// CastValueToEnum is the implementation of 'cast_value_to_enum', it converts
+// the member or the index to the index of the ENUM.
+func CastValueToEnum(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[uint16](result)
+	members := enumMembers(parameters[1])
+	if parameters[0].GetType().Oid.IsMySQLString() {
+		source := vector.GenerateFunctionStrParameter(parameters[0])
+		for i := uint64(0); i < uint64(length); i++ {
+			v, null := source.GetStrValue(i)
+			if null {
+				if err := rs.Append(0, true); err != nil {
+					return err
+				}
+				continue
+			}
+			idx, err := types.ParseEnum(members, string(v))
+			if err != nil {
+				return err
+			}
+			if err = rs.Append(idx, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	source := vector.GenerateFunctionFixedTypeParameter[uint64](parameters[0])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := source.GetValue(i)
+		if null {
+			if err := rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		idx, err := types.ParseEnumIndex(members, v)
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(idx, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CastEnumToValue is the implementation of 'cast_enum_to_value', it returns
+// the member of the index.
+func CastEnumToValue(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	members := enumMembers(parameters[1])
+	source := vector.GenerateFunctionFixedTypeParameter[uint16](parameters[0])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := source.GetValue(i)
+		if null {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		s, err := types.EnumString(members, v)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendBytes([]byte(s), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CastValueToSet is the implementation of 'cast_value_to_set', it converts
+// the comma separated members or the bitmap to the bitmap of the SET.
+func CastValueToSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[uint64](result)
+	members := enumMembers(parameters[1])
+	if parameters[0].GetType().Oid.IsMySQLString() {
+		source := vector.GenerateFunctionStrParameter(parameters[0])
+		for i := uint64(0); i < uint64(length); i++ {
+			v, null := source.GetStrValue(i)
+			if null {
+				if err := rs.Append(0, true); err != nil {
+					return err
+				}
+				continue
+			}
+			bm, err := types.ParseSet(members, string(v))
+			if err != nil {
+				return err
+			}
+			if err = rs.Append(bm, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	source := vector.GenerateFunctionFixedTypeParameter[uint64](parameters[0])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := source.GetValue(i)
+		if null {
+			if err := rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		bm, err := types.ParseSetBitmap(members, v)
+		if err != nil {
+			return err
+		}
+		if err = rs.Append(bm, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CastSetToValue is the implementation of 'cast_set_to_value', it returns
+// the comma separated members of the bitmap.
+func CastSetToValue(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	members := enumMembers(parameters[1])
+	source := vector.GenerateFunctionFixedTypeParameter[uint64](parameters[0])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := source.GetValue(i)
+		if null {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := rs.AppendBytes([]byte(types.SetString(members, v)), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inside
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCastEnum(t *testing.T) {
+	members := "'a','b','c'"
+	proc := testutil.NewProcess()
+	cases := []struct {
+		info   string
+		inputs []testutil.FunctionTestInput
+		expect testutil.FunctionTestResult
+		fn     func([]*vector.Vector, vector.FunctionResultWrapper, *process.Process, int) error
+	}{
+		{
+			info: "value to enum",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"b", "C", "1", ""}, []bool{false, false, false, true}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{members, members, members, members}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_enum.ToType(), false,
+				[]uint16{2, 3, 1, 0}, []bool{false, false, false, true}),
+			fn: CastValueToEnum,
+		},
+		{
+			info: "not a member of enum",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"d"}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{members}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_enum.ToType(), true,
+				[]uint16{0}, nil),
+			fn: CastValueToEnum,
+		},
+		{
+			info: "index to enum",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_uint64.ToType(),
+					[]uint64{3}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{members}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_enum.ToType(), false,
+				[]uint16{3}, nil),
+			fn: CastValueToEnum,
+		},
+		{
+			info: "enum to value",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_enum.ToType(),
+					[]uint16{0, 3}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{members, members}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"", "c"}, nil),
+			fn: CastEnumToValue,
+		},
+		{
+			info: "value to set",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"c,a", ""}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{members, members}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_set.ToType(), false,
+				[]uint64{5, 0}, nil),
+			fn: CastValueToSet,
+		},
+		{
+			info: "set to value",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_set.ToType(),
+					[]uint64{6}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{members}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"b,c"}, nil),
+			fn: CastSetToValue,
+		},
+	}
+	for _, c := range cases {
+		fcTC := testutil.NewFunctionTestCase(proc, c.inputs, c.expect, c.fn)
+		s, info := fcTC.Run()
+		require.True(t, s, "case is '%s', err info is '%s'", c.info, info)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func JsonArray(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	elems := make([]bytejson.ByteJson, len(parameters))
+	for i := uint64(0); i < uint64(length); i++ {
+		for j, param := range parameters {
+			var err error
+			if elems[j], err = jsonValueAt(proc, param, i); err != nil {
+				return err
+			}
+		}
+		if err := appendJson(rs, bytejson.CreateArray(elems)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonObject(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	n := len(parameters) / 2
+	keys := make([]string, n)
+	vals := make([]bytejson.ByteJson, n)
+	for i := uint64(0); i < uint64(length); i++ {
+		for j := 0; j < n; j++ {
+			key := parameters[2*j]
+			if key.GetType().Oid == types.T_any || rowIsNull(key, i) {
+				return moerr.NewInvalidInput(proc.Ctx, "JSON documents may not contain NULL member names")
+			}
+			keys[j] = key.GetStringAt(rowOf(key, i))
+			var err error
+			if vals[j], err = jsonValueAt(proc, parameters[2*j+1], i); err != nil {
+				return err
+			}
+		}
+		obj, err := bytejson.CreateObject(keys, vals)
+		if err != nil {
+			return err
+		}
+		if err = appendJson(rs, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
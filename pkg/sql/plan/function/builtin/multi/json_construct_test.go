@@ -0,0 +1,63 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonArray(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 2}, nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", ""}, []bool{false, true}),
+		testutil.NewFunctionTestInput(types.T_json.ToType(), encodeJsons(t, `{"b": [true]}`, `[]`), nil),
+		testutil.NewFunctionTestInput(types.T_date.ToType(), []types.Date{types.DateFromCalendar(2023, 1, 2), 0}, nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+		encodeJsons(t, `[1, "a", {"b": [true]}, "2023-01-02"]`, `[2, null, [], "0001-01-01"]`), nil)
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonArray)
+	s, info := kase.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonObject(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"b", "a"}, nil),
+		testutil.NewFunctionTestInput(types.T_float64.ToType(), []float64{1.5, 0}, []bool{false, true}),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", "a"}, nil),
+		testutil.NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+		encodeJsons(t, `{"a": true, "b": 1.5}`, `{"a": false}`), nil)
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonObject)
+	s, info := kase.Run()
+	require.True(t, s, info)
+
+	// NULL is not allowed to be a key
+	inputs = []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{""}, []bool{true}),
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1}, nil),
+	}
+	expect = testutil.NewFunctionTestResult(types.T_json.ToType(), true, []string{""}, nil)
+	kase = testutil.NewFunctionTestCase(proc, inputs, expect, JsonObject)
+	s, info = kase.Run()
+	require.True(t, s, info)
+}
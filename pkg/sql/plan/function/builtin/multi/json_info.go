@@ -0,0 +1,150 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonTargetAt returns the part of the document at parameters[0] which is
+// selected by the optional path at parameters[pathIdx], the missing part is
+// returned as NULL.
+func jsonTargetAt(parameters []*vector.Vector, pathIdx int, i uint64) (bytejson.ByteJson, bool, error) {
+	doc, isNull, err := jsonDocAt(parameters[0], i)
+	if err != nil || isNull || len(parameters) <= pathIdx {
+		return doc, isNull, err
+	}
+	path, isNull, err := jsonPathAt(parameters[pathIdx], i)
+	if err != nil || isNull {
+		return doc, isNull, err
+	}
+	target, ok, err := doc.Lookup(path)
+	return target, !ok, err
+}
+
+func JsonContains(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[bool](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		target, isNull, err := jsonTargetAt(parameters, 2, i)
+		if err != nil {
+			return err
+		}
+		var candidate bytejson.ByteJson
+		if !isNull {
+			var null bool
+			if candidate, null, err = jsonDocAt(parameters[1], i); err != nil {
+				return err
+			}
+			isNull = null
+		}
+		if isNull {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.Append(target.Contains(candidate), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonKeys(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		target, isNull, err := jsonTargetAt(parameters, 1, i)
+		if err != nil {
+			return err
+		}
+		if isNull || target.Type != bytejson.TpCodeObject {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = appendJson(rs, target.Keys()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonLength(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[int64](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		target, isNull, err := jsonTargetAt(parameters, 1, i)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			if err = rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.Append(target.Length(), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonType(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, isNull, err := jsonDocAt(parameters[0], i)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.AppendBytes([]byte(doc.TypeName()), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JsonValid returns whether the argument is a valid json document, the json
+// values are always valid.
+func JsonValid(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[bool](result)
+	vec := parameters[0]
+	for i := uint64(0); i < uint64(length); i++ {
+		if vec.GetType().Oid == types.T_any || rowIsNull(vec, i) {
+			if err := rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		valid := true
+		if vec.GetType().Oid != types.T_json {
+			_, err := types.ParseSliceToByteJson(vec.GetBytesAt(rowOf(vec, i)))
+			valid = err == nil
+		}
+		if err := rs.Append(valid, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
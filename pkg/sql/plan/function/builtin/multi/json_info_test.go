@@ -0,0 +1,95 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonContains(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+			[]string{`{"a": [1, 2], "b": 3}`, `{"a": [1, 2], "b": 3}`, `{"a": [1, 2], "b": 3}`, `[1]`}, nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 2}`, `[2, 1]`, `3`, `1`}, nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$", "$.a", "$.a", "$.x"}, nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_bool.ToType(), false,
+		[]bool{true, true, false, false}, []bool{false, false, false, true})
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonContains)
+	s, info := kase.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonKeys(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_json.ToType(),
+			encodeJsons(t, `{"b": 1, "a": {"c": 2}}`, `{"b": 1, "a": {"c": 2}}`, `[1]`), nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$", "$.a", "$"}, nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+		encodeJsons(t, `["a", "b"]`, `["c"]`, ``), []bool{false, false, true})
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonKeys)
+	s, info := kase.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonLength(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`[1, 2, {"a": 3}]`, `{"a": [1, 2]}`, `"x"`, ``}, []bool{false, false, false, true}),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+		[]int64{3, 1, 1, 0}, []bool{false, false, false, true})
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonLength)
+	s, info := kase.Run()
+	require.True(t, s, info)
+
+	inputs = append(inputs, testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$[2]", "$.a", "$.b", "$"}, nil))
+	expect = testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+		[]int64{1, 2, 0, 0}, []bool{false, false, true, true})
+	kase = testutil.NewFunctionTestCase(proc, inputs, expect, JsonLength)
+	s, info = kase.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonType(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_json.ToType(), encodeJsons(t, `{}`, `[1]`, `1`, `1.5`, `"a"`, `null`), nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+		[]string{"OBJECT", "ARRAY", "INTEGER", "DOUBLE", "STRING", "NULL"}, nil)
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonType)
+	s, info := kase.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonValid(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`, `{"a": 1`, `hello`, ``}, []bool{false, false, false, true}),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_bool.ToType(), false,
+		[]bool{true, false, false, false}, []bool{false, false, false, true})
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonValid)
+	s, info := kase.Run()
+	require.True(t, s, info)
+}
@@ -0,0 +1,251 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func JsonSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.ModifySet)
+}
+
+func JsonInsert(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.ModifyInsert)
+}
+
+func JsonReplace(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.ModifyReplace)
+}
+
+// jsonModify changes the document at parameters[0] with the pairs of the paths
+// and the values behind it.
+func jsonModify(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, tp bytejson.ModifyType) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	n := (len(parameters) - 1) / 2
+	paths := make([]*bytejson.Path, n)
+	vals := make([]bytejson.ByteJson, n)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, isNull, err := jsonDocAt(parameters[0], i)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < n && !isNull; j++ {
+			if paths[j], isNull, err = jsonPathAt(parameters[2*j+1], i); err != nil {
+				return err
+			}
+			if vals[j], err = jsonValueAt(proc, parameters[2*j+2], i); err != nil {
+				return err
+			}
+		}
+		if isNull {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := doc.Modify(paths, vals, tp)
+		if err != nil {
+			return err
+		}
+		if err = appendJson(rs, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonRemove(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	paths := make([]*bytejson.Path, len(parameters)-1)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, isNull, err := jsonDocAt(parameters[0], i)
+		if err != nil {
+			return err
+		}
+		for j := range paths {
+			if isNull {
+				break
+			}
+			if paths[j], isNull, err = jsonPathAt(parameters[j+1], i); err != nil {
+				return err
+			}
+		}
+		if isNull {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := doc.Remove(paths)
+		if err != nil {
+			return err
+		}
+		if err = appendJson(rs, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonMergePatch(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	docs := make([]bytejson.ByteJson, len(parameters))
+	for i := uint64(0); i < uint64(length); i++ {
+		isNull := false
+		for j, param := range parameters {
+			var err error
+			var null bool
+			if docs[j], null, err = jsonDocAt(param, i); err != nil {
+				return err
+			}
+			isNull = isNull || null
+		}
+		if isNull {
+			if err := rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := bytejson.MergePatch(docs)
+		if err != nil {
+			return err
+		}
+		if err = appendJson(rs, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendJson(rs *vector.FunctionResult[types.Varlena], bj bytejson.ByteJson) error {
+	dt, err := bj.Marshal()
+	if err != nil {
+		return err
+	}
+	return rs.AppendBytes(dt, false)
+}
+
+func rowIsNull(vec *vector.Vector, i uint64) bool {
+	if vec.IsConstNull() {
+		return true
+	}
+	if vec.IsConst() {
+		return false
+	}
+	return vec.GetNulls().Contains(i)
+}
+
+func rowOf(vec *vector.Vector, i uint64) int {
+	if vec.IsConst() {
+		return 0
+	}
+	return int(i)
+}
+
+// jsonDocAt returns the json document at row i of the vector, the strings are
+// parsed as json text.
+func jsonDocAt(vec *vector.Vector, i uint64) (bytejson.ByteJson, bool, error) {
+	if vec.GetType().Oid == types.T_any || rowIsNull(vec, i) {
+		return bytejson.ByteJson{}, true, nil
+	}
+	data := vec.GetBytesAt(rowOf(vec, i))
+	if vec.GetType().Oid == types.T_json {
+		return types.DecodeJson(data), false, nil
+	}
+	bj, err := types.ParseSliceToByteJson(data)
+	return bj, false, err
+}
+
+// jsonPathAt returns the json path at row i of the vector.
+func jsonPathAt(vec *vector.Vector, i uint64) (*bytejson.Path, bool, error) {
+	if vec.GetType().Oid == types.T_any || rowIsNull(vec, i) {
+		return nil, true, nil
+	}
+	p, err := types.ParseStringToPath(vec.GetStringAt(rowOf(vec, i)))
+	if err != nil {
+		return nil, false, err
+	}
+	return &p, false, nil
+}
+
+// jsonValueAt converts the value at row i of the vector to a json value, the
+// strings are json strings rather than json text, and NULL is the json null.
+func jsonValueAt(proc *process.Process, vec *vector.Vector, i uint64) (bytejson.ByteJson, error) {
+	typ := vec.GetType()
+	if typ.Oid == types.T_any || rowIsNull(vec, i) {
+		return bytejson.Null, nil
+	}
+	row := rowOf(vec, i)
+	var val interface{}
+	switch typ.Oid {
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(row)), nil
+	case types.T_bool:
+		val = vector.GetFixedAt[bool](vec, row)
+	case types.T_int8:
+		val = int64(vector.GetFixedAt[int8](vec, row))
+	case types.T_int16:
+		val = int64(vector.GetFixedAt[int16](vec, row))
+	case types.T_int32:
+		val = int64(vector.GetFixedAt[int32](vec, row))
+	case types.T_int64:
+		val = vector.GetFixedAt[int64](vec, row)
+	case types.T_uint8:
+		val = uint64(vector.GetFixedAt[uint8](vec, row))
+	case types.T_uint16:
+		val = uint64(vector.GetFixedAt[uint16](vec, row))
+	case types.T_uint32:
+		val = uint64(vector.GetFixedAt[uint32](vec, row))
+	case types.T_uint64:
+		val = vector.GetFixedAt[uint64](vec, row)
+	case types.T_float32:
+		val = float64(vector.GetFixedAt[float32](vec, row))
+	case types.T_float64:
+		val = vector.GetFixedAt[float64](vec, row)
+	case types.T_decimal64:
+		val = json.Number(vector.GetFixedAt[types.Decimal64](vec, row).Format(typ.Scale))
+	case types.T_decimal128:
+		val = json.Number(vector.GetFixedAt[types.Decimal128](vec, row).Format(typ.Scale))
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		val = vec.GetStringAt(row)
+	case types.T_date:
+		val = vector.GetFixedAt[types.Date](vec, row).String()
+	case types.T_datetime:
+		val = vector.GetFixedAt[types.Datetime](vec, row).String2(typ.Scale)
+	case types.T_time:
+		val = vector.GetFixedAt[types.Time](vec, row).String2(typ.Scale)
+	case types.T_timestamp:
+		loc := time.Local
+		if proc != nil && proc.SessionInfo.TimeZone != nil {
+			loc = proc.SessionInfo.TimeZone
+		}
+		val = vector.GetFixedAt[types.Timestamp](vec, row).String2(loc, typ.Scale)
+	case types.T_uuid:
+		val = vector.GetFixedAt[types.Uuid](vec, row).ToString()
+	default:
+		return bytejson.ByteJson{}, moerr.NewInvalidInputNoCtx("invalid data type for json data: %s", typ)
+	}
+	var bj bytejson.ByteJson
+	err := bj.UnmarshalObject(val)
+	return bj, err
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// encodeJsons returns the binary encoding of the json texts, "" is kept for NULL.
+func encodeJsons(t *testing.T, strs ...string) []string {
+	ret := make([]string, len(strs))
+	for i, s := range strs {
+		if s == "" {
+			continue
+		}
+		bj, err := types.ParseStringToByteJson(s)
+		require.NoError(t, err)
+		dt, err := bj.Marshal()
+		require.NoError(t, err)
+		ret[i] = string(dt)
+	}
+	return ret
+}
+
+func TestJsonModify(t *testing.T) {
+	proc := testutil.NewProc()
+	docs := []string{`{"a": 1}`, `[1, 2]`, `{"a": 1}`, ``}
+	docNulls := []bool{false, false, false, true}
+	inputs := func() []testutil.FunctionTestInput {
+		return []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, docNulls),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", "$[1]", "$.b", "$.a"}, nil),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{10, 20, 0, 40}, []bool{false, false, true, false}),
+		}
+	}
+	tcs := []struct {
+		name string
+		fn   func([]*vector.Vector, vector.FunctionResultWrapper, *process.Process, int) error
+		want []string
+	}{
+		{"json_set", JsonSet, []string{`{"a": 10}`, `[1, 20]`, `{"a": 1, "b": null}`, ``}},
+		{"json_insert", JsonInsert, []string{`{"a": 1}`, `[1, 2]`, `{"a": 1, "b": null}`, ``}},
+		{"json_replace", JsonReplace, []string{`{"a": 10}`, `[1, 20]`, `{"a": 1}`, ``}},
+	}
+	for _, tc := range tcs {
+		expect := testutil.NewFunctionTestResult(types.T_json.ToType(), false, encodeJsons(t, tc.want...), docNulls)
+		kase := testutil.NewFunctionTestCase(proc, inputs(), expect, tc.fn)
+		s, info := kase.Run()
+		require.True(t, s, "%s: %s", tc.name, info)
+	}
+
+	// the wildcards are not allowed
+	inputs2 := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`[1]`}, nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$[*]"}, nil),
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1}, nil),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_json.ToType(), true, []string{""}, nil)
+	kase := testutil.NewFunctionTestCase(proc, inputs2, expect, JsonSet)
+	s, info := kase.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonRemove(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_json.ToType(),
+			encodeJsons(t, `{"a": 1, "b": [1, 2]}`, `{"a": 1, "b": [1, 2]}`, `[1]`), nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", "$.b[0]", "$[0]"}, []bool{false, false, true}),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+		encodeJsons(t, `{"b": [1, 2]}`, `{"a": 1, "b": [2]}`, ``), []bool{false, false, true})
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonRemove)
+	s, info := kase.Run()
+	require.True(t, s, info)
+}
+
+func TestJsonMergePatch(t *testing.T) {
+	proc := testutil.NewProc()
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1, "b": 2}`, `[1]`, `{}`}, nil),
+		testutil.NewFunctionTestInput(types.T_json.ToType(),
+			encodeJsons(t, `{"a": null, "c": 3}`, `{"a": 1}`, ``), []bool{false, false, true}),
+	}
+	expect := testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+		encodeJsons(t, `{"b": 2, "c": 3}`, `{"a": 1}`, ``), []bool{false, false, true})
+	kase := testutil.NewFunctionTestCase(proc, inputs, expect, JsonMergePatch)
+	s, info := kase.Run()
+	require.True(t, s, info)
+
+	// the invalid json text is an error
+	inputs = []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1`}, nil),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{}`}, nil),
+	}
+	expect = testutil.NewFunctionTestResult(types.T_json.ToType(), true, []string{""}, nil)
+	kase = testutil.NewFunctionTestCase(proc, inputs, expect, JsonMergePatch)
+	s, info = kase.Run()
+	require.True(t, s, info)
+}
@@ -39,6 +39,10 @@ func (sh *sqlHelper) ExecSql(sql string) ([]interface{}, error) {
 	return mocks, nil
 }
 
+func (sh *sqlHelper) HasBackupPrivilege() (bool, error) {
+	return false, nil
+}
+
 func TestSetVal(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
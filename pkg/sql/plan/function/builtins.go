@@ -2015,6 +2015,255 @@ var builtins = map[int]Functions{
 			},
 		},
 	},
+	JSON_SET: {
+		Id:     JSON_SET,
+		Flag:   plan.Function_NONE,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 3 || len(inputs)%2 == 0 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return i%2 == 1 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonSet,
+			},
+		},
+	},
+	JSON_INSERT: {
+		Id:     JSON_INSERT,
+		Flag:   plan.Function_NONE,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 3 || len(inputs)%2 == 0 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return i%2 == 1 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonInsert,
+			},
+		},
+	},
+	JSON_REPLACE: {
+		Id:     JSON_REPLACE,
+		Flag:   plan.Function_NONE,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 3 || len(inputs)%2 == 0 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return i%2 == 1 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonReplace,
+			},
+		},
+	},
+	JSON_REMOVE: {
+		Id:     JSON_REMOVE,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 2 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return i > 0 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonRemove,
+			},
+		},
+	},
+	JSON_ARRAY: {
+		Id:     JSON_ARRAY,
+		Flag:   plan.Function_NONE,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return jsonTypeCheck(inputs, func(i int) bool { return false }, func(i int) bool { return false })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonArray,
+			},
+		},
+	},
+	JSON_OBJECT: {
+		Id:     JSON_OBJECT,
+		Flag:   plan.Function_NONE,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs)%2 != 0 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return false }, func(i int) bool { return i%2 == 0 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonObject,
+			},
+		},
+	},
+	JSON_CONTAINS: {
+		Id:     JSON_CONTAINS,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 2 || len(inputs) > 3 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i < 2 }, func(i int) bool { return i == 2 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_bool,
+				UseNewFramework: true,
+				NewFn:           multi.JsonContains,
+			},
+		},
+	},
+	JSON_KEYS: {
+		Id:     JSON_KEYS,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 1 || len(inputs) > 2 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return i == 1 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonKeys,
+			},
+		},
+	},
+	JSON_LENGTH: {
+		Id:     JSON_LENGTH,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 1 || len(inputs) > 2 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return i == 1 })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_int64,
+				UseNewFramework: true,
+				NewFn:           multi.JsonLength,
+			},
+		},
+	},
+	JSON_TYPE: {
+		Id:     JSON_TYPE,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) != 1 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return false })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_varchar,
+				UseNewFramework: true,
+				NewFn:           multi.JsonType,
+			},
+		},
+	},
+	JSON_VALID: {
+		Id:     JSON_VALID,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) != 1 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return i == 0 }, func(i int) bool { return false })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_bool,
+				UseNewFramework: true,
+				NewFn:           multi.JsonValid,
+			},
+		},
+	},
+	JSON_MERGE_PATCH: {
+		Id:     JSON_MERGE_PATCH,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) < 2 {
+				return wrongFunctionParameters, nil
+			}
+			return jsonTypeCheck(inputs, func(i int) bool { return true }, func(i int) bool { return false })
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Volatile:        false,
+				Args:            []types.T{},
+				ReturnTyp:       types.T_json,
+				UseNewFramework: true,
+				NewFn:           multi.JsonMergePatch,
+			},
+		},
+	},
 
 	ENABLE_FAULT_INJECTION: {
 		Id:     ENABLE_FAULT_INJECTION,
@@ -2937,6 +3186,176 @@ var builtins = map[int]Functions{
 			},
 		},
 	},
+	CAST_VALUE_TO_ENUM: {
+		Id:     CAST_VALUE_TO_ENUM,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp:       types.T_enum,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToEnum,
+			},
+			{
+				Index:           1,
+				Args:            []types.T{types.T_char, types.T_varchar},
+				ReturnTyp:       types.T_enum,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToEnum,
+			},
+			{
+				Index:           2,
+				Args:            []types.T{types.T_text, types.T_varchar},
+				ReturnTyp:       types.T_enum,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToEnum,
+			},
+			{
+				Index:           3,
+				Args:            []types.T{types.T_uint64, types.T_varchar},
+				ReturnTyp:       types.T_enum,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToEnum,
+			},
+		},
+	},
+	CAST_ENUM_TO_VALUE: {
+		Id:     CAST_ENUM_TO_VALUE,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_enum, types.T_varchar},
+				ReturnTyp:       types.T_varchar,
+				UseNewFramework: true,
+				NewFn:           inside.CastEnumToValue,
+			},
+		},
+	},
+	CAST_VALUE_TO_SET: {
+		Id:     CAST_VALUE_TO_SET,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp:       types.T_set,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToSet,
+			},
+			{
+				Index:           1,
+				Args:            []types.T{types.T_char, types.T_varchar},
+				ReturnTyp:       types.T_set,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToSet,
+			},
+			{
+				Index:           2,
+				Args:            []types.T{types.T_text, types.T_varchar},
+				ReturnTyp:       types.T_set,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToSet,
+			},
+			{
+				Index:           3,
+				Args:            []types.T{types.T_uint64, types.T_varchar},
+				ReturnTyp:       types.T_set,
+				UseNewFramework: true,
+				NewFn:           inside.CastValueToSet,
+			},
+		},
+	},
+	CAST_SET_TO_VALUE: {
+		Id:     CAST_SET_TO_VALUE,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_set, types.T_varchar},
+				ReturnTyp:       types.T_varchar,
+				UseNewFramework: true,
+				NewFn:           inside.CastSetToValue,
+			},
+		},
+	},
+	L2_DISTANCE: {
+		Id:     L2_DISTANCE,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return vectorTypeCheck(inputs)
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_vecf32, types.T_vecf32},
+				ReturnTyp:       types.T_float64,
+				UseNewFramework: true,
+				NewFn:           binary.L2Distance[float32],
+			},
+			{
+				Index:           1,
+				Args:            []types.T{types.T_vecf64, types.T_vecf64},
+				ReturnTyp:       types.T_float64,
+				UseNewFramework: true,
+				NewFn:           binary.L2Distance[float64],
+			},
+		},
+	},
+	COSINE_DISTANCE: {
+		Id:     COSINE_DISTANCE,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return vectorTypeCheck(inputs)
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_vecf32, types.T_vecf32},
+				ReturnTyp:       types.T_float64,
+				UseNewFramework: true,
+				NewFn:           binary.CosineDistance[float32],
+			},
+			{
+				Index:           1,
+				Args:            []types.T{types.T_vecf64, types.T_vecf64},
+				ReturnTyp:       types.T_float64,
+				UseNewFramework: true,
+				NewFn:           binary.CosineDistance[float64],
+			},
+		},
+	},
+	INNER_PRODUCT: {
+		Id:     INNER_PRODUCT,
+		Flag:   plan.Function_STRICT,
+		Layout: STANDARD_FUNCTION,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			return vectorTypeCheck(inputs)
+		},
+		Overloads: []Function{
+			{
+				Index:           0,
+				Args:            []types.T{types.T_vecf32, types.T_vecf32},
+				ReturnTyp:       types.T_float64,
+				UseNewFramework: true,
+				NewFn:           binary.InnerProduct[float32],
+			},
+			{
+				Index:           1,
+				Args:            []types.T{types.T_vecf64, types.T_vecf64},
+				ReturnTyp:       types.T_float64,
+				UseNewFramework: true,
+				NewFn:           binary.InnerProduct[float64],
+			},
+		},
+	},
 	INTERNAL_AUTO_INCREMENT: {
 		Id:     INTERNAL_AUTO_INCREMENT,
 		Flag:   plan.Function_STRICT,
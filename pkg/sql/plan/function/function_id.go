@@ -312,6 +312,33 @@ const (
 	CURRVAL
 	LASTVAL
 
+	JSON_SET         // JSON_SET
+	JSON_INSERT      // JSON_INSERT
+	JSON_REPLACE     // JSON_REPLACE
+	JSON_REMOVE      // JSON_REMOVE
+	JSON_ARRAY       // JSON_ARRAY
+	JSON_OBJECT      // JSON_OBJECT
+	JSON_CONTAINS    // JSON_CONTAINS
+	JSON_KEYS        // JSON_KEYS
+	JSON_LENGTH      // JSON_LENGTH
+	JSON_TYPE        // JSON_TYPE
+	JSON_VALID       // JSON_VALID
+	JSON_MERGE_PATCH // JSON_MERGE_PATCH
+	JSON_ARRAYAGG    // JSON_ARRAYAGG
+	JSON_OBJECTAGG   // JSON_OBJECTAGG
+
+	// the conversion between the values and the storage of ENUM and SET,
+	// they are added by the plan.
+	CAST_VALUE_TO_ENUM
+	CAST_ENUM_TO_VALUE
+	CAST_VALUE_TO_SET
+	CAST_SET_TO_VALUE
+
+	// the distances of the vectors
+	L2_DISTANCE
+	COSINE_DISTANCE
+	INNER_PRODUCT
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -386,6 +413,14 @@ var functionIdRegister = map[string]int32{
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
 	"median":                MEDIAN,
+	// window
+	"row_number":  ROW_NUMBER,
+	"rank":        RANK,
+	"dense_rank":  DENSE_RANK,
+	"lag":         LAG,
+	"lead":        LEAD,
+	"first_value": FIRST_VALUE,
+	"last_value":  LAST_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -541,6 +576,27 @@ var functionIdRegister = map[string]int32{
 	"setval":                         SETVAL,
 	"currval":                        CURRVAL,
 	"lastval":                        LASTVAL,
+	"json_set":                       JSON_SET,
+	"json_insert":                    JSON_INSERT,
+	"json_replace":                   JSON_REPLACE,
+	"json_remove":                    JSON_REMOVE,
+	"json_array":                     JSON_ARRAY,
+	"json_object":                    JSON_OBJECT,
+	"json_contains":                  JSON_CONTAINS,
+	"json_keys":                      JSON_KEYS,
+	"json_length":                    JSON_LENGTH,
+	"json_type":                      JSON_TYPE,
+	"json_valid":                     JSON_VALID,
+	"json_merge_patch":               JSON_MERGE_PATCH,
+	"json_arrayagg":                  JSON_ARRAYAGG,
+	"json_objectagg":                 JSON_OBJECTAGG,
+	"cast_value_to_enum":             CAST_VALUE_TO_ENUM,
+	"cast_enum_to_value":             CAST_ENUM_TO_VALUE,
+	"cast_value_to_set":              CAST_VALUE_TO_SET,
+	"cast_set_to_value":              CAST_SET_TO_VALUE,
+	"l2_distance":                    L2_DISTANCE,
+	"cosine_distance":                COSINE_DISTANCE,
+	"inner_product":                  INNER_PRODUCT,
 }
 
 func GetFunctionIsWinfunByName(name string) bool {
@@ -23,6 +23,7 @@ import (
 
 // init function fills the functionRegister with
 // aggregates,	see initAggregateFunction
+// windows,	see initWindowFunction
 // builtins,	see initBuiltIns
 // operators,	see initOperators
 func init() {
@@ -31,6 +32,7 @@ func init() {
 	initOperators()
 	initBuiltIns()
 	initAggregateFunction()
+	initWindowFunction()
 
 	initTypeCheckRelated()
 }
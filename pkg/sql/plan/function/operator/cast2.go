@@ -45,6 +45,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_decimal64, types.T_decimal128,
 		types.T_date, types.T_datetime,
 		types.T_time, types.T_timestamp,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_bool: {
@@ -64,6 +65,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_int16: {
@@ -75,6 +77,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_int32: {
@@ -86,6 +89,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_int64: {
@@ -97,6 +101,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_uint8: {
@@ -108,6 +113,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_uint16: {
@@ -119,6 +125,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_uint32: {
@@ -130,6 +137,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_uint64: {
@@ -141,6 +149,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year, types.T_enum, types.T_set,
 	},
 
 	types.T_float32: {
@@ -169,6 +178,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_year,
 	},
 
 	types.T_datetime: {
@@ -178,6 +188,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_decimal64, types.T_decimal128,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_year,
 	},
 
 	types.T_timestamp: {
@@ -230,6 +241,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_vecf32, types.T_vecf64,
 	},
 
 	types.T_varchar: {
@@ -244,6 +257,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_vecf32, types.T_vecf64,
 	},
 
 	types.T_binary: {
@@ -257,6 +272,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_varbinary, types.T_binary,
+		types.T_bit, types.T_year,
+		types.T_vecf32, types.T_vecf64,
 	},
 
 	types.T_varbinary: {
@@ -270,6 +287,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_vecf32, types.T_vecf64,
 	},
 
 	types.T_blob: {
@@ -284,6 +303,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_vecf32, types.T_vecf64,
 	},
 
 	types.T_text: {
@@ -298,6 +319,8 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_bit, types.T_year,
+		types.T_vecf32, types.T_vecf64,
 	},
 
 	types.T_json: {
@@ -316,6 +339,57 @@ var supportedTypeCast = map[types.T][]types.T{
 	types.T_Rowid: {
 		types.T_Rowid,
 	},
+
+	types.T_bit: {
+		types.T_bit,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary,
+	},
+
+	types.T_year: {
+		types.T_year,
+		types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary,
+	},
+
+	types.T_enum: {
+		types.T_enum,
+		types.T_int32, types.T_int64,
+		types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary,
+	},
+
+	types.T_set: {
+		types.T_set,
+		types.T_int64, types.T_uint64,
+		types.T_float64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary,
+	},
+
+	types.T_vecf32: {
+		types.T_vecf32, types.T_vecf64,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary,
+	},
+
+	types.T_vecf64: {
+		types.T_vecf32, types.T_vecf64,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary,
+	},
 }
 
 func IfTypeCastSupported(sourceType, targetType types.T) bool {
@@ -408,6 +482,24 @@ func NewCast(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 	case types.T_json:
 		s := vector.GenerateFunctionStrParameter(from)
 		err = jsonToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_bit:
+		s := vector.GenerateFunctionFixedTypeParameter[uint64](from)
+		err = bitToOthers(proc.Ctx, s, *fromType, *toType, result, length)
+	case types.T_year:
+		s := vector.GenerateFunctionFixedTypeParameter[int16](from)
+		err = yearToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_enum:
+		s := vector.GenerateFunctionFixedTypeParameter[uint16](from)
+		err = enumToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_set:
+		s := vector.GenerateFunctionFixedTypeParameter[uint64](from)
+		err = setToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_vecf32:
+		s := vector.GenerateFunctionStrParameter(from)
+		err = vectorToOthers[float32](proc.Ctx, s, *toType, result, length)
+	case types.T_vecf64:
+		s := vector.GenerateFunctionStrParameter(from)
+		err = vectorToOthers[float64](proc.Ctx, s, *toType, result, length)
 	default:
 		// XXX we set the function here to adapt to the BVT cases.
 		err = formatCastError(proc.Ctx, from, *toType, "")
@@ -437,7 +529,8 @@ func scalarNullToOthers(ctx context.Context,
 	case types.T_uint64:
 		return appendNulls[uint64](result, length)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_text, types.T_json:
+		types.T_binary, types.T_varbinary, types.T_text, types.T_json,
+		types.T_vecf32, types.T_vecf64:
 		return appendNulls[types.Varlena](result, length)
 	case types.T_float32:
 		return appendNulls[float32](result, length)
@@ -455,6 +548,12 @@ func scalarNullToOthers(ctx context.Context,
 		return appendNulls[types.Time](result, length)
 	case types.T_timestamp:
 		return appendNulls[types.Timestamp](result, length)
+	case types.T_bit, types.T_set:
+		return appendNulls[uint64](result, length)
+	case types.T_year:
+		return appendNulls[int16](result, length)
+	case types.T_enum:
+		return appendNulls[uint16](result, length)
 	}
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from NULL to %s", totype))
 }
@@ -552,6 +651,18 @@ func int8ToOthers(ctx context.Context,
 		// string type.
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return signedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -611,6 +722,18 @@ func int16ToOthers(ctx context.Context,
 		// string type.
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return signedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -670,6 +793,18 @@ func int32ToOthers(ctx context.Context,
 		// string type.
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return signedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -729,6 +864,18 @@ func int64ToOthers(ctx context.Context,
 		// string type.
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return signedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -787,6 +934,18 @@ func uint8ToOthers(ctx context.Context,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return unsignedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -845,6 +1004,18 @@ func uint16ToOthers(ctx context.Context,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return unsignedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -903,6 +1074,18 @@ func uint32ToOthers(ctx context.Context,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return unsignedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -961,6 +1144,18 @@ func uint64ToOthers(ctx context.Context,
 		types.T_binary, types.T_text, types.T_varbinary:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return unsignedToStr(source, rs, length, toType)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return integerToYear(source, rs, length)
+	case types.T_enum:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_set:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
 	case types.T_time:
 		rs := vector.MustFunctionResult[types.Time](result)
 		return integerToTime(ctx, source, rs, length)
@@ -1118,6 +1313,9 @@ func dateToOthers(proc *process.Process,
 		types.T_binary, types.T_varbinary, types.T_text:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return dateToStr(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return dateToYear(source, rs, length)
 	}
 	return moerr.NewInternalError(proc.Ctx, fmt.Sprintf("unsupported cast from date to %s", toType))
 }
@@ -1161,6 +1359,9 @@ func datetimeToOthers(proc *process.Process,
 	case types.T_decimal128:
 		rs := vector.MustFunctionResult[types.Decimal128](result)
 		return datetimeToDecimal128(proc.Ctx, source, rs, length)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return datetimeToYear(source, rs, length)
 	}
 	return moerr.NewInternalError(proc.Ctx, fmt.Sprintf("unsupported cast from datetime to %s", toType))
 }
@@ -1422,6 +1623,18 @@ func strTypeToOthers(proc *process.Process,
 			zone = proc.SessionInfo.TimeZone
 		}
 		return strToTimestamp(source, rs, zone, length)
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return strToBit(source, rs, length, toType)
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		return strToYear(source, rs, length)
+	case types.T_vecf32:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToVector[float32](source, rs, length, toType)
+	case types.T_vecf64:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToVector[float64](source, rs, length, toType)
 	case types.T_char, types.T_varchar, types.T_text,
 		types.T_binary, types.T_varbinary, types.T_blob:
 		rs := vector.MustFunctionResult[types.Varlena](result)
@@ -1486,6 +1699,190 @@ func jsonToOthers(ctx context.Context,
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from json to %s", toType))
 }
 
+func bitToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[uint64], fromType types.Type,
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_bit:
+		rs := vector.MustFunctionResult[uint64](result)
+		return integerToBit(source, rs, length, toType)
+	case types.T_int8:
+		rs := vector.MustFunctionResult[int8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int16:
+		rs := vector.MustFunctionResult[int16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int32:
+		rs := vector.MustFunctionResult[int32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint8:
+		rs := vector.MustFunctionResult[uint8](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint16:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint32:
+		rs := vector.MustFunctionResult[uint32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint64:
+		rs := vector.MustFunctionResult[uint64](result)
+		rs.SetFromParameter(source)
+		return nil
+	case types.T_float32:
+		rs := vector.MustFunctionResult[float32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float64:
+		rs := vector.MustFunctionResult[float64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_decimal64:
+		rs := vector.MustFunctionResult[types.Decimal64](result)
+		return unsignedToDecimal64(source, rs, length)
+	case types.T_decimal128:
+		rs := vector.MustFunctionResult[types.Decimal128](result)
+		return unsignedToDecimal128(source, rs, length)
+	case types.T_char, types.T_varchar, types.T_blob,
+		types.T_binary, types.T_varbinary, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return bitToStr(source, rs, length, fromType)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from bit to %s", toType))
+}
+
+func yearToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[int16],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_year:
+		rs := vector.MustFunctionResult[int16](result)
+		rs.SetFromParameter(source)
+		return nil
+	case types.T_int16:
+		rs := vector.MustFunctionResult[int16](result)
+		rs.SetFromParameter(source)
+		return nil
+	case types.T_int32:
+		rs := vector.MustFunctionResult[int32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint16:
+		rs := vector.MustFunctionResult[uint16](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint32:
+		rs := vector.MustFunctionResult[uint32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint64:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float32:
+		rs := vector.MustFunctionResult[float32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float64:
+		rs := vector.MustFunctionResult[float64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_decimal64:
+		rs := vector.MustFunctionResult[types.Decimal64](result)
+		return signedToDecimal64(source, rs, length)
+	case types.T_decimal128:
+		rs := vector.MustFunctionResult[types.Decimal128](result)
+		return signedToDecimal128(source, rs, length)
+	case types.T_char, types.T_varchar, types.T_blob,
+		types.T_binary, types.T_varbinary, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return yearToStr(source, rs, length)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from year to %s", toType))
+}
+
+// enumToOthers casts the index of ENUM, the members are converted by the
+// function cast_enum_to_value which is added by the plan.
+func enumToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[uint16],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_enum, types.T_uint16:
+		rs := vector.MustFunctionResult[uint16](result)
+		rs.SetFromParameter(source)
+		return nil
+	case types.T_int32:
+		rs := vector.MustFunctionResult[int32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint32:
+		rs := vector.MustFunctionResult[uint32](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_uint64:
+		rs := vector.MustFunctionResult[uint64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float64:
+		rs := vector.MustFunctionResult[float64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_decimal64:
+		rs := vector.MustFunctionResult[types.Decimal64](result)
+		return unsignedToDecimal64(source, rs, length)
+	case types.T_decimal128:
+		rs := vector.MustFunctionResult[types.Decimal128](result)
+		return unsignedToDecimal128(source, rs, length)
+	case types.T_char, types.T_varchar, types.T_blob,
+		types.T_binary, types.T_varbinary, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return unsignedToStr(source, rs, length, toType)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from enum to %s", toType))
+}
+
+// setToOthers casts the bitmap of SET, the members are converted by the
+// function cast_set_to_value which is added by the plan.
+func setToOthers(ctx context.Context,
+	source vector.FunctionParameterWrapper[uint64],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	switch toType.Oid {
+	case types.T_set, types.T_uint64:
+		rs := vector.MustFunctionResult[uint64](result)
+		rs.SetFromParameter(source)
+		return nil
+	case types.T_int64:
+		rs := vector.MustFunctionResult[int64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_float64:
+		rs := vector.MustFunctionResult[float64](result)
+		return numericToNumeric(ctx, source, rs, length)
+	case types.T_decimal64:
+		rs := vector.MustFunctionResult[types.Decimal64](result)
+		return unsignedToDecimal64(source, rs, length)
+	case types.T_decimal128:
+		rs := vector.MustFunctionResult[types.Decimal128](result)
+		return unsignedToDecimal128(source, rs, length)
+	case types.T_char, types.T_varchar, types.T_blob,
+		types.T_binary, types.T_varbinary, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return unsignedToStr(source, rs, length, toType)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from set to %s", toType))
+}
+
+func vectorToOthers[T types.RealNumbers](ctx context.Context,
+	source vector.FunctionParameterWrapper[types.Varlena],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	switch toType.Oid {
+	case types.T_vecf32:
+		return vectorToVector[T, float32](source, rs, length, toType)
+	case types.T_vecf64:
+		return vectorToVector[T, float64](source, rs, length, toType)
+	case types.T_char, types.T_varchar, types.T_blob,
+		types.T_binary, types.T_varbinary, types.T_text:
+		return vectorToStr[T](source, rs, length, toType)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from %s to %s", source.GetType(), toType))
+}
+
 func integerToFixFloat[T1, T2 constraints.Integer | constraints.Float](
 	ctx context.Context,
 	from vector.FunctionParameterWrapper[T1], to *vector.FunctionResult[T2], length uint64) error {
@@ -4356,3 +4753,262 @@ func floatToBytes(v float64, bitSize int) []byte {
 		return []byte(strconv.FormatFloat(float64(v), 'f', -1, bitSize))
 	}
 }
+
+func integerToBit[T constraints.Integer](
+	from vector.FunctionParameterWrapper[T],
+	to *vector.FunctionResult[uint64], length int, toType types.Type) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := types.CheckBit(uint64(v), toType.Width); err != nil {
+			return err
+		}
+		if err := to.Append(uint64(v), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func integerToYear[T constraints.Integer](
+	from vector.FunctionParameterWrapper[T],
+	to *vector.FunctionResult[int16], length int) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		y, err := types.YearFromInt(int64(v))
+		if err != nil {
+			return err
+		}
+		if err = to.Append(y, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func strToBit(
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[uint64], length int, toType types.Type) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		r, err := types.BitFromBytes(v, toType.Width)
+		if err != nil {
+			return err
+		}
+		if err = to.Append(r, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func strToYear(
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[int16], length int) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		y, err := types.ParseYear(string(v))
+		if err != nil {
+			return err
+		}
+		if err = to.Append(y, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func bitToStr(
+	from vector.FunctionParameterWrapper[uint64],
+	to *vector.FunctionResult[types.Varlena], length int, fromType types.Type) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := to.AppendBytes(types.BitToBytes(v, fromType.Width), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func yearToStr(
+	from vector.FunctionParameterWrapper[int16],
+	to *vector.FunctionResult[types.Varlena], length int) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := to.AppendBytes([]byte(types.YearString(v)), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dateToYear(
+	from vector.FunctionParameterWrapper[types.Date],
+	to *vector.FunctionResult[int16], length int) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		y, err := types.YearFromInt(int64(v.Year()))
+		if err != nil {
+			return err
+		}
+		if err = to.Append(y, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func datetimeToYear(
+	from vector.FunctionParameterWrapper[types.Datetime],
+	to *vector.FunctionResult[int16], length int) error {
+	var i uint64
+	l := uint64(length)
+	for i = 0; i < l; i++ {
+		v, null := from.GetValue(i)
+		if null {
+			if err := to.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		y, err := types.YearFromInt(int64(v.Year()))
+		if err != nil {
+			return err
+		}
+		if err = to.Append(y, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func strToVector[T types.RealNumbers](
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		vec, err := types.ParseVector[T](convertByteSliceToString(v), toType.Width)
+		if err != nil {
+			return err
+		}
+		if err = to.AppendBytes(types.VectorToBytes(vec), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func vectorToVector[T1, T2 types.RealNumbers](
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		vec := types.BytesToVector[T1](v)
+		if err := types.CheckVectorDimension(len(vec), toType.Width); err != nil {
+			return err
+		}
+		res := make([]T2, len(vec))
+		for j, f := range vec {
+			res[j] = T2(f)
+		}
+		if err := to.AppendBytes(types.VectorToBytes(res), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func vectorToStr[T types.RealNumbers](
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		result := []byte(types.VectorToString(types.BytesToVector[T](v)))
+		if toType.Oid == types.T_binary && toType.Scale == -1 {
+			if err := explicitCastToBinary(toType, result, false, to); err != nil {
+				return err
+			}
+			continue
+		}
+		if toType.Oid != types.T_text && toType.Oid != types.T_blob && int32(len(result)) > toType.Width {
+			return moerr.NewDataTruncatedNoCtx("vector", "%s is too long for %s", result, toType)
+		}
+		if err := to.AppendBytes(result, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1471,6 +1471,135 @@ func initCastTestCase() []tcTemp {
 		},
 	}
 
+	bit16 := types.New(types.T_bit, 16, 0)
+	castBitYearCases := []tcTemp{
+		{
+			info: "int64 to bit",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(),
+					[]int64{258, 65536}, []bool{false, false}),
+				testutil.NewFunctionTestInput(bit16, []uint64{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(bit16, true,
+				[]uint64{258, 0}, []bool{false, false}),
+		},
+		{
+			info: "str type to bit",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"\x01\x02"}, nil),
+				testutil.NewFunctionTestInput(bit16, []uint64{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(bit16, false,
+				[]uint64{258}, []bool{false}),
+		},
+		{
+			info: "bit to int64",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(bit16,
+					[]uint64{258}, []bool{false}),
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+				[]int64{258}, []bool{false}),
+		},
+		{
+			info: "bit to str type",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(bit16,
+					[]uint64{0x4142}, []bool{false}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"AB"}, nil),
+		},
+		{
+			info: "int64 to year",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(),
+					[]int64{0, 23, 99, 2023}, []bool{false, false, false, false}),
+				testutil.NewFunctionTestInput(types.T_year.ToType(), []int16{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_year.ToType(), false,
+				[]int16{0, 2023, 1999, 2023}, []bool{false, false, false, false}),
+		},
+		{
+			info: "int64 to year out of range",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(),
+					[]int64{1900}, []bool{false}),
+				testutil.NewFunctionTestInput(types.T_year.ToType(), []int16{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_year.ToType(), true,
+				[]int16{0}, []bool{false}),
+		},
+		{
+			info: "str type to year",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"0", "0000", "1987"}, nil),
+				testutil.NewFunctionTestInput(types.T_year.ToType(), []int16{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_year.ToType(), false,
+				[]int16{2000, 0, 1987}, []bool{false, false, false}),
+		},
+		{
+			info: "year to str type",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_year.ToType(),
+					[]int16{0, 2023}, []bool{false, false}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"0000", "2023"}, nil),
+		},
+	}
+
+	vecf32 := types.New(types.T_vecf32, 3, 0)
+	vecf64 := types.New(types.T_vecf64, 3, 0)
+	castVectorCases := []tcTemp{
+		{
+			info: "str type to vecf32",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"[1, 2.5, -3]"}, nil),
+				testutil.NewFunctionTestInput(vecf32, []string{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(vecf32, false,
+				[]string{string(types.VectorToBytes([]float32{1, 2.5, -3}))}, nil),
+		},
+		{
+			info: "str type to vecf32 with wrong dimension",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"[1, 2]"}, nil),
+				testutil.NewFunctionTestInput(vecf32, []string{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(vecf32, true,
+				[]string{""}, nil),
+		},
+		{
+			info: "vecf32 to vecf64",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(vecf32,
+					[]string{string(types.VectorToBytes([]float32{1, 2.5, -3}))}, nil),
+				testutil.NewFunctionTestInput(vecf64, []string{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(vecf64, false,
+				[]string{string(types.VectorToBytes([]float64{1, 2.5, -3}))}, nil),
+		},
+		{
+			info: "vecf64 to str type",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(vecf64,
+					[]string{string(types.VectorToBytes([]float64{1, 2.5, -3}))}, nil),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{}, nil),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"[1, 2.5, -3]"}, nil),
+		},
+	}
+
 	// init the testCases
 	testCases = append(testCases, castToSameTypeCases...)
 	testCases = append(testCases, castInt8ToOthers...)
@@ -1486,6 +1615,8 @@ func initCastTestCase() []tcTemp {
 	testCases = append(testCases, castStrToOthers...)
 	testCases = append(testCases, castDecToOthers...)
 	testCases = append(testCases, castTimestampToOthers...)
+	testCases = append(testCases, castBitYearCases...)
+	testCases = append(testCases, castVectorCases...)
 
 	return testCases
 }
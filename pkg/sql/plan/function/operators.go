@@ -287,6 +287,22 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.IsNull,
 			},
+			{
+				Index: 21,
+				Args: []types.T{
+					types.T_vecf32,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull,
+			},
+			{
+				Index: 22,
+				Args: []types.T{
+					types.T_vecf64,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNull,
+			},
 		},
 	},
 
@@ -463,6 +479,22 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.IsNotNull,
 			},
+			{
+				Index: 21,
+				Args: []types.T{
+					types.T_vecf32,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull,
+			},
+			{
+				Index: 22,
+				Args: []types.T{
+					types.T_vecf64,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.IsNotNull,
+			},
 		},
 	},
 	// comparison operator
@@ -600,3 +600,74 @@ func tryToMatch(inputs, requires []types.T) (int, int) {
 	}
 	return matchedFailed, 0
 }
+
+// jsonTypeCheck checks the arguments of the json functions. docs reports the
+// arguments which are json documents, they should be json or strings. paths reports
+// the arguments which will be cast to varchar, such as the json paths and the
+// keys. the others are json values, and they keep their own types.
+func jsonTypeCheck(inputs []types.T, docs, paths func(i int) bool) (overloadIndex int32, ts []types.T) {
+	ts = make([]types.T, len(inputs))
+	needCast := false
+	for i, t := range inputs {
+		ts[i] = t
+		switch {
+		case t == ScalarNull:
+		case docs(i):
+			if t != types.T_json && !t.IsMySQLString() {
+				return wrongFunctionParameters, nil
+			}
+		case paths(i):
+			if !t.IsMySQLString() {
+				ts[i] = types.T_varchar
+				needCast = true
+			}
+		default:
+			if !isJsonValueType(t) {
+				return wrongFunctionParameters, nil
+			}
+		}
+	}
+	if !needCast {
+		return 0, nil
+	}
+	return 0, ts
+}
+
+// isJsonValueType returns true if the values of the type can be converted to json values.
+func isJsonValueType(t types.T) bool {
+	switch t {
+	case types.T_json, types.T_bool,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+		types.T_date, types.T_datetime, types.T_time, types.T_timestamp, types.T_uuid:
+		return true
+	}
+	return t.IsMySQLString()
+}
+
+// vectorTypeCheck checks the arguments of the vector distance functions, the
+// strings are cast to the vectors. The overload 0 is for VECF32 and 1 is for
+// VECF64, the VECF32 is converted to VECF64 if they are mixed.
+func vectorTypeCheck(inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) != 2 {
+		return wrongFunctionParameters, nil
+	}
+	target := types.T_vecf32
+	for _, t := range inputs {
+		switch {
+		case t == types.T_vecf64:
+			target = types.T_vecf64
+		case t == types.T_vecf32, t == ScalarNull, t.IsMySQLString():
+		default:
+			return wrongFunctionParameters, nil
+		}
+	}
+	if target == types.T_vecf64 {
+		overloadIndex = 1
+	}
+	if inputs[0] == target && inputs[1] == target {
+		return overloadIndex, nil
+	}
+	return overloadIndex, []types.T{target, target}
+}
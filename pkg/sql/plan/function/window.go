@@ -0,0 +1,175 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func initWindowFunction() {
+	var err error
+
+	for fid, fs := range windows {
+		err = appendFunction(context.Background(), fid, fs)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// windows contains the pure window functions indexed by function id.
+// Aggregate functions can be used as window functions too, they are
+// registered in aggregates.
+// The evaluation of all of them is done by the window operator, so
+// the overloads here only describe the types.
+var windows = map[int]Functions{
+	ROW_NUMBER: {
+		Id:          ROW_NUMBER,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: rankTypeCheck,
+		Overloads: []Function{
+			{
+				Index:             0,
+				ReturnTyp:         types.T_int64,
+				ResultWillNotNull: true,
+			},
+		},
+	},
+	RANK: {
+		Id:          RANK,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: rankTypeCheck,
+		Overloads: []Function{
+			{
+				Index:             0,
+				ReturnTyp:         types.T_int64,
+				ResultWillNotNull: true,
+			},
+		},
+	},
+	DENSE_RANK: {
+		Id:          DENSE_RANK,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: rankTypeCheck,
+		Overloads: []Function{
+			{
+				Index:             0,
+				ReturnTyp:         types.T_int64,
+				ResultWillNotNull: true,
+			},
+		},
+	},
+	LAG: {
+		Id:          LAG,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: offsetTypeCheck,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+	LEAD: {
+		Id:          LEAD,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: offsetTypeCheck,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+	FIRST_VALUE: {
+		Id:          FIRST_VALUE,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: valueTypeCheck,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+	LAST_VALUE: {
+		Id:          LAST_VALUE,
+		Flag:        plan.Function_WIN,
+		Layout:      STANDARD_FUNCTION,
+		TypeCheckFn: valueTypeCheck,
+		Overloads: []Function{
+			{
+				Index:              0,
+				FlexibleReturnType: firstParameterType,
+			},
+		},
+	},
+}
+
+// rankTypeCheck is the type check for row_number, rank and dense_rank, they take no arguments.
+func rankTypeCheck(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 0 {
+		return 0, nil
+	}
+	return wrongFunctionParameters, nil
+}
+
+// offsetTypeCheck is the type check for lag(expr [, offset [, default]]) and lead.
+// the offset will be cast to int64 and the default value will be cast to the type of expr.
+func offsetTypeCheck(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) < 1 || len(inputs) > 3 {
+		return wrongFunctionParameters, nil
+	}
+	if inputs[0] == ScalarNull {
+		return wrongFunctionParameters, nil
+	}
+	if len(inputs) == 1 {
+		return 0, nil
+	}
+	ts = make([]types.T, len(inputs))
+	ts[0] = inputs[0]
+	if inputs[1] != types.T_int64 && inputs[1] != ScalarNull && !castTable[inputs[1]][types.T_int64] {
+		return wrongFunctionParameters, nil
+	}
+	ts[1] = types.T_int64
+	if len(inputs) == 3 {
+		if inputs[2] != inputs[0] && inputs[2] != ScalarNull && !castTable[inputs[2]][inputs[0]] {
+			return wrongFunctionParameters, nil
+		}
+		ts[2] = inputs[0]
+	}
+	return 0, ts
+}
+
+// valueTypeCheck is the type check for first_value and last_value.
+func valueTypeCheck(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 1 && inputs[0] != ScalarNull {
+		return 0, nil
+	}
+	return wrongFunctionParameters, nil
+}
+
+func firstParameterType(parameters []types.Type) types.Type {
+	return parameters[0]
+}
@@ -0,0 +1,247 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// NewGeneratedColumnBinder returns the binder of the expression of the
+// generated column at colPos of tableDef, generated is the set of the names of
+// all the generated columns of the table.
+func NewGeneratedColumnBinder(builder *QueryBuilder, ctx *BindContext, tableDef *TableDef, colPos int32, generated map[string]bool) *GeneratedColumnBinder {
+	b := &GeneratedColumnBinder{
+		tableDef:  tableDef,
+		colPos:    colPos,
+		generated: generated,
+	}
+	b.sysCtx = builder.GetContext()
+	b.builder = builder
+	b.ctx = ctx
+	b.impl = b
+	return b
+}
+
+func (b *GeneratedColumnBinder) colName() string {
+	return b.tableDef.Cols[b.colPos].Name
+}
+
+func (b *GeneratedColumnBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
+	switch astExpr.(type) {
+	case *tree.VarExpr, *tree.ParamExpr:
+		return nil, moerr.NewGeneratedColumnFunctionNotAllowed(b.GetContext(), b.colName())
+	}
+	return b.baseBindExpr(astExpr, depth, isRoot)
+}
+
+func (b *GeneratedColumnBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
+	expr, err := b.baseBindColRef(astExpr, depth, isRoot)
+	if err != nil {
+		return nil, err
+	}
+	colRef := expr.GetCol()
+	if colRef == nil {
+		// a prior virtual column already replaced by its expression
+		return expr, nil
+	}
+
+	col := b.tableDef.Cols[colRef.ColPos]
+	if col.Typ.AutoIncr {
+		return nil, moerr.NewGeneratedColumnRefAutoInc(b.GetContext(), b.colName())
+	}
+	if b.generated[col.Name] && colRef.ColPos >= b.colPos {
+		return nil, moerr.NewGeneratedColumnNonPrior(b.GetContext())
+	}
+	// the prior generated column is replaced by its expression, so the
+	// expression only refers to the base columns
+	if gen := col.Default.GetGenerated(); gen != nil {
+		return remapGeneratedExpr(b.GetContext(), gen.Expr, b.tableDef, colRef.RelPos, 0)
+	}
+	colRef.Name = col.Name
+	return expr, nil
+}
+
+func (b *GeneratedColumnBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewGeneratedColumnFunctionNotAllowed(b.GetContext(), b.colName())
+}
+
+func (b *GeneratedColumnBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewGeneratedColumnFunctionNotAllowed(b.GetContext(), b.colName())
+}
+
+func (b *GeneratedColumnBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	return nil, moerr.NewGeneratedColumnFunctionNotAllowed(b.GetContext(), b.colName())
+}
+
+// buildGeneratedColumns binds the expressions of the generated columns of the
+// table in the order of the columns, defs is the definitions of the generated
+// columns by their names.
+func buildGeneratedColumns(ctx CompilerContext, tableDef *TableDef, defs map[string]*tree.AttributeGeneratedAlways) error {
+	if len(defs) == 0 {
+		return nil
+	}
+
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return err
+	}
+
+	generated := make(map[string]bool, len(defs))
+	for name := range defs {
+		generated[name] = true
+	}
+	for i, col := range tableDef.Cols {
+		def, ok := defs[col.Name]
+		if !ok {
+			continue
+		}
+		binder := NewGeneratedColumnBinder(builder, bindContext, tableDef, int32(i), generated)
+		expr, err := binder.BindExpr(def.Expr, 0, true)
+		if err != nil {
+			return err
+		}
+		if err = checkGeneratedColumnFunctions(ctx, col.Name, expr); err != nil {
+			return err
+		}
+		expr, err = makePlan2CastExpr(ctx.GetContext(), expr, col.Typ)
+		if err != nil {
+			return err
+		}
+
+		fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithSingleQuoteString())
+		def.Expr.Format(fmtCtx)
+		col.Default.Generated = &plan.GeneratedCol{
+			Expr:         expr,
+			OriginString: fmtCtx.String(),
+			Stored:       def.Stored,
+		}
+	}
+	return nil
+}
+
+// checkGeneratedColumnFunctions checks that the expression of a generated
+// column is deterministic.
+func checkGeneratedColumnFunctions(ctx CompilerContext, col string, expr *Expr) error {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		f, err := function.GetFunctionByID(ctx.GetContext(), exprImpl.F.Func.GetObj())
+		if err != nil {
+			return err
+		}
+		if f.Volatile || f.RealTimeRelated {
+			return moerr.NewGeneratedColumnFunctionNotAllowed(ctx.GetContext(), col)
+		}
+		for _, arg := range exprImpl.F.Args {
+			if err = checkGeneratedColumnFunctions(ctx, col, arg); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		for _, e := range exprImpl.List.List {
+			if err := checkGeneratedColumnFunctions(ctx, col, e); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_V, *plan.Expr_P, *plan.Expr_Sub:
+		return moerr.NewGeneratedColumnFunctionNotAllowed(ctx.GetContext(), col)
+	}
+	return nil
+}
+
+// remapGeneratedExpr returns a copy of the expression of a generated column,
+// whose columns refer to the columns of tableDef bound at tag.
+func remapGeneratedExpr(ctx context.Context, expr *Expr, tableDef *TableDef, tag int32, depth int32) (*Expr, error) {
+	expr = DeepCopyExpr(expr)
+	if err := remapGeneratedColRefs(ctx, expr, tableDef, tag, depth); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+func remapGeneratedColRefs(ctx context.Context, expr *Expr, tableDef *TableDef, tag int32, depth int32) error {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if err := remapGeneratedColRefs(ctx, arg, tableDef, tag, depth); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		for _, e := range exprImpl.List.List {
+			if err := remapGeneratedColRefs(ctx, e, tableDef, tag, depth); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_Col:
+		colPos := NotFound
+		for i, col := range tableDef.Cols {
+			if col.Name == exprImpl.Col.Name {
+				colPos = int32(i)
+				break
+			}
+		}
+		if colPos == NotFound {
+			return moerr.NewInvalidInput(ctx, "column '%s' of generated column does not exist", exprImpl.Col.Name)
+		}
+		if depth == 0 {
+			exprImpl.Col.RelPos = tag
+			exprImpl.Col.ColPos = colPos
+		} else {
+			expr.Expr = &plan.Expr_Corr{
+				Corr: &plan.CorrColRef{
+					RelPos: tag,
+					ColPos: colPos,
+					Depth:  depth,
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// bindVirtualColumn returns the expression of the column at colPos of the
+// binding if it's a virtual generated column of a scanned table, or nil.
+func (b *baseBinder) bindVirtualColumn(binding *Binding, colPos int32, depth int32) (*Expr, error) {
+	if b.builder == nil || int(binding.nodeId) >= len(b.builder.qry.Nodes) {
+		return nil, nil
+	}
+	node := b.builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || int(colPos) >= len(node.TableDef.Cols) {
+		return nil, nil
+	}
+	gen := node.TableDef.Cols[colPos].GetDefault().GetGenerated()
+	if gen == nil || gen.Stored {
+		return nil, nil
+	}
+	return remapGeneratedExpr(b.GetContext(), gen.Expr, node.TableDef, binding.tag, depth)
+}
+
+// isVirtualColumn returns true if the column is a virtual generated column.
+func isVirtualColumn(col *ColDef) bool {
+	gen := col.GetDefault().GetGenerated()
+	return gen != nil && !gen.Stored
+}
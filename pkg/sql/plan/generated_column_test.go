@@ -0,0 +1,141 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// addGeneratedTable creates the table gen_t in the mock context.
+func addGeneratedTable(t *testing.T, mock *MockOptimizer) *TableDef {
+	logicPlan, err := runOneStmt(mock, t, `create table gen_t (
+		a int primary key,
+		b int as (a + 1) stored,
+		c int generated always as (b * 2) virtual,
+		d json,
+		e varchar(10) as (json_unquote(json_extract(d, '$.name'))) stored,
+		key(e))`)
+	require.NoError(t, err)
+	tableDef := logicPlan.GetDdl().GetCreateTable().TableDef
+	tableDef.TblId = 1000
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.tables["gen_t"] = tableDef
+	ctx.objects["gen_t"] = &ObjectRef{SchemaName: "tpch", ObjName: "gen_t", Obj: 1000, PubAccountId: -1}
+	ctx.id2name[tableDef.TblId] = "gen_t"
+	ctx.stats["gen_t"] = &plan.Stats{Outcnt: 1}
+	return tableDef
+}
+
+func colRefNames(expr *Expr, names []string) []string {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			names = colRefNames(arg, names)
+		}
+	case *plan.Expr_Col:
+		names = append(names, e.Col.Name)
+	}
+	return names
+}
+
+func TestBuildGeneratedColumns(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	tableDef := addGeneratedTable(t, mock)
+
+	cols := make(map[string]*ColDef)
+	for _, col := range tableDef.Cols {
+		cols[col.Name] = col
+	}
+	require.Nil(t, cols["a"].Default.Generated)
+	require.True(t, cols["b"].Default.Generated.Stored)
+	require.Equal(t, "a + 1", cols["b"].Default.Generated.OriginString)
+	require.False(t, cols["c"].Default.Generated.Stored)
+	// the reference to b is replaced by its expression
+	require.Equal(t, []string{"a"}, colRefNames(cols["c"].Default.Generated.Expr, nil))
+	require.Equal(t, []string{"d"}, colRefNames(cols["e"].Default.Generated.Expr, nil))
+
+	// the default value of the generated columns survives the deep copy
+	require.Equal(t, cols["c"].Default.Generated, DeepCopyDefault(cols["c"].Default).Generated)
+}
+
+func TestBuildGeneratedColumnsError(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	tests := []struct {
+		sql  string
+		code uint16
+	}{
+		{"create table t (a int, b int as (c + 1), c int as (a))", moerr.ErrGeneratedColumnNonPrior},
+		{"create table t (a int, b int as (b + 1))", moerr.ErrGeneratedColumnNonPrior},
+		{"create table t (a int auto_increment, b int as (a + 1))", moerr.ErrGeneratedColumnRefAutoInc},
+		{"create table t (a int, b varchar(36) as (uuid()))", moerr.ErrGeneratedColumnFunctionNotAllowed},
+		{"create table t (a int, b datetime as (now()))", moerr.ErrGeneratedColumnFunctionNotAllowed},
+		{"create table t (a int, b int as (a + @v))", moerr.ErrGeneratedColumnFunctionNotAllowed},
+		{"create table t (a int, b int as ((select 1)))", moerr.ErrGeneratedColumnFunctionNotAllowed},
+		{"create table t (a int, b int as (a + 1) default 1)", moerr.ErrUnsupportedActionOnGeneratedColumn},
+		{"create table t (a int, b int as (a + 1) primary key)", moerr.ErrUnsupportedActionOnGeneratedColumn},
+		{"create table t (a int, b int as (a + 1), key(b))", moerr.ErrNotSupported},
+	}
+	for _, test := range tests {
+		_, err := runOneStmt(mock, t, test.sql)
+		require.True(t, moerr.IsMoErrCode(err, test.code), "sql: %s, err: %v", test.sql, err)
+	}
+}
+
+func TestGeneratedColumnDML(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	addGeneratedTable(t, mock)
+
+	runTestShouldPass(mock, t, []string{
+		"insert into gen_t (a, d) values (1, '{}')",
+		"insert into gen_t values (1, default, default, '{}', default)",
+		"insert into gen_t (a) select n_nationkey from nation",
+		"update gen_t set a = 2, b = default",
+		"select a, b, c, e from gen_t where c > 2",
+		"select * from gen_t",
+	}, false, false)
+
+	for _, sql := range []string{
+		"insert into gen_t values (1, 2, default, '{}', default)",
+		"insert into gen_t (a, c) values (1, 2)",
+		"insert into gen_t (a, b) select n_nationkey, n_nationkey from nation",
+		"update gen_t set c = 1",
+	} {
+		_, err := runOneStmt(mock, t, sql)
+		require.True(t, moerr.IsMoErrCode(err, moerr.ErrNonDefaultValueForGeneratedColumn), "sql: %s, err: %v", sql, err)
+	}
+}
+
+func TestVirtualColumnScan(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	addGeneratedTable(t, mock)
+
+	logicPlan, err := runOneStmt(mock, t, "select c from gen_t")
+	require.NoError(t, err)
+	query := logicPlan.GetQuery()
+	var scan *Node
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			scan = node
+		}
+	}
+	require.NotNil(t, scan)
+	// the virtual column is computed from a, so only a is read
+	require.Equal(t, 1, len(scan.TableDef.Cols))
+	require.Equal(t, "a", scan.TableDef.Cols[0].Name)
+}
@@ -0,0 +1,583 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sort"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// If the filters on the index columns are estimated to return less than this ratio
+// of the table, we look up the primary keys in the index table before reading the table
+const kIndexScanSelectivity = 0.05
+
+// The most primary keys found in the index table which are compared one by one by the
+// table scan, more keys are looked up in the list of them
+const kIndexLookupMaxKeys = 256
+
+// indexCandidate is an index of a table scan which can serve some filters of the scan
+type indexCandidate struct {
+	indexDef *plan.IndexDef
+	objRef   *ObjectRef
+	tableDef *TableDef
+
+	// the filters of the index table scan, they refer to the index table
+	filters []*plan.Expr
+	// the rows estimated to be read from the index table
+	outcnt float64
+}
+
+// checkIndexHints makes sure that all the indexes named by the hints exist in the table.
+func checkIndexHints(ctx context.Context, tableDef *TableDef, hints []*tree.IndexHint) error {
+	for _, hint := range hints {
+		for _, name := range hint.IndexNames {
+			if strings.EqualFold(name, "primary") && hasPrimaryKey(tableDef) {
+				continue
+			}
+			if findIndexDef(tableDef, name) == nil {
+				return moerr.NewKeyDoesNotExist(ctx, name, tableDef.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func hasPrimaryKey(tableDef *TableDef) bool {
+	if tableDef.Pkey != nil {
+		return true
+	}
+	for _, col := range tableDef.Cols {
+		if col.Primary {
+			return true
+		}
+	}
+	return false
+}
+
+func findIndexDef(tableDef *TableDef, name string) *plan.IndexDef {
+	for _, indexDef := range tableDef.Indexes {
+		if strings.EqualFold(indexDef.IndexName, name) {
+			return indexDef
+		}
+	}
+	return nil
+}
+
+// applyIndices makes the table scans which have selective filters on the columns of an
+// index look up the primary keys of the rows to read in the index table.
+// Only unique indexes are materialised as index tables for now, so the secondary indexes
+// are never used as an access path.
+func (builder *QueryBuilder) applyIndices(nodeID int32) (int32, error) {
+	node := builder.qry.Nodes[nodeID]
+	for i, childID := range node.Children {
+		newChildID, err := builder.applyIndices(childID)
+		if err != nil {
+			return 0, err
+		}
+		node.Children[i] = newChildID
+	}
+
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || node.ObjRef == nil {
+		return nodeID, nil
+	}
+	// the scan of an ivfflat search has looked up its index
+	if node.IndexLookup != nil || len(node.FilterList) == 0 || len(node.TableDef.Indexes) == 0 {
+		return nodeID, nil
+	}
+
+	// the index table refers to the rows by the primary key
+	pkDef := builder.compCtx.GetPrimaryKeyDef(node.ObjRef.SchemaName, node.ObjRef.ObjName)
+	if len(pkDef) != 1 {
+		return nodeID, nil
+	}
+	pkPos := findColumnPos(node.TableDef, pkDef[0].Name)
+	if pkPos == -1 {
+		return nodeID, nil
+	}
+	// a lookup by the primary key is never worse than the one by an index
+	for _, filter := range node.FilterList {
+		if funcName, ok := isIndexColFilter(filter, node.BindingTags[0], pkPos); ok && funcName == "=" {
+			return nodeID, nil
+		}
+	}
+
+	allowed, force := resolveIndexHints(node.TableDef, builder.indexHints[nodeID])
+
+	var best *indexCandidate
+	for _, indexDef := range node.TableDef.Indexes {
+		if !allowed[indexDef] {
+			continue
+		}
+		candidate, err := builder.buildIndexCandidate(node, indexDef)
+		if err != nil {
+			return 0, err
+		}
+		if candidate == nil {
+			continue
+		}
+		if best == nil || candidate.outcnt < best.outcnt {
+			best = candidate
+		}
+	}
+	if best == nil {
+		return nodeID, nil
+	}
+
+	if !force {
+		tableCnt := builder.compCtx.GetStatsCache().GetStatsInfoMap(node.TableDef.TblId).TableCnt
+		if tableCnt <= 0 && node.Stats != nil {
+			tableCnt = node.Stats.TableCnt
+		}
+		if tableCnt <= 0 || best.outcnt >= tableCnt*kIndexScanSelectivity {
+			return nodeID, nil
+		}
+	}
+
+	builder.attachIndexLookup(node, pkPos, best)
+	return nodeID, nil
+}
+
+// resolveIndexHints returns the indexes that may be used for the scan, and whether
+// the scan must use one of them. Only the hints for scans and joins decide the access
+// path, the ones for ORDER BY and GROUP BY are ignored.
+func resolveIndexHints(tableDef *TableDef, hints []*tree.IndexHint) (map[*plan.IndexDef]bool, bool) {
+	allowed := make(map[*plan.IndexDef]bool)
+	for _, indexDef := range tableDef.Indexes {
+		if indexDef.Unique && indexDef.TableExist && indexDef.IndexTableName != "" {
+			allowed[indexDef] = true
+		}
+	}
+
+	force := false
+	var used map[*plan.IndexDef]bool
+	for _, hint := range hints {
+		if hint.HintScope == tree.HintForOrderBy || hint.HintScope == tree.HintForGroupBy {
+			continue
+		}
+		switch hint.HintType {
+		case tree.HintUse, tree.HintForce:
+			if used == nil {
+				used = make(map[*plan.IndexDef]bool)
+			}
+			for _, name := range hint.IndexNames {
+				if indexDef := findIndexDef(tableDef, name); indexDef != nil {
+					used[indexDef] = true
+				}
+			}
+			if hint.HintType == tree.HintForce {
+				force = true
+			}
+		case tree.HintIgnore:
+			for _, name := range hint.IndexNames {
+				if indexDef := findIndexDef(tableDef, name); indexDef != nil {
+					delete(allowed, indexDef)
+				}
+			}
+		}
+	}
+
+	if used != nil {
+		for indexDef := range allowed {
+			if !used[indexDef] {
+				delete(allowed, indexDef)
+			}
+		}
+	}
+	return allowed, force
+}
+
+// buildIndexCandidate returns nil if none of the filters of the scan can be served by the index.
+func (builder *QueryBuilder) buildIndexCandidate(node *plan.Node, indexDef *plan.IndexDef) (*indexCandidate, error) {
+	objRef, tableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, indexDef.IndexTableName)
+	if tableDef == nil {
+		return nil, nil
+	}
+	idxPos := findColumnPos(tableDef, catalog.IndexTableIndexColName)
+	if idxPos == -1 || findColumnPos(tableDef, catalog.IndexTablePrimaryColName) == -1 {
+		return nil, nil
+	}
+	idxCol := &plan.ColRef{
+		ColPos: idxPos,
+		Name:   tableDef.Cols[idxPos].Name,
+	}
+
+	tag := node.BindingTags[0]
+	candidate := &indexCandidate{
+		indexDef: indexDef,
+		objRef:   objRef,
+		tableDef: tableDef,
+	}
+
+	if len(indexDef.Parts) == 1 {
+		pos := findColumnPos(node.TableDef, indexDef.Parts[0])
+		if pos == -1 {
+			return nil, nil
+		}
+		colTyp := node.TableDef.Cols[pos].Typ
+		if colTyp.Id != tableDef.Cols[idxPos].Typ.Id || colTyp.Scale != tableDef.Cols[idxPos].Typ.Scale {
+			return nil, nil
+		}
+
+		var keyCnt float64
+		var rangeFilters []*plan.Expr
+		for _, filter := range node.FilterList {
+			funcName, ok := isIndexColFilter(filter, tag, pos)
+			if !ok {
+				continue
+			}
+			candidate.filters = append(candidate.filters, replaceIndexColRef(DeepCopyExpr(filter), tag, pos, idxCol))
+			switch funcName {
+			case "=":
+				keyCnt = 1
+			case "in":
+				cnt := float64(len(filter.Expr.(*plan.Expr_F).F.Args[1].Expr.(*plan.Expr_List).List.List))
+				if keyCnt == 0 || cnt < keyCnt {
+					keyCnt = cnt
+				}
+			default:
+				estimated := DeepCopyExpr(filter)
+				fixIndexColName(estimated, node.TableDef)
+				rangeFilters = append(rangeFilters, estimated)
+			}
+		}
+		if len(candidate.filters) == 0 {
+			return nil, nil
+		}
+
+		// an equality on a unique index reads at most one row for each key
+		if keyCnt > 0 {
+			candidate.outcnt = keyCnt
+		} else {
+			s := builder.compCtx.GetStatsCache().GetStatsInfoMap(node.TableDef.TblId)
+			tableCnt := s.TableCnt
+			if tableCnt <= 0 && node.Stats != nil {
+				tableCnt = node.Stats.TableCnt
+			}
+			expr, err := combinePlanConjunction(builder.GetContext(), rangeFilters)
+			if err != nil {
+				return nil, err
+			}
+			candidate.outcnt = EstimateOutCnt(expr, "", tableCnt, tableCnt, s)
+		}
+		return candidate, nil
+	}
+
+	// a multi-part index is keyed by the serial of all the parts, so only the
+	// equalities on every part can be served
+	keys := make([]*plan.Expr, len(indexDef.Parts))
+	for i, part := range indexDef.Parts {
+		pos := findColumnPos(node.TableDef, part)
+		if pos == -1 {
+			return nil, nil
+		}
+		for _, filter := range node.FilterList {
+			if funcName, ok := isIndexColFilter(filter, tag, pos); ok && funcName == "=" {
+				args := filter.Expr.(*plan.Expr_F).F.Args
+				key := args[1]
+				if _, isCol := key.Expr.(*plan.Expr_Col); isCol {
+					key = args[0]
+				}
+				key, err := makePlan2CastExpr(builder.GetContext(), DeepCopyExpr(key), node.TableDef.Cols[pos].Typ)
+				if err != nil {
+					return nil, err
+				}
+				keys[i] = key
+				break
+			}
+		}
+		if keys[i] == nil {
+			return nil, nil
+		}
+	}
+
+	serial, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "serial", keys)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*plan.Expr{
+		{
+			Typ: DeepCopyType(tableDef.Cols[idxPos].Typ),
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: idxCol.ColPos,
+					Name:   idxCol.Name,
+				},
+			},
+		},
+		serial,
+	})
+	if err != nil {
+		return nil, err
+	}
+	candidate.filters = []*plan.Expr{filter}
+	candidate.outcnt = 1
+	return candidate, nil
+}
+
+// attachIndexLookup makes the table scan look up the primary keys of the rows to read in
+// the index table, the keys found are pushed down to the scan as a filter on the primary
+// key when the plan is compiled. The filters of the table scan are kept, so the lookup only
+// skips the blocks and the rows without the keys.
+func (builder *QueryBuilder) attachIndexLookup(node *plan.Node, pkPos int32, candidate *indexCandidate) {
+	tag := node.BindingTags[0]
+
+	// the index column is the first column read from the index table
+	idxPos := findColumnPos(candidate.tableDef, catalog.IndexTableIndexColName)
+	idxCol := &plan.ColRef{
+		ColPos: 0,
+		Name:   catalog.IndexTableIndexColName,
+	}
+	for _, filter := range candidate.filters {
+		replaceTag(filter, tag, 0)
+		replaceIndexColRef(filter, 0, idxPos, idxCol)
+	}
+
+	node.IndexLookup = &plan.IndexLookup{
+		ObjRef:     candidate.objRef,
+		TableDef:   candidate.tableDef,
+		FilterList: candidate.filters,
+		Pk:         makeIndexLookupPk(node, pkPos),
+	}
+}
+
+// makeIndexLookupPk returns the primary key column of the table scan to filter by the keys
+// found in the index table.
+func makeIndexLookupPk(node *plan.Node, pkPos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: DeepCopyType(node.TableDef.Cols[pkPos].Typ),
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: node.BindingTags[0],
+				ColPos: pkPos,
+				Name:   node.TableDef.Cols[pkPos].Name,
+			},
+		},
+	}
+}
+
+// BuildIndexLookupFilter builds the filter of the table scan on the primary keys found in the
+// index table. A few keys are compared one by one, and more keys are looked up in the list of
+// them, with the range of them to skip the blocks by the zonemap. It returns nil if the keys
+// can't be pushed down.
+func BuildIndexLookupFilter(proc *process.Process, lookup *plan.IndexLookup, keys *vector.Vector) (*plan.Expr, error) {
+	if keys.Length() == 0 {
+		return makePlan2BoolConstExprWithType(false), nil
+	}
+
+	makeConst := func(i int) *plan.Expr {
+		vec := keys.ToConst(i, 1, proc.Mp())
+		c := rule.GetConstantValue(vec, true)
+		if vec != keys {
+			vec.Free(proc.Mp())
+		}
+		if c == nil || c.Isnull {
+			return nil
+		}
+		return &plan.Expr{
+			Typ:  DeepCopyType(lookup.Pk.Typ),
+			Expr: &plan.Expr_C{C: c},
+		}
+	}
+	// the keys of the types which can't be constants are not pushed down
+	compare := func(funcName string, i int) (*plan.Expr, error) {
+		c := makeConst(i)
+		if c == nil {
+			return nil, nil
+		}
+		return bindFuncExprImplByPlanExpr(proc.Ctx, funcName, []*plan.Expr{DeepCopyExpr(lookup.Pk), c})
+	}
+
+	if keys.Length() <= kIndexLookupMaxKeys {
+		var filter *plan.Expr
+		for i := 0; i < keys.Length(); i++ {
+			eq, err := compare("=", i)
+			if err != nil || eq == nil {
+				return nil, err
+			}
+			if filter == nil {
+				filter = eq
+				continue
+			}
+			filter, err = bindFuncExprImplByPlanExpr(proc.Ctx, "or", []*plan.Expr{filter, eq})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return filter, nil
+	}
+
+	sels := make([]int64, keys.Length())
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	var strCol []string
+	if keys.GetType().IsVarlen() {
+		strCol = vector.MustStrCol(keys)
+	}
+	sort.Sort(false, false, false, sels, keys, strCol)
+	lower, err := compare(">=", int(sels[0]))
+	if err != nil || lower == nil {
+		return nil, err
+	}
+	upper, err := compare("<=", int(sels[len(sels)-1]))
+	if err != nil || upper == nil {
+		return nil, err
+	}
+	keyRange, err := bindFuncExprImplByPlanExpr(proc.Ctx, "and", []*plan.Expr{lower, upper})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]*plan.Expr, keys.Length())
+	for i := range list {
+		if list[i] = makeConst(i); list[i] == nil {
+			return nil, nil
+		}
+	}
+	in, err := bindFuncExprImplByPlanExpr(proc.Ctx, "in", []*plan.Expr{
+		DeepCopyExpr(lookup.Pk),
+		{
+			Typ: &plan.Type{Id: int32(types.T_tuple)},
+			Expr: &plan.Expr_List{
+				List: &plan.ExprList{List: list},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bindFuncExprImplByPlanExpr(proc.Ctx, "and", []*plan.Expr{keyRange, in})
+}
+
+// isIndexColFilter checks whether the filter compares the column with constants,
+// like col = 1, col in (1, 2) or col > 1, and returns the name of the function.
+func isIndexColFilter(filter *plan.Expr, tag, pos int32) (string, bool) {
+	f, ok := filter.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 2 {
+		return "", false
+	}
+	funcName := f.F.Func.ObjName
+	isCol := func(expr *plan.Expr) bool {
+		col, ok := expr.Expr.(*plan.Expr_Col)
+		return ok && col.Col.RelPos == tag && col.Col.ColPos == pos
+	}
+
+	switch funcName {
+	case "=", ">", ">=", "<", "<=":
+		if isCol(f.F.Args[0]) && isConstForIndex(f.F.Args[1]) {
+			return funcName, true
+		}
+		if isCol(f.F.Args[1]) && isConstForIndex(f.F.Args[0]) {
+			return funcName, true
+		}
+	case "in":
+		if _, ok := f.F.Args[1].Expr.(*plan.Expr_List); ok && isCol(f.F.Args[0]) && isConstForIndex(f.F.Args[1]) {
+			return funcName, true
+		}
+	}
+	return "", false
+}
+
+// isConstForIndex returns true if the expression has the same value for all the rows.
+func isConstForIndex(expr *plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_C, *plan.Expr_P, *plan.Expr_V:
+		return true
+	case *plan.Expr_F:
+		if exprImpl.F.Func.ObjName != "cast" {
+			return false
+		}
+		for _, arg := range exprImpl.F.Args {
+			if !isConstForIndex(arg) {
+				return false
+			}
+		}
+		return true
+	case *plan.Expr_T:
+		return true
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			if !isConstForIndex(arg) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// replaceIndexColRef replaces the references of the column with the index column.
+func replaceIndexColRef(expr *plan.Expr, tag, pos int32, idxCol *plan.ColRef) *plan.Expr {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			exprImpl.F.Args[i] = replaceIndexColRef(arg, tag, pos, idxCol)
+		}
+	case *plan.Expr_Col:
+		if exprImpl.Col.RelPos == tag && exprImpl.Col.ColPos == pos {
+			exprImpl.Col.ColPos = idxCol.ColPos
+			exprImpl.Col.Name = idxCol.Name
+		}
+	}
+	return expr
+}
+
+func replaceTag(expr *plan.Expr, oldTag, newTag int32) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			replaceTag(arg, oldTag, newTag)
+		}
+	case *plan.Expr_Col:
+		if exprImpl.Col.RelPos == oldTag {
+			exprImpl.Col.RelPos = newTag
+		}
+	}
+}
+
+// fixIndexColName names the column references by the column names, which are the
+// keys of the StatsInfoMap.
+func fixIndexColName(expr *plan.Expr, tableDef *TableDef) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			fixIndexColName(arg, tableDef)
+		}
+	case *plan.Expr_Col:
+		if int(exprImpl.Col.ColPos) < len(tableDef.Cols) {
+			exprImpl.Col.Name = tableDef.Cols[exprImpl.Col.ColPos].Name
+		}
+	}
+}
+
+func findColumnPos(tableDef *TableDef, name string) int32 {
+	for i, col := range tableDef.Cols {
+		if col.Name == name {
+			return int32(i)
+		}
+	}
+	return -1
+}
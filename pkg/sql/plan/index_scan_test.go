@@ -0,0 +1,160 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyIndices(t *testing.T) {
+	mock := newIndexMockOptimizer()
+
+	cases := []struct {
+		sql      string
+		useIndex bool
+	}{
+		{"SELECT * FROM constraint_test.dept FORCE INDEX (dname) WHERE dname = 'sales'", true},
+		{"SELECT * FROM constraint_test.dept FORCE INDEX (dname) WHERE dname IN ('sales', 'research')", true},
+		{"SELECT * FROM constraint_test.dept FORCE INDEX (dname) WHERE dname >= 'sales'", true},
+		{"SELECT * FROM constraint_test.emp FORCE INDEX (ename) WHERE ename = 'SMITH' AND job = 'CLERK'", true},
+		// the primary key is read for the lookup though it is not selected
+		{"SELECT loc FROM constraint_test.dept FORCE INDEX (dname) WHERE dname = 'sales'", true},
+		// not all the parts of the index are compared
+		{"SELECT * FROM constraint_test.emp FORCE INDEX (ename) WHERE ename = 'SMITH'", false},
+		// no filter on the index column
+		{"SELECT * FROM constraint_test.dept FORCE INDEX (dname) WHERE loc = 'dallas'", false},
+		// the primary key is a better access path
+		{"SELECT * FROM constraint_test.dept FORCE INDEX (dname) WHERE dname = 'sales' AND deptno = 1", false},
+		{"SELECT * FROM constraint_test.dept IGNORE INDEX (dname) WHERE dname = 'sales'", false},
+		{"SELECT * FROM constraint_test.dept USE INDEX () WHERE dname = 'sales'", false},
+		// the mock has no table stats, so the index is only used when forced
+		{"SELECT * FROM constraint_test.dept WHERE dname = 'sales'", false},
+	}
+
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.useIndex, scansIndexTable(logicPlan.GetQuery()), c.sql)
+	}
+}
+
+func TestResolveIndexHints(t *testing.T) {
+	dname := &plan.IndexDef{IndexName: "dname", Unique: true, TableExist: true, IndexTableName: "t1"}
+	loc := &plan.IndexDef{IndexName: "loc", Unique: true, TableExist: true, IndexTableName: "t2"}
+	secondary := &plan.IndexDef{IndexName: "deptno"}
+	tableDef := &TableDef{Indexes: []*plan.IndexDef{dname, loc, secondary}}
+
+	allowed, force := resolveIndexHints(tableDef, nil)
+	require.False(t, force)
+	require.Equal(t, map[*plan.IndexDef]bool{dname: true, loc: true}, allowed)
+
+	allowed, force = resolveIndexHints(tableDef, []*tree.IndexHint{
+		{IndexNames: []string{"LOC"}, HintType: tree.HintForce, HintScope: tree.HintForScan},
+	})
+	require.True(t, force)
+	require.Equal(t, map[*plan.IndexDef]bool{loc: true}, allowed)
+
+	allowed, force = resolveIndexHints(tableDef, []*tree.IndexHint{
+		{IndexNames: []string{"dname"}, HintType: tree.HintIgnore, HintScope: tree.HintForJoin},
+		{IndexNames: []string{"loc"}, HintType: tree.HintIgnore, HintScope: tree.HintForOrderBy},
+	})
+	require.False(t, force)
+	require.Equal(t, map[*plan.IndexDef]bool{loc: true}, allowed)
+}
+
+func TestBuildIndexLookupFilter(t *testing.T) {
+	proc := testutil.NewProcess()
+	lookup := &plan.IndexLookup{
+		Pk: &plan.Expr{
+			Typ: &plan.Type{Id: int32(types.T_int64)},
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{ColPos: 0, Name: "deptno"},
+			},
+		},
+	}
+	newKeys := func(n int) *vector.Vector {
+		vec := vector.NewVec(types.T_int64.ToType())
+		for i := n; i > 0; i-- {
+			require.NoError(t, vector.AppendFixed(vec, int64(i), false, proc.Mp()))
+		}
+		return vec
+	}
+
+	// no key is found
+	keys := newKeys(0)
+	filter, err := BuildIndexLookupFilter(proc, lookup, keys)
+	require.NoError(t, err)
+	require.False(t, filter.Expr.(*plan.Expr_C).C.Value.(*plan.Const_Bval).Bval)
+	keys.Free(proc.Mp())
+
+	// a few keys are compared one by one
+	keys = newKeys(3)
+	filter, err = BuildIndexLookupFilter(proc, lookup, keys)
+	require.NoError(t, err)
+	require.Equal(t, "or", filter.Expr.(*plan.Expr_F).F.Func.ObjName)
+	keys.Free(proc.Mp())
+
+	// more keys are looked up in the list of them, within the range of them
+	keys = newKeys(kIndexLookupMaxKeys + 1)
+	filter, err = BuildIndexLookupFilter(proc, lookup, keys)
+	require.NoError(t, err)
+	require.Equal(t, "and", filter.Expr.(*plan.Expr_F).F.Func.ObjName)
+	in := filter.Expr.(*plan.Expr_F).F.Args[1].Expr.(*plan.Expr_F).F
+	require.Equal(t, "in", in.Func.ObjName)
+	require.Equal(t, kIndexLookupMaxKeys+1, len(in.Args[1].Expr.(*plan.Expr_List).List.List))
+	args := filter.Expr.(*plan.Expr_F).F.Args[0].Expr.(*plan.Expr_F).F.Args
+	require.Equal(t, ">=", args[0].Expr.(*plan.Expr_F).F.Func.ObjName)
+	require.Equal(t, int64(1), args[0].Expr.(*plan.Expr_F).F.Args[1].Expr.(*plan.Expr_C).C.Value.(*plan.Const_I64Val).I64Val)
+	require.Equal(t, "<=", args[1].Expr.(*plan.Expr_F).F.Func.ObjName)
+	require.Equal(t, int64(kIndexLookupMaxKeys+1), args[1].Expr.(*plan.Expr_F).F.Args[1].Expr.(*plan.Expr_C).C.Value.(*plan.Const_I64Val).I64Val)
+	keys.Free(proc.Mp())
+}
+
+// newIndexMockOptimizer names the indexes of the mock tables by their first parts,
+// so that the index hints can refer to them.
+func newIndexMockOptimizer() *MockOptimizer {
+	mock := NewMockOptimizer(false)
+	for _, tableDef := range mock.ctxt.tables {
+		for _, indexDef := range tableDef.Indexes {
+			if indexDef.IndexName == "" && len(indexDef.Parts) > 0 {
+				indexDef.IndexName = indexDef.Parts[0]
+			}
+		}
+	}
+	return mock
+}
+
+func scansIndexTable(qry *plan.Query) bool {
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.IndexLookup != nil {
+			// the primary key of the lookup is one of the columns read by the scan
+			pk := node.IndexLookup.Pk.Expr.(*plan.Expr_Col).Col
+			if int(pk.ColPos) >= len(node.TableDef.Cols) || !node.TableDef.Cols[pk.ColPos].Primary {
+				return false
+			}
+			return strings.HasPrefix(node.IndexLookup.TableDef.Name, catalog.IndexTableNamePrefix)
+		}
+	}
+	return false
+}
@@ -0,0 +1,473 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+/*
+The IVFFLAT index of the vector column is kept in a hidden table which has
+three columns:
+
+ 1. __mo_index_idx_col: the list id, the lists are numbered from 1.
+ 2. __mo_index_pri_col: the primary key of the row in the list, NULL for the
+    centroid rows.
+ 3. __mo_index_centroid_col: the centroid of the list, NULL for the rows of
+    the primary keys.
+
+The lists are built by k-means when the index is created. The centroids are
+not changed later, the rows inserted or updated are written into the list of
+the nearest centroid, and the rows deleted are deleted from their lists by the
+join on the primary key, like the rows of the unique indexes. The table scan of
+`ORDER BY <distance>(col, const) LIMIT k` reads the centroids, looks up the
+primary keys in the lists nearest to the constant, and reads the rows with
+them only. The index created on an empty table has no list, and the search
+reads the whole table. So the index is rebuilt to balance the lists after many
+rows are written, by dropping and creating it.
+*/
+
+const (
+	// DefaultIvfflatProbes is the default value of ivfflat_probes
+	DefaultIvfflatProbes = 4
+)
+
+func isIvfflatIndex(def *tree.Index) bool {
+	return def.KeyType == tree.INDEX_TYPE_IVFFLAT ||
+		(def.IndexOption != nil && def.IndexOption.IType == tree.INDEX_TYPE_IVFFLAT)
+}
+
+func isVectorType(typ *plan.Type) bool {
+	return typ.Id == int32(types.T_vecf32) || typ.Id == int32(types.T_vecf64)
+}
+
+func isIvfflatIndexDef(def *plan.IndexDef) bool {
+	return def.IndexAlgo == catalog.IndexAlgoIvfflat
+}
+
+func buildIvfflatIndexTable(createTable *plan.CreateTable, indexDef *plan.IndexDef, indexInfo *tree.Index, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if len(indexInfo.KeyParts) != 1 {
+		return moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT index on more than one column")
+	}
+	if pkeyName == "" {
+		return moerr.NewNotSupported(ctx.GetContext(), "IVFFLAT index on the table without primary key")
+	}
+	indexTableName, err := util.BuildIvfflatIndexTableName(ctx.GetContext())
+	if err != nil {
+		return err
+	}
+	vecTyp := colMap[indexInfo.KeyParts[0].ColName.Parts[0]].Typ
+	tableDef := &TableDef{
+		Name: indexTableName,
+		Cols: []*ColDef{
+			{
+				Name: catalog.IndexTableIndexColName,
+				Alg:  plan.CompressType_Lz4,
+				Typ:  &Type{Id: int32(types.T_int64)},
+				Default: &plan.Default{
+					NullAbility: false,
+				},
+			},
+			{
+				Name: catalog.IndexTablePrimaryColName,
+				Alg:  plan.CompressType_Lz4,
+				Typ:  DeepCopyType(colMap[pkeyName].Typ),
+				Default: &plan.Default{
+					NullAbility: true,
+				},
+			},
+			{
+				Name: catalog.IndexTableCentroidColName,
+				Alg:  plan.CompressType_Lz4,
+				Typ:  &Type{Id: vecTyp.Id, Width: vecTyp.Width},
+				Default: &plan.Default{
+					NullAbility: true,
+				},
+			},
+		},
+	}
+	indexDef.IndexTableName = indexTableName
+	indexDef.TableExist = true
+	indexDef.IndexAlgo = catalog.IndexAlgoIvfflat
+	if indexInfo.IndexOption != nil {
+		indexDef.IndexAlgoLists = int64(indexInfo.IndexOption.Lists)
+	}
+	createTable.IndexTables = append(createTable.IndexTables, tableDef)
+	return nil
+}
+
+func (builder *QueryBuilder) getIvfflatProbes() int64 {
+	val, err := builder.compCtx.ResolveVariable("ivfflat_probes", true, false)
+	if err != nil {
+		return DefaultIvfflatProbes
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	case int:
+		return int64(v)
+	}
+	return DefaultIvfflatProbes
+}
+
+// ivfflatSearch is a nearest neighbor search on the column of an IVFFLAT index
+type ivfflatSearch struct {
+	table    string
+	indexDef *plan.IndexDef
+	funcName string
+	query    tree.Expr
+	probes   int64
+}
+
+// findIvfflatSearch finds the nearest neighbor search on the column of an IVFFLAT index, like
+//
+//	select * from t order by l2_distance(v, '[1,2,3]') limit 10
+//
+// The table scan of such a search looks up the primary keys in the ivfflat_probes lists
+// nearest to the constant, see attachIvfflatLookup. It returns nil if the query is not such
+// a search.
+func (builder *QueryBuilder) findIvfflatSearch(clause *tree.SelectClause, orderBy tree.OrderBy, limit *tree.Limit, ctx *BindContext) *ivfflatSearch {
+	if len(orderBy) != 1 || orderBy[0].Direction == tree.Descending || limit == nil || limit.Count == nil {
+		return nil
+	}
+	if clause.Distinct || len(clause.GroupBy) > 0 || clause.Having != nil || len(clause.From.Tables) != 1 {
+		return nil
+	}
+
+	tblExpr := clause.From.Tables[0]
+	if join, ok := tblExpr.(*tree.JoinTableExpr); ok && join.Right == nil {
+		tblExpr = join.Left
+	}
+	aliased, ok := tblExpr.(*tree.AliasedTableExpr)
+	if !ok || aliased.AsOfTimestamp != nil {
+		return nil
+	}
+	tblName, ok := aliased.Expr.(*tree.TableName)
+	if !ok {
+		return nil
+	}
+	schema := string(tblName.SchemaName)
+	table := string(tblName.ObjectName)
+	if schema == "" {
+		if ctx.findCTE(table) != nil {
+			return nil
+		}
+		schema = ctx.defaultDatabase
+		if schema == "" {
+			schema = builder.compCtx.DefaultDatabase()
+		}
+	}
+	alias := string(aliased.As.Alias)
+	if alias == "" {
+		alias = table
+	}
+
+	// the distance function may be referred by the alias in the select list
+	orderExpr := orderBy[0].Expr
+	if name, ok := orderExpr.(*tree.UnresolvedName); ok && name.NumParts == 1 {
+		for _, selectExpr := range clause.Exprs {
+			if selectExpr.As != nil && selectExpr.As.Compare() == name.Parts[0] {
+				orderExpr = selectExpr.Expr
+				break
+			}
+		}
+	}
+	fn, ok := orderExpr.(*tree.FuncExpr)
+	if !ok || len(fn.Exprs) != 2 {
+		return nil
+	}
+	funcRef, ok := fn.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return nil
+	}
+	funcName := strings.ToLower(funcRef.Parts[0])
+	if funcName != "l2_distance" && funcName != "cosine_distance" {
+		return nil
+	}
+	colName, query := ivfflatSearchArgs(fn.Exprs[0], fn.Exprs[1], alias)
+	if colName == "" {
+		colName, query = ivfflatSearchArgs(fn.Exprs[1], fn.Exprs[0], alias)
+	}
+	if colName == "" {
+		return nil
+	}
+
+	_, tableDef := builder.compCtx.Resolve(schema, table)
+	if tableDef == nil || tableDef.TableType == catalog.SystemViewRel || tableDef.Pkey == nil {
+		return nil
+	}
+	var indexDef *plan.IndexDef
+	for _, def := range tableDef.Indexes {
+		if isIvfflatIndexDef(def) && def.TableExist && len(def.Parts) == 1 && strings.EqualFold(def.Parts[0], colName) {
+			indexDef = def
+			break
+		}
+	}
+	if indexDef == nil {
+		return nil
+	}
+	for _, hint := range aliased.IndexHints {
+		if hint.HintType != tree.HintIgnore {
+			continue
+		}
+		for _, name := range hint.IndexNames {
+			if strings.EqualFold(name, indexDef.IndexName) {
+				return nil
+			}
+		}
+	}
+
+	probes := builder.getIvfflatProbes()
+	if probes <= 0 {
+		return nil
+	}
+	return &ivfflatSearch{
+		table:    table,
+		indexDef: indexDef,
+		funcName: funcName,
+		query:    query,
+		probes:   probes,
+	}
+}
+
+// attachIvfflatLookup makes the table scan of the search look up the primary keys in the
+// lists nearest to the constant, see compileIndexLookup.
+func (builder *QueryBuilder) attachIvfflatLookup(nodeID int32, search *ivfflatSearch) {
+	node := builder.qry.Nodes[nodeID]
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || node.ObjRef == nil ||
+		node.TableDef.Name != search.table || node.TableDef.Pkey == nil {
+		return
+	}
+	pkPos := findColumnPos(node.TableDef, node.TableDef.Pkey.PkeyColName)
+	if pkPos == -1 {
+		return
+	}
+	objRef, tableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, search.indexDef.IndexTableName)
+	if tableDef == nil {
+		return
+	}
+	centroidPos := findColumnPos(tableDef, catalog.IndexTableCentroidColName)
+	if centroidPos == -1 {
+		return
+	}
+
+	// the centroid column is the third column read from the index table
+	centroid := &plan.Expr{
+		Typ: DeepCopyType(tableDef.Cols[centroidPos].Typ),
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: 2,
+				Name:   catalog.IndexTableCentroidColName,
+			},
+		},
+	}
+	query, err := NewDefaultBinder(builder.GetContext(), nil, nil, nil, nil).BindExpr(search.query, 0, true)
+	if err != nil {
+		// the constant is not a vector, search without the index
+		return
+	}
+	distance, err := bindFuncExprImplByPlanExpr(builder.GetContext(), search.funcName, []*plan.Expr{centroid, query})
+	if err != nil {
+		return
+	}
+
+	node.IndexLookup = &plan.IndexLookup{
+		ObjRef:   objRef,
+		TableDef: tableDef,
+		Pk:       makeIndexLookupPk(node, pkPos),
+		Ivfflat: &plan.IvfflatLookup{
+			Distance: distance,
+			Probes:   search.probes,
+		},
+	}
+}
+
+// BuildIvfflatListFilter builds the filter of the rows of the primary keys in the lists, on
+// the list column and the primary key column which are read first from the index table.
+func BuildIvfflatListFilter(ctx context.Context, lookup *plan.IndexLookup, lists []int64) (*plan.Expr, error) {
+	pkPos := findColumnPos(lookup.TableDef, catalog.IndexTablePrimaryColName)
+	if pkPos == -1 {
+		return nil, moerr.NewInternalError(ctx, "invalid ivfflat index table %s", lookup.TableDef.Name)
+	}
+	listCol := &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: 0,
+				Name:   catalog.IndexTableIndexColName,
+			},
+		},
+	}
+	pkCol := &plan.Expr{
+		Typ: DeepCopyType(lookup.TableDef.Cols[pkPos].Typ),
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: 1,
+				Name:   catalog.IndexTablePrimaryColName,
+			},
+		},
+	}
+
+	list := make([]*plan.Expr, len(lists))
+	for i, id := range lists {
+		list[i] = makePlan2Int64ConstExprWithType(id)
+	}
+	in, err := bindFuncExprImplByPlanExpr(ctx, "in", []*plan.Expr{
+		listCol,
+		{
+			Typ: &plan.Type{Id: int32(types.T_tuple)},
+			Expr: &plan.Expr_List{
+				List: &plan.ExprList{List: list},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// the centroid rows have no primary key
+	notNull, err := bindFuncExprImplByPlanExpr(ctx, "isnotnull", []*plan.Expr{pkCol})
+	if err != nil {
+		return nil, err
+	}
+	return bindFuncExprImplByPlanExpr(ctx, "and", []*plan.Expr{in, notNull})
+}
+
+// ivfflatSearchArgs returns the column and the constant of the distance function
+func ivfflatSearchArgs(col, query tree.Expr, alias string) (string, tree.Expr) {
+	name, ok := col.(*tree.UnresolvedName)
+	if !ok || name.Star || name.NumParts > 2 {
+		return "", nil
+	}
+	if name.NumParts == 2 && name.Parts[1] != alias {
+		return "", nil
+	}
+	if cast, ok := query.(*tree.CastExpr); ok {
+		if _, ok = cast.Expr.(*tree.NumVal); !ok {
+			return "", nil
+		}
+	} else if _, ok := query.(*tree.NumVal); !ok {
+		return "", nil
+	}
+	return name.Parts[0], query
+}
+
+// appendIvfflatIndexJoin joins the rows of the primary keys in the ivfflat index table, so
+// the rows of the deleted or updated rows are deleted from the index table as well.
+func appendIvfflatIndexJoin(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelectInfo, tableDef *TableDef,
+	indexDef *plan.IndexDef, baseNodeId int32, oldColPosMap map[string]int, typMap map[string]*plan.Type) error {
+	if tableDef.Pkey == nil {
+		return nil
+	}
+	joinCtx := NewBindContext(builder, bindCtx)
+	rightCtx := NewBindContext(builder, joinCtx)
+	astTblName := tree.NewTableName(tree.Identifier(indexDef.IndexTableName), tree.ObjectNamePrefix{})
+	rightId, err := builder.buildTable(astTblName, rightCtx, -1, nil)
+	if err != nil {
+		return err
+	}
+	rightTag := builder.qry.Nodes[rightId].BindingTags[0]
+	baseTag := builder.qry.Nodes[baseNodeId].BindingTags[0]
+	rightTableDef := builder.qry.Nodes[rightId].TableDef
+
+	if info.typ == "insert" {
+		rowIdCol := MakeRowIdColDef()
+		rightTableDef.Cols = append(rightTableDef.Cols, rowIdCol)
+		rightTableDef.Name2ColIndex[catalog.Row_ID] = int32(len(rightTableDef.Cols)) - 1
+	}
+	rightRowIdPos := findColumnPos(rightTableDef, catalog.Row_ID)
+	rightPkPos := findColumnPos(rightTableDef, catalog.IndexTablePrimaryColName)
+	if rightRowIdPos == -1 || rightPkPos == -1 {
+		return moerr.NewInternalError(builder.GetContext(), "invalid ivfflat index table %s", indexDef.IndexTableName)
+	}
+	rightColExpr := func(pos int32) *plan.Expr {
+		return &plan.Expr{
+			Typ: rightTableDef.Cols[pos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: rightTag,
+					ColPos: pos,
+				},
+			},
+		}
+	}
+
+	// like the unique indexes, the primary key column index = column index of row_id + 1
+	info.projectList = append(info.projectList, rightColExpr(rightRowIdPos), rightColExpr(rightPkPos))
+	info.onIdx = append(info.onIdx, info.idx)
+	info.idx = info.idx + 2
+
+	var leftExpr *Expr
+	if tableDef.Pkey.CompPkeyCol != nil {
+		args := make([]*Expr, len(tableDef.Pkey.Names))
+		for i, column := range tableDef.Pkey.Names {
+			args[i] = &plan.Expr{
+				Typ: typMap[column],
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: baseTag,
+						ColPos: int32(oldColPosMap[column]),
+					},
+				},
+			}
+		}
+		leftExpr, err = bindFuncExprImplByPlanExpr(builder.GetContext(), "serial", args)
+		if err != nil {
+			return err
+		}
+	} else {
+		pkName := tableDef.Pkey.PkeyColName
+		leftExpr = &Expr{
+			Typ: typMap[pkName],
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: baseTag,
+					ColPos: int32(oldColPosMap[pkName]),
+				},
+			},
+		}
+	}
+	condExpr, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{leftExpr, rightColExpr(rightPkPos)})
+	if err != nil {
+		return err
+	}
+
+	leftCtx := builder.ctxByNode[info.rootId]
+	err = joinCtx.mergeContexts(builder.GetContext(), leftCtx, rightCtx)
+	if err != nil {
+		return err
+	}
+	info.rootId = builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{info.rootId, rightId},
+		JoinType: plan.Node_LEFT,
+		OnList:   []*Expr{condExpr},
+	}, joinCtx)
+	bindCtx.binder = NewTableBinder(builder, bindCtx)
+	info.onIdxTbl = append(info.onIdxTbl, &plan.ObjectRef{
+		SchemaName: builder.compCtx.DefaultDatabase(),
+		ObjName:    indexDef.IndexTableName,
+	})
+	return nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTableWithVector(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	p, err := runOneStmt(mock, t, "create table t1(a int primary key, b vecf32(3), c vecf64(128), index idx using ivfflat (b) lists 10)")
+	require.NoError(t, err)
+	createTable := p.GetDdl().GetCreateTable()
+	cols := createTable.TableDef.Cols
+	require.Equal(t, int32(types.T_vecf32), cols[1].Typ.Id)
+	require.Equal(t, int32(3), cols[1].Typ.Width)
+	require.Equal(t, int32(types.T_vecf64), cols[2].Typ.Id)
+	require.Equal(t, int32(128), cols[2].Typ.Width)
+
+	indexDef := createTable.TableDef.Indexes[0]
+	require.Equal(t, catalog.IndexAlgoIvfflat, indexDef.IndexAlgo)
+	require.Equal(t, int64(10), indexDef.IndexAlgoLists)
+	require.True(t, indexDef.TableExist)
+	require.True(t, strings.HasPrefix(indexDef.IndexTableName, catalog.IvfflatIndexTableNamePrefix))
+	require.Equal(t, 1, len(createTable.IndexTables))
+	require.Equal(t, 3, len(createTable.IndexTables[0].Cols))
+	require.Equal(t, int32(types.T_vecf32), createTable.IndexTables[0].Cols[2].Typ.Id)
+
+	errCases := []string{
+		"create table t1(a int primary key, b vecf32(0))",
+		"create table t1(a int primary key, b vecf32(65536))",
+		"create table t1(a int primary key, b vecf32(3), index idx (b))",
+		"create table t1(a int primary key, b vecf32(3), unique key idx (b))",
+		"create table t1(a int primary key, b vecf32(3), primary key (b))",
+		"create table t1(a int primary key, b int, index idx using ivfflat (b))",
+		"create table t1(a int, b vecf32(3), index idx using ivfflat (b))",
+	}
+	for _, sql := range errCases {
+		_, err = runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestIvfflatLookup(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	cases := []struct {
+		sql      string
+		useIndex bool
+	}{
+		{"select id from vec_tbl order by l2_distance(v, '[1,2,3]') limit 3", true},
+		{"select id, cosine_distance(v, '[1,2,3]') as d from vec_tbl where id > 10 order by d limit 3", true},
+		{"select id from tpch.vec_tbl t order by l2_distance('[1,2,3]', t.v) limit 3", true},
+		// not the nearest neighbors
+		{"select id from vec_tbl order by l2_distance(v, '[1,2,3]') desc limit 3", false},
+		{"select id from vec_tbl order by l2_distance(v, '[1,2,3]')", false},
+		{"select id from vec_tbl order by inner_product(v, '[1,2,3]') limit 3", false},
+		{"select id from vec_tbl order by l2_distance(v, v) limit 3", false},
+		{"select id from vec_tbl ignore index (idx_v) order by l2_distance(v, '[1,2,3]') limit 3", false},
+	}
+
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		lookup := findIvfflatLookup(logicPlan.GetQuery())
+		require.Equal(t, c.useIndex, lookup != nil, c.sql)
+		if lookup == nil {
+			continue
+		}
+		require.True(t, strings.HasPrefix(lookup.TableDef.Name, catalog.IvfflatIndexTableNamePrefix), c.sql)
+		require.Equal(t, int64(DefaultIvfflatProbes), lookup.Ivfflat.Probes, c.sql)
+		require.NotNil(t, lookup.Ivfflat.Distance, c.sql)
+		// the index table is not scanned by the plan
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				require.Equal(t, "vec_tbl", node.TableDef.Name, c.sql)
+			}
+		}
+	}
+}
+
+func findIvfflatLookup(qry *plan.Query) *plan.IndexLookup {
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.IndexLookup != nil && node.IndexLookup.Ivfflat != nil {
+			return node.IndexLookup
+		}
+	}
+	return nil
+}
+
+func TestIvfflatDml(t *testing.T) {
+	mock := NewMockOptimizer(true)
+
+	// the rows of the deleted or updated rows are deleted from the index table
+	sqls := []string{
+		"delete from vec_tbl where id = 1",
+		"update vec_tbl set v = '[1,2,3]' where id = 1",
+	}
+	for _, sql := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		qry := logicPlan.GetQuery()
+		var idxRef []*plan.ObjectRef
+		var idxIdx []int32
+		for _, node := range qry.Nodes {
+			switch node.NodeType {
+			case plan.Node_DELETE:
+				idxRef, idxIdx = node.DeleteCtx.IdxRef, node.DeleteCtx.IdxIdx
+			case plan.Node_UPDATE:
+				idxRef, idxIdx = node.UpdateCtx.IdxRef, node.UpdateCtx.IdxIdx
+			}
+		}
+		require.Equal(t, 1, len(idxRef), sql)
+		require.Equal(t, 1, len(idxIdx), sql)
+		require.True(t, strings.HasPrefix(idxRef[0].ObjName, catalog.IvfflatIndexTableNamePrefix), sql)
+	}
+}
+
+func TestBuildIvfflatListFilter(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	_, tableDef := mock.CurrentContext().Resolve("tpch", catalog.IvfflatIndexTableNamePrefix+"vec_tbl_v")
+	lookup := &plan.IndexLookup{TableDef: tableDef}
+
+	filter, err := BuildIvfflatListFilter(mock.CurrentContext().GetContext(), lookup, []int64{3, 5})
+	require.NoError(t, err)
+	args := filter.Expr.(*plan.Expr_F).F.Args
+	require.Equal(t, "in", args[0].Expr.(*plan.Expr_F).F.Func.ObjName)
+	require.Equal(t, 2, len(args[0].Expr.(*plan.Expr_F).F.Args[1].Expr.(*plan.Expr_List).List.List))
+	// the centroid rows are not read
+	require.Equal(t, "isnotnull", args[1].Expr.(*plan.Expr_F).F.Func.ObjName)
+}
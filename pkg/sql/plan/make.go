@@ -253,6 +253,10 @@ func MakePlan2NullTextConstExprWithType(v string) *plan.Expr {
 }
 
 func makePlan2CastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, error) {
+	expr, done, err := castEnumExpr(ctx, expr, targetType)
+	if err != nil || done {
+		return expr, err
+	}
 	if isSameColumnType(expr.Typ, targetType) {
 		return expr, nil
 	}
@@ -37,6 +37,8 @@ type MockCompilerContext struct {
 	id2name         map[uint64]string
 	isDml           bool
 	mysqlCompatible bool
+	// the access of current user by "db.table"
+	tableAccess map[string]*TableAccess
 
 	// ctx default: nil
 	ctx context.Context
@@ -156,6 +158,24 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		pks:    []int{0},
 		outcnt: 25,
 	}
+	tpchSchema["vec_tbl"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"v", types.T_vecf32, true, 3, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 1000,
+	}
+	tpchSchema[catalog.IvfflatIndexTableNamePrefix+"vec_tbl_v"] = &Schema{
+		cols: []col{
+			{catalog.IndexTableIndexColName, types.T_int64, false, 0, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, true, 0, 0},
+			{catalog.IndexTableCentroidColName, types.T_vecf32, true, 3, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		outcnt: 1010,
+	}
 	tpchSchema["region"] = &Schema{
 		cols: []col{
 			{"r_regionkey", types.T_int32, false, 0, 0},
@@ -279,8 +299,10 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 	}
 	moSchema["mo_tables"] = &Schema{
 		cols: []col{
-			{"reldatabase", types.T_varchar, false, 50, 0},
+			{"rel_id", types.T_uint64, false, 0, 0},
 			{"relname", types.T_varchar, false, 50, 0},
+			{"reldatabase", types.T_varchar, false, 50, 0},
+			{"reldatabase_id", types.T_uint64, false, 0, 0},
 			{"relkind", types.T_varchar, false, 50, 0},
 			{"account_id", types.T_uint32, false, 0, 0},
 			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
@@ -309,6 +331,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 			{"att_is_hidden", types.T_bool, false, 0, 0},
 			{"attr_has_update", types.T_int8, false, 0, 0},
 			{"attr_update", types.T_varchar, false, 2048, 0},
+			{"attr_enum", types.T_varchar, false, 2048, 0},
 			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
 		},
 	}
@@ -386,6 +409,45 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		},
 	}
 
+	moSchema["mo_column_privs"] = &Schema{
+		cols: []col{
+			{"role_id", types.T_int32, false, 50, 0},
+			{"role_name", types.T_varchar, false, 100, 0},
+			{"database_name", types.T_varchar, false, 5000, 0},
+			{"table_name", types.T_varchar, false, 5000, 0},
+			{"column_name", types.T_varchar, false, 256, 0},
+			{"operation_user_id", types.T_uint32, false, 50, 0},
+			{"granted_time", types.T_timestamp, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+	}
+
+	moSchema["mo_policies"] = &Schema{
+		cols: []col{
+			{"policy_name", types.T_varchar, false, 64, 0},
+			{"database_name", types.T_varchar, false, 5000, 0},
+			{"table_name", types.T_varchar, false, 5000, 0},
+			{"role_names", types.T_text, false, 0, 0},
+			{"policy_expr", types.T_text, false, 0, 0},
+			{"creator", types.T_uint32, false, 50, 0},
+			{"created_time", types.T_timestamp, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+	}
+
+	moSchema["mo_column_stats"] = &Schema{
+		cols: []col{
+			{"table_id", types.T_uint64, false, 0, 0},
+			{"column_name", types.T_varchar, false, 256, 0},
+			{"row_count", types.T_float64, false, 0, 0},
+			{"null_frac", types.T_float64, false, 0, 0},
+			{"ndv", types.T_float64, false, 0, 0},
+			{"histogram", types.T_text, false, 0, 0},
+			{"analyzed_time", types.T_timestamp, false, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+	}
+
 	moSchema["mo_role"] = &Schema{
 		cols: []col{
 			{"role_id", types.T_uint64, false, 100, 0},
@@ -676,6 +738,23 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				tableDef.Indexes = []*plan.IndexDef{p}
 			}
 
+			if tableName == "vec_tbl" {
+				tableDef.Pkey = &plan.PrimaryKeyDef{
+					Names:       []string{"id"},
+					PkeyColName: "id",
+				}
+				tableDef.Indexes = []*plan.IndexDef{
+					{
+						IndexName:      "idx_v",
+						Parts:          []string{"v"},
+						IndexTableName: catalog.IvfflatIndexTableNamePrefix + "vec_tbl_v",
+						TableExist:     true,
+						IndexAlgo:      catalog.IndexAlgoIvfflat,
+						IndexAlgoLists: 10,
+					},
+				}
+			}
+
 			if tableName == "v1" {
 				tableDef.TableType = catalog.SystemViewRel
 				viewData, _ := json.Marshal(ViewData{
@@ -834,6 +913,10 @@ func (m *MockCompilerContext) IsPublishing(dbName string) (bool, error) {
 	return false, nil
 }
 
+func (m *MockCompilerContext) GetTableAccess(dbName string, tableName string) (*TableAccess, error) {
+	return m.tableAccess[dbName+"."+tableName], nil
+}
+
 type MockOptimizer struct {
 	ctxt MockCompilerContext
 }
@@ -30,6 +30,12 @@ func (builder *QueryBuilder) removeSimpleProjections(nodeID int32, parentType pl
 	for i := range node.OrderBy {
 		increaseRefCnt(node.OrderBy[i].Expr, colRefCnt)
 	}
+	if node.WinSpec != nil {
+		increaseRefCntForExprList(node.WinSpec.PartitionBy, colRefCnt)
+		for i := range node.WinSpec.OrderBy {
+			increaseRefCnt(node.WinSpec.OrderBy[i].Expr, colRefCnt)
+		}
+	}
 
 	switch node.NodeType {
 	case plan.Node_JOIN:
@@ -76,6 +82,12 @@ func (builder *QueryBuilder) removeSimpleProjections(nodeID int32, parentType pl
 	for i := range node.OrderBy {
 		node.OrderBy[i].Expr = removeProjectionsForExpr(node.OrderBy[i].Expr, projMap)
 	}
+	if node.WinSpec != nil {
+		removeProjectionsForExprList(node.WinSpec.PartitionBy, projMap)
+		for i := range node.WinSpec.OrderBy {
+			node.WinSpec.OrderBy[i].Expr = removeProjectionsForExpr(node.WinSpec.OrderBy[i].Expr, projMap)
+		}
+	}
 
 	if builder.canRemoveProject(parentType, node) {
 		allColRef := true
@@ -119,7 +131,7 @@ func (builder *QueryBuilder) canRemoveProject(parentType plan.Node_NodeType, nod
 	if parentType == plan.Node_DISTINCT || parentType == plan.Node_UNKNOWN {
 		return false
 	}
-	if parentType == plan.Node_UNION || parentType == plan.Node_UNION_ALL {
+	if parentType == plan.Node_UNION || parentType == plan.Node_UNION_ALL || parentType == plan.Node_RECURSIVE_CTE {
 		return false
 	}
 	if parentType == plan.Node_MINUS || parentType == plan.Node_MINUS_ALL {
@@ -521,6 +533,40 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 
 		node.Children[0] = childID
 
+	case plan.Node_WINDOW:
+		// filters above a window can't be pushed down, they would change the partitions
+		cantPushdown = filters
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], nil, separateNonEquiConds)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{node.Children[0]},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[0] = childID
+
+	case plan.Node_RECURSIVE_CTE:
+		// filters above a recursive CTE can't be pushed down, they would change the rows of the next iteration
+		cantPushdown = filters
+
+		for i, childID := range node.Children {
+			newChildID, cantPushdownChild := builder.pushdownFilters(childID, nil, separateNonEquiConds)
+
+			if len(cantPushdownChild) > 0 {
+				newChildID = builder.appendNode(&plan.Node{
+					NodeType:   plan.Node_FILTER,
+					Children:   []int32{childID},
+					FilterList: cantPushdownChild,
+				}, nil)
+			}
+
+			node.Children[i] = newChildID
+		}
+
 	case plan.Node_TABLE_SCAN, plan.Node_EXTERNAL_SCAN:
 		for _, filter := range filters {
 			if onlyContainsTag(filter, node.BindingTags[0]) {
@@ -0,0 +1,443 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// the partition expression is evaluated for every combination of the values of the
+// partition columns, give up pruning if there are too many of them
+const kMaxPartitionPruneValues = 1024
+
+// partitionPrune finds the partitions which may contain the rows satisfying the filters
+// of the scans on the partitioned tables. The filters with parameters of the prepared
+// statement are pruned again by PartitionPruneRule when the statement is executed.
+func (builder *QueryBuilder) partitionPrune(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.partitionPrune(childID)
+	}
+
+	if node.NodeType == plan.Node_TABLE_SCAN {
+		node.PartitionPrune = PrunePartitions(builder.compCtx.GetProcess(), node)
+	}
+}
+
+// PrunePartitions returns the partitions of the table scan which may contain the rows
+// satisfying its filters, or nil if no partition can be skipped.
+//
+// The partition of a row is computed by PartitionByDef.PartitionExpression, so the
+// partitions hit by an equality on every partition column are found by evaluating the
+// expression on the constants, which works for all of RANGE, LIST, HASH and KEY. For
+// the RANGE partitions on a single column, the partition expression is monotonic, so
+// the bounds of the column are evaluated to get the first and the last partitions.
+func PrunePartitions(proc *process.Process, node *plan.Node) *plan.PartitionPrune {
+	if node.TableDef == nil || node.TableDef.Partition == nil || len(node.FilterList) == 0 {
+		return nil
+	}
+	partitionDef := node.TableDef.Partition
+	if partitionDef.PartitionExpression == nil || len(partitionDef.Partitions) == 0 {
+		return nil
+	}
+
+	p := newPartitionPruner(proc, node)
+	if p == nil {
+		return nil
+	}
+	selected := p.pruneConjuncts(node.FilterList)
+	if selected == nil {
+		return nil
+	}
+
+	prune := &plan.PartitionPrune{
+		IsPruned: true,
+	}
+	for i, hit := range selected {
+		if hit {
+			prune.SelectedPartitions = append(prune.SelectedPartitions, partitionDef.Partitions[i])
+		}
+	}
+	if len(prune.SelectedPartitions) == len(partitionDef.Partitions) {
+		return nil
+	}
+	return prune
+}
+
+type partitionPruner struct {
+	proc         *process.Process
+	bat          *batch.Batch
+	partitionDef *plan.PartitionByDef
+	// the columns of the table scan
+	cols []*ColDef
+	// the columns used by the partition expression, they are referred by
+	// the positions of the columns when the table was created
+	partitionCols map[string]int32
+	// the column of the RANGE partitions whose expression is monotonic
+	rangeCol string
+}
+
+func newPartitionPruner(proc *process.Process, node *plan.Node) *partitionPruner {
+	if proc == nil {
+		return nil
+	}
+	partitionDef := node.TableDef.Partition
+	p := &partitionPruner{
+		proc:          proc,
+		bat:           batch.NewWithSize(0),
+		partitionDef:  partitionDef,
+		cols:          node.TableDef.Cols,
+		partitionCols: make(map[string]int32),
+	}
+	p.bat.Zs = []int64{1}
+
+	pos2name := make(map[int32]string, len(node.TableDef.Name2ColIndex))
+	for name, pos := range node.TableDef.Name2ColIndex {
+		pos2name[pos] = name
+	}
+	if len(pos2name) == 0 {
+		for i, col := range node.TableDef.Cols {
+			pos2name[int32(i)] = col.Name
+		}
+	}
+	colPos := make(map[int32]bool)
+	collectPartitionColPos(partitionDef.PartitionExpression, colPos)
+	for pos := range colPos {
+		name, ok := pos2name[pos]
+		if !ok {
+			return nil
+		}
+		p.partitionCols[name] = pos
+	}
+
+	switch partitionDef.Type {
+	case plan.PartitionType_RANGE:
+		if partitionDef.PartitionExpr != nil {
+			if col, ok := partitionDef.PartitionExpr.Expr.Expr.(*plan.Expr_Col); ok {
+				p.rangeCol = pos2name[col.Col.ColPos]
+			}
+		}
+	case plan.PartitionType_RANGE_COLUMNS:
+		if len(p.partitionCols) == 1 {
+			for name := range p.partitionCols {
+				p.rangeCol = name
+			}
+		}
+	}
+	return p
+}
+
+func collectPartitionColPos(expr *plan.Expr, colPos map[int32]bool) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		colPos[exprImpl.Col.ColPos] = true
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			collectPartitionColPos(arg, colPos)
+		}
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			collectPartitionColPos(arg, colPos)
+		}
+	}
+}
+
+// pruneConjuncts returns the partitions hit by the conjunction of the filters, nil means all of them.
+func (p *partitionPruner) pruneConjuncts(filters []*plan.Expr) []bool {
+	var selected []bool
+	eqValues := make(map[string][]*plan.Expr)
+	var lowers, uppers []*plan.Expr
+
+	for _, filter := range filters {
+		f, ok := filter.Expr.(*plan.Expr_F)
+		if !ok {
+			continue
+		}
+		switch f.F.Func.ObjName {
+		case "and":
+			selected = intersectPartitions(selected, p.pruneConjuncts(f.F.Args))
+
+		case "or":
+			var union []bool
+			for _, arg := range f.F.Args {
+				sub := p.pruneConjuncts(splitAndConjuncts(arg))
+				if sub == nil {
+					union = nil
+					break
+				}
+				union = unionPartitions(union, sub)
+			}
+			selected = intersectPartitions(selected, union)
+
+		case "=":
+			if name, val, ok := p.getColAndConst(f.F.Args[0], f.F.Args[1]); ok {
+				eqValues[name] = shorterValues(eqValues[name], []*plan.Expr{val})
+			} else if name, val, ok = p.getColAndConst(f.F.Args[1], f.F.Args[0]); ok {
+				eqValues[name] = shorterValues(eqValues[name], []*plan.Expr{val})
+			}
+
+		case "in":
+			list, ok := f.F.Args[1].Expr.(*plan.Expr_List)
+			if !ok {
+				break
+			}
+			name, ok := p.getColName(f.F.Args[0])
+			if !ok || !rule.IsConstant(f.F.Args[1]) {
+				break
+			}
+			eqValues[name] = shorterValues(eqValues[name], list.List.List)
+
+		case ">", ">=", "<", "<=":
+			if p.rangeCol == "" {
+				break
+			}
+			funcName := f.F.Func.ObjName
+			name, val, ok := p.getColAndConst(f.F.Args[0], f.F.Args[1])
+			if !ok {
+				// const op col is the same as col reversed_op const
+				if name, val, ok = p.getColAndConst(f.F.Args[1], f.F.Args[0]); !ok {
+					break
+				}
+				funcName = reverseCompareFuncName(funcName)
+			}
+			if name != p.rangeCol {
+				break
+			}
+			if funcName == ">" || funcName == ">=" {
+				lowers = append(lowers, val)
+			} else {
+				uppers = append(uppers, val)
+			}
+		}
+	}
+
+	if len(p.partitionCols) > 0 && len(eqValues) == len(p.partitionCols) {
+		selected = intersectPartitions(selected, p.pruneByValues(eqValues))
+	}
+	if len(lowers) > 0 || len(uppers) > 0 {
+		selected = intersectPartitions(selected, p.pruneByRange(lowers, uppers))
+	}
+	return selected
+}
+
+// pruneByValues evaluates the partition expression on every combination of the values.
+func (p *partitionPruner) pruneByValues(eqValues map[string][]*plan.Expr) []bool {
+	names := make([]string, 0, len(p.partitionCols))
+	cnt := 1
+	for name := range p.partitionCols {
+		values, ok := eqValues[name]
+		if !ok {
+			return nil
+		}
+		cnt *= len(values)
+		if cnt > kMaxPartitionPruneValues {
+			return nil
+		}
+		names = append(names, name)
+	}
+
+	selected := make([]bool, len(p.partitionDef.Partitions))
+	current := make(map[int32]*plan.Expr, len(names))
+	var walk func(i int) bool
+	walk = func(i int) bool {
+		if i == len(names) {
+			idx, ok := p.evalPartition(current)
+			if !ok {
+				return false
+			}
+			if idx >= 0 && idx < len(selected) {
+				selected[idx] = true
+			}
+			return true
+		}
+		pos := p.partitionCols[names[i]]
+		for _, val := range eqValues[names[i]] {
+			current[pos] = val
+			if !walk(i + 1) {
+				return false
+			}
+		}
+		return true
+	}
+	if !walk(0) {
+		return nil
+	}
+	return selected
+}
+
+// pruneByRange returns the partitions between the ones of the lower and the upper bounds.
+func (p *partitionPruner) pruneByRange(lowers, uppers []*plan.Expr) []bool {
+	pos := p.partitionCols[p.rangeCol]
+	first, last := 0, len(p.partitionDef.Partitions)-1
+	for _, val := range lowers {
+		idx, ok := p.evalPartition(map[int32]*plan.Expr{pos: val})
+		if !ok {
+			return nil
+		}
+		if idx < 0 {
+			// greater than all the partitions
+			return make([]bool, len(p.partitionDef.Partitions))
+		}
+		if idx > first {
+			first = idx
+		}
+	}
+	for _, val := range uppers {
+		idx, ok := p.evalPartition(map[int32]*plan.Expr{pos: val})
+		if !ok {
+			return nil
+		}
+		if idx >= 0 && idx < last {
+			last = idx
+		}
+	}
+
+	selected := make([]bool, len(p.partitionDef.Partitions))
+	for i := first; i <= last; i++ {
+		selected[i] = true
+	}
+	return selected
+}
+
+// evalPartition replaces the partition columns with the values and evaluates the partition expression,
+// it returns -1 if the values belong to no partition.
+func (p *partitionPruner) evalPartition(values map[int32]*plan.Expr) (int, bool) {
+	expr, err := p.replacePartitionCols(DeepCopyExpr(p.partitionDef.PartitionExpression), values)
+	if err != nil {
+		return 0, false
+	}
+	expr, err = ConstantFold(p.bat, expr, p.proc)
+	if err != nil {
+		return 0, false
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, false
+	}
+	idx, ok := constToFloat64(c.C)
+	if !ok {
+		return 0, false
+	}
+	return int(idx), true
+}
+
+func (p *partitionPruner) replacePartitionCols(expr *plan.Expr, values map[int32]*plan.Expr) (*plan.Expr, error) {
+	var err error
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		val, ok := values[exprImpl.Col.ColPos]
+		if !ok {
+			return expr, nil
+		}
+		return forceCastExpr(p.proc.Ctx, DeepCopyExpr(val), DeepCopyType(expr.Typ))
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			if exprImpl.F.Args[i], err = p.replacePartitionCols(arg, values); err != nil {
+				return nil, err
+			}
+		}
+	case *plan.Expr_List:
+		// ConstantFold doesn't go into the lists, but the in function needs them to be constants
+		for i, arg := range exprImpl.List.List {
+			if exprImpl.List.List[i], err = p.replacePartitionCols(arg, values); err != nil {
+				return nil, err
+			}
+			if exprImpl.List.List[i], err = ConstantFold(p.bat, exprImpl.List.List[i], p.proc); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return expr, nil
+}
+
+func (p *partitionPruner) getColName(expr *plan.Expr) (string, bool) {
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok || int(col.Col.ColPos) >= len(p.cols) {
+		return "", false
+	}
+	name := p.cols[col.Col.ColPos].Name
+	if _, ok = p.partitionCols[name]; !ok {
+		return "", false
+	}
+	return name, true
+}
+
+func (p *partitionPruner) getColAndConst(colExpr, constExpr *plan.Expr) (string, *plan.Expr, bool) {
+	name, ok := p.getColName(colExpr)
+	if !ok || !rule.IsConstant(constExpr) {
+		return "", nil, false
+	}
+	return name, constExpr, true
+}
+
+func splitAndConjuncts(expr *plan.Expr) []*plan.Expr {
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "and" {
+		var exprs []*plan.Expr
+		for _, arg := range f.F.Args {
+			exprs = append(exprs, splitAndConjuncts(arg)...)
+		}
+		return exprs
+	}
+	return []*plan.Expr{expr}
+}
+
+func reverseCompareFuncName(funcName string) string {
+	switch funcName {
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	}
+	return funcName
+}
+
+// shorterValues keeps the shorter list of the values of a column, the rows hit by either
+// of the lists is a superset of the ones hit by both of them.
+func shorterValues(values, others []*plan.Expr) []*plan.Expr {
+	if values == nil || len(others) < len(values) {
+		return others
+	}
+	return values
+}
+
+func intersectPartitions(selected, others []bool) []bool {
+	if selected == nil {
+		return others
+	}
+	if others == nil {
+		return selected
+	}
+	for i := range selected {
+		selected[i] = selected[i] && others[i]
+	}
+	return selected
+}
+
+func unionPartitions(selected, others []bool) []bool {
+	if selected == nil {
+		return others
+	}
+	for i := range selected {
+		selected[i] = selected[i] || others[i]
+	}
+	return selected
+}
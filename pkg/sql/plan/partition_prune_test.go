@@ -0,0 +1,165 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func addMockPartitionTable(t *testing.T, mock *MockOptimizer, sql string) {
+	p, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	tableDef := p.GetDdl().GetCreateTable().GetTableDef()
+	require.NotNil(t, tableDef.Partition)
+
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.tables[tableDef.Name] = tableDef
+	ctx.objects[tableDef.Name] = &ObjectRef{
+		SchemaName:   "tpch",
+		ObjName:      tableDef.Name,
+		PubAccountId: -1,
+	}
+	ctx.stats[tableDef.Name] = &plan.Stats{Outcnt: 1}
+}
+
+// getPrunedPartitions returns the names of the partitions hit by the scan, nil if no partition is pruned.
+func getPrunedPartitions(t *testing.T, p *Plan) []string {
+	var prune *plan.PartitionPrune
+	found := false
+	for _, node := range p.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef.Partition != nil {
+			prune = node.PartitionPrune
+			found = true
+		}
+	}
+	require.True(t, found)
+	if prune == nil {
+		return nil
+	}
+	require.True(t, prune.IsPruned)
+	names := make([]string, 0, len(prune.SelectedPartitions))
+	for _, partition := range prune.SelectedPartitions {
+		names = append(names, partition.PartitionName)
+	}
+	return names
+}
+
+func TestPartitionPrune(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	addMockPartitionTable(t, mock, `create table pt_range (a int, b int) partition by range(a) (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than (30),
+		partition p3 values less than maxvalue)`)
+	addMockPartitionTable(t, mock, `create table pt_range_columns (a int, b int) partition by range columns(a) (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than (30))`)
+	addMockPartitionTable(t, mock, `create table pt_list (a int, b int) partition by list(a) (
+		partition p0 values in (1, 3, 5),
+		partition p1 values in (2, 4, 6))`)
+	addMockPartitionTable(t, mock, `create table pt_list_columns (a int, b int) partition by list columns(a, b) (
+		partition p0 values in ((1, 1), (1, 2)),
+		partition p1 values in ((2, 1), (2, 2)))`)
+
+	cases := []struct {
+		sql        string
+		partitions []string
+	}{
+		{"select * from pt_range where a = 15", []string{"p1"}},
+		{"select * from pt_range where 15 = a", []string{"p1"}},
+		{"select * from pt_range where a < 15", []string{"p0", "p1"}},
+		{"select * from pt_range where a >= 20 and a < 25", []string{"p2"}},
+		{"select * from pt_range where a between 12 and 25", []string{"p1", "p2"}},
+		{"select * from pt_range where 25 < a", []string{"p2", "p3"}},
+		{"select * from pt_range where a > 100", []string{"p3"}},
+		{"select * from pt_range where a in (1, 25)", []string{"p0", "p2"}},
+		{"select * from pt_range where a = 1 or a = 25", []string{"p0", "p2"}},
+		{"select * from pt_range where (a = 1 or a = 25) and b = 1", []string{"p0", "p2"}},
+		{"select * from pt_range where a = 1 or b = 25", nil},
+		{"select * from pt_range where b = 1", nil},
+		{"select * from pt_range where a > 1", nil},
+		{"select * from pt_range_columns where a >= 20", []string{"p2"}},
+		{"select * from pt_range_columns where a >= 30", []string{}},
+		{"select * from pt_range_columns where a < 100", nil},
+		{"select * from pt_list where a = 3", []string{"p0"}},
+		{"select * from pt_list where a in (3, 4)", nil},
+		{"select * from pt_list where a = 7", []string{}},
+		{"select * from pt_list_columns where a = 2 and b = 1", []string{"p1"}},
+		{"select * from pt_list_columns where a = 2", nil},
+	}
+	for _, c := range cases {
+		p, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.partitions, getPrunedPartitions(t, p), c.sql)
+	}
+}
+
+func TestHashPartitionPrune(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	addMockPartitionTable(t, mock, "create table pt_hash (a int, b int) partition by hash(a) partitions 4")
+	addMockPartitionTable(t, mock, "create table pt_key (a int, b varchar(10)) partition by key(a, b) partitions 4")
+
+	cases := []struct {
+		sql string
+		cnt int
+	}{
+		{"select * from pt_hash where a = 1", 1},
+		{"select * from pt_hash where a = 1 and b = 2", 1},
+		{"select * from pt_key where a = 1 and b = 'x'", 1},
+	}
+	for _, c := range cases {
+		p, err := runOneStmt(mock, t, c.sql)
+		require.NoError(t, err, c.sql)
+		require.Equal(t, c.cnt, len(getPrunedPartitions(t, p)), c.sql)
+	}
+
+	for _, sql := range []string{
+		"select * from pt_hash where a > 1",
+		"select * from pt_hash where b = 1",
+		"select * from pt_key where a = 1",
+	} {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		require.Nil(t, getPrunedPartitions(t, p), sql)
+	}
+}
+
+func TestPartitionPruneRule(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	addMockPartitionTable(t, mock, `create table pt_range (a int, b int) partition by range(a) (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than (30))`)
+
+	p, err := runOneStmt(mock, t, "prepare stmt from select * from pt_range where a = ?")
+	require.NoError(t, err)
+	prepared := DeepCopyPlan(p.GetDcl().GetPrepare().GetPlan())
+	// the partitions can't be pruned before the parameter is known
+	require.Nil(t, getPrunedPartitions(t, prepared))
+
+	args := []*plan.Expr{makePlan2Int64ConstExprWithType(15)}
+	vp := NewVisitPlan(prepared, []VisitPlanRule{
+		NewResetParamRefRule(context.TODO(), args),
+		NewConstantFoldRule(mock.CurrentContext()),
+		NewPartitionPruneRule(mock.CurrentContext().GetProcess()),
+	})
+	require.NoError(t, vp.Visit(context.TODO()))
+	require.Equal(t, []string{"p1"}, getPrunedPartitions(t, prepared))
+}
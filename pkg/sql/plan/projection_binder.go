@@ -16,6 +16,7 @@ package plan
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -68,11 +69,214 @@ func (b *ProjectionBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32,
 }
 
 func (b *ProjectionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	if astExpr.WindowSpec != nil {
+		return b.BindWinFunc(funcName, astExpr, depth, isRoot)
+	}
 	return b.havingBinder.BindAggFunc(funcName, astExpr, depth, isRoot)
 }
 
 func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
-	return nil, moerr.NewNYI(b.GetContext(), "window functions")
+	if astExpr.WindowSpec == nil {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function %s requires an OVER clause", funcName)
+	}
+	if b.insideWin {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window function calls cannot be nested")
+	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, moerr.NewNYI(b.GetContext(), "DISTINCT in window function %s", funcName)
+	}
+	if funcName == "group_concat" {
+		return nil, moerr.NewNYI(b.GetContext(), "window function %s", funcName)
+	}
+
+	b.insideWin = true
+	defer func() {
+		b.insideWin = false
+	}()
+
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	if err != nil {
+		return nil, err
+	}
+	if funcName == "lag" || funcName == "lead" {
+		args := expr.GetF().Args
+		if len(args) > 1 {
+			if c, ok := args[1].Expr.(*plan.Expr_C); !ok || c.C.GetI64Val() < 0 {
+				return nil, moerr.NewInvalidArg(b.GetContext(), "offset of window function "+funcName, tree.String(astExpr.Exprs[1], dialect.MYSQL))
+			}
+		}
+	}
+
+	spec, err := b.bindWindowSpec(astExpr.WindowSpec, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	specStr := spec.String()
+	idx, ok := b.ctx.windowBySpec[specStr]
+	if !ok {
+		idx = int32(len(b.ctx.windowSpecs))
+		b.ctx.windowBySpec[specStr] = idx
+		b.ctx.windowTags = append(b.ctx.windowTags, b.builder.genNewTag())
+		b.ctx.windowSpecs = append(b.ctx.windowSpecs, spec)
+		b.ctx.windows = append(b.ctx.windows, nil)
+	}
+
+	colPos := int32(len(b.ctx.windows[idx]))
+	b.ctx.windows[idx] = append(b.ctx.windows[idx], expr)
+	b.builder.nameByColRef[[2]int32{b.ctx.windowTags[idx], colPos}] = tree.String(astExpr, dialect.MYSQL)
+
+	return &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: b.ctx.windowTags[idx],
+				ColPos: colPos,
+			},
+		},
+	}, nil
+}
+
+func (b *ProjectionBinder) bindWindowSpec(astSpec *tree.WindowSpec, depth int32) (*plan.WindowSpec, error) {
+	spec := &plan.WindowSpec{}
+
+	for _, astExpr := range astSpec.PartitionBy {
+		expr, err := b.BindExpr(astExpr, depth, true)
+		if err != nil {
+			return nil, err
+		}
+		spec.PartitionBy = append(spec.PartitionBy, expr)
+	}
+
+	for _, order := range astSpec.OrderBy {
+		expr, err := b.BindExpr(order.Expr, depth, true)
+		if err != nil {
+			return nil, err
+		}
+
+		orderBy := &plan.OrderBySpec{
+			Expr: expr,
+			Flag: plan.OrderBySpec_INTERNAL,
+		}
+
+		switch order.Direction {
+		case tree.Ascending:
+			orderBy.Flag |= plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy.Flag |= plan.OrderBySpec_DESC
+		}
+
+		switch order.NullsPosition {
+		case tree.NullsFirst:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_FIRST
+		case tree.NullsLast:
+			orderBy.Flag |= plan.OrderBySpec_NULLS_LAST
+		}
+
+		spec.OrderBy = append(spec.OrderBy, orderBy)
+	}
+
+	// the default frame is RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW,
+	// which is the whole partition if there is no ORDER BY.
+	spec.Frame = &plan.FrameClause{
+		Type: plan.FrameClause_RANGE,
+		Start: &plan.FrameBound{
+			Type:      plan.FrameBound_PRECEDING,
+			Unbounded: true,
+		},
+		End: &plan.FrameBound{
+			Type: plan.FrameBound_CURRENT_ROW,
+		},
+	}
+
+	astFrame := astSpec.WindowFrame
+	if astFrame == nil {
+		return spec, nil
+	}
+
+	switch astFrame.Unit {
+	case tree.WIN_FRAME_UNIT_ROWS:
+		spec.Frame.Type = plan.FrameClause_ROWS
+	case tree.WIN_FRAME_UNIT_RANGE:
+		spec.Frame.Type = plan.FrameClause_RANGE
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "GROUPS frame")
+	}
+
+	var err error
+	if spec.Frame.Start, err = b.bindFrameBound(astFrame.StartBound, spec); err != nil {
+		return nil, err
+	}
+	if astFrame.EndBound != nil {
+		if spec.Frame.End, err = b.bindFrameBound(astFrame.EndBound, spec); err != nil {
+			return nil, err
+		}
+	}
+
+	start, end := spec.Frame.Start, spec.Frame.End
+	if start.Type == plan.FrameBound_FOLLOWING && start.Unbounded {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame can not start with UNBOUNDED FOLLOWING")
+	}
+	if end.Type == plan.FrameBound_PRECEDING && end.Unbounded {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame can not end with UNBOUNDED PRECEDING")
+	}
+	if start.Type > end.Type {
+		return nil, moerr.NewSyntaxError(b.GetContext(), "window frame starts after it ends")
+	}
+
+	return spec, nil
+}
+
+func (b *ProjectionBinder) bindFrameBound(astBound tree.WindowFrameBound, spec *plan.WindowSpec) (*plan.FrameBound, error) {
+	bound := &plan.FrameBound{}
+
+	var astExpr tree.Expr
+	switch astImpl := astBound.(type) {
+	case *tree.WindowFrameBoundCurrentRow:
+		bound.Type = plan.FrameBound_CURRENT_ROW
+		return bound, nil
+	case *tree.WindowFrameBoundPreceding:
+		bound.Type = plan.FrameBound_PRECEDING
+		astExpr = astImpl.Expr
+	case *tree.WindowFrameBoundFollowing:
+		bound.Type = plan.FrameBound_FOLLOWING
+		astExpr = astImpl.Expr
+	default:
+		return nil, moerr.NewNYI(b.GetContext(), "window frame bound %T", astBound)
+	}
+
+	if astExpr == nil {
+		bound.Unbounded = true
+		return bound, nil
+	}
+
+	expr, err := b.baseBindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.GetI64Val() < 0 || c.C.Isnull {
+		return nil, moerr.NewInvalidArg(b.GetContext(), "window frame offset", tree.String(astExpr, dialect.MYSQL))
+	}
+	if _, ok = c.C.Value.(*plan.Const_I64Val); !ok {
+		return nil, moerr.NewNYI(b.GetContext(), "window frame offset %s", tree.String(astExpr, dialect.MYSQL))
+	}
+	bound.Val = expr
+
+	if spec.Frame.Type == plan.FrameClause_RANGE {
+		if len(spec.OrderBy) != 1 {
+			return nil, moerr.NewSyntaxError(b.GetContext(), "RANGE frame with offset requires exactly one ORDER BY expression")
+		}
+		switch types.T(spec.OrderBy[0].Expr.Typ.Id) {
+		case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+			types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+			types.T_float32, types.T_float64:
+		default:
+			return nil, moerr.NewNYI(b.GetContext(), "RANGE frame with offset on ORDER BY expression of type %s", types.T(spec.OrderBy[0].Expr.Typ.Id).String())
+		}
+	}
+
+	return bound, nil
 }
 
 func (b *ProjectionBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
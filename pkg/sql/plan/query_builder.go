@@ -54,6 +54,7 @@ func NewQueryBuilder(queryType plan.Query_StatementType, ctx CompilerContext) *Q
 		ctxByNode:       []*BindContext{},
 		nameByColRef:    make(map[[2]int32]string),
 		nextTag:         0,
+		indexHints:      make(map[int32][]*tree.IndexHint),
 		mysqlCompatible: mysqlCompatible,
 	}
 }
@@ -230,6 +231,10 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 		for _, expr := range node.FilterList {
 			increaseRefCnt(expr, colRefCnt)
 		}
+		// the primary key is read to filter the rows by the keys looked up
+		if node.IndexLookup != nil {
+			increaseRefCnt(node.IndexLookup.Pk, colRefCnt)
+		}
 
 		internalRemapping := &ColRefRemapping{
 			globalToLocal: make(map[[2]int32][2]int32),
@@ -271,6 +276,13 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 				return nil, err
 			}
 		}
+		if node.IndexLookup != nil {
+			decreaseRefCnt(node.IndexLookup.Pk, colRefCnt)
+			err := builder.remapColRefForExpr(node.IndexLookup.Pk, internalRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		for i, col := range node.TableDef.Cols {
 			if colRefCnt[internalRemapping.localToGlobal[i]] == 0 {
@@ -323,7 +335,8 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 
 	case plan.Node_INTERSECT, plan.Node_INTERSECT_ALL,
 		plan.Node_UNION, plan.Node_UNION_ALL,
-		plan.Node_MINUS, plan.Node_MINUS_ALL:
+		plan.Node_MINUS, plan.Node_MINUS_ALL,
+		plan.Node_RECURSIVE_CTE:
 
 		thisTag := node.BindingTags[0]
 		leftID := node.Children[0]
@@ -637,6 +650,105 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			})
 		}
 
+	case plan.Node_WINDOW:
+		windowTag := node.BindingTags[0]
+
+		for _, expr := range node.WinSpec.PartitionBy {
+			increaseRefCnt(expr, colRefCnt)
+		}
+		for _, orderBy := range node.WinSpec.OrderBy {
+			increaseRefCnt(orderBy.Expr, colRefCnt)
+		}
+		for _, expr := range node.AggList {
+			increaseRefCnt(expr, colRefCnt)
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, expr := range node.WinSpec.PartitionBy {
+			decreaseRefCnt(expr, colRefCnt)
+			err := builder.remapColRefForExpr(expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, orderBy := range node.WinSpec.OrderBy {
+			decreaseRefCnt(orderBy.Expr, colRefCnt)
+			err := builder.remapColRefForExpr(orderBy.Expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, expr := range node.AggList {
+			decreaseRefCnt(expr, colRefCnt)
+			err := builder.remapColRefForExpr(expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// the window operator appends the results of the window functions
+		// after all the columns of its child
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		childLen := int32(len(childProjList))
+		for i, expr := range node.AggList {
+			globalRef := [2]int32{windowTag, int32(i)}
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: expr.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: childLen + int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		if len(node.ProjectList) == 0 {
+			globalRef := [2]int32{windowTag, 0}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: node.AggList[0].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: childLen,
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_FILTER:
 		for _, expr := range node.FilterList {
 			increaseRefCnt(expr, colRefCnt)
@@ -825,6 +937,15 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		colRefCnt := make(map[[2]int32]int)
 		builder.removeSimpleProjections(rootID, plan.Node_UNKNOWN, false, colRefCnt)
 		ReCalcNodeStats(rootID, builder, true, true)
+		builder.partitionPrune(rootID)
+		if builder.qry.StmtType == plan.Query_SELECT {
+			var err error
+			rootID, err = builder.applyIndices(rootID)
+			if err != nil {
+				return nil, err
+			}
+			ReCalcNodeStats(rootID, builder, true, false)
+		}
 		rootID = builder.aggPushDown(rootID)
 		ReCalcNodeStats(rootID, builder, true, false)
 		rootID = builder.determineJoinOrder(rootID)
@@ -1183,6 +1304,9 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.UnionClause, astOrderBy tree.
 
 	// set heading
 	if isRoot {
+		if err = resetEnumProjection(builder.GetContext(), ctx.results); err != nil {
+			return 0, err
+		}
 		builder.qry.Headings = append(builder.qry.Headings, ctx.headings...)
 	}
 
@@ -1215,26 +1339,33 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			maskedNames = append(maskedNames, name)
 
 			ctx.cteByName[name] = &CTERef{
-				ast:        cte,
-				maskedCTEs: maskedCTEs,
+				ast:         cte,
+				maskedCTEs:  maskedCTEs,
+				isRecursive: stmt.With.IsRecursive,
 			}
 		}
 
 		// Try to do binding for CTE at declaration
 		for _, cte := range stmt.With.CTEs {
+			cteRef := ctx.cteByName[string(cte.Name.Alias)]
 			subCtx := NewBindContext(builder, ctx)
-			subCtx.maskedCTEs = ctx.cteByName[string(cte.Name.Alias)].maskedCTEs
+			subCtx.maskedCTEs = cteRef.maskedCTEs
 
 			var err error
-			switch stmt := cte.Stmt.(type) {
-			case *tree.Select:
-				_, err = builder.buildSelect(stmt, subCtx, false)
+			if cteRef.isRecursive {
+				subCtx.cteName = string(cte.Name.Alias)
+				_, err = builder.buildRecursiveCTE(cteRef, subCtx)
+			} else {
+				switch stmt := cte.Stmt.(type) {
+				case *tree.Select:
+					_, err = builder.buildSelect(stmt, subCtx, false)
 
-			case *tree.ParenSelect:
-				_, err = builder.buildSelect(stmt.Select, subCtx, false)
+				case *tree.ParenSelect:
+					_, err = builder.buildSelect(stmt.Select, subCtx, false)
 
-			default:
-				err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+				default:
+					err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+				}
 			}
 
 			if err != nil {
@@ -1287,6 +1418,11 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		return 0, moerr.NewNYI(builder.GetContext(), "statement '%s'", tree.String(stmt, dialect.MYSQL))
 	}
 
+	var ivfflat *ivfflatSearch
+	if clause != nil {
+		ivfflat = builder.findIvfflatSearch(clause, astOrderBy, astLimit, ctx)
+	}
+
 	var projectionBinder *ProjectionBinder
 	var havingList []*plan.Expr
 	var selectList tree.SelectExprs
@@ -1390,6 +1526,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		if err != nil {
 			return 0, err
 		}
+		if ivfflat != nil {
+			builder.attachIvfflatLookup(nodeID, ivfflat)
+		}
 
 		ctx.binder = NewWhereBinder(builder, ctx)
 		// unfold stars and generate headings
@@ -1647,6 +1786,17 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		for i, proj := range ctx.projects {
 			ctx.projects[i] = builder.wrapBareColRefsInAnyValue(proj, ctx)
 		}
+		for i, spec := range ctx.windowSpecs {
+			for j, expr := range ctx.windows[i] {
+				ctx.windows[i][j] = builder.wrapBareColRefsInAnyValue(expr, ctx)
+			}
+			for j, expr := range spec.PartitionBy {
+				spec.PartitionBy[j] = builder.wrapBareColRefsInAnyValue(expr, ctx)
+			}
+			for _, orderBy := range spec.OrderBy {
+				orderBy.Expr = builder.wrapBareColRefsInAnyValue(orderBy.Expr, ctx)
+			}
+		}
 	}
 
 	// FIXME: delete this when SINGLE join is ready
@@ -1693,6 +1843,55 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 	}
 
+	// append SORT and WINDOW nodes, the input of a WINDOW node is sorted by
+	// the partition keys and the order keys of its window spec
+	for i, spec := range ctx.windowSpecs {
+		for j, expr := range ctx.windows[i] {
+			nodeID, ctx.windows[i][j], err = builder.flattenSubqueries(nodeID, expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		orderBys := make([]*plan.OrderBySpec, 0, len(spec.PartitionBy)+len(spec.OrderBy))
+		for j, expr := range spec.PartitionBy {
+			nodeID, spec.PartitionBy[j], err = builder.flattenSubqueries(nodeID, expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+			orderBys = append(orderBys, &plan.OrderBySpec{
+				Expr: DeepCopyExpr(spec.PartitionBy[j]),
+				Flag: plan.OrderBySpec_INTERNAL,
+			})
+		}
+		for _, orderBy := range spec.OrderBy {
+			nodeID, orderBy.Expr, err = builder.flattenSubqueries(nodeID, orderBy.Expr, ctx)
+			if err != nil {
+				return 0, err
+			}
+			orderBys = append(orderBys, &plan.OrderBySpec{
+				Expr: DeepCopyExpr(orderBy.Expr),
+				Flag: orderBy.Flag,
+			})
+		}
+
+		if len(orderBys) > 0 {
+			nodeID = builder.appendNode(&plan.Node{
+				NodeType: plan.Node_SORT,
+				Children: []int32{nodeID},
+				OrderBy:  orderBys,
+			}, ctx)
+		}
+
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:    plan.Node_WINDOW,
+			Children:    []int32{nodeID},
+			WinSpec:     spec,
+			AggList:     ctx.windows[i],
+			BindingTags: []int32{ctx.windowTags[i]},
+		}, ctx)
+	}
+
 	// append PROJECT node
 	for i, proj := range ctx.projects {
 		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx)
@@ -1761,6 +1960,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	}
 
 	if isRoot {
+		if err = resetEnumProjection(builder.GetContext(), ctx.results); err != nil {
+			return 0, err
+		}
 		builder.qry.Headings = append(builder.qry.Headings, ctx.headings...)
 	}
 
@@ -1862,6 +2064,11 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 
 		if len(schema) == 0 {
 			cteRef := ctx.findCTE(table)
+			if cteRef != nil && cteRef.state != cteNotBuilding {
+				// a recursive CTE referred inside its own definition
+				nodeID, err = builder.buildWorkTableScan(cteRef, table, ctx)
+				return
+			}
 			if cteRef != nil {
 				subCtx := NewBindContext(builder, ctx)
 				subCtx.maskedCTEs = cteRef.maskedCTEs
@@ -1871,15 +2078,19 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 					subCtx.defaultDatabase = cteRef.defaultDatabase
 				}
 
-				switch stmt := cteRef.ast.Stmt.(type) {
-				case *tree.Select:
-					nodeID, err = builder.buildSelect(stmt, subCtx, false)
+				if cteRef.isRecursive {
+					nodeID, err = builder.buildRecursiveCTE(cteRef, subCtx)
+				} else {
+					switch stmt := cteRef.ast.Stmt.(type) {
+					case *tree.Select:
+						nodeID, err = builder.buildSelect(stmt, subCtx, false)
 
-				case *tree.ParenSelect:
-					nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
+					case *tree.ParenSelect:
+						nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
 
-				default:
-					err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+					default:
+						err = moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+					}
 				}
 
 				if err != nil {
@@ -2041,11 +2252,31 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 
 		//tableDef := builder.qry.Nodes[nodeID].GetTableDef()
 		midNode := builder.qry.Nodes[nodeID]
+		if tbl.AsOfTimestamp != nil {
+			if midNode.NodeType != plan.Node_TABLE_SCAN {
+				return 0, moerr.NewNotSupported(builder.GetContext(), "AS OF TIMESTAMP on a view or subquery")
+			}
+			snapshotTS, err := builder.buildSnapshotTS(tbl.AsOfTimestamp)
+			if err != nil {
+				return 0, err
+			}
+			midNode.SnapshotTs = snapshotTS
+			// the timestamp may be relative to NOW()
+			midNode.NotCacheable = true
+		}
+
 		//if it is the non-sys account and reads the cluster table,
 		//we add an account_id filter to make sure that the non-sys account
 		//can only read its own data.
 
 		if midNode.NodeType == plan.Node_TABLE_SCAN {
+			if len(tbl.IndexHints) > 0 {
+				if err := checkIndexHints(builder.GetContext(), midNode.TableDef, tbl.IndexHints); err != nil {
+					return 0, err
+				}
+				builder.indexHints[nodeID] = tbl.IndexHints
+			}
+
 			dbName := midNode.ObjRef.SchemaName
 			tableName := midNode.TableDef.Name
 			currentAccountID := builder.compCtx.GetAccountId()
@@ -2101,6 +2332,11 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 					builder.qry.Nodes[nodeID].FilterList = accountFilterExprs
 				}
 			}
+
+			// the binding is not added if err is not nil
+			if err == nil {
+				err = builder.applyTableAccess(nodeID, ctx)
+			}
 		}
 		return
 	case *tree.StatementSource:
@@ -0,0 +1,221 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// DefaultMaxRecursionDepth is the default value of cte_max_recursion_depth
+const DefaultMaxRecursionDepth = 1000
+
+// buildRecursiveCTE builds the definition of a CTE declared by WITH RECURSIVE.
+// A definition which refers to itself must be "anchor UNION [ALL] recursive member",
+// it is built as a RECURSIVE_CTE node whose first child is the anchor and second
+// child is the recursive member. The recursive member reads the rows produced by
+// the previous iteration through a MATERIAL_SCAN node.
+func (builder *QueryBuilder) buildRecursiveCTE(cteRef *CTERef, ctx *BindContext) (int32, error) {
+	name := ctx.cteName
+	ctx.recursiveCTE = cteRef
+
+	var stmt tree.Statement = cteRef.ast.Stmt
+	if parenSelect, ok := stmt.(*tree.ParenSelect); ok {
+		stmt = parenSelect.Select
+	}
+	sltStmt, ok := stmt.(*tree.Select)
+	if !ok {
+		return 0, moerr.NewParseError(builder.GetContext(), "unexpected statement: '%v'", tree.String(stmt, dialect.MYSQL))
+	}
+
+	defer func() {
+		cteRef.state = cteNotBuilding
+		cteRef.workTable = nil
+		cteRef.workTableRefs = 0
+	}()
+
+	unionClause, ok := sltStmt.Select.(*tree.UnionClause)
+	if !ok || unionClause.Type != tree.UNION {
+		cteRef.state = cteBuildingNoUnion
+		return builder.buildSelect(sltStmt, ctx, false)
+	}
+
+	// the anchor decides the columns of the CTE
+	cteRef.state = cteBuildingAnchor
+	anchorCtx := NewBindContext(builder, ctx)
+	anchorID, err := builder.buildSelect(&tree.Select{Select: unionClause.Left}, anchorCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	cols := cteRef.ast.Name.Cols
+	if len(cols) > len(anchorCtx.headings) {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "table %q has %d columns available but %d columns specified", name, len(anchorCtx.headings), len(cols))
+	}
+
+	headings := make([]string, len(anchorCtx.headings))
+	copy(headings, anchorCtx.headings)
+	for i, col := range cols {
+		headings[i] = string(col)
+	}
+
+	anchorNode := builder.qry.Nodes[anchorID]
+	workTable := &plan.TableDef{
+		Name: name,
+	}
+	for i, expr := range anchorNode.ProjectList {
+		workTable.Cols = append(workTable.Cols, &plan.ColDef{
+			ColId: uint64(i),
+			Name:  strings.ToLower(headings[i]),
+			Typ:   expr.Typ,
+		})
+	}
+
+	cteRef.state = cteBuildingRecursive
+	cteRef.workTable = workTable
+	recursiveCtx := NewBindContext(builder, ctx)
+	recursiveID, err := builder.buildSelect(&tree.Select{Select: unionClause.Right}, recursiveCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if cteRef.workTableRefs == 0 {
+		// the CTE doesn't refer to itself, it is an ordinary CTE
+		cteRef.state = cteBuildingNoUnion
+		ctx.recursiveCTE = nil
+		return builder.buildSelect(sltStmt, ctx, false)
+	}
+
+	if len(recursiveCtx.aggregates) > 0 || len(recursiveCtx.groups) > 0 || len(recursiveCtx.windows) > 0 {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "Recursive Common Table Expression '%s' can contain neither aggregation nor window functions in recursive query block", name)
+	}
+	if recursiveCtx.isDistinct {
+		return 0, moerr.NewNYI(builder.GetContext(), "DISTINCT in recursive query block of Common Table Expression")
+	}
+	if sltStmt.OrderBy != nil || sltStmt.Limit != nil {
+		return 0, moerr.NewNYI(builder.GetContext(), "ORDER BY / LIMIT over UNION in recursive Common Table Expression")
+	}
+
+	// the rows of the recursive member are cast to the types of the anchor
+	recursiveNode := builder.qry.Nodes[recursiveID]
+	if len(recursiveNode.ProjectList) != len(anchorNode.ProjectList) {
+		return 0, moerr.NewParseError(builder.GetContext(), "SELECT statements have different number of columns")
+	}
+	for i, expr := range recursiveNode.ProjectList {
+		targetType := anchorNode.ProjectList[i].Typ
+		if targetType.Id == int32(types.T_any) || makeTypeByPlan2Expr(expr).Eq(makeTypeByPlan2Type(targetType)) {
+			continue
+		}
+		recursiveNode.ProjectList[i], err = appendCastBeforeExpr(builder.GetContext(), expr, targetType)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	cteTag := builder.genNewTag()
+	anchorTag := anchorNode.BindingTags[0]
+	projectList := make([]*plan.Expr, len(anchorNode.ProjectList))
+	for i, expr := range anchorNode.ProjectList {
+		projectList[i] = &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: anchorTag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		builder.nameByColRef[[2]int32{cteTag, int32(i)}] = headings[i]
+	}
+
+	lastNodeID := builder.appendNode(&plan.Node{
+		NodeType:          plan.Node_RECURSIVE_CTE,
+		Children:          []int32{anchorID, recursiveID},
+		BindingTags:       []int32{cteTag},
+		ProjectList:       projectList,
+		UnionAll:          unionClause.All,
+		MaxRecursionDepth: builder.getMaxRecursionDepth(),
+	}, ctx)
+
+	ctx.headings = append(ctx.headings, headings...)
+	ctx.groupTag = builder.genNewTag()
+	ctx.aggregateTag = builder.genNewTag()
+	ctx.projectTag = builder.genNewTag()
+	for i, v := range ctx.headings {
+		ctx.aliasMap[v] = int32(i)
+		builder.nameByColRef[[2]int32{ctx.projectTag, int32(i)}] = v
+	}
+	for i, expr := range projectList {
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: cteTag,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	ctx.results = ctx.projects
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: ctx.projects,
+		Children:    []int32{lastNodeID},
+		BindingTags: []int32{ctx.projectTag},
+	}, ctx), nil
+}
+
+// buildWorkTableScan builds the reference of a recursive CTE inside its own definition.
+func (builder *QueryBuilder) buildWorkTableScan(cteRef *CTERef, name string, ctx *BindContext) (int32, error) {
+	switch cteRef.state {
+	case cteBuildingNoUnion:
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "Recursive Common Table Expression '%s' should contain a UNION", name)
+	case cteBuildingAnchor:
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "Recursive Common Table Expression '%s' should have one or more non-recursive query blocks followed by one or more recursive ones", name)
+	}
+
+	if cteRef.workTableRefs > 0 {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "In recursive query block of Recursive Common Table Expression '%s', the recursive table must be referenced only once", name)
+	}
+	cteRef.workTableRefs++
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_MATERIAL_SCAN,
+		TableDef:    DeepCopyTableDef(cteRef.workTable),
+		BindingTags: []int32{builder.genNewTag()},
+	}, ctx), nil
+}
+
+func (builder *QueryBuilder) getMaxRecursionDepth() int64 {
+	val, err := builder.compCtx.ResolveVariable("cte_max_recursion_depth", true, false)
+	if err != nil {
+		return DefaultMaxRecursionDepth
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	case int:
+		return int64(v)
+	}
+	return DefaultMaxRecursionDepth
+}
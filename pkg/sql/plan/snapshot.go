@@ -0,0 +1,117 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+)
+
+// buildSnapshotTS evaluates the timestamp of AS OF TIMESTAMP into the snapshot
+// timestamp the table scan reads at. The timestamp can't refer to any column.
+func (builder *QueryBuilder) buildSnapshotTS(astExpr tree.Expr) (*timestamp.Timestamp, error) {
+	ctx := builder.GetContext()
+	binder := NewDefaultBinder(ctx, nil, nil, nil, nil)
+	expr, err := binder.BindExpr(astExpr, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	expr, err = makePlan2CastExpr(ctx, expr, &plan.Type{
+		Id:    int32(types.T_timestamp),
+		Scale: 6,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the timestamp is evaluated once here even if it is volatile like NOW()
+	proc := builder.compCtx.GetProcess()
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	vec, err := colexec.EvalExpr(bat, proc, expr)
+	if err != nil {
+		return nil, err
+	}
+	c := rule.GetConstantValue(vec, false)
+	vec.Free(proc.Mp())
+	if c == nil || c.Isnull {
+		return nil, moerr.NewInvalidInput(ctx, "AS OF TIMESTAMP must be a constant timestamp")
+	}
+
+	ts := timestamp.Timestamp{PhysicalTime: types.Timestamp(c.GetTimestampval()).UnixNano()}
+	if err = checkSnapshotTS(ctx, ts); err != nil {
+		return nil, err
+	}
+	return &ts, nil
+}
+
+// ParseSnapshotTS parses the value of SET TRANSACTION SNAPSHOT, which is either a
+// timestamp in the session time zone or a "physical-logical" timestamp like mo_ctl's.
+func ParseSnapshotTS(ctx context.Context, value string, loc *time.Location) (timestamp.Timestamp, error) {
+	var ts timestamp.Timestamp
+	if v, err := types.ParseTimestamp(loc, value, 6); err == nil {
+		ts = timestamp.Timestamp{PhysicalTime: v.UnixNano()}
+	} else if ts, err = timestamp.ParseTimestamp(value); err != nil {
+		return timestamp.Timestamp{}, moerr.NewInvalidInput(ctx, "invalid snapshot timestamp '%s'", value)
+	}
+	if err := checkSnapshotTS(ctx, ts); err != nil {
+		return timestamp.Timestamp{}, err
+	}
+	return ts, nil
+}
+
+// checkSnapshotTS rejects the snapshots in the future, no data is committed there
+// yet, and the ones older than the history retention of the DN, whose data may
+// have been reclaimed by GC. The retention is refreshed by the CN from the DN.
+func checkSnapshotTS(ctx context.Context, ts timestamp.Timestamp) error {
+	if ts.PhysicalTime <= 0 {
+		return moerr.NewInvalidInput(ctx, "invalid snapshot timestamp %s", ts.DebugString())
+	}
+	now := time.Now()
+	if ts.PhysicalTime > now.UnixNano() {
+		return moerr.NewInvalidInput(ctx, "snapshot timestamp %s is in the future", ts.DebugString())
+	}
+	retention, ok := getHistoryRetention()
+	if !ok {
+		return moerr.NewInvalidState(ctx, "the history retention of the DN is unknown yet, retry later")
+	}
+	if ts.PhysicalTime < now.Add(-retention).UnixNano() {
+		return moerr.NewInvalidInput(ctx, "snapshot timestamp %s is older than the history retention %s of the DN",
+			ts.DebugString(), retention)
+	}
+	return nil
+}
+
+func getHistoryRetention() (time.Duration, bool) {
+	rt := runtime.ProcessLevelRuntime()
+	if rt == nil {
+		return 0, false
+	}
+	v, ok := rt.GetGlobalVariables(runtime.HistoryRetention)
+	if !ok {
+		return 0, false
+	}
+	return v.(time.Duration), true
+}
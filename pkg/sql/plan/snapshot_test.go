@@ -0,0 +1,116 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/stretchr/testify/require"
+)
+
+// setHistoryRetention sets the history retention refreshed by the CN from the DN.
+func setHistoryRetention(retention time.Duration) {
+	if runtime.ProcessLevelRuntime() == nil {
+		runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	}
+	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.HistoryRetention, retention)
+}
+
+// getSnapshotTSs returns the snapshot timestamps of the table scans by table name.
+func getSnapshotTSs(p *Plan) map[string]*timestamp.Timestamp {
+	tss := make(map[string]*timestamp.Timestamp)
+	for _, node := range p.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			tss[node.TableDef.Name] = node.SnapshotTs
+		}
+	}
+	return tss
+}
+
+func TestAsOfTimestamp(t *testing.T) {
+	setHistoryRetention(time.Hour * 24 * 365 * 100)
+	mock := NewMockOptimizer(false)
+
+	p, err := runOneStmt(mock, t, "select * from nation as of timestamp '2023-01-01 00:00:00' n join region on n.n_regionkey = region.r_regionkey")
+	require.NoError(t, err)
+	tss := getSnapshotTSs(p)
+	require.NotNil(t, tss["nation"])
+	require.Nil(t, tss["region"])
+
+	p, err = runOneStmt(mock, t, "select * from nation as of timestamp '2023-01-01 00:00:01'")
+	require.NoError(t, err)
+	require.Equal(t, tss["nation"].PhysicalTime+int64(time.Second), getSnapshotTSs(p)["nation"].PhysicalTime)
+
+	p, err = runOneStmt(mock, t, "select * from nation as of timestamp date_sub(now(), interval 1 hour)")
+	require.NoError(t, err)
+	ts := getSnapshotTSs(p)["nation"]
+	require.NotNil(t, ts)
+	require.Less(t, ts.PhysicalTime, time.Now().UnixNano())
+
+	for _, sql := range []string{
+		"select * from nation as of timestamp '2999-01-01 00:00:00'",
+		"select * from nation as of timestamp n_name",
+		"select * from nation as of timestamp null",
+		"select * from v1 as of timestamp '2023-01-01 00:00:00'",
+	} {
+		_, err = runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+	}
+}
+
+func TestParseSnapshotTS(t *testing.T) {
+	setHistoryRetention(time.Hour * 24 * 365 * 100)
+	ctx := context.Background()
+	ts, err := ParseSnapshotTS(ctx, "2023-01-01 00:00:00", time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano(), ts.PhysicalTime)
+
+	ts, err = ParseSnapshotTS(ctx, "1672531200000000000-1", time.UTC)
+	require.NoError(t, err)
+	require.Equal(t, timestamp.Timestamp{PhysicalTime: 1672531200000000000, LogicalTime: 1}, ts)
+
+	for _, value := range []string{"abc", "2999-01-01 00:00:00", "0-0"} {
+		_, err = ParseSnapshotTS(ctx, value, time.UTC)
+		require.Error(t, err, value)
+	}
+}
+
+func TestSnapshotTSHistoryRetention(t *testing.T) {
+	ctx := context.Background()
+	mock := NewMockOptimizer(false)
+	setHistoryRetention(time.Hour)
+
+	_, err := ParseSnapshotTS(ctx, time.Now().Add(-time.Minute).UTC().Format("2006-01-02 15:04:05"), time.UTC)
+	require.NoError(t, err)
+	_, err = runOneStmt(mock, t, "select * from nation as of timestamp date_sub(now(), interval 1 minute)")
+	require.NoError(t, err)
+
+	// the snapshots older than the history retention are rejected
+	_, err = ParseSnapshotTS(ctx, "2023-01-01 00:00:00", time.UTC)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+	_, err = runOneStmt(mock, t, "select * from nation as of timestamp date_sub(now(), interval 2 hour)")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+
+	// no history is kept without the retention
+	setHistoryRetention(0)
+	_, err = ParseSnapshotTS(ctx, "2023-01-01 00:00:00", time.UTC)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
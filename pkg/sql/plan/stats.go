@@ -47,6 +47,9 @@ type StatsInfoMap struct {
 	BlockNumber int //detect if block number changes , update stats info map
 	TableCnt    float64
 	tableName   string
+
+	// collected by ANALYZE TABLE and loaded from mo_catalog
+	ColumnStatsMap map[string]*ColumnStats
 }
 
 func NewStatsInfoMap() *StatsInfoMap {
@@ -57,6 +60,8 @@ func NewStatsInfoMap() *StatsInfoMap {
 		DataTypeMap: make(map[string]types.T),
 		BlockNumber: 0,
 		TableCnt:    0,
+
+		ColumnStatsMap: make(map[string]*ColumnStats),
 	}
 }
 
@@ -197,6 +202,9 @@ func getColNdv(col *plan.ColRef, nodeID int32, builder *QueryBuilder) float64 {
 
 	if binding, ok := ctx.bindingByTag[col.RelPos]; ok {
 		s := sc.GetStatsInfoMap(binding.tableID)
+		if cs, ok := s.ColumnStatsMap[binding.cols[col.ColPos]]; ok {
+			return cs.Ndv
+		}
 		return s.NdvMap[binding.cols[col.ColPos]]
 	} else {
 		return -1
@@ -300,6 +308,10 @@ func EstimateOutCnt(expr *plan.Expr, sortKeyName string, tableCnt, cost float64,
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_F:
 		funcName := exprImpl.F.Func.ObjName
+		if analyzed, ok := estimateOutCntByColumnStats(expr, funcName, tableCnt, s); ok {
+			outcnt = analyzed
+			break
+		}
 		switch funcName {
 		case "=":
 			outcnt = estimateOutCntForEquality(expr, sortKeyName, tableCnt, cost, s.NdvMap)
@@ -411,7 +423,7 @@ func calcNdvUsingMinMax(minVal, maxVal any, t types.Type) float64 {
 		return float64(maxVal.(types.Time)-minVal.(types.Time)) + 1
 	case types.T_datetime:
 		return float64(maxVal.(types.Datetime)-minVal.(types.Datetime)) + 1
-	case types.T_uuid, types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_text:
+	case types.T_uuid, types.T_char, types.T_varchar, types.T_blob, types.T_json, types.T_text, types.T_vecf32, types.T_vecf64:
 		return -1
 	default:
 		return -1
@@ -614,6 +626,12 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 			Cost:        leftStats.Outcnt + rightStats.Outcnt,
 			Selectivity: 1,
 		}
+	case plan.Node_RECURSIVE_CTE:
+		node.Stats = &plan.Stats{
+			Outcnt:      leftStats.Outcnt + rightStats.Outcnt,
+			Cost:        leftStats.Cost + rightStats.Cost,
+			Selectivity: 1,
+		}
 	case plan.Node_INTERSECT:
 		node.Stats = &plan.Stats{
 			Outcnt:      math.Min(leftStats.Outcnt, rightStats.Outcnt) * 0.5,
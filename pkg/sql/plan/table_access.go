@@ -0,0 +1,122 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+/*
+The column privileges and the row level security policies are enforced when
+the table is bound, see CompilerContext.GetTableAccess:
+
+ 1. The columns not granted are marked on the binding, the binder rejects any
+    reference to them, including the ones expanded from *.
+ 2. The USING expressions of the policies are ORed and added to the filters of
+    the table scan, so no other expression can see the invisible rows.
+*/
+
+// applyTableAccess applies the access of current user to the table scan which
+// has been bound in ctx.
+func (builder *QueryBuilder) applyTableAccess(nodeID int32, ctx *BindContext) error {
+	node := builder.qry.Nodes[nodeID]
+	access, err := builder.compCtx.GetTableAccess(node.ObjRef.SchemaName, node.TableDef.Name)
+	if err != nil || access == nil {
+		return err
+	}
+
+	if len(access.Policies) > 0 {
+		filter, err := parsePolicies(builder.GetContext(), access.Policies)
+		if err != nil {
+			return err
+		}
+		ctx.binder = NewWhereBinder(builder, ctx)
+		filterExprs, err := splitAndBindCondition(filter, ctx)
+		if err != nil {
+			return err
+		}
+		for _, expr := range filterExprs {
+			if !isPolicyFilter(expr) {
+				return moerr.NewNotSupported(builder.GetContext(), "subquery or outer column in the policy of table '%s'", node.TableDef.Name)
+			}
+		}
+		node.FilterList = append(node.FilterList, filterExprs...)
+	}
+
+	// the policies above can use the columns not granted
+	if access.Columns != nil {
+		binding := ctx.bindingByTag[node.BindingTags[0]]
+		binding.colIsDenied = make([]bool, len(binding.cols))
+		for i, col := range node.TableDef.Cols {
+			if col.Hidden {
+				continue
+			}
+			binding.colIsDenied[i] = true
+			for _, name := range access.Columns {
+				if strings.EqualFold(name, col.Name) {
+					binding.colIsDenied[i] = false
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// parsePolicies parses the USING expressions of the policies, the row is
+// visible if it satisfies any of them.
+func parsePolicies(ctx context.Context, policies []string) (tree.Expr, error) {
+	var filter tree.Expr
+	for _, policy := range policies {
+		stmts, err := mysql.Parse(ctx, "select 1 where "+policy, 1)
+		if err != nil {
+			return nil, err
+		}
+		expr := stmts[0].(*tree.Select).Select.(*tree.SelectClause).Where.Expr
+		if filter == nil {
+			filter = tree.NewParenExpr(expr)
+		} else {
+			filter = tree.NewOrExpr(filter, tree.NewParenExpr(expr))
+		}
+	}
+	return filter, nil
+}
+
+// isPolicyFilter checks the expression only uses the columns of the table.
+func isPolicyFilter(expr *plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Sub, *plan.Expr_Corr:
+		return false
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if !isPolicyFilter(arg) {
+				return false
+			}
+		}
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			if !isPolicyFilter(arg) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestColumnPrivileges(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mock.ctxt.tableAccess = map[string]*TableAccess{
+		"tpch.nation": {Columns: []string{"n_nationkey", "N_NAME"}},
+	}
+
+	runTestShouldPass(mock, t, []string{
+		"select n_nationkey, n_name from nation",
+		"select n.n_name from nation n where n.n_nationkey > 10 order by 1",
+		"select count(*) from nation",
+		"select r_name from region where r_regionkey in (select n_nationkey from nation)",
+		"select r_comment from region join nation on n_nationkey = r_regionkey",
+	}, false, false)
+
+	runTestShouldError(mock, t, []string{
+		"select * from nation",
+		"select n_comment from nation",
+		"select n_name from nation where n_regionkey = 1",
+		"select n_name from nation group by n_regionkey",
+		"select r_name from region where exists (select 1 from nation where n_regionkey = r_regionkey)",
+		"select nation.* from nation join region on n_nationkey = r_regionkey",
+	})
+
+	_, err := runOneStmt(mock, t, "select n_comment from nation")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrColumnAccessDenied))
+	require.Equal(t, moerr.ER_COLUMNACCESS_DENIED_ERROR, err.(*moerr.Error).MySQLCode())
+}
+
+func TestRowLevelSecurityPolicies(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mock.ctxt.tableAccess = map[string]*TableAccess{
+		"tpch.nation": {
+			Columns:  []string{"n_name"},
+			Policies: []string{"n_regionkey = 1", "n_nationkey < 10 and n_comment like 'a%'"},
+		},
+	}
+
+	for _, sql := range []string{
+		"select n_name from nation",
+		"select n_name from nation n join region r on n.n_name = r.r_name",
+	} {
+		p, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		found := false
+		for _, node := range p.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef.Name == "nation" {
+				require.NotEmpty(t, node.FilterList, sql)
+				found = true
+			}
+		}
+		require.True(t, found, sql)
+	}
+
+	// the policies can only use the columns of the table
+	mock.ctxt.tableAccess["tpch.nation"] = &TableAccess{Policies: []string{"n_regionkey in (select r_regionkey from region)"}}
+	_, err := runOneStmt(mock, t, "select n_name from nation")
+	require.Error(t, err)
+	mock.ctxt.tableAccess["tpch.nation"] = &TableAccess{Policies: []string{"r_regionkey = 1"}}
+	_, err = runOneStmt(mock, t, "select r_name from region where exists (select 1 from nation)")
+	require.Error(t, err)
+}
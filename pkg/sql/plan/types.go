@@ -57,6 +57,7 @@ type ClusterByDef = plan.ClusterByDef
 type OrderBySpec = plan.OrderBySpec
 type FkColName = plan.FkColName
 type ForeignKeyDef = plan.ForeignKeyDef
+type CheckDef = plan.CheckDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
@@ -104,6 +105,20 @@ type CompilerContext interface {
 	SetQueryingSubscription(meta *SubscriptionMeta)
 	GetQueryingSubscription() *SubscriptionMeta
 	IsPublishing(dbName string) (bool, error)
+	// get the column privileges and the row level security policies of
+	// current user on the table, nil if the user can access the whole table
+	GetTableAccess(dbName string, tableName string) (*TableAccess, error)
+}
+
+// TableAccess is the access of current user to the table which is narrower
+// than the table privileges.
+type TableAccess struct {
+	// Columns are the columns granted by GRANT SELECT (col, ...) if the user
+	// does not have SELECT on the table, nil means all the columns.
+	Columns []string
+	// Policies are the USING expressions of the row level security policies,
+	// the row is visible if it satisfies any of them.
+	Policies []string
 }
 
 type Optimizer interface {
@@ -155,13 +170,32 @@ type QueryBuilder struct {
 
 	nextTag int32
 
+	// the index hints of the table scans, keyed by the node id of the scan
+	indexHints map[int32][]*tree.IndexHint
+
 	mysqlCompatible bool
 }
 
+// the build state of a recursive CTE, it decides what a reference
+// to the CTE inside its own definition means.
+const (
+	cteNotBuilding = iota
+	cteBuildingNoUnion
+	cteBuildingAnchor
+	cteBuildingRecursive
+)
+
 type CTERef struct {
 	defaultDatabase string
 	ast             *tree.CTE
 	maskedCTEs      map[string]any
+
+	isRecursive bool
+	state       int
+	// workTable holds the columns of the rows produced by the last iteration,
+	// the recursive member reads them through a MATERIAL_SCAN node.
+	workTable     *plan.TableDef
+	workTableRefs int
 }
 
 type BindContext struct {
@@ -172,6 +206,8 @@ type BindContext struct {
 
 	cteName  string
 	headings []string
+	// recursiveCTE is set if this context builds the definition of a recursive CTE.
+	recursiveCTE *CTERef
 
 	groupTag     int32
 	aggregateTag int32
@@ -187,6 +223,13 @@ type BindContext struct {
 	aggregateByAst map[string]int32
 	projectByExpr  map[string]int32
 
+	// window functions with the same window spec are evaluated by
+	// the same WINDOW node, each one has its own binding tag.
+	windowTags   []int32
+	windowSpecs  []*plan.WindowSpec
+	windows      [][]*plan.Expr
+	windowBySpec map[string]int32
+
 	aliasMap map[string]int32
 
 	bindings       []*Binding
@@ -270,6 +313,7 @@ type HavingBinder struct {
 type ProjectionBinder struct {
 	baseBinder
 	havingBinder *HavingBinder
+	insideWin    bool
 }
 
 type OrderBinder struct {
@@ -285,6 +329,20 @@ type PartitionBinder struct {
 	baseBinder
 }
 
+type GeneratedColumnBinder struct {
+	baseBinder
+	tableDef  *TableDef
+	colPos    int32
+	generated map[string]bool
+}
+
+type CheckBinder struct {
+	baseBinder
+	tableDef *TableDef
+	name     string
+	col      string
+}
+
 var _ Binder = (*TableBinder)(nil)
 var _ Binder = (*WhereBinder)(nil)
 var _ Binder = (*GroupBinder)(nil)
@@ -292,6 +350,8 @@ var _ Binder = (*HavingBinder)(nil)
 var _ Binder = (*ProjectionBinder)(nil)
 var _ Binder = (*LimitBinder)(nil)
 var _ Binder = (*PartitionBinder)(nil)
+var _ Binder = (*GeneratedColumnBinder)(nil)
+var _ Binder = (*CheckBinder)(nil)
 var _ Binder = (*UpdateBinder)(nil)
 
 var Sequence_cols_name = []string{"last_seq_num", "min_value", "max_value", "start_value", "increment_value", "cycle", "is_called"}
@@ -308,6 +368,7 @@ type Binding struct {
 	tableID        uint64
 	cols           []string
 	colIsHidden    []bool
+	colIsDenied    []bool
 	types          []*plan.Type
 	refCnts        []uint
 	colIdByName    map[string]int32
@@ -253,6 +253,21 @@ func (mr *MockCompilerContext2MockRecorder) GetSubscriptionMeta(dbName interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionMeta", reflect.TypeOf((*MockCompilerContext2)(nil).GetSubscriptionMeta), dbName)
 }
 
+// GetTableAccess mocks base method.
+func (m *MockCompilerContext2) GetTableAccess(dbName, tableName string) (*TableAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTableAccess", dbName, tableName)
+	ret0, _ := ret[0].(*TableAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTableAccess indicates an expected call of GetTableAccess.
+func (mr *MockCompilerContext2MockRecorder) GetTableAccess(dbName, tableName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTableAccess", reflect.TypeOf((*MockCompilerContext2)(nil).GetTableAccess), dbName, tableName)
+}
+
 // GetUserName mocks base method.
 func (m *MockCompilerContext2) GetUserName() string {
 	m.ctrl.T.Helper()
@@ -1119,7 +1119,7 @@ func InitInfileParam(param *tree.ExternParam) error {
 			param.CompressType = param.Option[i+1]
 		case "format":
 			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE {
+			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
 				return moerr.NewBadConfig(param.Ctx, "the format '%s' is not supported", format)
 			}
 			param.Format = format
@@ -1143,6 +1143,10 @@ func InitInfileParam(param *tree.ExternParam) error {
 	if len(param.Format) == 0 {
 		param.Format = tree.CSV
 	}
+	// the parquet file is read by row groups, it can't be split by lines
+	if param.Format == tree.PARQUET {
+		param.Parallel = false
+	}
 	return nil
 }
 
@@ -1172,7 +1176,7 @@ func InitS3Param(param *tree.ExternParam) error {
 			param.S3Param.ExternalId = param.Option[i+1]
 		case "format":
 			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE {
+			if format != tree.CSV && format != tree.JSONLINE && format != tree.PARQUET {
 				return moerr.NewBadConfig(param.Ctx, "the format '%s' is not supported", format)
 			}
 			param.Format = format
@@ -1194,6 +1198,10 @@ func InitS3Param(param *tree.ExternParam) error {
 	if len(param.Format) == 0 {
 		param.Format = tree.CSV
 	}
+	// the parquet file is read by row groups, it can't be split by lines
+	if param.Format == tree.PARQUET {
+		param.Parallel = false
+	}
 	return nil
 }
 
@@ -34,6 +34,7 @@ var (
 	_ VisitPlanRule = &ResetParamRefRule{}
 	_ VisitPlanRule = &ResetVarRefRule{}
 	_ VisitPlanRule = &ConstantFoldRule{}
+	_ VisitPlanRule = &PartitionPruneRule{}
 )
 
 var (
@@ -442,3 +443,34 @@ func (r *RecomputeRealTimeRelatedFuncRule) ApplyExpr(e *plan.Expr) (*plan.Expr,
 		return e, nil
 	}
 }
+
+// ---------------------------
+
+// PartitionPruneRule prunes the partitions again after the parameters and the variables
+// in the filters are replaced by their values.
+type PartitionPruneRule struct {
+	proc *process.Process
+}
+
+func NewPartitionPruneRule(proc *process.Process) *PartitionPruneRule {
+	return &PartitionPruneRule{
+		proc: proc,
+	}
+}
+
+func (r *PartitionPruneRule) MatchNode(node *Node) bool {
+	return node.NodeType == plan.Node_TABLE_SCAN && node.TableDef != nil && node.TableDef.Partition != nil
+}
+
+func (r *PartitionPruneRule) IsApplyExpr() bool {
+	return false
+}
+
+func (r *PartitionPruneRule) ApplyNode(node *Node) error {
+	node.PartitionPrune = PrunePartitions(r.proc, node)
+	return nil
+}
+
+func (r *PartitionPruneRule) ApplyExpr(e *plan.Expr) (*plan.Expr, error) {
+	return e, nil
+}
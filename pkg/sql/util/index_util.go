@@ -16,6 +16,7 @@ package util
 
 import (
 	"context"
+	"math"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
@@ -24,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/distance"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -47,6 +49,15 @@ func BuildIndexTableName(ctx context.Context, unique bool) (string, error) {
 	return name, nil
 }
 
+// BuildIvfflatIndexTableName returns the name of the hidden table of the ivfflat index
+func BuildIvfflatIndexTableName(ctx context.Context) (string, error) {
+	id, err := uuid.NewUUID()
+	if err != nil {
+		return "", moerr.NewInternalError(ctx, "newuuid failed")
+	}
+	return catalog.IvfflatIndexTableNamePrefix + id.String(), nil
+}
+
 func BuildUniqueKeyBatch(vecs []*vector.Vector, attrs []string, parts []string, originTablePrimaryKey string, proc *process.Process) (*batch.Batch, int) {
 	var b *batch.Batch
 	if originTablePrimaryKey == "" {
@@ -111,6 +122,127 @@ func BuildUniqueKeyBatch(vecs []*vector.Vector, attrs []string, parts []string,
 	return b, b.Vecs[0].Length()
 }
 
+// BuildIvfflatIndexBatch clusters the vectors of vecCol into lists by k-means and
+// returns the rows of the ivfflat index table: a row of the centroid for each list,
+// and a row of the primary key for each vector. The rows of NULL are not in any
+// list. If lists is 0, the square root of the number of the vectors is used.
+func BuildIvfflatIndexBatch(vecCol, pkCol *vector.Vector, lists int64, proc *process.Process) (*batch.Batch, error) {
+	switch vecCol.GetType().Oid {
+	case types.T_vecf32:
+		return buildIvfflatIndexBatch[float32](vecCol, pkCol, lists, proc)
+	case types.T_vecf64:
+		return buildIvfflatIndexBatch[float64](vecCol, pkCol, lists, proc)
+	}
+	return nil, moerr.NewInternalError(proc.Ctx, "ivfflat index on the column of type %s", vecCol.GetType().String())
+}
+
+func buildIvfflatIndexBatch[T types.RealNumbers](vecCol, pkCol *vector.Vector, lists int64, proc *process.Process) (*batch.Batch, error) {
+	vecs := make([][]T, 0, vecCol.Length())
+	rows := make([]int64, 0, vecCol.Length())
+	for i := 0; i < vecCol.Length(); i++ {
+		if nulls.Contains(vecCol.GetNulls(), uint64(i)) {
+			continue
+		}
+		vecs = append(vecs, types.BytesToVector[T](vecCol.GetBytesAt(i)))
+		rows = append(rows, int64(i))
+	}
+	if lists <= 0 {
+		lists = int64(math.Sqrt(float64(len(vecs))))
+		if lists == 0 {
+			lists = 1
+		}
+	}
+	centroids, assignments := distance.KMeans(vecs, int(lists), distance.DefaultKMeansIterations)
+
+	b := batch.NewWithSize(3)
+	b.Attrs = []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName, catalog.IndexTableCentroidColName}
+	b.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	b.Vecs[1] = vector.NewVec(*pkCol.GetType())
+	b.Vecs[2] = vector.NewVec(*vecCol.GetType())
+	var err error
+	defer func() {
+		if err != nil {
+			b.Clean(proc.Mp())
+		}
+	}()
+	for i, centroid := range centroids {
+		if err = vector.AppendFixed(b.Vecs[0], int64(i+1), false, proc.Mp()); err != nil {
+			return nil, err
+		}
+		if err = b.Vecs[1].UnionNull(proc.Mp()); err != nil {
+			return nil, err
+		}
+		if err = vector.AppendBytes(b.Vecs[2], types.VectorToBytes(centroid), false, proc.Mp()); err != nil {
+			return nil, err
+		}
+	}
+	for i, row := range rows {
+		if err = vector.AppendFixed(b.Vecs[0], int64(assignments[i]+1), false, proc.Mp()); err != nil {
+			return nil, err
+		}
+		if err = b.Vecs[1].UnionOne(pkCol, row, proc.Mp()); err != nil {
+			return nil, err
+		}
+		if err = vector.AppendBytes(b.Vecs[2], nil, true, proc.Mp()); err != nil {
+			return nil, err
+		}
+	}
+	b.SetZs(b.Vecs[0].Length(), proc.Mp())
+	return b, nil
+}
+
+// BuildIvfflatListBatch assigns the vectors of vecCol to the lists of the nearest
+// centroids, and returns the rows of the primary keys in the ivfflat index table.
+// The centroid of the list lists[i] is centroids[i], and the rows of NULL are not
+// in any list.
+func BuildIvfflatListBatch(vecCol, pkCol *vector.Vector, lists []int64, centroids [][]byte, proc *process.Process) (*batch.Batch, error) {
+	switch vecCol.GetType().Oid {
+	case types.T_vecf32:
+		return buildIvfflatListBatch[float32](vecCol, pkCol, lists, centroids, proc)
+	case types.T_vecf64:
+		return buildIvfflatListBatch[float64](vecCol, pkCol, lists, centroids, proc)
+	}
+	return nil, moerr.NewInternalError(proc.Ctx, "ivfflat index on the column of type %s", vecCol.GetType().String())
+}
+
+func buildIvfflatListBatch[T types.RealNumbers](vecCol, pkCol *vector.Vector, lists []int64, centroids [][]byte, proc *process.Process) (*batch.Batch, error) {
+	cs := make([][]T, len(centroids))
+	for i, c := range centroids {
+		cs[i] = types.BytesToVector[T](c)
+	}
+
+	b := batch.NewWithSize(3)
+	b.Attrs = []string{catalog.IndexTableIndexColName, catalog.IndexTablePrimaryColName, catalog.IndexTableCentroidColName}
+	b.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	b.Vecs[1] = vector.NewVec(*pkCol.GetType())
+	b.Vecs[2] = vector.NewVec(*vecCol.GetType())
+	var err error
+	defer func() {
+		if err != nil {
+			b.Clean(proc.Mp())
+		}
+	}()
+	if len(cs) > 0 {
+		for i := 0; i < vecCol.Length(); i++ {
+			if nulls.Contains(vecCol.GetNulls(), uint64(i)) {
+				continue
+			}
+			v := types.BytesToVector[T](vecCol.GetBytesAt(i))
+			if err = vector.AppendFixed(b.Vecs[0], lists[distance.NearestCentroid(cs, v)], false, proc.Mp()); err != nil {
+				return nil, err
+			}
+			if err = b.Vecs[1].UnionOne(pkCol, int64(i), proc.Mp()); err != nil {
+				return nil, err
+			}
+			if err = vector.AppendBytes(b.Vecs[2], nil, true, proc.Mp()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b.SetZs(b.Vecs[0].Length(), proc.Mp())
+	return b, nil
+}
+
 // SerialWithCompacted have a similar function named Serial
 // SerialWithCompacted function is used by BuildUniqueKeyBatch
 // when vs have null value, the function will ignore the row in
@@ -148,7 +280,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeInt8(b)
 				}
 			}
-		case types.T_int16:
+		case types.T_int16, types.T_year:
 			s := vector.MustFixedCol[int16](v)
 			for i, b := range s {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -184,7 +316,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeUint8(b)
 				}
 			}
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			s := vector.MustFixedCol[uint16](v)
 			for i, b := range s {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -202,7 +334,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeUint32(b)
 				}
 			}
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			s := vector.MustFixedCol[uint64](v)
 			for i, b := range s {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -283,7 +415,7 @@ func serialWithCompacted(vs []*vector.Vector, proc *process.Process) (*vector.Ve
 					ps[i].EncodeDecimal128(b)
 				}
 			}
-		case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 			vs := vector.MustStrCol(v)
 			for i := range vs {
 				if nulls.Contains(v.GetNulls(), uint64(i)) {
@@ -332,7 +464,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		s := vector.MustFixedCol[int16](v)
 		ns := make([]int16, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -372,7 +504,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		s := vector.MustFixedCol[uint16](v)
 		ns := make([]uint16, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -392,7 +524,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		s := vector.MustFixedCol[uint64](v)
 		ns := make([]uint64, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -482,7 +614,7 @@ func compactSingleIndexCol(v *vector.Vector, proc *process.Process) (*vector.Vec
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob:
+	case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_vecf32, types.T_vecf64:
 		s := vector.MustBytesCol(v)
 		ns := make([][]byte, 0, len(s)-nulls.Size(nsp))
 		for i, b := range s {
@@ -520,7 +652,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		s := vector.MustFixedCol[int16](v)
 		ns := make([]int16, 0)
 		for i, b := range s {
@@ -560,7 +692,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		s := vector.MustFixedCol[uint16](v)
 		ns := make([]uint16, 0)
 		for i, b := range s {
@@ -580,7 +712,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		s := vector.MustFixedCol[uint64](v)
 		ns := make([]uint64, 0)
 		for i, b := range s {
@@ -670,7 +802,7 @@ func compactPrimaryCol(v *vector.Vector, bitMap *nulls.Nulls, proc *process.Proc
 		}
 		vec = vector.NewVec(*v.GetType())
 		vector.AppendFixedList(vec, ns, nil, proc.Mp())
-	case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob:
+	case types.T_json, types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_vecf32, types.T_vecf64:
 		s := vector.MustBytesCol(v)
 		ns := make([][]byte, 0)
 		for i, b := range s {
@@ -96,6 +96,65 @@ func TestBuildUniqueKeyBatch(t *testing.T) {
 	}
 }
 
+func TestBuildIvfflatIndexBatch(t *testing.T) {
+	proc := testutil.NewProcess()
+	vecTyp := types.New(types.T_vecf32, 2, 0)
+	vecCol := vector.NewVec(vecTyp)
+	for _, v := range [][]float32{{0, 0}, {10, 10}, {0, 1}, {10, 11}} {
+		require.NoError(t, vector.AppendBytes(vecCol, types.VectorToBytes(v), false, proc.Mp()))
+	}
+	require.NoError(t, vector.AppendBytes(vecCol, nil, true, proc.Mp()))
+	pkCol := testutil.NewVector(5, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3, 4, 5})
+
+	b, err := BuildIvfflatIndexBatch(vecCol, pkCol, 2, proc)
+	require.NoError(t, err)
+	// 2 centroids and 4 vectors, the NULL is not in any list
+	require.Equal(t, 6, b.Length())
+	lists := vector.MustFixedCol[int64](b.Vecs[0])
+	require.Equal(t, []int64{1, 2}, lists[:2])
+	require.True(t, b.Vecs[1].GetNulls().Contains(0))
+	require.False(t, b.Vecs[2].GetNulls().Contains(0))
+	require.True(t, b.Vecs[2].GetNulls().Contains(2))
+	pks := vector.MustFixedCol[int64](b.Vecs[1])
+	require.Equal(t, []int64{1, 2, 3, 4}, pks[2:])
+	require.Equal(t, lists[2], lists[4])
+	require.Equal(t, lists[3], lists[5])
+	require.NotEqual(t, lists[2], lists[3])
+
+	// the lists are the square root of the vectors by default
+	b, err = BuildIvfflatIndexBatch(vecCol, pkCol, 0, proc)
+	require.NoError(t, err)
+	require.Equal(t, 6, b.Length())
+}
+
+func TestBuildIvfflatListBatch(t *testing.T) {
+	proc := testutil.NewProcess()
+	vecTyp := types.New(types.T_vecf32, 2, 0)
+	vecCol := vector.NewVec(vecTyp)
+	for _, v := range [][]float32{{1, 1}, {9, 9}} {
+		require.NoError(t, vector.AppendBytes(vecCol, types.VectorToBytes(v), false, proc.Mp()))
+	}
+	require.NoError(t, vector.AppendBytes(vecCol, nil, true, proc.Mp()))
+	pkCol := testutil.NewVector(3, types.T_int64.ToType(), proc.Mp(), false, []int64{1, 2, 3})
+	centroids := [][]byte{
+		types.VectorToBytes([]float32{10, 10}),
+		types.VectorToBytes([]float32{0, 0}),
+	}
+
+	b, err := BuildIvfflatListBatch(vecCol, pkCol, []int64{3, 7}, centroids, proc)
+	require.NoError(t, err)
+	// the NULL is not in any list
+	require.Equal(t, 2, b.Length())
+	require.Equal(t, []int64{7, 3}, vector.MustFixedCol[int64](b.Vecs[0]))
+	require.Equal(t, []int64{1, 2}, vector.MustFixedCol[int64](b.Vecs[1]))
+	require.True(t, b.Vecs[2].GetNulls().Contains(0))
+
+	// no row is in any list if there is no centroid
+	b, err = BuildIvfflatListBatch(vecCol, pkCol, nil, nil, proc)
+	require.NoError(t, err)
+	require.Equal(t, 0, b.Length())
+}
+
 func TestCompactUniqueKeyBatch(t *testing.T) {
 	proc := testutil.NewProcess()
 	tests := []struct {
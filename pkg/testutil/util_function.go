@@ -188,7 +188,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		r := vector.GenerateFunctionFixedTypeParameter[int16](v)
 		s := vector.GenerateFunctionFixedTypeParameter[int16](vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -260,7 +260,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		r := vector.GenerateFunctionFixedTypeParameter[uint16](v)
 		s := vector.GenerateFunctionFixedTypeParameter[uint16](vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -296,7 +296,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 					i+1, want, get)
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		r := vector.GenerateFunctionFixedTypeParameter[uint64](v)
 		s := vector.GenerateFunctionFixedTypeParameter[uint64](vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -459,7 +459,7 @@ func (fc *FunctionTestCase) Run() (succeed bool, errInfo string) {
 			}
 		}
 	case types.T_char, types.T_varchar,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		r := vector.GenerateFunctionStrParameter(v)
 		s := vector.GenerateFunctionStrParameter(vExpected)
 		for i = 0; i < uint64(fc.fnLength); i++ {
@@ -603,7 +603,7 @@ func newVectorByType(mp *mpool.MPool, typ types.Type, val any, nsp *nulls.Nulls)
 	case types.T_int8:
 		values := val.([]int8)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		values := val.([]int16)
 		vector.AppendFixedList(vec, values, nil, mp)
 	case types.T_int32:
@@ -615,13 +615,13 @@ func newVectorByType(mp *mpool.MPool, typ types.Type, val any, nsp *nulls.Nulls)
 	case types.T_uint8:
 		values := val.([]uint8)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		values := val.([]uint16)
 		vector.AppendFixedList(vec, values, nil, mp)
 	case types.T_uint32:
 		values := val.([]uint32)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		values := val.([]uint64)
 		vector.AppendFixedList(vec, values, nil, mp)
 	case types.T_float32:
@@ -648,7 +648,7 @@ func newVectorByType(mp *mpool.MPool, typ types.Type, val any, nsp *nulls.Nulls)
 	case types.T_timestamp:
 		values := val.([]types.Timestamp)
 		vector.AppendFixedList(vec, values, nil, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		values := val.([]string)
 		vector.AppendStringList(vec, values, nil, mp)
 	case types.T_uuid:
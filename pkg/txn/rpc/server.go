@@ -41,6 +41,13 @@ func WithServerEnableCompress(enable bool) ServerOption {
 	}
 }
 
+// WithServerTLS enable mutual TLS
+func WithServerTLS(cfg morpc.TLSConfig) ServerOption {
+	return func(s *server) {
+		s.options.tls = cfg
+	}
+}
+
 // set filter func. Requests can be modified or filtered out by the filter
 // before they are processed by the handler.
 func WithServerMessageFilter(filter func(*txn.TxnRequest) bool) ServerOption {
@@ -63,6 +70,7 @@ type server struct {
 		filter         func(*txn.TxnRequest) bool
 		maxMessageSize int
 		enableCompress bool
+		tls            morpc.TLSConfig
 	}
 }
 
@@ -102,16 +110,26 @@ func NewTxnServer(
 		}
 		codecOpts = append(codecOpts, morpc.WithCodecEnableCompress(mp))
 	}
-	rpc, err := morpc.NewRPCServer("txn-server", address,
-		morpc.NewMessageCodec(s.acquireRequest,
-			codecOpts...),
+	serverOpts := []morpc.ServerOption{
 		morpc.WithServerLogger(s.rt.Logger().RawLogger()),
 		morpc.WithServerGoettyOptions(goetty.WithSessionReleaseMsgFunc(func(v interface{}) {
 			m := v.(morpc.RPCMessage)
 			if !m.InternalMessage() {
 				s.releaseResponse(m.Message.(*txn.TxnResponse))
 			}
-		})))
+		})),
+	}
+	if s.options.tls.Enable {
+		tc, err := s.options.tls.NewServerTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts, morpc.WithServerTLS(tc))
+	}
+	rpc, err := morpc.NewRPCServer("txn-server", address,
+		morpc.NewMessageCodec(s.acquireRequest,
+			codecOpts...),
+		serverOpts...)
 	if err != nil {
 		return nil, err
 	}
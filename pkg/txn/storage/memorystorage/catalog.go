@@ -197,6 +197,8 @@ func (r *RelationRow) AttrByName(handler *MemHandler, tx *Transaction, name stri
 		ret.Value = []byte(r.ViewDef)
 	case catalog.SystemRelAttr_Constraint:
 		ret.Value = r.Constraint
+	case catalog.SystemRelAttr_Compression:
+		ret.Value = []byte(r.Properties[catalog.SystemRelAttr_Compression])
 	default:
 		panic(fmt.Sprintf("fixme: %s", name))
 	}
@@ -345,6 +347,8 @@ func (a *AttributeRow) AttrByName(handler *MemHandler, tx *Transaction, name str
 		ret.Value = a.ID.ToRowID()
 	case catalog.SystemColAttr_IsClusterBy:
 		ret.Value = boolToInt8(a.ClusterBy)
+	case catalog.SystemColAttr_EnumValues:
+		ret.Value = []byte(a.EnumValues)
 	default:
 		panic(fmt.Sprintf("fixme: %s", name))
 	}
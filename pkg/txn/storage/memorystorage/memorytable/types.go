@@ -175,7 +175,7 @@ func TypeMatch(v any, typ types.T) bool {
 		_, ok = v.(bool)
 	case types.T_int8:
 		_, ok = v.(int8)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		_, ok = v.(int16)
 	case types.T_int32:
 		_, ok = v.(int32)
@@ -183,11 +183,11 @@ func TypeMatch(v any, typ types.T) bool {
 		_, ok = v.(int64)
 	case types.T_uint8:
 		_, ok = v.(uint8)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		_, ok = v.(uint16)
 	case types.T_uint32:
 		_, ok = v.(uint32)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		_, ok = v.(uint64)
 	case types.T_float32:
 		_, ok = v.(float32)
@@ -221,6 +221,8 @@ func TypeMatch(v any, typ types.T) bool {
 		_, ok = v.([]byte)
 	case types.T_text:
 		_, ok = v.([]byte)
+	case types.T_vecf32, types.T_vecf64:
+		_, ok = v.([]byte)
 	case types.T_uuid:
 		_, ok = v.(types.Uuid)
 	case types.T_TS:
@@ -35,7 +35,7 @@ func VectorAt(vec *vector.Vector, i int) (value Nullable) {
 	case types.T_int8:
 		return vectorAtFixed[int8](vec, i)
 
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return vectorAtFixed[int16](vec, i)
 
 	case types.T_int32:
@@ -47,13 +47,13 @@ func VectorAt(vec *vector.Vector, i int) (value Nullable) {
 	case types.T_uint8:
 		return vectorAtFixed[uint8](vec, i)
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return vectorAtFixed[uint16](vec, i)
 
 	case types.T_uint32:
 		return vectorAtFixed[uint32](vec, i)
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return vectorAtFixed[uint64](vec, i)
 
 	case types.T_float32:
@@ -93,7 +93,7 @@ func VectorAt(vec *vector.Vector, i int) (value Nullable) {
 	case types.T_uuid:
 		return vectorAtFixed[types.Uuid](vec, i)
 
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
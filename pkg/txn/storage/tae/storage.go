@@ -16,9 +16,11 @@ package taestorage
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/multierr"
 
+	"github.com/matrixorigin/matrixone/pkg/backup"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
@@ -38,6 +40,9 @@ type taeStorage struct {
 	shard         metadata.DNShard
 	taeHandler    rpchandle.Handler
 	logtailServer *service.LogtailServer
+	// historyRetention is how long the history data is kept for the reads at past
+	// timestamps, the CNs get it to reject the older snapshots.
+	historyRetention time.Duration
 }
 
 var _ storage.TxnStorage = (*taeStorage)(nil)
@@ -49,6 +54,8 @@ func NewTAEStorage(
 	fs fileservice.FileService,
 	rt runtime.Runtime,
 	ckpCfg *options.CheckpointCfg,
+	gcCfg *options.GCCfg,
+	backupCfg *options.BackupCfg,
 	logtailServerAddr string,
 	logtailServerCfg *options.LogtailServerCfg,
 	logStore options.LogstoreType,
@@ -59,8 +66,17 @@ func NewTAEStorage(
 		Lc:            logservicedriver.LogServiceClientFactory(factory),
 		Shard:         shard,
 		CheckpointCfg: ckpCfg,
+		GCCfg:         gcCfg,
+		BackupCfg:     backupCfg,
 		LogStoreT:     logStore,
 	}
+	if backupCfg != nil && backupCfg.ArchiveWAL && backupCfg.Root != "" {
+		archiveFs, err := backup.OpenPath(fs, backupCfg.Root)
+		if err != nil {
+			return nil, err
+		}
+		opt.ArchiveFs = archiveFs
+	}
 
 	taeHandler := rpc.NewTAEHandle(dataDir, opt)
 	tae := taeHandler.GetTxnEngine().GetTAE(context.Background())
@@ -70,10 +86,15 @@ func NewTAEStorage(
 		return nil, err
 	}
 
+	var historyRetention time.Duration
+	if gcCfg != nil {
+		historyRetention = gcCfg.HistoryRetention
+	}
 	return &taeStorage{
-		shard:         shard,
-		taeHandler:    taeHandler,
-		logtailServer: server,
+		shard:            shard,
+		taeHandler:       taeHandler,
+		logtailServer:    server,
+		historyRetention: historyRetention,
 	}, nil
 }
 
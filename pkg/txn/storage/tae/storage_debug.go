@@ -73,6 +73,18 @@ func (s *taeStorage) Debug(ctx context.Context,
 			})
 		}
 		return resp.Read()
+	case uint32(ctl.CmdMethod_Backup):
+		resp, err := handleRead(
+			ctx, s, txnMeta, data, s.taeHandler.HandleBackup,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Read()
+	case uint32(ctl.CmdMethod_HistoryRetention):
+		return protoc.MustMarshal(&ctl.DNStringResponse{
+			ReturnStr: s.historyRetention.String(),
+		}), nil
 	default:
 		return nil, moerr.NewNotSupportedNoCtx("TAEStorage not support ctl method %d", opCode)
 	}
@@ -17,6 +17,8 @@ package export
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"path"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
@@ -51,3 +53,17 @@ func GetWriterFactory(fs fileservice.FileService, nodeUUID, nodeType string, ext
 
 	return factory
 }
+
+// GetAuditFileWriterFactory returns the factory that writes the rows as the csv
+// files in the local dir, like: <dir>/<account>/<yyyy>/<mm>/<dd>/<table>_<unix nano>.csv
+func GetAuditFileWriterFactory(dir string) (table.WriterFactory, error) {
+	fs, err := fileservice.NewLocalETLFS("audit", dir)
+	if err != nil {
+		return nil, err
+	}
+	factory := func(ctx context.Context, account string, tbl *table.Table, ts time.Time) table.RowWriter {
+		filePath := path.Join(account, ts.Format("2006/01/02"), fmt.Sprintf("%s_%d.csv", tbl.GetName(), ts.UnixNano()))
+		return etl.NewCSVWriter(ctx, bytes.NewBuffer(nil), etl.NewFSWriter(ctx, fs, etl.WithFilePath(filePath)))
+	}
+	return factory, nil
+}
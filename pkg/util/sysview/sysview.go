@@ -180,7 +180,7 @@ var (
 			"internal_datetime_scale(atttyp) AS DATETIME_PRECISION,"+
 			"(case internal_column_character_set(atttyp) WHEN 0 then 'utf8' WHEN 1 then 'utf8' else NULL end) AS CHARACTER_SET_NAME,"+
 			"(case internal_column_character_set(atttyp) WHEN 0 then 'utf8_bin' WHEN 1 then 'utf8_bin' else NULL end) AS COLLATION_NAME,"+
-			"mo_show_visible_bin(atttyp,3) as COLUMN_TYPE,"+
+			"concat(mo_show_visible_bin(atttyp,3), iff(attr_enum = '', '', concat('(', attr_enum, ')'))) as COLUMN_TYPE,"+
 			"case when att_constraint_type = 'p' then 'PRI' else '' end as COLUMN_KEY,"+
 			"case when att_is_auto_increment = 1 then 'auto_increment' else '' end as EXTRA,"+
 			"'select,insert,update,references' as `PRIVILEGES`,"+
@@ -68,6 +68,7 @@ func (t batchETLHandler) NewItemBuffer(name string) bp.ItemBuffer[bp.HasName, an
 	case MOSpanType:
 	case MOLogType:
 	case MORawLogType:
+	case MOAuditType, AuditLogTable.GetName():
 	default:
 		logutil.Warnf("batchETLHandler handle new type: %s", name)
 	}
@@ -36,6 +36,7 @@ const (
 	MOLogType       = "log"
 	MOErrorType     = "error"
 	MORawLogType    = "rawlog"
+	MOAuditType     = "audit"
 )
 
 // tracerProviderConfig.
@@ -63,6 +64,8 @@ type tracerProviderConfig struct {
 
 	// writerFactory gen writer for CSV output
 	writerFactory table.WriterFactory // WithFSWriterFactory, default from export.GetFSWriterFactory4Trace
+	// auditWriterFactory gen writer for the audit log, nil for the table system.system_audit
+	auditWriterFactory table.WriterFactory // WithAuditWriterFactory
 
 	sqlExecutor func() ie.InternalExecutor // WithSQLExecutor
 	// needInit control table schema create
@@ -144,6 +147,14 @@ func WithFSWriterFactory(f table.WriterFactory) tracerProviderOption {
 	})
 }
 
+// WithAuditWriterFactory writes the audit log by the factory instead of writerFactory,
+// see export.GetAuditFileWriterFactory
+func WithAuditWriterFactory(f table.WriterFactory) tracerProviderOption {
+	return tracerProviderOption(func(cfg *tracerProviderConfig) {
+		cfg.auditWriterFactory = f
+	})
+}
+
 func WithExportInterval(secs int) tracerProviderOption {
 	return tracerProviderOption(func(cfg *tracerProviderConfig) {
 		cfg.exportInterval = time.Second * time.Duration(secs)
@@ -0,0 +1,160 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unsafe"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
+)
+
+const (
+	auditLogTbl = "system_audit"
+
+	AuditEventConnect   = "Connect"
+	AuditEventStatement = "Statement"
+)
+
+var (
+	auditEventCol  = table.StringColumn("event", "audit event, enum: Connect, Statement")
+	auditRoleCol   = table.StringColumn("role", "role name")
+	auditConnIDCol = table.UInt64Column("connection_id", "connection id")
+	auditDigestCol = table.StringColumn("statement_digest", "sha256 of the statement with the literals replaced by '?'")
+	auditStatusCol = table.StringColumn("status", "event status, enum: Success, Failed")
+
+	AuditLogTable = &table.Table{
+		Account:  table.AccountAll,
+		Database: StatsDatabase,
+		Table:    auditLogTbl,
+		Columns: []table.Column{
+			timestampCol,
+			auditEventCol,
+			nodeUUIDCol,
+			nodeTypeCol,
+			accountCol,
+			userCol,
+			auditRoleCol,
+			hostCol,
+			sesIDCol,
+			auditConnIDCol,
+			dbCol,
+			queryTypeCol,
+			stmtTypeCol,
+			auditDigestCol,
+			stmtCol,
+			auditStatusCol,
+			errCodeCol,
+			errorCol,
+		},
+		PrimaryKeyColumn: nil,
+		Engine:           table.ExternalTableEngine,
+		Comment:          "audit log of the logins and the statements",
+		PathBuilder:      table.NewAccountDatePathBuilder(),
+		AccountColumn:    &accountCol,
+		// SupportUserAccess
+		SupportUserAccess: false,
+	}
+)
+
+// AuditRecord is one event of the audit log, implement IBuffer2SqlItem and table.RowField
+type AuditRecord struct {
+	Timestamp     time.Time
+	Event         string // AuditEventConnect or AuditEventStatement
+	Account       string
+	User          string
+	Role          string
+	Host          string // client ip
+	SessionID     uuid.UUID
+	ConnectionID  uint32
+	Database      string
+	QueryType     string
+	StatementType string
+	Digest        string
+	Statement     string // empty if the text is not recorded
+	Error         error
+}
+
+func (r *AuditRecord) GetName() string {
+	return AuditLogTable.GetName()
+}
+
+func (r *AuditRecord) Size() int64 {
+	return int64(unsafe.Sizeof(*r)) + int64(len(r.Account)+len(r.User)+len(r.Role)+len(r.Host)+
+		len(r.Database)+len(r.Digest)+len(r.Statement))
+}
+
+func (r *AuditRecord) Free() {
+	r.Statement = ""
+	r.Error = nil
+}
+
+func (r *AuditRecord) GetTable() *table.Table { return AuditLogTable }
+
+func (r *AuditRecord) FillRow(ctx context.Context, row *table.Row) {
+	row.Reset()
+	row.SetColumnVal(timestampCol, r.Timestamp)
+	row.SetColumnVal(auditEventCol, r.Event)
+	row.SetColumnVal(nodeUUIDCol, GetNodeResource().NodeUuid)
+	row.SetColumnVal(nodeTypeCol, GetNodeResource().NodeType)
+	row.SetColumnVal(accountCol, r.Account)
+	row.SetColumnVal(userCol, r.User)
+	row.SetColumnVal(auditRoleCol, r.Role)
+	row.SetColumnVal(hostCol, r.Host)
+	row.SetColumnVal(sesIDCol, r.SessionID.String())
+	row.SetColumnVal(auditConnIDCol, uint64(r.ConnectionID))
+	row.SetColumnVal(dbCol, r.Database)
+	row.SetColumnVal(queryTypeCol, r.QueryType)
+	row.SetColumnVal(stmtTypeCol, r.StatementType)
+	row.SetColumnVal(auditDigestCol, r.Digest)
+	row.SetColumnVal(stmtCol, r.Statement)
+	if r.Error == nil {
+		row.SetColumnVal(auditStatusCol, "Success")
+		return
+	}
+	row.SetColumnVal(auditStatusCol, "Failed")
+	row.SetColumnVal(errorCol, r.Error.Error())
+	var moError *moerr.Error
+	if errors.As(r.Error, &moError) {
+		row.SetColumnVal(errCodeCol, fmt.Sprintf("%d", moError.ErrorCode()))
+	}
+}
+
+// ReportAudit send the audit record to BatchProcessor, it fails with the trace disabled.
+func ReportAudit(ctx context.Context, r *AuditRecord) error {
+	if !GetTracerProvider().IsEnable() {
+		return moerr.NewInternalError(ctx, "the audit log is not recorded with the trace disabled")
+	}
+	return GetGlobalBatchProcessor().Collect(DefaultContext(), r)
+}
+
+// auditBufferOptions writes the audit log by auditWriterFactory if it is set.
+func auditBufferOptions(config *tracerProviderConfig, defaultOptions []BufferOption) []BufferOption {
+	factory := config.auditWriterFactory
+	if factory == nil {
+		return defaultOptions
+	}
+	opts := make([]BufferOption, 0, len(defaultOptions)+1)
+	opts = append(opts, defaultOptions...)
+	return append(opts, BufferWithGenBatchFunc(func(ctx context.Context, in []IBuffer2SqlItem, buf *bytes.Buffer, _ table.WriterFactory) any {
+		return genETLData(ctx, in, buf, factory)
+	}))
+}
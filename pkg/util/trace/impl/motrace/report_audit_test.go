@@ -0,0 +1,93 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
+	"github.com/stretchr/testify/require"
+)
+
+type auditRowWriter struct {
+	rows [][]string
+}
+
+func (w *auditRowWriter) WriteRow(row *table.Row) error {
+	w.rows = append(w.rows, row.ToStrings())
+	return nil
+}
+func (w *auditRowWriter) GetContent() string          { return "" }
+func (w *auditRowWriter) FlushAndClose() (int, error) { return 0, nil }
+
+func TestAuditRecord_FillRow(t *testing.T) {
+	ctx := context.TODO()
+	r := &AuditRecord{
+		Timestamp:    time.Unix(0, 0),
+		Event:        AuditEventConnect,
+		Account:      "sys",
+		User:         "root",
+		Host:         "127.0.0.1",
+		SessionID:    uuid.New(),
+		ConnectionID: 1,
+	}
+	row := AuditLogTable.GetRow(ctx)
+	defer row.Free()
+	r.FillRow(ctx, row)
+	require.Equal(t, "sys", row.GetAccount())
+	vals := row.ToStrings()
+	require.Contains(t, vals, "Success")
+	require.Contains(t, vals, r.SessionID.String())
+
+	r.Error = moerr.NewInternalError(ctx, "wrong password")
+	r.FillRow(ctx, row)
+	vals = row.ToStrings()
+	require.Contains(t, vals, "Failed")
+	require.Contains(t, vals, "20101")
+}
+
+func TestAuditBufferOptions(t *testing.T) {
+	var cfg tracerProviderConfig
+	defaultOptions := []BufferOption{BufferWithSizeThreshold(1)}
+	require.Len(t, auditBufferOptions(&cfg, defaultOptions), 1)
+
+	w := &auditRowWriter{}
+	WithAuditWriterFactory(func(ctx context.Context, account string, tbl *table.Table, ts time.Time) table.RowWriter {
+		require.Equal(t, AuditLogTable, tbl)
+		return w
+	}).apply(&cfg)
+	opts := auditBufferOptions(&cfg, defaultOptions)
+	require.Len(t, opts, 2)
+
+	b := NewItemBuffer(opts...)
+	b.Add(&AuditRecord{Event: AuditEventStatement, Account: "sys", Statement: "select 1"})
+	reqs := b.GetBatch(context.TODO(), bytes.NewBuffer(nil))
+	require.Len(t, reqs, 1)
+	require.Len(t, w.rows, 1)
+	require.Contains(t, w.rows[0], "select 1")
+}
+
+func TestReportAudit_Disabled(t *testing.T) {
+	p := GetTracerProvider()
+	enable := p.IsEnable()
+	p.SetEnable(false)
+	defer p.SetEnable(enable)
+	require.Error(t, ReportAudit(context.TODO(), &AuditRecord{}))
+}
@@ -228,7 +228,7 @@ const (
 	sqlCreateDBConst = `create database if not exists ` + StatsDatabase
 )
 
-var tables = []*table.Table{SingleStatementTable, SingleRowLogTable}
+var tables = []*table.Table{SingleStatementTable, SingleRowLogTable, AuditLogTable}
 var views = []*table.View{logView, errorView, spanView}
 
 // InitSchemaByInnerExecutor init schema, which can access db by io.InternalExecutor on any Node.
@@ -124,6 +124,7 @@ func initExporter(ctx context.Context, config *tracerProviderConfig) error {
 		p.Register(&MOZapLog{}, NewBufferPipe2CSVWorker(defaultOptions...))
 		p.Register(&StatementInfo{}, NewBufferPipe2CSVWorker(defaultOptions...))
 		p.Register(&MOErrorHolder{}, NewBufferPipe2CSVWorker(defaultOptions...))
+		p.Register(&AuditRecord{}, NewBufferPipe2CSVWorker(auditBufferOptions(config, defaultOptions)...))
 	default:
 		return moerr.NewInternalError(ctx, "unknown batchProcessMode: %s", config.batchProcessMode)
 	}
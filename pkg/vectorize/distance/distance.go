@@ -0,0 +1,102 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distance
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+/*
+The distances of the vectors of VECF32 and VECF64, the results are always
+float64 to keep the precision of the sum. The vectors must have the same
+dimension, it's checked by the callers with CheckDimension.
+*/
+
+// CheckDimension checks the vectors have the same dimension
+func CheckDimension[T types.RealNumbers](v1, v2 []T) error {
+	if len(v1) != len(v2) {
+		return moerr.NewInvalidInputNoCtx("vectors have different dimensions %d and %d", len(v1), len(v2))
+	}
+	return nil
+}
+
+// L2DistanceSquare returns the square of the euclidean distance, it's enough
+// to compare the distances without the sqrt.
+func L2DistanceSquare[T types.RealNumbers](v1, v2 []T) float64 {
+	var s0, s1, s2, s3 float64
+	n := len(v1) &^ 3
+	for i := 0; i < n; i += 4 {
+		d0 := float64(v1[i]) - float64(v2[i])
+		d1 := float64(v1[i+1]) - float64(v2[i+1])
+		d2 := float64(v1[i+2]) - float64(v2[i+2])
+		d3 := float64(v1[i+3]) - float64(v2[i+3])
+		s0 += d0 * d0
+		s1 += d1 * d1
+		s2 += d2 * d2
+		s3 += d3 * d3
+	}
+	for i := n; i < len(v1); i++ {
+		d := float64(v1[i]) - float64(v2[i])
+		s0 += d * d
+	}
+	return s0 + s1 + s2 + s3
+}
+
+// L2Distance returns the euclidean distance
+func L2Distance[T types.RealNumbers](v1, v2 []T) float64 {
+	return math.Sqrt(L2DistanceSquare(v1, v2))
+}
+
+// InnerProduct returns the dot product
+func InnerProduct[T types.RealNumbers](v1, v2 []T) float64 {
+	var s0, s1, s2, s3 float64
+	n := len(v1) &^ 3
+	for i := 0; i < n; i += 4 {
+		s0 += float64(v1[i]) * float64(v2[i])
+		s1 += float64(v1[i+1]) * float64(v2[i+1])
+		s2 += float64(v1[i+2]) * float64(v2[i+2])
+		s3 += float64(v1[i+3]) * float64(v2[i+3])
+	}
+	for i := n; i < len(v1); i++ {
+		s0 += float64(v1[i]) * float64(v2[i])
+	}
+	return s0 + s1 + s2 + s3
+}
+
+// CosineDistance returns 1 - cosine similarity, ok is false if any of the
+// vectors is zero as the cosine is undefined.
+func CosineDistance[T types.RealNumbers](v1, v2 []T) (float64, bool) {
+	var dot, n1, n2 float64
+	for i := range v1 {
+		x, y := float64(v1[i]), float64(v2[i])
+		dot += x * y
+		n1 += x * x
+		n2 += y * y
+	}
+	if n1 == 0 || n2 == 0 {
+		return 0, false
+	}
+	sim := dot / (math.Sqrt(n1) * math.Sqrt(n2))
+	// keep the rounding error in the range
+	if sim > 1 {
+		sim = 1
+	} else if sim < -1 {
+		sim = -1
+	}
+	return 1 - sim, true
+}
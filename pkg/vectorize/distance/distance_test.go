@@ -0,0 +1,59 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distance
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestL2Distance(t *testing.T) {
+	require.Equal(t, float64(5), L2Distance([]float32{0, 0}, []float32{3, 4}))
+	require.Equal(t, float64(25), L2DistanceSquare([]float64{0, 0}, []float64{3, 4}))
+	// more than the unrolled width
+	v1 := []float64{1, 2, 3, 4, 5, 6, 7}
+	v2 := []float64{2, 3, 4, 5, 6, 7, 8}
+	require.InDelta(t, math.Sqrt(7), L2Distance(v1, v2), 1e-12)
+	require.Equal(t, float64(0), L2Distance(v1, v1))
+}
+
+func TestInnerProduct(t *testing.T) {
+	require.Equal(t, float64(32), InnerProduct([]float32{1, 2, 3}, []float32{4, 5, 6}))
+	require.Equal(t, float64(8), InnerProduct([]float64{1, 1, 1, 1, 1, 1, 1, 1}, []float64{1, 1, 1, 1, 1, 1, 1, 1}))
+}
+
+func TestCosineDistance(t *testing.T) {
+	d, ok := CosineDistance([]float32{1, 0}, []float32{0, 1})
+	require.True(t, ok)
+	require.InDelta(t, 1, d, 1e-12)
+
+	d, ok = CosineDistance([]float64{1, 2}, []float64{2, 4})
+	require.True(t, ok)
+	require.InDelta(t, 0, d, 1e-12)
+
+	d, ok = CosineDistance([]float64{1, 2}, []float64{-1, -2})
+	require.True(t, ok)
+	require.InDelta(t, 2, d, 1e-12)
+
+	_, ok = CosineDistance([]float64{0, 0}, []float64{1, 2})
+	require.False(t, ok)
+}
+
+func TestCheckDimension(t *testing.T) {
+	require.NoError(t, CheckDimension([]float32{1, 2}, []float32{3, 4}))
+	require.Error(t, CheckDimension([]float32{1, 2}, []float32{3}))
+}
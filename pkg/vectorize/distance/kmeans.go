@@ -0,0 +1,98 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distance
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// DefaultKMeansIterations is the max iterations of KMeans
+const DefaultKMeansIterations = 16
+
+// KMeans clusters the vectors into k lists by the euclidean distance with the
+// Lloyd's algorithm, it returns the centroids and the list of each vector.
+// The initial centroids are picked evenly from the vectors so the result is
+// stable for the same input. The number of the lists is reduced to the number
+// of the vectors if there are not enough vectors.
+func KMeans[T types.RealNumbers](vecs [][]T, k int, iterations int) ([][]T, []int) {
+	if k > len(vecs) {
+		k = len(vecs)
+	}
+	if k == 0 {
+		return nil, nil
+	}
+	dim := len(vecs[0])
+	centroids := make([][]T, k)
+	for i := range centroids {
+		centroids[i] = make([]T, dim)
+		copy(centroids[i], vecs[i*len(vecs)/k])
+	}
+	assignments := make([]int, len(vecs))
+	for i := range assignments {
+		assignments[i] = -1
+	}
+	sums := make([][]float64, k)
+	for i := range sums {
+		sums[i] = make([]float64, dim)
+	}
+	counts := make([]int, k)
+	for it := 0; it < iterations; it++ {
+		changed := false
+		for i, v := range vecs {
+			if c := NearestCentroid(centroids, v); c != assignments[i] {
+				assignments[i] = c
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		for i := range sums {
+			for j := range sums[i] {
+				sums[i][j] = 0
+			}
+			counts[i] = 0
+		}
+		for i, v := range vecs {
+			c := assignments[i]
+			for j, f := range v {
+				sums[c][j] += float64(f)
+			}
+			counts[c]++
+		}
+		for i := range centroids {
+			// the empty list keeps its centroid
+			if counts[i] == 0 {
+				continue
+			}
+			for j := range centroids[i] {
+				centroids[i][j] = T(sums[i][j] / float64(counts[i]))
+			}
+		}
+	}
+	return centroids, assignments
+}
+
+// NearestCentroid returns the position of the centroid nearest to the vector
+func NearestCentroid[T types.RealNumbers](centroids [][]T, v []T) int {
+	nearest := -1
+	var minDist float64
+	for i, c := range centroids {
+		if d := L2DistanceSquare(c, v); nearest == -1 || d < minDist {
+			nearest, minDist = i, d
+		}
+	}
+	return nearest
+}
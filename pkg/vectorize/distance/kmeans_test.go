@@ -0,0 +1,48 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKMeans(t *testing.T) {
+	vecs := [][]float32{
+		{0, 0}, {0, 1}, {1, 0},
+		{10, 10}, {10, 11}, {11, 10},
+	}
+	centroids, lists := KMeans(vecs, 2, DefaultKMeansIterations)
+	require.Equal(t, 2, len(centroids))
+	require.Equal(t, lists[0], lists[1])
+	require.Equal(t, lists[0], lists[2])
+	require.Equal(t, lists[3], lists[4])
+	require.Equal(t, lists[3], lists[5])
+	require.NotEqual(t, lists[0], lists[3])
+	require.InDeltaSlice(t, []float32{1.0 / 3, 1.0 / 3}, centroids[lists[0]], 1e-6)
+	require.InDeltaSlice(t, []float32{31.0 / 3, 31.0 / 3}, centroids[lists[3]], 1e-5)
+	require.Equal(t, lists[0], NearestCentroid(centroids, []float32{-1, -1}))
+	require.Equal(t, lists[3], NearestCentroid(centroids, []float32{20, 20}))
+
+	// not enough vectors
+	centroids, lists = KMeans(vecs[:1], 4, DefaultKMeansIterations)
+	require.Equal(t, 1, len(centroids))
+	require.Equal(t, []int{0}, lists)
+
+	centroids, lists = KMeans[float32](nil, 4, DefaultKMeansIterations)
+	require.Nil(t, centroids)
+	require.Nil(t, lists)
+}
@@ -183,6 +183,7 @@ func (cc *CatalogCache) GetTable(tbl *TableItem) bool {
 			tbl.Partitioned = item.Partitioned
 			tbl.Partition = item.Partition
 			tbl.CreateSql = item.CreateSql
+			tbl.Compression = item.Compression
 			tbl.PrimaryIdx = item.PrimaryIdx
 			tbl.ClusterByIdx = item.ClusterByIdx
 		}
@@ -260,6 +261,7 @@ func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
 	partitioneds := vector.MustFixedCol[int8](bat.GetVector(catalog.MO_TABLES_PARTITIONED_IDX + MO_OFF))
 	paritions := vector.MustStrCol(bat.GetVector(catalog.MO_TABLES_PARTITION_INFO_IDX + MO_OFF))
 	constraints := vector.MustBytesCol(bat.GetVector(catalog.MO_TABLES_CONSTRAINT_IDX + MO_OFF))
+	compressions := vector.MustStrCol(bat.GetVector(catalog.MO_TABLES_COMPRESSION_IDX + MO_OFF))
 
 	for i, account := range accounts {
 		item := new(TableItem)
@@ -275,6 +277,7 @@ func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
 		item.Partitioned = partitioneds[i]
 		item.Partition = paritions[i]
 		item.CreateSql = createSqls[i]
+		item.Compression = compressions[i]
 		item.PrimaryIdx = -1
 		item.ClusterByIdx = -1
 		copy(item.Rowid[:], rowids[i][:])
@@ -306,6 +309,7 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 	updateExprs := vector.MustBytesCol(bat.GetVector(catalog.MO_COLUMNS_ATT_UPDATE_IDX + MO_OFF))
 	nums := vector.MustFixedCol[int32](bat.GetVector(catalog.MO_COLUMNS_ATTNUM_IDX + MO_OFF))
 	clusters := vector.MustFixedCol[int8](bat.GetVector(catalog.MO_COLUMNS_ATT_IS_CLUSTERBY + MO_OFF))
+	enumValues := vector.MustStrCol(bat.GetVector(catalog.MO_COLUMNS_ATT_ENUM_IDX + MO_OFF))
 	for i, account := range accounts {
 		key.AccountId = account
 		key.Name = tableNames[i]
@@ -328,6 +332,7 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 				hasUpdate:       hasUpdates[i],
 				constraintType:  constraintTypes[i],
 				isClusterBy:     clusters[i],
+				enumValues:      enumValues[i],
 			}
 			col.typ = append(col.typ, typs[i]...)
 			col.updateExpr = append(col.updateExpr, updateExprs[i]...)
@@ -400,6 +405,7 @@ func genTableDefOfColumn(col column) engine.TableDef {
 	attr.IsHidden = col.isHidden == 1
 	attr.ClusterBy = col.isClusterBy == 1
 	attr.AutoIncrement = col.isAutoIncrement == 1
+	attr.EnumValues = col.enumValues
 	if err := types.Decode(col.typ, &attr.Type); err != nil {
 		panic(err)
 	}
@@ -434,10 +440,11 @@ func getTableDef(name string, defs []engine.TableDef) *plan.TableDef {
 				ColId: attr.Attr.ID,
 				Name:  attr.Attr.Name,
 				Typ: &plan.Type{
-					Id:       int32(attr.Attr.Type.Oid),
-					Width:    attr.Attr.Type.Width,
-					Scale:    attr.Attr.Type.Scale,
-					AutoIncr: attr.Attr.AutoIncrement,
+					Id:         int32(attr.Attr.Type.Oid),
+					Width:      attr.Attr.Type.Width,
+					Scale:      attr.Attr.Type.Scale,
+					AutoIncr:   attr.Attr.AutoIncrement,
+					Enumvalues: attr.Attr.EnumValues,
 				},
 				Primary:  attr.Attr.Primary,
 				Default:  attr.Attr.Default,
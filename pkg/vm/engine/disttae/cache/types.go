@@ -97,6 +97,7 @@ type TableItem struct {
 	Partitioned int8
 	Partition   string
 	CreateSql   string
+	Compression string
 
 	// primary index
 	PrimaryIdx int
@@ -129,6 +130,7 @@ type column struct {
 	hasUpdate       int8
 	updateExpr      []byte
 	isClusterBy     int8
+	enumValues      string
 }
 
 type columns []column
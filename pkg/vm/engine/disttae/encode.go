@@ -46,7 +46,7 @@ func encodePrimaryKeyVector(vec *vector.Vector, packer *types.Packer) (ret [][]b
 			packer.Reset()
 		}
 
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		s := vector.MustFixedCol[int16](vec)
 		for _, v := range s {
 			packer.EncodeInt16(v)
@@ -78,7 +78,7 @@ func encodePrimaryKeyVector(vec *vector.Vector, packer *types.Packer) (ret [][]b
 			packer.Reset()
 		}
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		s := vector.MustFixedCol[uint16](vec)
 		for _, v := range s {
 			packer.EncodeUint16(v)
@@ -94,7 +94,7 @@ func encodePrimaryKeyVector(vec *vector.Vector, packer *types.Packer) (ret [][]b
 			packer.Reset()
 		}
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		s := vector.MustFixedCol[uint64](vec)
 		for _, v := range s {
 			packer.EncodeUint64(v)
@@ -92,6 +92,9 @@ type pushClient struct {
 
 	// timestampWaiter is used to notify the latest commit timestamp
 	timestampWaiter client.TimestampWaiter
+
+	// tls is the mutual TLS config to connect to the log tail service
+	tls morpc.TLSConfig
 }
 
 func (client *pushClient) init(
@@ -104,7 +107,7 @@ func (client *pushClient) init(
 	if client.subscriber == nil {
 		client.subscriber = new(logTailSubscriber)
 	}
-	err := client.subscriber.init(serviceAddr)
+	err := client.subscriber.init(serviceAddr, client.tls)
 	if err != nil {
 		return err
 	}
@@ -489,17 +492,25 @@ type logTailSubscriberResponse struct {
 
 // XXX generate a rpc client and new a stream.
 // we should hide these code into service's NewClient method next day.
-func newRpcStreamToDnLogTailService(serviceAddr string) (morpc.Stream, error) {
+func newRpcStreamToDnLogTailService(serviceAddr string, tlsCfg morpc.TLSConfig) (morpc.Stream, error) {
 	logger := logutil.GetGlobalLogger().Named("cn-log-tail-client")
 	codec := morpc.NewMessageCodec(func() morpc.Message {
 		return &service.LogtailResponseSegment{}
 	})
-	factory := morpc.NewGoettyBasedBackendFactory(codec,
+	backendOpts := []morpc.BackendOption{
 		morpc.WithBackendGoettyOptions(
 			goetty.WithSessionRWBUfferSize(1<<20, 1<<20),
 		),
 		morpc.WithBackendLogger(logger),
-	)
+	}
+	if tlsCfg.Enable {
+		tc, err := tlsCfg.NewClientTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		backendOpts = append(backendOpts, morpc.WithBackendTLS(tc))
+	}
+	factory := morpc.NewGoettyBasedBackendFactory(codec, backendOpts...)
 
 	c, err1 := morpc.NewClient(factory,
 		morpc.WithClientMaxBackendPerHost(10000),
@@ -514,11 +525,11 @@ func newRpcStreamToDnLogTailService(serviceAddr string) (morpc.Stream, error) {
 	return stream, err2
 }
 
-func (s *logTailSubscriber) init(serviceAddr string) (err error) {
+func (s *logTailSubscriber) init(serviceAddr string, tlsCfg morpc.TLSConfig) (err error) {
 	// XXX we assume that we have only 1 dn now.
 	s.dnNodeID = 0
 
-	stream, err := newRpcStreamToDnLogTailService(serviceAddr)
+	stream, err := newRpcStreamToDnLogTailService(serviceAddr, tlsCfg)
 	if err != nil {
 		return err
 	}
@@ -594,8 +605,10 @@ func (e *Engine) UsePushModelOrNot() bool {
 
 func (e *Engine) InitLogTailPushModel(
 	ctx context.Context,
-	timestampWaiter client.TimestampWaiter) error {
+	timestampWaiter client.TimestampWaiter,
+	tlsCfg morpc.TLSConfig) error {
 	e.SetPushModelFlag(true)
+	e.pClient.tls = tlsCfg
 
 	// get log tail service address.
 	dnLogTailServerBackend := e.getDNServices()[0].LogTailServiceAddress
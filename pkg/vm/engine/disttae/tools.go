@@ -241,6 +241,11 @@ func genCreateTableTuple(tbl *txnTable, sql string, accountId, userId, roleId ui
 		if err := vector.AppendFixed(bat.Vecs[idx], uint32(0), false, m); err != nil {
 			return nil, err
 		}
+		idx = catalog.MO_TABLES_COMPRESSION_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // rel_compression
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(tbl.compression), false, m); err != nil {
+			return nil, err
+		}
 	}
 	return bat, nil
 }
@@ -361,6 +366,11 @@ func genCreateColumnTuple(col column, m *mpool.MPool) (*batch.Batch, error) {
 		if err := vector.AppendFixed(bat.Vecs[idx], col.isClusterBy, false, m); err != nil {
 			return nil, err
 		}
+		idx = catalog.MO_COLUMNS_ATT_ENUM_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // attr_enum
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(col.enumValues), false, m); err != nil {
+			return nil, err
+		}
 
 	}
 	return bat, nil
@@ -788,6 +798,7 @@ func getColumnsFromRows(rows [][]any) []column {
 		cols[i].updateExpr = row[catalog.MO_COLUMNS_ATT_UPDATE_IDX].([]byte)
 		cols[i].num = row[catalog.MO_COLUMNS_ATTNUM_IDX].(int32)
 		cols[i].isClusterBy = row[catalog.MO_COLUMNS_ATT_IS_CLUSTERBY].(int8)
+		cols[i].enumValues = string(row[catalog.MO_COLUMNS_ATT_ENUM_IDX].([]byte))
 	}
 	sort.Sort(Columns(cols))
 	return cols
@@ -823,6 +834,7 @@ func genTableDefOfColumn(col column) engine.TableDef {
 	if col.isClusterBy == 1 {
 		attr.ClusterBy = true
 	}
+	attr.EnumValues = col.enumValues
 	return &engine.AttributeDef{Attr: attr}
 }
 */
@@ -876,6 +888,7 @@ func genColumns(accountId uint32, tableName, databaseName string,
 			databaseName: databaseName,
 			num:          num,
 			comment:      attrDef.Attr.Comment,
+			enumValues:   attrDef.Attr.EnumValues,
 		}
 		attrDef.Attr.ID = uint64(num)
 		col.hasDef = 0
@@ -1188,7 +1201,7 @@ func transferIval[T int32 | int64](v T, oid types.T) (bool, any) {
 	switch oid {
 	case types.T_int8:
 		return true, int8(v)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return true, int16(v)
 	case types.T_int32:
 		return true, int32(v)
@@ -1196,11 +1209,11 @@ func transferIval[T int32 | int64](v T, oid types.T) (bool, any) {
 		return true, int64(v)
 	case types.T_uint8:
 		return true, uint8(v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return true, uint16(v)
 	case types.T_uint32:
 		return true, uint32(v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return true, uint64(v)
 	case types.T_float32:
 		return true, float32(v)
@@ -1215,7 +1228,7 @@ func transferUval[T uint32 | uint64](v T, oid types.T) (bool, any) {
 	switch oid {
 	case types.T_int8:
 		return true, int8(v)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return true, int16(v)
 	case types.T_int32:
 		return true, int32(v)
@@ -1223,11 +1236,11 @@ func transferUval[T uint32 | uint64](v T, oid types.T) (bool, any) {
 		return true, int64(v)
 	case types.T_uint8:
 		return true, uint8(v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return true, uint16(v)
 	case types.T_uint32:
 		return true, uint32(v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return true, uint64(v)
 	case types.T_float32:
 		return true, float32(v)
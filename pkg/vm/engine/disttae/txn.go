@@ -227,6 +227,9 @@ func (txn *Transaction) getS3Writer(key [2]string) (*colexec.S3Writer, engine.Re
 	if sortIdx != -1 {
 		s3Writer.SetSortIdx(sortIdx)
 	}
+	if t, ok := tbl.(*txnTable); ok {
+		s3Writer.SetCompression(t.compression)
+	}
 	return s3Writer, tbl, nil
 }
 
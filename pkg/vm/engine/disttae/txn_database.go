@@ -122,6 +122,7 @@ func (db *txnDatabase) Relation(ctx context.Context, name string) (engine.Relati
 		partition:    item.Partition,
 		createSql:    item.CreateSql,
 		constraint:   item.Constraint,
+		compression:  item.Compression,
 	}
 	columnLength := len(item.TableDef.Cols) - 1 // we use this data to fetch zonemap, but row_id has no zonemap
 	meta, err := db.txn.getTableMeta(ctx, db.databaseId, item.Id,
@@ -252,6 +253,8 @@ func (db *txnDatabase) Create(ctx context.Context, name string, defs []engine.Ta
 						tbl.relKind = property.Value
 					case catalog.SystemRelAttr_CreateSQL:
 						tbl.createSql = property.Value // I don't trust this information.
+					case catalog.SystemRelAttr_Compression:
+						tbl.compression = property.Value
 					default:
 					}
 				}
@@ -230,6 +230,7 @@ type txnTable struct {
 	relKind      string
 	createSql    string
 	constraint   []byte
+	compression  string
 
 	updated bool
 	// use for skip rows
@@ -267,6 +268,7 @@ type column struct {
 	isAutoIncrement int8
 	hasUpdate       int8
 	updateExpr      []byte
+	enumValues      string
 }
 
 type blockReader struct {
@@ -739,7 +739,8 @@ func getBinarySearchFuncByPkValue[T compareT](typ types.T, v T) func(*vector.Vec
 	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
 		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
 		types.T_float32, types.T_float64,
-		types.T_date, types.T_time, types.T_datetime, types.T_timestamp:
+		types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+		types.T_bit, types.T_year, types.T_enum, types.T_set:
 		return func(vec *vector.Vector) int {
 			rows := vector.MustFixedCol[T](vec)
 			return sort.Search(vec.Length(), func(idx int) bool {
@@ -54,6 +54,10 @@ func (c *CompilerContext) GetQueryingSubscription() *plan.SubscriptionMeta {
 	return nil
 }
 
+func (c *CompilerContext) GetTableAccess(dbName string, tableName string) (*plan.TableAccess, error) {
+	return nil, nil
+}
+
 func (e *Engine) NewCompilerContext(
 	ctx context.Context,
 	defaultDB string,
@@ -323,7 +323,7 @@ func getNullableValueFromVector(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
@@ -379,7 +379,7 @@ func getNullableValueFromVector(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
@@ -407,7 +407,7 @@ func getNullableValueFromVector(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
@@ -463,7 +463,7 @@ func getNullableValueFromVector(vec *vector.Vector, i int) (value Nullable) {
 		}
 		return
 
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		if vec.IsConstNull() {
 			value = Nullable{
 				IsNull: true,
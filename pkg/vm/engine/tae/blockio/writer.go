@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -63,6 +64,11 @@ func NewBlockWriterNew(fs fileservice.FileService, name objectio.ObjectName) (*B
 	}, nil
 }
 
+// SetCompress sets the algorithm to compress the column data, lz4 by default.
+func (w *BlockWriter) SetCompress(typ compress.T, level int) {
+	w.writer.SetCompress(typ, level)
+}
+
 func (w *BlockWriter) SetPrimaryKey(idx uint16) {
 	w.isSetPK = true
 	w.pk = idx
@@ -15,12 +15,14 @@
 package catalog
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -372,3 +374,52 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg1.String())
 	t.Log(tb.String())
 }
+
+func TestSchemaCompression(t *testing.T) {
+	schema := MockSchema(2, 0)
+	typ, level := schema.GetCompression()
+	assert.Equal(t, compress.T(compress.Lz4), typ)
+	assert.Equal(t, 0, level)
+
+	schema.Compression = "zstd:9"
+	cloned := schema.Clone()
+	assert.Equal(t, "zstd:9", cloned.Compression)
+	typ, level = cloned.GetCompression()
+	assert.Equal(t, compress.T(compress.Zstd), typ)
+	assert.Equal(t, 9, level)
+}
+
+func TestSchemaOldFormat(t *testing.T) {
+	schema := MockSchema(2, 0)
+	schema.Compression = "zstd:9"
+	schema.ColDefs[0].EnumValues = "a,b"
+	buf2, err := schema.marshalWithFormat(SchemaFormatV2)
+	assert.NoError(t, err)
+	replayed2 := NewEmptySchema("")
+	_, err = replayed2.ReadFromWithFormat(bytes.NewBuffer(buf2), SchemaFormatV2)
+	assert.NoError(t, err)
+	assert.Equal(t, "zstd:9", replayed2.Compression)
+	assert.Equal(t, "", replayed2.ColDefs[0].EnumValues)
+
+	buf, err := schema.marshalWithFormat(SchemaFormatV1)
+	assert.NoError(t, err)
+
+	replayed := NewEmptySchema("")
+	_, err = replayed.ReadFromWithFormat(bytes.NewBuffer(buf), SchemaFormatV1)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Name, replayed.Name)
+	assert.Equal(t, len(schema.ColDefs), len(replayed.ColDefs))
+	assert.Equal(t, "", replayed.Compression)
+	assert.Equal(t, "", replayed.ColDefs[0].EnumValues)
+
+	cmd := txnif.GetCmdFactory(CmdUpdateTable)(CmdUpdateTable)
+	node := cmd.(*EntryCommand[*TableMVCCNode, *TableNode]).mvccNode.BaseNode
+	_, err = node.ReadFrom(bytes.NewBuffer(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Name, node.Schema.Name)
+	assert.Equal(t, "", node.Schema.Compression)
+
+	cloned := schema.Clone()
+	assert.Equal(t, "zstd:9", cloned.Compression)
+	assert.Equal(t, "a,b", cloned.ColDefs[0].EnumValues)
+}
@@ -31,6 +31,11 @@ const (
 	CmdUpdateTable
 	CmdUpdateSegment
 	CmdUpdateBlock
+	// CmdUpdateTableV2 and CmdUpdateTableV3 update a table with the schema in
+	// SchemaFormatV2 and SchemaFormatV3. The old ones are kept to replay the
+	// wal written in an old format.
+	CmdUpdateTableV2
+	CmdUpdateTableV3
 )
 
 var cmdNames = map[int16]string{
@@ -38,6 +43,8 @@ var cmdNames = map[int16]string{
 	CmdUpdateTable:    "UTBL",
 	CmdUpdateSegment:  "USEG",
 	CmdUpdateBlock:    "UBLK",
+	CmdUpdateTableV2:  "UTBL2",
+	CmdUpdateTableV3:  "UTBL3",
 }
 
 func CmdName(t int16) string {
@@ -52,7 +59,17 @@ func init() {
 	})
 	txnif.RegisterCmdFactory(CmdUpdateTable, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType,
-			NewEmptyMVCCNodeFactory(NewEmptyTableMVCCNode),
+			NewEmptyMVCCNodeFactory(newEmptyTableMVCCNodeFactory(SchemaFormatV1)),
+			func() *TableNode { return &TableNode{} })
+	})
+	txnif.RegisterCmdFactory(CmdUpdateTableV2, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType,
+			NewEmptyMVCCNodeFactory(newEmptyTableMVCCNodeFactory(SchemaFormatV2)),
+			func() *TableNode { return &TableNode{} })
+	})
+	txnif.RegisterCmdFactory(CmdUpdateTableV3, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType,
+			NewEmptyMVCCNodeFactory(newEmptyTableMVCCNodeFactory(SchemaFormatV3)),
 			func() *TableNode { return &TableNode{} })
 	})
 	txnif.RegisterCmdFactory(CmdUpdateSegment, func(cmdType int16) txnif.TxnCmd {
@@ -186,7 +203,7 @@ func (cmd *EntryCommand[T, N]) IDString() string {
 	switch cmd.cmdType {
 	case CmdUpdateDatabase:
 		s = fmt.Sprintf("%sDB=%d", s, dbid)
-	case CmdUpdateTable:
+	case CmdUpdateTable, CmdUpdateTableV2, CmdUpdateTableV3:
 		s = fmt.Sprintf("%sDB=%d;CommonID=%s", s, dbid, id.TableString())
 	case CmdUpdateSegment:
 		s = fmt.Sprintf("%sDB=%d;CommonID=%s", s, dbid, id.SegmentString())
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
@@ -54,6 +55,7 @@ type ColDef struct {
 	ClusterBy     bool
 	Default       []byte
 	OnUpdate      []byte
+	EnumValues    string // the members of ENUM or SET
 }
 
 func (def *ColDef) GetName() string     { return def.Name }
@@ -100,6 +102,19 @@ func (cpk *SortKey) GetDef(pos int) *ColDef         { return cpk.Defs[pos] }
 func (cpk *SortKey) HasColumn(idx int) (found bool) { _, found = cpk.search[idx]; return }
 func (cpk *SortKey) GetSingleIdx() int              { return cpk.Defs[0].Idx }
 
+// The formats of a marshaled schema. A new format is added with a new table
+// command type, so that the wal written in an old format can be replayed.
+const (
+	// SchemaFormatV1 is the format before the compression option
+	SchemaFormatV1 = uint16(iota + 1)
+	// SchemaFormatV2 adds the compression option of the table
+	SchemaFormatV2
+	// SchemaFormatV3 adds the members of the ENUM and SET columns
+	SchemaFormatV3
+
+	SchemaFormatCurrent = SchemaFormatV3
+)
+
 type Schema struct {
 	Version          uint32
 	NextColSeqnum    uint16
@@ -116,6 +131,9 @@ type Schema struct {
 	Createsql        string
 	View             string
 	Constraint       []byte
+	// Compression is the compression option of the table, the column data of
+	// the objects written by the table are compressed by it
+	Compression string
 
 	SortKey    *SortKey
 	PhyAddrKey *ColDef
@@ -164,6 +182,12 @@ func (s *Schema) GetSingleSortKeyIdx() int         { return s.SortKey.Defs[0].Id
 func (s *Schema) GetSingleSortKeyType() types.Type { return s.GetSingleSortKey().Type }
 
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
+	return s.ReadFromWithFormat(r, SchemaFormatCurrent)
+}
+
+// ReadFromWithFormat reads a schema marshaled in the given format, the fields
+// added by a later format are left empty
+func (s *Schema) ReadFromWithFormat(r io.Reader, format uint16) (n int64, err error) {
 	var sn2 int
 	if sn2, err = r.Read(types.EncodeUint32(&s.BlockMaxRows)); err != nil {
 		return
@@ -220,6 +244,12 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		return
 	}
 	n += sn
+	if format >= SchemaFormatV2 {
+		if s.Compression, sn, err = objectio.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+	}
 	colCnt := uint16(0)
 	if sn2, err = r.Read(types.EncodeUint16(&colCnt)); err != nil {
 		return
@@ -281,6 +311,12 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if format >= SchemaFormatV3 {
+			if def.EnumValues, sn, err = objectio.ReadString(r); err != nil {
+				return
+			}
+			n += sn
+		}
 		if err = s.AppendColDef(def); err != nil {
 			return
 		}
@@ -290,6 +326,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 }
 
 func (s *Schema) Marshal() (buf []byte, err error) {
+	return s.marshalWithFormat(SchemaFormatCurrent)
+}
+
+func (s *Schema) marshalWithFormat(format uint16) (buf []byte, err error) {
 	var w bytes.Buffer
 	if _, err = w.Write(types.EncodeUint32(&s.BlockMaxRows)); err != nil {
 		return
@@ -330,6 +370,11 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if _, err = objectio.WriteBytes(s.Constraint, &w); err != nil {
 		return
 	}
+	if format >= SchemaFormatV2 {
+		if _, err = objectio.WriteString(s.Compression, &w); err != nil {
+			return
+		}
+	}
 	length := uint16(len(s.ColDefs))
 	if _, err = w.Write(types.EncodeUint16(&length)); err != nil {
 		return
@@ -374,6 +419,11 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = objectio.WriteBytes(def.OnUpdate, &w); err != nil {
 			return
 		}
+		if format >= SchemaFormatV3 {
+			if _, err = objectio.WriteString(def.EnumValues, &w); err != nil {
+				return
+			}
+		}
 	}
 	buf = w.Bytes()
 	return
@@ -408,6 +458,7 @@ func (s *Schema) ReadFromBatch(bat *containers.Batch, offset int, targetTid uint
 		def.Comment = string(bat.GetVectorByName((pkgcatalog.SystemColAttr_Comment)).Get(offset).([]byte))
 		def.OnUpdate = bat.GetVectorByName((pkgcatalog.SystemColAttr_Update)).Get(offset).([]byte)
 		def.Default = bat.GetVectorByName((pkgcatalog.SystemColAttr_DefaultExpr)).Get(offset).([]byte)
+		def.EnumValues = string(bat.GetVectorByName((pkgcatalog.SystemColAttr_EnumValues)).Get(offset).([]byte))
 		def.Idx = int(bat.GetVectorByName((pkgcatalog.SystemColAttr_Num)).Get(offset).(int32)) - 1
 		s.NameIndex[def.Name] = def.Idx
 		s.ColDefs = append(s.ColDefs, def)
@@ -426,6 +477,16 @@ func (s *Schema) ReadFromBatch(bat *containers.Batch, offset int, targetTid uint
 	return offset
 }
 
+// GetCompression returns the algorithm and the level to compress the column data, lz4 by default.
+func (s *Schema) GetCompression() (compress.T, int) {
+	typ, level, err := compress.ParseCompression(s.Compression)
+	if err != nil {
+		// the option is checked when the table is created
+		return compress.Lz4, 0
+	}
+	return typ, level
+}
+
 func (s *Schema) AppendColDef(def *ColDef) (err error) {
 	def.Idx = len(s.ColDefs)
 	s.ColDefs = append(s.ColDefs, def)
@@ -496,6 +557,7 @@ func ColDefFromAttribute(attr engine.Attribute) (*ColDef, error) {
 		Comment:       attr.Comment,
 		AutoIncrement: attr.AutoIncrement,
 		ClusterBy:     attr.ClusterBy,
+		EnumValues:    attr.EnumValues,
 		Default:       []byte(""),
 		OnUpdate:      []byte(""),
 	}
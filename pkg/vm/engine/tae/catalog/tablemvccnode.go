@@ -22,12 +22,22 @@ import (
 type TableMVCCNode struct {
 	// history schema
 	Schema *Schema
+	// the format of the schema to read, the current format if zero
+	schemaFormat uint16
 }
 
 func NewEmptyTableMVCCNode() *TableMVCCNode {
 	return &TableMVCCNode{}
 }
 
+// newEmptyTableMVCCNodeFactory returns a factory of the table mvcc nodes
+// reading the schema written in the given format
+func newEmptyTableMVCCNodeFactory(schemaFormat uint16) func() *TableMVCCNode {
+	return func() *TableMVCCNode {
+		return &TableMVCCNode{schemaFormat: schemaFormat}
+	}
+}
+
 func (e *TableMVCCNode) CloneAll() *TableMVCCNode {
 	return &TableMVCCNode{
 		Schema: e.Schema.Clone(),
@@ -61,7 +71,11 @@ func (e *TableMVCCNode) WriteTo(w io.Writer) (n int64, err error) {
 
 func (e *TableMVCCNode) ReadFrom(r io.Reader) (n int64, err error) {
 	e.Schema = NewEmptySchema("")
-	if n, err = e.Schema.ReadFrom(r); err != nil {
+	format := e.schemaFormat
+	if format == 0 {
+		format = SchemaFormatCurrent
+	}
+	if n, err = e.Schema.ReadFromWithFormat(r, format); err != nil {
 		return
 	}
 	return
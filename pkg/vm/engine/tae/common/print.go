@@ -75,7 +75,8 @@ func TypeStringValue(t types.Type, v any, isNull bool, opts ...TypePrintOpt) str
 	switch t.Oid {
 	case types.T_bool, types.T_int8, types.T_int16, types.T_int32,
 		types.T_int64, types.T_uint8, types.T_uint16, types.T_uint32,
-		types.T_uint64, types.T_float32, types.T_float64:
+		types.T_uint64, types.T_float32, types.T_float64,
+		types.T_bit, types.T_year, types.T_enum, types.T_set:
 		return fmt.Sprintf("%v", v)
 	case types.T_char, types.T_varchar,
 		types.T_binary, types.T_varbinary, types.T_text, types.T_blob:
@@ -119,6 +120,10 @@ func TypeStringValue(t types.Type, v any, isNull bool, opts ...TypePrintOpt) str
 		val := v.([]byte)
 		j := types.DecodeJson(val)
 		return j.String()
+	case types.T_vecf32:
+		return types.VectorToString(types.BytesToVector[float32](v.([]byte)))
+	case types.T_vecf64:
+		return types.VectorToString(types.BytesToVector[float64](v.([]byte)))
 	case types.T_uuid:
 		val := v.(types.Uuid)
 		return val.ToString()
@@ -165,7 +170,7 @@ func moVec2String(v *vector.Vector, printN int) string {
 		return vec2Str(vector.MustFixedCol[bool](v)[:printN], v)
 	case types.T_int8:
 		return vec2Str(vector.MustFixedCol[int8](v)[:printN], v)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return vec2Str(vector.MustFixedCol[int16](v)[:printN], v)
 	case types.T_int32:
 		return vec2Str(vector.MustFixedCol[int32](v)[:printN], v)
@@ -173,11 +178,11 @@ func moVec2String(v *vector.Vector, printN int) string {
 		return vec2Str(vector.MustFixedCol[int64](v)[:printN], v)
 	case types.T_uint8:
 		return vec2Str(vector.MustFixedCol[uint8](v)[:printN], v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return vec2Str(vector.MustFixedCol[uint16](v)[:printN], v)
 	case types.T_uint32:
 		return vec2Str(vector.MustFixedCol[uint32](v)[:printN], v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return vec2Str(vector.MustFixedCol[uint64](v)[:printN], v)
 	case types.T_float32:
 		return vec2Str(vector.MustFixedCol[float32](v)[:printN], v)
@@ -59,7 +59,7 @@ func Compare(a, b []byte, t types.T) int64 {
 		return CompareBool(types.DecodeBool(a), types.DecodeBool(b))
 	case types.T_int8:
 		return CompareOrdered(types.DecodeInt8(a), types.DecodeInt8(b))
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return CompareOrdered(types.DecodeInt16(a), types.DecodeInt16(b))
 	case types.T_int32:
 		return CompareOrdered(types.DecodeInt32(a), types.DecodeInt32(b))
@@ -67,11 +67,11 @@ func Compare(a, b []byte, t types.T) int64 {
 		return CompareOrdered(types.DecodeInt64(a), types.DecodeInt64(b))
 	case types.T_uint8:
 		return CompareOrdered(types.DecodeUint8(a), types.DecodeUint8(b))
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return CompareOrdered(types.DecodeUint16(a), types.DecodeUint16(b))
 	case types.T_uint32:
 		return CompareOrdered(types.DecodeUint32(a), types.DecodeUint32(b))
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return CompareOrdered(types.DecodeUint64(a), types.DecodeUint64(b))
 	case types.T_decimal64:
 		return types.CompareDecimal64(types.DecodeDecimal64(a), types.DecodeDecimal64(b))
@@ -98,7 +98,7 @@ func Compare(a, b []byte, t types.T) int64 {
 	case types.T_uuid:
 		return types.CompareUuid(types.DecodeUuid(a), types.DecodeUuid(b))
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_text, types.T_vecf32, types.T_vecf64:
 		return CompareBytes(a, b)
 	case types.T_any:
 		return 0
@@ -113,7 +113,7 @@ func CompareGeneric(a, b any, t types.T) int64 {
 		return CompareBool(a.(bool), b.(bool))
 	case types.T_int8:
 		return CompareOrdered[int8](a.(int8), b.(int8))
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return CompareOrdered[int16](a.(int16), b.(int16))
 	case types.T_int32:
 		return CompareOrdered[int32](a.(int32), b.(int32))
@@ -121,11 +121,11 @@ func CompareGeneric(a, b any, t types.T) int64 {
 		return CompareOrdered[int64](a.(int64), b.(int64))
 	case types.T_uint8:
 		return CompareOrdered[uint8](a.(uint8), b.(uint8))
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return CompareOrdered[uint16](a.(uint16), b.(uint16))
 	case types.T_uint32:
 		return CompareOrdered[uint32](a.(uint32), b.(uint32))
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return CompareOrdered[uint64](a.(uint64), b.(uint64))
 	case types.T_decimal64:
 		return int64(a.(types.Decimal64).Compare(b.(types.Decimal64)))
@@ -154,7 +154,7 @@ func CompareGeneric(a, b any, t types.T) int64 {
 	case types.T_uuid:
 		return types.CompareUuid(a.(types.Uuid), b.(types.Uuid))
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_text, types.T_vecf32, types.T_vecf64:
 		return CompareBytes(a.([]byte), b.([]byte))
 	case types.T_any:
 		return 0
@@ -158,7 +158,7 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 		return GetOffsetWithFunc(data.Slice().([]bool), v.(bool), CompareBool, skipmask)
 	case types.T_int8:
 		return GetOffsetOfOrdered[int8](data.Slice(), v, skipmask)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return GetOffsetOfOrdered[int16](data.Slice(), v, skipmask)
 	case types.T_int32:
 		return GetOffsetOfOrdered[int32](data.Slice(), v, skipmask)
@@ -166,11 +166,11 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 		return GetOffsetOfOrdered[int64](data.Slice(), v, skipmask)
 	case types.T_uint8:
 		return GetOffsetOfOrdered[uint8](data.Slice(), v, skipmask)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return GetOffsetOfOrdered[uint16](data.Slice(), v, skipmask)
 	case types.T_uint32:
 		return GetOffsetOfOrdered[uint32](data.Slice(), v, skipmask)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return GetOffsetOfOrdered[uint64](data.Slice(), v, skipmask)
 	case types.T_float32:
 		return GetOffsetOfOrdered[float32](data.Slice(), v, skipmask)
@@ -221,7 +221,7 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 			types.CompareUuid,
 			skipmask)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_text, types.T_vecf32, types.T_vecf64:
 		// column := data.Slice().(*containers.Bytes)
 		val := v.([]byte)
 		start, end := 0, data.Length()-1
@@ -26,7 +26,7 @@ func MakeVector(typ types.Type, opts ...Options) (vec Vector) {
 		vec = NewVector[bool](typ, opts...)
 	case types.T_int8:
 		vec = NewVector[int8](typ, opts...)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		vec = NewVector[int16](typ, opts...)
 	case types.T_int32:
 		vec = NewVector[int32](typ, opts...)
@@ -34,11 +34,11 @@ func MakeVector(typ types.Type, opts ...Options) (vec Vector) {
 		vec = NewVector[int64](typ, opts...)
 	case types.T_uint8:
 		vec = NewVector[uint8](typ, opts...)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vec = NewVector[uint16](typ, opts...)
 	case types.T_uint32:
 		vec = NewVector[uint32](typ, opts...)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vec = NewVector[uint64](typ, opts...)
 	case types.T_decimal64:
 		vec = NewVector[types.Decimal64](typ, opts...)
@@ -65,7 +65,7 @@ func MakeVector(typ types.Type, opts ...Options) (vec Vector) {
 	case types.T_Blockid:
 		vec = NewVector[types.Blockid](typ, opts...)
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		vec = NewVector[[]byte](typ, opts...)
 	default:
 		panic("not support")
@@ -173,7 +173,7 @@ func MockVector(t types.Type, rows int, unique bool, provider Vector) (vec Vecto
 			v2 := rand.Intn(math.MaxInt32) + 1
 			vec.Append(float64(v1)/float64(v2), false)
 		}
-	case types.T_varchar, types.T_char, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+	case types.T_varchar, types.T_char, types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		if unique {
 			for i := 0; i < rows; i++ {
 				s := fmt.Sprintf("%d-%d", i, 0)
@@ -307,7 +307,7 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 			vec.Append(types.Datetime(i+offset), false)
 		}
 	case types.T_char, types.T_varchar, types.T_binary,
-		types.T_varbinary, types.T_blob, types.T_text:
+		types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		for i := 0; i < rows; i++ {
 			vec.Append([]byte(strconv.Itoa(i+offset)), false)
 		}
@@ -80,7 +80,7 @@ func getNonNullValue(col *movec.Vector, row uint32) any {
 		return movec.GetFixedAt[bool](col, int(row))
 	case types.T_int8:
 		return movec.GetFixedAt[int8](col, int(row))
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return movec.GetFixedAt[int16](col, int(row))
 	case types.T_int32:
 		return movec.GetFixedAt[int32](col, int(row))
@@ -88,11 +88,11 @@ func getNonNullValue(col *movec.Vector, row uint32) any {
 		return movec.GetFixedAt[int64](col, int(row))
 	case types.T_uint8:
 		return movec.GetFixedAt[uint8](col, int(row))
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return movec.GetFixedAt[uint16](col, int(row))
 	case types.T_uint32:
 		return movec.GetFixedAt[uint32](col, int(row))
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return movec.GetFixedAt[uint64](col, int(row))
 	case types.T_decimal64:
 		return movec.GetFixedAt[types.Decimal64](col, int(row))
@@ -118,7 +118,7 @@ func getNonNullValue(col *movec.Vector, row uint32) any {
 		return movec.GetFixedAt[types.Rowid](col, int(row))
 	case types.T_Blockid:
 		return movec.GetFixedAt[types.Blockid](col, int(row))
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		return col.GetBytesAt(int(row))
 	default:
 		//return vector.ErrVecTypeNotSupport
@@ -164,7 +164,7 @@ func UpdateValue(col *movec.Vector, row uint32, val any, isNull bool) {
 		GenericUpdateFixedValue[bool](col, row, val, isNull)
 	case types.T_int8:
 		GenericUpdateFixedValue[int8](col, row, val, isNull)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		GenericUpdateFixedValue[int16](col, row, val, isNull)
 	case types.T_int32:
 		GenericUpdateFixedValue[int32](col, row, val, isNull)
@@ -172,11 +172,11 @@ func UpdateValue(col *movec.Vector, row uint32, val any, isNull bool) {
 		GenericUpdateFixedValue[int64](col, row, val, isNull)
 	case types.T_uint8:
 		GenericUpdateFixedValue[uint8](col, row, val, isNull)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		GenericUpdateFixedValue[uint16](col, row, val, isNull)
 	case types.T_uint32:
 		GenericUpdateFixedValue[uint32](col, row, val, isNull)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		GenericUpdateFixedValue[uint64](col, row, val, isNull)
 	case types.T_decimal64:
 		GenericUpdateFixedValue[types.Decimal64](col, row, val, isNull)
@@ -203,7 +203,7 @@ func UpdateValue(col *movec.Vector, row uint32, val any, isNull bool) {
 	case types.T_Blockid:
 		GenericUpdateFixedValue[types.Blockid](col, row, val, isNull)
 	case types.T_varchar, types.T_char, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		GenericUpdateBytes(col, row, val, isNull)
 	default:
 		panic(moerr.NewInternalErrorNoCtx("%v not supported", col.GetType()))
@@ -306,7 +306,7 @@ func ForeachVectorWindow(
 			op.(func(int8, bool, int) error),
 			nil,
 			sel)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return ForeachWindowFixed[int16](
 			vec,
 			start,
@@ -338,7 +338,7 @@ func ForeachVectorWindow(
 			op.(func(uint8, bool, int) error),
 			nil,
 			sel)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return ForeachWindowFixed[uint16](
 			vec,
 			start,
@@ -354,7 +354,7 @@ func ForeachVectorWindow(
 			op.(func(uint32, bool, int) error),
 			nil,
 			sel)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return ForeachWindowFixed[uint64](
 			vec,
 			start,
@@ -616,7 +616,7 @@ func MakeForeachVectorOp(t types.T, overloads map[types.T]any, args ...any) any
 	case types.T_int8:
 		overload := overloads[t].(func(...any) func(int8, bool, int) error)
 		return overload(args...)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		overload := overloads[t].(func(...any) func(int16, bool, int) error)
 		return overload(args...)
 	case types.T_int32:
@@ -628,13 +628,13 @@ func MakeForeachVectorOp(t types.T, overloads map[types.T]any, args ...any) any
 	case types.T_uint8:
 		overload := overloads[t].(func(...any) func(uint8, bool, int) error)
 		return overload(args...)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		overload := overloads[t].(func(...any) func(uint16, bool, int) error)
 		return overload(args...)
 	case types.T_uint32:
 		overload := overloads[t].(func(...any) func(uint32, bool, int) error)
 		return overload(args...)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		overload := overloads[t].(func(...any) func(uint64, bool, int) error)
 		return overload(args...)
 	case types.T_float32:
@@ -0,0 +1,84 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkpoint
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+)
+
+// BackupCheckpoint collects a global checkpoint at end with the databases and
+// tables accepted by the filters, and writes it into fs with a metadata file
+// having only this checkpoint, so a TAE opened on fs replays it. The deletes of
+// the blocks are replaced by deltaFn if it is not nil. It returns the files
+// written and the objects referred by the checkpoint.
+func BackupCheckpoint(
+	ctx context.Context,
+	fs fileservice.FileService,
+	c *catalog.Catalog,
+	end types.TS,
+	dbFilter func(*catalog.DBEntry) bool,
+	tblFilter func(*catalog.TableEntry) bool,
+	deltaFn func(entry *catalog.BlockEntry, metaLoc, deltaLoc objectio.Location) (objectio.Location, error),
+) (files, objects []string, err error) {
+	entry := NewCheckpointEntry(types.TS{}, end, ET_Global)
+	factory := logtail.BackupCheckpointDataFactory(entry.end, dbFilter, tblFilter, deltaFn)
+	data, err := factory(c)
+	if err != nil {
+		return
+	}
+	defer data.Close()
+
+	segmentid, _ := types.BuildUuid()
+	name := objectio.BuildObjectName(segmentid, 0)
+	writer, err := blockio.NewBlockWriterNew(fs, name)
+	if err != nil {
+		return
+	}
+	blks, err := data.WriteTo(writer)
+	if err != nil {
+		return
+	}
+	entry.SetLocation(objectio.BuildLocation(name, blks[0].GetExtent(), 0, blks[0].GetID()))
+	files = append(files, name.String())
+
+	bat := makeRespBatchFromSchema(CheckpointSchema)
+	defer bat.Close()
+	bat.GetVectorByName(CheckpointAttr_StartTS).Append(entry.start, false)
+	bat.GetVectorByName(CheckpointAttr_EndTS).Append(entry.end, false)
+	bat.GetVectorByName(CheckpointAttr_MetaLocation).Append([]byte(entry.GetLocation()), false)
+	bat.GetVectorByName(CheckpointAttr_EntryType).Append(false, false)
+	metaName := blockio.EncodeCheckpointMetadataFileName(CheckpointDir, PrefixMetadata, entry.start, entry.end)
+	metaWriter, err := objectio.NewObjectWriterSpecial(objectio.WriterCheckpoint, metaName, fs)
+	if err != nil {
+		return
+	}
+	if _, err = metaWriter.Write(containers.ToCNBatch(bat)); err != nil {
+		return
+	}
+	if _, err = metaWriter.WriteEnd(ctx); err != nil {
+		return
+	}
+	files = append(files, metaName)
+	objects = data.GetObjectNames()
+	return
+}
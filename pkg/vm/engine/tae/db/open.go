@@ -90,6 +90,11 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	case options.LogstoreLogservice:
 		db.Wal = wal.NewDriverWithLogservice(opts.Lc)
 	}
+	var archived *wal.ArchivedDriver
+	if opts.ArchiveFs != nil {
+		archived = wal.NewArchivedDriver(db.Wal, opts.ArchiveFs)
+		db.Wal = archived
+	}
 	db.Scheduler = newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
 	dataFactory := tables.NewDataFactory(
 		db.Fs, indexCache, db.Scheduler, db.Dir)
@@ -137,6 +142,10 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		common.AnyField("cost", time.Since(now)),
 		common.AnyField("checkpointed", checkpointed.ToString()))
 
+	if archived != nil {
+		archived.Begin(checkpointed)
+	}
+
 	now = time.Now()
 	db.Replay(dataFactory, checkpointed)
 	db.Catalog.ReplayTableRows()
@@ -163,7 +172,9 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	db.DiskCleaner.AddChecker(
 		func(item any) bool {
 			checkpoint := item.(*checkpoint.CheckpointEntry)
-			ts := types.BuildTS(time.Now().UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
+			now := time.Now()
+			ts := types.BuildTS(now.UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
+			ts = gc.Watermark(ts, now, opts.GCCfg.HistoryRetention)
 			return !checkpoint.GetEnd().GreaterEq(ts)
 		})
 	// Init gc manager at last
@@ -195,7 +206,8 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				return db.BGCheckpointRunner.GCByTS(ctx, consumed.GetEnd())
+				ts := gc.Watermark(consumed.GetEnd(), time.Now(), opts.GCCfg.HistoryRetention)
+				return db.BGCheckpointRunner.GCByTS(ctx, ts)
 			}),
 		gc.WithCronJob(
 			"catalog-gc",
@@ -208,7 +220,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				db.Catalog.GCByTS(ctx, consumed.GetEnd())
+				db.Catalog.GCByTS(ctx, gc.Watermark(consumed.GetEnd(), time.Now(), opts.GCCfg.HistoryRetention))
 				return nil
 			}),
 		gc.WithCronJob(
@@ -217,7 +229,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 			func(ctx context.Context) error {
 				global := db.BGCheckpointRunner.MaxGlobalCheckpoint()
 				if global != nil {
-					db.LogtailMgr.GCByTS(ctx, global.GetEnd())
+					db.LogtailMgr.GCByTS(ctx, gc.Watermark(global.GetEnd(), time.Now(), opts.GCCfg.HistoryRetention))
 				}
 				return nil
 			},
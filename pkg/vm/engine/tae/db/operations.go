@@ -125,6 +125,19 @@ type InspectDN struct {
 	Operation  string
 }
 
+// Backup backs up the scope into the path, Scope is the kind of backup.Scope.
+type Backup struct {
+	Scope      string
+	AccountID  uint32
+	DatabaseID uint64
+	Path       string
+}
+
+type BackupResp struct {
+	TS    string
+	Files int
+}
+
 type CreateDatabaseResp struct {
 	ID uint64
 }
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/stretchr/testify/assert"
 )
@@ -61,3 +62,13 @@ func TestManager(t *testing.T) {
 	t.Log(ints)
 	assert.Equal(t, []int{2, 1}, ints[0:2])
 }
+
+func TestWatermark(t *testing.T) {
+	now := time.Now()
+	ts := types.BuildTS(now.UTC().UnixNano()-int64(time.Minute), 0)
+	assert.Equal(t, ts, Watermark(ts, now, 0))
+	assert.Equal(t, ts, Watermark(ts, now, time.Second))
+
+	retained := types.BuildTS(now.UTC().UnixNano()-int64(time.Hour), 0)
+	assert.Equal(t, retained, Watermark(ts, now, time.Hour))
+}
@@ -0,0 +1,35 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gc
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Watermark merges the watermark of a gc job with the history retention: the
+// data committed in the last retention before now is kept for the reads at
+// past timestamps, so the job never reclaims anything after now-retention.
+func Watermark(ts types.TS, now time.Time, retention time.Duration) types.TS {
+	if retention <= 0 {
+		return ts
+	}
+	retained := types.BuildTS(now.UTC().UnixNano()-int64(retention), 0)
+	if retained.Less(ts) {
+		return retained
+	}
+	return ts
+}
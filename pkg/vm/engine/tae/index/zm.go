@@ -259,7 +259,7 @@ func (zm ZM) getValue(buf []byte) any {
 		return types.DecodeFixed[bool](buf)
 	case types.T_int8:
 		return types.DecodeFixed[int8](buf)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return types.DecodeFixed[int16](buf)
 	case types.T_int32:
 		return types.DecodeFixed[int32](buf)
@@ -267,11 +267,11 @@ func (zm ZM) getValue(buf []byte) any {
 		return types.DecodeFixed[int64](buf)
 	case types.T_uint8:
 		return types.DecodeFixed[uint8](buf)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return types.DecodeFixed[uint16](buf)
 	case types.T_uint32:
 		return types.DecodeFixed[uint32](buf)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return types.DecodeFixed[uint64](buf)
 	case types.T_float32:
 		return types.DecodeFixed[float32](buf)
@@ -298,7 +298,7 @@ func (zm ZM) getValue(buf []byte) any {
 	case types.T_Blockid:
 		return types.DecodeFixed[types.Rowid](buf)
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		return buf
 	}
 	panic(fmt.Sprintf("unsupported type: %v", zm.GetType()))
@@ -637,18 +637,7 @@ func LoadCheckpointEntries(
 	}
 
 	for i := range locations {
-		pref, err := blockio.BuildPrefetchParams(fs, objectLocations[i])
-		if err != nil {
-			return nil, err
-		}
-		for idx, item := range checkpointDataRefer {
-			idxes := make([]uint16, len(item.attrs))
-			for col := range item.attrs {
-				idxes[col] = uint16(col)
-			}
-			pref.AddBlock(idxes, []uint16{uint16(idx)})
-		}
-		err = blockio.PrefetchWithMerged(pref)
+		err := prefetchCheckpointData(ctx, fs, objectLocations[i])
 		if err != nil {
 			return nil, err
 		}
@@ -66,6 +66,13 @@ func WithServerEnableChecksum(enable bool) ServerOption {
 	}
 }
 
+// WithServerTLS enables mutual TLS
+func WithServerTLS(cfg morpc.TLSConfig) ServerOption {
+	return func(s *LogtailServer) {
+		s.cfg.RpcTLS = cfg
+	}
+}
+
 // WithServerCollectInterval sets logtail collection interval.
 func WithServerCollectInterval(interval time.Duration) ServerOption {
 	return func(s *LogtailServer) {
@@ -180,7 +187,7 @@ func NewLogtailServer(
 		return s.pool.requests.Acquire()
 	}, codecOpts...)
 
-	rpc, err := morpc.NewRPCServer(LogtailServiceRPCName, address, codec,
+	serverOpts := []morpc.ServerOption{
 		morpc.WithServerLogger(s.logger.RawLogger()),
 		morpc.WithServerGoettyOptions(
 			goetty.WithSessionReleaseMsgFunc(func(v interface{}) {
@@ -190,7 +197,15 @@ func NewLogtailServer(
 				}
 			}),
 		),
-	)
+	}
+	if s.cfg.RpcTLS.Enable {
+		tc, err := s.cfg.RpcTLS.NewServerTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts, morpc.WithServerTLS(tc))
+	}
+	rpc, err := morpc.NewRPCServer(LogtailServiceRPCName, address, codec, serverOpts...)
 	if err != nil {
 		return nil, err
 	}
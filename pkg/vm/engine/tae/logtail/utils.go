@@ -17,6 +17,7 @@ package logtail
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -155,6 +156,71 @@ func GlobalCheckpointDataFactory(end types.TS, versionInterval time.Duration) fu
 	}
 }
 
+// BackupCheckpointDataFactory collects a global checkpoint at end like
+// GlobalCheckpointDataFactory, but only with the databases and tables accepted
+// by the filters. No dropped history is kept, the checkpoint is for a backup.
+// If deltaFn is not nil, the deletes of every block not dropped are replaced by
+// the deletes it returns, which may delete more rows of the block.
+func BackupCheckpointDataFactory(
+	end types.TS,
+	dbFilter func(*catalog.DBEntry) bool,
+	tblFilter func(*catalog.TableEntry) bool,
+	deltaFn func(entry *catalog.BlockEntry, metaLoc, deltaLoc objectio.Location) (objectio.Location, error),
+) func(c *catalog.Catalog) (*CheckpointData, error) {
+	return func(c *catalog.Catalog) (data *CheckpointData, err error) {
+		collector := NewGlobalCollector(end, 0)
+		defer collector.Close()
+		visitDB, visitTable, visitBlk := collector.DatabaseFn, collector.TableFn, collector.BlockFn
+		collector.DatabaseFn = func(entry *catalog.DBEntry) error {
+			if !dbFilter(entry) {
+				return moerr.GetOkStopCurrRecur()
+			}
+			return visitDB(entry)
+		}
+		collector.TableFn = func(entry *catalog.TableEntry) error {
+			if !tblFilter(entry) {
+				return moerr.GetOkStopCurrRecur()
+			}
+			return visitTable(entry)
+		}
+		if deltaFn != nil {
+			collector.BlockFn = func(entry *catalog.BlockEntry) error {
+				insBat, delBat := collector.data.bats[BLKMetaInsertIDX], collector.data.bats[BLKMetaDeleteIDX]
+				insStart := insBat.GetVectorByName(catalog.AttrRowID).Length()
+				delStart := delBat.GetVectorByName(catalog.AttrRowID).Length()
+				if err := visitBlk(entry); err != nil {
+					return err
+				}
+				insEnd := insBat.GetVectorByName(catalog.AttrRowID).Length()
+				if insEnd == insStart || delBat.GetVectorByName(catalog.AttrRowID).Length() != delStart {
+					return nil
+				}
+				// the last metadata of the block has its latest locations
+				last := insEnd - 1
+				metaLoc := objectio.Location(insBat.GetVectorByName(pkgcatalog.BlockMeta_MetaLoc).Get(last).([]byte))
+				deltaLoc := objectio.Location(insBat.GetVectorByName(pkgcatalog.BlockMeta_DeltaLoc).Get(last).([]byte))
+				loc, err := deltaFn(entry, metaLoc, deltaLoc)
+				if err != nil {
+					return err
+				}
+				insBat.GetVectorByName(pkgcatalog.BlockMeta_DeltaLoc).Update(last, []byte(loc), false)
+				collector.data.bats[BLKMetaInsertTxnIDX].GetVectorByName(pkgcatalog.BlockMeta_DeltaLoc).Update(last, []byte(loc), false)
+				return nil
+			}
+		}
+		err = c.RecurLoop(collector)
+		if moerr.IsMoErrCode(err, moerr.OkStopCurrRecur) {
+			err = nil
+		}
+		collector.data.prepareMeta()
+		if err != nil {
+			return
+		}
+		data = collector.OrphanData()
+		return
+	}
+}
+
 type CheckpointMeta struct {
 	blkInsertOffset *common.ClosedInterval
 	blkDeleteOffset *common.ClosedInterval
@@ -433,6 +499,39 @@ func (data *CheckpointData) UpdateBlkMeta(tid uint64, insStart, insEnd, delStart
 	}
 }
 
+// GetObjectNames returns the names of the objects, both the data and the
+// deltas, referred by the blocks in the checkpoint.
+func (data *CheckpointData) GetObjectNames() []string {
+	names := make(map[string]struct{})
+	for _, idx := range []uint16{
+		BLKMetaInsertTxnIDX,
+		BLKMetaDeleteTxnIDX,
+		BLKDNMetaInsertTxnIDX,
+		BLKDNMetaDeleteTxnIDX,
+	} {
+		bat := data.bats[idx]
+		for _, attr := range []string{pkgcatalog.BlockMeta_MetaLoc, pkgcatalog.BlockMeta_DeltaLoc} {
+			vec := bat.GetVectorByName(attr)
+			for i := 0; i < vec.Length(); i++ {
+				if vec.IsNull(i) {
+					continue
+				}
+				loc := objectio.Location(vec.Get(i).([]byte))
+				if loc.IsEmpty() {
+					continue
+				}
+				names[loc.Name().String()] = struct{}{}
+			}
+		}
+	}
+	ret := make([]string, 0, len(names))
+	for name := range names {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
 func (data *CheckpointData) PrintData() {
 	logutil.Info(BatchToString("BLK-META-DEL-BAT", data.bats[BLKMetaDeleteIDX], true))
 	logutil.Info(BatchToString("BLK-META-INS-BAT", data.bats[BLKMetaInsertIDX], true))
@@ -449,11 +548,30 @@ func (data *CheckpointData) WriteTo(
 	return
 }
 
+// checkpointColumns returns the indexes of the columns stored in the id-th
+// block of a checkpoint. Checkpoints written before a column was appended to
+// a system table schema have fewer columns than colNames.
+func checkpointColumns(ctx context.Context, colNames []string, id uint16, reader *blockio.BlockReader) ([]uint16, error) {
+	meta, err := reader.LoadObjectMeta(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	cnt := len(colNames)
+	if stored := int(meta.GetBlockMeta(uint32(id)).GetColumnCount()); stored < cnt {
+		cnt = stored
+	}
+	idxs := make([]uint16, cnt)
+	for i := range idxs {
+		idxs[i] = uint16(i)
+	}
+	return idxs, nil
+}
+
 func LoadBlkColumnsByMeta(cxt context.Context, colTypes []types.Type, colNames []string, id uint16, reader *blockio.BlockReader) (*containers.Batch, error) {
 	bat := containers.NewBatch()
-	idxs := make([]uint16, len(colNames))
-	for i := range colNames {
-		idxs[i] = uint16(i)
+	idxs, err := checkpointColumns(cxt, colNames, id, reader)
+	if err != nil {
+		return nil, err
 	}
 	ioResult, err := reader.LoadColumns(cxt, idxs, id, nil)
 	if err != nil {
@@ -472,28 +590,50 @@ func LoadBlkColumnsByMeta(cxt context.Context, colTypes []types.Type, colNames [
 		bat.Vecs[i] = vec
 
 	}
+	// the columns missing in an old checkpoint are filled with nulls
+	rows := 0
+	if len(bat.Vecs) > 0 {
+		rows = bat.Vecs[0].Length()
+	}
+	for i := len(idxs); i < len(colNames); i++ {
+		vec := containers.MakeVector(colTypes[i])
+		for j := 0; j < rows; j++ {
+			vec.Append(nil, true)
+		}
+		bat.AddVector(colNames[i], vec)
+	}
 	return bat, nil
 }
 
-func (data *CheckpointData) PrefetchFrom(
+func prefetchCheckpointData(
 	ctx context.Context,
 	service fileservice.FileService,
 	key objectio.Location) (err error) {
-
+	reader, err := blockio.NewObjectReader(service, key)
+	if err != nil {
+		return
+	}
 	pref, err := blockio.BuildPrefetchParams(service, key)
 	if err != nil {
 		return
 	}
 	for idx, item := range checkpointDataRefer {
-		idxes := make([]uint16, len(item.attrs))
-		for i := range item.attrs {
-			idxes[i] = uint16(i)
+		var idxes []uint16
+		if idxes, err = checkpointColumns(ctx, item.attrs, uint16(idx), reader); err != nil {
+			return
 		}
 		pref.AddBlock(idxes, []uint16{uint16(idx)})
 	}
 	return blockio.PrefetchWithMerged(pref)
 }
 
+func (data *CheckpointData) PrefetchFrom(
+	ctx context.Context,
+	service fileservice.FileService,
+	key objectio.Location) (err error) {
+	return prefetchCheckpointData(ctx, service, key)
+}
+
 // TODO:
 // There need a global io pool
 func (data *CheckpointData) ReadFrom(
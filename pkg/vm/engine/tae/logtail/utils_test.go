@@ -0,0 +1,80 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logtail
+
+import (
+	"context"
+	"testing"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/stretchr/testify/assert"
+)
+
+// a checkpoint written before the last columns were appended to mo_tables
+// and mo_columns
+func TestReadOldCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	fs, err := fileservice.NewMemoryFS("memory", fileservice.DisabledCacheConfig, nil)
+	assert.NoError(t, err)
+
+	data := NewCheckpointData()
+	defer data.Close()
+	tbl := data.bats[TBLInsertIDX]
+	col := data.bats[TBLColInsertIDX]
+	for _, idx := range []uint16{TBLInsertIDX, TBLColInsertIDX} {
+		bat := data.bats[idx]
+		old := containers.NewBatch()
+		for i, attr := range bat.Attrs[:len(bat.Attrs)-1] {
+			vec := bat.Vecs[i]
+			vec.Append(nil, true)
+			old.AddVector(attr, vec)
+		}
+		data.bats[idx] = old
+	}
+
+	segmentid, _ := types.BuildUuid()
+	name := objectio.BuildObjectName(segmentid, 0)
+	writer, err := blockio.NewBlockWriterNew(fs, name)
+	assert.NoError(t, err)
+	blks, err := data.WriteTo(writer)
+	assert.NoError(t, err)
+	data.bats[TBLInsertIDX] = tbl
+	data.bats[TBLColInsertIDX] = col
+	location := objectio.BuildLocation(name, blks[0].GetExtent(), 0, blks[0].GetID())
+
+	replayed := NewCheckpointData()
+	defer replayed.Close()
+	assert.NoError(t, replayed.PrefetchFrom(ctx, fs, location))
+	reader, err := blockio.NewObjectReader(fs, location)
+	assert.NoError(t, err)
+	assert.NoError(t, replayed.ReadFrom(ctx, reader, nil))
+
+	bat := replayed.bats[TBLInsertIDX]
+	assert.Equal(t, len(tbl.Attrs), len(bat.Attrs))
+	assert.Equal(t, 1, bat.Length())
+	vec := bat.GetVectorByName(pkgcatalog.SystemRelAttr_Compression)
+	assert.Equal(t, 1, vec.Length())
+	assert.True(t, vec.IsNull(0))
+
+	bat = replayed.bats[TBLColInsertIDX]
+	assert.Equal(t, len(col.Attrs), len(bat.Attrs))
+	vec = bat.GetVectorByName(pkgcatalog.SystemColAttr_EnumValues)
+	assert.Equal(t, 1, vec.Length())
+}
@@ -76,7 +76,7 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		Sort(cols[pk], boolLess, sortedIdx)
 	case types.T_int8:
 		Sort(cols[pk], numericLess[int8], sortedIdx)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		Sort(cols[pk], numericLess[int16], sortedIdx)
 	case types.T_int32:
 		Sort(cols[pk], numericLess[int32], sortedIdx)
@@ -84,11 +84,11 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		Sort(cols[pk], numericLess[int64], sortedIdx)
 	case types.T_uint8:
 		Sort(cols[pk], numericLess[uint8], sortedIdx)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		Sort(cols[pk], numericLess[uint16], sortedIdx)
 	case types.T_uint32:
 		Sort(cols[pk], numericLess[uint32], sortedIdx)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		Sort(cols[pk], numericLess[uint64], sortedIdx)
 	case types.T_float32:
 		Sort(cols[pk], numericLess[float32], sortedIdx)
@@ -115,7 +115,7 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 	case types.T_Blockid:
 		Sort(cols[pk], blockidLess, sortedIdx)
 	case types.T_char, types.T_json, types.T_varchar,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		Sort(cols[pk], bytesLess, sortedIdx)
 	default:
 		panic(fmt.Sprintf("%s not supported", cols[pk].GetType().String()))
@@ -183,7 +183,7 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = Merge(column, sortedIdx, boolLess, fromLayout, toLayout)
 	case types.T_int8:
 		ret, mapping = Merge(column, sortedIdx, numericLess[int8], fromLayout, toLayout)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		ret, mapping = Merge(column, sortedIdx, numericLess[int16], fromLayout, toLayout)
 	case types.T_int32:
 		ret, mapping = Merge(column, sortedIdx, numericLess[int32], fromLayout, toLayout)
@@ -191,11 +191,11 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = Merge(column, sortedIdx, numericLess[int64], fromLayout, toLayout)
 	case types.T_uint8:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint8], fromLayout, toLayout)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint16], fromLayout, toLayout)
 	case types.T_uint32:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint32], fromLayout, toLayout)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		ret, mapping = Merge(column, sortedIdx, numericLess[uint64], fromLayout, toLayout)
 	case types.T_float32:
 		ret, mapping = Merge(column, sortedIdx, numericLess[float32], fromLayout, toLayout)
@@ -222,7 +222,7 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 	case types.T_Blockid:
 		ret, mapping = Merge(column, sortedIdx, blockidLess, fromLayout, toLayout)
 	case types.T_char, types.T_json, types.T_varchar,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		ret, mapping = Merge(column, sortedIdx, bytesLess, fromLayout, toLayout)
 	default:
 		panic(fmt.Sprintf("%s not supported", column[0].GetType().String()))
@@ -79,6 +79,7 @@ func AttrFromColDef(col *catalog.ColDef) (attrs *engine.Attribute, err error) {
 		OnUpdate:      onUpdate,
 		AutoIncrement: col.IsAutoIncrement(),
 		ClusterBy:     col.IsClusterBy(),
+		EnumValues:    col.EnumValues,
 	}
 	return attr, nil
 }
@@ -132,6 +133,12 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 			Value: schema.Createsql,
 		})
 	}
+	if schema.Compression != "" {
+		pro.Properties = append(pro.Properties, engine.Property{
+			Key:   pkgcatalog.SystemRelAttr_Compression,
+			Value: schema.Compression,
+		})
+	}
 	defs = append(defs, pro)
 
 	return
@@ -176,6 +183,8 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 					schema.Relkind = property.Value
 				case pkgcatalog.SystemRelAttr_CreateSQL:
 					schema.Createsql = property.Value
+				case pkgcatalog.SystemRelAttr_Compression:
+					schema.Compression = property.Value
 				default:
 				}
 			}
@@ -17,6 +17,7 @@ package options
 import (
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/morpc"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 )
 
@@ -53,6 +54,18 @@ type CheckpointCfg struct {
 type GCCfg struct {
 	GCTTL          time.Duration
 	ScanGCInterval time.Duration
+	// HistoryRetention is how long the history data is kept for the reads at past
+	// timestamps, e.g. AS OF TIMESTAMP. 0 means no history is retained beyond GCTTL.
+	HistoryRetention time.Duration
+}
+
+type BackupCfg struct {
+	// Root is the dir the backups are written under, a local dir or a path with the
+	// arguments of the service, like LOAD DATA takes. The backups are disabled if
+	// it is empty.
+	Root string
+	// ArchiveWAL archives the WAL under Root for the point-in-time restore.
+	ArchiveWAL bool
 }
 
 type CatalogCfg struct {
@@ -76,6 +89,7 @@ type LogtailServerCfg struct {
 	LogtailCollectInterval   time.Duration
 	ResponseSendTimeout      time.Duration
 	MaxLogtailFetchFailure   int
+	RpcTLS                   morpc.TLSConfig
 }
 
 func NewDefaultLogtailServerCfg() *LogtailServerCfg {
@@ -183,6 +183,10 @@ func (o *Options) FillDefaults(dirname string) *Options {
 		o.GCCfg.ScanGCInterval = DefaultScanGCInterval
 	}
 
+	if o.BackupCfg == nil {
+		o.BackupCfg = new(BackupCfg)
+	}
+
 	if o.SchedulerCfg == nil {
 		ioworkers := DefaultIOWorkers
 		if ioworkers < runtime.NumCPU() {
@@ -67,6 +67,7 @@ type Options struct {
 	CheckpointCfg *CheckpointCfg `toml:"checkpoint-cfg"`
 	SchedulerCfg  *SchedulerCfg  `toml:"scheduler-cfg"`
 	GCCfg         *GCCfg
+	BackupCfg     *BackupCfg
 	LogtailCfg    *LogtailCfg
 	CatalogCfg    *CatalogCfg
 	Catalog       *catalog.Catalog
@@ -78,4 +79,8 @@ type Options struct {
 	Lc        logservicedriver.LogServiceClientFactory
 	Shard     metadata.DNShard
 	LogStoreT LogstoreType
+
+	// ArchiveFs is where the WAL is archived for the point-in-time restore, see
+	// wal.ArchivedDriver. The WAL is not archived if it is nil.
+	ArchiveFs fileservice.FileService
 }
@@ -536,6 +536,11 @@ func genCreateColumnTuple(
 		if err := vector.AppendFixed(bat.Vecs[idx], col.clusterBy, false, m); err != nil {
 			return nil, err
 		}
+		idx = catalog.MO_COLUMNS_ATT_ENUM_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoColumnsTypes[idx]) // attr_enum
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(""), false, m); err != nil {
+			return nil, err
+		}
 
 	}
 	return bat, nil
@@ -730,6 +735,11 @@ func genCreateTableTuple(
 		if err := vector.AppendFixed(bat.Vecs[idx], uint32(0), false, m); err != nil {
 			return nil, err
 		}
+		idx = catalog.MO_TABLES_COMPRESSION_IDX
+		bat.Vecs[idx] = vector.NewVec(catalog.MoTablesTypes[idx]) // rel_compression
+		if err := vector.AppendBytes(bat.Vecs[idx], []byte(""), false, m); err != nil {
+			return nil, err
+		}
 
 	}
 	return bat, nil
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 
 	"github.com/google/shlex"
+	"github.com/matrixorigin/matrixone/pkg/backup"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -394,6 +395,30 @@ func (h *Handle) HandleInspectDN(
 	return nil
 }
 
+func (h *Handle) HandleBackup(
+	ctx context.Context,
+	meta txn.TxnMeta,
+	req db.Backup,
+	resp *db.BackupResp) (err error) {
+	tae := h.eng.GetTAE(ctx)
+	dst, err := backup.OpenUnderRoot(tae.Fs.Service, tae.Opts.BackupCfg.Root, req.Path)
+	if err != nil {
+		return
+	}
+	scope := backup.Scope{
+		Kind:       backup.ScopeKind(req.Scope),
+		AccountID:  req.AccountID,
+		DatabaseID: req.DatabaseID,
+	}
+	m, err := backup.Backup(ctx, tae, dst, scope, 0)
+	if err != nil {
+		return
+	}
+	resp.TS = m.TS.DebugString()
+	resp.Files = len(m.Files)
+	return
+}
+
 func (h *Handle) prefetch(ctx context.Context,
 	req *db.WriteReq) error {
 	if len(req.DeltaLocs) == 0 {
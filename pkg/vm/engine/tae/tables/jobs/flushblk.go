@@ -70,6 +70,7 @@ func (task *flushBlkTask) Execute() error {
 	if task.meta.GetSchema().HasPK() {
 		writer.SetPrimaryKey(uint16(task.meta.GetSchema().GetSingleSortKeyIdx()))
 	}
+	writer.SetCompress(task.meta.GetSchema().GetCompression())
 	_, err = writer.WriteBatch(containers.ToCNBatch(task.data))
 	if err != nil {
 		return err
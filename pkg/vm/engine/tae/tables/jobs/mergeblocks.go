@@ -337,6 +337,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		pkIdx := schema.GetSingleSortKeyIdx()
 		writer.SetPrimaryKey(uint16(pkIdx))
 	}
+	writer.SetCompress(schema.GetCompression())
 	for _, bat := range batchs {
 		_, err = writer.WriteBatch(containers.ToCNBatch(bat))
 		if err != nil {
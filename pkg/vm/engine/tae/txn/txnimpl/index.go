@@ -98,7 +98,7 @@ func (idx *simpleTableIndex) KeyToVector(kType types.Type) containers.Vector {
 	vec := containers.MakeVector(kType)
 	switch kType.Oid {
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		for k := range idx.tree {
 			vec.Append([]byte(k.(string)), false)
 		}
@@ -115,7 +115,7 @@ func (idx *simpleTableIndex) KeyToVectors(kType types.Type) []containers.Vector
 	var vecs []containers.Vector
 	switch kType.Oid {
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		for k := range idx.tree {
 			if vec.Length() > int(MaxNodeRows) {
 				vecs = append(vecs, vec)
@@ -202,7 +202,7 @@ func (idx *simpleTableIndex) BatchInsert(
 		return InsertOp[bool](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_int8:
 		return InsertOp[int8](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		return InsertOp[int16](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_int32:
 		return InsertOp[int32](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
@@ -210,11 +210,11 @@ func (idx *simpleTableIndex) BatchInsert(
 		return InsertOp[int64](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_uint8:
 		return InsertOp[uint8](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return InsertOp[uint16](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_uint32:
 		return InsertOp[uint32](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return InsertOp[uint64](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_decimal64:
 		return InsertOp[types.Decimal64](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
@@ -241,7 +241,7 @@ func (idx *simpleTableIndex) BatchInsert(
 	case types.T_Blockid:
 		return InsertOp[types.Blockid](colType, attr, col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		vs := col
 		if dedupInput {
 			set := make(map[string]bool)
@@ -282,7 +282,7 @@ func (idx *simpleTableIndex) BatchDedup(attr string, col containers.Vector) erro
 	case types.T_int8:
 		vals := col.Slice()
 		return DedupOp[int8](colType, attr, vals, idx.tree)
-	case types.T_int16:
+	case types.T_int16, types.T_year:
 		vals := col.Slice()
 		return DedupOp[int16](colType, attr, vals, idx.tree)
 	case types.T_int32:
@@ -294,13 +294,13 @@ func (idx *simpleTableIndex) BatchDedup(attr string, col containers.Vector) erro
 	case types.T_uint8:
 		vals := col.Slice()
 		return DedupOp[uint8](colType, attr, vals, idx.tree)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vals := col.Slice()
 		return DedupOp[uint16](colType, attr, vals, idx.tree)
 	case types.T_uint32:
 		vals := col.Slice()
 		return DedupOp[uint32](colType, attr, vals, idx.tree)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vals := col.Slice()
 		return DedupOp[uint64](colType, attr, vals, idx.tree)
 	case types.T_decimal64:
@@ -337,7 +337,7 @@ func (idx *simpleTableIndex) BatchDedup(attr string, col containers.Vector) erro
 		vals := col.Slice()
 		return DedupOp[types.Blockid](colType, attr, vals, idx.tree)
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_vecf32, types.T_vecf64:
 		bs := col
 		for i := 0; i < col.Length(); i++ {
 			v := string(bs.Get(i).([]byte))
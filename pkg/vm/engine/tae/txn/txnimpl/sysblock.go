@@ -207,6 +207,8 @@ func FillColumnRow(table *catalog.TableEntry, node *catalog.MVCCNode[*catalog.Ta
 			colData.Append(bool2i8(colDef.IsClusterBy()), false)
 		case pkgcatalog.SystemColAttr_Update:
 			colData.Append(colDef.OnUpdate, false)
+		case pkgcatalog.SystemColAttr_EnumValues:
+			colData.Append([]byte(colDef.EnumValues), false)
 		default:
 			panic("unexpected colname. if add new catalog def, fill it in this switch")
 		}
@@ -276,6 +278,8 @@ func FillTableRow(table *catalog.TableEntry, node *catalog.MVCCNode[*catalog.Tab
 		colData.Append(schema.Constraint, false)
 	case pkgcatalog.SystemRelAttr_Version:
 		colData.Append(schema.Version, false)
+	case pkgcatalog.SystemRelAttr_Compression:
+		colData.Append([]byte(schema.Compression), false)
 	default:
 		panic("unexpected colname. if add new catalog def, fill it in this switch")
 	}
@@ -0,0 +1,255 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wal
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/store"
+)
+
+const (
+	// ArchiveDir is the dir of the archived WAL in the file service of the archive.
+	ArchiveDir = "wal/"
+
+	archiveBufferSize = 32 * common.M
+)
+
+// ArchivedDriver archives the txn records of the WAL into fs before they are
+// truncated by the checkpoints, so the point-in-time restore can replay them after
+// a backup. The records are buffered, and written into fs before the WAL is
+// checkpointed. The records not written before a crash are still in the WAL, they
+// are archived again by the replay of the restart. So a record may be archived
+// more than once.
+//
+// The records are archived in the dir of a session, which starts after the
+// checkpointed ts of the open, see Begin. Only the records of GroupPrepare are
+// archived, which are all the txns of a single DN.
+type ArchivedDriver struct {
+	Driver
+	fs fileservice.FileService
+
+	mu      sync.Mutex
+	session string
+	seq     int
+	pending [][]byte
+	size    int
+}
+
+func NewArchivedDriver(driver Driver, fs fileservice.FileService) *ArchivedDriver {
+	return &ArchivedDriver{
+		Driver: driver,
+		fs:     fs,
+	}
+}
+
+// Begin starts the session of the archive, all the records committed after
+// checkpointed are archived in it. It is called before the WAL is replayed.
+func (driver *ArchivedDriver) Begin(checkpointed types.TS) {
+	driver.mu.Lock()
+	defer driver.mu.Unlock()
+	// the sessions starting after the same checkpoint are told apart by the time
+	driver.session = fmt.Sprintf("%s%020d-%010d-%020d/", ArchiveDir,
+		checkpointed.Physical(), checkpointed.Logical(), time.Now().UnixNano())
+	driver.seq = 0
+}
+
+func (driver *ArchivedDriver) Replay(handle store.ApplyHandle) error {
+	return driver.Driver.Replay(func(group uint32, lsn uint64, payload []byte, typ uint16, info any) {
+		if group == GroupPrepare {
+			driver.archive(payload)
+		}
+		handle(group, lsn, payload, typ, info)
+	})
+}
+
+func (driver *ArchivedDriver) AppendEntry(group uint32, e LogEntry) (uint64, error) {
+	lsn, err := driver.Driver.AppendEntry(group, e)
+	if err == nil && group == GroupPrepare {
+		driver.archive(e.GetPayload())
+	}
+	return lsn, err
+}
+
+func (driver *ArchivedDriver) Checkpoint(indexes []*Index) (LogEntry, error) {
+	if err := driver.Flush(context.Background()); err != nil {
+		return nil, err
+	}
+	return driver.Driver.Checkpoint(indexes)
+}
+
+func (driver *ArchivedDriver) RangeCheckpoint(start, end uint64) (LogEntry, error) {
+	if err := driver.Flush(context.Background()); err != nil {
+		return nil, err
+	}
+	return driver.Driver.RangeCheckpoint(start, end)
+}
+
+func (driver *ArchivedDriver) Close() error {
+	if err := driver.Flush(context.Background()); err != nil {
+		logutil.Errorf("archive the WAL before close: %v", err)
+	}
+	return driver.Driver.Close()
+}
+
+func (driver *ArchivedDriver) archive(payload []byte) {
+	driver.mu.Lock()
+	defer driver.mu.Unlock()
+	driver.pending = append(driver.pending, append([]byte(nil), payload...))
+	driver.size += len(payload)
+	if driver.size < archiveBufferSize {
+		return
+	}
+	// the records are kept and written by the next flush if it fails
+	if err := driver.flushLocked(context.Background()); err != nil {
+		logutil.Warnf("archive the WAL: %v", err)
+	}
+}
+
+// Flush writes the buffered records into the file service of the archive.
+func (driver *ArchivedDriver) Flush(ctx context.Context) error {
+	driver.mu.Lock()
+	defer driver.mu.Unlock()
+	return driver.flushLocked(ctx)
+}
+
+func (driver *ArchivedDriver) flushLocked(ctx context.Context) error {
+	if len(driver.pending) == 0 {
+		return nil
+	}
+	if driver.session == "" {
+		return moerr.NewInternalError(ctx, "the archive of the WAL is not begun")
+	}
+	var buf bytes.Buffer
+	var size [4]byte
+	for _, payload := range driver.pending {
+		binary.LittleEndian.PutUint32(size[:], uint32(len(payload)))
+		buf.Write(size[:])
+		buf.Write(payload)
+	}
+	if err := driver.fs.Write(ctx, fileservice.IOVector{
+		FilePath: fmt.Sprintf("%s%010d", driver.session, driver.seq),
+		Entries: []fileservice.IOEntry{
+			{
+				Size: int64(buf.Len()),
+				Data: buf.Bytes(),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	driver.seq++
+	driver.pending = nil
+	driver.size = 0
+	return nil
+}
+
+// ArchiveSession is a session of the archived WAL.
+type ArchiveSession struct {
+	// Start is the checkpointed ts of the open, all the records committed after it
+	// are in the session.
+	Start types.TS
+	dir   string
+}
+
+// ListArchiveSessions returns the sessions of the WAL archived in fs, ordered by
+// their starts.
+func ListArchiveSessions(ctx context.Context, fs fileservice.FileService) ([]ArchiveSession, error) {
+	entries, err := fs.List(ctx, ArchiveDir)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]ArchiveSession, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir {
+			continue
+		}
+		var physical, nano int64
+		var logical uint32
+		if _, err = fmt.Sscanf(entry.Name, "%d-%d-%d", &physical, &logical, &nano); err != nil {
+			return nil, moerr.NewInternalError(ctx, "the session '%s' of the archived WAL: %v", entry.Name, err)
+		}
+		sessions = append(sessions, ArchiveSession{
+			Start: types.BuildTS(physical, logical),
+			dir:   ArchiveDir + entry.Name + "/",
+		})
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].Start.Equal(sessions[j].Start) {
+			return sessions[i].dir < sessions[j].dir
+		}
+		return sessions[i].Start.Less(sessions[j].Start)
+	})
+	return sessions, nil
+}
+
+// ReadArchiveSession calls fn with the records archived in the session, in the
+// order of the WAL.
+func ReadArchiveSession(
+	ctx context.Context,
+	fs fileservice.FileService,
+	session ArchiveSession,
+	fn func(payload []byte) error) error {
+	entries, err := fs.List(ctx, session.dir)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir {
+			names = append(names, entry.Name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		vec := &fileservice.IOVector{
+			FilePath: session.dir + name,
+			Entries: []fileservice.IOEntry{
+				{
+					Size: -1,
+				},
+			},
+		}
+		if err = fs.Read(ctx, vec); err != nil {
+			return err
+		}
+		data := vec.Entries[0].Data
+		for len(data) > 0 {
+			if len(data) < 4 {
+				return moerr.NewInternalError(ctx, "the archived WAL '%s' is truncated", vec.FilePath)
+			}
+			size := int(binary.LittleEndian.Uint32(data))
+			if len(data) < 4+size {
+				return moerr.NewInternalError(ctx, "the archived WAL '%s' is truncated", vec.FilePath)
+			}
+			if err = fn(data[4 : 4+size]); err != nil {
+				return err
+			}
+			data = data[4+size:]
+		}
+	}
+	return nil
+}
@@ -66,6 +66,8 @@ type Attribute struct {
 	Comment string
 	// AutoIncrement is auto incr or not
 	AutoIncrement bool
+	// EnumValues is the members of ENUM or SET
+	EnumValues string
 }
 
 type PropertiesDef struct {
@@ -145,6 +147,10 @@ type RefChildTableDef struct {
 	Tables []uint64
 }
 
+type CheckDef struct {
+	Checks []*plan.CheckDef
+}
+
 type TableDef interface {
 	tableDef()
 }
@@ -169,6 +175,7 @@ const (
 	RefChildTable
 	ForeignKey
 	PrimaryKey
+	Check
 )
 
 type EngineType int8
@@ -244,6 +251,23 @@ func (c *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				return nil, err
 			}
 			buf.Write(bytes)
+		case *CheckDef:
+			if err := binary.Write(buf, binary.BigEndian, Check); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Checks))); err != nil {
+				return nil, err
+			}
+			for _, check := range def.Checks {
+				bytes, err := check.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
 		}
 	}
 	return buf.Bytes(), nil
@@ -312,6 +336,24 @@ func (c *ConstraintDef) UnmarshalBinary(data []byte) error {
 			}
 			l += int(length)
 			c.Cts = append(c.Cts, &PrimaryKeyDef{pkey})
+
+		case Check:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			checks := make([]*plan.CheckDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				check := &plan.CheckDef{}
+				err := check.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				checks[i] = check
+			}
+			c.Cts = append(c.Cts, &CheckDef{checks})
 		}
 	}
 	return nil
@@ -336,6 +378,7 @@ func (*ForeignKeyDef) constraint()    {}
 func (*PrimaryKeyDef) constraint()    {}
 func (*RefChildTableDef) constraint() {}
 func (*IndexDef) constraint()         {}
+func (*CheckDef) constraint()         {}
 
 type Relation interface {
 	Statistics
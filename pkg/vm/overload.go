@@ -54,6 +54,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/product"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/recursivecte"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/right"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/rightanti"
@@ -63,6 +64,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/table_function"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -118,6 +120,9 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 
 	TableFunction: table_function.String,
 
+	Window:       window.String,
+	RecursiveCte: recursivecte.String,
+
 	LockOp: lockop.String,
 }
 
@@ -173,6 +178,9 @@ var prepareFunc = [...]func(*process.Process, any) error{
 
 	TableFunction: table_function.Prepare,
 
+	Window:       window.Prepare,
+	RecursiveCte: recursivecte.Prepare,
+
 	LockOp: lockop.Prepare,
 }
 
@@ -229,5 +237,8 @@ var execFunc = [...]func(int, *process.Process, any, bool, bool) (bool, error){
 
 	TableFunction: table_function.Call,
 
+	Window:       window.Call,
+	RecursiveCte: recursivecte.Call,
+
 	LockOp: lockop.Call,
 }
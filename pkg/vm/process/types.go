@@ -82,6 +82,10 @@ type Limitation struct {
 	ReaderSize int64
 	// MaxMessageSize max size for read messages from dn
 	MaxMsgSize uint64
+	// SpillSize, memory threshold of a query, operators spill their
+	// intermediate results to the local file service once it is exceeded.
+	// 0 means never spill.
+	SpillSize int64
 }
 
 // SessionInfo session information
@@ -190,6 +194,8 @@ type vectorPool struct {
 
 type sqlHelper interface {
 	ExecSql(string) ([]interface{}, error)
+	// HasBackupPrivilege returns whether the user of the session can back up.
+	HasBackupPrivilege() (bool, error)
 }
 
 type WrapCs struct {